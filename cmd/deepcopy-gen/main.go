@@ -0,0 +1,233 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+// deepcopy-gen writes the DeepCopy and DeepCopyInto methods of every struct of the
+// message packages to their deepcopy.go
+//
+//	go run ./cmd/deepcopy-gen pkg/pacs_v08 pkg/pain_v11 ...
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+const outputName = "deepcopy.go"
+
+func main() {
+	if len(os.Args) < 2 {
+		log.Fatal("usage: deepcopy-gen <package directory>...")
+	}
+	// the importer caches the packages shared by the message packages
+	fset := token.NewFileSet()
+	imp := importer.ForCompiler(fset, "source", nil)
+	for _, dir := range os.Args[1:] {
+		if err := generate(fset, imp, dir); err != nil {
+			log.Fatalf("%s: %v", dir, err)
+		}
+	}
+}
+
+func generate(fset *token.FileSet, imp types.Importer, dir string) error {
+	pkgs, err := parser.ParseDir(fset, dir, func(info os.FileInfo) bool {
+		name := info.Name()
+		return !strings.HasSuffix(name, "_test.go") && name != outputName
+	}, 0)
+	if err != nil {
+		return err
+	}
+	if len(pkgs) != 1 {
+		return fmt.Errorf("found %d packages", len(pkgs))
+	}
+
+	var files []*ast.File
+	var name string
+	for pkgName, pkg := range pkgs {
+		name = pkgName
+		for _, file := range pkg.Files {
+			files = append(files, file)
+		}
+	}
+
+	conf := types.Config{Importer: imp}
+	pkg, err := conf.Check(name, fset, files, nil)
+	if err != nil {
+		return err
+	}
+
+	g := &generator{pkg: pkg, deep: make(map[types.Type]bool), imports: make(map[string]bool)}
+	for _, typeName := range pkg.Scope().Names() {
+		obj, ok := pkg.Scope().Lookup(typeName).(*types.TypeName)
+		if !ok {
+			continue
+		}
+		named, ok := obj.Type().(*types.Named)
+		if !ok {
+			continue
+		}
+		if st, ok := named.Underlying().(*types.Struct); ok {
+			if err := g.writeStruct(typeName, st); err != nil {
+				return err
+			}
+		}
+	}
+	if g.buf.Len() == 0 {
+		return nil
+	}
+
+	var out bytes.Buffer
+	fmt.Fprintf(&out, "// Code generated by deepcopy-gen. DO NOT EDIT.\n\npackage %s\n\n", name)
+	if len(g.imports) > 0 {
+		var paths []string
+		for path := range g.imports {
+			paths = append(paths, path)
+		}
+		sort.Strings(paths)
+		out.WriteString("import (\n")
+		for _, path := range paths {
+			fmt.Fprintf(&out, "\t%q\n", path)
+		}
+		out.WriteString(")\n\n")
+	}
+	out.Write(g.buf.Bytes())
+	src, err := format.Source(out.Bytes())
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(dir, outputName), src, 0644)
+}
+
+type generator struct {
+	pkg     *types.Package
+	buf     bytes.Buffer
+	deep    map[types.Type]bool
+	imports map[string]bool
+}
+
+func (g *generator) writeStruct(name string, st *types.Struct) error {
+	fmt.Fprintf(&g.buf, `// DeepCopy returns a copy of r sharing no memory with r
+func (r *%[1]s) DeepCopy() *%[1]s {
+	if r == nil {
+		return nil
+	}
+	out := new(%[1]s)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *%[1]s) DeepCopyInto(out *%[1]s) {
+	*out = *r
+`, name)
+	for i := 0; i < st.NumFields(); i++ {
+		field := st.Field(i)
+		if !g.needsDeepCopy(field.Type()) {
+			continue
+		}
+		if err := g.writeCopy("out."+field.Name(), "r."+field.Name(), field.Type()); err != nil {
+			return fmt.Errorf("%s.%s: %w", name, field.Name(), err)
+		}
+	}
+	g.buf.WriteString("}\n\n")
+	return nil
+}
+
+// writeCopy writes the statements deep copying src into dst, dst holds a shallow copy of src
+func (g *generator) writeCopy(dst, src string, t types.Type) error {
+	switch u := t.Underlying().(type) {
+	case *types.Struct:
+		named, ok := t.(*types.Named)
+		if !ok || named.Obj().Pkg() != g.pkg {
+			return fmt.Errorf("unsupported struct %s", t)
+		}
+		fmt.Fprintf(&g.buf, "%s.DeepCopyInto(&%s)\n", src, dst)
+	case *types.Pointer:
+		elem := g.typeString(u.Elem())
+		fmt.Fprintf(&g.buf, "if %s != nil {\n%s = new(%s)\n", src, dst, elem)
+		if g.needsDeepCopy(u.Elem()) {
+			if _, ok := u.Elem().Underlying().(*types.Struct); ok {
+				fmt.Fprintf(&g.buf, "%s.DeepCopyInto(%s)\n", src, dst)
+			} else {
+				fmt.Fprintf(&g.buf, "*%s = *%s\n", dst, src)
+				if err := g.writeCopy("(*"+dst+")", "(*"+src+")", u.Elem()); err != nil {
+					return err
+				}
+			}
+		} else {
+			fmt.Fprintf(&g.buf, "*%s = *%s\n", dst, src)
+		}
+		g.buf.WriteString("}\n")
+	case *types.Slice:
+		fmt.Fprintf(&g.buf, "if %s != nil {\n%s = make(%s, len(%s))\n", src, dst, g.typeString(t), src)
+		if g.needsDeepCopy(u.Elem()) {
+			fmt.Fprintf(&g.buf, "for i := range %s {\n", src)
+			if _, ok := u.Elem().Underlying().(*types.Struct); ok {
+				fmt.Fprintf(&g.buf, "%s[i].DeepCopyInto(&%s[i])\n", src, dst)
+			} else {
+				fmt.Fprintf(&g.buf, "%s[i] = %s[i]\n", dst, src)
+				if err := g.writeCopy(dst+"[i]", src+"[i]", u.Elem()); err != nil {
+					return err
+				}
+			}
+			g.buf.WriteString("}\n")
+		} else {
+			fmt.Fprintf(&g.buf, "copy(%s, %s)\n", dst, src)
+		}
+		g.buf.WriteString("}\n")
+	default:
+		return fmt.Errorf("unsupported type %s", t)
+	}
+	return nil
+}
+
+// needsDeepCopy reports whether copying a value of t shares memory
+func (g *generator) needsDeepCopy(t types.Type) bool {
+	if deep, ok := g.deep[t]; ok {
+		return deep
+	}
+	// recursive types hold pointers or slices
+	g.deep[t] = true
+
+	var deep bool
+	switch u := t.Underlying().(type) {
+	case *types.Basic:
+		deep = false
+	case *types.Struct:
+		// structs of other packages (xml.Name, time.Time) are values
+		if named, ok := t.(*types.Named); ok && named.Obj().Pkg() != g.pkg {
+			break
+		}
+		for i := 0; i < u.NumFields(); i++ {
+			if g.needsDeepCopy(u.Field(i).Type()) {
+				deep = true
+				break
+			}
+		}
+	default:
+		deep = true
+	}
+	g.deep[t] = deep
+	return deep
+}
+
+func (g *generator) typeString(t types.Type) string {
+	return types.TypeString(t, func(pkg *types.Package) string {
+		if pkg == g.pkg {
+			return ""
+		}
+		g.imports[pkg.Path()] = true
+		return pkg.Name()
+	})
+}
@@ -6,12 +6,35 @@ package main
 
 import (
 	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"net/http/httptest"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 
+	"github.com/gorilla/mux"
+	baseConfig "github.com/moov-io/base/config"
+	baseLog "github.com/moov-io/base/log"
+	"github.com/moov-io/iso20022/pkg/deadletter"
+	"github.com/moov-io/iso20022/pkg/loadtest"
+	"github.com/moov-io/iso20022/pkg/manifest"
+	"github.com/moov-io/iso20022/pkg/pipeline"
+	"github.com/moov-io/iso20022/pkg/server"
+	"github.com/moov-io/iso20022/pkg/storage"
+	"github.com/moov-io/iso20022/pkg/templates"
 	"github.com/moov-io/iso20022/pkg/utils"
 	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
 )
 
 var (
@@ -79,6 +102,56 @@ func TestConvertXml(t *testing.T) {
 	deleteFile()
 }
 
+func TestConvertNameTemplate(t *testing.T) {
+	t.Cleanup(func() {
+		Convert.Flags().Set("name-template", "")
+		Convert.Flags().Set("by-message-type", "false")
+	})
+
+	dir := t.TempDir()
+	_, err := executeCommand(rootCmd, "convert", dir, "--input", testXmlFileName, "--name-template", "{msgType}_{msgId}{ext}", "--by-message-type")
+	if err != nil {
+		t.Errorf(err.Error())
+	}
+	if _, err = os.Stat(filepath.Join(dir, "pain.002.001.11", "pain.002.001.11_MsgId.xml")); err != nil {
+		t.Errorf("document isn't written: %v", err)
+	}
+}
+
+func TestConvertDeterministic(t *testing.T) {
+	t.Cleanup(func() {
+		Convert.Flags().Set("deterministic", "false")
+	})
+
+	original, err := os.ReadFile(testXmlFileName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dir := t.TempDir()
+	declared := filepath.Join(dir, "declared.xml")
+	replacer := strings.NewReplacer("\n", "", "\t", "", "<Document ", `<Document xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" `)
+	if err = os.WriteFile(declared, []byte(replacer.Replace(string(original))), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	// the same message with another indentation and declarations
+	var outputs [][]byte
+	for _, input := range []string{testXmlFileName, declared} {
+		output := filepath.Join(dir, "output.xml")
+		if _, err = executeCommand(rootCmd, "convert", output, "--input", input, "--deterministic"); err != nil {
+			t.Errorf(err.Error())
+		}
+		buf, err := os.ReadFile(output)
+		if err != nil {
+			t.Fatal(err)
+		}
+		outputs = append(outputs, buf)
+	}
+	if !bytes.Equal(outputs[0], outputs[1]) {
+		t.Errorf("outputs differ:\n%s\n%s", outputs[0], outputs[1])
+	}
+}
+
 func TestConvertUnknown(t *testing.T) {
 	_, err := executeCommand(rootCmd, "convert", "output", "--input", testFileName, "--format", "unknown")
 	if err == nil {
@@ -216,3 +289,405 @@ func TestWebTest(t *testing.T) {
 		t.Errorf(err.Error())
 	}
 }
+
+func TestServerValidateConfig(t *testing.T) {
+	t.Cleanup(func() { WebCmd.Flags().Set("validate-config", "false") })
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, "config.yml")
+	config := "iso20022:\n  Backends:\n    - Name: archive\n      Type: storage\n      Addresses: [\"file://" + dir + "\"]\n"
+	if err := os.WriteFile(path, []byte(config), 0600); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("APP_CONFIG", path)
+
+	output, err := executeCommand(rootCmd, "server", "--validate-config")
+	if err != nil {
+		t.Errorf(err.Error())
+	}
+	if !strings.Contains(output, `"check": "backends.archive"`) || !strings.Contains(output, `"valid": true`) {
+		t.Errorf("unexpected diagnostics %s", output)
+	}
+
+	config = "iso20022:\n  Backends:\n    - Name: cache\n      Type: redis\n      Addresses: [\"127.0.0.1:1\"]\n"
+	if err := os.WriteFile(path, []byte(config), 0600); err != nil {
+		t.Fatal(err)
+	}
+	output, err = executeCommand(rootCmd, "server", "--validate-config")
+	if err == nil {
+		t.Errorf("invalid configuration")
+	}
+	if !strings.Contains(output, `"valid": false`) {
+		t.Errorf("unexpected diagnostics %s", output)
+	}
+
+	// secret references are resolved before the configuration is validated
+	t.Setenv("DOCS_DIR", dir)
+	config = "iso20022:\n  API:\n    Storage:\n      Directory: \"env:DOCS_DIR\"\n"
+	if err := os.WriteFile(path, []byte(config), 0600); err != nil {
+		t.Fatal(err)
+	}
+	output, err = executeCommand(rootCmd, "server", "--validate-config")
+	if err != nil {
+		t.Errorf(err.Error())
+	}
+	if !strings.Contains(output, `"check": "secrets"`) || !strings.Contains(output, `"valid": true`) {
+		t.Errorf("unexpected diagnostics %s", output)
+	}
+	if _, err := os.Stat("env:DOCS_DIR"); !os.IsNotExist(err) {
+		t.Errorf("the secret reference is used as a directory")
+	}
+
+	config = "iso20022:\n  API:\n    Storage:\n      Directory: \"env:MISSING_DOCS_DIR\"\n"
+	if err := os.WriteFile(path, []byte(config), 0600); err != nil {
+		t.Fatal(err)
+	}
+	output, err = executeCommand(rootCmd, "server", "--validate-config")
+	if err == nil {
+		t.Errorf("unresolved secret reference")
+	}
+	if !strings.Contains(output, `"check": "secrets"`) || !strings.Contains(output, `"valid": false`) {
+		t.Errorf("unexpected diagnostics %s", output)
+	}
+}
+
+func TestCompare(t *testing.T) {
+	t.Cleanup(func() {
+		Compare.Flags().Set("current", "")
+		Compare.Flags().Set("candidate-config", "")
+	})
+
+	path := filepath.Join(t.TempDir(), "candidate.yml")
+	config := "name: SCTInst\nversion: \"2\"\nextends: SCTInst\nrules:\n  - id: CAND-001\n    expression: \"exists(PmtId/UETR)\"\n"
+	if err := os.WriteFile(path, []byte(config), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	input := filepath.Join("..", "..", "test", "testdata", "sct_inst_pacs_v08.xml")
+	output, err := executeCommand(rootCmd, "compare", input, testXmlFileName, "--current", "SCTInst", "--candidate-config", path)
+	if err != nil {
+		t.Errorf(err.Error())
+	}
+	if !strings.Contains(output, input+": + CAND-001") || !strings.Contains(output, `"Documents": 2`) {
+		t.Errorf("unexpected comparison %s", output)
+	}
+
+	_, err = executeCommand(rootCmd, "compare", input, "--current", "SCTInst", "--candidate-config", "")
+	if err == nil {
+		t.Errorf("requires candidate")
+	}
+}
+
+func TestCorpusCheck(t *testing.T) {
+	dir := t.TempDir()
+	buf, err := os.ReadFile(testXmlFileName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = os.WriteFile(filepath.Join(dir, "valid.xml"), buf, 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	output, err := executeCommand(rootCmd, "corpus-check", dir)
+	if err != nil {
+		t.Errorf(err.Error())
+	}
+	if !strings.Contains(output, `"compatible": 1`) {
+		t.Errorf("unexpected report %s", output)
+	}
+
+	if err = os.WriteFile(filepath.Join(dir, "broken.json"), []byte("{"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	output, err = executeCommand(rootCmd, "corpus-check", dir)
+	if err == nil || err.Error() != "1 of 2 messages are incompatible" {
+		t.Errorf("unexpected error %v", err)
+	}
+	if !strings.Contains(output, `"stage": "parse"`) {
+		t.Errorf("unexpected report %s", output)
+	}
+}
+
+func TestRedrive(t *testing.T) {
+	t.Cleanup(func() {
+		Redrive.Flags().Set("output", "")
+		Redrive.Flags().Set("by-message-type", "false")
+	})
+
+	dir, output := t.TempDir(), filepath.Join(t.TempDir(), "out")
+	store, err := storage.NewFileStore(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	input, err := os.ReadFile(testXmlFileName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	queue := deadletter.NewQueue(store)
+	if _, err = queue.Put(context.Background(), pipeline.Item{Name: "inbox/pain.xml", Input: input, Stage: "enrich", Err: errors.New("timeout")}); err != nil {
+		t.Fatal(err)
+	}
+
+	out, err := executeCommand(rootCmd, "redrive", dir, "--output", output, "--by-message-type")
+	if err != nil {
+		t.Errorf(err.Error())
+	}
+	if !strings.Contains(out, `"Succeeded": 1`) {
+		t.Errorf("unexpected stats %s", out)
+	}
+	if _, err = os.Stat(filepath.Join(output, "pain.002.001.11", "pain.xml")); err != nil {
+		t.Errorf("message isn't written: %v", err)
+	}
+	if letters, _ := queue.List(context.Background()); len(letters) != 0 {
+		t.Errorf("letters are kept: %v", letters)
+	}
+
+	if _, err = queue.Put(context.Background(), pipeline.Item{Name: "broken.xml", Input: []byte("<Document"), Stage: pipeline.StageParse, Err: errors.New("EOF")}); err != nil {
+		t.Fatal(err)
+	}
+	_, err = executeCommand(rootCmd, "redrive", dir, "--output", output)
+	if err == nil || err.Error() != "1 of 1 messages are still failing" {
+		t.Errorf("unexpected error %v", err)
+	}
+}
+
+func TestSplit(t *testing.T) {
+	dir := t.TempDir()
+	out, err := executeCommand(rootCmd, "split", dir, "--input", filepath.Join("..", "..", "test", "testdata", "valid_camt053_v08.xml"))
+	if err != nil {
+		t.Errorf(err.Error())
+	}
+	for _, name := range []string{"STMT-20210415-0001-1.xml", "STMT-20210415-0001-2.xml"} {
+		if !strings.Contains(out, name) {
+			t.Errorf("unexpected output %s", out)
+		}
+		if _, err = os.Stat(filepath.Join(dir, name)); err != nil {
+			t.Errorf("statement isn't written: %v", err)
+		}
+	}
+
+	_, err = executeCommand(rootCmd, "split", dir, "--input", testXmlFileName)
+	if err == nil {
+		t.Errorf("splits a pain.002 document")
+	}
+}
+
+func TestDigest(t *testing.T) {
+	dir := t.TempDir()
+	out, err := executeCommand(rootCmd, "digest", dir,
+		filepath.Join("..", "..", "test", "testdata", "valid_camt054_v08_1.xml"),
+		filepath.Join("..", "..", "test", "testdata", "valid_camt054_v08_2.xml"))
+	if err != nil {
+		t.Errorf(err.Error())
+	}
+	for _, name := range []string{"DGST-20210415-1.xml", "DGST-20210415-2.xml", "DGST-20210416-3.xml"} {
+		if !strings.Contains(out, name) {
+			t.Errorf("unexpected output %s", out)
+		}
+		if _, err = os.Stat(filepath.Join(dir, name)); err != nil {
+			t.Errorf("digest isn't written: %v", err)
+		}
+	}
+
+	t.Cleanup(func() {
+		Digest.Flags().Set("time-zone", "UTC")
+		Digest.Flags().Set("cutoff", "")
+	})
+	out, err = executeCommand(rootCmd, "digest", dir, "--time-zone", "UTC", "--cutoff", "16:00",
+		filepath.Join("..", "..", "test", "testdata", "valid_camt054_v08_1.xml"),
+		filepath.Join("..", "..", "test", "testdata", "valid_camt054_v08_2.xml"))
+	if err != nil {
+		t.Errorf(err.Error())
+	}
+	if !strings.Contains(out, "DGST-20210416-3.xml") {
+		t.Errorf("unexpected output %s", out)
+	}
+	_, err = executeCommand(rootCmd, "digest", dir, "--cutoff", "18h", testXmlFileName)
+	if err == nil {
+		t.Errorf("accepts an invalid cutoff")
+	}
+	Digest.Flags().Set("cutoff", "")
+
+	_, err = executeCommand(rootCmd, "digest", dir, testXmlFileName)
+	if err == nil {
+		t.Errorf("merges a pain.002 document")
+	}
+}
+
+func TestSplitManifest(t *testing.T) {
+	t.Cleanup(func() {
+		Split.Flags().Set("manifest", "")
+		Split.Flags().Set("signing-key", "")
+	})
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dir := t.TempDir()
+	keyFile, path := filepath.Join(dir, "key.pem"), filepath.Join(dir, "run.manifest.json")
+	if err = os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	input := filepath.Join("..", "..", "test", "testdata", "valid_camt053_v08.xml")
+	_, err = executeCommand(rootCmd, "split", filepath.Join(dir, "out"), "--input", input, "--manifest", path, "--signing-key", keyFile)
+	if err != nil {
+		t.Errorf(err.Error())
+	}
+	m, _, err := manifest.Verify(path, key.Public())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m.Command != "split" || len(m.Inputs) != 1 || m.Inputs[0].Status != manifest.StatusSucceeded || len(m.Outputs) != 2 {
+		t.Errorf("unexpected manifest %+v", m)
+	}
+	for _, output := range m.Outputs {
+		buf, err := os.ReadFile(output.Path)
+		if err != nil {
+			t.Errorf("output isn't written: %v", err)
+		}
+		if sum := sha256.Sum256(buf); hex.EncodeToString(sum[:]) != output.SHA256 {
+			t.Errorf("unexpected hash of %s", output.Path)
+		}
+	}
+
+	// failed runs are recorded
+	_, err = executeCommand(rootCmd, "split", filepath.Join(dir, "out"), "--input", testXmlFileName, "--manifest", path, "--signing-key", keyFile)
+	if err == nil {
+		t.Errorf("splits a pain.002 document")
+	}
+	if m, _, err = manifest.Verify(path, key.Public()); err != nil {
+		t.Fatal(err)
+	}
+	if len(m.Inputs) != 1 || m.Inputs[0].Status != manifest.StatusFailed || len(m.Outputs) != 0 {
+		t.Errorf("unexpected manifest %+v", m)
+	}
+}
+
+func TestInstantiate(t *testing.T) {
+	dir := t.TempDir()
+	fixture, err := os.ReadFile(filepath.Join("..", "..", "test", "testdata", "valid_pacs_v08.xml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl, err := yaml.Marshal(templates.Template{
+		Name:      "payroll",
+		Variables: []templates.Variable{{Name: "month", Required: true}},
+		Content:   strings.Replace(string(fixture), "MSG-20210415-0001", "PAYROLL-${month}", 1),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	input := filepath.Join(dir, "payroll.yaml")
+	if err = os.WriteFile(input, tmpl, 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	output := filepath.Join(dir, "payroll.xml")
+	_, err = executeCommand(rootCmd, "instantiate", output, "--input", input)
+	if err == nil || !strings.Contains(err.Error(), "month required") {
+		t.Errorf("instantiates without required variable: %v", err)
+	}
+
+	_, err = executeCommand(rootCmd, "instantiate", output, "--input", input, "--var", "month=2026-10")
+	if err != nil {
+		t.Errorf(err.Error())
+	}
+	buf, err := os.ReadFile(output)
+	if err != nil || !bytes.Contains(buf, []byte("<MsgId>PAYROLL-2026-10</MsgId>")) {
+		t.Errorf("unexpected instance %s: %v", buf, err)
+	}
+
+	_, err = executeCommand(rootCmd, "instantiate", output, "--input", testXmlFileName)
+	if err == nil {
+		t.Errorf("instantiates a document")
+	}
+}
+
+func TestLoadTest(t *testing.T) {
+	router := mux.NewRouter()
+	if err := server.ConfigureHandlers(router); err != nil {
+		t.Fatal(err)
+	}
+	ts := httptest.NewServer(router)
+	defer ts.Close()
+
+	_, err := executeCommand(rootCmd, "loadtest", ts.URL)
+	if err == nil || !strings.Contains(err.Error(), "requires --message") {
+		t.Errorf("load tests without message: %v", err)
+	}
+
+	// the invalid messages of the mix fail the test with a zero max error rate
+	valid := filepath.Join("..", "..", "test", "testdata", "valid_pacs_v08.xml")
+	output, err := executeCommand(rootCmd, "loadtest", ts.URL, "--message", valid+"=3", "--message", testErrorFileName,
+		"--requests", "20", "--concurrency", "2", "--max-error-rate", "0")
+	if err == nil || !strings.Contains(err.Error(), "exceeds 0.00%") {
+		t.Errorf("load test doesn't fail with errors: %v", err)
+	}
+	var report loadtest.Report
+	if err := json.NewDecoder(strings.NewReader(output)).Decode(&report); err != nil {
+		t.Fatal(err)
+	}
+	if report.Requests != 20 || report.Statuses["200"]+report.Errors != 20 || len(report.Messages) != 2 {
+		t.Errorf("unexpected report: %s", output)
+	}
+}
+
+func TestSnapshot(t *testing.T) {
+	newEnvironment := func(config *server.Config) (*server.Environment, *httptest.Server) {
+		env, err := server.NewEnvironment(&server.Environment{Logger: baseLog.NewNopLogger(), Config: config})
+		if err != nil {
+			t.Fatal(err)
+		}
+		t.Cleanup(env.Shutdown)
+		admin := httptest.NewServer(server.SnapshotHandler(env))
+		t.Cleanup(admin.Close)
+		return env, admin
+	}
+	templatesDir := t.TempDir()
+	primary, primaryAdmin := newEnvironment(&server.Config{API: server.APIConfig{Templates: server.TemplatesConfig{Directory: templatesDir}}})
+	buf, err := os.ReadFile(filepath.Join("..", "..", "test", "testdata", "valid_pacs_v08.xml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := primary.Templates.Put(context.Background(), templates.Template{Name: "payment", Content: string(buf)}); err != nil {
+		t.Fatal(err)
+	}
+	standby, standbyAdmin := newEnvironment(&server.Config{})
+
+	file := filepath.Join(t.TempDir(), "snapshot.json")
+	if _, err := executeCommand(rootCmd, "snapshot", "export", file, "--admin", primaryAdmin.URL); err != nil {
+		t.Fatal(err)
+	}
+	config := filepath.Join(t.TempDir(), "config.yml")
+	output, err := executeCommand(rootCmd, "snapshot", "import", file, "--admin", standbyAdmin.URL, "--config", config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var status server.RestoreStatus
+	if err := json.NewDecoder(strings.NewReader(output)).Decode(&status); err != nil || status.Templates != 1 {
+		t.Errorf("unexpected restore status: %s", output)
+	}
+	if _, err := standby.Templates.Get(context.Background(), "payment"); err != nil {
+		t.Errorf("template isn't imported: %v", err)
+	}
+
+	// the config file of the standby is the configuration of the primary
+	t.Setenv(baseConfig.APP_CONFIG, config)
+	cfg, err := server.LoadConfig(baseLog.NewNopLogger())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.API.Templates.Directory != templatesDir {
+		t.Errorf("unexpected templates directory %q", cfg.API.Templates.Directory)
+	}
+
+	if _, err := executeCommand(rootCmd, "snapshot", "export", file, "--admin", "http://localhost:1"); err == nil {
+		t.Error("exported a snapshot of an unavailable server")
+	}
+}
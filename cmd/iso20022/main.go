@@ -5,19 +5,38 @@
 package main
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
 	"encoding/xml"
 	"errors"
 	"fmt"
+	"io"
 	"log"
+	"net/http"
 	"os"
 	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
 
 	baseLog "github.com/moov-io/base/log"
+	"github.com/moov-io/iso20022/pkg/deadletter"
 	"github.com/moov-io/iso20022/pkg/document"
+	"github.com/moov-io/iso20022/pkg/jws"
+	"github.com/moov-io/iso20022/pkg/loadtest"
+	"github.com/moov-io/iso20022/pkg/manifest"
+	"github.com/moov-io/iso20022/pkg/pipeline"
+	"github.com/moov-io/iso20022/pkg/profile"
+	"github.com/moov-io/iso20022/pkg/secrets"
 	"github.com/moov-io/iso20022/pkg/server"
+	"github.com/moov-io/iso20022/pkg/service"
+	"github.com/moov-io/iso20022/pkg/statement"
+	"github.com/moov-io/iso20022/pkg/storage"
+	"github.com/moov-io/iso20022/pkg/templates"
 	"github.com/moov-io/iso20022/pkg/utils"
 )
 
@@ -27,10 +46,15 @@ var (
 )
 
 var WebCmd = &cobra.Command{
-	Use:   "web",
-	Short: "Launches web server",
-	Long:  "Launches web server",
+	Use:     "web",
+	Aliases: []string{"server"},
+	Short:   "Launches web server",
+	Long:    "Launches web server",
 	RunE: func(cmd *cobra.Command, args []string) error {
+		if validate, _ := cmd.Flags().GetBool("validate-config"); validate {
+			return validateConfig(cmd)
+		}
+
 		env := &server.Environment{
 			Logger: baseLog.NewDefaultLogger(),
 		}
@@ -52,6 +76,40 @@ var WebCmd = &cobra.Command{
 	},
 }
 
+// validateConfig prints the diagnostics of the configuration as json, failing when it's invalid
+func validateConfig(cmd *cobra.Command) error {
+	var diagnostics server.Diagnostics
+	cfg, err := server.LoadConfig(baseLog.NewDefaultLogger())
+	if err != nil {
+		diagnostics.Checks = append(diagnostics.Checks, server.Diagnostic{
+			Check:   "config",
+			Status:  server.DiagnosticStatusError,
+			Message: err.Error(),
+		})
+	} else if err = secrets.NewResolver(cfg.Secrets).ResolveAll(context.Background(), cfg); err != nil {
+		// the configuration is validated with the values of its secret references, like at boot
+		diagnostics.Checks = append(diagnostics.Checks, server.Diagnostic{
+			Check:   "secrets",
+			Status:  server.DiagnosticStatusError,
+			Message: err.Error(),
+		})
+	} else {
+		diagnostics = server.ValidateConfig(cfg)
+		diagnostics.Checks = append([]server.Diagnostic{{Check: "secrets", Status: server.DiagnosticStatusOk}}, diagnostics.Checks...)
+	}
+
+	output, err := json.MarshalIndent(diagnostics, "", "\t")
+	if err != nil {
+		return err
+	}
+	fmt.Fprintln(cmd.OutOrStdout(), string(output))
+
+	if !diagnostics.Valid {
+		return errors.New("invalid configuration")
+	}
+	return nil
+}
+
 var Validate = &cobra.Command{
 	Use:   "validator",
 	Short: "Validate iso20022 message",
@@ -68,6 +126,9 @@ var Validate = &cobra.Command{
 			return err
 		}
 
+		if warning := document.SupportLevel(doc.NameSpace()).Warning(); warning != "" {
+			fmt.Fprintln(os.Stderr, "warning: "+warning)
+		}
 		fmt.Println("the iso20022 (" + doc.NameSpace() + ") message is valid")
 		return nil
 	},
@@ -123,7 +184,7 @@ var Convert = &cobra.Command{
 		}
 		return nil
 	},
-	RunE: func(cmd *cobra.Command, args []string) error {
+	RunE: withManifest(func(cmd *cobra.Command, args []string, recorder *manifest.Recorder) error {
 		var format utils.DocumentType
 		if ff, err := cmd.Flags().GetString("format"); err != nil {
 			return err
@@ -136,41 +197,523 @@ var Convert = &cobra.Command{
 			format = utils.DocumentTypeXml
 		}
 
+		input := recorder.RecordInput(documentFileName, documentBuffer)
 		doc, err := document.ParseIso20022Document(documentBuffer)
 		if err != nil {
+			recorder.Failed(input, pipeline.StageParse, err)
 			return err
 		}
 
 		var output []byte
-		switch format {
-		case utils.DocumentTypeJson:
-			output, err = json.MarshalIndent(doc, "", "\t")
-		case utils.DocumentTypeXml:
-			output, err = xml.MarshalIndent(doc, "", "\t")
-		case utils.DocumentTypeUnknown:
-			err = errors.New("invalid format")
+		if deterministic, _ := cmd.Flags().GetBool("deterministic"); deterministic {
+			var buf bytes.Buffer
+			err = service.EncodeDeterministic(&buf, doc, format)
+			output = buf.Bytes()
+		} else {
+			switch format {
+			case utils.DocumentTypeJson:
+				output, err = json.MarshalIndent(doc, "", "\t")
+			case utils.DocumentTypeXml:
+				output, err = xml.MarshalIndent(doc, "", "\t")
+			case utils.DocumentTypeUnknown:
+				err = errors.New("invalid format")
+			}
+		}
+		if err != nil {
+			recorder.Failed(input, pipeline.StageTransform, err)
+			return err
+		}
+
+		path := args[0]
+		if layout := outputLayout(cmd, path); layout.NameTemplate != "" || layout.ByMessageType {
+			// the output argument is the directory of the named file
+			layout.Format = format
+			path = layout.Path(pipeline.Item{Name: documentFileName, Input: output, Document: doc})
+			if err = os.MkdirAll(filepath.Dir(path), 0750); err != nil {
+				return err
+			}
+		}
+		if err = pipeline.WriteFile(path, output); err != nil {
+			return err
+		}
+		recorder.RecordOutput(path, output, input)
+		recorder.Succeeded(input)
+		return nil
+	}),
+}
+
+var Compare = &cobra.Command{
+	Use:   "compare [files...]",
+	Short: "Compare validation profiles",
+	Long:  "Validate iso20022 messages against a current and a candidate profile and report divergent outcomes",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		current, err := compareProfile(cmd, "current", "current-config")
+		if err != nil {
+			return err
+		}
+		candidate, err := compareProfile(cmd, "candidate", "candidate-config")
+		if err != nil {
+			return err
+		}
+
+		inputs := map[string][]byte{documentFileName: documentBuffer}
+		names := []string{documentFileName}
+		if len(args) > 0 {
+			inputs, names = make(map[string][]byte), args
+			for _, name := range args {
+				if inputs[name], err = os.ReadFile(name); err != nil {
+					return err
+				}
+			}
+		}
+
+		out := cmd.OutOrStdout()
+		summary := profile.NewComparisonSummary(current, candidate)
+		for _, name := range names {
+			doc, err := document.ParseIso20022Document(inputs[name])
+			if err != nil {
+				fmt.Fprintf(out, "%s: %v\n", name, err)
+				continue
+			}
+			comparison := profile.Compare(current, candidate, profile.Input{Document: doc, Now: time.Now()})
+			summary.Add(comparison)
+			for _, finding := range comparison.Added {
+				fmt.Fprintf(out, "%s: + %s\n", name, finding.Error())
+			}
+			for _, finding := range comparison.Removed {
+				fmt.Fprintf(out, "%s: - %s\n", name, finding.Error())
+			}
+		}
+
+		output, err := json.MarshalIndent(summary, "", "\t")
+		if err != nil {
+			return err
+		}
+		fmt.Fprintln(out, string(output))
+		return nil
+	},
+}
+
+var CorpusCheck = &cobra.Command{
+	Use:   "corpus-check <dir>",
+	Short: "Check the compatibility of a corpus of messages",
+	Long:  "Run every xml and json message of a directory through parse, validate, convert and re-validate and report the compatibility in json",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		report, err := service.CheckCorpus(args[0])
+		if err != nil {
+			return err
+		}
+
+		output, err := json.MarshalIndent(report, "", "\t")
+		if err != nil {
+			return err
+		}
+		fmt.Fprintln(cmd.OutOrStdout(), string(output))
+		if report.Incompatible > 0 {
+			return fmt.Errorf("%d of %d messages are incompatible", report.Incompatible, report.Samples)
+		}
+		return nil
+	},
+}
+
+var Redrive = &cobra.Command{
+	Use:   "redrive <dead-letter-dir>",
+	Short: "Reprocess dead-lettered messages",
+	Long:  "Reprocess the dead letters of a directory, writing the messages that now pass into the output directory",
+	Args:  cobra.ExactArgs(1),
+	RunE: withManifest(func(cmd *cobra.Command, args []string, recorder *manifest.Recorder) error {
+		output, _ := cmd.Flags().GetString("output")
+		if output == "" {
+			return errors.New("requires --output")
+		}
+		store, err := storage.NewFileStore(args[0])
+		if err != nil {
+			return err
+		}
+
+		queue := deadletter.NewQueue(store)
+		write := recorder.Sink(recorder.Output(outputLayout(cmd, output)))
+		stats, err := queue.Redrive(cmd.Context(), func(ctx context.Context, item pipeline.Item) error {
+			if item.Name == "" {
+				item.Name = item.Headers[deadletter.HeaderID]
+			}
+			return write(ctx, item)
+		})
+		if err != nil {
+			return err
+		}
+
+		buf, err := json.MarshalIndent(stats, "", "\t")
+		if err != nil {
+			return err
+		}
+		fmt.Fprintln(cmd.OutOrStdout(), string(buf))
+		if stats.Failed > 0 {
+			return fmt.Errorf("%d of %d messages are still failing", stats.Failed, stats.Read)
+		}
+		return nil
+	}),
+}
+
+var Split = &cobra.Command{
+	Use:   "split <output-dir>",
+	Short: "Split a statement into one document per account",
+	Long:  "Split a multi-account camt.053 statement into one statement document per account, written into the output directory",
+	Args:  cobra.ExactArgs(1),
+	RunE: withManifest(func(cmd *cobra.Command, args []string, recorder *manifest.Recorder) error {
+		input := recorder.RecordInput(documentFileName, documentBuffer)
+		doc, err := document.ParseIso20022Document(documentBuffer)
+		if err != nil {
+			recorder.Failed(input, pipeline.StageParse, err)
+			return err
 		}
+		statements, err := statement.SplitByAccount(doc)
 		if err != nil {
+			recorder.Failed(input, pipeline.StageTransform, err)
 			return err
 		}
 
-		wFile, err := os.Create(args[0])
+		layout := outputLayout(cmd, args[0])
+		if layout.NameTemplate == "" {
+			layout.NameTemplate = "{msgId}{ext}"
+		}
+		layout.Format = utils.GetDocumentFormat(documentBuffer)
+		for _, stmt := range statements {
+			path, content, err := layout.Write(pipeline.Item{Name: documentFileName, Document: stmt})
+			if err != nil {
+				return err
+			}
+			recorder.RecordOutput(path, content, input)
+			fmt.Fprintln(cmd.OutOrStdout(), path)
+		}
+		recorder.Succeeded(input)
+		return nil
+	}),
+}
+
+var Digest = &cobra.Command{
+	Use:   "digest <output-dir> <files...>",
+	Short: "Merge notifications into daily digests",
+	Long:  "Merge the entries of camt.054 notifications, e.g. intraday files, into one notification document per account and day written into the output directory",
+	Args:  cobra.MinimumNArgs(2),
+	RunE: withManifest(func(cmd *cobra.Command, args []string, recorder *manifest.Recorder) error {
+		var docs []document.Iso20022Document
+		var format utils.DocumentType
+		var inputs []int
+		for _, name := range args[1:] {
+			buf, err := os.ReadFile(name)
+			if err != nil {
+				return err
+			}
+			input := recorder.RecordInput(name, buf)
+			doc, err := document.ParseIso20022Document(buf)
+			if err != nil {
+				recorder.Failed(input, pipeline.StageParse, err)
+				return fmt.Errorf("%s: %w", name, err)
+			}
+			docs = append(docs, doc)
+			inputs = append(inputs, input)
+			if format == "" {
+				format = utils.GetDocumentFormat(buf)
+			}
+		}
+		zone, _ := cmd.Flags().GetString("time-zone")
+		cutoff, _ := cmd.Flags().GetString("cutoff")
+		calendar, err := statement.ParseCalendar(zone, cutoff)
 		if err != nil {
 			return err
 		}
-		_, err = wFile.Write(output)
-		wFile.Close()
+		digests, err := statement.Digest(docs, statement.WithCalendar(calendar))
+		if err != nil {
+			return err
+		}
+
+		layout := outputLayout(cmd, args[0])
+		if layout.NameTemplate == "" {
+			layout.NameTemplate = "{msgId}{ext}"
+		}
+		layout.Format = format
+		for _, digest := range digests {
+			// a digest merges the entries of any input
+			path, content, err := layout.Write(pipeline.Item{Document: digest})
+			if err != nil {
+				return err
+			}
+			recorder.RecordOutput(path, content, inputs...)
+			fmt.Fprintln(cmd.OutOrStdout(), path)
+		}
+		for _, input := range inputs {
+			recorder.Succeeded(input)
+		}
+		return nil
+	}),
+}
+
+// outputLayout returns the layout of the files written into dir by the naming flags of cmd
+func outputLayout(cmd *cobra.Command, dir string) pipeline.Layout {
+	layout := pipeline.Layout{Dir: dir}
+	layout.NameTemplate, _ = cmd.Flags().GetString("name-template")
+	layout.ByMessageType, _ = cmd.Flags().GetBool("by-message-type")
+	layout.Deterministic, _ = cmd.Flags().GetBool("deterministic")
+	return layout
+}
+
+// withManifest runs a batch command recording its manifest, written to the file of the
+// manifest flag and signed by the key of the signing-key flag, also when the run fails
+func withManifest(run func(cmd *cobra.Command, args []string, recorder *manifest.Recorder) error) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		path, _ := cmd.Flags().GetString("manifest")
+		keyFile, _ := cmd.Flags().GetString("signing-key")
+		if path == "" && keyFile != "" {
+			return errors.New("--signing-key requires --manifest")
+		}
+		var signer *jws.Signer
+		if keyFile != "" {
+			key, err := jws.LoadKey(keyFile)
+			if err != nil {
+				return fmt.Errorf("signing key: %w", err)
+			}
+			keyID, _ := cmd.Flags().GetString("signing-key-id")
+			if signer, err = jws.NewSigner(key, keyID); err != nil {
+				return fmt.Errorf("signing key: %w", err)
+			}
+		}
 
+		recorder := manifest.NewRecorder(cmd.Name())
+		err := run(cmd, args, recorder)
+		if path != "" {
+			if merr := manifest.Write(path, recorder.Finish(), signer); err == nil {
+				err = merr
+			}
+		}
 		return err
+	}
+}
+
+// compareProfile returns the profile of the name flag, or the one defined by the file of the config flag
+func compareProfile(cmd *cobra.Command, nameFlag, configFlag string) (*profile.Profile, error) {
+	if path, _ := cmd.Flags().GetString(configFlag); path != "" {
+		cfg, err := profile.LoadConfig(path)
+		if err != nil {
+			return nil, err
+		}
+		return profile.NewProfile(cfg)
+	}
+	name, _ := cmd.Flags().GetString(nameFlag)
+	if name == "" {
+		return nil, fmt.Errorf("requires --%s or --%s", nameFlag, configFlag)
+	}
+	return profile.Get(name)
+}
+
+var Instantiate = &cobra.Command{
+	Use:   "instantiate <output>",
+	Short: "Instantiate a message template",
+	Long:  "Write the message of the template of the input (yaml or json) with the values of --var name=value, failing unless the message is valid",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		tmpl, err := templates.Parse(documentBuffer)
+		if err != nil {
+			return fmt.Errorf("%s: %w", documentFileName, err)
+		}
+		values, err := flagValues(cmd, "var")
+		if err != nil {
+			return err
+		}
+
+		doc, output, err := tmpl.Instantiate(values)
+		if err != nil {
+			return fmt.Errorf("template %s: %w", tmpl.Name, err)
+		}
+		if ff, _ := cmd.Flags().GetString("format"); ff != "" {
+			format := utils.DocumentType(ff)
+			if format != utils.DocumentTypeJson && format != utils.DocumentTypeXml {
+				return errors.New("don't support the format")
+			}
+			var buf bytes.Buffer
+			if err = service.Encode(&buf, doc, format); err != nil {
+				return err
+			}
+			output = buf.Bytes()
+		}
+		return pipeline.WriteFile(args[0], output)
+	},
+}
+
+var LoadTest = &cobra.Command{
+	Use:   "loadtest <url>",
+	Short: "Load test a running server",
+	Long:  "Send synthetic traffic, a weighted mix of the messages and templates of --message path[=weight], to the endpoint of a running server and report the latency percentiles, throughput and error rate in json",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		paths, _ := cmd.Flags().GetStringArray("message")
+		if len(paths) == 0 {
+			return errors.New("requires --message")
+		}
+		values, err := flagValues(cmd, "var")
+		if err != nil {
+			return err
+		}
+		fields, err := flagValues(cmd, "field")
+		if err != nil {
+			return err
+		}
+
+		config := loadtest.Config{URL: args[0], Fields: fields}
+		config.Endpoint, _ = cmd.Flags().GetString("endpoint")
+		config.Transactions, _ = cmd.Flags().GetIntSlice("transactions")
+		config.Concurrency, _ = cmd.Flags().GetInt("concurrency")
+		config.Requests, _ = cmd.Flags().GetInt("requests")
+		config.Duration, _ = cmd.Flags().GetDuration("duration")
+		config.Timeout, _ = cmd.Flags().GetDuration("timeout")
+		if config.Requests > 0 && !cmd.Flags().Changed("duration") {
+			config.Duration = 0
+		}
+		for _, path := range paths {
+			msg := loadtest.Message{Name: path}
+			if i := strings.LastIndex(path, "="); i > 0 {
+				if weight, err := strconv.Atoi(path[i+1:]); err == nil {
+					msg.Name, msg.Weight = path[:i], weight
+				}
+			}
+			if ext := filepath.Ext(msg.Name); ext == ".yaml" || ext == ".yml" {
+				if msg.Template, err = templates.Load(msg.Name); err != nil {
+					return fmt.Errorf("%s: %w", msg.Name, err)
+				}
+				msg.Values = values
+			} else if msg.Content, err = os.ReadFile(msg.Name); err != nil {
+				return err
+			}
+			config.Messages = append(config.Messages, msg)
+		}
+
+		report, err := loadtest.Run(cmd.Context(), config)
+		if err != nil {
+			return err
+		}
+		output, err := json.MarshalIndent(report, "", "\t")
+		if err != nil {
+			return err
+		}
+		fmt.Fprintln(cmd.OutOrStdout(), string(output))
+		if maxErrorRate, _ := cmd.Flags().GetFloat64("max-error-rate"); report.ErrorRate > maxErrorRate {
+			return fmt.Errorf("error rate %.2f%% exceeds %.2f%%", report.ErrorRate, maxErrorRate)
+		}
+		return nil
+	},
+}
+
+var Snapshot = &cobra.Command{
+	Use:   "snapshot",
+	Short: "Export and import the state of a server",
+	Long:  "Export the state of a running server (configuration, profiles, templates, usage counters and scheduled jobs) as a portable snapshot and import it into a standby server, through their admin endpoints",
+}
+
+var SnapshotExport = &cobra.Command{
+	Use:   "export <file>",
+	Short: "Export the state of a server to a snapshot file",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		admin, _ := cmd.Flags().GetString("admin")
+		resp, err := http.Get(strings.TrimSuffix(admin, "/") + "/snapshot")
+		if err != nil {
+			return err
+		}
+		defer resp.Body.Close()
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return err
+		}
+		if resp.StatusCode != http.StatusOK {
+			return fmt.Errorf("exporting snapshot: %s: %s", resp.Status, bytes.TrimSpace(body))
+		}
+
+		// snapshots hold the configuration, they're only readable by their owner
+		if err := pipeline.WriteFile(args[0], body); err != nil {
+			return err
+		}
+		fmt.Fprintf(cmd.OutOrStdout(), "exported snapshot of %s to %s\n", admin, args[0])
+		return nil
+	},
+}
+
+var SnapshotImport = &cobra.Command{
+	Use:   "import <file>",
+	Short: "Import a snapshot file into a server",
+	Long:  "Import the profiles, templates, usage counters and history of scheduled jobs of a snapshot into a running server, --config writes the configuration of the snapshot as the yaml config file of a standby",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		buf, err := os.ReadFile(args[0])
+		if err != nil {
+			return err
+		}
+		if path, _ := cmd.Flags().GetString("config"); path != "" {
+			var snapshot server.Snapshot
+			if err := json.Unmarshal(buf, &snapshot); err != nil {
+				return fmt.Errorf("reading snapshot: %w", err)
+			}
+			config, err := yaml.Marshal(server.GlobalConfig{ISO20022: snapshot.Config})
+			if err != nil {
+				return err
+			}
+			if err := pipeline.WriteFile(path, config); err != nil {
+				return err
+			}
+		}
+		if skip, _ := cmd.Flags().GetBool("config-only"); skip {
+			return nil
+		}
+
+		admin, _ := cmd.Flags().GetString("admin")
+		resp, err := http.Post(strings.TrimSuffix(admin, "/")+"/snapshot", "application/json", bytes.NewReader(buf))
+		if err != nil {
+			return err
+		}
+		defer resp.Body.Close()
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return err
+		}
+		if resp.StatusCode != http.StatusOK {
+			return fmt.Errorf("importing snapshot: %s: %s", resp.Status, bytes.TrimSpace(body))
+		}
+		var status server.RestoreStatus
+		if err := json.Unmarshal(body, &status); err != nil {
+			return err
+		}
+		output, err := json.MarshalIndent(status, "", "\t")
+		if err != nil {
+			return err
+		}
+		fmt.Fprintln(cmd.OutOrStdout(), string(output))
+		return nil
 	},
 }
 
+// flagValues returns the name=value pairs of the string array flag name of cmd
+func flagValues(cmd *cobra.Command, name string) (map[string]string, error) {
+	pairs, err := cmd.Flags().GetStringArray(name)
+	if err != nil {
+		return nil, err
+	}
+	values := make(map[string]string, len(pairs))
+	for _, pair := range pairs {
+		key, value, found := strings.Cut(pair, "=")
+		if !found || key == "" {
+			return nil, fmt.Errorf("%s %q isn't name=value", name, pair)
+		}
+		values[key] = value
+	}
+	return values, nil
+}
+
 var rootCmd = &cobra.Command{
 	Use:   "",
 	Short: "",
 	Long:  "",
 	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
-		isWeb := false
+		isWeb, isSnapshot := false, false
 		cmdNames := make([]string, 0)
 		getName := func(c *cobra.Command) {}
 		getName = func(c *cobra.Command) {
@@ -181,11 +724,16 @@ var rootCmd = &cobra.Command{
 			if c.Name() == "web" {
 				isWeb = true
 			}
+			if c.Name() == "snapshot" {
+				isSnapshot = true
+			}
 			getName(c.Parent())
 		}
 		getName(cmd)
 
-		if !isWeb {
+		// compare reads the files of its arguments instead of the input, corpus-check and redrive the files of a directory,
+		// digest and loadtest the files of their arguments and flags, snapshots the files of their arguments
+		if !isWeb && !isSnapshot && !(cmd.Name() == "compare" && len(args) > 0) && cmd.Name() != "corpus-check" && cmd.Name() != "redrive" && cmd.Name() != "digest" && cmd.Name() != "loadtest" {
 			if documentFileName == "" {
 				path, err := os.Getwd()
 				if err != nil {
@@ -211,8 +759,41 @@ var rootCmd = &cobra.Command{
 
 func initRootCmd() {
 	WebCmd.Flags().BoolP("test", "t", false, "test server")
+	WebCmd.Flags().Bool("validate-config", false, "validate the configuration and connectivity of backends, then exit")
 	Convert.Flags().String("format", "xml", "format of document file")
 	Print.Flags().String("format", "xml", "print format")
+	Compare.Flags().String("current", "", "name of the current profile")
+	Compare.Flags().String("candidate", "", "name of the candidate profile")
+	Compare.Flags().String("current-config", "", "yaml definition of the current profile")
+	Compare.Flags().String("candidate-config", "", "yaml definition of the candidate profile")
+	Redrive.Flags().String("output", "", "directory of the reprocessed messages")
+	Digest.Flags().String("time-zone", "UTC", "time zone of business days, e.g. Europe/Zurich")
+	Digest.Flags().String("cutoff", "", "time (hh:mm) business days end at, midnight by default")
+	Instantiate.Flags().StringArray("var", nil, "value of a template variable as name=value, repeated for every variable")
+	Instantiate.Flags().String("format", "", "format of the message, the format of the template by default")
+	LoadTest.Flags().StringArray("message", nil, "message or template (yaml) of the mix with its weight as path[=weight], repeated for every message")
+	LoadTest.Flags().StringArray("var", nil, "value of a variable of the templates as name=value")
+	LoadTest.Flags().StringArray("field", nil, "form field of the requests as name=value, e.g. format=json for /convert")
+	LoadTest.Flags().String("endpoint", loadtest.DefaultEndpoint, "endpoint receiving the messages")
+	LoadTest.Flags().IntSlice("transactions", nil, "sizes of the xml messages in transactions, e.g. 1,10,100")
+	LoadTest.Flags().Int("concurrency", 1, "number of requests in flight")
+	LoadTest.Flags().Int("requests", 0, "number of requests, the test runs for --duration when zero")
+	LoadTest.Flags().Duration("duration", 30*time.Second, "duration of a test without --requests")
+	LoadTest.Flags().Duration("timeout", 30*time.Second, "timeout of every request")
+	LoadTest.Flags().Float64("max-error-rate", 100, "fail when the percentage of failed requests exceeds it")
+	for _, cmd := range []*cobra.Command{SnapshotExport, SnapshotImport} {
+		cmd.Flags().String("admin", "http://localhost:8209", "url of the admin server")
+	}
+	SnapshotImport.Flags().String("config", "", "yaml config file written with the configuration of the snapshot")
+	SnapshotImport.Flags().Bool("config-only", false, "only write the config file, e.g. before starting the standby")
+	for _, cmd := range []*cobra.Command{Convert, Redrive, Split, Digest} {
+		cmd.Flags().String("name-template", "", "name of written files, placeholders are {msgType}, {msgId}, {date}, {name} and {ext}")
+		cmd.Flags().Bool("by-message-type", false, "write files into a subdirectory per message type")
+		cmd.Flags().Bool("deterministic", false, "write documents in a deterministic form, identical messages are written to identical bytes")
+		cmd.Flags().String("manifest", "", "file of the provenance manifest of the run, its inputs, outputs and versions")
+		cmd.Flags().String("signing-key", "", "PEM private key signing the manifest, its detached JWS is written to the manifest file with .jws")
+		cmd.Flags().String("signing-key-id", "", "key id (kid) of manifest signatures")
+	}
 
 	rootCmd.SilenceUsage = true
 	rootCmd.PersistentFlags().StringVar(&documentFileName, "input", "", "iso20022 document (valid types are xml, json. default is $PWD/iso20022_document.xml)")
@@ -220,10 +801,21 @@ func initRootCmd() {
 	rootCmd.AddCommand(Convert)
 	rootCmd.AddCommand(Print)
 	rootCmd.AddCommand(Validate)
+	rootCmd.AddCommand(Compare)
+	rootCmd.AddCommand(CorpusCheck)
+	rootCmd.AddCommand(Redrive)
+	rootCmd.AddCommand(Split)
+	rootCmd.AddCommand(Digest)
+	rootCmd.AddCommand(Instantiate)
+	rootCmd.AddCommand(LoadTest)
+	Snapshot.AddCommand(SnapshotExport, SnapshotImport)
+	rootCmd.AddCommand(Snapshot)
 }
 
 func main() {
 	initRootCmd()
 
-	rootCmd.Execute()
+	if err := rootCmd.Execute(); err != nil {
+		os.Exit(1)
+	}
 }
@@ -1,6 +1,6 @@
 module github.com/moov-io/iso20022
 
-go 1.19
+go 1.20
 
 replace github.com/gogo/protobuf => github.com/gogo/protobuf v1.3.2
 
@@ -12,7 +12,9 @@ require (
 	github.com/moov-io/base v0.38.1
 	github.com/spf13/cobra v1.6.1
 	github.com/stretchr/testify v1.8.1
+	golang.org/x/net v0.5.0
 	golang.org/x/oauth2 v0.4.0
+	gopkg.in/yaml.v3 v3.0.1
 )
 
 require (
@@ -41,12 +43,10 @@ require (
 	github.com/spf13/pflag v1.0.5 // indirect
 	github.com/spf13/viper v1.14.0 // indirect
 	github.com/subosito/gotenv v1.4.1 // indirect
-	golang.org/x/net v0.5.0 // indirect
 	golang.org/x/sys v0.4.0 // indirect
 	golang.org/x/text v0.6.0 // indirect
 	google.golang.org/appengine v1.6.7 // indirect
 	google.golang.org/protobuf v1.28.1 // indirect
 	gopkg.in/ini.v1 v1.67.0 // indirect
 	gopkg.in/yaml.v2 v2.4.0 // indirect
-	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
@@ -0,0 +1,130 @@
+// Code generated by deepcopy-gen. DO NOT EDIT.
+
+package acmt_v01
+
+import (
+	"github.com/moov-io/iso20022/pkg/common"
+)
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *AccountSwitchDetails1) DeepCopy() *AccountSwitchDetails1 {
+	if r == nil {
+		return nil
+	}
+	out := new(AccountSwitchDetails1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *AccountSwitchDetails1) DeepCopyInto(out *AccountSwitchDetails1) {
+	*out = *r
+	if r.SwtchRcvdDtTm != nil {
+		out.SwtchRcvdDtTm = new(common.ISODateTime)
+		*out.SwtchRcvdDtTm = *r.SwtchRcvdDtTm
+	}
+	if r.SwtchSts != nil {
+		out.SwtchSts = new(SwitchStatus1Code)
+		*out.SwtchSts = *r.SwtchSts
+	}
+	if r.BalTrfWndw != nil {
+		out.BalTrfWndw = new(BalanceTransferWindow1Code)
+		*out.BalTrfWndw = *r.BalTrfWndw
+	}
+	if r.Rspn != nil {
+		out.Rspn = make([]ResponseDetails1, len(r.Rspn))
+		for i := range r.Rspn {
+			r.Rspn[i].DeepCopyInto(&out.Rspn[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *AccountSwitchTerminationSwitchV01) DeepCopy() *AccountSwitchTerminationSwitchV01 {
+	if r == nil {
+		return nil
+	}
+	out := new(AccountSwitchTerminationSwitchV01)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *AccountSwitchTerminationSwitchV01) DeepCopyInto(out *AccountSwitchTerminationSwitchV01) {
+	*out = *r
+	r.AcctSwtchDtls.DeepCopyInto(&out.AcctSwtchDtls)
+	if r.SplmtryData != nil {
+		out.SplmtryData = make([]SupplementaryData1, len(r.SplmtryData))
+		for i := range r.SplmtryData {
+			r.SplmtryData[i].DeepCopyInto(&out.SplmtryData[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *MessageIdentification1) DeepCopy() *MessageIdentification1 {
+	if r == nil {
+		return nil
+	}
+	out := new(MessageIdentification1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *MessageIdentification1) DeepCopyInto(out *MessageIdentification1) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ResponseDetails1) DeepCopy() *ResponseDetails1 {
+	if r == nil {
+		return nil
+	}
+	out := new(ResponseDetails1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ResponseDetails1) DeepCopyInto(out *ResponseDetails1) {
+	*out = *r
+	if r.AddtlDtls != nil {
+		out.AddtlDtls = new(common.Max350Text)
+		*out.AddtlDtls = *r.AddtlDtls
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *SupplementaryData1) DeepCopy() *SupplementaryData1 {
+	if r == nil {
+		return nil
+	}
+	out := new(SupplementaryData1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *SupplementaryData1) DeepCopyInto(out *SupplementaryData1) {
+	*out = *r
+	if r.PlcAndNm != nil {
+		out.PlcAndNm = new(common.Max350Text)
+		*out.PlcAndNm = *r.PlcAndNm
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *SupplementaryDataEnvelope1) DeepCopy() *SupplementaryDataEnvelope1 {
+	if r == nil {
+		return nil
+	}
+	out := new(SupplementaryDataEnvelope1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *SupplementaryDataEnvelope1) DeepCopyInto(out *SupplementaryDataEnvelope1) {
+	*out = *r
+}
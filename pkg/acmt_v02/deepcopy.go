@@ -0,0 +1,1537 @@
+// Code generated by deepcopy-gen. DO NOT EDIT.
+
+package acmt_v02
+
+import (
+	"github.com/moov-io/iso20022/pkg/common"
+)
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *AccountIdentification4Choice) DeepCopy() *AccountIdentification4Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(AccountIdentification4Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *AccountIdentification4Choice) DeepCopyInto(out *AccountIdentification4Choice) {
+	*out = *r
+	r.Othr.DeepCopyInto(&out.Othr)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *AccountSchemeName1Choice) DeepCopy() *AccountSchemeName1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(AccountSchemeName1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *AccountSchemeName1Choice) DeepCopyInto(out *AccountSchemeName1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *AccountSwitchDetails1) DeepCopy() *AccountSwitchDetails1 {
+	if r == nil {
+		return nil
+	}
+	out := new(AccountSwitchDetails1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *AccountSwitchDetails1) DeepCopyInto(out *AccountSwitchDetails1) {
+	*out = *r
+	if r.SwtchRcvdDtTm != nil {
+		out.SwtchRcvdDtTm = new(common.ISODateTime)
+		*out.SwtchRcvdDtTm = *r.SwtchRcvdDtTm
+	}
+	if r.SwtchDt != nil {
+		out.SwtchDt = new(common.ISODate)
+		*out.SwtchDt = *r.SwtchDt
+	}
+	if r.SwtchSts != nil {
+		out.SwtchSts = new(SwitchStatus1Code)
+		*out.SwtchSts = *r.SwtchSts
+	}
+	if r.BalTrfWndw != nil {
+		out.BalTrfWndw = new(BalanceTransferWindow1Code)
+		*out.BalTrfWndw = *r.BalTrfWndw
+	}
+	if r.Rspn != nil {
+		out.Rspn = make([]ResponseDetails1, len(r.Rspn))
+		for i := range r.Rspn {
+			r.Rspn[i].DeepCopyInto(&out.Rspn[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *AccountSwitchNotifyAccountSwitchCompleteV02) DeepCopy() *AccountSwitchNotifyAccountSwitchCompleteV02 {
+	if r == nil {
+		return nil
+	}
+	out := new(AccountSwitchNotifyAccountSwitchCompleteV02)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *AccountSwitchNotifyAccountSwitchCompleteV02) DeepCopyInto(out *AccountSwitchNotifyAccountSwitchCompleteV02) {
+	*out = *r
+	r.AcctSwtchDtls.DeepCopyInto(&out.AcctSwtchDtls)
+	if r.SplmtryData != nil {
+		out.SplmtryData = make([]SupplementaryData1, len(r.SplmtryData))
+		for i := range r.SplmtryData {
+			r.SplmtryData[i].DeepCopyInto(&out.SplmtryData[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *AccountSwitchPaymentResponseV02) DeepCopy() *AccountSwitchPaymentResponseV02 {
+	if r == nil {
+		return nil
+	}
+	out := new(AccountSwitchPaymentResponseV02)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *AccountSwitchPaymentResponseV02) DeepCopyInto(out *AccountSwitchPaymentResponseV02) {
+	*out = *r
+	r.AcctSwtchDtls.DeepCopyInto(&out.AcctSwtchDtls)
+	if r.SplmtryData != nil {
+		out.SplmtryData = make([]SupplementaryData1, len(r.SplmtryData))
+		for i := range r.SplmtryData {
+			r.SplmtryData[i].DeepCopyInto(&out.SplmtryData[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *AccountSwitchRequestRedirectionV02) DeepCopy() *AccountSwitchRequestRedirectionV02 {
+	if r == nil {
+		return nil
+	}
+	out := new(AccountSwitchRequestRedirectionV02)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *AccountSwitchRequestRedirectionV02) DeepCopyInto(out *AccountSwitchRequestRedirectionV02) {
+	*out = *r
+	r.AcctSwtchDtls.DeepCopyInto(&out.AcctSwtchDtls)
+	r.NewAcct.DeepCopyInto(&out.NewAcct)
+	r.OdAcct.DeepCopyInto(&out.OdAcct)
+	if r.SplmtryData != nil {
+		out.SplmtryData = make([]SupplementaryData1, len(r.SplmtryData))
+		for i := range r.SplmtryData {
+			r.SplmtryData[i].DeepCopyInto(&out.SplmtryData[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *AccountSwitchTechnicalRejectionV02) DeepCopy() *AccountSwitchTechnicalRejectionV02 {
+	if r == nil {
+		return nil
+	}
+	out := new(AccountSwitchTechnicalRejectionV02)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *AccountSwitchTechnicalRejectionV02) DeepCopyInto(out *AccountSwitchTechnicalRejectionV02) {
+	*out = *r
+	r.AcctSwtchDtls.DeepCopyInto(&out.AcctSwtchDtls)
+	if r.SplmtryData != nil {
+		out.SplmtryData = make([]SupplementaryData1, len(r.SplmtryData))
+		for i := range r.SplmtryData {
+			r.SplmtryData[i].DeepCopyInto(&out.SplmtryData[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *AddressType3Choice) DeepCopy() *AddressType3Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(AddressType3Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *AddressType3Choice) DeepCopyInto(out *AddressType3Choice) {
+	*out = *r
+	r.Prtry.DeepCopyInto(&out.Prtry)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *BranchAndFinancialInstitutionIdentification5) DeepCopy() *BranchAndFinancialInstitutionIdentification5 {
+	if r == nil {
+		return nil
+	}
+	out := new(BranchAndFinancialInstitutionIdentification5)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *BranchAndFinancialInstitutionIdentification5) DeepCopyInto(out *BranchAndFinancialInstitutionIdentification5) {
+	*out = *r
+	r.FinInstnId.DeepCopyInto(&out.FinInstnId)
+	if r.BrnchId != nil {
+		out.BrnchId = new(BranchData2)
+		r.BrnchId.DeepCopyInto(out.BrnchId)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *BranchAndFinancialInstitutionIdentification6) DeepCopy() *BranchAndFinancialInstitutionIdentification6 {
+	if r == nil {
+		return nil
+	}
+	out := new(BranchAndFinancialInstitutionIdentification6)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *BranchAndFinancialInstitutionIdentification6) DeepCopyInto(out *BranchAndFinancialInstitutionIdentification6) {
+	*out = *r
+	r.FinInstnId.DeepCopyInto(&out.FinInstnId)
+	if r.BrnchId != nil {
+		out.BrnchId = new(BranchData3)
+		r.BrnchId.DeepCopyInto(out.BrnchId)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *BranchData2) DeepCopy() *BranchData2 {
+	if r == nil {
+		return nil
+	}
+	out := new(BranchData2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *BranchData2) DeepCopyInto(out *BranchData2) {
+	*out = *r
+	if r.Id != nil {
+		out.Id = new(common.Max35Text)
+		*out.Id = *r.Id
+	}
+	if r.Nm != nil {
+		out.Nm = new(common.Max140Text)
+		*out.Nm = *r.Nm
+	}
+	if r.PstlAdr != nil {
+		out.PstlAdr = new(PostalAddress6)
+		r.PstlAdr.DeepCopyInto(out.PstlAdr)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *BranchData3) DeepCopy() *BranchData3 {
+	if r == nil {
+		return nil
+	}
+	out := new(BranchData3)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *BranchData3) DeepCopyInto(out *BranchData3) {
+	*out = *r
+	if r.Id != nil {
+		out.Id = new(common.Max35Text)
+		*out.Id = *r.Id
+	}
+	if r.LEI != nil {
+		out.LEI = new(common.LEIIdentifier)
+		*out.LEI = *r.LEI
+	}
+	if r.Nm != nil {
+		out.Nm = new(common.Max140Text)
+		*out.Nm = *r.Nm
+	}
+	if r.PstlAdr != nil {
+		out.PstlAdr = new(PostalAddress24)
+		r.PstlAdr.DeepCopyInto(out.PstlAdr)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CashAccount39) DeepCopy() *CashAccount39 {
+	if r == nil {
+		return nil
+	}
+	out := new(CashAccount39)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CashAccount39) DeepCopyInto(out *CashAccount39) {
+	*out = *r
+	r.Id.DeepCopyInto(&out.Id)
+	if r.Tp != nil {
+		out.Tp = new(CashAccountType2Choice)
+		*out.Tp = *r.Tp
+	}
+	if r.Ccy != nil {
+		out.Ccy = new(common.ActiveOrHistoricCurrencyCode)
+		*out.Ccy = *r.Ccy
+	}
+	if r.Nm != nil {
+		out.Nm = new(common.Max70Text)
+		*out.Nm = *r.Nm
+	}
+	if r.Prxy != nil {
+		out.Prxy = new(ProxyAccountIdentification1)
+		r.Prxy.DeepCopyInto(out.Prxy)
+	}
+	if r.Ownr != nil {
+		out.Ownr = new(PartyIdentification135)
+		r.Ownr.DeepCopyInto(out.Ownr)
+	}
+	if r.Svcr != nil {
+		out.Svcr = new(BranchAndFinancialInstitutionIdentification6)
+		r.Svcr.DeepCopyInto(out.Svcr)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CashAccountType2Choice) DeepCopy() *CashAccountType2Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(CashAccountType2Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CashAccountType2Choice) DeepCopyInto(out *CashAccountType2Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ClearingSystemIdentification2Choice) DeepCopy() *ClearingSystemIdentification2Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(ClearingSystemIdentification2Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ClearingSystemIdentification2Choice) DeepCopyInto(out *ClearingSystemIdentification2Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ClearingSystemMemberIdentification2) DeepCopy() *ClearingSystemMemberIdentification2 {
+	if r == nil {
+		return nil
+	}
+	out := new(ClearingSystemMemberIdentification2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ClearingSystemMemberIdentification2) DeepCopyInto(out *ClearingSystemMemberIdentification2) {
+	*out = *r
+	if r.ClrSysId != nil {
+		out.ClrSysId = new(ClearingSystemIdentification2Choice)
+		*out.ClrSysId = *r.ClrSysId
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *Contact4) DeepCopy() *Contact4 {
+	if r == nil {
+		return nil
+	}
+	out := new(Contact4)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *Contact4) DeepCopyInto(out *Contact4) {
+	*out = *r
+	if r.NmPrfx != nil {
+		out.NmPrfx = new(common.NamePrefix2Code)
+		*out.NmPrfx = *r.NmPrfx
+	}
+	if r.Nm != nil {
+		out.Nm = new(common.Max140Text)
+		*out.Nm = *r.Nm
+	}
+	if r.PhneNb != nil {
+		out.PhneNb = new(common.PhoneNumber)
+		*out.PhneNb = *r.PhneNb
+	}
+	if r.MobNb != nil {
+		out.MobNb = new(common.PhoneNumber)
+		*out.MobNb = *r.MobNb
+	}
+	if r.FaxNb != nil {
+		out.FaxNb = new(common.PhoneNumber)
+		*out.FaxNb = *r.FaxNb
+	}
+	if r.EmailAdr != nil {
+		out.EmailAdr = new(common.Max2048Text)
+		*out.EmailAdr = *r.EmailAdr
+	}
+	if r.EmailPurp != nil {
+		out.EmailPurp = new(common.Max35Text)
+		*out.EmailPurp = *r.EmailPurp
+	}
+	if r.JobTitl != nil {
+		out.JobTitl = new(common.Max35Text)
+		*out.JobTitl = *r.JobTitl
+	}
+	if r.Rspnsblty != nil {
+		out.Rspnsblty = new(common.Max35Text)
+		*out.Rspnsblty = *r.Rspnsblty
+	}
+	if r.Dept != nil {
+		out.Dept = new(common.Max70Text)
+		*out.Dept = *r.Dept
+	}
+	if r.Othr != nil {
+		out.Othr = make([]OtherContact1, len(r.Othr))
+		for i := range r.Othr {
+			r.Othr[i].DeepCopyInto(&out.Othr[i])
+		}
+	}
+	if r.PrefrdMtd != nil {
+		out.PrefrdMtd = new(PreferredContactMethod1Code)
+		*out.PrefrdMtd = *r.PrefrdMtd
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ContactDetails2) DeepCopy() *ContactDetails2 {
+	if r == nil {
+		return nil
+	}
+	out := new(ContactDetails2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ContactDetails2) DeepCopyInto(out *ContactDetails2) {
+	*out = *r
+	if r.NmPrfx != nil {
+		out.NmPrfx = new(common.NamePrefix1Code)
+		*out.NmPrfx = *r.NmPrfx
+	}
+	if r.Nm != nil {
+		out.Nm = new(common.Max140Text)
+		*out.Nm = *r.Nm
+	}
+	if r.PhneNb != nil {
+		out.PhneNb = new(common.PhoneNumber)
+		*out.PhneNb = *r.PhneNb
+	}
+	if r.MobNb != nil {
+		out.MobNb = new(common.PhoneNumber)
+		*out.MobNb = *r.MobNb
+	}
+	if r.FaxNb != nil {
+		out.FaxNb = new(common.PhoneNumber)
+		*out.FaxNb = *r.FaxNb
+	}
+	if r.EmailAdr != nil {
+		out.EmailAdr = new(common.Max2048Text)
+		*out.EmailAdr = *r.EmailAdr
+	}
+	if r.Othr != nil {
+		out.Othr = new(common.Max35Text)
+		*out.Othr = *r.Othr
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *DateAndPlaceOfBirth) DeepCopy() *DateAndPlaceOfBirth {
+	if r == nil {
+		return nil
+	}
+	out := new(DateAndPlaceOfBirth)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *DateAndPlaceOfBirth) DeepCopyInto(out *DateAndPlaceOfBirth) {
+	*out = *r
+	if r.PrvcOfBirth != nil {
+		out.PrvcOfBirth = new(common.Max35Text)
+		*out.PrvcOfBirth = *r.PrvcOfBirth
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *DateAndPlaceOfBirth1) DeepCopy() *DateAndPlaceOfBirth1 {
+	if r == nil {
+		return nil
+	}
+	out := new(DateAndPlaceOfBirth1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *DateAndPlaceOfBirth1) DeepCopyInto(out *DateAndPlaceOfBirth1) {
+	*out = *r
+	if r.PrvcOfBirth != nil {
+		out.PrvcOfBirth = new(common.Max35Text)
+		*out.PrvcOfBirth = *r.PrvcOfBirth
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *FinancialIdentificationSchemeName1Choice) DeepCopy() *FinancialIdentificationSchemeName1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(FinancialIdentificationSchemeName1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *FinancialIdentificationSchemeName1Choice) DeepCopyInto(out *FinancialIdentificationSchemeName1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *FinancialInstitutionIdentification18) DeepCopy() *FinancialInstitutionIdentification18 {
+	if r == nil {
+		return nil
+	}
+	out := new(FinancialInstitutionIdentification18)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *FinancialInstitutionIdentification18) DeepCopyInto(out *FinancialInstitutionIdentification18) {
+	*out = *r
+	if r.BICFI != nil {
+		out.BICFI = new(common.BICFIDec2014Identifier)
+		*out.BICFI = *r.BICFI
+	}
+	if r.ClrSysMmbId != nil {
+		out.ClrSysMmbId = new(ClearingSystemMemberIdentification2)
+		r.ClrSysMmbId.DeepCopyInto(out.ClrSysMmbId)
+	}
+	if r.LEI != nil {
+		out.LEI = new(common.LEIIdentifier)
+		*out.LEI = *r.LEI
+	}
+	if r.Nm != nil {
+		out.Nm = new(common.Max140Text)
+		*out.Nm = *r.Nm
+	}
+	if r.PstlAdr != nil {
+		out.PstlAdr = new(PostalAddress24)
+		r.PstlAdr.DeepCopyInto(out.PstlAdr)
+	}
+	if r.Othr != nil {
+		out.Othr = new(GenericFinancialIdentification1)
+		r.Othr.DeepCopyInto(out.Othr)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *FinancialInstitutionIdentification8) DeepCopy() *FinancialInstitutionIdentification8 {
+	if r == nil {
+		return nil
+	}
+	out := new(FinancialInstitutionIdentification8)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *FinancialInstitutionIdentification8) DeepCopyInto(out *FinancialInstitutionIdentification8) {
+	*out = *r
+	if r.BICFI != nil {
+		out.BICFI = new(common.BICFIIdentifier)
+		*out.BICFI = *r.BICFI
+	}
+	if r.ClrSysMmbId != nil {
+		out.ClrSysMmbId = new(ClearingSystemMemberIdentification2)
+		r.ClrSysMmbId.DeepCopyInto(out.ClrSysMmbId)
+	}
+	if r.Nm != nil {
+		out.Nm = new(common.Max140Text)
+		*out.Nm = *r.Nm
+	}
+	if r.PstlAdr != nil {
+		out.PstlAdr = new(PostalAddress6)
+		r.PstlAdr.DeepCopyInto(out.PstlAdr)
+	}
+	if r.Othr != nil {
+		out.Othr = new(GenericFinancialIdentification1)
+		r.Othr.DeepCopyInto(out.Othr)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *GenericAccountIdentification1) DeepCopy() *GenericAccountIdentification1 {
+	if r == nil {
+		return nil
+	}
+	out := new(GenericAccountIdentification1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *GenericAccountIdentification1) DeepCopyInto(out *GenericAccountIdentification1) {
+	*out = *r
+	if r.SchmeNm != nil {
+		out.SchmeNm = new(AccountSchemeName1Choice)
+		*out.SchmeNm = *r.SchmeNm
+	}
+	if r.Issr != nil {
+		out.Issr = new(common.Max35Text)
+		*out.Issr = *r.Issr
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *GenericFinancialIdentification1) DeepCopy() *GenericFinancialIdentification1 {
+	if r == nil {
+		return nil
+	}
+	out := new(GenericFinancialIdentification1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *GenericFinancialIdentification1) DeepCopyInto(out *GenericFinancialIdentification1) {
+	*out = *r
+	if r.SchmeNm != nil {
+		out.SchmeNm = new(FinancialIdentificationSchemeName1Choice)
+		*out.SchmeNm = *r.SchmeNm
+	}
+	if r.Issr != nil {
+		out.Issr = new(common.Max35Text)
+		*out.Issr = *r.Issr
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *GenericIdentification30) DeepCopy() *GenericIdentification30 {
+	if r == nil {
+		return nil
+	}
+	out := new(GenericIdentification30)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *GenericIdentification30) DeepCopyInto(out *GenericIdentification30) {
+	*out = *r
+	if r.SchmeNm != nil {
+		out.SchmeNm = new(common.Max35Text)
+		*out.SchmeNm = *r.SchmeNm
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *GenericOrganisationIdentification1) DeepCopy() *GenericOrganisationIdentification1 {
+	if r == nil {
+		return nil
+	}
+	out := new(GenericOrganisationIdentification1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *GenericOrganisationIdentification1) DeepCopyInto(out *GenericOrganisationIdentification1) {
+	*out = *r
+	if r.SchmeNm != nil {
+		out.SchmeNm = new(OrganisationIdentificationSchemeName1Choice)
+		*out.SchmeNm = *r.SchmeNm
+	}
+	if r.Issr != nil {
+		out.Issr = new(common.Max35Text)
+		*out.Issr = *r.Issr
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *GenericPersonIdentification1) DeepCopy() *GenericPersonIdentification1 {
+	if r == nil {
+		return nil
+	}
+	out := new(GenericPersonIdentification1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *GenericPersonIdentification1) DeepCopyInto(out *GenericPersonIdentification1) {
+	*out = *r
+	if r.SchmeNm != nil {
+		out.SchmeNm = new(PersonIdentificationSchemeName1Choice)
+		*out.SchmeNm = *r.SchmeNm
+	}
+	if r.Issr != nil {
+		out.Issr = new(common.Max35Text)
+		*out.Issr = *r.Issr
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *IdentificationAssignment2) DeepCopy() *IdentificationAssignment2 {
+	if r == nil {
+		return nil
+	}
+	out := new(IdentificationAssignment2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *IdentificationAssignment2) DeepCopyInto(out *IdentificationAssignment2) {
+	*out = *r
+	if r.Cretr != nil {
+		out.Cretr = new(Party12Choice)
+		r.Cretr.DeepCopyInto(out.Cretr)
+	}
+	if r.FrstAgt != nil {
+		out.FrstAgt = new(BranchAndFinancialInstitutionIdentification5)
+		r.FrstAgt.DeepCopyInto(out.FrstAgt)
+	}
+	r.Assgnr.DeepCopyInto(&out.Assgnr)
+	r.Assgne.DeepCopyInto(&out.Assgne)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *IdentificationInformation2) DeepCopy() *IdentificationInformation2 {
+	if r == nil {
+		return nil
+	}
+	out := new(IdentificationInformation2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *IdentificationInformation2) DeepCopyInto(out *IdentificationInformation2) {
+	*out = *r
+	if r.Pty != nil {
+		out.Pty = new(PartyIdentification43)
+		r.Pty.DeepCopyInto(out.Pty)
+	}
+	if r.Acct != nil {
+		out.Acct = new(AccountIdentification4Choice)
+		r.Acct.DeepCopyInto(out.Acct)
+	}
+	if r.Agt != nil {
+		out.Agt = new(BranchAndFinancialInstitutionIdentification5)
+		r.Agt.DeepCopyInto(out.Agt)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *IdentificationModification2) DeepCopy() *IdentificationModification2 {
+	if r == nil {
+		return nil
+	}
+	out := new(IdentificationModification2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *IdentificationModification2) DeepCopyInto(out *IdentificationModification2) {
+	*out = *r
+	if r.OrgnlPtyAndAcctId != nil {
+		out.OrgnlPtyAndAcctId = new(IdentificationInformation2)
+		r.OrgnlPtyAndAcctId.DeepCopyInto(out.OrgnlPtyAndAcctId)
+	}
+	r.UpdtdPtyAndAcctId.DeepCopyInto(&out.UpdtdPtyAndAcctId)
+	if r.AddtlInf != nil {
+		out.AddtlInf = new(common.Max140Text)
+		*out.AddtlInf = *r.AddtlInf
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *IdentificationModificationAdviceV02) DeepCopy() *IdentificationModificationAdviceV02 {
+	if r == nil {
+		return nil
+	}
+	out := new(IdentificationModificationAdviceV02)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *IdentificationModificationAdviceV02) DeepCopyInto(out *IdentificationModificationAdviceV02) {
+	*out = *r
+	r.Assgnmt.DeepCopyInto(&out.Assgnmt)
+	if r.OrgnlTxRef != nil {
+		out.OrgnlTxRef = new(OriginalTransactionReference18)
+		r.OrgnlTxRef.DeepCopyInto(out.OrgnlTxRef)
+	}
+	if r.Mod != nil {
+		out.Mod = make([]IdentificationModification2, len(r.Mod))
+		for i := range r.Mod {
+			r.Mod[i].DeepCopyInto(&out.Mod[i])
+		}
+	}
+	if r.SplmtryData != nil {
+		out.SplmtryData = make([]SupplementaryData1, len(r.SplmtryData))
+		for i := range r.SplmtryData {
+			r.SplmtryData[i].DeepCopyInto(&out.SplmtryData[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *IdentificationVerification2) DeepCopy() *IdentificationVerification2 {
+	if r == nil {
+		return nil
+	}
+	out := new(IdentificationVerification2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *IdentificationVerification2) DeepCopyInto(out *IdentificationVerification2) {
+	*out = *r
+	r.PtyAndAcctId.DeepCopyInto(&out.PtyAndAcctId)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *IdentificationVerificationReportV02) DeepCopy() *IdentificationVerificationReportV02 {
+	if r == nil {
+		return nil
+	}
+	out := new(IdentificationVerificationReportV02)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *IdentificationVerificationReportV02) DeepCopyInto(out *IdentificationVerificationReportV02) {
+	*out = *r
+	r.Assgnmt.DeepCopyInto(&out.Assgnmt)
+	if r.OrgnlAssgnmt != nil {
+		out.OrgnlAssgnmt = new(MessageIdentification5)
+		r.OrgnlAssgnmt.DeepCopyInto(out.OrgnlAssgnmt)
+	}
+	if r.Rpt != nil {
+		out.Rpt = make([]VerificationReport2, len(r.Rpt))
+		for i := range r.Rpt {
+			r.Rpt[i].DeepCopyInto(&out.Rpt[i])
+		}
+	}
+	if r.SplmtryData != nil {
+		out.SplmtryData = make([]SupplementaryData1, len(r.SplmtryData))
+		for i := range r.SplmtryData {
+			r.SplmtryData[i].DeepCopyInto(&out.SplmtryData[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *IdentificationVerificationRequestV02) DeepCopy() *IdentificationVerificationRequestV02 {
+	if r == nil {
+		return nil
+	}
+	out := new(IdentificationVerificationRequestV02)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *IdentificationVerificationRequestV02) DeepCopyInto(out *IdentificationVerificationRequestV02) {
+	*out = *r
+	r.Assgnmt.DeepCopyInto(&out.Assgnmt)
+	if r.Vrfctn != nil {
+		out.Vrfctn = make([]IdentificationVerification2, len(r.Vrfctn))
+		for i := range r.Vrfctn {
+			r.Vrfctn[i].DeepCopyInto(&out.Vrfctn[i])
+		}
+	}
+	if r.SplmtryData != nil {
+		out.SplmtryData = make([]SupplementaryData1, len(r.SplmtryData))
+		for i := range r.SplmtryData {
+			r.SplmtryData[i].DeepCopyInto(&out.SplmtryData[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *MessageIdentification1) DeepCopy() *MessageIdentification1 {
+	if r == nil {
+		return nil
+	}
+	out := new(MessageIdentification1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *MessageIdentification1) DeepCopyInto(out *MessageIdentification1) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *MessageIdentification5) DeepCopy() *MessageIdentification5 {
+	if r == nil {
+		return nil
+	}
+	out := new(MessageIdentification5)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *MessageIdentification5) DeepCopyInto(out *MessageIdentification5) {
+	*out = *r
+	if r.MsgId != nil {
+		out.MsgId = new(common.Max35Text)
+		*out.MsgId = *r.MsgId
+	}
+	if r.CreDtTm != nil {
+		out.CreDtTm = new(common.ISODateTime)
+		*out.CreDtTm = *r.CreDtTm
+	}
+	if r.FrstAgt != nil {
+		out.FrstAgt = new(BranchAndFinancialInstitutionIdentification5)
+		r.FrstAgt.DeepCopyInto(out.FrstAgt)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *OrganisationIdentification29) DeepCopy() *OrganisationIdentification29 {
+	if r == nil {
+		return nil
+	}
+	out := new(OrganisationIdentification29)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *OrganisationIdentification29) DeepCopyInto(out *OrganisationIdentification29) {
+	*out = *r
+	if r.AnyBIC != nil {
+		out.AnyBIC = new(common.AnyBICDec2014Identifier)
+		*out.AnyBIC = *r.AnyBIC
+	}
+	if r.LEI != nil {
+		out.LEI = new(common.LEIIdentifier)
+		*out.LEI = *r.LEI
+	}
+	if r.Othr != nil {
+		out.Othr = make([]GenericOrganisationIdentification1, len(r.Othr))
+		for i := range r.Othr {
+			r.Othr[i].DeepCopyInto(&out.Othr[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *OrganisationIdentification8) DeepCopy() *OrganisationIdentification8 {
+	if r == nil {
+		return nil
+	}
+	out := new(OrganisationIdentification8)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *OrganisationIdentification8) DeepCopyInto(out *OrganisationIdentification8) {
+	*out = *r
+	if r.AnyBIC != nil {
+		out.AnyBIC = new(common.AnyBICIdentifier)
+		*out.AnyBIC = *r.AnyBIC
+	}
+	if r.Othr != nil {
+		out.Othr = make([]GenericOrganisationIdentification1, len(r.Othr))
+		for i := range r.Othr {
+			r.Othr[i].DeepCopyInto(&out.Othr[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *OrganisationIdentificationSchemeName1Choice) DeepCopy() *OrganisationIdentificationSchemeName1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(OrganisationIdentificationSchemeName1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *OrganisationIdentificationSchemeName1Choice) DeepCopyInto(out *OrganisationIdentificationSchemeName1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *OriginalTransactionReference18) DeepCopy() *OriginalTransactionReference18 {
+	if r == nil {
+		return nil
+	}
+	out := new(OriginalTransactionReference18)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *OriginalTransactionReference18) DeepCopyInto(out *OriginalTransactionReference18) {
+	*out = *r
+	if r.MsgId != nil {
+		out.MsgId = new(common.Max35Text)
+		*out.MsgId = *r.MsgId
+	}
+	if r.MsgNmId != nil {
+		out.MsgNmId = new(common.Max35Text)
+		*out.MsgNmId = *r.MsgNmId
+	}
+	if r.CreDtTm != nil {
+		out.CreDtTm = new(common.ISODateTime)
+		*out.CreDtTm = *r.CreDtTm
+	}
+	if r.OrgnlTx != nil {
+		out.OrgnlTx = make([]PaymentIdentification4, len(r.OrgnlTx))
+		for i := range r.OrgnlTx {
+			r.OrgnlTx[i].DeepCopyInto(&out.OrgnlTx[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *OtherContact1) DeepCopy() *OtherContact1 {
+	if r == nil {
+		return nil
+	}
+	out := new(OtherContact1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *OtherContact1) DeepCopyInto(out *OtherContact1) {
+	*out = *r
+	if r.Id != nil {
+		out.Id = new(common.Max128Text)
+		*out.Id = *r.Id
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *Party11Choice) DeepCopy() *Party11Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(Party11Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *Party11Choice) DeepCopyInto(out *Party11Choice) {
+	*out = *r
+	r.OrgId.DeepCopyInto(&out.OrgId)
+	r.PrvtId.DeepCopyInto(&out.PrvtId)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *Party12Choice) DeepCopy() *Party12Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(Party12Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *Party12Choice) DeepCopyInto(out *Party12Choice) {
+	*out = *r
+	r.Pty.DeepCopyInto(&out.Pty)
+	r.Agt.DeepCopyInto(&out.Agt)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *Party38Choice) DeepCopy() *Party38Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(Party38Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *Party38Choice) DeepCopyInto(out *Party38Choice) {
+	*out = *r
+	r.OrgId.DeepCopyInto(&out.OrgId)
+	r.PrvtId.DeepCopyInto(&out.PrvtId)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *PartyIdentification135) DeepCopy() *PartyIdentification135 {
+	if r == nil {
+		return nil
+	}
+	out := new(PartyIdentification135)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *PartyIdentification135) DeepCopyInto(out *PartyIdentification135) {
+	*out = *r
+	if r.Nm != nil {
+		out.Nm = new(common.Max140Text)
+		*out.Nm = *r.Nm
+	}
+	if r.PstlAdr != nil {
+		out.PstlAdr = new(PostalAddress24)
+		r.PstlAdr.DeepCopyInto(out.PstlAdr)
+	}
+	if r.Id != nil {
+		out.Id = new(Party38Choice)
+		r.Id.DeepCopyInto(out.Id)
+	}
+	if r.CtryOfRes != nil {
+		out.CtryOfRes = new(common.CountryCode)
+		*out.CtryOfRes = *r.CtryOfRes
+	}
+	if r.CtctDtls != nil {
+		out.CtctDtls = new(Contact4)
+		r.CtctDtls.DeepCopyInto(out.CtctDtls)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *PartyIdentification43) DeepCopy() *PartyIdentification43 {
+	if r == nil {
+		return nil
+	}
+	out := new(PartyIdentification43)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *PartyIdentification43) DeepCopyInto(out *PartyIdentification43) {
+	*out = *r
+	if r.Nm != nil {
+		out.Nm = new(common.Max140Text)
+		*out.Nm = *r.Nm
+	}
+	if r.PstlAdr != nil {
+		out.PstlAdr = new(PostalAddress6)
+		r.PstlAdr.DeepCopyInto(out.PstlAdr)
+	}
+	if r.Id != nil {
+		out.Id = new(Party11Choice)
+		r.Id.DeepCopyInto(out.Id)
+	}
+	if r.CtryOfRes != nil {
+		out.CtryOfRes = new(common.CountryCode)
+		*out.CtryOfRes = *r.CtryOfRes
+	}
+	if r.CtctDtls != nil {
+		out.CtctDtls = new(ContactDetails2)
+		r.CtctDtls.DeepCopyInto(out.CtctDtls)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *PaymentIdentification4) DeepCopy() *PaymentIdentification4 {
+	if r == nil {
+		return nil
+	}
+	out := new(PaymentIdentification4)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *PaymentIdentification4) DeepCopyInto(out *PaymentIdentification4) {
+	*out = *r
+	if r.InstrId != nil {
+		out.InstrId = new(common.Max35Text)
+		*out.InstrId = *r.InstrId
+	}
+	if r.ClrSysRef != nil {
+		out.ClrSysRef = new(common.Max35Text)
+		*out.ClrSysRef = *r.ClrSysRef
+	}
+	if r.FrstAgt != nil {
+		out.FrstAgt = new(BranchAndFinancialInstitutionIdentification5)
+		r.FrstAgt.DeepCopyInto(out.FrstAgt)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *PersonIdentification13) DeepCopy() *PersonIdentification13 {
+	if r == nil {
+		return nil
+	}
+	out := new(PersonIdentification13)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *PersonIdentification13) DeepCopyInto(out *PersonIdentification13) {
+	*out = *r
+	if r.DtAndPlcOfBirth != nil {
+		out.DtAndPlcOfBirth = new(DateAndPlaceOfBirth1)
+		r.DtAndPlcOfBirth.DeepCopyInto(out.DtAndPlcOfBirth)
+	}
+	if r.Othr != nil {
+		out.Othr = make([]GenericPersonIdentification1, len(r.Othr))
+		for i := range r.Othr {
+			r.Othr[i].DeepCopyInto(&out.Othr[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *PersonIdentification5) DeepCopy() *PersonIdentification5 {
+	if r == nil {
+		return nil
+	}
+	out := new(PersonIdentification5)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *PersonIdentification5) DeepCopyInto(out *PersonIdentification5) {
+	*out = *r
+	if r.DtAndPlcOfBirth != nil {
+		out.DtAndPlcOfBirth = new(DateAndPlaceOfBirth)
+		r.DtAndPlcOfBirth.DeepCopyInto(out.DtAndPlcOfBirth)
+	}
+	if r.Othr != nil {
+		out.Othr = make([]GenericPersonIdentification1, len(r.Othr))
+		for i := range r.Othr {
+			r.Othr[i].DeepCopyInto(&out.Othr[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *PersonIdentificationSchemeName1Choice) DeepCopy() *PersonIdentificationSchemeName1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(PersonIdentificationSchemeName1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *PersonIdentificationSchemeName1Choice) DeepCopyInto(out *PersonIdentificationSchemeName1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *PostalAddress24) DeepCopy() *PostalAddress24 {
+	if r == nil {
+		return nil
+	}
+	out := new(PostalAddress24)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *PostalAddress24) DeepCopyInto(out *PostalAddress24) {
+	*out = *r
+	if r.AdrTp != nil {
+		out.AdrTp = new(AddressType3Choice)
+		r.AdrTp.DeepCopyInto(out.AdrTp)
+	}
+	if r.Dept != nil {
+		out.Dept = new(common.Max70Text)
+		*out.Dept = *r.Dept
+	}
+	if r.SubDept != nil {
+		out.SubDept = new(common.Max70Text)
+		*out.SubDept = *r.SubDept
+	}
+	if r.StrtNm != nil {
+		out.StrtNm = new(common.Max70Text)
+		*out.StrtNm = *r.StrtNm
+	}
+	if r.BldgNb != nil {
+		out.BldgNb = new(common.Max16Text)
+		*out.BldgNb = *r.BldgNb
+	}
+	if r.BldgNm != nil {
+		out.BldgNm = new(common.Max35Text)
+		*out.BldgNm = *r.BldgNm
+	}
+	if r.Flr != nil {
+		out.Flr = new(common.Max70Text)
+		*out.Flr = *r.Flr
+	}
+	if r.PstBx != nil {
+		out.PstBx = new(common.Max16Text)
+		*out.PstBx = *r.PstBx
+	}
+	if r.Room != nil {
+		out.Room = new(common.Max70Text)
+		*out.Room = *r.Room
+	}
+	if r.PstCd != nil {
+		out.PstCd = new(common.Max16Text)
+		*out.PstCd = *r.PstCd
+	}
+	if r.TwnNm != nil {
+		out.TwnNm = new(common.Max35Text)
+		*out.TwnNm = *r.TwnNm
+	}
+	if r.TwnLctnNm != nil {
+		out.TwnLctnNm = new(common.Max35Text)
+		*out.TwnLctnNm = *r.TwnLctnNm
+	}
+	if r.DstrctNm != nil {
+		out.DstrctNm = new(common.Max35Text)
+		*out.DstrctNm = *r.DstrctNm
+	}
+	if r.CtrySubDvsn != nil {
+		out.CtrySubDvsn = new(common.Max35Text)
+		*out.CtrySubDvsn = *r.CtrySubDvsn
+	}
+	if r.Ctry != nil {
+		out.Ctry = new(common.CountryCode)
+		*out.Ctry = *r.Ctry
+	}
+	if r.AdrLine != nil {
+		out.AdrLine = make([]common.Max70Text, len(r.AdrLine))
+		copy(out.AdrLine, r.AdrLine)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *PostalAddress6) DeepCopy() *PostalAddress6 {
+	if r == nil {
+		return nil
+	}
+	out := new(PostalAddress6)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *PostalAddress6) DeepCopyInto(out *PostalAddress6) {
+	*out = *r
+	if r.AdrTp != nil {
+		out.AdrTp = new(common.AddressType2Code)
+		*out.AdrTp = *r.AdrTp
+	}
+	if r.Dept != nil {
+		out.Dept = new(common.Max70Text)
+		*out.Dept = *r.Dept
+	}
+	if r.SubDept != nil {
+		out.SubDept = new(common.Max70Text)
+		*out.SubDept = *r.SubDept
+	}
+	if r.StrtNm != nil {
+		out.StrtNm = new(common.Max70Text)
+		*out.StrtNm = *r.StrtNm
+	}
+	if r.BldgNb != nil {
+		out.BldgNb = new(common.Max16Text)
+		*out.BldgNb = *r.BldgNb
+	}
+	if r.PstCd != nil {
+		out.PstCd = new(common.Max16Text)
+		*out.PstCd = *r.PstCd
+	}
+	if r.TwnNm != nil {
+		out.TwnNm = new(common.Max35Text)
+		*out.TwnNm = *r.TwnNm
+	}
+	if r.CtrySubDvsn != nil {
+		out.CtrySubDvsn = new(common.Max35Text)
+		*out.CtrySubDvsn = *r.CtrySubDvsn
+	}
+	if r.Ctry != nil {
+		out.Ctry = new(common.CountryCode)
+		*out.Ctry = *r.Ctry
+	}
+	if r.AdrLine != nil {
+		out.AdrLine = make([]common.Max70Text, len(r.AdrLine))
+		copy(out.AdrLine, r.AdrLine)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ProxyAccountIdentification1) DeepCopy() *ProxyAccountIdentification1 {
+	if r == nil {
+		return nil
+	}
+	out := new(ProxyAccountIdentification1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ProxyAccountIdentification1) DeepCopyInto(out *ProxyAccountIdentification1) {
+	*out = *r
+	if r.Tp != nil {
+		out.Tp = new(ProxyAccountType1Choice)
+		*out.Tp = *r.Tp
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ProxyAccountType1Choice) DeepCopy() *ProxyAccountType1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(ProxyAccountType1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ProxyAccountType1Choice) DeepCopyInto(out *ProxyAccountType1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ResponseDetails1) DeepCopy() *ResponseDetails1 {
+	if r == nil {
+		return nil
+	}
+	out := new(ResponseDetails1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ResponseDetails1) DeepCopyInto(out *ResponseDetails1) {
+	*out = *r
+	if r.AddtlDtls != nil {
+		out.AddtlDtls = new(common.Max350Text)
+		*out.AddtlDtls = *r.AddtlDtls
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *SupplementaryData1) DeepCopy() *SupplementaryData1 {
+	if r == nil {
+		return nil
+	}
+	out := new(SupplementaryData1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *SupplementaryData1) DeepCopyInto(out *SupplementaryData1) {
+	*out = *r
+	if r.PlcAndNm != nil {
+		out.PlcAndNm = new(common.Max350Text)
+		*out.PlcAndNm = *r.PlcAndNm
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *SupplementaryDataEnvelope1) DeepCopy() *SupplementaryDataEnvelope1 {
+	if r == nil {
+		return nil
+	}
+	out := new(SupplementaryDataEnvelope1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *SupplementaryDataEnvelope1) DeepCopyInto(out *SupplementaryDataEnvelope1) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *VerificationReason1Choice) DeepCopy() *VerificationReason1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(VerificationReason1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *VerificationReason1Choice) DeepCopyInto(out *VerificationReason1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *VerificationReport2) DeepCopy() *VerificationReport2 {
+	if r == nil {
+		return nil
+	}
+	out := new(VerificationReport2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *VerificationReport2) DeepCopyInto(out *VerificationReport2) {
+	*out = *r
+	if r.Rsn != nil {
+		out.Rsn = new(VerificationReason1Choice)
+		*out.Rsn = *r.Rsn
+	}
+	if r.OrgnlPtyAndAcctId != nil {
+		out.OrgnlPtyAndAcctId = new(IdentificationInformation2)
+		r.OrgnlPtyAndAcctId.DeepCopyInto(out.OrgnlPtyAndAcctId)
+	}
+	if r.UpdtdPtyAndAcctId != nil {
+		out.UpdtdPtyAndAcctId = new(IdentificationInformation2)
+		r.UpdtdPtyAndAcctId.DeepCopyInto(out.UpdtdPtyAndAcctId)
+	}
+}
@@ -0,0 +1,5198 @@
+// Code generated by deepcopy-gen. DO NOT EDIT.
+
+package acmt_v03
+
+import (
+	"github.com/moov-io/iso20022/pkg/common"
+)
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *AccountAdditionalInformationRequestV03) DeepCopy() *AccountAdditionalInformationRequestV03 {
+	if r == nil {
+		return nil
+	}
+	out := new(AccountAdditionalInformationRequestV03)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *AccountAdditionalInformationRequestV03) DeepCopyInto(out *AccountAdditionalInformationRequestV03) {
+	*out = *r
+	r.Refs.DeepCopyInto(&out.Refs)
+	if r.Fr != nil {
+		out.Fr = new(OrganisationIdentification29)
+		r.Fr.DeepCopyInto(out.Fr)
+	}
+	r.OrgId.DeepCopyInto(&out.OrgId)
+	r.AcctSvcrId.DeepCopyInto(&out.AcctSvcrId)
+	if r.AcctId != nil {
+		out.AcctId = make([]AccountForAction1, len(r.AcctId))
+		for i := range r.AcctId {
+			r.AcctId[i].DeepCopyInto(&out.AcctId[i])
+		}
+	}
+	if r.DgtlSgntr != nil {
+		out.DgtlSgntr = make([]PartyAndSignature3, len(r.DgtlSgntr))
+		for i := range r.DgtlSgntr {
+			r.DgtlSgntr[i].DeepCopyInto(&out.DgtlSgntr[i])
+		}
+	}
+	if r.SplmtryData != nil {
+		out.SplmtryData = make([]SupplementaryData1, len(r.SplmtryData))
+		for i := range r.SplmtryData {
+			r.SplmtryData[i].DeepCopyInto(&out.SplmtryData[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *AccountClosingAdditionalInformationRequestV03) DeepCopy() *AccountClosingAdditionalInformationRequestV03 {
+	if r == nil {
+		return nil
+	}
+	out := new(AccountClosingAdditionalInformationRequestV03)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *AccountClosingAdditionalInformationRequestV03) DeepCopyInto(out *AccountClosingAdditionalInformationRequestV03) {
+	*out = *r
+	r.Refs.DeepCopyInto(&out.Refs)
+	if r.Fr != nil {
+		out.Fr = new(OrganisationIdentification29)
+		r.Fr.DeepCopyInto(out.Fr)
+	}
+	r.OrgId.DeepCopyInto(&out.OrgId)
+	r.AcctId.DeepCopyInto(&out.AcctId)
+	r.AcctSvcrId.DeepCopyInto(&out.AcctSvcrId)
+	if r.BalTrfAcct != nil {
+		out.BalTrfAcct = new(AccountForAction1)
+		r.BalTrfAcct.DeepCopyInto(out.BalTrfAcct)
+	}
+	if r.TrfAcctSvcrId != nil {
+		out.TrfAcctSvcrId = new(BranchAndFinancialInstitutionIdentification6)
+		r.TrfAcctSvcrId.DeepCopyInto(out.TrfAcctSvcrId)
+	}
+	if r.DgtlSgntr != nil {
+		out.DgtlSgntr = make([]PartyAndSignature3, len(r.DgtlSgntr))
+		for i := range r.DgtlSgntr {
+			r.DgtlSgntr[i].DeepCopyInto(&out.DgtlSgntr[i])
+		}
+	}
+	if r.SplmtryData != nil {
+		out.SplmtryData = make([]SupplementaryData1, len(r.SplmtryData))
+		for i := range r.SplmtryData {
+			r.SplmtryData[i].DeepCopyInto(&out.SplmtryData[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *AccountClosingAmendmentRequestV03) DeepCopy() *AccountClosingAmendmentRequestV03 {
+	if r == nil {
+		return nil
+	}
+	out := new(AccountClosingAmendmentRequestV03)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *AccountClosingAmendmentRequestV03) DeepCopyInto(out *AccountClosingAmendmentRequestV03) {
+	*out = *r
+	r.Refs.DeepCopyInto(&out.Refs)
+	if r.Fr != nil {
+		out.Fr = new(OrganisationIdentification29)
+		r.Fr.DeepCopyInto(out.Fr)
+	}
+	r.AcctId.DeepCopyInto(&out.AcctId)
+	r.AcctSvcrId.DeepCopyInto(&out.AcctSvcrId)
+	r.OrgId.DeepCopyInto(&out.OrgId)
+	if r.CtrctDts != nil {
+		out.CtrctDts = new(AccountContract4)
+		r.CtrctDts.DeepCopyInto(out.CtrctDts)
+	}
+	if r.BalTrfAcct != nil {
+		out.BalTrfAcct = new(AccountForAction1)
+		r.BalTrfAcct.DeepCopyInto(out.BalTrfAcct)
+	}
+	if r.TrfAcctSvcrId != nil {
+		out.TrfAcctSvcrId = new(BranchAndFinancialInstitutionIdentification6)
+		r.TrfAcctSvcrId.DeepCopyInto(out.TrfAcctSvcrId)
+	}
+	if r.DgtlSgntr != nil {
+		out.DgtlSgntr = make([]PartyAndSignature3, len(r.DgtlSgntr))
+		for i := range r.DgtlSgntr {
+			r.DgtlSgntr[i].DeepCopyInto(&out.DgtlSgntr[i])
+		}
+	}
+	if r.SplmtryData != nil {
+		out.SplmtryData = make([]SupplementaryData1, len(r.SplmtryData))
+		for i := range r.SplmtryData {
+			r.SplmtryData[i].DeepCopyInto(&out.SplmtryData[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *AccountClosingRequestV03) DeepCopy() *AccountClosingRequestV03 {
+	if r == nil {
+		return nil
+	}
+	out := new(AccountClosingRequestV03)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *AccountClosingRequestV03) DeepCopyInto(out *AccountClosingRequestV03) {
+	*out = *r
+	r.Refs.DeepCopyInto(&out.Refs)
+	if r.Fr != nil {
+		out.Fr = new(OrganisationIdentification29)
+		r.Fr.DeepCopyInto(out.Fr)
+	}
+	r.AcctId.DeepCopyInto(&out.AcctId)
+	r.AcctSvcrId.DeepCopyInto(&out.AcctSvcrId)
+	r.OrgId.DeepCopyInto(&out.OrgId)
+	if r.CtrctDts != nil {
+		out.CtrctDts = new(AccountContract4)
+		r.CtrctDts.DeepCopyInto(out.CtrctDts)
+	}
+	if r.BalTrfAcct != nil {
+		out.BalTrfAcct = new(AccountForAction1)
+		r.BalTrfAcct.DeepCopyInto(out.BalTrfAcct)
+	}
+	if r.TrfAcctSvcrId != nil {
+		out.TrfAcctSvcrId = new(BranchAndFinancialInstitutionIdentification6)
+		r.TrfAcctSvcrId.DeepCopyInto(out.TrfAcctSvcrId)
+	}
+	if r.DgtlSgntr != nil {
+		out.DgtlSgntr = make([]PartyAndSignature3, len(r.DgtlSgntr))
+		for i := range r.DgtlSgntr {
+			r.DgtlSgntr[i].DeepCopyInto(&out.DgtlSgntr[i])
+		}
+	}
+	if r.SplmtryData != nil {
+		out.SplmtryData = make([]SupplementaryData1, len(r.SplmtryData))
+		for i := range r.SplmtryData {
+			r.SplmtryData[i].DeepCopyInto(&out.SplmtryData[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *AccountContract2) DeepCopy() *AccountContract2 {
+	if r == nil {
+		return nil
+	}
+	out := new(AccountContract2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *AccountContract2) DeepCopyInto(out *AccountContract2) {
+	*out = *r
+	if r.TrgtGoLiveDt != nil {
+		out.TrgtGoLiveDt = new(common.ISODate)
+		*out.TrgtGoLiveDt = *r.TrgtGoLiveDt
+	}
+	if r.TrgtClsgDt != nil {
+		out.TrgtClsgDt = new(common.ISODate)
+		*out.TrgtClsgDt = *r.TrgtClsgDt
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *AccountContract3) DeepCopy() *AccountContract3 {
+	if r == nil {
+		return nil
+	}
+	out := new(AccountContract3)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *AccountContract3) DeepCopyInto(out *AccountContract3) {
+	*out = *r
+	if r.TrgtGoLiveDt != nil {
+		out.TrgtGoLiveDt = new(common.ISODate)
+		*out.TrgtGoLiveDt = *r.TrgtGoLiveDt
+	}
+	if r.TrgtClsgDt != nil {
+		out.TrgtClsgDt = new(common.ISODate)
+		*out.TrgtClsgDt = *r.TrgtClsgDt
+	}
+	if r.GoLiveDt != nil {
+		out.GoLiveDt = new(common.ISODate)
+		*out.GoLiveDt = *r.GoLiveDt
+	}
+	if r.ClsgDt != nil {
+		out.ClsgDt = new(common.ISODate)
+		*out.ClsgDt = *r.ClsgDt
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *AccountContract4) DeepCopy() *AccountContract4 {
+	if r == nil {
+		return nil
+	}
+	out := new(AccountContract4)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *AccountContract4) DeepCopyInto(out *AccountContract4) {
+	*out = *r
+	if r.TrgtClsgDt != nil {
+		out.TrgtClsgDt = new(common.ISODate)
+		*out.TrgtClsgDt = *r.TrgtClsgDt
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *AccountExcludedMandateMaintenanceAmendmentRequestV03) DeepCopy() *AccountExcludedMandateMaintenanceAmendmentRequestV03 {
+	if r == nil {
+		return nil
+	}
+	out := new(AccountExcludedMandateMaintenanceAmendmentRequestV03)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *AccountExcludedMandateMaintenanceAmendmentRequestV03) DeepCopyInto(out *AccountExcludedMandateMaintenanceAmendmentRequestV03) {
+	*out = *r
+	r.Refs.DeepCopyInto(&out.Refs)
+	if r.Fr != nil {
+		out.Fr = new(OrganisationIdentification29)
+		r.Fr.DeepCopyInto(out.Fr)
+	}
+	if r.CtrctDts != nil {
+		out.CtrctDts = new(AccountContract2)
+		r.CtrctDts.DeepCopyInto(out.CtrctDts)
+	}
+	if r.UndrlygMstrAgrmt != nil {
+		out.UndrlygMstrAgrmt = new(ContractDocument1)
+		r.UndrlygMstrAgrmt.DeepCopyInto(out.UndrlygMstrAgrmt)
+	}
+	r.Acct.DeepCopyInto(&out.Acct)
+	r.AcctSvcrId.DeepCopyInto(&out.AcctSvcrId)
+	r.Org.DeepCopyInto(&out.Org)
+	if r.DgtlSgntr != nil {
+		out.DgtlSgntr = make([]PartyAndSignature3, len(r.DgtlSgntr))
+		for i := range r.DgtlSgntr {
+			r.DgtlSgntr[i].DeepCopyInto(&out.DgtlSgntr[i])
+		}
+	}
+	if r.SplmtryData != nil {
+		out.SplmtryData = make([]SupplementaryData1, len(r.SplmtryData))
+		for i := range r.SplmtryData {
+			r.SplmtryData[i].DeepCopyInto(&out.SplmtryData[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *AccountExcludedMandateMaintenanceRequestV03) DeepCopy() *AccountExcludedMandateMaintenanceRequestV03 {
+	if r == nil {
+		return nil
+	}
+	out := new(AccountExcludedMandateMaintenanceRequestV03)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *AccountExcludedMandateMaintenanceRequestV03) DeepCopyInto(out *AccountExcludedMandateMaintenanceRequestV03) {
+	*out = *r
+	r.Refs.DeepCopyInto(&out.Refs)
+	if r.Fr != nil {
+		out.Fr = new(OrganisationIdentification29)
+		r.Fr.DeepCopyInto(out.Fr)
+	}
+	if r.CtrctDts != nil {
+		out.CtrctDts = new(AccountContract2)
+		r.CtrctDts.DeepCopyInto(out.CtrctDts)
+	}
+	if r.UndrlygMstrAgrmt != nil {
+		out.UndrlygMstrAgrmt = new(ContractDocument1)
+		r.UndrlygMstrAgrmt.DeepCopyInto(out.UndrlygMstrAgrmt)
+	}
+	r.Acct.DeepCopyInto(&out.Acct)
+	r.AcctSvcrId.DeepCopyInto(&out.AcctSvcrId)
+	r.Org.DeepCopyInto(&out.Org)
+	if r.AddtlMsgInf != nil {
+		out.AddtlMsgInf = new(AdditionalInformation5)
+		r.AddtlMsgInf.DeepCopyInto(out.AddtlMsgInf)
+	}
+	if r.DgtlSgntr != nil {
+		out.DgtlSgntr = make([]PartyAndSignature3, len(r.DgtlSgntr))
+		for i := range r.DgtlSgntr {
+			r.DgtlSgntr[i].DeepCopyInto(&out.DgtlSgntr[i])
+		}
+	}
+	if r.SplmtryData != nil {
+		out.SplmtryData = make([]SupplementaryData1, len(r.SplmtryData))
+		for i := range r.SplmtryData {
+			r.SplmtryData[i].DeepCopyInto(&out.SplmtryData[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *AccountForAction1) DeepCopy() *AccountForAction1 {
+	if r == nil {
+		return nil
+	}
+	out := new(AccountForAction1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *AccountForAction1) DeepCopyInto(out *AccountForAction1) {
+	*out = *r
+	r.Id.DeepCopyInto(&out.Id)
+	if r.Ccy != nil {
+		out.Ccy = new(common.ActiveCurrencyCode)
+		*out.Ccy = *r.Ccy
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *AccountForAction2) DeepCopy() *AccountForAction2 {
+	if r == nil {
+		return nil
+	}
+	out := new(AccountForAction2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *AccountForAction2) DeepCopyInto(out *AccountForAction2) {
+	*out = *r
+	r.Id.DeepCopyInto(&out.Id)
+	if r.Nm != nil {
+		out.Nm = new(common.Max70Text)
+		*out.Nm = *r.Nm
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *AccountIdentification4Choice) DeepCopy() *AccountIdentification4Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(AccountIdentification4Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *AccountIdentification4Choice) DeepCopyInto(out *AccountIdentification4Choice) {
+	*out = *r
+	r.Othr.DeepCopyInto(&out.Othr)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *AccountMandateMaintenanceAmendmentRequestV03) DeepCopy() *AccountMandateMaintenanceAmendmentRequestV03 {
+	if r == nil {
+		return nil
+	}
+	out := new(AccountMandateMaintenanceAmendmentRequestV03)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *AccountMandateMaintenanceAmendmentRequestV03) DeepCopyInto(out *AccountMandateMaintenanceAmendmentRequestV03) {
+	*out = *r
+	r.Refs.DeepCopyInto(&out.Refs)
+	if r.Fr != nil {
+		out.Fr = new(OrganisationIdentification29)
+		r.Fr.DeepCopyInto(out.Fr)
+	}
+	if r.CtrctDts != nil {
+		out.CtrctDts = new(AccountContract2)
+		r.CtrctDts.DeepCopyInto(out.CtrctDts)
+	}
+	if r.UndrlygMstrAgrmt != nil {
+		out.UndrlygMstrAgrmt = new(ContractDocument1)
+		r.UndrlygMstrAgrmt.DeepCopyInto(out.UndrlygMstrAgrmt)
+	}
+	if r.AcctId != nil {
+		out.AcctId = make([]AccountForAction1, len(r.AcctId))
+		for i := range r.AcctId {
+			r.AcctId[i].DeepCopyInto(&out.AcctId[i])
+		}
+	}
+	r.AcctSvcrId.DeepCopyInto(&out.AcctSvcrId)
+	r.OrgId.DeepCopyInto(&out.OrgId)
+	if r.Mndt != nil {
+		out.Mndt = make([]OperationMandate5, len(r.Mndt))
+		for i := range r.Mndt {
+			r.Mndt[i].DeepCopyInto(&out.Mndt[i])
+		}
+	}
+	if r.Grp != nil {
+		out.Grp = make([]Group3, len(r.Grp))
+		for i := range r.Grp {
+			r.Grp[i].DeepCopyInto(&out.Grp[i])
+		}
+	}
+	if r.DgtlSgntr != nil {
+		out.DgtlSgntr = make([]PartyAndSignature3, len(r.DgtlSgntr))
+		for i := range r.DgtlSgntr {
+			r.DgtlSgntr[i].DeepCopyInto(&out.DgtlSgntr[i])
+		}
+	}
+	if r.SplmtryData != nil {
+		out.SplmtryData = make([]SupplementaryData1, len(r.SplmtryData))
+		for i := range r.SplmtryData {
+			r.SplmtryData[i].DeepCopyInto(&out.SplmtryData[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *AccountMandateMaintenanceRequestV03) DeepCopy() *AccountMandateMaintenanceRequestV03 {
+	if r == nil {
+		return nil
+	}
+	out := new(AccountMandateMaintenanceRequestV03)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *AccountMandateMaintenanceRequestV03) DeepCopyInto(out *AccountMandateMaintenanceRequestV03) {
+	*out = *r
+	r.Refs.DeepCopyInto(&out.Refs)
+	if r.Fr != nil {
+		out.Fr = new(OrganisationIdentification29)
+		r.Fr.DeepCopyInto(out.Fr)
+	}
+	if r.CtrctDts != nil {
+		out.CtrctDts = new(AccountContract2)
+		r.CtrctDts.DeepCopyInto(out.CtrctDts)
+	}
+	if r.UndrlygMstrAgrmt != nil {
+		out.UndrlygMstrAgrmt = new(ContractDocument1)
+		r.UndrlygMstrAgrmt.DeepCopyInto(out.UndrlygMstrAgrmt)
+	}
+	if r.AcctId != nil {
+		out.AcctId = make([]AccountForAction1, len(r.AcctId))
+		for i := range r.AcctId {
+			r.AcctId[i].DeepCopyInto(&out.AcctId[i])
+		}
+	}
+	r.AcctSvcrId.DeepCopyInto(&out.AcctSvcrId)
+	r.OrgId.DeepCopyInto(&out.OrgId)
+	if r.Mndt != nil {
+		out.Mndt = make([]OperationMandate5, len(r.Mndt))
+		for i := range r.Mndt {
+			r.Mndt[i].DeepCopyInto(&out.Mndt[i])
+		}
+	}
+	if r.Grp != nil {
+		out.Grp = make([]Group3, len(r.Grp))
+		for i := range r.Grp {
+			r.Grp[i].DeepCopyInto(&out.Grp[i])
+		}
+	}
+	if r.AddtlMsgInf != nil {
+		out.AddtlMsgInf = new(AdditionalInformation5)
+		r.AddtlMsgInf.DeepCopyInto(out.AddtlMsgInf)
+	}
+	if r.DgtlSgntr != nil {
+		out.DgtlSgntr = make([]PartyAndSignature3, len(r.DgtlSgntr))
+		for i := range r.DgtlSgntr {
+			r.DgtlSgntr[i].DeepCopyInto(&out.DgtlSgntr[i])
+		}
+	}
+	if r.SplmtryData != nil {
+		out.SplmtryData = make([]SupplementaryData1, len(r.SplmtryData))
+		for i := range r.SplmtryData {
+			r.SplmtryData[i].DeepCopyInto(&out.SplmtryData[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *AccountOpeningAdditionalInformationRequestV03) DeepCopy() *AccountOpeningAdditionalInformationRequestV03 {
+	if r == nil {
+		return nil
+	}
+	out := new(AccountOpeningAdditionalInformationRequestV03)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *AccountOpeningAdditionalInformationRequestV03) DeepCopyInto(out *AccountOpeningAdditionalInformationRequestV03) {
+	*out = *r
+	r.Refs.DeepCopyInto(&out.Refs)
+	if r.Fr != nil {
+		out.Fr = new(OrganisationIdentification29)
+		r.Fr.DeepCopyInto(out.Fr)
+	}
+	r.OrgId.DeepCopyInto(&out.OrgId)
+	r.Acct.DeepCopyInto(&out.Acct)
+	r.AcctSvcrId.DeepCopyInto(&out.AcctSvcrId)
+	if r.UndrlygMstrAgrmt != nil {
+		out.UndrlygMstrAgrmt = new(ContractDocument1)
+		r.UndrlygMstrAgrmt.DeepCopyInto(out.UndrlygMstrAgrmt)
+	}
+	if r.DgtlSgntr != nil {
+		out.DgtlSgntr = make([]PartyAndSignature3, len(r.DgtlSgntr))
+		for i := range r.DgtlSgntr {
+			r.DgtlSgntr[i].DeepCopyInto(&out.DgtlSgntr[i])
+		}
+	}
+	if r.SplmtryData != nil {
+		out.SplmtryData = make([]SupplementaryData1, len(r.SplmtryData))
+		for i := range r.SplmtryData {
+			r.SplmtryData[i].DeepCopyInto(&out.SplmtryData[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *AccountOpeningAmendmentRequestV03) DeepCopy() *AccountOpeningAmendmentRequestV03 {
+	if r == nil {
+		return nil
+	}
+	out := new(AccountOpeningAmendmentRequestV03)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *AccountOpeningAmendmentRequestV03) DeepCopyInto(out *AccountOpeningAmendmentRequestV03) {
+	*out = *r
+	r.Refs.DeepCopyInto(&out.Refs)
+	if r.Fr != nil {
+		out.Fr = new(OrganisationIdentification29)
+		r.Fr.DeepCopyInto(out.Fr)
+	}
+	if r.CtrctDts != nil {
+		out.CtrctDts = new(AccountContract2)
+		r.CtrctDts.DeepCopyInto(out.CtrctDts)
+	}
+	if r.UndrlygMstrAgrmt != nil {
+		out.UndrlygMstrAgrmt = new(ContractDocument1)
+		r.UndrlygMstrAgrmt.DeepCopyInto(out.UndrlygMstrAgrmt)
+	}
+	r.Acct.DeepCopyInto(&out.Acct)
+	r.AcctSvcrId.DeepCopyInto(&out.AcctSvcrId)
+	r.Org.DeepCopyInto(&out.Org)
+	if r.Mndt != nil {
+		out.Mndt = make([]OperationMandate4, len(r.Mndt))
+		for i := range r.Mndt {
+			r.Mndt[i].DeepCopyInto(&out.Mndt[i])
+		}
+	}
+	if r.Grp != nil {
+		out.Grp = make([]Group4, len(r.Grp))
+		for i := range r.Grp {
+			r.Grp[i].DeepCopyInto(&out.Grp[i])
+		}
+	}
+	if r.RefAcct != nil {
+		out.RefAcct = new(CashAccount38)
+		r.RefAcct.DeepCopyInto(out.RefAcct)
+	}
+	if r.DgtlSgntr != nil {
+		out.DgtlSgntr = make([]PartyAndSignature3, len(r.DgtlSgntr))
+		for i := range r.DgtlSgntr {
+			r.DgtlSgntr[i].DeepCopyInto(&out.DgtlSgntr[i])
+		}
+	}
+	if r.SplmtryData != nil {
+		out.SplmtryData = make([]SupplementaryData1, len(r.SplmtryData))
+		for i := range r.SplmtryData {
+			r.SplmtryData[i].DeepCopyInto(&out.SplmtryData[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *AccountOpeningRequestV03) DeepCopy() *AccountOpeningRequestV03 {
+	if r == nil {
+		return nil
+	}
+	out := new(AccountOpeningRequestV03)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *AccountOpeningRequestV03) DeepCopyInto(out *AccountOpeningRequestV03) {
+	*out = *r
+	r.Refs.DeepCopyInto(&out.Refs)
+	if r.Fr != nil {
+		out.Fr = new(OrganisationIdentification29)
+		r.Fr.DeepCopyInto(out.Fr)
+	}
+	r.Acct.DeepCopyInto(&out.Acct)
+	if r.CtrctDts != nil {
+		out.CtrctDts = new(AccountContract2)
+		r.CtrctDts.DeepCopyInto(out.CtrctDts)
+	}
+	if r.UndrlygMstrAgrmt != nil {
+		out.UndrlygMstrAgrmt = new(ContractDocument1)
+		r.UndrlygMstrAgrmt.DeepCopyInto(out.UndrlygMstrAgrmt)
+	}
+	r.AcctSvcrId.DeepCopyInto(&out.AcctSvcrId)
+	r.Org.DeepCopyInto(&out.Org)
+	if r.Mndt != nil {
+		out.Mndt = make([]OperationMandate4, len(r.Mndt))
+		for i := range r.Mndt {
+			r.Mndt[i].DeepCopyInto(&out.Mndt[i])
+		}
+	}
+	if r.Grp != nil {
+		out.Grp = make([]Group4, len(r.Grp))
+		for i := range r.Grp {
+			r.Grp[i].DeepCopyInto(&out.Grp[i])
+		}
+	}
+	if r.RefAcct != nil {
+		out.RefAcct = new(CashAccount38)
+		r.RefAcct.DeepCopyInto(out.RefAcct)
+	}
+	if r.DgtlSgntr != nil {
+		out.DgtlSgntr = make([]PartyAndSignature3, len(r.DgtlSgntr))
+		for i := range r.DgtlSgntr {
+			r.DgtlSgntr[i].DeepCopyInto(&out.DgtlSgntr[i])
+		}
+	}
+	if r.SplmtryData != nil {
+		out.SplmtryData = make([]SupplementaryData1, len(r.SplmtryData))
+		for i := range r.SplmtryData {
+			r.SplmtryData[i].DeepCopyInto(&out.SplmtryData[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *AccountReport23) DeepCopy() *AccountReport23 {
+	if r == nil {
+		return nil
+	}
+	out := new(AccountReport23)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *AccountReport23) DeepCopyInto(out *AccountReport23) {
+	*out = *r
+	r.Acct.DeepCopyInto(&out.Acct)
+	if r.UndrlygMstrAgrmt != nil {
+		out.UndrlygMstrAgrmt = new(ContractDocument1)
+		r.UndrlygMstrAgrmt.DeepCopyInto(out.UndrlygMstrAgrmt)
+	}
+	if r.CtrctDts != nil {
+		out.CtrctDts = new(AccountContract3)
+		r.CtrctDts.DeepCopyInto(out.CtrctDts)
+	}
+	if r.Mndt != nil {
+		out.Mndt = make([]OperationMandate4, len(r.Mndt))
+		for i := range r.Mndt {
+			r.Mndt[i].DeepCopyInto(&out.Mndt[i])
+		}
+	}
+	if r.Grp != nil {
+		out.Grp = make([]Group4, len(r.Grp))
+		for i := range r.Grp {
+			r.Grp[i].DeepCopyInto(&out.Grp[i])
+		}
+	}
+	if r.RefAcct != nil {
+		out.RefAcct = new(CashAccount38)
+		r.RefAcct.DeepCopyInto(out.RefAcct)
+	}
+	if r.BalTrfAcct != nil {
+		out.BalTrfAcct = new(AccountForAction1)
+		r.BalTrfAcct.DeepCopyInto(out.BalTrfAcct)
+	}
+	if r.TrfAcctSvcrId != nil {
+		out.TrfAcctSvcrId = new(BranchAndFinancialInstitutionIdentification6)
+		r.TrfAcctSvcrId.DeepCopyInto(out.TrfAcctSvcrId)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *AccountReportRequestV03) DeepCopy() *AccountReportRequestV03 {
+	if r == nil {
+		return nil
+	}
+	out := new(AccountReportRequestV03)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *AccountReportRequestV03) DeepCopyInto(out *AccountReportRequestV03) {
+	*out = *r
+	r.Refs.DeepCopyInto(&out.Refs)
+	if r.Fr != nil {
+		out.Fr = new(OrganisationIdentification29)
+		r.Fr.DeepCopyInto(out.Fr)
+	}
+	if r.AcctId != nil {
+		out.AcctId = make([]AccountForAction1, len(r.AcctId))
+		for i := range r.AcctId {
+			r.AcctId[i].DeepCopyInto(&out.AcctId[i])
+		}
+	}
+	r.AcctSvcrId.DeepCopyInto(&out.AcctSvcrId)
+	r.OrgId.DeepCopyInto(&out.OrgId)
+	if r.DgtlSgntr != nil {
+		out.DgtlSgntr = make([]PartyAndSignature3, len(r.DgtlSgntr))
+		for i := range r.DgtlSgntr {
+			r.DgtlSgntr[i].DeepCopyInto(&out.DgtlSgntr[i])
+		}
+	}
+	if r.SplmtryData != nil {
+		out.SplmtryData = make([]SupplementaryData1, len(r.SplmtryData))
+		for i := range r.SplmtryData {
+			r.SplmtryData[i].DeepCopyInto(&out.SplmtryData[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *AccountReportV03) DeepCopy() *AccountReportV03 {
+	if r == nil {
+		return nil
+	}
+	out := new(AccountReportV03)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *AccountReportV03) DeepCopyInto(out *AccountReportV03) {
+	*out = *r
+	r.Refs.DeepCopyInto(&out.Refs)
+	if r.Fr != nil {
+		out.Fr = new(OrganisationIdentification29)
+		r.Fr.DeepCopyInto(out.Fr)
+	}
+	r.AcctSvcrId.DeepCopyInto(&out.AcctSvcrId)
+	r.Org.DeepCopyInto(&out.Org)
+	if r.Rpt != nil {
+		out.Rpt = make([]AccountReport23, len(r.Rpt))
+		for i := range r.Rpt {
+			r.Rpt[i].DeepCopyInto(&out.Rpt[i])
+		}
+	}
+	if r.DgtlSgntr != nil {
+		out.DgtlSgntr = make([]PartyAndSignature3, len(r.DgtlSgntr))
+		for i := range r.DgtlSgntr {
+			r.DgtlSgntr[i].DeepCopyInto(&out.DgtlSgntr[i])
+		}
+	}
+	if r.SplmtryData != nil {
+		out.SplmtryData = make([]SupplementaryData1, len(r.SplmtryData))
+		for i := range r.SplmtryData {
+			r.SplmtryData[i].DeepCopyInto(&out.SplmtryData[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *AccountRequestAcknowledgementV03) DeepCopy() *AccountRequestAcknowledgementV03 {
+	if r == nil {
+		return nil
+	}
+	out := new(AccountRequestAcknowledgementV03)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *AccountRequestAcknowledgementV03) DeepCopyInto(out *AccountRequestAcknowledgementV03) {
+	*out = *r
+	r.Refs.DeepCopyInto(&out.Refs)
+	if r.Fr != nil {
+		out.Fr = new(OrganisationIdentification29)
+		r.Fr.DeepCopyInto(out.Fr)
+	}
+	if r.AcctId != nil {
+		out.AcctId = make([]AccountForAction1, len(r.AcctId))
+		for i := range r.AcctId {
+			r.AcctId[i].DeepCopyInto(&out.AcctId[i])
+		}
+	}
+	r.OrgId.DeepCopyInto(&out.OrgId)
+	r.AcctSvcrId.DeepCopyInto(&out.AcctSvcrId)
+	if r.DgtlSgntr != nil {
+		out.DgtlSgntr = make([]PartyAndSignature3, len(r.DgtlSgntr))
+		for i := range r.DgtlSgntr {
+			r.DgtlSgntr[i].DeepCopyInto(&out.DgtlSgntr[i])
+		}
+	}
+	if r.SplmtryData != nil {
+		out.SplmtryData = make([]SupplementaryData1, len(r.SplmtryData))
+		for i := range r.SplmtryData {
+			r.SplmtryData[i].DeepCopyInto(&out.SplmtryData[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *AccountRequestRejectionV03) DeepCopy() *AccountRequestRejectionV03 {
+	if r == nil {
+		return nil
+	}
+	out := new(AccountRequestRejectionV03)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *AccountRequestRejectionV03) DeepCopyInto(out *AccountRequestRejectionV03) {
+	*out = *r
+	r.Refs.DeepCopyInto(&out.Refs)
+	if r.Fr != nil {
+		out.Fr = new(OrganisationIdentification29)
+		r.Fr.DeepCopyInto(out.Fr)
+	}
+	r.AcctSvcrId.DeepCopyInto(&out.AcctSvcrId)
+	if r.AcctId != nil {
+		out.AcctId = make([]AccountForAction1, len(r.AcctId))
+		for i := range r.AcctId {
+			r.AcctId[i].DeepCopyInto(&out.AcctId[i])
+		}
+	}
+	r.OrgId.DeepCopyInto(&out.OrgId)
+	if r.DgtlSgntr != nil {
+		out.DgtlSgntr = make([]PartyAndSignature3, len(r.DgtlSgntr))
+		for i := range r.DgtlSgntr {
+			r.DgtlSgntr[i].DeepCopyInto(&out.DgtlSgntr[i])
+		}
+	}
+	if r.SplmtryData != nil {
+		out.SplmtryData = make([]SupplementaryData1, len(r.SplmtryData))
+		for i := range r.SplmtryData {
+			r.SplmtryData[i].DeepCopyInto(&out.SplmtryData[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *AccountSchemeName1Choice) DeepCopy() *AccountSchemeName1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(AccountSchemeName1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *AccountSchemeName1Choice) DeepCopyInto(out *AccountSchemeName1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *AccountStatusModification1) DeepCopy() *AccountStatusModification1 {
+	if r == nil {
+		return nil
+	}
+	out := new(AccountStatusModification1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *AccountStatusModification1) DeepCopyInto(out *AccountStatusModification1) {
+	*out = *r
+	if r.ModCd != nil {
+		out.ModCd = new(Modification1Code)
+		*out.ModCd = *r.ModCd
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *AccountSwitchBalanceTransferAcknowledgementV03) DeepCopy() *AccountSwitchBalanceTransferAcknowledgementV03 {
+	if r == nil {
+		return nil
+	}
+	out := new(AccountSwitchBalanceTransferAcknowledgementV03)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *AccountSwitchBalanceTransferAcknowledgementV03) DeepCopyInto(out *AccountSwitchBalanceTransferAcknowledgementV03) {
+	*out = *r
+	r.AcctSwtchDtls.DeepCopyInto(&out.AcctSwtchDtls)
+	r.OdAcct.DeepCopyInto(&out.OdAcct)
+	r.OdAcctBal.DeepCopyInto(&out.OdAcctBal)
+	if r.BalTrf != nil {
+		out.BalTrf = make([]BalanceTransfer3, len(r.BalTrf))
+		for i := range r.BalTrf {
+			r.BalTrf[i].DeepCopyInto(&out.BalTrf[i])
+		}
+	}
+	if r.SplmtryData != nil {
+		out.SplmtryData = make([]SupplementaryData1, len(r.SplmtryData))
+		for i := range r.SplmtryData {
+			r.SplmtryData[i].DeepCopyInto(&out.SplmtryData[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *AccountSwitchCancelExistingPaymentV03) DeepCopy() *AccountSwitchCancelExistingPaymentV03 {
+	if r == nil {
+		return nil
+	}
+	out := new(AccountSwitchCancelExistingPaymentV03)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *AccountSwitchCancelExistingPaymentV03) DeepCopyInto(out *AccountSwitchCancelExistingPaymentV03) {
+	*out = *r
+	r.AcctSwtchDtls.DeepCopyInto(&out.AcctSwtchDtls)
+	r.OdAcct.DeepCopyInto(&out.OdAcct)
+	if r.PmtInstr != nil {
+		out.PmtInstr = make([]PaymentInstruction36, len(r.PmtInstr))
+		for i := range r.PmtInstr {
+			r.PmtInstr[i].DeepCopyInto(&out.PmtInstr[i])
+		}
+	}
+	if r.DrctDbtInstr != nil {
+		out.DrctDbtInstr = make([]DirectDebitInstructionDetails2, len(r.DrctDbtInstr))
+		for i := range r.DrctDbtInstr {
+			r.DrctDbtInstr[i].DeepCopyInto(&out.DrctDbtInstr[i])
+		}
+	}
+	if r.SplmtryData != nil {
+		out.SplmtryData = make([]SupplementaryData1, len(r.SplmtryData))
+		for i := range r.SplmtryData {
+			r.SplmtryData[i].DeepCopyInto(&out.SplmtryData[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *AccountSwitchDetails1) DeepCopy() *AccountSwitchDetails1 {
+	if r == nil {
+		return nil
+	}
+	out := new(AccountSwitchDetails1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *AccountSwitchDetails1) DeepCopyInto(out *AccountSwitchDetails1) {
+	*out = *r
+	if r.SwtchRcvdDtTm != nil {
+		out.SwtchRcvdDtTm = new(common.ISODateTime)
+		*out.SwtchRcvdDtTm = *r.SwtchRcvdDtTm
+	}
+	if r.SwtchDt != nil {
+		out.SwtchDt = new(common.ISODate)
+		*out.SwtchDt = *r.SwtchDt
+	}
+	if r.SwtchSts != nil {
+		out.SwtchSts = new(SwitchStatus1Code)
+		*out.SwtchSts = *r.SwtchSts
+	}
+	if r.BalTrfWndw != nil {
+		out.BalTrfWndw = new(BalanceTransferWindow1Code)
+		*out.BalTrfWndw = *r.BalTrfWndw
+	}
+	if r.Rspn != nil {
+		out.Rspn = make([]ResponseDetails1, len(r.Rspn))
+		for i := range r.Rspn {
+			r.Rspn[i].DeepCopyInto(&out.Rspn[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *AccountSwitchInformationRequestV03) DeepCopy() *AccountSwitchInformationRequestV03 {
+	if r == nil {
+		return nil
+	}
+	out := new(AccountSwitchInformationRequestV03)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *AccountSwitchInformationRequestV03) DeepCopyInto(out *AccountSwitchInformationRequestV03) {
+	*out = *r
+	r.AcctSwtchDtls.DeepCopyInto(&out.AcctSwtchDtls)
+	r.NewAcct.DeepCopyInto(&out.NewAcct)
+	r.OdAcct.DeepCopyInto(&out.OdAcct)
+	if r.BalTrf != nil {
+		out.BalTrf = make([]BalanceTransfer3, len(r.BalTrf))
+		for i := range r.BalTrf {
+			r.BalTrf[i].DeepCopyInto(&out.BalTrf[i])
+		}
+	}
+	if r.SplmtryData != nil {
+		out.SplmtryData = make([]SupplementaryData1, len(r.SplmtryData))
+		for i := range r.SplmtryData {
+			r.SplmtryData[i].DeepCopyInto(&out.SplmtryData[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *AccountSwitchInformationResponseV03) DeepCopy() *AccountSwitchInformationResponseV03 {
+	if r == nil {
+		return nil
+	}
+	out := new(AccountSwitchInformationResponseV03)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *AccountSwitchInformationResponseV03) DeepCopyInto(out *AccountSwitchInformationResponseV03) {
+	*out = *r
+	r.AcctSwtchDtls.DeepCopyInto(&out.AcctSwtchDtls)
+	r.NewAcct.DeepCopyInto(&out.NewAcct)
+	r.OdAcct.DeepCopyInto(&out.OdAcct)
+	if r.PmtInstr != nil {
+		out.PmtInstr = make([]PaymentInstruction36, len(r.PmtInstr))
+		for i := range r.PmtInstr {
+			r.PmtInstr[i].DeepCopyInto(&out.PmtInstr[i])
+		}
+	}
+	if r.DrctDbtInstr != nil {
+		out.DrctDbtInstr = make([]DirectDebitInstructionDetails2, len(r.DrctDbtInstr))
+		for i := range r.DrctDbtInstr {
+			r.DrctDbtInstr[i].DeepCopyInto(&out.DrctDbtInstr[i])
+		}
+	}
+	if r.SplmtryData != nil {
+		out.SplmtryData = make([]SupplementaryData1, len(r.SplmtryData))
+		for i := range r.SplmtryData {
+			r.SplmtryData[i].DeepCopyInto(&out.SplmtryData[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *AccountSwitchRequestBalanceTransferV03) DeepCopy() *AccountSwitchRequestBalanceTransferV03 {
+	if r == nil {
+		return nil
+	}
+	out := new(AccountSwitchRequestBalanceTransferV03)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *AccountSwitchRequestBalanceTransferV03) DeepCopyInto(out *AccountSwitchRequestBalanceTransferV03) {
+	*out = *r
+	r.AcctSwtchDtls.DeepCopyInto(&out.AcctSwtchDtls)
+	r.NewAcct.DeepCopyInto(&out.NewAcct)
+	if r.NmntdAcct != nil {
+		out.NmntdAcct = new(CashAccount39)
+		r.NmntdAcct.DeepCopyInto(out.NmntdAcct)
+	}
+	if r.BalTrf != nil {
+		out.BalTrf = make([]BalanceTransfer3, len(r.BalTrf))
+		for i := range r.BalTrf {
+			r.BalTrf[i].DeepCopyInto(&out.BalTrf[i])
+		}
+	}
+	if r.SplmtryData != nil {
+		out.SplmtryData = make([]SupplementaryData1, len(r.SplmtryData))
+		for i := range r.SplmtryData {
+			r.SplmtryData[i].DeepCopyInto(&out.SplmtryData[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *AccountSwitchRequestPaymentV03) DeepCopy() *AccountSwitchRequestPaymentV03 {
+	if r == nil {
+		return nil
+	}
+	out := new(AccountSwitchRequestPaymentV03)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *AccountSwitchRequestPaymentV03) DeepCopyInto(out *AccountSwitchRequestPaymentV03) {
+	*out = *r
+	r.AcctSwtchDtls.DeepCopyInto(&out.AcctSwtchDtls)
+	r.OdAcct.DeepCopyInto(&out.OdAcct)
+	r.CdtInstr.DeepCopyInto(&out.CdtInstr)
+	if r.SplmtryData != nil {
+		out.SplmtryData = make([]SupplementaryData1, len(r.SplmtryData))
+		for i := range r.SplmtryData {
+			r.SplmtryData[i].DeepCopyInto(&out.SplmtryData[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ActiveCurrencyAndAmount) DeepCopy() *ActiveCurrencyAndAmount {
+	if r == nil {
+		return nil
+	}
+	out := new(ActiveCurrencyAndAmount)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ActiveCurrencyAndAmount) DeepCopyInto(out *ActiveCurrencyAndAmount) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ActiveOrHistoricCurrencyAndAmount) DeepCopy() *ActiveOrHistoricCurrencyAndAmount {
+	if r == nil {
+		return nil
+	}
+	out := new(ActiveOrHistoricCurrencyAndAmount)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ActiveOrHistoricCurrencyAndAmount) DeepCopyInto(out *ActiveOrHistoricCurrencyAndAmount) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *AdditionalInformation5) DeepCopy() *AdditionalInformation5 {
+	if r == nil {
+		return nil
+	}
+	out := new(AdditionalInformation5)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *AdditionalInformation5) DeepCopyInto(out *AdditionalInformation5) {
+	*out = *r
+	if r.Inf != nil {
+		out.Inf = make([]common.Max256Text, len(r.Inf))
+		copy(out.Inf, r.Inf)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *AddressModification2) DeepCopy() *AddressModification2 {
+	if r == nil {
+		return nil
+	}
+	out := new(AddressModification2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *AddressModification2) DeepCopyInto(out *AddressModification2) {
+	*out = *r
+	if r.ModCd != nil {
+		out.ModCd = new(Modification1Code)
+		*out.ModCd = *r.ModCd
+	}
+	r.Adr.DeepCopyInto(&out.Adr)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *AddressType3Choice) DeepCopy() *AddressType3Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(AddressType3Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *AddressType3Choice) DeepCopyInto(out *AddressType3Choice) {
+	*out = *r
+	r.Prtry.DeepCopyInto(&out.Prtry)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *AmountAndDirection5) DeepCopy() *AmountAndDirection5 {
+	if r == nil {
+		return nil
+	}
+	out := new(AmountAndDirection5)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *AmountAndDirection5) DeepCopyInto(out *AmountAndDirection5) {
+	*out = *r
+	if r.CdtDbt != nil {
+		out.CdtDbt = new(common.CreditDebitCode)
+		*out.CdtDbt = *r.CdtDbt
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *AmountModification1) DeepCopy() *AmountModification1 {
+	if r == nil {
+		return nil
+	}
+	out := new(AmountModification1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *AmountModification1) DeepCopyInto(out *AmountModification1) {
+	*out = *r
+	if r.ModCd != nil {
+		out.ModCd = new(Modification1Code)
+		*out.ModCd = *r.ModCd
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *Authorisation2) DeepCopy() *Authorisation2 {
+	if r == nil {
+		return nil
+	}
+	out := new(Authorisation2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *Authorisation2) DeepCopyInto(out *Authorisation2) {
+	*out = *r
+	if r.MaxAmtByTx != nil {
+		out.MaxAmtByTx = new(FixedAmountOrUnlimited1Choice)
+		*out.MaxAmtByTx = *r.MaxAmtByTx
+	}
+	if r.MaxAmtByPrd != nil {
+		out.MaxAmtByPrd = make([]MaximumAmountByPeriod1, len(r.MaxAmtByPrd))
+		copy(out.MaxAmtByPrd, r.MaxAmtByPrd)
+	}
+	if r.MaxAmtByBlkSubmissn != nil {
+		out.MaxAmtByBlkSubmissn = new(FixedAmountOrUnlimited1Choice)
+		*out.MaxAmtByBlkSubmissn = *r.MaxAmtByBlkSubmissn
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *BalanceTransfer3) DeepCopy() *BalanceTransfer3 {
+	if r == nil {
+		return nil
+	}
+	out := new(BalanceTransfer3)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *BalanceTransfer3) DeepCopyInto(out *BalanceTransfer3) {
+	*out = *r
+	if r.BalTrfRef != nil {
+		out.BalTrfRef = new(BalanceTransferReference1)
+		*out.BalTrfRef = *r.BalTrfRef
+	}
+	if r.BalTrfMtd != nil {
+		out.BalTrfMtd = new(SettlementMethod3Choice)
+		r.BalTrfMtd.DeepCopyInto(out.BalTrfMtd)
+	}
+	if r.BalTrfFndgLmt != nil {
+		out.BalTrfFndgLmt = new(BalanceTransferFundingLimit1)
+		*out.BalTrfFndgLmt = *r.BalTrfFndgLmt
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *BalanceTransferFundingLimit1) DeepCopy() *BalanceTransferFundingLimit1 {
+	if r == nil {
+		return nil
+	}
+	out := new(BalanceTransferFundingLimit1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *BalanceTransferFundingLimit1) DeepCopyInto(out *BalanceTransferFundingLimit1) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *BalanceTransferReference1) DeepCopy() *BalanceTransferReference1 {
+	if r == nil {
+		return nil
+	}
+	out := new(BalanceTransferReference1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *BalanceTransferReference1) DeepCopyInto(out *BalanceTransferReference1) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *BankTransactionCodeStructure4) DeepCopy() *BankTransactionCodeStructure4 {
+	if r == nil {
+		return nil
+	}
+	out := new(BankTransactionCodeStructure4)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *BankTransactionCodeStructure4) DeepCopyInto(out *BankTransactionCodeStructure4) {
+	*out = *r
+	if r.Domn != nil {
+		out.Domn = new(BankTransactionCodeStructure5)
+		*out.Domn = *r.Domn
+	}
+	if r.Prtry != nil {
+		out.Prtry = new(ProprietaryBankTransactionCodeStructure1)
+		r.Prtry.DeepCopyInto(out.Prtry)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *BankTransactionCodeStructure5) DeepCopy() *BankTransactionCodeStructure5 {
+	if r == nil {
+		return nil
+	}
+	out := new(BankTransactionCodeStructure5)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *BankTransactionCodeStructure5) DeepCopyInto(out *BankTransactionCodeStructure5) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *BankTransactionCodeStructure6) DeepCopy() *BankTransactionCodeStructure6 {
+	if r == nil {
+		return nil
+	}
+	out := new(BankTransactionCodeStructure6)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *BankTransactionCodeStructure6) DeepCopyInto(out *BankTransactionCodeStructure6) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *BranchAndFinancialInstitutionIdentification6) DeepCopy() *BranchAndFinancialInstitutionIdentification6 {
+	if r == nil {
+		return nil
+	}
+	out := new(BranchAndFinancialInstitutionIdentification6)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *BranchAndFinancialInstitutionIdentification6) DeepCopyInto(out *BranchAndFinancialInstitutionIdentification6) {
+	*out = *r
+	r.FinInstnId.DeepCopyInto(&out.FinInstnId)
+	if r.BrnchId != nil {
+		out.BrnchId = new(BranchData3)
+		r.BrnchId.DeepCopyInto(out.BrnchId)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *BranchData3) DeepCopy() *BranchData3 {
+	if r == nil {
+		return nil
+	}
+	out := new(BranchData3)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *BranchData3) DeepCopyInto(out *BranchData3) {
+	*out = *r
+	if r.Id != nil {
+		out.Id = new(common.Max35Text)
+		*out.Id = *r.Id
+	}
+	if r.LEI != nil {
+		out.LEI = new(common.LEIIdentifier)
+		*out.LEI = *r.LEI
+	}
+	if r.Nm != nil {
+		out.Nm = new(common.Max140Text)
+		*out.Nm = *r.Nm
+	}
+	if r.PstlAdr != nil {
+		out.PstlAdr = new(PostalAddress24)
+		r.PstlAdr.DeepCopyInto(out.PstlAdr)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CashAccount38) DeepCopy() *CashAccount38 {
+	if r == nil {
+		return nil
+	}
+	out := new(CashAccount38)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CashAccount38) DeepCopyInto(out *CashAccount38) {
+	*out = *r
+	if r.Id != nil {
+		out.Id = new(AccountIdentification4Choice)
+		r.Id.DeepCopyInto(out.Id)
+	}
+	if r.Tp != nil {
+		out.Tp = new(CashAccountType2Choice)
+		*out.Tp = *r.Tp
+	}
+	if r.Ccy != nil {
+		out.Ccy = new(common.ActiveOrHistoricCurrencyCode)
+		*out.Ccy = *r.Ccy
+	}
+	if r.Nm != nil {
+		out.Nm = new(common.Max70Text)
+		*out.Nm = *r.Nm
+	}
+	if r.Prxy != nil {
+		out.Prxy = new(ProxyAccountIdentification1)
+		r.Prxy.DeepCopyInto(out.Prxy)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CashAccount39) DeepCopy() *CashAccount39 {
+	if r == nil {
+		return nil
+	}
+	out := new(CashAccount39)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CashAccount39) DeepCopyInto(out *CashAccount39) {
+	*out = *r
+	r.Id.DeepCopyInto(&out.Id)
+	if r.Tp != nil {
+		out.Tp = new(CashAccountType2Choice)
+		*out.Tp = *r.Tp
+	}
+	if r.Ccy != nil {
+		out.Ccy = new(common.ActiveOrHistoricCurrencyCode)
+		*out.Ccy = *r.Ccy
+	}
+	if r.Nm != nil {
+		out.Nm = new(common.Max70Text)
+		*out.Nm = *r.Nm
+	}
+	if r.Prxy != nil {
+		out.Prxy = new(ProxyAccountIdentification1)
+		r.Prxy.DeepCopyInto(out.Prxy)
+	}
+	if r.Ownr != nil {
+		out.Ownr = new(PartyIdentification135)
+		r.Ownr.DeepCopyInto(out.Ownr)
+	}
+	if r.Svcr != nil {
+		out.Svcr = new(BranchAndFinancialInstitutionIdentification6)
+		r.Svcr.DeepCopyInto(out.Svcr)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CashAccountType2Choice) DeepCopy() *CashAccountType2Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(CashAccountType2Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CashAccountType2Choice) DeepCopyInto(out *CashAccountType2Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CategoryPurpose1Choice) DeepCopy() *CategoryPurpose1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(CategoryPurpose1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CategoryPurpose1Choice) DeepCopyInto(out *CategoryPurpose1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *Channel2Choice) DeepCopy() *Channel2Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(Channel2Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *Channel2Choice) DeepCopyInto(out *Channel2Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *Cheque11) DeepCopy() *Cheque11 {
+	if r == nil {
+		return nil
+	}
+	out := new(Cheque11)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *Cheque11) DeepCopyInto(out *Cheque11) {
+	*out = *r
+	if r.ChqTp != nil {
+		out.ChqTp = new(ChequeType2Code)
+		*out.ChqTp = *r.ChqTp
+	}
+	if r.ChqNb != nil {
+		out.ChqNb = new(common.Max35Text)
+		*out.ChqNb = *r.ChqNb
+	}
+	if r.ChqFr != nil {
+		out.ChqFr = new(NameAndAddress16)
+		r.ChqFr.DeepCopyInto(out.ChqFr)
+	}
+	if r.DlvryMtd != nil {
+		out.DlvryMtd = new(ChequeDeliveryMethod1Choice)
+		*out.DlvryMtd = *r.DlvryMtd
+	}
+	if r.DlvrTo != nil {
+		out.DlvrTo = new(NameAndAddress16)
+		r.DlvrTo.DeepCopyInto(out.DlvrTo)
+	}
+	if r.InstrPrty != nil {
+		out.InstrPrty = new(Priority2Code)
+		*out.InstrPrty = *r.InstrPrty
+	}
+	if r.ChqMtrtyDt != nil {
+		out.ChqMtrtyDt = new(common.ISODate)
+		*out.ChqMtrtyDt = *r.ChqMtrtyDt
+	}
+	if r.FrmsCd != nil {
+		out.FrmsCd = new(common.Max35Text)
+		*out.FrmsCd = *r.FrmsCd
+	}
+	if r.MemoFld != nil {
+		out.MemoFld = make([]common.Max35Text, len(r.MemoFld))
+		copy(out.MemoFld, r.MemoFld)
+	}
+	if r.RgnlClrZone != nil {
+		out.RgnlClrZone = new(common.Max35Text)
+		*out.RgnlClrZone = *r.RgnlClrZone
+	}
+	if r.PrtLctn != nil {
+		out.PrtLctn = new(common.Max35Text)
+		*out.PrtLctn = *r.PrtLctn
+	}
+	if r.Sgntr != nil {
+		out.Sgntr = make([]common.Max70Text, len(r.Sgntr))
+		copy(out.Sgntr, r.Sgntr)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ChequeDeliveryMethod1Choice) DeepCopy() *ChequeDeliveryMethod1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(ChequeDeliveryMethod1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ChequeDeliveryMethod1Choice) DeepCopyInto(out *ChequeDeliveryMethod1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CitizenshipInformation1) DeepCopy() *CitizenshipInformation1 {
+	if r == nil {
+		return nil
+	}
+	out := new(CitizenshipInformation1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CitizenshipInformation1) DeepCopyInto(out *CitizenshipInformation1) {
+	*out = *r
+	if r.StartDt != nil {
+		out.StartDt = new(common.ISODate)
+		*out.StartDt = *r.StartDt
+	}
+	if r.EndDt != nil {
+		out.EndDt = new(common.ISODate)
+		*out.EndDt = *r.EndDt
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ClearingSystemIdentification2Choice) DeepCopy() *ClearingSystemIdentification2Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(ClearingSystemIdentification2Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ClearingSystemIdentification2Choice) DeepCopyInto(out *ClearingSystemIdentification2Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ClearingSystemMemberIdentification2) DeepCopy() *ClearingSystemMemberIdentification2 {
+	if r == nil {
+		return nil
+	}
+	out := new(ClearingSystemMemberIdentification2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ClearingSystemMemberIdentification2) DeepCopyInto(out *ClearingSystemMemberIdentification2) {
+	*out = *r
+	if r.ClrSysId != nil {
+		out.ClrSysId = new(ClearingSystemIdentification2Choice)
+		*out.ClrSysId = *r.ClrSysId
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CodeOrProprietary1Choice) DeepCopy() *CodeOrProprietary1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(CodeOrProprietary1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CodeOrProprietary1Choice) DeepCopyInto(out *CodeOrProprietary1Choice) {
+	*out = *r
+	r.Prtry.DeepCopyInto(&out.Prtry)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CommunicationAddress3) DeepCopy() *CommunicationAddress3 {
+	if r == nil {
+		return nil
+	}
+	out := new(CommunicationAddress3)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CommunicationAddress3) DeepCopyInto(out *CommunicationAddress3) {
+	*out = *r
+	if r.Email != nil {
+		out.Email = new(common.Max256Text)
+		*out.Email = *r.Email
+	}
+	if r.Phne != nil {
+		out.Phne = new(common.PhoneNumber)
+		*out.Phne = *r.Phne
+	}
+	if r.Mob != nil {
+		out.Mob = new(common.PhoneNumber)
+		*out.Mob = *r.Mob
+	}
+	if r.FaxNb != nil {
+		out.FaxNb = new(common.PhoneNumber)
+		*out.FaxNb = *r.FaxNb
+	}
+	if r.TlxAdr != nil {
+		out.TlxAdr = new(common.Max35Text)
+		*out.TlxAdr = *r.TlxAdr
+	}
+	if r.URLAdr != nil {
+		out.URLAdr = new(common.Max256Text)
+		*out.URLAdr = *r.URLAdr
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CommunicationFormat1Choice) DeepCopy() *CommunicationFormat1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(CommunicationFormat1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CommunicationFormat1Choice) DeepCopyInto(out *CommunicationFormat1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CommunicationMethod2Choice) DeepCopy() *CommunicationMethod2Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(CommunicationMethod2Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CommunicationMethod2Choice) DeepCopyInto(out *CommunicationMethod2Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *Contact4) DeepCopy() *Contact4 {
+	if r == nil {
+		return nil
+	}
+	out := new(Contact4)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *Contact4) DeepCopyInto(out *Contact4) {
+	*out = *r
+	if r.NmPrfx != nil {
+		out.NmPrfx = new(common.NamePrefix2Code)
+		*out.NmPrfx = *r.NmPrfx
+	}
+	if r.Nm != nil {
+		out.Nm = new(common.Max140Text)
+		*out.Nm = *r.Nm
+	}
+	if r.PhneNb != nil {
+		out.PhneNb = new(common.PhoneNumber)
+		*out.PhneNb = *r.PhneNb
+	}
+	if r.MobNb != nil {
+		out.MobNb = new(common.PhoneNumber)
+		*out.MobNb = *r.MobNb
+	}
+	if r.FaxNb != nil {
+		out.FaxNb = new(common.PhoneNumber)
+		*out.FaxNb = *r.FaxNb
+	}
+	if r.EmailAdr != nil {
+		out.EmailAdr = new(common.Max2048Text)
+		*out.EmailAdr = *r.EmailAdr
+	}
+	if r.EmailPurp != nil {
+		out.EmailPurp = new(common.Max35Text)
+		*out.EmailPurp = *r.EmailPurp
+	}
+	if r.JobTitl != nil {
+		out.JobTitl = new(common.Max35Text)
+		*out.JobTitl = *r.JobTitl
+	}
+	if r.Rspnsblty != nil {
+		out.Rspnsblty = new(common.Max35Text)
+		*out.Rspnsblty = *r.Rspnsblty
+	}
+	if r.Dept != nil {
+		out.Dept = new(common.Max70Text)
+		*out.Dept = *r.Dept
+	}
+	if r.Othr != nil {
+		out.Othr = make([]OtherContact1, len(r.Othr))
+		for i := range r.Othr {
+			r.Othr[i].DeepCopyInto(&out.Othr[i])
+		}
+	}
+	if r.PrefrdMtd != nil {
+		out.PrefrdMtd = new(PreferredContactMethod1Code)
+		*out.PrefrdMtd = *r.PrefrdMtd
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ContractDocument1) DeepCopy() *ContractDocument1 {
+	if r == nil {
+		return nil
+	}
+	out := new(ContractDocument1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ContractDocument1) DeepCopyInto(out *ContractDocument1) {
+	*out = *r
+	if r.SgnOffDt != nil {
+		out.SgnOffDt = new(common.ISODate)
+		*out.SgnOffDt = *r.SgnOffDt
+	}
+	if r.Vrsn != nil {
+		out.Vrsn = new(common.Max6Text)
+		*out.Vrsn = *r.Vrsn
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CountryAndResidentialStatusType1) DeepCopy() *CountryAndResidentialStatusType1 {
+	if r == nil {
+		return nil
+	}
+	out := new(CountryAndResidentialStatusType1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CountryAndResidentialStatusType1) DeepCopyInto(out *CountryAndResidentialStatusType1) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CreditTransferTransaction41) DeepCopy() *CreditTransferTransaction41 {
+	if r == nil {
+		return nil
+	}
+	out := new(CreditTransferTransaction41)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CreditTransferTransaction41) DeepCopyInto(out *CreditTransferTransaction41) {
+	*out = *r
+	r.PmtId.DeepCopyInto(&out.PmtId)
+	if r.PmtTpInf != nil {
+		out.PmtTpInf = new(PaymentTypeInformation26)
+		r.PmtTpInf.DeepCopyInto(out.PmtTpInf)
+	}
+	if r.TaxRateMrkr != nil {
+		out.TaxRateMrkr = new(TaxRateMarker1Code)
+		*out.TaxRateMrkr = *r.TaxRateMrkr
+	}
+	if r.ChrgBr != nil {
+		out.ChrgBr = new(ChargeBearerType1Code)
+		*out.ChrgBr = *r.ChrgBr
+	}
+	if r.ChqInstr != nil {
+		out.ChqInstr = new(Cheque11)
+		r.ChqInstr.DeepCopyInto(out.ChqInstr)
+	}
+	if r.Frqcy != nil {
+		out.Frqcy = new(Frequency1)
+		r.Frqcy.DeepCopyInto(out.Frqcy)
+	}
+	if r.TrfInstr != nil {
+		out.TrfInstr = new(TransferInstruction1)
+		r.TrfInstr.DeepCopyInto(out.TrfInstr)
+	}
+	if r.UltmtDbtr != nil {
+		out.UltmtDbtr = new(PartyIdentification135)
+		r.UltmtDbtr.DeepCopyInto(out.UltmtDbtr)
+	}
+	if r.IntrmyAgt1 != nil {
+		out.IntrmyAgt1 = new(BranchAndFinancialInstitutionIdentification6)
+		r.IntrmyAgt1.DeepCopyInto(out.IntrmyAgt1)
+	}
+	if r.IntrmyAgt2 != nil {
+		out.IntrmyAgt2 = new(BranchAndFinancialInstitutionIdentification6)
+		r.IntrmyAgt2.DeepCopyInto(out.IntrmyAgt2)
+	}
+	if r.IntrmyAgt3 != nil {
+		out.IntrmyAgt3 = new(BranchAndFinancialInstitutionIdentification6)
+		r.IntrmyAgt3.DeepCopyInto(out.IntrmyAgt3)
+	}
+	r.CdtrAgt.DeepCopyInto(&out.CdtrAgt)
+	if r.Cdtr != nil {
+		out.Cdtr = new(PartyIdentification135)
+		r.Cdtr.DeepCopyInto(out.Cdtr)
+	}
+	if r.CdtrAcct != nil {
+		out.CdtrAcct = new(CashAccount38)
+		r.CdtrAcct.DeepCopyInto(out.CdtrAcct)
+	}
+	if r.UltmtCdtr != nil {
+		out.UltmtCdtr = new(PartyIdentification135)
+		r.UltmtCdtr.DeepCopyInto(out.UltmtCdtr)
+	}
+	if r.InstrForCdtrAgt != nil {
+		out.InstrForCdtrAgt = make([]InstructionForCreditorAgent3, len(r.InstrForCdtrAgt))
+		for i := range r.InstrForCdtrAgt {
+			r.InstrForCdtrAgt[i].DeepCopyInto(&out.InstrForCdtrAgt[i])
+		}
+	}
+	if r.Purp != nil {
+		out.Purp = new(Purpose2Choice)
+		*out.Purp = *r.Purp
+	}
+	if r.RgltryRptg != nil {
+		out.RgltryRptg = make([]RegulatoryReporting3, len(r.RgltryRptg))
+		for i := range r.RgltryRptg {
+			r.RgltryRptg[i].DeepCopyInto(&out.RgltryRptg[i])
+		}
+	}
+	if r.Tax != nil {
+		out.Tax = new(TaxInformation8)
+		r.Tax.DeepCopyInto(out.Tax)
+	}
+	if r.RltdRmtInf != nil {
+		out.RltdRmtInf = make([]RemittanceLocation6, len(r.RltdRmtInf))
+		for i := range r.RltdRmtInf {
+			r.RltdRmtInf[i].DeepCopyInto(&out.RltdRmtInf[i])
+		}
+	}
+	r.RmtInf.DeepCopyInto(&out.RmtInf)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CreditorReferenceInformation2) DeepCopy() *CreditorReferenceInformation2 {
+	if r == nil {
+		return nil
+	}
+	out := new(CreditorReferenceInformation2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CreditorReferenceInformation2) DeepCopyInto(out *CreditorReferenceInformation2) {
+	*out = *r
+	if r.Tp != nil {
+		out.Tp = new(CreditorReferenceType2)
+		r.Tp.DeepCopyInto(out.Tp)
+	}
+	if r.Ref != nil {
+		out.Ref = new(common.Max35Text)
+		*out.Ref = *r.Ref
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CreditorReferenceType1Choice) DeepCopy() *CreditorReferenceType1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(CreditorReferenceType1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CreditorReferenceType1Choice) DeepCopyInto(out *CreditorReferenceType1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CreditorReferenceType2) DeepCopy() *CreditorReferenceType2 {
+	if r == nil {
+		return nil
+	}
+	out := new(CreditorReferenceType2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CreditorReferenceType2) DeepCopyInto(out *CreditorReferenceType2) {
+	*out = *r
+	if r.Issr != nil {
+		out.Issr = new(common.Max35Text)
+		*out.Issr = *r.Issr
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CustomerAccount4) DeepCopy() *CustomerAccount4 {
+	if r == nil {
+		return nil
+	}
+	out := new(CustomerAccount4)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CustomerAccount4) DeepCopyInto(out *CustomerAccount4) {
+	*out = *r
+	if r.Id != nil {
+		out.Id = new(AccountIdentification4Choice)
+		r.Id.DeepCopyInto(out.Id)
+	}
+	if r.Nm != nil {
+		out.Nm = new(common.Max70Text)
+		*out.Nm = *r.Nm
+	}
+	if r.Sts != nil {
+		out.Sts = new(common.AccountStatus3Code)
+		*out.Sts = *r.Sts
+	}
+	if r.Tp != nil {
+		out.Tp = new(CashAccountType2Choice)
+		*out.Tp = *r.Tp
+	}
+	if r.MnthlyTxNb != nil {
+		out.MnthlyTxNb = new(common.Max5NumericText)
+		*out.MnthlyTxNb = *r.MnthlyTxNb
+	}
+	if r.AcctPurp != nil {
+		out.AcctPurp = new(common.Max140Text)
+		*out.AcctPurp = *r.AcctPurp
+	}
+	if r.StmtFrqcyAndFrmt != nil {
+		out.StmtFrqcyAndFrmt = make([]StatementFrequencyAndForm1, len(r.StmtFrqcyAndFrmt))
+		copy(out.StmtFrqcyAndFrmt, r.StmtFrqcyAndFrmt)
+	}
+	if r.ClsgDt != nil {
+		out.ClsgDt = new(common.ISODate)
+		*out.ClsgDt = *r.ClsgDt
+	}
+	if r.Rstrctn != nil {
+		out.Rstrctn = make([]Restriction1, len(r.Rstrctn))
+		for i := range r.Rstrctn {
+			r.Rstrctn[i].DeepCopyInto(&out.Rstrctn[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CustomerAccount5) DeepCopy() *CustomerAccount5 {
+	if r == nil {
+		return nil
+	}
+	out := new(CustomerAccount5)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CustomerAccount5) DeepCopyInto(out *CustomerAccount5) {
+	*out = *r
+	if r.Id != nil {
+		out.Id = make([]AccountIdentification4Choice, len(r.Id))
+		for i := range r.Id {
+			r.Id[i].DeepCopyInto(&out.Id[i])
+		}
+	}
+	if r.Nm != nil {
+		out.Nm = new(common.Max70Text)
+		*out.Nm = *r.Nm
+	}
+	if r.Sts != nil {
+		out.Sts = new(common.AccountStatus3Code)
+		*out.Sts = *r.Sts
+	}
+	if r.Tp != nil {
+		out.Tp = new(CashAccountType2Choice)
+		*out.Tp = *r.Tp
+	}
+	if r.MnthlyTxNb != nil {
+		out.MnthlyTxNb = new(common.Max5NumericText)
+		*out.MnthlyTxNb = *r.MnthlyTxNb
+	}
+	if r.AcctPurp != nil {
+		out.AcctPurp = new(common.Max140Text)
+		*out.AcctPurp = *r.AcctPurp
+	}
+	if r.StmtFrqcyAndFrmt != nil {
+		out.StmtFrqcyAndFrmt = make([]StatementFrequencyAndForm1, len(r.StmtFrqcyAndFrmt))
+		copy(out.StmtFrqcyAndFrmt, r.StmtFrqcyAndFrmt)
+	}
+	if r.ClsgDt != nil {
+		out.ClsgDt = new(common.ISODate)
+		*out.ClsgDt = *r.ClsgDt
+	}
+	if r.Rstrctn != nil {
+		out.Rstrctn = make([]Restriction1, len(r.Rstrctn))
+		for i := range r.Rstrctn {
+			r.Rstrctn[i].DeepCopyInto(&out.Rstrctn[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CustomerAccountModification1) DeepCopy() *CustomerAccountModification1 {
+	if r == nil {
+		return nil
+	}
+	out := new(CustomerAccountModification1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CustomerAccountModification1) DeepCopyInto(out *CustomerAccountModification1) {
+	*out = *r
+	if r.Id != nil {
+		out.Id = make([]AccountIdentification4Choice, len(r.Id))
+		for i := range r.Id {
+			r.Id[i].DeepCopyInto(&out.Id[i])
+		}
+	}
+	if r.Nm != nil {
+		out.Nm = new(NameModification1)
+		r.Nm.DeepCopyInto(out.Nm)
+	}
+	if r.Sts != nil {
+		out.Sts = new(AccountStatusModification1)
+		r.Sts.DeepCopyInto(out.Sts)
+	}
+	if r.Tp != nil {
+		out.Tp = new(TypeModification1)
+		r.Tp.DeepCopyInto(out.Tp)
+	}
+	if r.MnthlyPmtVal != nil {
+		out.MnthlyPmtVal = new(AmountModification1)
+		r.MnthlyPmtVal.DeepCopyInto(out.MnthlyPmtVal)
+	}
+	if r.MnthlyRcvdVal != nil {
+		out.MnthlyRcvdVal = new(AmountModification1)
+		r.MnthlyRcvdVal.DeepCopyInto(out.MnthlyRcvdVal)
+	}
+	if r.MnthlyTxNb != nil {
+		out.MnthlyTxNb = new(NumberModification1)
+		r.MnthlyTxNb.DeepCopyInto(out.MnthlyTxNb)
+	}
+	if r.AvrgBal != nil {
+		out.AvrgBal = new(AmountModification1)
+		r.AvrgBal.DeepCopyInto(out.AvrgBal)
+	}
+	if r.AcctPurp != nil {
+		out.AcctPurp = new(PurposeModification1)
+		r.AcctPurp.DeepCopyInto(out.AcctPurp)
+	}
+	if r.FlrNtfctnAmt != nil {
+		out.FlrNtfctnAmt = new(AmountModification1)
+		r.FlrNtfctnAmt.DeepCopyInto(out.FlrNtfctnAmt)
+	}
+	if r.ClngNtfctnAmt != nil {
+		out.ClngNtfctnAmt = new(AmountModification1)
+		r.ClngNtfctnAmt.DeepCopyInto(out.ClngNtfctnAmt)
+	}
+	if r.StmtFrqcyAndFrmt != nil {
+		out.StmtFrqcyAndFrmt = make([]StatementFrequencyAndFormModification1, len(r.StmtFrqcyAndFrmt))
+		for i := range r.StmtFrqcyAndFrmt {
+			r.StmtFrqcyAndFrmt[i].DeepCopyInto(&out.StmtFrqcyAndFrmt[i])
+		}
+	}
+	if r.ClsgDt != nil {
+		out.ClsgDt = new(DateModification1)
+		r.ClsgDt.DeepCopyInto(out.ClsgDt)
+	}
+	if r.Rstrctn != nil {
+		out.Rstrctn = make([]RestrictionModification1, len(r.Rstrctn))
+		for i := range r.Rstrctn {
+			r.Rstrctn[i].DeepCopyInto(&out.Rstrctn[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *DateAndPlaceOfBirth1) DeepCopy() *DateAndPlaceOfBirth1 {
+	if r == nil {
+		return nil
+	}
+	out := new(DateAndPlaceOfBirth1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *DateAndPlaceOfBirth1) DeepCopyInto(out *DateAndPlaceOfBirth1) {
+	*out = *r
+	if r.PrvcOfBirth != nil {
+		out.PrvcOfBirth = new(common.Max35Text)
+		*out.PrvcOfBirth = *r.PrvcOfBirth
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *DateModification1) DeepCopy() *DateModification1 {
+	if r == nil {
+		return nil
+	}
+	out := new(DateModification1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *DateModification1) DeepCopyInto(out *DateModification1) {
+	*out = *r
+	if r.ModCd != nil {
+		out.ModCd = new(Modification1Code)
+		*out.ModCd = *r.ModCd
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *DatePeriod2) DeepCopy() *DatePeriod2 {
+	if r == nil {
+		return nil
+	}
+	out := new(DatePeriod2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *DatePeriod2) DeepCopyInto(out *DatePeriod2) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *DirectDebitInstructionDetails2) DeepCopy() *DirectDebitInstructionDetails2 {
+	if r == nil {
+		return nil
+	}
+	out := new(DirectDebitInstructionDetails2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *DirectDebitInstructionDetails2) DeepCopyInto(out *DirectDebitInstructionDetails2) {
+	*out = *r
+	r.Cdtr.DeepCopyInto(&out.Cdtr)
+	if r.LastColltnCcyAmt != nil {
+		out.LastColltnCcyAmt = new(ActiveOrHistoricCurrencyAndAmount)
+		*out.LastColltnCcyAmt = *r.LastColltnCcyAmt
+	}
+	if r.LastColltnDt != nil {
+		out.LastColltnDt = new(common.ISODate)
+		*out.LastColltnDt = *r.LastColltnDt
+	}
+	if r.OthrDtls != nil {
+		out.OthrDtls = make([]TransferInstruction1, len(r.OthrDtls))
+		for i := range r.OthrDtls {
+			r.OthrDtls[i].DeepCopyInto(&out.OthrDtls[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *DiscountAmountAndType1) DeepCopy() *DiscountAmountAndType1 {
+	if r == nil {
+		return nil
+	}
+	out := new(DiscountAmountAndType1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *DiscountAmountAndType1) DeepCopyInto(out *DiscountAmountAndType1) {
+	*out = *r
+	if r.Tp != nil {
+		out.Tp = new(DiscountAmountType1Choice)
+		*out.Tp = *r.Tp
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *DiscountAmountType1Choice) DeepCopy() *DiscountAmountType1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(DiscountAmountType1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *DiscountAmountType1Choice) DeepCopyInto(out *DiscountAmountType1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *DocumentAdjustment1) DeepCopy() *DocumentAdjustment1 {
+	if r == nil {
+		return nil
+	}
+	out := new(DocumentAdjustment1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *DocumentAdjustment1) DeepCopyInto(out *DocumentAdjustment1) {
+	*out = *r
+	if r.CdtDbtInd != nil {
+		out.CdtDbtInd = new(common.CreditDebitCode)
+		*out.CdtDbtInd = *r.CdtDbtInd
+	}
+	if r.Rsn != nil {
+		out.Rsn = new(common.Max4Text)
+		*out.Rsn = *r.Rsn
+	}
+	if r.AddtlInf != nil {
+		out.AddtlInf = new(common.Max140Text)
+		*out.AddtlInf = *r.AddtlInf
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *DocumentLineIdentification1) DeepCopy() *DocumentLineIdentification1 {
+	if r == nil {
+		return nil
+	}
+	out := new(DocumentLineIdentification1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *DocumentLineIdentification1) DeepCopyInto(out *DocumentLineIdentification1) {
+	*out = *r
+	if r.Tp != nil {
+		out.Tp = new(DocumentLineType1)
+		r.Tp.DeepCopyInto(out.Tp)
+	}
+	if r.Nb != nil {
+		out.Nb = new(common.Max35Text)
+		*out.Nb = *r.Nb
+	}
+	if r.RltdDt != nil {
+		out.RltdDt = new(common.ISODate)
+		*out.RltdDt = *r.RltdDt
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *DocumentLineInformation1) DeepCopy() *DocumentLineInformation1 {
+	if r == nil {
+		return nil
+	}
+	out := new(DocumentLineInformation1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *DocumentLineInformation1) DeepCopyInto(out *DocumentLineInformation1) {
+	*out = *r
+	if r.Id != nil {
+		out.Id = make([]DocumentLineIdentification1, len(r.Id))
+		for i := range r.Id {
+			r.Id[i].DeepCopyInto(&out.Id[i])
+		}
+	}
+	if r.Desc != nil {
+		out.Desc = new(common.Max2048Text)
+		*out.Desc = *r.Desc
+	}
+	if r.Amt != nil {
+		out.Amt = new(RemittanceAmount3)
+		r.Amt.DeepCopyInto(out.Amt)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *DocumentLineType1) DeepCopy() *DocumentLineType1 {
+	if r == nil {
+		return nil
+	}
+	out := new(DocumentLineType1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *DocumentLineType1) DeepCopyInto(out *DocumentLineType1) {
+	*out = *r
+	if r.Issr != nil {
+		out.Issr = new(common.Max35Text)
+		*out.Issr = *r.Issr
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *DocumentLineType1Choice) DeepCopy() *DocumentLineType1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(DocumentLineType1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *DocumentLineType1Choice) DeepCopyInto(out *DocumentLineType1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *EndPoint1Choice) DeepCopy() *EndPoint1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(EndPoint1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *EndPoint1Choice) DeepCopyInto(out *EndPoint1Choice) {
+	*out = *r
+	if r.NbOfPmts != nil {
+		out.NbOfPmts = new(common.Max35Text)
+		*out.NbOfPmts = *r.NbOfPmts
+	}
+	if r.LastPmtDt != nil {
+		out.LastPmtDt = new(common.ISODate)
+		*out.LastPmtDt = *r.LastPmtDt
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *FinancialIdentificationSchemeName1Choice) DeepCopy() *FinancialIdentificationSchemeName1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(FinancialIdentificationSchemeName1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *FinancialIdentificationSchemeName1Choice) DeepCopyInto(out *FinancialIdentificationSchemeName1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *FinancialInstitutionIdentification18) DeepCopy() *FinancialInstitutionIdentification18 {
+	if r == nil {
+		return nil
+	}
+	out := new(FinancialInstitutionIdentification18)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *FinancialInstitutionIdentification18) DeepCopyInto(out *FinancialInstitutionIdentification18) {
+	*out = *r
+	if r.BICFI != nil {
+		out.BICFI = new(common.BICFIDec2014Identifier)
+		*out.BICFI = *r.BICFI
+	}
+	if r.ClrSysMmbId != nil {
+		out.ClrSysMmbId = new(ClearingSystemMemberIdentification2)
+		r.ClrSysMmbId.DeepCopyInto(out.ClrSysMmbId)
+	}
+	if r.LEI != nil {
+		out.LEI = new(common.LEIIdentifier)
+		*out.LEI = *r.LEI
+	}
+	if r.Nm != nil {
+		out.Nm = new(common.Max140Text)
+		*out.Nm = *r.Nm
+	}
+	if r.PstlAdr != nil {
+		out.PstlAdr = new(PostalAddress24)
+		r.PstlAdr.DeepCopyInto(out.PstlAdr)
+	}
+	if r.Othr != nil {
+		out.Othr = new(GenericFinancialIdentification1)
+		r.Othr.DeepCopyInto(out.Othr)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *FixedAmountOrUnlimited1Choice) DeepCopy() *FixedAmountOrUnlimited1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(FixedAmountOrUnlimited1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *FixedAmountOrUnlimited1Choice) DeepCopyInto(out *FixedAmountOrUnlimited1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *Frequency1) DeepCopy() *Frequency1 {
+	if r == nil {
+		return nil
+	}
+	out := new(Frequency1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *Frequency1) DeepCopyInto(out *Frequency1) {
+	*out = *r
+	if r.Seq != nil {
+		out.Seq = new(common.Max3NumericText)
+		*out.Seq = *r.Seq
+	}
+	r.EndPtChc.DeepCopyInto(&out.EndPtChc)
+	if r.ReqdFrqcyPttrn != nil {
+		out.ReqdFrqcyPttrn = new(Frequency37Choice)
+		*out.ReqdFrqcyPttrn = *r.ReqdFrqcyPttrn
+	}
+	if r.NonWorkgDayAdjstmnt != nil {
+		out.NonWorkgDayAdjstmnt = new(BusinessDayConvention1Code)
+		*out.NonWorkgDayAdjstmnt = *r.NonWorkgDayAdjstmnt
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *Frequency37Choice) DeepCopy() *Frequency37Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(Frequency37Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *Frequency37Choice) DeepCopyInto(out *Frequency37Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *FullLegalNameModification1) DeepCopy() *FullLegalNameModification1 {
+	if r == nil {
+		return nil
+	}
+	out := new(FullLegalNameModification1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *FullLegalNameModification1) DeepCopyInto(out *FullLegalNameModification1) {
+	*out = *r
+	if r.ModCd != nil {
+		out.ModCd = new(Modification1Code)
+		*out.ModCd = *r.ModCd
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *Garnishment3) DeepCopy() *Garnishment3 {
+	if r == nil {
+		return nil
+	}
+	out := new(Garnishment3)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *Garnishment3) DeepCopyInto(out *Garnishment3) {
+	*out = *r
+	r.Tp.DeepCopyInto(&out.Tp)
+	if r.Grnshee != nil {
+		out.Grnshee = new(PartyIdentification135)
+		r.Grnshee.DeepCopyInto(out.Grnshee)
+	}
+	if r.GrnshmtAdmstr != nil {
+		out.GrnshmtAdmstr = new(PartyIdentification135)
+		r.GrnshmtAdmstr.DeepCopyInto(out.GrnshmtAdmstr)
+	}
+	if r.RefNb != nil {
+		out.RefNb = new(common.Max140Text)
+		*out.RefNb = *r.RefNb
+	}
+	if r.Dt != nil {
+		out.Dt = new(common.ISODate)
+		*out.Dt = *r.Dt
+	}
+	if r.RmtdAmt != nil {
+		out.RmtdAmt = new(ActiveOrHistoricCurrencyAndAmount)
+		*out.RmtdAmt = *r.RmtdAmt
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *GarnishmentType1) DeepCopy() *GarnishmentType1 {
+	if r == nil {
+		return nil
+	}
+	out := new(GarnishmentType1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *GarnishmentType1) DeepCopyInto(out *GarnishmentType1) {
+	*out = *r
+	if r.Issr != nil {
+		out.Issr = new(common.Max35Text)
+		*out.Issr = *r.Issr
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *GarnishmentType1Choice) DeepCopy() *GarnishmentType1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(GarnishmentType1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *GarnishmentType1Choice) DeepCopyInto(out *GarnishmentType1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *GenericAccountIdentification1) DeepCopy() *GenericAccountIdentification1 {
+	if r == nil {
+		return nil
+	}
+	out := new(GenericAccountIdentification1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *GenericAccountIdentification1) DeepCopyInto(out *GenericAccountIdentification1) {
+	*out = *r
+	if r.SchmeNm != nil {
+		out.SchmeNm = new(AccountSchemeName1Choice)
+		*out.SchmeNm = *r.SchmeNm
+	}
+	if r.Issr != nil {
+		out.Issr = new(common.Max35Text)
+		*out.Issr = *r.Issr
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *GenericFinancialIdentification1) DeepCopy() *GenericFinancialIdentification1 {
+	if r == nil {
+		return nil
+	}
+	out := new(GenericFinancialIdentification1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *GenericFinancialIdentification1) DeepCopyInto(out *GenericFinancialIdentification1) {
+	*out = *r
+	if r.SchmeNm != nil {
+		out.SchmeNm = new(FinancialIdentificationSchemeName1Choice)
+		*out.SchmeNm = *r.SchmeNm
+	}
+	if r.Issr != nil {
+		out.Issr = new(common.Max35Text)
+		*out.Issr = *r.Issr
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *GenericIdentification13) DeepCopy() *GenericIdentification13 {
+	if r == nil {
+		return nil
+	}
+	out := new(GenericIdentification13)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *GenericIdentification13) DeepCopyInto(out *GenericIdentification13) {
+	*out = *r
+	if r.SchmeNm != nil {
+		out.SchmeNm = new(common.Max35Text)
+		*out.SchmeNm = *r.SchmeNm
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *GenericIdentification30) DeepCopy() *GenericIdentification30 {
+	if r == nil {
+		return nil
+	}
+	out := new(GenericIdentification30)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *GenericIdentification30) DeepCopyInto(out *GenericIdentification30) {
+	*out = *r
+	if r.SchmeNm != nil {
+		out.SchmeNm = new(common.Max35Text)
+		*out.SchmeNm = *r.SchmeNm
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *GenericIdentification44) DeepCopy() *GenericIdentification44 {
+	if r == nil {
+		return nil
+	}
+	out := new(GenericIdentification44)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *GenericIdentification44) DeepCopyInto(out *GenericIdentification44) {
+	*out = *r
+	r.Tp.DeepCopyInto(&out.Tp)
+	if r.Issr != nil {
+		out.Issr = new(common.Max35Text)
+		*out.Issr = *r.Issr
+	}
+	if r.IsseDt != nil {
+		out.IsseDt = new(common.ISODate)
+		*out.IsseDt = *r.IsseDt
+	}
+	if r.XpryDt != nil {
+		out.XpryDt = new(common.ISODate)
+		*out.XpryDt = *r.XpryDt
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *GenericIdentification47) DeepCopy() *GenericIdentification47 {
+	if r == nil {
+		return nil
+	}
+	out := new(GenericIdentification47)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *GenericIdentification47) DeepCopyInto(out *GenericIdentification47) {
+	*out = *r
+	if r.SchmeNm != nil {
+		out.SchmeNm = new(common.Max4AlphaNumericText)
+		*out.SchmeNm = *r.SchmeNm
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *GenericOrganisationIdentification1) DeepCopy() *GenericOrganisationIdentification1 {
+	if r == nil {
+		return nil
+	}
+	out := new(GenericOrganisationIdentification1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *GenericOrganisationIdentification1) DeepCopyInto(out *GenericOrganisationIdentification1) {
+	*out = *r
+	if r.SchmeNm != nil {
+		out.SchmeNm = new(OrganisationIdentificationSchemeName1Choice)
+		*out.SchmeNm = *r.SchmeNm
+	}
+	if r.Issr != nil {
+		out.Issr = new(common.Max35Text)
+		*out.Issr = *r.Issr
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *GenericPersonIdentification1) DeepCopy() *GenericPersonIdentification1 {
+	if r == nil {
+		return nil
+	}
+	out := new(GenericPersonIdentification1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *GenericPersonIdentification1) DeepCopyInto(out *GenericPersonIdentification1) {
+	*out = *r
+	if r.SchmeNm != nil {
+		out.SchmeNm = new(PersonIdentificationSchemeName1Choice)
+		*out.SchmeNm = *r.SchmeNm
+	}
+	if r.Issr != nil {
+		out.Issr = new(common.Max35Text)
+		*out.Issr = *r.Issr
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *Group3) DeepCopy() *Group3 {
+	if r == nil {
+		return nil
+	}
+	out := new(Group3)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *Group3) DeepCopyInto(out *Group3) {
+	*out = *r
+	if r.ModCd != nil {
+		out.ModCd = new(Modification1Code)
+		*out.ModCd = *r.ModCd
+	}
+	if r.Pty != nil {
+		out.Pty = make([]PartyAndCertificate5, len(r.Pty))
+		for i := range r.Pty {
+			r.Pty[i].DeepCopyInto(&out.Pty[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *Group4) DeepCopy() *Group4 {
+	if r == nil {
+		return nil
+	}
+	out := new(Group4)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *Group4) DeepCopyInto(out *Group4) {
+	*out = *r
+	if r.Pty != nil {
+		out.Pty = make([]PartyAndCertificate4, len(r.Pty))
+		for i := range r.Pty {
+			r.Pty[i].DeepCopyInto(&out.Pty[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *IndividualPerson36) DeepCopy() *IndividualPerson36 {
+	if r == nil {
+		return nil
+	}
+	out := new(IndividualPerson36)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *IndividualPerson36) DeepCopyInto(out *IndividualPerson36) {
+	*out = *r
+	r.CurNm.DeepCopyInto(&out.CurNm)
+	if r.PrvsNm != nil {
+		out.PrvsNm = make([]IndividualPersonNameLong2, len(r.PrvsNm))
+		for i := range r.PrvsNm {
+			r.PrvsNm[i].DeepCopyInto(&out.PrvsNm[i])
+		}
+	}
+	if r.Gndr != nil {
+		out.Gndr = new(Gender1Code)
+		*out.Gndr = *r.Gndr
+	}
+	if r.BirthDt != nil {
+		out.BirthDt = new(common.ISODate)
+		*out.BirthDt = *r.BirthDt
+	}
+	if r.CtryOfBirth != nil {
+		out.CtryOfBirth = new(common.CountryCode)
+		*out.CtryOfBirth = *r.CtryOfBirth
+	}
+	if r.PrvcOfBirth != nil {
+		out.PrvcOfBirth = new(common.Max35Text)
+		*out.PrvcOfBirth = *r.PrvcOfBirth
+	}
+	if r.CityOfBirth != nil {
+		out.CityOfBirth = new(common.Max35Text)
+		*out.CityOfBirth = *r.CityOfBirth
+	}
+	if r.TaxtnCtry != nil {
+		out.TaxtnCtry = new(common.CountryCode)
+		*out.TaxtnCtry = *r.TaxtnCtry
+	}
+	if r.CtryAndResdtlSts != nil {
+		out.CtryAndResdtlSts = new(CountryAndResidentialStatusType1)
+		*out.CtryAndResdtlSts = *r.CtryAndResdtlSts
+	}
+	if r.SclSctyNb != nil {
+		out.SclSctyNb = new(common.Max35Text)
+		*out.SclSctyNb = *r.SclSctyNb
+	}
+	if r.PstlAdr != nil {
+		out.PstlAdr = make([]PostalAddress24, len(r.PstlAdr))
+		for i := range r.PstlAdr {
+			r.PstlAdr[i].DeepCopyInto(&out.PstlAdr[i])
+		}
+	}
+	if r.CtznshInf != nil {
+		out.CtznshInf = make([]CitizenshipInformation1, len(r.CtznshInf))
+		for i := range r.CtznshInf {
+			r.CtznshInf[i].DeepCopyInto(&out.CtznshInf[i])
+		}
+	}
+	if r.PmryComAdr != nil {
+		out.PmryComAdr = new(CommunicationAddress3)
+		r.PmryComAdr.DeepCopyInto(out.PmryComAdr)
+	}
+	if r.ScndryComAdr != nil {
+		out.ScndryComAdr = new(CommunicationAddress3)
+		r.ScndryComAdr.DeepCopyInto(out.ScndryComAdr)
+	}
+	if r.OthrId != nil {
+		out.OthrId = make([]GenericIdentification44, len(r.OthrId))
+		for i := range r.OthrId {
+			r.OthrId[i].DeepCopyInto(&out.OthrId[i])
+		}
+	}
+	if r.OthrDtls != nil {
+		out.OthrDtls = make([]TransferInstruction1, len(r.OthrDtls))
+		for i := range r.OthrDtls {
+			r.OthrDtls[i].DeepCopyInto(&out.OthrDtls[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *IndividualPersonNameLong2) DeepCopy() *IndividualPersonNameLong2 {
+	if r == nil {
+		return nil
+	}
+	out := new(IndividualPersonNameLong2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *IndividualPersonNameLong2) DeepCopyInto(out *IndividualPersonNameLong2) {
+	*out = *r
+	if r.NmPrfx != nil {
+		out.NmPrfx = new(common.NamePrefix2Code)
+		*out.NmPrfx = *r.NmPrfx
+	}
+	if r.GvnNm != nil {
+		out.GvnNm = new(common.Max35Text)
+		*out.GvnNm = *r.GvnNm
+	}
+	if r.MddlNm != nil {
+		out.MddlNm = new(common.Max35Text)
+		*out.MddlNm = *r.MddlNm
+	}
+	if r.Initls != nil {
+		out.Initls = new(common.Max6Text)
+		*out.Initls = *r.Initls
+	}
+	if r.NmSfx != nil {
+		out.NmSfx = new(common.Max350Text)
+		*out.NmSfx = *r.NmSfx
+	}
+	if r.Nm != nil {
+		out.Nm = new(common.Max350Text)
+		*out.Nm = *r.Nm
+	}
+	if r.StartDt != nil {
+		out.StartDt = new(common.ISODate)
+		*out.StartDt = *r.StartDt
+	}
+	if r.EndDt != nil {
+		out.EndDt = new(common.ISODate)
+		*out.EndDt = *r.EndDt
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *InstructionForCreditorAgent3) DeepCopy() *InstructionForCreditorAgent3 {
+	if r == nil {
+		return nil
+	}
+	out := new(InstructionForCreditorAgent3)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *InstructionForCreditorAgent3) DeepCopyInto(out *InstructionForCreditorAgent3) {
+	*out = *r
+	if r.Cd != nil {
+		out.Cd = new(ExternalCreditorAgentInstruction1Code)
+		*out.Cd = *r.Cd
+	}
+	if r.InstrInf != nil {
+		out.InstrInf = new(common.Max140Text)
+		*out.InstrInf = *r.InstrInf
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *LocalInstrument2Choice) DeepCopy() *LocalInstrument2Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(LocalInstrument2Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *LocalInstrument2Choice) DeepCopyInto(out *LocalInstrument2Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *MaximumAmountByPeriod1) DeepCopy() *MaximumAmountByPeriod1 {
+	if r == nil {
+		return nil
+	}
+	out := new(MaximumAmountByPeriod1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *MaximumAmountByPeriod1) DeepCopyInto(out *MaximumAmountByPeriod1) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *MessageIdentification1) DeepCopy() *MessageIdentification1 {
+	if r == nil {
+		return nil
+	}
+	out := new(MessageIdentification1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *MessageIdentification1) DeepCopyInto(out *MessageIdentification1) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *NameAndAddress16) DeepCopy() *NameAndAddress16 {
+	if r == nil {
+		return nil
+	}
+	out := new(NameAndAddress16)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *NameAndAddress16) DeepCopyInto(out *NameAndAddress16) {
+	*out = *r
+	r.Adr.DeepCopyInto(&out.Adr)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *NameModification1) DeepCopy() *NameModification1 {
+	if r == nil {
+		return nil
+	}
+	out := new(NameModification1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *NameModification1) DeepCopyInto(out *NameModification1) {
+	*out = *r
+	if r.ModCd != nil {
+		out.ModCd = new(Modification1Code)
+		*out.ModCd = *r.ModCd
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *NewAccount2) DeepCopy() *NewAccount2 {
+	if r == nil {
+		return nil
+	}
+	out := new(NewAccount2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *NewAccount2) DeepCopyInto(out *NewAccount2) {
+	*out = *r
+	r.Acct.DeepCopyInto(&out.Acct)
+	if r.AcctPty != nil {
+		out.AcctPty = make([]IndividualPerson36, len(r.AcctPty))
+		for i := range r.AcctPty {
+			r.AcctPty[i].DeepCopyInto(&out.AcctPty[i])
+		}
+	}
+	if r.Org != nil {
+		out.Org = new(Organisation35)
+		r.Org.DeepCopyInto(out.Org)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *NumberModification1) DeepCopy() *NumberModification1 {
+	if r == nil {
+		return nil
+	}
+	out := new(NumberModification1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *NumberModification1) DeepCopyInto(out *NumberModification1) {
+	*out = *r
+	if r.ModCd != nil {
+		out.ModCd = new(Modification1Code)
+		*out.ModCd = *r.ModCd
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *OperationMandate4) DeepCopy() *OperationMandate4 {
+	if r == nil {
+		return nil
+	}
+	out := new(OperationMandate4)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *OperationMandate4) DeepCopyInto(out *OperationMandate4) {
+	*out = *r
+	if r.AplblChanl != nil {
+		out.AplblChanl = make([]Channel2Choice, len(r.AplblChanl))
+		copy(out.AplblChanl, r.AplblChanl)
+	}
+	if r.MndtHldr != nil {
+		out.MndtHldr = make([]PartyAndAuthorisation4, len(r.MndtHldr))
+		for i := range r.MndtHldr {
+			r.MndtHldr[i].DeepCopyInto(&out.MndtHldr[i])
+		}
+	}
+	if r.BkOpr != nil {
+		out.BkOpr = make([]BankTransactionCodeStructure4, len(r.BkOpr))
+		for i := range r.BkOpr {
+			r.BkOpr[i].DeepCopyInto(&out.BkOpr[i])
+		}
+	}
+	if r.StartDt != nil {
+		out.StartDt = new(common.ISODate)
+		*out.StartDt = *r.StartDt
+	}
+	if r.EndDt != nil {
+		out.EndDt = new(common.ISODate)
+		*out.EndDt = *r.EndDt
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *OperationMandate5) DeepCopy() *OperationMandate5 {
+	if r == nil {
+		return nil
+	}
+	out := new(OperationMandate5)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *OperationMandate5) DeepCopyInto(out *OperationMandate5) {
+	*out = *r
+	if r.ModCd != nil {
+		out.ModCd = new(Modification1Code)
+		*out.ModCd = *r.ModCd
+	}
+	if r.AplblChanl != nil {
+		out.AplblChanl = make([]Channel2Choice, len(r.AplblChanl))
+		copy(out.AplblChanl, r.AplblChanl)
+	}
+	if r.MndtHldr != nil {
+		out.MndtHldr = make([]PartyAndAuthorisation5, len(r.MndtHldr))
+		for i := range r.MndtHldr {
+			r.MndtHldr[i].DeepCopyInto(&out.MndtHldr[i])
+		}
+	}
+	if r.BkOpr != nil {
+		out.BkOpr = make([]BankTransactionCodeStructure4, len(r.BkOpr))
+		for i := range r.BkOpr {
+			r.BkOpr[i].DeepCopyInto(&out.BkOpr[i])
+		}
+	}
+	if r.StartDt != nil {
+		out.StartDt = new(common.ISODate)
+		*out.StartDt = *r.StartDt
+	}
+	if r.EndDt != nil {
+		out.EndDt = new(common.ISODate)
+		*out.EndDt = *r.EndDt
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *Organisation33) DeepCopy() *Organisation33 {
+	if r == nil {
+		return nil
+	}
+	out := new(Organisation33)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *Organisation33) DeepCopyInto(out *Organisation33) {
+	*out = *r
+	if r.TradgNm != nil {
+		out.TradgNm = new(common.Max350Text)
+		*out.TradgNm = *r.TradgNm
+	}
+	if r.RegnDt != nil {
+		out.RegnDt = new(common.ISODate)
+		*out.RegnDt = *r.RegnDt
+	}
+	if r.OprlAdr != nil {
+		out.OprlAdr = new(PostalAddress24)
+		r.OprlAdr.DeepCopyInto(out.OprlAdr)
+	}
+	if r.BizAdr != nil {
+		out.BizAdr = new(PostalAddress24)
+		r.BizAdr.DeepCopyInto(out.BizAdr)
+	}
+	r.LglAdr.DeepCopyInto(&out.LglAdr)
+	if r.BllgAdr != nil {
+		out.BllgAdr = new(PostalAddress24)
+		r.BllgAdr.DeepCopyInto(out.BllgAdr)
+	}
+	r.OrgId.DeepCopyInto(&out.OrgId)
+	if r.RprtvOffcr != nil {
+		out.RprtvOffcr = make([]PartyIdentification137, len(r.RprtvOffcr))
+		for i := range r.RprtvOffcr {
+			r.RprtvOffcr[i].DeepCopyInto(&out.RprtvOffcr[i])
+		}
+	}
+	if r.TrsrMgr != nil {
+		out.TrsrMgr = new(PartyIdentification137)
+		r.TrsrMgr.DeepCopyInto(out.TrsrMgr)
+	}
+	if r.MainMndtHldr != nil {
+		out.MainMndtHldr = make([]PartyIdentification137, len(r.MainMndtHldr))
+		for i := range r.MainMndtHldr {
+			r.MainMndtHldr[i].DeepCopyInto(&out.MainMndtHldr[i])
+		}
+	}
+	if r.Sndr != nil {
+		out.Sndr = make([]PartyIdentification137, len(r.Sndr))
+		for i := range r.Sndr {
+			r.Sndr[i].DeepCopyInto(&out.Sndr[i])
+		}
+	}
+	if r.LglRprtv != nil {
+		out.LglRprtv = make([]PartyIdentification137, len(r.LglRprtv))
+		for i := range r.LglRprtv {
+			r.LglRprtv[i].DeepCopyInto(&out.LglRprtv[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *Organisation34) DeepCopy() *Organisation34 {
+	if r == nil {
+		return nil
+	}
+	out := new(Organisation34)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *Organisation34) DeepCopyInto(out *Organisation34) {
+	*out = *r
+	if r.FullLglNm != nil {
+		out.FullLglNm = new(common.Max350Text)
+		*out.FullLglNm = *r.FullLglNm
+	}
+	r.OrgId.DeepCopyInto(&out.OrgId)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *Organisation35) DeepCopy() *Organisation35 {
+	if r == nil {
+		return nil
+	}
+	out := new(Organisation35)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *Organisation35) DeepCopyInto(out *Organisation35) {
+	*out = *r
+	if r.TradgNm != nil {
+		out.TradgNm = new(common.Max350Text)
+		*out.TradgNm = *r.TradgNm
+	}
+	if r.OrgLglSts != nil {
+		out.OrgLglSts = new(OrganisationLegalStatus1Code)
+		*out.OrgLglSts = *r.OrgLglSts
+	}
+	if r.EstblishdDt != nil {
+		out.EstblishdDt = new(common.ISODate)
+		*out.EstblishdDt = *r.EstblishdDt
+	}
+	if r.RegnNb != nil {
+		out.RegnNb = new(common.Max70Text)
+		*out.RegnNb = *r.RegnNb
+	}
+	if r.RegnCtry != nil {
+		out.RegnCtry = new(common.CountryCode)
+		*out.RegnCtry = *r.RegnCtry
+	}
+	if r.RegnDt != nil {
+		out.RegnDt = new(common.ISODate)
+		*out.RegnDt = *r.RegnDt
+	}
+	if r.TaxtnIdNb != nil {
+		out.TaxtnIdNb = new(common.Max35Text)
+		*out.TaxtnIdNb = *r.TaxtnIdNb
+	}
+	if r.TaxtnCtry != nil {
+		out.TaxtnCtry = new(common.CountryCode)
+		*out.TaxtnCtry = *r.TaxtnCtry
+	}
+	if r.CtryOfOpr != nil {
+		out.CtryOfOpr = new(common.CountryCode)
+		*out.CtryOfOpr = *r.CtryOfOpr
+	}
+	if r.BizAdr != nil {
+		out.BizAdr = new(PostalAddress24)
+		r.BizAdr.DeepCopyInto(out.BizAdr)
+	}
+	if r.OprlAdr != nil {
+		out.OprlAdr = new(PostalAddress24)
+		r.OprlAdr.DeepCopyInto(out.OprlAdr)
+	}
+	if r.LglAdr != nil {
+		out.LglAdr = new(PostalAddress24)
+		r.LglAdr.DeepCopyInto(out.LglAdr)
+	}
+	if r.RprtvOffcr != nil {
+		out.RprtvOffcr = make([]PartyIdentification135, len(r.RprtvOffcr))
+		for i := range r.RprtvOffcr {
+			r.RprtvOffcr[i].DeepCopyInto(&out.RprtvOffcr[i])
+		}
+	}
+	if r.TrsrMgr != nil {
+		out.TrsrMgr = new(PartyIdentification135)
+		r.TrsrMgr.DeepCopyInto(out.TrsrMgr)
+	}
+	if r.MainMndtHldr != nil {
+		out.MainMndtHldr = make([]PartyIdentification135, len(r.MainMndtHldr))
+		for i := range r.MainMndtHldr {
+			r.MainMndtHldr[i].DeepCopyInto(&out.MainMndtHldr[i])
+		}
+	}
+	if r.Sndr != nil {
+		out.Sndr = make([]PartyIdentification135, len(r.Sndr))
+		for i := range r.Sndr {
+			r.Sndr[i].DeepCopyInto(&out.Sndr[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *OrganisationIdentification29) DeepCopy() *OrganisationIdentification29 {
+	if r == nil {
+		return nil
+	}
+	out := new(OrganisationIdentification29)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *OrganisationIdentification29) DeepCopyInto(out *OrganisationIdentification29) {
+	*out = *r
+	if r.AnyBIC != nil {
+		out.AnyBIC = new(common.AnyBICDec2014Identifier)
+		*out.AnyBIC = *r.AnyBIC
+	}
+	if r.LEI != nil {
+		out.LEI = new(common.LEIIdentifier)
+		*out.LEI = *r.LEI
+	}
+	if r.Othr != nil {
+		out.Othr = make([]GenericOrganisationIdentification1, len(r.Othr))
+		for i := range r.Othr {
+			r.Othr[i].DeepCopyInto(&out.Othr[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *OrganisationIdentificationSchemeName1Choice) DeepCopy() *OrganisationIdentificationSchemeName1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(OrganisationIdentificationSchemeName1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *OrganisationIdentificationSchemeName1Choice) DeepCopyInto(out *OrganisationIdentificationSchemeName1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *OrganisationModification2) DeepCopy() *OrganisationModification2 {
+	if r == nil {
+		return nil
+	}
+	out := new(OrganisationModification2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *OrganisationModification2) DeepCopyInto(out *OrganisationModification2) {
+	*out = *r
+	r.FullLglNm.DeepCopyInto(&out.FullLglNm)
+	if r.TradgNm != nil {
+		out.TradgNm = new(TradingNameModification1)
+		r.TradgNm.DeepCopyInto(out.TradgNm)
+	}
+	if r.RegnDt != nil {
+		out.RegnDt = new(common.ISODate)
+		*out.RegnDt = *r.RegnDt
+	}
+	if r.OprlAdr != nil {
+		out.OprlAdr = new(AddressModification2)
+		r.OprlAdr.DeepCopyInto(out.OprlAdr)
+	}
+	if r.BizAdr != nil {
+		out.BizAdr = new(AddressModification2)
+		r.BizAdr.DeepCopyInto(out.BizAdr)
+	}
+	r.LglAdr.DeepCopyInto(&out.LglAdr)
+	if r.BllgAdr != nil {
+		out.BllgAdr = new(AddressModification2)
+		r.BllgAdr.DeepCopyInto(out.BllgAdr)
+	}
+	r.OrgId.DeepCopyInto(&out.OrgId)
+	if r.RprtvOffcr != nil {
+		out.RprtvOffcr = make([]PartyModification2, len(r.RprtvOffcr))
+		for i := range r.RprtvOffcr {
+			r.RprtvOffcr[i].DeepCopyInto(&out.RprtvOffcr[i])
+		}
+	}
+	if r.TrsrMgr != nil {
+		out.TrsrMgr = new(PartyModification2)
+		r.TrsrMgr.DeepCopyInto(out.TrsrMgr)
+	}
+	if r.MainMndtHldr != nil {
+		out.MainMndtHldr = make([]PartyModification2, len(r.MainMndtHldr))
+		for i := range r.MainMndtHldr {
+			r.MainMndtHldr[i].DeepCopyInto(&out.MainMndtHldr[i])
+		}
+	}
+	if r.Sndr != nil {
+		out.Sndr = make([]PartyModification2, len(r.Sndr))
+		for i := range r.Sndr {
+			r.Sndr[i].DeepCopyInto(&out.Sndr[i])
+		}
+	}
+	if r.LglRprtv != nil {
+		out.LglRprtv = make([]PartyModification2, len(r.LglRprtv))
+		for i := range r.LglRprtv {
+			r.LglRprtv[i].DeepCopyInto(&out.LglRprtv[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *OtherContact1) DeepCopy() *OtherContact1 {
+	if r == nil {
+		return nil
+	}
+	out := new(OtherContact1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *OtherContact1) DeepCopyInto(out *OtherContact1) {
+	*out = *r
+	if r.Id != nil {
+		out.Id = new(common.Max128Text)
+		*out.Id = *r.Id
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *OtherIdentification1Choice) DeepCopy() *OtherIdentification1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(OtherIdentification1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *OtherIdentification1Choice) DeepCopyInto(out *OtherIdentification1Choice) {
+	*out = *r
+	r.Prtry.DeepCopyInto(&out.Prtry)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *Party38Choice) DeepCopy() *Party38Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(Party38Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *Party38Choice) DeepCopyInto(out *Party38Choice) {
+	*out = *r
+	r.OrgId.DeepCopyInto(&out.OrgId)
+	r.PrvtId.DeepCopyInto(&out.PrvtId)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *PartyAndAuthorisation4) DeepCopy() *PartyAndAuthorisation4 {
+	if r == nil {
+		return nil
+	}
+	out := new(PartyAndAuthorisation4)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *PartyAndAuthorisation4) DeepCopyInto(out *PartyAndAuthorisation4) {
+	*out = *r
+	r.PtyOrGrp.DeepCopyInto(&out.PtyOrGrp)
+	if r.SgntrOrdr != nil {
+		out.SgntrOrdr = new(common.Max15PlusSignedNumericText)
+		*out.SgntrOrdr = *r.SgntrOrdr
+	}
+	r.Authstn.DeepCopyInto(&out.Authstn)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *PartyAndAuthorisation5) DeepCopy() *PartyAndAuthorisation5 {
+	if r == nil {
+		return nil
+	}
+	out := new(PartyAndAuthorisation5)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *PartyAndAuthorisation5) DeepCopyInto(out *PartyAndAuthorisation5) {
+	*out = *r
+	if r.ModCd != nil {
+		out.ModCd = new(Modification1Code)
+		*out.ModCd = *r.ModCd
+	}
+	r.PtyOrGrp.DeepCopyInto(&out.PtyOrGrp)
+	if r.SgntrOrdr != nil {
+		out.SgntrOrdr = new(common.Max15PlusSignedNumericText)
+		*out.SgntrOrdr = *r.SgntrOrdr
+	}
+	r.Authstn.DeepCopyInto(&out.Authstn)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *PartyAndCertificate4) DeepCopy() *PartyAndCertificate4 {
+	if r == nil {
+		return nil
+	}
+	out := new(PartyAndCertificate4)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *PartyAndCertificate4) DeepCopyInto(out *PartyAndCertificate4) {
+	*out = *r
+	r.Pty.DeepCopyInto(&out.Pty)
+	if r.Cert != nil {
+		out.Cert = new(common.Max10KBinary)
+		*out.Cert = *r.Cert
+		if (*r.Cert) != nil {
+			(*out.Cert) = make(common.Max10KBinary, len((*r.Cert)))
+			copy((*out.Cert), (*r.Cert))
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *PartyAndCertificate5) DeepCopy() *PartyAndCertificate5 {
+	if r == nil {
+		return nil
+	}
+	out := new(PartyAndCertificate5)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *PartyAndCertificate5) DeepCopyInto(out *PartyAndCertificate5) {
+	*out = *r
+	if r.ModCd != nil {
+		out.ModCd = new(Modification1Code)
+		*out.ModCd = *r.ModCd
+	}
+	r.Pty.DeepCopyInto(&out.Pty)
+	if r.Cert != nil {
+		out.Cert = new(common.Max10KBinary)
+		*out.Cert = *r.Cert
+		if (*r.Cert) != nil {
+			(*out.Cert) = make(common.Max10KBinary, len((*r.Cert)))
+			copy((*out.Cert), (*r.Cert))
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *PartyAndSignature3) DeepCopy() *PartyAndSignature3 {
+	if r == nil {
+		return nil
+	}
+	out := new(PartyAndSignature3)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *PartyAndSignature3) DeepCopyInto(out *PartyAndSignature3) {
+	*out = *r
+	r.Pty.DeepCopyInto(&out.Pty)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *PartyIdentification135) DeepCopy() *PartyIdentification135 {
+	if r == nil {
+		return nil
+	}
+	out := new(PartyIdentification135)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *PartyIdentification135) DeepCopyInto(out *PartyIdentification135) {
+	*out = *r
+	if r.Nm != nil {
+		out.Nm = new(common.Max140Text)
+		*out.Nm = *r.Nm
+	}
+	if r.PstlAdr != nil {
+		out.PstlAdr = new(PostalAddress24)
+		r.PstlAdr.DeepCopyInto(out.PstlAdr)
+	}
+	if r.Id != nil {
+		out.Id = new(Party38Choice)
+		r.Id.DeepCopyInto(out.Id)
+	}
+	if r.CtryOfRes != nil {
+		out.CtryOfRes = new(common.CountryCode)
+		*out.CtryOfRes = *r.CtryOfRes
+	}
+	if r.CtctDtls != nil {
+		out.CtctDtls = new(Contact4)
+		r.CtctDtls.DeepCopyInto(out.CtctDtls)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *PartyIdentification137) DeepCopy() *PartyIdentification137 {
+	if r == nil {
+		return nil
+	}
+	out := new(PartyIdentification137)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *PartyIdentification137) DeepCopyInto(out *PartyIdentification137) {
+	*out = *r
+	if r.Nm != nil {
+		out.Nm = new(common.Max140Text)
+		*out.Nm = *r.Nm
+	}
+	if r.PstlAdr != nil {
+		out.PstlAdr = new(PostalAddress24)
+		r.PstlAdr.DeepCopyInto(out.PstlAdr)
+	}
+	if r.Id != nil {
+		out.Id = new(PersonIdentification13)
+		r.Id.DeepCopyInto(out.Id)
+	}
+	if r.CtryOfRes != nil {
+		out.CtryOfRes = new(common.CountryCode)
+		*out.CtryOfRes = *r.CtryOfRes
+	}
+	if r.CtctDtls != nil {
+		out.CtctDtls = new(Contact4)
+		r.CtctDtls.DeepCopyInto(out.CtctDtls)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *PartyModification2) DeepCopy() *PartyModification2 {
+	if r == nil {
+		return nil
+	}
+	out := new(PartyModification2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *PartyModification2) DeepCopyInto(out *PartyModification2) {
+	*out = *r
+	if r.ModCd != nil {
+		out.ModCd = new(Modification1Code)
+		*out.ModCd = *r.ModCd
+	}
+	r.PtyId.DeepCopyInto(&out.PtyId)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *PartyOrGroup2Choice) DeepCopy() *PartyOrGroup2Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(PartyOrGroup2Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *PartyOrGroup2Choice) DeepCopyInto(out *PartyOrGroup2Choice) {
+	*out = *r
+	r.Pty.DeepCopyInto(&out.Pty)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *PaymentIdentification6) DeepCopy() *PaymentIdentification6 {
+	if r == nil {
+		return nil
+	}
+	out := new(PaymentIdentification6)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *PaymentIdentification6) DeepCopyInto(out *PaymentIdentification6) {
+	*out = *r
+	if r.InstrId != nil {
+		out.InstrId = new(common.Max35Text)
+		*out.InstrId = *r.InstrId
+	}
+	if r.UETR != nil {
+		out.UETR = new(common.UUIDv4Identifier)
+		*out.UETR = *r.UETR
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *PaymentInstruction36) DeepCopy() *PaymentInstruction36 {
+	if r == nil {
+		return nil
+	}
+	out := new(PaymentInstruction36)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *PaymentInstruction36) DeepCopyInto(out *PaymentInstruction36) {
+	*out = *r
+	if r.NbOfTxs != nil {
+		out.NbOfTxs = new(common.Max15NumericText)
+		*out.NbOfTxs = *r.NbOfTxs
+	}
+	if r.PmtTpInf != nil {
+		out.PmtTpInf = new(PaymentTypeInformation26)
+		r.PmtTpInf.DeepCopyInto(out.PmtTpInf)
+	}
+	if r.PoolgAdjstmntDt != nil {
+		out.PoolgAdjstmntDt = new(common.ISODate)
+		*out.PoolgAdjstmntDt = *r.PoolgAdjstmntDt
+	}
+	r.Dbtr.DeepCopyInto(&out.Dbtr)
+	r.DbtrAcct.DeepCopyInto(&out.DbtrAcct)
+	r.DbtrAgt.DeepCopyInto(&out.DbtrAgt)
+	if r.DbtrAgtAcct != nil {
+		out.DbtrAgtAcct = new(CashAccount38)
+		r.DbtrAgtAcct.DeepCopyInto(out.DbtrAgtAcct)
+	}
+	if r.InstrForDbtrAgt != nil {
+		out.InstrForDbtrAgt = new(common.Max140Text)
+		*out.InstrForDbtrAgt = *r.InstrForDbtrAgt
+	}
+	if r.UltmtDbtr != nil {
+		out.UltmtDbtr = new(PartyIdentification135)
+		r.UltmtDbtr.DeepCopyInto(out.UltmtDbtr)
+	}
+	if r.ChrgBr != nil {
+		out.ChrgBr = new(ChargeBearerType1Code)
+		*out.ChrgBr = *r.ChrgBr
+	}
+	if r.ChrgsAcct != nil {
+		out.ChrgsAcct = new(CashAccount38)
+		r.ChrgsAcct.DeepCopyInto(out.ChrgsAcct)
+	}
+	if r.ChrgsAcctAgt != nil {
+		out.ChrgsAcctAgt = new(BranchAndFinancialInstitutionIdentification6)
+		r.ChrgsAcctAgt.DeepCopyInto(out.ChrgsAcctAgt)
+	}
+	if r.CdtTrfTxInf != nil {
+		out.CdtTrfTxInf = make([]CreditTransferTransaction41, len(r.CdtTrfTxInf))
+		for i := range r.CdtTrfTxInf {
+			r.CdtTrfTxInf[i].DeepCopyInto(&out.CdtTrfTxInf[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *PaymentTypeInformation26) DeepCopy() *PaymentTypeInformation26 {
+	if r == nil {
+		return nil
+	}
+	out := new(PaymentTypeInformation26)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *PaymentTypeInformation26) DeepCopyInto(out *PaymentTypeInformation26) {
+	*out = *r
+	if r.InstrPrty != nil {
+		out.InstrPrty = new(Priority2Code)
+		*out.InstrPrty = *r.InstrPrty
+	}
+	if r.SvcLvl != nil {
+		out.SvcLvl = make([]ServiceLevel8Choice, len(r.SvcLvl))
+		copy(out.SvcLvl, r.SvcLvl)
+	}
+	if r.LclInstrm != nil {
+		out.LclInstrm = new(LocalInstrument2Choice)
+		*out.LclInstrm = *r.LclInstrm
+	}
+	if r.CtgyPurp != nil {
+		out.CtgyPurp = new(CategoryPurpose1Choice)
+		*out.CtgyPurp = *r.CtgyPurp
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *PersonIdentification13) DeepCopy() *PersonIdentification13 {
+	if r == nil {
+		return nil
+	}
+	out := new(PersonIdentification13)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *PersonIdentification13) DeepCopyInto(out *PersonIdentification13) {
+	*out = *r
+	if r.DtAndPlcOfBirth != nil {
+		out.DtAndPlcOfBirth = new(DateAndPlaceOfBirth1)
+		r.DtAndPlcOfBirth.DeepCopyInto(out.DtAndPlcOfBirth)
+	}
+	if r.Othr != nil {
+		out.Othr = make([]GenericPersonIdentification1, len(r.Othr))
+		for i := range r.Othr {
+			r.Othr[i].DeepCopyInto(&out.Othr[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *PersonIdentificationSchemeName1Choice) DeepCopy() *PersonIdentificationSchemeName1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(PersonIdentificationSchemeName1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *PersonIdentificationSchemeName1Choice) DeepCopyInto(out *PersonIdentificationSchemeName1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *PostalAddress24) DeepCopy() *PostalAddress24 {
+	if r == nil {
+		return nil
+	}
+	out := new(PostalAddress24)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *PostalAddress24) DeepCopyInto(out *PostalAddress24) {
+	*out = *r
+	if r.AdrTp != nil {
+		out.AdrTp = new(AddressType3Choice)
+		r.AdrTp.DeepCopyInto(out.AdrTp)
+	}
+	if r.Dept != nil {
+		out.Dept = new(common.Max70Text)
+		*out.Dept = *r.Dept
+	}
+	if r.SubDept != nil {
+		out.SubDept = new(common.Max70Text)
+		*out.SubDept = *r.SubDept
+	}
+	if r.StrtNm != nil {
+		out.StrtNm = new(common.Max70Text)
+		*out.StrtNm = *r.StrtNm
+	}
+	if r.BldgNb != nil {
+		out.BldgNb = new(common.Max16Text)
+		*out.BldgNb = *r.BldgNb
+	}
+	if r.BldgNm != nil {
+		out.BldgNm = new(common.Max35Text)
+		*out.BldgNm = *r.BldgNm
+	}
+	if r.Flr != nil {
+		out.Flr = new(common.Max70Text)
+		*out.Flr = *r.Flr
+	}
+	if r.PstBx != nil {
+		out.PstBx = new(common.Max16Text)
+		*out.PstBx = *r.PstBx
+	}
+	if r.Room != nil {
+		out.Room = new(common.Max70Text)
+		*out.Room = *r.Room
+	}
+	if r.PstCd != nil {
+		out.PstCd = new(common.Max16Text)
+		*out.PstCd = *r.PstCd
+	}
+	if r.TwnNm != nil {
+		out.TwnNm = new(common.Max35Text)
+		*out.TwnNm = *r.TwnNm
+	}
+	if r.TwnLctnNm != nil {
+		out.TwnLctnNm = new(common.Max35Text)
+		*out.TwnLctnNm = *r.TwnLctnNm
+	}
+	if r.DstrctNm != nil {
+		out.DstrctNm = new(common.Max35Text)
+		*out.DstrctNm = *r.DstrctNm
+	}
+	if r.CtrySubDvsn != nil {
+		out.CtrySubDvsn = new(common.Max35Text)
+		*out.CtrySubDvsn = *r.CtrySubDvsn
+	}
+	if r.Ctry != nil {
+		out.Ctry = new(common.CountryCode)
+		*out.Ctry = *r.Ctry
+	}
+	if r.AdrLine != nil {
+		out.AdrLine = make([]common.Max70Text, len(r.AdrLine))
+		copy(out.AdrLine, r.AdrLine)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ProprietaryBankTransactionCodeStructure1) DeepCopy() *ProprietaryBankTransactionCodeStructure1 {
+	if r == nil {
+		return nil
+	}
+	out := new(ProprietaryBankTransactionCodeStructure1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ProprietaryBankTransactionCodeStructure1) DeepCopyInto(out *ProprietaryBankTransactionCodeStructure1) {
+	*out = *r
+	if r.Issr != nil {
+		out.Issr = new(common.Max35Text)
+		*out.Issr = *r.Issr
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ProxyAccountIdentification1) DeepCopy() *ProxyAccountIdentification1 {
+	if r == nil {
+		return nil
+	}
+	out := new(ProxyAccountIdentification1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ProxyAccountIdentification1) DeepCopyInto(out *ProxyAccountIdentification1) {
+	*out = *r
+	if r.Tp != nil {
+		out.Tp = new(ProxyAccountType1Choice)
+		*out.Tp = *r.Tp
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ProxyAccountType1Choice) DeepCopy() *ProxyAccountType1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(ProxyAccountType1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ProxyAccountType1Choice) DeepCopyInto(out *ProxyAccountType1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *Purpose2Choice) DeepCopy() *Purpose2Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(Purpose2Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *Purpose2Choice) DeepCopyInto(out *Purpose2Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *PurposeModification1) DeepCopy() *PurposeModification1 {
+	if r == nil {
+		return nil
+	}
+	out := new(PurposeModification1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *PurposeModification1) DeepCopyInto(out *PurposeModification1) {
+	*out = *r
+	if r.ModCd != nil {
+		out.ModCd = new(Modification1Code)
+		*out.ModCd = *r.ModCd
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *References3) DeepCopy() *References3 {
+	if r == nil {
+		return nil
+	}
+	out := new(References3)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *References3) DeepCopyInto(out *References3) {
+	*out = *r
+	if r.ReqRsn != nil {
+		out.ReqRsn = make([]common.Max35Text, len(r.ReqRsn))
+		copy(out.ReqRsn, r.ReqRsn)
+	}
+	if r.AttchdDocNm != nil {
+		out.AttchdDocNm = make([]common.Max70Text, len(r.AttchdDocNm))
+		copy(out.AttchdDocNm, r.AttchdDocNm)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *References4) DeepCopy() *References4 {
+	if r == nil {
+		return nil
+	}
+	out := new(References4)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *References4) DeepCopyInto(out *References4) {
+	*out = *r
+	if r.AttchdDocNm != nil {
+		out.AttchdDocNm = make([]common.Max70Text, len(r.AttchdDocNm))
+		copy(out.AttchdDocNm, r.AttchdDocNm)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *References5) DeepCopy() *References5 {
+	if r == nil {
+		return nil
+	}
+	out := new(References5)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *References5) DeepCopyInto(out *References5) {
+	*out = *r
+	if r.AckdMsgId != nil {
+		out.AckdMsgId = make([]MessageIdentification1, len(r.AckdMsgId))
+		copy(out.AckdMsgId, r.AckdMsgId)
+	}
+	if r.Sts != nil {
+		out.Sts = new(common.Max35Text)
+		*out.Sts = *r.Sts
+	}
+	if r.AttchdDocNm != nil {
+		out.AttchdDocNm = make([]common.Max70Text, len(r.AttchdDocNm))
+		copy(out.AttchdDocNm, r.AttchdDocNm)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *References6) DeepCopy() *References6 {
+	if r == nil {
+		return nil
+	}
+	out := new(References6)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *References6) DeepCopyInto(out *References6) {
+	*out = *r
+	if r.RjctnRsn != nil {
+		out.RjctnRsn = make([]common.Max350Text, len(r.RjctnRsn))
+		copy(out.RjctnRsn, r.RjctnRsn)
+	}
+	if r.AttchdDocNm != nil {
+		out.AttchdDocNm = make([]common.Max70Text, len(r.AttchdDocNm))
+		copy(out.AttchdDocNm, r.AttchdDocNm)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ReferredDocumentInformation7) DeepCopy() *ReferredDocumentInformation7 {
+	if r == nil {
+		return nil
+	}
+	out := new(ReferredDocumentInformation7)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ReferredDocumentInformation7) DeepCopyInto(out *ReferredDocumentInformation7) {
+	*out = *r
+	if r.Tp != nil {
+		out.Tp = new(ReferredDocumentType4)
+		r.Tp.DeepCopyInto(out.Tp)
+	}
+	if r.Nb != nil {
+		out.Nb = new(common.Max35Text)
+		*out.Nb = *r.Nb
+	}
+	if r.RltdDt != nil {
+		out.RltdDt = new(common.ISODate)
+		*out.RltdDt = *r.RltdDt
+	}
+	if r.LineDtls != nil {
+		out.LineDtls = make([]DocumentLineInformation1, len(r.LineDtls))
+		for i := range r.LineDtls {
+			r.LineDtls[i].DeepCopyInto(&out.LineDtls[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ReferredDocumentType3Choice) DeepCopy() *ReferredDocumentType3Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(ReferredDocumentType3Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ReferredDocumentType3Choice) DeepCopyInto(out *ReferredDocumentType3Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ReferredDocumentType4) DeepCopy() *ReferredDocumentType4 {
+	if r == nil {
+		return nil
+	}
+	out := new(ReferredDocumentType4)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ReferredDocumentType4) DeepCopyInto(out *ReferredDocumentType4) {
+	*out = *r
+	if r.Issr != nil {
+		out.Issr = new(common.Max35Text)
+		*out.Issr = *r.Issr
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *RegulatoryAuthority2) DeepCopy() *RegulatoryAuthority2 {
+	if r == nil {
+		return nil
+	}
+	out := new(RegulatoryAuthority2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *RegulatoryAuthority2) DeepCopyInto(out *RegulatoryAuthority2) {
+	*out = *r
+	if r.Nm != nil {
+		out.Nm = new(common.Max140Text)
+		*out.Nm = *r.Nm
+	}
+	if r.Ctry != nil {
+		out.Ctry = new(common.CountryCode)
+		*out.Ctry = *r.Ctry
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *RegulatoryReporting3) DeepCopy() *RegulatoryReporting3 {
+	if r == nil {
+		return nil
+	}
+	out := new(RegulatoryReporting3)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *RegulatoryReporting3) DeepCopyInto(out *RegulatoryReporting3) {
+	*out = *r
+	if r.DbtCdtRptgInd != nil {
+		out.DbtCdtRptgInd = new(RegulatoryReportingType1Code)
+		*out.DbtCdtRptgInd = *r.DbtCdtRptgInd
+	}
+	if r.Authrty != nil {
+		out.Authrty = new(RegulatoryAuthority2)
+		r.Authrty.DeepCopyInto(out.Authrty)
+	}
+	if r.Dtls != nil {
+		out.Dtls = make([]StructuredRegulatoryReporting3, len(r.Dtls))
+		for i := range r.Dtls {
+			r.Dtls[i].DeepCopyInto(&out.Dtls[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *RemittanceAmount2) DeepCopy() *RemittanceAmount2 {
+	if r == nil {
+		return nil
+	}
+	out := new(RemittanceAmount2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *RemittanceAmount2) DeepCopyInto(out *RemittanceAmount2) {
+	*out = *r
+	if r.DuePyblAmt != nil {
+		out.DuePyblAmt = new(ActiveOrHistoricCurrencyAndAmount)
+		*out.DuePyblAmt = *r.DuePyblAmt
+	}
+	if r.DscntApldAmt != nil {
+		out.DscntApldAmt = make([]DiscountAmountAndType1, len(r.DscntApldAmt))
+		for i := range r.DscntApldAmt {
+			r.DscntApldAmt[i].DeepCopyInto(&out.DscntApldAmt[i])
+		}
+	}
+	if r.CdtNoteAmt != nil {
+		out.CdtNoteAmt = new(ActiveOrHistoricCurrencyAndAmount)
+		*out.CdtNoteAmt = *r.CdtNoteAmt
+	}
+	if r.TaxAmt != nil {
+		out.TaxAmt = make([]TaxAmountAndType1, len(r.TaxAmt))
+		for i := range r.TaxAmt {
+			r.TaxAmt[i].DeepCopyInto(&out.TaxAmt[i])
+		}
+	}
+	if r.AdjstmntAmtAndRsn != nil {
+		out.AdjstmntAmtAndRsn = make([]DocumentAdjustment1, len(r.AdjstmntAmtAndRsn))
+		for i := range r.AdjstmntAmtAndRsn {
+			r.AdjstmntAmtAndRsn[i].DeepCopyInto(&out.AdjstmntAmtAndRsn[i])
+		}
+	}
+	if r.RmtdAmt != nil {
+		out.RmtdAmt = new(ActiveOrHistoricCurrencyAndAmount)
+		*out.RmtdAmt = *r.RmtdAmt
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *RemittanceAmount3) DeepCopy() *RemittanceAmount3 {
+	if r == nil {
+		return nil
+	}
+	out := new(RemittanceAmount3)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *RemittanceAmount3) DeepCopyInto(out *RemittanceAmount3) {
+	*out = *r
+	if r.DuePyblAmt != nil {
+		out.DuePyblAmt = new(ActiveOrHistoricCurrencyAndAmount)
+		*out.DuePyblAmt = *r.DuePyblAmt
+	}
+	if r.DscntApldAmt != nil {
+		out.DscntApldAmt = make([]DiscountAmountAndType1, len(r.DscntApldAmt))
+		for i := range r.DscntApldAmt {
+			r.DscntApldAmt[i].DeepCopyInto(&out.DscntApldAmt[i])
+		}
+	}
+	if r.CdtNoteAmt != nil {
+		out.CdtNoteAmt = new(ActiveOrHistoricCurrencyAndAmount)
+		*out.CdtNoteAmt = *r.CdtNoteAmt
+	}
+	if r.TaxAmt != nil {
+		out.TaxAmt = make([]TaxAmountAndType1, len(r.TaxAmt))
+		for i := range r.TaxAmt {
+			r.TaxAmt[i].DeepCopyInto(&out.TaxAmt[i])
+		}
+	}
+	if r.AdjstmntAmtAndRsn != nil {
+		out.AdjstmntAmtAndRsn = make([]DocumentAdjustment1, len(r.AdjstmntAmtAndRsn))
+		for i := range r.AdjstmntAmtAndRsn {
+			r.AdjstmntAmtAndRsn[i].DeepCopyInto(&out.AdjstmntAmtAndRsn[i])
+		}
+	}
+	if r.RmtdAmt != nil {
+		out.RmtdAmt = new(ActiveOrHistoricCurrencyAndAmount)
+		*out.RmtdAmt = *r.RmtdAmt
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *RemittanceInformation16) DeepCopy() *RemittanceInformation16 {
+	if r == nil {
+		return nil
+	}
+	out := new(RemittanceInformation16)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *RemittanceInformation16) DeepCopyInto(out *RemittanceInformation16) {
+	*out = *r
+	if r.Ustrd != nil {
+		out.Ustrd = make([]common.Max140Text, len(r.Ustrd))
+		copy(out.Ustrd, r.Ustrd)
+	}
+	if r.Strd != nil {
+		out.Strd = make([]StructuredRemittanceInformation16, len(r.Strd))
+		for i := range r.Strd {
+			r.Strd[i].DeepCopyInto(&out.Strd[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *RemittanceLocation6) DeepCopy() *RemittanceLocation6 {
+	if r == nil {
+		return nil
+	}
+	out := new(RemittanceLocation6)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *RemittanceLocation6) DeepCopyInto(out *RemittanceLocation6) {
+	*out = *r
+	if r.RmtId != nil {
+		out.RmtId = new(common.Max35Text)
+		*out.RmtId = *r.RmtId
+	}
+	if r.RmtLctnMtd != nil {
+		out.RmtLctnMtd = new(RemittanceLocationMethod2Code)
+		*out.RmtLctnMtd = *r.RmtLctnMtd
+	}
+	if r.RmtLctnElctrncAdr != nil {
+		out.RmtLctnElctrncAdr = new(common.Max2048Text)
+		*out.RmtLctnElctrncAdr = *r.RmtLctnElctrncAdr
+	}
+	if r.RmtLctnPstlAdr != nil {
+		out.RmtLctnPstlAdr = new(NameAndAddress16)
+		r.RmtLctnPstlAdr.DeepCopyInto(out.RmtLctnPstlAdr)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ResponseDetails1) DeepCopy() *ResponseDetails1 {
+	if r == nil {
+		return nil
+	}
+	out := new(ResponseDetails1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ResponseDetails1) DeepCopyInto(out *ResponseDetails1) {
+	*out = *r
+	if r.AddtlDtls != nil {
+		out.AddtlDtls = new(common.Max350Text)
+		*out.AddtlDtls = *r.AddtlDtls
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *Restriction1) DeepCopy() *Restriction1 {
+	if r == nil {
+		return nil
+	}
+	out := new(Restriction1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *Restriction1) DeepCopyInto(out *Restriction1) {
+	*out = *r
+	r.RstrctnTp.DeepCopyInto(&out.RstrctnTp)
+	if r.VldUntil != nil {
+		out.VldUntil = new(common.ISODateTime)
+		*out.VldUntil = *r.VldUntil
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *RestrictionModification1) DeepCopy() *RestrictionModification1 {
+	if r == nil {
+		return nil
+	}
+	out := new(RestrictionModification1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *RestrictionModification1) DeepCopyInto(out *RestrictionModification1) {
+	*out = *r
+	if r.ModCd != nil {
+		out.ModCd = new(Modification1Code)
+		*out.ModCd = *r.ModCd
+	}
+	r.Rstrctn.DeepCopyInto(&out.Rstrctn)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ServiceLevel8Choice) DeepCopy() *ServiceLevel8Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(ServiceLevel8Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ServiceLevel8Choice) DeepCopyInto(out *ServiceLevel8Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *SettlementMethod3Choice) DeepCopy() *SettlementMethod3Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(SettlementMethod3Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *SettlementMethod3Choice) DeepCopyInto(out *SettlementMethod3Choice) {
+	*out = *r
+	r.Cdt.DeepCopyInto(&out.Cdt)
+	r.Dbt.DeepCopyInto(&out.Dbt)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *SkipPayload) DeepCopy() *SkipPayload {
+	if r == nil {
+		return nil
+	}
+	out := new(SkipPayload)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *SkipPayload) DeepCopyInto(out *SkipPayload) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *StatementFrequencyAndForm1) DeepCopy() *StatementFrequencyAndForm1 {
+	if r == nil {
+		return nil
+	}
+	out := new(StatementFrequencyAndForm1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *StatementFrequencyAndForm1) DeepCopyInto(out *StatementFrequencyAndForm1) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *StatementFrequencyAndFormModification1) DeepCopy() *StatementFrequencyAndFormModification1 {
+	if r == nil {
+		return nil
+	}
+	out := new(StatementFrequencyAndFormModification1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *StatementFrequencyAndFormModification1) DeepCopyInto(out *StatementFrequencyAndFormModification1) {
+	*out = *r
+	if r.ModCd != nil {
+		out.ModCd = new(Modification1Code)
+		*out.ModCd = *r.ModCd
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *StructuredRegulatoryReporting3) DeepCopy() *StructuredRegulatoryReporting3 {
+	if r == nil {
+		return nil
+	}
+	out := new(StructuredRegulatoryReporting3)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *StructuredRegulatoryReporting3) DeepCopyInto(out *StructuredRegulatoryReporting3) {
+	*out = *r
+	if r.Tp != nil {
+		out.Tp = new(common.Max35Text)
+		*out.Tp = *r.Tp
+	}
+	if r.Dt != nil {
+		out.Dt = new(common.ISODate)
+		*out.Dt = *r.Dt
+	}
+	if r.Ctry != nil {
+		out.Ctry = new(common.CountryCode)
+		*out.Ctry = *r.Ctry
+	}
+	if r.Cd != nil {
+		out.Cd = new(common.Max10Text)
+		*out.Cd = *r.Cd
+	}
+	if r.Amt != nil {
+		out.Amt = new(ActiveOrHistoricCurrencyAndAmount)
+		*out.Amt = *r.Amt
+	}
+	if r.Inf != nil {
+		out.Inf = make([]common.Max35Text, len(r.Inf))
+		copy(out.Inf, r.Inf)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *StructuredRemittanceInformation16) DeepCopy() *StructuredRemittanceInformation16 {
+	if r == nil {
+		return nil
+	}
+	out := new(StructuredRemittanceInformation16)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *StructuredRemittanceInformation16) DeepCopyInto(out *StructuredRemittanceInformation16) {
+	*out = *r
+	if r.RfrdDocInf != nil {
+		out.RfrdDocInf = make([]ReferredDocumentInformation7, len(r.RfrdDocInf))
+		for i := range r.RfrdDocInf {
+			r.RfrdDocInf[i].DeepCopyInto(&out.RfrdDocInf[i])
+		}
+	}
+	if r.RfrdDocAmt != nil {
+		out.RfrdDocAmt = new(RemittanceAmount2)
+		r.RfrdDocAmt.DeepCopyInto(out.RfrdDocAmt)
+	}
+	if r.CdtrRefInf != nil {
+		out.CdtrRefInf = new(CreditorReferenceInformation2)
+		r.CdtrRefInf.DeepCopyInto(out.CdtrRefInf)
+	}
+	if r.Invcr != nil {
+		out.Invcr = new(PartyIdentification135)
+		r.Invcr.DeepCopyInto(out.Invcr)
+	}
+	if r.Invcee != nil {
+		out.Invcee = new(PartyIdentification135)
+		r.Invcee.DeepCopyInto(out.Invcee)
+	}
+	if r.TaxRmt != nil {
+		out.TaxRmt = new(TaxInformation7)
+		r.TaxRmt.DeepCopyInto(out.TaxRmt)
+	}
+	if r.GrnshmtRmt != nil {
+		out.GrnshmtRmt = new(Garnishment3)
+		r.GrnshmtRmt.DeepCopyInto(out.GrnshmtRmt)
+	}
+	if r.AddtlRmtInf != nil {
+		out.AddtlRmtInf = make([]common.Max140Text, len(r.AddtlRmtInf))
+		copy(out.AddtlRmtInf, r.AddtlRmtInf)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *SupplementaryData1) DeepCopy() *SupplementaryData1 {
+	if r == nil {
+		return nil
+	}
+	out := new(SupplementaryData1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *SupplementaryData1) DeepCopyInto(out *SupplementaryData1) {
+	*out = *r
+	if r.PlcAndNm != nil {
+		out.PlcAndNm = new(common.Max350Text)
+		*out.PlcAndNm = *r.PlcAndNm
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *SupplementaryDataEnvelope1) DeepCopy() *SupplementaryDataEnvelope1 {
+	if r == nil {
+		return nil
+	}
+	out := new(SupplementaryDataEnvelope1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *SupplementaryDataEnvelope1) DeepCopyInto(out *SupplementaryDataEnvelope1) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *TaxAmount2) DeepCopy() *TaxAmount2 {
+	if r == nil {
+		return nil
+	}
+	out := new(TaxAmount2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *TaxAmount2) DeepCopyInto(out *TaxAmount2) {
+	*out = *r
+	if r.TaxblBaseAmt != nil {
+		out.TaxblBaseAmt = new(ActiveOrHistoricCurrencyAndAmount)
+		*out.TaxblBaseAmt = *r.TaxblBaseAmt
+	}
+	if r.TtlAmt != nil {
+		out.TtlAmt = new(ActiveOrHistoricCurrencyAndAmount)
+		*out.TtlAmt = *r.TtlAmt
+	}
+	if r.Dtls != nil {
+		out.Dtls = make([]TaxRecordDetails2, len(r.Dtls))
+		for i := range r.Dtls {
+			r.Dtls[i].DeepCopyInto(&out.Dtls[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *TaxAmountAndType1) DeepCopy() *TaxAmountAndType1 {
+	if r == nil {
+		return nil
+	}
+	out := new(TaxAmountAndType1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *TaxAmountAndType1) DeepCopyInto(out *TaxAmountAndType1) {
+	*out = *r
+	if r.Tp != nil {
+		out.Tp = new(TaxAmountType1Choice)
+		*out.Tp = *r.Tp
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *TaxAmountType1Choice) DeepCopy() *TaxAmountType1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(TaxAmountType1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *TaxAmountType1Choice) DeepCopyInto(out *TaxAmountType1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *TaxAuthorisation1) DeepCopy() *TaxAuthorisation1 {
+	if r == nil {
+		return nil
+	}
+	out := new(TaxAuthorisation1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *TaxAuthorisation1) DeepCopyInto(out *TaxAuthorisation1) {
+	*out = *r
+	if r.Titl != nil {
+		out.Titl = new(common.Max35Text)
+		*out.Titl = *r.Titl
+	}
+	if r.Nm != nil {
+		out.Nm = new(common.Max140Text)
+		*out.Nm = *r.Nm
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *TaxInformation7) DeepCopy() *TaxInformation7 {
+	if r == nil {
+		return nil
+	}
+	out := new(TaxInformation7)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *TaxInformation7) DeepCopyInto(out *TaxInformation7) {
+	*out = *r
+	if r.Cdtr != nil {
+		out.Cdtr = new(TaxParty1)
+		r.Cdtr.DeepCopyInto(out.Cdtr)
+	}
+	if r.Dbtr != nil {
+		out.Dbtr = new(TaxParty2)
+		r.Dbtr.DeepCopyInto(out.Dbtr)
+	}
+	if r.UltmtDbtr != nil {
+		out.UltmtDbtr = new(TaxParty2)
+		r.UltmtDbtr.DeepCopyInto(out.UltmtDbtr)
+	}
+	if r.AdmstnZone != nil {
+		out.AdmstnZone = new(common.Max35Text)
+		*out.AdmstnZone = *r.AdmstnZone
+	}
+	if r.RefNb != nil {
+		out.RefNb = new(common.Max140Text)
+		*out.RefNb = *r.RefNb
+	}
+	if r.Mtd != nil {
+		out.Mtd = new(common.Max35Text)
+		*out.Mtd = *r.Mtd
+	}
+	if r.TtlTaxblBaseAmt != nil {
+		out.TtlTaxblBaseAmt = new(ActiveOrHistoricCurrencyAndAmount)
+		*out.TtlTaxblBaseAmt = *r.TtlTaxblBaseAmt
+	}
+	if r.TtlTaxAmt != nil {
+		out.TtlTaxAmt = new(ActiveOrHistoricCurrencyAndAmount)
+		*out.TtlTaxAmt = *r.TtlTaxAmt
+	}
+	if r.Dt != nil {
+		out.Dt = new(common.ISODate)
+		*out.Dt = *r.Dt
+	}
+	if r.Rcrd != nil {
+		out.Rcrd = make([]TaxRecord2, len(r.Rcrd))
+		for i := range r.Rcrd {
+			r.Rcrd[i].DeepCopyInto(&out.Rcrd[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *TaxInformation8) DeepCopy() *TaxInformation8 {
+	if r == nil {
+		return nil
+	}
+	out := new(TaxInformation8)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *TaxInformation8) DeepCopyInto(out *TaxInformation8) {
+	*out = *r
+	if r.Cdtr != nil {
+		out.Cdtr = new(TaxParty1)
+		r.Cdtr.DeepCopyInto(out.Cdtr)
+	}
+	if r.Dbtr != nil {
+		out.Dbtr = new(TaxParty2)
+		r.Dbtr.DeepCopyInto(out.Dbtr)
+	}
+	if r.AdmstnZone != nil {
+		out.AdmstnZone = new(common.Max35Text)
+		*out.AdmstnZone = *r.AdmstnZone
+	}
+	if r.RefNb != nil {
+		out.RefNb = new(common.Max140Text)
+		*out.RefNb = *r.RefNb
+	}
+	if r.Mtd != nil {
+		out.Mtd = new(common.Max35Text)
+		*out.Mtd = *r.Mtd
+	}
+	if r.TtlTaxblBaseAmt != nil {
+		out.TtlTaxblBaseAmt = new(ActiveOrHistoricCurrencyAndAmount)
+		*out.TtlTaxblBaseAmt = *r.TtlTaxblBaseAmt
+	}
+	if r.TtlTaxAmt != nil {
+		out.TtlTaxAmt = new(ActiveOrHistoricCurrencyAndAmount)
+		*out.TtlTaxAmt = *r.TtlTaxAmt
+	}
+	if r.Dt != nil {
+		out.Dt = new(common.ISODate)
+		*out.Dt = *r.Dt
+	}
+	if r.Rcrd != nil {
+		out.Rcrd = make([]TaxRecord2, len(r.Rcrd))
+		for i := range r.Rcrd {
+			r.Rcrd[i].DeepCopyInto(&out.Rcrd[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *TaxParty1) DeepCopy() *TaxParty1 {
+	if r == nil {
+		return nil
+	}
+	out := new(TaxParty1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *TaxParty1) DeepCopyInto(out *TaxParty1) {
+	*out = *r
+	if r.TaxId != nil {
+		out.TaxId = new(common.Max35Text)
+		*out.TaxId = *r.TaxId
+	}
+	if r.RegnId != nil {
+		out.RegnId = new(common.Max35Text)
+		*out.RegnId = *r.RegnId
+	}
+	if r.TaxTp != nil {
+		out.TaxTp = new(common.Max35Text)
+		*out.TaxTp = *r.TaxTp
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *TaxParty2) DeepCopy() *TaxParty2 {
+	if r == nil {
+		return nil
+	}
+	out := new(TaxParty2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *TaxParty2) DeepCopyInto(out *TaxParty2) {
+	*out = *r
+	if r.TaxId != nil {
+		out.TaxId = new(common.Max35Text)
+		*out.TaxId = *r.TaxId
+	}
+	if r.RegnId != nil {
+		out.RegnId = new(common.Max35Text)
+		*out.RegnId = *r.RegnId
+	}
+	if r.TaxTp != nil {
+		out.TaxTp = new(common.Max35Text)
+		*out.TaxTp = *r.TaxTp
+	}
+	if r.Authstn != nil {
+		out.Authstn = new(TaxAuthorisation1)
+		r.Authstn.DeepCopyInto(out.Authstn)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *TaxPeriod2) DeepCopy() *TaxPeriod2 {
+	if r == nil {
+		return nil
+	}
+	out := new(TaxPeriod2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *TaxPeriod2) DeepCopyInto(out *TaxPeriod2) {
+	*out = *r
+	if r.Yr != nil {
+		out.Yr = new(common.ISODate)
+		*out.Yr = *r.Yr
+	}
+	if r.Tp != nil {
+		out.Tp = new(TaxRecordPeriod1Code)
+		*out.Tp = *r.Tp
+	}
+	if r.FrToDt != nil {
+		out.FrToDt = new(DatePeriod2)
+		*out.FrToDt = *r.FrToDt
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *TaxRecord2) DeepCopy() *TaxRecord2 {
+	if r == nil {
+		return nil
+	}
+	out := new(TaxRecord2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *TaxRecord2) DeepCopyInto(out *TaxRecord2) {
+	*out = *r
+	if r.Tp != nil {
+		out.Tp = new(common.Max35Text)
+		*out.Tp = *r.Tp
+	}
+	if r.Ctgy != nil {
+		out.Ctgy = new(common.Max35Text)
+		*out.Ctgy = *r.Ctgy
+	}
+	if r.CtgyDtls != nil {
+		out.CtgyDtls = new(common.Max35Text)
+		*out.CtgyDtls = *r.CtgyDtls
+	}
+	if r.DbtrSts != nil {
+		out.DbtrSts = new(common.Max35Text)
+		*out.DbtrSts = *r.DbtrSts
+	}
+	if r.CertId != nil {
+		out.CertId = new(common.Max35Text)
+		*out.CertId = *r.CertId
+	}
+	if r.FrmsCd != nil {
+		out.FrmsCd = new(common.Max35Text)
+		*out.FrmsCd = *r.FrmsCd
+	}
+	if r.Prd != nil {
+		out.Prd = new(TaxPeriod2)
+		r.Prd.DeepCopyInto(out.Prd)
+	}
+	if r.TaxAmt != nil {
+		out.TaxAmt = new(TaxAmount2)
+		r.TaxAmt.DeepCopyInto(out.TaxAmt)
+	}
+	if r.AddtlInf != nil {
+		out.AddtlInf = new(common.Max140Text)
+		*out.AddtlInf = *r.AddtlInf
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *TaxRecordDetails2) DeepCopy() *TaxRecordDetails2 {
+	if r == nil {
+		return nil
+	}
+	out := new(TaxRecordDetails2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *TaxRecordDetails2) DeepCopyInto(out *TaxRecordDetails2) {
+	*out = *r
+	if r.Prd != nil {
+		out.Prd = new(TaxPeriod2)
+		r.Prd.DeepCopyInto(out.Prd)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *TradingNameModification1) DeepCopy() *TradingNameModification1 {
+	if r == nil {
+		return nil
+	}
+	out := new(TradingNameModification1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *TradingNameModification1) DeepCopyInto(out *TradingNameModification1) {
+	*out = *r
+	if r.ModCd != nil {
+		out.ModCd = new(Modification1Code)
+		*out.ModCd = *r.ModCd
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *TransferInstruction1) DeepCopy() *TransferInstruction1 {
+	if r == nil {
+		return nil
+	}
+	out := new(TransferInstruction1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *TransferInstruction1) DeepCopyInto(out *TransferInstruction1) {
+	*out = *r
+	if r.Prtry != nil {
+		out.Prtry = new(common.Max256Text)
+		*out.Prtry = *r.Prtry
+	}
+	if r.StartDtTm != nil {
+		out.StartDtTm = new(common.ISODateTime)
+		*out.StartDtTm = *r.StartDtTm
+	}
+	if r.StartDt != nil {
+		out.StartDt = new(common.ISODate)
+		*out.StartDt = *r.StartDt
+	}
+	if r.Desc != nil {
+		out.Desc = new(common.Max350Text)
+		*out.Desc = *r.Desc
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *TypeModification1) DeepCopy() *TypeModification1 {
+	if r == nil {
+		return nil
+	}
+	out := new(TypeModification1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *TypeModification1) DeepCopyInto(out *TypeModification1) {
+	*out = *r
+	if r.ModCd != nil {
+		out.ModCd = new(Modification1Code)
+		*out.ModCd = *r.ModCd
+	}
+}
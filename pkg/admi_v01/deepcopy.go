@@ -0,0 +1,1359 @@
+// Code generated by deepcopy-gen. DO NOT EDIT.
+
+package admi_v01
+
+import (
+	"github.com/moov-io/iso20022/pkg/common"
+)
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *AccountIdentification4Choice) DeepCopy() *AccountIdentification4Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(AccountIdentification4Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *AccountIdentification4Choice) DeepCopyInto(out *AccountIdentification4Choice) {
+	*out = *r
+	r.Othr.DeepCopyInto(&out.Othr)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *AccountIdentificationSearchCriteria2Choice) DeepCopy() *AccountIdentificationSearchCriteria2Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(AccountIdentificationSearchCriteria2Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *AccountIdentificationSearchCriteria2Choice) DeepCopyInto(out *AccountIdentificationSearchCriteria2Choice) {
+	*out = *r
+	r.EQ.DeepCopyInto(&out.EQ)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *AccountSchemeName1Choice) DeepCopy() *AccountSchemeName1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(AccountSchemeName1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *AccountSchemeName1Choice) DeepCopyInto(out *AccountSchemeName1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *AddressType3Choice) DeepCopy() *AddressType3Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(AddressType3Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *AddressType3Choice) DeepCopyInto(out *AddressType3Choice) {
+	*out = *r
+	r.Prtry.DeepCopyInto(&out.Prtry)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *AddtlRawData) DeepCopy() *AddtlRawData {
+	if r == nil {
+		return nil
+	}
+	out := new(AddtlRawData)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *AddtlRawData) DeepCopyInto(out *AddtlRawData) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *Admi00200101) DeepCopy() *Admi00200101 {
+	if r == nil {
+		return nil
+	}
+	out := new(Admi00200101)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *Admi00200101) DeepCopyInto(out *Admi00200101) {
+	*out = *r
+	r.Rsn.DeepCopyInto(&out.Rsn)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *Admi00400101) DeepCopy() *Admi00400101 {
+	if r == nil {
+		return nil
+	}
+	out := new(Admi00400101)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *Admi00400101) DeepCopyInto(out *Admi00400101) {
+	*out = *r
+	r.EvtInf.DeepCopyInto(&out.EvtInf)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *BalanceType11Choice) DeepCopy() *BalanceType11Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(BalanceType11Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *BalanceType11Choice) DeepCopyInto(out *BalanceType11Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *BranchAndFinancialInstitutionIdentification6) DeepCopy() *BranchAndFinancialInstitutionIdentification6 {
+	if r == nil {
+		return nil
+	}
+	out := new(BranchAndFinancialInstitutionIdentification6)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *BranchAndFinancialInstitutionIdentification6) DeepCopyInto(out *BranchAndFinancialInstitutionIdentification6) {
+	*out = *r
+	r.FinInstnId.DeepCopyInto(&out.FinInstnId)
+	if r.BrnchId != nil {
+		out.BrnchId = new(BranchData3)
+		r.BrnchId.DeepCopyInto(out.BrnchId)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *BranchData3) DeepCopy() *BranchData3 {
+	if r == nil {
+		return nil
+	}
+	out := new(BranchData3)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *BranchData3) DeepCopyInto(out *BranchData3) {
+	*out = *r
+	if r.Id != nil {
+		out.Id = new(common.Max35Text)
+		*out.Id = *r.Id
+	}
+	if r.LEI != nil {
+		out.LEI = new(common.LEIIdentifier)
+		*out.LEI = *r.LEI
+	}
+	if r.Nm != nil {
+		out.Nm = new(common.Max140Text)
+		*out.Nm = *r.Nm
+	}
+	if r.PstlAdr != nil {
+		out.PstlAdr = new(PostalAddress24)
+		r.PstlAdr.DeepCopyInto(out.PstlAdr)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CashBalance12) DeepCopy() *CashBalance12 {
+	if r == nil {
+		return nil
+	}
+	out := new(CashBalance12)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CashBalance12) DeepCopyInto(out *CashBalance12) {
+	*out = *r
+	if r.Tp != nil {
+		out.Tp = make([]BalanceType11Choice, len(r.Tp))
+		copy(out.Tp, r.Tp)
+	}
+	if r.CtrPtyId != nil {
+		out.CtrPtyId = make([]BranchAndFinancialInstitutionIdentification6, len(r.CtrPtyId))
+		for i := range r.CtrPtyId {
+			r.CtrPtyId[i].DeepCopyInto(&out.CtrPtyId[i])
+		}
+	}
+	if r.ValDt != nil {
+		out.ValDt = make([]DateAndDateTimeSearch4Choice, len(r.ValDt))
+		copy(out.ValDt, r.ValDt)
+	}
+	if r.PrcgDt != nil {
+		out.PrcgDt = new(DateAndDateTimeSearch4Choice)
+		*out.PrcgDt = *r.PrcgDt
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ClearingSystemIdentification2Choice) DeepCopy() *ClearingSystemIdentification2Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(ClearingSystemIdentification2Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ClearingSystemIdentification2Choice) DeepCopyInto(out *ClearingSystemIdentification2Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ClearingSystemMemberIdentification2) DeepCopy() *ClearingSystemMemberIdentification2 {
+	if r == nil {
+		return nil
+	}
+	out := new(ClearingSystemMemberIdentification2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ClearingSystemMemberIdentification2) DeepCopyInto(out *ClearingSystemMemberIdentification2) {
+	*out = *r
+	if r.ClrSysId != nil {
+		out.ClrSysId = new(ClearingSystemIdentification2Choice)
+		*out.ClrSysId = *r.ClrSysId
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *DateAndDateTimeSearch4Choice) DeepCopy() *DateAndDateTimeSearch4Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(DateAndDateTimeSearch4Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *DateAndDateTimeSearch4Choice) DeepCopyInto(out *DateAndDateTimeSearch4Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *DatePeriod2) DeepCopy() *DatePeriod2 {
+	if r == nil {
+		return nil
+	}
+	out := new(DatePeriod2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *DatePeriod2) DeepCopyInto(out *DatePeriod2) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *DatePeriodSearch1Choice) DeepCopy() *DatePeriodSearch1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(DatePeriodSearch1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *DatePeriodSearch1Choice) DeepCopyInto(out *DatePeriodSearch1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *DateTimePeriod1) DeepCopy() *DateTimePeriod1 {
+	if r == nil {
+		return nil
+	}
+	out := new(DateTimePeriod1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *DateTimePeriod1) DeepCopyInto(out *DateTimePeriod1) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *DateTimePeriod1Choice) DeepCopy() *DateTimePeriod1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(DateTimePeriod1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *DateTimePeriod1Choice) DeepCopyInto(out *DateTimePeriod1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *DateTimeSearch2Choice) DeepCopy() *DateTimeSearch2Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(DateTimeSearch2Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *DateTimeSearch2Choice) DeepCopyInto(out *DateTimeSearch2Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *Event1) DeepCopy() *Event1 {
+	if r == nil {
+		return nil
+	}
+	out := new(Event1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *Event1) DeepCopyInto(out *Event1) {
+	*out = *r
+	if r.EvtParam != nil {
+		out.EvtParam = make([]common.Max35Text, len(r.EvtParam))
+		copy(out.EvtParam, r.EvtParam)
+	}
+	if r.EvtDesc != nil {
+		out.EvtDesc = new(common.Max350Text)
+		*out.EvtDesc = *r.EvtDesc
+	}
+	if r.EvtTm != nil {
+		out.EvtTm = new(common.ISODateTime)
+		*out.EvtTm = *r.EvtTm
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *EventType1Choice) DeepCopy() *EventType1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(EventType1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *EventType1Choice) DeepCopyInto(out *EventType1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *FinancialIdentificationSchemeName1Choice) DeepCopy() *FinancialIdentificationSchemeName1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(FinancialIdentificationSchemeName1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *FinancialIdentificationSchemeName1Choice) DeepCopyInto(out *FinancialIdentificationSchemeName1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *FinancialInstitutionIdentification18) DeepCopy() *FinancialInstitutionIdentification18 {
+	if r == nil {
+		return nil
+	}
+	out := new(FinancialInstitutionIdentification18)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *FinancialInstitutionIdentification18) DeepCopyInto(out *FinancialInstitutionIdentification18) {
+	*out = *r
+	if r.BICFI != nil {
+		out.BICFI = new(common.BICFIDec2014Identifier)
+		*out.BICFI = *r.BICFI
+	}
+	if r.ClrSysMmbId != nil {
+		out.ClrSysMmbId = new(ClearingSystemMemberIdentification2)
+		r.ClrSysMmbId.DeepCopyInto(out.ClrSysMmbId)
+	}
+	if r.LEI != nil {
+		out.LEI = new(common.LEIIdentifier)
+		*out.LEI = *r.LEI
+	}
+	if r.Nm != nil {
+		out.Nm = new(common.Max140Text)
+		*out.Nm = *r.Nm
+	}
+	if r.PstlAdr != nil {
+		out.PstlAdr = new(PostalAddress24)
+		r.PstlAdr.DeepCopyInto(out.PstlAdr)
+	}
+	if r.Othr != nil {
+		out.Othr = new(GenericFinancialIdentification1)
+		r.Othr.DeepCopyInto(out.Othr)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *GenericAccountIdentification1) DeepCopy() *GenericAccountIdentification1 {
+	if r == nil {
+		return nil
+	}
+	out := new(GenericAccountIdentification1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *GenericAccountIdentification1) DeepCopyInto(out *GenericAccountIdentification1) {
+	*out = *r
+	if r.SchmeNm != nil {
+		out.SchmeNm = new(AccountSchemeName1Choice)
+		*out.SchmeNm = *r.SchmeNm
+	}
+	if r.Issr != nil {
+		out.Issr = new(common.Max35Text)
+		*out.Issr = *r.Issr
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *GenericFinancialIdentification1) DeepCopy() *GenericFinancialIdentification1 {
+	if r == nil {
+		return nil
+	}
+	out := new(GenericFinancialIdentification1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *GenericFinancialIdentification1) DeepCopyInto(out *GenericFinancialIdentification1) {
+	*out = *r
+	if r.SchmeNm != nil {
+		out.SchmeNm = new(FinancialIdentificationSchemeName1Choice)
+		*out.SchmeNm = *r.SchmeNm
+	}
+	if r.Issr != nil {
+		out.Issr = new(common.Max35Text)
+		*out.Issr = *r.Issr
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *GenericIdentification1) DeepCopy() *GenericIdentification1 {
+	if r == nil {
+		return nil
+	}
+	out := new(GenericIdentification1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *GenericIdentification1) DeepCopyInto(out *GenericIdentification1) {
+	*out = *r
+	if r.SchmeNm != nil {
+		out.SchmeNm = new(common.Max35Text)
+		*out.SchmeNm = *r.SchmeNm
+	}
+	if r.Issr != nil {
+		out.Issr = new(common.Max35Text)
+		*out.Issr = *r.Issr
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *GenericIdentification30) DeepCopy() *GenericIdentification30 {
+	if r == nil {
+		return nil
+	}
+	out := new(GenericIdentification30)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *GenericIdentification30) DeepCopyInto(out *GenericIdentification30) {
+	*out = *r
+	if r.SchmeNm != nil {
+		out.SchmeNm = new(common.Max35Text)
+		*out.SchmeNm = *r.SchmeNm
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *GenericIdentification36) DeepCopy() *GenericIdentification36 {
+	if r == nil {
+		return nil
+	}
+	out := new(GenericIdentification36)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *GenericIdentification36) DeepCopyInto(out *GenericIdentification36) {
+	*out = *r
+	if r.SchmeNm != nil {
+		out.SchmeNm = new(common.Max35Text)
+		*out.SchmeNm = *r.SchmeNm
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *MessageHeader10) DeepCopy() *MessageHeader10 {
+	if r == nil {
+		return nil
+	}
+	out := new(MessageHeader10)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *MessageHeader10) DeepCopyInto(out *MessageHeader10) {
+	*out = *r
+	if r.CreDtTm != nil {
+		out.CreDtTm = new(common.ISODateTime)
+		*out.CreDtTm = *r.CreDtTm
+	}
+	if r.QryNm != nil {
+		out.QryNm = new(common.Max35Text)
+		*out.QryNm = *r.QryNm
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *MessageHeader7) DeepCopy() *MessageHeader7 {
+	if r == nil {
+		return nil
+	}
+	out := new(MessageHeader7)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *MessageHeader7) DeepCopyInto(out *MessageHeader7) {
+	*out = *r
+	if r.CreDtTm != nil {
+		out.CreDtTm = new(common.ISODateTime)
+		*out.CreDtTm = *r.CreDtTm
+	}
+	if r.ReqTp != nil {
+		out.ReqTp = new(RequestType4Choice)
+		r.ReqTp.DeepCopyInto(out.ReqTp)
+	}
+	if r.OrgnlBizQry != nil {
+		out.OrgnlBizQry = new(OriginalBusinessQuery1)
+		r.OrgnlBizQry.DeepCopyInto(out.OrgnlBizQry)
+	}
+	if r.QryNm != nil {
+		out.QryNm = new(common.Max35Text)
+		*out.QryNm = *r.QryNm
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *MessageReference) DeepCopy() *MessageReference {
+	if r == nil {
+		return nil
+	}
+	out := new(MessageReference)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *MessageReference) DeepCopyInto(out *MessageReference) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *MessageReference1) DeepCopy() *MessageReference1 {
+	if r == nil {
+		return nil
+	}
+	out := new(MessageReference1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *MessageReference1) DeepCopyInto(out *MessageReference1) {
+	*out = *r
+	if r.MsgNm != nil {
+		out.MsgNm = new(common.Max35Text)
+		*out.MsgNm = *r.MsgNm
+	}
+	r.RefIssr.DeepCopyInto(&out.RefIssr)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *NameAndAddress5) DeepCopy() *NameAndAddress5 {
+	if r == nil {
+		return nil
+	}
+	out := new(NameAndAddress5)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *NameAndAddress5) DeepCopyInto(out *NameAndAddress5) {
+	*out = *r
+	if r.Adr != nil {
+		out.Adr = new(PostalAddress1)
+		r.Adr.DeepCopyInto(out.Adr)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *NameAndAddress8) DeepCopy() *NameAndAddress8 {
+	if r == nil {
+		return nil
+	}
+	out := new(NameAndAddress8)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *NameAndAddress8) DeepCopyInto(out *NameAndAddress8) {
+	*out = *r
+	if r.Adr != nil {
+		out.Adr = new(PostalAddress1)
+		r.Adr.DeepCopyInto(out.Adr)
+	}
+	if r.AltrntvIdr != nil {
+		out.AltrntvIdr = make([]common.Max35Text, len(r.AltrntvIdr))
+		copy(out.AltrntvIdr, r.AltrntvIdr)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *OriginalBusinessQuery1) DeepCopy() *OriginalBusinessQuery1 {
+	if r == nil {
+		return nil
+	}
+	out := new(OriginalBusinessQuery1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *OriginalBusinessQuery1) DeepCopyInto(out *OriginalBusinessQuery1) {
+	*out = *r
+	if r.MsgNmId != nil {
+		out.MsgNmId = new(common.Max35Text)
+		*out.MsgNmId = *r.MsgNmId
+	}
+	if r.CreDtTm != nil {
+		out.CreDtTm = new(common.ISODateTime)
+		*out.CreDtTm = *r.CreDtTm
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *PartyIdentification120Choice) DeepCopy() *PartyIdentification120Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(PartyIdentification120Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *PartyIdentification120Choice) DeepCopyInto(out *PartyIdentification120Choice) {
+	*out = *r
+	r.PrtryId.DeepCopyInto(&out.PrtryId)
+	r.NmAndAdr.DeepCopyInto(&out.NmAndAdr)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *PartyIdentification136) DeepCopy() *PartyIdentification136 {
+	if r == nil {
+		return nil
+	}
+	out := new(PartyIdentification136)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *PartyIdentification136) DeepCopyInto(out *PartyIdentification136) {
+	*out = *r
+	r.Id.DeepCopyInto(&out.Id)
+	if r.LEI != nil {
+		out.LEI = new(common.LEIIdentifier)
+		*out.LEI = *r.LEI
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *PartyIdentification44) DeepCopy() *PartyIdentification44 {
+	if r == nil {
+		return nil
+	}
+	out := new(PartyIdentification44)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *PartyIdentification44) DeepCopyInto(out *PartyIdentification44) {
+	*out = *r
+	if r.AltrntvIdr != nil {
+		out.AltrntvIdr = make([]common.Max35Text, len(r.AltrntvIdr))
+		copy(out.AltrntvIdr, r.AltrntvIdr)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *PartyIdentification59) DeepCopy() *PartyIdentification59 {
+	if r == nil {
+		return nil
+	}
+	out := new(PartyIdentification59)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *PartyIdentification59) DeepCopyInto(out *PartyIdentification59) {
+	*out = *r
+	if r.PtyNm != nil {
+		out.PtyNm = new(common.Max34Text)
+		*out.PtyNm = *r.PtyNm
+	}
+	if r.AnyBIC != nil {
+		out.AnyBIC = new(PartyIdentification44)
+		r.AnyBIC.DeepCopyInto(out.AnyBIC)
+	}
+	if r.AcctNb != nil {
+		out.AcctNb = new(common.Max34Text)
+		*out.AcctNb = *r.AcctNb
+	}
+	if r.Adr != nil {
+		out.Adr = new(common.Max105Text)
+		*out.Adr = *r.Adr
+	}
+	if r.ClrSysId != nil {
+		out.ClrSysId = new(ClearingSystemIdentification2Choice)
+		*out.ClrSysId = *r.ClrSysId
+	}
+	if r.LglNttyIdr != nil {
+		out.LglNttyIdr = new(common.LEIIdentifier)
+		*out.LglNttyIdr = *r.LglNttyIdr
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *PartyIdentification73Choice) DeepCopy() *PartyIdentification73Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(PartyIdentification73Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *PartyIdentification73Choice) DeepCopyInto(out *PartyIdentification73Choice) {
+	*out = *r
+	r.NmAndAdr.DeepCopyInto(&out.NmAndAdr)
+	r.AnyBIC.DeepCopyInto(&out.AnyBIC)
+	r.PtyId.DeepCopyInto(&out.PtyId)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *PostalAddress1) DeepCopy() *PostalAddress1 {
+	if r == nil {
+		return nil
+	}
+	out := new(PostalAddress1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *PostalAddress1) DeepCopyInto(out *PostalAddress1) {
+	*out = *r
+	if r.AdrTp != nil {
+		out.AdrTp = new(common.AddressType2Code)
+		*out.AdrTp = *r.AdrTp
+	}
+	if r.AdrLine != nil {
+		out.AdrLine = make([]common.Max70Text, len(r.AdrLine))
+		copy(out.AdrLine, r.AdrLine)
+	}
+	if r.StrtNm != nil {
+		out.StrtNm = new(common.Max70Text)
+		*out.StrtNm = *r.StrtNm
+	}
+	if r.BldgNb != nil {
+		out.BldgNb = new(common.Max16Text)
+		*out.BldgNb = *r.BldgNb
+	}
+	if r.PstCd != nil {
+		out.PstCd = new(common.Max16Text)
+		*out.PstCd = *r.PstCd
+	}
+	if r.TwnNm != nil {
+		out.TwnNm = new(common.Max35Text)
+		*out.TwnNm = *r.TwnNm
+	}
+	if r.CtrySubDvsn != nil {
+		out.CtrySubDvsn = new(common.Max35Text)
+		*out.CtrySubDvsn = *r.CtrySubDvsn
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *PostalAddress24) DeepCopy() *PostalAddress24 {
+	if r == nil {
+		return nil
+	}
+	out := new(PostalAddress24)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *PostalAddress24) DeepCopyInto(out *PostalAddress24) {
+	*out = *r
+	if r.AdrTp != nil {
+		out.AdrTp = new(AddressType3Choice)
+		r.AdrTp.DeepCopyInto(out.AdrTp)
+	}
+	if r.Dept != nil {
+		out.Dept = new(common.Max70Text)
+		*out.Dept = *r.Dept
+	}
+	if r.SubDept != nil {
+		out.SubDept = new(common.Max70Text)
+		*out.SubDept = *r.SubDept
+	}
+	if r.StrtNm != nil {
+		out.StrtNm = new(common.Max70Text)
+		*out.StrtNm = *r.StrtNm
+	}
+	if r.BldgNb != nil {
+		out.BldgNb = new(common.Max16Text)
+		*out.BldgNb = *r.BldgNb
+	}
+	if r.BldgNm != nil {
+		out.BldgNm = new(common.Max35Text)
+		*out.BldgNm = *r.BldgNm
+	}
+	if r.Flr != nil {
+		out.Flr = new(common.Max70Text)
+		*out.Flr = *r.Flr
+	}
+	if r.PstBx != nil {
+		out.PstBx = new(common.Max16Text)
+		*out.PstBx = *r.PstBx
+	}
+	if r.Room != nil {
+		out.Room = new(common.Max70Text)
+		*out.Room = *r.Room
+	}
+	if r.PstCd != nil {
+		out.PstCd = new(common.Max16Text)
+		*out.PstCd = *r.PstCd
+	}
+	if r.TwnNm != nil {
+		out.TwnNm = new(common.Max35Text)
+		*out.TwnNm = *r.TwnNm
+	}
+	if r.TwnLctnNm != nil {
+		out.TwnLctnNm = new(common.Max35Text)
+		*out.TwnLctnNm = *r.TwnLctnNm
+	}
+	if r.DstrctNm != nil {
+		out.DstrctNm = new(common.Max35Text)
+		*out.DstrctNm = *r.DstrctNm
+	}
+	if r.CtrySubDvsn != nil {
+		out.CtrySubDvsn = new(common.Max35Text)
+		*out.CtrySubDvsn = *r.CtrySubDvsn
+	}
+	if r.Ctry != nil {
+		out.Ctry = new(common.CountryCode)
+		*out.Ctry = *r.Ctry
+	}
+	if r.AdrLine != nil {
+		out.AdrLine = make([]common.Max70Text, len(r.AdrLine))
+		copy(out.AdrLine, r.AdrLine)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ProcessingRequestV01) DeepCopy() *ProcessingRequestV01 {
+	if r == nil {
+		return nil
+	}
+	out := new(ProcessingRequestV01)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ProcessingRequestV01) DeepCopyInto(out *ProcessingRequestV01) {
+	*out = *r
+	if r.SttlmSsnIdr != nil {
+		out.SttlmSsnIdr = new(common.Exact4AlphaNumericText)
+		*out.SttlmSsnIdr = *r.SttlmSsnIdr
+	}
+	r.Req.DeepCopyInto(&out.Req)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ReceiptAcknowledgementReport2) DeepCopy() *ReceiptAcknowledgementReport2 {
+	if r == nil {
+		return nil
+	}
+	out := new(ReceiptAcknowledgementReport2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ReceiptAcknowledgementReport2) DeepCopyInto(out *ReceiptAcknowledgementReport2) {
+	*out = *r
+	r.RltdRef.DeepCopyInto(&out.RltdRef)
+	r.ReqHdlg.DeepCopyInto(&out.ReqHdlg)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ReceiptAcknowledgementV01) DeepCopy() *ReceiptAcknowledgementV01 {
+	if r == nil {
+		return nil
+	}
+	out := new(ReceiptAcknowledgementV01)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ReceiptAcknowledgementV01) DeepCopyInto(out *ReceiptAcknowledgementV01) {
+	*out = *r
+	r.MsgId.DeepCopyInto(&out.MsgId)
+	if r.Rpt != nil {
+		out.Rpt = make([]ReceiptAcknowledgementReport2, len(r.Rpt))
+		for i := range r.Rpt {
+			r.Rpt[i].DeepCopyInto(&out.Rpt[i])
+		}
+	}
+	if r.SplmtryData != nil {
+		out.SplmtryData = make([]SupplementaryData1, len(r.SplmtryData))
+		for i := range r.SplmtryData {
+			r.SplmtryData[i].DeepCopyInto(&out.SplmtryData[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *RejectionReason2) DeepCopy() *RejectionReason2 {
+	if r == nil {
+		return nil
+	}
+	out := new(RejectionReason2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *RejectionReason2) DeepCopyInto(out *RejectionReason2) {
+	*out = *r
+	if r.RjctnDtTm != nil {
+		out.RjctnDtTm = new(common.ISODateTime)
+		*out.RjctnDtTm = *r.RjctnDtTm
+	}
+	if r.ErrLctn != nil {
+		out.ErrLctn = new(common.Max350Text)
+		*out.ErrLctn = *r.ErrLctn
+	}
+	if r.RsnDesc != nil {
+		out.RsnDesc = new(common.Max350Text)
+		*out.RsnDesc = *r.RsnDesc
+	}
+	if r.AddtlData != nil {
+		out.AddtlData = new(common.Max20000Text)
+		*out.AddtlData = *r.AddtlData
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ReportQueryCriteria2) DeepCopy() *ReportQueryCriteria2 {
+	if r == nil {
+		return nil
+	}
+	out := new(ReportQueryCriteria2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ReportQueryCriteria2) DeepCopyInto(out *ReportQueryCriteria2) {
+	*out = *r
+	r.SchCrit.DeepCopyInto(&out.SchCrit)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ReportQueryRequestV01) DeepCopy() *ReportQueryRequestV01 {
+	if r == nil {
+		return nil
+	}
+	out := new(ReportQueryRequestV01)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ReportQueryRequestV01) DeepCopyInto(out *ReportQueryRequestV01) {
+	*out = *r
+	r.MsgHdr.DeepCopyInto(&out.MsgHdr)
+	if r.RptQryCrit != nil {
+		out.RptQryCrit = make([]ReportQueryCriteria2, len(r.RptQryCrit))
+		for i := range r.RptQryCrit {
+			r.RptQryCrit[i].DeepCopyInto(&out.RptQryCrit[i])
+		}
+	}
+	if r.SplmtryData != nil {
+		out.SplmtryData = make([]SupplementaryData1, len(r.SplmtryData))
+		for i := range r.SplmtryData {
+			r.SplmtryData[i].DeepCopyInto(&out.SplmtryData[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ReportQuerySearchCriteria2) DeepCopy() *ReportQuerySearchCriteria2 {
+	if r == nil {
+		return nil
+	}
+	out := new(ReportQuerySearchCriteria2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ReportQuerySearchCriteria2) DeepCopyInto(out *ReportQuerySearchCriteria2) {
+	*out = *r
+	if r.AcctId != nil {
+		out.AcctId = make([]AccountIdentificationSearchCriteria2Choice, len(r.AcctId))
+		for i := range r.AcctId {
+			r.AcctId[i].DeepCopyInto(&out.AcctId[i])
+		}
+	}
+	if r.Bal != nil {
+		out.Bal = make([]CashBalance12, len(r.Bal))
+		for i := range r.Bal {
+			r.Bal[i].DeepCopyInto(&out.Bal[i])
+		}
+	}
+	if r.RptNm != nil {
+		out.RptNm = new(common.Max4AlphaNumericText)
+		*out.RptNm = *r.RptNm
+	}
+	if r.MsgNmId != nil {
+		out.MsgNmId = new(common.Max35Text)
+		*out.MsgNmId = *r.MsgNmId
+	}
+	r.PtyId.DeepCopyInto(&out.PtyId)
+	if r.RspnsblPtyId != nil {
+		out.RspnsblPtyId = new(PartyIdentification136)
+		r.RspnsblPtyId.DeepCopyInto(out.RspnsblPtyId)
+	}
+	if r.DtSch != nil {
+		out.DtSch = new(DatePeriodSearch1Choice)
+		*out.DtSch = *r.DtSch
+	}
+	if r.SchdldTm != nil {
+		out.SchdldTm = new(DateTimePeriod1Choice)
+		*out.SchdldTm = *r.SchdldTm
+	}
+	if r.Evt != nil {
+		out.Evt = new(EventType1Choice)
+		*out.Evt = *r.Evt
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *RequestDetails19) DeepCopy() *RequestDetails19 {
+	if r == nil {
+		return nil
+	}
+	out := new(RequestDetails19)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *RequestDetails19) DeepCopyInto(out *RequestDetails19) {
+	*out = *r
+	if r.RqstrId != nil {
+		out.RqstrId = new(PartyIdentification73Choice)
+		r.RqstrId.DeepCopyInto(out.RqstrId)
+	}
+	if r.AddtlReqInf != nil {
+		out.AddtlReqInf = make([]common.Max35Text, len(r.AddtlReqInf))
+		copy(out.AddtlReqInf, r.AddtlReqInf)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *RequestHandling2) DeepCopy() *RequestHandling2 {
+	if r == nil {
+		return nil
+	}
+	out := new(RequestHandling2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *RequestHandling2) DeepCopyInto(out *RequestHandling2) {
+	*out = *r
+	if r.StsDtTm != nil {
+		out.StsDtTm = new(common.ISODateTime)
+		*out.StsDtTm = *r.StsDtTm
+	}
+	if r.Desc != nil {
+		out.Desc = new(common.Max140Text)
+		*out.Desc = *r.Desc
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *RequestType4Choice) DeepCopy() *RequestType4Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(RequestType4Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *RequestType4Choice) DeepCopyInto(out *RequestType4Choice) {
+	*out = *r
+	r.Prtry.DeepCopyInto(&out.Prtry)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ResendRequestV01) DeepCopy() *ResendRequestV01 {
+	if r == nil {
+		return nil
+	}
+	out := new(ResendRequestV01)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ResendRequestV01) DeepCopyInto(out *ResendRequestV01) {
+	*out = *r
+	r.MsgHdr.DeepCopyInto(&out.MsgHdr)
+	if r.RsndSchCrit != nil {
+		out.RsndSchCrit = make([]ResendSearchCriteria2, len(r.RsndSchCrit))
+		for i := range r.RsndSchCrit {
+			r.RsndSchCrit[i].DeepCopyInto(&out.RsndSchCrit[i])
+		}
+	}
+	if r.SplmtryData != nil {
+		out.SplmtryData = make([]SupplementaryData1, len(r.SplmtryData))
+		for i := range r.SplmtryData {
+			r.SplmtryData[i].DeepCopyInto(&out.SplmtryData[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ResendSearchCriteria2) DeepCopy() *ResendSearchCriteria2 {
+	if r == nil {
+		return nil
+	}
+	out := new(ResendSearchCriteria2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ResendSearchCriteria2) DeepCopyInto(out *ResendSearchCriteria2) {
+	*out = *r
+	if r.BizDt != nil {
+		out.BizDt = new(common.ISODate)
+		*out.BizDt = *r.BizDt
+	}
+	if r.SeqNb != nil {
+		out.SeqNb = new(common.Max35Text)
+		*out.SeqNb = *r.SeqNb
+	}
+	if r.SeqRg != nil {
+		out.SeqRg = new(SequenceRange1Choice)
+		r.SeqRg.DeepCopyInto(out.SeqRg)
+	}
+	if r.OrgnlMsgNmId != nil {
+		out.OrgnlMsgNmId = new(common.Max35Text)
+		*out.OrgnlMsgNmId = *r.OrgnlMsgNmId
+	}
+	if r.FileRef != nil {
+		out.FileRef = new(common.Max35Text)
+		*out.FileRef = *r.FileRef
+	}
+	r.Rcpt.DeepCopyInto(&out.Rcpt)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *SequenceRange1) DeepCopy() *SequenceRange1 {
+	if r == nil {
+		return nil
+	}
+	out := new(SequenceRange1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *SequenceRange1) DeepCopyInto(out *SequenceRange1) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *SequenceRange1Choice) DeepCopy() *SequenceRange1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(SequenceRange1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *SequenceRange1Choice) DeepCopyInto(out *SequenceRange1Choice) {
+	*out = *r
+	if r.FrToSeq != nil {
+		out.FrToSeq = make([]SequenceRange1, len(r.FrToSeq))
+		copy(out.FrToSeq, r.FrToSeq)
+	}
+	if r.EQSeq != nil {
+		out.EQSeq = make([]common.Max35Text, len(r.EQSeq))
+		copy(out.EQSeq, r.EQSeq)
+	}
+	if r.NEQSeq != nil {
+		out.NEQSeq = make([]common.Max35Text, len(r.NEQSeq))
+		copy(out.NEQSeq, r.NEQSeq)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *SupplementaryData1) DeepCopy() *SupplementaryData1 {
+	if r == nil {
+		return nil
+	}
+	out := new(SupplementaryData1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *SupplementaryData1) DeepCopyInto(out *SupplementaryData1) {
+	*out = *r
+	if r.PlcAndNm != nil {
+		out.PlcAndNm = new(common.Max350Text)
+		*out.PlcAndNm = *r.PlcAndNm
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *SupplementaryDataEnvelope1) DeepCopy() *SupplementaryDataEnvelope1 {
+	if r == nil {
+		return nil
+	}
+	out := new(SupplementaryDataEnvelope1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *SupplementaryDataEnvelope1) DeepCopyInto(out *SupplementaryDataEnvelope1) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *SystemEventAcknowledgementV01) DeepCopy() *SystemEventAcknowledgementV01 {
+	if r == nil {
+		return nil
+	}
+	out := new(SystemEventAcknowledgementV01)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *SystemEventAcknowledgementV01) DeepCopyInto(out *SystemEventAcknowledgementV01) {
+	*out = *r
+	if r.OrgtrRef != nil {
+		out.OrgtrRef = new(common.Max35Text)
+		*out.OrgtrRef = *r.OrgtrRef
+	}
+	if r.SttlmSsnIdr != nil {
+		out.SttlmSsnIdr = new(common.Exact4AlphaNumericText)
+		*out.SttlmSsnIdr = *r.SttlmSsnIdr
+	}
+	if r.AckDtls != nil {
+		out.AckDtls = new(Event1)
+		r.AckDtls.DeepCopyInto(out.AckDtls)
+	}
+	if r.SplmtryData != nil {
+		out.SplmtryData = make([]SupplementaryData1, len(r.SplmtryData))
+		for i := range r.SplmtryData {
+			r.SplmtryData[i].DeepCopyInto(&out.SplmtryData[i])
+		}
+	}
+}
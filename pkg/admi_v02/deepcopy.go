@@ -0,0 +1,210 @@
+// Code generated by deepcopy-gen. DO NOT EDIT.
+
+package admi_v02
+
+import (
+	"github.com/moov-io/iso20022/pkg/common"
+)
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *Event2) DeepCopy() *Event2 {
+	if r == nil {
+		return nil
+	}
+	out := new(Event2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *Event2) DeepCopyInto(out *Event2) {
+	*out = *r
+	if r.EvtParam != nil {
+		out.EvtParam = make([]common.Max35Text, len(r.EvtParam))
+		copy(out.EvtParam, r.EvtParam)
+	}
+	if r.EvtDesc != nil {
+		out.EvtDesc = new(common.Max1000Text)
+		*out.EvtDesc = *r.EvtDesc
+	}
+	if r.EvtTm != nil {
+		out.EvtTm = new(common.ISODateTime)
+		*out.EvtTm = *r.EvtTm
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ReportParameter1) DeepCopy() *ReportParameter1 {
+	if r == nil {
+		return nil
+	}
+	out := new(ReportParameter1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ReportParameter1) DeepCopyInto(out *ReportParameter1) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *RequestDetails3) DeepCopy() *RequestDetails3 {
+	if r == nil {
+		return nil
+	}
+	out := new(RequestDetails3)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *RequestDetails3) DeepCopyInto(out *RequestDetails3) {
+	*out = *r
+	if r.Key != nil {
+		out.Key = new(common.Max35Text)
+		*out.Key = *r.Key
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *RequestDetails4) DeepCopy() *RequestDetails4 {
+	if r == nil {
+		return nil
+	}
+	out := new(RequestDetails4)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *RequestDetails4) DeepCopyInto(out *RequestDetails4) {
+	*out = *r
+	if r.RptData != nil {
+		out.RptData = make([]ReportParameter1, len(r.RptData))
+		copy(out.RptData, r.RptData)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *RequestDetails5) DeepCopy() *RequestDetails5 {
+	if r == nil {
+		return nil
+	}
+	out := new(RequestDetails5)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *RequestDetails5) DeepCopyInto(out *RequestDetails5) {
+	*out = *r
+	if r.RptKey != nil {
+		out.RptKey = make([]RequestDetails4, len(r.RptKey))
+		for i := range r.RptKey {
+			r.RptKey[i].DeepCopyInto(&out.RptKey[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *StaticDataReportV02) DeepCopy() *StaticDataReportV02 {
+	if r == nil {
+		return nil
+	}
+	out := new(StaticDataReportV02)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *StaticDataReportV02) DeepCopyInto(out *StaticDataReportV02) {
+	*out = *r
+	if r.SttlmSsnIdr != nil {
+		out.SttlmSsnIdr = new(common.Exact4AlphaNumericText)
+		*out.SttlmSsnIdr = *r.SttlmSsnIdr
+	}
+	r.RptDtls.DeepCopyInto(&out.RptDtls)
+	if r.SplmtryData != nil {
+		out.SplmtryData = make([]SupplementaryData1, len(r.SplmtryData))
+		for i := range r.SplmtryData {
+			r.SplmtryData[i].DeepCopyInto(&out.SplmtryData[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *StaticDataRequestV02) DeepCopy() *StaticDataRequestV02 {
+	if r == nil {
+		return nil
+	}
+	out := new(StaticDataRequestV02)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *StaticDataRequestV02) DeepCopyInto(out *StaticDataRequestV02) {
+	*out = *r
+	if r.SttlmSsnIdr != nil {
+		out.SttlmSsnIdr = new(common.Exact4AlphaNumericText)
+		*out.SttlmSsnIdr = *r.SttlmSsnIdr
+	}
+	r.DataReqDtls.DeepCopyInto(&out.DataReqDtls)
+	if r.SplmtryData != nil {
+		out.SplmtryData = make([]SupplementaryData1, len(r.SplmtryData))
+		for i := range r.SplmtryData {
+			r.SplmtryData[i].DeepCopyInto(&out.SplmtryData[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *SupplementaryData1) DeepCopy() *SupplementaryData1 {
+	if r == nil {
+		return nil
+	}
+	out := new(SupplementaryData1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *SupplementaryData1) DeepCopyInto(out *SupplementaryData1) {
+	*out = *r
+	if r.PlcAndNm != nil {
+		out.PlcAndNm = new(common.Max350Text)
+		*out.PlcAndNm = *r.PlcAndNm
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *SupplementaryDataEnvelope1) DeepCopy() *SupplementaryDataEnvelope1 {
+	if r == nil {
+		return nil
+	}
+	out := new(SupplementaryDataEnvelope1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *SupplementaryDataEnvelope1) DeepCopyInto(out *SupplementaryDataEnvelope1) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *SystemEventNotificationV02) DeepCopy() *SystemEventNotificationV02 {
+	if r == nil {
+		return nil
+	}
+	out := new(SystemEventNotificationV02)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *SystemEventNotificationV02) DeepCopyInto(out *SystemEventNotificationV02) {
+	*out = *r
+	r.EvtInf.DeepCopyInto(&out.EvtInf)
+}
@@ -0,0 +1,517 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package as2
+
+/*
+	As2 receives the payment files trading partners send over AS2 (RFC 4130) into a pipeline
+	and answers with the message disposition notifications (MDNs) they request:
+
+		cert, key, err := as2.LoadKeyPair("as2.crt", "as2.key")
+		bank, err := as2.LoadCertificate("bank.crt")
+		receiver := as2.NewReceiver("MOOV", cert, key, as2.Partner{ID: "BANK", Certificate: bank, RequireEncryption: true})
+		http.Handle("/as2", receiver)
+
+		p := pipeline.New(receiver.Source(), receiver.Sink(pipeline.Output(layout)),
+			pipeline.WithErrorSink(receiver.ErrorSink(deadletter)))
+
+	Messages are decrypted with the key of the receiver, decompressed and their signature is
+	verified with the certificate of their partner. Partners with a certificate must sign their
+	messages and partners requiring encryption must encrypt them.
+
+	A message is answered once the pipeline processed it: the MDN is processed when the sink
+	succeeded and processed/error when the error sink handled it, e.g. a message failing
+	validation. Failures of the sink are answered with 503 Service Unavailable and no MDN, so
+	that partners resend the message. MDNs are signed when partners request it, synchronous
+	MDNs are the responses of messages and asynchronous MDNs are posted to the url of their
+	Receipt-Delivery-Option header. Asynchronous MDNs are only posted for the authenticated
+	messages of a partner, to one of its MDNURLs: other messages are answered synchronously.
+*/
+
+import (
+	"context"
+	"crypto"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/textproto"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/moov-io/base/log"
+
+	"github.com/moov-io/iso20022/pkg/pipeline"
+)
+
+const (
+	// HeaderFrom, HeaderTo, HeaderMessageID and HeaderFilename are the headers of the items
+	// emitted by a receiver, the AS2 identifiers of the partner and receiver, the id of the
+	// message and the file name of its content when given
+	HeaderFrom      = "x-as2-from"
+	HeaderTo        = "x-as2-to"
+	HeaderMessageID = "x-as2-message-id"
+	HeaderFilename  = "x-as2-filename"
+
+	// DefaultTimeout bounds the processing of a message by the pipeline
+	DefaultTimeout = time.Minute
+
+	maxMessageSize = 64 << 20
+	maxNesting     = 8
+)
+
+var (
+	// ErrNotRunning is answered with 503 Service Unavailable when the source of a receiver
+	// isn't running
+	ErrNotRunning = errors.New("as2: receiver isn't running")
+
+	errInFlight = errors.New("as2: message is already being processed")
+)
+
+// Partner is a trading partner sending messages to a receiver
+type Partner struct {
+	// ID is the AS2 identifier of the partner, the AS2-From header of its messages
+	ID string
+
+	// Certificate verifies the signatures of the messages of the partner, which must be
+	// signed when it's set
+	Certificate *x509.Certificate
+
+	// RequireEncryption rejects the messages of the partner which aren't encrypted
+	RequireEncryption bool
+
+	// MDNURLs are the urls of the Receipt-Delivery-Option header the asynchronous MDNs of the
+	// partner are posted to, the MDNs requested at other urls are sent in the response
+	MDNURLs []string
+}
+
+// Receiver is the http handler of the AS2 messages of partners, delivered to the pipeline of
+// its source
+type Receiver struct {
+	// ID is the AS2 identifier of the receiver, the AS2-To header of the messages of partners
+	ID string
+
+	// Certificate and Key decrypt messages and sign MDNs, the key must be a crypto.Decrypter
+	// to decrypt
+	Certificate *x509.Certificate
+	Key         crypto.Signer
+
+	// Partners are the partners allowed to send messages by AS2 identifier
+	Partners map[string]Partner
+
+	// Timeout bounds the processing of a message by the pipeline, DefaultTimeout when zero
+	Timeout time.Duration
+
+	// HTTPClient posts asynchronous MDNs, http.DefaultClient when nil
+	HTTPClient *http.Client
+
+	// Logger logs the failures of asynchronous MDNs, the default logger when nil
+	Logger log.Logger
+
+	mu      sync.Mutex
+	items   chan pipeline.Item
+	stopped chan struct{}
+	pending map[string]chan error
+	now     func() time.Time
+}
+
+// NewReceiver returns the receiver id of the messages of partners
+func NewReceiver(id string, cert *x509.Certificate, key crypto.Signer, partners ...Partner) *Receiver {
+	r := &Receiver{
+		ID:          id,
+		Certificate: cert,
+		Key:         key,
+		Partners:    make(map[string]Partner),
+	}
+	for _, partner := range partners {
+		r.Partners[partner.ID] = partner
+	}
+	return r
+}
+
+// LoadKeyPair reads the certificate and private key of a receiver from pem files
+func LoadKeyPair(certFile, keyFile string) (*x509.Certificate, crypto.Signer, error) {
+	pair, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, nil, err
+	}
+	cert, err := x509.ParseCertificate(pair.Certificate[0])
+	if err != nil {
+		return nil, nil, err
+	}
+	key, ok := pair.PrivateKey.(crypto.Signer)
+	if !ok {
+		return nil, nil, fmt.Errorf("unsupported private key %T", pair.PrivateKey)
+	}
+	return cert, key, nil
+}
+
+// LoadCertificate reads the certificate of a partner from a pem or der file
+func LoadCertificate(path string) (*x509.Certificate, error) {
+	buf, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if block, _ := pem.Decode(buf); block != nil {
+		buf = block.Bytes
+	}
+	return x509.ParseCertificate(buf)
+}
+
+func (r *Receiver) timeout() time.Duration {
+	if r.Timeout > 0 {
+		return r.Timeout
+	}
+	return DefaultTimeout
+}
+
+func (r *Receiver) logger() log.Logger {
+	if r.Logger != nil {
+		return r.Logger
+	}
+	return log.NewDefaultLogger()
+}
+
+func (r *Receiver) clock() time.Time {
+	if r.now != nil {
+		return r.now()
+	}
+	return time.Now()
+}
+
+// ServeHTTP receives a message and answers once the pipeline processed it
+func (r *Receiver) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "AS2 messages are posted", http.StatusMethodNotAllowed)
+		return
+	}
+	msg := message{
+		from: unquoteName(req.Header.Get("AS2-From")),
+		to:   unquoteName(req.Header.Get("AS2-To")),
+		id:   strings.TrimSpace(req.Header.Get("Message-ID")),
+		opts: parseMDNOptions(req.Header),
+	}
+	if msg.from == "" || msg.to == "" || msg.id == "" {
+		http.Error(w, "AS2-From, AS2-To and Message-ID headers are required", http.StatusBadRequest)
+		return
+	}
+	body, err := io.ReadAll(http.MaxBytesReader(w, req.Body, maxMessageSize))
+	if err != nil {
+		http.Error(w, fmt.Sprintf("reading message: %v", err), http.StatusBadRequest)
+		return
+	}
+
+	payload, mic, err := r.open(msg, req.Header, body)
+	if err != nil || !r.Partners[msg.from].allowsMDN(msg.opts.url) {
+		// the urls of messages failing authentication or unknown to the partner aren't posted to
+		msg.opts.url = ""
+	}
+	if err == nil {
+		ctx := req.Context()
+		if msg.opts.url != "" {
+			// the partner waits for the MDN of the url, not for the response
+			ctx = context.Background()
+		}
+		err = r.process(ctx, msg, payload)
+	}
+	r.respond(w, msg, mic, err)
+}
+
+// allowsMDN reports whether asynchronous MDNs of the partner are posted to url
+func (p Partner) allowsMDN(url string) bool {
+	for _, allowed := range p.MDNURLs {
+		if url == allowed {
+			return true
+		}
+	}
+	return false
+}
+
+// open returns the decrypted, decompressed and verified content of a message and its MIC
+func (r *Receiver) open(msg message, header http.Header, body []byte) (*entity, []byte, error) {
+	partner, exists := r.Partners[msg.from]
+	if !exists {
+		return nil, nil, &failure{modifierAuthentication, fmt.Errorf("unknown trading partner %s", msg.from)}
+	}
+	if msg.to != r.ID {
+		return nil, nil, &failure{modifierAuthentication, fmt.Errorf("message is sent to %s instead of %s", msg.to, r.ID)}
+	}
+	e, err := newEntity(textproto.MIMEHeader(header), nil, body)
+	if err != nil {
+		return nil, nil, &failure{modifierUnexpected, err}
+	}
+
+	var signed, encrypted bool
+	var micContent []byte
+	for depth := 0; depth < maxNesting; depth++ {
+		switch {
+		case e.mediaType == "application/pkcs7-mime" || e.mediaType == "application/x-pkcs7-mime":
+			data, err := e.content()
+			if err != nil {
+				return nil, nil, &failure{modifierUnexpected, err}
+			}
+			var inner []byte
+			switch kind := strings.ToLower(e.params["smime-type"]); kind {
+			case "enveloped-data", "":
+				if inner, err = decrypt(data, r.Certificate, r.Key); err != nil {
+					return nil, nil, &failure{modifierDecryption, err}
+				}
+				encrypted = true
+				if !signed {
+					micContent = inner
+				}
+			case "compressed-data":
+				if inner, err = decompress(data, maxMessageSize); err != nil {
+					return nil, nil, &failure{modifierDecompression, err}
+				}
+			case "signed-data":
+				if partner.Certificate == nil {
+					return nil, nil, &failure{modifierAuthentication, fmt.Errorf("no certificate of %s verifies signatures", partner.ID)}
+				}
+				if inner, err = verify(data, nil, partner.Certificate); err != nil {
+					return nil, nil, signatureFailure(err)
+				}
+				signed, micContent = true, inner
+			default:
+				return nil, nil, &failure{modifierUnexpected, fmt.Errorf("unsupported smime-type %s", kind)}
+			}
+			if e, err = parseEntity(inner); err != nil {
+				return nil, nil, &failure{modifierUnexpected, err}
+			}
+
+		case e.mediaType == "multipart/signed":
+			if partner.Certificate == nil {
+				return nil, nil, &failure{modifierAuthentication, fmt.Errorf("no certificate of %s verifies signatures", partner.ID)}
+			}
+			parts, err := splitMultipart(e.body, e.params["boundary"])
+			if err != nil || len(parts) != 2 {
+				return nil, nil, &failure{modifierIntegrity, fmt.Errorf("signed content isn't a content and its signature: %v", err)}
+			}
+			sig, err := parseEntity(parts[1])
+			if err != nil {
+				return nil, nil, &failure{modifierIntegrity, err}
+			}
+			signature, err := sig.content()
+			if err != nil {
+				return nil, nil, &failure{modifierIntegrity, err}
+			}
+			if err := verifyDetached(signature, parts[0], partner.Certificate); err != nil {
+				return nil, nil, signatureFailure(err)
+			}
+			signed, micContent = true, parts[0]
+			if e, err = parseEntity(parts[0]); err != nil {
+				return nil, nil, &failure{modifierUnexpected, err}
+			}
+
+		default:
+			content, err := e.content()
+			if err != nil {
+				return nil, nil, &failure{modifierUnexpected, err}
+			}
+			if partner.Certificate != nil && !signed {
+				return nil, nil, &failure{modifierSecurity, errors.New("message isn't signed")}
+			}
+			if partner.RequireEncryption && !encrypted {
+				return nil, nil, &failure{modifierSecurity, errors.New("message isn't encrypted")}
+			}
+			if micContent == nil {
+				// the MIC of plain messages is of their content, without headers
+				micContent = content
+			}
+			e.body = content
+			return e, digest(msg.opts.hash, micContent), nil
+		}
+	}
+	return nil, nil, &failure{modifierUnexpected, fmt.Errorf("message is nested more than %d times", maxNesting)}
+}
+
+func signatureFailure(err error) error {
+	if errors.Is(err, errIntegrity) {
+		return &failure{modifierIntegrity, err}
+	}
+	return &failure{modifierAuthentication, err}
+}
+
+// process delivers the payload of a message to the source and waits for the pipeline to
+// process it
+func (r *Receiver) process(ctx context.Context, msg message, payload *entity) error {
+	name := payload.filename()
+	headers := map[string]string{
+		HeaderFrom:      msg.from,
+		HeaderTo:        msg.to,
+		HeaderMessageID: msg.id,
+	}
+	if name != "" {
+		headers[HeaderFilename] = name
+	} else {
+		name = strings.Trim(msg.id, "<>")
+	}
+	item := pipeline.Item{Name: name, Input: payload.body, Headers: headers}
+
+	r.mu.Lock()
+	items, stopped := r.items, r.stopped
+	if items == nil {
+		r.mu.Unlock()
+		return ErrNotRunning
+	}
+	if _, exists := r.pending[msg.id]; exists {
+		r.mu.Unlock()
+		return errInFlight
+	}
+	if r.pending == nil {
+		r.pending = make(map[string]chan error)
+	}
+	done := make(chan error, 1)
+	r.pending[msg.id] = done
+	r.mu.Unlock()
+	defer func() {
+		r.mu.Lock()
+		delete(r.pending, msg.id)
+		r.mu.Unlock()
+	}()
+
+	timer := time.NewTimer(r.timeout())
+	defer timer.Stop()
+	select {
+	case items <- item:
+	case <-stopped:
+		return ErrNotRunning
+	case <-timer.C:
+		return fmt.Errorf("as2: message %s wasn't accepted in %v", msg.id, r.timeout())
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	select {
+	case err := <-done:
+		return err
+	case <-stopped:
+		return ErrNotRunning
+	case <-timer.C:
+		return fmt.Errorf("as2: message %s wasn't processed in %v", msg.id, r.timeout())
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// respond answers a message with its MDN when requested
+func (r *Receiver) respond(w http.ResponseWriter, msg message, mic []byte, err error) {
+	var failed *failure
+	if err != nil && !errors.As(err, &failed) {
+		http.Error(w, err.Error(), http.StatusServiceUnavailable)
+		return
+	}
+	if !msg.opts.requested {
+		if failed != nil {
+			http.Error(w, failed.Error(), http.StatusBadRequest)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	header, body, err := r.mdn(msg, mic, failed)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if msg.opts.url == "" {
+		for key, values := range header {
+			w.Header()[key] = values
+		}
+		w.WriteHeader(http.StatusOK)
+		w.Write(body)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	if flusher, ok := w.(http.Flusher); ok {
+		flusher.Flush()
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), r.timeout())
+	defer cancel()
+	if err := r.send(ctx, msg.opts.url, header, body); err != nil {
+		r.logger().Warn().With(log.Fields{
+			"as2From":   log.String(msg.from),
+			"messageID": log.String(msg.id),
+		}).LogErrorf("sending asynchronous mdn: %v", err)
+	}
+}
+
+// Source emits the messages received by the receiver until ctx is done, named by the file
+// name of their content or their message id. A receiver has one running source at a time.
+func (r *Receiver) Source() pipeline.Source {
+	return func(ctx context.Context, emit func(pipeline.Item) error) error {
+		items, stopped := make(chan pipeline.Item), make(chan struct{})
+		r.mu.Lock()
+		if r.items != nil {
+			r.mu.Unlock()
+			return errors.New("as2: receiver already has a running source")
+		}
+		r.items, r.stopped = items, stopped
+		r.mu.Unlock()
+		defer func() {
+			r.mu.Lock()
+			r.items, r.stopped = nil, nil
+			close(stopped)
+			r.mu.Unlock()
+		}()
+
+		for {
+			select {
+			case item := <-items:
+				if err := emit(item); err != nil {
+					return err
+				}
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+	}
+}
+
+// Sink answers the messages of the receiver processed by sink, which may be nil, with a
+// processed MDN and the messages sink fails with 503 Service Unavailable
+func (r *Receiver) Sink(sink pipeline.Sink) pipeline.Sink {
+	return func(ctx context.Context, item pipeline.Item) error {
+		var err error
+		if sink != nil {
+			err = sink(ctx, item)
+		}
+		r.complete(item, err)
+		return err
+	}
+}
+
+// ErrorSink answers the failed messages of the receiver handled by sink, which may be nil,
+// with a processed/error MDN reporting their error
+func (r *Receiver) ErrorSink(sink pipeline.Sink) pipeline.Sink {
+	return func(ctx context.Context, item pipeline.Item) error {
+		if sink != nil {
+			if err := sink(ctx, item); err != nil {
+				r.complete(item, err)
+				return err
+			}
+		}
+		r.complete(item, &failure{modifierUnexpected, fmt.Errorf("%s: %w", item.Stage, item.Err)})
+		return nil
+	}
+}
+
+func (r *Receiver) complete(item pipeline.Item, err error) {
+	r.mu.Lock()
+	done := r.pending[item.Headers[HeaderMessageID]]
+	r.mu.Unlock()
+	if done != nil {
+		select {
+		case done <- err:
+		default:
+		}
+	}
+}
@@ -0,0 +1,362 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package as2
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/textproto"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/moov-io/iso20022/pkg/iso20022test/fixtures"
+	"github.com/moov-io/iso20022/pkg/pipeline"
+)
+
+type as2Test struct {
+	receiver *Receiver
+	server   *httptest.Server
+
+	cert, bankCert *x509.Certificate
+	bankKey        crypto.Signer
+
+	mu    sync.Mutex
+	items []pipeline.Item
+}
+
+// newAS2Test runs a receiver of the partners BANK, signing and encrypting its messages, and
+// PLAIN, whose messages are neither signed nor encrypted
+func newAS2Test(t *testing.T, sink pipeline.Sink) *as2Test {
+	cert, key := newKeyPair(t, "MOOV")
+	bankCert, bankKey := newKeyPair(t, "BANK")
+	test := &as2Test{cert: cert, bankCert: bankCert, bankKey: bankKey}
+	test.receiver = NewReceiver("MOOV", cert, key,
+		Partner{ID: "BANK", Certificate: bankCert, RequireEncryption: true},
+		Partner{ID: "PLAIN"})
+	test.receiver.Timeout = 5 * time.Second
+	test.server = httptest.NewServer(test.receiver)
+	t.Cleanup(test.server.Close)
+
+	if sink == nil {
+		sink = func(_ context.Context, item pipeline.Item) error {
+			test.mu.Lock()
+			defer test.mu.Unlock()
+			test.items = append(test.items, item)
+			return nil
+		}
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		pipeline.New(test.receiver.Source(), test.receiver.Sink(sink),
+			pipeline.WithErrorSink(test.receiver.ErrorSink(nil)), pipeline.WithWorkers(1)).Run(ctx)
+	}()
+	t.Cleanup(func() {
+		cancel()
+		<-done
+	})
+	require.Eventually(t, func() bool {
+		test.receiver.mu.Lock()
+		defer test.receiver.mu.Unlock()
+		return test.receiver.items != nil
+	}, 5*time.Second, time.Millisecond)
+	return test
+}
+
+func (test *as2Test) received() []pipeline.Item {
+	test.mu.Lock()
+	defer test.mu.Unlock()
+	return append([]pipeline.Item{}, test.items...)
+}
+
+// post sends raw, the entity of a message, with the headers requesting its MDN
+func (test *as2Test) post(t *testing.T, from, id string, raw []byte, header http.Header) *http.Response {
+	t.Helper()
+	e, err := parseEntity(raw)
+	require.Nil(t, err)
+	req, err := http.NewRequest(http.MethodPost, test.server.URL, bytes.NewReader(e.body))
+	require.Nil(t, err)
+	for key, values := range e.header {
+		req.Header[key] = values
+	}
+	for key, values := range header {
+		req.Header[key] = values
+	}
+	req.Header.Set("AS2-Version", "1.2")
+	req.Header.Set("AS2-From", from)
+	req.Header.Set("AS2-To", "MOOV")
+	req.Header.Set("Message-ID", id)
+	resp, err := http.DefaultClient.Do(req)
+	require.Nil(t, err)
+	t.Cleanup(func() { resp.Body.Close() })
+	return resp
+}
+
+var signedMDN = http.Header{
+	"Disposition-Notification-To":      {"as2@bank.example"},
+	"Disposition-Notification-Options": {"signed-receipt-protocol=optional, pkcs7-signature; signed-receipt-micalg=optional, sha-256, sha1"},
+}
+
+// readMDN returns the fields of the disposition notification of an MDN, verifying its
+// signature when signed by cert
+func readMDN(t *testing.T, header http.Header, body []byte, cert *x509.Certificate) textproto.MIMEHeader {
+	t.Helper()
+	e, err := newEntity(textproto.MIMEHeader(header), nil, body)
+	require.Nil(t, err)
+	if e.mediaType == "multipart/signed" {
+		parts, err := splitMultipart(e.body, e.params["boundary"])
+		require.Nil(t, err)
+		require.Len(t, parts, 2)
+		sig, err := parseEntity(parts[1])
+		require.Nil(t, err)
+		signature, err := sig.content()
+		require.Nil(t, err)
+		_, err = verify(signature, parts[0], cert)
+		require.Nil(t, err)
+		e, err = parseEntity(parts[0])
+		require.Nil(t, err)
+	}
+	require.Equal(t, "multipart/report", e.mediaType)
+	require.Equal(t, "disposition-notification", e.params["report-type"])
+	parts, err := splitMultipart(e.body, e.params["boundary"])
+	require.Nil(t, err)
+	require.Len(t, parts, 2)
+	notification, err := parseEntity(parts[1])
+	require.Nil(t, err)
+	require.Equal(t, "message/disposition-notification", notification.mediaType)
+	fields, err := textproto.NewReader(bufio.NewReader(bytes.NewReader(append(notification.body, "\r\n"...)))).ReadMIMEHeader()
+	require.Nil(t, err)
+	return fields
+}
+
+func mic(content []byte) string {
+	sum := sha256.Sum256(content)
+	return base64.StdEncoding.EncodeToString(sum[:]) + ", sha-256"
+}
+
+func TestReceiver(t *testing.T) {
+	test := newAS2Test(t, nil)
+	payload := payloadEntity("pacs.xml", fixtures.ReadFile(t, "valid_pacs_v08.xml"))
+	signed := signedEntity(t, payload, test.bankCert, test.bankKey)
+
+	resp := test.post(t, "BANK", "<1@bank.example>", encryptedEntity(t, signed, test.cert), signedMDN)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, "MOOV", resp.Header.Get("AS2-From"))
+	require.Equal(t, "BANK", resp.Header.Get("AS2-To"))
+	body, err := io.ReadAll(resp.Body)
+	require.Nil(t, err)
+	fields := readMDN(t, resp.Header, body, test.cert)
+	require.Equal(t, "<1@bank.example>", fields.Get("Original-Message-ID"))
+	require.Equal(t, "automatic-action/MDN-sent-automatically; processed", fields.Get("Disposition"))
+	// the MIC of signed messages is of their signed entity, headers included
+	require.Equal(t, mic(payload), fields.Get("Received-Content-MIC"))
+
+	items := test.received()
+	require.Len(t, items, 1)
+	item := items[0]
+	require.Equal(t, "pacs.xml", item.Name)
+	require.Equal(t, fixtures.ReadFile(t, "valid_pacs_v08.xml"), item.Input)
+	require.Equal(t, map[string]string{HeaderFrom: "BANK", HeaderTo: "MOOV", HeaderMessageID: "<1@bank.example>", HeaderFilename: "pacs.xml"}, item.Headers)
+	require.NotNil(t, item.Document)
+
+	// compressed before signing and encryption
+	compressed := signedEntity(t, compressedEntity(t, payload), test.bankCert, test.bankKey)
+	resp = test.post(t, "BANK", "<2@bank.example>", encryptedEntity(t, compressed, test.cert), signedMDN)
+	body, _ = io.ReadAll(resp.Body)
+	fields = readMDN(t, resp.Header, body, test.cert)
+	require.Equal(t, "automatic-action/MDN-sent-automatically; processed", fields.Get("Disposition"))
+	items = test.received()
+	require.Len(t, items, 2)
+	require.Equal(t, "pacs.xml", items[1].Name)
+
+	// plain messages without a file name and mdn
+	resp = test.post(t, "PLAIN", "<3@plain.example>", []byte("Content-Type: application/xml\r\n\r\n"+string(fixtures.ReadFile(t, "valid_camt053_v08.xml"))), nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	body, _ = io.ReadAll(resp.Body)
+	require.Empty(t, body)
+	items = test.received()
+	require.Len(t, items, 3)
+	require.Equal(t, "3@plain.example", items[2].Name)
+	require.Equal(t, "", items[2].Headers[HeaderFilename])
+
+	resp = test.post(t, "PLAIN", "<4@plain.example>", payload, http.Header{"Disposition-Notification-To": {"as2@plain.example"}})
+	body, _ = io.ReadAll(resp.Body)
+	require.Equal(t, "multipart/report", mediaType(resp.Header))
+	fields = readMDN(t, resp.Header, body, nil)
+	// the MIC of plain messages is of their content
+	require.Equal(t, mic(fixtures.ReadFile(t, "valid_pacs_v08.xml")), fields.Get("Received-Content-MIC"))
+}
+
+func mediaType(header http.Header) string {
+	e, _ := newEntity(textproto.MIMEHeader(header), nil, nil)
+	return e.mediaType
+}
+
+func TestReceiverFailures(t *testing.T) {
+	test := newAS2Test(t, nil)
+	payload := payloadEntity("pacs.xml", fixtures.ReadFile(t, "valid_pacs_v08.xml"))
+	otherCert, otherKey := newKeyPair(t, "BANK")
+
+	tampered := signedEntity(t, payload, test.bankCert, test.bankKey)
+	tampered = bytes.Replace(tampered, []byte("<Document"), []byte("<Document "), 1)
+
+	cases := []struct {
+		name, from string
+		raw        []byte
+		modifier   string
+	}{
+		{"unknown partner", "OTHER", payload, modifierAuthentication},
+		{"unsigned", "BANK", encryptedEntity(t, payload, test.cert), modifierSecurity},
+		{"unencrypted", "BANK", signedEntity(t, payload, test.bankCert, test.bankKey), modifierSecurity},
+		{"signed by another key", "BANK", encryptedEntity(t, signedEntity(t, payload, otherCert, otherKey), test.cert), modifierAuthentication},
+		{"tampered", "BANK", encryptedEntity(t, tampered, test.cert), modifierIntegrity},
+		{"encrypted for another certificate", "BANK", encryptedEntity(t, signedEntity(t, payload, test.bankCert, test.bankKey), otherCert), modifierDecryption},
+		{"invalid document", "PLAIN", payloadEntity("invalid.xml", []byte("<Document><Unknown/></Document>")), modifierUnexpected},
+	}
+	for i, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			resp := test.post(t, tc.from, fmt.Sprintf("<%d@bank.example>", i), tc.raw, signedMDN)
+			require.Equal(t, http.StatusOK, resp.StatusCode)
+			body, _ := io.ReadAll(resp.Body)
+			fields := readMDN(t, resp.Header, body, test.cert)
+			require.Equal(t, "automatic-action/MDN-sent-automatically; processed/error: "+tc.modifier, fields.Get("Disposition"))
+		})
+	}
+	require.Empty(t, test.received())
+
+	// without mdn the failures are the status of the response
+	resp := test.post(t, "OTHER", "<10@bank.example>", payload, nil)
+	require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	resp = test.post(t, "BANK", "", payload, nil)
+	require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	resp, err := http.Get(test.server.URL)
+	require.Nil(t, err)
+	resp.Body.Close()
+	require.Equal(t, http.StatusMethodNotAllowed, resp.StatusCode)
+}
+
+func TestReceiverReplayedSignature(t *testing.T) {
+	test := newAS2Test(t, nil)
+	payload := payloadEntity("pacs.xml", fixtures.ReadFile(t, "valid_pacs_v08.xml"))
+	signature, err := sign(payload, test.bankCert, test.bankKey, crypto.SHA256, time.Now())
+	require.Nil(t, err)
+
+	// a signature of the partner encapsulating the payload it signed doesn't sign another content
+	forged := multipartSignedEntity(payloadEntity("evil.xml", fixtures.ReadFile(t, "valid_camt053_v08.xml")), attachContent(t, signature, payload))
+	resp := test.post(t, "BANK", "<1@bank.example>", encryptedEntity(t, forged, test.cert), signedMDN)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	body, err := io.ReadAll(resp.Body)
+	require.Nil(t, err)
+	fields := readMDN(t, resp.Header, body, test.cert)
+	require.Equal(t, "automatic-action/MDN-sent-automatically; processed/error: "+modifierIntegrity, fields.Get("Disposition"))
+	require.Empty(t, test.received())
+
+	// attached signatures of the content of the entity are accepted
+	attached := multipartSignedEntity(payload, attachContent(t, signature, payload))
+	resp = test.post(t, "BANK", "<2@bank.example>", encryptedEntity(t, attached, test.cert), signedMDN)
+	body, err = io.ReadAll(resp.Body)
+	require.Nil(t, err)
+	fields = readMDN(t, resp.Header, body, test.cert)
+	require.Equal(t, "automatic-action/MDN-sent-automatically; processed", fields.Get("Disposition"))
+	require.Len(t, test.received(), 1)
+}
+
+func TestReceiverAsyncMDN(t *testing.T) {
+	test := newAS2Test(t, nil)
+	mdns := make(chan *http.Request, 1)
+	bodies := make(chan []byte, 1)
+	partner := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		mdns <- r
+		bodies <- body
+	}))
+	defer partner.Close()
+	bank := test.receiver.Partners["BANK"]
+	bank.MDNURLs = []string{partner.URL}
+	test.receiver.Partners["BANK"] = bank
+
+	deliver := func(url string) http.Header {
+		header := http.Header{"Receipt-Delivery-Option": {url}}
+		for key, values := range signedMDN {
+			header[key] = values
+		}
+		return header
+	}
+	payload := payloadEntity("pacs.xml", fixtures.ReadFile(t, "valid_pacs_v08.xml"))
+	signed := encryptedEntity(t, signedEntity(t, payload, test.bankCert, test.bankKey), test.cert)
+
+	// the MDNs of unauthenticated messages and unknown urls are sent in the response
+	cases := []struct {
+		name, from, url string
+		raw             []byte
+		disposition     string
+	}{
+		{"unknown partner", "OTHER", partner.URL, payload, "processed/error: " + modifierAuthentication},
+		{"unsigned", "BANK", partner.URL, encryptedEntity(t, payload, test.cert), "processed/error: " + modifierSecurity},
+		{"url of another partner", "PLAIN", partner.URL, payload, "processed"},
+		{"unknown url", "BANK", partner.URL + "/other", signed, "processed"},
+	}
+	for i, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			resp := test.post(t, tc.from, fmt.Sprintf("<sync-%d@bank.example>", i), tc.raw, deliver(tc.url))
+			require.Equal(t, http.StatusOK, resp.StatusCode)
+			body, _ := io.ReadAll(resp.Body)
+			fields := readMDN(t, resp.Header, body, test.cert)
+			require.Equal(t, "automatic-action/MDN-sent-automatically; "+tc.disposition, fields.Get("Disposition"))
+		})
+	}
+	select {
+	case req := <-mdns:
+		t.Fatalf("mdn posted to %s", req.URL)
+	default:
+	}
+
+	resp := test.post(t, "BANK", "<1@bank.example>", signed, deliver(partner.URL))
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	body, _ := io.ReadAll(resp.Body)
+	require.Empty(t, body)
+
+	select {
+	case req := <-mdns:
+		require.Equal(t, "BANK", req.Header.Get("AS2-To"))
+		fields := readMDN(t, req.Header, <-bodies, test.cert)
+		require.Equal(t, "automatic-action/MDN-sent-automatically; processed", fields.Get("Disposition"))
+		require.Equal(t, mic(payload), fields.Get("Received-Content-MIC"))
+	case <-time.After(5 * time.Second):
+		t.Fatal("no asynchronous mdn")
+	}
+}
+
+func TestReceiverUnavailable(t *testing.T) {
+	failing := errors.New("storage is down")
+	test := newAS2Test(t, func(context.Context, pipeline.Item) error { return failing })
+	payload := payloadEntity("pacs.xml", fixtures.ReadFile(t, "valid_pacs_v08.xml"))
+
+	// failures of the sink abort the pipeline and are retried by partners
+	resp := test.post(t, "PLAIN", "<1@plain.example>", payload, signedMDN)
+	require.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+	body, _ := io.ReadAll(resp.Body)
+	require.Contains(t, string(body), "storage is down")
+
+	require.Eventually(t, func() bool {
+		resp := test.post(t, "PLAIN", "<2@plain.example>", payload, signedMDN)
+		body, _ := io.ReadAll(resp.Body)
+		return resp.StatusCode == http.StatusServiceUnavailable && bytes.Contains(body, []byte(ErrNotRunning.Error()))
+	}, 5*time.Second, 10*time.Millisecond)
+}
@@ -0,0 +1,637 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package as2
+
+import (
+	"bytes"
+	"compress/zlib"
+	"crypto"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/des"
+	"crypto/ecdsa"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"sort"
+	"time"
+)
+
+// The CMS (RFC 5652) structures of S/MIME messages: detached and attached signed data,
+// enveloped data of RSA key transport and compressed data (RFC 3274)
+
+var (
+	oidData           = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 7, 1}
+	oidSignedData     = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 7, 2}
+	oidEnvelopedData  = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 7, 3}
+	oidCompressedData = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 9, 16, 1, 9}
+
+	oidContentType   = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 9, 3}
+	oidMessageDigest = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 9, 4}
+	oidSigningTime   = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 9, 5}
+
+	oidSHA1   = asn1.ObjectIdentifier{1, 3, 14, 3, 2, 26}
+	oidSHA256 = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 2, 1}
+	oidSHA384 = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 2, 2}
+	oidSHA512 = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 2, 3}
+
+	oidRSA         = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 1, 1}
+	oidRSAOAEP     = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 1, 7}
+	oidRSAPSS      = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 1, 10}
+	oidECDSASHA256 = asn1.ObjectIdentifier{1, 2, 840, 10045, 4, 3, 2}
+	oidECDSASHA384 = asn1.ObjectIdentifier{1, 2, 840, 10045, 4, 3, 3}
+	oidECDSASHA512 = asn1.ObjectIdentifier{1, 2, 840, 10045, 4, 3, 4}
+
+	oidAES128CBC  = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 1, 2}
+	oidAES192CBC  = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 1, 22}
+	oidAES256CBC  = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 1, 42}
+	oidDESEDE3CBC = asn1.ObjectIdentifier{1, 2, 840, 113549, 3, 7}
+
+	oidZlib = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 9, 16, 3, 8}
+)
+
+var (
+	errIntegrity      = errors.New("message digest doesn't match the content")
+	errAuthentication = errors.New("signature isn't of the certificate of the partner")
+)
+
+type contentInfo struct {
+	ContentType asn1.ObjectIdentifier
+	Content     asn1.RawValue `asn1:"explicit,optional,tag:0"`
+}
+
+type encapContentInfo struct {
+	EContentType asn1.ObjectIdentifier
+	EContent     asn1.RawValue `asn1:"explicit,optional,tag:0"`
+}
+
+type signedData struct {
+	Version          int
+	DigestAlgorithms []pkix.AlgorithmIdentifier `asn1:"set"`
+	EncapContentInfo encapContentInfo
+	Certificates     asn1.RawValue `asn1:"optional,tag:0"`
+	CRLs             asn1.RawValue `asn1:"optional,tag:1"`
+	SignerInfos      []signerInfo  `asn1:"set"`
+}
+
+type signerInfo struct {
+	Version            int
+	SID                asn1.RawValue
+	DigestAlgorithm    pkix.AlgorithmIdentifier
+	SignedAttrs        asn1.RawValue `asn1:"optional,tag:0"`
+	SignatureAlgorithm pkix.AlgorithmIdentifier
+	Signature          []byte
+	UnsignedAttrs      asn1.RawValue `asn1:"optional,tag:1"`
+}
+
+type attribute struct {
+	Type   asn1.ObjectIdentifier
+	Values asn1.RawValue
+}
+
+type issuerAndSerial struct {
+	Issuer asn1.RawValue
+	Serial *big.Int
+}
+
+type envelopedData struct {
+	Version              int
+	OriginatorInfo       asn1.RawValue   `asn1:"optional,tag:0"`
+	RecipientInfos       []asn1.RawValue `asn1:"set"`
+	EncryptedContentInfo encryptedContentInfo
+	UnprotectedAttrs     asn1.RawValue `asn1:"optional,tag:1"`
+}
+
+type keyTransRecipientInfo struct {
+	Version                int
+	RID                    asn1.RawValue
+	KeyEncryptionAlgorithm pkix.AlgorithmIdentifier
+	EncryptedKey           []byte
+}
+
+type encryptedContentInfo struct {
+	ContentType                asn1.ObjectIdentifier
+	ContentEncryptionAlgorithm pkix.AlgorithmIdentifier
+	EncryptedContent           asn1.RawValue `asn1:"optional,tag:0"`
+}
+
+type compressedData struct {
+	Version              int
+	CompressionAlgorithm pkix.AlgorithmIdentifier
+	EncapContentInfo     encapContentInfo
+}
+
+type oaepParameters struct {
+	Hash pkix.AlgorithmIdentifier `asn1:"optional,explicit,tag:0"`
+}
+
+// parseContentInfo returns the content of the content info of ber, which must be of kind
+func parseContentInfo(ber []byte, kind asn1.ObjectIdentifier, content interface{}) error {
+	der, err := berToDER(ber)
+	if err != nil {
+		return err
+	}
+	var info contentInfo
+	if _, err := asn1.Unmarshal(der, &info); err != nil {
+		return err
+	}
+	if !info.ContentType.Equal(kind) {
+		return fmt.Errorf("content is %s instead of %s", info.ContentType, kind)
+	}
+	_, err = asn1.Unmarshal(info.Content.Bytes, content)
+	return err
+}
+
+// octets returns the bytes of an octet string, which BER encodings split in chunks of
+// constructed strings
+func octets(v asn1.RawValue) ([]byte, error) {
+	if !v.IsCompound {
+		return v.Bytes, nil
+	}
+	var out []byte
+	for rest := v.Bytes; len(rest) > 0; {
+		var chunk asn1.RawValue
+		var err error
+		if rest, err = asn1.Unmarshal(rest, &chunk); err != nil {
+			return nil, err
+		}
+		b, err := octets(chunk)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, b...)
+	}
+	return out, nil
+}
+
+func digestHash(oid asn1.ObjectIdentifier) (crypto.Hash, error) {
+	switch {
+	case oid.Equal(oidSHA1):
+		return crypto.SHA1, nil
+	case oid.Equal(oidSHA256):
+		return crypto.SHA256, nil
+	case oid.Equal(oidSHA384):
+		return crypto.SHA384, nil
+	case oid.Equal(oidSHA512):
+		return crypto.SHA512, nil
+	}
+	return 0, fmt.Errorf("unsupported digest algorithm %s", oid)
+}
+
+func digestOID(hash crypto.Hash) (asn1.ObjectIdentifier, error) {
+	switch hash {
+	case crypto.SHA1:
+		return oidSHA1, nil
+	case crypto.SHA256:
+		return oidSHA256, nil
+	case crypto.SHA384:
+		return oidSHA384, nil
+	case crypto.SHA512:
+		return oidSHA512, nil
+	}
+	return nil, fmt.Errorf("unsupported digest algorithm %s", hash)
+}
+
+func digest(hash crypto.Hash, content []byte) []byte {
+	h := hash.New()
+	h.Write(content)
+	return h.Sum(nil)
+}
+
+// verify checks that signature, a CMS signed data, signs content with the key of cert and
+// returns the signed content, the encapsulated content of attached signatures
+func verify(signature, content []byte, cert *x509.Certificate) ([]byte, error) {
+	var sd signedData
+	if err := parseContentInfo(signature, oidSignedData, &sd); err != nil {
+		return nil, fmt.Errorf("reading signature: %w", err)
+	}
+	if len(sd.EncapContentInfo.EContent.FullBytes) > 0 {
+		attached, err := octets(sd.EncapContentInfo.EContent)
+		if err != nil {
+			return nil, fmt.Errorf("reading signed content: %w", err)
+		}
+		content = attached
+	}
+	if len(sd.SignerInfos) == 0 {
+		return nil, errAuthentication
+	}
+	var err error
+	for _, si := range sd.SignerInfos {
+		if err = verifySigner(si, content, cert); err == nil {
+			return content, nil
+		}
+	}
+	return nil, err
+}
+
+// verifyDetached checks that signature, the detached signature of a multipart/signed entity,
+// signs content with the key of cert. Signatures encapsulating a content sign that content,
+// another signed content can't be replayed with them.
+func verifyDetached(signature, content []byte, cert *x509.Certificate) error {
+	signed, err := verify(signature, content, cert)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(signed, content) {
+		return fmt.Errorf("%w: the signature encapsulates another content", errIntegrity)
+	}
+	return nil
+}
+
+func verifySigner(si signerInfo, content []byte, cert *x509.Certificate) error {
+	hash, err := digestHash(si.DigestAlgorithm.Algorithm)
+	if err != nil {
+		return err
+	}
+	hashed := digest(hash, content)
+	if len(si.SignedAttrs.FullBytes) > 0 {
+		var messageDigest []byte
+		for rest := si.SignedAttrs.Bytes; len(rest) > 0; {
+			var attr attribute
+			if rest, err = asn1.Unmarshal(rest, &attr); err != nil {
+				return fmt.Errorf("reading signed attributes: %w", err)
+			}
+			if attr.Type.Equal(oidMessageDigest) {
+				if _, err := asn1.Unmarshal(attr.Values.Bytes, &messageDigest); err != nil {
+					return fmt.Errorf("reading message digest: %w", err)
+				}
+			}
+		}
+		if !hmac.Equal(messageDigest, hashed) {
+			return errIntegrity
+		}
+		// signatures sign the DER encoding of the SET OF attributes, not of their implicit tag
+		signed := append([]byte{0x31}, si.SignedAttrs.FullBytes[1:]...)
+		hashed = digest(hash, signed)
+	}
+
+	switch pub := cert.PublicKey.(type) {
+	case *rsa.PublicKey:
+		if si.SignatureAlgorithm.Algorithm.Equal(oidRSAPSS) {
+			err = rsa.VerifyPSS(pub, hash, hashed, si.Signature, &rsa.PSSOptions{SaltLength: rsa.PSSSaltLengthAuto})
+		} else {
+			err = rsa.VerifyPKCS1v15(pub, hash, hashed, si.Signature)
+		}
+		if err != nil {
+			return errAuthentication
+		}
+	case *ecdsa.PublicKey:
+		if !ecdsa.VerifyASN1(pub, hashed, si.Signature) {
+			return errAuthentication
+		}
+	default:
+		return fmt.Errorf("unsupported public key %T", cert.PublicKey)
+	}
+	return nil
+}
+
+// sign returns the detached CMS signature of content by key, carrying cert
+func sign(content []byte, cert *x509.Certificate, key crypto.Signer, hash crypto.Hash, now time.Time) ([]byte, error) {
+	digestAlgorithm, err := digestOID(hash)
+	if err != nil {
+		return nil, err
+	}
+	var signatureAlgorithm pkix.AlgorithmIdentifier
+	switch key.Public().(type) {
+	case *rsa.PublicKey:
+		signatureAlgorithm = pkix.AlgorithmIdentifier{Algorithm: oidRSA, Parameters: asn1.NullRawValue}
+	case *ecdsa.PublicKey:
+		switch hash {
+		case crypto.SHA384:
+			signatureAlgorithm.Algorithm = oidECDSASHA384
+		case crypto.SHA512:
+			signatureAlgorithm.Algorithm = oidECDSASHA512
+		default:
+			signatureAlgorithm.Algorithm = oidECDSASHA256
+		}
+	default:
+		return nil, fmt.Errorf("unsupported signing key %T", key.Public())
+	}
+
+	attrs, err := signedAttributes(digest(hash, content), now)
+	if err != nil {
+		return nil, err
+	}
+	set, err := asn1.Marshal(asn1.RawValue{Tag: asn1.TagSet, IsCompound: true, Bytes: attrs})
+	if err != nil {
+		return nil, err
+	}
+	signature, err := key.Sign(rand.Reader, digest(hash, set), hash)
+	if err != nil {
+		return nil, fmt.Errorf("signing: %w", err)
+	}
+	sid, err := asn1.Marshal(issuerAndSerial{Issuer: asn1.RawValue{FullBytes: cert.RawIssuer}, Serial: cert.SerialNumber})
+	if err != nil {
+		return nil, err
+	}
+
+	sd, err := asn1.Marshal(signedData{
+		Version:          1,
+		DigestAlgorithms: []pkix.AlgorithmIdentifier{{Algorithm: digestAlgorithm, Parameters: asn1.NullRawValue}},
+		EncapContentInfo: encapContentInfo{EContentType: oidData},
+		Certificates:     asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 0, IsCompound: true, Bytes: cert.Raw},
+		SignerInfos: []signerInfo{{
+			Version:            1,
+			SID:                asn1.RawValue{FullBytes: sid},
+			DigestAlgorithm:    pkix.AlgorithmIdentifier{Algorithm: digestAlgorithm, Parameters: asn1.NullRawValue},
+			SignedAttrs:        asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 0, IsCompound: true, Bytes: attrs},
+			SignatureAlgorithm: signatureAlgorithm,
+			Signature:          signature,
+		}},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalContentInfo(oidSignedData, sd)
+}
+
+// signedAttributes returns the DER encodings of the content type, signing time and message
+// digest attributes, in the order of their encodings as DER sets require
+func signedAttributes(messageDigest []byte, now time.Time) ([]byte, error) {
+	values := []struct {
+		oid   asn1.ObjectIdentifier
+		value interface{}
+	}{
+		{oidContentType, oidData},
+		{oidSigningTime, now.UTC()},
+		{oidMessageDigest, messageDigest},
+	}
+	var encoded [][]byte
+	for _, v := range values {
+		value, err := asn1.Marshal(v.value)
+		if err != nil {
+			return nil, err
+		}
+		attr, err := asn1.Marshal(attribute{Type: v.oid, Values: asn1.RawValue{Tag: asn1.TagSet, IsCompound: true, Bytes: value}})
+		if err != nil {
+			return nil, err
+		}
+		encoded = append(encoded, attr)
+	}
+	sort.Slice(encoded, func(i, j int) bool { return bytes.Compare(encoded[i], encoded[j]) < 0 })
+	return bytes.Join(encoded, nil), nil
+}
+
+func marshalContentInfo(kind asn1.ObjectIdentifier, content []byte) ([]byte, error) {
+	return asn1.Marshal(contentInfo{
+		ContentType: kind,
+		Content:     asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 0, IsCompound: true, Bytes: content},
+	})
+}
+
+// contentCipher returns the key length and block cipher of a content encryption algorithm
+func contentCipher(oid asn1.ObjectIdentifier) (int, func([]byte) (cipher.Block, error), error) {
+	switch {
+	case oid.Equal(oidAES128CBC):
+		return 16, aes.NewCipher, nil
+	case oid.Equal(oidAES192CBC):
+		return 24, aes.NewCipher, nil
+	case oid.Equal(oidAES256CBC):
+		return 32, aes.NewCipher, nil
+	case oid.Equal(oidDESEDE3CBC):
+		return 24, des.NewTripleDESCipher, nil
+	}
+	return 0, nil, fmt.Errorf("unsupported content encryption algorithm %s", oid)
+}
+
+// decrypt returns the content of enveloped, a CMS enveloped data, encrypted for cert
+func decrypt(enveloped []byte, cert *x509.Certificate, key crypto.Signer) ([]byte, error) {
+	decrypter, ok := key.(crypto.Decrypter)
+	if !ok {
+		return nil, fmt.Errorf("key %T doesn't decrypt", key)
+	}
+	var ed envelopedData
+	if err := parseContentInfo(enveloped, oidEnvelopedData, &ed); err != nil {
+		return nil, fmt.Errorf("reading encrypted content: %w", err)
+	}
+	eci := ed.EncryptedContentInfo
+	keyLen, newCipher, err := contentCipher(eci.ContentEncryptionAlgorithm.Algorithm)
+	if err != nil {
+		return nil, err
+	}
+	var iv []byte
+	if _, err := asn1.Unmarshal(eci.ContentEncryptionAlgorithm.Parameters.FullBytes, &iv); err != nil {
+		return nil, fmt.Errorf("reading iv: %w", err)
+	}
+
+	var cek []byte
+	for _, ri := range ed.RecipientInfos {
+		var ktri keyTransRecipientInfo
+		if ri.Class != asn1.ClassUniversal || ri.Tag != asn1.TagSequence {
+			continue // key agreement and other recipients
+		}
+		if _, err := asn1.Unmarshal(ri.FullBytes, &ktri); err != nil {
+			return nil, fmt.Errorf("reading recipient: %w", err)
+		}
+		if !isRecipient(ktri.RID, cert) {
+			continue
+		}
+		if cek, err = decryptKey(decrypter, ktri, keyLen); err != nil {
+			return nil, err
+		}
+		break
+	}
+	if cek == nil {
+		return nil, errors.New("message isn't encrypted for the certificate of the receiver")
+	}
+
+	ciphertext, err := octets(eci.EncryptedContent)
+	if err != nil {
+		return nil, fmt.Errorf("reading encrypted content: %w", err)
+	}
+	block, err := newCipher(cek)
+	if err != nil {
+		return nil, err
+	}
+	size := block.BlockSize()
+	if len(iv) != size || len(ciphertext) == 0 || len(ciphertext)%size != 0 {
+		return nil, errors.New("encrypted content isn't a sequence of blocks")
+	}
+	plaintext := make([]byte, len(ciphertext))
+	cipher.NewCBCDecrypter(block, iv).CryptBlocks(plaintext, ciphertext)
+
+	pad := int(plaintext[len(plaintext)-1])
+	if pad == 0 || pad > size {
+		return nil, errors.New("invalid padding of encrypted content")
+	}
+	for _, b := range plaintext[len(plaintext)-pad:] {
+		if int(b) != pad {
+			return nil, errors.New("invalid padding of encrypted content")
+		}
+	}
+	return plaintext[:len(plaintext)-pad], nil
+}
+
+// isRecipient returns true when rid, an issuer and serial number or a subject key
+// identifier, identifies cert
+func isRecipient(rid asn1.RawValue, cert *x509.Certificate) bool {
+	if rid.Class == asn1.ClassContextSpecific && rid.Tag == 0 {
+		return len(cert.SubjectKeyId) > 0 && bytes.Equal(rid.Bytes, cert.SubjectKeyId)
+	}
+	var ias issuerAndSerial
+	if _, err := asn1.Unmarshal(rid.FullBytes, &ias); err != nil {
+		return false
+	}
+	return bytes.Equal(ias.Issuer.FullBytes, cert.RawIssuer) && ias.Serial.Cmp(cert.SerialNumber) == 0
+}
+
+func decryptKey(decrypter crypto.Decrypter, ktri keyTransRecipientInfo, keyLen int) ([]byte, error) {
+	alg := ktri.KeyEncryptionAlgorithm
+	switch {
+	case alg.Algorithm.Equal(oidRSA):
+		// a random key replaces the key of invalid paddings, which then fails decrypting the
+		// content, so that paddings aren't an oracle (RFC 3218)
+		return decrypter.Decrypt(rand.Reader, ktri.EncryptedKey, &rsa.PKCS1v15DecryptOptions{SessionKeyLen: keyLen})
+	case alg.Algorithm.Equal(oidRSAOAEP):
+		var params oaepParameters
+		hash := crypto.SHA1
+		if len(alg.Parameters.FullBytes) > 0 {
+			if _, err := asn1.Unmarshal(alg.Parameters.FullBytes, &params); err != nil {
+				return nil, fmt.Errorf("reading oaep parameters: %w", err)
+			}
+			if len(params.Hash.Algorithm) > 0 {
+				var err error
+				if hash, err = digestHash(params.Hash.Algorithm); err != nil {
+					return nil, err
+				}
+			}
+		}
+		return decrypter.Decrypt(rand.Reader, ktri.EncryptedKey, &rsa.OAEPOptions{Hash: hash})
+	}
+	return nil, fmt.Errorf("unsupported key encryption algorithm %s", alg.Algorithm)
+}
+
+// decompress returns the content of compressed, a CMS compressed data of zlib
+func decompress(compressed []byte, limit int64) ([]byte, error) {
+	var cd compressedData
+	if err := parseContentInfo(compressed, oidCompressedData, &cd); err != nil {
+		return nil, fmt.Errorf("reading compressed content: %w", err)
+	}
+	if !cd.CompressionAlgorithm.Algorithm.Equal(oidZlib) {
+		return nil, fmt.Errorf("unsupported compression algorithm %s", cd.CompressionAlgorithm.Algorithm)
+	}
+	content, err := octets(cd.EncapContentInfo.EContent)
+	if err != nil {
+		return nil, fmt.Errorf("reading compressed content: %w", err)
+	}
+	r, err := zlib.NewReader(bytes.NewReader(content))
+	if err != nil {
+		return nil, fmt.Errorf("decompressing: %w", err)
+	}
+	out, err := io.ReadAll(io.LimitReader(r, limit+1))
+	if err != nil {
+		return nil, fmt.Errorf("decompressing: %w", err)
+	}
+	if int64(len(out)) > limit {
+		return nil, fmt.Errorf("decompressed content is larger than %d bytes", limit)
+	}
+	return out, nil
+}
+
+// berToDER converts the indefinite lengths of a BER encoding, which streaming S/MIME
+// implementations produce, to definite lengths
+func berToDER(ber []byte) ([]byte, error) {
+	der, _, err := berValue(ber, 0)
+	return der, err
+}
+
+func berValue(ber []byte, depth int) (der, rest []byte, err error) {
+	if depth > 32 {
+		return nil, nil, errors.New("ber: too deeply nested")
+	}
+	i := 1
+	if len(ber) < 2 {
+		return nil, nil, errors.New("ber: truncated value")
+	}
+	if ber[0]&0x1f == 0x1f {
+		for i < len(ber) && ber[i]&0x80 != 0 {
+			i++
+		}
+		i++
+	}
+	if i >= len(ber) {
+		return nil, nil, errors.New("ber: truncated tag")
+	}
+	tag, constructed := ber[:i], ber[0]&0x20 != 0
+	l := ber[i]
+	i++
+
+	var body []byte
+	switch {
+	case l == 0x80:
+		if !constructed {
+			return nil, nil, errors.New("ber: indefinite length of primitive value")
+		}
+		rest = ber[i:]
+		for {
+			if len(rest) < 2 {
+				return nil, nil, errors.New("ber: missing end of contents")
+			}
+			if rest[0] == 0 && rest[1] == 0 {
+				return appendTLV(tag, body), rest[2:], nil
+			}
+			var child []byte
+			if child, rest, err = berValue(rest, depth+1); err != nil {
+				return nil, nil, err
+			}
+			body = append(body, child...)
+		}
+	case l&0x80 != 0:
+		n := int(l & 0x7f)
+		if n > 4 || i+n > len(ber) {
+			return nil, nil, errors.New("ber: invalid length")
+		}
+		length := 0
+		for _, b := range ber[i : i+n] {
+			length = length<<8 | int(b)
+		}
+		i += n
+		if length < 0 || length > len(ber)-i {
+			return nil, nil, errors.New("ber: truncated value")
+		}
+		body, rest = ber[i:i+length], ber[i+length:]
+	default:
+		if int(l) > len(ber)-i {
+			return nil, nil, errors.New("ber: truncated value")
+		}
+		body, rest = ber[i:i+int(l)], ber[i+int(l):]
+	}
+	if !constructed {
+		return appendTLV(tag, body), rest, nil
+	}
+	var children []byte
+	for content := body; len(content) > 0; {
+		var child []byte
+		if child, content, err = berValue(content, depth+1); err != nil {
+			return nil, nil, err
+		}
+		children = append(children, child...)
+	}
+	return appendTLV(tag, children), rest, nil
+}
+
+func appendTLV(tag, body []byte) []byte {
+	out := append([]byte{}, tag...)
+	switch n := len(body); {
+	case n < 0x80:
+		out = append(out, byte(n))
+	case n < 0x100:
+		out = append(out, 0x81, byte(n))
+	case n < 0x10000:
+		out = append(out, 0x82, byte(n>>8), byte(n))
+	case n < 0x1000000:
+		out = append(out, 0x83, byte(n>>16), byte(n>>8), byte(n))
+	default:
+		out = append(out, 0x84, byte(n>>24), byte(n>>16), byte(n>>8), byte(n))
+	}
+	return append(out, body...)
+}
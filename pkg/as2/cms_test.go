@@ -0,0 +1,95 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package as2
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/moov-io/iso20022/pkg/iso20022test/fixtures"
+)
+
+func TestSignAndVerify(t *testing.T) {
+	rsaCert, rsaKey := newKeyPair(t, "BANK")
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.Nil(t, err)
+	ecCert := newCertificate(t, "BANK", ecKey)
+	content := payloadEntity("pacs.xml", fixtures.ReadFile(t, "valid_pacs_v08.xml"))
+
+	for _, hash := range []crypto.Hash{crypto.SHA1, crypto.SHA256, crypto.SHA512} {
+		signature, err := sign(content, rsaCert, rsaKey, hash, time.Now())
+		require.Nil(t, err)
+		signed, err := verify(signature, content, rsaCert)
+		require.Nil(t, err)
+		require.Equal(t, content, signed)
+	}
+
+	signature, err := sign(content, ecCert, ecKey, crypto.SHA384, time.Now())
+	require.Nil(t, err)
+	_, err = verify(signature, content, ecCert)
+	require.Nil(t, err)
+
+	_, err = verify(signature, append(content, ' '), ecCert)
+	require.ErrorIs(t, err, errIntegrity)
+	_, err = verify(signature, content, rsaCert)
+	require.ErrorIs(t, err, errAuthentication)
+	_, err = verify(content, content, rsaCert)
+	require.ErrorContains(t, err, "reading signature")
+}
+
+func TestDecrypt(t *testing.T) {
+	cert, key := newKeyPair(t, "MOOV")
+	other, _ := newKeyPair(t, "OTHER")
+	content := fixtures.ReadFile(t, "valid_pacs_v08.xml")
+
+	plain, err := decrypt(encrypt(t, content, cert), cert, key)
+	require.Nil(t, err)
+	require.Equal(t, content, plain)
+
+	_, err = decrypt(encrypt(t, content, other), cert, key)
+	require.ErrorContains(t, err, "isn't encrypted for the certificate of the receiver")
+
+	// a wrong key decrypts a random content key, failing the padding or the content
+	enveloped := encrypt(t, content, cert)
+	_, otherKey := newKeyPair(t, "MOOV")
+	plain, err = decrypt(enveloped, cert, otherKey)
+	if err == nil {
+		require.NotEqual(t, content, plain)
+	}
+}
+
+func TestDecompress(t *testing.T) {
+	content := fixtures.ReadFile(t, "valid_pacs_v08.xml")
+	out, err := decompress(compress(t, content), maxMessageSize)
+	require.Nil(t, err)
+	require.Equal(t, content, out)
+
+	_, err = decompress(compress(t, content), 16)
+	require.ErrorContains(t, err, "larger than 16 bytes")
+}
+
+func TestBERToDER(t *testing.T) {
+	// indefinite lengths of nested sequences
+	der, err := berToDER([]byte{0x30, 0x80, 0x30, 0x80, 0x04, 0x02, 0x01, 0x02, 0x00, 0x00, 0x00, 0x00})
+	require.Nil(t, err)
+	require.Equal(t, []byte{0x30, 0x06, 0x30, 0x04, 0x04, 0x02, 0x01, 0x02}, der)
+
+	// long form lengths of definite values are kept
+	long := append([]byte{0x04, 0x81, 0x80}, make([]byte, 0x80)...)
+	der, err = berToDER(long)
+	require.Nil(t, err)
+	require.Equal(t, long, der)
+
+	_, err = berToDER([]byte{0x30, 0x80, 0x04, 0x01, 0x61})
+	require.ErrorContains(t, err, "ber:")
+	_, err = berToDER([]byte{0x04, 0x80, 0x00, 0x00})
+	require.ErrorContains(t, err, "indefinite length of primitive value")
+}
@@ -0,0 +1,236 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package as2
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// Disposition modifiers of the MDNs of failed messages (RFC 4130 section 7.4.3, RFC 5402)
+const (
+	modifierAuthentication = "authentication-failed"
+	modifierDecryption     = "decryption-failed"
+	modifierDecompression  = "decompression-failed"
+	modifierSecurity       = "insufficient-message-security"
+	modifierIntegrity      = "integrity-check-failed"
+	modifierUnexpected     = "unexpected-processing-error"
+
+	reportingUA = "moov-io/iso20022"
+)
+
+// failure is the error of a message answered with a processed/error MDN
+type failure struct {
+	modifier string
+	err      error
+}
+
+func (f *failure) Error() string {
+	return f.modifier + ": " + f.err.Error()
+}
+
+func (f *failure) Unwrap() error {
+	return f.err
+}
+
+// micAlgorithms are the digests of the signed-receipt-micalg option, by their names of
+// RFC 5751 and of older implementations
+var micAlgorithms = map[string]crypto.Hash{
+	"sha1":    crypto.SHA1,
+	"sha-1":   crypto.SHA1,
+	"sha256":  crypto.SHA256,
+	"sha-256": crypto.SHA256,
+	"sha384":  crypto.SHA384,
+	"sha-384": crypto.SHA384,
+	"sha512":  crypto.SHA512,
+	"sha-512": crypto.SHA512,
+}
+
+// mdnOptions are the MDN requested by the headers of a message
+type mdnOptions struct {
+	requested bool
+	signed    bool
+	micalg    string
+	hash      crypto.Hash
+
+	// url receives asynchronous MDNs, synchronous MDNs are sent in the response when empty
+	url string
+}
+
+// parseMDNOptions reads the Disposition-Notification-To, Disposition-Notification-Options
+// (e.g. signed-receipt-protocol=optional, pkcs7-signature; signed-receipt-micalg=optional,
+// sha-256, sha1) and Receipt-Delivery-Option headers
+func parseMDNOptions(header http.Header) mdnOptions {
+	opts := mdnOptions{
+		requested: header.Get("Disposition-Notification-To") != "",
+		micalg:    "sha-256",
+		hash:      crypto.SHA256,
+		url:       strings.TrimSpace(header.Get("Receipt-Delivery-Option")),
+	}
+	for _, param := range strings.Split(header.Get("Disposition-Notification-Options"), ";") {
+		name, value, _ := strings.Cut(param, "=")
+		values := strings.Split(value, ",")
+		for i := range values {
+			values[i] = strings.ToLower(strings.TrimSpace(values[i]))
+		}
+		if len(values) < 2 {
+			continue
+		}
+		// the first value is the importance of the option, required or optional
+		switch strings.ToLower(strings.TrimSpace(name)) {
+		case "signed-receipt-protocol":
+			for _, v := range values[1:] {
+				opts.signed = opts.signed || v == "pkcs7-signature"
+			}
+		case "signed-receipt-micalg":
+			for _, v := range values[1:] {
+				if hash, ok := micAlgorithms[v]; ok {
+					opts.micalg, opts.hash = v, hash
+					break
+				}
+			}
+		}
+	}
+	return opts
+}
+
+// message identifies a received message
+type message struct {
+	from, to, id string
+	opts         mdnOptions
+}
+
+// mdn returns the headers and body of the MDN (RFC 3798) of msg, processed when failed is nil
+func (r *Receiver) mdn(msg message, mic []byte, failed *failure) (http.Header, []byte, error) {
+	id := strings.Trim(msg.id, "<>")
+	disposition, text := "processed", fmt.Sprintf("The message %s sent to %s was received and processed.", id, msg.to)
+	if failed != nil {
+		disposition = "processed/error: " + failed.modifier
+		text = fmt.Sprintf("The message %s sent to %s could not be processed: %v", id, msg.to, failed.err)
+	}
+
+	var notification bytes.Buffer
+	fmt.Fprintf(&notification, "Reporting-UA: %s\r\n", reportingUA)
+	fmt.Fprintf(&notification, "Original-Recipient: rfc822; %s\r\n", msg.to)
+	fmt.Fprintf(&notification, "Final-Recipient: rfc822; %s\r\n", msg.to)
+	fmt.Fprintf(&notification, "Original-Message-ID: %s\r\n", msg.id)
+	fmt.Fprintf(&notification, "Disposition: automatic-action/MDN-sent-automatically; %s\r\n", disposition)
+	if mic != nil {
+		fmt.Fprintf(&notification, "Received-Content-MIC: %s, %s\r\n", base64.StdEncoding.EncodeToString(mic), msg.opts.micalg)
+	}
+
+	boundary, err := randomHex()
+	if err != nil {
+		return nil, nil, err
+	}
+	contentType := fmt.Sprintf(`multipart/report; report-type=disposition-notification; boundary="%s"`, boundary)
+	var body bytes.Buffer
+	fmt.Fprintf(&body, "--%s\r\nContent-Type: text/plain; charset=us-ascii\r\nContent-Transfer-Encoding: 7bit\r\n\r\n%s\r\n", boundary, text)
+	fmt.Fprintf(&body, "--%s\r\nContent-Type: message/disposition-notification\r\nContent-Transfer-Encoding: 7bit\r\n\r\n", boundary)
+	body.Write(notification.Bytes())
+	fmt.Fprintf(&body, "\r\n--%s--\r\n", boundary)
+
+	messageID, err := randomHex()
+	if err != nil {
+		return nil, nil, err
+	}
+	header := http.Header{}
+	header.Set("AS2-Version", "1.2")
+	header.Set("AS2-From", quoteName(r.ID))
+	header.Set("AS2-To", quoteName(msg.from))
+	header.Set("Message-ID", fmt.Sprintf("<%s@%s>", messageID, strings.ReplaceAll(r.ID, " ", "_")))
+	header.Set("Mime-Version", "1.0")
+	header.Set("Subject", "Message Disposition Notification")
+
+	if !msg.opts.signed || r.Key == nil || r.Certificate == nil {
+		header.Set("Content-Type", contentType)
+		return header, body.Bytes(), nil
+	}
+
+	// the signature signs the report entity, its headers included
+	report := append([]byte("Content-Type: "+contentType+"\r\n\r\n"), body.Bytes()...)
+	signature, err := sign(report, r.Certificate, r.Key, msg.opts.hash, r.clock())
+	if err != nil {
+		return nil, nil, fmt.Errorf("signing mdn: %w", err)
+	}
+	outer, err := randomHex()
+	if err != nil {
+		return nil, nil, err
+	}
+	var signed bytes.Buffer
+	fmt.Fprintf(&signed, "--%s\r\n", outer)
+	signed.Write(report)
+	fmt.Fprintf(&signed, "\r\n--%s\r\n", outer)
+	signed.WriteString("Content-Type: application/pkcs7-signature; name=smime.p7s\r\n")
+	signed.WriteString("Content-Transfer-Encoding: base64\r\n")
+	signed.WriteString("Content-Disposition: attachment; filename=smime.p7s\r\n\r\n")
+	encoded := base64.StdEncoding.EncodeToString(signature)
+	for len(encoded) > 76 {
+		signed.WriteString(encoded[:76] + "\r\n")
+		encoded = encoded[76:]
+	}
+	fmt.Fprintf(&signed, "%s\r\n--%s--\r\n", encoded, outer)
+
+	header.Set("Content-Type", fmt.Sprintf(`multipart/signed; protocol="application/pkcs7-signature"; micalg=%s; boundary="%s"`, msg.opts.micalg, outer))
+	return header, signed.Bytes(), nil
+}
+
+// send posts an asynchronous MDN to the url requested by its message
+func (r *Receiver) send(ctx context.Context, url string, header http.Header, body []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	for key, values := range header {
+		req.Header[key] = values
+	}
+	client := r.HTTPClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, io.LimitReader(resp.Body, 1<<20))
+	if resp.StatusCode/100 != 2 {
+		return fmt.Errorf("posting mdn to %s: %s", url, resp.Status)
+	}
+	return nil
+}
+
+func randomHex() (string, error) {
+	buf := make([]byte, 16)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buf), nil
+}
+
+// quoteName quotes the AS2 identifiers which aren't atoms (RFC 4130 section 6.2)
+func quoteName(name string) string {
+	if strings.ContainsAny(name, " \t\"\\()<>@,;:[]") {
+		return strconv.Quote(name)
+	}
+	return name
+}
+
+func unquoteName(name string) string {
+	name = strings.TrimSpace(name)
+	if unquoted, err := strconv.Unquote(name); err == nil && strings.HasPrefix(name, `"`) {
+		return unquoted
+	}
+	return name
+}
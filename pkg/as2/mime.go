@@ -0,0 +1,145 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package as2
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"mime/quotedprintable"
+	"net/textproto"
+	"path"
+	"strings"
+)
+
+// entity is a MIME entity (RFC 2045), raw holds its headers and body as sent
+type entity struct {
+	header    textproto.MIMEHeader
+	mediaType string
+	params    map[string]string
+	raw       []byte
+	body      []byte
+}
+
+func newEntity(header textproto.MIMEHeader, raw, body []byte) (*entity, error) {
+	e := &entity{header: header, raw: raw, body: body, mediaType: "application/octet-stream"}
+	if contentType := header.Get("Content-Type"); contentType != "" {
+		mediaType, params, err := mime.ParseMediaType(contentType)
+		if err != nil {
+			return nil, fmt.Errorf("content type %q: %w", contentType, err)
+		}
+		e.mediaType, e.params = mediaType, params
+	}
+	return e, nil
+}
+
+// parseEntity reads the headers and body of raw
+func parseEntity(raw []byte) (*entity, error) {
+	end, next := bytes.Index(raw, []byte("\r\n\r\n")), 4
+	if i := bytes.Index(raw, []byte("\n\n")); i >= 0 && (end < 0 || i < end) {
+		end, next = i, 2
+	}
+	if bytes.HasPrefix(raw, []byte("\r\n")) || bytes.HasPrefix(raw, []byte("\n")) {
+		// an entity without headers
+		return newEntity(textproto.MIMEHeader{}, raw, bytes.TrimPrefix(bytes.TrimPrefix(raw, []byte("\r")), []byte("\n")))
+	}
+	if end < 0 {
+		return nil, errors.New("mime entity has no end of headers")
+	}
+	header, err := textproto.NewReader(bufio.NewReader(bytes.NewReader(raw[:end+next]))).ReadMIMEHeader()
+	if err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("reading mime headers: %w", err)
+	}
+	return newEntity(header, raw, raw[end+next:])
+}
+
+// content returns the body decoded from its transfer encoding
+func (e *entity) content() ([]byte, error) {
+	switch encoding := strings.ToLower(strings.TrimSpace(e.header.Get("Content-Transfer-Encoding"))); encoding {
+	case "", "7bit", "8bit", "binary":
+		return e.body, nil
+	case "base64":
+		cleaned := bytes.Map(func(r rune) rune {
+			if r == '\r' || r == '\n' || r == ' ' || r == '\t' {
+				return -1
+			}
+			return r
+		}, e.body)
+		out := make([]byte, base64.StdEncoding.DecodedLen(len(cleaned)))
+		n, err := base64.StdEncoding.Decode(out, cleaned)
+		if err != nil {
+			return nil, fmt.Errorf("decoding base64 content: %w", err)
+		}
+		return out[:n], nil
+	case "quoted-printable":
+		out, err := io.ReadAll(quotedprintable.NewReader(bytes.NewReader(e.body)))
+		if err != nil {
+			return nil, fmt.Errorf("decoding quoted-printable content: %w", err)
+		}
+		return out, nil
+	default:
+		return nil, fmt.Errorf("unsupported content transfer encoding %s", encoding)
+	}
+}
+
+// filename returns the file name of the content disposition of the entity, without directories
+func (e *entity) filename() string {
+	_, params, err := mime.ParseMediaType(e.header.Get("Content-Disposition"))
+	if err != nil || params["filename"] == "" {
+		return ""
+	}
+	name := path.Base(strings.ReplaceAll(params["filename"], `\`, "/"))
+	if name == "." || name == ".." || name == "/" {
+		return ""
+	}
+	return name
+}
+
+// splitMultipart returns the raw body parts of a multipart body (RFC 2046 section 5.1.1), the
+// line break before a delimiter belongs to the delimiter
+func splitMultipart(body []byte, boundary string) ([][]byte, error) {
+	if boundary == "" {
+		return nil, errors.New("multipart content has no boundary")
+	}
+	delimiter := []byte("--" + boundary)
+	var parts [][]byte
+	start := -1
+	for offset := 0; offset < len(body); {
+		i := bytes.Index(body[offset:], delimiter)
+		if i < 0 {
+			break
+		}
+		i += offset
+		after := body[i+len(delimiter):]
+		offset = i + len(delimiter)
+		if (i > 0 && body[i-1] != '\n') || (len(after) > 0 && !strings.ContainsRune("-\r\n \t", rune(after[0]))) {
+			continue // not at the start of a line or a longer boundary
+		}
+		if start >= 0 {
+			end := i
+			if end > start && body[end-1] == '\n' {
+				end--
+			}
+			if end > start && body[end-1] == '\r' {
+				end--
+			}
+			parts = append(parts, body[start:end])
+		}
+		if bytes.HasPrefix(after, []byte("--")) {
+			return parts, nil
+		}
+		eol := bytes.IndexByte(after, '\n')
+		if eol < 0 {
+			break
+		}
+		start = offset + eol + 1
+		offset = start
+	}
+	return nil, errors.New("multipart content has no closing delimiter")
+}
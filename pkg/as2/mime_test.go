@@ -0,0 +1,55 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package as2
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseEntity(t *testing.T) {
+	e, err := parseEntity([]byte("Content-Type: application/xml; charset=utf-8\r\nContent-Transfer-Encoding: base64\r\nContent-Disposition: attachment; filename=\"..\\\\inbound\\\\pacs.xml\"\r\n\r\nPERvY3VtZW50Lz4=\r\n"))
+	require.Nil(t, err)
+	require.Equal(t, "application/xml", e.mediaType)
+	require.Equal(t, "utf-8", e.params["charset"])
+	require.Equal(t, "pacs.xml", e.filename())
+	content, err := e.content()
+	require.Nil(t, err)
+	require.Equal(t, "<Document/>", string(content))
+
+	// line feeds of entities written by unix tools
+	e, err = parseEntity([]byte("Content-Type: text/plain\nContent-Transfer-Encoding: quoted-printable\n\nline=3D1\n"))
+	require.Nil(t, err)
+	content, err = e.content()
+	require.Nil(t, err)
+	require.Equal(t, "line=1\n", string(content))
+	require.Equal(t, "", e.filename())
+
+	e, err = parseEntity([]byte("\r\n<Document/>"))
+	require.Nil(t, err)
+	require.Equal(t, "application/octet-stream", e.mediaType)
+	require.Equal(t, "<Document/>", string(e.body))
+
+	_, err = parseEntity([]byte("Content-Type: text/plain"))
+	require.ErrorContains(t, err, "no end of headers")
+
+	e, err = parseEntity([]byte("Content-Transfer-Encoding: x-uuencode\r\n\r\n"))
+	require.Nil(t, err)
+	_, err = e.content()
+	require.ErrorContains(t, err, "unsupported content transfer encoding")
+}
+
+func TestSplitMultipart(t *testing.T) {
+	body := "preamble\r\n--b\r\nContent-Type: text/plain\r\n\r\nfirst\r\n--bb\r\n\r\n--b  \r\n\r\nsecond\n--b--\r\nepilogue"
+	parts, err := splitMultipart([]byte(body), "b")
+	require.Nil(t, err)
+	require.Equal(t, []string{"Content-Type: text/plain\r\n\r\nfirst\r\n--bb\r\n", "\r\nsecond"}, []string{string(parts[0]), string(parts[1])})
+
+	_, err = splitMultipart([]byte("--b\r\nfirst\r\n"), "b")
+	require.ErrorContains(t, err, "no closing delimiter")
+	_, err = splitMultipart([]byte(body), "")
+	require.ErrorContains(t, err, "no boundary")
+}
@@ -0,0 +1,162 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package as2
+
+import (
+	"bytes"
+	"compress/zlib"
+	"crypto"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/base64"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// newKeyPair returns a self-signed certificate of name and its rsa key
+func newKeyPair(t *testing.T, name string) (*x509.Certificate, *rsa.PrivateKey) {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.Nil(t, err)
+	return newCertificate(t, name, key), key
+}
+
+func newCertificate(t *testing.T, name string, key crypto.Signer) *x509.Certificate {
+	t.Helper()
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, key.Public(), key)
+	require.Nil(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.Nil(t, err)
+	return cert
+}
+
+// encrypt returns the enveloped data of content encrypted for cert with AES-128-CBC, as sent
+// by partners
+func encrypt(t *testing.T, content []byte, cert *x509.Certificate) []byte {
+	t.Helper()
+	key, iv := make([]byte, 16), make([]byte, aes.BlockSize)
+	rand.Read(key)
+	rand.Read(iv)
+	pad := aes.BlockSize - len(content)%aes.BlockSize
+	padded := append(append([]byte{}, content...), bytes.Repeat([]byte{byte(pad)}, pad)...)
+	block, err := aes.NewCipher(key)
+	require.Nil(t, err)
+	ciphertext := make([]byte, len(padded))
+	cipher.NewCBCEncrypter(block, iv).CryptBlocks(ciphertext, padded)
+
+	encryptedKey, err := rsa.EncryptPKCS1v15(rand.Reader, cert.PublicKey.(*rsa.PublicKey), key)
+	require.Nil(t, err)
+	rid, err := asn1.Marshal(issuerAndSerial{Issuer: asn1.RawValue{FullBytes: cert.RawIssuer}, Serial: cert.SerialNumber})
+	require.Nil(t, err)
+	recipient, err := asn1.Marshal(keyTransRecipientInfo{
+		RID:                    asn1.RawValue{FullBytes: rid},
+		KeyEncryptionAlgorithm: pkix.AlgorithmIdentifier{Algorithm: oidRSA, Parameters: asn1.NullRawValue},
+		EncryptedKey:           encryptedKey,
+	})
+	require.Nil(t, err)
+	params, err := asn1.Marshal(iv)
+	require.Nil(t, err)
+	ed, err := asn1.Marshal(envelopedData{
+		RecipientInfos: []asn1.RawValue{{FullBytes: recipient}},
+		EncryptedContentInfo: encryptedContentInfo{
+			ContentType:                oidData,
+			ContentEncryptionAlgorithm: pkix.AlgorithmIdentifier{Algorithm: oidAES128CBC, Parameters: asn1.RawValue{FullBytes: params}},
+			EncryptedContent:           asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 0, Bytes: ciphertext},
+		},
+	})
+	require.Nil(t, err)
+	out, err := marshalContentInfo(oidEnvelopedData, ed)
+	require.Nil(t, err)
+	return out
+}
+
+// compress returns the compressed data of content
+func compress(t *testing.T, content []byte) []byte {
+	t.Helper()
+	var zipped bytes.Buffer
+	w := zlib.NewWriter(&zipped)
+	w.Write(content)
+	require.Nil(t, w.Close())
+	octets, err := asn1.Marshal(zipped.Bytes())
+	require.Nil(t, err)
+	cd, err := asn1.Marshal(compressedData{
+		CompressionAlgorithm: pkix.AlgorithmIdentifier{Algorithm: oidZlib},
+		EncapContentInfo: encapContentInfo{
+			EContentType: oidData,
+			EContent:     asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 0, IsCompound: true, Bytes: octets},
+		},
+	})
+	require.Nil(t, err)
+	out, err := marshalContentInfo(oidCompressedData, cd)
+	require.Nil(t, err)
+	return out
+}
+
+// The entities of the messages of partners, their headers are the headers of the request
+// when they're the outermost entity
+
+func payloadEntity(filename string, content []byte) []byte {
+	return append([]byte(fmt.Sprintf("Content-Type: application/xml\r\nContent-Disposition: attachment; filename=%q\r\n\r\n", filename)), content...)
+}
+
+func signedEntity(t *testing.T, inner []byte, cert *x509.Certificate, key crypto.Signer) []byte {
+	t.Helper()
+	signature, err := sign(inner, cert, key, crypto.SHA256, time.Now())
+	require.Nil(t, err)
+	return multipartSignedEntity(inner, signature)
+}
+
+// attachContent returns signature encapsulating content, as attached signatures do
+func attachContent(t *testing.T, signature, content []byte) []byte {
+	t.Helper()
+	var sd signedData
+	require.Nil(t, parseContentInfo(signature, oidSignedData, &sd))
+	octets, err := asn1.Marshal(content)
+	require.Nil(t, err)
+	sd.EncapContentInfo.EContent = asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 0, IsCompound: true, Bytes: octets}
+	der, err := asn1.Marshal(sd)
+	require.Nil(t, err)
+	out, err := marshalContentInfo(oidSignedData, der)
+	require.Nil(t, err)
+	return out
+}
+
+func multipartSignedEntity(inner, signature []byte) []byte {
+	var buf bytes.Buffer
+	buf.WriteString("Content-Type: multipart/signed; protocol=\"application/pkcs7-signature\"; micalg=sha-256; boundary=\"----=_Part_1\"\r\n\r\n")
+	buf.WriteString("This is an S/MIME signed message\r\n\r\n------=_Part_1\r\n")
+	buf.Write(inner)
+	buf.WriteString("\r\n------=_Part_1\r\nContent-Type: application/pkcs7-signature; name=smime.p7s\r\nContent-Transfer-Encoding: base64\r\n\r\n")
+	buf.WriteString(base64.StdEncoding.EncodeToString(signature))
+	buf.WriteString("\r\n------=_Part_1--\r\n")
+	return buf.Bytes()
+}
+
+func encryptedEntity(t *testing.T, inner []byte, cert *x509.Certificate) []byte {
+	t.Helper()
+	header := "Content-Type: application/pkcs7-mime; smime-type=enveloped-data; name=smime.p7m\r\nContent-Transfer-Encoding: binary\r\n\r\n"
+	return append([]byte(header), encrypt(t, inner, cert)...)
+}
+
+func compressedEntity(t *testing.T, inner []byte) []byte {
+	t.Helper()
+	header := "Content-Type: application/pkcs7-mime; smime-type=compressed-data; name=smime.p7z\r\nContent-Transfer-Encoding: base64\r\n\r\n"
+	return append([]byte(header), base64.StdEncoding.EncodeToString(compress(t, inner))...)
+}
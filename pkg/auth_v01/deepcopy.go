@@ -0,0 +1,978 @@
+// Code generated by deepcopy-gen. DO NOT EDIT.
+
+package auth_v01
+
+import (
+	"github.com/moov-io/iso20022/pkg/common"
+)
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *AccountAndParties1) DeepCopy() *AccountAndParties1 {
+	if r == nil {
+		return nil
+	}
+	out := new(AccountAndParties1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *AccountAndParties1) DeepCopyInto(out *AccountAndParties1) {
+	*out = *r
+	r.Id.DeepCopyInto(&out.Id)
+	if r.AuthrtyReqTp != nil {
+		out.AuthrtyReqTp = make([]AuthorityRequestType1, len(r.AuthrtyReqTp))
+		for i := range r.AuthrtyReqTp {
+			r.AuthrtyReqTp[i].DeepCopyInto(&out.AuthrtyReqTp[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *AccountIdentification4Choice) DeepCopy() *AccountIdentification4Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(AccountIdentification4Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *AccountIdentification4Choice) DeepCopyInto(out *AccountIdentification4Choice) {
+	*out = *r
+	r.Othr.DeepCopyInto(&out.Othr)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *AccountSchemeName1Choice) DeepCopy() *AccountSchemeName1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(AccountSchemeName1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *AccountSchemeName1Choice) DeepCopyInto(out *AccountSchemeName1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *AuthorityInvestigation2) DeepCopy() *AuthorityInvestigation2 {
+	if r == nil {
+		return nil
+	}
+	out := new(AuthorityInvestigation2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *AuthorityInvestigation2) DeepCopyInto(out *AuthorityInvestigation2) {
+	*out = *r
+	r.Tp.DeepCopyInto(&out.Tp)
+	if r.AddtlInvstgtdPties != nil {
+		out.AddtlInvstgtdPties = new(InvestigatedParties1Choice)
+		*out.AddtlInvstgtdPties = *r.AddtlInvstgtdPties
+	}
+	if r.AddtlInf != nil {
+		out.AddtlInf = new(common.Max500Text)
+		*out.AddtlInf = *r.AddtlInf
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *AuthorityRequestType1) DeepCopy() *AuthorityRequestType1 {
+	if r == nil {
+		return nil
+	}
+	out := new(AuthorityRequestType1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *AuthorityRequestType1) DeepCopyInto(out *AuthorityRequestType1) {
+	*out = *r
+	if r.MsgNm != nil {
+		out.MsgNm = new(common.Max140Text)
+		*out.MsgNm = *r.MsgNm
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *BranchAndFinancialInstitutionIdentification5) DeepCopy() *BranchAndFinancialInstitutionIdentification5 {
+	if r == nil {
+		return nil
+	}
+	out := new(BranchAndFinancialInstitutionIdentification5)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *BranchAndFinancialInstitutionIdentification5) DeepCopyInto(out *BranchAndFinancialInstitutionIdentification5) {
+	*out = *r
+	r.FinInstnId.DeepCopyInto(&out.FinInstnId)
+	if r.BrnchId != nil {
+		out.BrnchId = new(BranchData2)
+		r.BrnchId.DeepCopyInto(out.BrnchId)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *BranchData2) DeepCopy() *BranchData2 {
+	if r == nil {
+		return nil
+	}
+	out := new(BranchData2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *BranchData2) DeepCopyInto(out *BranchData2) {
+	*out = *r
+	if r.Id != nil {
+		out.Id = new(common.Max35Text)
+		*out.Id = *r.Id
+	}
+	if r.Nm != nil {
+		out.Nm = new(common.Max140Text)
+		*out.Nm = *r.Nm
+	}
+	if r.PstlAdr != nil {
+		out.PstlAdr = new(PostalAddress6)
+		r.PstlAdr.DeepCopyInto(out.PstlAdr)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CashAccount25) DeepCopy() *CashAccount25 {
+	if r == nil {
+		return nil
+	}
+	out := new(CashAccount25)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CashAccount25) DeepCopyInto(out *CashAccount25) {
+	*out = *r
+	r.Id.DeepCopyInto(&out.Id)
+	if r.Tp != nil {
+		out.Tp = new(CashAccountType2Choice)
+		*out.Tp = *r.Tp
+	}
+	if r.Ccy != nil {
+		out.Ccy = new(common.ActiveOrHistoricCurrencyCode)
+		*out.Ccy = *r.Ccy
+	}
+	if r.Nm != nil {
+		out.Nm = new(common.Max70Text)
+		*out.Nm = *r.Nm
+	}
+	if r.Ownr != nil {
+		out.Ownr = new(PartyIdentification43)
+		r.Ownr.DeepCopyInto(out.Ownr)
+	}
+	if r.Svcr != nil {
+		out.Svcr = new(BranchAndFinancialInstitutionIdentification5)
+		r.Svcr.DeepCopyInto(out.Svcr)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CashAccountType2Choice) DeepCopy() *CashAccountType2Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(CashAccountType2Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CashAccountType2Choice) DeepCopyInto(out *CashAccountType2Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ClearingSystemIdentification2Choice) DeepCopy() *ClearingSystemIdentification2Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(ClearingSystemIdentification2Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ClearingSystemIdentification2Choice) DeepCopyInto(out *ClearingSystemIdentification2Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ClearingSystemMemberIdentification2) DeepCopy() *ClearingSystemMemberIdentification2 {
+	if r == nil {
+		return nil
+	}
+	out := new(ClearingSystemMemberIdentification2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ClearingSystemMemberIdentification2) DeepCopyInto(out *ClearingSystemMemberIdentification2) {
+	*out = *r
+	if r.ClrSysId != nil {
+		out.ClrSysId = new(ClearingSystemIdentification2Choice)
+		*out.ClrSysId = *r.ClrSysId
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ContactDetails2) DeepCopy() *ContactDetails2 {
+	if r == nil {
+		return nil
+	}
+	out := new(ContactDetails2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ContactDetails2) DeepCopyInto(out *ContactDetails2) {
+	*out = *r
+	if r.NmPrfx != nil {
+		out.NmPrfx = new(common.NamePrefix1Code)
+		*out.NmPrfx = *r.NmPrfx
+	}
+	if r.Nm != nil {
+		out.Nm = new(common.Max140Text)
+		*out.Nm = *r.Nm
+	}
+	if r.PhneNb != nil {
+		out.PhneNb = new(common.PhoneNumber)
+		*out.PhneNb = *r.PhneNb
+	}
+	if r.MobNb != nil {
+		out.MobNb = new(common.PhoneNumber)
+		*out.MobNb = *r.MobNb
+	}
+	if r.FaxNb != nil {
+		out.FaxNb = new(common.PhoneNumber)
+		*out.FaxNb = *r.FaxNb
+	}
+	if r.EmailAdr != nil {
+		out.EmailAdr = new(common.Max2048Text)
+		*out.EmailAdr = *r.EmailAdr
+	}
+	if r.Othr != nil {
+		out.Othr = new(common.Max35Text)
+		*out.Othr = *r.Othr
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CustomerIdentification1) DeepCopy() *CustomerIdentification1 {
+	if r == nil {
+		return nil
+	}
+	out := new(CustomerIdentification1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CustomerIdentification1) DeepCopyInto(out *CustomerIdentification1) {
+	*out = *r
+	r.Pty.DeepCopyInto(&out.Pty)
+	if r.AuthrtyReq != nil {
+		out.AuthrtyReq = make([]AuthorityInvestigation2, len(r.AuthrtyReq))
+		for i := range r.AuthrtyReq {
+			r.AuthrtyReq[i].DeepCopyInto(&out.AuthrtyReq[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *DateAndPlaceOfBirth) DeepCopy() *DateAndPlaceOfBirth {
+	if r == nil {
+		return nil
+	}
+	out := new(DateAndPlaceOfBirth)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *DateAndPlaceOfBirth) DeepCopyInto(out *DateAndPlaceOfBirth) {
+	*out = *r
+	if r.PrvcOfBirth != nil {
+		out.PrvcOfBirth = new(common.Max35Text)
+		*out.PrvcOfBirth = *r.PrvcOfBirth
+	}
+	if r.CityOfBirth != nil {
+		out.CityOfBirth = new(common.Max35Text)
+		*out.CityOfBirth = *r.CityOfBirth
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *DateOrDateTimePeriodChoice) DeepCopy() *DateOrDateTimePeriodChoice {
+	if r == nil {
+		return nil
+	}
+	out := new(DateOrDateTimePeriodChoice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *DateOrDateTimePeriodChoice) DeepCopyInto(out *DateOrDateTimePeriodChoice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *DatePeriodDetails) DeepCopy() *DatePeriodDetails {
+	if r == nil {
+		return nil
+	}
+	out := new(DatePeriodDetails)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *DatePeriodDetails) DeepCopyInto(out *DatePeriodDetails) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *DateTimePeriodDetails) DeepCopy() *DateTimePeriodDetails {
+	if r == nil {
+		return nil
+	}
+	out := new(DateTimePeriodDetails)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *DateTimePeriodDetails) DeepCopyInto(out *DateTimePeriodDetails) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *DueDate1) DeepCopy() *DueDate1 {
+	if r == nil {
+		return nil
+	}
+	out := new(DueDate1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *DueDate1) DeepCopyInto(out *DueDate1) {
+	*out = *r
+	if r.DueDt != nil {
+		out.DueDt = new(common.ISODate)
+		*out.DueDt = *r.DueDt
+	}
+	if r.AddtlInf != nil {
+		out.AddtlInf = new(common.Max140Text)
+		*out.AddtlInf = *r.AddtlInf
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *FinancialIdentificationSchemeName1Choice) DeepCopy() *FinancialIdentificationSchemeName1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(FinancialIdentificationSchemeName1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *FinancialIdentificationSchemeName1Choice) DeepCopyInto(out *FinancialIdentificationSchemeName1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *FinancialInstitutionIdentification8) DeepCopy() *FinancialInstitutionIdentification8 {
+	if r == nil {
+		return nil
+	}
+	out := new(FinancialInstitutionIdentification8)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *FinancialInstitutionIdentification8) DeepCopyInto(out *FinancialInstitutionIdentification8) {
+	*out = *r
+	if r.BICFI != nil {
+		out.BICFI = new(common.BICFIIdentifier)
+		*out.BICFI = *r.BICFI
+	}
+	if r.ClrSysMmbId != nil {
+		out.ClrSysMmbId = new(ClearingSystemMemberIdentification2)
+		r.ClrSysMmbId.DeepCopyInto(out.ClrSysMmbId)
+	}
+	if r.Nm != nil {
+		out.Nm = new(common.Max140Text)
+		*out.Nm = *r.Nm
+	}
+	if r.PstlAdr != nil {
+		out.PstlAdr = new(PostalAddress6)
+		r.PstlAdr.DeepCopyInto(out.PstlAdr)
+	}
+	if r.Othr != nil {
+		out.Othr = new(GenericFinancialIdentification1)
+		r.Othr.DeepCopyInto(out.Othr)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *GenericAccountIdentification1) DeepCopy() *GenericAccountIdentification1 {
+	if r == nil {
+		return nil
+	}
+	out := new(GenericAccountIdentification1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *GenericAccountIdentification1) DeepCopyInto(out *GenericAccountIdentification1) {
+	*out = *r
+	if r.SchmeNm != nil {
+		out.SchmeNm = new(AccountSchemeName1Choice)
+		*out.SchmeNm = *r.SchmeNm
+	}
+	if r.Issr != nil {
+		out.Issr = new(common.Max35Text)
+		*out.Issr = *r.Issr
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *GenericFinancialIdentification1) DeepCopy() *GenericFinancialIdentification1 {
+	if r == nil {
+		return nil
+	}
+	out := new(GenericFinancialIdentification1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *GenericFinancialIdentification1) DeepCopyInto(out *GenericFinancialIdentification1) {
+	*out = *r
+	if r.SchmeNm != nil {
+		out.SchmeNm = new(FinancialIdentificationSchemeName1Choice)
+		*out.SchmeNm = *r.SchmeNm
+	}
+	if r.Issr != nil {
+		out.Issr = new(common.Max35Text)
+		*out.Issr = *r.Issr
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *GenericOrganisationIdentification1) DeepCopy() *GenericOrganisationIdentification1 {
+	if r == nil {
+		return nil
+	}
+	out := new(GenericOrganisationIdentification1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *GenericOrganisationIdentification1) DeepCopyInto(out *GenericOrganisationIdentification1) {
+	*out = *r
+	if r.SchmeNm != nil {
+		out.SchmeNm = new(OrganisationIdentificationSchemeName1Choice)
+		*out.SchmeNm = *r.SchmeNm
+	}
+	if r.Issr != nil {
+		out.Issr = new(common.Max35Text)
+		*out.Issr = *r.Issr
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *GenericPersonIdentification1) DeepCopy() *GenericPersonIdentification1 {
+	if r == nil {
+		return nil
+	}
+	out := new(GenericPersonIdentification1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *GenericPersonIdentification1) DeepCopyInto(out *GenericPersonIdentification1) {
+	*out = *r
+	if r.SchmeNm != nil {
+		out.SchmeNm = new(PersonIdentificationSchemeName1Choice)
+		*out.SchmeNm = *r.SchmeNm
+	}
+	if r.Issr != nil {
+		out.Issr = new(common.Max35Text)
+		*out.Issr = *r.Issr
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *InformationRequestOpeningV01) DeepCopy() *InformationRequestOpeningV01 {
+	if r == nil {
+		return nil
+	}
+	out := new(InformationRequestOpeningV01)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *InformationRequestOpeningV01) DeepCopyInto(out *InformationRequestOpeningV01) {
+	*out = *r
+	r.LglMndtBsis.DeepCopyInto(&out.LglMndtBsis)
+	if r.DueDt != nil {
+		out.DueDt = new(DueDate1)
+		r.DueDt.DeepCopyInto(out.DueDt)
+	}
+	r.SchCrit.DeepCopyInto(&out.SchCrit)
+	if r.SplmtryData != nil {
+		out.SplmtryData = make([]SupplementaryData1, len(r.SplmtryData))
+		for i := range r.SplmtryData {
+			r.SplmtryData[i].DeepCopyInto(&out.SplmtryData[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *InformationRequestResponseV01) DeepCopy() *InformationRequestResponseV01 {
+	if r == nil {
+		return nil
+	}
+	out := new(InformationRequestResponseV01)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *InformationRequestResponseV01) DeepCopyInto(out *InformationRequestResponseV01) {
+	*out = *r
+	r.SchCrit.DeepCopyInto(&out.SchCrit)
+	if r.RtrInd != nil {
+		out.RtrInd = make([]ReturnIndicator1, len(r.RtrInd))
+		for i := range r.RtrInd {
+			r.RtrInd[i].DeepCopyInto(&out.RtrInd[i])
+		}
+	}
+	if r.SplmtryData != nil {
+		out.SplmtryData = make([]SupplementaryData1, len(r.SplmtryData))
+		for i := range r.SplmtryData {
+			r.SplmtryData[i].DeepCopyInto(&out.SplmtryData[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *InformationRequestStatusChangeNotificationV01) DeepCopy() *InformationRequestStatusChangeNotificationV01 {
+	if r == nil {
+		return nil
+	}
+	out := new(InformationRequestStatusChangeNotificationV01)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *InformationRequestStatusChangeNotificationV01) DeepCopyInto(out *InformationRequestStatusChangeNotificationV01) {
+	*out = *r
+	if r.SplmtryData != nil {
+		out.SplmtryData = make([]SupplementaryData1, len(r.SplmtryData))
+		for i := range r.SplmtryData {
+			r.SplmtryData[i].DeepCopyInto(&out.SplmtryData[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *InvestigatedParties1Choice) DeepCopy() *InvestigatedParties1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(InvestigatedParties1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *InvestigatedParties1Choice) DeepCopyInto(out *InvestigatedParties1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *InvestigationResult1Choice) DeepCopy() *InvestigationResult1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(InvestigationResult1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *InvestigationResult1Choice) DeepCopyInto(out *InvestigationResult1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *LegalMandate1) DeepCopy() *LegalMandate1 {
+	if r == nil {
+		return nil
+	}
+	out := new(LegalMandate1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *LegalMandate1) DeepCopyInto(out *LegalMandate1) {
+	*out = *r
+	if r.Dsclmr != nil {
+		out.Dsclmr = new(common.Max350Text)
+		*out.Dsclmr = *r.Dsclmr
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *OrganisationIdentification8) DeepCopy() *OrganisationIdentification8 {
+	if r == nil {
+		return nil
+	}
+	out := new(OrganisationIdentification8)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *OrganisationIdentification8) DeepCopyInto(out *OrganisationIdentification8) {
+	*out = *r
+	if r.AnyBIC != nil {
+		out.AnyBIC = new(common.AnyBICIdentifier)
+		*out.AnyBIC = *r.AnyBIC
+	}
+	if r.Othr != nil {
+		out.Othr = make([]GenericOrganisationIdentification1, len(r.Othr))
+		for i := range r.Othr {
+			r.Othr[i].DeepCopyInto(&out.Othr[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *OrganisationIdentificationSchemeName1Choice) DeepCopy() *OrganisationIdentificationSchemeName1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(OrganisationIdentificationSchemeName1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *OrganisationIdentificationSchemeName1Choice) DeepCopyInto(out *OrganisationIdentificationSchemeName1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *Party11Choice) DeepCopy() *Party11Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(Party11Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *Party11Choice) DeepCopyInto(out *Party11Choice) {
+	*out = *r
+	r.OrgId.DeepCopyInto(&out.OrgId)
+	r.PrvtId.DeepCopyInto(&out.PrvtId)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *PartyIdentification43) DeepCopy() *PartyIdentification43 {
+	if r == nil {
+		return nil
+	}
+	out := new(PartyIdentification43)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *PartyIdentification43) DeepCopyInto(out *PartyIdentification43) {
+	*out = *r
+	if r.Nm != nil {
+		out.Nm = new(common.Max140Text)
+		*out.Nm = *r.Nm
+	}
+	if r.PstlAdr != nil {
+		out.PstlAdr = new(PostalAddress6)
+		r.PstlAdr.DeepCopyInto(out.PstlAdr)
+	}
+	if r.Id != nil {
+		out.Id = new(Party11Choice)
+		r.Id.DeepCopyInto(out.Id)
+	}
+	if r.CtryOfRes != nil {
+		out.CtryOfRes = new(common.CountryCode)
+		*out.CtryOfRes = *r.CtryOfRes
+	}
+	if r.CtctDtls != nil {
+		out.CtctDtls = new(ContactDetails2)
+		r.CtctDtls.DeepCopyInto(out.CtctDtls)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *PaymentInstrumentType1) DeepCopy() *PaymentInstrumentType1 {
+	if r == nil {
+		return nil
+	}
+	out := new(PaymentInstrumentType1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *PaymentInstrumentType1) DeepCopyInto(out *PaymentInstrumentType1) {
+	*out = *r
+	if r.AuthrtyReqTp != nil {
+		out.AuthrtyReqTp = make([]AuthorityRequestType1, len(r.AuthrtyReqTp))
+		for i := range r.AuthrtyReqTp {
+			r.AuthrtyReqTp[i].DeepCopyInto(&out.AuthrtyReqTp[i])
+		}
+	}
+	if r.AddtlInf != nil {
+		out.AddtlInf = new(common.Max500Text)
+		*out.AddtlInf = *r.AddtlInf
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *PersonIdentification5) DeepCopy() *PersonIdentification5 {
+	if r == nil {
+		return nil
+	}
+	out := new(PersonIdentification5)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *PersonIdentification5) DeepCopyInto(out *PersonIdentification5) {
+	*out = *r
+	if r.DtAndPlcOfBirth != nil {
+		out.DtAndPlcOfBirth = new(DateAndPlaceOfBirth)
+		r.DtAndPlcOfBirth.DeepCopyInto(out.DtAndPlcOfBirth)
+	}
+	if r.Othr != nil {
+		out.Othr = make([]GenericPersonIdentification1, len(r.Othr))
+		for i := range r.Othr {
+			r.Othr[i].DeepCopyInto(&out.Othr[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *PersonIdentificationSchemeName1Choice) DeepCopy() *PersonIdentificationSchemeName1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(PersonIdentificationSchemeName1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *PersonIdentificationSchemeName1Choice) DeepCopyInto(out *PersonIdentificationSchemeName1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *PostalAddress6) DeepCopy() *PostalAddress6 {
+	if r == nil {
+		return nil
+	}
+	out := new(PostalAddress6)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *PostalAddress6) DeepCopyInto(out *PostalAddress6) {
+	*out = *r
+	if r.AdrTp != nil {
+		out.AdrTp = new(common.AddressType2Code)
+		*out.AdrTp = *r.AdrTp
+	}
+	if r.Dept != nil {
+		out.Dept = new(common.Max70Text)
+		*out.Dept = *r.Dept
+	}
+	if r.SubDept != nil {
+		out.SubDept = new(common.Max70Text)
+		*out.SubDept = *r.SubDept
+	}
+	if r.StrtNm != nil {
+		out.StrtNm = new(common.Max70Text)
+		*out.StrtNm = *r.StrtNm
+	}
+	if r.BldgNb != nil {
+		out.BldgNb = new(common.Max16Text)
+		*out.BldgNb = *r.BldgNb
+	}
+	if r.PstCd != nil {
+		out.PstCd = new(common.Max16Text)
+		*out.PstCd = *r.PstCd
+	}
+	if r.TwnNm != nil {
+		out.TwnNm = new(common.Max35Text)
+		*out.TwnNm = *r.TwnNm
+	}
+	if r.CtrySubDvsn != nil {
+		out.CtrySubDvsn = new(common.Max35Text)
+		*out.CtrySubDvsn = *r.CtrySubDvsn
+	}
+	if r.Ctry != nil {
+		out.Ctry = new(common.CountryCode)
+		*out.Ctry = *r.Ctry
+	}
+	if r.AdrLine != nil {
+		out.AdrLine = make([]common.Max70Text, len(r.AdrLine))
+		copy(out.AdrLine, r.AdrLine)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *RequestType1) DeepCopy() *RequestType1 {
+	if r == nil {
+		return nil
+	}
+	out := new(RequestType1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *RequestType1) DeepCopyInto(out *RequestType1) {
+	*out = *r
+	if r.Tp != nil {
+		out.Tp = make([]TransactionRequestType1Code, len(r.Tp))
+		copy(out.Tp, r.Tp)
+	}
+	if r.AddtlInf != nil {
+		out.AddtlInf = new(common.Max500Text)
+		*out.AddtlInf = *r.AddtlInf
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ReturnIndicator1) DeepCopy() *ReturnIndicator1 {
+	if r == nil {
+		return nil
+	}
+	out := new(ReturnIndicator1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ReturnIndicator1) DeepCopyInto(out *ReturnIndicator1) {
+	*out = *r
+	if r.RspnPrd != nil {
+		out.RspnPrd = new(DateOrDateTimePeriodChoice)
+		*out.RspnPrd = *r.RspnPrd
+	}
+	if r.AuthrtyReqTp != nil {
+		out.AuthrtyReqTp = new(AuthorityRequestType1)
+		r.AuthrtyReqTp.DeepCopyInto(out.AuthrtyReqTp)
+	}
+	if r.AddtlInf != nil {
+		out.AddtlInf = new(common.Max500Text)
+		*out.AddtlInf = *r.AddtlInf
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *SearchCriteria1Choice) DeepCopy() *SearchCriteria1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(SearchCriteria1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *SearchCriteria1Choice) DeepCopyInto(out *SearchCriteria1Choice) {
+	*out = *r
+	r.Acct.DeepCopyInto(&out.Acct)
+	r.CstmrId.DeepCopyInto(&out.CstmrId)
+	r.PmtInstrm.DeepCopyInto(&out.PmtInstrm)
+	if r.OrgnlTxNb != nil {
+		out.OrgnlTxNb = make([]RequestType1, len(r.OrgnlTxNb))
+		for i := range r.OrgnlTxNb {
+			r.OrgnlTxNb[i].DeepCopyInto(&out.OrgnlTxNb[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *SupplementaryData1) DeepCopy() *SupplementaryData1 {
+	if r == nil {
+		return nil
+	}
+	out := new(SupplementaryData1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *SupplementaryData1) DeepCopyInto(out *SupplementaryData1) {
+	*out = *r
+	if r.PlcAndNm != nil {
+		out.PlcAndNm = new(common.Max350Text)
+		*out.PlcAndNm = *r.PlcAndNm
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *SupplementaryDataEnvelope1) DeepCopy() *SupplementaryDataEnvelope1 {
+	if r == nil {
+		return nil
+	}
+	out := new(SupplementaryDataEnvelope1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *SupplementaryDataEnvelope1) DeepCopyInto(out *SupplementaryDataEnvelope1) {
+	*out = *r
+}
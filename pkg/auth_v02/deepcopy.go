@@ -0,0 +1,3140 @@
+// Code generated by deepcopy-gen. DO NOT EDIT.
+
+package auth_v02
+
+import (
+	"github.com/moov-io/iso20022/pkg/common"
+)
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *AccountIdentification4Choice) DeepCopy() *AccountIdentification4Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(AccountIdentification4Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *AccountIdentification4Choice) DeepCopyInto(out *AccountIdentification4Choice) {
+	*out = *r
+	r.Othr.DeepCopyInto(&out.Othr)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *AccountSchemeName1Choice) DeepCopy() *AccountSchemeName1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(AccountSchemeName1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *AccountSchemeName1Choice) DeepCopyInto(out *AccountSchemeName1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ActiveCurrencyAndAmount) DeepCopy() *ActiveCurrencyAndAmount {
+	if r == nil {
+		return nil
+	}
+	out := new(ActiveCurrencyAndAmount)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ActiveCurrencyAndAmount) DeepCopyInto(out *ActiveCurrencyAndAmount) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *AddressType3Choice) DeepCopy() *AddressType3Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(AddressType3Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *AddressType3Choice) DeepCopyInto(out *AddressType3Choice) {
+	*out = *r
+	r.Prtry.DeepCopyInto(&out.Prtry)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *BenchmarkCurveName4Choice) DeepCopy() *BenchmarkCurveName4Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(BenchmarkCurveName4Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *BenchmarkCurveName4Choice) DeepCopyInto(out *BenchmarkCurveName4Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *BinaryFile1) DeepCopy() *BinaryFile1 {
+	if r == nil {
+		return nil
+	}
+	out := new(BinaryFile1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *BinaryFile1) DeepCopyInto(out *BinaryFile1) {
+	*out = *r
+	if r.MIMETp != nil {
+		out.MIMETp = new(common.Max35Text)
+		*out.MIMETp = *r.MIMETp
+	}
+	if r.NcodgTp != nil {
+		out.NcodgTp = new(common.Max35Text)
+		*out.NcodgTp = *r.NcodgTp
+	}
+	if r.CharSet != nil {
+		out.CharSet = new(common.Max35Text)
+		*out.CharSet = *r.CharSet
+	}
+	if r.InclBinryObjct != nil {
+		out.InclBinryObjct = new(common.Max100KBinary)
+		*out.InclBinryObjct = *r.InclBinryObjct
+		if (*r.InclBinryObjct) != nil {
+			(*out.InclBinryObjct) = make(common.Max100KBinary, len((*r.InclBinryObjct)))
+			copy((*out.InclBinryObjct), (*r.InclBinryObjct))
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *BranchAndFinancialInstitutionIdentification6) DeepCopy() *BranchAndFinancialInstitutionIdentification6 {
+	if r == nil {
+		return nil
+	}
+	out := new(BranchAndFinancialInstitutionIdentification6)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *BranchAndFinancialInstitutionIdentification6) DeepCopyInto(out *BranchAndFinancialInstitutionIdentification6) {
+	*out = *r
+	r.FinInstnId.DeepCopyInto(&out.FinInstnId)
+	if r.BrnchId != nil {
+		out.BrnchId = new(BranchData3)
+		r.BrnchId.DeepCopyInto(out.BrnchId)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *BranchData3) DeepCopy() *BranchData3 {
+	if r == nil {
+		return nil
+	}
+	out := new(BranchData3)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *BranchData3) DeepCopyInto(out *BranchData3) {
+	*out = *r
+	if r.Id != nil {
+		out.Id = new(common.Max35Text)
+		*out.Id = *r.Id
+	}
+	if r.LEI != nil {
+		out.LEI = new(common.LEIIdentifier)
+		*out.LEI = *r.LEI
+	}
+	if r.Nm != nil {
+		out.Nm = new(common.Max140Text)
+		*out.Nm = *r.Nm
+	}
+	if r.PstlAdr != nil {
+		out.PstlAdr = new(PostalAddress24)
+		r.PstlAdr.DeepCopyInto(out.PstlAdr)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CashAccount38) DeepCopy() *CashAccount38 {
+	if r == nil {
+		return nil
+	}
+	out := new(CashAccount38)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CashAccount38) DeepCopyInto(out *CashAccount38) {
+	*out = *r
+	r.Id.DeepCopyInto(&out.Id)
+	if r.Tp != nil {
+		out.Tp = new(CashAccountType2Choice)
+		*out.Tp = *r.Tp
+	}
+	if r.Ccy != nil {
+		out.Ccy = new(common.ActiveOrHistoricCurrencyCode)
+		*out.Ccy = *r.Ccy
+	}
+	if r.Nm != nil {
+		out.Nm = new(common.Max70Text)
+		*out.Nm = *r.Nm
+	}
+	if r.Prxy != nil {
+		out.Prxy = new(ProxyAccountIdentification1)
+		r.Prxy.DeepCopyInto(out.Prxy)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CashAccountType2Choice) DeepCopy() *CashAccountType2Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(CashAccountType2Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CashAccountType2Choice) DeepCopyInto(out *CashAccountType2Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CashCollateral5) DeepCopy() *CashCollateral5 {
+	if r == nil {
+		return nil
+	}
+	out := new(CashCollateral5)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CashCollateral5) DeepCopyInto(out *CashCollateral5) {
+	*out = *r
+	if r.CollId != nil {
+		out.CollId = new(common.Max35Text)
+		*out.CollId = *r.CollId
+	}
+	if r.CshAcctId != nil {
+		out.CshAcctId = new(AccountIdentification4Choice)
+		r.CshAcctId.DeepCopyInto(out.CshAcctId)
+	}
+	if r.AsstNb != nil {
+		out.AsstNb = new(common.Max35Text)
+		*out.AsstNb = *r.AsstNb
+	}
+	if r.DpstAmt != nil {
+		out.DpstAmt = new(ActiveCurrencyAndAmount)
+		*out.DpstAmt = *r.DpstAmt
+	}
+	if r.DpstTp != nil {
+		out.DpstTp = new(DepositType1Code)
+		*out.DpstTp = *r.DpstTp
+	}
+	if r.MtrtyDt != nil {
+		out.MtrtyDt = new(common.ISODate)
+		*out.MtrtyDt = *r.MtrtyDt
+	}
+	if r.ValDt != nil {
+		out.ValDt = new(common.ISODate)
+		*out.ValDt = *r.ValDt
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CertificateIdentification1) DeepCopy() *CertificateIdentification1 {
+	if r == nil {
+		return nil
+	}
+	out := new(CertificateIdentification1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CertificateIdentification1) DeepCopyInto(out *CertificateIdentification1) {
+	*out = *r
+	if r.MsgId != nil {
+		out.MsgId = new(common.Max35Text)
+		*out.MsgId = *r.MsgId
+	}
+	if r.AcctSvcrRef != nil {
+		out.AcctSvcrRef = new(common.Max35Text)
+		*out.AcctSvcrRef = *r.AcctSvcrRef
+	}
+	if r.PmtInfId != nil {
+		out.PmtInfId = new(common.Max35Text)
+		*out.PmtInfId = *r.PmtInfId
+	}
+	if r.InstrId != nil {
+		out.InstrId = new(common.Max35Text)
+		*out.InstrId = *r.InstrId
+	}
+	if r.EndToEndId != nil {
+		out.EndToEndId = new(common.Max35Text)
+		*out.EndToEndId = *r.EndToEndId
+	}
+	if r.Prtry != nil {
+		out.Prtry = new(ProprietaryReference1)
+		*out.Prtry = *r.Prtry
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CertificateReference1) DeepCopy() *CertificateReference1 {
+	if r == nil {
+		return nil
+	}
+	out := new(CertificateReference1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CertificateReference1) DeepCopyInto(out *CertificateReference1) {
+	*out = *r
+	r.Id.DeepCopyInto(&out.Id)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ClearingSystemIdentification2Choice) DeepCopy() *ClearingSystemIdentification2Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(ClearingSystemIdentification2Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ClearingSystemIdentification2Choice) DeepCopyInto(out *ClearingSystemIdentification2Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ClearingSystemMemberIdentification2) DeepCopy() *ClearingSystemMemberIdentification2 {
+	if r == nil {
+		return nil
+	}
+	out := new(ClearingSystemMemberIdentification2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ClearingSystemMemberIdentification2) DeepCopyInto(out *ClearingSystemMemberIdentification2) {
+	*out = *r
+	if r.ClrSysId != nil {
+		out.ClrSysId = new(ClearingSystemIdentification2Choice)
+		*out.ClrSysId = *r.ClrSysId
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *Contact4) DeepCopy() *Contact4 {
+	if r == nil {
+		return nil
+	}
+	out := new(Contact4)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *Contact4) DeepCopyInto(out *Contact4) {
+	*out = *r
+	if r.NmPrfx != nil {
+		out.NmPrfx = new(common.NamePrefix2Code)
+		*out.NmPrfx = *r.NmPrfx
+	}
+	if r.Nm != nil {
+		out.Nm = new(common.Max140Text)
+		*out.Nm = *r.Nm
+	}
+	if r.PhneNb != nil {
+		out.PhneNb = new(common.PhoneNumber)
+		*out.PhneNb = *r.PhneNb
+	}
+	if r.MobNb != nil {
+		out.MobNb = new(common.PhoneNumber)
+		*out.MobNb = *r.MobNb
+	}
+	if r.FaxNb != nil {
+		out.FaxNb = new(common.PhoneNumber)
+		*out.FaxNb = *r.FaxNb
+	}
+	if r.EmailAdr != nil {
+		out.EmailAdr = new(common.Max2048Text)
+		*out.EmailAdr = *r.EmailAdr
+	}
+	if r.EmailPurp != nil {
+		out.EmailPurp = new(common.Max35Text)
+		*out.EmailPurp = *r.EmailPurp
+	}
+	if r.JobTitl != nil {
+		out.JobTitl = new(common.Max35Text)
+		*out.JobTitl = *r.JobTitl
+	}
+	if r.Rspnsblty != nil {
+		out.Rspnsblty = new(common.Max35Text)
+		*out.Rspnsblty = *r.Rspnsblty
+	}
+	if r.Dept != nil {
+		out.Dept = new(common.Max70Text)
+		*out.Dept = *r.Dept
+	}
+	if r.Othr != nil {
+		out.Othr = make([]OtherContact1, len(r.Othr))
+		for i := range r.Othr {
+			r.Othr[i].DeepCopyInto(&out.Othr[i])
+		}
+	}
+	if r.PrefrdMtd != nil {
+		out.PrefrdMtd = new(PreferredContactMethod1Code)
+		*out.PrefrdMtd = *r.PrefrdMtd
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ContractBalance1) DeepCopy() *ContractBalance1 {
+	if r == nil {
+		return nil
+	}
+	out := new(ContractBalance1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ContractBalance1) DeepCopyInto(out *ContractBalance1) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ContractBalanceType1Choice) DeepCopy() *ContractBalanceType1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(ContractBalanceType1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ContractBalanceType1Choice) DeepCopyInto(out *ContractBalanceType1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ContractClosureReason1Choice) DeepCopy() *ContractClosureReason1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(ContractClosureReason1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ContractClosureReason1Choice) DeepCopyInto(out *ContractClosureReason1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ContractCollateral1) DeepCopy() *ContractCollateral1 {
+	if r == nil {
+		return nil
+	}
+	out := new(ContractCollateral1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ContractCollateral1) DeepCopyInto(out *ContractCollateral1) {
+	*out = *r
+	if r.CollDesc != nil {
+		out.CollDesc = make([]CashCollateral5, len(r.CollDesc))
+		for i := range r.CollDesc {
+			r.CollDesc[i].DeepCopyInto(&out.CollDesc[i])
+		}
+	}
+	if r.AddtlInf != nil {
+		out.AddtlInf = new(common.Max1025Text)
+		*out.AddtlInf = *r.AddtlInf
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ContractRegistration3) DeepCopy() *ContractRegistration3 {
+	if r == nil {
+		return nil
+	}
+	out := new(ContractRegistration3)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ContractRegistration3) DeepCopyInto(out *ContractRegistration3) {
+	*out = *r
+	r.RptgPty.DeepCopyInto(&out.RptgPty)
+	r.RegnAgt.DeepCopyInto(&out.RegnAgt)
+	if r.CtrctRegnOpng != nil {
+		out.CtrctRegnOpng = make([]ContractRegistration4, len(r.CtrctRegnOpng))
+		for i := range r.CtrctRegnOpng {
+			r.CtrctRegnOpng[i].DeepCopyInto(&out.CtrctRegnOpng[i])
+		}
+	}
+	if r.SplmtryData != nil {
+		out.SplmtryData = make([]SupplementaryData1, len(r.SplmtryData))
+		for i := range r.SplmtryData {
+			r.SplmtryData[i].DeepCopyInto(&out.SplmtryData[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ContractRegistration4) DeepCopy() *ContractRegistration4 {
+	if r == nil {
+		return nil
+	}
+	out := new(ContractRegistration4)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ContractRegistration4) DeepCopyInto(out *ContractRegistration4) {
+	*out = *r
+	r.Ctrct.DeepCopyInto(&out.Ctrct)
+	if r.CtrctBal != nil {
+		out.CtrctBal = make([]ContractBalance1, len(r.CtrctBal))
+		copy(out.CtrctBal, r.CtrctBal)
+	}
+	if r.PmtSchdlTp != nil {
+		out.PmtSchdlTp = new(PaymentScheduleType1Choice)
+		*out.PmtSchdlTp = *r.PmtSchdlTp
+	}
+	if r.PrvsRegnId != nil {
+		out.PrvsRegnId = make([]DocumentIdentification22, len(r.PrvsRegnId))
+		for i := range r.PrvsRegnId {
+			r.PrvsRegnId[i].DeepCopyInto(&out.PrvsRegnId[i])
+		}
+	}
+	if r.AddtlInf != nil {
+		out.AddtlInf = new(common.Max1025Text)
+		*out.AddtlInf = *r.AddtlInf
+	}
+	if r.Attchmnt != nil {
+		out.Attchmnt = make([]DocumentGeneralInformation3, len(r.Attchmnt))
+		for i := range r.Attchmnt {
+			r.Attchmnt[i].DeepCopyInto(&out.Attchmnt[i])
+		}
+	}
+	if r.SplmtryData != nil {
+		out.SplmtryData = make([]SupplementaryData1, len(r.SplmtryData))
+		for i := range r.SplmtryData {
+			r.SplmtryData[i].DeepCopyInto(&out.SplmtryData[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ContractRegistrationAmendmentRequestV02) DeepCopy() *ContractRegistrationAmendmentRequestV02 {
+	if r == nil {
+		return nil
+	}
+	out := new(ContractRegistrationAmendmentRequestV02)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ContractRegistrationAmendmentRequestV02) DeepCopyInto(out *ContractRegistrationAmendmentRequestV02) {
+	*out = *r
+	r.GrpHdr.DeepCopyInto(&out.GrpHdr)
+	if r.CtrctRegnAmdmnt != nil {
+		out.CtrctRegnAmdmnt = make([]RegisteredContract9, len(r.CtrctRegnAmdmnt))
+		for i := range r.CtrctRegnAmdmnt {
+			r.CtrctRegnAmdmnt[i].DeepCopyInto(&out.CtrctRegnAmdmnt[i])
+		}
+	}
+	if r.SplmtryData != nil {
+		out.SplmtryData = make([]SupplementaryData1, len(r.SplmtryData))
+		for i := range r.SplmtryData {
+			r.SplmtryData[i].DeepCopyInto(&out.SplmtryData[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ContractRegistrationClosureRequestV02) DeepCopy() *ContractRegistrationClosureRequestV02 {
+	if r == nil {
+		return nil
+	}
+	out := new(ContractRegistrationClosureRequestV02)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ContractRegistrationClosureRequestV02) DeepCopyInto(out *ContractRegistrationClosureRequestV02) {
+	*out = *r
+	r.GrpHdr.DeepCopyInto(&out.GrpHdr)
+	if r.RegdCtrctClsr != nil {
+		out.RegdCtrctClsr = make([]RegisteredContract6, len(r.RegdCtrctClsr))
+		for i := range r.RegdCtrctClsr {
+			r.RegdCtrctClsr[i].DeepCopyInto(&out.RegdCtrctClsr[i])
+		}
+	}
+	if r.SplmtryData != nil {
+		out.SplmtryData = make([]SupplementaryData1, len(r.SplmtryData))
+		for i := range r.SplmtryData {
+			r.SplmtryData[i].DeepCopyInto(&out.SplmtryData[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ContractRegistrationConfirmationV02) DeepCopy() *ContractRegistrationConfirmationV02 {
+	if r == nil {
+		return nil
+	}
+	out := new(ContractRegistrationConfirmationV02)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ContractRegistrationConfirmationV02) DeepCopyInto(out *ContractRegistrationConfirmationV02) {
+	*out = *r
+	r.GrpHdr.DeepCopyInto(&out.GrpHdr)
+	if r.RegdCtrct != nil {
+		out.RegdCtrct = make([]RegisteredContract7, len(r.RegdCtrct))
+		for i := range r.RegdCtrct {
+			r.RegdCtrct[i].DeepCopyInto(&out.RegdCtrct[i])
+		}
+	}
+	if r.SplmtryData != nil {
+		out.SplmtryData = make([]SupplementaryData1, len(r.SplmtryData))
+		for i := range r.SplmtryData {
+			r.SplmtryData[i].DeepCopyInto(&out.SplmtryData[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ContractRegistrationReference1Choice) DeepCopy() *ContractRegistrationReference1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(ContractRegistrationReference1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ContractRegistrationReference1Choice) DeepCopyInto(out *ContractRegistrationReference1Choice) {
+	*out = *r
+	r.Ctrct.DeepCopyInto(&out.Ctrct)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ContractRegistrationRequestV02) DeepCopy() *ContractRegistrationRequestV02 {
+	if r == nil {
+		return nil
+	}
+	out := new(ContractRegistrationRequestV02)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ContractRegistrationRequestV02) DeepCopyInto(out *ContractRegistrationRequestV02) {
+	*out = *r
+	r.GrpHdr.DeepCopyInto(&out.GrpHdr)
+	if r.CtrctRegn != nil {
+		out.CtrctRegn = make([]ContractRegistration3, len(r.CtrctRegn))
+		for i := range r.CtrctRegn {
+			r.CtrctRegn[i].DeepCopyInto(&out.CtrctRegn[i])
+		}
+	}
+	if r.SplmtryData != nil {
+		out.SplmtryData = make([]SupplementaryData1, len(r.SplmtryData))
+		for i := range r.SplmtryData {
+			r.SplmtryData[i].DeepCopyInto(&out.SplmtryData[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ContractRegistrationStatement2) DeepCopy() *ContractRegistrationStatement2 {
+	if r == nil {
+		return nil
+	}
+	out := new(ContractRegistrationStatement2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ContractRegistrationStatement2) DeepCopyInto(out *ContractRegistrationStatement2) {
+	*out = *r
+	if r.StmtId != nil {
+		out.StmtId = new(common.Max35Text)
+		*out.StmtId = *r.StmtId
+	}
+	r.RptgPty.DeepCopyInto(&out.RptgPty)
+	r.RegnAgt.DeepCopyInto(&out.RegnAgt)
+	r.RptgPrd.DeepCopyInto(&out.RptgPrd)
+	r.RegdCtrct.DeepCopyInto(&out.RegdCtrct)
+	if r.TxJrnl != nil {
+		out.TxJrnl = make([]TransactionCertificate3, len(r.TxJrnl))
+		for i := range r.TxJrnl {
+			r.TxJrnl[i].DeepCopyInto(&out.TxJrnl[i])
+		}
+	}
+	if r.SpprtgDocJrnl != nil {
+		out.SpprtgDocJrnl = make([]SupportingDocument2, len(r.SpprtgDocJrnl))
+		for i := range r.SpprtgDocJrnl {
+			r.SpprtgDocJrnl[i].DeepCopyInto(&out.SpprtgDocJrnl[i])
+		}
+	}
+	if r.AddtlSpprtgDocJrnl != nil {
+		out.AddtlSpprtgDocJrnl = make([]SupportingDocument2, len(r.AddtlSpprtgDocJrnl))
+		for i := range r.AddtlSpprtgDocJrnl {
+			r.AddtlSpprtgDocJrnl[i].DeepCopyInto(&out.AddtlSpprtgDocJrnl[i])
+		}
+	}
+	if r.RgltryRuleVldtn != nil {
+		out.RgltryRuleVldtn = make([]GenericValidationRuleIdentification1, len(r.RgltryRuleVldtn))
+		for i := range r.RgltryRuleVldtn {
+			r.RgltryRuleVldtn[i].DeepCopyInto(&out.RgltryRuleVldtn[i])
+		}
+	}
+	if r.SplmtryData != nil {
+		out.SplmtryData = make([]SupplementaryData1, len(r.SplmtryData))
+		for i := range r.SplmtryData {
+			r.SplmtryData[i].DeepCopyInto(&out.SplmtryData[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ContractRegistrationStatementCriteria1) DeepCopy() *ContractRegistrationStatementCriteria1 {
+	if r == nil {
+		return nil
+	}
+	out := new(ContractRegistrationStatementCriteria1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ContractRegistrationStatementCriteria1) DeepCopyInto(out *ContractRegistrationStatementCriteria1) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ContractRegistrationStatementRequest2) DeepCopy() *ContractRegistrationStatementRequest2 {
+	if r == nil {
+		return nil
+	}
+	out := new(ContractRegistrationStatementRequest2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ContractRegistrationStatementRequest2) DeepCopyInto(out *ContractRegistrationStatementRequest2) {
+	*out = *r
+	r.RptgPrd.DeepCopyInto(&out.RptgPrd)
+	r.RptgPty.DeepCopyInto(&out.RptgPty)
+	r.RegnAgt.DeepCopyInto(&out.RegnAgt)
+	if r.RtrCrit != nil {
+		out.RtrCrit = new(ContractRegistrationStatementCriteria1)
+		*out.RtrCrit = *r.RtrCrit
+	}
+	if r.SplmtryData != nil {
+		out.SplmtryData = make([]SupplementaryData1, len(r.SplmtryData))
+		for i := range r.SplmtryData {
+			r.SplmtryData[i].DeepCopyInto(&out.SplmtryData[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ContractRegistrationStatementRequestV02) DeepCopy() *ContractRegistrationStatementRequestV02 {
+	if r == nil {
+		return nil
+	}
+	out := new(ContractRegistrationStatementRequestV02)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ContractRegistrationStatementRequestV02) DeepCopyInto(out *ContractRegistrationStatementRequestV02) {
+	*out = *r
+	r.GrpHdr.DeepCopyInto(&out.GrpHdr)
+	if r.StmtReq != nil {
+		out.StmtReq = make([]ContractRegistrationStatementRequest2, len(r.StmtReq))
+		for i := range r.StmtReq {
+			r.StmtReq[i].DeepCopyInto(&out.StmtReq[i])
+		}
+	}
+	if r.SplmtryData != nil {
+		out.SplmtryData = make([]SupplementaryData1, len(r.SplmtryData))
+		for i := range r.SplmtryData {
+			r.SplmtryData[i].DeepCopyInto(&out.SplmtryData[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ContractRegistrationStatementV02) DeepCopy() *ContractRegistrationStatementV02 {
+	if r == nil {
+		return nil
+	}
+	out := new(ContractRegistrationStatementV02)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ContractRegistrationStatementV02) DeepCopyInto(out *ContractRegistrationStatementV02) {
+	*out = *r
+	r.GrpHdr.DeepCopyInto(&out.GrpHdr)
+	if r.Stmt != nil {
+		out.Stmt = make([]ContractRegistrationStatement2, len(r.Stmt))
+		for i := range r.Stmt {
+			r.Stmt[i].DeepCopyInto(&out.Stmt[i])
+		}
+	}
+	if r.SplmtryData != nil {
+		out.SplmtryData = make([]SupplementaryData1, len(r.SplmtryData))
+		for i := range r.SplmtryData {
+			r.SplmtryData[i].DeepCopyInto(&out.SplmtryData[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CurrencyControlGroupStatus2) DeepCopy() *CurrencyControlGroupStatus2 {
+	if r == nil {
+		return nil
+	}
+	out := new(CurrencyControlGroupStatus2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CurrencyControlGroupStatus2) DeepCopyInto(out *CurrencyControlGroupStatus2) {
+	*out = *r
+	r.OrgnlRefs.DeepCopyInto(&out.OrgnlRefs)
+	r.RptgPty.DeepCopyInto(&out.RptgPty)
+	r.RegnAgt.DeepCopyInto(&out.RegnAgt)
+	if r.RptgPrd != nil {
+		out.RptgPrd = new(Period4Choice)
+		*out.RptgPrd = *r.RptgPrd
+	}
+	if r.Sts != nil {
+		out.Sts = new(StatisticalReportingStatus1Code)
+		*out.Sts = *r.Sts
+	}
+	if r.StsRsn != nil {
+		out.StsRsn = make([]ValidationStatusReason2, len(r.StsRsn))
+		for i := range r.StsRsn {
+			r.StsRsn[i].DeepCopyInto(&out.StsRsn[i])
+		}
+	}
+	if r.StsDtTm != nil {
+		out.StsDtTm = new(common.ISODateTime)
+		*out.StsDtTm = *r.StsDtTm
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CurrencyControlHeader4) DeepCopy() *CurrencyControlHeader4 {
+	if r == nil {
+		return nil
+	}
+	out := new(CurrencyControlHeader4)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CurrencyControlHeader4) DeepCopyInto(out *CurrencyControlHeader4) {
+	*out = *r
+	r.InitgPty.DeepCopyInto(&out.InitgPty)
+	if r.FwdgAgt != nil {
+		out.FwdgAgt = new(BranchAndFinancialInstitutionIdentification6)
+		r.FwdgAgt.DeepCopyInto(out.FwdgAgt)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CurrencyControlHeader5) DeepCopy() *CurrencyControlHeader5 {
+	if r == nil {
+		return nil
+	}
+	out := new(CurrencyControlHeader5)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CurrencyControlHeader5) DeepCopyInto(out *CurrencyControlHeader5) {
+	*out = *r
+	r.InitgPty.DeepCopyInto(&out.InitgPty)
+	if r.FwdgAgt != nil {
+		out.FwdgAgt = new(BranchAndFinancialInstitutionIdentification6)
+		r.FwdgAgt.DeepCopyInto(out.FwdgAgt)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CurrencyControlHeader6) DeepCopy() *CurrencyControlHeader6 {
+	if r == nil {
+		return nil
+	}
+	out := new(CurrencyControlHeader6)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CurrencyControlHeader6) DeepCopyInto(out *CurrencyControlHeader6) {
+	*out = *r
+	r.RcvgPty.DeepCopyInto(&out.RcvgPty)
+	r.RegnAgt.DeepCopyInto(&out.RegnAgt)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CurrencyControlPackageStatus2) DeepCopy() *CurrencyControlPackageStatus2 {
+	if r == nil {
+		return nil
+	}
+	out := new(CurrencyControlPackageStatus2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CurrencyControlPackageStatus2) DeepCopyInto(out *CurrencyControlPackageStatus2) {
+	*out = *r
+	if r.StsRsn != nil {
+		out.StsRsn = make([]ValidationStatusReason2, len(r.StsRsn))
+		for i := range r.StsRsn {
+			r.StsRsn[i].DeepCopyInto(&out.StsRsn[i])
+		}
+	}
+	if r.StsDtTm != nil {
+		out.StsDtTm = new(common.ISODateTime)
+		*out.StsDtTm = *r.StsDtTm
+	}
+	if r.RcrdSts != nil {
+		out.RcrdSts = make([]CurrencyControlRecordStatus2, len(r.RcrdSts))
+		for i := range r.RcrdSts {
+			r.RcrdSts[i].DeepCopyInto(&out.RcrdSts[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CurrencyControlRecordStatus2) DeepCopy() *CurrencyControlRecordStatus2 {
+	if r == nil {
+		return nil
+	}
+	out := new(CurrencyControlRecordStatus2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CurrencyControlRecordStatus2) DeepCopyInto(out *CurrencyControlRecordStatus2) {
+	*out = *r
+	if r.StsRsn != nil {
+		out.StsRsn = make([]ValidationStatusReason2, len(r.StsRsn))
+		for i := range r.StsRsn {
+			r.StsRsn[i].DeepCopyInto(&out.StsRsn[i])
+		}
+	}
+	if r.StsDtTm != nil {
+		out.StsDtTm = new(common.ISODateTime)
+		*out.StsDtTm = *r.StsDtTm
+	}
+	if r.DocId != nil {
+		out.DocId = new(DocumentIdentification28)
+		r.DocId.DeepCopyInto(out.DocId)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CurrencyControlRequestOrLetterV02) DeepCopy() *CurrencyControlRequestOrLetterV02 {
+	if r == nil {
+		return nil
+	}
+	out := new(CurrencyControlRequestOrLetterV02)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CurrencyControlRequestOrLetterV02) DeepCopyInto(out *CurrencyControlRequestOrLetterV02) {
+	*out = *r
+	r.GrpHdr.DeepCopyInto(&out.GrpHdr)
+	if r.ReqOrLttr != nil {
+		out.ReqOrLttr = make([]SupportingDocumentRequestOrLetter2, len(r.ReqOrLttr))
+		for i := range r.ReqOrLttr {
+			r.ReqOrLttr[i].DeepCopyInto(&out.ReqOrLttr[i])
+		}
+	}
+	if r.SplmtryData != nil {
+		out.SplmtryData = make([]SupplementaryData1, len(r.SplmtryData))
+		for i := range r.SplmtryData {
+			r.SplmtryData[i].DeepCopyInto(&out.SplmtryData[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CurrencyControlStatusAdviceV02) DeepCopy() *CurrencyControlStatusAdviceV02 {
+	if r == nil {
+		return nil
+	}
+	out := new(CurrencyControlStatusAdviceV02)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CurrencyControlStatusAdviceV02) DeepCopyInto(out *CurrencyControlStatusAdviceV02) {
+	*out = *r
+	r.GrpHdr.DeepCopyInto(&out.GrpHdr)
+	if r.GrpSts != nil {
+		out.GrpSts = make([]CurrencyControlGroupStatus2, len(r.GrpSts))
+		for i := range r.GrpSts {
+			r.GrpSts[i].DeepCopyInto(&out.GrpSts[i])
+		}
+	}
+	if r.PackgSts != nil {
+		out.PackgSts = make([]CurrencyControlPackageStatus2, len(r.PackgSts))
+		for i := range r.PackgSts {
+			r.PackgSts[i].DeepCopyInto(&out.PackgSts[i])
+		}
+	}
+	if r.SplmtryData != nil {
+		out.SplmtryData = make([]SupplementaryData1, len(r.SplmtryData))
+		for i := range r.SplmtryData {
+			r.SplmtryData[i].DeepCopyInto(&out.SplmtryData[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CurrencyControlSupportingDocumentDeliveryV02) DeepCopy() *CurrencyControlSupportingDocumentDeliveryV02 {
+	if r == nil {
+		return nil
+	}
+	out := new(CurrencyControlSupportingDocumentDeliveryV02)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CurrencyControlSupportingDocumentDeliveryV02) DeepCopyInto(out *CurrencyControlSupportingDocumentDeliveryV02) {
+	*out = *r
+	r.GrpHdr.DeepCopyInto(&out.GrpHdr)
+	if r.SpprtgDoc != nil {
+		out.SpprtgDoc = make([]SupportingDocument2, len(r.SpprtgDoc))
+		for i := range r.SpprtgDoc {
+			r.SpprtgDoc[i].DeepCopyInto(&out.SpprtgDoc[i])
+		}
+	}
+	if r.SplmtryData != nil {
+		out.SplmtryData = make([]SupplementaryData1, len(r.SplmtryData))
+		for i := range r.SplmtryData {
+			r.SplmtryData[i].DeepCopyInto(&out.SplmtryData[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *DateAndPlaceOfBirth1) DeepCopy() *DateAndPlaceOfBirth1 {
+	if r == nil {
+		return nil
+	}
+	out := new(DateAndPlaceOfBirth1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *DateAndPlaceOfBirth1) DeepCopyInto(out *DateAndPlaceOfBirth1) {
+	*out = *r
+	if r.PrvcOfBirth != nil {
+		out.PrvcOfBirth = new(common.Max35Text)
+		*out.PrvcOfBirth = *r.PrvcOfBirth
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *DatePeriod3) DeepCopy() *DatePeriod3 {
+	if r == nil {
+		return nil
+	}
+	out := new(DatePeriod3)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *DatePeriod3) DeepCopyInto(out *DatePeriod3) {
+	*out = *r
+	if r.ToDt != nil {
+		out.ToDt = new(common.ISODate)
+		*out.ToDt = *r.ToDt
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *DocumentAmendment1) DeepCopy() *DocumentAmendment1 {
+	if r == nil {
+		return nil
+	}
+	out := new(DocumentAmendment1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *DocumentAmendment1) DeepCopyInto(out *DocumentAmendment1) {
+	*out = *r
+	if r.OrgnlDocId != nil {
+		out.OrgnlDocId = new(common.Max35Text)
+		*out.OrgnlDocId = *r.OrgnlDocId
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *DocumentGeneralInformation3) DeepCopy() *DocumentGeneralInformation3 {
+	if r == nil {
+		return nil
+	}
+	out := new(DocumentGeneralInformation3)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *DocumentGeneralInformation3) DeepCopyInto(out *DocumentGeneralInformation3) {
+	*out = *r
+	if r.SndrRcvrSeqId != nil {
+		out.SndrRcvrSeqId = new(common.Max140Text)
+		*out.SndrRcvrSeqId = *r.SndrRcvrSeqId
+	}
+	if r.IsseDt != nil {
+		out.IsseDt = new(common.ISODate)
+		*out.IsseDt = *r.IsseDt
+	}
+	if r.URL != nil {
+		out.URL = new(common.Max256Text)
+		*out.URL = *r.URL
+	}
+	if r.LkFileHash != nil {
+		out.LkFileHash = new(SignatureEnvelopeReference)
+		*out.LkFileHash = *r.LkFileHash
+	}
+	r.AttchdBinryFile.DeepCopyInto(&out.AttchdBinryFile)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *DocumentIdentification22) DeepCopy() *DocumentIdentification22 {
+	if r == nil {
+		return nil
+	}
+	out := new(DocumentIdentification22)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *DocumentIdentification22) DeepCopyInto(out *DocumentIdentification22) {
+	*out = *r
+	if r.DtOfIsse != nil {
+		out.DtOfIsse = new(common.ISODate)
+		*out.DtOfIsse = *r.DtOfIsse
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *DocumentIdentification28) DeepCopy() *DocumentIdentification28 {
+	if r == nil {
+		return nil
+	}
+	out := new(DocumentIdentification28)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *DocumentIdentification28) DeepCopyInto(out *DocumentIdentification28) {
+	*out = *r
+	if r.Id != nil {
+		out.Id = new(common.Max35Text)
+		*out.Id = *r.Id
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *DocumentIdentification29) DeepCopy() *DocumentIdentification29 {
+	if r == nil {
+		return nil
+	}
+	out := new(DocumentIdentification29)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *DocumentIdentification29) DeepCopyInto(out *DocumentIdentification29) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ExchangeRate1) DeepCopy() *ExchangeRate1 {
+	if r == nil {
+		return nil
+	}
+	out := new(ExchangeRate1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ExchangeRate1) DeepCopyInto(out *ExchangeRate1) {
+	*out = *r
+	if r.UnitCcy != nil {
+		out.UnitCcy = new(common.ActiveOrHistoricCurrencyCode)
+		*out.UnitCcy = *r.UnitCcy
+	}
+	if r.RateTp != nil {
+		out.RateTp = new(ExchangeRateType1Code)
+		*out.RateTp = *r.RateTp
+	}
+	if r.CtrctId != nil {
+		out.CtrctId = new(common.Max35Text)
+		*out.CtrctId = *r.CtrctId
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *FinancialIdentificationSchemeName1Choice) DeepCopy() *FinancialIdentificationSchemeName1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(FinancialIdentificationSchemeName1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *FinancialIdentificationSchemeName1Choice) DeepCopyInto(out *FinancialIdentificationSchemeName1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *FinancialInstitutionIdentification18) DeepCopy() *FinancialInstitutionIdentification18 {
+	if r == nil {
+		return nil
+	}
+	out := new(FinancialInstitutionIdentification18)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *FinancialInstitutionIdentification18) DeepCopyInto(out *FinancialInstitutionIdentification18) {
+	*out = *r
+	if r.BICFI != nil {
+		out.BICFI = new(common.BICFIDec2014Identifier)
+		*out.BICFI = *r.BICFI
+	}
+	if r.ClrSysMmbId != nil {
+		out.ClrSysMmbId = new(ClearingSystemMemberIdentification2)
+		r.ClrSysMmbId.DeepCopyInto(out.ClrSysMmbId)
+	}
+	if r.LEI != nil {
+		out.LEI = new(common.LEIIdentifier)
+		*out.LEI = *r.LEI
+	}
+	if r.Nm != nil {
+		out.Nm = new(common.Max140Text)
+		*out.Nm = *r.Nm
+	}
+	if r.PstlAdr != nil {
+		out.PstlAdr = new(PostalAddress24)
+		r.PstlAdr.DeepCopyInto(out.PstlAdr)
+	}
+	if r.Othr != nil {
+		out.Othr = new(GenericFinancialIdentification1)
+		r.Othr.DeepCopyInto(out.Othr)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *FloatingInterestRate4) DeepCopy() *FloatingInterestRate4 {
+	if r == nil {
+		return nil
+	}
+	out := new(FloatingInterestRate4)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *FloatingInterestRate4) DeepCopyInto(out *FloatingInterestRate4) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *GenericAccountIdentification1) DeepCopy() *GenericAccountIdentification1 {
+	if r == nil {
+		return nil
+	}
+	out := new(GenericAccountIdentification1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *GenericAccountIdentification1) DeepCopyInto(out *GenericAccountIdentification1) {
+	*out = *r
+	if r.SchmeNm != nil {
+		out.SchmeNm = new(AccountSchemeName1Choice)
+		*out.SchmeNm = *r.SchmeNm
+	}
+	if r.Issr != nil {
+		out.Issr = new(common.Max35Text)
+		*out.Issr = *r.Issr
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *GenericFinancialIdentification1) DeepCopy() *GenericFinancialIdentification1 {
+	if r == nil {
+		return nil
+	}
+	out := new(GenericFinancialIdentification1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *GenericFinancialIdentification1) DeepCopyInto(out *GenericFinancialIdentification1) {
+	*out = *r
+	if r.SchmeNm != nil {
+		out.SchmeNm = new(FinancialIdentificationSchemeName1Choice)
+		*out.SchmeNm = *r.SchmeNm
+	}
+	if r.Issr != nil {
+		out.Issr = new(common.Max35Text)
+		*out.Issr = *r.Issr
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *GenericIdentification30) DeepCopy() *GenericIdentification30 {
+	if r == nil {
+		return nil
+	}
+	out := new(GenericIdentification30)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *GenericIdentification30) DeepCopyInto(out *GenericIdentification30) {
+	*out = *r
+	if r.SchmeNm != nil {
+		out.SchmeNm = new(common.Max35Text)
+		*out.SchmeNm = *r.SchmeNm
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *GenericOrganisationIdentification1) DeepCopy() *GenericOrganisationIdentification1 {
+	if r == nil {
+		return nil
+	}
+	out := new(GenericOrganisationIdentification1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *GenericOrganisationIdentification1) DeepCopyInto(out *GenericOrganisationIdentification1) {
+	*out = *r
+	if r.SchmeNm != nil {
+		out.SchmeNm = new(OrganisationIdentificationSchemeName1Choice)
+		*out.SchmeNm = *r.SchmeNm
+	}
+	if r.Issr != nil {
+		out.Issr = new(common.Max35Text)
+		*out.Issr = *r.Issr
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *GenericPersonIdentification1) DeepCopy() *GenericPersonIdentification1 {
+	if r == nil {
+		return nil
+	}
+	out := new(GenericPersonIdentification1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *GenericPersonIdentification1) DeepCopyInto(out *GenericPersonIdentification1) {
+	*out = *r
+	if r.SchmeNm != nil {
+		out.SchmeNm = new(PersonIdentificationSchemeName1Choice)
+		*out.SchmeNm = *r.SchmeNm
+	}
+	if r.Issr != nil {
+		out.Issr = new(common.Max35Text)
+		*out.Issr = *r.Issr
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *GenericValidationRuleIdentification1) DeepCopy() *GenericValidationRuleIdentification1 {
+	if r == nil {
+		return nil
+	}
+	out := new(GenericValidationRuleIdentification1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *GenericValidationRuleIdentification1) DeepCopyInto(out *GenericValidationRuleIdentification1) {
+	*out = *r
+	if r.Desc != nil {
+		out.Desc = new(common.Max350Text)
+		*out.Desc = *r.Desc
+	}
+	if r.SchmeNm != nil {
+		out.SchmeNm = new(ValidationRuleSchemeName1Choice)
+		*out.SchmeNm = *r.SchmeNm
+	}
+	if r.Issr != nil {
+		out.Issr = new(common.Max35Text)
+		*out.Issr = *r.Issr
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *InterestPaymentDateRange1) DeepCopy() *InterestPaymentDateRange1 {
+	if r == nil {
+		return nil
+	}
+	out := new(InterestPaymentDateRange1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *InterestPaymentDateRange1) DeepCopyInto(out *InterestPaymentDateRange1) {
+	*out = *r
+	if r.IntrstSchdlId != nil {
+		out.IntrstSchdlId = new(common.Max35Text)
+		*out.IntrstSchdlId = *r.IntrstSchdlId
+	}
+	if r.XpctdDt != nil {
+		out.XpctdDt = new(common.ISODate)
+		*out.XpctdDt = *r.XpctdDt
+	}
+	if r.DueDt != nil {
+		out.DueDt = new(common.ISODate)
+		*out.DueDt = *r.DueDt
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *InterestPaymentDateRange2) DeepCopy() *InterestPaymentDateRange2 {
+	if r == nil {
+		return nil
+	}
+	out := new(InterestPaymentDateRange2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *InterestPaymentDateRange2) DeepCopyInto(out *InterestPaymentDateRange2) {
+	*out = *r
+	if r.IntrstSchdlId != nil {
+		out.IntrstSchdlId = new(common.Max35Text)
+		*out.IntrstSchdlId = *r.IntrstSchdlId
+	}
+	if r.AddtlInf != nil {
+		out.AddtlInf = new(common.Max1025Text)
+		*out.AddtlInf = *r.AddtlInf
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *InterestPaymentSchedule1Choice) DeepCopy() *InterestPaymentSchedule1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(InterestPaymentSchedule1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *InterestPaymentSchedule1Choice) DeepCopyInto(out *InterestPaymentSchedule1Choice) {
+	*out = *r
+	r.DtRg.DeepCopyInto(&out.DtRg)
+	if r.SubSchdl != nil {
+		out.SubSchdl = make([]InterestPaymentDateRange2, len(r.SubSchdl))
+		for i := range r.SubSchdl {
+			r.SubSchdl[i].DeepCopyInto(&out.SubSchdl[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *InterestRate2Choice) DeepCopy() *InterestRate2Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(InterestRate2Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *InterestRate2Choice) DeepCopyInto(out *InterestRate2Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *InterestRateContractTerm1) DeepCopy() *InterestRateContractTerm1 {
+	if r == nil {
+		return nil
+	}
+	out := new(InterestRateContractTerm1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *InterestRateContractTerm1) DeepCopyInto(out *InterestRateContractTerm1) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *LegalOrganisation2) DeepCopy() *LegalOrganisation2 {
+	if r == nil {
+		return nil
+	}
+	out := new(LegalOrganisation2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *LegalOrganisation2) DeepCopyInto(out *LegalOrganisation2) {
+	*out = *r
+	if r.Id != nil {
+		out.Id = new(common.Max35Text)
+		*out.Id = *r.Id
+	}
+	if r.Nm != nil {
+		out.Nm = new(common.Max140Text)
+		*out.Nm = *r.Nm
+	}
+	if r.EstblishmtDt != nil {
+		out.EstblishmtDt = new(common.ISODate)
+		*out.EstblishmtDt = *r.EstblishmtDt
+	}
+	if r.RegnDt != nil {
+		out.RegnDt = new(common.ISODate)
+		*out.RegnDt = *r.RegnDt
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *LoanContract2) DeepCopy() *LoanContract2 {
+	if r == nil {
+		return nil
+	}
+	out := new(LoanContract2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *LoanContract2) DeepCopyInto(out *LoanContract2) {
+	*out = *r
+	r.CtrctDocId.DeepCopyInto(&out.CtrctDocId)
+	if r.Buyr != nil {
+		out.Buyr = make([]TradeParty5, len(r.Buyr))
+		for i := range r.Buyr {
+			r.Buyr[i].DeepCopyInto(&out.Buyr[i])
+		}
+	}
+	if r.Sellr != nil {
+		out.Sellr = make([]TradeParty5, len(r.Sellr))
+		for i := range r.Sellr {
+			r.Sellr[i].DeepCopyInto(&out.Sellr[i])
+		}
+	}
+	if r.SpclConds != nil {
+		out.SpclConds = new(SpecialCondition1)
+		r.SpclConds.DeepCopyInto(out.SpclConds)
+	}
+	if r.Trch != nil {
+		out.Trch = make([]LoanContractTranche1, len(r.Trch))
+		for i := range r.Trch {
+			r.Trch[i].DeepCopyInto(&out.Trch[i])
+		}
+	}
+	if r.PmtSchdl != nil {
+		out.PmtSchdl = new(PaymentSchedule1Choice)
+		r.PmtSchdl.DeepCopyInto(out.PmtSchdl)
+	}
+	r.IntrstSchdl.DeepCopyInto(&out.IntrstSchdl)
+	if r.Coll != nil {
+		out.Coll = new(ContractCollateral1)
+		r.Coll.DeepCopyInto(out.Coll)
+	}
+	if r.SndctdLn != nil {
+		out.SndctdLn = make([]SyndicatedLoan2, len(r.SndctdLn))
+		for i := range r.SndctdLn {
+			r.SndctdLn[i].DeepCopyInto(&out.SndctdLn[i])
+		}
+	}
+	if r.Attchmnt != nil {
+		out.Attchmnt = make([]DocumentGeneralInformation3, len(r.Attchmnt))
+		for i := range r.Attchmnt {
+			r.Attchmnt[i].DeepCopyInto(&out.Attchmnt[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *LoanContractTranche1) DeepCopy() *LoanContractTranche1 {
+	if r == nil {
+		return nil
+	}
+	out := new(LoanContractTranche1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *LoanContractTranche1) DeepCopyInto(out *LoanContractTranche1) {
+	*out = *r
+	if r.DueDt != nil {
+		out.DueDt = new(common.ISODate)
+		*out.DueDt = *r.DueDt
+	}
+	if r.DrtnCd != nil {
+		out.DrtnCd = new(Exact1NumericText)
+		*out.DrtnCd = *r.DrtnCd
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *OrganisationIdentification29) DeepCopy() *OrganisationIdentification29 {
+	if r == nil {
+		return nil
+	}
+	out := new(OrganisationIdentification29)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *OrganisationIdentification29) DeepCopyInto(out *OrganisationIdentification29) {
+	*out = *r
+	if r.AnyBIC != nil {
+		out.AnyBIC = new(common.AnyBICDec2014Identifier)
+		*out.AnyBIC = *r.AnyBIC
+	}
+	if r.LEI != nil {
+		out.LEI = new(common.LEIIdentifier)
+		*out.LEI = *r.LEI
+	}
+	if r.Othr != nil {
+		out.Othr = make([]GenericOrganisationIdentification1, len(r.Othr))
+		for i := range r.Othr {
+			r.Othr[i].DeepCopyInto(&out.Othr[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *OrganisationIdentificationSchemeName1Choice) DeepCopy() *OrganisationIdentificationSchemeName1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(OrganisationIdentificationSchemeName1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *OrganisationIdentificationSchemeName1Choice) DeepCopyInto(out *OrganisationIdentificationSchemeName1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *OriginalMessage4) DeepCopy() *OriginalMessage4 {
+	if r == nil {
+		return nil
+	}
+	out := new(OriginalMessage4)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *OriginalMessage4) DeepCopyInto(out *OriginalMessage4) {
+	*out = *r
+	if r.OrgnlSndr != nil {
+		out.OrgnlSndr = new(Party40Choice)
+		r.OrgnlSndr.DeepCopyInto(out.OrgnlSndr)
+	}
+	if r.OrgnlCreDtTm != nil {
+		out.OrgnlCreDtTm = new(common.ISODateTime)
+		*out.OrgnlCreDtTm = *r.OrgnlCreDtTm
+	}
+	if r.OrgnlPackgId != nil {
+		out.OrgnlPackgId = new(common.Max35Text)
+		*out.OrgnlPackgId = *r.OrgnlPackgId
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *OriginalMessage5) DeepCopy() *OriginalMessage5 {
+	if r == nil {
+		return nil
+	}
+	out := new(OriginalMessage5)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *OriginalMessage5) DeepCopyInto(out *OriginalMessage5) {
+	*out = *r
+	if r.OrgnlSndr != nil {
+		out.OrgnlSndr = new(Party40Choice)
+		r.OrgnlSndr.DeepCopyInto(out.OrgnlSndr)
+	}
+	if r.OrgnlCreDtTm != nil {
+		out.OrgnlCreDtTm = new(common.ISODateTime)
+		*out.OrgnlCreDtTm = *r.OrgnlCreDtTm
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *OtherContact1) DeepCopy() *OtherContact1 {
+	if r == nil {
+		return nil
+	}
+	out := new(OtherContact1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *OtherContact1) DeepCopyInto(out *OtherContact1) {
+	*out = *r
+	if r.Id != nil {
+		out.Id = new(common.Max128Text)
+		*out.Id = *r.Id
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *Party38Choice) DeepCopy() *Party38Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(Party38Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *Party38Choice) DeepCopyInto(out *Party38Choice) {
+	*out = *r
+	r.OrgId.DeepCopyInto(&out.OrgId)
+	r.PrvtId.DeepCopyInto(&out.PrvtId)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *Party40Choice) DeepCopy() *Party40Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(Party40Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *Party40Choice) DeepCopyInto(out *Party40Choice) {
+	*out = *r
+	r.Pty.DeepCopyInto(&out.Pty)
+	r.Agt.DeepCopyInto(&out.Agt)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *PartyIdentification135) DeepCopy() *PartyIdentification135 {
+	if r == nil {
+		return nil
+	}
+	out := new(PartyIdentification135)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *PartyIdentification135) DeepCopyInto(out *PartyIdentification135) {
+	*out = *r
+	if r.Nm != nil {
+		out.Nm = new(common.Max140Text)
+		*out.Nm = *r.Nm
+	}
+	if r.PstlAdr != nil {
+		out.PstlAdr = new(PostalAddress24)
+		r.PstlAdr.DeepCopyInto(out.PstlAdr)
+	}
+	if r.Id != nil {
+		out.Id = new(Party38Choice)
+		r.Id.DeepCopyInto(out.Id)
+	}
+	if r.CtryOfRes != nil {
+		out.CtryOfRes = new(common.CountryCode)
+		*out.CtryOfRes = *r.CtryOfRes
+	}
+	if r.CtctDtls != nil {
+		out.CtctDtls = new(Contact4)
+		r.CtctDtls.DeepCopyInto(out.CtctDtls)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *PaymentDateRange1) DeepCopy() *PaymentDateRange1 {
+	if r == nil {
+		return nil
+	}
+	out := new(PaymentDateRange1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *PaymentDateRange1) DeepCopyInto(out *PaymentDateRange1) {
+	*out = *r
+	if r.PmtSchdlId != nil {
+		out.PmtSchdlId = new(common.Max35Text)
+		*out.PmtSchdlId = *r.PmtSchdlId
+	}
+	if r.XpctdDt != nil {
+		out.XpctdDt = new(common.ISODate)
+		*out.XpctdDt = *r.XpctdDt
+	}
+	if r.DueDt != nil {
+		out.DueDt = new(common.ISODate)
+		*out.DueDt = *r.DueDt
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *PaymentDateRange2) DeepCopy() *PaymentDateRange2 {
+	if r == nil {
+		return nil
+	}
+	out := new(PaymentDateRange2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *PaymentDateRange2) DeepCopyInto(out *PaymentDateRange2) {
+	*out = *r
+	if r.PmtSchdlId != nil {
+		out.PmtSchdlId = new(common.Max35Text)
+		*out.PmtSchdlId = *r.PmtSchdlId
+	}
+	if r.XpctdDt != nil {
+		out.XpctdDt = new(common.ISODate)
+		*out.XpctdDt = *r.XpctdDt
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *PaymentRegulatoryInformationNotificationV02) DeepCopy() *PaymentRegulatoryInformationNotificationV02 {
+	if r == nil {
+		return nil
+	}
+	out := new(PaymentRegulatoryInformationNotificationV02)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *PaymentRegulatoryInformationNotificationV02) DeepCopyInto(out *PaymentRegulatoryInformationNotificationV02) {
+	*out = *r
+	r.GrpHdr.DeepCopyInto(&out.GrpHdr)
+	if r.TxNtfctn != nil {
+		out.TxNtfctn = make([]RegulatoryReportingNotification2, len(r.TxNtfctn))
+		for i := range r.TxNtfctn {
+			r.TxNtfctn[i].DeepCopyInto(&out.TxNtfctn[i])
+		}
+	}
+	if r.SplmtryData != nil {
+		out.SplmtryData = make([]SupplementaryData1, len(r.SplmtryData))
+		for i := range r.SplmtryData {
+			r.SplmtryData[i].DeepCopyInto(&out.SplmtryData[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *PaymentSchedule1Choice) DeepCopy() *PaymentSchedule1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(PaymentSchedule1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *PaymentSchedule1Choice) DeepCopyInto(out *PaymentSchedule1Choice) {
+	*out = *r
+	r.DtRg.DeepCopyInto(&out.DtRg)
+	if r.SubSchdl != nil {
+		out.SubSchdl = make([]PaymentDateRange2, len(r.SubSchdl))
+		for i := range r.SubSchdl {
+			r.SubSchdl[i].DeepCopyInto(&out.SubSchdl[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *PaymentScheduleType1Choice) DeepCopy() *PaymentScheduleType1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(PaymentScheduleType1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *PaymentScheduleType1Choice) DeepCopyInto(out *PaymentScheduleType1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *Period2) DeepCopy() *Period2 {
+	if r == nil {
+		return nil
+	}
+	out := new(Period2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *Period2) DeepCopyInto(out *Period2) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *Period4Choice) DeepCopy() *Period4Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(Period4Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *Period4Choice) DeepCopyInto(out *Period4Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *PersonIdentification13) DeepCopy() *PersonIdentification13 {
+	if r == nil {
+		return nil
+	}
+	out := new(PersonIdentification13)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *PersonIdentification13) DeepCopyInto(out *PersonIdentification13) {
+	*out = *r
+	if r.DtAndPlcOfBirth != nil {
+		out.DtAndPlcOfBirth = new(DateAndPlaceOfBirth1)
+		r.DtAndPlcOfBirth.DeepCopyInto(out.DtAndPlcOfBirth)
+	}
+	if r.Othr != nil {
+		out.Othr = make([]GenericPersonIdentification1, len(r.Othr))
+		for i := range r.Othr {
+			r.Othr[i].DeepCopyInto(&out.Othr[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *PersonIdentificationSchemeName1Choice) DeepCopy() *PersonIdentificationSchemeName1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(PersonIdentificationSchemeName1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *PersonIdentificationSchemeName1Choice) DeepCopyInto(out *PersonIdentificationSchemeName1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *PostalAddress24) DeepCopy() *PostalAddress24 {
+	if r == nil {
+		return nil
+	}
+	out := new(PostalAddress24)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *PostalAddress24) DeepCopyInto(out *PostalAddress24) {
+	*out = *r
+	if r.AdrTp != nil {
+		out.AdrTp = new(AddressType3Choice)
+		r.AdrTp.DeepCopyInto(out.AdrTp)
+	}
+	if r.Dept != nil {
+		out.Dept = new(common.Max70Text)
+		*out.Dept = *r.Dept
+	}
+	if r.SubDept != nil {
+		out.SubDept = new(common.Max70Text)
+		*out.SubDept = *r.SubDept
+	}
+	if r.StrtNm != nil {
+		out.StrtNm = new(common.Max70Text)
+		*out.StrtNm = *r.StrtNm
+	}
+	if r.BldgNb != nil {
+		out.BldgNb = new(common.Max16Text)
+		*out.BldgNb = *r.BldgNb
+	}
+	if r.BldgNm != nil {
+		out.BldgNm = new(common.Max35Text)
+		*out.BldgNm = *r.BldgNm
+	}
+	if r.Flr != nil {
+		out.Flr = new(common.Max70Text)
+		*out.Flr = *r.Flr
+	}
+	if r.PstBx != nil {
+		out.PstBx = new(common.Max16Text)
+		*out.PstBx = *r.PstBx
+	}
+	if r.Room != nil {
+		out.Room = new(common.Max70Text)
+		*out.Room = *r.Room
+	}
+	if r.PstCd != nil {
+		out.PstCd = new(common.Max16Text)
+		*out.PstCd = *r.PstCd
+	}
+	if r.TwnNm != nil {
+		out.TwnNm = new(common.Max35Text)
+		*out.TwnNm = *r.TwnNm
+	}
+	if r.TwnLctnNm != nil {
+		out.TwnLctnNm = new(common.Max35Text)
+		*out.TwnLctnNm = *r.TwnLctnNm
+	}
+	if r.DstrctNm != nil {
+		out.DstrctNm = new(common.Max35Text)
+		*out.DstrctNm = *r.DstrctNm
+	}
+	if r.CtrySubDvsn != nil {
+		out.CtrySubDvsn = new(common.Max35Text)
+		*out.CtrySubDvsn = *r.CtrySubDvsn
+	}
+	if r.Ctry != nil {
+		out.Ctry = new(common.CountryCode)
+		*out.Ctry = *r.Ctry
+	}
+	if r.AdrLine != nil {
+		out.AdrLine = make([]common.Max70Text, len(r.AdrLine))
+		copy(out.AdrLine, r.AdrLine)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ProprietaryReference1) DeepCopy() *ProprietaryReference1 {
+	if r == nil {
+		return nil
+	}
+	out := new(ProprietaryReference1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ProprietaryReference1) DeepCopyInto(out *ProprietaryReference1) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ProxyAccountIdentification1) DeepCopy() *ProxyAccountIdentification1 {
+	if r == nil {
+		return nil
+	}
+	out := new(ProxyAccountIdentification1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ProxyAccountIdentification1) DeepCopyInto(out *ProxyAccountIdentification1) {
+	*out = *r
+	if r.Tp != nil {
+		out.Tp = new(ProxyAccountType1Choice)
+		*out.Tp = *r.Tp
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ProxyAccountType1Choice) DeepCopy() *ProxyAccountType1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(ProxyAccountType1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ProxyAccountType1Choice) DeepCopyInto(out *ProxyAccountType1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *RegisteredContract10) DeepCopy() *RegisteredContract10 {
+	if r == nil {
+		return nil
+	}
+	out := new(RegisteredContract10)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *RegisteredContract10) DeepCopyInto(out *RegisteredContract10) {
+	*out = *r
+	r.Ctrct.DeepCopyInto(&out.Ctrct)
+	if r.CtrctBal != nil {
+		out.CtrctBal = make([]ContractBalance1, len(r.CtrctBal))
+		copy(out.CtrctBal, r.CtrctBal)
+	}
+	if r.PmtSchdlTp != nil {
+		out.PmtSchdlTp = new(PaymentScheduleType1Choice)
+		*out.PmtSchdlTp = *r.PmtSchdlTp
+	}
+	if r.AddtlInf != nil {
+		out.AddtlInf = new(common.Max1025Text)
+		*out.AddtlInf = *r.AddtlInf
+	}
+	if r.Attchmnt != nil {
+		out.Attchmnt = make([]DocumentGeneralInformation3, len(r.Attchmnt))
+		for i := range r.Attchmnt {
+			r.Attchmnt[i].DeepCopyInto(&out.Attchmnt[i])
+		}
+	}
+	if r.SplmtryData != nil {
+		out.SplmtryData = make([]SupplementaryData1, len(r.SplmtryData))
+		for i := range r.SplmtryData {
+			r.SplmtryData[i].DeepCopyInto(&out.SplmtryData[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *RegisteredContract6) DeepCopy() *RegisteredContract6 {
+	if r == nil {
+		return nil
+	}
+	out := new(RegisteredContract6)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *RegisteredContract6) DeepCopyInto(out *RegisteredContract6) {
+	*out = *r
+	r.RptgPty.DeepCopyInto(&out.RptgPty)
+	r.RegnAgt.DeepCopyInto(&out.RegnAgt)
+	if r.SplmtryData != nil {
+		out.SplmtryData = make([]SupplementaryData1, len(r.SplmtryData))
+		for i := range r.SplmtryData {
+			r.SplmtryData[i].DeepCopyInto(&out.SplmtryData[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *RegisteredContract7) DeepCopy() *RegisteredContract7 {
+	if r == nil {
+		return nil
+	}
+	out := new(RegisteredContract7)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *RegisteredContract7) DeepCopyInto(out *RegisteredContract7) {
+	*out = *r
+	if r.OrgnlCtrctRegnReq != nil {
+		out.OrgnlCtrctRegnReq = new(common.Max35Text)
+		*out.OrgnlCtrctRegnReq = *r.OrgnlCtrctRegnReq
+	}
+	r.RptgPty.DeepCopyInto(&out.RptgPty)
+	r.RegnAgt.DeepCopyInto(&out.RegnAgt)
+	r.IssrFI.DeepCopyInto(&out.IssrFI)
+	r.Ctrct.DeepCopyInto(&out.Ctrct)
+	if r.CtrctBal != nil {
+		out.CtrctBal = make([]ContractBalance1, len(r.CtrctBal))
+		copy(out.CtrctBal, r.CtrctBal)
+	}
+	if r.PmtSchdlTp != nil {
+		out.PmtSchdlTp = new(PaymentScheduleType1Choice)
+		*out.PmtSchdlTp = *r.PmtSchdlTp
+	}
+	if r.PrvsRegdCtrctId != nil {
+		out.PrvsRegdCtrctId = new(DocumentIdentification22)
+		r.PrvsRegdCtrctId.DeepCopyInto(out.PrvsRegdCtrctId)
+	}
+	if r.RegdCtrctJrnl != nil {
+		out.RegdCtrctJrnl = make([]RegisteredContractJournal2, len(r.RegdCtrctJrnl))
+		for i := range r.RegdCtrctJrnl {
+			r.RegdCtrctJrnl[i].DeepCopyInto(&out.RegdCtrctJrnl[i])
+		}
+	}
+	if r.Amdmnt != nil {
+		out.Amdmnt = make([]RegisteredContractAmendment1, len(r.Amdmnt))
+		for i := range r.Amdmnt {
+			r.Amdmnt[i].DeepCopyInto(&out.Amdmnt[i])
+		}
+	}
+	if r.LnPrncplAmt != nil {
+		out.LnPrncplAmt = new(ActiveCurrencyAndAmount)
+		*out.LnPrncplAmt = *r.LnPrncplAmt
+	}
+	if r.AddtlInf != nil {
+		out.AddtlInf = new(common.Max1025Text)
+		*out.AddtlInf = *r.AddtlInf
+	}
+	if r.SplmtryData != nil {
+		out.SplmtryData = make([]SupplementaryData1, len(r.SplmtryData))
+		for i := range r.SplmtryData {
+			r.SplmtryData[i].DeepCopyInto(&out.SplmtryData[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *RegisteredContract8) DeepCopy() *RegisteredContract8 {
+	if r == nil {
+		return nil
+	}
+	out := new(RegisteredContract8)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *RegisteredContract8) DeepCopyInto(out *RegisteredContract8) {
+	*out = *r
+	if r.OrgnlCtrctRegnReq != nil {
+		out.OrgnlCtrctRegnReq = new(common.Max35Text)
+		*out.OrgnlCtrctRegnReq = *r.OrgnlCtrctRegnReq
+	}
+	r.IssrFI.DeepCopyInto(&out.IssrFI)
+	r.Ctrct.DeepCopyInto(&out.Ctrct)
+	if r.CtrctBal != nil {
+		out.CtrctBal = make([]ContractBalance1, len(r.CtrctBal))
+		copy(out.CtrctBal, r.CtrctBal)
+	}
+	if r.PmtSchdlTp != nil {
+		out.PmtSchdlTp = new(PaymentScheduleType1Choice)
+		*out.PmtSchdlTp = *r.PmtSchdlTp
+	}
+	if r.PrvsRegdCtrctId != nil {
+		out.PrvsRegdCtrctId = new(DocumentIdentification22)
+		r.PrvsRegdCtrctId.DeepCopyInto(out.PrvsRegdCtrctId)
+	}
+	if r.RegdCtrctJrnl != nil {
+		out.RegdCtrctJrnl = make([]RegisteredContractJournal2, len(r.RegdCtrctJrnl))
+		for i := range r.RegdCtrctJrnl {
+			r.RegdCtrctJrnl[i].DeepCopyInto(&out.RegdCtrctJrnl[i])
+		}
+	}
+	if r.Amdmnt != nil {
+		out.Amdmnt = make([]RegisteredContractAmendment1, len(r.Amdmnt))
+		for i := range r.Amdmnt {
+			r.Amdmnt[i].DeepCopyInto(&out.Amdmnt[i])
+		}
+	}
+	if r.LnPrncplAmt != nil {
+		out.LnPrncplAmt = new(ActiveCurrencyAndAmount)
+		*out.LnPrncplAmt = *r.LnPrncplAmt
+	}
+	if r.AddtlInf != nil {
+		out.AddtlInf = new(common.Max1025Text)
+		*out.AddtlInf = *r.AddtlInf
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *RegisteredContract9) DeepCopy() *RegisteredContract9 {
+	if r == nil {
+		return nil
+	}
+	out := new(RegisteredContract9)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *RegisteredContract9) DeepCopyInto(out *RegisteredContract9) {
+	*out = *r
+	r.RptgPty.DeepCopyInto(&out.RptgPty)
+	r.RegnAgt.DeepCopyInto(&out.RegnAgt)
+	if r.RegdCtrctAmdmnt != nil {
+		out.RegdCtrctAmdmnt = make([]RegisteredContract10, len(r.RegdCtrctAmdmnt))
+		for i := range r.RegdCtrctAmdmnt {
+			r.RegdCtrctAmdmnt[i].DeepCopyInto(&out.RegdCtrctAmdmnt[i])
+		}
+	}
+	if r.SplmtryData != nil {
+		out.SplmtryData = make([]SupplementaryData1, len(r.SplmtryData))
+		for i := range r.SplmtryData {
+			r.SplmtryData[i].DeepCopyInto(&out.SplmtryData[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *RegisteredContractAmendment1) DeepCopy() *RegisteredContractAmendment1 {
+	if r == nil {
+		return nil
+	}
+	out := new(RegisteredContractAmendment1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *RegisteredContractAmendment1) DeepCopyInto(out *RegisteredContractAmendment1) {
+	*out = *r
+	r.Doc.DeepCopyInto(&out.Doc)
+	if r.StartDt != nil {
+		out.StartDt = new(common.ISODate)
+		*out.StartDt = *r.StartDt
+	}
+	if r.AmdmntRsn != nil {
+		out.AmdmntRsn = new(common.Max35Text)
+		*out.AmdmntRsn = *r.AmdmntRsn
+	}
+	if r.AddtlInf != nil {
+		out.AddtlInf = new(common.Max1025Text)
+		*out.AddtlInf = *r.AddtlInf
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *RegisteredContractCommunication1) DeepCopy() *RegisteredContractCommunication1 {
+	if r == nil {
+		return nil
+	}
+	out := new(RegisteredContractCommunication1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *RegisteredContractCommunication1) DeepCopyInto(out *RegisteredContractCommunication1) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *RegisteredContractJournal2) DeepCopy() *RegisteredContractJournal2 {
+	if r == nil {
+		return nil
+	}
+	out := new(RegisteredContractJournal2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *RegisteredContractJournal2) DeepCopyInto(out *RegisteredContractJournal2) {
+	*out = *r
+	r.RegnAgt.DeepCopyInto(&out.RegnAgt)
+	if r.UnqId != nil {
+		out.UnqId = new(DocumentIdentification28)
+		r.UnqId.DeepCopyInto(out.UnqId)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *RegulatoryReportingNotification2) DeepCopy() *RegulatoryReportingNotification2 {
+	if r == nil {
+		return nil
+	}
+	out := new(RegulatoryReportingNotification2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *RegulatoryReportingNotification2) DeepCopyInto(out *RegulatoryReportingNotification2) {
+	*out = *r
+	r.AcctOwnr.DeepCopyInto(&out.AcctOwnr)
+	r.AcctSvcr.DeepCopyInto(&out.AcctSvcr)
+	if r.TxCert != nil {
+		out.TxCert = make([]TransactionCertificate3, len(r.TxCert))
+		for i := range r.TxCert {
+			r.TxCert[i].DeepCopyInto(&out.TxCert[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ReportingPeriod4) DeepCopy() *ReportingPeriod4 {
+	if r == nil {
+		return nil
+	}
+	out := new(ReportingPeriod4)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ReportingPeriod4) DeepCopyInto(out *ReportingPeriod4) {
+	*out = *r
+	r.FrToDt.DeepCopyInto(&out.FrToDt)
+	r.FrToTm.DeepCopyInto(&out.FrToTm)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ShipmentAttribute1) DeepCopy() *ShipmentAttribute1 {
+	if r == nil {
+		return nil
+	}
+	out := new(ShipmentAttribute1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ShipmentAttribute1) DeepCopyInto(out *ShipmentAttribute1) {
+	*out = *r
+	if r.Conds != nil {
+		out.Conds = new(ExternalShipmentCondition1Code)
+		*out.Conds = *r.Conds
+	}
+	if r.XpctdDt != nil {
+		out.XpctdDt = new(common.ISODate)
+		*out.XpctdDt = *r.XpctdDt
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ShipmentDateRange1) DeepCopy() *ShipmentDateRange1 {
+	if r == nil {
+		return nil
+	}
+	out := new(ShipmentDateRange1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ShipmentDateRange1) DeepCopyInto(out *ShipmentDateRange1) {
+	*out = *r
+	if r.EarlstShipmntDt != nil {
+		out.EarlstShipmntDt = new(common.ISODate)
+		*out.EarlstShipmntDt = *r.EarlstShipmntDt
+	}
+	if r.LatstShipmntDt != nil {
+		out.LatstShipmntDt = new(common.ISODate)
+		*out.LatstShipmntDt = *r.LatstShipmntDt
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ShipmentDateRange2) DeepCopy() *ShipmentDateRange2 {
+	if r == nil {
+		return nil
+	}
+	out := new(ShipmentDateRange2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ShipmentDateRange2) DeepCopyInto(out *ShipmentDateRange2) {
+	*out = *r
+	if r.EarlstShipmntDt != nil {
+		out.EarlstShipmntDt = new(common.ISODate)
+		*out.EarlstShipmntDt = *r.EarlstShipmntDt
+	}
+	if r.LatstShipmntDt != nil {
+		out.LatstShipmntDt = new(common.ISODate)
+		*out.LatstShipmntDt = *r.LatstShipmntDt
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ShipmentSchedule2Choice) DeepCopy() *ShipmentSchedule2Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(ShipmentSchedule2Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ShipmentSchedule2Choice) DeepCopyInto(out *ShipmentSchedule2Choice) {
+	*out = *r
+	r.ShipmntDtRg.DeepCopyInto(&out.ShipmntDtRg)
+	if r.ShipmntSubSchdl != nil {
+		out.ShipmntSubSchdl = make([]ShipmentDateRange2, len(r.ShipmntSubSchdl))
+		for i := range r.ShipmntSubSchdl {
+			r.ShipmntSubSchdl[i].DeepCopyInto(&out.ShipmntSubSchdl[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *SignatureEnvelopeReference) DeepCopy() *SignatureEnvelopeReference {
+	if r == nil {
+		return nil
+	}
+	out := new(SignatureEnvelopeReference)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *SignatureEnvelopeReference) DeepCopyInto(out *SignatureEnvelopeReference) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *SpecialCondition1) DeepCopy() *SpecialCondition1 {
+	if r == nil {
+		return nil
+	}
+	out := new(SpecialCondition1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *SpecialCondition1) DeepCopyInto(out *SpecialCondition1) {
+	*out = *r
+	if r.IncmgAmtToOthrAcct != nil {
+		out.IncmgAmtToOthrAcct = new(ActiveCurrencyAndAmount)
+		*out.IncmgAmtToOthrAcct = *r.IncmgAmtToOthrAcct
+	}
+	if r.PmtFrOthrAcct != nil {
+		out.PmtFrOthrAcct = new(ActiveCurrencyAndAmount)
+		*out.PmtFrOthrAcct = *r.PmtFrOthrAcct
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *StatusReason6Choice) DeepCopy() *StatusReason6Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(StatusReason6Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *StatusReason6Choice) DeepCopyInto(out *StatusReason6Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *SupplementaryData1) DeepCopy() *SupplementaryData1 {
+	if r == nil {
+		return nil
+	}
+	out := new(SupplementaryData1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *SupplementaryData1) DeepCopyInto(out *SupplementaryData1) {
+	*out = *r
+	if r.PlcAndNm != nil {
+		out.PlcAndNm = new(common.Max350Text)
+		*out.PlcAndNm = *r.PlcAndNm
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *SupplementaryDataEnvelope1) DeepCopy() *SupplementaryDataEnvelope1 {
+	if r == nil {
+		return nil
+	}
+	out := new(SupplementaryDataEnvelope1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *SupplementaryDataEnvelope1) DeepCopyInto(out *SupplementaryDataEnvelope1) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *SupportingDocument2) DeepCopy() *SupportingDocument2 {
+	if r == nil {
+		return nil
+	}
+	out := new(SupportingDocument2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *SupportingDocument2) DeepCopyInto(out *SupportingDocument2) {
+	*out = *r
+	if r.OrgnlReqId != nil {
+		out.OrgnlReqId = new(common.Max35Text)
+		*out.OrgnlReqId = *r.OrgnlReqId
+	}
+	r.Cert.DeepCopyInto(&out.Cert)
+	r.AcctOwnr.DeepCopyInto(&out.AcctOwnr)
+	r.AcctSvcr.DeepCopyInto(&out.AcctSvcr)
+	if r.Amdmnt != nil {
+		out.Amdmnt = new(DocumentAmendment1)
+		r.Amdmnt.DeepCopyInto(out.Amdmnt)
+	}
+	r.CtrctRef.DeepCopyInto(&out.CtrctRef)
+	if r.Ntry != nil {
+		out.Ntry = make([]SupportingDocumentEntry1, len(r.Ntry))
+		for i := range r.Ntry {
+			r.Ntry[i].DeepCopyInto(&out.Ntry[i])
+		}
+	}
+	if r.SplmtryData != nil {
+		out.SplmtryData = make([]SupplementaryData1, len(r.SplmtryData))
+		for i := range r.SplmtryData {
+			r.SplmtryData[i].DeepCopyInto(&out.SplmtryData[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *SupportingDocumentEntry1) DeepCopy() *SupportingDocumentEntry1 {
+	if r == nil {
+		return nil
+	}
+	out := new(SupportingDocumentEntry1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *SupportingDocumentEntry1) DeepCopyInto(out *SupportingDocumentEntry1) {
+	*out = *r
+	r.OrgnlDoc.DeepCopyInto(&out.OrgnlDoc)
+	if r.TtlAmt != nil {
+		out.TtlAmt = new(ActiveCurrencyAndAmount)
+		*out.TtlAmt = *r.TtlAmt
+	}
+	if r.TtlAmtAftrShipmnt != nil {
+		out.TtlAmtAftrShipmnt = new(ActiveCurrencyAndAmount)
+		*out.TtlAmtAftrShipmnt = *r.TtlAmtAftrShipmnt
+	}
+	if r.TtlAmtInCtrctCcy != nil {
+		out.TtlAmtInCtrctCcy = new(ActiveCurrencyAndAmount)
+		*out.TtlAmtInCtrctCcy = *r.TtlAmtInCtrctCcy
+	}
+	if r.TtlAmtAftrShipmntInCtrctCcy != nil {
+		out.TtlAmtAftrShipmntInCtrctCcy = new(ActiveCurrencyAndAmount)
+		*out.TtlAmtAftrShipmntInCtrctCcy = *r.TtlAmtAftrShipmntInCtrctCcy
+	}
+	r.ShipmntAttrbts.DeepCopyInto(&out.ShipmntAttrbts)
+	if r.AddtlInf != nil {
+		out.AddtlInf = new(common.Max500Text)
+		*out.AddtlInf = *r.AddtlInf
+	}
+	if r.Attchmnt != nil {
+		out.Attchmnt = make([]DocumentGeneralInformation3, len(r.Attchmnt))
+		for i := range r.Attchmnt {
+			r.Attchmnt[i].DeepCopyInto(&out.Attchmnt[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *SupportingDocumentRequestOrLetter2) DeepCopy() *SupportingDocumentRequestOrLetter2 {
+	if r == nil {
+		return nil
+	}
+	out := new(SupportingDocumentRequestOrLetter2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *SupportingDocumentRequestOrLetter2) DeepCopyInto(out *SupportingDocumentRequestOrLetter2) {
+	*out = *r
+	if r.Dt != nil {
+		out.Dt = new(common.ISODate)
+		*out.Dt = *r.Dt
+	}
+	if r.Sndr != nil {
+		out.Sndr = new(Party40Choice)
+		r.Sndr.DeepCopyInto(out.Sndr)
+	}
+	if r.Rcvr != nil {
+		out.Rcvr = new(Party40Choice)
+		r.Rcvr.DeepCopyInto(out.Rcvr)
+	}
+	if r.OrgnlRefs != nil {
+		out.OrgnlRefs = make([]OriginalMessage4, len(r.OrgnlRefs))
+		for i := range r.OrgnlRefs {
+			r.OrgnlRefs[i].DeepCopyInto(&out.OrgnlRefs[i])
+		}
+	}
+	if r.Desc != nil {
+		out.Desc = new(common.Max1025Text)
+		*out.Desc = *r.Desc
+	}
+	if r.DueDt != nil {
+		out.DueDt = new(common.ISODate)
+		*out.DueDt = *r.DueDt
+	}
+	if r.Attchmnt != nil {
+		out.Attchmnt = make([]DocumentGeneralInformation3, len(r.Attchmnt))
+		for i := range r.Attchmnt {
+			r.Attchmnt[i].DeepCopyInto(&out.Attchmnt[i])
+		}
+	}
+	if r.SplmtryData != nil {
+		out.SplmtryData = make([]SupplementaryData1, len(r.SplmtryData))
+		for i := range r.SplmtryData {
+			r.SplmtryData[i].DeepCopyInto(&out.SplmtryData[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *SyndicatedLoan2) DeepCopy() *SyndicatedLoan2 {
+	if r == nil {
+		return nil
+	}
+	out := new(SyndicatedLoan2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *SyndicatedLoan2) DeepCopyInto(out *SyndicatedLoan2) {
+	*out = *r
+	r.Brrwr.DeepCopyInto(&out.Brrwr)
+	if r.Lndr != nil {
+		out.Lndr = new(TradeParty5)
+		r.Lndr.DeepCopyInto(out.Lndr)
+	}
+	if r.Amt != nil {
+		out.Amt = new(ActiveCurrencyAndAmount)
+		*out.Amt = *r.Amt
+	}
+	if r.XchgRateInf != nil {
+		out.XchgRateInf = new(ExchangeRate1)
+		r.XchgRateInf.DeepCopyInto(out.XchgRateInf)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *TaxExemptionReasonFormat1Choice) DeepCopy() *TaxExemptionReasonFormat1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(TaxExemptionReasonFormat1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *TaxExemptionReasonFormat1Choice) DeepCopyInto(out *TaxExemptionReasonFormat1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *TaxParty4) DeepCopy() *TaxParty4 {
+	if r == nil {
+		return nil
+	}
+	out := new(TaxParty4)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *TaxParty4) DeepCopyInto(out *TaxParty4) {
+	*out = *r
+	if r.TaxId != nil {
+		out.TaxId = new(common.Max35Text)
+		*out.TaxId = *r.TaxId
+	}
+	if r.TaxTp != nil {
+		out.TaxTp = new(common.Max35Text)
+		*out.TaxTp = *r.TaxTp
+	}
+	if r.RegnId != nil {
+		out.RegnId = new(common.Max35Text)
+		*out.RegnId = *r.RegnId
+	}
+	if r.TaxXmptnRsn != nil {
+		out.TaxXmptnRsn = make([]TaxExemptionReasonFormat1Choice, len(r.TaxXmptnRsn))
+		copy(out.TaxXmptnRsn, r.TaxXmptnRsn)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *TimePeriod2) DeepCopy() *TimePeriod2 {
+	if r == nil {
+		return nil
+	}
+	out := new(TimePeriod2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *TimePeriod2) DeepCopyInto(out *TimePeriod2) {
+	*out = *r
+	if r.ToTm != nil {
+		out.ToTm = new(common.ISOTime)
+		*out.ToTm = *r.ToTm
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *TradeContract2) DeepCopy() *TradeContract2 {
+	if r == nil {
+		return nil
+	}
+	out := new(TradeContract2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *TradeContract2) DeepCopyInto(out *TradeContract2) {
+	*out = *r
+	if r.CtrctDocId != nil {
+		out.CtrctDocId = new(DocumentIdentification22)
+		r.CtrctDocId.DeepCopyInto(out.CtrctDocId)
+	}
+	if r.Buyr != nil {
+		out.Buyr = make([]TradeParty5, len(r.Buyr))
+		for i := range r.Buyr {
+			r.Buyr[i].DeepCopyInto(&out.Buyr[i])
+		}
+	}
+	if r.Sellr != nil {
+		out.Sellr = make([]TradeParty5, len(r.Sellr))
+		for i := range r.Sellr {
+			r.Sellr[i].DeepCopyInto(&out.Sellr[i])
+		}
+	}
+	if r.XchgRateInf != nil {
+		out.XchgRateInf = new(ExchangeRate1)
+		r.XchgRateInf.DeepCopyInto(out.XchgRateInf)
+	}
+	if r.PmtSchdl != nil {
+		out.PmtSchdl = new(InterestPaymentDateRange1)
+		r.PmtSchdl.DeepCopyInto(out.PmtSchdl)
+	}
+	if r.ShipmntSchdl != nil {
+		out.ShipmntSchdl = new(ShipmentSchedule2Choice)
+		r.ShipmntSchdl.DeepCopyInto(out.ShipmntSchdl)
+	}
+	if r.Attchmnt != nil {
+		out.Attchmnt = make([]DocumentGeneralInformation3, len(r.Attchmnt))
+		for i := range r.Attchmnt {
+			r.Attchmnt[i].DeepCopyInto(&out.Attchmnt[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *TradeParty5) DeepCopy() *TradeParty5 {
+	if r == nil {
+		return nil
+	}
+	out := new(TradeParty5)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *TradeParty5) DeepCopyInto(out *TradeParty5) {
+	*out = *r
+	r.PtyId.DeepCopyInto(&out.PtyId)
+	if r.LglOrg != nil {
+		out.LglOrg = new(LegalOrganisation2)
+		r.LglOrg.DeepCopyInto(out.LglOrg)
+	}
+	if r.TaxPty != nil {
+		out.TaxPty = make([]TaxParty4, len(r.TaxPty))
+		for i := range r.TaxPty {
+			r.TaxPty[i].DeepCopyInto(&out.TaxPty[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *TransactionCertificate2) DeepCopy() *TransactionCertificate2 {
+	if r == nil {
+		return nil
+	}
+	out := new(TransactionCertificate2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *TransactionCertificate2) DeepCopyInto(out *TransactionCertificate2) {
+	*out = *r
+	r.RfrdDoc.DeepCopyInto(&out.RfrdDoc)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *TransactionCertificate3) DeepCopy() *TransactionCertificate3 {
+	if r == nil {
+		return nil
+	}
+	out := new(TransactionCertificate3)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *TransactionCertificate3) DeepCopyInto(out *TransactionCertificate3) {
+	*out = *r
+	r.Cert.DeepCopyInto(&out.Cert)
+	if r.Acct != nil {
+		out.Acct = new(CashAccount38)
+		r.Acct.DeepCopyInto(out.Acct)
+	}
+	if r.BkAcctDmcltnCtry != nil {
+		out.BkAcctDmcltnCtry = new(common.CountryCode)
+		*out.BkAcctDmcltnCtry = *r.BkAcctDmcltnCtry
+	}
+	if r.Amdmnt != nil {
+		out.Amdmnt = new(DocumentAmendment1)
+		r.Amdmnt.DeepCopyInto(out.Amdmnt)
+	}
+	if r.CertRcrd != nil {
+		out.CertRcrd = make([]TransactionCertificateRecord1, len(r.CertRcrd))
+		for i := range r.CertRcrd {
+			r.CertRcrd[i].DeepCopyInto(&out.CertRcrd[i])
+		}
+	}
+	if r.SplmtryData != nil {
+		out.SplmtryData = make([]SupplementaryData1, len(r.SplmtryData))
+		for i := range r.SplmtryData {
+			r.SplmtryData[i].DeepCopyInto(&out.SplmtryData[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *TransactionCertificateContract1) DeepCopy() *TransactionCertificateContract1 {
+	if r == nil {
+		return nil
+	}
+	out := new(TransactionCertificateContract1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *TransactionCertificateContract1) DeepCopyInto(out *TransactionCertificateContract1) {
+	*out = *r
+	if r.CtrctRef != nil {
+		out.CtrctRef = new(ContractRegistrationReference1Choice)
+		r.CtrctRef.DeepCopyInto(out.CtrctRef)
+	}
+	if r.TxAmtInCtrctCcy != nil {
+		out.TxAmtInCtrctCcy = new(ActiveCurrencyAndAmount)
+		*out.TxAmtInCtrctCcy = *r.TxAmtInCtrctCcy
+	}
+	if r.XpctdShipmntDt != nil {
+		out.XpctdShipmntDt = new(common.ISODate)
+		*out.XpctdShipmntDt = *r.XpctdShipmntDt
+	}
+	if r.XpctdAdvncPmtRtrDt != nil {
+		out.XpctdAdvncPmtRtrDt = new(common.ISODate)
+		*out.XpctdAdvncPmtRtrDt = *r.XpctdAdvncPmtRtrDt
+	}
+	if r.AddtlInf != nil {
+		out.AddtlInf = new(common.Max1025Text)
+		*out.AddtlInf = *r.AddtlInf
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *TransactionCertificateRecord1) DeepCopy() *TransactionCertificateRecord1 {
+	if r == nil {
+		return nil
+	}
+	out := new(TransactionCertificateRecord1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *TransactionCertificateRecord1) DeepCopyInto(out *TransactionCertificateRecord1) {
+	*out = *r
+	r.Tx.DeepCopyInto(&out.Tx)
+	if r.Ctrct != nil {
+		out.Ctrct = new(TransactionCertificateContract1)
+		r.Ctrct.DeepCopyInto(out.Ctrct)
+	}
+	if r.Attchmnt != nil {
+		out.Attchmnt = make([]DocumentGeneralInformation3, len(r.Attchmnt))
+		for i := range r.Attchmnt {
+			r.Attchmnt[i].DeepCopyInto(&out.Attchmnt[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *UnderlyingContract2Choice) DeepCopy() *UnderlyingContract2Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(UnderlyingContract2Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *UnderlyingContract2Choice) DeepCopyInto(out *UnderlyingContract2Choice) {
+	*out = *r
+	r.Ln.DeepCopyInto(&out.Ln)
+	r.Trad.DeepCopyInto(&out.Trad)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ValidationRuleSchemeName1Choice) DeepCopy() *ValidationRuleSchemeName1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(ValidationRuleSchemeName1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ValidationRuleSchemeName1Choice) DeepCopyInto(out *ValidationRuleSchemeName1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ValidationStatusReason2) DeepCopy() *ValidationStatusReason2 {
+	if r == nil {
+		return nil
+	}
+	out := new(ValidationStatusReason2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ValidationStatusReason2) DeepCopyInto(out *ValidationStatusReason2) {
+	*out = *r
+	if r.Orgtr != nil {
+		out.Orgtr = new(PartyIdentification135)
+		r.Orgtr.DeepCopyInto(out.Orgtr)
+	}
+	if r.Rsn != nil {
+		out.Rsn = new(StatusReason6Choice)
+		*out.Rsn = *r.Rsn
+	}
+	if r.VldtnRule != nil {
+		out.VldtnRule = make([]GenericValidationRuleIdentification1, len(r.VldtnRule))
+		for i := range r.VldtnRule {
+			r.VldtnRule[i].DeepCopyInto(&out.VldtnRule[i])
+		}
+	}
+	if r.AddtlInf != nil {
+		out.AddtlInf = make([]common.Max105Text, len(r.AddtlInf))
+		copy(out.AddtlInf, r.AddtlInf)
+	}
+}
@@ -0,0 +1,140 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package bucket
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// azureVersion is the version of the Blob Storage api
+const azureVersion = "2021-08-06"
+
+// Azure is a container of Azure Blob Storage
+type Azure struct {
+	Container string
+
+	// Endpoint is the endpoint of the storage account, https://<account>.blob.core.windows.net,
+	// or of Azurite
+	Endpoint string
+
+	// SAS is the shared access signature authorizing requests, the query string of a SAS url
+	// with the list, read and write permissions of the container
+	SAS string
+
+	HTTPClient *http.Client
+}
+
+// NewAzure returns the container of the storage account authorized by the shared access signature sas,
+// calls are guarded as the azure/<account>/<container> integration
+func NewAzure(account, container, sas string) *Azure {
+	return &Azure{
+		Container:  container,
+		Endpoint:   "https://" + account + ".blob.core.windows.net",
+		SAS:        strings.TrimPrefix(sas, "?"),
+		HTTPClient: newClient("azure/" + account + "/" + container),
+	}
+}
+
+// url returns the url of the blob key, the url of the container when empty
+func (a *Azure) url(key string, query url.Values) string {
+	u := strings.TrimSuffix(a.Endpoint, "/") + "/" + url.PathEscape(a.Container)
+	if key != "" {
+		u += "/" + escapePath(key)
+	}
+	parts := []string{}
+	if encoded := query.Encode(); encoded != "" {
+		parts = append(parts, encoded)
+	}
+	if a.SAS != "" {
+		parts = append(parts, a.SAS)
+	}
+	if len(parts) > 0 {
+		u += "?" + strings.Join(parts, "&")
+	}
+	return u
+}
+
+func (a *Azure) do(ctx context.Context, method, rawURL string, header http.Header, body []byte) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, method, rawURL, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	for key, values := range header {
+		req.Header[key] = values
+	}
+	req.Header.Set("x-ms-version", azureVersion)
+	req.Header.Set("x-ms-date", time.Now().UTC().Format(http.TimeFormat))
+	data, err := send(a.HTTPClient, req)
+	if err != nil {
+		return nil, fmt.Errorf("azure: %w", err)
+	}
+	return data, nil
+}
+
+// List returns the blobs under prefix, following the markers of the listing
+func (a *Azure) List(ctx context.Context, prefix string) ([]Object, error) {
+	var (
+		objects []Object
+		marker  string
+	)
+	for {
+		query := url.Values{"restype": {"container"}, "comp": {"list"}, "prefix": {prefix}}
+		if marker != "" {
+			query.Set("marker", marker)
+		}
+		data, err := a.do(ctx, http.MethodGet, a.url("", query), nil, nil)
+		if err != nil {
+			return nil, err
+		}
+		var result struct {
+			Blobs []struct {
+				Name       string `xml:"Name"`
+				Properties struct {
+					LastModified  string `xml:"Last-Modified"`
+					ETag          string `xml:"Etag"`
+					ContentLength int64  `xml:"Content-Length"`
+				} `xml:"Properties"`
+			} `xml:"Blobs>Blob"`
+			NextMarker string `xml:"NextMarker"`
+		}
+		if err := xml.Unmarshal(data, &result); err != nil {
+			return nil, fmt.Errorf("azure: %w", err)
+		}
+		for _, blob := range result.Blobs {
+			modified, _ := time.Parse(http.TimeFormat, blob.Properties.LastModified)
+			objects = append(objects, Object{
+				Key:      blob.Name,
+				Size:     blob.Properties.ContentLength,
+				ETag:     strings.Trim(blob.Properties.ETag, `"`),
+				Modified: modified,
+			})
+		}
+		if result.NextMarker == "" {
+			return objects, nil
+		}
+		marker = result.NextMarker
+	}
+}
+
+// Get returns the content of the blob key
+func (a *Azure) Get(ctx context.Context, key string) ([]byte, error) {
+	return a.do(ctx, http.MethodGet, a.url(key, nil), nil, nil)
+}
+
+// Put writes content to the block blob key
+func (a *Azure) Put(ctx context.Context, key string, content []byte) error {
+	header := http.Header{}
+	header.Set("x-ms-blob-type", "BlockBlob")
+	header.Set("Content-Type", contentType(key))
+	_, err := a.do(ctx, http.MethodPut, a.url(key, nil), header, content)
+	return err
+}
@@ -0,0 +1,107 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package bucket
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// fakeAzure serves the list, get and put of the blobs of the container drops, listing one blob
+// per page
+type fakeAzure struct {
+	mu       sync.Mutex
+	blobs    map[string][]byte
+	blobType string
+}
+
+func (f *fakeAzure) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Query().Get("sig") != "secret" || r.Header.Get("x-ms-version") == "" {
+		w.WriteHeader(http.StatusForbidden)
+		fmt.Fprint(w, `<?xml version="1.0" encoding="utf-8"?><Error><Code>AuthenticationFailed</Code><Message>Server failed to authenticate the request.</Message></Error>`)
+		return
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	key := strings.TrimPrefix(r.URL.Path, "/drops/")
+	switch {
+	case r.Method == http.MethodGet && r.URL.Query().Get("comp") == "list":
+		var keys []string
+		for k := range f.blobs {
+			if strings.HasPrefix(k, r.URL.Query().Get("prefix")) {
+				keys = append(keys, k)
+			}
+		}
+		sort.Strings(keys)
+		start, _ := strconv.Atoi(r.URL.Query().Get("marker"))
+		fmt.Fprint(w, `<?xml version="1.0" encoding="utf-8"?><EnumerationResults><Blobs>`)
+		if start < len(keys) {
+			fmt.Fprintf(w, `<Blob><Name>%s</Name><Properties><Last-Modified>Thu, 15 Apr 2021 10:30:00 GMT</Last-Modified><Etag>0x8D9%d</Etag><Content-Length>%d</Content-Length></Properties></Blob>`,
+				keys[start], start, len(f.blobs[keys[start]]))
+		}
+		fmt.Fprint(w, `</Blobs>`)
+		if start+1 < len(keys) {
+			fmt.Fprintf(w, `<NextMarker>%d</NextMarker>`, start+1)
+		} else {
+			fmt.Fprint(w, `<NextMarker/>`)
+		}
+		fmt.Fprint(w, `</EnumerationResults>`)
+	case r.Method == http.MethodGet:
+		content, ok := f.blobs[key]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `<?xml version="1.0" encoding="utf-8"?><Error><Code>BlobNotFound</Code><Message>The specified blob does not exist.</Message></Error>`)
+			return
+		}
+		w.Write(content)
+	case r.Method == http.MethodPut:
+		content, _ := io.ReadAll(r.Body)
+		f.blobs[key] = content
+		f.blobType = r.Header.Get("x-ms-blob-type")
+		w.WriteHeader(http.StatusCreated)
+	}
+}
+
+func TestAzure(t *testing.T) {
+	ctx := context.Background()
+	f := &fakeAzure{blobs: map[string][]byte{"inbound/a.xml": []byte("a"), "inbound/b.xml": []byte("b"), "other/c.xml": []byte("c")}}
+	server := httptest.NewServer(f)
+	defer server.Close()
+
+	a := NewAzure("moov", "drops", "?sv=2021-08-06&sp=rwl&sig=secret")
+	require.Equal(t, "https://moov.blob.core.windows.net", a.Endpoint)
+	a.Endpoint = server.URL
+
+	objects, err := a.List(ctx, "inbound/")
+	require.Nil(t, err)
+	require.Len(t, objects, 2)
+	require.Equal(t, "inbound/b.xml", objects[1].Key)
+	require.Equal(t, "0x8D91", objects[1].ETag)
+	require.Equal(t, int64(1), objects[1].Size)
+	require.Equal(t, 2021, objects[1].Modified.Year())
+
+	require.Nil(t, a.Put(ctx, "processed/pacs file.xml", []byte("<Document/>")))
+	require.Equal(t, "BlockBlob", f.blobType)
+	content, err := a.Get(ctx, "processed/pacs file.xml")
+	require.Nil(t, err)
+	require.Equal(t, []byte("<Document/>"), content)
+
+	_, err = a.Get(ctx, "inbound/missing.xml")
+	require.ErrorIs(t, err, ErrNotFound)
+
+	a.SAS = "sv=2021-08-06&sig=expired"
+	_, err = a.List(ctx, "inbound/")
+	require.ErrorContains(t, err, "AuthenticationFailed")
+}
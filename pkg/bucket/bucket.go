@@ -0,0 +1,235 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package bucket
+
+/*
+	Bucket ingests the ISO 20022 files landing in S3, GCS or Azure Blob Storage buckets and
+	writes the processed messages under an output prefix:
+
+		b := bucket.NewS3("bank-drops", "us-east-1", sigv4.EnvCredentials())
+		l := ledger.New(store)
+		p := pipeline.New(l.Source(bucket.Watch(b, "inbound/", time.Minute)),
+			l.Sink(bucket.Output(b, pipeline.Layout{Dir: "processed", ByMessageType: true})),
+			pipeline.WithErrorSink(l.ErrorSink(nil)))
+
+	Watch lists the prefix every interval and emits the xml and json objects which are new or
+	changed since the previous listing, the objects present at start included. A ledger
+	skips the objects processed before a restart. The output prefix must not be under the
+	watched prefix.
+
+	Buckets notifying their new objects to a queue (S3 event notifications to SQS, GCS
+	notifications to Pub/Sub, Event Grid events of Azure to a queue) are read with
+	Notifications instead, which fetches the objects of the notifications of a queue source:
+
+		queue := sqs.New("us-east-1", sqs.EnvCredentials()).Queue(queueURL, 10)
+		p := pipeline.New(bucket.Notifications(b, queue.Source(), queue.ErrorSink(nil)),
+			queue.Sink(bucket.Output(b, layout)), pipeline.WithErrorSink(queue.ErrorSink(nil)))
+
+	Items keep the headers of their notification, so the sinks of the queue acknowledge them.
+*/
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/moov-io/iso20022/pkg/pipeline"
+)
+
+const (
+	// HeaderKey and HeaderETag are the headers of the items emitted by Watch and Notifications
+	HeaderKey  = "x-bucket-key"
+	HeaderETag = "x-bucket-etag"
+)
+
+// ErrNotFound is returned by the reads of missing objects
+var ErrNotFound = errors.New("bucket: object not found")
+
+// Object is an object of a bucket
+type Object struct {
+	Key      string
+	Size     int64
+	ETag     string
+	Modified time.Time
+}
+
+// Bucket is a bucket of object storage
+type Bucket interface {
+	// List returns the objects whose key starts with prefix
+	List(ctx context.Context, prefix string) ([]Object, error)
+
+	// Get returns the content of the object key, ErrNotFound when it doesn't exist
+	Get(ctx context.Context, key string) ([]byte, error)
+
+	// Put writes content to the object key, replacing it when it exists
+	Put(ctx context.Context, key string, content []byte) error
+}
+
+// isDocument reports whether the object key is an xml or json file
+func isDocument(key string) bool {
+	ext := strings.ToLower(path.Ext(key))
+	return ext == ".xml" || ext == ".json"
+}
+
+// Watch emits the new and changed xml and json objects of b under prefix, listing them every
+// interval until ctx is done. Objects are named by their key.
+func Watch(b Bucket, prefix string, interval time.Duration) pipeline.Source {
+	return func(ctx context.Context, emit func(pipeline.Item) error) error {
+		seen := make(map[string]string)
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			objects, err := b.List(ctx, prefix)
+			if err != nil {
+				return fmt.Errorf("listing %s: %w", prefix, err)
+			}
+			for _, obj := range objects {
+				if !isDocument(obj.Key) || seen[obj.Key] == obj.ETag {
+					continue
+				}
+				content, err := b.Get(ctx, obj.Key)
+				if errors.Is(err, ErrNotFound) {
+					continue // deleted since the listing
+				}
+				if err != nil {
+					return err
+				}
+				item := pipeline.Item{
+					Name:    obj.Key,
+					Input:   content,
+					Headers: map[string]string{HeaderKey: obj.Key, HeaderETag: obj.ETag},
+				}
+				if err := emit(item); err != nil {
+					return err
+				}
+				seen[obj.Key] = obj.ETag
+			}
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-ticker.C:
+			}
+		}
+	}
+}
+
+// Notifications emits the xml and json objects of b created according to the notifications
+// emitted by source, named by their key. Notifications without such an object, e.g. the test
+// events of S3, are routed to skip, if not nil, to acknowledge them.
+func Notifications(b Bucket, source pipeline.Source, skip pipeline.Sink) pipeline.Source {
+	return func(ctx context.Context, emit func(pipeline.Item) error) error {
+		return source(ctx, func(notification pipeline.Item) error {
+			emitted := false
+			for _, key := range EventKeys(notification.Input, notification.Headers) {
+				if !isDocument(key) {
+					continue
+				}
+				content, err := b.Get(ctx, key)
+				if errors.Is(err, ErrNotFound) {
+					continue // deleted since the notification
+				}
+				if err != nil {
+					return err
+				}
+				headers := map[string]string{HeaderKey: key}
+				for k, v := range notification.Headers {
+					headers[k] = v
+				}
+				if err := emit(pipeline.Item{Name: key, Input: content, Headers: headers}); err != nil {
+					return err
+				}
+				emitted = true
+			}
+			if !emitted && skip != nil {
+				return skip(ctx, notification)
+			}
+			return nil
+		})
+	}
+}
+
+// EventKeys returns the keys of the objects created according to a notification: an S3 event
+// notification or EventBridge event, a GCS notification of Pub/Sub (with its attributes) or an
+// Event Grid event of Azure Blob Storage
+func EventKeys(input []byte, attributes map[string]string) []string {
+	if attributes["eventType"] == "OBJECT_FINALIZE" && attributes["objectId"] != "" {
+		return []string{attributes["objectId"]}
+	}
+
+	var s3 struct {
+		Records []struct {
+			EventName string `json:"eventName"`
+			S3        struct {
+				Object struct {
+					Key string `json:"key"`
+				} `json:"object"`
+			} `json:"s3"`
+		} `json:"Records"`
+		DetailType string `json:"detail-type"`
+		Detail     struct {
+			Object struct {
+				Key string `json:"key"`
+			} `json:"object"`
+		} `json:"detail"`
+	}
+	trimmed := bytes.TrimSpace(input)
+	if len(trimmed) > 0 && trimmed[0] == '{' && json.Unmarshal(trimmed, &s3) == nil {
+		var keys []string
+		for _, record := range s3.Records {
+			// keys of event notifications are url encoded, with + for spaces
+			if key, err := url.QueryUnescape(record.S3.Object.Key); err == nil && strings.HasPrefix(record.EventName, "ObjectCreated:") {
+				keys = append(keys, key)
+			}
+		}
+		if s3.DetailType == "Object Created" && s3.Detail.Object.Key != "" {
+			keys = append(keys, s3.Detail.Object.Key)
+		}
+		if len(keys) > 0 {
+			return keys
+		}
+	}
+
+	type gridEvent struct {
+		EventType string `json:"eventType"`
+		Type      string `json:"type"`
+		Subject   string `json:"subject"`
+	}
+	var events []gridEvent
+	if len(trimmed) > 0 && trimmed[0] == '[' {
+		json.Unmarshal(trimmed, &events)
+	} else {
+		var event gridEvent
+		if json.Unmarshal(trimmed, &event) == nil {
+			events = append(events, event)
+		}
+	}
+	var keys []string
+	for _, event := range events {
+		i := strings.Index(event.Subject, "/blobs/")
+		if (event.EventType == "Microsoft.Storage.BlobCreated" || event.Type == "Microsoft.Storage.BlobCreated") && i >= 0 {
+			keys = append(keys, event.Subject[i+len("/blobs/"):])
+		}
+	}
+	return keys
+}
+
+// Output writes every item to b under the key of layout, with slashes as separators. Items
+// are written as is unless the layout has a format or is deterministic.
+func Output(b Bucket, layout pipeline.Layout) pipeline.Sink {
+	return func(ctx context.Context, item pipeline.Item) error {
+		content, err := layout.Content(item)
+		if err != nil {
+			return err
+		}
+		return b.Put(ctx, filepath.ToSlash(layout.Path(item)), content)
+	}
+}
@@ -0,0 +1,151 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package bucket
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/moov-io/iso20022/pkg/iso20022test/fixtures"
+	"github.com/moov-io/iso20022/pkg/pipeline"
+)
+
+func TestDir(t *testing.T) {
+	ctx := context.Background()
+	d := NewDir(t.TempDir())
+
+	require.Nil(t, d.Put(ctx, "inbound/b.xml", []byte("b")))
+	require.Nil(t, d.Put(ctx, "inbound/a.xml", []byte("a")))
+	require.Nil(t, d.Put(ctx, "processed/a.xml", []byte("a")))
+	require.Nil(t, os.WriteFile(filepath.Join(d.Root, "inbound", ".a.xml-123"), []byte("partial"), 0600))
+
+	objects, err := d.List(ctx, "inbound/")
+	require.Nil(t, err)
+	require.Len(t, objects, 2)
+	require.Equal(t, "inbound/a.xml", objects[0].Key)
+	require.Equal(t, int64(1), objects[0].Size)
+	require.NotEmpty(t, objects[0].ETag)
+
+	content, err := d.Get(ctx, "inbound/b.xml")
+	require.Nil(t, err)
+	require.Equal(t, []byte("b"), content)
+
+	_, err = d.Get(ctx, "inbound/c.xml")
+	require.ErrorIs(t, err, ErrNotFound)
+	require.ErrorContains(t, d.Put(ctx, "../escape.xml", nil), "outside of")
+
+	objects, err = NewDir(filepath.Join(d.Root, "missing")).List(ctx, "")
+	require.Nil(t, err)
+	require.Empty(t, objects)
+}
+
+func TestWatch(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	d := NewDir(t.TempDir())
+	require.Nil(t, d.Put(ctx, "inbound/valid.xml", fixtures.ReadFile(t, "valid_pacs_v08.xml")))
+	require.Nil(t, d.Put(ctx, "inbound/notes.txt", []byte("not a message")))
+
+	var (
+		mu    sync.Mutex
+		names []string
+	)
+	sink := func(ctx context.Context, item pipeline.Item) error {
+		mu.Lock()
+		defer mu.Unlock()
+		names = append(names, item.Name)
+		require.Equal(t, item.Name, item.Headers[HeaderKey])
+		switch len(names) {
+		case 1:
+			// files landing later are picked up by the next listing
+			return d.Put(ctx, "inbound/camt.xml", fixtures.ReadFile(t, "valid_camt053_v08.xml"))
+		case 2:
+			cancel()
+		}
+		return nil
+	}
+	output := Output(d, pipeline.Layout{Dir: "processed", ByMessageType: true})
+	_, err := pipeline.New(Watch(d, "inbound/", 10*time.Millisecond), func(ctx context.Context, item pipeline.Item) error {
+		if err := output(ctx, item); err != nil {
+			return err
+		}
+		return sink(ctx, item)
+	}, pipeline.WithWorkers(1)).Run(ctx)
+	require.ErrorIs(t, err, context.Canceled)
+	require.Equal(t, []string{"inbound/valid.xml", "inbound/camt.xml"}, names)
+
+	objects, err := d.List(context.Background(), "processed/")
+	require.Nil(t, err)
+	require.Len(t, objects, 2)
+	require.Equal(t, "processed/camt.053.001.08/camt.xml", objects[0].Key)
+	require.Equal(t, "processed/pacs.008.001.08/valid.xml", objects[1].Key)
+}
+
+func TestEventKeys(t *testing.T) {
+	// S3 event notification, keys are url encoded
+	keys := EventKeys([]byte(`{"Records":[{"eventName":"ObjectCreated:Put","s3":{"bucket":{"name":"drops"},"object":{"key":"inbound/pacs+file%281%29.xml","size":1024}}}]}`), nil)
+	require.Equal(t, []string{"inbound/pacs file(1).xml"}, keys)
+	require.Empty(t, EventKeys([]byte(`{"Records":[{"eventName":"ObjectRemoved:Delete","s3":{"object":{"key":"inbound/a.xml"}}}]}`), nil))
+	require.Empty(t, EventKeys([]byte(`{"Service":"Amazon S3","Event":"s3:TestEvent"}`), nil))
+
+	// EventBridge event of S3
+	keys = EventKeys([]byte(`{"detail-type":"Object Created","source":"aws.s3","detail":{"bucket":{"name":"drops"},"object":{"key":"inbound/a.xml"}}}`), nil)
+	require.Equal(t, []string{"inbound/a.xml"}, keys)
+
+	// GCS notification of Pub/Sub
+	keys = EventKeys([]byte(`{"kind":"storage#object","name":"inbound/a.xml"}`), map[string]string{"eventType": "OBJECT_FINALIZE", "objectId": "inbound/a.xml"})
+	require.Equal(t, []string{"inbound/a.xml"}, keys)
+	require.Empty(t, EventKeys([]byte(`{}`), map[string]string{"eventType": "OBJECT_DELETE", "objectId": "inbound/a.xml"}))
+
+	// Event Grid events, in the event grid and cloud events schemas
+	keys = EventKeys([]byte(`[{"eventType":"Microsoft.Storage.BlobCreated","subject":"/blobServices/default/containers/drops/blobs/inbound/a.xml"}]`), nil)
+	require.Equal(t, []string{"inbound/a.xml"}, keys)
+	keys = EventKeys([]byte(`{"type":"Microsoft.Storage.BlobCreated","subject":"/blobServices/default/containers/drops/blobs/inbound/b.xml"}`), nil)
+	require.Equal(t, []string{"inbound/b.xml"}, keys)
+
+	require.Empty(t, EventKeys(fixtures.ReadFile(t, "valid_pacs_v08.xml"), nil))
+}
+
+func TestNotifications(t *testing.T) {
+	ctx := context.Background()
+	d := NewDir(t.TempDir())
+	require.Nil(t, d.Put(ctx, "inbound/a.xml", fixtures.ReadFile(t, "valid_pacs_v08.xml")))
+
+	source := func(ctx context.Context, emit func(pipeline.Item) error) error {
+		notifications := []string{
+			`{"Records":[{"eventName":"ObjectCreated:Put","s3":{"object":{"key":"inbound/a.xml"}}}]}`,
+			`{"Service":"Amazon S3","Event":"s3:TestEvent"}`,
+			`{"Records":[{"eventName":"ObjectCreated:Put","s3":{"object":{"key":"inbound/deleted.xml"}}}]}`,
+		}
+		for i, notification := range notifications {
+			item := pipeline.Item{Name: "msg", Input: []byte(notification), Headers: map[string]string{"x-receipt": string(rune('0' + i))}}
+			if err := emit(item); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	var skipped, processed []string
+	skip := func(_ context.Context, item pipeline.Item) error {
+		skipped = append(skipped, item.Headers["x-receipt"])
+		return nil
+	}
+	sink := func(_ context.Context, item pipeline.Item) error {
+		processed = append(processed, item.Name+"@"+item.Headers["x-receipt"])
+		return nil
+	}
+	stats, err := pipeline.New(Notifications(d, source, skip), sink, pipeline.WithWorkers(1)).Run(ctx)
+	require.Nil(t, err)
+	require.Equal(t, int64(1), stats.Succeeded)
+	require.Equal(t, []string{"inbound/a.xml@0"}, processed)
+	require.Equal(t, []string{"1", "2"}, skipped)
+}
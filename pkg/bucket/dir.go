@@ -0,0 +1,104 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package bucket
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/moov-io/iso20022/pkg/pipeline"
+)
+
+// Dir is a local directory used as a bucket, e.g. a mounted volume or an sftp drop directory,
+// keys are the slash-separated paths of files relative to it
+type Dir struct {
+	Root string
+}
+
+// NewDir returns the bucket of the files of root
+func NewDir(root string) *Dir {
+	return &Dir{Root: root}
+}
+
+func (d *Dir) path(key string) (string, error) {
+	p := filepath.Join(d.Root, filepath.FromSlash(key))
+	if rel, err := filepath.Rel(d.Root, p); err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", fmt.Errorf("bucket: key %s is outside of %s", key, d.Root)
+	}
+	return p, nil
+}
+
+// List returns the files under prefix in the order of their keys, hidden files (e.g. the
+// temporary files of writes in progress) excluded
+func (d *Dir) List(_ context.Context, prefix string) ([]Object, error) {
+	var objects []Object
+	err := filepath.WalkDir(d.Root, func(p string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) && p == d.Root {
+				return fs.SkipDir
+			}
+			return err
+		}
+		if entry.IsDir() || strings.HasPrefix(entry.Name(), ".") {
+			return nil
+		}
+		rel, err := filepath.Rel(d.Root, p)
+		if err != nil {
+			return err
+		}
+		key := filepath.ToSlash(rel)
+		if !strings.HasPrefix(key, prefix) {
+			return nil
+		}
+		info, err := entry.Info()
+		if err != nil {
+			return err
+		}
+		objects = append(objects, Object{
+			Key:      key,
+			Size:     info.Size(),
+			ETag:     strconv.FormatInt(info.ModTime().UnixNano(), 36) + "-" + strconv.FormatInt(info.Size(), 36),
+			Modified: info.ModTime(),
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(objects, func(i, j int) bool { return objects[i].Key < objects[j].Key })
+	return objects, nil
+}
+
+// Get returns the content of the file key
+func (d *Dir) Get(_ context.Context, key string) ([]byte, error) {
+	p, err := d.path(key)
+	if err != nil {
+		return nil, err
+	}
+	content, err := os.ReadFile(p)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("%w: %s", ErrNotFound, key)
+	}
+	return content, err
+}
+
+// Put writes content to the file key, see pipeline.WriteFile
+func (d *Dir) Put(_ context.Context, key string, content []byte) error {
+	p, err := d.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(p), 0750); err != nil {
+		return err
+	}
+	return pipeline.WriteFile(p, content)
+}
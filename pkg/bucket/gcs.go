@@ -0,0 +1,132 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package bucket
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DefaultGCSEndpoint is the endpoint of the json api of Google Cloud Storage
+const DefaultGCSEndpoint = "https://storage.googleapis.com"
+
+// GCS is a Google Cloud Storage bucket
+type GCS struct {
+	Bucket string
+
+	// Endpoint is DefaultGCSEndpoint, or the emulator of STORAGE_EMULATOR_HOST
+	Endpoint string
+
+	// Token returns the OAuth 2.0 access token authorizing requests, e.g. pubsub.MetadataToken().
+	// Requests of emulators are sent without token when it's nil.
+	Token func(ctx context.Context) (string, error)
+
+	HTTPClient *http.Client
+}
+
+// NewGCS returns the bucket authorized by the tokens of token, calls are guarded as the
+// gcs/<bucket> integration
+func NewGCS(bucket string, token func(ctx context.Context) (string, error)) *GCS {
+	endpoint := DefaultGCSEndpoint
+	if host := os.Getenv("STORAGE_EMULATOR_HOST"); host != "" {
+		endpoint = host
+		if !strings.Contains(host, "://") {
+			endpoint = "http://" + host
+		}
+	}
+	return &GCS{Bucket: bucket, Endpoint: endpoint, Token: token, HTTPClient: newClient("gcs/" + bucket)}
+}
+
+func (g *GCS) do(ctx context.Context, method, rawURL, contentType string, body []byte) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, method, rawURL, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	if g.Token != nil {
+		token, err := g.Token(ctx)
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	data, err := send(g.HTTPClient, req)
+	if err != nil {
+		return nil, fmt.Errorf("gcs: %w", err)
+	}
+	return data, nil
+}
+
+func (g *GCS) endpoint() string {
+	return strings.TrimSuffix(g.Endpoint, "/")
+}
+
+// List returns the objects under prefix, following the pages of the listing
+func (g *GCS) List(ctx context.Context, prefix string) ([]Object, error) {
+	var (
+		objects []Object
+		page    string
+	)
+	for {
+		query := url.Values{"prefix": {prefix}, "fields": {"items(name,size,etag,updated),nextPageToken"}}
+		if page != "" {
+			query.Set("pageToken", page)
+		}
+		data, err := g.do(ctx, http.MethodGet, g.endpoint()+"/storage/v1/b/"+url.PathEscape(g.Bucket)+"/o?"+query.Encode(), "", nil)
+		if err != nil {
+			return nil, err
+		}
+		var result struct {
+			Items []struct {
+				Name    string    `json:"name"`
+				Size    string    `json:"size"`
+				ETag    string    `json:"etag"`
+				Updated time.Time `json:"updated"`
+			} `json:"items"`
+			NextPageToken string `json:"nextPageToken"`
+		}
+		if err := json.Unmarshal(data, &result); err != nil {
+			return nil, fmt.Errorf("gcs: %w", err)
+		}
+		for _, item := range result.Items {
+			size, _ := strconv.ParseInt(item.Size, 10, 64)
+			objects = append(objects, Object{Key: item.Name, Size: size, ETag: item.ETag, Modified: item.Updated})
+		}
+		if result.NextPageToken == "" {
+			return objects, nil
+		}
+		page = result.NextPageToken
+	}
+}
+
+// Get returns the content of the object key
+func (g *GCS) Get(ctx context.Context, key string) ([]byte, error) {
+	return g.do(ctx, http.MethodGet, g.endpoint()+"/storage/v1/b/"+url.PathEscape(g.Bucket)+"/o/"+url.PathEscape(key)+"?alt=media", "", nil)
+}
+
+// Put writes content to the object key with a simple upload
+func (g *GCS) Put(ctx context.Context, key string, content []byte) error {
+	query := url.Values{"uploadType": {"media"}, "name": {key}}
+	_, err := g.do(ctx, http.MethodPost, g.endpoint()+"/upload/storage/v1/b/"+url.PathEscape(g.Bucket)+"/o?"+query.Encode(), contentType(key), content)
+	return err
+}
+
+// contentType returns the media type of the object key
+func contentType(key string) string {
+	if strings.HasSuffix(strings.ToLower(key), ".json") {
+		return "application/json"
+	}
+	return "application/xml"
+}
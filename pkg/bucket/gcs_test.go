@@ -0,0 +1,104 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package bucket
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// fakeGCS serves the list, get and simple upload of objects of the bucket drops, listing one
+// object per page
+type fakeGCS struct {
+	mu          sync.Mutex
+	objects     map[string][]byte
+	contentType string
+}
+
+func (f *fakeGCS) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Header.Get("Authorization") != "Bearer secret" {
+		w.WriteHeader(http.StatusUnauthorized)
+		w.Write([]byte(`{"error":{"code":401,"message":"Invalid Credentials"}}`))
+		return
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	switch {
+	case r.URL.Path == "/storage/v1/b/drops/o":
+		var keys []string
+		for k := range f.objects {
+			if strings.HasPrefix(k, r.URL.Query().Get("prefix")) {
+				keys = append(keys, k)
+			}
+		}
+		sort.Strings(keys)
+		start, _ := strconv.Atoi(r.URL.Query().Get("pageToken"))
+		result := map[string]interface{}{}
+		if start < len(keys) {
+			result["items"] = []map[string]string{{
+				"name": keys[start], "size": strconv.Itoa(len(f.objects[keys[start]])), "etag": "etag-" + keys[start], "updated": "2021-04-15T10:30:00.000Z",
+			}}
+		}
+		if start+1 < len(keys) {
+			result["nextPageToken"] = strconv.Itoa(start + 1)
+		}
+		json.NewEncoder(w).Encode(result)
+	case strings.HasPrefix(r.URL.Path, "/storage/v1/b/drops/o/") && r.URL.Query().Get("alt") == "media":
+		content, ok := f.objects[strings.TrimPrefix(r.URL.Path, "/storage/v1/b/drops/o/")]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"error":{"code":404,"message":"No such object"}}`))
+			return
+		}
+		w.Write(content)
+	case r.URL.Path == "/upload/storage/v1/b/drops/o" && r.URL.Query().Get("uploadType") == "media":
+		content, _ := io.ReadAll(r.Body)
+		f.objects[r.URL.Query().Get("name")] = content
+		f.contentType = r.Header.Get("Content-Type")
+		w.Write([]byte(`{}`))
+	default:
+		w.WriteHeader(http.StatusBadRequest)
+	}
+}
+
+func TestGCS(t *testing.T) {
+	ctx := context.Background()
+	f := &fakeGCS{objects: map[string][]byte{"inbound/a.xml": []byte("a"), "inbound/b.xml": []byte("b"), "other/c.xml": []byte("c")}}
+	server := httptest.NewServer(f)
+	defer server.Close()
+
+	t.Setenv("STORAGE_EMULATOR_HOST", strings.TrimPrefix(server.URL, "http://"))
+	g := NewGCS("drops", func(context.Context) (string, error) { return "secret", nil })
+	require.Equal(t, server.URL, g.Endpoint)
+
+	objects, err := g.List(ctx, "inbound/")
+	require.Nil(t, err)
+	require.Len(t, objects, 2)
+	require.Equal(t, Object{Key: "inbound/b.xml", Size: 1, ETag: "etag-inbound/b.xml", Modified: objects[1].Modified}, objects[1])
+	require.Equal(t, 2021, objects[1].Modified.Year())
+
+	require.Nil(t, g.Put(ctx, "processed/a.json", []byte("{}")))
+	require.Equal(t, "application/json", f.contentType)
+	content, err := g.Get(ctx, "processed/a.json")
+	require.Nil(t, err)
+	require.Equal(t, []byte("{}"), content)
+
+	_, err = g.Get(ctx, "inbound/missing.xml")
+	require.ErrorIs(t, err, ErrNotFound)
+
+	g.Token = func(context.Context) (string, error) { return "expired", nil }
+	_, err = g.List(ctx, "inbound/")
+	require.ErrorContains(t, err, "Invalid Credentials")
+}
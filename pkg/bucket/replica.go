@@ -0,0 +1,55 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package bucket
+
+import (
+	"context"
+	"encoding/json"
+	"path"
+	"time"
+
+	"github.com/moov-io/iso20022/pkg/storage"
+)
+
+// KindTombstone records replace the objects of the records deleted from the store of a
+// Replica, buckets have no deletes. Restores skip them.
+const KindTombstone = "tombstone"
+
+// Replica replicates the records of a storage.ReplicatedStore to a bucket, e.g. a bucket of a
+// secondary region. Records are written to the objects <prefix>/<id>.json in the format of
+// storage.FileStore, a restore copies them to the directory of a file store.
+type Replica struct {
+	bucket Bucket
+	prefix string
+	now    func() time.Time
+}
+
+// NewReplica returns the replica of the records written under prefix of b
+func NewReplica(b Bucket, prefix string) *Replica {
+	return &Replica{bucket: b, prefix: prefix, now: time.Now}
+}
+
+func (r *Replica) key(id string) string {
+	return path.Join(r.prefix, id+".json")
+}
+
+// Put writes rec to its object
+func (r *Replica) Put(ctx context.Context, rec storage.Record) error {
+	if err := storage.CheckID(rec.ID); err != nil {
+		return err
+	}
+	buf, err := json.Marshal(rec)
+	if err != nil {
+		return err
+	}
+	return r.bucket.Put(ctx, r.key(rec.ID), buf)
+}
+
+// Delete replaces the object of id with a tombstone, so erased content doesn't survive in the
+// bucket
+func (r *Replica) Delete(ctx context.Context, id string) error {
+	now := r.now().UTC()
+	return r.Put(ctx, storage.Record{ID: id, Kind: KindTombstone, Created: now, Updated: now})
+}
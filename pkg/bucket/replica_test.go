@@ -0,0 +1,43 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package bucket
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/moov-io/iso20022/pkg/iso20022test/fixtures"
+	"github.com/moov-io/iso20022/pkg/storage"
+)
+
+func TestReplica(t *testing.T) {
+	ctx := context.Background()
+	d := NewDir(t.TempDir())
+	store := storage.NewReplicatedStore(storage.NewMemoryStore(), NewReplica(d, "dr/documents"), storage.ReplicationOptions{})
+
+	statement := fixtures.ReadFile(t, "valid_camt053_v08.xml")
+	require.Nil(t, store.Put(ctx, storage.Record{ID: "statement", MessageType: "camt.053.001.08", Content: statement}))
+	require.Nil(t, store.Put(ctx, storage.Record{ID: "erased", Content: statement}))
+	require.Nil(t, store.Delete(ctx, "erased"))
+	require.ErrorIs(t, store.Put(ctx, storage.Record{ID: "../escape"}), storage.ErrInvalidID)
+	store.Close()
+	require.Zero(t, store.Status().Failed)
+
+	// the objects are read by a file store of the restored directory
+	restored, err := storage.NewFileStore(filepath.Join(d.Root, "dr", "documents"))
+	require.Nil(t, err)
+	rec, err := restored.Get(ctx, "statement")
+	require.Nil(t, err)
+	require.Equal(t, "camt.053.001.08", rec.MessageType)
+	require.Equal(t, statement, rec.Content)
+
+	rec, err = restored.Get(ctx, "erased")
+	require.Nil(t, err)
+	require.Equal(t, KindTombstone, rec.Kind)
+	require.Empty(t, rec.Content)
+}
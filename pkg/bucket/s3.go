@@ -0,0 +1,186 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package bucket
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/moov-io/iso20022/pkg/resilience"
+	"github.com/moov-io/iso20022/pkg/sigv4"
+)
+
+const httpTimeout = 60 * time.Second
+
+// newClient returns a client retrying the calls of the integration name with
+// resilience.DefaultPolicy, its guard is registered so its metrics are listed by resilience.Snapshot
+func newClient(name string) *http.Client {
+	guard := resilience.New(name, resilience.DefaultPolicy)
+	resilience.Register(guard)
+	return guard.Client(&http.Client{Timeout: httpTimeout})
+}
+
+// S3 is an AWS S3 bucket
+type S3 struct {
+	Bucket      string
+	Region      string
+	Credentials sigv4.Credentials
+
+	// Endpoint replaces the regional endpoint of S3 with path-style requests, e.g. the endpoint
+	// of MinIO or localstack
+	Endpoint string
+
+	HTTPClient *http.Client
+	now        func() time.Time
+}
+
+// NewS3 returns the bucket of region signing its requests with credentials, calls are guarded
+// as the s3/<bucket> integration
+func NewS3(bucket, region string, credentials sigv4.Credentials) *S3 {
+	return &S3{
+		Bucket:      bucket,
+		Region:      region,
+		Credentials: credentials,
+		HTTPClient:  newClient("s3/" + bucket),
+		now:         time.Now,
+	}
+}
+
+// url returns the url of the object key, the url of the bucket when empty
+func (s *S3) url(key string, query url.Values) *url.URL {
+	u := &url.URL{Scheme: "https", Host: s.Bucket + ".s3." + s.Region + ".amazonaws.com", Path: "/"}
+	// spaces of queries are escaped as %20, as in signatures
+	u.RawQuery = strings.ReplaceAll(query.Encode(), "+", "%20")
+	if s.Endpoint != "" {
+		endpoint, err := url.Parse(s.Endpoint)
+		if err == nil {
+			u.Scheme, u.Host, u.Path = endpoint.Scheme, endpoint.Host, strings.TrimSuffix(endpoint.Path, "/")+"/"+s.Bucket+"/"
+		}
+	}
+	u.RawPath = escapePath(u.Path) + escapePath(key)
+	u.Path += key
+	return u
+}
+
+// escapePath escapes the segments of p as required by signatures, keeping its slashes
+func escapePath(p string) string {
+	segments := strings.Split(p, "/")
+	for i, segment := range segments {
+		segments[i] = strings.ReplaceAll(url.QueryEscape(segment), "+", "%20")
+	}
+	return strings.Join(segments, "/")
+}
+
+func (s *S3) do(ctx context.Context, method string, u *url.URL, body []byte) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	sigv4.Sign(req, body, "s3", s.Region, s.Credentials, s.now())
+	return send(s.HTTPClient, req)
+}
+
+// send sends req and returns the body of its response, ErrNotFound for 404 responses
+func send(client *http.Client, req *http.Request) ([]byte, error) {
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case resp.StatusCode == http.StatusNotFound && req.Method == http.MethodGet:
+		return nil, fmt.Errorf("%w: %s", ErrNotFound, req.URL.Path)
+	case resp.StatusCode/100 != 2:
+		return nil, fmt.Errorf("%s %s: %s: %s", req.Method, req.URL.Path, resp.Status, errorMessage(data))
+	}
+	return data, nil
+}
+
+// errorMessage returns the message of the xml or json error of a response
+func errorMessage(data []byte) string {
+	var xmlErr struct {
+		Code    string `xml:"Code"`
+		Message string `xml:"Message"`
+	}
+	if xml.Unmarshal(data, &xmlErr) == nil && xmlErr.Code != "" {
+		return xmlErr.Code + ": " + xmlErr.Message
+	}
+	var jsonErr struct {
+		Error struct {
+			Message string `json:"message"`
+		} `json:"error"`
+	}
+	if json.Unmarshal(data, &jsonErr) == nil && jsonErr.Error.Message != "" {
+		return jsonErr.Error.Message
+	}
+	return strings.TrimSpace(string(data))
+}
+
+// List returns the objects under prefix, following the continuations of ListObjectsV2
+func (s *S3) List(ctx context.Context, prefix string) ([]Object, error) {
+	var (
+		objects      []Object
+		continuation string
+	)
+	for {
+		query := url.Values{"list-type": {"2"}, "prefix": {prefix}}
+		if continuation != "" {
+			query.Set("continuation-token", continuation)
+		}
+		data, err := s.do(ctx, http.MethodGet, s.url("", query), nil)
+		if err != nil {
+			return nil, fmt.Errorf("s3: %w", err)
+		}
+		var result struct {
+			Contents []struct {
+				Key          string    `xml:"Key"`
+				Size         int64     `xml:"Size"`
+				ETag         string    `xml:"ETag"`
+				LastModified time.Time `xml:"LastModified"`
+			} `xml:"Contents"`
+			IsTruncated           bool   `xml:"IsTruncated"`
+			NextContinuationToken string `xml:"NextContinuationToken"`
+		}
+		if err := xml.Unmarshal(data, &result); err != nil {
+			return nil, fmt.Errorf("s3: %w", err)
+		}
+		for _, c := range result.Contents {
+			objects = append(objects, Object{Key: c.Key, Size: c.Size, ETag: strings.Trim(c.ETag, `"`), Modified: c.LastModified})
+		}
+		if !result.IsTruncated || result.NextContinuationToken == "" {
+			return objects, nil
+		}
+		continuation = result.NextContinuationToken
+	}
+}
+
+// Get returns the content of the object key
+func (s *S3) Get(ctx context.Context, key string) ([]byte, error) {
+	data, err := s.do(ctx, http.MethodGet, s.url(key, nil), nil)
+	if err != nil {
+		return nil, fmt.Errorf("s3: %w", err)
+	}
+	return data, nil
+}
+
+// Put writes content to the object key
+func (s *S3) Put(ctx context.Context, key string, content []byte) error {
+	if _, err := s.do(ctx, http.MethodPut, s.url(key, nil), content); err != nil {
+		return fmt.Errorf("s3: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,145 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package bucket
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/moov-io/iso20022/pkg/resilience"
+	"github.com/moov-io/iso20022/pkg/sigv4"
+)
+
+// fakeS3 serves the ListObjectsV2, GetObject and PutObject operations of path-style requests
+// to the bucket drops, listing one object per page
+type fakeS3 struct {
+	mu      sync.Mutex
+	objects map[string][]byte
+	paths   []string
+}
+
+func (f *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential=AKID/") || r.Header.Get("X-Amz-Content-Sha256") == "" {
+		w.WriteHeader(http.StatusForbidden)
+		w.Write([]byte(`<Error><Code>AccessDenied</Code><Message>Access Denied</Message></Error>`))
+		return
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.paths = append(f.paths, r.URL.EscapedPath())
+
+	key := strings.TrimPrefix(r.URL.Path, "/drops/")
+	switch {
+	case r.Method == http.MethodGet && key == "":
+		var keys []string
+		for k := range f.objects {
+			if strings.HasPrefix(k, r.URL.Query().Get("prefix")) {
+				keys = append(keys, k)
+			}
+		}
+		sort.Strings(keys)
+		start := 0
+		if token := r.URL.Query().Get("continuation-token"); token != "" {
+			fmt.Sscanf(token, "page-%d", &start)
+		}
+		fmt.Fprint(w, `<ListBucketResult>`)
+		if start < len(keys) {
+			fmt.Fprintf(w, `<Contents><Key>%s</Key><Size>%d</Size><ETag>"etag-%s"</ETag><LastModified>2021-04-15T10:30:00.000Z</LastModified></Contents>`,
+				keys[start], len(f.objects[keys[start]]), keys[start])
+		}
+		if start+1 < len(keys) {
+			fmt.Fprintf(w, `<IsTruncated>true</IsTruncated><NextContinuationToken>page-%d</NextContinuationToken>`, start+1)
+		}
+		fmt.Fprint(w, `</ListBucketResult>`)
+	case r.Method == http.MethodGet:
+		content, ok := f.objects[key]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`<Error><Code>NoSuchKey</Code><Message>The specified key does not exist.</Message></Error>`))
+			return
+		}
+		w.Write(content)
+	case r.Method == http.MethodPut:
+		content, _ := io.ReadAll(r.Body)
+		f.objects[key] = content
+	}
+}
+
+func TestS3(t *testing.T) {
+	ctx := context.Background()
+	f := &fakeS3{objects: map[string][]byte{"inbound/a.xml": []byte("a"), "inbound/b.xml": []byte("b"), "other/c.xml": []byte("c")}}
+	server := httptest.NewServer(f)
+	defer server.Close()
+
+	s := NewS3("drops", "us-east-1", sigv4.Credentials{AccessKeyID: "AKID", SecretAccessKey: "secret"})
+	s.Endpoint = server.URL
+
+	objects, err := s.List(ctx, "inbound/")
+	require.Nil(t, err)
+	require.Len(t, objects, 2)
+	require.Equal(t, "inbound/b.xml", objects[1].Key)
+	require.Equal(t, "etag-inbound/b.xml", objects[1].ETag)
+	require.Equal(t, int64(1), objects[1].Size)
+	require.Equal(t, 2021, objects[1].Modified.Year())
+
+	require.Nil(t, s.Put(ctx, "processed/pacs file.xml", []byte("<Document/>")))
+	content, err := s.Get(ctx, "processed/pacs file.xml")
+	require.Nil(t, err)
+	require.Equal(t, []byte("<Document/>"), content)
+	require.Contains(t, f.paths, "/drops/processed/pacs%20file.xml")
+
+	_, err = s.Get(ctx, "inbound/missing.xml")
+	require.ErrorIs(t, err, ErrNotFound)
+
+	s.Credentials.AccessKeyID = "OTHER"
+	_, err = s.List(ctx, "inbound/")
+	require.ErrorContains(t, err, "AccessDenied: Access Denied")
+}
+
+func TestS3Retries(t *testing.T) {
+	f := &fakeS3{objects: map[string][]byte{}}
+	var failures int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if failures < 1 {
+			failures++
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		f.ServeHTTP(w, r)
+	}))
+	defer server.Close()
+
+	s := NewS3("drops", "us-east-1", sigv4.Credentials{AccessKeyID: "AKID", SecretAccessKey: "secret"})
+	s.Endpoint = server.URL
+	require.Nil(t, s.Put(context.Background(), "inbound/a.xml", []byte("<Document/>")))
+	require.Equal(t, []byte("<Document/>"), f.objects["inbound/a.xml"])
+
+	// the calls of the bucket are guarded by a registered guard
+	for _, stats := range resilience.Snapshot() {
+		if stats.Name == "s3/drops" {
+			require.Equal(t, 1, stats.Retries)
+			return
+		}
+	}
+	t.Fatal("the guard of the bucket isn't registered")
+}
+
+func TestS3Endpoint(t *testing.T) {
+	s := NewS3("drops", "eu-west-1", sigv4.Credentials{})
+	require.Equal(t, "https://drops.s3.eu-west-1.amazonaws.com/inbound/a%20b.xml", s.url("inbound/a b.xml", nil).String())
+	require.Equal(t, "https://drops.s3.eu-west-1.amazonaws.com/?list-type=2&prefix=in%20bound%2F", s.url("", map[string][]string{"list-type": {"2"}, "prefix": {"in bound/"}}).String())
+
+	s.Endpoint = "http://localhost:9000"
+	require.Equal(t, "http://localhost:9000/drops/inbound/a.xml", s.url("inbound/a.xml", nil).String())
+}
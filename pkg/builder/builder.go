@@ -0,0 +1,111 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package builder
+
+/*
+	Builders create new documents out of existing ones, for example a payment status
+	request referencing an original payment. References to the original are read
+	through the generic element helpers, so any version of the original message can
+	be used as input.
+*/
+
+import (
+	"encoding/xml"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/moov-io/iso20022/pkg/common"
+	"github.com/moov-io/iso20022/pkg/document"
+	"github.com/moov-io/iso20022/pkg/utils"
+)
+
+var (
+	// ErrNoTransactions is returned when the original document has no transactions to reference
+	ErrNoTransactions = errors.New("original document has no transactions")
+)
+
+// PaymentReference holds the references of a single transaction of an original payment
+type PaymentReference struct {
+	MessageId        string
+	MessageNameId    string
+	CreationDateTime string `json:",omitempty"`
+
+	InstructionId           string `json:",omitempty"`
+	EndToEndId              string `json:",omitempty"`
+	TransactionId           string `json:",omitempty"`
+	UETR                    string `json:",omitempty"`
+	ClearingSystemReference string `json:",omitempty"`
+	AcceptanceDateTime      string `json:",omitempty"`
+
+	Amount         float64 `json:",omitempty"`
+	Currency       string  `json:",omitempty"`
+	SettlementDate string  `json:",omitempty"`
+
+	InstructingAgent string `json:",omitempty"`
+	InstructedAgent  string `json:",omitempty"`
+}
+
+// GetPaymentReferences returns the references of every transaction of doc
+func GetPaymentReferences(doc document.Iso20022Document) []PaymentReference {
+	var refs []PaymentReference
+	for _, tx := range utils.GetTransactions(doc.InspectMessage()) {
+		if tx.Path == "" {
+			continue
+		}
+		amount, _ := strconv.ParseFloat(tx.Lookup("IntrBkSttlmAmt", "InstdAmt"), 64)
+		refs = append(refs, PaymentReference{
+			MessageId:               tx.Lookup("GrpHdr/MsgId"),
+			MessageNameId:           utils.GetMessageType(doc.NameSpace()),
+			CreationDateTime:        tx.Lookup("GrpHdr/CreDtTm"),
+			InstructionId:           tx.Lookup("PmtId/InstrId"),
+			EndToEndId:              tx.Lookup("PmtId/EndToEndId"),
+			TransactionId:           tx.Lookup("PmtId/TxId"),
+			UETR:                    tx.Lookup("PmtId/UETR"),
+			ClearingSystemReference: tx.Lookup("PmtId/ClrSysRef"),
+			AcceptanceDateTime:      tx.Lookup("AccptncDtTm"),
+			Amount:                  amount,
+			Currency:                tx.Lookup("IntrBkSttlmAmt/@Ccy", "InstdAmt/@Ccy"),
+			SettlementDate:          tx.Lookup("IntrBkSttlmDt"),
+			InstructingAgent:        tx.Lookup("InstgAgt/FinInstnId/BICFI"),
+			InstructedAgent:         tx.Lookup("InstdAgt/FinInstnId/BICFI"),
+		})
+	}
+	return refs
+}
+
+// newDocument returns a document of namespace space holding msg
+func newDocument(space string, msg document.Iso20022Message) document.Iso20022Document {
+	return &document.Iso20022DocumentObject{
+		XMLName: xml.Name{Local: "Document"},
+		Attrs:   []xml.Attr{{Name: xml.Name{Local: utils.XmlDefaultNamespace}, Value: space}},
+		Message: msg,
+	}
+}
+
+func optionalMax35Text(text string) *common.Max35Text {
+	if text == "" {
+		return nil
+	}
+	value := common.Max35Text(text)
+	return &value
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, value := range values {
+		if value != "" {
+			return value
+		}
+	}
+	return ""
+}
+
+func optionalDateTime(tm time.Time) *common.ISODateTime {
+	if tm.IsZero() {
+		return nil
+	}
+	value := common.ISODateTime(tm)
+	return &value
+}
@@ -0,0 +1,535 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package builder
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/moov-io/iso20022/pkg/camt_v03"
+	"github.com/moov-io/iso20022/pkg/camt_v05"
+	"github.com/moov-io/iso20022/pkg/camt_v06"
+	"github.com/moov-io/iso20022/pkg/camt_v08"
+	"github.com/moov-io/iso20022/pkg/document"
+	"github.com/moov-io/iso20022/pkg/iso20022test/fixtures"
+	"github.com/moov-io/iso20022/pkg/pacs_v04"
+	"github.com/moov-io/iso20022/pkg/pacs_v10"
+	"github.com/moov-io/iso20022/pkg/pain_v07"
+	"github.com/moov-io/iso20022/pkg/pain_v11"
+	"github.com/moov-io/iso20022/pkg/reasons"
+	"github.com/moov-io/iso20022/pkg/utils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetPaymentReferences(t *testing.T) {
+	refs := GetPaymentReferences(fixtures.Document(t, "valid_pacs_v08.xml"))
+	require.Len(t, refs, 2)
+	require.Equal(t, PaymentReference{
+		MessageId:          "MSG-20210415-0001",
+		MessageNameId:      "pacs.008.001.08",
+		CreationDateTime:   "2021-04-15T10:30:00",
+		InstructionId:      "INSTR-0001",
+		EndToEndId:         "E2E-0001",
+		TransactionId:      "TX-0001",
+		UETR:               "8a562c67-ca16-48ba-b074-65581be6f011",
+		AcceptanceDateTime: "2021-04-15T10:29:58",
+		Amount:             250000,
+		Currency:           "USD",
+		SettlementDate:     "2021-04-15",
+		InstructingAgent:   "BANKUS33XXX",
+		InstructedAgent:    "BANKGB2LXXX",
+	}, refs[0])
+	require.Equal(t, "E2E-0002", refs[1].EndToEndId)
+	require.Equal(t, 500.75, refs[1].Amount)
+
+	require.Empty(t, GetPaymentReferences(fixtures.Document(t, "valid_acmt_v03.xml")))
+}
+
+func TestNewPaymentStatusRequest(t *testing.T) {
+	req := StatusRequest{
+		MessageId:        "STSREQ-0001",
+		CreationDateTime: time.Date(2021, 4, 15, 11, 0, 0, 0, time.UTC),
+	}
+	doc, err := NewPaymentStatusRequest(req, fixtures.Document(t, "valid_pacs_v08.xml"))
+	require.Nil(t, err)
+
+	buf, err := xml.MarshalIndent(doc, "", "\t")
+	require.Nil(t, err)
+	parsed, err := document.ParseIso20022Document(buf)
+	require.Nil(t, err)
+	require.Nil(t, parsed.Validate())
+
+	msg, ok := parsed.InspectMessage().(*pacs_v04.FIToFIPaymentStatusRequestV04)
+	require.True(t, ok)
+	require.Equal(t, "STSREQ-0001", string(msg.GrpHdr.MsgId))
+	require.Equal(t, "BANKUS33XXX", string(*msg.GrpHdr.InstgAgt.FinInstnId.BICFI))
+	require.Len(t, msg.TxInf, 2)
+
+	tx := msg.TxInf[0]
+	require.Equal(t, "MSG-20210415-0001", string(tx.OrgnlGrpInf.OrgnlMsgId))
+	require.Equal(t, "pacs.008.001.08", string(tx.OrgnlGrpInf.OrgnlMsgNmId))
+	require.Equal(t, "E2E-0001", string(*tx.OrgnlEndToEndId))
+	require.Equal(t, "8a562c67-ca16-48ba-b074-65581be6f011", string(*tx.OrgnlUETR))
+	require.Equal(t, time.Date(2021, 4, 15, 10, 29, 58, 0, time.UTC), time.Time(*tx.AccptncDtTm))
+	require.Equal(t, 250000.0, tx.OrgnlTxRef.IntrBkSttlmAmt.Value)
+	require.Nil(t, msg.TxInf[1].OrgnlUETR)
+
+	req.InstructedAgent = "BANKDEFFXXX"
+	doc, err = NewPaymentStatusRequest(req, fixtures.Document(t, "sct_inst_pacs_v08.xml"))
+	require.Nil(t, err)
+	msg = doc.InspectMessage().(*pacs_v04.FIToFIPaymentStatusRequestV04)
+	require.Equal(t, "BANKDEFFXXX", string(*msg.GrpHdr.InstdAgt.FinInstnId.BICFI))
+	require.Len(t, msg.TxInf, 1)
+
+	_, err = NewPaymentStatusRequest(req, fixtures.Document(t, "valid_acmt_v03.xml"))
+	require.Equal(t, ErrNoTransactions, err)
+
+	_, err = NewPaymentStatusRequestFromReferences(req, []PaymentReference{{MessageId: "MSG", MessageNameId: "pacs.008.001.08", AcceptanceDateTime: "invalid"}})
+	require.NotNil(t, err)
+}
+
+func TestNewLiquidityCreditTransfer(t *testing.T) {
+	trf := MainToSubAccount("BANKDEFFXXX", "MAIN-0001", "SUB-0001", 1500000, "EUR")
+	trf.MessageId = "LQDTY-0001"
+	trf.CreationDateTime = time.Date(2021, 4, 15, 11, 0, 0, 0, time.UTC)
+	trf.EndToEndId = "LQDTY-E2E-0001"
+	trf.SettlementDate = time.Date(2021, 4, 15, 0, 0, 0, 0, time.UTC)
+
+	doc, err := NewLiquidityCreditTransfer(trf)
+	require.Nil(t, err)
+
+	buf, err := xml.MarshalIndent(doc, "", "\t")
+	require.Nil(t, err)
+	parsed, err := document.ParseIso20022Document(buf)
+	require.Nil(t, err)
+
+	msg, ok := parsed.InspectMessage().(*camt_v05.LiquidityCreditTransferV05)
+	require.True(t, ok)
+	require.Equal(t, "LQDTY-0001", string(msg.MsgHdr.MsgId))
+	require.Equal(t, "LQDTY-E2E-0001", string(*msg.LqdtyCdtTrf.LqdtyTrfId.EndToEndId))
+	require.Equal(t, "MAIN-0001", string(msg.LqdtyCdtTrf.DbtrAcct.Id.Othr.Id))
+	require.Equal(t, "SUB-0001", string(msg.LqdtyCdtTrf.CdtrAcct.Id.Othr.Id))
+	require.Equal(t, "BANKDEFFXXX", string(*msg.LqdtyCdtTrf.Cdtr.FinInstnId.BICFI))
+	require.Equal(t, 1500000.0, msg.LqdtyCdtTrf.TrfdAmt.AmtWthCcy.Value)
+	require.Equal(t, "2021-04-15", time.Time(*msg.LqdtyCdtTrf.SttlmDt).Format("2006-01-02"))
+
+	back := SubToMainAccount("BANKDEFFXXX", "SUB-0001", "MAIN-0001", 10, "EUR")
+	require.Equal(t, "SUB-0001", back.DebtorAccount)
+	require.Equal(t, "MAIN-0001", back.CreditorAccount)
+
+	_, err = NewLiquidityCreditTransfer(MainToSubAccount("BANKDEFFXXX", "MAIN-0001", "SUB-0001", 0, "EUR"))
+	require.Equal(t, ErrInvalidTransferAmount, err)
+	_, err = NewLiquidityCreditTransfer(MainToSubAccount("BANKDEFFXXX", "MAIN-0001", "", 10, "EUR"))
+	require.Equal(t, ErrTransferAccountOmitted, err)
+}
+
+func TestNewReceipt(t *testing.T) {
+	trf := MainToSubAccount("BANKDEFFXXX", "MAIN-0001", "SUB-0001", 100, "EUR")
+	trf.MessageId = "LQDTY-0001"
+	original, err := NewLiquidityCreditTransfer(trf)
+	require.Nil(t, err)
+
+	doc, err := NewReceipt(Receipt{
+		MessageId:        "RCT-0001",
+		CreationDateTime: time.Date(2021, 4, 15, 11, 0, 1, 0, time.UTC),
+		StatusCode:       ReceiptStatusCompleted,
+		Description:      "settled",
+	}, original)
+	require.Nil(t, err)
+
+	msg, ok := doc.InspectMessage().(*camt_v05.ReceiptV05)
+	require.True(t, ok)
+	require.Len(t, msg.RctDtls, 1)
+	require.Equal(t, "LQDTY-0001", string(msg.RctDtls[0].OrgnlMsgId.MsgId))
+	require.Equal(t, "camt.050.001.05", string(*msg.RctDtls[0].OrgnlMsgId.MsgNmId))
+	require.Equal(t, ReceiptStatusCompleted, string(msg.RctDtls[0].ReqHdlg[0].StsCd))
+
+	doc, err = NewReceipt(Receipt{MessageId: "RCT-0002", StatusCode: ReceiptStatusRejected}, fixtures.Document(t, "valid_pacs_v08.xml"))
+	require.Nil(t, err)
+	msg = doc.InspectMessage().(*camt_v05.ReceiptV05)
+	require.Equal(t, "MSG-20210415-0001", string(msg.RctDtls[0].OrgnlMsgId.MsgId))
+	require.Nil(t, msg.RctDtls[0].ReqHdlg[0].Desc)
+
+	_, err = NewReceipt(Receipt{MessageId: "RCT-0003", StatusCode: ReceiptStatusPending}, newDocument(utils.DocumentCamt05000105NameSpace, &camt_v05.LiquidityCreditTransferV05{}))
+	require.Equal(t, ErrOriginalMessageIdOmitted, err)
+}
+
+func TestNewRequestToPay(t *testing.T) {
+	req := RequestToPay{
+		MessageId:              "RTP-20210415-0001",
+		CreationDateTime:       time.Date(2021, 4, 15, 10, 0, 0, 0, time.UTC),
+		EndToEndId:             "E2E-FN-0001",
+		UETR:                   "8a562c67-ca16-48ba-b074-65581be6f011",
+		Amount:                 2500,
+		Currency:               "USD",
+		RequestedExecutionDate: time.Date(2021, 4, 15, 0, 0, 0, 0, time.UTC),
+		ExpiryDateTime:         time.Date(2021, 4, 16, 10, 0, 0, 0, time.UTC),
+		Debtor:                 "John Smith",
+		DebtorAgent:            "BANKUS33XXX",
+		Creditor:               "Jane Doe",
+		CreditorAccount:        "987654321",
+		CreditorAgent:          "BANKGB2LXXX",
+		RemittanceInformation:  "Invoice 42",
+	}
+	doc, err := NewRequestToPay(req)
+	require.Nil(t, err)
+
+	buf, err := xml.MarshalIndent(doc, "", "\t")
+	require.Nil(t, err)
+	parsed, err := document.ParseIso20022Document(buf)
+	require.Nil(t, err)
+	require.Nil(t, parsed.Validate())
+
+	msg, ok := parsed.InspectMessage().(*pain_v07.CreditorPaymentActivationRequestV07)
+	require.True(t, ok)
+	require.Equal(t, "RTP-20210415-0001", string(*msg.PmtInf[0].PmtInfId))
+	require.Equal(t, "Jane Doe", string(*msg.GrpHdr.InitgPty.Nm))
+	require.Equal(t, "2021-04-15", time.Time(*msg.PmtInf[0].ReqdExctnDt.Dt).Format("2006-01-02"))
+	tx := msg.PmtInf[0].CdtTrfTx[0]
+	require.Equal(t, "E2E-FN-0001", string(tx.PmtId.EndToEndId))
+	require.Equal(t, 2500.0, tx.Amt.InstdAmt.Value)
+	require.Equal(t, "987654321", string(tx.CdtrAcct.Id.Othr.Id))
+
+	_, err = NewRequestToPay(RequestToPay{EndToEndId: "E2E"})
+	require.Equal(t, ErrInvalidTransferAmount, err)
+	_, err = NewRequestToPay(RequestToPay{Amount: 10})
+	require.Equal(t, ErrEndToEndIdOmitted, err)
+}
+
+func TestNewRequestToPayStatus(t *testing.T) {
+	original, err := NewRequestToPay(RequestToPay{
+		MessageId:              "RTP-0001",
+		CreationDateTime:       time.Date(2021, 4, 15, 10, 0, 0, 0, time.UTC),
+		EndToEndId:             "E2E-RTP-0001",
+		Amount:                 10,
+		Currency:               "USD",
+		RequestedExecutionDate: time.Date(2021, 4, 15, 0, 0, 0, 0, time.UTC),
+		Debtor:                 "John Smith",
+		DebtorAgent:            "BANKUS33XXX",
+		Creditor:               "Jane Doe",
+		CreditorAccount:        "987654321",
+		CreditorAgent:          "BANKGB2LXXX",
+	})
+	require.Nil(t, err)
+
+	doc, err := NewRequestToPayStatus(RequestToPayStatus{
+		MessageId:        "RTPSTS-0001",
+		CreationDateTime: time.Date(2021, 4, 15, 10, 0, 5, 0, time.UTC),
+		StatusCode:       RequestToPayStatusRejected,
+		ReasonCode:       "AC04",
+	}, original)
+	require.Nil(t, err)
+
+	msg, ok := doc.InspectMessage().(*pain_v07.CreditorPaymentActivationRequestStatusReportV07)
+	require.True(t, ok)
+	require.Equal(t, "John Smith", string(*msg.GrpHdr.InitgPty.Nm))
+	require.Equal(t, "RTP-0001", string(msg.OrgnlGrpInfAndSts.OrgnlMsgId))
+	require.Equal(t, "pain.013.001.07", string(msg.OrgnlGrpInfAndSts.OrgnlMsgNmId))
+	require.Len(t, msg.OrgnlPmtInfAndSts, 1)
+	tx := msg.OrgnlPmtInfAndSts[0].TxInfAndSts[0]
+	require.Equal(t, "E2E-RTP-0001", string(*tx.OrgnlEndToEndId))
+	require.Nil(t, tx.OrgnlUETR)
+	require.Equal(t, RequestToPayStatusRejected, string(*tx.TxSts))
+	require.Equal(t, "AC04", string(*tx.StsRsnInf[0].Rsn.Cd))
+
+	_, err = NewRequestToPayStatus(RequestToPayStatus{MessageId: "RTPSTS-0002", StatusCode: RequestToPayStatusAccepted}, fixtures.Document(t, "valid_pacs_v08.xml"))
+	require.Equal(t, ErrNoTransactions, err)
+}
+
+func TestNewRejection(t *testing.T) {
+	input := fixtures.ReadFile(t, "valid_pacs_v08.xml")
+	input = bytes.Replace(input, []byte(`<IntrBkSttlmAmt Ccy="USD">500.75`), []byte(`<IntrBkSttlmAmt Ccy="usd">500.75`), 1)
+	input = bytes.Replace(input, []byte("<EndToEndId>E2E-0002</EndToEndId>"), []byte("<EndToEndId>"+strings.Repeat("E2E", 12)+"</EndToEndId>"), 1)
+	original, err := document.ParseIso20022Document(input)
+	require.Nil(t, err)
+	rej := Rejection{MessageId: "RJCT-0001", CreationDateTime: time.Date(2021, 4, 15, 10, 31, 0, 0, time.UTC)}
+
+	doc, err := NewRejection(rej, original, original.Validate())
+	require.Nil(t, err)
+	require.Equal(t, utils.DocumentPacs00200110NameSpace, doc.NameSpace())
+	msg, ok := doc.InspectMessage().(*pacs_v10.FIToFIPaymentStatusReportV10)
+	require.True(t, ok)
+	require.Equal(t, "BANKGB2LXXX", string(*msg.GrpHdr.InstgAgt.FinInstnId.BICFI))
+	require.Equal(t, "BANKUS33XXX", string(*msg.GrpHdr.InstdAgt.FinInstnId.BICFI))
+	group := msg.OrgnlGrpInfAndSts[0]
+	require.Equal(t, "MSG-20210415-0001", string(group.OrgnlMsgId))
+	require.Equal(t, "pacs.008.001.08", string(group.OrgnlMsgNmId))
+	require.Equal(t, RejectedStatus, string(*group.GrpSts))
+
+	require.Len(t, msg.TxInfAndSts, 2)
+	first := msg.TxInfAndSts[0]
+	require.Equal(t, "E2E-0001", string(*first.OrgnlEndToEndId))
+	require.Equal(t, RejectedStatus, string(*first.TxSts))
+	require.Equal(t, group.StsRsnInf, first.StsRsnInf)
+
+	// the invalid end to end identification isn't reported
+	second := msg.TxInfAndSts[1]
+	require.Nil(t, second.OrgnlEndToEndId)
+	require.Equal(t, "TX-0002", string(*second.OrgnlTxId))
+	require.Len(t, second.StsRsnInf, 2)
+	require.Equal(t, "CH16", string(*second.StsRsnInf[0].Rsn.Cd))
+	require.Equal(t, "PmtId/EndToEndId: The value of Max35Text has invalid length (minLength:1, maxLength:35)", string(second.StsRsnInf[0].AddtlInf[0]))
+	require.Equal(t, "AM03", string(*second.StsRsnInf[1].Rsn.Cd))
+	require.Equal(t, "IntrBkSttlmAmt/@Ccy: The value of ActiveCurrencyCode is invalid", string(second.StsRsnInf[1].AddtlInf[0]))
+
+	_, err = NewRejection(rej, fixtures.Document(t, "valid_camt053_v08.xml"), errors.New("invalid"))
+	require.ErrorIs(t, err, ErrNoRejection)
+	_, err = NewRejection(rej, original, nil)
+	require.EqualError(t, err, "original document is valid")
+
+	// codes are translated to the scheme of the counterparty
+	rej.ReasonScheme = reasons.SchemeSEPA
+	doc, err = NewRejection(rej, original, original.Validate())
+	require.Nil(t, err)
+	second = doc.InspectMessage().(*pacs_v10.FIToFIPaymentStatusReportV10).TxInfAndSts[1]
+	require.Equal(t, "FF01", string(*second.StsRsnInf[0].Rsn.Cd))
+	require.Equal(t, "MS03", string(*second.StsRsnInf[1].Rsn.Cd))
+}
+
+func TestNewPain002Rejection(t *testing.T) {
+	original, err := document.ParseIso20022Document([]byte(`<Document xmlns="urn:iso:std:iso:20022:tech:xsd:pain.001.001.10">
+	<CstmrCdtTrfInitn>
+		<GrpHdr>
+			<MsgId>PAIN-0001</MsgId>
+			<CreDtTm>2021-04-15T10:30:00</CreDtTm>
+			<NbOfTxs>1</NbOfTxs>
+		</GrpHdr>
+		<PmtInf>
+			<PmtInfId>PMT-0001</PmtInfId>
+			<CdtTrfTxInf>
+				<PmtId>
+					<EndToEndId>E2E-0001</EndToEndId>
+				</PmtId>
+				<Amt>
+					<InstdAmt Ccy="usd">10</InstdAmt>
+				</Amt>
+			</CdtTrfTxInf>
+		</PmtInf>
+	</CstmrCdtTrfInitn>
+</Document>`))
+	require.Nil(t, err)
+
+	doc, err := NewRejection(Rejection{MessageId: "RJCT-0001", InitiatingParty: "Bank"}, original, original.Validate())
+	require.Nil(t, err)
+	require.Equal(t, utils.DocumentPain00200111NameSpace, doc.NameSpace())
+	msg, ok := doc.InspectMessage().(*pain_v11.CustomerPaymentStatusReportV11)
+	require.True(t, ok)
+	require.Equal(t, "Bank", string(*msg.GrpHdr.InitgPty.Nm))
+	require.Equal(t, "PAIN-0001", string(msg.OrgnlGrpInfAndSts.OrgnlMsgId))
+	require.Equal(t, "1", string(*msg.OrgnlGrpInfAndSts.OrgnlNbOfTxs))
+	require.Len(t, msg.OrgnlPmtInfAndSts, 1)
+	require.Equal(t, "PMT-0001", string(msg.OrgnlPmtInfAndSts[0].OrgnlPmtInfId))
+	tx := msg.OrgnlPmtInfAndSts[0].TxInfAndSts[0]
+	require.Equal(t, "E2E-0001", string(*tx.OrgnlEndToEndId))
+	require.Equal(t, RejectedStatus, string(*tx.TxSts))
+	require.Equal(t, "AM03", string(*tx.StsRsnInf[0].Rsn.Cd))
+}
+
+func TestRejectionReasonCode(t *testing.T) {
+	require.Equal(t, "AM03", RejectionReasonCode("The value of ActiveCurrencyCode is invalid (ActiveCurrencyAndAmount, GroupHeader93)"))
+	require.Equal(t, "CH16", RejectionReasonCode("The value of Max35Text has invalid length (minLength:1, maxLength:35) (GroupHeader93, ISODate)"))
+	require.Equal(t, "CH17", RejectionReasonCode("The element GrpHdr/Unknown is unknown"))
+	require.Equal(t, "FF01", RejectionReasonCode("The namespace of document is invalid"))
+	require.Equal(t, DefaultRejectionReason, RejectionReasonCode("unexpected EOF"))
+}
+
+func TestAdditionalInfo(t *testing.T) {
+	require.Empty(t, additionalInfo(""))
+	lines := additionalInfo(strings.Repeat("é", 60))
+	require.Len(t, lines, 2)
+	require.Equal(t, strings.Repeat("é", 52), lines[0])
+	require.Equal(t, strings.Repeat("é", 8), lines[1])
+}
+
+func TestLinkRequestToPay(t *testing.T) {
+	newRequest := func(endToEndId, uetr string, amount float64) document.Iso20022Document {
+		doc, err := NewRequestToPay(RequestToPay{
+			MessageId:        "RTP-" + endToEndId,
+			CreationDateTime: time.Date(2021, 4, 15, 10, 0, 0, 0, time.UTC),
+			EndToEndId:       endToEndId,
+			UETR:             uetr,
+			Amount:           amount,
+			Currency:         "USD",
+			Debtor:           "John Smith",
+			Creditor:         "Jane Doe",
+			CreditorAccount:  "987654321",
+		})
+		require.Nil(t, err)
+		return doc
+	}
+	payments := []document.Iso20022Document{fixtures.Document(t, "valid_pacs_v08.xml"), fixtures.Document(t, "fednow_pacs_v08.xml")}
+
+	// the UETR takes precedence over the end to end identification
+	links := LinkRequestToPay(newRequest("E2E-0001", "8a562c67-ca16-48ba-b074-65581be6f011", 2500), payments...)
+	require.Len(t, links, 1)
+	require.Equal(t, "E2E-0001", links[0].Request.EndToEndId)
+	require.Equal(t, "MSG-20210415-0001", links[0].Payment.MessageId)
+	require.True(t, links[0].Paid())
+
+	links = LinkRequestToPay(newRequest("E2E-0002", "", 600), payments...)
+	require.Equal(t, 500.75, links[0].Payment.Amount)
+	require.False(t, links[0].Paid())
+
+	links = LinkRequestToPay(newRequest("E2E-UNPAID", "", 10), payments...)
+	require.Nil(t, links[0].Payment)
+	require.False(t, links[0].Paid())
+
+	require.Empty(t, LinkRequestToPay(payments[0], payments...))
+}
+
+func TestNewModifyStandingOrder(t *testing.T) {
+	req := StandingOrderRequest{MessageId: "STGORDR-0001", CreationDateTime: time.Date(2021, 4, 15, 11, 0, 0, 0, time.UTC)}
+	doc, err := NewModifyStandingOrder(req, StandingOrder{
+		Id:              "SO-0001",
+		Account:         "MAIN-0001",
+		AccountOwner:    "BANKDEFFXXX",
+		Amount:          250000,
+		Currency:        "EUR",
+		CreditorAccount: "SUB-0001",
+		Frequency:       StandingOrderDaily,
+		ExecutionEvent:  "SOD",
+		ValidFrom:       time.Date(2021, 5, 1, 0, 0, 0, 0, time.UTC),
+	})
+	require.Nil(t, err)
+
+	buf, err := xml.MarshalIndent(doc, "", "\t")
+	require.Nil(t, err)
+	parsed, err := document.ParseIso20022Document(buf)
+	require.Nil(t, err)
+
+	msg, ok := parsed.InspectMessage().(*camt_v06.ModifyStandingOrderV06)
+	require.True(t, ok)
+	require.Equal(t, "STGORDR-0001", string(msg.MsgHdr.MsgId))
+	require.Equal(t, "SO-0001", string(*msg.StgOrdrId.Id))
+	require.Equal(t, "MAIN-0001", string(msg.StgOrdrId.Acct.Id.Othr.Id))
+	require.Equal(t, "BANKDEFFXXX", string(*msg.StgOrdrId.AcctOwnr.FinInstnId.BICFI))
+	values := msg.NewStgOrdrValSet
+	require.Equal(t, 250000.0, values.Amt.AmtWthCcy.Value)
+	require.Equal(t, "SUB-0001", string(values.CdtrAcct.Id.Othr.Id))
+	require.Nil(t, values.DbtrAcct)
+	require.Equal(t, StandingOrderDaily, string(*values.Frqcy))
+	require.Equal(t, "SOD", string(values.ExctnTp.Evt.Cd))
+	require.Equal(t, "2021-05-01", time.Time(values.VldtyPrd.FrDt).Format("2006-01-02"))
+
+	// a zero sweep doesn't carry an amount
+	doc, err = NewModifyStandingOrder(req, StandingOrder{Account: "SUB-0001", ZeroSweep: true})
+	require.Nil(t, err)
+	msg = doc.InspectMessage().(*camt_v06.ModifyStandingOrderV06)
+	require.Nil(t, msg.NewStgOrdrValSet.Amt)
+	require.True(t, msg.NewStgOrdrValSet.ZeroSweepInd)
+
+	_, err = NewModifyStandingOrder(req, StandingOrder{Amount: 10})
+	require.Equal(t, ErrStandingOrderAccountOmitted, err)
+	_, err = NewModifyStandingOrder(req, StandingOrder{Account: "MAIN-0001", Amount: -10})
+	require.Equal(t, ErrInvalidTransferAmount, err)
+}
+
+func TestNewGetAndDeleteStandingOrder(t *testing.T) {
+	req := StandingOrderRequest{MessageId: "STGORDR-0002"}
+	doc, err := NewGetStandingOrder(req, StandingOrderQuery{Account: "MAIN-0001", Currency: "EUR"})
+	require.Nil(t, err)
+
+	buf, err := xml.MarshalIndent(doc, "", "\t")
+	require.Nil(t, err)
+	parsed, err := document.ParseIso20022Document(buf)
+	require.Nil(t, err)
+	query, ok := parsed.InspectMessage().(*camt_v03.GetStandingOrderV03)
+	require.True(t, ok)
+	require.Equal(t, "ALLL", string(*query.StgOrdrQryDef.QryTp))
+	criteria := query.StgOrdrQryDef.StgOrdrCrit.NewCrit.SchCrit[0]
+	require.Equal(t, "MAIN-0001", string(criteria.Acct.Id.Othr.Id))
+	require.Equal(t, "EUR", string(*criteria.Ccy))
+	require.Nil(t, criteria.StgOrdrId)
+
+	doc, err = NewDeleteStandingOrder(req, StandingOrder{Id: "SO-0001", Account: "MAIN-0001"}, StandingOrder{Account: "MAIN-0002", AccountOwner: "BANKDEFFXXX"})
+	require.Nil(t, err)
+	deletion, ok := doc.InspectMessage().(*camt_v03.DeleteStandingOrderV03)
+	require.True(t, ok)
+	require.Len(t, deletion.StgOrdrDtls.StgOrdr, 2)
+	require.Equal(t, "SO-0001", string(*deletion.StgOrdrDtls.StgOrdr[0].Id))
+	require.Equal(t, "BANKDEFFXXX", string(*deletion.StgOrdrDtls.StgOrdr[1].AcctOwnr.FinInstnId.BICFI))
+
+	_, err = NewDeleteStandingOrder(req)
+	require.Equal(t, ErrNoStandingOrders, err)
+	_, err = NewDeleteStandingOrder(req, StandingOrder{Id: "SO-0001"})
+	require.Equal(t, ErrStandingOrderAccountOmitted, err)
+}
+
+func TestNewStatusReport(t *testing.T) {
+	original := fixtures.Document(t, "valid_pacs_v08.xml")
+	sts := StatusReport{MessageId: "STS-0001", CreationDateTime: time.Date(2021, 4, 15, 10, 31, 0, 0, time.UTC)}
+
+	doc, err := NewStatusReport(sts, original)
+	require.Nil(t, err)
+	require.Equal(t, utils.DocumentPacs00200110NameSpace, doc.NameSpace())
+	msg, ok := doc.InspectMessage().(*pacs_v10.FIToFIPaymentStatusReportV10)
+	require.True(t, ok)
+	require.Equal(t, "BANKGB2LXXX", string(*msg.GrpHdr.InstgAgt.FinInstnId.BICFI))
+	group := msg.OrgnlGrpInfAndSts[0]
+	require.Equal(t, "MSG-20210415-0001", string(group.OrgnlMsgId))
+	require.Equal(t, "2", string(*group.OrgnlNbOfTxs))
+	require.Equal(t, AcceptedSettlementCompletedStatus, string(*group.GrpSts))
+	require.Len(t, msg.TxInfAndSts, 2)
+	require.Equal(t, "8a562c67-ca16-48ba-b074-65581be6f011", string(*msg.TxInfAndSts[0].OrgnlUETR))
+	require.Empty(t, msg.TxInfAndSts[0].StsRsnInf)
+
+	// transactions of different statuses partially accept the group
+	sts.Transactions = []TransactionStatus{{}, {Status: RejectedStatus, Reason: "AM04"}}
+	doc, err = NewStatusReport(sts, original)
+	require.Nil(t, err)
+	msg = doc.InspectMessage().(*pacs_v10.FIToFIPaymentStatusReportV10)
+	require.Equal(t, PartiallyAcceptedStatus, string(*msg.OrgnlGrpInfAndSts[0].GrpSts))
+	require.Equal(t, AcceptedSettlementCompletedStatus, string(*msg.TxInfAndSts[0].TxSts))
+	require.Equal(t, RejectedStatus, string(*msg.TxInfAndSts[1].TxSts))
+	require.Equal(t, "AM04", string(*msg.TxInfAndSts[1].StsRsnInf[0].Rsn.Cd))
+
+	_, err = NewStatusReport(sts, fixtures.Document(t, "valid_camt053_v08.xml"))
+	require.ErrorIs(t, err, ErrNotCreditTransfer)
+}
+
+func TestNewCreditNotification(t *testing.T) {
+	original := fixtures.Document(t, "valid_pacs_v08.xml")
+	ntf := CreditNotification{MessageId: "NTFCTN-0001", CreationDateTime: time.Date(2021, 4, 15, 10, 31, 0, 0, time.UTC)}
+
+	doc, err := NewCreditNotification(ntf, original)
+	require.Nil(t, err)
+	require.Equal(t, utils.DocumentCamt05400108NameSpace, doc.NameSpace())
+	msg, ok := doc.InspectMessage().(*camt_v08.BankToCustomerDebitCreditNotificationV08)
+	require.True(t, ok)
+	require.Len(t, msg.Ntfctn, 1)
+	entries := msg.Ntfctn[0].Ntry
+	require.Len(t, entries, 2)
+	require.Equal(t, 250000.0, entries[0].Amt.Value)
+	require.Equal(t, "USD", string(entries[0].Amt.Ccy))
+	require.Equal(t, "CRDT", string(entries[0].CdtDbtInd))
+	require.Equal(t, BookedStatus, string(entries[0].Sts.Cd))
+	require.Equal(t, "2021-04-15", time.Time(entries[0].BookgDt.Dt).Format("2006-01-02"))
+	refs := entries[0].NtryDtls[0].TxDtls[0].Refs
+	require.Equal(t, "MSG-20210415-0001", string(*refs.MsgId))
+	require.Equal(t, "E2E-0001", string(*refs.EndToEndId))
+
+	// the notification is printed and read back
+	buf, err := xml.Marshal(doc)
+	require.Nil(t, err)
+	_, err = document.ParseIso20022Document(buf)
+	require.Nil(t, err)
+
+	ntf.Transactions = []int{1}
+	doc, err = NewCreditNotification(ntf, original)
+	require.Nil(t, err)
+	msg = doc.InspectMessage().(*camt_v08.BankToCustomerDebitCreditNotificationV08)
+	require.Len(t, msg.Ntfctn[0].Ntry, 1)
+	require.Equal(t, "TX-0002", string(*msg.Ntfctn[0].Ntry[0].NtryRef))
+
+	ntf.Transactions = []int{}
+	_, err = NewCreditNotification(ntf, original)
+	require.ErrorIs(t, err, ErrNoTransactions)
+	_, err = NewCreditNotification(ntf, fixtures.Document(t, "valid_camt053_v08.xml"))
+	require.ErrorIs(t, err, ErrNotCreditTransfer)
+}
@@ -0,0 +1,235 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package builder
+
+import (
+	"errors"
+	"time"
+
+	"github.com/moov-io/iso20022/pkg/camt_v03"
+	"github.com/moov-io/iso20022/pkg/camt_v06"
+	"github.com/moov-io/iso20022/pkg/common"
+	"github.com/moov-io/iso20022/pkg/document"
+	"github.com/moov-io/iso20022/pkg/utils"
+)
+
+// Frequencies of a standing order
+const (
+	StandingOrderDaily    = "DAIL"
+	StandingOrderWeekly   = "WEEK"
+	StandingOrderMonthly  = "MNTH"
+	StandingOrderIntraDay = "INDA"
+)
+
+var (
+	// ErrStandingOrderAccountOmitted is returned when a standing order has no account
+	ErrStandingOrderAccountOmitted = errors.New("standing order account is omitted")
+
+	// ErrNoStandingOrders is returned when a deletion has no standing orders to delete
+	ErrNoStandingOrders = errors.New("no standing orders")
+)
+
+// StandingOrderRequest is the header of a standing order management message
+type StandingOrderRequest struct {
+	MessageId        string
+	CreationDateTime time.Time
+}
+
+// StandingOrder describes a standing order of an RTGS account, a liquidity transfer
+// executed at every occurrence of its frequency, time or system event
+type StandingOrder struct {
+	// Id, Account and AccountOwner (a BIC) identify the order
+	Id           string `json:",omitempty"`
+	Account      string
+	AccountOwner string `json:",omitempty"`
+
+	// Amount is transferred in Currency, a zero sweep transfers the whole balance instead
+	Amount    float64 `json:",omitempty"`
+	Currency  string  `json:",omitempty"`
+	ZeroSweep bool    `json:",omitempty"`
+
+	// Debtor and Creditor are the BICs of the account owners
+	Debtor          string `json:",omitempty"`
+	DebtorAccount   string `json:",omitempty"`
+	Creditor        string `json:",omitempty"`
+	CreditorAccount string `json:",omitempty"`
+
+	// Frequency is a frequency code (e.g. DAIL), the order is executed at ExecutionTime
+	// of the day or at the system event ExecutionEvent (e.g. a cut-off code)
+	Frequency      string    `json:",omitempty"`
+	ExecutionTime  time.Time `json:",omitempty"`
+	ExecutionEvent string    `json:",omitempty"`
+
+	ValidFrom time.Time `json:",omitempty"`
+	ValidTo   time.Time `json:",omitempty"`
+}
+
+// NewModifyStandingOrder creates a camt.024 modification of the standing order identified
+// by order, setting the values of order that aren't empty
+//
+// The message is not validated, amounts, accounts and execution types are choices and
+// only one of their alternatives is set.
+func NewModifyStandingOrder(req StandingOrderRequest, order StandingOrder) (document.Iso20022Document, error) {
+	if order.Account == "" {
+		return nil, ErrStandingOrderAccountOmitted
+	}
+	if order.Amount < 0 {
+		return nil, ErrInvalidTransferAmount
+	}
+
+	values := camt_v06.StandingOrder7{
+		Cdtr:         standingOrderAgent(order.Creditor),
+		Dbtr:         standingOrderAgent(order.Debtor),
+		ZeroSweepInd: order.ZeroSweep,
+	}
+	if order.Amount > 0 {
+		values.Amt = &camt_v06.Amount2Choice{
+			AmtWthCcy: camt_v06.ActiveCurrencyAndAmount{Value: order.Amount, Ccy: common.ActiveCurrencyCode(order.Currency)},
+		}
+	}
+	if order.CreditorAccount != "" {
+		values.CdtrAcct = standingOrderAccount(order.CreditorAccount)
+	}
+	if order.DebtorAccount != "" {
+		values.DbtrAcct = standingOrderAccount(order.DebtorAccount)
+	}
+	switch {
+	case order.ExecutionEvent != "":
+		values.ExctnTp = &camt_v06.ExecutionType1Choice{Evt: camt_v06.EventType1Choice{Cd: camt_v06.ExternalSystemEventType1Code(order.ExecutionEvent)}}
+	case !order.ExecutionTime.IsZero():
+		values.ExctnTp = &camt_v06.ExecutionType1Choice{Tm: common.ISOTime(order.ExecutionTime)}
+	}
+	if order.Frequency != "" {
+		frequency := camt_v06.Frequency2Code(order.Frequency)
+		values.Frqcy = &frequency
+	}
+	if !order.ValidFrom.IsZero() || !order.ValidTo.IsZero() {
+		values.VldtyPrd = &camt_v06.DatePeriod2Choice{}
+		switch {
+		case order.ValidTo.IsZero():
+			values.VldtyPrd.FrDt = common.ISODate(order.ValidFrom)
+		case order.ValidFrom.IsZero():
+			values.VldtyPrd.ToDt = common.ISODate(order.ValidTo)
+		default:
+			values.VldtyPrd.FrToDt = camt_v06.DatePeriod2{FrDt: common.ISODate(order.ValidFrom), ToDt: common.ISODate(order.ValidTo)}
+		}
+	}
+
+	msg := &camt_v06.ModifyStandingOrderV06{
+		MsgHdr: camt_v06.MessageHeader1{
+			MsgId:   common.Max35Text(req.MessageId),
+			CreDtTm: optionalDateTime(req.CreationDateTime),
+		},
+		StgOrdrId: camt_v06.StandingOrderIdentification4{
+			Id:       optionalMax35Text(order.Id),
+			Acct:     *standingOrderAccount(order.Account),
+			AcctOwnr: standingOrderAgent(order.AccountOwner),
+		},
+		NewStgOrdrValSet: values,
+	}
+	return newDocument(utils.DocumentCamt02400106NameSpace, msg), nil
+}
+
+// StandingOrderQuery selects the standing orders returned for a camt.069 query, empty
+// fields match every order
+type StandingOrderQuery struct {
+	Id       string `json:",omitempty"`
+	Account  string `json:",omitempty"`
+	Currency string `json:",omitempty"`
+
+	// QueryType is ALLL (default), CHNG, MODF or DELD
+	QueryType string `json:",omitempty"`
+}
+
+// NewGetStandingOrder creates a camt.069 query of the standing orders matching query
+//
+// The message is not validated, accounts and criteria are choices and only one of
+// their alternatives is set.
+func NewGetStandingOrder(req StandingOrderRequest, query StandingOrderQuery) (document.Iso20022Document, error) {
+	criteria := camt_v03.StandingOrderSearchCriteria3{
+		StgOrdrId: optionalMax35Text(query.Id),
+	}
+	if query.Account != "" {
+		criteria.Acct = &camt_v03.CashAccount38{
+			Id: camt_v03.AccountIdentification4Choice{
+				Othr: camt_v03.GenericAccountIdentification1{Id: common.Max34Text(query.Account)},
+			},
+		}
+	}
+	if query.Currency != "" {
+		ccy := common.ActiveCurrencyCode(query.Currency)
+		criteria.Ccy = &ccy
+	}
+
+	queryType := camt_v03.QueryType2Code(firstNonEmpty(query.QueryType, "ALLL"))
+	msg := &camt_v03.GetStandingOrderV03{
+		MsgHdr: camt_v03.MessageHeader4{
+			MsgId:   common.Max35Text(req.MessageId),
+			CreDtTm: optionalDateTime(req.CreationDateTime),
+		},
+		StgOrdrQryDef: &camt_v03.StandingOrderQuery3{
+			QryTp: &queryType,
+			StgOrdrCrit: &camt_v03.StandingOrderCriteria3Choice{
+				NewCrit: camt_v03.StandingOrderCriteria3{SchCrit: []camt_v03.StandingOrderSearchCriteria3{criteria}},
+			},
+		},
+	}
+	return newDocument(utils.DocumentCamt06900103NameSpace, msg), nil
+}
+
+// NewDeleteStandingOrder creates a camt.071 deletion of the standing orders identified by orders
+//
+// The message is not validated, accounts are choices and only one of their alternatives is set.
+func NewDeleteStandingOrder(req StandingOrderRequest, orders ...StandingOrder) (document.Iso20022Document, error) {
+	if len(orders) == 0 {
+		return nil, ErrNoStandingOrders
+	}
+
+	msg := &camt_v03.DeleteStandingOrderV03{
+		MsgHdr: camt_v03.MessageHeader1{
+			MsgId:   common.Max35Text(req.MessageId),
+			CreDtTm: optionalDateTime(req.CreationDateTime),
+		},
+	}
+	for _, order := range orders {
+		if order.Account == "" {
+			return nil, ErrStandingOrderAccountOmitted
+		}
+		id := camt_v03.StandingOrderIdentification4{
+			Id: optionalMax35Text(order.Id),
+			Acct: camt_v03.CashAccount38{
+				Id: camt_v03.AccountIdentification4Choice{
+					Othr: camt_v03.GenericAccountIdentification1{Id: common.Max34Text(order.Account)},
+				},
+			},
+		}
+		if order.AccountOwner != "" {
+			bicfi := common.BICFIDec2014Identifier(order.AccountOwner)
+			id.AcctOwnr = &camt_v03.BranchAndFinancialInstitutionIdentification6{
+				FinInstnId: camt_v03.FinancialInstitutionIdentification18{BICFI: &bicfi},
+			}
+		}
+		msg.StgOrdrDtls.StgOrdr = append(msg.StgOrdrDtls.StgOrdr, id)
+	}
+	return newDocument(utils.DocumentCamt07100103NameSpace, msg), nil
+}
+
+func standingOrderAgent(bic string) *camt_v06.BranchAndFinancialInstitutionIdentification6 {
+	if bic == "" {
+		return nil
+	}
+	bicfi := common.BICFIDec2014Identifier(bic)
+	return &camt_v06.BranchAndFinancialInstitutionIdentification6{
+		FinInstnId: camt_v06.FinancialInstitutionIdentification18{BICFI: &bicfi},
+	}
+}
+
+func standingOrderAccount(id string) *camt_v06.CashAccount38 {
+	return &camt_v06.CashAccount38{
+		Id: camt_v06.AccountIdentification4Choice{
+			Othr: camt_v06.GenericAccountIdentification1{Id: common.Max34Text(id)},
+		},
+	}
+}
@@ -0,0 +1,80 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package builder
+
+import (
+	"errors"
+	"time"
+
+	"github.com/moov-io/iso20022/pkg/camt_v05"
+	"github.com/moov-io/iso20022/pkg/common"
+	"github.com/moov-io/iso20022/pkg/document"
+	"github.com/moov-io/iso20022/pkg/utils"
+)
+
+// Status codes of a receipt
+const (
+	ReceiptStatusCompleted = "COMP"
+	ReceiptStatusPending   = "PDNG"
+	ReceiptStatusRejected  = "REJT"
+)
+
+var (
+	// ErrOriginalMessageIdOmitted is returned when the original document has no message identification
+	ErrOriginalMessageIdOmitted = errors.New("original message identification is omitted")
+)
+
+// Receipt describes a camt.025 receipt for an original message
+type Receipt struct {
+	MessageId        string
+	CreationDateTime time.Time
+
+	StatusCode  string
+	Description string `json:",omitempty"`
+}
+
+// NewReceipt creates a camt.025 receipt acknowledging original, a camt.050 liquidity transfer for example
+func NewReceipt(rct Receipt, original document.Iso20022Document) (document.Iso20022Document, error) {
+	origId := firstNonEmpty(
+		firstValue(utils.FindElementValues(original.InspectMessage(), "MsgHdr/MsgId")),
+		firstValue(utils.FindElementValues(original.InspectMessage(), "GrpHdr/MsgId")),
+	)
+	if origId == "" {
+		return nil, ErrOriginalMessageIdOmitted
+	}
+
+	details := camt_v05.Receipt3{
+		OrgnlMsgId: camt_v05.OriginalMessageAndIssuer1{
+			MsgId:   common.Max35Text(origId),
+			MsgNmId: optionalMax35Text(utils.GetMessageType(original.NameSpace())),
+		},
+		ReqHdlg: []camt_v05.RequestHandling1{{StsCd: common.Max4AlphaNumericText(rct.StatusCode)}},
+	}
+	if rct.Description != "" {
+		desc := common.Max140Text(rct.Description)
+		details.ReqHdlg[0].Desc = &desc
+	}
+
+	msg := &camt_v05.ReceiptV05{
+		MsgHdr: camt_v05.MessageHeader9{
+			MsgId:   common.Max35Text(rct.MessageId),
+			CreDtTm: optionalDateTime(rct.CreationDateTime),
+		},
+		RctDtls: []camt_v05.Receipt3{details},
+	}
+
+	doc := newDocument(utils.DocumentCamt02500105NameSpace, msg)
+	if err := doc.Validate(); err != nil {
+		return nil, err
+	}
+	return doc, nil
+}
+
+func firstValue(values []string) string {
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
@@ -0,0 +1,129 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package builder
+
+import (
+	"errors"
+	"time"
+
+	"github.com/moov-io/iso20022/pkg/camt_v05"
+	"github.com/moov-io/iso20022/pkg/common"
+	"github.com/moov-io/iso20022/pkg/document"
+	"github.com/moov-io/iso20022/pkg/utils"
+)
+
+var (
+	// ErrInvalidTransferAmount is returned when a liquidity transfer has no positive amount
+	ErrInvalidTransferAmount = errors.New("transfer amount must be positive")
+
+	// ErrTransferAccountOmitted is returned when a liquidity transfer has no debtor or creditor account
+	ErrTransferAccountOmitted = errors.New("debtor and creditor accounts are required")
+)
+
+// LiquidityTransfer describes a camt.050 liquidity credit transfer between two RTGS accounts
+type LiquidityTransfer struct {
+	MessageId        string
+	CreationDateTime time.Time
+
+	EndToEndId    string
+	InstructionId string `json:",omitempty"`
+
+	Amount   float64
+	Currency string
+
+	// Debtor and Creditor are the BICs of the account owners
+	Debtor          string `json:",omitempty"`
+	DebtorAccount   string
+	Creditor        string `json:",omitempty"`
+	CreditorAccount string
+
+	SettlementDate time.Time `json:",omitempty"`
+}
+
+// MainToSubAccount returns a transfer of liquidity from the main account of owner to one of its sub-accounts
+func MainToSubAccount(owner, mainAccount, subAccount string, amount float64, currency string) LiquidityTransfer {
+	return LiquidityTransfer{
+		Amount:          amount,
+		Currency:        currency,
+		Debtor:          owner,
+		DebtorAccount:   mainAccount,
+		Creditor:        owner,
+		CreditorAccount: subAccount,
+	}
+}
+
+// SubToMainAccount returns a transfer of liquidity from a sub-account of owner back to its main account
+func SubToMainAccount(owner, subAccount, mainAccount string, amount float64, currency string) LiquidityTransfer {
+	return LiquidityTransfer{
+		Amount:          amount,
+		Currency:        currency,
+		Debtor:          owner,
+		DebtorAccount:   subAccount,
+		Creditor:        owner,
+		CreditorAccount: mainAccount,
+	}
+}
+
+// NewLiquidityCreditTransfer creates a camt.050 liquidity credit transfer
+//
+// The message is not validated, accounts are choices of an IBAN or a proprietary
+// identification and only one of them is set.
+func NewLiquidityCreditTransfer(trf LiquidityTransfer) (document.Iso20022Document, error) {
+	if trf.Amount <= 0 {
+		return nil, ErrInvalidTransferAmount
+	}
+	if trf.DebtorAccount == "" || trf.CreditorAccount == "" {
+		return nil, ErrTransferAccountOmitted
+	}
+
+	msg := &camt_v05.LiquidityCreditTransferV05{
+		MsgHdr: camt_v05.MessageHeader1{
+			MsgId:   common.Max35Text(trf.MessageId),
+			CreDtTm: optionalDateTime(trf.CreationDateTime),
+		},
+		LqdtyCdtTrf: camt_v05.LiquidityCreditTransfer2{
+			Cdtr:     camtAgent(trf.Creditor),
+			CdtrAcct: camtAccount(trf.CreditorAccount),
+			TrfdAmt: camt_v05.Amount2Choice{
+				AmtWthCcy: camt_v05.ActiveCurrencyAndAmount{
+					Value: trf.Amount,
+					Ccy:   common.ActiveCurrencyCode(trf.Currency),
+				},
+			},
+			Dbtr:     camtAgent(trf.Debtor),
+			DbtrAcct: camtAccount(trf.DebtorAccount),
+		},
+	}
+	if trf.EndToEndId != "" || trf.InstructionId != "" {
+		msg.LqdtyCdtTrf.LqdtyTrfId = &camt_v05.PaymentIdentification8{
+			InstrId:    optionalMax35Text(trf.InstructionId),
+			EndToEndId: optionalMax35Text(firstNonEmpty(trf.EndToEndId, trf.InstructionId)),
+		}
+	}
+	if !trf.SettlementDate.IsZero() {
+		date := common.ISODate(trf.SettlementDate)
+		msg.LqdtyCdtTrf.SttlmDt = &date
+	}
+
+	return newDocument(utils.DocumentCamt05000105NameSpace, msg), nil
+}
+
+func camtAgent(bic string) *camt_v05.BranchAndFinancialInstitutionIdentification6 {
+	if bic == "" {
+		return nil
+	}
+	bicfi := common.BICFIDec2014Identifier(bic)
+	return &camt_v05.BranchAndFinancialInstitutionIdentification6{
+		FinInstnId: camt_v05.FinancialInstitutionIdentification18{BICFI: &bicfi},
+	}
+}
+
+func camtAccount(id string) *camt_v05.CashAccount38 {
+	return &camt_v05.CashAccount38{
+		Id: camt_v05.AccountIdentification4Choice{
+			Othr: camt_v05.GenericAccountIdentification1{Id: common.Max34Text(id)},
+		},
+	}
+}
@@ -0,0 +1,136 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package builder
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/moov-io/iso20022/pkg/camt_v08"
+	"github.com/moov-io/iso20022/pkg/common"
+	"github.com/moov-io/iso20022/pkg/document"
+	"github.com/moov-io/iso20022/pkg/messagetype"
+	"github.com/moov-io/iso20022/pkg/utils"
+)
+
+const (
+	// BookedStatus is the status of booked entries
+	BookedStatus = "BOOK"
+
+	creditCode = "CRDT"
+)
+
+// CreditNotification describes the camt.054 notifying the creditors of a pacs.008 of the credit
+// of their accounts
+type CreditNotification struct {
+	MessageId        string
+	CreationDateTime time.Time
+
+	// Transactions are the indexes of the credited transactions of the original payment, every
+	// transaction is credited when nil
+	Transactions []int `json:",omitempty"`
+}
+
+// NewCreditNotification creates the camt.054 notifying the credits of the transactions of
+// original, a pacs.008 of any version. Credits are booked on their interbank settlement date,
+// the creation date of the notification when omitted, with a notification by creditor account.
+//
+// The message is not validated, accounts, dates and entry statuses are choices and only one
+// of their elements is set.
+func NewCreditNotification(ntf CreditNotification, original document.Iso20022Document) (document.Iso20022Document, error) {
+	messageType := messagetype.Type(utils.GetMessageType(original.NameSpace()))
+	if messageType.Message() != "pacs.008" {
+		return nil, fmt.Errorf("%w: %s", ErrNotCreditTransfer, messageType)
+	}
+	credited := make(map[int]bool)
+	for _, i := range ntf.Transactions {
+		credited[i] = true
+	}
+
+	msg := &camt_v08.BankToCustomerDebitCreditNotificationV08{
+		GrpHdr: camt_v08.GroupHeader81{
+			MsgId:   common.Max35Text(ntf.MessageId),
+			CreDtTm: common.ISODateTime(ntf.CreationDateTime),
+		},
+	}
+	accounts := make(map[string]int)
+	var txs []utils.Transaction
+	for _, tx := range utils.GetTransactions(original.InspectMessage()) {
+		if tx.Path != "" {
+			txs = append(txs, tx)
+		}
+	}
+	for i, tx := range txs {
+		if ntf.Transactions != nil && !credited[i] {
+			continue
+		}
+		account := firstNonEmpty(tx.Lookup("CdtrAcct/Id/IBAN"), tx.Lookup("CdtrAcct/Id/Othr/Id"))
+		idx, exists := accounts[account]
+		if !exists {
+			idx = len(msg.Ntfctn)
+			accounts[account] = idx
+			created := common.ISODateTime(ntf.CreationDateTime)
+			msg.Ntfctn = append(msg.Ntfctn, camt_v08.AccountNotification17{
+				Id:      common.Max35Text(strconv.Itoa(idx + 1)),
+				CreDtTm: &created,
+				Acct:    camt_v08.CashAccount39{Id: notificationAccount(tx)},
+			})
+		}
+		msg.Ntfctn[idx].Ntry = append(msg.Ntfctn[idx].Ntry, creditEntry(ntf, tx))
+	}
+	if len(msg.Ntfctn) == 0 {
+		return nil, ErrNoTransactions
+	}
+
+	return newDocument(utils.DocumentCamt05400108NameSpace, msg), nil
+}
+
+func notificationAccount(tx utils.Transaction) camt_v08.AccountIdentification4Choice {
+	if iban := tx.Lookup("CdtrAcct/Id/IBAN"); iban != "" {
+		return camt_v08.AccountIdentification4Choice{IBAN: common.IBAN2007Identifier(iban)}
+	}
+	return camt_v08.AccountIdentification4Choice{
+		Othr: camt_v08.GenericAccountIdentification1{Id: common.Max34Text(reportedMax35Text(tx.Lookup("CdtrAcct/Id/Othr/Id")))},
+	}
+}
+
+func creditEntry(ntf CreditNotification, tx utils.Transaction) camt_v08.ReportEntry10 {
+	amount, _ := strconv.ParseFloat(tx.Lookup("IntrBkSttlmAmt"), 64)
+	value := camt_v08.ActiveOrHistoricCurrencyAndAmount{
+		Value: amount,
+		Ccy:   common.ActiveOrHistoricCurrencyCode(tx.Lookup("IntrBkSttlmAmt/@Ccy")),
+	}
+	booked := common.ISODate(ntf.CreationDateTime)
+	if date := tx.Lookup("IntrBkSttlmDt"); date != "" {
+		var settled common.ISODate
+		if settled.UnmarshalText([]byte(date)) == nil {
+			booked = settled
+		}
+	}
+	credit := common.CreditDebitCode(creditCode)
+
+	return camt_v08.ReportEntry10{
+		NtryRef:   optionalReportedMax35Text(tx.Lookup("PmtId/TxId", "PmtId/EndToEndId")),
+		Amt:       value,
+		CdtDbtInd: credit,
+		Sts:       camt_v08.EntryStatus1Choice{Cd: BookedStatus},
+		BookgDt:   &camt_v08.DateAndDateTime2Choice{Dt: booked},
+		ValDt:     &camt_v08.DateAndDateTime2Choice{Dt: booked},
+		NtryDtls: []camt_v08.EntryDetails9{{
+			TxDtls: []camt_v08.EntryTransaction10{{
+				Refs: &camt_v08.TransactionReferences6{
+					MsgId:      optionalReportedMax35Text(tx.Lookup("GrpHdr/MsgId")),
+					InstrId:    optionalReportedMax35Text(tx.Lookup("PmtId/InstrId")),
+					EndToEndId: optionalReportedMax35Text(tx.Lookup("PmtId/EndToEndId")),
+					UETR:       reportedUETR(tx.Lookup("PmtId/UETR")),
+					TxId:       optionalReportedMax35Text(tx.Lookup("PmtId/TxId")),
+				},
+				Amt:       &value,
+				CdtDbtInd: &credit,
+			}},
+		}},
+	}
+}
@@ -0,0 +1,126 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package builder
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/moov-io/iso20022/pkg/common"
+	"github.com/moov-io/iso20022/pkg/document"
+	"github.com/moov-io/iso20022/pkg/messagetype"
+	"github.com/moov-io/iso20022/pkg/pacs_v10"
+	"github.com/moov-io/iso20022/pkg/utils"
+)
+
+var (
+	// ErrNotCreditTransfer is returned when the original document of a status report or credit
+	// notification isn't a pacs.008
+	ErrNotCreditTransfer = errors.New("original document isn't a credit transfer")
+)
+
+const (
+	// AcceptedSettlementCompletedStatus is the status of settled transactions
+	AcceptedSettlementCompletedStatus = "ACSC"
+
+	// PartiallyAcceptedStatus is the group status of reports with several transaction statuses
+	PartiallyAcceptedStatus = "PART"
+)
+
+// StatusReport describes the pacs.002 of the counterparty of a pacs.008 reporting the status of
+// its transactions
+type StatusReport struct {
+	MessageId        string
+	CreationDateTime time.Time
+
+	// InstructingAgent and InstructedAgent are the BICs of the report, they default to the
+	// instructed and instructing agents of the original payment
+	InstructingAgent string `json:",omitempty"`
+	InstructedAgent  string `json:",omitempty"`
+
+	// Transactions are the statuses of the transactions of the original payment by index,
+	// transactions without status are reported with Status and Reason
+	Transactions []TransactionStatus `json:",omitempty"`
+
+	// Status is an external payment transaction status code, ACSC when empty, Reason the
+	// external status reason code of rejected transactions
+	Status string `json:",omitempty"`
+	Reason string `json:",omitempty"`
+}
+
+// TransactionStatus is the status of a transaction reported by a status report
+type TransactionStatus struct {
+	Status string
+	Reason string `json:",omitempty"`
+}
+
+// NewStatusReport creates the pacs.002 reporting the status of the transactions of original,
+// a pacs.008 of any version. The group status is the status of the transactions when they
+// share it and PART otherwise.
+func NewStatusReport(sts StatusReport, original document.Iso20022Document) (document.Iso20022Document, error) {
+	messageType := messagetype.Type(utils.GetMessageType(original.NameSpace()))
+	if messageType.Message() != "pacs.008" {
+		return nil, fmt.Errorf("%w: %s", ErrNotCreditTransfer, messageType)
+	}
+	refs := GetPaymentReferences(original)
+	if len(refs) == 0 {
+		return nil, ErrNoTransactions
+	}
+
+	msg := &pacs_v10.FIToFIPaymentStatusReportV10{
+		GrpHdr: pacs_v10.GroupHeader91{
+			MsgId:    common.Max35Text(sts.MessageId),
+			CreDtTm:  common.ISODateTime(sts.CreationDateTime),
+			InstgAgt: pacs002Agent(firstNonEmpty(sts.InstructingAgent, refs[0].InstructedAgent)),
+			InstdAgt: pacs002Agent(firstNonEmpty(sts.InstructedAgent, refs[0].InstructingAgent)),
+		},
+	}
+	var groupStatus string
+	for i, ref := range refs {
+		status := TransactionStatus{Status: sts.Status, Reason: sts.Reason}
+		if i < len(sts.Transactions) && sts.Transactions[i].Status != "" {
+			status = sts.Transactions[i]
+		}
+		if status.Status == "" {
+			status.Status = AcceptedSettlementCompletedStatus
+		}
+		switch groupStatus {
+		case "":
+			groupStatus = status.Status
+		case status.Status:
+		default:
+			groupStatus = PartiallyAcceptedStatus
+		}
+
+		txStatus := pacs_v10.ExternalPaymentTransactionStatus1Code(status.Status)
+		txSts := pacs_v10.PaymentTransaction110{
+			OrgnlInstrId:    optionalReportedMax35Text(ref.InstructionId),
+			OrgnlEndToEndId: optionalReportedMax35Text(ref.EndToEndId),
+			OrgnlTxId:       optionalReportedMax35Text(ref.TransactionId),
+			OrgnlUETR:       reportedUETR(ref.UETR),
+			TxSts:           &txStatus,
+		}
+		if status.Reason != "" {
+			txSts.StsRsnInf = []pacs_v10.StatusReasonInformation12{pacs002Reason(statusReason{code: status.Reason})}
+		}
+		msg.TxInfAndSts = append(msg.TxInfAndSts, txSts)
+	}
+
+	group := pacs_v10.ExternalPaymentGroupStatus1Code(groupStatus)
+	msg.OrgnlGrpInfAndSts = []pacs_v10.OriginalGroupHeader17{{
+		OrgnlMsgId:   reportedMax35Text(refs[0].MessageId),
+		OrgnlMsgNmId: common.Max35Text(refs[0].MessageNameId),
+		OrgnlCreDtTm: reportedDateTime(refs[0].CreationDateTime),
+		OrgnlNbOfTxs: reportedNumberOfTxs(firstValue(utils.FindElementValues(original.InspectMessage(), "GrpHdr/NbOfTxs"))),
+		GrpSts:       &group,
+	}}
+
+	doc := newDocument(utils.DocumentPacs00200110NameSpace, msg)
+	if err := doc.Validate(); err != nil {
+		return nil, err
+	}
+	return doc, nil
+}
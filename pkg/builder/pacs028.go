@@ -0,0 +1,106 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package builder
+
+import (
+	"time"
+
+	"github.com/moov-io/iso20022/pkg/common"
+	"github.com/moov-io/iso20022/pkg/document"
+	"github.com/moov-io/iso20022/pkg/pacs_v04"
+	"github.com/moov-io/iso20022/pkg/utils"
+)
+
+// StatusRequest describes a pacs.028 payment status request
+type StatusRequest struct {
+	MessageId        string
+	CreationDateTime time.Time
+
+	// InstructingAgent and InstructedAgent are BICs, they default to the agents of the original payment
+	InstructingAgent string
+	InstructedAgent  string
+}
+
+// NewPaymentStatusRequest creates a pacs.028 status request for every transaction of original
+func NewPaymentStatusRequest(req StatusRequest, original document.Iso20022Document) (document.Iso20022Document, error) {
+	return NewPaymentStatusRequestFromReferences(req, GetPaymentReferences(original))
+}
+
+// NewPaymentStatusRequestFromReferences creates a pacs.028 status request for the given references
+func NewPaymentStatusRequestFromReferences(req StatusRequest, refs []PaymentReference) (document.Iso20022Document, error) {
+	if len(refs) == 0 {
+		return nil, ErrNoTransactions
+	}
+
+	msg := &pacs_v04.FIToFIPaymentStatusRequestV04{
+		GrpHdr: pacs_v04.GroupHeader91{
+			MsgId:    common.Max35Text(req.MessageId),
+			CreDtTm:  common.ISODateTime(req.CreationDateTime),
+			InstgAgt: pacs028Agent(firstNonEmpty(req.InstructingAgent, refs[0].InstructingAgent)),
+			InstdAgt: pacs028Agent(firstNonEmpty(req.InstructedAgent, refs[0].InstructedAgent)),
+		},
+	}
+
+	for _, ref := range refs {
+		tx := pacs_v04.PaymentTransaction121{
+			OrgnlGrpInf: &pacs_v04.OriginalGroupInformation29{
+				OrgnlMsgId:   common.Max35Text(ref.MessageId),
+				OrgnlMsgNmId: common.Max35Text(ref.MessageNameId),
+			},
+			OrgnlInstrId:    optionalMax35Text(ref.InstructionId),
+			OrgnlEndToEndId: optionalMax35Text(ref.EndToEndId),
+			OrgnlTxId:       optionalMax35Text(ref.TransactionId),
+			ClrSysRef:       optionalMax35Text(ref.ClearingSystemReference),
+		}
+		if ref.CreationDateTime != "" {
+			tx.OrgnlGrpInf.OrgnlCreDtTm = new(common.ISODateTime)
+			if err := tx.OrgnlGrpInf.OrgnlCreDtTm.UnmarshalText([]byte(ref.CreationDateTime)); err != nil {
+				return nil, err
+			}
+		}
+		if ref.UETR != "" {
+			uetr := common.UUIDv4Identifier(ref.UETR)
+			tx.OrgnlUETR = &uetr
+		}
+		if ref.AcceptanceDateTime != "" {
+			tx.AccptncDtTm = new(common.ISODateTime)
+			if err := tx.AccptncDtTm.UnmarshalText([]byte(ref.AcceptanceDateTime)); err != nil {
+				return nil, err
+			}
+		}
+		if ref.Currency != "" || ref.SettlementDate != "" {
+			tx.OrgnlTxRef = &pacs_v04.OriginalTransactionReference31{}
+			if ref.Currency != "" {
+				tx.OrgnlTxRef.IntrBkSttlmAmt = &pacs_v04.ActiveOrHistoricCurrencyAndAmount{
+					Value: ref.Amount,
+					Ccy:   common.ActiveOrHistoricCurrencyCode(ref.Currency),
+				}
+			}
+			if ref.SettlementDate != "" {
+				tx.OrgnlTxRef.IntrBkSttlmDt = new(common.ISODate)
+				if err := tx.OrgnlTxRef.IntrBkSttlmDt.UnmarshalText([]byte(ref.SettlementDate)); err != nil {
+					return nil, err
+				}
+			}
+		}
+		msg.TxInf = append(msg.TxInf, tx)
+	}
+
+	doc := newDocument(utils.DocumentPacs02800104NameSpace, msg)
+	if err := doc.Validate(); err != nil {
+		return nil, err
+	}
+	return doc, nil
+}
+
+func pacs028Agent(bic string) *pacs_v04.BranchAndFinancialInstitutionIdentification6 {
+	if bic == "" {
+		return nil
+	}
+	bicfi := common.BICFIDec2014Identifier(bic)
+	return &pacs_v04.BranchAndFinancialInstitutionIdentification6{
+		FinInstnId: pacs_v04.FinancialInstitutionIdentification18{BICFI: &bicfi},
+	}
+}
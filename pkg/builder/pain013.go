@@ -0,0 +1,295 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package builder
+
+import (
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/moov-io/iso20022/pkg/common"
+	"github.com/moov-io/iso20022/pkg/document"
+	"github.com/moov-io/iso20022/pkg/pain_v07"
+	"github.com/moov-io/iso20022/pkg/utils"
+)
+
+// Status codes of a request to pay status report
+const (
+	RequestToPayStatusAccepted          = "ACCP"
+	RequestToPayStatusPending           = "PDNG"
+	RequestToPayStatusRejected          = "RJCT"
+	RequestToPayStatusAcceptedTechnical = "ACTC"
+)
+
+var (
+	// ErrEndToEndIdOmitted is returned when a request to pay has no end to end identification
+	ErrEndToEndIdOmitted = errors.New("end to end identification is omitted")
+)
+
+// RequestToPay describes a pain.013 creditor payment activation request, asking a debtor to
+// initiate a single credit transfer
+type RequestToPay struct {
+	MessageId            string
+	CreationDateTime     time.Time
+	PaymentInformationId string `json:",omitempty"`
+
+	// EndToEndId and UETR are carried by the credit transfer paying the request
+	EndToEndId    string
+	InstructionId string `json:",omitempty"`
+	UETR          string `json:",omitempty"`
+
+	Amount   float64
+	Currency string
+
+	RequestedExecutionDate time.Time
+	ExpiryDateTime         time.Time `json:",omitempty"`
+
+	// Debtor and Creditor are names, DebtorAgent and CreditorAgent are BICs
+	Debtor          string
+	DebtorAccount   string `json:",omitempty"`
+	DebtorAgent     string
+	Creditor        string
+	CreditorAccount string
+	CreditorAgent   string
+
+	RemittanceInformation string `json:",omitempty"`
+}
+
+// NewRequestToPay creates a pain.013 creditor payment activation request
+func NewRequestToPay(req RequestToPay) (document.Iso20022Document, error) {
+	if req.Amount <= 0 {
+		return nil, ErrInvalidTransferAmount
+	}
+	if req.EndToEndId == "" {
+		return nil, ErrEndToEndIdOmitted
+	}
+
+	tx := pain_v07.CreditTransferTransaction35{
+		PmtId: pain_v07.PaymentIdentification6{
+			InstrId:    optionalMax35Text(req.InstructionId),
+			EndToEndId: common.Max35Text(req.EndToEndId),
+		},
+		Amt: pain_v07.AmountType4Choice{
+			InstdAmt: &pain_v07.ActiveOrHistoricCurrencyAndAmount{
+				Value: req.Amount,
+				Ccy:   pain_v07.ActiveOrHistoricCurrencyCode(req.Currency),
+			},
+		},
+		ChrgBr:   "SLEV",
+		CdtrAgt:  pain013Agent(req.CreditorAgent),
+		Cdtr:     pain013Party(req.Creditor),
+		CdtrAcct: pain013Account(req.CreditorAccount),
+	}
+	if req.UETR != "" {
+		uetr := common.UUIDv4Identifier(req.UETR)
+		tx.PmtId.UETR = &uetr
+	}
+	if req.RemittanceInformation != "" {
+		tx.RmtInf = &pain_v07.RemittanceInformation16{Ustrd: []common.Max140Text{common.Max140Text(req.RemittanceInformation)}}
+	}
+
+	execution := common.ISODate(req.RequestedExecutionDate)
+	pmtInf := pain_v07.PaymentInstruction31{
+		PmtInfId:    optionalMax35Text(firstNonEmpty(req.PaymentInformationId, req.MessageId)),
+		PmtMtd:      "TRF",
+		ReqdExctnDt: pain_v07.DateAndDateTime2Choice{Dt: &execution},
+		Dbtr:        pain013Party(req.Debtor),
+		DbtrAgt:     pain013Agent(req.DebtorAgent),
+		CdtTrfTx:    []pain_v07.CreditTransferTransaction35{tx},
+	}
+	if req.DebtorAccount != "" {
+		pmtInf.DbtrAcct = pain013Account(req.DebtorAccount)
+	}
+	if !req.ExpiryDateTime.IsZero() {
+		pmtInf.XpryDt = &pain_v07.DateAndDateTime2Choice{DtTm: optionalDateTime(req.ExpiryDateTime)}
+	}
+
+	msg := &pain_v07.CreditorPaymentActivationRequestV07{
+		GrpHdr: pain_v07.GroupHeader78{
+			MsgId:    common.Max35Text(req.MessageId),
+			CreDtTm:  common.ISODateTime(req.CreationDateTime),
+			NbOfTxs:  "1",
+			CtrlSum:  req.Amount,
+			InitgPty: pain013Party(req.Creditor),
+		},
+		PmtInf: []pain_v07.PaymentInstruction31{pmtInf},
+	}
+	doc := newDocument(utils.DocumentPain01300107NameSpace, msg)
+	if err := doc.Validate(); err != nil {
+		return nil, err
+	}
+	return doc, nil
+}
+
+// RequestToPayStatus describes a pain.014 status report of a request to pay
+type RequestToPayStatus struct {
+	MessageId        string
+	CreationDateTime time.Time
+
+	// InitiatingParty is the name of the reporting party, the debtor of the original request by default
+	InitiatingParty string `json:",omitempty"`
+
+	StatusCode string
+
+	// ReasonCode is an external status reason code (e.g. AC04), reported with rejections
+	ReasonCode string `json:",omitempty"`
+}
+
+// NewRequestToPayStatus creates a pain.014 status report with the status of every
+// transaction of original, a pain.013 request to pay of any version
+func NewRequestToPayStatus(sts RequestToPayStatus, original document.Iso20022Document) (document.Iso20022Document, error) {
+	msg := original.InspectMessage()
+	origId := firstValue(utils.FindElementValues(msg, "GrpHdr/MsgId"))
+	if origId == "" {
+		return nil, ErrOriginalMessageIdOmitted
+	}
+
+	status := pain_v07.ExternalPaymentGroupStatus1Code(sts.StatusCode)
+	txStatus := pain_v07.ExternalPaymentTransactionStatus1Code(sts.StatusCode)
+	report := &pain_v07.CreditorPaymentActivationRequestStatusReportV07{
+		GrpHdr: pain_v07.GroupHeader87{
+			MsgId:    common.Max35Text(sts.MessageId),
+			CreDtTm:  common.ISODateTime(sts.CreationDateTime),
+			InitgPty: pain013Party(firstNonEmpty(sts.InitiatingParty, firstValue(utils.FindElementValues(msg, "PmtInf/Dbtr/Nm")))),
+		},
+		OrgnlGrpInfAndSts: pain_v07.OriginalGroupInformation30{
+			OrgnlMsgId:   common.Max35Text(origId),
+			OrgnlMsgNmId: common.Max35Text(utils.GetMessageType(original.NameSpace())),
+			GrpSts:       &status,
+		},
+	}
+	if nbOfTxs := firstValue(utils.FindElementValues(msg, "GrpHdr/NbOfTxs")); nbOfTxs != "" {
+		value := common.Max15NumericText(nbOfTxs)
+		report.OrgnlGrpInfAndSts.OrgnlNbOfTxs = &value
+	}
+	if created := firstValue(utils.FindElementValues(msg, "GrpHdr/CreDtTm")); created != "" {
+		report.OrgnlGrpInfAndSts.OrgnlCreDtTm = new(common.ISODateTime)
+		if err := report.OrgnlGrpInfAndSts.OrgnlCreDtTm.UnmarshalText([]byte(created)); err != nil {
+			return nil, err
+		}
+	}
+
+	instructions := make(map[string]int)
+	for _, tx := range utils.GetTransactions(msg) {
+		if !utils.MatchElementPath(tx.Path, "PmtInf/CdtTrfTx") {
+			continue
+		}
+		pmtInfId := firstNonEmpty(tx.Lookup("PmtInf/PmtInfId"), origId)
+		idx, exists := instructions[pmtInfId]
+		if !exists {
+			idx = len(report.OrgnlPmtInfAndSts)
+			instructions[pmtInfId] = idx
+			report.OrgnlPmtInfAndSts = append(report.OrgnlPmtInfAndSts, pain_v07.OriginalPaymentInstruction31{
+				OrgnlPmtInfId: common.Max35Text(pmtInfId),
+				PmtInfSts:     &status,
+			})
+		}
+
+		txSts := pain_v07.PaymentTransaction104{
+			OrgnlInstrId:    optionalMax35Text(tx.Lookup("CdtTrfTx/PmtId/InstrId")),
+			OrgnlEndToEndId: optionalMax35Text(tx.Lookup("CdtTrfTx/PmtId/EndToEndId")),
+			TxSts:           &txStatus,
+		}
+		if uetr := tx.Lookup("CdtTrfTx/PmtId/UETR"); uetr != "" {
+			value := common.UUIDv4Identifier(uetr)
+			txSts.OrgnlUETR = &value
+		}
+		if sts.ReasonCode != "" {
+			reason := pain_v07.ExternalStatusReason1Code(sts.ReasonCode)
+			txSts.StsRsnInf = []pain_v07.StatusReasonInformation12{{Rsn: &pain_v07.StatusReason6Choice{Cd: &reason}}}
+		}
+		report.OrgnlPmtInfAndSts[idx].TxInfAndSts = append(report.OrgnlPmtInfAndSts[idx].TxInfAndSts, txSts)
+	}
+	if len(report.OrgnlPmtInfAndSts) == 0 {
+		return nil, ErrNoTransactions
+	}
+
+	doc := newDocument(utils.DocumentPain01400107NameSpace, report)
+	if err := doc.Validate(); err != nil {
+		return nil, err
+	}
+	return doc, nil
+}
+
+// RequestToPayLink links a transaction of a request to pay to the credit transfer paying it
+type RequestToPayLink struct {
+	Request PaymentReference
+
+	// Payment is the transaction of a pacs.008 carrying the UETR or end to end identification
+	// of the request, nil while the request is unpaid
+	Payment *PaymentReference `json:",omitempty"`
+}
+
+// Paid reports whether the request is paid in full in its currency
+func (l RequestToPayLink) Paid() bool {
+	return l.Payment != nil && l.Payment.Currency == l.Request.Currency && l.Payment.Amount >= l.Request.Amount
+}
+
+// LinkRequestToPay links every transaction of request, a pain.013, to the transactions of
+// payments sharing its UETR, or its end to end identification when either has no UETR
+func LinkRequestToPay(request document.Iso20022Document, payments ...document.Iso20022Document) []RequestToPayLink {
+	var refs []PaymentReference
+	for _, payment := range payments {
+		refs = append(refs, GetPaymentReferences(payment)...)
+	}
+
+	var links []RequestToPayLink
+	for _, tx := range utils.GetTransactions(request.InspectMessage()) {
+		if !utils.MatchElementPath(tx.Path, "PmtInf/CdtTrfTx") {
+			continue
+		}
+		amount, _ := strconv.ParseFloat(tx.Lookup("Amt/InstdAmt"), 64)
+		link := RequestToPayLink{Request: PaymentReference{
+			MessageId:        tx.Lookup("GrpHdr/MsgId"),
+			MessageNameId:    utils.GetMessageType(request.NameSpace()),
+			CreationDateTime: tx.Lookup("GrpHdr/CreDtTm"),
+			InstructionId:    tx.Lookup("PmtId/InstrId"),
+			EndToEndId:       tx.Lookup("PmtId/EndToEndId"),
+			UETR:             tx.Lookup("PmtId/UETR"),
+			Amount:           amount,
+			Currency:         tx.Lookup("Amt/InstdAmt/@Ccy"),
+		}}
+		for i := range refs {
+			ref := refs[i]
+			if ref.UETR != "" && link.Request.UETR != "" {
+				if ref.UETR != link.Request.UETR {
+					continue
+				}
+			} else if ref.EndToEndId == "" || ref.EndToEndId != link.Request.EndToEndId {
+				continue
+			}
+			link.Payment = &ref
+			break
+		}
+		links = append(links, link)
+	}
+	return links
+}
+
+func pain013Party(name string) pain_v07.PartyIdentification135 {
+	if name == "" {
+		return pain_v07.PartyIdentification135{}
+	}
+	nm := common.Max140Text(name)
+	return pain_v07.PartyIdentification135{Nm: &nm}
+}
+
+func pain013Agent(bic string) pain_v07.BranchAndFinancialInstitutionIdentification6 {
+	if bic == "" {
+		return pain_v07.BranchAndFinancialInstitutionIdentification6{}
+	}
+	bicfi := common.BICFIDec2014Identifier(bic)
+	return pain_v07.BranchAndFinancialInstitutionIdentification6{
+		FinInstnId: pain_v07.FinancialInstitutionIdentification18{BICFI: &bicfi},
+	}
+}
+
+func pain013Account(id string) *pain_v07.CashAccount38 {
+	return &pain_v07.CashAccount38{
+		Id: pain_v07.AccountIdentification4Choice{
+			Othr: &pain_v07.GenericAccountIdentification1{Id: common.Max34Text(id)},
+		},
+	}
+}
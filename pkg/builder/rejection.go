@@ -0,0 +1,378 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package builder
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/moov-io/iso20022/pkg/common"
+	"github.com/moov-io/iso20022/pkg/document"
+	"github.com/moov-io/iso20022/pkg/messagetype"
+	"github.com/moov-io/iso20022/pkg/pacs_v10"
+	"github.com/moov-io/iso20022/pkg/pain_v11"
+	"github.com/moov-io/iso20022/pkg/reasons"
+	"github.com/moov-io/iso20022/pkg/report"
+	"github.com/moov-io/iso20022/pkg/utils"
+)
+
+var (
+	// ErrNoRejection is returned when no status report rejects the message of the original document
+	ErrNoRejection = errors.New("no rejection of message")
+)
+
+const (
+	// RejectedStatus is the status of rejected groups and transactions
+	RejectedStatus = "RJCT"
+
+	// notProvided replaces original references that can't be reported
+	notProvided = "NOTPROVIDED"
+)
+
+// RejectionReason maps validation errors to an external status reason code
+type RejectionReason struct {
+	// Contains is a part of the messages of the validation errors, e.g. a type name
+	Contains string
+	Code     string
+}
+
+var (
+	// RejectionReasons map validation errors to reason codes, the first reason whose text is
+	// contained by the message of an error gives its code
+	RejectionReasons = []RejectionReason{
+		{Contains: "CurrencyCode", Code: "AM03"},
+		{Contains: "IBAN2007Identifier", Code: "AC01"},
+		{Contains: "BICFIDec2014Identifier", Code: "RC01"},
+		{Contains: "AnyBICDec2014Identifier", Code: "RC01"},
+		{Contains: "ISODate", Code: "DT01"},
+		{Contains: "is unknown", Code: "CH17"},
+		{Contains: "namespace", Code: "FF01"},
+		{Contains: "type of file", Code: "FF01"},
+		{Contains: "invalid length", Code: "CH16"},
+		{Contains: "is invalid", Code: "CH16"},
+	}
+
+	// DefaultRejectionReason is the reason code of validation errors no reason maps
+	DefaultRejectionReason = "FF01"
+)
+
+// Rejection describes the negative status report of a payment failing validation, a pacs.002
+// for a pacs.008 and a pain.002 for a pain.001
+type Rejection struct {
+	MessageId        string
+	CreationDateTime time.Time
+
+	// InstructingAgent and InstructedAgent are the BICs of a pacs.002, they default to the
+	// instructed and instructing agents of the original payment
+	InstructingAgent string `json:",omitempty"`
+	InstructedAgent  string `json:",omitempty"`
+
+	// InitiatingParty is the name of the party reporting a pain.002
+	InitiatingParty string `json:",omitempty"`
+
+	// ReasonScheme is the scheme of the reason codes translated by the default reason table,
+	// the ISO external codes when empty
+	ReasonScheme reasons.Scheme `json:",omitempty"`
+}
+
+// RejectionReasonCode returns the reason code of a validation error message
+func RejectionReasonCode(message string) string {
+	// messages end with the types enclosing the invalid value
+	if i := strings.Index(message, " ("); i > 0 {
+		message = message[:i]
+	}
+	for _, reason := range RejectionReasons {
+		if strings.Contains(message, reason.Contains) {
+			return reason.Code
+		}
+	}
+	return DefaultRejectionReason
+}
+
+// NewRejection creates the status report rejecting original, a pacs.008 or pain.001 of any
+// version failing validation with err. The group is rejected with the reason of err and every
+// transaction with the reasons of its invalid elements, or the reason of err when it has none.
+func NewRejection(rej Rejection, original document.Iso20022Document, err error) (document.Iso20022Document, error) {
+	if err == nil {
+		return nil, errors.New("original document is valid")
+	}
+	rejected := newRejected(original, err)
+	messageType := messagetype.Type(utils.GetMessageType(original.NameSpace()))
+	var doc document.Iso20022Document
+	switch messageType.Message() {
+	case "pacs.008":
+		doc, err = newPacs002Rejection(rej, rejected)
+	case "pain.001":
+		doc, err = newPain002Rejection(rej, rejected)
+	default:
+		return nil, fmt.Errorf("%w %s", ErrNoRejection, messageType)
+	}
+	if err != nil || rej.ReasonScheme == "" || rej.ReasonScheme == reasons.SchemeISO {
+		return doc, err
+	}
+	table, err := reasons.GetTable(reasons.DefaultTableName)
+	if err != nil {
+		return nil, err
+	}
+	if err := table.TranslateDocument(doc, reasons.SchemeISO, rej.ReasonScheme); err != nil {
+		return nil, err
+	}
+	return doc, nil
+}
+
+// rejected holds the references and reasons of a rejected document
+type rejected struct {
+	messageId        string
+	messageNameId    string
+	creationDateTime string
+	numberOfTxs      string
+	reason           statusReason
+	transactions     []rejectedTransaction
+}
+
+type rejectedTransaction struct {
+	utils.Transaction
+	reasons []statusReason
+}
+
+type statusReason struct {
+	code string
+	info []string
+}
+
+func newRejected(original document.Iso20022Document, err error) rejected {
+	msg := original.InspectMessage()
+	r := rejected{
+		messageId:        firstValue(utils.FindElementValues(msg, "GrpHdr/MsgId")),
+		messageNameId:    utils.GetMessageType(original.NameSpace()),
+		creationDateTime: firstValue(utils.FindElementValues(msg, "GrpHdr/CreDtTm")),
+		numberOfTxs:      firstValue(utils.FindElementValues(msg, "GrpHdr/NbOfTxs")),
+		reason:           statusReason{code: RejectionReasonCode(err.Error()), info: additionalInfo(err.Error())},
+	}
+
+	findings := report.New("", nil, original, err).Findings
+	for _, tx := range utils.GetTransactions(msg) {
+		if tx.Path == "" {
+			continue
+		}
+		rtx := rejectedTransaction{Transaction: tx}
+		codes := make(map[string]int)
+		for _, finding := range findings {
+			if !strings.HasPrefix(finding.Path, tx.Path+"/") {
+				continue
+			}
+			code := RejectionReasonCode(finding.Message)
+			info := additionalInfo(strings.TrimPrefix(finding.Path, tx.Path+"/") + ": " + finding.Message)
+			if i, exists := codes[code]; exists {
+				rtx.reasons[i].info = append(rtx.reasons[i].info, info...)
+				continue
+			}
+			codes[code] = len(rtx.reasons)
+			rtx.reasons = append(rtx.reasons, statusReason{code: code, info: info})
+		}
+		if len(rtx.reasons) == 0 {
+			rtx.reasons = []statusReason{r.reason}
+		}
+		r.transactions = append(r.transactions, rtx)
+	}
+	return r
+}
+
+func newPacs002Rejection(rej Rejection, r rejected) (document.Iso20022Document, error) {
+	groupStatus := pacs_v10.ExternalPaymentGroupStatus1Code(RejectedStatus)
+	txStatus := pacs_v10.ExternalPaymentTransactionStatus1Code(RejectedStatus)
+
+	var instructing, instructed string
+	if len(r.transactions) > 0 {
+		instructing = r.transactions[0].Lookup("InstdAgt/FinInstnId/BICFI")
+		instructed = r.transactions[0].Lookup("InstgAgt/FinInstnId/BICFI")
+	}
+	group := pacs_v10.OriginalGroupHeader17{
+		OrgnlMsgId:   reportedMax35Text(r.messageId),
+		OrgnlMsgNmId: common.Max35Text(r.messageNameId),
+		OrgnlCreDtTm: reportedDateTime(r.creationDateTime),
+		OrgnlNbOfTxs: reportedNumberOfTxs(r.numberOfTxs),
+		GrpSts:       &groupStatus,
+		StsRsnInf:    []pacs_v10.StatusReasonInformation12{pacs002Reason(r.reason)},
+	}
+	msg := &pacs_v10.FIToFIPaymentStatusReportV10{
+		GrpHdr: pacs_v10.GroupHeader91{
+			MsgId:    common.Max35Text(rej.MessageId),
+			CreDtTm:  common.ISODateTime(rej.CreationDateTime),
+			InstgAgt: pacs002Agent(firstNonEmpty(rej.InstructingAgent, instructing)),
+			InstdAgt: pacs002Agent(firstNonEmpty(rej.InstructedAgent, instructed)),
+		},
+		OrgnlGrpInfAndSts: []pacs_v10.OriginalGroupHeader17{group},
+	}
+	for _, tx := range r.transactions {
+		txSts := pacs_v10.PaymentTransaction110{
+			OrgnlInstrId:    optionalReportedMax35Text(tx.Lookup("PmtId/InstrId")),
+			OrgnlEndToEndId: optionalReportedMax35Text(tx.Lookup("PmtId/EndToEndId")),
+			OrgnlTxId:       optionalReportedMax35Text(tx.Lookup("PmtId/TxId")),
+			OrgnlUETR:       reportedUETR(tx.Lookup("PmtId/UETR")),
+			TxSts:           &txStatus,
+		}
+		for _, reason := range tx.reasons {
+			txSts.StsRsnInf = append(txSts.StsRsnInf, pacs002Reason(reason))
+		}
+		msg.TxInfAndSts = append(msg.TxInfAndSts, txSts)
+	}
+
+	doc := newDocument(utils.DocumentPacs00200110NameSpace, msg)
+	if err := doc.Validate(); err != nil {
+		return nil, err
+	}
+	return doc, nil
+}
+
+func pacs002Reason(reason statusReason) pacs_v10.StatusReasonInformation12 {
+	code := pacs_v10.ExternalStatusReason1Code(reason.code)
+	info := pacs_v10.StatusReasonInformation12{Rsn: &pacs_v10.StatusReason6Choice{Cd: &code}}
+	for _, text := range reason.info {
+		info.AddtlInf = append(info.AddtlInf, common.Max105Text(text))
+	}
+	return info
+}
+
+func pacs002Agent(bic string) *pacs_v10.BranchAndFinancialInstitutionIdentification6 {
+	bicfi := common.BICFIDec2014Identifier(bic)
+	if bic == "" || bicfi.Validate() != nil {
+		return nil
+	}
+	return &pacs_v10.BranchAndFinancialInstitutionIdentification6{
+		FinInstnId: pacs_v10.FinancialInstitutionIdentification18{BICFI: &bicfi},
+	}
+}
+
+func newPain002Rejection(rej Rejection, r rejected) (document.Iso20022Document, error) {
+	groupStatus := pain_v11.ExternalPaymentGroupStatus1Code(RejectedStatus)
+	txStatus := pain_v11.ExternalPaymentTransactionStatus1Code(RejectedStatus)
+
+	msg := &pain_v11.CustomerPaymentStatusReportV11{
+		GrpHdr: pain_v11.GroupHeader86{
+			MsgId:   common.Max35Text(rej.MessageId),
+			CreDtTm: common.ISODateTime(rej.CreationDateTime),
+		},
+		OrgnlGrpInfAndSts: pain_v11.OriginalGroupHeader17{
+			OrgnlMsgId:   reportedMax35Text(r.messageId),
+			OrgnlMsgNmId: common.Max35Text(r.messageNameId),
+			OrgnlCreDtTm: reportedDateTime(r.creationDateTime),
+			OrgnlNbOfTxs: reportedNumberOfTxs(r.numberOfTxs),
+			GrpSts:       &groupStatus,
+			StsRsnInf:    []pain_v11.StatusReasonInformation12{pain002Reason(r.reason)},
+		},
+	}
+	if rej.InitiatingParty != "" {
+		name := common.Max140Text(rej.InitiatingParty)
+		msg.GrpHdr.InitgPty = &pain_v11.PartyIdentification135{Nm: &name}
+	}
+
+	instructions := make(map[string]int)
+	for _, tx := range r.transactions {
+		pmtInfId := reportedMax35Text(tx.Lookup("PmtInf/PmtInfId"))
+		idx, exists := instructions[string(pmtInfId)]
+		if !exists {
+			idx = len(msg.OrgnlPmtInfAndSts)
+			instructions[string(pmtInfId)] = idx
+			msg.OrgnlPmtInfAndSts = append(msg.OrgnlPmtInfAndSts, pain_v11.OriginalPaymentInstruction38{
+				OrgnlPmtInfId: pmtInfId,
+				PmtInfSts:     &groupStatus,
+			})
+		}
+
+		txSts := pain_v11.PaymentTransaction126{
+			OrgnlInstrId:    optionalReportedMax35Text(tx.Lookup("CdtTrfTxInf/PmtId/InstrId")),
+			OrgnlEndToEndId: optionalReportedMax35Text(tx.Lookup("CdtTrfTxInf/PmtId/EndToEndId")),
+			OrgnlUETR:       reportedUETR(tx.Lookup("CdtTrfTxInf/PmtId/UETR")),
+			TxSts:           &txStatus,
+		}
+		for _, reason := range tx.reasons {
+			txSts.StsRsnInf = append(txSts.StsRsnInf, pain002Reason(reason))
+		}
+		msg.OrgnlPmtInfAndSts[idx].TxInfAndSts = append(msg.OrgnlPmtInfAndSts[idx].TxInfAndSts, txSts)
+	}
+
+	doc := newDocument(utils.DocumentPain00200111NameSpace, msg)
+	if err := doc.Validate(); err != nil {
+		return nil, err
+	}
+	return doc, nil
+}
+
+func pain002Reason(reason statusReason) pain_v11.StatusReasonInformation12 {
+	code := pain_v11.ExternalStatusReason1Code(reason.code)
+	info := pain_v11.StatusReasonInformation12{Rsn: &pain_v11.StatusReason6Choice{Cd: &code}}
+	for _, text := range reason.info {
+		info.AddtlInf = append(info.AddtlInf, common.Max105Text(text))
+	}
+	return info
+}
+
+// additionalInfo splits text into the lines of additional information, up to 105 bytes each
+func additionalInfo(text string) []string {
+	var lines []string
+	for text != "" {
+		n := 0
+		for n < len(text) {
+			_, size := utf8.DecodeRuneInString(text[n:])
+			if n+size > 105 {
+				break
+			}
+			n += size
+		}
+		lines = append(lines, text[:n])
+		text = text[n:]
+	}
+	return lines
+}
+
+// the references of the original document are invalid when they failed its validation, they
+// are reported when they're valid
+
+func reportedMax35Text(text string) common.Max35Text {
+	value := common.Max35Text(text)
+	if value.Validate() != nil {
+		return notProvided
+	}
+	return value
+}
+
+func optionalReportedMax35Text(text string) *common.Max35Text {
+	value := common.Max35Text(text)
+	if text == "" || value.Validate() != nil {
+		return nil
+	}
+	return &value
+}
+
+func reportedDateTime(text string) *common.ISODateTime {
+	if text == "" {
+		return nil
+	}
+	value := new(common.ISODateTime)
+	if value.UnmarshalText([]byte(text)) != nil {
+		return nil
+	}
+	return value
+}
+
+func reportedNumberOfTxs(text string) *common.Max15NumericText {
+	value := common.Max15NumericText(text)
+	if text == "" || value.Validate() != nil {
+		return nil
+	}
+	return &value
+}
+
+func reportedUETR(text string) *common.UUIDv4Identifier {
+	value := common.UUIDv4Identifier(text)
+	if text == "" || value.Validate() != nil {
+		return nil
+	}
+	return &value
+}
@@ -0,0 +1,1071 @@
+// Code generated by deepcopy-gen. DO NOT EDIT.
+
+package camt_v01
+
+import (
+	"github.com/moov-io/iso20022/pkg/common"
+)
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *AccountIdentification4Choice) DeepCopy() *AccountIdentification4Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(AccountIdentification4Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *AccountIdentification4Choice) DeepCopyInto(out *AccountIdentification4Choice) {
+	*out = *r
+	r.Othr.DeepCopyInto(&out.Othr)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *AccountSchemeName1Choice) DeepCopy() *AccountSchemeName1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(AccountSchemeName1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *AccountSchemeName1Choice) DeepCopyInto(out *AccountSchemeName1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ActiveCurrencyAndAmount) DeepCopy() *ActiveCurrencyAndAmount {
+	if r == nil {
+		return nil
+	}
+	out := new(ActiveCurrencyAndAmount)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ActiveCurrencyAndAmount) DeepCopyInto(out *ActiveCurrencyAndAmount) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *AddressType3Choice) DeepCopy() *AddressType3Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(AddressType3Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *AddressType3Choice) DeepCopyInto(out *AddressType3Choice) {
+	*out = *r
+	r.Prtry.DeepCopyInto(&out.Prtry)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *Amount2Choice) DeepCopy() *Amount2Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(Amount2Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *Amount2Choice) DeepCopyInto(out *Amount2Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *BranchAndFinancialInstitutionIdentification6) DeepCopy() *BranchAndFinancialInstitutionIdentification6 {
+	if r == nil {
+		return nil
+	}
+	out := new(BranchAndFinancialInstitutionIdentification6)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *BranchAndFinancialInstitutionIdentification6) DeepCopyInto(out *BranchAndFinancialInstitutionIdentification6) {
+	*out = *r
+	r.FinInstnId.DeepCopyInto(&out.FinInstnId)
+	if r.BrnchId != nil {
+		out.BrnchId = new(BranchData3)
+		r.BrnchId.DeepCopyInto(out.BrnchId)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *BranchData3) DeepCopy() *BranchData3 {
+	if r == nil {
+		return nil
+	}
+	out := new(BranchData3)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *BranchData3) DeepCopyInto(out *BranchData3) {
+	*out = *r
+	if r.Id != nil {
+		out.Id = new(common.Max35Text)
+		*out.Id = *r.Id
+	}
+	if r.LEI != nil {
+		out.LEI = new(common.LEIIdentifier)
+		*out.LEI = *r.LEI
+	}
+	if r.Nm != nil {
+		out.Nm = new(common.Max140Text)
+		*out.Nm = *r.Nm
+	}
+	if r.PstlAdr != nil {
+		out.PstlAdr = new(PostalAddress24)
+		r.PstlAdr.DeepCopyInto(out.PstlAdr)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CashAccount38) DeepCopy() *CashAccount38 {
+	if r == nil {
+		return nil
+	}
+	out := new(CashAccount38)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CashAccount38) DeepCopyInto(out *CashAccount38) {
+	*out = *r
+	r.Id.DeepCopyInto(&out.Id)
+	if r.Tp != nil {
+		out.Tp = new(CashAccountType2Choice)
+		*out.Tp = *r.Tp
+	}
+	if r.Ccy != nil {
+		out.Ccy = new(common.ActiveOrHistoricCurrencyCode)
+		*out.Ccy = *r.Ccy
+	}
+	if r.Nm != nil {
+		out.Nm = new(common.Max70Text)
+		*out.Nm = *r.Nm
+	}
+	if r.Prxy != nil {
+		out.Prxy = new(ProxyAccountIdentification1)
+		r.Prxy.DeepCopyInto(out.Prxy)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CashAccountType2Choice) DeepCopy() *CashAccountType2Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(CashAccountType2Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CashAccountType2Choice) DeepCopyInto(out *CashAccountType2Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ClearingSystemIdentification2Choice) DeepCopy() *ClearingSystemIdentification2Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(ClearingSystemIdentification2Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ClearingSystemIdentification2Choice) DeepCopyInto(out *ClearingSystemIdentification2Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ClearingSystemMemberIdentification2) DeepCopy() *ClearingSystemMemberIdentification2 {
+	if r == nil {
+		return nil
+	}
+	out := new(ClearingSystemMemberIdentification2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ClearingSystemMemberIdentification2) DeepCopyInto(out *ClearingSystemMemberIdentification2) {
+	*out = *r
+	if r.ClrSysId != nil {
+		out.ClrSysId = new(ClearingSystemIdentification2Choice)
+		*out.ClrSysId = *r.ClrSysId
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CommunicationAddress8) DeepCopy() *CommunicationAddress8 {
+	if r == nil {
+		return nil
+	}
+	out := new(CommunicationAddress8)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CommunicationAddress8) DeepCopyInto(out *CommunicationAddress8) {
+	*out = *r
+	r.PstlAdr.DeepCopyInto(&out.PstlAdr)
+	if r.FaxNb != nil {
+		out.FaxNb = new(common.PhoneNumber)
+		*out.FaxNb = *r.FaxNb
+	}
+	if r.EmailAdr != nil {
+		out.EmailAdr = new(common.Max256Text)
+		*out.EmailAdr = *r.EmailAdr
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ContactIdentificationAndAddress1) DeepCopy() *ContactIdentificationAndAddress1 {
+	if r == nil {
+		return nil
+	}
+	out := new(ContactIdentificationAndAddress1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ContactIdentificationAndAddress1) DeepCopyInto(out *ContactIdentificationAndAddress1) {
+	*out = *r
+	if r.Nm != nil {
+		out.Nm = new(common.Max35Text)
+		*out.Nm = *r.Nm
+	}
+	r.ComAdr.DeepCopyInto(&out.ComAdr)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CreateLimitV01) DeepCopy() *CreateLimitV01 {
+	if r == nil {
+		return nil
+	}
+	out := new(CreateLimitV01)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CreateLimitV01) DeepCopyInto(out *CreateLimitV01) {
+	*out = *r
+	r.MsgHdr.DeepCopyInto(&out.MsgHdr)
+	if r.LmtData != nil {
+		out.LmtData = make([]LimitStructure4, len(r.LmtData))
+		for i := range r.LmtData {
+			r.LmtData[i].DeepCopyInto(&out.LmtData[i])
+		}
+	}
+	if r.SplmtryData != nil {
+		out.SplmtryData = make([]SupplementaryData1, len(r.SplmtryData))
+		for i := range r.SplmtryData {
+			r.SplmtryData[i].DeepCopyInto(&out.SplmtryData[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CreateMemberV01) DeepCopy() *CreateMemberV01 {
+	if r == nil {
+		return nil
+	}
+	out := new(CreateMemberV01)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CreateMemberV01) DeepCopyInto(out *CreateMemberV01) {
+	*out = *r
+	r.MsgHdr.DeepCopyInto(&out.MsgHdr)
+	r.MmbId.DeepCopyInto(&out.MmbId)
+	r.ValSet.DeepCopyInto(&out.ValSet)
+	if r.SplmtryData != nil {
+		out.SplmtryData = make([]SupplementaryData1, len(r.SplmtryData))
+		for i := range r.SplmtryData {
+			r.SplmtryData[i].DeepCopyInto(&out.SplmtryData[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CreateReservationV01) DeepCopy() *CreateReservationV01 {
+	if r == nil {
+		return nil
+	}
+	out := new(CreateReservationV01)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CreateReservationV01) DeepCopyInto(out *CreateReservationV01) {
+	*out = *r
+	r.MsgHdr.DeepCopyInto(&out.MsgHdr)
+	r.RsvatnId.DeepCopyInto(&out.RsvatnId)
+	r.ValSet.DeepCopyInto(&out.ValSet)
+	if r.SplmtryData != nil {
+		out.SplmtryData = make([]SupplementaryData1, len(r.SplmtryData))
+		for i := range r.SplmtryData {
+			r.SplmtryData[i].DeepCopyInto(&out.SplmtryData[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CreateStandingOrderV01) DeepCopy() *CreateStandingOrderV01 {
+	if r == nil {
+		return nil
+	}
+	out := new(CreateStandingOrderV01)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CreateStandingOrderV01) DeepCopyInto(out *CreateStandingOrderV01) {
+	*out = *r
+	r.MsgHdr.DeepCopyInto(&out.MsgHdr)
+	r.StgOrdrId.DeepCopyInto(&out.StgOrdrId)
+	r.ValSet.DeepCopyInto(&out.ValSet)
+	if r.SplmtryData != nil {
+		out.SplmtryData = make([]SupplementaryData1, len(r.SplmtryData))
+		for i := range r.SplmtryData {
+			r.SplmtryData[i].DeepCopyInto(&out.SplmtryData[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *DateAndDateTime2Choice) DeepCopy() *DateAndDateTime2Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(DateAndDateTime2Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *DateAndDateTime2Choice) DeepCopyInto(out *DateAndDateTime2Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *DatePeriod2) DeepCopy() *DatePeriod2 {
+	if r == nil {
+		return nil
+	}
+	out := new(DatePeriod2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *DatePeriod2) DeepCopyInto(out *DatePeriod2) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *DatePeriod2Choice) DeepCopy() *DatePeriod2Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(DatePeriod2Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *DatePeriod2Choice) DeepCopyInto(out *DatePeriod2Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *EventType1Choice) DeepCopy() *EventType1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(EventType1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *EventType1Choice) DeepCopyInto(out *EventType1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ExecutionType1Choice) DeepCopy() *ExecutionType1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(ExecutionType1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ExecutionType1Choice) DeepCopyInto(out *ExecutionType1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *FinancialIdentificationSchemeName1Choice) DeepCopy() *FinancialIdentificationSchemeName1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(FinancialIdentificationSchemeName1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *FinancialIdentificationSchemeName1Choice) DeepCopyInto(out *FinancialIdentificationSchemeName1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *FinancialInstitutionIdentification18) DeepCopy() *FinancialInstitutionIdentification18 {
+	if r == nil {
+		return nil
+	}
+	out := new(FinancialInstitutionIdentification18)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *FinancialInstitutionIdentification18) DeepCopyInto(out *FinancialInstitutionIdentification18) {
+	*out = *r
+	if r.BICFI != nil {
+		out.BICFI = new(common.BICFIDec2014Identifier)
+		*out.BICFI = *r.BICFI
+	}
+	if r.ClrSysMmbId != nil {
+		out.ClrSysMmbId = new(ClearingSystemMemberIdentification2)
+		r.ClrSysMmbId.DeepCopyInto(out.ClrSysMmbId)
+	}
+	if r.LEI != nil {
+		out.LEI = new(common.LEIIdentifier)
+		*out.LEI = *r.LEI
+	}
+	if r.Nm != nil {
+		out.Nm = new(common.Max140Text)
+		*out.Nm = *r.Nm
+	}
+	if r.PstlAdr != nil {
+		out.PstlAdr = new(PostalAddress24)
+		r.PstlAdr.DeepCopyInto(out.PstlAdr)
+	}
+	if r.Othr != nil {
+		out.Othr = new(GenericFinancialIdentification1)
+		r.Othr.DeepCopyInto(out.Othr)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *GenericAccountIdentification1) DeepCopy() *GenericAccountIdentification1 {
+	if r == nil {
+		return nil
+	}
+	out := new(GenericAccountIdentification1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *GenericAccountIdentification1) DeepCopyInto(out *GenericAccountIdentification1) {
+	*out = *r
+	if r.SchmeNm != nil {
+		out.SchmeNm = new(AccountSchemeName1Choice)
+		*out.SchmeNm = *r.SchmeNm
+	}
+	if r.Issr != nil {
+		out.Issr = new(common.Max35Text)
+		*out.Issr = *r.Issr
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *GenericFinancialIdentification1) DeepCopy() *GenericFinancialIdentification1 {
+	if r == nil {
+		return nil
+	}
+	out := new(GenericFinancialIdentification1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *GenericFinancialIdentification1) DeepCopyInto(out *GenericFinancialIdentification1) {
+	*out = *r
+	if r.SchmeNm != nil {
+		out.SchmeNm = new(FinancialIdentificationSchemeName1Choice)
+		*out.SchmeNm = *r.SchmeNm
+	}
+	if r.Issr != nil {
+		out.Issr = new(common.Max35Text)
+		*out.Issr = *r.Issr
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *GenericIdentification30) DeepCopy() *GenericIdentification30 {
+	if r == nil {
+		return nil
+	}
+	out := new(GenericIdentification30)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *GenericIdentification30) DeepCopyInto(out *GenericIdentification30) {
+	*out = *r
+	if r.SchmeNm != nil {
+		out.SchmeNm = new(common.Max35Text)
+		*out.SchmeNm = *r.SchmeNm
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *LimitIdentification5) DeepCopy() *LimitIdentification5 {
+	if r == nil {
+		return nil
+	}
+	out := new(LimitIdentification5)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *LimitIdentification5) DeepCopyInto(out *LimitIdentification5) {
+	*out = *r
+	if r.SysId != nil {
+		out.SysId = new(SystemIdentification2Choice)
+		*out.SysId = *r.SysId
+	}
+	if r.BilLmtCtrPtyId != nil {
+		out.BilLmtCtrPtyId = new(BranchAndFinancialInstitutionIdentification6)
+		r.BilLmtCtrPtyId.DeepCopyInto(out.BilLmtCtrPtyId)
+	}
+	if r.AcctOwnr != nil {
+		out.AcctOwnr = new(BranchAndFinancialInstitutionIdentification6)
+		r.AcctOwnr.DeepCopyInto(out.AcctOwnr)
+	}
+	if r.AcctId != nil {
+		out.AcctId = new(AccountIdentification4Choice)
+		r.AcctId.DeepCopyInto(out.AcctId)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *LimitStructure4) DeepCopy() *LimitStructure4 {
+	if r == nil {
+		return nil
+	}
+	out := new(LimitStructure4)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *LimitStructure4) DeepCopyInto(out *LimitStructure4) {
+	*out = *r
+	r.LmtId.DeepCopyInto(&out.LmtId)
+	if r.StartDtTm != nil {
+		out.StartDtTm = new(DateAndDateTime2Choice)
+		*out.StartDtTm = *r.StartDtTm
+	}
+	if r.CdtDbtInd != nil {
+		out.CdtDbtInd = new(common.CreditDebitCode)
+		*out.CdtDbtInd = *r.CdtDbtInd
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *LimitType1Choice) DeepCopy() *LimitType1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(LimitType1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *LimitType1Choice) DeepCopyInto(out *LimitType1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *LongPostalAddress1Choice) DeepCopy() *LongPostalAddress1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(LongPostalAddress1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *LongPostalAddress1Choice) DeepCopyInto(out *LongPostalAddress1Choice) {
+	*out = *r
+	r.Strd.DeepCopyInto(&out.Strd)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *MarketInfrastructureIdentification1Choice) DeepCopy() *MarketInfrastructureIdentification1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(MarketInfrastructureIdentification1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *MarketInfrastructureIdentification1Choice) DeepCopyInto(out *MarketInfrastructureIdentification1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *Member6) DeepCopy() *Member6 {
+	if r == nil {
+		return nil
+	}
+	out := new(Member6)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *Member6) DeepCopyInto(out *Member6) {
+	*out = *r
+	if r.MmbRtrAdr != nil {
+		out.MmbRtrAdr = make([]MemberIdentification3Choice, len(r.MmbRtrAdr))
+		for i := range r.MmbRtrAdr {
+			r.MmbRtrAdr[i].DeepCopyInto(&out.MmbRtrAdr[i])
+		}
+	}
+	if r.CtctRef != nil {
+		out.CtctRef = make([]ContactIdentificationAndAddress1, len(r.CtctRef))
+		for i := range r.CtctRef {
+			r.CtctRef[i].DeepCopyInto(&out.CtctRef[i])
+		}
+	}
+	if r.ComAdr != nil {
+		out.ComAdr = new(CommunicationAddress8)
+		r.ComAdr.DeepCopyInto(out.ComAdr)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *MemberIdentification3Choice) DeepCopy() *MemberIdentification3Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(MemberIdentification3Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *MemberIdentification3Choice) DeepCopyInto(out *MemberIdentification3Choice) {
+	*out = *r
+	r.ClrSysMmbId.DeepCopyInto(&out.ClrSysMmbId)
+	r.Othr.DeepCopyInto(&out.Othr)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *MessageHeader1) DeepCopy() *MessageHeader1 {
+	if r == nil {
+		return nil
+	}
+	out := new(MessageHeader1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *MessageHeader1) DeepCopyInto(out *MessageHeader1) {
+	*out = *r
+	if r.CreDtTm != nil {
+		out.CreDtTm = new(common.ISODateTime)
+		*out.CreDtTm = *r.CreDtTm
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *PostalAddress24) DeepCopy() *PostalAddress24 {
+	if r == nil {
+		return nil
+	}
+	out := new(PostalAddress24)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *PostalAddress24) DeepCopyInto(out *PostalAddress24) {
+	*out = *r
+	if r.AdrTp != nil {
+		out.AdrTp = new(AddressType3Choice)
+		r.AdrTp.DeepCopyInto(out.AdrTp)
+	}
+	if r.Dept != nil {
+		out.Dept = new(common.Max70Text)
+		*out.Dept = *r.Dept
+	}
+	if r.SubDept != nil {
+		out.SubDept = new(common.Max70Text)
+		*out.SubDept = *r.SubDept
+	}
+	if r.StrtNm != nil {
+		out.StrtNm = new(common.Max70Text)
+		*out.StrtNm = *r.StrtNm
+	}
+	if r.BldgNb != nil {
+		out.BldgNb = new(common.Max16Text)
+		*out.BldgNb = *r.BldgNb
+	}
+	if r.BldgNm != nil {
+		out.BldgNm = new(common.Max35Text)
+		*out.BldgNm = *r.BldgNm
+	}
+	if r.Flr != nil {
+		out.Flr = new(common.Max70Text)
+		*out.Flr = *r.Flr
+	}
+	if r.PstBx != nil {
+		out.PstBx = new(common.Max16Text)
+		*out.PstBx = *r.PstBx
+	}
+	if r.Room != nil {
+		out.Room = new(common.Max70Text)
+		*out.Room = *r.Room
+	}
+	if r.PstCd != nil {
+		out.PstCd = new(common.Max16Text)
+		*out.PstCd = *r.PstCd
+	}
+	if r.TwnNm != nil {
+		out.TwnNm = new(common.Max35Text)
+		*out.TwnNm = *r.TwnNm
+	}
+	if r.TwnLctnNm != nil {
+		out.TwnLctnNm = new(common.Max35Text)
+		*out.TwnLctnNm = *r.TwnLctnNm
+	}
+	if r.DstrctNm != nil {
+		out.DstrctNm = new(common.Max35Text)
+		*out.DstrctNm = *r.DstrctNm
+	}
+	if r.CtrySubDvsn != nil {
+		out.CtrySubDvsn = new(common.Max35Text)
+		*out.CtrySubDvsn = *r.CtrySubDvsn
+	}
+	if r.Ctry != nil {
+		out.Ctry = new(common.CountryCode)
+		*out.Ctry = *r.Ctry
+	}
+	if r.AdrLine != nil {
+		out.AdrLine = make([]common.Max70Text, len(r.AdrLine))
+		copy(out.AdrLine, r.AdrLine)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ProxyAccountIdentification1) DeepCopy() *ProxyAccountIdentification1 {
+	if r == nil {
+		return nil
+	}
+	out := new(ProxyAccountIdentification1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ProxyAccountIdentification1) DeepCopyInto(out *ProxyAccountIdentification1) {
+	*out = *r
+	if r.Tp != nil {
+		out.Tp = new(ProxyAccountType1Choice)
+		*out.Tp = *r.Tp
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ProxyAccountType1Choice) DeepCopy() *ProxyAccountType1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(ProxyAccountType1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ProxyAccountType1Choice) DeepCopyInto(out *ProxyAccountType1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *Reservation4) DeepCopy() *Reservation4 {
+	if r == nil {
+		return nil
+	}
+	out := new(Reservation4)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *Reservation4) DeepCopyInto(out *Reservation4) {
+	*out = *r
+	if r.StartDtTm != nil {
+		out.StartDtTm = new(DateAndDateTime2Choice)
+		*out.StartDtTm = *r.StartDtTm
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ReservationIdentification2) DeepCopy() *ReservationIdentification2 {
+	if r == nil {
+		return nil
+	}
+	out := new(ReservationIdentification2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ReservationIdentification2) DeepCopyInto(out *ReservationIdentification2) {
+	*out = *r
+	if r.RsvatnId != nil {
+		out.RsvatnId = new(common.Max35Text)
+		*out.RsvatnId = *r.RsvatnId
+	}
+	if r.SysId != nil {
+		out.SysId = new(SystemIdentification2Choice)
+		*out.SysId = *r.SysId
+	}
+	if r.AcctOwnr != nil {
+		out.AcctOwnr = new(BranchAndFinancialInstitutionIdentification6)
+		r.AcctOwnr.DeepCopyInto(out.AcctOwnr)
+	}
+	if r.AcctId != nil {
+		out.AcctId = new(AccountIdentification4Choice)
+		r.AcctId.DeepCopyInto(out.AcctId)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ReservationType1Choice) DeepCopy() *ReservationType1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(ReservationType1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ReservationType1Choice) DeepCopyInto(out *ReservationType1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *StandingOrder7) DeepCopy() *StandingOrder7 {
+	if r == nil {
+		return nil
+	}
+	out := new(StandingOrder7)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *StandingOrder7) DeepCopyInto(out *StandingOrder7) {
+	*out = *r
+	if r.Amt != nil {
+		out.Amt = new(Amount2Choice)
+		*out.Amt = *r.Amt
+	}
+	if r.Cdtr != nil {
+		out.Cdtr = new(BranchAndFinancialInstitutionIdentification6)
+		r.Cdtr.DeepCopyInto(out.Cdtr)
+	}
+	if r.CdtrAcct != nil {
+		out.CdtrAcct = new(CashAccount38)
+		r.CdtrAcct.DeepCopyInto(out.CdtrAcct)
+	}
+	if r.Dbtr != nil {
+		out.Dbtr = new(BranchAndFinancialInstitutionIdentification6)
+		r.Dbtr.DeepCopyInto(out.Dbtr)
+	}
+	if r.DbtrAcct != nil {
+		out.DbtrAcct = new(CashAccount38)
+		r.DbtrAcct.DeepCopyInto(out.DbtrAcct)
+	}
+	if r.ExctnTp != nil {
+		out.ExctnTp = new(ExecutionType1Choice)
+		*out.ExctnTp = *r.ExctnTp
+	}
+	if r.Frqcy != nil {
+		out.Frqcy = new(Frequency2Code)
+		*out.Frqcy = *r.Frqcy
+	}
+	if r.VldtyPrd != nil {
+		out.VldtyPrd = new(DatePeriod2Choice)
+		*out.VldtyPrd = *r.VldtyPrd
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *StandingOrderIdentification4) DeepCopy() *StandingOrderIdentification4 {
+	if r == nil {
+		return nil
+	}
+	out := new(StandingOrderIdentification4)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *StandingOrderIdentification4) DeepCopyInto(out *StandingOrderIdentification4) {
+	*out = *r
+	if r.Id != nil {
+		out.Id = new(common.Max35Text)
+		*out.Id = *r.Id
+	}
+	r.Acct.DeepCopyInto(&out.Acct)
+	if r.AcctOwnr != nil {
+		out.AcctOwnr = new(BranchAndFinancialInstitutionIdentification6)
+		r.AcctOwnr.DeepCopyInto(out.AcctOwnr)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *StructuredLongPostalAddress1) DeepCopy() *StructuredLongPostalAddress1 {
+	if r == nil {
+		return nil
+	}
+	out := new(StructuredLongPostalAddress1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *StructuredLongPostalAddress1) DeepCopyInto(out *StructuredLongPostalAddress1) {
+	*out = *r
+	if r.BldgNm != nil {
+		out.BldgNm = new(common.Max35Text)
+		*out.BldgNm = *r.BldgNm
+	}
+	if r.StrtNm != nil {
+		out.StrtNm = new(common.Max35Text)
+		*out.StrtNm = *r.StrtNm
+	}
+	if r.StrtBldgId != nil {
+		out.StrtBldgId = new(common.Max35Text)
+		*out.StrtBldgId = *r.StrtBldgId
+	}
+	if r.Flr != nil {
+		out.Flr = new(common.Max16Text)
+		*out.Flr = *r.Flr
+	}
+	if r.DstrctNm != nil {
+		out.DstrctNm = new(common.Max35Text)
+		*out.DstrctNm = *r.DstrctNm
+	}
+	if r.RgnId != nil {
+		out.RgnId = new(common.Max35Text)
+		*out.RgnId = *r.RgnId
+	}
+	if r.Stat != nil {
+		out.Stat = new(common.Max35Text)
+		*out.Stat = *r.Stat
+	}
+	if r.CtyId != nil {
+		out.CtyId = new(common.Max35Text)
+		*out.CtyId = *r.CtyId
+	}
+	if r.POB != nil {
+		out.POB = new(common.Max16Text)
+		*out.POB = *r.POB
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *SupplementaryData1) DeepCopy() *SupplementaryData1 {
+	if r == nil {
+		return nil
+	}
+	out := new(SupplementaryData1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *SupplementaryData1) DeepCopyInto(out *SupplementaryData1) {
+	*out = *r
+	if r.PlcAndNm != nil {
+		out.PlcAndNm = new(common.Max350Text)
+		*out.PlcAndNm = *r.PlcAndNm
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *SupplementaryDataEnvelope1) DeepCopy() *SupplementaryDataEnvelope1 {
+	if r == nil {
+		return nil
+	}
+	out := new(SupplementaryDataEnvelope1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *SupplementaryDataEnvelope1) DeepCopyInto(out *SupplementaryDataEnvelope1) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *SystemIdentification2Choice) DeepCopy() *SystemIdentification2Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(SystemIdentification2Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *SystemIdentification2Choice) DeepCopyInto(out *SystemIdentification2Choice) {
+	*out = *r
+}
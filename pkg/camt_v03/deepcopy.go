@@ -0,0 +1,2651 @@
+// Code generated by deepcopy-gen. DO NOT EDIT.
+
+package camt_v03
+
+import (
+	"github.com/moov-io/iso20022/pkg/common"
+)
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *AccountIdentification4Choice) DeepCopy() *AccountIdentification4Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(AccountIdentification4Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *AccountIdentification4Choice) DeepCopyInto(out *AccountIdentification4Choice) {
+	*out = *r
+	r.Othr.DeepCopyInto(&out.Othr)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *AccountSchemeName1Choice) DeepCopy() *AccountSchemeName1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(AccountSchemeName1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *AccountSchemeName1Choice) DeepCopyInto(out *AccountSchemeName1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *AccountTax1) DeepCopy() *AccountTax1 {
+	if r == nil {
+		return nil
+	}
+	out := new(AccountTax1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *AccountTax1) DeepCopyInto(out *AccountTax1) {
+	*out = *r
+	if r.Rgn != nil {
+		out.Rgn = new(common.Max40Text)
+		*out.Rgn = *r.Rgn
+	}
+	if r.NonResCtry != nil {
+		out.NonResCtry = new(ResidenceLocation1Choice)
+		*out.NonResCtry = *r.NonResCtry
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ActiveOrHistoricCurrencyAndAmount) DeepCopy() *ActiveOrHistoricCurrencyAndAmount {
+	if r == nil {
+		return nil
+	}
+	out := new(ActiveOrHistoricCurrencyAndAmount)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ActiveOrHistoricCurrencyAndAmount) DeepCopyInto(out *ActiveOrHistoricCurrencyAndAmount) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *AddressType3Choice) DeepCopy() *AddressType3Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(AddressType3Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *AddressType3Choice) DeepCopyInto(out *AddressType3Choice) {
+	*out = *r
+	r.Prtry.DeepCopyInto(&out.Prtry)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *AmountAndDirection34) DeepCopy() *AmountAndDirection34 {
+	if r == nil {
+		return nil
+	}
+	out := new(AmountAndDirection34)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *AmountAndDirection34) DeepCopyInto(out *AmountAndDirection34) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *BalanceAdjustment1) DeepCopy() *BalanceAdjustment1 {
+	if r == nil {
+		return nil
+	}
+	out := new(BalanceAdjustment1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *BalanceAdjustment1) DeepCopyInto(out *BalanceAdjustment1) {
+	*out = *r
+	if r.AvrgAmt != nil {
+		out.AvrgAmt = new(AmountAndDirection34)
+		*out.AvrgAmt = *r.AvrgAmt
+	}
+	if r.ErrDt != nil {
+		out.ErrDt = new(common.ISODate)
+		*out.ErrDt = *r.ErrDt
+	}
+	if r.PstngDt != nil {
+		out.PstngDt = new(common.ISODate)
+		*out.PstngDt = *r.PstngDt
+	}
+	if r.EarngsAdjstmntAmt != nil {
+		out.EarngsAdjstmntAmt = new(AmountAndDirection34)
+		*out.EarngsAdjstmntAmt = *r.EarngsAdjstmntAmt
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *BankServicesBillingStatementV03) DeepCopy() *BankServicesBillingStatementV03 {
+	if r == nil {
+		return nil
+	}
+	out := new(BankServicesBillingStatementV03)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *BankServicesBillingStatementV03) DeepCopyInto(out *BankServicesBillingStatementV03) {
+	*out = *r
+	r.RptHdr.DeepCopyInto(&out.RptHdr)
+	if r.BllgStmtGrp != nil {
+		out.BllgStmtGrp = make([]StatementGroup3, len(r.BllgStmtGrp))
+		for i := range r.BllgStmtGrp {
+			r.BllgStmtGrp[i].DeepCopyInto(&out.BllgStmtGrp[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *BankTransactionCodeStructure4) DeepCopy() *BankTransactionCodeStructure4 {
+	if r == nil {
+		return nil
+	}
+	out := new(BankTransactionCodeStructure4)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *BankTransactionCodeStructure4) DeepCopyInto(out *BankTransactionCodeStructure4) {
+	*out = *r
+	if r.Domn != nil {
+		out.Domn = new(BankTransactionCodeStructure5)
+		*out.Domn = *r.Domn
+	}
+	if r.Prtry != nil {
+		out.Prtry = new(ProprietaryBankTransactionCodeStructure1)
+		r.Prtry.DeepCopyInto(out.Prtry)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *BankTransactionCodeStructure5) DeepCopy() *BankTransactionCodeStructure5 {
+	if r == nil {
+		return nil
+	}
+	out := new(BankTransactionCodeStructure5)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *BankTransactionCodeStructure5) DeepCopyInto(out *BankTransactionCodeStructure5) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *BankTransactionCodeStructure6) DeepCopy() *BankTransactionCodeStructure6 {
+	if r == nil {
+		return nil
+	}
+	out := new(BankTransactionCodeStructure6)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *BankTransactionCodeStructure6) DeepCopyInto(out *BankTransactionCodeStructure6) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *BillingBalance1) DeepCopy() *BillingBalance1 {
+	if r == nil {
+		return nil
+	}
+	out := new(BillingBalance1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *BillingBalance1) DeepCopyInto(out *BillingBalance1) {
+	*out = *r
+	if r.CcyTp != nil {
+		out.CcyTp = new(BillingCurrencyType1Code)
+		*out.CcyTp = *r.CcyTp
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *BillingBalanceType1Choice) DeepCopy() *BillingBalanceType1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(BillingBalanceType1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *BillingBalanceType1Choice) DeepCopyInto(out *BillingBalanceType1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *BillingCompensation1) DeepCopy() *BillingCompensation1 {
+	if r == nil {
+		return nil
+	}
+	out := new(BillingCompensation1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *BillingCompensation1) DeepCopyInto(out *BillingCompensation1) {
+	*out = *r
+	if r.CcyTp != nil {
+		out.CcyTp = new(BillingCurrencyType2Code)
+		*out.CcyTp = *r.CcyTp
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *BillingCompensationType1Choice) DeepCopy() *BillingCompensationType1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(BillingCompensationType1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *BillingCompensationType1Choice) DeepCopyInto(out *BillingCompensationType1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *BillingMethod1) DeepCopy() *BillingMethod1 {
+	if r == nil {
+		return nil
+	}
+	out := new(BillingMethod1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *BillingMethod1) DeepCopyInto(out *BillingMethod1) {
+	*out = *r
+	r.SvcTax.DeepCopyInto(&out.SvcTax)
+	r.TtlChrg.DeepCopyInto(&out.TtlChrg)
+	if r.TaxId != nil {
+		out.TaxId = make([]BillingServicesTax1, len(r.TaxId))
+		for i := range r.TaxId {
+			r.TaxId[i].DeepCopyInto(&out.TaxId[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *BillingMethod1Choice) DeepCopy() *BillingMethod1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(BillingMethod1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *BillingMethod1Choice) DeepCopyInto(out *BillingMethod1Choice) {
+	*out = *r
+	r.MtdA.DeepCopyInto(&out.MtdA)
+	r.MtdB.DeepCopyInto(&out.MtdB)
+	r.MtdD.DeepCopyInto(&out.MtdD)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *BillingMethod2) DeepCopy() *BillingMethod2 {
+	if r == nil {
+		return nil
+	}
+	out := new(BillingMethod2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *BillingMethod2) DeepCopyInto(out *BillingMethod2) {
+	*out = *r
+	r.SvcTax.DeepCopyInto(&out.SvcTax)
+	if r.TaxId != nil {
+		out.TaxId = make([]BillingServicesTax1, len(r.TaxId))
+		for i := range r.TaxId {
+			r.TaxId[i].DeepCopyInto(&out.TaxId[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *BillingMethod3) DeepCopy() *BillingMethod3 {
+	if r == nil {
+		return nil
+	}
+	out := new(BillingMethod3)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *BillingMethod3) DeepCopyInto(out *BillingMethod3) {
+	*out = *r
+	if r.TaxId != nil {
+		out.TaxId = make([]BillingServicesTax2, len(r.TaxId))
+		for i := range r.TaxId {
+			r.TaxId[i].DeepCopyInto(&out.TaxId[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *BillingMethod4) DeepCopy() *BillingMethod4 {
+	if r == nil {
+		return nil
+	}
+	out := new(BillingMethod4)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *BillingMethod4) DeepCopyInto(out *BillingMethod4) {
+	*out = *r
+	if r.SvcDtl != nil {
+		out.SvcDtl = make([]BillingServiceParameters2, len(r.SvcDtl))
+		for i := range r.SvcDtl {
+			r.SvcDtl[i].DeepCopyInto(&out.SvcDtl[i])
+		}
+	}
+	r.TaxClctn.DeepCopyInto(&out.TaxClctn)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *BillingPrice1) DeepCopy() *BillingPrice1 {
+	if r == nil {
+		return nil
+	}
+	out := new(BillingPrice1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *BillingPrice1) DeepCopyInto(out *BillingPrice1) {
+	*out = *r
+	if r.Ccy != nil {
+		out.Ccy = new(common.ActiveOrHistoricCurrencyCode)
+		*out.Ccy = *r.Ccy
+	}
+	if r.UnitPric != nil {
+		out.UnitPric = new(AmountAndDirection34)
+		*out.UnitPric = *r.UnitPric
+	}
+	if r.Mtd != nil {
+		out.Mtd = new(BillingChargeMethod1Code)
+		*out.Mtd = *r.Mtd
+	}
+	if r.Rule != nil {
+		out.Rule = new(common.Max20Text)
+		*out.Rule = *r.Rule
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *BillingRate1) DeepCopy() *BillingRate1 {
+	if r == nil {
+		return nil
+	}
+	out := new(BillingRate1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *BillingRate1) DeepCopyInto(out *BillingRate1) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *BillingRateIdentification1Choice) DeepCopy() *BillingRateIdentification1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(BillingRateIdentification1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *BillingRateIdentification1Choice) DeepCopyInto(out *BillingRateIdentification1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *BillingService2) DeepCopy() *BillingService2 {
+	if r == nil {
+		return nil
+	}
+	out := new(BillingService2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *BillingService2) DeepCopyInto(out *BillingService2) {
+	*out = *r
+	r.SvcDtl.DeepCopyInto(&out.SvcDtl)
+	if r.Pric != nil {
+		out.Pric = new(BillingPrice1)
+		r.Pric.DeepCopyInto(out.Pric)
+	}
+	if r.OrgnlChrgSttlmAmt != nil {
+		out.OrgnlChrgSttlmAmt = new(AmountAndDirection34)
+		*out.OrgnlChrgSttlmAmt = *r.OrgnlChrgSttlmAmt
+	}
+	if r.BalReqrdAcctAmt != nil {
+		out.BalReqrdAcctAmt = new(AmountAndDirection34)
+		*out.BalReqrdAcctAmt = *r.BalReqrdAcctAmt
+	}
+	r.TaxDsgnt.DeepCopyInto(&out.TaxDsgnt)
+	if r.TaxClctn != nil {
+		out.TaxClctn = new(BillingMethod1Choice)
+		r.TaxClctn.DeepCopyInto(out.TaxClctn)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *BillingServiceAdjustment1) DeepCopy() *BillingServiceAdjustment1 {
+	if r == nil {
+		return nil
+	}
+	out := new(BillingServiceAdjustment1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *BillingServiceAdjustment1) DeepCopyInto(out *BillingServiceAdjustment1) {
+	*out = *r
+	if r.BalReqrdAmt != nil {
+		out.BalReqrdAmt = new(AmountAndDirection34)
+		*out.BalReqrdAmt = *r.BalReqrdAmt
+	}
+	if r.ErrDt != nil {
+		out.ErrDt = new(common.ISODate)
+		*out.ErrDt = *r.ErrDt
+	}
+	if r.AdjstmntId != nil {
+		out.AdjstmntId = new(common.Max35Text)
+		*out.AdjstmntId = *r.AdjstmntId
+	}
+	if r.SubSvc != nil {
+		out.SubSvc = new(BillingSubServiceIdentification1)
+		*out.SubSvc = *r.SubSvc
+	}
+	if r.PricChng != nil {
+		out.PricChng = new(AmountAndDirection34)
+		*out.PricChng = *r.PricChng
+	}
+	if r.OrgnlPric != nil {
+		out.OrgnlPric = new(AmountAndDirection34)
+		*out.OrgnlPric = *r.OrgnlPric
+	}
+	if r.NewPric != nil {
+		out.NewPric = new(AmountAndDirection34)
+		*out.NewPric = *r.NewPric
+	}
+	if r.OrgnlChrgAmt != nil {
+		out.OrgnlChrgAmt = new(AmountAndDirection34)
+		*out.OrgnlChrgAmt = *r.OrgnlChrgAmt
+	}
+	if r.NewChrgAmt != nil {
+		out.NewChrgAmt = new(AmountAndDirection34)
+		*out.NewChrgAmt = *r.NewChrgAmt
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *BillingServiceCommonIdentification1) DeepCopy() *BillingServiceCommonIdentification1 {
+	if r == nil {
+		return nil
+	}
+	out := new(BillingServiceCommonIdentification1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *BillingServiceCommonIdentification1) DeepCopyInto(out *BillingServiceCommonIdentification1) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *BillingServiceIdentification2) DeepCopy() *BillingServiceIdentification2 {
+	if r == nil {
+		return nil
+	}
+	out := new(BillingServiceIdentification2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *BillingServiceIdentification2) DeepCopyInto(out *BillingServiceIdentification2) {
+	*out = *r
+	if r.SubSvc != nil {
+		out.SubSvc = new(BillingSubServiceIdentification1)
+		*out.SubSvc = *r.SubSvc
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *BillingServiceIdentification3) DeepCopy() *BillingServiceIdentification3 {
+	if r == nil {
+		return nil
+	}
+	out := new(BillingServiceIdentification3)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *BillingServiceIdentification3) DeepCopyInto(out *BillingServiceIdentification3) {
+	*out = *r
+	if r.SubSvc != nil {
+		out.SubSvc = new(BillingSubServiceIdentification1)
+		*out.SubSvc = *r.SubSvc
+	}
+	if r.CmonCd != nil {
+		out.CmonCd = new(BillingServiceCommonIdentification1)
+		*out.CmonCd = *r.CmonCd
+	}
+	if r.BkTxCd != nil {
+		out.BkTxCd = new(BankTransactionCodeStructure4)
+		r.BkTxCd.DeepCopyInto(out.BkTxCd)
+	}
+	if r.SvcTp != nil {
+		out.SvcTp = new(common.Max12Text)
+		*out.SvcTp = *r.SvcTp
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *BillingServiceParameters2) DeepCopy() *BillingServiceParameters2 {
+	if r == nil {
+		return nil
+	}
+	out := new(BillingServiceParameters2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *BillingServiceParameters2) DeepCopyInto(out *BillingServiceParameters2) {
+	*out = *r
+	r.BkSvc.DeepCopyInto(&out.BkSvc)
+	if r.UnitPric != nil {
+		out.UnitPric = new(AmountAndDirection34)
+		*out.UnitPric = *r.UnitPric
+	}
+	if r.SvcChrgAmt != nil {
+		out.SvcChrgAmt = new(AmountAndDirection34)
+		*out.SvcChrgAmt = *r.SvcChrgAmt
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *BillingServiceParameters3) DeepCopy() *BillingServiceParameters3 {
+	if r == nil {
+		return nil
+	}
+	out := new(BillingServiceParameters3)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *BillingServiceParameters3) DeepCopyInto(out *BillingServiceParameters3) {
+	*out = *r
+	r.BkSvc.DeepCopyInto(&out.BkSvc)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *BillingServicesAmount1) DeepCopy() *BillingServicesAmount1 {
+	if r == nil {
+		return nil
+	}
+	out := new(BillingServicesAmount1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *BillingServicesAmount1) DeepCopyInto(out *BillingServicesAmount1) {
+	*out = *r
+	if r.PricgAmt != nil {
+		out.PricgAmt = new(AmountAndDirection34)
+		*out.PricgAmt = *r.PricgAmt
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *BillingServicesAmount2) DeepCopy() *BillingServicesAmount2 {
+	if r == nil {
+		return nil
+	}
+	out := new(BillingServicesAmount2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *BillingServicesAmount2) DeepCopyInto(out *BillingServicesAmount2) {
+	*out = *r
+	if r.SttlmAmt != nil {
+		out.SttlmAmt = new(AmountAndDirection34)
+		*out.SttlmAmt = *r.SttlmAmt
+	}
+	if r.PricgAmt != nil {
+		out.PricgAmt = new(AmountAndDirection34)
+		*out.PricgAmt = *r.PricgAmt
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *BillingServicesAmount3) DeepCopy() *BillingServicesAmount3 {
+	if r == nil {
+		return nil
+	}
+	out := new(BillingServicesAmount3)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *BillingServicesAmount3) DeepCopyInto(out *BillingServicesAmount3) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *BillingServicesTax1) DeepCopy() *BillingServicesTax1 {
+	if r == nil {
+		return nil
+	}
+	out := new(BillingServicesTax1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *BillingServicesTax1) DeepCopyInto(out *BillingServicesTax1) {
+	*out = *r
+	if r.Desc != nil {
+		out.Desc = new(common.Max40Text)
+		*out.Desc = *r.Desc
+	}
+	if r.PricgAmt != nil {
+		out.PricgAmt = new(AmountAndDirection34)
+		*out.PricgAmt = *r.PricgAmt
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *BillingServicesTax2) DeepCopy() *BillingServicesTax2 {
+	if r == nil {
+		return nil
+	}
+	out := new(BillingServicesTax2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *BillingServicesTax2) DeepCopyInto(out *BillingServicesTax2) {
+	*out = *r
+	if r.Desc != nil {
+		out.Desc = new(common.Max40Text)
+		*out.Desc = *r.Desc
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *BillingServicesTax3) DeepCopy() *BillingServicesTax3 {
+	if r == nil {
+		return nil
+	}
+	out := new(BillingServicesTax3)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *BillingServicesTax3) DeepCopyInto(out *BillingServicesTax3) {
+	*out = *r
+	if r.Desc != nil {
+		out.Desc = new(common.Max40Text)
+		*out.Desc = *r.Desc
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *BillingStatement3) DeepCopy() *BillingStatement3 {
+	if r == nil {
+		return nil
+	}
+	out := new(BillingStatement3)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *BillingStatement3) DeepCopyInto(out *BillingStatement3) {
+	*out = *r
+	r.FrToDt.DeepCopyInto(&out.FrToDt)
+	r.AcctChrtcs.DeepCopyInto(&out.AcctChrtcs)
+	if r.RateData != nil {
+		out.RateData = make([]BillingRate1, len(r.RateData))
+		copy(out.RateData, r.RateData)
+	}
+	if r.CcyXchg != nil {
+		out.CcyXchg = make([]CurrencyExchange6, len(r.CcyXchg))
+		for i := range r.CcyXchg {
+			r.CcyXchg[i].DeepCopyInto(&out.CcyXchg[i])
+		}
+	}
+	if r.Bal != nil {
+		out.Bal = make([]BillingBalance1, len(r.Bal))
+		for i := range r.Bal {
+			r.Bal[i].DeepCopyInto(&out.Bal[i])
+		}
+	}
+	if r.Compstn != nil {
+		out.Compstn = make([]BillingCompensation1, len(r.Compstn))
+		for i := range r.Compstn {
+			r.Compstn[i].DeepCopyInto(&out.Compstn[i])
+		}
+	}
+	if r.Svc != nil {
+		out.Svc = make([]BillingService2, len(r.Svc))
+		for i := range r.Svc {
+			r.Svc[i].DeepCopyInto(&out.Svc[i])
+		}
+	}
+	if r.TaxRgn != nil {
+		out.TaxRgn = make([]BillingTaxRegion2, len(r.TaxRgn))
+		for i := range r.TaxRgn {
+			r.TaxRgn[i].DeepCopyInto(&out.TaxRgn[i])
+		}
+	}
+	if r.BalAdjstmnt != nil {
+		out.BalAdjstmnt = make([]BalanceAdjustment1, len(r.BalAdjstmnt))
+		for i := range r.BalAdjstmnt {
+			r.BalAdjstmnt[i].DeepCopyInto(&out.BalAdjstmnt[i])
+		}
+	}
+	if r.SvcAdjstmnt != nil {
+		out.SvcAdjstmnt = make([]BillingServiceAdjustment1, len(r.SvcAdjstmnt))
+		for i := range r.SvcAdjstmnt {
+			r.SvcAdjstmnt[i].DeepCopyInto(&out.SvcAdjstmnt[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *BillingSubServiceIdentification1) DeepCopy() *BillingSubServiceIdentification1 {
+	if r == nil {
+		return nil
+	}
+	out := new(BillingSubServiceIdentification1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *BillingSubServiceIdentification1) DeepCopyInto(out *BillingSubServiceIdentification1) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *BillingSubServiceQualifier1Choice) DeepCopy() *BillingSubServiceQualifier1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(BillingSubServiceQualifier1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *BillingSubServiceQualifier1Choice) DeepCopyInto(out *BillingSubServiceQualifier1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *BillingTaxIdentification2) DeepCopy() *BillingTaxIdentification2 {
+	if r == nil {
+		return nil
+	}
+	out := new(BillingTaxIdentification2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *BillingTaxIdentification2) DeepCopyInto(out *BillingTaxIdentification2) {
+	*out = *r
+	if r.VATRegnNb != nil {
+		out.VATRegnNb = new(common.Max35Text)
+		*out.VATRegnNb = *r.VATRegnNb
+	}
+	if r.TaxRegnNb != nil {
+		out.TaxRegnNb = new(common.Max35Text)
+		*out.TaxRegnNb = *r.TaxRegnNb
+	}
+	if r.TaxCtct != nil {
+		out.TaxCtct = new(Contact4)
+		r.TaxCtct.DeepCopyInto(out.TaxCtct)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *BillingTaxRegion2) DeepCopy() *BillingTaxRegion2 {
+	if r == nil {
+		return nil
+	}
+	out := new(BillingTaxRegion2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *BillingTaxRegion2) DeepCopyInto(out *BillingTaxRegion2) {
+	*out = *r
+	if r.PtDt != nil {
+		out.PtDt = new(common.ISODate)
+		*out.PtDt = *r.PtDt
+	}
+	if r.SndgFI != nil {
+		out.SndgFI = new(BillingTaxIdentification2)
+		r.SndgFI.DeepCopyInto(out.SndgFI)
+	}
+	if r.InvcNb != nil {
+		out.InvcNb = new(common.Max40Text)
+		*out.InvcNb = *r.InvcNb
+	}
+	if r.MtdC != nil {
+		out.MtdC = new(BillingMethod4)
+		r.MtdC.DeepCopyInto(out.MtdC)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *BranchAndFinancialInstitutionIdentification5) DeepCopy() *BranchAndFinancialInstitutionIdentification5 {
+	if r == nil {
+		return nil
+	}
+	out := new(BranchAndFinancialInstitutionIdentification5)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *BranchAndFinancialInstitutionIdentification5) DeepCopyInto(out *BranchAndFinancialInstitutionIdentification5) {
+	*out = *r
+	r.FinInstnId.DeepCopyInto(&out.FinInstnId)
+	if r.BrnchId != nil {
+		out.BrnchId = new(BranchData2)
+		r.BrnchId.DeepCopyInto(out.BrnchId)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *BranchAndFinancialInstitutionIdentification6) DeepCopy() *BranchAndFinancialInstitutionIdentification6 {
+	if r == nil {
+		return nil
+	}
+	out := new(BranchAndFinancialInstitutionIdentification6)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *BranchAndFinancialInstitutionIdentification6) DeepCopyInto(out *BranchAndFinancialInstitutionIdentification6) {
+	*out = *r
+	r.FinInstnId.DeepCopyInto(&out.FinInstnId)
+	if r.BrnchId != nil {
+		out.BrnchId = new(BranchData3)
+		r.BrnchId.DeepCopyInto(out.BrnchId)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *BranchData2) DeepCopy() *BranchData2 {
+	if r == nil {
+		return nil
+	}
+	out := new(BranchData2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *BranchData2) DeepCopyInto(out *BranchData2) {
+	*out = *r
+	if r.Id != nil {
+		out.Id = new(common.Max35Text)
+		*out.Id = *r.Id
+	}
+	if r.Nm != nil {
+		out.Nm = new(common.Max140Text)
+		*out.Nm = *r.Nm
+	}
+	if r.PstlAdr != nil {
+		out.PstlAdr = new(PostalAddress6)
+		r.PstlAdr.DeepCopyInto(out.PstlAdr)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *BranchData3) DeepCopy() *BranchData3 {
+	if r == nil {
+		return nil
+	}
+	out := new(BranchData3)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *BranchData3) DeepCopyInto(out *BranchData3) {
+	*out = *r
+	if r.Id != nil {
+		out.Id = new(common.Max35Text)
+		*out.Id = *r.Id
+	}
+	if r.LEI != nil {
+		out.LEI = new(common.LEIIdentifier)
+		*out.LEI = *r.LEI
+	}
+	if r.Nm != nil {
+		out.Nm = new(common.Max140Text)
+		*out.Nm = *r.Nm
+	}
+	if r.PstlAdr != nil {
+		out.PstlAdr = new(PostalAddress24)
+		r.PstlAdr.DeepCopyInto(out.PstlAdr)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *Case3) DeepCopy() *Case3 {
+	if r == nil {
+		return nil
+	}
+	out := new(Case3)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *Case3) DeepCopyInto(out *Case3) {
+	*out = *r
+	r.Cretr.DeepCopyInto(&out.Cretr)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CaseAssignment3) DeepCopy() *CaseAssignment3 {
+	if r == nil {
+		return nil
+	}
+	out := new(CaseAssignment3)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CaseAssignment3) DeepCopyInto(out *CaseAssignment3) {
+	*out = *r
+	r.Assgnr.DeepCopyInto(&out.Assgnr)
+	r.Assgne.DeepCopyInto(&out.Assgne)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CashAccount38) DeepCopy() *CashAccount38 {
+	if r == nil {
+		return nil
+	}
+	out := new(CashAccount38)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CashAccount38) DeepCopyInto(out *CashAccount38) {
+	*out = *r
+	r.Id.DeepCopyInto(&out.Id)
+	if r.Tp != nil {
+		out.Tp = new(CashAccountType2Choice)
+		*out.Tp = *r.Tp
+	}
+	if r.Ccy != nil {
+		out.Ccy = new(common.ActiveOrHistoricCurrencyCode)
+		*out.Ccy = *r.Ccy
+	}
+	if r.Nm != nil {
+		out.Nm = new(common.Max70Text)
+		*out.Nm = *r.Nm
+	}
+	if r.Prxy != nil {
+		out.Prxy = new(ProxyAccountIdentification1)
+		r.Prxy.DeepCopyInto(out.Prxy)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CashAccountCharacteristics3) DeepCopy() *CashAccountCharacteristics3 {
+	if r == nil {
+		return nil
+	}
+	out := new(CashAccountCharacteristics3)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CashAccountCharacteristics3) DeepCopyInto(out *CashAccountCharacteristics3) {
+	*out = *r
+	r.CshAcct.DeepCopyInto(&out.CshAcct)
+	if r.AcctSvcr != nil {
+		out.AcctSvcr = new(BranchAndFinancialInstitutionIdentification6)
+		r.AcctSvcr.DeepCopyInto(out.AcctSvcr)
+	}
+	if r.PrntAcct != nil {
+		out.PrntAcct = new(ParentCashAccount3)
+		r.PrntAcct.DeepCopyInto(out.PrntAcct)
+	}
+	if r.DbtAcct != nil {
+		out.DbtAcct = new(AccountIdentification4Choice)
+		r.DbtAcct.DeepCopyInto(out.DbtAcct)
+	}
+	if r.DelydDbtDt != nil {
+		out.DelydDbtDt = new(common.ISODate)
+		*out.DelydDbtDt = *r.DelydDbtDt
+	}
+	if r.SttlmAdvc != nil {
+		out.SttlmAdvc = new(common.Max105Text)
+		*out.SttlmAdvc = *r.SttlmAdvc
+	}
+	if r.SttlmCcyCd != nil {
+		out.SttlmCcyCd = new(common.ActiveOrHistoricCurrencyCode)
+		*out.SttlmCcyCd = *r.SttlmCcyCd
+	}
+	if r.HstCcyCd != nil {
+		out.HstCcyCd = new(common.ActiveOrHistoricCurrencyCode)
+		*out.HstCcyCd = *r.HstCcyCd
+	}
+	if r.Tax != nil {
+		out.Tax = new(AccountTax1)
+		r.Tax.DeepCopyInto(out.Tax)
+	}
+	r.AcctSvcrCtct.DeepCopyInto(&out.AcctSvcrCtct)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CashAccountType2Choice) DeepCopy() *CashAccountType2Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(CashAccountType2Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CashAccountType2Choice) DeepCopyInto(out *CashAccountType2Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ClearingSystemIdentification2Choice) DeepCopy() *ClearingSystemIdentification2Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(ClearingSystemIdentification2Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ClearingSystemIdentification2Choice) DeepCopyInto(out *ClearingSystemIdentification2Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ClearingSystemMemberIdentification2) DeepCopy() *ClearingSystemMemberIdentification2 {
+	if r == nil {
+		return nil
+	}
+	out := new(ClearingSystemMemberIdentification2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ClearingSystemMemberIdentification2) DeepCopyInto(out *ClearingSystemMemberIdentification2) {
+	*out = *r
+	if r.ClrSysId != nil {
+		out.ClrSysId = new(ClearingSystemIdentification2Choice)
+		*out.ClrSysId = *r.ClrSysId
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *Contact4) DeepCopy() *Contact4 {
+	if r == nil {
+		return nil
+	}
+	out := new(Contact4)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *Contact4) DeepCopyInto(out *Contact4) {
+	*out = *r
+	if r.NmPrfx != nil {
+		out.NmPrfx = new(common.NamePrefix2Code)
+		*out.NmPrfx = *r.NmPrfx
+	}
+	if r.Nm != nil {
+		out.Nm = new(common.Max140Text)
+		*out.Nm = *r.Nm
+	}
+	if r.PhneNb != nil {
+		out.PhneNb = new(common.PhoneNumber)
+		*out.PhneNb = *r.PhneNb
+	}
+	if r.MobNb != nil {
+		out.MobNb = new(common.PhoneNumber)
+		*out.MobNb = *r.MobNb
+	}
+	if r.FaxNb != nil {
+		out.FaxNb = new(common.PhoneNumber)
+		*out.FaxNb = *r.FaxNb
+	}
+	if r.EmailAdr != nil {
+		out.EmailAdr = new(common.Max2048Text)
+		*out.EmailAdr = *r.EmailAdr
+	}
+	if r.EmailPurp != nil {
+		out.EmailPurp = new(common.Max35Text)
+		*out.EmailPurp = *r.EmailPurp
+	}
+	if r.JobTitl != nil {
+		out.JobTitl = new(common.Max35Text)
+		*out.JobTitl = *r.JobTitl
+	}
+	if r.Rspnsblty != nil {
+		out.Rspnsblty = new(common.Max35Text)
+		*out.Rspnsblty = *r.Rspnsblty
+	}
+	if r.Dept != nil {
+		out.Dept = new(common.Max70Text)
+		*out.Dept = *r.Dept
+	}
+	if r.Othr != nil {
+		out.Othr = make([]OtherContact1, len(r.Othr))
+		for i := range r.Othr {
+			r.Othr[i].DeepCopyInto(&out.Othr[i])
+		}
+	}
+	if r.PrefrdMtd != nil {
+		out.PrefrdMtd = new(PreferredContactMethod1Code)
+		*out.PrefrdMtd = *r.PrefrdMtd
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ContactDetails2) DeepCopy() *ContactDetails2 {
+	if r == nil {
+		return nil
+	}
+	out := new(ContactDetails2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ContactDetails2) DeepCopyInto(out *ContactDetails2) {
+	*out = *r
+	if r.NmPrfx != nil {
+		out.NmPrfx = new(common.NamePrefix1Code)
+		*out.NmPrfx = *r.NmPrfx
+	}
+	if r.Nm != nil {
+		out.Nm = new(common.Max140Text)
+		*out.Nm = *r.Nm
+	}
+	if r.PhneNb != nil {
+		out.PhneNb = new(common.PhoneNumber)
+		*out.PhneNb = *r.PhneNb
+	}
+	if r.MobNb != nil {
+		out.MobNb = new(common.PhoneNumber)
+		*out.MobNb = *r.MobNb
+	}
+	if r.FaxNb != nil {
+		out.FaxNb = new(common.PhoneNumber)
+		*out.FaxNb = *r.FaxNb
+	}
+	if r.EmailAdr != nil {
+		out.EmailAdr = new(common.Max2048Text)
+		*out.EmailAdr = *r.EmailAdr
+	}
+	if r.Othr != nil {
+		out.Othr = new(common.Max35Text)
+		*out.Othr = *r.Othr
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CurrencyExchange6) DeepCopy() *CurrencyExchange6 {
+	if r == nil {
+		return nil
+	}
+	out := new(CurrencyExchange6)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CurrencyExchange6) DeepCopyInto(out *CurrencyExchange6) {
+	*out = *r
+	if r.Desc != nil {
+		out.Desc = new(common.Max40Text)
+		*out.Desc = *r.Desc
+	}
+	if r.UnitCcy != nil {
+		out.UnitCcy = new(common.ActiveOrHistoricCurrencyCode)
+		*out.UnitCcy = *r.UnitCcy
+	}
+	if r.Cmnts != nil {
+		out.Cmnts = new(common.Max70Text)
+		*out.Cmnts = *r.Cmnts
+	}
+	if r.QtnDt != nil {
+		out.QtnDt = new(common.ISODateTime)
+		*out.QtnDt = *r.QtnDt
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *DateAndPlaceOfBirth) DeepCopy() *DateAndPlaceOfBirth {
+	if r == nil {
+		return nil
+	}
+	out := new(DateAndPlaceOfBirth)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *DateAndPlaceOfBirth) DeepCopyInto(out *DateAndPlaceOfBirth) {
+	*out = *r
+	if r.PrvcOfBirth != nil {
+		out.PrvcOfBirth = new(common.Max35Text)
+		*out.PrvcOfBirth = *r.PrvcOfBirth
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *DatePeriod1) DeepCopy() *DatePeriod1 {
+	if r == nil {
+		return nil
+	}
+	out := new(DatePeriod1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *DatePeriod1) DeepCopyInto(out *DatePeriod1) {
+	*out = *r
+	if r.FrDt != nil {
+		out.FrDt = new(common.ISODate)
+		*out.FrDt = *r.FrDt
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *DatePeriod2) DeepCopy() *DatePeriod2 {
+	if r == nil {
+		return nil
+	}
+	out := new(DatePeriod2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *DatePeriod2) DeepCopyInto(out *DatePeriod2) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *DatePeriod2Choice) DeepCopy() *DatePeriod2Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(DatePeriod2Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *DatePeriod2Choice) DeepCopyInto(out *DatePeriod2Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *DeleteStandingOrderV03) DeepCopy() *DeleteStandingOrderV03 {
+	if r == nil {
+		return nil
+	}
+	out := new(DeleteStandingOrderV03)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *DeleteStandingOrderV03) DeepCopyInto(out *DeleteStandingOrderV03) {
+	*out = *r
+	r.MsgHdr.DeepCopyInto(&out.MsgHdr)
+	r.StgOrdrDtls.DeepCopyInto(&out.StgOrdrDtls)
+	if r.SplmtryData != nil {
+		out.SplmtryData = make([]SupplementaryData1, len(r.SplmtryData))
+		for i := range r.SplmtryData {
+			r.SplmtryData[i].DeepCopyInto(&out.SplmtryData[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *FinancialIdentificationSchemeName1Choice) DeepCopy() *FinancialIdentificationSchemeName1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(FinancialIdentificationSchemeName1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *FinancialIdentificationSchemeName1Choice) DeepCopyInto(out *FinancialIdentificationSchemeName1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *FinancialInstitutionIdentification18) DeepCopy() *FinancialInstitutionIdentification18 {
+	if r == nil {
+		return nil
+	}
+	out := new(FinancialInstitutionIdentification18)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *FinancialInstitutionIdentification18) DeepCopyInto(out *FinancialInstitutionIdentification18) {
+	*out = *r
+	if r.BICFI != nil {
+		out.BICFI = new(common.BICFIDec2014Identifier)
+		*out.BICFI = *r.BICFI
+	}
+	if r.ClrSysMmbId != nil {
+		out.ClrSysMmbId = new(ClearingSystemMemberIdentification2)
+		r.ClrSysMmbId.DeepCopyInto(out.ClrSysMmbId)
+	}
+	if r.LEI != nil {
+		out.LEI = new(common.LEIIdentifier)
+		*out.LEI = *r.LEI
+	}
+	if r.Nm != nil {
+		out.Nm = new(common.Max140Text)
+		*out.Nm = *r.Nm
+	}
+	if r.PstlAdr != nil {
+		out.PstlAdr = new(PostalAddress24)
+		r.PstlAdr.DeepCopyInto(out.PstlAdr)
+	}
+	if r.Othr != nil {
+		out.Othr = new(GenericFinancialIdentification1)
+		r.Othr.DeepCopyInto(out.Othr)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *FinancialInstitutionIdentification19) DeepCopy() *FinancialInstitutionIdentification19 {
+	if r == nil {
+		return nil
+	}
+	out := new(FinancialInstitutionIdentification19)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *FinancialInstitutionIdentification19) DeepCopyInto(out *FinancialInstitutionIdentification19) {
+	*out = *r
+	if r.BICFI != nil {
+		out.BICFI = new(common.BICFIDec2014Identifier)
+		*out.BICFI = *r.BICFI
+	}
+	if r.ClrSysMmbId != nil {
+		out.ClrSysMmbId = new(ClearingSystemMemberIdentification2)
+		r.ClrSysMmbId.DeepCopyInto(out.ClrSysMmbId)
+	}
+	if r.LEI != nil {
+		out.LEI = new(common.LEIIdentifier)
+		*out.LEI = *r.LEI
+	}
+	if r.Othr != nil {
+		out.Othr = new(GenericFinancialIdentification1)
+		r.Othr.DeepCopyInto(out.Othr)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *FinancialInstitutionIdentification8) DeepCopy() *FinancialInstitutionIdentification8 {
+	if r == nil {
+		return nil
+	}
+	out := new(FinancialInstitutionIdentification8)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *FinancialInstitutionIdentification8) DeepCopyInto(out *FinancialInstitutionIdentification8) {
+	*out = *r
+	if r.BICFI != nil {
+		out.BICFI = new(common.BICFIIdentifier)
+		*out.BICFI = *r.BICFI
+	}
+	if r.ClrSysMmbId != nil {
+		out.ClrSysMmbId = new(ClearingSystemMemberIdentification2)
+		r.ClrSysMmbId.DeepCopyInto(out.ClrSysMmbId)
+	}
+	if r.Nm != nil {
+		out.Nm = new(common.Max140Text)
+		*out.Nm = *r.Nm
+	}
+	if r.PstlAdr != nil {
+		out.PstlAdr = new(PostalAddress6)
+		r.PstlAdr.DeepCopyInto(out.PstlAdr)
+	}
+	if r.Othr != nil {
+		out.Othr = new(GenericFinancialIdentification1)
+		r.Othr.DeepCopyInto(out.Othr)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *GenericAccountIdentification1) DeepCopy() *GenericAccountIdentification1 {
+	if r == nil {
+		return nil
+	}
+	out := new(GenericAccountIdentification1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *GenericAccountIdentification1) DeepCopyInto(out *GenericAccountIdentification1) {
+	*out = *r
+	if r.SchmeNm != nil {
+		out.SchmeNm = new(AccountSchemeName1Choice)
+		*out.SchmeNm = *r.SchmeNm
+	}
+	if r.Issr != nil {
+		out.Issr = new(common.Max35Text)
+		*out.Issr = *r.Issr
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *GenericFinancialIdentification1) DeepCopy() *GenericFinancialIdentification1 {
+	if r == nil {
+		return nil
+	}
+	out := new(GenericFinancialIdentification1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *GenericFinancialIdentification1) DeepCopyInto(out *GenericFinancialIdentification1) {
+	*out = *r
+	if r.SchmeNm != nil {
+		out.SchmeNm = new(FinancialIdentificationSchemeName1Choice)
+		*out.SchmeNm = *r.SchmeNm
+	}
+	if r.Issr != nil {
+		out.Issr = new(common.Max35Text)
+		*out.Issr = *r.Issr
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *GenericIdentification1) DeepCopy() *GenericIdentification1 {
+	if r == nil {
+		return nil
+	}
+	out := new(GenericIdentification1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *GenericIdentification1) DeepCopyInto(out *GenericIdentification1) {
+	*out = *r
+	if r.SchmeNm != nil {
+		out.SchmeNm = new(common.Max35Text)
+		*out.SchmeNm = *r.SchmeNm
+	}
+	if r.Issr != nil {
+		out.Issr = new(common.Max35Text)
+		*out.Issr = *r.Issr
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *GenericIdentification30) DeepCopy() *GenericIdentification30 {
+	if r == nil {
+		return nil
+	}
+	out := new(GenericIdentification30)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *GenericIdentification30) DeepCopyInto(out *GenericIdentification30) {
+	*out = *r
+	if r.SchmeNm != nil {
+		out.SchmeNm = new(common.Max35Text)
+		*out.SchmeNm = *r.SchmeNm
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *GenericOrganisationIdentification1) DeepCopy() *GenericOrganisationIdentification1 {
+	if r == nil {
+		return nil
+	}
+	out := new(GenericOrganisationIdentification1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *GenericOrganisationIdentification1) DeepCopyInto(out *GenericOrganisationIdentification1) {
+	*out = *r
+	if r.SchmeNm != nil {
+		out.SchmeNm = new(OrganisationIdentificationSchemeName1Choice)
+		*out.SchmeNm = *r.SchmeNm
+	}
+	if r.Issr != nil {
+		out.Issr = new(common.Max35Text)
+		*out.Issr = *r.Issr
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *GenericPersonIdentification1) DeepCopy() *GenericPersonIdentification1 {
+	if r == nil {
+		return nil
+	}
+	out := new(GenericPersonIdentification1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *GenericPersonIdentification1) DeepCopyInto(out *GenericPersonIdentification1) {
+	*out = *r
+	if r.SchmeNm != nil {
+		out.SchmeNm = new(PersonIdentificationSchemeName1Choice)
+		*out.SchmeNm = *r.SchmeNm
+	}
+	if r.Issr != nil {
+		out.Issr = new(common.Max35Text)
+		*out.Issr = *r.Issr
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *GetStandingOrderV03) DeepCopy() *GetStandingOrderV03 {
+	if r == nil {
+		return nil
+	}
+	out := new(GetStandingOrderV03)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *GetStandingOrderV03) DeepCopyInto(out *GetStandingOrderV03) {
+	*out = *r
+	r.MsgHdr.DeepCopyInto(&out.MsgHdr)
+	if r.StgOrdrQryDef != nil {
+		out.StgOrdrQryDef = new(StandingOrderQuery3)
+		r.StgOrdrQryDef.DeepCopyInto(out.StgOrdrQryDef)
+	}
+	if r.SplmtryData != nil {
+		out.SplmtryData = make([]SupplementaryData1, len(r.SplmtryData))
+		for i := range r.SplmtryData {
+			r.SplmtryData[i].DeepCopyInto(&out.SplmtryData[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *MessageHeader1) DeepCopy() *MessageHeader1 {
+	if r == nil {
+		return nil
+	}
+	out := new(MessageHeader1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *MessageHeader1) DeepCopyInto(out *MessageHeader1) {
+	*out = *r
+	if r.CreDtTm != nil {
+		out.CreDtTm = new(common.ISODateTime)
+		*out.CreDtTm = *r.CreDtTm
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *MessageHeader4) DeepCopy() *MessageHeader4 {
+	if r == nil {
+		return nil
+	}
+	out := new(MessageHeader4)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *MessageHeader4) DeepCopyInto(out *MessageHeader4) {
+	*out = *r
+	if r.CreDtTm != nil {
+		out.CreDtTm = new(common.ISODateTime)
+		*out.CreDtTm = *r.CreDtTm
+	}
+	if r.ReqTp != nil {
+		out.ReqTp = new(RequestType3Choice)
+		r.ReqTp.DeepCopyInto(out.ReqTp)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *OrganisationIdentification29) DeepCopy() *OrganisationIdentification29 {
+	if r == nil {
+		return nil
+	}
+	out := new(OrganisationIdentification29)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *OrganisationIdentification29) DeepCopyInto(out *OrganisationIdentification29) {
+	*out = *r
+	if r.AnyBIC != nil {
+		out.AnyBIC = new(common.AnyBICDec2014Identifier)
+		*out.AnyBIC = *r.AnyBIC
+	}
+	if r.LEI != nil {
+		out.LEI = new(common.LEIIdentifier)
+		*out.LEI = *r.LEI
+	}
+	if r.Othr != nil {
+		out.Othr = make([]GenericOrganisationIdentification1, len(r.Othr))
+		for i := range r.Othr {
+			r.Othr[i].DeepCopyInto(&out.Othr[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *OrganisationIdentification8) DeepCopy() *OrganisationIdentification8 {
+	if r == nil {
+		return nil
+	}
+	out := new(OrganisationIdentification8)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *OrganisationIdentification8) DeepCopyInto(out *OrganisationIdentification8) {
+	*out = *r
+	if r.AnyBIC != nil {
+		out.AnyBIC = new(common.AnyBICIdentifier)
+		*out.AnyBIC = *r.AnyBIC
+	}
+	if r.Othr != nil {
+		out.Othr = make([]GenericOrganisationIdentification1, len(r.Othr))
+		for i := range r.Othr {
+			r.Othr[i].DeepCopyInto(&out.Othr[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *OrganisationIdentificationSchemeName1Choice) DeepCopy() *OrganisationIdentificationSchemeName1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(OrganisationIdentificationSchemeName1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *OrganisationIdentificationSchemeName1Choice) DeepCopyInto(out *OrganisationIdentificationSchemeName1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *OtherContact1) DeepCopy() *OtherContact1 {
+	if r == nil {
+		return nil
+	}
+	out := new(OtherContact1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *OtherContact1) DeepCopyInto(out *OtherContact1) {
+	*out = *r
+	if r.Id != nil {
+		out.Id = new(common.Max128Text)
+		*out.Id = *r.Id
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *Pagination1) DeepCopy() *Pagination1 {
+	if r == nil {
+		return nil
+	}
+	out := new(Pagination1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *Pagination1) DeepCopyInto(out *Pagination1) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ParentCashAccount3) DeepCopy() *ParentCashAccount3 {
+	if r == nil {
+		return nil
+	}
+	out := new(ParentCashAccount3)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ParentCashAccount3) DeepCopyInto(out *ParentCashAccount3) {
+	*out = *r
+	if r.Lvl != nil {
+		out.Lvl = new(AccountLevel1Code)
+		*out.Lvl = *r.Lvl
+	}
+	r.Id.DeepCopyInto(&out.Id)
+	if r.Svcr != nil {
+		out.Svcr = new(BranchAndFinancialInstitutionIdentification6)
+		r.Svcr.DeepCopyInto(out.Svcr)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *Party11Choice) DeepCopy() *Party11Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(Party11Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *Party11Choice) DeepCopyInto(out *Party11Choice) {
+	*out = *r
+	r.OrgId.DeepCopyInto(&out.OrgId)
+	r.PrvtId.DeepCopyInto(&out.PrvtId)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *Party12Choice) DeepCopy() *Party12Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(Party12Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *Party12Choice) DeepCopyInto(out *Party12Choice) {
+	*out = *r
+	r.Pty.DeepCopyInto(&out.Pty)
+	r.Agt.DeepCopyInto(&out.Agt)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *Party43Choice) DeepCopy() *Party43Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(Party43Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *Party43Choice) DeepCopyInto(out *Party43Choice) {
+	*out = *r
+	r.OrgId.DeepCopyInto(&out.OrgId)
+	r.FIId.DeepCopyInto(&out.FIId)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *PartyIdentification138) DeepCopy() *PartyIdentification138 {
+	if r == nil {
+		return nil
+	}
+	out := new(PartyIdentification138)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *PartyIdentification138) DeepCopyInto(out *PartyIdentification138) {
+	*out = *r
+	if r.LglNm != nil {
+		out.LglNm = new(common.Max140Text)
+		*out.LglNm = *r.LglNm
+	}
+	if r.PstlAdr != nil {
+		out.PstlAdr = new(PostalAddress24)
+		r.PstlAdr.DeepCopyInto(out.PstlAdr)
+	}
+	r.Id.DeepCopyInto(&out.Id)
+	if r.CtryOfRes != nil {
+		out.CtryOfRes = new(common.CountryCode)
+		*out.CtryOfRes = *r.CtryOfRes
+	}
+	if r.CtctDtls != nil {
+		out.CtctDtls = new(Contact4)
+		r.CtctDtls.DeepCopyInto(out.CtctDtls)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *PartyIdentification43) DeepCopy() *PartyIdentification43 {
+	if r == nil {
+		return nil
+	}
+	out := new(PartyIdentification43)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *PartyIdentification43) DeepCopyInto(out *PartyIdentification43) {
+	*out = *r
+	if r.Nm != nil {
+		out.Nm = new(common.Max140Text)
+		*out.Nm = *r.Nm
+	}
+	if r.PstlAdr != nil {
+		out.PstlAdr = new(PostalAddress6)
+		r.PstlAdr.DeepCopyInto(out.PstlAdr)
+	}
+	if r.Id != nil {
+		out.Id = new(Party11Choice)
+		r.Id.DeepCopyInto(out.Id)
+	}
+	if r.CtryOfRes != nil {
+		out.CtryOfRes = new(common.CountryCode)
+		*out.CtryOfRes = *r.CtryOfRes
+	}
+	if r.CtctDtls != nil {
+		out.CtctDtls = new(ContactDetails2)
+		r.CtctDtls.DeepCopyInto(out.CtctDtls)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *PersonIdentification5) DeepCopy() *PersonIdentification5 {
+	if r == nil {
+		return nil
+	}
+	out := new(PersonIdentification5)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *PersonIdentification5) DeepCopyInto(out *PersonIdentification5) {
+	*out = *r
+	if r.DtAndPlcOfBirth != nil {
+		out.DtAndPlcOfBirth = new(DateAndPlaceOfBirth)
+		r.DtAndPlcOfBirth.DeepCopyInto(out.DtAndPlcOfBirth)
+	}
+	if r.Othr != nil {
+		out.Othr = make([]GenericPersonIdentification1, len(r.Othr))
+		for i := range r.Othr {
+			r.Othr[i].DeepCopyInto(&out.Othr[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *PersonIdentificationSchemeName1Choice) DeepCopy() *PersonIdentificationSchemeName1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(PersonIdentificationSchemeName1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *PersonIdentificationSchemeName1Choice) DeepCopyInto(out *PersonIdentificationSchemeName1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *PostalAddress24) DeepCopy() *PostalAddress24 {
+	if r == nil {
+		return nil
+	}
+	out := new(PostalAddress24)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *PostalAddress24) DeepCopyInto(out *PostalAddress24) {
+	*out = *r
+	if r.AdrTp != nil {
+		out.AdrTp = new(AddressType3Choice)
+		r.AdrTp.DeepCopyInto(out.AdrTp)
+	}
+	if r.Dept != nil {
+		out.Dept = new(common.Max70Text)
+		*out.Dept = *r.Dept
+	}
+	if r.SubDept != nil {
+		out.SubDept = new(common.Max70Text)
+		*out.SubDept = *r.SubDept
+	}
+	if r.StrtNm != nil {
+		out.StrtNm = new(common.Max70Text)
+		*out.StrtNm = *r.StrtNm
+	}
+	if r.BldgNb != nil {
+		out.BldgNb = new(common.Max16Text)
+		*out.BldgNb = *r.BldgNb
+	}
+	if r.BldgNm != nil {
+		out.BldgNm = new(common.Max35Text)
+		*out.BldgNm = *r.BldgNm
+	}
+	if r.Flr != nil {
+		out.Flr = new(common.Max70Text)
+		*out.Flr = *r.Flr
+	}
+	if r.PstBx != nil {
+		out.PstBx = new(common.Max16Text)
+		*out.PstBx = *r.PstBx
+	}
+	if r.Room != nil {
+		out.Room = new(common.Max70Text)
+		*out.Room = *r.Room
+	}
+	if r.PstCd != nil {
+		out.PstCd = new(common.Max16Text)
+		*out.PstCd = *r.PstCd
+	}
+	if r.TwnNm != nil {
+		out.TwnNm = new(common.Max35Text)
+		*out.TwnNm = *r.TwnNm
+	}
+	if r.TwnLctnNm != nil {
+		out.TwnLctnNm = new(common.Max35Text)
+		*out.TwnLctnNm = *r.TwnLctnNm
+	}
+	if r.DstrctNm != nil {
+		out.DstrctNm = new(common.Max35Text)
+		*out.DstrctNm = *r.DstrctNm
+	}
+	if r.CtrySubDvsn != nil {
+		out.CtrySubDvsn = new(common.Max35Text)
+		*out.CtrySubDvsn = *r.CtrySubDvsn
+	}
+	if r.Ctry != nil {
+		out.Ctry = new(common.CountryCode)
+		*out.Ctry = *r.Ctry
+	}
+	if r.AdrLine != nil {
+		out.AdrLine = make([]common.Max70Text, len(r.AdrLine))
+		copy(out.AdrLine, r.AdrLine)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *PostalAddress6) DeepCopy() *PostalAddress6 {
+	if r == nil {
+		return nil
+	}
+	out := new(PostalAddress6)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *PostalAddress6) DeepCopyInto(out *PostalAddress6) {
+	*out = *r
+	if r.AdrTp != nil {
+		out.AdrTp = new(common.AddressType2Code)
+		*out.AdrTp = *r.AdrTp
+	}
+	if r.Dept != nil {
+		out.Dept = new(common.Max70Text)
+		*out.Dept = *r.Dept
+	}
+	if r.SubDept != nil {
+		out.SubDept = new(common.Max70Text)
+		*out.SubDept = *r.SubDept
+	}
+	if r.StrtNm != nil {
+		out.StrtNm = new(common.Max70Text)
+		*out.StrtNm = *r.StrtNm
+	}
+	if r.BldgNb != nil {
+		out.BldgNb = new(common.Max16Text)
+		*out.BldgNb = *r.BldgNb
+	}
+	if r.PstCd != nil {
+		out.PstCd = new(common.Max16Text)
+		*out.PstCd = *r.PstCd
+	}
+	if r.TwnNm != nil {
+		out.TwnNm = new(common.Max35Text)
+		*out.TwnNm = *r.TwnNm
+	}
+	if r.CtrySubDvsn != nil {
+		out.CtrySubDvsn = new(common.Max35Text)
+		*out.CtrySubDvsn = *r.CtrySubDvsn
+	}
+	if r.Ctry != nil {
+		out.Ctry = new(common.CountryCode)
+		*out.Ctry = *r.Ctry
+	}
+	if r.AdrLine != nil {
+		out.AdrLine = make([]common.Max70Text, len(r.AdrLine))
+		copy(out.AdrLine, r.AdrLine)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ProprietaryBankTransactionCodeStructure1) DeepCopy() *ProprietaryBankTransactionCodeStructure1 {
+	if r == nil {
+		return nil
+	}
+	out := new(ProprietaryBankTransactionCodeStructure1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ProprietaryBankTransactionCodeStructure1) DeepCopyInto(out *ProprietaryBankTransactionCodeStructure1) {
+	*out = *r
+	if r.Issr != nil {
+		out.Issr = new(common.Max35Text)
+		*out.Issr = *r.Issr
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ProprietaryData3) DeepCopy() *ProprietaryData3 {
+	if r == nil {
+		return nil
+	}
+	out := new(ProprietaryData3)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ProprietaryData3) DeepCopyInto(out *ProprietaryData3) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ProprietaryData4) DeepCopy() *ProprietaryData4 {
+	if r == nil {
+		return nil
+	}
+	out := new(ProprietaryData4)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ProprietaryData4) DeepCopyInto(out *ProprietaryData4) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ProprietaryFormatInvestigationV03) DeepCopy() *ProprietaryFormatInvestigationV03 {
+	if r == nil {
+		return nil
+	}
+	out := new(ProprietaryFormatInvestigationV03)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ProprietaryFormatInvestigationV03) DeepCopyInto(out *ProprietaryFormatInvestigationV03) {
+	*out = *r
+	r.Assgnmt.DeepCopyInto(&out.Assgnmt)
+	r.Case.DeepCopyInto(&out.Case)
+	if r.SplmtryData != nil {
+		out.SplmtryData = make([]SupplementaryData1, len(r.SplmtryData))
+		for i := range r.SplmtryData {
+			r.SplmtryData[i].DeepCopyInto(&out.SplmtryData[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ProxyAccountIdentification1) DeepCopy() *ProxyAccountIdentification1 {
+	if r == nil {
+		return nil
+	}
+	out := new(ProxyAccountIdentification1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ProxyAccountIdentification1) DeepCopyInto(out *ProxyAccountIdentification1) {
+	*out = *r
+	if r.Tp != nil {
+		out.Tp = new(ProxyAccountType1Choice)
+		*out.Tp = *r.Tp
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ProxyAccountType1Choice) DeepCopy() *ProxyAccountType1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(ProxyAccountType1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ProxyAccountType1Choice) DeepCopyInto(out *ProxyAccountType1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ReportHeader6) DeepCopy() *ReportHeader6 {
+	if r == nil {
+		return nil
+	}
+	out := new(ReportHeader6)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ReportHeader6) DeepCopyInto(out *ReportHeader6) {
+	*out = *r
+	if r.MsgPgntn != nil {
+		out.MsgPgntn = new(Pagination1)
+		*out.MsgPgntn = *r.MsgPgntn
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *RequestType3Choice) DeepCopy() *RequestType3Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(RequestType3Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *RequestType3Choice) DeepCopyInto(out *RequestType3Choice) {
+	*out = *r
+	r.Prtry.DeepCopyInto(&out.Prtry)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ResidenceLocation1Choice) DeepCopy() *ResidenceLocation1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(ResidenceLocation1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ResidenceLocation1Choice) DeepCopyInto(out *ResidenceLocation1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ServiceTaxDesignation1) DeepCopy() *ServiceTaxDesignation1 {
+	if r == nil {
+		return nil
+	}
+	out := new(ServiceTaxDesignation1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ServiceTaxDesignation1) DeepCopyInto(out *ServiceTaxDesignation1) {
+	*out = *r
+	if r.Rgn != nil {
+		out.Rgn = new(common.Max35Text)
+		*out.Rgn = *r.Rgn
+	}
+	if r.TaxRsn != nil {
+		out.TaxRsn = make([]TaxReason1, len(r.TaxRsn))
+		copy(out.TaxRsn, r.TaxRsn)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *StandingOrderCriteria3) DeepCopy() *StandingOrderCriteria3 {
+	if r == nil {
+		return nil
+	}
+	out := new(StandingOrderCriteria3)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *StandingOrderCriteria3) DeepCopyInto(out *StandingOrderCriteria3) {
+	*out = *r
+	if r.NewQryNm != nil {
+		out.NewQryNm = new(common.Max35Text)
+		*out.NewQryNm = *r.NewQryNm
+	}
+	if r.SchCrit != nil {
+		out.SchCrit = make([]StandingOrderSearchCriteria3, len(r.SchCrit))
+		for i := range r.SchCrit {
+			r.SchCrit[i].DeepCopyInto(&out.SchCrit[i])
+		}
+	}
+	if r.RtrCrit != nil {
+		out.RtrCrit = new(StandingOrderReturnCriteria1)
+		*out.RtrCrit = *r.RtrCrit
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *StandingOrderCriteria3Choice) DeepCopy() *StandingOrderCriteria3Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(StandingOrderCriteria3Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *StandingOrderCriteria3Choice) DeepCopyInto(out *StandingOrderCriteria3Choice) {
+	*out = *r
+	r.NewCrit.DeepCopyInto(&out.NewCrit)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *StandingOrderIdentification4) DeepCopy() *StandingOrderIdentification4 {
+	if r == nil {
+		return nil
+	}
+	out := new(StandingOrderIdentification4)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *StandingOrderIdentification4) DeepCopyInto(out *StandingOrderIdentification4) {
+	*out = *r
+	if r.Id != nil {
+		out.Id = new(common.Max35Text)
+		*out.Id = *r.Id
+	}
+	r.Acct.DeepCopyInto(&out.Acct)
+	if r.AcctOwnr != nil {
+		out.AcctOwnr = new(BranchAndFinancialInstitutionIdentification6)
+		r.AcctOwnr.DeepCopyInto(out.AcctOwnr)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *StandingOrderIdentification5) DeepCopy() *StandingOrderIdentification5 {
+	if r == nil {
+		return nil
+	}
+	out := new(StandingOrderIdentification5)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *StandingOrderIdentification5) DeepCopyInto(out *StandingOrderIdentification5) {
+	*out = *r
+	r.Acct.DeepCopyInto(&out.Acct)
+	if r.AcctOwnr != nil {
+		out.AcctOwnr = new(BranchAndFinancialInstitutionIdentification6)
+		r.AcctOwnr.DeepCopyInto(out.AcctOwnr)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *StandingOrderOrAll2Choice) DeepCopy() *StandingOrderOrAll2Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(StandingOrderOrAll2Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *StandingOrderOrAll2Choice) DeepCopyInto(out *StandingOrderOrAll2Choice) {
+	*out = *r
+	if r.StgOrdr != nil {
+		out.StgOrdr = make([]StandingOrderIdentification4, len(r.StgOrdr))
+		for i := range r.StgOrdr {
+			r.StgOrdr[i].DeepCopyInto(&out.StgOrdr[i])
+		}
+	}
+	if r.AllStgOrdrs != nil {
+		out.AllStgOrdrs = make([]StandingOrderIdentification5, len(r.AllStgOrdrs))
+		for i := range r.AllStgOrdrs {
+			r.AllStgOrdrs[i].DeepCopyInto(&out.AllStgOrdrs[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *StandingOrderQuery3) DeepCopy() *StandingOrderQuery3 {
+	if r == nil {
+		return nil
+	}
+	out := new(StandingOrderQuery3)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *StandingOrderQuery3) DeepCopyInto(out *StandingOrderQuery3) {
+	*out = *r
+	if r.QryTp != nil {
+		out.QryTp = new(QueryType2Code)
+		*out.QryTp = *r.QryTp
+	}
+	if r.StgOrdrCrit != nil {
+		out.StgOrdrCrit = new(StandingOrderCriteria3Choice)
+		r.StgOrdrCrit.DeepCopyInto(out.StgOrdrCrit)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *StandingOrderReturnCriteria1) DeepCopy() *StandingOrderReturnCriteria1 {
+	if r == nil {
+		return nil
+	}
+	out := new(StandingOrderReturnCriteria1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *StandingOrderReturnCriteria1) DeepCopyInto(out *StandingOrderReturnCriteria1) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *StandingOrderSearchCriteria3) DeepCopy() *StandingOrderSearchCriteria3 {
+	if r == nil {
+		return nil
+	}
+	out := new(StandingOrderSearchCriteria3)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *StandingOrderSearchCriteria3) DeepCopyInto(out *StandingOrderSearchCriteria3) {
+	*out = *r
+	if r.StgOrdrId != nil {
+		out.StgOrdrId = new(common.Max35Text)
+		*out.StgOrdrId = *r.StgOrdrId
+	}
+	if r.Tp != nil {
+		out.Tp = new(StandingOrderType1Choice)
+		r.Tp.DeepCopyInto(out.Tp)
+	}
+	if r.Acct != nil {
+		out.Acct = new(CashAccount38)
+		r.Acct.DeepCopyInto(out.Acct)
+	}
+	if r.Ccy != nil {
+		out.Ccy = new(common.ActiveCurrencyCode)
+		*out.Ccy = *r.Ccy
+	}
+	if r.VldtyPrd != nil {
+		out.VldtyPrd = new(DatePeriod2Choice)
+		*out.VldtyPrd = *r.VldtyPrd
+	}
+	if r.SysMmb != nil {
+		out.SysMmb = new(BranchAndFinancialInstitutionIdentification6)
+		r.SysMmb.DeepCopyInto(out.SysMmb)
+	}
+	if r.RspnsblPty != nil {
+		out.RspnsblPty = new(BranchAndFinancialInstitutionIdentification6)
+		r.RspnsblPty.DeepCopyInto(out.RspnsblPty)
+	}
+	if r.AssoctdPoolAcct != nil {
+		out.AssoctdPoolAcct = new(AccountIdentification4Choice)
+		r.AssoctdPoolAcct.DeepCopyInto(out.AssoctdPoolAcct)
+	}
+	if r.LkSetId != nil {
+		out.LkSetId = new(common.Max35Text)
+		*out.LkSetId = *r.LkSetId
+	}
+	if r.LkSetOrdrId != nil {
+		out.LkSetOrdrId = new(common.Max35Text)
+		*out.LkSetOrdrId = *r.LkSetOrdrId
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *StandingOrderType1Choice) DeepCopy() *StandingOrderType1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(StandingOrderType1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *StandingOrderType1Choice) DeepCopyInto(out *StandingOrderType1Choice) {
+	*out = *r
+	r.Prtry.DeepCopyInto(&out.Prtry)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *StatementGroup3) DeepCopy() *StatementGroup3 {
+	if r == nil {
+		return nil
+	}
+	out := new(StatementGroup3)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *StatementGroup3) DeepCopyInto(out *StatementGroup3) {
+	*out = *r
+	r.Sndr.DeepCopyInto(&out.Sndr)
+	if r.SndrIndvCtct != nil {
+		out.SndrIndvCtct = make([]Contact4, len(r.SndrIndvCtct))
+		for i := range r.SndrIndvCtct {
+			r.SndrIndvCtct[i].DeepCopyInto(&out.SndrIndvCtct[i])
+		}
+	}
+	r.Rcvr.DeepCopyInto(&out.Rcvr)
+	if r.RcvrIndvCtct != nil {
+		out.RcvrIndvCtct = make([]Contact4, len(r.RcvrIndvCtct))
+		for i := range r.RcvrIndvCtct {
+			r.RcvrIndvCtct[i].DeepCopyInto(&out.RcvrIndvCtct[i])
+		}
+	}
+	if r.BllgStmt != nil {
+		out.BllgStmt = make([]BillingStatement3, len(r.BllgStmt))
+		for i := range r.BllgStmt {
+			r.BllgStmt[i].DeepCopyInto(&out.BllgStmt[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *SupplementaryData1) DeepCopy() *SupplementaryData1 {
+	if r == nil {
+		return nil
+	}
+	out := new(SupplementaryData1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *SupplementaryData1) DeepCopyInto(out *SupplementaryData1) {
+	*out = *r
+	if r.PlcAndNm != nil {
+		out.PlcAndNm = new(common.Max350Text)
+		*out.PlcAndNm = *r.PlcAndNm
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *SupplementaryDataEnvelope1) DeepCopy() *SupplementaryDataEnvelope1 {
+	if r == nil {
+		return nil
+	}
+	out := new(SupplementaryDataEnvelope1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *SupplementaryDataEnvelope1) DeepCopyInto(out *SupplementaryDataEnvelope1) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *TaxCalculation1) DeepCopy() *TaxCalculation1 {
+	if r == nil {
+		return nil
+	}
+	out := new(TaxCalculation1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *TaxCalculation1) DeepCopyInto(out *TaxCalculation1) {
+	*out = *r
+	if r.TaxblSvcChrgConvs != nil {
+		out.TaxblSvcChrgConvs = make([]BillingServicesAmount3, len(r.TaxblSvcChrgConvs))
+		copy(out.TaxblSvcChrgConvs, r.TaxblSvcChrgConvs)
+	}
+	if r.TaxId != nil {
+		out.TaxId = make([]BillingServicesTax3, len(r.TaxId))
+		for i := range r.TaxId {
+			r.TaxId[i].DeepCopyInto(&out.TaxId[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *TaxReason1) DeepCopy() *TaxReason1 {
+	if r == nil {
+		return nil
+	}
+	out := new(TaxReason1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *TaxReason1) DeepCopyInto(out *TaxReason1) {
+	*out = *r
+}
@@ -0,0 +1,2357 @@
+// Code generated by deepcopy-gen. DO NOT EDIT.
+
+package camt_v04
+
+import (
+	"github.com/moov-io/iso20022/pkg/common"
+)
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *AccountIdentification4Choice) DeepCopy() *AccountIdentification4Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(AccountIdentification4Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *AccountIdentification4Choice) DeepCopyInto(out *AccountIdentification4Choice) {
+	*out = *r
+	r.Othr.DeepCopyInto(&out.Othr)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *AccountSchemeName1Choice) DeepCopy() *AccountSchemeName1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(AccountSchemeName1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *AccountSchemeName1Choice) DeepCopyInto(out *AccountSchemeName1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ActiveCurrencyAndAmount) DeepCopy() *ActiveCurrencyAndAmount {
+	if r == nil {
+		return nil
+	}
+	out := new(ActiveCurrencyAndAmount)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ActiveCurrencyAndAmount) DeepCopyInto(out *ActiveCurrencyAndAmount) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *AddressType3Choice) DeepCopy() *AddressType3Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(AddressType3Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *AddressType3Choice) DeepCopyInto(out *AddressType3Choice) {
+	*out = *r
+	r.Prtry.DeepCopyInto(&out.Prtry)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *Amount2Choice) DeepCopy() *Amount2Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(Amount2Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *Amount2Choice) DeepCopyInto(out *Amount2Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *BranchAndFinancialInstitutionIdentification6) DeepCopy() *BranchAndFinancialInstitutionIdentification6 {
+	if r == nil {
+		return nil
+	}
+	out := new(BranchAndFinancialInstitutionIdentification6)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *BranchAndFinancialInstitutionIdentification6) DeepCopyInto(out *BranchAndFinancialInstitutionIdentification6) {
+	*out = *r
+	r.FinInstnId.DeepCopyInto(&out.FinInstnId)
+	if r.BrnchId != nil {
+		out.BrnchId = new(BranchData3)
+		r.BrnchId.DeepCopyInto(out.BrnchId)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *BranchData3) DeepCopy() *BranchData3 {
+	if r == nil {
+		return nil
+	}
+	out := new(BranchData3)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *BranchData3) DeepCopyInto(out *BranchData3) {
+	*out = *r
+	if r.Id != nil {
+		out.Id = new(common.Max35Text)
+		*out.Id = *r.Id
+	}
+	if r.LEI != nil {
+		out.LEI = new(common.LEIIdentifier)
+		*out.LEI = *r.LEI
+	}
+	if r.Nm != nil {
+		out.Nm = new(common.Max140Text)
+		*out.Nm = *r.Nm
+	}
+	if r.PstlAdr != nil {
+		out.PstlAdr = new(PostalAddress24)
+		r.PstlAdr.DeepCopyInto(out.PstlAdr)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *BusinessInformationCriteria1) DeepCopy() *BusinessInformationCriteria1 {
+	if r == nil {
+		return nil
+	}
+	out := new(BusinessInformationCriteria1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *BusinessInformationCriteria1) DeepCopyInto(out *BusinessInformationCriteria1) {
+	*out = *r
+	if r.NewQryNm != nil {
+		out.NewQryNm = new(common.Max35Text)
+		*out.NewQryNm = *r.NewQryNm
+	}
+	if r.SchCrit != nil {
+		out.SchCrit = make([]GeneralBusinessInformationSearchCriteria1, len(r.SchCrit))
+		for i := range r.SchCrit {
+			r.SchCrit[i].DeepCopyInto(&out.SchCrit[i])
+		}
+	}
+	if r.RtrCrit != nil {
+		out.RtrCrit = new(GeneralBusinessInformationReturnCriteria1)
+		*out.RtrCrit = *r.RtrCrit
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *BusinessInformationQueryDefinition3) DeepCopy() *BusinessInformationQueryDefinition3 {
+	if r == nil {
+		return nil
+	}
+	out := new(BusinessInformationQueryDefinition3)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *BusinessInformationQueryDefinition3) DeepCopyInto(out *BusinessInformationQueryDefinition3) {
+	*out = *r
+	if r.QryTp != nil {
+		out.QryTp = new(QueryType2Code)
+		*out.QryTp = *r.QryTp
+	}
+	if r.GnlBizInfCrit != nil {
+		out.GnlBizInfCrit = new(GeneralBusinessInformationCriteriaDefinition1Choice)
+		r.GnlBizInfCrit.DeepCopyInto(out.GnlBizInfCrit)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CancelCaseAssignmentV04) DeepCopy() *CancelCaseAssignmentV04 {
+	if r == nil {
+		return nil
+	}
+	out := new(CancelCaseAssignmentV04)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CancelCaseAssignmentV04) DeepCopyInto(out *CancelCaseAssignmentV04) {
+	*out = *r
+	r.Assgnmt.DeepCopyInto(&out.Assgnmt)
+	r.Case.DeepCopyInto(&out.Case)
+	if r.SplmtryData != nil {
+		out.SplmtryData = make([]SupplementaryData1, len(r.SplmtryData))
+		for i := range r.SplmtryData {
+			r.SplmtryData[i].DeepCopyInto(&out.SplmtryData[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *Case5) DeepCopy() *Case5 {
+	if r == nil {
+		return nil
+	}
+	out := new(Case5)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *Case5) DeepCopyInto(out *Case5) {
+	*out = *r
+	r.Cretr.DeepCopyInto(&out.Cretr)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CaseAssignment5) DeepCopy() *CaseAssignment5 {
+	if r == nil {
+		return nil
+	}
+	out := new(CaseAssignment5)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CaseAssignment5) DeepCopyInto(out *CaseAssignment5) {
+	*out = *r
+	r.Assgnr.DeepCopyInto(&out.Assgnr)
+	r.Assgne.DeepCopyInto(&out.Assgne)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CaseStatusReportRequestV04) DeepCopy() *CaseStatusReportRequestV04 {
+	if r == nil {
+		return nil
+	}
+	out := new(CaseStatusReportRequestV04)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CaseStatusReportRequestV04) DeepCopyInto(out *CaseStatusReportRequestV04) {
+	*out = *r
+	r.ReqHdr.DeepCopyInto(&out.ReqHdr)
+	r.Case.DeepCopyInto(&out.Case)
+	if r.SplmtryData != nil {
+		out.SplmtryData = make([]SupplementaryData1, len(r.SplmtryData))
+		for i := range r.SplmtryData {
+			r.SplmtryData[i].DeepCopyInto(&out.SplmtryData[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CashAccount38) DeepCopy() *CashAccount38 {
+	if r == nil {
+		return nil
+	}
+	out := new(CashAccount38)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CashAccount38) DeepCopyInto(out *CashAccount38) {
+	*out = *r
+	r.Id.DeepCopyInto(&out.Id)
+	if r.Tp != nil {
+		out.Tp = new(CashAccountType2Choice)
+		*out.Tp = *r.Tp
+	}
+	if r.Ccy != nil {
+		out.Ccy = new(common.ActiveOrHistoricCurrencyCode)
+		*out.Ccy = *r.Ccy
+	}
+	if r.Nm != nil {
+		out.Nm = new(common.Max70Text)
+		*out.Nm = *r.Nm
+	}
+	if r.Prxy != nil {
+		out.Prxy = new(ProxyAccountIdentification1)
+		r.Prxy.DeepCopyInto(out.Prxy)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CashAccountType2Choice) DeepCopy() *CashAccountType2Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(CashAccountType2Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CashAccountType2Choice) DeepCopyInto(out *CashAccountType2Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CharacterSearch1Choice) DeepCopy() *CharacterSearch1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(CharacterSearch1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CharacterSearch1Choice) DeepCopyInto(out *CharacterSearch1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ClearingSystemIdentification2Choice) DeepCopy() *ClearingSystemIdentification2Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(ClearingSystemIdentification2Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ClearingSystemIdentification2Choice) DeepCopyInto(out *ClearingSystemIdentification2Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ClearingSystemMemberIdentification2) DeepCopy() *ClearingSystemMemberIdentification2 {
+	if r == nil {
+		return nil
+	}
+	out := new(ClearingSystemMemberIdentification2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ClearingSystemMemberIdentification2) DeepCopyInto(out *ClearingSystemMemberIdentification2) {
+	*out = *r
+	if r.ClrSysId != nil {
+		out.ClrSysId = new(ClearingSystemIdentification2Choice)
+		*out.ClrSysId = *r.ClrSysId
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CommunicationAddress10) DeepCopy() *CommunicationAddress10 {
+	if r == nil {
+		return nil
+	}
+	out := new(CommunicationAddress10)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CommunicationAddress10) DeepCopyInto(out *CommunicationAddress10) {
+	*out = *r
+	r.PstlAdr.DeepCopyInto(&out.PstlAdr)
+	if r.FaxNb != nil {
+		out.FaxNb = new(common.PhoneNumber)
+		*out.FaxNb = *r.FaxNb
+	}
+	if r.EmailAdr != nil {
+		out.EmailAdr = new(common.Max2048Text)
+		*out.EmailAdr = *r.EmailAdr
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CommunicationAddress8) DeepCopy() *CommunicationAddress8 {
+	if r == nil {
+		return nil
+	}
+	out := new(CommunicationAddress8)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CommunicationAddress8) DeepCopyInto(out *CommunicationAddress8) {
+	*out = *r
+	r.PstlAdr.DeepCopyInto(&out.PstlAdr)
+	if r.FaxNb != nil {
+		out.FaxNb = new(common.PhoneNumber)
+		*out.FaxNb = *r.FaxNb
+	}
+	if r.EmailAdr != nil {
+		out.EmailAdr = new(common.Max256Text)
+		*out.EmailAdr = *r.EmailAdr
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *Contact4) DeepCopy() *Contact4 {
+	if r == nil {
+		return nil
+	}
+	out := new(Contact4)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *Contact4) DeepCopyInto(out *Contact4) {
+	*out = *r
+	if r.NmPrfx != nil {
+		out.NmPrfx = new(common.NamePrefix2Code)
+		*out.NmPrfx = *r.NmPrfx
+	}
+	if r.Nm != nil {
+		out.Nm = new(common.Max140Text)
+		*out.Nm = *r.Nm
+	}
+	if r.PhneNb != nil {
+		out.PhneNb = new(common.PhoneNumber)
+		*out.PhneNb = *r.PhneNb
+	}
+	if r.MobNb != nil {
+		out.MobNb = new(common.PhoneNumber)
+		*out.MobNb = *r.MobNb
+	}
+	if r.FaxNb != nil {
+		out.FaxNb = new(common.PhoneNumber)
+		*out.FaxNb = *r.FaxNb
+	}
+	if r.EmailAdr != nil {
+		out.EmailAdr = new(common.Max2048Text)
+		*out.EmailAdr = *r.EmailAdr
+	}
+	if r.EmailPurp != nil {
+		out.EmailPurp = new(common.Max35Text)
+		*out.EmailPurp = *r.EmailPurp
+	}
+	if r.JobTitl != nil {
+		out.JobTitl = new(common.Max35Text)
+		*out.JobTitl = *r.JobTitl
+	}
+	if r.Rspnsblty != nil {
+		out.Rspnsblty = new(common.Max35Text)
+		*out.Rspnsblty = *r.Rspnsblty
+	}
+	if r.Dept != nil {
+		out.Dept = new(common.Max70Text)
+		*out.Dept = *r.Dept
+	}
+	if r.Othr != nil {
+		out.Othr = make([]OtherContact1, len(r.Othr))
+		for i := range r.Othr {
+			r.Othr[i].DeepCopyInto(&out.Othr[i])
+		}
+	}
+	if r.PrefrdMtd != nil {
+		out.PrefrdMtd = new(PreferredContactMethod1Code)
+		*out.PrefrdMtd = *r.PrefrdMtd
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ContactIdentificationAndAddress1) DeepCopy() *ContactIdentificationAndAddress1 {
+	if r == nil {
+		return nil
+	}
+	out := new(ContactIdentificationAndAddress1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ContactIdentificationAndAddress1) DeepCopyInto(out *ContactIdentificationAndAddress1) {
+	*out = *r
+	if r.Nm != nil {
+		out.Nm = new(common.Max35Text)
+		*out.Nm = *r.Nm
+	}
+	r.ComAdr.DeepCopyInto(&out.ComAdr)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ContactIdentificationAndAddress2) DeepCopy() *ContactIdentificationAndAddress2 {
+	if r == nil {
+		return nil
+	}
+	out := new(ContactIdentificationAndAddress2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ContactIdentificationAndAddress2) DeepCopyInto(out *ContactIdentificationAndAddress2) {
+	*out = *r
+	if r.Nm != nil {
+		out.Nm = new(common.Max35Text)
+		*out.Nm = *r.Nm
+	}
+	r.ComAdr.DeepCopyInto(&out.ComAdr)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CurrencyCriteriaDefinition1Choice) DeepCopy() *CurrencyCriteriaDefinition1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(CurrencyCriteriaDefinition1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CurrencyCriteriaDefinition1Choice) DeepCopyInto(out *CurrencyCriteriaDefinition1Choice) {
+	*out = *r
+	r.NewCrit.DeepCopyInto(&out.NewCrit)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CurrencyExchange7) DeepCopy() *CurrencyExchange7 {
+	if r == nil {
+		return nil
+	}
+	out := new(CurrencyExchange7)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CurrencyExchange7) DeepCopyInto(out *CurrencyExchange7) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CurrencyExchangeCriteria2) DeepCopy() *CurrencyExchangeCriteria2 {
+	if r == nil {
+		return nil
+	}
+	out := new(CurrencyExchangeCriteria2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CurrencyExchangeCriteria2) DeepCopyInto(out *CurrencyExchangeCriteria2) {
+	*out = *r
+	if r.NewQryNm != nil {
+		out.NewQryNm = new(common.Max35Text)
+		*out.NewQryNm = *r.NewQryNm
+	}
+	if r.SchCrit != nil {
+		out.SchCrit = make([]CurrencyExchangeSearchCriteria1, len(r.SchCrit))
+		copy(out.SchCrit, r.SchCrit)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CurrencyExchangeReport3) DeepCopy() *CurrencyExchangeReport3 {
+	if r == nil {
+		return nil
+	}
+	out := new(CurrencyExchangeReport3)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CurrencyExchangeReport3) DeepCopyInto(out *CurrencyExchangeReport3) {
+	*out = *r
+	r.CcyXchgOrErr.DeepCopyInto(&out.CcyXchgOrErr)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CurrencyExchangeSearchCriteria1) DeepCopy() *CurrencyExchangeSearchCriteria1 {
+	if r == nil {
+		return nil
+	}
+	out := new(CurrencyExchangeSearchCriteria1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CurrencyExchangeSearchCriteria1) DeepCopyInto(out *CurrencyExchangeSearchCriteria1) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CurrencyQueryDefinition3) DeepCopy() *CurrencyQueryDefinition3 {
+	if r == nil {
+		return nil
+	}
+	out := new(CurrencyQueryDefinition3)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CurrencyQueryDefinition3) DeepCopyInto(out *CurrencyQueryDefinition3) {
+	*out = *r
+	if r.QryTp != nil {
+		out.QryTp = new(QueryType2Code)
+		*out.QryTp = *r.QryTp
+	}
+	if r.CcyCrit != nil {
+		out.CcyCrit = new(CurrencyCriteriaDefinition1Choice)
+		r.CcyCrit.DeepCopyInto(out.CcyCrit)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CurrencySourceTarget1) DeepCopy() *CurrencySourceTarget1 {
+	if r == nil {
+		return nil
+	}
+	out := new(CurrencySourceTarget1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CurrencySourceTarget1) DeepCopyInto(out *CurrencySourceTarget1) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *DateAndPlaceOfBirth1) DeepCopy() *DateAndPlaceOfBirth1 {
+	if r == nil {
+		return nil
+	}
+	out := new(DateAndPlaceOfBirth1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *DateAndPlaceOfBirth1) DeepCopyInto(out *DateAndPlaceOfBirth1) {
+	*out = *r
+	if r.PrvcOfBirth != nil {
+		out.PrvcOfBirth = new(common.Max35Text)
+		*out.PrvcOfBirth = *r.PrvcOfBirth
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *DatePeriodDetails1) DeepCopy() *DatePeriodDetails1 {
+	if r == nil {
+		return nil
+	}
+	out := new(DatePeriodDetails1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *DatePeriodDetails1) DeepCopyInto(out *DatePeriodDetails1) {
+	*out = *r
+	if r.ToDt != nil {
+		out.ToDt = new(common.ISODate)
+		*out.ToDt = *r.ToDt
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ErrorHandling1Choice) DeepCopy() *ErrorHandling1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(ErrorHandling1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ErrorHandling1Choice) DeepCopyInto(out *ErrorHandling1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ErrorHandling3) DeepCopy() *ErrorHandling3 {
+	if r == nil {
+		return nil
+	}
+	out := new(ErrorHandling3)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ErrorHandling3) DeepCopyInto(out *ErrorHandling3) {
+	*out = *r
+	if r.Desc != nil {
+		out.Desc = new(common.Max140Text)
+		*out.Desc = *r.Desc
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ErrorHandling3Choice) DeepCopy() *ErrorHandling3Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(ErrorHandling3Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ErrorHandling3Choice) DeepCopyInto(out *ErrorHandling3Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ErrorHandling5) DeepCopy() *ErrorHandling5 {
+	if r == nil {
+		return nil
+	}
+	out := new(ErrorHandling5)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ErrorHandling5) DeepCopyInto(out *ErrorHandling5) {
+	*out = *r
+	if r.Desc != nil {
+		out.Desc = new(common.Max140Text)
+		*out.Desc = *r.Desc
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *EventType1Choice) DeepCopy() *EventType1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(EventType1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *EventType1Choice) DeepCopyInto(out *EventType1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ExchangeRateReportOrError1Choice) DeepCopy() *ExchangeRateReportOrError1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(ExchangeRateReportOrError1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ExchangeRateReportOrError1Choice) DeepCopyInto(out *ExchangeRateReportOrError1Choice) {
+	*out = *r
+	if r.CcyXchgRpt != nil {
+		out.CcyXchgRpt = make([]CurrencyExchangeReport3, len(r.CcyXchgRpt))
+		for i := range r.CcyXchgRpt {
+			r.CcyXchgRpt[i].DeepCopyInto(&out.CcyXchgRpt[i])
+		}
+	}
+	if r.OprlErr != nil {
+		out.OprlErr = make([]ErrorHandling3, len(r.OprlErr))
+		for i := range r.OprlErr {
+			r.OprlErr[i].DeepCopyInto(&out.OprlErr[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ExchangeRateReportOrError2Choice) DeepCopy() *ExchangeRateReportOrError2Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(ExchangeRateReportOrError2Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ExchangeRateReportOrError2Choice) DeepCopyInto(out *ExchangeRateReportOrError2Choice) {
+	*out = *r
+	if r.BizErr != nil {
+		out.BizErr = make([]ErrorHandling3, len(r.BizErr))
+		for i := range r.BizErr {
+			r.BizErr[i].DeepCopyInto(&out.BizErr[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ExecutionType1Choice) DeepCopy() *ExecutionType1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(ExecutionType1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ExecutionType1Choice) DeepCopyInto(out *ExecutionType1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *FinancialIdentificationSchemeName1Choice) DeepCopy() *FinancialIdentificationSchemeName1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(FinancialIdentificationSchemeName1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *FinancialIdentificationSchemeName1Choice) DeepCopyInto(out *FinancialIdentificationSchemeName1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *FinancialInstitutionIdentification18) DeepCopy() *FinancialInstitutionIdentification18 {
+	if r == nil {
+		return nil
+	}
+	out := new(FinancialInstitutionIdentification18)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *FinancialInstitutionIdentification18) DeepCopyInto(out *FinancialInstitutionIdentification18) {
+	*out = *r
+	if r.BICFI != nil {
+		out.BICFI = new(common.BICFIDec2014Identifier)
+		*out.BICFI = *r.BICFI
+	}
+	if r.ClrSysMmbId != nil {
+		out.ClrSysMmbId = new(ClearingSystemMemberIdentification2)
+		r.ClrSysMmbId.DeepCopyInto(out.ClrSysMmbId)
+	}
+	if r.LEI != nil {
+		out.LEI = new(common.LEIIdentifier)
+		*out.LEI = *r.LEI
+	}
+	if r.Nm != nil {
+		out.Nm = new(common.Max140Text)
+		*out.Nm = *r.Nm
+	}
+	if r.PstlAdr != nil {
+		out.PstlAdr = new(PostalAddress24)
+		r.PstlAdr.DeepCopyInto(out.PstlAdr)
+	}
+	if r.Othr != nil {
+		out.Othr = new(GenericFinancialIdentification1)
+		r.Othr.DeepCopyInto(out.Othr)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *GeneralBusinessInformationCriteriaDefinition1Choice) DeepCopy() *GeneralBusinessInformationCriteriaDefinition1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(GeneralBusinessInformationCriteriaDefinition1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *GeneralBusinessInformationCriteriaDefinition1Choice) DeepCopyInto(out *GeneralBusinessInformationCriteriaDefinition1Choice) {
+	*out = *r
+	r.NewCrit.DeepCopyInto(&out.NewCrit)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *GeneralBusinessInformationReturnCriteria1) DeepCopy() *GeneralBusinessInformationReturnCriteria1 {
+	if r == nil {
+		return nil
+	}
+	out := new(GeneralBusinessInformationReturnCriteria1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *GeneralBusinessInformationReturnCriteria1) DeepCopyInto(out *GeneralBusinessInformationReturnCriteria1) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *GeneralBusinessInformationSearchCriteria1) DeepCopy() *GeneralBusinessInformationSearchCriteria1 {
+	if r == nil {
+		return nil
+	}
+	out := new(GeneralBusinessInformationSearchCriteria1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *GeneralBusinessInformationSearchCriteria1) DeepCopyInto(out *GeneralBusinessInformationSearchCriteria1) {
+	*out = *r
+	if r.Ref != nil {
+		out.Ref = make([]common.Max35Text, len(r.Ref))
+		copy(out.Ref, r.Ref)
+	}
+	if r.Sbjt != nil {
+		out.Sbjt = make([]CharacterSearch1Choice, len(r.Sbjt))
+		copy(out.Sbjt, r.Sbjt)
+	}
+	if r.Qlfr != nil {
+		out.Qlfr = make([]InformationQualifierType1, len(r.Qlfr))
+		for i := range r.Qlfr {
+			r.Qlfr[i].DeepCopyInto(&out.Qlfr[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *GenericAccountIdentification1) DeepCopy() *GenericAccountIdentification1 {
+	if r == nil {
+		return nil
+	}
+	out := new(GenericAccountIdentification1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *GenericAccountIdentification1) DeepCopyInto(out *GenericAccountIdentification1) {
+	*out = *r
+	if r.SchmeNm != nil {
+		out.SchmeNm = new(AccountSchemeName1Choice)
+		*out.SchmeNm = *r.SchmeNm
+	}
+	if r.Issr != nil {
+		out.Issr = new(common.Max35Text)
+		*out.Issr = *r.Issr
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *GenericFinancialIdentification1) DeepCopy() *GenericFinancialIdentification1 {
+	if r == nil {
+		return nil
+	}
+	out := new(GenericFinancialIdentification1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *GenericFinancialIdentification1) DeepCopyInto(out *GenericFinancialIdentification1) {
+	*out = *r
+	if r.SchmeNm != nil {
+		out.SchmeNm = new(FinancialIdentificationSchemeName1Choice)
+		*out.SchmeNm = *r.SchmeNm
+	}
+	if r.Issr != nil {
+		out.Issr = new(common.Max35Text)
+		*out.Issr = *r.Issr
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *GenericIdentification1) DeepCopy() *GenericIdentification1 {
+	if r == nil {
+		return nil
+	}
+	out := new(GenericIdentification1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *GenericIdentification1) DeepCopyInto(out *GenericIdentification1) {
+	*out = *r
+	if r.SchmeNm != nil {
+		out.SchmeNm = new(common.Max35Text)
+		*out.SchmeNm = *r.SchmeNm
+	}
+	if r.Issr != nil {
+		out.Issr = new(common.Max35Text)
+		*out.Issr = *r.Issr
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *GenericIdentification30) DeepCopy() *GenericIdentification30 {
+	if r == nil {
+		return nil
+	}
+	out := new(GenericIdentification30)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *GenericIdentification30) DeepCopyInto(out *GenericIdentification30) {
+	*out = *r
+	if r.SchmeNm != nil {
+		out.SchmeNm = new(common.Max35Text)
+		*out.SchmeNm = *r.SchmeNm
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *GenericOrganisationIdentification1) DeepCopy() *GenericOrganisationIdentification1 {
+	if r == nil {
+		return nil
+	}
+	out := new(GenericOrganisationIdentification1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *GenericOrganisationIdentification1) DeepCopyInto(out *GenericOrganisationIdentification1) {
+	*out = *r
+	if r.SchmeNm != nil {
+		out.SchmeNm = new(OrganisationIdentificationSchemeName1Choice)
+		*out.SchmeNm = *r.SchmeNm
+	}
+	if r.Issr != nil {
+		out.Issr = new(common.Max35Text)
+		*out.Issr = *r.Issr
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *GenericPersonIdentification1) DeepCopy() *GenericPersonIdentification1 {
+	if r == nil {
+		return nil
+	}
+	out := new(GenericPersonIdentification1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *GenericPersonIdentification1) DeepCopyInto(out *GenericPersonIdentification1) {
+	*out = *r
+	if r.SchmeNm != nil {
+		out.SchmeNm = new(PersonIdentificationSchemeName1Choice)
+		*out.SchmeNm = *r.SchmeNm
+	}
+	if r.Issr != nil {
+		out.Issr = new(common.Max35Text)
+		*out.Issr = *r.Issr
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *GetCurrencyExchangeRateV04) DeepCopy() *GetCurrencyExchangeRateV04 {
+	if r == nil {
+		return nil
+	}
+	out := new(GetCurrencyExchangeRateV04)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *GetCurrencyExchangeRateV04) DeepCopyInto(out *GetCurrencyExchangeRateV04) {
+	*out = *r
+	r.MsgHdr.DeepCopyInto(&out.MsgHdr)
+	if r.CcyQryDef != nil {
+		out.CcyQryDef = new(CurrencyQueryDefinition3)
+		r.CcyQryDef.DeepCopyInto(out.CcyQryDef)
+	}
+	if r.SplmtryData != nil {
+		out.SplmtryData = make([]SupplementaryData1, len(r.SplmtryData))
+		for i := range r.SplmtryData {
+			r.SplmtryData[i].DeepCopyInto(&out.SplmtryData[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *GetGeneralBusinessInformationV04) DeepCopy() *GetGeneralBusinessInformationV04 {
+	if r == nil {
+		return nil
+	}
+	out := new(GetGeneralBusinessInformationV04)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *GetGeneralBusinessInformationV04) DeepCopyInto(out *GetGeneralBusinessInformationV04) {
+	*out = *r
+	r.MsgHdr.DeepCopyInto(&out.MsgHdr)
+	if r.GnlBizInfQryDef != nil {
+		out.GnlBizInfQryDef = new(BusinessInformationQueryDefinition3)
+		r.GnlBizInfQryDef.DeepCopyInto(out.GnlBizInfQryDef)
+	}
+	if r.SplmtryData != nil {
+		out.SplmtryData = make([]SupplementaryData1, len(r.SplmtryData))
+		for i := range r.SplmtryData {
+			r.SplmtryData[i].DeepCopyInto(&out.SplmtryData[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *GetMemberV04) DeepCopy() *GetMemberV04 {
+	if r == nil {
+		return nil
+	}
+	out := new(GetMemberV04)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *GetMemberV04) DeepCopyInto(out *GetMemberV04) {
+	*out = *r
+	r.MsgHdr.DeepCopyInto(&out.MsgHdr)
+	if r.MmbQryDef != nil {
+		out.MmbQryDef = new(MemberQueryDefinition4)
+		r.MmbQryDef.DeepCopyInto(out.MmbQryDef)
+	}
+	if r.SplmtryData != nil {
+		out.SplmtryData = make([]SupplementaryData1, len(r.SplmtryData))
+		for i := range r.SplmtryData {
+			r.SplmtryData[i].DeepCopyInto(&out.SplmtryData[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *InformationQualifierType1) DeepCopy() *InformationQualifierType1 {
+	if r == nil {
+		return nil
+	}
+	out := new(InformationQualifierType1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *InformationQualifierType1) DeepCopyInto(out *InformationQualifierType1) {
+	*out = *r
+	if r.Prty != nil {
+		out.Prty = new(Priority1Code)
+		*out.Prty = *r.Prty
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *LongPostalAddress1Choice) DeepCopy() *LongPostalAddress1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(LongPostalAddress1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *LongPostalAddress1Choice) DeepCopyInto(out *LongPostalAddress1Choice) {
+	*out = *r
+	r.Strd.DeepCopyInto(&out.Strd)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *Member5) DeepCopy() *Member5 {
+	if r == nil {
+		return nil
+	}
+	out := new(Member5)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *Member5) DeepCopyInto(out *Member5) {
+	*out = *r
+	if r.Nm != nil {
+		out.Nm = new(common.Max35Text)
+		*out.Nm = *r.Nm
+	}
+	if r.RtrAdr != nil {
+		out.RtrAdr = make([]MemberIdentification3Choice, len(r.RtrAdr))
+		for i := range r.RtrAdr {
+			r.RtrAdr[i].DeepCopyInto(&out.RtrAdr[i])
+		}
+	}
+	if r.Acct != nil {
+		out.Acct = make([]CashAccount38, len(r.Acct))
+		for i := range r.Acct {
+			r.Acct[i].DeepCopyInto(&out.Acct[i])
+		}
+	}
+	if r.Tp != nil {
+		out.Tp = new(SystemMemberType1Choice)
+		*out.Tp = *r.Tp
+	}
+	if r.Sts != nil {
+		out.Sts = new(SystemMemberStatus1Choice)
+		*out.Sts = *r.Sts
+	}
+	if r.CtctRef != nil {
+		out.CtctRef = make([]ContactIdentificationAndAddress2, len(r.CtctRef))
+		for i := range r.CtctRef {
+			r.CtctRef[i].DeepCopyInto(&out.CtctRef[i])
+		}
+	}
+	r.ComAdr.DeepCopyInto(&out.ComAdr)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *Member6) DeepCopy() *Member6 {
+	if r == nil {
+		return nil
+	}
+	out := new(Member6)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *Member6) DeepCopyInto(out *Member6) {
+	*out = *r
+	if r.MmbRtrAdr != nil {
+		out.MmbRtrAdr = make([]MemberIdentification3Choice, len(r.MmbRtrAdr))
+		for i := range r.MmbRtrAdr {
+			r.MmbRtrAdr[i].DeepCopyInto(&out.MmbRtrAdr[i])
+		}
+	}
+	if r.CtctRef != nil {
+		out.CtctRef = make([]ContactIdentificationAndAddress1, len(r.CtctRef))
+		for i := range r.CtctRef {
+			r.CtctRef[i].DeepCopyInto(&out.CtctRef[i])
+		}
+	}
+	if r.ComAdr != nil {
+		out.ComAdr = new(CommunicationAddress8)
+		r.ComAdr.DeepCopyInto(out.ComAdr)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *MemberCriteria4) DeepCopy() *MemberCriteria4 {
+	if r == nil {
+		return nil
+	}
+	out := new(MemberCriteria4)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *MemberCriteria4) DeepCopyInto(out *MemberCriteria4) {
+	*out = *r
+	if r.NewQryNm != nil {
+		out.NewQryNm = new(common.Max35Text)
+		*out.NewQryNm = *r.NewQryNm
+	}
+	if r.SchCrit != nil {
+		out.SchCrit = make([]MemberSearchCriteria4, len(r.SchCrit))
+		for i := range r.SchCrit {
+			r.SchCrit[i].DeepCopyInto(&out.SchCrit[i])
+		}
+	}
+	if r.RtrCrit != nil {
+		out.RtrCrit = new(MemberReturnCriteria1)
+		*out.RtrCrit = *r.RtrCrit
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *MemberCriteriaDefinition2Choice) DeepCopy() *MemberCriteriaDefinition2Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(MemberCriteriaDefinition2Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *MemberCriteriaDefinition2Choice) DeepCopyInto(out *MemberCriteriaDefinition2Choice) {
+	*out = *r
+	r.NewCrit.DeepCopyInto(&out.NewCrit)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *MemberIdentification3Choice) DeepCopy() *MemberIdentification3Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(MemberIdentification3Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *MemberIdentification3Choice) DeepCopyInto(out *MemberIdentification3Choice) {
+	*out = *r
+	r.ClrSysMmbId.DeepCopyInto(&out.ClrSysMmbId)
+	r.Othr.DeepCopyInto(&out.Othr)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *MemberQueryDefinition4) DeepCopy() *MemberQueryDefinition4 {
+	if r == nil {
+		return nil
+	}
+	out := new(MemberQueryDefinition4)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *MemberQueryDefinition4) DeepCopyInto(out *MemberQueryDefinition4) {
+	*out = *r
+	if r.QryTp != nil {
+		out.QryTp = new(QueryType2Code)
+		*out.QryTp = *r.QryTp
+	}
+	if r.MmbCrit != nil {
+		out.MmbCrit = new(MemberCriteriaDefinition2Choice)
+		r.MmbCrit.DeepCopyInto(out.MmbCrit)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *MemberReport5) DeepCopy() *MemberReport5 {
+	if r == nil {
+		return nil
+	}
+	out := new(MemberReport5)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *MemberReport5) DeepCopyInto(out *MemberReport5) {
+	*out = *r
+	r.MmbId.DeepCopyInto(&out.MmbId)
+	r.MmbOrErr.DeepCopyInto(&out.MmbOrErr)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *MemberReportOrError5Choice) DeepCopy() *MemberReportOrError5Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(MemberReportOrError5Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *MemberReportOrError5Choice) DeepCopyInto(out *MemberReportOrError5Choice) {
+	*out = *r
+	if r.Rpt != nil {
+		out.Rpt = make([]MemberReport5, len(r.Rpt))
+		for i := range r.Rpt {
+			r.Rpt[i].DeepCopyInto(&out.Rpt[i])
+		}
+	}
+	if r.OprlErr != nil {
+		out.OprlErr = make([]ErrorHandling3, len(r.OprlErr))
+		for i := range r.OprlErr {
+			r.OprlErr[i].DeepCopyInto(&out.OprlErr[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *MemberReportOrError6Choice) DeepCopy() *MemberReportOrError6Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(MemberReportOrError6Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *MemberReportOrError6Choice) DeepCopyInto(out *MemberReportOrError6Choice) {
+	*out = *r
+	r.Mmb.DeepCopyInto(&out.Mmb)
+	r.BizErr.DeepCopyInto(&out.BizErr)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *MemberReturnCriteria1) DeepCopy() *MemberReturnCriteria1 {
+	if r == nil {
+		return nil
+	}
+	out := new(MemberReturnCriteria1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *MemberReturnCriteria1) DeepCopyInto(out *MemberReturnCriteria1) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *MemberSearchCriteria4) DeepCopy() *MemberSearchCriteria4 {
+	if r == nil {
+		return nil
+	}
+	out := new(MemberSearchCriteria4)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *MemberSearchCriteria4) DeepCopyInto(out *MemberSearchCriteria4) {
+	*out = *r
+	if r.Id != nil {
+		out.Id = make([]MemberIdentification3Choice, len(r.Id))
+		for i := range r.Id {
+			r.Id[i].DeepCopyInto(&out.Id[i])
+		}
+	}
+	if r.Tp != nil {
+		out.Tp = make([]SystemMemberType1Choice, len(r.Tp))
+		copy(out.Tp, r.Tp)
+	}
+	if r.Sts != nil {
+		out.Sts = make([]SystemMemberStatus1Choice, len(r.Sts))
+		copy(out.Sts, r.Sts)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *MessageHeader1) DeepCopy() *MessageHeader1 {
+	if r == nil {
+		return nil
+	}
+	out := new(MessageHeader1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *MessageHeader1) DeepCopyInto(out *MessageHeader1) {
+	*out = *r
+	if r.CreDtTm != nil {
+		out.CreDtTm = new(common.ISODateTime)
+		*out.CreDtTm = *r.CreDtTm
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *MessageHeader6) DeepCopy() *MessageHeader6 {
+	if r == nil {
+		return nil
+	}
+	out := new(MessageHeader6)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *MessageHeader6) DeepCopyInto(out *MessageHeader6) {
+	*out = *r
+	if r.CreDtTm != nil {
+		out.CreDtTm = new(common.ISODateTime)
+		*out.CreDtTm = *r.CreDtTm
+	}
+	if r.OrgnlBizQry != nil {
+		out.OrgnlBizQry = new(OriginalBusinessQuery1)
+		r.OrgnlBizQry.DeepCopyInto(out.OrgnlBizQry)
+	}
+	if r.QryNm != nil {
+		out.QryNm = new(common.Max35Text)
+		*out.QryNm = *r.QryNm
+	}
+	if r.ReqTp != nil {
+		out.ReqTp = new(RequestType3Choice)
+		r.ReqTp.DeepCopyInto(out.ReqTp)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *MessageHeader7) DeepCopy() *MessageHeader7 {
+	if r == nil {
+		return nil
+	}
+	out := new(MessageHeader7)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *MessageHeader7) DeepCopyInto(out *MessageHeader7) {
+	*out = *r
+	if r.CreDtTm != nil {
+		out.CreDtTm = new(common.ISODateTime)
+		*out.CreDtTm = *r.CreDtTm
+	}
+	if r.ReqTp != nil {
+		out.ReqTp = new(RequestType4Choice)
+		r.ReqTp.DeepCopyInto(out.ReqTp)
+	}
+	if r.OrgnlBizQry != nil {
+		out.OrgnlBizQry = new(OriginalBusinessQuery1)
+		r.OrgnlBizQry.DeepCopyInto(out.OrgnlBizQry)
+	}
+	if r.QryNm != nil {
+		out.QryNm = new(common.Max35Text)
+		*out.QryNm = *r.QryNm
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *MessageHeader9) DeepCopy() *MessageHeader9 {
+	if r == nil {
+		return nil
+	}
+	out := new(MessageHeader9)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *MessageHeader9) DeepCopyInto(out *MessageHeader9) {
+	*out = *r
+	if r.CreDtTm != nil {
+		out.CreDtTm = new(common.ISODateTime)
+		*out.CreDtTm = *r.CreDtTm
+	}
+	if r.ReqTp != nil {
+		out.ReqTp = new(RequestType4Choice)
+		r.ReqTp.DeepCopyInto(out.ReqTp)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ModifyMemberV04) DeepCopy() *ModifyMemberV04 {
+	if r == nil {
+		return nil
+	}
+	out := new(ModifyMemberV04)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ModifyMemberV04) DeepCopyInto(out *ModifyMemberV04) {
+	*out = *r
+	r.MsgHdr.DeepCopyInto(&out.MsgHdr)
+	r.MmbId.DeepCopyInto(&out.MmbId)
+	r.NewMmbValSet.DeepCopyInto(&out.NewMmbValSet)
+	if r.SplmtryData != nil {
+		out.SplmtryData = make([]SupplementaryData1, len(r.SplmtryData))
+		for i := range r.SplmtryData {
+			r.SplmtryData[i].DeepCopyInto(&out.SplmtryData[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *OrganisationIdentification29) DeepCopy() *OrganisationIdentification29 {
+	if r == nil {
+		return nil
+	}
+	out := new(OrganisationIdentification29)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *OrganisationIdentification29) DeepCopyInto(out *OrganisationIdentification29) {
+	*out = *r
+	if r.AnyBIC != nil {
+		out.AnyBIC = new(common.AnyBICDec2014Identifier)
+		*out.AnyBIC = *r.AnyBIC
+	}
+	if r.LEI != nil {
+		out.LEI = new(common.LEIIdentifier)
+		*out.LEI = *r.LEI
+	}
+	if r.Othr != nil {
+		out.Othr = make([]GenericOrganisationIdentification1, len(r.Othr))
+		for i := range r.Othr {
+			r.Othr[i].DeepCopyInto(&out.Othr[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *OrganisationIdentificationSchemeName1Choice) DeepCopy() *OrganisationIdentificationSchemeName1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(OrganisationIdentificationSchemeName1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *OrganisationIdentificationSchemeName1Choice) DeepCopyInto(out *OrganisationIdentificationSchemeName1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *OriginalBusinessQuery1) DeepCopy() *OriginalBusinessQuery1 {
+	if r == nil {
+		return nil
+	}
+	out := new(OriginalBusinessQuery1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *OriginalBusinessQuery1) DeepCopyInto(out *OriginalBusinessQuery1) {
+	*out = *r
+	if r.MsgNmId != nil {
+		out.MsgNmId = new(common.Max35Text)
+		*out.MsgNmId = *r.MsgNmId
+	}
+	if r.CreDtTm != nil {
+		out.CreDtTm = new(common.ISODateTime)
+		*out.CreDtTm = *r.CreDtTm
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *OtherContact1) DeepCopy() *OtherContact1 {
+	if r == nil {
+		return nil
+	}
+	out := new(OtherContact1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *OtherContact1) DeepCopyInto(out *OtherContact1) {
+	*out = *r
+	if r.Id != nil {
+		out.Id = new(common.Max128Text)
+		*out.Id = *r.Id
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *Party38Choice) DeepCopy() *Party38Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(Party38Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *Party38Choice) DeepCopyInto(out *Party38Choice) {
+	*out = *r
+	r.OrgId.DeepCopyInto(&out.OrgId)
+	r.PrvtId.DeepCopyInto(&out.PrvtId)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *Party40Choice) DeepCopy() *Party40Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(Party40Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *Party40Choice) DeepCopyInto(out *Party40Choice) {
+	*out = *r
+	r.Pty.DeepCopyInto(&out.Pty)
+	r.Agt.DeepCopyInto(&out.Agt)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *PartyIdentification135) DeepCopy() *PartyIdentification135 {
+	if r == nil {
+		return nil
+	}
+	out := new(PartyIdentification135)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *PartyIdentification135) DeepCopyInto(out *PartyIdentification135) {
+	*out = *r
+	if r.Nm != nil {
+		out.Nm = new(common.Max140Text)
+		*out.Nm = *r.Nm
+	}
+	if r.PstlAdr != nil {
+		out.PstlAdr = new(PostalAddress24)
+		r.PstlAdr.DeepCopyInto(out.PstlAdr)
+	}
+	if r.Id != nil {
+		out.Id = new(Party38Choice)
+		r.Id.DeepCopyInto(out.Id)
+	}
+	if r.CtryOfRes != nil {
+		out.CtryOfRes = new(common.CountryCode)
+		*out.CtryOfRes = *r.CtryOfRes
+	}
+	if r.CtctDtls != nil {
+		out.CtctDtls = new(Contact4)
+		r.CtctDtls.DeepCopyInto(out.CtctDtls)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *PaymentRole1Choice) DeepCopy() *PaymentRole1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(PaymentRole1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *PaymentRole1Choice) DeepCopyInto(out *PaymentRole1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *PersonIdentification13) DeepCopy() *PersonIdentification13 {
+	if r == nil {
+		return nil
+	}
+	out := new(PersonIdentification13)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *PersonIdentification13) DeepCopyInto(out *PersonIdentification13) {
+	*out = *r
+	if r.DtAndPlcOfBirth != nil {
+		out.DtAndPlcOfBirth = new(DateAndPlaceOfBirth1)
+		r.DtAndPlcOfBirth.DeepCopyInto(out.DtAndPlcOfBirth)
+	}
+	if r.Othr != nil {
+		out.Othr = make([]GenericPersonIdentification1, len(r.Othr))
+		for i := range r.Othr {
+			r.Othr[i].DeepCopyInto(&out.Othr[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *PersonIdentificationSchemeName1Choice) DeepCopy() *PersonIdentificationSchemeName1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(PersonIdentificationSchemeName1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *PersonIdentificationSchemeName1Choice) DeepCopyInto(out *PersonIdentificationSchemeName1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *PostalAddress24) DeepCopy() *PostalAddress24 {
+	if r == nil {
+		return nil
+	}
+	out := new(PostalAddress24)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *PostalAddress24) DeepCopyInto(out *PostalAddress24) {
+	*out = *r
+	if r.AdrTp != nil {
+		out.AdrTp = new(AddressType3Choice)
+		r.AdrTp.DeepCopyInto(out.AdrTp)
+	}
+	if r.Dept != nil {
+		out.Dept = new(common.Max70Text)
+		*out.Dept = *r.Dept
+	}
+	if r.SubDept != nil {
+		out.SubDept = new(common.Max70Text)
+		*out.SubDept = *r.SubDept
+	}
+	if r.StrtNm != nil {
+		out.StrtNm = new(common.Max70Text)
+		*out.StrtNm = *r.StrtNm
+	}
+	if r.BldgNb != nil {
+		out.BldgNb = new(common.Max16Text)
+		*out.BldgNb = *r.BldgNb
+	}
+	if r.BldgNm != nil {
+		out.BldgNm = new(common.Max35Text)
+		*out.BldgNm = *r.BldgNm
+	}
+	if r.Flr != nil {
+		out.Flr = new(common.Max70Text)
+		*out.Flr = *r.Flr
+	}
+	if r.PstBx != nil {
+		out.PstBx = new(common.Max16Text)
+		*out.PstBx = *r.PstBx
+	}
+	if r.Room != nil {
+		out.Room = new(common.Max70Text)
+		*out.Room = *r.Room
+	}
+	if r.PstCd != nil {
+		out.PstCd = new(common.Max16Text)
+		*out.PstCd = *r.PstCd
+	}
+	if r.TwnNm != nil {
+		out.TwnNm = new(common.Max35Text)
+		*out.TwnNm = *r.TwnNm
+	}
+	if r.TwnLctnNm != nil {
+		out.TwnLctnNm = new(common.Max35Text)
+		*out.TwnLctnNm = *r.TwnLctnNm
+	}
+	if r.DstrctNm != nil {
+		out.DstrctNm = new(common.Max35Text)
+		*out.DstrctNm = *r.DstrctNm
+	}
+	if r.CtrySubDvsn != nil {
+		out.CtrySubDvsn = new(common.Max35Text)
+		*out.CtrySubDvsn = *r.CtrySubDvsn
+	}
+	if r.Ctry != nil {
+		out.Ctry = new(common.CountryCode)
+		*out.Ctry = *r.Ctry
+	}
+	if r.AdrLine != nil {
+		out.AdrLine = make([]common.Max70Text, len(r.AdrLine))
+		copy(out.AdrLine, r.AdrLine)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ProxyAccountIdentification1) DeepCopy() *ProxyAccountIdentification1 {
+	if r == nil {
+		return nil
+	}
+	out := new(ProxyAccountIdentification1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ProxyAccountIdentification1) DeepCopyInto(out *ProxyAccountIdentification1) {
+	*out = *r
+	if r.Tp != nil {
+		out.Tp = new(ProxyAccountType1Choice)
+		*out.Tp = *r.Tp
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ProxyAccountType1Choice) DeepCopy() *ProxyAccountType1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(ProxyAccountType1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ProxyAccountType1Choice) DeepCopyInto(out *ProxyAccountType1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ReportHeader5) DeepCopy() *ReportHeader5 {
+	if r == nil {
+		return nil
+	}
+	out := new(ReportHeader5)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ReportHeader5) DeepCopyInto(out *ReportHeader5) {
+	*out = *r
+	r.Fr.DeepCopyInto(&out.Fr)
+	r.To.DeepCopyInto(&out.To)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *RequestType3Choice) DeepCopy() *RequestType3Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(RequestType3Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *RequestType3Choice) DeepCopyInto(out *RequestType3Choice) {
+	*out = *r
+	r.Prtry.DeepCopyInto(&out.Prtry)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *RequestType4Choice) DeepCopy() *RequestType4Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(RequestType4Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *RequestType4Choice) DeepCopyInto(out *RequestType4Choice) {
+	*out = *r
+	r.Prtry.DeepCopyInto(&out.Prtry)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ReturnCurrencyExchangeRateV04) DeepCopy() *ReturnCurrencyExchangeRateV04 {
+	if r == nil {
+		return nil
+	}
+	out := new(ReturnCurrencyExchangeRateV04)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ReturnCurrencyExchangeRateV04) DeepCopyInto(out *ReturnCurrencyExchangeRateV04) {
+	*out = *r
+	r.MsgHdr.DeepCopyInto(&out.MsgHdr)
+	r.RptOrErr.DeepCopyInto(&out.RptOrErr)
+	if r.SplmtryData != nil {
+		out.SplmtryData = make([]SupplementaryData1, len(r.SplmtryData))
+		for i := range r.SplmtryData {
+			r.SplmtryData[i].DeepCopyInto(&out.SplmtryData[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ReturnMemberV04) DeepCopy() *ReturnMemberV04 {
+	if r == nil {
+		return nil
+	}
+	out := new(ReturnMemberV04)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ReturnMemberV04) DeepCopyInto(out *ReturnMemberV04) {
+	*out = *r
+	r.MsgHdr.DeepCopyInto(&out.MsgHdr)
+	r.RptOrErr.DeepCopyInto(&out.RptOrErr)
+	if r.SplmtryData != nil {
+		out.SplmtryData = make([]SupplementaryData1, len(r.SplmtryData))
+		for i := range r.SplmtryData {
+			r.SplmtryData[i].DeepCopyInto(&out.SplmtryData[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ReturnStandingOrderV04) DeepCopy() *ReturnStandingOrderV04 {
+	if r == nil {
+		return nil
+	}
+	out := new(ReturnStandingOrderV04)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ReturnStandingOrderV04) DeepCopyInto(out *ReturnStandingOrderV04) {
+	*out = *r
+	r.MsgHdr.DeepCopyInto(&out.MsgHdr)
+	r.RptOrErr.DeepCopyInto(&out.RptOrErr)
+	if r.SplmtryData != nil {
+		out.SplmtryData = make([]SupplementaryData1, len(r.SplmtryData))
+		for i := range r.SplmtryData {
+			r.SplmtryData[i].DeepCopyInto(&out.SplmtryData[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *StandingOrder6) DeepCopy() *StandingOrder6 {
+	if r == nil {
+		return nil
+	}
+	out := new(StandingOrder6)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *StandingOrder6) DeepCopyInto(out *StandingOrder6) {
+	*out = *r
+	if r.Ccy != nil {
+		out.Ccy = new(common.ActiveCurrencyCode)
+		*out.Ccy = *r.Ccy
+	}
+	if r.Tp != nil {
+		out.Tp = new(StandingOrderType1Choice)
+		r.Tp.DeepCopyInto(out.Tp)
+	}
+	if r.AssoctdPoolAcct != nil {
+		out.AssoctdPoolAcct = new(AccountIdentification4Choice)
+		r.AssoctdPoolAcct.DeepCopyInto(out.AssoctdPoolAcct)
+	}
+	if r.Ref != nil {
+		out.Ref = new(common.Max35Text)
+		*out.Ref = *r.Ref
+	}
+	if r.Frqcy != nil {
+		out.Frqcy = new(Frequency2Code)
+		*out.Frqcy = *r.Frqcy
+	}
+	if r.VldtyPrd != nil {
+		out.VldtyPrd = new(DatePeriodDetails1)
+		r.VldtyPrd.DeepCopyInto(out.VldtyPrd)
+	}
+	if r.SysMmb != nil {
+		out.SysMmb = new(BranchAndFinancialInstitutionIdentification6)
+		r.SysMmb.DeepCopyInto(out.SysMmb)
+	}
+	if r.RspnsblPty != nil {
+		out.RspnsblPty = new(BranchAndFinancialInstitutionIdentification6)
+		r.RspnsblPty.DeepCopyInto(out.RspnsblPty)
+	}
+	if r.LkSetId != nil {
+		out.LkSetId = new(common.Max35Text)
+		*out.LkSetId = *r.LkSetId
+	}
+	if r.LkSetOrdrId != nil {
+		out.LkSetOrdrId = new(common.Max35Text)
+		*out.LkSetOrdrId = *r.LkSetOrdrId
+	}
+	if r.ExctnTp != nil {
+		out.ExctnTp = new(ExecutionType1Choice)
+		*out.ExctnTp = *r.ExctnTp
+	}
+	if r.Cdtr != nil {
+		out.Cdtr = new(BranchAndFinancialInstitutionIdentification6)
+		r.Cdtr.DeepCopyInto(out.Cdtr)
+	}
+	if r.CdtrAcct != nil {
+		out.CdtrAcct = new(CashAccount38)
+		r.CdtrAcct.DeepCopyInto(out.CdtrAcct)
+	}
+	if r.Dbtr != nil {
+		out.Dbtr = new(BranchAndFinancialInstitutionIdentification6)
+		r.Dbtr.DeepCopyInto(out.Dbtr)
+	}
+	if r.DbtrAcct != nil {
+		out.DbtrAcct = new(CashAccount38)
+		r.DbtrAcct.DeepCopyInto(out.DbtrAcct)
+	}
+	if r.TtlsPerStgOrdr != nil {
+		out.TtlsPerStgOrdr = new(StandingOrderTotalAmount1)
+		r.TtlsPerStgOrdr.DeepCopyInto(out.TtlsPerStgOrdr)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *StandingOrderIdentification4) DeepCopy() *StandingOrderIdentification4 {
+	if r == nil {
+		return nil
+	}
+	out := new(StandingOrderIdentification4)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *StandingOrderIdentification4) DeepCopyInto(out *StandingOrderIdentification4) {
+	*out = *r
+	if r.Id != nil {
+		out.Id = new(common.Max35Text)
+		*out.Id = *r.Id
+	}
+	r.Acct.DeepCopyInto(&out.Acct)
+	if r.AcctOwnr != nil {
+		out.AcctOwnr = new(BranchAndFinancialInstitutionIdentification6)
+		r.AcctOwnr.DeepCopyInto(out.AcctOwnr)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *StandingOrderOrError5Choice) DeepCopy() *StandingOrderOrError5Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(StandingOrderOrError5Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *StandingOrderOrError5Choice) DeepCopyInto(out *StandingOrderOrError5Choice) {
+	*out = *r
+	if r.Rpt != nil {
+		out.Rpt = make([]StandingOrderReport1, len(r.Rpt))
+		for i := range r.Rpt {
+			r.Rpt[i].DeepCopyInto(&out.Rpt[i])
+		}
+	}
+	if r.OprlErr != nil {
+		out.OprlErr = make([]ErrorHandling5, len(r.OprlErr))
+		for i := range r.OprlErr {
+			r.OprlErr[i].DeepCopyInto(&out.OprlErr[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *StandingOrderOrError6Choice) DeepCopy() *StandingOrderOrError6Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(StandingOrderOrError6Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *StandingOrderOrError6Choice) DeepCopyInto(out *StandingOrderOrError6Choice) {
+	*out = *r
+	r.StgOrdr.DeepCopyInto(&out.StgOrdr)
+	if r.BizErr != nil {
+		out.BizErr = make([]ErrorHandling5, len(r.BizErr))
+		for i := range r.BizErr {
+			r.BizErr[i].DeepCopyInto(&out.BizErr[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *StandingOrderReport1) DeepCopy() *StandingOrderReport1 {
+	if r == nil {
+		return nil
+	}
+	out := new(StandingOrderReport1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *StandingOrderReport1) DeepCopyInto(out *StandingOrderReport1) {
+	*out = *r
+	r.StgOrdrId.DeepCopyInto(&out.StgOrdrId)
+	r.StgOrdrOrErr.DeepCopyInto(&out.StgOrdrOrErr)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *StandingOrderTotalAmount1) DeepCopy() *StandingOrderTotalAmount1 {
+	if r == nil {
+		return nil
+	}
+	out := new(StandingOrderTotalAmount1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *StandingOrderTotalAmount1) DeepCopyInto(out *StandingOrderTotalAmount1) {
+	*out = *r
+	r.SetPrdfndOrdr.DeepCopyInto(&out.SetPrdfndOrdr)
+	r.PdgPrdfndOrdr.DeepCopyInto(&out.PdgPrdfndOrdr)
+	r.SetStgOrdr.DeepCopyInto(&out.SetStgOrdr)
+	r.PdgStgOrdr.DeepCopyInto(&out.PdgStgOrdr)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *StandingOrderType1Choice) DeepCopy() *StandingOrderType1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(StandingOrderType1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *StandingOrderType1Choice) DeepCopyInto(out *StandingOrderType1Choice) {
+	*out = *r
+	r.Prtry.DeepCopyInto(&out.Prtry)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *StructuredLongPostalAddress1) DeepCopy() *StructuredLongPostalAddress1 {
+	if r == nil {
+		return nil
+	}
+	out := new(StructuredLongPostalAddress1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *StructuredLongPostalAddress1) DeepCopyInto(out *StructuredLongPostalAddress1) {
+	*out = *r
+	if r.BldgNm != nil {
+		out.BldgNm = new(common.Max35Text)
+		*out.BldgNm = *r.BldgNm
+	}
+	if r.StrtNm != nil {
+		out.StrtNm = new(common.Max35Text)
+		*out.StrtNm = *r.StrtNm
+	}
+	if r.StrtBldgId != nil {
+		out.StrtBldgId = new(common.Max35Text)
+		*out.StrtBldgId = *r.StrtBldgId
+	}
+	if r.Flr != nil {
+		out.Flr = new(common.Max16Text)
+		*out.Flr = *r.Flr
+	}
+	if r.DstrctNm != nil {
+		out.DstrctNm = new(common.Max35Text)
+		*out.DstrctNm = *r.DstrctNm
+	}
+	if r.RgnId != nil {
+		out.RgnId = new(common.Max35Text)
+		*out.RgnId = *r.RgnId
+	}
+	if r.Stat != nil {
+		out.Stat = new(common.Max35Text)
+		*out.Stat = *r.Stat
+	}
+	if r.POB != nil {
+		out.POB = new(common.Max16Text)
+		*out.POB = *r.POB
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *SupplementaryData1) DeepCopy() *SupplementaryData1 {
+	if r == nil {
+		return nil
+	}
+	out := new(SupplementaryData1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *SupplementaryData1) DeepCopyInto(out *SupplementaryData1) {
+	*out = *r
+	if r.PlcAndNm != nil {
+		out.PlcAndNm = new(common.Max350Text)
+		*out.PlcAndNm = *r.PlcAndNm
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *SupplementaryDataEnvelope1) DeepCopy() *SupplementaryDataEnvelope1 {
+	if r == nil {
+		return nil
+	}
+	out := new(SupplementaryDataEnvelope1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *SupplementaryDataEnvelope1) DeepCopyInto(out *SupplementaryDataEnvelope1) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *SystemMemberStatus1Choice) DeepCopy() *SystemMemberStatus1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(SystemMemberStatus1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *SystemMemberStatus1Choice) DeepCopyInto(out *SystemMemberStatus1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *SystemMemberType1Choice) DeepCopy() *SystemMemberType1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(SystemMemberType1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *SystemMemberType1Choice) DeepCopyInto(out *SystemMemberType1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *TotalAmountAndCurrency1) DeepCopy() *TotalAmountAndCurrency1 {
+	if r == nil {
+		return nil
+	}
+	out := new(TotalAmountAndCurrency1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *TotalAmountAndCurrency1) DeepCopyInto(out *TotalAmountAndCurrency1) {
+	*out = *r
+	if r.CdtDbtInd != nil {
+		out.CdtDbtInd = new(common.CreditDebitCode)
+		*out.CdtDbtInd = *r.CdtDbtInd
+	}
+	if r.Ccy != nil {
+		out.Ccy = new(common.ActiveCurrencyCode)
+		*out.Ccy = *r.Ccy
+	}
+}
@@ -0,0 +1,4891 @@
+// Code generated by deepcopy-gen. DO NOT EDIT.
+
+package camt_v05
+
+import (
+	"github.com/moov-io/iso20022/pkg/common"
+)
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *AccountIdentification4Choice) DeepCopy() *AccountIdentification4Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(AccountIdentification4Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *AccountIdentification4Choice) DeepCopyInto(out *AccountIdentification4Choice) {
+	*out = *r
+	r.Othr.DeepCopyInto(&out.Othr)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *AccountReportingRequestV05) DeepCopy() *AccountReportingRequestV05 {
+	if r == nil {
+		return nil
+	}
+	out := new(AccountReportingRequestV05)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *AccountReportingRequestV05) DeepCopyInto(out *AccountReportingRequestV05) {
+	*out = *r
+	r.GrpHdr.DeepCopyInto(&out.GrpHdr)
+	if r.RptgReq != nil {
+		out.RptgReq = make([]ReportingRequest5, len(r.RptgReq))
+		for i := range r.RptgReq {
+			r.RptgReq[i].DeepCopyInto(&out.RptgReq[i])
+		}
+	}
+	if r.SplmtryData != nil {
+		out.SplmtryData = make([]SupplementaryData1, len(r.SplmtryData))
+		for i := range r.SplmtryData {
+			r.SplmtryData[i].DeepCopyInto(&out.SplmtryData[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *AccountSchemeName1Choice) DeepCopy() *AccountSchemeName1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(AccountSchemeName1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *AccountSchemeName1Choice) DeepCopyInto(out *AccountSchemeName1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ActiveCurrencyAndAmount) DeepCopy() *ActiveCurrencyAndAmount {
+	if r == nil {
+		return nil
+	}
+	out := new(ActiveCurrencyAndAmount)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ActiveCurrencyAndAmount) DeepCopyInto(out *ActiveCurrencyAndAmount) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ActiveOrHistoricCurrencyAndAmount) DeepCopy() *ActiveOrHistoricCurrencyAndAmount {
+	if r == nil {
+		return nil
+	}
+	out := new(ActiveOrHistoricCurrencyAndAmount)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ActiveOrHistoricCurrencyAndAmount) DeepCopyInto(out *ActiveOrHistoricCurrencyAndAmount) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *AdditionalPaymentInformationV05) DeepCopy() *AdditionalPaymentInformationV05 {
+	if r == nil {
+		return nil
+	}
+	out := new(AdditionalPaymentInformationV05)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *AdditionalPaymentInformationV05) DeepCopyInto(out *AdditionalPaymentInformationV05) {
+	*out = *r
+	r.Assgnmt.DeepCopyInto(&out.Assgnmt)
+	r.Case.DeepCopyInto(&out.Case)
+	r.Undrlyg.DeepCopyInto(&out.Undrlyg)
+	r.Inf.DeepCopyInto(&out.Inf)
+	if r.SplmtryData != nil {
+		out.SplmtryData = make([]SupplementaryData1, len(r.SplmtryData))
+		for i := range r.SplmtryData {
+			r.SplmtryData[i].DeepCopyInto(&out.SplmtryData[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *AddressType3Choice) DeepCopy() *AddressType3Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(AddressType3Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *AddressType3Choice) DeepCopyInto(out *AddressType3Choice) {
+	*out = *r
+	r.Prtry.DeepCopyInto(&out.Prtry)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *AmendmentInformationDetails10) DeepCopy() *AmendmentInformationDetails10 {
+	if r == nil {
+		return nil
+	}
+	out := new(AmendmentInformationDetails10)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *AmendmentInformationDetails10) DeepCopyInto(out *AmendmentInformationDetails10) {
+	*out = *r
+	if r.OrgnlMndtId != nil {
+		out.OrgnlMndtId = new(common.Max35Text)
+		*out.OrgnlMndtId = *r.OrgnlMndtId
+	}
+	if r.OrgnlCdtrSchmeId != nil {
+		out.OrgnlCdtrSchmeId = new(PartyIdentification43)
+		r.OrgnlCdtrSchmeId.DeepCopyInto(out.OrgnlCdtrSchmeId)
+	}
+	if r.OrgnlCdtrAgt != nil {
+		out.OrgnlCdtrAgt = new(BranchAndFinancialInstitutionIdentification5)
+		r.OrgnlCdtrAgt.DeepCopyInto(out.OrgnlCdtrAgt)
+	}
+	if r.OrgnlCdtrAgtAcct != nil {
+		out.OrgnlCdtrAgtAcct = new(CashAccount24)
+		r.OrgnlCdtrAgtAcct.DeepCopyInto(out.OrgnlCdtrAgtAcct)
+	}
+	if r.OrgnlDbtr != nil {
+		out.OrgnlDbtr = new(PartyIdentification43)
+		r.OrgnlDbtr.DeepCopyInto(out.OrgnlDbtr)
+	}
+	if r.OrgnlDbtrAcct != nil {
+		out.OrgnlDbtrAcct = new(CashAccount24)
+		r.OrgnlDbtrAcct.DeepCopyInto(out.OrgnlDbtrAcct)
+	}
+	if r.OrgnlDbtrAgt != nil {
+		out.OrgnlDbtrAgt = new(BranchAndFinancialInstitutionIdentification5)
+		r.OrgnlDbtrAgt.DeepCopyInto(out.OrgnlDbtrAgt)
+	}
+	if r.OrgnlDbtrAgtAcct != nil {
+		out.OrgnlDbtrAgtAcct = new(CashAccount24)
+		r.OrgnlDbtrAgtAcct.DeepCopyInto(out.OrgnlDbtrAgtAcct)
+	}
+	if r.OrgnlFnlColltnDt != nil {
+		out.OrgnlFnlColltnDt = new(common.ISODate)
+		*out.OrgnlFnlColltnDt = *r.OrgnlFnlColltnDt
+	}
+	if r.OrgnlFrqcy != nil {
+		out.OrgnlFrqcy = new(Frequency21Choice)
+		*out.OrgnlFrqcy = *r.OrgnlFrqcy
+	}
+	if r.OrgnlRsn != nil {
+		out.OrgnlRsn = new(MandateSetupReason1Choice)
+		*out.OrgnlRsn = *r.OrgnlRsn
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *Amount2Choice) DeepCopy() *Amount2Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(Amount2Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *Amount2Choice) DeepCopyInto(out *Amount2Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *AmountType4Choice) DeepCopy() *AmountType4Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(AmountType4Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *AmountType4Choice) DeepCopyInto(out *AmountType4Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *BalanceSubType1Choice) DeepCopy() *BalanceSubType1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(BalanceSubType1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *BalanceSubType1Choice) DeepCopyInto(out *BalanceSubType1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *BalanceType10Choice) DeepCopy() *BalanceType10Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(BalanceType10Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *BalanceType10Choice) DeepCopyInto(out *BalanceType10Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *BalanceType13) DeepCopy() *BalanceType13 {
+	if r == nil {
+		return nil
+	}
+	out := new(BalanceType13)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *BalanceType13) DeepCopyInto(out *BalanceType13) {
+	*out = *r
+	if r.SubTp != nil {
+		out.SubTp = new(BalanceSubType1Choice)
+		*out.SubTp = *r.SubTp
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *BranchAndFinancialInstitutionIdentification5) DeepCopy() *BranchAndFinancialInstitutionIdentification5 {
+	if r == nil {
+		return nil
+	}
+	out := new(BranchAndFinancialInstitutionIdentification5)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *BranchAndFinancialInstitutionIdentification5) DeepCopyInto(out *BranchAndFinancialInstitutionIdentification5) {
+	*out = *r
+	r.FinInstnId.DeepCopyInto(&out.FinInstnId)
+	if r.BrnchId != nil {
+		out.BrnchId = new(BranchData2)
+		r.BrnchId.DeepCopyInto(out.BrnchId)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *BranchAndFinancialInstitutionIdentification6) DeepCopy() *BranchAndFinancialInstitutionIdentification6 {
+	if r == nil {
+		return nil
+	}
+	out := new(BranchAndFinancialInstitutionIdentification6)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *BranchAndFinancialInstitutionIdentification6) DeepCopyInto(out *BranchAndFinancialInstitutionIdentification6) {
+	*out = *r
+	r.FinInstnId.DeepCopyInto(&out.FinInstnId)
+	if r.BrnchId != nil {
+		out.BrnchId = new(BranchData3)
+		r.BrnchId.DeepCopyInto(out.BrnchId)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *BranchData2) DeepCopy() *BranchData2 {
+	if r == nil {
+		return nil
+	}
+	out := new(BranchData2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *BranchData2) DeepCopyInto(out *BranchData2) {
+	*out = *r
+	if r.Id != nil {
+		out.Id = new(common.Max35Text)
+		*out.Id = *r.Id
+	}
+	if r.Nm != nil {
+		out.Nm = new(common.Max140Text)
+		*out.Nm = *r.Nm
+	}
+	if r.PstlAdr != nil {
+		out.PstlAdr = new(PostalAddress6)
+		r.PstlAdr.DeepCopyInto(out.PstlAdr)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *BranchData3) DeepCopy() *BranchData3 {
+	if r == nil {
+		return nil
+	}
+	out := new(BranchData3)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *BranchData3) DeepCopyInto(out *BranchData3) {
+	*out = *r
+	if r.Id != nil {
+		out.Id = new(common.Max35Text)
+		*out.Id = *r.Id
+	}
+	if r.LEI != nil {
+		out.LEI = new(common.LEIIdentifier)
+		*out.LEI = *r.LEI
+	}
+	if r.Nm != nil {
+		out.Nm = new(common.Max140Text)
+		*out.Nm = *r.Nm
+	}
+	if r.PstlAdr != nil {
+		out.PstlAdr = new(PostalAddress24)
+		r.PstlAdr.DeepCopyInto(out.PstlAdr)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *BusinessDayCriteria2) DeepCopy() *BusinessDayCriteria2 {
+	if r == nil {
+		return nil
+	}
+	out := new(BusinessDayCriteria2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *BusinessDayCriteria2) DeepCopyInto(out *BusinessDayCriteria2) {
+	*out = *r
+	if r.NewQryNm != nil {
+		out.NewQryNm = new(common.Max35Text)
+		*out.NewQryNm = *r.NewQryNm
+	}
+	if r.SchCrit != nil {
+		out.SchCrit = make([]BusinessDaySearchCriteria2, len(r.SchCrit))
+		for i := range r.SchCrit {
+			r.SchCrit[i].DeepCopyInto(&out.SchCrit[i])
+		}
+	}
+	if r.RtrCrit != nil {
+		out.RtrCrit = new(BusinessDayReturnCriteria2)
+		*out.RtrCrit = *r.RtrCrit
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *BusinessDayCriteria3Choice) DeepCopy() *BusinessDayCriteria3Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(BusinessDayCriteria3Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *BusinessDayCriteria3Choice) DeepCopyInto(out *BusinessDayCriteria3Choice) {
+	*out = *r
+	r.NewCrit.DeepCopyInto(&out.NewCrit)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *BusinessDayQuery2) DeepCopy() *BusinessDayQuery2 {
+	if r == nil {
+		return nil
+	}
+	out := new(BusinessDayQuery2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *BusinessDayQuery2) DeepCopyInto(out *BusinessDayQuery2) {
+	*out = *r
+	if r.QryTp != nil {
+		out.QryTp = new(QueryType2Code)
+		*out.QryTp = *r.QryTp
+	}
+	if r.Crit != nil {
+		out.Crit = new(BusinessDayCriteria3Choice)
+		r.Crit.DeepCopyInto(out.Crit)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *BusinessDayReturnCriteria2) DeepCopy() *BusinessDayReturnCriteria2 {
+	if r == nil {
+		return nil
+	}
+	out := new(BusinessDayReturnCriteria2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *BusinessDayReturnCriteria2) DeepCopyInto(out *BusinessDayReturnCriteria2) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *BusinessDaySearchCriteria2) DeepCopy() *BusinessDaySearchCriteria2 {
+	if r == nil {
+		return nil
+	}
+	out := new(BusinessDaySearchCriteria2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *BusinessDaySearchCriteria2) DeepCopyInto(out *BusinessDaySearchCriteria2) {
+	*out = *r
+	if r.SysDt != nil {
+		out.SysDt = new(common.ISODate)
+		*out.SysDt = *r.SysDt
+	}
+	if r.SysId != nil {
+		out.SysId = make([]SystemIdentification2Choice, len(r.SysId))
+		copy(out.SysId, r.SysId)
+	}
+	if r.SysCcy != nil {
+		out.SysCcy = make([]common.ActiveCurrencyCode, len(r.SysCcy))
+		copy(out.SysCcy, r.SysCcy)
+	}
+	if r.EvtTp != nil {
+		out.EvtTp = new(SystemEventType2Choice)
+		r.EvtTp.DeepCopyInto(out.EvtTp)
+	}
+	if r.ClsrPrd != nil {
+		out.ClsrPrd = new(DateTimePeriod1Choice)
+		*out.ClsrPrd = *r.ClsrPrd
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CancellationReason14Choice) DeepCopy() *CancellationReason14Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(CancellationReason14Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CancellationReason14Choice) DeepCopyInto(out *CancellationReason14Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *Case3) DeepCopy() *Case3 {
+	if r == nil {
+		return nil
+	}
+	out := new(Case3)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *Case3) DeepCopyInto(out *Case3) {
+	*out = *r
+	r.Cretr.DeepCopyInto(&out.Cretr)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *Case5) DeepCopy() *Case5 {
+	if r == nil {
+		return nil
+	}
+	out := new(Case5)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *Case5) DeepCopyInto(out *Case5) {
+	*out = *r
+	r.Cretr.DeepCopyInto(&out.Cretr)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CaseAssignment3) DeepCopy() *CaseAssignment3 {
+	if r == nil {
+		return nil
+	}
+	out := new(CaseAssignment3)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CaseAssignment3) DeepCopyInto(out *CaseAssignment3) {
+	*out = *r
+	r.Assgnr.DeepCopyInto(&out.Assgnr)
+	r.Assgne.DeepCopyInto(&out.Assgne)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CaseAssignment5) DeepCopy() *CaseAssignment5 {
+	if r == nil {
+		return nil
+	}
+	out := new(CaseAssignment5)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CaseAssignment5) DeepCopyInto(out *CaseAssignment5) {
+	*out = *r
+	r.Assgnr.DeepCopyInto(&out.Assgnr)
+	r.Assgne.DeepCopyInto(&out.Assgne)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CaseForwardingNotification3) DeepCopy() *CaseForwardingNotification3 {
+	if r == nil {
+		return nil
+	}
+	out := new(CaseForwardingNotification3)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CaseForwardingNotification3) DeepCopyInto(out *CaseForwardingNotification3) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CaseStatus2) DeepCopy() *CaseStatus2 {
+	if r == nil {
+		return nil
+	}
+	out := new(CaseStatus2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CaseStatus2) DeepCopyInto(out *CaseStatus2) {
+	*out = *r
+	if r.Rsn != nil {
+		out.Rsn = new(common.Max140Text)
+		*out.Rsn = *r.Rsn
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CaseStatusReportV05) DeepCopy() *CaseStatusReportV05 {
+	if r == nil {
+		return nil
+	}
+	out := new(CaseStatusReportV05)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CaseStatusReportV05) DeepCopyInto(out *CaseStatusReportV05) {
+	*out = *r
+	r.Hdr.DeepCopyInto(&out.Hdr)
+	r.Case.DeepCopyInto(&out.Case)
+	r.Sts.DeepCopyInto(&out.Sts)
+	if r.NewAssgnmt != nil {
+		out.NewAssgnmt = new(CaseAssignment5)
+		r.NewAssgnmt.DeepCopyInto(out.NewAssgnmt)
+	}
+	if r.SplmtryData != nil {
+		out.SplmtryData = make([]SupplementaryData1, len(r.SplmtryData))
+		for i := range r.SplmtryData {
+			r.SplmtryData[i].DeepCopyInto(&out.SplmtryData[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CashAccount24) DeepCopy() *CashAccount24 {
+	if r == nil {
+		return nil
+	}
+	out := new(CashAccount24)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CashAccount24) DeepCopyInto(out *CashAccount24) {
+	*out = *r
+	r.Id.DeepCopyInto(&out.Id)
+	if r.Tp != nil {
+		out.Tp = new(CashAccountType2Choice)
+		*out.Tp = *r.Tp
+	}
+	if r.Ccy != nil {
+		out.Ccy = new(common.ActiveOrHistoricCurrencyCode)
+		*out.Ccy = *r.Ccy
+	}
+	if r.Nm != nil {
+		out.Nm = new(common.Max70Text)
+		*out.Nm = *r.Nm
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CashAccount38) DeepCopy() *CashAccount38 {
+	if r == nil {
+		return nil
+	}
+	out := new(CashAccount38)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CashAccount38) DeepCopyInto(out *CashAccount38) {
+	*out = *r
+	r.Id.DeepCopyInto(&out.Id)
+	if r.Tp != nil {
+		out.Tp = new(CashAccountType2Choice)
+		*out.Tp = *r.Tp
+	}
+	if r.Ccy != nil {
+		out.Ccy = new(common.ActiveOrHistoricCurrencyCode)
+		*out.Ccy = *r.Ccy
+	}
+	if r.Nm != nil {
+		out.Nm = new(common.Max70Text)
+		*out.Nm = *r.Nm
+	}
+	if r.Prxy != nil {
+		out.Prxy = new(ProxyAccountIdentification1)
+		r.Prxy.DeepCopyInto(out.Prxy)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CashAccountType2Choice) DeepCopy() *CashAccountType2Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(CashAccountType2Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CashAccountType2Choice) DeepCopyInto(out *CashAccountType2Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CategoryPurpose1Choice) DeepCopy() *CategoryPurpose1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(CategoryPurpose1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CategoryPurpose1Choice) DeepCopyInto(out *CategoryPurpose1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ClearingSystemIdentification2Choice) DeepCopy() *ClearingSystemIdentification2Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(ClearingSystemIdentification2Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ClearingSystemIdentification2Choice) DeepCopyInto(out *ClearingSystemIdentification2Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ClearingSystemIdentification3Choice) DeepCopy() *ClearingSystemIdentification3Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(ClearingSystemIdentification3Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ClearingSystemIdentification3Choice) DeepCopyInto(out *ClearingSystemIdentification3Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ClearingSystemMemberIdentification2) DeepCopy() *ClearingSystemMemberIdentification2 {
+	if r == nil {
+		return nil
+	}
+	out := new(ClearingSystemMemberIdentification2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ClearingSystemMemberIdentification2) DeepCopyInto(out *ClearingSystemMemberIdentification2) {
+	*out = *r
+	if r.ClrSysId != nil {
+		out.ClrSysId = new(ClearingSystemIdentification2Choice)
+		*out.ClrSysId = *r.ClrSysId
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *Contact4) DeepCopy() *Contact4 {
+	if r == nil {
+		return nil
+	}
+	out := new(Contact4)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *Contact4) DeepCopyInto(out *Contact4) {
+	*out = *r
+	if r.NmPrfx != nil {
+		out.NmPrfx = new(common.NamePrefix2Code)
+		*out.NmPrfx = *r.NmPrfx
+	}
+	if r.Nm != nil {
+		out.Nm = new(common.Max140Text)
+		*out.Nm = *r.Nm
+	}
+	if r.PhneNb != nil {
+		out.PhneNb = new(common.PhoneNumber)
+		*out.PhneNb = *r.PhneNb
+	}
+	if r.MobNb != nil {
+		out.MobNb = new(common.PhoneNumber)
+		*out.MobNb = *r.MobNb
+	}
+	if r.FaxNb != nil {
+		out.FaxNb = new(common.PhoneNumber)
+		*out.FaxNb = *r.FaxNb
+	}
+	if r.EmailAdr != nil {
+		out.EmailAdr = new(common.Max2048Text)
+		*out.EmailAdr = *r.EmailAdr
+	}
+	if r.EmailPurp != nil {
+		out.EmailPurp = new(common.Max35Text)
+		*out.EmailPurp = *r.EmailPurp
+	}
+	if r.JobTitl != nil {
+		out.JobTitl = new(common.Max35Text)
+		*out.JobTitl = *r.JobTitl
+	}
+	if r.Rspnsblty != nil {
+		out.Rspnsblty = new(common.Max35Text)
+		*out.Rspnsblty = *r.Rspnsblty
+	}
+	if r.Dept != nil {
+		out.Dept = new(common.Max70Text)
+		*out.Dept = *r.Dept
+	}
+	if r.Othr != nil {
+		out.Othr = make([]OtherContact1, len(r.Othr))
+		for i := range r.Othr {
+			r.Othr[i].DeepCopyInto(&out.Othr[i])
+		}
+	}
+	if r.PrefrdMtd != nil {
+		out.PrefrdMtd = new(PreferredContactMethod1Code)
+		*out.PrefrdMtd = *r.PrefrdMtd
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ContactDetails2) DeepCopy() *ContactDetails2 {
+	if r == nil {
+		return nil
+	}
+	out := new(ContactDetails2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ContactDetails2) DeepCopyInto(out *ContactDetails2) {
+	*out = *r
+	if r.NmPrfx != nil {
+		out.NmPrfx = new(common.NamePrefix1Code)
+		*out.NmPrfx = *r.NmPrfx
+	}
+	if r.Nm != nil {
+		out.Nm = new(common.Max140Text)
+		*out.Nm = *r.Nm
+	}
+	if r.PhneNb != nil {
+		out.PhneNb = new(common.PhoneNumber)
+		*out.PhneNb = *r.PhneNb
+	}
+	if r.MobNb != nil {
+		out.MobNb = new(common.PhoneNumber)
+		*out.MobNb = *r.MobNb
+	}
+	if r.FaxNb != nil {
+		out.FaxNb = new(common.PhoneNumber)
+		*out.FaxNb = *r.FaxNb
+	}
+	if r.EmailAdr != nil {
+		out.EmailAdr = new(common.Max2048Text)
+		*out.EmailAdr = *r.EmailAdr
+	}
+	if r.Othr != nil {
+		out.Othr = new(common.Max35Text)
+		*out.Othr = *r.Othr
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ControlData1) DeepCopy() *ControlData1 {
+	if r == nil {
+		return nil
+	}
+	out := new(ControlData1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ControlData1) DeepCopyInto(out *ControlData1) {
+	*out = *r
+	if r.NbOfTxs != nil {
+		out.NbOfTxs = new(common.Max15NumericText)
+		*out.NbOfTxs = *r.NbOfTxs
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CreditorReferenceInformation2) DeepCopy() *CreditorReferenceInformation2 {
+	if r == nil {
+		return nil
+	}
+	out := new(CreditorReferenceInformation2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CreditorReferenceInformation2) DeepCopyInto(out *CreditorReferenceInformation2) {
+	*out = *r
+	if r.Tp != nil {
+		out.Tp = new(CreditorReferenceType2)
+		r.Tp.DeepCopyInto(out.Tp)
+	}
+	if r.Ref != nil {
+		out.Ref = new(common.Max35Text)
+		*out.Ref = *r.Ref
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CreditorReferenceType1Choice) DeepCopy() *CreditorReferenceType1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(CreditorReferenceType1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CreditorReferenceType1Choice) DeepCopyInto(out *CreditorReferenceType1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CreditorReferenceType2) DeepCopy() *CreditorReferenceType2 {
+	if r == nil {
+		return nil
+	}
+	out := new(CreditorReferenceType2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CreditorReferenceType2) DeepCopyInto(out *CreditorReferenceType2) {
+	*out = *r
+	if r.Issr != nil {
+		out.Issr = new(common.Max35Text)
+		*out.Issr = *r.Issr
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CurrentOrDefaultReservation2Choice) DeepCopy() *CurrentOrDefaultReservation2Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(CurrentOrDefaultReservation2Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CurrentOrDefaultReservation2Choice) DeepCopyInto(out *CurrentOrDefaultReservation2Choice) {
+	*out = *r
+	r.Cur.DeepCopyInto(&out.Cur)
+	r.Dflt.DeepCopyInto(&out.Dflt)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *DataPayload) DeepCopy() *DataPayload {
+	if r == nil {
+		return nil
+	}
+	out := new(DataPayload)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *DataPayload) DeepCopyInto(out *DataPayload) {
+	*out = *r
+	if r.Content != nil {
+		out.Content = make([]byte, len(r.Content))
+		copy(out.Content, r.Content)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *DateAndDateTime2Choice) DeepCopy() *DateAndDateTime2Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(DateAndDateTime2Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *DateAndDateTime2Choice) DeepCopyInto(out *DateAndDateTime2Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *DateAndDateTimeChoice) DeepCopy() *DateAndDateTimeChoice {
+	if r == nil {
+		return nil
+	}
+	out := new(DateAndDateTimeChoice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *DateAndDateTimeChoice) DeepCopyInto(out *DateAndDateTimeChoice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *DateAndPlaceOfBirth) DeepCopy() *DateAndPlaceOfBirth {
+	if r == nil {
+		return nil
+	}
+	out := new(DateAndPlaceOfBirth)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *DateAndPlaceOfBirth) DeepCopyInto(out *DateAndPlaceOfBirth) {
+	*out = *r
+	if r.PrvcOfBirth != nil {
+		out.PrvcOfBirth = new(common.Max35Text)
+		*out.PrvcOfBirth = *r.PrvcOfBirth
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *DateAndPlaceOfBirth1) DeepCopy() *DateAndPlaceOfBirth1 {
+	if r == nil {
+		return nil
+	}
+	out := new(DateAndPlaceOfBirth1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *DateAndPlaceOfBirth1) DeepCopyInto(out *DateAndPlaceOfBirth1) {
+	*out = *r
+	if r.PrvcOfBirth != nil {
+		out.PrvcOfBirth = new(common.Max35Text)
+		*out.PrvcOfBirth = *r.PrvcOfBirth
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *DatePeriodDetails) DeepCopy() *DatePeriodDetails {
+	if r == nil {
+		return nil
+	}
+	out := new(DatePeriodDetails)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *DatePeriodDetails) DeepCopyInto(out *DatePeriodDetails) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *DatePeriodDetails1) DeepCopy() *DatePeriodDetails1 {
+	if r == nil {
+		return nil
+	}
+	out := new(DatePeriodDetails1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *DatePeriodDetails1) DeepCopyInto(out *DatePeriodDetails1) {
+	*out = *r
+	if r.ToDt != nil {
+		out.ToDt = new(common.ISODate)
+		*out.ToDt = *r.ToDt
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *DateTimePeriod1) DeepCopy() *DateTimePeriod1 {
+	if r == nil {
+		return nil
+	}
+	out := new(DateTimePeriod1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *DateTimePeriod1) DeepCopyInto(out *DateTimePeriod1) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *DateTimePeriod1Choice) DeepCopy() *DateTimePeriod1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(DateTimePeriod1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *DateTimePeriod1Choice) DeepCopyInto(out *DateTimePeriod1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *DebitAuthorisationConfirmation2) DeepCopy() *DebitAuthorisationConfirmation2 {
+	if r == nil {
+		return nil
+	}
+	out := new(DebitAuthorisationConfirmation2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *DebitAuthorisationConfirmation2) DeepCopyInto(out *DebitAuthorisationConfirmation2) {
+	*out = *r
+	if r.AmtToDbt != nil {
+		out.AmtToDbt = new(ActiveCurrencyAndAmount)
+		*out.AmtToDbt = *r.AmtToDbt
+	}
+	if r.ValDtToDbt != nil {
+		out.ValDtToDbt = new(common.ISODate)
+		*out.ValDtToDbt = *r.ValDtToDbt
+	}
+	if r.Rsn != nil {
+		out.Rsn = new(common.Max140Text)
+		*out.Rsn = *r.Rsn
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *DebitAuthorisationResponseV05) DeepCopy() *DebitAuthorisationResponseV05 {
+	if r == nil {
+		return nil
+	}
+	out := new(DebitAuthorisationResponseV05)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *DebitAuthorisationResponseV05) DeepCopyInto(out *DebitAuthorisationResponseV05) {
+	*out = *r
+	r.Assgnmt.DeepCopyInto(&out.Assgnmt)
+	r.Case.DeepCopyInto(&out.Case)
+	r.Conf.DeepCopyInto(&out.Conf)
+	if r.SplmtryData != nil {
+		out.SplmtryData = make([]SupplementaryData1, len(r.SplmtryData))
+		for i := range r.SplmtryData {
+			r.SplmtryData[i].DeepCopyInto(&out.SplmtryData[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *DeleteReservationV05) DeepCopy() *DeleteReservationV05 {
+	if r == nil {
+		return nil
+	}
+	out := new(DeleteReservationV05)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *DeleteReservationV05) DeepCopyInto(out *DeleteReservationV05) {
+	*out = *r
+	r.MsgHdr.DeepCopyInto(&out.MsgHdr)
+	if r.CurRsvatn != nil {
+		out.CurRsvatn = new(ReservationIdentification2)
+		r.CurRsvatn.DeepCopyInto(out.CurRsvatn)
+	}
+	if r.SplmtryData != nil {
+		out.SplmtryData = make([]SupplementaryData1, len(r.SplmtryData))
+		for i := range r.SplmtryData {
+			r.SplmtryData[i].DeepCopyInto(&out.SplmtryData[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *DiscountAmountAndType1) DeepCopy() *DiscountAmountAndType1 {
+	if r == nil {
+		return nil
+	}
+	out := new(DiscountAmountAndType1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *DiscountAmountAndType1) DeepCopyInto(out *DiscountAmountAndType1) {
+	*out = *r
+	if r.Tp != nil {
+		out.Tp = new(DiscountAmountType1Choice)
+		*out.Tp = *r.Tp
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *DiscountAmountType1Choice) DeepCopy() *DiscountAmountType1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(DiscountAmountType1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *DiscountAmountType1Choice) DeepCopyInto(out *DiscountAmountType1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *DocumentAdjustment1) DeepCopy() *DocumentAdjustment1 {
+	if r == nil {
+		return nil
+	}
+	out := new(DocumentAdjustment1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *DocumentAdjustment1) DeepCopyInto(out *DocumentAdjustment1) {
+	*out = *r
+	if r.CdtDbtInd != nil {
+		out.CdtDbtInd = new(CreditDebitCode)
+		*out.CdtDbtInd = *r.CdtDbtInd
+	}
+	if r.Rsn != nil {
+		out.Rsn = new(common.Max4Text)
+		*out.Rsn = *r.Rsn
+	}
+	if r.AddtlInf != nil {
+		out.AddtlInf = new(common.Max140Text)
+		*out.AddtlInf = *r.AddtlInf
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *DocumentLineIdentification1) DeepCopy() *DocumentLineIdentification1 {
+	if r == nil {
+		return nil
+	}
+	out := new(DocumentLineIdentification1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *DocumentLineIdentification1) DeepCopyInto(out *DocumentLineIdentification1) {
+	*out = *r
+	if r.Tp != nil {
+		out.Tp = new(DocumentLineType1)
+		r.Tp.DeepCopyInto(out.Tp)
+	}
+	if r.Nb != nil {
+		out.Nb = new(common.Max35Text)
+		*out.Nb = *r.Nb
+	}
+	if r.RltdDt != nil {
+		out.RltdDt = new(common.ISODate)
+		*out.RltdDt = *r.RltdDt
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *DocumentLineInformation1) DeepCopy() *DocumentLineInformation1 {
+	if r == nil {
+		return nil
+	}
+	out := new(DocumentLineInformation1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *DocumentLineInformation1) DeepCopyInto(out *DocumentLineInformation1) {
+	*out = *r
+	if r.Id != nil {
+		out.Id = make([]DocumentLineIdentification1, len(r.Id))
+		for i := range r.Id {
+			r.Id[i].DeepCopyInto(&out.Id[i])
+		}
+	}
+	if r.Desc != nil {
+		out.Desc = new(common.Max2048Text)
+		*out.Desc = *r.Desc
+	}
+	if r.Amt != nil {
+		out.Amt = new(RemittanceAmount3)
+		r.Amt.DeepCopyInto(out.Amt)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *DocumentLineType1) DeepCopy() *DocumentLineType1 {
+	if r == nil {
+		return nil
+	}
+	out := new(DocumentLineType1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *DocumentLineType1) DeepCopyInto(out *DocumentLineType1) {
+	*out = *r
+	if r.Issr != nil {
+		out.Issr = new(common.Max35Text)
+		*out.Issr = *r.Issr
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *DocumentLineType1Choice) DeepCopy() *DocumentLineType1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(DocumentLineType1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *DocumentLineType1Choice) DeepCopyInto(out *DocumentLineType1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *EntryStatus1Choice) DeepCopy() *EntryStatus1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(EntryStatus1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *EntryStatus1Choice) DeepCopyInto(out *EntryStatus1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *EquivalentAmount2) DeepCopy() *EquivalentAmount2 {
+	if r == nil {
+		return nil
+	}
+	out := new(EquivalentAmount2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *EquivalentAmount2) DeepCopyInto(out *EquivalentAmount2) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *FIToFIPaymentCancellationRequestV05) DeepCopy() *FIToFIPaymentCancellationRequestV05 {
+	if r == nil {
+		return nil
+	}
+	out := new(FIToFIPaymentCancellationRequestV05)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *FIToFIPaymentCancellationRequestV05) DeepCopyInto(out *FIToFIPaymentCancellationRequestV05) {
+	*out = *r
+	r.Assgnmt.DeepCopyInto(&out.Assgnmt)
+	if r.Case != nil {
+		out.Case = new(Case3)
+		r.Case.DeepCopyInto(out.Case)
+	}
+	if r.CtrlData != nil {
+		out.CtrlData = new(ControlData1)
+		r.CtrlData.DeepCopyInto(out.CtrlData)
+	}
+	if r.Undrlyg != nil {
+		out.Undrlyg = make([]UnderlyingTransaction13, len(r.Undrlyg))
+		for i := range r.Undrlyg {
+			r.Undrlyg[i].DeepCopyInto(&out.Undrlyg[i])
+		}
+	}
+	if r.SplmtryData != nil {
+		out.SplmtryData = make([]SupplementaryData1, len(r.SplmtryData))
+		for i := range r.SplmtryData {
+			r.SplmtryData[i].DeepCopyInto(&out.SplmtryData[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *FinancialIdentificationSchemeName1Choice) DeepCopy() *FinancialIdentificationSchemeName1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(FinancialIdentificationSchemeName1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *FinancialIdentificationSchemeName1Choice) DeepCopyInto(out *FinancialIdentificationSchemeName1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *FinancialInstitutionIdentification18) DeepCopy() *FinancialInstitutionIdentification18 {
+	if r == nil {
+		return nil
+	}
+	out := new(FinancialInstitutionIdentification18)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *FinancialInstitutionIdentification18) DeepCopyInto(out *FinancialInstitutionIdentification18) {
+	*out = *r
+	if r.BICFI != nil {
+		out.BICFI = new(common.BICFIDec2014Identifier)
+		*out.BICFI = *r.BICFI
+	}
+	if r.ClrSysMmbId != nil {
+		out.ClrSysMmbId = new(ClearingSystemMemberIdentification2)
+		r.ClrSysMmbId.DeepCopyInto(out.ClrSysMmbId)
+	}
+	if r.LEI != nil {
+		out.LEI = new(common.LEIIdentifier)
+		*out.LEI = *r.LEI
+	}
+	if r.Nm != nil {
+		out.Nm = new(common.Max140Text)
+		*out.Nm = *r.Nm
+	}
+	if r.PstlAdr != nil {
+		out.PstlAdr = new(PostalAddress24)
+		r.PstlAdr.DeepCopyInto(out.PstlAdr)
+	}
+	if r.Othr != nil {
+		out.Othr = new(GenericFinancialIdentification1)
+		r.Othr.DeepCopyInto(out.Othr)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *FinancialInstitutionIdentification8) DeepCopy() *FinancialInstitutionIdentification8 {
+	if r == nil {
+		return nil
+	}
+	out := new(FinancialInstitutionIdentification8)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *FinancialInstitutionIdentification8) DeepCopyInto(out *FinancialInstitutionIdentification8) {
+	*out = *r
+	if r.BICFI != nil {
+		out.BICFI = new(common.BICFIIdentifier)
+		*out.BICFI = *r.BICFI
+	}
+	if r.ClrSysMmbId != nil {
+		out.ClrSysMmbId = new(ClearingSystemMemberIdentification2)
+		r.ClrSysMmbId.DeepCopyInto(out.ClrSysMmbId)
+	}
+	if r.Nm != nil {
+		out.Nm = new(common.Max140Text)
+		*out.Nm = *r.Nm
+	}
+	if r.PstlAdr != nil {
+		out.PstlAdr = new(PostalAddress6)
+		r.PstlAdr.DeepCopyInto(out.PstlAdr)
+	}
+	if r.Othr != nil {
+		out.Othr = new(GenericFinancialIdentification1)
+		r.Othr.DeepCopyInto(out.Othr)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *Frequency21Choice) DeepCopy() *Frequency21Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(Frequency21Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *Frequency21Choice) DeepCopyInto(out *Frequency21Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *FrequencyPeriod1) DeepCopy() *FrequencyPeriod1 {
+	if r == nil {
+		return nil
+	}
+	out := new(FrequencyPeriod1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *FrequencyPeriod1) DeepCopyInto(out *FrequencyPeriod1) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *Garnishment1) DeepCopy() *Garnishment1 {
+	if r == nil {
+		return nil
+	}
+	out := new(Garnishment1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *Garnishment1) DeepCopyInto(out *Garnishment1) {
+	*out = *r
+	r.Tp.DeepCopyInto(&out.Tp)
+	if r.Grnshee != nil {
+		out.Grnshee = new(PartyIdentification43)
+		r.Grnshee.DeepCopyInto(out.Grnshee)
+	}
+	if r.GrnshmtAdmstr != nil {
+		out.GrnshmtAdmstr = new(PartyIdentification43)
+		r.GrnshmtAdmstr.DeepCopyInto(out.GrnshmtAdmstr)
+	}
+	if r.RefNb != nil {
+		out.RefNb = new(common.Max140Text)
+		*out.RefNb = *r.RefNb
+	}
+	if r.Dt != nil {
+		out.Dt = new(common.ISODate)
+		*out.Dt = *r.Dt
+	}
+	if r.RmtdAmt != nil {
+		out.RmtdAmt = new(ActiveOrHistoricCurrencyAndAmount)
+		*out.RmtdAmt = *r.RmtdAmt
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *GarnishmentType1) DeepCopy() *GarnishmentType1 {
+	if r == nil {
+		return nil
+	}
+	out := new(GarnishmentType1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *GarnishmentType1) DeepCopyInto(out *GarnishmentType1) {
+	*out = *r
+	if r.Issr != nil {
+		out.Issr = new(common.Max35Text)
+		*out.Issr = *r.Issr
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *GarnishmentType1Choice) DeepCopy() *GarnishmentType1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(GarnishmentType1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *GarnishmentType1Choice) DeepCopyInto(out *GarnishmentType1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *GenericAccountIdentification1) DeepCopy() *GenericAccountIdentification1 {
+	if r == nil {
+		return nil
+	}
+	out := new(GenericAccountIdentification1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *GenericAccountIdentification1) DeepCopyInto(out *GenericAccountIdentification1) {
+	*out = *r
+	if r.SchmeNm != nil {
+		out.SchmeNm = new(AccountSchemeName1Choice)
+		*out.SchmeNm = *r.SchmeNm
+	}
+	if r.Issr != nil {
+		out.Issr = new(common.Max35Text)
+		*out.Issr = *r.Issr
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *GenericFinancialIdentification1) DeepCopy() *GenericFinancialIdentification1 {
+	if r == nil {
+		return nil
+	}
+	out := new(GenericFinancialIdentification1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *GenericFinancialIdentification1) DeepCopyInto(out *GenericFinancialIdentification1) {
+	*out = *r
+	if r.SchmeNm != nil {
+		out.SchmeNm = new(FinancialIdentificationSchemeName1Choice)
+		*out.SchmeNm = *r.SchmeNm
+	}
+	if r.Issr != nil {
+		out.Issr = new(common.Max35Text)
+		*out.Issr = *r.Issr
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *GenericIdentification1) DeepCopy() *GenericIdentification1 {
+	if r == nil {
+		return nil
+	}
+	out := new(GenericIdentification1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *GenericIdentification1) DeepCopyInto(out *GenericIdentification1) {
+	*out = *r
+	if r.SchmeNm != nil {
+		out.SchmeNm = new(common.Max35Text)
+		*out.SchmeNm = *r.SchmeNm
+	}
+	if r.Issr != nil {
+		out.Issr = new(common.Max35Text)
+		*out.Issr = *r.Issr
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *GenericIdentification30) DeepCopy() *GenericIdentification30 {
+	if r == nil {
+		return nil
+	}
+	out := new(GenericIdentification30)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *GenericIdentification30) DeepCopyInto(out *GenericIdentification30) {
+	*out = *r
+	if r.SchmeNm != nil {
+		out.SchmeNm = new(common.Max35Text)
+		*out.SchmeNm = *r.SchmeNm
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *GenericOrganisationIdentification1) DeepCopy() *GenericOrganisationIdentification1 {
+	if r == nil {
+		return nil
+	}
+	out := new(GenericOrganisationIdentification1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *GenericOrganisationIdentification1) DeepCopyInto(out *GenericOrganisationIdentification1) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *GenericPersonIdentification1) DeepCopy() *GenericPersonIdentification1 {
+	if r == nil {
+		return nil
+	}
+	out := new(GenericPersonIdentification1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *GenericPersonIdentification1) DeepCopyInto(out *GenericPersonIdentification1) {
+	*out = *r
+	if r.SchmeNm != nil {
+		out.SchmeNm = new(PersonIdentificationSchemeName1Choice)
+		*out.SchmeNm = *r.SchmeNm
+	}
+	if r.Issr != nil {
+		out.Issr = new(common.Max35Text)
+		*out.Issr = *r.Issr
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *GetBusinessDayInformationV05) DeepCopy() *GetBusinessDayInformationV05 {
+	if r == nil {
+		return nil
+	}
+	out := new(GetBusinessDayInformationV05)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *GetBusinessDayInformationV05) DeepCopyInto(out *GetBusinessDayInformationV05) {
+	*out = *r
+	r.MsgHdr.DeepCopyInto(&out.MsgHdr)
+	if r.BizDayInfQryDef != nil {
+		out.BizDayInfQryDef = new(BusinessDayQuery2)
+		r.BizDayInfQryDef.DeepCopyInto(out.BizDayInfQryDef)
+	}
+	if r.SplmtryData != nil {
+		out.SplmtryData = make([]SupplementaryData1, len(r.SplmtryData))
+		for i := range r.SplmtryData {
+			r.SplmtryData[i].DeepCopyInto(&out.SplmtryData[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *GetReservationV05) DeepCopy() *GetReservationV05 {
+	if r == nil {
+		return nil
+	}
+	out := new(GetReservationV05)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *GetReservationV05) DeepCopyInto(out *GetReservationV05) {
+	*out = *r
+	r.MsgHdr.DeepCopyInto(&out.MsgHdr)
+	if r.RsvatnQryDef != nil {
+		out.RsvatnQryDef = new(ReservationQuery3)
+		r.RsvatnQryDef.DeepCopyInto(out.RsvatnQryDef)
+	}
+	if r.SplmtryData != nil {
+		out.SplmtryData = make([]SupplementaryData1, len(r.SplmtryData))
+		for i := range r.SplmtryData {
+			r.SplmtryData[i].DeepCopyInto(&out.SplmtryData[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *GroupHeader77) DeepCopy() *GroupHeader77 {
+	if r == nil {
+		return nil
+	}
+	out := new(GroupHeader77)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *GroupHeader77) DeepCopyInto(out *GroupHeader77) {
+	*out = *r
+	if r.MsgSndr != nil {
+		out.MsgSndr = new(Party40Choice)
+		r.MsgSndr.DeepCopyInto(out.MsgSndr)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *InstructionForCreditorAgent1) DeepCopy() *InstructionForCreditorAgent1 {
+	if r == nil {
+		return nil
+	}
+	out := new(InstructionForCreditorAgent1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *InstructionForCreditorAgent1) DeepCopyInto(out *InstructionForCreditorAgent1) {
+	*out = *r
+	if r.Cd != nil {
+		out.Cd = new(Instruction3Code)
+		*out.Cd = *r.Cd
+	}
+	if r.InstrInf != nil {
+		out.InstrInf = new(common.Max140Text)
+		*out.InstrInf = *r.InstrInf
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *InstructionForNextAgent1) DeepCopy() *InstructionForNextAgent1 {
+	if r == nil {
+		return nil
+	}
+	out := new(InstructionForNextAgent1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *InstructionForNextAgent1) DeepCopyInto(out *InstructionForNextAgent1) {
+	*out = *r
+	if r.Cd != nil {
+		out.Cd = new(Instruction4Code)
+		*out.Cd = *r.Cd
+	}
+	if r.InstrInf != nil {
+		out.InstrInf = new(common.Max140Text)
+		*out.InstrInf = *r.InstrInf
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *Limit2) DeepCopy() *Limit2 {
+	if r == nil {
+		return nil
+	}
+	out := new(Limit2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *Limit2) DeepCopyInto(out *Limit2) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *LiquidityCreditTransfer2) DeepCopy() *LiquidityCreditTransfer2 {
+	if r == nil {
+		return nil
+	}
+	out := new(LiquidityCreditTransfer2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *LiquidityCreditTransfer2) DeepCopyInto(out *LiquidityCreditTransfer2) {
+	*out = *r
+	if r.LqdtyTrfId != nil {
+		out.LqdtyTrfId = new(PaymentIdentification8)
+		r.LqdtyTrfId.DeepCopyInto(out.LqdtyTrfId)
+	}
+	if r.Cdtr != nil {
+		out.Cdtr = new(BranchAndFinancialInstitutionIdentification6)
+		r.Cdtr.DeepCopyInto(out.Cdtr)
+	}
+	if r.CdtrAcct != nil {
+		out.CdtrAcct = new(CashAccount38)
+		r.CdtrAcct.DeepCopyInto(out.CdtrAcct)
+	}
+	if r.Dbtr != nil {
+		out.Dbtr = new(BranchAndFinancialInstitutionIdentification6)
+		r.Dbtr.DeepCopyInto(out.Dbtr)
+	}
+	if r.DbtrAcct != nil {
+		out.DbtrAcct = new(CashAccount38)
+		r.DbtrAcct.DeepCopyInto(out.DbtrAcct)
+	}
+	if r.SttlmDt != nil {
+		out.SttlmDt = new(common.ISODate)
+		*out.SttlmDt = *r.SttlmDt
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *LiquidityCreditTransferV05) DeepCopy() *LiquidityCreditTransferV05 {
+	if r == nil {
+		return nil
+	}
+	out := new(LiquidityCreditTransferV05)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *LiquidityCreditTransferV05) DeepCopyInto(out *LiquidityCreditTransferV05) {
+	*out = *r
+	r.MsgHdr.DeepCopyInto(&out.MsgHdr)
+	r.LqdtyCdtTrf.DeepCopyInto(&out.LqdtyCdtTrf)
+	if r.SplmtryData != nil {
+		out.SplmtryData = make([]SupplementaryData1, len(r.SplmtryData))
+		for i := range r.SplmtryData {
+			r.SplmtryData[i].DeepCopyInto(&out.SplmtryData[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *LiquidityDebitTransfer2) DeepCopy() *LiquidityDebitTransfer2 {
+	if r == nil {
+		return nil
+	}
+	out := new(LiquidityDebitTransfer2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *LiquidityDebitTransfer2) DeepCopyInto(out *LiquidityDebitTransfer2) {
+	*out = *r
+	if r.LqdtyTrfId != nil {
+		out.LqdtyTrfId = new(PaymentIdentification8)
+		r.LqdtyTrfId.DeepCopyInto(out.LqdtyTrfId)
+	}
+	if r.Cdtr != nil {
+		out.Cdtr = new(BranchAndFinancialInstitutionIdentification6)
+		r.Cdtr.DeepCopyInto(out.Cdtr)
+	}
+	if r.CdtrAcct != nil {
+		out.CdtrAcct = new(CashAccount38)
+		r.CdtrAcct.DeepCopyInto(out.CdtrAcct)
+	}
+	if r.Dbtr != nil {
+		out.Dbtr = new(BranchAndFinancialInstitutionIdentification6)
+		r.Dbtr.DeepCopyInto(out.Dbtr)
+	}
+	if r.DbtrAcct != nil {
+		out.DbtrAcct = new(CashAccount38)
+		r.DbtrAcct.DeepCopyInto(out.DbtrAcct)
+	}
+	if r.SttlmDt != nil {
+		out.SttlmDt = new(common.ISODate)
+		*out.SttlmDt = *r.SttlmDt
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *LiquidityDebitTransferV05) DeepCopy() *LiquidityDebitTransferV05 {
+	if r == nil {
+		return nil
+	}
+	out := new(LiquidityDebitTransferV05)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *LiquidityDebitTransferV05) DeepCopyInto(out *LiquidityDebitTransferV05) {
+	*out = *r
+	r.MsgHdr.DeepCopyInto(&out.MsgHdr)
+	r.LqdtyDbtTrf.DeepCopyInto(&out.LqdtyDbtTrf)
+	if r.SplmtryData != nil {
+		out.SplmtryData = make([]SupplementaryData1, len(r.SplmtryData))
+		for i := range r.SplmtryData {
+			r.SplmtryData[i].DeepCopyInto(&out.SplmtryData[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *LocalInstrument2Choice) DeepCopy() *LocalInstrument2Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(LocalInstrument2Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *LocalInstrument2Choice) DeepCopyInto(out *LocalInstrument2Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *LongPaymentIdentification2) DeepCopy() *LongPaymentIdentification2 {
+	if r == nil {
+		return nil
+	}
+	out := new(LongPaymentIdentification2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *LongPaymentIdentification2) DeepCopyInto(out *LongPaymentIdentification2) {
+	*out = *r
+	if r.TxId != nil {
+		out.TxId = new(common.Max35Text)
+		*out.TxId = *r.TxId
+	}
+	if r.UETR != nil {
+		out.UETR = new(common.UUIDv4Identifier)
+		*out.UETR = *r.UETR
+	}
+	if r.PmtMtd != nil {
+		out.PmtMtd = new(PaymentOrigin1Choice)
+		*out.PmtMtd = *r.PmtMtd
+	}
+	r.InstgAgt.DeepCopyInto(&out.InstgAgt)
+	r.InstdAgt.DeepCopyInto(&out.InstdAgt)
+	if r.NtryTp != nil {
+		out.NtryTp = new(EntryTypeIdentifier)
+		*out.NtryTp = *r.NtryTp
+	}
+	if r.EndToEndId != nil {
+		out.EndToEndId = new(common.Max35Text)
+		*out.EndToEndId = *r.EndToEndId
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *MandateRelatedInformation10) DeepCopy() *MandateRelatedInformation10 {
+	if r == nil {
+		return nil
+	}
+	out := new(MandateRelatedInformation10)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *MandateRelatedInformation10) DeepCopyInto(out *MandateRelatedInformation10) {
+	*out = *r
+	if r.MndtId != nil {
+		out.MndtId = new(common.Max35Text)
+		*out.MndtId = *r.MndtId
+	}
+	if r.DtOfSgntr != nil {
+		out.DtOfSgntr = new(common.ISODate)
+		*out.DtOfSgntr = *r.DtOfSgntr
+	}
+	if r.AmdmntInfDtls != nil {
+		out.AmdmntInfDtls = new(AmendmentInformationDetails10)
+		r.AmdmntInfDtls.DeepCopyInto(out.AmdmntInfDtls)
+	}
+	if r.ElctrncSgntr != nil {
+		out.ElctrncSgntr = new(common.Max1025Text)
+		*out.ElctrncSgntr = *r.ElctrncSgntr
+	}
+	if r.FrstColltnDt != nil {
+		out.FrstColltnDt = new(common.ISODate)
+		*out.FrstColltnDt = *r.FrstColltnDt
+	}
+	if r.FnlColltnDt != nil {
+		out.FnlColltnDt = new(common.ISODate)
+		*out.FnlColltnDt = *r.FnlColltnDt
+	}
+	if r.Frqcy != nil {
+		out.Frqcy = new(Frequency21Choice)
+		*out.Frqcy = *r.Frqcy
+	}
+	if r.Rsn != nil {
+		out.Rsn = new(MandateSetupReason1Choice)
+		*out.Rsn = *r.Rsn
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *MandateSetupReason1Choice) DeepCopy() *MandateSetupReason1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(MandateSetupReason1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *MandateSetupReason1Choice) DeepCopyInto(out *MandateSetupReason1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *MarketInfrastructureIdentification1Choice) DeepCopy() *MarketInfrastructureIdentification1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(MarketInfrastructureIdentification1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *MarketInfrastructureIdentification1Choice) DeepCopyInto(out *MarketInfrastructureIdentification1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *MessageHeader1) DeepCopy() *MessageHeader1 {
+	if r == nil {
+		return nil
+	}
+	out := new(MessageHeader1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *MessageHeader1) DeepCopyInto(out *MessageHeader1) {
+	*out = *r
+	if r.CreDtTm != nil {
+		out.CreDtTm = new(common.ISODateTime)
+		*out.CreDtTm = *r.CreDtTm
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *MessageHeader9) DeepCopy() *MessageHeader9 {
+	if r == nil {
+		return nil
+	}
+	out := new(MessageHeader9)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *MessageHeader9) DeepCopyInto(out *MessageHeader9) {
+	*out = *r
+	if r.CreDtTm != nil {
+		out.CreDtTm = new(common.ISODateTime)
+		*out.CreDtTm = *r.CreDtTm
+	}
+	if r.ReqTp != nil {
+		out.ReqTp = new(RequestType4Choice)
+		r.ReqTp.DeepCopyInto(out.ReqTp)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *MissingOrIncorrectInformation3) DeepCopy() *MissingOrIncorrectInformation3 {
+	if r == nil {
+		return nil
+	}
+	out := new(MissingOrIncorrectInformation3)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *MissingOrIncorrectInformation3) DeepCopyInto(out *MissingOrIncorrectInformation3) {
+	*out = *r
+	if r.MssngInf != nil {
+		out.MssngInf = make([]UnableToApplyMissing1, len(r.MssngInf))
+		for i := range r.MssngInf {
+			r.MssngInf[i].DeepCopyInto(&out.MssngInf[i])
+		}
+	}
+	if r.IncrrctInf != nil {
+		out.IncrrctInf = make([]UnableToApplyIncorrect1, len(r.IncrrctInf))
+		for i := range r.IncrrctInf {
+			r.IncrrctInf[i].DeepCopyInto(&out.IncrrctInf[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ModifyReservationV05) DeepCopy() *ModifyReservationV05 {
+	if r == nil {
+		return nil
+	}
+	out := new(ModifyReservationV05)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ModifyReservationV05) DeepCopyInto(out *ModifyReservationV05) {
+	*out = *r
+	r.MsgHdr.DeepCopyInto(&out.MsgHdr)
+	r.RsvatnId.DeepCopyInto(&out.RsvatnId)
+	r.NewRsvatnValSet.DeepCopyInto(&out.NewRsvatnValSet)
+	if r.SplmtryData != nil {
+		out.SplmtryData = make([]SupplementaryData1, len(r.SplmtryData))
+		for i := range r.SplmtryData {
+			r.SplmtryData[i].DeepCopyInto(&out.SplmtryData[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *NotificationOfCaseAssignmentV05) DeepCopy() *NotificationOfCaseAssignmentV05 {
+	if r == nil {
+		return nil
+	}
+	out := new(NotificationOfCaseAssignmentV05)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *NotificationOfCaseAssignmentV05) DeepCopyInto(out *NotificationOfCaseAssignmentV05) {
+	*out = *r
+	r.Hdr.DeepCopyInto(&out.Hdr)
+	r.Case.DeepCopyInto(&out.Case)
+	r.Assgnmt.DeepCopyInto(&out.Assgnmt)
+	if r.SplmtryData != nil {
+		out.SplmtryData = make([]SupplementaryData1, len(r.SplmtryData))
+		for i := range r.SplmtryData {
+			r.SplmtryData[i].DeepCopyInto(&out.SplmtryData[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *OrganisationIdentification29) DeepCopy() *OrganisationIdentification29 {
+	if r == nil {
+		return nil
+	}
+	out := new(OrganisationIdentification29)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *OrganisationIdentification29) DeepCopyInto(out *OrganisationIdentification29) {
+	*out = *r
+	if r.AnyBIC != nil {
+		out.AnyBIC = new(common.AnyBICDec2014Identifier)
+		*out.AnyBIC = *r.AnyBIC
+	}
+	if r.LEI != nil {
+		out.LEI = new(common.LEIIdentifier)
+		*out.LEI = *r.LEI
+	}
+	if r.Othr != nil {
+		out.Othr = make([]GenericOrganisationIdentification1, len(r.Othr))
+		copy(out.Othr, r.Othr)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *OrganisationIdentification8) DeepCopy() *OrganisationIdentification8 {
+	if r == nil {
+		return nil
+	}
+	out := new(OrganisationIdentification8)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *OrganisationIdentification8) DeepCopyInto(out *OrganisationIdentification8) {
+	*out = *r
+	if r.AnyBIC != nil {
+		out.AnyBIC = new(common.AnyBICIdentifier)
+		*out.AnyBIC = *r.AnyBIC
+	}
+	if r.Othr != nil {
+		out.Othr = make([]GenericOrganisationIdentification1, len(r.Othr))
+		copy(out.Othr, r.Othr)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *OrganisationIdentificationSchemeName1Choice) DeepCopy() *OrganisationIdentificationSchemeName1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(OrganisationIdentificationSchemeName1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *OrganisationIdentificationSchemeName1Choice) DeepCopyInto(out *OrganisationIdentificationSchemeName1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *OriginalGroupHeader4) DeepCopy() *OriginalGroupHeader4 {
+	if r == nil {
+		return nil
+	}
+	out := new(OriginalGroupHeader4)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *OriginalGroupHeader4) DeepCopyInto(out *OriginalGroupHeader4) {
+	*out = *r
+	if r.GrpCxlId != nil {
+		out.GrpCxlId = new(common.Max35Text)
+		*out.GrpCxlId = *r.GrpCxlId
+	}
+	if r.Case != nil {
+		out.Case = new(Case3)
+		r.Case.DeepCopyInto(out.Case)
+	}
+	if r.OrgnlCreDtTm != nil {
+		out.OrgnlCreDtTm = new(common.ISODateTime)
+		*out.OrgnlCreDtTm = *r.OrgnlCreDtTm
+	}
+	if r.NbOfTxs != nil {
+		out.NbOfTxs = new(common.Max15NumericText)
+		*out.NbOfTxs = *r.NbOfTxs
+	}
+	if r.CxlRsnInf != nil {
+		out.CxlRsnInf = make([]PaymentCancellationReason2, len(r.CxlRsnInf))
+		for i := range r.CxlRsnInf {
+			r.CxlRsnInf[i].DeepCopyInto(&out.CxlRsnInf[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *OriginalGroupInformation3) DeepCopy() *OriginalGroupInformation3 {
+	if r == nil {
+		return nil
+	}
+	out := new(OriginalGroupInformation3)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *OriginalGroupInformation3) DeepCopyInto(out *OriginalGroupInformation3) {
+	*out = *r
+	if r.OrgnlCreDtTm != nil {
+		out.OrgnlCreDtTm = new(common.ISODateTime)
+		*out.OrgnlCreDtTm = *r.OrgnlCreDtTm
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *OriginalMessageAndIssuer1) DeepCopy() *OriginalMessageAndIssuer1 {
+	if r == nil {
+		return nil
+	}
+	out := new(OriginalMessageAndIssuer1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *OriginalMessageAndIssuer1) DeepCopyInto(out *OriginalMessageAndIssuer1) {
+	*out = *r
+	if r.MsgNmId != nil {
+		out.MsgNmId = new(common.Max35Text)
+		*out.MsgNmId = *r.MsgNmId
+	}
+	if r.OrgtrNm != nil {
+		out.OrgtrNm = new(common.Max70Text)
+		*out.OrgtrNm = *r.OrgtrNm
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *OriginalTransactionReference22) DeepCopy() *OriginalTransactionReference22 {
+	if r == nil {
+		return nil
+	}
+	out := new(OriginalTransactionReference22)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *OriginalTransactionReference22) DeepCopyInto(out *OriginalTransactionReference22) {
+	*out = *r
+	if r.IntrBkSttlmAmt != nil {
+		out.IntrBkSttlmAmt = new(ActiveOrHistoricCurrencyAndAmount)
+		*out.IntrBkSttlmAmt = *r.IntrBkSttlmAmt
+	}
+	if r.Amt != nil {
+		out.Amt = new(AmountType4Choice)
+		*out.Amt = *r.Amt
+	}
+	if r.IntrBkSttlmDt != nil {
+		out.IntrBkSttlmDt = new(common.ISODate)
+		*out.IntrBkSttlmDt = *r.IntrBkSttlmDt
+	}
+	if r.ReqdColltnDt != nil {
+		out.ReqdColltnDt = new(common.ISODate)
+		*out.ReqdColltnDt = *r.ReqdColltnDt
+	}
+	if r.ReqdExctnDt != nil {
+		out.ReqdExctnDt = new(common.ISODate)
+		*out.ReqdExctnDt = *r.ReqdExctnDt
+	}
+	if r.CdtrSchmeId != nil {
+		out.CdtrSchmeId = new(PartyIdentification43)
+		r.CdtrSchmeId.DeepCopyInto(out.CdtrSchmeId)
+	}
+	if r.SttlmInf != nil {
+		out.SttlmInf = new(SettlementInstruction4)
+		r.SttlmInf.DeepCopyInto(out.SttlmInf)
+	}
+	if r.PmtTpInf != nil {
+		out.PmtTpInf = new(PaymentTypeInformation25)
+		r.PmtTpInf.DeepCopyInto(out.PmtTpInf)
+	}
+	if r.PmtMtd != nil {
+		out.PmtMtd = new(PaymentMethod4Code)
+		*out.PmtMtd = *r.PmtMtd
+	}
+	if r.MndtRltdInf != nil {
+		out.MndtRltdInf = new(MandateRelatedInformation10)
+		r.MndtRltdInf.DeepCopyInto(out.MndtRltdInf)
+	}
+	if r.RmtInf != nil {
+		out.RmtInf = new(RemittanceInformation11)
+		r.RmtInf.DeepCopyInto(out.RmtInf)
+	}
+	if r.UltmtDbtr != nil {
+		out.UltmtDbtr = new(PartyIdentification43)
+		r.UltmtDbtr.DeepCopyInto(out.UltmtDbtr)
+	}
+	if r.Dbtr != nil {
+		out.Dbtr = new(PartyIdentification43)
+		r.Dbtr.DeepCopyInto(out.Dbtr)
+	}
+	if r.DbtrAcct != nil {
+		out.DbtrAcct = new(CashAccount24)
+		r.DbtrAcct.DeepCopyInto(out.DbtrAcct)
+	}
+	if r.DbtrAgt != nil {
+		out.DbtrAgt = new(BranchAndFinancialInstitutionIdentification5)
+		r.DbtrAgt.DeepCopyInto(out.DbtrAgt)
+	}
+	if r.DbtrAgtAcct != nil {
+		out.DbtrAgtAcct = new(CashAccount24)
+		r.DbtrAgtAcct.DeepCopyInto(out.DbtrAgtAcct)
+	}
+	if r.CdtrAgt != nil {
+		out.CdtrAgt = new(BranchAndFinancialInstitutionIdentification5)
+		r.CdtrAgt.DeepCopyInto(out.CdtrAgt)
+	}
+	if r.CdtrAgtAcct != nil {
+		out.CdtrAgtAcct = new(CashAccount24)
+		r.CdtrAgtAcct.DeepCopyInto(out.CdtrAgtAcct)
+	}
+	if r.Cdtr != nil {
+		out.Cdtr = new(PartyIdentification43)
+		r.Cdtr.DeepCopyInto(out.Cdtr)
+	}
+	if r.CdtrAcct != nil {
+		out.CdtrAcct = new(CashAccount24)
+		r.CdtrAcct.DeepCopyInto(out.CdtrAcct)
+	}
+	if r.UltmtCdtr != nil {
+		out.UltmtCdtr = new(PartyIdentification43)
+		r.UltmtCdtr.DeepCopyInto(out.UltmtCdtr)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *OtherContact1) DeepCopy() *OtherContact1 {
+	if r == nil {
+		return nil
+	}
+	out := new(OtherContact1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *OtherContact1) DeepCopyInto(out *OtherContact1) {
+	*out = *r
+	if r.Id != nil {
+		out.Id = new(common.Max128Text)
+		*out.Id = *r.Id
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *Party11Choice) DeepCopy() *Party11Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(Party11Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *Party11Choice) DeepCopyInto(out *Party11Choice) {
+	*out = *r
+	r.OrgId.DeepCopyInto(&out.OrgId)
+	r.PrvtId.DeepCopyInto(&out.PrvtId)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *Party12Choice) DeepCopy() *Party12Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(Party12Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *Party12Choice) DeepCopyInto(out *Party12Choice) {
+	*out = *r
+	r.Pty.DeepCopyInto(&out.Pty)
+	r.Agt.DeepCopyInto(&out.Agt)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *Party38Choice) DeepCopy() *Party38Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(Party38Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *Party38Choice) DeepCopyInto(out *Party38Choice) {
+	*out = *r
+	r.OrgId.DeepCopyInto(&out.OrgId)
+	r.PrvtId.DeepCopyInto(&out.PrvtId)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *Party40Choice) DeepCopy() *Party40Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(Party40Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *Party40Choice) DeepCopyInto(out *Party40Choice) {
+	*out = *r
+	if r.Pty != nil {
+		out.Pty = new(PartyIdentification135)
+		r.Pty.DeepCopyInto(out.Pty)
+	}
+	if r.Agt != nil {
+		out.Agt = new(BranchAndFinancialInstitutionIdentification6)
+		r.Agt.DeepCopyInto(out.Agt)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *PartyIdentification135) DeepCopy() *PartyIdentification135 {
+	if r == nil {
+		return nil
+	}
+	out := new(PartyIdentification135)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *PartyIdentification135) DeepCopyInto(out *PartyIdentification135) {
+	*out = *r
+	if r.Nm != nil {
+		out.Nm = new(common.Max140Text)
+		*out.Nm = *r.Nm
+	}
+	if r.PstlAdr != nil {
+		out.PstlAdr = new(PostalAddress24)
+		r.PstlAdr.DeepCopyInto(out.PstlAdr)
+	}
+	if r.Id != nil {
+		out.Id = new(Party38Choice)
+		r.Id.DeepCopyInto(out.Id)
+	}
+	if r.CtryOfRes != nil {
+		out.CtryOfRes = new(common.CountryCode)
+		*out.CtryOfRes = *r.CtryOfRes
+	}
+	if r.CtctDtls != nil {
+		out.CtctDtls = new(Contact4)
+		r.CtctDtls.DeepCopyInto(out.CtctDtls)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *PartyIdentification43) DeepCopy() *PartyIdentification43 {
+	if r == nil {
+		return nil
+	}
+	out := new(PartyIdentification43)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *PartyIdentification43) DeepCopyInto(out *PartyIdentification43) {
+	*out = *r
+	if r.Nm != nil {
+		out.Nm = new(common.Max140Text)
+		*out.Nm = *r.Nm
+	}
+	if r.PstlAdr != nil {
+		out.PstlAdr = new(PostalAddress6)
+		r.PstlAdr.DeepCopyInto(out.PstlAdr)
+	}
+	if r.Id != nil {
+		out.Id = new(Party11Choice)
+		r.Id.DeepCopyInto(out.Id)
+	}
+	if r.CtryOfRes != nil {
+		out.CtryOfRes = new(common.CountryCode)
+		*out.CtryOfRes = *r.CtryOfRes
+	}
+	if r.CtctDtls != nil {
+		out.CtctDtls = new(ContactDetails2)
+		r.CtctDtls.DeepCopyInto(out.CtctDtls)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *PaymentCancellationReason2) DeepCopy() *PaymentCancellationReason2 {
+	if r == nil {
+		return nil
+	}
+	out := new(PaymentCancellationReason2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *PaymentCancellationReason2) DeepCopyInto(out *PaymentCancellationReason2) {
+	*out = *r
+	if r.Orgtr != nil {
+		out.Orgtr = new(PartyIdentification43)
+		r.Orgtr.DeepCopyInto(out.Orgtr)
+	}
+	if r.Rsn != nil {
+		out.Rsn = new(CancellationReason14Choice)
+		*out.Rsn = *r.Rsn
+	}
+	if r.AddtlInf != nil {
+		out.AddtlInf = make([]common.Max105Text, len(r.AddtlInf))
+		copy(out.AddtlInf, r.AddtlInf)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *PaymentComplementaryInformation4) DeepCopy() *PaymentComplementaryInformation4 {
+	if r == nil {
+		return nil
+	}
+	out := new(PaymentComplementaryInformation4)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *PaymentComplementaryInformation4) DeepCopyInto(out *PaymentComplementaryInformation4) {
+	*out = *r
+	if r.InstrId != nil {
+		out.InstrId = new(common.Max35Text)
+		*out.InstrId = *r.InstrId
+	}
+	if r.EndToEndId != nil {
+		out.EndToEndId = new(common.Max35Text)
+		*out.EndToEndId = *r.EndToEndId
+	}
+	if r.TxId != nil {
+		out.TxId = new(common.Max35Text)
+		*out.TxId = *r.TxId
+	}
+	if r.PmtTpInf != nil {
+		out.PmtTpInf = new(PaymentTypeInformation25)
+		r.PmtTpInf.DeepCopyInto(out.PmtTpInf)
+	}
+	if r.ReqdExctnDt != nil {
+		out.ReqdExctnDt = new(common.ISODate)
+		*out.ReqdExctnDt = *r.ReqdExctnDt
+	}
+	if r.ReqdColltnDt != nil {
+		out.ReqdColltnDt = new(common.ISODate)
+		*out.ReqdColltnDt = *r.ReqdColltnDt
+	}
+	if r.IntrBkSttlmDt != nil {
+		out.IntrBkSttlmDt = new(common.ISODate)
+		*out.IntrBkSttlmDt = *r.IntrBkSttlmDt
+	}
+	if r.Amt != nil {
+		out.Amt = new(AmountType4Choice)
+		*out.Amt = *r.Amt
+	}
+	if r.IntrBkSttlmAmt != nil {
+		out.IntrBkSttlmAmt = new(ActiveOrHistoricCurrencyAndAmount)
+		*out.IntrBkSttlmAmt = *r.IntrBkSttlmAmt
+	}
+	if r.ChrgBr != nil {
+		out.ChrgBr = new(ChargeBearerType1Code)
+		*out.ChrgBr = *r.ChrgBr
+	}
+	if r.UltmtDbtr != nil {
+		out.UltmtDbtr = new(PartyIdentification43)
+		r.UltmtDbtr.DeepCopyInto(out.UltmtDbtr)
+	}
+	if r.Dbtr != nil {
+		out.Dbtr = new(PartyIdentification43)
+		r.Dbtr.DeepCopyInto(out.Dbtr)
+	}
+	if r.DbtrAcct != nil {
+		out.DbtrAcct = new(CashAccount24)
+		r.DbtrAcct.DeepCopyInto(out.DbtrAcct)
+	}
+	if r.DbtrAgt != nil {
+		out.DbtrAgt = new(BranchAndFinancialInstitutionIdentification5)
+		r.DbtrAgt.DeepCopyInto(out.DbtrAgt)
+	}
+	if r.DbtrAgtAcct != nil {
+		out.DbtrAgtAcct = new(CashAccount24)
+		r.DbtrAgtAcct.DeepCopyInto(out.DbtrAgtAcct)
+	}
+	if r.SttlmInf != nil {
+		out.SttlmInf = new(SettlementInstruction1)
+		r.SttlmInf.DeepCopyInto(out.SttlmInf)
+	}
+	if r.IntrmyAgt1 != nil {
+		out.IntrmyAgt1 = new(BranchAndFinancialInstitutionIdentification5)
+		r.IntrmyAgt1.DeepCopyInto(out.IntrmyAgt1)
+	}
+	if r.IntrmyAgt1Acct != nil {
+		out.IntrmyAgt1Acct = new(CashAccount24)
+		r.IntrmyAgt1Acct.DeepCopyInto(out.IntrmyAgt1Acct)
+	}
+	if r.IntrmyAgt2 != nil {
+		out.IntrmyAgt2 = new(BranchAndFinancialInstitutionIdentification5)
+		r.IntrmyAgt2.DeepCopyInto(out.IntrmyAgt2)
+	}
+	if r.IntrmyAgt2Acct != nil {
+		out.IntrmyAgt2Acct = new(CashAccount24)
+		r.IntrmyAgt2Acct.DeepCopyInto(out.IntrmyAgt2Acct)
+	}
+	if r.IntrmyAgt3 != nil {
+		out.IntrmyAgt3 = new(BranchAndFinancialInstitutionIdentification5)
+		r.IntrmyAgt3.DeepCopyInto(out.IntrmyAgt3)
+	}
+	if r.IntrmyAgt3Acct != nil {
+		out.IntrmyAgt3Acct = new(CashAccount24)
+		r.IntrmyAgt3Acct.DeepCopyInto(out.IntrmyAgt3Acct)
+	}
+	if r.CdtrAgt != nil {
+		out.CdtrAgt = new(BranchAndFinancialInstitutionIdentification5)
+		r.CdtrAgt.DeepCopyInto(out.CdtrAgt)
+	}
+	if r.CdtrAgtAcct != nil {
+		out.CdtrAgtAcct = new(CashAccount24)
+		r.CdtrAgtAcct.DeepCopyInto(out.CdtrAgtAcct)
+	}
+	if r.Cdtr != nil {
+		out.Cdtr = new(PartyIdentification43)
+		r.Cdtr.DeepCopyInto(out.Cdtr)
+	}
+	if r.CdtrAcct != nil {
+		out.CdtrAcct = new(CashAccount24)
+		r.CdtrAcct.DeepCopyInto(out.CdtrAcct)
+	}
+	if r.UltmtCdtr != nil {
+		out.UltmtCdtr = new(PartyIdentification43)
+		r.UltmtCdtr.DeepCopyInto(out.UltmtCdtr)
+	}
+	if r.Purp != nil {
+		out.Purp = new(Purpose2Choice)
+		*out.Purp = *r.Purp
+	}
+	if r.InstrForDbtrAgt != nil {
+		out.InstrForDbtrAgt = new(common.Max140Text)
+		*out.InstrForDbtrAgt = *r.InstrForDbtrAgt
+	}
+	if r.PrvsInstgAgt != nil {
+		out.PrvsInstgAgt = new(BranchAndFinancialInstitutionIdentification5)
+		r.PrvsInstgAgt.DeepCopyInto(out.PrvsInstgAgt)
+	}
+	if r.PrvsInstgAgtAcct != nil {
+		out.PrvsInstgAgtAcct = new(CashAccount24)
+		r.PrvsInstgAgtAcct.DeepCopyInto(out.PrvsInstgAgtAcct)
+	}
+	if r.InstrForNxtAgt != nil {
+		out.InstrForNxtAgt = make([]InstructionForNextAgent1, len(r.InstrForNxtAgt))
+		for i := range r.InstrForNxtAgt {
+			r.InstrForNxtAgt[i].DeepCopyInto(&out.InstrForNxtAgt[i])
+		}
+	}
+	if r.InstrForCdtrAgt != nil {
+		out.InstrForCdtrAgt = make([]InstructionForCreditorAgent1, len(r.InstrForCdtrAgt))
+		for i := range r.InstrForCdtrAgt {
+			r.InstrForCdtrAgt[i].DeepCopyInto(&out.InstrForCdtrAgt[i])
+		}
+	}
+	if r.RmtInf != nil {
+		out.RmtInf = new(RemittanceInformation10)
+		r.RmtInf.DeepCopyInto(out.RmtInf)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *PaymentIdentification6Choice) DeepCopy() *PaymentIdentification6Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(PaymentIdentification6Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *PaymentIdentification6Choice) DeepCopyInto(out *PaymentIdentification6Choice) {
+	*out = *r
+	r.LngBizId.DeepCopyInto(&out.LngBizId)
+	r.ShrtBizId.DeepCopyInto(&out.ShrtBizId)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *PaymentIdentification8) DeepCopy() *PaymentIdentification8 {
+	if r == nil {
+		return nil
+	}
+	out := new(PaymentIdentification8)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *PaymentIdentification8) DeepCopyInto(out *PaymentIdentification8) {
+	*out = *r
+	if r.InstrId != nil {
+		out.InstrId = new(common.Max35Text)
+		*out.InstrId = *r.InstrId
+	}
+	if r.EndToEndId != nil {
+		out.EndToEndId = new(common.Max35Text)
+		*out.EndToEndId = *r.EndToEndId
+	}
+	if r.TxId != nil {
+		out.TxId = new(common.Max35Text)
+		*out.TxId = *r.TxId
+	}
+	if r.UETR != nil {
+		out.UETR = new(common.UUIDv4Identifier)
+		*out.UETR = *r.UETR
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *PaymentOrigin1Choice) DeepCopy() *PaymentOrigin1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(PaymentOrigin1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *PaymentOrigin1Choice) DeepCopyInto(out *PaymentOrigin1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *PaymentTransaction62) DeepCopy() *PaymentTransaction62 {
+	if r == nil {
+		return nil
+	}
+	out := new(PaymentTransaction62)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *PaymentTransaction62) DeepCopyInto(out *PaymentTransaction62) {
+	*out = *r
+	if r.CxlId != nil {
+		out.CxlId = new(common.Max35Text)
+		*out.CxlId = *r.CxlId
+	}
+	if r.Case != nil {
+		out.Case = new(Case3)
+		r.Case.DeepCopyInto(out.Case)
+	}
+	if r.OrgnlGrpInf != nil {
+		out.OrgnlGrpInf = new(OriginalGroupInformation3)
+		r.OrgnlGrpInf.DeepCopyInto(out.OrgnlGrpInf)
+	}
+	if r.OrgnlInstrId != nil {
+		out.OrgnlInstrId = new(common.Max35Text)
+		*out.OrgnlInstrId = *r.OrgnlInstrId
+	}
+	if r.OrgnlEndToEndId != nil {
+		out.OrgnlEndToEndId = new(common.Max35Text)
+		*out.OrgnlEndToEndId = *r.OrgnlEndToEndId
+	}
+	if r.OrgnlTxId != nil {
+		out.OrgnlTxId = new(common.Max35Text)
+		*out.OrgnlTxId = *r.OrgnlTxId
+	}
+	if r.OrgnlClrSysRef != nil {
+		out.OrgnlClrSysRef = new(common.Max35Text)
+		*out.OrgnlClrSysRef = *r.OrgnlClrSysRef
+	}
+	if r.OrgnlIntrBkSttlmAmt != nil {
+		out.OrgnlIntrBkSttlmAmt = new(ActiveOrHistoricCurrencyAndAmount)
+		*out.OrgnlIntrBkSttlmAmt = *r.OrgnlIntrBkSttlmAmt
+	}
+	if r.OrgnlIntrBkSttlmDt != nil {
+		out.OrgnlIntrBkSttlmDt = new(common.ISODate)
+		*out.OrgnlIntrBkSttlmDt = *r.OrgnlIntrBkSttlmDt
+	}
+	if r.Assgnr != nil {
+		out.Assgnr = new(BranchAndFinancialInstitutionIdentification5)
+		r.Assgnr.DeepCopyInto(out.Assgnr)
+	}
+	if r.Assgne != nil {
+		out.Assgne = new(BranchAndFinancialInstitutionIdentification5)
+		r.Assgne.DeepCopyInto(out.Assgne)
+	}
+	if r.CxlRsnInf != nil {
+		out.CxlRsnInf = make([]PaymentCancellationReason2, len(r.CxlRsnInf))
+		for i := range r.CxlRsnInf {
+			r.CxlRsnInf[i].DeepCopyInto(&out.CxlRsnInf[i])
+		}
+	}
+	if r.OrgnlTxRef != nil {
+		out.OrgnlTxRef = new(OriginalTransactionReference22)
+		r.OrgnlTxRef.DeepCopyInto(out.OrgnlTxRef)
+	}
+	if r.SplmtryData != nil {
+		out.SplmtryData = make([]SupplementaryData1, len(r.SplmtryData))
+		for i := range r.SplmtryData {
+			r.SplmtryData[i].DeepCopyInto(&out.SplmtryData[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *PaymentTypeInformation25) DeepCopy() *PaymentTypeInformation25 {
+	if r == nil {
+		return nil
+	}
+	out := new(PaymentTypeInformation25)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *PaymentTypeInformation25) DeepCopyInto(out *PaymentTypeInformation25) {
+	*out = *r
+	if r.InstrPrty != nil {
+		out.InstrPrty = new(Priority2Code)
+		*out.InstrPrty = *r.InstrPrty
+	}
+	if r.ClrChanl != nil {
+		out.ClrChanl = new(ClearingChannel2Code)
+		*out.ClrChanl = *r.ClrChanl
+	}
+	if r.SvcLvl != nil {
+		out.SvcLvl = new(ServiceLevel8Choice)
+		*out.SvcLvl = *r.SvcLvl
+	}
+	if r.LclInstrm != nil {
+		out.LclInstrm = new(LocalInstrument2Choice)
+		*out.LclInstrm = *r.LclInstrm
+	}
+	if r.SeqTp != nil {
+		out.SeqTp = new(SequenceType3Code)
+		*out.SeqTp = *r.SeqTp
+	}
+	if r.CtgyPurp != nil {
+		out.CtgyPurp = new(CategoryPurpose1Choice)
+		*out.CtgyPurp = *r.CtgyPurp
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *PersonIdentification13) DeepCopy() *PersonIdentification13 {
+	if r == nil {
+		return nil
+	}
+	out := new(PersonIdentification13)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *PersonIdentification13) DeepCopyInto(out *PersonIdentification13) {
+	*out = *r
+	if r.DtAndPlcOfBirth != nil {
+		out.DtAndPlcOfBirth = new(DateAndPlaceOfBirth1)
+		r.DtAndPlcOfBirth.DeepCopyInto(out.DtAndPlcOfBirth)
+	}
+	if r.Othr != nil {
+		out.Othr = make([]GenericPersonIdentification1, len(r.Othr))
+		for i := range r.Othr {
+			r.Othr[i].DeepCopyInto(&out.Othr[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *PersonIdentification5) DeepCopy() *PersonIdentification5 {
+	if r == nil {
+		return nil
+	}
+	out := new(PersonIdentification5)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *PersonIdentification5) DeepCopyInto(out *PersonIdentification5) {
+	*out = *r
+	if r.DtAndPlcOfBirth != nil {
+		out.DtAndPlcOfBirth = new(DateAndPlaceOfBirth)
+		r.DtAndPlcOfBirth.DeepCopyInto(out.DtAndPlcOfBirth)
+	}
+	if r.Othr != nil {
+		out.Othr = make([]GenericPersonIdentification1, len(r.Othr))
+		for i := range r.Othr {
+			r.Othr[i].DeepCopyInto(&out.Othr[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *PersonIdentificationSchemeName1Choice) DeepCopy() *PersonIdentificationSchemeName1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(PersonIdentificationSchemeName1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *PersonIdentificationSchemeName1Choice) DeepCopyInto(out *PersonIdentificationSchemeName1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *PostalAddress24) DeepCopy() *PostalAddress24 {
+	if r == nil {
+		return nil
+	}
+	out := new(PostalAddress24)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *PostalAddress24) DeepCopyInto(out *PostalAddress24) {
+	*out = *r
+	if r.AdrTp != nil {
+		out.AdrTp = new(AddressType3Choice)
+		r.AdrTp.DeepCopyInto(out.AdrTp)
+	}
+	if r.Dept != nil {
+		out.Dept = new(common.Max70Text)
+		*out.Dept = *r.Dept
+	}
+	if r.SubDept != nil {
+		out.SubDept = new(common.Max70Text)
+		*out.SubDept = *r.SubDept
+	}
+	if r.StrtNm != nil {
+		out.StrtNm = new(common.Max70Text)
+		*out.StrtNm = *r.StrtNm
+	}
+	if r.BldgNb != nil {
+		out.BldgNb = new(common.Max16Text)
+		*out.BldgNb = *r.BldgNb
+	}
+	if r.BldgNm != nil {
+		out.BldgNm = new(common.Max35Text)
+		*out.BldgNm = *r.BldgNm
+	}
+	if r.Flr != nil {
+		out.Flr = new(common.Max70Text)
+		*out.Flr = *r.Flr
+	}
+	if r.PstBx != nil {
+		out.PstBx = new(common.Max16Text)
+		*out.PstBx = *r.PstBx
+	}
+	if r.Room != nil {
+		out.Room = new(common.Max70Text)
+		*out.Room = *r.Room
+	}
+	if r.PstCd != nil {
+		out.PstCd = new(common.Max16Text)
+		*out.PstCd = *r.PstCd
+	}
+	if r.TwnNm != nil {
+		out.TwnNm = new(common.Max35Text)
+		*out.TwnNm = *r.TwnNm
+	}
+	if r.TwnLctnNm != nil {
+		out.TwnLctnNm = new(common.Max35Text)
+		*out.TwnLctnNm = *r.TwnLctnNm
+	}
+	if r.DstrctNm != nil {
+		out.DstrctNm = new(common.Max35Text)
+		*out.DstrctNm = *r.DstrctNm
+	}
+	if r.CtrySubDvsn != nil {
+		out.CtrySubDvsn = new(common.Max35Text)
+		*out.CtrySubDvsn = *r.CtrySubDvsn
+	}
+	if r.Ctry != nil {
+		out.Ctry = new(common.CountryCode)
+		*out.Ctry = *r.Ctry
+	}
+	if r.AdrLine != nil {
+		out.AdrLine = make([]common.Max70Text, len(r.AdrLine))
+		copy(out.AdrLine, r.AdrLine)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *PostalAddress6) DeepCopy() *PostalAddress6 {
+	if r == nil {
+		return nil
+	}
+	out := new(PostalAddress6)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *PostalAddress6) DeepCopyInto(out *PostalAddress6) {
+	*out = *r
+	if r.AdrTp != nil {
+		out.AdrTp = new(AddressType2Code)
+		*out.AdrTp = *r.AdrTp
+	}
+	if r.Dept != nil {
+		out.Dept = new(common.Max70Text)
+		*out.Dept = *r.Dept
+	}
+	if r.SubDept != nil {
+		out.SubDept = new(common.Max70Text)
+		*out.SubDept = *r.SubDept
+	}
+	if r.StrtNm != nil {
+		out.StrtNm = new(common.Max70Text)
+		*out.StrtNm = *r.StrtNm
+	}
+	if r.BldgNb != nil {
+		out.BldgNb = new(common.Max16Text)
+		*out.BldgNb = *r.BldgNb
+	}
+	if r.PstCd != nil {
+		out.PstCd = new(common.Max16Text)
+		*out.PstCd = *r.PstCd
+	}
+	if r.TwnNm != nil {
+		out.TwnNm = new(common.Max35Text)
+		*out.TwnNm = *r.TwnNm
+	}
+	if r.CtrySubDvsn != nil {
+		out.CtrySubDvsn = new(common.Max35Text)
+		*out.CtrySubDvsn = *r.CtrySubDvsn
+	}
+	if r.Ctry != nil {
+		out.Ctry = new(common.CountryCode)
+		*out.Ctry = *r.Ctry
+	}
+	if r.AdrLine != nil {
+		out.AdrLine = make([]common.Max70Text, len(r.AdrLine))
+		copy(out.AdrLine, r.AdrLine)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ProprietaryData6) DeepCopy() *ProprietaryData6 {
+	if r == nil {
+		return nil
+	}
+	out := new(ProprietaryData6)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ProprietaryData6) DeepCopyInto(out *ProprietaryData6) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ProprietaryData7) DeepCopy() *ProprietaryData7 {
+	if r == nil {
+		return nil
+	}
+	out := new(ProprietaryData7)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ProprietaryData7) DeepCopyInto(out *ProprietaryData7) {
+	*out = *r
+	r.Data.DeepCopyInto(&out.Data)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ProprietaryFormatInvestigationV05) DeepCopy() *ProprietaryFormatInvestigationV05 {
+	if r == nil {
+		return nil
+	}
+	out := new(ProprietaryFormatInvestigationV05)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ProprietaryFormatInvestigationV05) DeepCopyInto(out *ProprietaryFormatInvestigationV05) {
+	*out = *r
+	r.Assgnmt.DeepCopyInto(&out.Assgnmt)
+	if r.Case != nil {
+		out.Case = new(Case5)
+		r.Case.DeepCopyInto(out.Case)
+	}
+	r.PrtryData.DeepCopyInto(&out.PrtryData)
+	if r.SplmtryData != nil {
+		out.SplmtryData = make([]SupplementaryData1, len(r.SplmtryData))
+		for i := range r.SplmtryData {
+			r.SplmtryData[i].DeepCopyInto(&out.SplmtryData[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ProxyAccountIdentification1) DeepCopy() *ProxyAccountIdentification1 {
+	if r == nil {
+		return nil
+	}
+	out := new(ProxyAccountIdentification1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ProxyAccountIdentification1) DeepCopyInto(out *ProxyAccountIdentification1) {
+	*out = *r
+	if r.Tp != nil {
+		out.Tp = new(ProxyAccountType1Choice)
+		*out.Tp = *r.Tp
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ProxyAccountType1Choice) DeepCopy() *ProxyAccountType1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(ProxyAccountType1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ProxyAccountType1Choice) DeepCopyInto(out *ProxyAccountType1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *Purpose2Choice) DeepCopy() *Purpose2Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(Purpose2Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *Purpose2Choice) DeepCopyInto(out *Purpose2Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *QueueTransactionIdentification1) DeepCopy() *QueueTransactionIdentification1 {
+	if r == nil {
+		return nil
+	}
+	out := new(QueueTransactionIdentification1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *QueueTransactionIdentification1) DeepCopyInto(out *QueueTransactionIdentification1) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *Receipt3) DeepCopy() *Receipt3 {
+	if r == nil {
+		return nil
+	}
+	out := new(Receipt3)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *Receipt3) DeepCopyInto(out *Receipt3) {
+	*out = *r
+	r.OrgnlMsgId.DeepCopyInto(&out.OrgnlMsgId)
+	if r.OrgnlPmtId != nil {
+		out.OrgnlPmtId = new(PaymentIdentification6Choice)
+		r.OrgnlPmtId.DeepCopyInto(out.OrgnlPmtId)
+	}
+	if r.ReqHdlg != nil {
+		out.ReqHdlg = make([]RequestHandling1, len(r.ReqHdlg))
+		for i := range r.ReqHdlg {
+			r.ReqHdlg[i].DeepCopyInto(&out.ReqHdlg[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ReceiptV05) DeepCopy() *ReceiptV05 {
+	if r == nil {
+		return nil
+	}
+	out := new(ReceiptV05)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ReceiptV05) DeepCopyInto(out *ReceiptV05) {
+	*out = *r
+	r.MsgHdr.DeepCopyInto(&out.MsgHdr)
+	if r.RctDtls != nil {
+		out.RctDtls = make([]Receipt3, len(r.RctDtls))
+		for i := range r.RctDtls {
+			r.RctDtls[i].DeepCopyInto(&out.RctDtls[i])
+		}
+	}
+	if r.SplmtryData != nil {
+		out.SplmtryData = make([]SupplementaryData1, len(r.SplmtryData))
+		for i := range r.SplmtryData {
+			r.SplmtryData[i].DeepCopyInto(&out.SplmtryData[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ReferredDocumentInformation6) DeepCopy() *ReferredDocumentInformation6 {
+	if r == nil {
+		return nil
+	}
+	out := new(ReferredDocumentInformation6)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ReferredDocumentInformation6) DeepCopyInto(out *ReferredDocumentInformation6) {
+	*out = *r
+	if r.Tp != nil {
+		out.Tp = new(ReferredDocumentType4)
+		r.Tp.DeepCopyInto(out.Tp)
+	}
+	if r.Nb != nil {
+		out.Nb = new(common.Max35Text)
+		*out.Nb = *r.Nb
+	}
+	if r.RltdDt != nil {
+		out.RltdDt = new(common.ISODate)
+		*out.RltdDt = *r.RltdDt
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ReferredDocumentInformation7) DeepCopy() *ReferredDocumentInformation7 {
+	if r == nil {
+		return nil
+	}
+	out := new(ReferredDocumentInformation7)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ReferredDocumentInformation7) DeepCopyInto(out *ReferredDocumentInformation7) {
+	*out = *r
+	if r.Tp != nil {
+		out.Tp = new(ReferredDocumentType4)
+		r.Tp.DeepCopyInto(out.Tp)
+	}
+	if r.Nb != nil {
+		out.Nb = new(common.Max35Text)
+		*out.Nb = *r.Nb
+	}
+	if r.RltdDt != nil {
+		out.RltdDt = new(common.ISODate)
+		*out.RltdDt = *r.RltdDt
+	}
+	if r.LineDtls != nil {
+		out.LineDtls = make([]DocumentLineInformation1, len(r.LineDtls))
+		for i := range r.LineDtls {
+			r.LineDtls[i].DeepCopyInto(&out.LineDtls[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ReferredDocumentType3Choice) DeepCopy() *ReferredDocumentType3Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(ReferredDocumentType3Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ReferredDocumentType3Choice) DeepCopyInto(out *ReferredDocumentType3Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ReferredDocumentType4) DeepCopy() *ReferredDocumentType4 {
+	if r == nil {
+		return nil
+	}
+	out := new(ReferredDocumentType4)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ReferredDocumentType4) DeepCopyInto(out *ReferredDocumentType4) {
+	*out = *r
+	if r.Issr != nil {
+		out.Issr = new(common.Max35Text)
+		*out.Issr = *r.Issr
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *RemittanceAmount2) DeepCopy() *RemittanceAmount2 {
+	if r == nil {
+		return nil
+	}
+	out := new(RemittanceAmount2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *RemittanceAmount2) DeepCopyInto(out *RemittanceAmount2) {
+	*out = *r
+	if r.DuePyblAmt != nil {
+		out.DuePyblAmt = new(ActiveOrHistoricCurrencyAndAmount)
+		*out.DuePyblAmt = *r.DuePyblAmt
+	}
+	if r.DscntApldAmt != nil {
+		out.DscntApldAmt = make([]DiscountAmountAndType1, len(r.DscntApldAmt))
+		for i := range r.DscntApldAmt {
+			r.DscntApldAmt[i].DeepCopyInto(&out.DscntApldAmt[i])
+		}
+	}
+	if r.CdtNoteAmt != nil {
+		out.CdtNoteAmt = new(ActiveOrHistoricCurrencyAndAmount)
+		*out.CdtNoteAmt = *r.CdtNoteAmt
+	}
+	if r.TaxAmt != nil {
+		out.TaxAmt = make([]TaxAmountAndType1, len(r.TaxAmt))
+		for i := range r.TaxAmt {
+			r.TaxAmt[i].DeepCopyInto(&out.TaxAmt[i])
+		}
+	}
+	if r.AdjstmntAmtAndRsn != nil {
+		out.AdjstmntAmtAndRsn = make([]DocumentAdjustment1, len(r.AdjstmntAmtAndRsn))
+		for i := range r.AdjstmntAmtAndRsn {
+			r.AdjstmntAmtAndRsn[i].DeepCopyInto(&out.AdjstmntAmtAndRsn[i])
+		}
+	}
+	if r.RmtdAmt != nil {
+		out.RmtdAmt = new(ActiveOrHistoricCurrencyAndAmount)
+		*out.RmtdAmt = *r.RmtdAmt
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *RemittanceAmount3) DeepCopy() *RemittanceAmount3 {
+	if r == nil {
+		return nil
+	}
+	out := new(RemittanceAmount3)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *RemittanceAmount3) DeepCopyInto(out *RemittanceAmount3) {
+	*out = *r
+	if r.DuePyblAmt != nil {
+		out.DuePyblAmt = new(ActiveOrHistoricCurrencyAndAmount)
+		*out.DuePyblAmt = *r.DuePyblAmt
+	}
+	if r.DscntApldAmt != nil {
+		out.DscntApldAmt = make([]DiscountAmountAndType1, len(r.DscntApldAmt))
+		for i := range r.DscntApldAmt {
+			r.DscntApldAmt[i].DeepCopyInto(&out.DscntApldAmt[i])
+		}
+	}
+	if r.CdtNoteAmt != nil {
+		out.CdtNoteAmt = new(ActiveOrHistoricCurrencyAndAmount)
+		*out.CdtNoteAmt = *r.CdtNoteAmt
+	}
+	if r.TaxAmt != nil {
+		out.TaxAmt = make([]TaxAmountAndType1, len(r.TaxAmt))
+		for i := range r.TaxAmt {
+			r.TaxAmt[i].DeepCopyInto(&out.TaxAmt[i])
+		}
+	}
+	if r.AdjstmntAmtAndRsn != nil {
+		out.AdjstmntAmtAndRsn = make([]DocumentAdjustment1, len(r.AdjstmntAmtAndRsn))
+		for i := range r.AdjstmntAmtAndRsn {
+			r.AdjstmntAmtAndRsn[i].DeepCopyInto(&out.AdjstmntAmtAndRsn[i])
+		}
+	}
+	if r.RmtdAmt != nil {
+		out.RmtdAmt = new(ActiveOrHistoricCurrencyAndAmount)
+		*out.RmtdAmt = *r.RmtdAmt
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *RemittanceInformation10) DeepCopy() *RemittanceInformation10 {
+	if r == nil {
+		return nil
+	}
+	out := new(RemittanceInformation10)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *RemittanceInformation10) DeepCopyInto(out *RemittanceInformation10) {
+	*out = *r
+	if r.Ustrd != nil {
+		out.Ustrd = make([]common.Max140Text, len(r.Ustrd))
+		copy(out.Ustrd, r.Ustrd)
+	}
+	if r.Strd != nil {
+		out.Strd = make([]StructuredRemittanceInformation12, len(r.Strd))
+		for i := range r.Strd {
+			r.Strd[i].DeepCopyInto(&out.Strd[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *RemittanceInformation11) DeepCopy() *RemittanceInformation11 {
+	if r == nil {
+		return nil
+	}
+	out := new(RemittanceInformation11)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *RemittanceInformation11) DeepCopyInto(out *RemittanceInformation11) {
+	*out = *r
+	if r.Ustrd != nil {
+		out.Ustrd = make([]common.Max140Text, len(r.Ustrd))
+		copy(out.Ustrd, r.Ustrd)
+	}
+	if r.Strd != nil {
+		out.Strd = make([]StructuredRemittanceInformation13, len(r.Strd))
+		for i := range r.Strd {
+			r.Strd[i].DeepCopyInto(&out.Strd[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ReportHeader5) DeepCopy() *ReportHeader5 {
+	if r == nil {
+		return nil
+	}
+	out := new(ReportHeader5)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ReportHeader5) DeepCopyInto(out *ReportHeader5) {
+	*out = *r
+	r.Fr.DeepCopyInto(&out.Fr)
+	r.To.DeepCopyInto(&out.To)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ReportingPeriod2) DeepCopy() *ReportingPeriod2 {
+	if r == nil {
+		return nil
+	}
+	out := new(ReportingPeriod2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ReportingPeriod2) DeepCopyInto(out *ReportingPeriod2) {
+	*out = *r
+	r.FrToDt.DeepCopyInto(&out.FrToDt)
+	if r.FrToTm != nil {
+		out.FrToTm = new(TimePeriodDetails1)
+		r.FrToTm.DeepCopyInto(out.FrToTm)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ReportingRequest5) DeepCopy() *ReportingRequest5 {
+	if r == nil {
+		return nil
+	}
+	out := new(ReportingRequest5)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ReportingRequest5) DeepCopyInto(out *ReportingRequest5) {
+	*out = *r
+	if r.Id != nil {
+		out.Id = new(common.Max35Text)
+		*out.Id = *r.Id
+	}
+	if r.Acct != nil {
+		out.Acct = new(CashAccount38)
+		r.Acct.DeepCopyInto(out.Acct)
+	}
+	r.AcctOwnr.DeepCopyInto(&out.AcctOwnr)
+	if r.AcctSvcr != nil {
+		out.AcctSvcr = new(BranchAndFinancialInstitutionIdentification6)
+		r.AcctSvcr.DeepCopyInto(out.AcctSvcr)
+	}
+	if r.RptgPrd != nil {
+		out.RptgPrd = new(ReportingPeriod2)
+		r.RptgPrd.DeepCopyInto(out.RptgPrd)
+	}
+	if r.RptgSeq != nil {
+		out.RptgSeq = new(SequenceRange1Choice)
+		r.RptgSeq.DeepCopyInto(out.RptgSeq)
+	}
+	if r.ReqdTxTp != nil {
+		out.ReqdTxTp = new(TransactionType2)
+		r.ReqdTxTp.DeepCopyInto(out.ReqdTxTp)
+	}
+	if r.ReqdBalTp != nil {
+		out.ReqdBalTp = make([]BalanceType13, len(r.ReqdBalTp))
+		for i := range r.ReqdBalTp {
+			r.ReqdBalTp[i].DeepCopyInto(&out.ReqdBalTp[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *RequestHandling1) DeepCopy() *RequestHandling1 {
+	if r == nil {
+		return nil
+	}
+	out := new(RequestHandling1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *RequestHandling1) DeepCopyInto(out *RequestHandling1) {
+	*out = *r
+	if r.Desc != nil {
+		out.Desc = new(common.Max140Text)
+		*out.Desc = *r.Desc
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *RequestType4Choice) DeepCopy() *RequestType4Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(RequestType4Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *RequestType4Choice) DeepCopyInto(out *RequestType4Choice) {
+	*out = *r
+	r.Prtry.DeepCopyInto(&out.Prtry)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *Reservation4) DeepCopy() *Reservation4 {
+	if r == nil {
+		return nil
+	}
+	out := new(Reservation4)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *Reservation4) DeepCopyInto(out *Reservation4) {
+	*out = *r
+	if r.StartDtTm != nil {
+		out.StartDtTm = new(DateAndDateTime2Choice)
+		*out.StartDtTm = *r.StartDtTm
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ReservationCriteria3Choice) DeepCopy() *ReservationCriteria3Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(ReservationCriteria3Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ReservationCriteria3Choice) DeepCopyInto(out *ReservationCriteria3Choice) {
+	*out = *r
+	r.NewCrit.DeepCopyInto(&out.NewCrit)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ReservationCriteria4) DeepCopy() *ReservationCriteria4 {
+	if r == nil {
+		return nil
+	}
+	out := new(ReservationCriteria4)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ReservationCriteria4) DeepCopyInto(out *ReservationCriteria4) {
+	*out = *r
+	if r.NewQryNm != nil {
+		out.NewQryNm = new(common.Max35Text)
+		*out.NewQryNm = *r.NewQryNm
+	}
+	if r.SchCrit != nil {
+		out.SchCrit = make([]ReservationSearchCriteria3, len(r.SchCrit))
+		for i := range r.SchCrit {
+			r.SchCrit[i].DeepCopyInto(&out.SchCrit[i])
+		}
+	}
+	if r.RtrCrit != nil {
+		out.RtrCrit = new(ReservationReturnCriteria1)
+		*out.RtrCrit = *r.RtrCrit
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ReservationIdentification2) DeepCopy() *ReservationIdentification2 {
+	if r == nil {
+		return nil
+	}
+	out := new(ReservationIdentification2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ReservationIdentification2) DeepCopyInto(out *ReservationIdentification2) {
+	*out = *r
+	if r.RsvatnId != nil {
+		out.RsvatnId = new(common.Max35Text)
+		*out.RsvatnId = *r.RsvatnId
+	}
+	if r.SysId != nil {
+		out.SysId = new(SystemIdentification2Choice)
+		*out.SysId = *r.SysId
+	}
+	if r.AcctOwnr != nil {
+		out.AcctOwnr = new(BranchAndFinancialInstitutionIdentification6)
+		r.AcctOwnr.DeepCopyInto(out.AcctOwnr)
+	}
+	if r.AcctId != nil {
+		out.AcctId = new(AccountIdentification4Choice)
+		r.AcctId.DeepCopyInto(out.AcctId)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ReservationQuery3) DeepCopy() *ReservationQuery3 {
+	if r == nil {
+		return nil
+	}
+	out := new(ReservationQuery3)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ReservationQuery3) DeepCopyInto(out *ReservationQuery3) {
+	*out = *r
+	if r.QryTp != nil {
+		out.QryTp = new(QueryType2Code)
+		*out.QryTp = *r.QryTp
+	}
+	if r.RsvatnCrit != nil {
+		out.RsvatnCrit = new(ReservationCriteria3Choice)
+		r.RsvatnCrit.DeepCopyInto(out.RsvatnCrit)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ReservationReturnCriteria1) DeepCopy() *ReservationReturnCriteria1 {
+	if r == nil {
+		return nil
+	}
+	out := new(ReservationReturnCriteria1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ReservationReturnCriteria1) DeepCopyInto(out *ReservationReturnCriteria1) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ReservationSearchCriteria3) DeepCopy() *ReservationSearchCriteria3 {
+	if r == nil {
+		return nil
+	}
+	out := new(ReservationSearchCriteria3)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ReservationSearchCriteria3) DeepCopyInto(out *ReservationSearchCriteria3) {
+	*out = *r
+	if r.SysId != nil {
+		out.SysId = new(SystemIdentification2Choice)
+		*out.SysId = *r.SysId
+	}
+	if r.DfltRsvatnTp != nil {
+		out.DfltRsvatnTp = make([]ReservationType1Code, len(r.DfltRsvatnTp))
+		copy(out.DfltRsvatnTp, r.DfltRsvatnTp)
+	}
+	if r.CurRsvatnTp != nil {
+		out.CurRsvatnTp = make([]ReservationType1Code, len(r.CurRsvatnTp))
+		copy(out.CurRsvatnTp, r.CurRsvatnTp)
+	}
+	if r.AcctOwnr != nil {
+		out.AcctOwnr = new(BranchAndFinancialInstitutionIdentification6)
+		r.AcctOwnr.DeepCopyInto(out.AcctOwnr)
+	}
+	if r.AcctId != nil {
+		out.AcctId = new(AccountIdentification4Choice)
+		r.AcctId.DeepCopyInto(out.AcctId)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ReservationType1Choice) DeepCopy() *ReservationType1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(ReservationType1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ReservationType1Choice) DeepCopyInto(out *ReservationType1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *SequenceRange1) DeepCopy() *SequenceRange1 {
+	if r == nil {
+		return nil
+	}
+	out := new(SequenceRange1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *SequenceRange1) DeepCopyInto(out *SequenceRange1) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *SequenceRange1Choice) DeepCopy() *SequenceRange1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(SequenceRange1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *SequenceRange1Choice) DeepCopyInto(out *SequenceRange1Choice) {
+	*out = *r
+	if r.FrToSeq != nil {
+		out.FrToSeq = make([]SequenceRange1, len(r.FrToSeq))
+		copy(out.FrToSeq, r.FrToSeq)
+	}
+	if r.EQSeq != nil {
+		out.EQSeq = make([]common.Max35Text, len(r.EQSeq))
+		copy(out.EQSeq, r.EQSeq)
+	}
+	if r.NEQSeq != nil {
+		out.NEQSeq = make([]common.Max35Text, len(r.NEQSeq))
+		copy(out.NEQSeq, r.NEQSeq)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ServiceLevel8Choice) DeepCopy() *ServiceLevel8Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(ServiceLevel8Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ServiceLevel8Choice) DeepCopyInto(out *ServiceLevel8Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *SettlementInstruction1) DeepCopy() *SettlementInstruction1 {
+	if r == nil {
+		return nil
+	}
+	out := new(SettlementInstruction1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *SettlementInstruction1) DeepCopyInto(out *SettlementInstruction1) {
+	*out = *r
+	if r.SttlmAcct != nil {
+		out.SttlmAcct = new(CashAccount24)
+		r.SttlmAcct.DeepCopyInto(out.SttlmAcct)
+	}
+	if r.ClrSys != nil {
+		out.ClrSys = new(ClearingSystemIdentification3Choice)
+		*out.ClrSys = *r.ClrSys
+	}
+	if r.InstgRmbrsmntAgt != nil {
+		out.InstgRmbrsmntAgt = new(BranchAndFinancialInstitutionIdentification5)
+		r.InstgRmbrsmntAgt.DeepCopyInto(out.InstgRmbrsmntAgt)
+	}
+	if r.InstgRmbrsmntAgtAcct != nil {
+		out.InstgRmbrsmntAgtAcct = new(CashAccount24)
+		r.InstgRmbrsmntAgtAcct.DeepCopyInto(out.InstgRmbrsmntAgtAcct)
+	}
+	if r.InstdRmbrsmntAgt != nil {
+		out.InstdRmbrsmntAgt = new(BranchAndFinancialInstitutionIdentification5)
+		r.InstdRmbrsmntAgt.DeepCopyInto(out.InstdRmbrsmntAgt)
+	}
+	if r.InstdRmbrsmntAgtAcct != nil {
+		out.InstdRmbrsmntAgtAcct = new(CashAccount24)
+		r.InstdRmbrsmntAgtAcct.DeepCopyInto(out.InstdRmbrsmntAgtAcct)
+	}
+	if r.ThrdRmbrsmntAgt != nil {
+		out.ThrdRmbrsmntAgt = new(BranchAndFinancialInstitutionIdentification5)
+		r.ThrdRmbrsmntAgt.DeepCopyInto(out.ThrdRmbrsmntAgt)
+	}
+	if r.ThrdRmbrsmntAgtAcct != nil {
+		out.ThrdRmbrsmntAgtAcct = new(CashAccount24)
+		r.ThrdRmbrsmntAgtAcct.DeepCopyInto(out.ThrdRmbrsmntAgtAcct)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *SettlementInstruction4) DeepCopy() *SettlementInstruction4 {
+	if r == nil {
+		return nil
+	}
+	out := new(SettlementInstruction4)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *SettlementInstruction4) DeepCopyInto(out *SettlementInstruction4) {
+	*out = *r
+	if r.SttlmAcct != nil {
+		out.SttlmAcct = new(CashAccount24)
+		r.SttlmAcct.DeepCopyInto(out.SttlmAcct)
+	}
+	if r.ClrSys != nil {
+		out.ClrSys = new(ClearingSystemIdentification3Choice)
+		*out.ClrSys = *r.ClrSys
+	}
+	if r.InstgRmbrsmntAgt != nil {
+		out.InstgRmbrsmntAgt = new(BranchAndFinancialInstitutionIdentification5)
+		r.InstgRmbrsmntAgt.DeepCopyInto(out.InstgRmbrsmntAgt)
+	}
+	if r.InstgRmbrsmntAgtAcct != nil {
+		out.InstgRmbrsmntAgtAcct = new(CashAccount24)
+		r.InstgRmbrsmntAgtAcct.DeepCopyInto(out.InstgRmbrsmntAgtAcct)
+	}
+	if r.InstdRmbrsmntAgt != nil {
+		out.InstdRmbrsmntAgt = new(BranchAndFinancialInstitutionIdentification5)
+		r.InstdRmbrsmntAgt.DeepCopyInto(out.InstdRmbrsmntAgt)
+	}
+	if r.InstdRmbrsmntAgtAcct != nil {
+		out.InstdRmbrsmntAgtAcct = new(CashAccount24)
+		r.InstdRmbrsmntAgtAcct.DeepCopyInto(out.InstdRmbrsmntAgtAcct)
+	}
+	if r.ThrdRmbrsmntAgt != nil {
+		out.ThrdRmbrsmntAgt = new(BranchAndFinancialInstitutionIdentification5)
+		r.ThrdRmbrsmntAgt.DeepCopyInto(out.ThrdRmbrsmntAgt)
+	}
+	if r.ThrdRmbrsmntAgtAcct != nil {
+		out.ThrdRmbrsmntAgtAcct = new(CashAccount24)
+		r.ThrdRmbrsmntAgtAcct.DeepCopyInto(out.ThrdRmbrsmntAgtAcct)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ShortPaymentIdentification2) DeepCopy() *ShortPaymentIdentification2 {
+	if r == nil {
+		return nil
+	}
+	out := new(ShortPaymentIdentification2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ShortPaymentIdentification2) DeepCopyInto(out *ShortPaymentIdentification2) {
+	*out = *r
+	r.InstgAgt.DeepCopyInto(&out.InstgAgt)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *SkipPayload) DeepCopy() *SkipPayload {
+	if r == nil {
+		return nil
+	}
+	out := new(SkipPayload)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *SkipPayload) DeepCopyInto(out *SkipPayload) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *StructuredRemittanceInformation12) DeepCopy() *StructuredRemittanceInformation12 {
+	if r == nil {
+		return nil
+	}
+	out := new(StructuredRemittanceInformation12)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *StructuredRemittanceInformation12) DeepCopyInto(out *StructuredRemittanceInformation12) {
+	*out = *r
+	if r.RfrdDocInf != nil {
+		out.RfrdDocInf = make([]ReferredDocumentInformation6, len(r.RfrdDocInf))
+		for i := range r.RfrdDocInf {
+			r.RfrdDocInf[i].DeepCopyInto(&out.RfrdDocInf[i])
+		}
+	}
+	if r.RfrdDocAmt != nil {
+		out.RfrdDocAmt = new(RemittanceAmount2)
+		r.RfrdDocAmt.DeepCopyInto(out.RfrdDocAmt)
+	}
+	if r.CdtrRefInf != nil {
+		out.CdtrRefInf = new(CreditorReferenceInformation2)
+		r.CdtrRefInf.DeepCopyInto(out.CdtrRefInf)
+	}
+	if r.Invcr != nil {
+		out.Invcr = new(PartyIdentification43)
+		r.Invcr.DeepCopyInto(out.Invcr)
+	}
+	if r.Invcee != nil {
+		out.Invcee = new(PartyIdentification43)
+		r.Invcee.DeepCopyInto(out.Invcee)
+	}
+	if r.TaxRmt != nil {
+		out.TaxRmt = new(TaxInformation4)
+		r.TaxRmt.DeepCopyInto(out.TaxRmt)
+	}
+	if r.GrnshmtRmt != nil {
+		out.GrnshmtRmt = new(Garnishment1)
+		r.GrnshmtRmt.DeepCopyInto(out.GrnshmtRmt)
+	}
+	if r.AddtlRmtInf != nil {
+		out.AddtlRmtInf = make([]common.Max140Text, len(r.AddtlRmtInf))
+		copy(out.AddtlRmtInf, r.AddtlRmtInf)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *StructuredRemittanceInformation13) DeepCopy() *StructuredRemittanceInformation13 {
+	if r == nil {
+		return nil
+	}
+	out := new(StructuredRemittanceInformation13)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *StructuredRemittanceInformation13) DeepCopyInto(out *StructuredRemittanceInformation13) {
+	*out = *r
+	if r.RfrdDocInf != nil {
+		out.RfrdDocInf = make([]ReferredDocumentInformation7, len(r.RfrdDocInf))
+		for i := range r.RfrdDocInf {
+			r.RfrdDocInf[i].DeepCopyInto(&out.RfrdDocInf[i])
+		}
+	}
+	if r.RfrdDocAmt != nil {
+		out.RfrdDocAmt = new(RemittanceAmount2)
+		r.RfrdDocAmt.DeepCopyInto(out.RfrdDocAmt)
+	}
+	if r.CdtrRefInf != nil {
+		out.CdtrRefInf = new(CreditorReferenceInformation2)
+		r.CdtrRefInf.DeepCopyInto(out.CdtrRefInf)
+	}
+	if r.Invcr != nil {
+		out.Invcr = new(PartyIdentification43)
+		r.Invcr.DeepCopyInto(out.Invcr)
+	}
+	if r.Invcee != nil {
+		out.Invcee = new(PartyIdentification43)
+		r.Invcee.DeepCopyInto(out.Invcee)
+	}
+	if r.TaxRmt != nil {
+		out.TaxRmt = new(TaxInformation4)
+		r.TaxRmt.DeepCopyInto(out.TaxRmt)
+	}
+	if r.GrnshmtRmt != nil {
+		out.GrnshmtRmt = new(Garnishment1)
+		r.GrnshmtRmt.DeepCopyInto(out.GrnshmtRmt)
+	}
+	if r.AddtlRmtInf != nil {
+		out.AddtlRmtInf = make([]common.Max140Text, len(r.AddtlRmtInf))
+		copy(out.AddtlRmtInf, r.AddtlRmtInf)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *SupplementaryData1) DeepCopy() *SupplementaryData1 {
+	if r == nil {
+		return nil
+	}
+	out := new(SupplementaryData1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *SupplementaryData1) DeepCopyInto(out *SupplementaryData1) {
+	*out = *r
+	if r.PlcAndNm != nil {
+		out.PlcAndNm = new(common.Max350Text)
+		*out.PlcAndNm = *r.PlcAndNm
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *SupplementaryDataEnvelope1) DeepCopy() *SupplementaryDataEnvelope1 {
+	if r == nil {
+		return nil
+	}
+	out := new(SupplementaryDataEnvelope1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *SupplementaryDataEnvelope1) DeepCopyInto(out *SupplementaryDataEnvelope1) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *SystemEventType2Choice) DeepCopy() *SystemEventType2Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(SystemEventType2Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *SystemEventType2Choice) DeepCopyInto(out *SystemEventType2Choice) {
+	*out = *r
+	r.Prtry.DeepCopyInto(&out.Prtry)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *SystemIdentification2Choice) DeepCopy() *SystemIdentification2Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(SystemIdentification2Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *SystemIdentification2Choice) DeepCopyInto(out *SystemIdentification2Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *TaxAmount1) DeepCopy() *TaxAmount1 {
+	if r == nil {
+		return nil
+	}
+	out := new(TaxAmount1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *TaxAmount1) DeepCopyInto(out *TaxAmount1) {
+	*out = *r
+	if r.TaxblBaseAmt != nil {
+		out.TaxblBaseAmt = new(ActiveOrHistoricCurrencyAndAmount)
+		*out.TaxblBaseAmt = *r.TaxblBaseAmt
+	}
+	if r.TtlAmt != nil {
+		out.TtlAmt = new(ActiveOrHistoricCurrencyAndAmount)
+		*out.TtlAmt = *r.TtlAmt
+	}
+	if r.Dtls != nil {
+		out.Dtls = make([]TaxRecordDetails1, len(r.Dtls))
+		for i := range r.Dtls {
+			r.Dtls[i].DeepCopyInto(&out.Dtls[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *TaxAmountAndType1) DeepCopy() *TaxAmountAndType1 {
+	if r == nil {
+		return nil
+	}
+	out := new(TaxAmountAndType1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *TaxAmountAndType1) DeepCopyInto(out *TaxAmountAndType1) {
+	*out = *r
+	if r.Tp != nil {
+		out.Tp = new(TaxAmountType1Choice)
+		*out.Tp = *r.Tp
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *TaxAmountType1Choice) DeepCopy() *TaxAmountType1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(TaxAmountType1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *TaxAmountType1Choice) DeepCopyInto(out *TaxAmountType1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *TaxAuthorisation1) DeepCopy() *TaxAuthorisation1 {
+	if r == nil {
+		return nil
+	}
+	out := new(TaxAuthorisation1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *TaxAuthorisation1) DeepCopyInto(out *TaxAuthorisation1) {
+	*out = *r
+	if r.Titl != nil {
+		out.Titl = new(common.Max35Text)
+		*out.Titl = *r.Titl
+	}
+	if r.Nm != nil {
+		out.Nm = new(common.Max140Text)
+		*out.Nm = *r.Nm
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *TaxInformation4) DeepCopy() *TaxInformation4 {
+	if r == nil {
+		return nil
+	}
+	out := new(TaxInformation4)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *TaxInformation4) DeepCopyInto(out *TaxInformation4) {
+	*out = *r
+	if r.Cdtr != nil {
+		out.Cdtr = new(TaxParty1)
+		r.Cdtr.DeepCopyInto(out.Cdtr)
+	}
+	if r.Dbtr != nil {
+		out.Dbtr = new(TaxParty2)
+		r.Dbtr.DeepCopyInto(out.Dbtr)
+	}
+	if r.UltmtDbtr != nil {
+		out.UltmtDbtr = new(TaxParty2)
+		r.UltmtDbtr.DeepCopyInto(out.UltmtDbtr)
+	}
+	if r.AdmstnZone != nil {
+		out.AdmstnZone = new(common.Max35Text)
+		*out.AdmstnZone = *r.AdmstnZone
+	}
+	if r.RefNb != nil {
+		out.RefNb = new(common.Max140Text)
+		*out.RefNb = *r.RefNb
+	}
+	if r.Mtd != nil {
+		out.Mtd = new(common.Max35Text)
+		*out.Mtd = *r.Mtd
+	}
+	if r.TtlTaxblBaseAmt != nil {
+		out.TtlTaxblBaseAmt = new(ActiveOrHistoricCurrencyAndAmount)
+		*out.TtlTaxblBaseAmt = *r.TtlTaxblBaseAmt
+	}
+	if r.TtlTaxAmt != nil {
+		out.TtlTaxAmt = new(ActiveOrHistoricCurrencyAndAmount)
+		*out.TtlTaxAmt = *r.TtlTaxAmt
+	}
+	if r.Dt != nil {
+		out.Dt = new(common.ISODate)
+		*out.Dt = *r.Dt
+	}
+	if r.Rcrd != nil {
+		out.Rcrd = make([]TaxRecord1, len(r.Rcrd))
+		for i := range r.Rcrd {
+			r.Rcrd[i].DeepCopyInto(&out.Rcrd[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *TaxParty1) DeepCopy() *TaxParty1 {
+	if r == nil {
+		return nil
+	}
+	out := new(TaxParty1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *TaxParty1) DeepCopyInto(out *TaxParty1) {
+	*out = *r
+	if r.TaxId != nil {
+		out.TaxId = new(common.Max35Text)
+		*out.TaxId = *r.TaxId
+	}
+	if r.RegnId != nil {
+		out.RegnId = new(common.Max35Text)
+		*out.RegnId = *r.RegnId
+	}
+	if r.TaxTp != nil {
+		out.TaxTp = new(common.Max35Text)
+		*out.TaxTp = *r.TaxTp
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *TaxParty2) DeepCopy() *TaxParty2 {
+	if r == nil {
+		return nil
+	}
+	out := new(TaxParty2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *TaxParty2) DeepCopyInto(out *TaxParty2) {
+	*out = *r
+	if r.TaxId != nil {
+		out.TaxId = new(common.Max35Text)
+		*out.TaxId = *r.TaxId
+	}
+	if r.RegnId != nil {
+		out.RegnId = new(common.Max35Text)
+		*out.RegnId = *r.RegnId
+	}
+	if r.TaxTp != nil {
+		out.TaxTp = new(common.Max35Text)
+		*out.TaxTp = *r.TaxTp
+	}
+	if r.Authstn != nil {
+		out.Authstn = new(TaxAuthorisation1)
+		r.Authstn.DeepCopyInto(out.Authstn)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *TaxPeriod1) DeepCopy() *TaxPeriod1 {
+	if r == nil {
+		return nil
+	}
+	out := new(TaxPeriod1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *TaxPeriod1) DeepCopyInto(out *TaxPeriod1) {
+	*out = *r
+	if r.Yr != nil {
+		out.Yr = new(common.ISODate)
+		*out.Yr = *r.Yr
+	}
+	if r.Tp != nil {
+		out.Tp = new(TaxRecordPeriod1Code)
+		*out.Tp = *r.Tp
+	}
+	if r.FrToDt != nil {
+		out.FrToDt = new(DatePeriodDetails)
+		*out.FrToDt = *r.FrToDt
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *TaxRecord1) DeepCopy() *TaxRecord1 {
+	if r == nil {
+		return nil
+	}
+	out := new(TaxRecord1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *TaxRecord1) DeepCopyInto(out *TaxRecord1) {
+	*out = *r
+	if r.Tp != nil {
+		out.Tp = new(common.Max35Text)
+		*out.Tp = *r.Tp
+	}
+	if r.Ctgy != nil {
+		out.Ctgy = new(common.Max35Text)
+		*out.Ctgy = *r.Ctgy
+	}
+	if r.CtgyDtls != nil {
+		out.CtgyDtls = new(common.Max35Text)
+		*out.CtgyDtls = *r.CtgyDtls
+	}
+	if r.DbtrSts != nil {
+		out.DbtrSts = new(common.Max35Text)
+		*out.DbtrSts = *r.DbtrSts
+	}
+	if r.CertId != nil {
+		out.CertId = new(common.Max35Text)
+		*out.CertId = *r.CertId
+	}
+	if r.FrmsCd != nil {
+		out.FrmsCd = new(common.Max35Text)
+		*out.FrmsCd = *r.FrmsCd
+	}
+	if r.Prd != nil {
+		out.Prd = new(TaxPeriod1)
+		r.Prd.DeepCopyInto(out.Prd)
+	}
+	if r.TaxAmt != nil {
+		out.TaxAmt = new(TaxAmount1)
+		r.TaxAmt.DeepCopyInto(out.TaxAmt)
+	}
+	if r.AddtlInf != nil {
+		out.AddtlInf = new(common.Max140Text)
+		*out.AddtlInf = *r.AddtlInf
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *TaxRecordDetails1) DeepCopy() *TaxRecordDetails1 {
+	if r == nil {
+		return nil
+	}
+	out := new(TaxRecordDetails1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *TaxRecordDetails1) DeepCopyInto(out *TaxRecordDetails1) {
+	*out = *r
+	if r.Prd != nil {
+		out.Prd = new(TaxPeriod1)
+		r.Prd.DeepCopyInto(out.Prd)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *TimePeriodDetails1) DeepCopy() *TimePeriodDetails1 {
+	if r == nil {
+		return nil
+	}
+	out := new(TimePeriodDetails1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *TimePeriodDetails1) DeepCopyInto(out *TimePeriodDetails1) {
+	*out = *r
+	if r.ToTm != nil {
+		out.ToTm = new(common.ISOTime)
+		*out.ToTm = *r.ToTm
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *TransactionType2) DeepCopy() *TransactionType2 {
+	if r == nil {
+		return nil
+	}
+	out := new(TransactionType2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *TransactionType2) DeepCopyInto(out *TransactionType2) {
+	*out = *r
+	if r.FlrLmt != nil {
+		out.FlrLmt = make([]Limit2, len(r.FlrLmt))
+		copy(out.FlrLmt, r.FlrLmt)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *UnableToApplyIncorrect1) DeepCopy() *UnableToApplyIncorrect1 {
+	if r == nil {
+		return nil
+	}
+	out := new(UnableToApplyIncorrect1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *UnableToApplyIncorrect1) DeepCopyInto(out *UnableToApplyIncorrect1) {
+	*out = *r
+	if r.AddtlIncrrctInf != nil {
+		out.AddtlIncrrctInf = new(common.Max140Text)
+		*out.AddtlIncrrctInf = *r.AddtlIncrrctInf
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *UnableToApplyJustification3Choice) DeepCopy() *UnableToApplyJustification3Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(UnableToApplyJustification3Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *UnableToApplyJustification3Choice) DeepCopyInto(out *UnableToApplyJustification3Choice) {
+	*out = *r
+	r.MssngOrIncrrctInf.DeepCopyInto(&out.MssngOrIncrrctInf)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *UnableToApplyMissing1) DeepCopy() *UnableToApplyMissing1 {
+	if r == nil {
+		return nil
+	}
+	out := new(UnableToApplyMissing1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *UnableToApplyMissing1) DeepCopyInto(out *UnableToApplyMissing1) {
+	*out = *r
+	if r.AddtlMssngInf != nil {
+		out.AddtlMssngInf = new(common.Max140Text)
+		*out.AddtlMssngInf = *r.AddtlMssngInf
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *UnableToApplyV05) DeepCopy() *UnableToApplyV05 {
+	if r == nil {
+		return nil
+	}
+	out := new(UnableToApplyV05)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *UnableToApplyV05) DeepCopyInto(out *UnableToApplyV05) {
+	*out = *r
+	r.Assgnmt.DeepCopyInto(&out.Assgnmt)
+	r.Case.DeepCopyInto(&out.Case)
+	r.Undrlyg.DeepCopyInto(&out.Undrlyg)
+	r.Justfn.DeepCopyInto(&out.Justfn)
+	if r.SplmtryData != nil {
+		out.SplmtryData = make([]SupplementaryData1, len(r.SplmtryData))
+		for i := range r.SplmtryData {
+			r.SplmtryData[i].DeepCopyInto(&out.SplmtryData[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *UnderlyingGroupInformation1) DeepCopy() *UnderlyingGroupInformation1 {
+	if r == nil {
+		return nil
+	}
+	out := new(UnderlyingGroupInformation1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *UnderlyingGroupInformation1) DeepCopyInto(out *UnderlyingGroupInformation1) {
+	*out = *r
+	if r.OrgnlCreDtTm != nil {
+		out.OrgnlCreDtTm = new(common.ISODateTime)
+		*out.OrgnlCreDtTm = *r.OrgnlCreDtTm
+	}
+	if r.OrgnlMsgDlvryChanl != nil {
+		out.OrgnlMsgDlvryChanl = new(common.Max35Text)
+		*out.OrgnlMsgDlvryChanl = *r.OrgnlMsgDlvryChanl
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *UnderlyingPaymentInstruction2) DeepCopy() *UnderlyingPaymentInstruction2 {
+	if r == nil {
+		return nil
+	}
+	out := new(UnderlyingPaymentInstruction2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *UnderlyingPaymentInstruction2) DeepCopyInto(out *UnderlyingPaymentInstruction2) {
+	*out = *r
+	if r.OrgnlGrpInf != nil {
+		out.OrgnlGrpInf = new(UnderlyingGroupInformation1)
+		r.OrgnlGrpInf.DeepCopyInto(out.OrgnlGrpInf)
+	}
+	if r.OrgnlPmtInfId != nil {
+		out.OrgnlPmtInfId = new(common.Max35Text)
+		*out.OrgnlPmtInfId = *r.OrgnlPmtInfId
+	}
+	if r.OrgnlInstrId != nil {
+		out.OrgnlInstrId = new(common.Max35Text)
+		*out.OrgnlInstrId = *r.OrgnlInstrId
+	}
+	if r.OrgnlEndToEndId != nil {
+		out.OrgnlEndToEndId = new(common.Max35Text)
+		*out.OrgnlEndToEndId = *r.OrgnlEndToEndId
+	}
+	if r.ReqdExctnDt != nil {
+		out.ReqdExctnDt = new(common.ISODate)
+		*out.ReqdExctnDt = *r.ReqdExctnDt
+	}
+	if r.ReqdColltnDt != nil {
+		out.ReqdColltnDt = new(common.ISODate)
+		*out.ReqdColltnDt = *r.ReqdColltnDt
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *UnderlyingPaymentInstruction3) DeepCopy() *UnderlyingPaymentInstruction3 {
+	if r == nil {
+		return nil
+	}
+	out := new(UnderlyingPaymentInstruction3)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *UnderlyingPaymentInstruction3) DeepCopyInto(out *UnderlyingPaymentInstruction3) {
+	*out = *r
+	if r.OrgnlGrpInf != nil {
+		out.OrgnlGrpInf = new(UnderlyingGroupInformation1)
+		r.OrgnlGrpInf.DeepCopyInto(out.OrgnlGrpInf)
+	}
+	if r.OrgnlPmtInfId != nil {
+		out.OrgnlPmtInfId = new(common.Max35Text)
+		*out.OrgnlPmtInfId = *r.OrgnlPmtInfId
+	}
+	if r.OrgnlInstrId != nil {
+		out.OrgnlInstrId = new(common.Max35Text)
+		*out.OrgnlInstrId = *r.OrgnlInstrId
+	}
+	if r.OrgnlEndToEndId != nil {
+		out.OrgnlEndToEndId = new(common.Max35Text)
+		*out.OrgnlEndToEndId = *r.OrgnlEndToEndId
+	}
+	if r.ReqdExctnDt != nil {
+		out.ReqdExctnDt = new(DateAndDateTimeChoice)
+		*out.ReqdExctnDt = *r.ReqdExctnDt
+	}
+	if r.ReqdColltnDt != nil {
+		out.ReqdColltnDt = new(common.ISODate)
+		*out.ReqdColltnDt = *r.ReqdColltnDt
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *UnderlyingPaymentTransaction2) DeepCopy() *UnderlyingPaymentTransaction2 {
+	if r == nil {
+		return nil
+	}
+	out := new(UnderlyingPaymentTransaction2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *UnderlyingPaymentTransaction2) DeepCopyInto(out *UnderlyingPaymentTransaction2) {
+	*out = *r
+	if r.OrgnlGrpInf != nil {
+		out.OrgnlGrpInf = new(UnderlyingGroupInformation1)
+		r.OrgnlGrpInf.DeepCopyInto(out.OrgnlGrpInf)
+	}
+	if r.OrgnlInstrId != nil {
+		out.OrgnlInstrId = new(common.Max35Text)
+		*out.OrgnlInstrId = *r.OrgnlInstrId
+	}
+	if r.OrgnlEndToEndId != nil {
+		out.OrgnlEndToEndId = new(common.Max35Text)
+		*out.OrgnlEndToEndId = *r.OrgnlEndToEndId
+	}
+	if r.OrgnlTxId != nil {
+		out.OrgnlTxId = new(common.Max35Text)
+		*out.OrgnlTxId = *r.OrgnlTxId
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *UnderlyingStatementEntry1) DeepCopy() *UnderlyingStatementEntry1 {
+	if r == nil {
+		return nil
+	}
+	out := new(UnderlyingStatementEntry1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *UnderlyingStatementEntry1) DeepCopyInto(out *UnderlyingStatementEntry1) {
+	*out = *r
+	if r.OrgnlGrpInf != nil {
+		out.OrgnlGrpInf = new(OriginalGroupInformation3)
+		r.OrgnlGrpInf.DeepCopyInto(out.OrgnlGrpInf)
+	}
+	if r.OrgnlStmtId != nil {
+		out.OrgnlStmtId = new(common.Max35Text)
+		*out.OrgnlStmtId = *r.OrgnlStmtId
+	}
+	if r.OrgnlNtryId != nil {
+		out.OrgnlNtryId = new(common.Max35Text)
+		*out.OrgnlNtryId = *r.OrgnlNtryId
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *UnderlyingTransaction13) DeepCopy() *UnderlyingTransaction13 {
+	if r == nil {
+		return nil
+	}
+	out := new(UnderlyingTransaction13)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *UnderlyingTransaction13) DeepCopyInto(out *UnderlyingTransaction13) {
+	*out = *r
+	if r.OrgnlGrpInfAndCxl != nil {
+		out.OrgnlGrpInfAndCxl = new(OriginalGroupHeader4)
+		r.OrgnlGrpInfAndCxl.DeepCopyInto(out.OrgnlGrpInfAndCxl)
+	}
+	if r.TxInf != nil {
+		out.TxInf = make([]PaymentTransaction62, len(r.TxInf))
+		for i := range r.TxInf {
+			r.TxInf[i].DeepCopyInto(&out.TxInf[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *UnderlyingTransaction2Choice) DeepCopy() *UnderlyingTransaction2Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(UnderlyingTransaction2Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *UnderlyingTransaction2Choice) DeepCopyInto(out *UnderlyingTransaction2Choice) {
+	*out = *r
+	r.Initn.DeepCopyInto(&out.Initn)
+	r.IntrBk.DeepCopyInto(&out.IntrBk)
+	r.StmtNtry.DeepCopyInto(&out.StmtNtry)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *UnderlyingTransaction3Choice) DeepCopy() *UnderlyingTransaction3Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(UnderlyingTransaction3Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *UnderlyingTransaction3Choice) DeepCopyInto(out *UnderlyingTransaction3Choice) {
+	*out = *r
+	r.Initn.DeepCopyInto(&out.Initn)
+	r.IntrBk.DeepCopyInto(&out.IntrBk)
+	r.StmtNtry.DeepCopyInto(&out.StmtNtry)
+}
@@ -0,0 +1,4893 @@
+// Code generated by deepcopy-gen. DO NOT EDIT.
+
+package camt_v06
+
+import (
+	"github.com/moov-io/iso20022/pkg/common"
+)
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *AccountIdentification4Choice) DeepCopy() *AccountIdentification4Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(AccountIdentification4Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *AccountIdentification4Choice) DeepCopyInto(out *AccountIdentification4Choice) {
+	*out = *r
+	r.Othr.DeepCopyInto(&out.Othr)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *AccountNotification16) DeepCopy() *AccountNotification16 {
+	if r == nil {
+		return nil
+	}
+	out := new(AccountNotification16)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *AccountNotification16) DeepCopyInto(out *AccountNotification16) {
+	*out = *r
+	if r.Acct != nil {
+		out.Acct = new(CashAccount38)
+		r.Acct.DeepCopyInto(out.Acct)
+	}
+	if r.AcctOwnr != nil {
+		out.AcctOwnr = new(Party40Choice)
+		r.AcctOwnr.DeepCopyInto(out.AcctOwnr)
+	}
+	if r.AcctSvcr != nil {
+		out.AcctSvcr = new(BranchAndFinancialInstitutionIdentification6)
+		r.AcctSvcr.DeepCopyInto(out.AcctSvcr)
+	}
+	if r.RltdAcct != nil {
+		out.RltdAcct = new(CashAccount38)
+		r.RltdAcct.DeepCopyInto(out.RltdAcct)
+	}
+	if r.TtlAmt != nil {
+		out.TtlAmt = new(ActiveOrHistoricCurrencyAndAmount)
+		*out.TtlAmt = *r.TtlAmt
+	}
+	if r.XpctdValDt != nil {
+		out.XpctdValDt = new(common.ISODate)
+		*out.XpctdValDt = *r.XpctdValDt
+	}
+	if r.Dbtr != nil {
+		out.Dbtr = new(Party40Choice)
+		r.Dbtr.DeepCopyInto(out.Dbtr)
+	}
+	if r.DbtrAgt != nil {
+		out.DbtrAgt = new(BranchAndFinancialInstitutionIdentification6)
+		r.DbtrAgt.DeepCopyInto(out.DbtrAgt)
+	}
+	if r.IntrmyAgt != nil {
+		out.IntrmyAgt = new(BranchAndFinancialInstitutionIdentification6)
+		r.IntrmyAgt.DeepCopyInto(out.IntrmyAgt)
+	}
+	if r.Itm != nil {
+		out.Itm = make([]NotificationItem7, len(r.Itm))
+		for i := range r.Itm {
+			r.Itm[i].DeepCopyInto(&out.Itm[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *AccountSchemeName1Choice) DeepCopy() *AccountSchemeName1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(AccountSchemeName1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *AccountSchemeName1Choice) DeepCopyInto(out *AccountSchemeName1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ActiveCurrencyAndAmount) DeepCopy() *ActiveCurrencyAndAmount {
+	if r == nil {
+		return nil
+	}
+	out := new(ActiveCurrencyAndAmount)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ActiveCurrencyAndAmount) DeepCopyInto(out *ActiveCurrencyAndAmount) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ActiveOrHistoricCurrencyAndAmount) DeepCopy() *ActiveOrHistoricCurrencyAndAmount {
+	if r == nil {
+		return nil
+	}
+	out := new(ActiveOrHistoricCurrencyAndAmount)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ActiveOrHistoricCurrencyAndAmount) DeepCopyInto(out *ActiveOrHistoricCurrencyAndAmount) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *AddressType3Choice) DeepCopy() *AddressType3Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(AddressType3Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *AddressType3Choice) DeepCopyInto(out *AddressType3Choice) {
+	*out = *r
+	r.Prtry.DeepCopyInto(&out.Prtry)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *AmendmentInformationDetails10) DeepCopy() *AmendmentInformationDetails10 {
+	if r == nil {
+		return nil
+	}
+	out := new(AmendmentInformationDetails10)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *AmendmentInformationDetails10) DeepCopyInto(out *AmendmentInformationDetails10) {
+	*out = *r
+	if r.OrgnlMndtId != nil {
+		out.OrgnlMndtId = new(common.Max35Text)
+		*out.OrgnlMndtId = *r.OrgnlMndtId
+	}
+	if r.OrgnlCdtrSchmeId != nil {
+		out.OrgnlCdtrSchmeId = new(PartyIdentification43)
+		r.OrgnlCdtrSchmeId.DeepCopyInto(out.OrgnlCdtrSchmeId)
+	}
+	if r.OrgnlCdtrAgt != nil {
+		out.OrgnlCdtrAgt = new(BranchAndFinancialInstitutionIdentification5)
+		r.OrgnlCdtrAgt.DeepCopyInto(out.OrgnlCdtrAgt)
+	}
+	if r.OrgnlCdtrAgtAcct != nil {
+		out.OrgnlCdtrAgtAcct = new(CashAccount24)
+		r.OrgnlCdtrAgtAcct.DeepCopyInto(out.OrgnlCdtrAgtAcct)
+	}
+	if r.OrgnlDbtr != nil {
+		out.OrgnlDbtr = new(PartyIdentification43)
+		r.OrgnlDbtr.DeepCopyInto(out.OrgnlDbtr)
+	}
+	if r.OrgnlDbtrAcct != nil {
+		out.OrgnlDbtrAcct = new(CashAccount24)
+		r.OrgnlDbtrAcct.DeepCopyInto(out.OrgnlDbtrAcct)
+	}
+	if r.OrgnlDbtrAgt != nil {
+		out.OrgnlDbtrAgt = new(BranchAndFinancialInstitutionIdentification5)
+		r.OrgnlDbtrAgt.DeepCopyInto(out.OrgnlDbtrAgt)
+	}
+	if r.OrgnlDbtrAgtAcct != nil {
+		out.OrgnlDbtrAgtAcct = new(CashAccount24)
+		r.OrgnlDbtrAgtAcct.DeepCopyInto(out.OrgnlDbtrAgtAcct)
+	}
+	if r.OrgnlFnlColltnDt != nil {
+		out.OrgnlFnlColltnDt = new(common.ISODate)
+		*out.OrgnlFnlColltnDt = *r.OrgnlFnlColltnDt
+	}
+	if r.OrgnlFrqcy != nil {
+		out.OrgnlFrqcy = new(Frequency21Choice)
+		*out.OrgnlFrqcy = *r.OrgnlFrqcy
+	}
+	if r.OrgnlRsn != nil {
+		out.OrgnlRsn = new(MandateSetupReason1Choice)
+		*out.OrgnlRsn = *r.OrgnlRsn
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *Amount2Choice) DeepCopy() *Amount2Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(Amount2Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *Amount2Choice) DeepCopyInto(out *Amount2Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *AmountType4Choice) DeepCopy() *AmountType4Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(AmountType4Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *AmountType4Choice) DeepCopyInto(out *AmountType4Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *BranchAndFinancialInstitutionIdentification5) DeepCopy() *BranchAndFinancialInstitutionIdentification5 {
+	if r == nil {
+		return nil
+	}
+	out := new(BranchAndFinancialInstitutionIdentification5)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *BranchAndFinancialInstitutionIdentification5) DeepCopyInto(out *BranchAndFinancialInstitutionIdentification5) {
+	*out = *r
+	r.FinInstnId.DeepCopyInto(&out.FinInstnId)
+	if r.BrnchId != nil {
+		out.BrnchId = new(BranchData2)
+		r.BrnchId.DeepCopyInto(out.BrnchId)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *BranchAndFinancialInstitutionIdentification6) DeepCopy() *BranchAndFinancialInstitutionIdentification6 {
+	if r == nil {
+		return nil
+	}
+	out := new(BranchAndFinancialInstitutionIdentification6)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *BranchAndFinancialInstitutionIdentification6) DeepCopyInto(out *BranchAndFinancialInstitutionIdentification6) {
+	*out = *r
+	r.FinInstnId.DeepCopyInto(&out.FinInstnId)
+	if r.BrnchId != nil {
+		out.BrnchId = new(BranchData3)
+		r.BrnchId.DeepCopyInto(out.BrnchId)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *BranchData2) DeepCopy() *BranchData2 {
+	if r == nil {
+		return nil
+	}
+	out := new(BranchData2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *BranchData2) DeepCopyInto(out *BranchData2) {
+	*out = *r
+	if r.Id != nil {
+		out.Id = new(common.Max35Text)
+		*out.Id = *r.Id
+	}
+	if r.Nm != nil {
+		out.Nm = new(common.Max140Text)
+		*out.Nm = *r.Nm
+	}
+	if r.PstlAdr != nil {
+		out.PstlAdr = new(PostalAddress6)
+		r.PstlAdr.DeepCopyInto(out.PstlAdr)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *BranchData3) DeepCopy() *BranchData3 {
+	if r == nil {
+		return nil
+	}
+	out := new(BranchData3)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *BranchData3) DeepCopyInto(out *BranchData3) {
+	*out = *r
+	if r.Id != nil {
+		out.Id = new(common.Max35Text)
+		*out.Id = *r.Id
+	}
+	if r.LEI != nil {
+		out.LEI = new(common.LEIIdentifier)
+		*out.LEI = *r.LEI
+	}
+	if r.Nm != nil {
+		out.Nm = new(common.Max140Text)
+		*out.Nm = *r.Nm
+	}
+	if r.PstlAdr != nil {
+		out.PstlAdr = new(PostalAddress24)
+		r.PstlAdr.DeepCopyInto(out.PstlAdr)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CancellationStatusReason2) DeepCopy() *CancellationStatusReason2 {
+	if r == nil {
+		return nil
+	}
+	out := new(CancellationStatusReason2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CancellationStatusReason2) DeepCopyInto(out *CancellationStatusReason2) {
+	*out = *r
+	if r.Orgtr != nil {
+		out.Orgtr = new(PartyIdentification43)
+		r.Orgtr.DeepCopyInto(out.Orgtr)
+	}
+	if r.Rsn != nil {
+		out.Rsn = new(CancellationStatusReason2Choice)
+		*out.Rsn = *r.Rsn
+	}
+	if r.AddtlInf != nil {
+		out.AddtlInf = make([]common.Max105Text, len(r.AddtlInf))
+		copy(out.AddtlInf, r.AddtlInf)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CancellationStatusReason2Choice) DeepCopy() *CancellationStatusReason2Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(CancellationStatusReason2Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CancellationStatusReason2Choice) DeepCopyInto(out *CancellationStatusReason2Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *Case3) DeepCopy() *Case3 {
+	if r == nil {
+		return nil
+	}
+	out := new(Case3)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *Case3) DeepCopyInto(out *Case3) {
+	*out = *r
+	r.Cretr.DeepCopyInto(&out.Cretr)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *Case5) DeepCopy() *Case5 {
+	if r == nil {
+		return nil
+	}
+	out := new(Case5)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *Case5) DeepCopyInto(out *Case5) {
+	*out = *r
+	r.Cretr.DeepCopyInto(&out.Cretr)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CaseAssignment3) DeepCopy() *CaseAssignment3 {
+	if r == nil {
+		return nil
+	}
+	out := new(CaseAssignment3)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CaseAssignment3) DeepCopyInto(out *CaseAssignment3) {
+	*out = *r
+	r.Assgnr.DeepCopyInto(&out.Assgnr)
+	r.Assgne.DeepCopyInto(&out.Assgne)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CaseAssignment5) DeepCopy() *CaseAssignment5 {
+	if r == nil {
+		return nil
+	}
+	out := new(CaseAssignment5)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CaseAssignment5) DeepCopyInto(out *CaseAssignment5) {
+	*out = *r
+	r.Assgnr.DeepCopyInto(&out.Assgnr)
+	r.Assgne.DeepCopyInto(&out.Assgne)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CashAccount24) DeepCopy() *CashAccount24 {
+	if r == nil {
+		return nil
+	}
+	out := new(CashAccount24)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CashAccount24) DeepCopyInto(out *CashAccount24) {
+	*out = *r
+	r.Id.DeepCopyInto(&out.Id)
+	if r.Tp != nil {
+		out.Tp = new(CashAccountType2Choice)
+		*out.Tp = *r.Tp
+	}
+	if r.Ccy != nil {
+		out.Ccy = new(common.ActiveOrHistoricCurrencyCode)
+		*out.Ccy = *r.Ccy
+	}
+	if r.Nm != nil {
+		out.Nm = new(common.Max70Text)
+		*out.Nm = *r.Nm
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CashAccount38) DeepCopy() *CashAccount38 {
+	if r == nil {
+		return nil
+	}
+	out := new(CashAccount38)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CashAccount38) DeepCopyInto(out *CashAccount38) {
+	*out = *r
+	r.Id.DeepCopyInto(&out.Id)
+	if r.Tp != nil {
+		out.Tp = new(CashAccountType2Choice)
+		*out.Tp = *r.Tp
+	}
+	if r.Ccy != nil {
+		out.Ccy = new(common.ActiveOrHistoricCurrencyCode)
+		*out.Ccy = *r.Ccy
+	}
+	if r.Nm != nil {
+		out.Nm = new(common.Max70Text)
+		*out.Nm = *r.Nm
+	}
+	if r.Prxy != nil {
+		out.Prxy = new(ProxyAccountIdentification1)
+		r.Prxy.DeepCopyInto(out.Prxy)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CashAccountType2Choice) DeepCopy() *CashAccountType2Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(CashAccountType2Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CashAccountType2Choice) DeepCopyInto(out *CashAccountType2Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CategoryPurpose1Choice) DeepCopy() *CategoryPurpose1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(CategoryPurpose1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CategoryPurpose1Choice) DeepCopyInto(out *CategoryPurpose1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ChargeType3Choice) DeepCopy() *ChargeType3Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(ChargeType3Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ChargeType3Choice) DeepCopyInto(out *ChargeType3Choice) {
+	*out = *r
+	r.Prtry.DeepCopyInto(&out.Prtry)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *Charges3) DeepCopy() *Charges3 {
+	if r == nil {
+		return nil
+	}
+	out := new(Charges3)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *Charges3) DeepCopyInto(out *Charges3) {
+	*out = *r
+	if r.TtlChrgsAndTaxAmt != nil {
+		out.TtlChrgsAndTaxAmt = new(ActiveOrHistoricCurrencyAndAmount)
+		*out.TtlChrgsAndTaxAmt = *r.TtlChrgsAndTaxAmt
+	}
+	if r.Rcrd != nil {
+		out.Rcrd = make([]ChargesRecord1, len(r.Rcrd))
+		for i := range r.Rcrd {
+			r.Rcrd[i].DeepCopyInto(&out.Rcrd[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ChargesRecord1) DeepCopy() *ChargesRecord1 {
+	if r == nil {
+		return nil
+	}
+	out := new(ChargesRecord1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ChargesRecord1) DeepCopyInto(out *ChargesRecord1) {
+	*out = *r
+	if r.CdtDbtInd != nil {
+		out.CdtDbtInd = new(common.CreditDebitCode)
+		*out.CdtDbtInd = *r.CdtDbtInd
+	}
+	if r.Tp != nil {
+		out.Tp = new(ChargeType3Choice)
+		r.Tp.DeepCopyInto(out.Tp)
+	}
+	if r.Br != nil {
+		out.Br = new(ChargeBearerType1Code)
+		*out.Br = *r.Br
+	}
+	if r.Agt != nil {
+		out.Agt = new(BranchAndFinancialInstitutionIdentification5)
+		r.Agt.DeepCopyInto(out.Agt)
+	}
+	if r.Tax != nil {
+		out.Tax = new(TaxCharges2)
+		r.Tax.DeepCopyInto(out.Tax)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ClearingSystemIdentification2Choice) DeepCopy() *ClearingSystemIdentification2Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(ClearingSystemIdentification2Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ClearingSystemIdentification2Choice) DeepCopyInto(out *ClearingSystemIdentification2Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ClearingSystemIdentification3Choice) DeepCopy() *ClearingSystemIdentification3Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(ClearingSystemIdentification3Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ClearingSystemIdentification3Choice) DeepCopyInto(out *ClearingSystemIdentification3Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ClearingSystemMemberIdentification2) DeepCopy() *ClearingSystemMemberIdentification2 {
+	if r == nil {
+		return nil
+	}
+	out := new(ClearingSystemMemberIdentification2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ClearingSystemMemberIdentification2) DeepCopyInto(out *ClearingSystemMemberIdentification2) {
+	*out = *r
+	if r.ClrSysId != nil {
+		out.ClrSysId = new(ClearingSystemIdentification2Choice)
+		*out.ClrSysId = *r.ClrSysId
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *Contact4) DeepCopy() *Contact4 {
+	if r == nil {
+		return nil
+	}
+	out := new(Contact4)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *Contact4) DeepCopyInto(out *Contact4) {
+	*out = *r
+	if r.NmPrfx != nil {
+		out.NmPrfx = new(common.NamePrefix2Code)
+		*out.NmPrfx = *r.NmPrfx
+	}
+	if r.Nm != nil {
+		out.Nm = new(common.Max140Text)
+		*out.Nm = *r.Nm
+	}
+	if r.PhneNb != nil {
+		out.PhneNb = new(common.PhoneNumber)
+		*out.PhneNb = *r.PhneNb
+	}
+	if r.MobNb != nil {
+		out.MobNb = new(common.PhoneNumber)
+		*out.MobNb = *r.MobNb
+	}
+	if r.FaxNb != nil {
+		out.FaxNb = new(common.PhoneNumber)
+		*out.FaxNb = *r.FaxNb
+	}
+	if r.EmailAdr != nil {
+		out.EmailAdr = new(common.Max2048Text)
+		*out.EmailAdr = *r.EmailAdr
+	}
+	if r.EmailPurp != nil {
+		out.EmailPurp = new(common.Max35Text)
+		*out.EmailPurp = *r.EmailPurp
+	}
+	if r.JobTitl != nil {
+		out.JobTitl = new(common.Max35Text)
+		*out.JobTitl = *r.JobTitl
+	}
+	if r.Rspnsblty != nil {
+		out.Rspnsblty = new(common.Max35Text)
+		*out.Rspnsblty = *r.Rspnsblty
+	}
+	if r.Dept != nil {
+		out.Dept = new(common.Max70Text)
+		*out.Dept = *r.Dept
+	}
+	if r.Othr != nil {
+		out.Othr = make([]OtherContact1, len(r.Othr))
+		for i := range r.Othr {
+			r.Othr[i].DeepCopyInto(&out.Othr[i])
+		}
+	}
+	if r.PrefrdMtd != nil {
+		out.PrefrdMtd = new(PreferredContactMethod1Code)
+		*out.PrefrdMtd = *r.PrefrdMtd
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ContactDetails2) DeepCopy() *ContactDetails2 {
+	if r == nil {
+		return nil
+	}
+	out := new(ContactDetails2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ContactDetails2) DeepCopyInto(out *ContactDetails2) {
+	*out = *r
+	if r.NmPrfx != nil {
+		out.NmPrfx = new(common.NamePrefix1Code)
+		*out.NmPrfx = *r.NmPrfx
+	}
+	if r.Nm != nil {
+		out.Nm = new(common.Max140Text)
+		*out.Nm = *r.Nm
+	}
+	if r.PhneNb != nil {
+		out.PhneNb = new(common.PhoneNumber)
+		*out.PhneNb = *r.PhneNb
+	}
+	if r.MobNb != nil {
+		out.MobNb = new(common.PhoneNumber)
+		*out.MobNb = *r.MobNb
+	}
+	if r.FaxNb != nil {
+		out.FaxNb = new(common.PhoneNumber)
+		*out.FaxNb = *r.FaxNb
+	}
+	if r.EmailAdr != nil {
+		out.EmailAdr = new(common.Max2048Text)
+		*out.EmailAdr = *r.EmailAdr
+	}
+	if r.Othr != nil {
+		out.Othr = new(common.Max35Text)
+		*out.Othr = *r.Othr
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CorrectiveGroupInformation1) DeepCopy() *CorrectiveGroupInformation1 {
+	if r == nil {
+		return nil
+	}
+	out := new(CorrectiveGroupInformation1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CorrectiveGroupInformation1) DeepCopyInto(out *CorrectiveGroupInformation1) {
+	*out = *r
+	if r.CreDtTm != nil {
+		out.CreDtTm = new(common.ISODateTime)
+		*out.CreDtTm = *r.CreDtTm
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CorrectiveInterbankTransaction1) DeepCopy() *CorrectiveInterbankTransaction1 {
+	if r == nil {
+		return nil
+	}
+	out := new(CorrectiveInterbankTransaction1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CorrectiveInterbankTransaction1) DeepCopyInto(out *CorrectiveInterbankTransaction1) {
+	*out = *r
+	if r.GrpHdr != nil {
+		out.GrpHdr = new(CorrectiveGroupInformation1)
+		r.GrpHdr.DeepCopyInto(out.GrpHdr)
+	}
+	if r.InstrId != nil {
+		out.InstrId = new(common.Max35Text)
+		*out.InstrId = *r.InstrId
+	}
+	if r.EndToEndId != nil {
+		out.EndToEndId = new(common.Max35Text)
+		*out.EndToEndId = *r.EndToEndId
+	}
+	if r.TxId != nil {
+		out.TxId = new(common.Max35Text)
+		*out.TxId = *r.TxId
+	}
+	if r.IntrBkSttlmAmt != nil {
+		out.IntrBkSttlmAmt = new(ActiveOrHistoricCurrencyAndAmount)
+		*out.IntrBkSttlmAmt = *r.IntrBkSttlmAmt
+	}
+	if r.IntrBkSttlmDt != nil {
+		out.IntrBkSttlmDt = new(common.ISODate)
+		*out.IntrBkSttlmDt = *r.IntrBkSttlmDt
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CorrectivePaymentInitiation1) DeepCopy() *CorrectivePaymentInitiation1 {
+	if r == nil {
+		return nil
+	}
+	out := new(CorrectivePaymentInitiation1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CorrectivePaymentInitiation1) DeepCopyInto(out *CorrectivePaymentInitiation1) {
+	*out = *r
+	if r.GrpHdr != nil {
+		out.GrpHdr = new(CorrectiveGroupInformation1)
+		r.GrpHdr.DeepCopyInto(out.GrpHdr)
+	}
+	if r.PmtInfId != nil {
+		out.PmtInfId = new(common.Max35Text)
+		*out.PmtInfId = *r.PmtInfId
+	}
+	if r.InstrId != nil {
+		out.InstrId = new(common.Max35Text)
+		*out.InstrId = *r.InstrId
+	}
+	if r.EndToEndId != nil {
+		out.EndToEndId = new(common.Max35Text)
+		*out.EndToEndId = *r.EndToEndId
+	}
+	if r.InstdAmt != nil {
+		out.InstdAmt = new(ActiveOrHistoricCurrencyAndAmount)
+		*out.InstdAmt = *r.InstdAmt
+	}
+	if r.ReqdExctnDt != nil {
+		out.ReqdExctnDt = new(common.ISODate)
+		*out.ReqdExctnDt = *r.ReqdExctnDt
+	}
+	if r.ReqdColltnDt != nil {
+		out.ReqdColltnDt = new(common.ISODate)
+		*out.ReqdColltnDt = *r.ReqdColltnDt
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CorrectiveTransaction1Choice) DeepCopy() *CorrectiveTransaction1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(CorrectiveTransaction1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CorrectiveTransaction1Choice) DeepCopyInto(out *CorrectiveTransaction1Choice) {
+	*out = *r
+	r.Initn.DeepCopyInto(&out.Initn)
+	r.IntrBk.DeepCopyInto(&out.IntrBk)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CreditorReferenceInformation2) DeepCopy() *CreditorReferenceInformation2 {
+	if r == nil {
+		return nil
+	}
+	out := new(CreditorReferenceInformation2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CreditorReferenceInformation2) DeepCopyInto(out *CreditorReferenceInformation2) {
+	*out = *r
+	if r.Tp != nil {
+		out.Tp = new(CreditorReferenceType2)
+		r.Tp.DeepCopyInto(out.Tp)
+	}
+	if r.Ref != nil {
+		out.Ref = new(common.Max35Text)
+		*out.Ref = *r.Ref
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CreditorReferenceType1Choice) DeepCopy() *CreditorReferenceType1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(CreditorReferenceType1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CreditorReferenceType1Choice) DeepCopyInto(out *CreditorReferenceType1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CreditorReferenceType2) DeepCopy() *CreditorReferenceType2 {
+	if r == nil {
+		return nil
+	}
+	out := new(CreditorReferenceType2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CreditorReferenceType2) DeepCopyInto(out *CreditorReferenceType2) {
+	*out = *r
+	if r.Issr != nil {
+		out.Issr = new(common.Max35Text)
+		*out.Issr = *r.Issr
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CurrentAndDefaultReservation4) DeepCopy() *CurrentAndDefaultReservation4 {
+	if r == nil {
+		return nil
+	}
+	out := new(CurrentAndDefaultReservation4)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CurrentAndDefaultReservation4) DeepCopyInto(out *CurrentAndDefaultReservation4) {
+	*out = *r
+	if r.CurRsvatn != nil {
+		out.CurRsvatn = make([]ReservationReport6, len(r.CurRsvatn))
+		for i := range r.CurRsvatn {
+			r.CurRsvatn[i].DeepCopyInto(&out.CurRsvatn[i])
+		}
+	}
+	if r.DfltRsvatn != nil {
+		out.DfltRsvatn = make([]ReservationReport6, len(r.DfltRsvatn))
+		for i := range r.DfltRsvatn {
+			r.DfltRsvatn[i].DeepCopyInto(&out.DfltRsvatn[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *DateAndDateTime2Choice) DeepCopy() *DateAndDateTime2Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(DateAndDateTime2Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *DateAndDateTime2Choice) DeepCopyInto(out *DateAndDateTime2Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *DateAndPlaceOfBirth) DeepCopy() *DateAndPlaceOfBirth {
+	if r == nil {
+		return nil
+	}
+	out := new(DateAndPlaceOfBirth)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *DateAndPlaceOfBirth) DeepCopyInto(out *DateAndPlaceOfBirth) {
+	*out = *r
+	if r.PrvcOfBirth != nil {
+		out.PrvcOfBirth = new(common.Max35Text)
+		*out.PrvcOfBirth = *r.PrvcOfBirth
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *DateAndPlaceOfBirth1) DeepCopy() *DateAndPlaceOfBirth1 {
+	if r == nil {
+		return nil
+	}
+	out := new(DateAndPlaceOfBirth1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *DateAndPlaceOfBirth1) DeepCopyInto(out *DateAndPlaceOfBirth1) {
+	*out = *r
+	if r.PrvcOfBirth != nil {
+		out.PrvcOfBirth = new(common.Max35Text)
+		*out.PrvcOfBirth = *r.PrvcOfBirth
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *DatePeriod2) DeepCopy() *DatePeriod2 {
+	if r == nil {
+		return nil
+	}
+	out := new(DatePeriod2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *DatePeriod2) DeepCopyInto(out *DatePeriod2) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *DatePeriod2Choice) DeepCopy() *DatePeriod2Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(DatePeriod2Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *DatePeriod2Choice) DeepCopyInto(out *DatePeriod2Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *DatePeriodDetails) DeepCopy() *DatePeriodDetails {
+	if r == nil {
+		return nil
+	}
+	out := new(DatePeriodDetails)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *DatePeriodDetails) DeepCopyInto(out *DatePeriodDetails) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *DiscountAmountAndType1) DeepCopy() *DiscountAmountAndType1 {
+	if r == nil {
+		return nil
+	}
+	out := new(DiscountAmountAndType1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *DiscountAmountAndType1) DeepCopyInto(out *DiscountAmountAndType1) {
+	*out = *r
+	if r.Tp != nil {
+		out.Tp = new(DiscountAmountType1Choice)
+		*out.Tp = *r.Tp
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *DiscountAmountType1Choice) DeepCopy() *DiscountAmountType1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(DiscountAmountType1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *DiscountAmountType1Choice) DeepCopyInto(out *DiscountAmountType1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *DocumentAdjustment1) DeepCopy() *DocumentAdjustment1 {
+	if r == nil {
+		return nil
+	}
+	out := new(DocumentAdjustment1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *DocumentAdjustment1) DeepCopyInto(out *DocumentAdjustment1) {
+	*out = *r
+	if r.CdtDbtInd != nil {
+		out.CdtDbtInd = new(common.CreditDebitCode)
+		*out.CdtDbtInd = *r.CdtDbtInd
+	}
+	if r.Rsn != nil {
+		out.Rsn = new(common.Max4Text)
+		*out.Rsn = *r.Rsn
+	}
+	if r.AddtlInf != nil {
+		out.AddtlInf = new(common.Max140Text)
+		*out.AddtlInf = *r.AddtlInf
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *DocumentLineIdentification1) DeepCopy() *DocumentLineIdentification1 {
+	if r == nil {
+		return nil
+	}
+	out := new(DocumentLineIdentification1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *DocumentLineIdentification1) DeepCopyInto(out *DocumentLineIdentification1) {
+	*out = *r
+	if r.Tp != nil {
+		out.Tp = new(DocumentLineType1)
+		r.Tp.DeepCopyInto(out.Tp)
+	}
+	if r.Nb != nil {
+		out.Nb = new(common.Max35Text)
+		*out.Nb = *r.Nb
+	}
+	if r.RltdDt != nil {
+		out.RltdDt = new(common.ISODate)
+		*out.RltdDt = *r.RltdDt
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *DocumentLineInformation1) DeepCopy() *DocumentLineInformation1 {
+	if r == nil {
+		return nil
+	}
+	out := new(DocumentLineInformation1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *DocumentLineInformation1) DeepCopyInto(out *DocumentLineInformation1) {
+	*out = *r
+	if r.Id != nil {
+		out.Id = make([]DocumentLineIdentification1, len(r.Id))
+		for i := range r.Id {
+			r.Id[i].DeepCopyInto(&out.Id[i])
+		}
+	}
+	if r.Desc != nil {
+		out.Desc = new(common.Max2048Text)
+		*out.Desc = *r.Desc
+	}
+	if r.Amt != nil {
+		out.Amt = new(RemittanceAmount3)
+		r.Amt.DeepCopyInto(out.Amt)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *DocumentLineType1) DeepCopy() *DocumentLineType1 {
+	if r == nil {
+		return nil
+	}
+	out := new(DocumentLineType1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *DocumentLineType1) DeepCopyInto(out *DocumentLineType1) {
+	*out = *r
+	if r.Issr != nil {
+		out.Issr = new(common.Max35Text)
+		*out.Issr = *r.Issr
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *DocumentLineType1Choice) DeepCopy() *DocumentLineType1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(DocumentLineType1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *DocumentLineType1Choice) DeepCopyInto(out *DocumentLineType1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *DuplicateV06) DeepCopy() *DuplicateV06 {
+	if r == nil {
+		return nil
+	}
+	out := new(DuplicateV06)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *DuplicateV06) DeepCopyInto(out *DuplicateV06) {
+	*out = *r
+	r.Assgnmt.DeepCopyInto(&out.Assgnmt)
+	if r.Case != nil {
+		out.Case = new(Case5)
+		r.Case.DeepCopyInto(out.Case)
+	}
+	if r.SplmtryData != nil {
+		out.SplmtryData = make([]SupplementaryData1, len(r.SplmtryData))
+		for i := range r.SplmtryData {
+			r.SplmtryData[i].DeepCopyInto(&out.SplmtryData[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *EquivalentAmount2) DeepCopy() *EquivalentAmount2 {
+	if r == nil {
+		return nil
+	}
+	out := new(EquivalentAmount2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *EquivalentAmount2) DeepCopyInto(out *EquivalentAmount2) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ErrorHandling3Choice) DeepCopy() *ErrorHandling3Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(ErrorHandling3Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ErrorHandling3Choice) DeepCopyInto(out *ErrorHandling3Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ErrorHandling5) DeepCopy() *ErrorHandling5 {
+	if r == nil {
+		return nil
+	}
+	out := new(ErrorHandling5)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ErrorHandling5) DeepCopyInto(out *ErrorHandling5) {
+	*out = *r
+	if r.Desc != nil {
+		out.Desc = new(common.Max140Text)
+		*out.Desc = *r.Desc
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *EventType1Choice) DeepCopy() *EventType1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(EventType1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *EventType1Choice) DeepCopyInto(out *EventType1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ExecutionType1Choice) DeepCopy() *ExecutionType1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(ExecutionType1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ExecutionType1Choice) DeepCopyInto(out *ExecutionType1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *FinancialIdentificationSchemeName1Choice) DeepCopy() *FinancialIdentificationSchemeName1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(FinancialIdentificationSchemeName1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *FinancialIdentificationSchemeName1Choice) DeepCopyInto(out *FinancialIdentificationSchemeName1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *FinancialInstitutionIdentification18) DeepCopy() *FinancialInstitutionIdentification18 {
+	if r == nil {
+		return nil
+	}
+	out := new(FinancialInstitutionIdentification18)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *FinancialInstitutionIdentification18) DeepCopyInto(out *FinancialInstitutionIdentification18) {
+	*out = *r
+	if r.BICFI != nil {
+		out.BICFI = new(common.BICFIDec2014Identifier)
+		*out.BICFI = *r.BICFI
+	}
+	if r.ClrSysMmbId != nil {
+		out.ClrSysMmbId = new(ClearingSystemMemberIdentification2)
+		r.ClrSysMmbId.DeepCopyInto(out.ClrSysMmbId)
+	}
+	if r.LEI != nil {
+		out.LEI = new(common.LEIIdentifier)
+		*out.LEI = *r.LEI
+	}
+	if r.Nm != nil {
+		out.Nm = new(common.Max140Text)
+		*out.Nm = *r.Nm
+	}
+	if r.PstlAdr != nil {
+		out.PstlAdr = new(PostalAddress24)
+		r.PstlAdr.DeepCopyInto(out.PstlAdr)
+	}
+	if r.Othr != nil {
+		out.Othr = new(GenericFinancialIdentification1)
+		r.Othr.DeepCopyInto(out.Othr)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *FinancialInstitutionIdentification8) DeepCopy() *FinancialInstitutionIdentification8 {
+	if r == nil {
+		return nil
+	}
+	out := new(FinancialInstitutionIdentification8)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *FinancialInstitutionIdentification8) DeepCopyInto(out *FinancialInstitutionIdentification8) {
+	*out = *r
+	if r.BICFI != nil {
+		out.BICFI = new(common.BICFIIdentifier)
+		*out.BICFI = *r.BICFI
+	}
+	if r.ClrSysMmbId != nil {
+		out.ClrSysMmbId = new(ClearingSystemMemberIdentification2)
+		r.ClrSysMmbId.DeepCopyInto(out.ClrSysMmbId)
+	}
+	if r.Nm != nil {
+		out.Nm = new(common.Max140Text)
+		*out.Nm = *r.Nm
+	}
+	if r.PstlAdr != nil {
+		out.PstlAdr = new(PostalAddress6)
+		r.PstlAdr.DeepCopyInto(out.PstlAdr)
+	}
+	if r.Othr != nil {
+		out.Othr = new(GenericFinancialIdentification1)
+		r.Othr.DeepCopyInto(out.Othr)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *Frequency21Choice) DeepCopy() *Frequency21Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(Frequency21Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *Frequency21Choice) DeepCopyInto(out *Frequency21Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *FrequencyPeriod1) DeepCopy() *FrequencyPeriod1 {
+	if r == nil {
+		return nil
+	}
+	out := new(FrequencyPeriod1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *FrequencyPeriod1) DeepCopyInto(out *FrequencyPeriod1) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *Garnishment1) DeepCopy() *Garnishment1 {
+	if r == nil {
+		return nil
+	}
+	out := new(Garnishment1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *Garnishment1) DeepCopyInto(out *Garnishment1) {
+	*out = *r
+	r.Tp.DeepCopyInto(&out.Tp)
+	if r.Grnshee != nil {
+		out.Grnshee = new(PartyIdentification43)
+		r.Grnshee.DeepCopyInto(out.Grnshee)
+	}
+	if r.GrnshmtAdmstr != nil {
+		out.GrnshmtAdmstr = new(PartyIdentification43)
+		r.GrnshmtAdmstr.DeepCopyInto(out.GrnshmtAdmstr)
+	}
+	if r.RefNb != nil {
+		out.RefNb = new(common.Max140Text)
+		*out.RefNb = *r.RefNb
+	}
+	if r.Dt != nil {
+		out.Dt = new(common.ISODate)
+		*out.Dt = *r.Dt
+	}
+	if r.RmtdAmt != nil {
+		out.RmtdAmt = new(ActiveOrHistoricCurrencyAndAmount)
+		*out.RmtdAmt = *r.RmtdAmt
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *Garnishment3) DeepCopy() *Garnishment3 {
+	if r == nil {
+		return nil
+	}
+	out := new(Garnishment3)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *Garnishment3) DeepCopyInto(out *Garnishment3) {
+	*out = *r
+	r.Tp.DeepCopyInto(&out.Tp)
+	if r.Grnshee != nil {
+		out.Grnshee = new(PartyIdentification135)
+		r.Grnshee.DeepCopyInto(out.Grnshee)
+	}
+	if r.GrnshmtAdmstr != nil {
+		out.GrnshmtAdmstr = new(PartyIdentification135)
+		r.GrnshmtAdmstr.DeepCopyInto(out.GrnshmtAdmstr)
+	}
+	if r.RefNb != nil {
+		out.RefNb = new(common.Max140Text)
+		*out.RefNb = *r.RefNb
+	}
+	if r.Dt != nil {
+		out.Dt = new(common.ISODate)
+		*out.Dt = *r.Dt
+	}
+	if r.RmtdAmt != nil {
+		out.RmtdAmt = new(ActiveOrHistoricCurrencyAndAmount)
+		*out.RmtdAmt = *r.RmtdAmt
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *GarnishmentType1) DeepCopy() *GarnishmentType1 {
+	if r == nil {
+		return nil
+	}
+	out := new(GarnishmentType1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *GarnishmentType1) DeepCopyInto(out *GarnishmentType1) {
+	*out = *r
+	if r.Issr != nil {
+		out.Issr = new(common.Max35Text)
+		*out.Issr = *r.Issr
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *GarnishmentType1Choice) DeepCopy() *GarnishmentType1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(GarnishmentType1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *GarnishmentType1Choice) DeepCopyInto(out *GarnishmentType1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *GeneralBusinessInformation1) DeepCopy() *GeneralBusinessInformation1 {
+	if r == nil {
+		return nil
+	}
+	out := new(GeneralBusinessInformation1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *GeneralBusinessInformation1) DeepCopyInto(out *GeneralBusinessInformation1) {
+	*out = *r
+	if r.Qlfr != nil {
+		out.Qlfr = new(InformationQualifierType1)
+		r.Qlfr.DeepCopyInto(out.Qlfr)
+	}
+	if r.Sbjt != nil {
+		out.Sbjt = new(common.Max35Text)
+		*out.Sbjt = *r.Sbjt
+	}
+	if r.SbjtDtls != nil {
+		out.SbjtDtls = new(common.Max350Text)
+		*out.SbjtDtls = *r.SbjtDtls
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *GeneralBusinessOrError7Choice) DeepCopy() *GeneralBusinessOrError7Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(GeneralBusinessOrError7Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *GeneralBusinessOrError7Choice) DeepCopyInto(out *GeneralBusinessOrError7Choice) {
+	*out = *r
+	if r.OprlErr != nil {
+		out.OprlErr = make([]ErrorHandling5, len(r.OprlErr))
+		for i := range r.OprlErr {
+			r.OprlErr[i].DeepCopyInto(&out.OprlErr[i])
+		}
+	}
+	if r.BizRpt != nil {
+		out.BizRpt = make([]GeneralBusinessReport6, len(r.BizRpt))
+		for i := range r.BizRpt {
+			r.BizRpt[i].DeepCopyInto(&out.BizRpt[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *GeneralBusinessOrError8Choice) DeepCopy() *GeneralBusinessOrError8Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(GeneralBusinessOrError8Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *GeneralBusinessOrError8Choice) DeepCopyInto(out *GeneralBusinessOrError8Choice) {
+	*out = *r
+	if r.BizErr != nil {
+		out.BizErr = make([]ErrorHandling5, len(r.BizErr))
+		for i := range r.BizErr {
+			r.BizErr[i].DeepCopyInto(&out.BizErr[i])
+		}
+	}
+	r.GnlBiz.DeepCopyInto(&out.GnlBiz)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *GeneralBusinessReport6) DeepCopy() *GeneralBusinessReport6 {
+	if r == nil {
+		return nil
+	}
+	out := new(GeneralBusinessReport6)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *GeneralBusinessReport6) DeepCopyInto(out *GeneralBusinessReport6) {
+	*out = *r
+	r.GnlBizOrErr.DeepCopyInto(&out.GnlBizOrErr)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *GenericAccountIdentification1) DeepCopy() *GenericAccountIdentification1 {
+	if r == nil {
+		return nil
+	}
+	out := new(GenericAccountIdentification1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *GenericAccountIdentification1) DeepCopyInto(out *GenericAccountIdentification1) {
+	*out = *r
+	if r.SchmeNm != nil {
+		out.SchmeNm = new(AccountSchemeName1Choice)
+		*out.SchmeNm = *r.SchmeNm
+	}
+	if r.Issr != nil {
+		out.Issr = new(common.Max35Text)
+		*out.Issr = *r.Issr
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *GenericFinancialIdentification1) DeepCopy() *GenericFinancialIdentification1 {
+	if r == nil {
+		return nil
+	}
+	out := new(GenericFinancialIdentification1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *GenericFinancialIdentification1) DeepCopyInto(out *GenericFinancialIdentification1) {
+	*out = *r
+	if r.SchmeNm != nil {
+		out.SchmeNm = new(FinancialIdentificationSchemeName1Choice)
+		*out.SchmeNm = *r.SchmeNm
+	}
+	if r.Issr != nil {
+		out.Issr = new(common.Max35Text)
+		*out.Issr = *r.Issr
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *GenericIdentification1) DeepCopy() *GenericIdentification1 {
+	if r == nil {
+		return nil
+	}
+	out := new(GenericIdentification1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *GenericIdentification1) DeepCopyInto(out *GenericIdentification1) {
+	*out = *r
+	if r.SchmeNm != nil {
+		out.SchmeNm = new(common.Max35Text)
+		*out.SchmeNm = *r.SchmeNm
+	}
+	if r.Issr != nil {
+		out.Issr = new(common.Max35Text)
+		*out.Issr = *r.Issr
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *GenericIdentification3) DeepCopy() *GenericIdentification3 {
+	if r == nil {
+		return nil
+	}
+	out := new(GenericIdentification3)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *GenericIdentification3) DeepCopyInto(out *GenericIdentification3) {
+	*out = *r
+	if r.Issr != nil {
+		out.Issr = new(common.Max35Text)
+		*out.Issr = *r.Issr
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *GenericIdentification30) DeepCopy() *GenericIdentification30 {
+	if r == nil {
+		return nil
+	}
+	out := new(GenericIdentification30)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *GenericIdentification30) DeepCopyInto(out *GenericIdentification30) {
+	*out = *r
+	if r.SchmeNm != nil {
+		out.SchmeNm = new(common.Max35Text)
+		*out.SchmeNm = *r.SchmeNm
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *GenericOrganisationIdentification1) DeepCopy() *GenericOrganisationIdentification1 {
+	if r == nil {
+		return nil
+	}
+	out := new(GenericOrganisationIdentification1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *GenericOrganisationIdentification1) DeepCopyInto(out *GenericOrganisationIdentification1) {
+	*out = *r
+	if r.SchmeNm != nil {
+		out.SchmeNm = new(OrganisationIdentificationSchemeName1Choice)
+		*out.SchmeNm = *r.SchmeNm
+	}
+	if r.Issr != nil {
+		out.Issr = new(common.Max35Text)
+		*out.Issr = *r.Issr
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *GenericPersonIdentification1) DeepCopy() *GenericPersonIdentification1 {
+	if r == nil {
+		return nil
+	}
+	out := new(GenericPersonIdentification1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *GenericPersonIdentification1) DeepCopyInto(out *GenericPersonIdentification1) {
+	*out = *r
+	if r.SchmeNm != nil {
+		out.SchmeNm = new(PersonIdentificationSchemeName1Choice)
+		*out.SchmeNm = *r.SchmeNm
+	}
+	if r.Issr != nil {
+		out.Issr = new(common.Max35Text)
+		*out.Issr = *r.Issr
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *GroupHeader77) DeepCopy() *GroupHeader77 {
+	if r == nil {
+		return nil
+	}
+	out := new(GroupHeader77)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *GroupHeader77) DeepCopyInto(out *GroupHeader77) {
+	*out = *r
+	if r.MsgSndr != nil {
+		out.MsgSndr = new(Party40Choice)
+		r.MsgSndr.DeepCopyInto(out.MsgSndr)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *GroupHeader84) DeepCopy() *GroupHeader84 {
+	if r == nil {
+		return nil
+	}
+	out := new(GroupHeader84)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *GroupHeader84) DeepCopyInto(out *GroupHeader84) {
+	*out = *r
+	if r.MsgRcpt != nil {
+		out.MsgRcpt = new(Party40Choice)
+		r.MsgRcpt.DeepCopyInto(out.MsgRcpt)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *InformationQualifierType1) DeepCopy() *InformationQualifierType1 {
+	if r == nil {
+		return nil
+	}
+	out := new(InformationQualifierType1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *InformationQualifierType1) DeepCopyInto(out *InformationQualifierType1) {
+	*out = *r
+	if r.Prty != nil {
+		out.Prty = new(Priority1Code)
+		*out.Prty = *r.Prty
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *InvestigationRejectionJustification1) DeepCopy() *InvestigationRejectionJustification1 {
+	if r == nil {
+		return nil
+	}
+	out := new(InvestigationRejectionJustification1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *InvestigationRejectionJustification1) DeepCopyInto(out *InvestigationRejectionJustification1) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *InvestigationStatus3Choice) DeepCopy() *InvestigationStatus3Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(InvestigationStatus3Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *InvestigationStatus3Choice) DeepCopyInto(out *InvestigationStatus3Choice) {
+	*out = *r
+	if r.RjctdMod != nil {
+		out.RjctdMod = make([]ModificationRejection2Code, len(r.RjctdMod))
+		copy(out.RjctdMod, r.RjctdMod)
+	}
+	r.DplctOf.DeepCopyInto(&out.DplctOf)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *LocalInstrument2Choice) DeepCopy() *LocalInstrument2Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(LocalInstrument2Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *LocalInstrument2Choice) DeepCopyInto(out *LocalInstrument2Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *MandateRelatedInformation10) DeepCopy() *MandateRelatedInformation10 {
+	if r == nil {
+		return nil
+	}
+	out := new(MandateRelatedInformation10)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *MandateRelatedInformation10) DeepCopyInto(out *MandateRelatedInformation10) {
+	*out = *r
+	if r.MndtId != nil {
+		out.MndtId = new(common.Max35Text)
+		*out.MndtId = *r.MndtId
+	}
+	if r.DtOfSgntr != nil {
+		out.DtOfSgntr = new(common.ISODate)
+		*out.DtOfSgntr = *r.DtOfSgntr
+	}
+	if r.AmdmntInfDtls != nil {
+		out.AmdmntInfDtls = new(AmendmentInformationDetails10)
+		r.AmdmntInfDtls.DeepCopyInto(out.AmdmntInfDtls)
+	}
+	if r.ElctrncSgntr != nil {
+		out.ElctrncSgntr = new(common.Max1025Text)
+		*out.ElctrncSgntr = *r.ElctrncSgntr
+	}
+	if r.FrstColltnDt != nil {
+		out.FrstColltnDt = new(common.ISODate)
+		*out.FrstColltnDt = *r.FrstColltnDt
+	}
+	if r.FnlColltnDt != nil {
+		out.FnlColltnDt = new(common.ISODate)
+		*out.FnlColltnDt = *r.FnlColltnDt
+	}
+	if r.Frqcy != nil {
+		out.Frqcy = new(Frequency21Choice)
+		*out.Frqcy = *r.Frqcy
+	}
+	if r.Rsn != nil {
+		out.Rsn = new(MandateSetupReason1Choice)
+		*out.Rsn = *r.Rsn
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *MandateSetupReason1Choice) DeepCopy() *MandateSetupReason1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(MandateSetupReason1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *MandateSetupReason1Choice) DeepCopyInto(out *MandateSetupReason1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *MarketInfrastructureIdentification1Choice) DeepCopy() *MarketInfrastructureIdentification1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(MarketInfrastructureIdentification1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *MarketInfrastructureIdentification1Choice) DeepCopyInto(out *MarketInfrastructureIdentification1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *MessageHeader1) DeepCopy() *MessageHeader1 {
+	if r == nil {
+		return nil
+	}
+	out := new(MessageHeader1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *MessageHeader1) DeepCopyInto(out *MessageHeader1) {
+	*out = *r
+	if r.CreDtTm != nil {
+		out.CreDtTm = new(common.ISODateTime)
+		*out.CreDtTm = *r.CreDtTm
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *MessageHeader7) DeepCopy() *MessageHeader7 {
+	if r == nil {
+		return nil
+	}
+	out := new(MessageHeader7)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *MessageHeader7) DeepCopyInto(out *MessageHeader7) {
+	*out = *r
+	if r.CreDtTm != nil {
+		out.CreDtTm = new(common.ISODateTime)
+		*out.CreDtTm = *r.CreDtTm
+	}
+	if r.ReqTp != nil {
+		out.ReqTp = new(RequestType4Choice)
+		r.ReqTp.DeepCopyInto(out.ReqTp)
+	}
+	if r.OrgnlBizQry != nil {
+		out.OrgnlBizQry = new(OriginalBusinessQuery1)
+		r.OrgnlBizQry.DeepCopyInto(out.OrgnlBizQry)
+	}
+	if r.QryNm != nil {
+		out.QryNm = new(common.Max35Text)
+		*out.QryNm = *r.QryNm
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ModifyStandingOrderV06) DeepCopy() *ModifyStandingOrderV06 {
+	if r == nil {
+		return nil
+	}
+	out := new(ModifyStandingOrderV06)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ModifyStandingOrderV06) DeepCopyInto(out *ModifyStandingOrderV06) {
+	*out = *r
+	r.MsgHdr.DeepCopyInto(&out.MsgHdr)
+	r.StgOrdrId.DeepCopyInto(&out.StgOrdrId)
+	r.NewStgOrdrValSet.DeepCopyInto(&out.NewStgOrdrValSet)
+	if r.SplmtryData != nil {
+		out.SplmtryData = make([]SupplementaryData1, len(r.SplmtryData))
+		for i := range r.SplmtryData {
+			r.SplmtryData[i].DeepCopyInto(&out.SplmtryData[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *NameAndAddress16) DeepCopy() *NameAndAddress16 {
+	if r == nil {
+		return nil
+	}
+	out := new(NameAndAddress16)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *NameAndAddress16) DeepCopyInto(out *NameAndAddress16) {
+	*out = *r
+	r.Adr.DeepCopyInto(&out.Adr)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *NotificationItem7) DeepCopy() *NotificationItem7 {
+	if r == nil {
+		return nil
+	}
+	out := new(NotificationItem7)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *NotificationItem7) DeepCopyInto(out *NotificationItem7) {
+	*out = *r
+	if r.EndToEndId != nil {
+		out.EndToEndId = new(common.Max35Text)
+		*out.EndToEndId = *r.EndToEndId
+	}
+	if r.UETR != nil {
+		out.UETR = new(common.UUIDv4Identifier)
+		*out.UETR = *r.UETR
+	}
+	if r.Acct != nil {
+		out.Acct = new(CashAccount38)
+		r.Acct.DeepCopyInto(out.Acct)
+	}
+	if r.AcctOwnr != nil {
+		out.AcctOwnr = new(Party40Choice)
+		r.AcctOwnr.DeepCopyInto(out.AcctOwnr)
+	}
+	if r.AcctSvcr != nil {
+		out.AcctSvcr = new(BranchAndFinancialInstitutionIdentification6)
+		r.AcctSvcr.DeepCopyInto(out.AcctSvcr)
+	}
+	if r.RltdAcct != nil {
+		out.RltdAcct = new(CashAccount38)
+		r.RltdAcct.DeepCopyInto(out.RltdAcct)
+	}
+	if r.Amt != nil {
+		out.Amt = new(ActiveOrHistoricCurrencyAndAmount)
+		*out.Amt = *r.Amt
+	}
+	if r.XpctdValDt != nil {
+		out.XpctdValDt = new(common.ISODate)
+		*out.XpctdValDt = *r.XpctdValDt
+	}
+	if r.Dbtr != nil {
+		out.Dbtr = new(Party40Choice)
+		r.Dbtr.DeepCopyInto(out.Dbtr)
+	}
+	if r.DbtrAgt != nil {
+		out.DbtrAgt = new(BranchAndFinancialInstitutionIdentification6)
+		r.DbtrAgt.DeepCopyInto(out.DbtrAgt)
+	}
+	if r.IntrmyAgt != nil {
+		out.IntrmyAgt = new(BranchAndFinancialInstitutionIdentification6)
+		r.IntrmyAgt.DeepCopyInto(out.IntrmyAgt)
+	}
+	if r.Purp != nil {
+		out.Purp = new(Purpose2Choice)
+		*out.Purp = *r.Purp
+	}
+	if r.RltdRmtInf != nil {
+		out.RltdRmtInf = new(RemittanceLocation7)
+		r.RltdRmtInf.DeepCopyInto(out.RltdRmtInf)
+	}
+	if r.RmtInf != nil {
+		out.RmtInf = new(RemittanceInformation16)
+		r.RmtInf.DeepCopyInto(out.RmtInf)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *NotificationToReceiveCancellationAdviceV06) DeepCopy() *NotificationToReceiveCancellationAdviceV06 {
+	if r == nil {
+		return nil
+	}
+	out := new(NotificationToReceiveCancellationAdviceV06)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *NotificationToReceiveCancellationAdviceV06) DeepCopyInto(out *NotificationToReceiveCancellationAdviceV06) {
+	*out = *r
+	r.GrpHdr.DeepCopyInto(&out.GrpHdr)
+	r.OrgnlNtfctn.DeepCopyInto(&out.OrgnlNtfctn)
+	if r.SplmtryData != nil {
+		out.SplmtryData = make([]SupplementaryData1, len(r.SplmtryData))
+		for i := range r.SplmtryData {
+			r.SplmtryData[i].DeepCopyInto(&out.SplmtryData[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *NotificationToReceiveStatusReportV06) DeepCopy() *NotificationToReceiveStatusReportV06 {
+	if r == nil {
+		return nil
+	}
+	out := new(NotificationToReceiveStatusReportV06)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *NotificationToReceiveStatusReportV06) DeepCopyInto(out *NotificationToReceiveStatusReportV06) {
+	*out = *r
+	r.GrpHdr.DeepCopyInto(&out.GrpHdr)
+	r.OrgnlNtfctnAndSts.DeepCopyInto(&out.OrgnlNtfctnAndSts)
+	if r.SplmtryData != nil {
+		out.SplmtryData = make([]SupplementaryData1, len(r.SplmtryData))
+		for i := range r.SplmtryData {
+			r.SplmtryData[i].DeepCopyInto(&out.SplmtryData[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *NotificationToReceiveV06) DeepCopy() *NotificationToReceiveV06 {
+	if r == nil {
+		return nil
+	}
+	out := new(NotificationToReceiveV06)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *NotificationToReceiveV06) DeepCopyInto(out *NotificationToReceiveV06) {
+	*out = *r
+	r.GrpHdr.DeepCopyInto(&out.GrpHdr)
+	r.Ntfctn.DeepCopyInto(&out.Ntfctn)
+	if r.SplmtryData != nil {
+		out.SplmtryData = make([]SupplementaryData1, len(r.SplmtryData))
+		for i := range r.SplmtryData {
+			r.SplmtryData[i].DeepCopyInto(&out.SplmtryData[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *NumberOfCancellationsPerStatus1) DeepCopy() *NumberOfCancellationsPerStatus1 {
+	if r == nil {
+		return nil
+	}
+	out := new(NumberOfCancellationsPerStatus1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *NumberOfCancellationsPerStatus1) DeepCopyInto(out *NumberOfCancellationsPerStatus1) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *NumberOfTransactionsPerStatus1) DeepCopy() *NumberOfTransactionsPerStatus1 {
+	if r == nil {
+		return nil
+	}
+	out := new(NumberOfTransactionsPerStatus1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *NumberOfTransactionsPerStatus1) DeepCopyInto(out *NumberOfTransactionsPerStatus1) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *OrganisationIdentification29) DeepCopy() *OrganisationIdentification29 {
+	if r == nil {
+		return nil
+	}
+	out := new(OrganisationIdentification29)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *OrganisationIdentification29) DeepCopyInto(out *OrganisationIdentification29) {
+	*out = *r
+	if r.AnyBIC != nil {
+		out.AnyBIC = new(common.AnyBICDec2014Identifier)
+		*out.AnyBIC = *r.AnyBIC
+	}
+	if r.LEI != nil {
+		out.LEI = new(common.LEIIdentifier)
+		*out.LEI = *r.LEI
+	}
+	if r.Othr != nil {
+		out.Othr = make([]GenericOrganisationIdentification1, len(r.Othr))
+		for i := range r.Othr {
+			r.Othr[i].DeepCopyInto(&out.Othr[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *OrganisationIdentification8) DeepCopy() *OrganisationIdentification8 {
+	if r == nil {
+		return nil
+	}
+	out := new(OrganisationIdentification8)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *OrganisationIdentification8) DeepCopyInto(out *OrganisationIdentification8) {
+	*out = *r
+	if r.AnyBIC != nil {
+		out.AnyBIC = new(common.AnyBICIdentifier)
+		*out.AnyBIC = *r.AnyBIC
+	}
+	if r.Othr != nil {
+		out.Othr = make([]GenericOrganisationIdentification1, len(r.Othr))
+		for i := range r.Othr {
+			r.Othr[i].DeepCopyInto(&out.Othr[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *OrganisationIdentificationSchemeName1Choice) DeepCopy() *OrganisationIdentificationSchemeName1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(OrganisationIdentificationSchemeName1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *OrganisationIdentificationSchemeName1Choice) DeepCopyInto(out *OrganisationIdentificationSchemeName1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *OriginalBusinessQuery1) DeepCopy() *OriginalBusinessQuery1 {
+	if r == nil {
+		return nil
+	}
+	out := new(OriginalBusinessQuery1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *OriginalBusinessQuery1) DeepCopyInto(out *OriginalBusinessQuery1) {
+	*out = *r
+	if r.MsgNmId != nil {
+		out.MsgNmId = new(common.Max35Text)
+		*out.MsgNmId = *r.MsgNmId
+	}
+	if r.CreDtTm != nil {
+		out.CreDtTm = new(common.ISODateTime)
+		*out.CreDtTm = *r.CreDtTm
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *OriginalGroupHeader5) DeepCopy() *OriginalGroupHeader5 {
+	if r == nil {
+		return nil
+	}
+	out := new(OriginalGroupHeader5)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *OriginalGroupHeader5) DeepCopyInto(out *OriginalGroupHeader5) {
+	*out = *r
+	if r.OrgnlGrpCxlId != nil {
+		out.OrgnlGrpCxlId = new(common.Max35Text)
+		*out.OrgnlGrpCxlId = *r.OrgnlGrpCxlId
+	}
+	if r.RslvdCase != nil {
+		out.RslvdCase = new(Case3)
+		r.RslvdCase.DeepCopyInto(out.RslvdCase)
+	}
+	if r.OrgnlCreDtTm != nil {
+		out.OrgnlCreDtTm = new(common.ISODateTime)
+		*out.OrgnlCreDtTm = *r.OrgnlCreDtTm
+	}
+	if r.OrgnlNbOfTxs != nil {
+		out.OrgnlNbOfTxs = new(common.Max15NumericText)
+		*out.OrgnlNbOfTxs = *r.OrgnlNbOfTxs
+	}
+	if r.GrpCxlSts != nil {
+		out.GrpCxlSts = new(GroupCancellationStatus1Code)
+		*out.GrpCxlSts = *r.GrpCxlSts
+	}
+	if r.CxlStsRsnInf != nil {
+		out.CxlStsRsnInf = make([]CancellationStatusReason2, len(r.CxlStsRsnInf))
+		for i := range r.CxlStsRsnInf {
+			r.CxlStsRsnInf[i].DeepCopyInto(&out.CxlStsRsnInf[i])
+		}
+	}
+	if r.NbOfTxsPerCxlSts != nil {
+		out.NbOfTxsPerCxlSts = make([]NumberOfTransactionsPerStatus1, len(r.NbOfTxsPerCxlSts))
+		copy(out.NbOfTxsPerCxlSts, r.NbOfTxsPerCxlSts)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *OriginalGroupInformation3) DeepCopy() *OriginalGroupInformation3 {
+	if r == nil {
+		return nil
+	}
+	out := new(OriginalGroupInformation3)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *OriginalGroupInformation3) DeepCopyInto(out *OriginalGroupInformation3) {
+	*out = *r
+	if r.OrgnlCreDtTm != nil {
+		out.OrgnlCreDtTm = new(common.ISODateTime)
+		*out.OrgnlCreDtTm = *r.OrgnlCreDtTm
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *OriginalItem6) DeepCopy() *OriginalItem6 {
+	if r == nil {
+		return nil
+	}
+	out := new(OriginalItem6)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *OriginalItem6) DeepCopyInto(out *OriginalItem6) {
+	*out = *r
+	if r.OrgnlEndToEndId != nil {
+		out.OrgnlEndToEndId = new(common.Max35Text)
+		*out.OrgnlEndToEndId = *r.OrgnlEndToEndId
+	}
+	if r.UETR != nil {
+		out.UETR = new(common.UUIDv4Identifier)
+		*out.UETR = *r.UETR
+	}
+	if r.XpctdValDt != nil {
+		out.XpctdValDt = new(common.ISODate)
+		*out.XpctdValDt = *r.XpctdValDt
+	}
+	if r.OrgnlItmRef != nil {
+		out.OrgnlItmRef = new(OriginalItemReference5)
+		r.OrgnlItmRef.DeepCopyInto(out.OrgnlItmRef)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *OriginalItemAndStatus6) DeepCopy() *OriginalItemAndStatus6 {
+	if r == nil {
+		return nil
+	}
+	out := new(OriginalItemAndStatus6)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *OriginalItemAndStatus6) DeepCopyInto(out *OriginalItemAndStatus6) {
+	*out = *r
+	if r.OrgnlEndToEndId != nil {
+		out.OrgnlEndToEndId = new(common.Max35Text)
+		*out.OrgnlEndToEndId = *r.OrgnlEndToEndId
+	}
+	if r.OrgnlUETR != nil {
+		out.OrgnlUETR = new(common.UUIDv4Identifier)
+		*out.OrgnlUETR = *r.OrgnlUETR
+	}
+	if r.XpctdValDt != nil {
+		out.XpctdValDt = new(common.ISODate)
+		*out.XpctdValDt = *r.XpctdValDt
+	}
+	if r.AddtlStsInf != nil {
+		out.AddtlStsInf = new(common.Max105Text)
+		*out.AddtlStsInf = *r.AddtlStsInf
+	}
+	if r.OrgnlItmRef != nil {
+		out.OrgnlItmRef = new(OriginalItemReference5)
+		r.OrgnlItmRef.DeepCopyInto(out.OrgnlItmRef)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *OriginalItemReference5) DeepCopy() *OriginalItemReference5 {
+	if r == nil {
+		return nil
+	}
+	out := new(OriginalItemReference5)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *OriginalItemReference5) DeepCopyInto(out *OriginalItemReference5) {
+	*out = *r
+	if r.Acct != nil {
+		out.Acct = new(CashAccount38)
+		r.Acct.DeepCopyInto(out.Acct)
+	}
+	if r.AcctOwnr != nil {
+		out.AcctOwnr = new(Party40Choice)
+		r.AcctOwnr.DeepCopyInto(out.AcctOwnr)
+	}
+	if r.AcctSvcr != nil {
+		out.AcctSvcr = new(BranchAndFinancialInstitutionIdentification6)
+		r.AcctSvcr.DeepCopyInto(out.AcctSvcr)
+	}
+	if r.RltdAcct != nil {
+		out.RltdAcct = new(CashAccount38)
+		r.RltdAcct.DeepCopyInto(out.RltdAcct)
+	}
+	if r.Dbtr != nil {
+		out.Dbtr = new(Party40Choice)
+		r.Dbtr.DeepCopyInto(out.Dbtr)
+	}
+	if r.DbtrAgt != nil {
+		out.DbtrAgt = new(BranchAndFinancialInstitutionIdentification6)
+		r.DbtrAgt.DeepCopyInto(out.DbtrAgt)
+	}
+	if r.IntrmyAgt != nil {
+		out.IntrmyAgt = new(BranchAndFinancialInstitutionIdentification6)
+		r.IntrmyAgt.DeepCopyInto(out.IntrmyAgt)
+	}
+	if r.Purp != nil {
+		out.Purp = new(Purpose2Choice)
+		*out.Purp = *r.Purp
+	}
+	if r.RltdRmtInf != nil {
+		out.RltdRmtInf = new(RemittanceLocation7)
+		r.RltdRmtInf.DeepCopyInto(out.RltdRmtInf)
+	}
+	if r.RmtInf != nil {
+		out.RmtInf = new(RemittanceInformation16)
+		r.RmtInf.DeepCopyInto(out.RmtInf)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *OriginalNotification11) DeepCopy() *OriginalNotification11 {
+	if r == nil {
+		return nil
+	}
+	out := new(OriginalNotification11)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *OriginalNotification11) DeepCopyInto(out *OriginalNotification11) {
+	*out = *r
+	if r.OrgnlCreDtTm != nil {
+		out.OrgnlCreDtTm = new(common.ISODateTime)
+		*out.OrgnlCreDtTm = *r.OrgnlCreDtTm
+	}
+	if r.NtfctnSts != nil {
+		out.NtfctnSts = new(NotificationStatus3Code)
+		*out.NtfctnSts = *r.NtfctnSts
+	}
+	if r.AddtlStsInf != nil {
+		out.AddtlStsInf = new(common.Max140Text)
+		*out.AddtlStsInf = *r.AddtlStsInf
+	}
+	if r.OrgnlNtfctnRef != nil {
+		out.OrgnlNtfctnRef = make([]OriginalNotificationReference9, len(r.OrgnlNtfctnRef))
+		for i := range r.OrgnlNtfctnRef {
+			r.OrgnlNtfctnRef[i].DeepCopyInto(&out.OrgnlNtfctnRef[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *OriginalNotification12) DeepCopy() *OriginalNotification12 {
+	if r == nil {
+		return nil
+	}
+	out := new(OriginalNotification12)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *OriginalNotification12) DeepCopyInto(out *OriginalNotification12) {
+	*out = *r
+	if r.OrgnlCreDtTm != nil {
+		out.OrgnlCreDtTm = new(common.ISODateTime)
+		*out.OrgnlCreDtTm = *r.OrgnlCreDtTm
+	}
+	if r.OrgnlNtfctnRef != nil {
+		out.OrgnlNtfctnRef = make([]OriginalNotificationReference10, len(r.OrgnlNtfctnRef))
+		for i := range r.OrgnlNtfctnRef {
+			r.OrgnlNtfctnRef[i].DeepCopyInto(&out.OrgnlNtfctnRef[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *OriginalNotificationReference10) DeepCopy() *OriginalNotificationReference10 {
+	if r == nil {
+		return nil
+	}
+	out := new(OriginalNotificationReference10)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *OriginalNotificationReference10) DeepCopyInto(out *OriginalNotificationReference10) {
+	*out = *r
+	if r.Acct != nil {
+		out.Acct = new(CashAccount38)
+		r.Acct.DeepCopyInto(out.Acct)
+	}
+	if r.AcctOwnr != nil {
+		out.AcctOwnr = new(Party40Choice)
+		r.AcctOwnr.DeepCopyInto(out.AcctOwnr)
+	}
+	if r.AcctSvcr != nil {
+		out.AcctSvcr = new(BranchAndFinancialInstitutionIdentification6)
+		r.AcctSvcr.DeepCopyInto(out.AcctSvcr)
+	}
+	if r.RltdAcct != nil {
+		out.RltdAcct = new(CashAccount38)
+		r.RltdAcct.DeepCopyInto(out.RltdAcct)
+	}
+	if r.TtlAmt != nil {
+		out.TtlAmt = new(ActiveOrHistoricCurrencyAndAmount)
+		*out.TtlAmt = *r.TtlAmt
+	}
+	if r.XpctdValDt != nil {
+		out.XpctdValDt = new(common.ISODate)
+		*out.XpctdValDt = *r.XpctdValDt
+	}
+	if r.Dbtr != nil {
+		out.Dbtr = new(Party40Choice)
+		r.Dbtr.DeepCopyInto(out.Dbtr)
+	}
+	if r.DbtrAgt != nil {
+		out.DbtrAgt = new(BranchAndFinancialInstitutionIdentification6)
+		r.DbtrAgt.DeepCopyInto(out.DbtrAgt)
+	}
+	if r.IntrmyAgt != nil {
+		out.IntrmyAgt = new(BranchAndFinancialInstitutionIdentification6)
+		r.IntrmyAgt.DeepCopyInto(out.IntrmyAgt)
+	}
+	if r.OrgnlItm != nil {
+		out.OrgnlItm = make([]OriginalItem6, len(r.OrgnlItm))
+		for i := range r.OrgnlItm {
+			r.OrgnlItm[i].DeepCopyInto(&out.OrgnlItm[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *OriginalNotificationReference9) DeepCopy() *OriginalNotificationReference9 {
+	if r == nil {
+		return nil
+	}
+	out := new(OriginalNotificationReference9)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *OriginalNotificationReference9) DeepCopyInto(out *OriginalNotificationReference9) {
+	*out = *r
+	if r.Acct != nil {
+		out.Acct = new(CashAccount38)
+		r.Acct.DeepCopyInto(out.Acct)
+	}
+	if r.AcctOwnr != nil {
+		out.AcctOwnr = new(Party40Choice)
+		r.AcctOwnr.DeepCopyInto(out.AcctOwnr)
+	}
+	if r.AcctSvcr != nil {
+		out.AcctSvcr = new(BranchAndFinancialInstitutionIdentification6)
+		r.AcctSvcr.DeepCopyInto(out.AcctSvcr)
+	}
+	if r.RltdAcct != nil {
+		out.RltdAcct = new(CashAccount38)
+		r.RltdAcct.DeepCopyInto(out.RltdAcct)
+	}
+	if r.TtlAmt != nil {
+		out.TtlAmt = new(ActiveOrHistoricCurrencyAndAmount)
+		*out.TtlAmt = *r.TtlAmt
+	}
+	if r.XpctdValDt != nil {
+		out.XpctdValDt = new(common.ISODate)
+		*out.XpctdValDt = *r.XpctdValDt
+	}
+	if r.Dbtr != nil {
+		out.Dbtr = new(Party40Choice)
+		r.Dbtr.DeepCopyInto(out.Dbtr)
+	}
+	if r.DbtrAgt != nil {
+		out.DbtrAgt = new(BranchAndFinancialInstitutionIdentification6)
+		r.DbtrAgt.DeepCopyInto(out.DbtrAgt)
+	}
+	if r.IntrmyAgt != nil {
+		out.IntrmyAgt = new(BranchAndFinancialInstitutionIdentification6)
+		r.IntrmyAgt.DeepCopyInto(out.IntrmyAgt)
+	}
+	if r.OrgnlItmAndSts != nil {
+		out.OrgnlItmAndSts = make([]OriginalItemAndStatus6, len(r.OrgnlItmAndSts))
+		for i := range r.OrgnlItmAndSts {
+			r.OrgnlItmAndSts[i].DeepCopyInto(&out.OrgnlItmAndSts[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *OriginalPaymentInstruction17) DeepCopy() *OriginalPaymentInstruction17 {
+	if r == nil {
+		return nil
+	}
+	out := new(OriginalPaymentInstruction17)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *OriginalPaymentInstruction17) DeepCopyInto(out *OriginalPaymentInstruction17) {
+	*out = *r
+	if r.OrgnlPmtInfCxlId != nil {
+		out.OrgnlPmtInfCxlId = new(common.Max35Text)
+		*out.OrgnlPmtInfCxlId = *r.OrgnlPmtInfCxlId
+	}
+	if r.RslvdCase != nil {
+		out.RslvdCase = new(Case3)
+		r.RslvdCase.DeepCopyInto(out.RslvdCase)
+	}
+	if r.OrgnlGrpInf != nil {
+		out.OrgnlGrpInf = new(OriginalGroupInformation3)
+		r.OrgnlGrpInf.DeepCopyInto(out.OrgnlGrpInf)
+	}
+	if r.OrgnlNbOfTxs != nil {
+		out.OrgnlNbOfTxs = new(common.Max15NumericText)
+		*out.OrgnlNbOfTxs = *r.OrgnlNbOfTxs
+	}
+	if r.PmtInfCxlSts != nil {
+		out.PmtInfCxlSts = new(GroupCancellationStatus1Code)
+		*out.PmtInfCxlSts = *r.PmtInfCxlSts
+	}
+	if r.CxlStsRsnInf != nil {
+		out.CxlStsRsnInf = make([]CancellationStatusReason2, len(r.CxlStsRsnInf))
+		for i := range r.CxlStsRsnInf {
+			r.CxlStsRsnInf[i].DeepCopyInto(&out.CxlStsRsnInf[i])
+		}
+	}
+	if r.NbOfTxsPerCxlSts != nil {
+		out.NbOfTxsPerCxlSts = make([]NumberOfCancellationsPerStatus1, len(r.NbOfTxsPerCxlSts))
+		copy(out.NbOfTxsPerCxlSts, r.NbOfTxsPerCxlSts)
+	}
+	if r.TxInfAndSts != nil {
+		out.TxInfAndSts = make([]PaymentTransaction66, len(r.TxInfAndSts))
+		for i := range r.TxInfAndSts {
+			r.TxInfAndSts[i].DeepCopyInto(&out.TxInfAndSts[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *OriginalTransactionReference22) DeepCopy() *OriginalTransactionReference22 {
+	if r == nil {
+		return nil
+	}
+	out := new(OriginalTransactionReference22)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *OriginalTransactionReference22) DeepCopyInto(out *OriginalTransactionReference22) {
+	*out = *r
+	if r.IntrBkSttlmAmt != nil {
+		out.IntrBkSttlmAmt = new(ActiveOrHistoricCurrencyAndAmount)
+		*out.IntrBkSttlmAmt = *r.IntrBkSttlmAmt
+	}
+	if r.Amt != nil {
+		out.Amt = new(AmountType4Choice)
+		*out.Amt = *r.Amt
+	}
+	if r.IntrBkSttlmDt != nil {
+		out.IntrBkSttlmDt = new(common.ISODate)
+		*out.IntrBkSttlmDt = *r.IntrBkSttlmDt
+	}
+	if r.ReqdColltnDt != nil {
+		out.ReqdColltnDt = new(common.ISODate)
+		*out.ReqdColltnDt = *r.ReqdColltnDt
+	}
+	if r.ReqdExctnDt != nil {
+		out.ReqdExctnDt = new(common.ISODate)
+		*out.ReqdExctnDt = *r.ReqdExctnDt
+	}
+	if r.CdtrSchmeId != nil {
+		out.CdtrSchmeId = new(PartyIdentification43)
+		r.CdtrSchmeId.DeepCopyInto(out.CdtrSchmeId)
+	}
+	if r.SttlmInf != nil {
+		out.SttlmInf = new(SettlementInstruction4)
+		r.SttlmInf.DeepCopyInto(out.SttlmInf)
+	}
+	if r.PmtTpInf != nil {
+		out.PmtTpInf = new(PaymentTypeInformation25)
+		r.PmtTpInf.DeepCopyInto(out.PmtTpInf)
+	}
+	if r.PmtMtd != nil {
+		out.PmtMtd = new(PaymentMethod4Code)
+		*out.PmtMtd = *r.PmtMtd
+	}
+	if r.MndtRltdInf != nil {
+		out.MndtRltdInf = new(MandateRelatedInformation10)
+		r.MndtRltdInf.DeepCopyInto(out.MndtRltdInf)
+	}
+	if r.RmtInf != nil {
+		out.RmtInf = new(RemittanceInformation11)
+		r.RmtInf.DeepCopyInto(out.RmtInf)
+	}
+	if r.UltmtDbtr != nil {
+		out.UltmtDbtr = new(PartyIdentification43)
+		r.UltmtDbtr.DeepCopyInto(out.UltmtDbtr)
+	}
+	if r.Dbtr != nil {
+		out.Dbtr = new(PartyIdentification43)
+		r.Dbtr.DeepCopyInto(out.Dbtr)
+	}
+	if r.DbtrAcct != nil {
+		out.DbtrAcct = new(CashAccount24)
+		r.DbtrAcct.DeepCopyInto(out.DbtrAcct)
+	}
+	if r.DbtrAgt != nil {
+		out.DbtrAgt = new(BranchAndFinancialInstitutionIdentification5)
+		r.DbtrAgt.DeepCopyInto(out.DbtrAgt)
+	}
+	if r.DbtrAgtAcct != nil {
+		out.DbtrAgtAcct = new(CashAccount24)
+		r.DbtrAgtAcct.DeepCopyInto(out.DbtrAgtAcct)
+	}
+	if r.CdtrAgt != nil {
+		out.CdtrAgt = new(BranchAndFinancialInstitutionIdentification5)
+		r.CdtrAgt.DeepCopyInto(out.CdtrAgt)
+	}
+	if r.CdtrAgtAcct != nil {
+		out.CdtrAgtAcct = new(CashAccount24)
+		r.CdtrAgtAcct.DeepCopyInto(out.CdtrAgtAcct)
+	}
+	if r.Cdtr != nil {
+		out.Cdtr = new(PartyIdentification43)
+		r.Cdtr.DeepCopyInto(out.Cdtr)
+	}
+	if r.CdtrAcct != nil {
+		out.CdtrAcct = new(CashAccount24)
+		r.CdtrAcct.DeepCopyInto(out.CdtrAcct)
+	}
+	if r.UltmtCdtr != nil {
+		out.UltmtCdtr = new(PartyIdentification43)
+		r.UltmtCdtr.DeepCopyInto(out.UltmtCdtr)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *OtherContact1) DeepCopy() *OtherContact1 {
+	if r == nil {
+		return nil
+	}
+	out := new(OtherContact1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *OtherContact1) DeepCopyInto(out *OtherContact1) {
+	*out = *r
+	if r.Id != nil {
+		out.Id = new(common.Max128Text)
+		*out.Id = *r.Id
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *Party11Choice) DeepCopy() *Party11Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(Party11Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *Party11Choice) DeepCopyInto(out *Party11Choice) {
+	*out = *r
+	r.OrgId.DeepCopyInto(&out.OrgId)
+	r.PrvtId.DeepCopyInto(&out.PrvtId)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *Party12Choice) DeepCopy() *Party12Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(Party12Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *Party12Choice) DeepCopyInto(out *Party12Choice) {
+	*out = *r
+	r.Pty.DeepCopyInto(&out.Pty)
+	r.Agt.DeepCopyInto(&out.Agt)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *Party38Choice) DeepCopy() *Party38Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(Party38Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *Party38Choice) DeepCopyInto(out *Party38Choice) {
+	*out = *r
+	r.OrgId.DeepCopyInto(&out.OrgId)
+	r.PrvtId.DeepCopyInto(&out.PrvtId)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *Party40Choice) DeepCopy() *Party40Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(Party40Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *Party40Choice) DeepCopyInto(out *Party40Choice) {
+	*out = *r
+	r.Pty.DeepCopyInto(&out.Pty)
+	r.Agt.DeepCopyInto(&out.Agt)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *PartyIdentification135) DeepCopy() *PartyIdentification135 {
+	if r == nil {
+		return nil
+	}
+	out := new(PartyIdentification135)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *PartyIdentification135) DeepCopyInto(out *PartyIdentification135) {
+	*out = *r
+	if r.Nm != nil {
+		out.Nm = new(common.Max140Text)
+		*out.Nm = *r.Nm
+	}
+	if r.PstlAdr != nil {
+		out.PstlAdr = new(PostalAddress24)
+		r.PstlAdr.DeepCopyInto(out.PstlAdr)
+	}
+	if r.Id != nil {
+		out.Id = new(Party38Choice)
+		r.Id.DeepCopyInto(out.Id)
+	}
+	if r.CtryOfRes != nil {
+		out.CtryOfRes = new(common.CountryCode)
+		*out.CtryOfRes = *r.CtryOfRes
+	}
+	if r.CtctDtls != nil {
+		out.CtctDtls = new(Contact4)
+		r.CtctDtls.DeepCopyInto(out.CtctDtls)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *PartyIdentification43) DeepCopy() *PartyIdentification43 {
+	if r == nil {
+		return nil
+	}
+	out := new(PartyIdentification43)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *PartyIdentification43) DeepCopyInto(out *PartyIdentification43) {
+	*out = *r
+	if r.Nm != nil {
+		out.Nm = new(common.Max140Text)
+		*out.Nm = *r.Nm
+	}
+	if r.PstlAdr != nil {
+		out.PstlAdr = new(PostalAddress6)
+		r.PstlAdr.DeepCopyInto(out.PstlAdr)
+	}
+	if r.Id != nil {
+		out.Id = new(Party11Choice)
+		r.Id.DeepCopyInto(out.Id)
+	}
+	if r.CtryOfRes != nil {
+		out.CtryOfRes = new(common.CountryCode)
+		*out.CtryOfRes = *r.CtryOfRes
+	}
+	if r.CtctDtls != nil {
+		out.CtctDtls = new(ContactDetails2)
+		r.CtctDtls.DeepCopyInto(out.CtctDtls)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *PaymentTransaction66) DeepCopy() *PaymentTransaction66 {
+	if r == nil {
+		return nil
+	}
+	out := new(PaymentTransaction66)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *PaymentTransaction66) DeepCopyInto(out *PaymentTransaction66) {
+	*out = *r
+	if r.CxlStsId != nil {
+		out.CxlStsId = new(common.Max35Text)
+		*out.CxlStsId = *r.CxlStsId
+	}
+	if r.RslvdCase != nil {
+		out.RslvdCase = new(Case3)
+		r.RslvdCase.DeepCopyInto(out.RslvdCase)
+	}
+	if r.OrgnlInstrId != nil {
+		out.OrgnlInstrId = new(common.Max35Text)
+		*out.OrgnlInstrId = *r.OrgnlInstrId
+	}
+	if r.OrgnlEndToEndId != nil {
+		out.OrgnlEndToEndId = new(common.Max35Text)
+		*out.OrgnlEndToEndId = *r.OrgnlEndToEndId
+	}
+	if r.TxCxlSts != nil {
+		out.TxCxlSts = new(CancellationIndividualStatus1Code)
+		*out.TxCxlSts = *r.TxCxlSts
+	}
+	if r.CxlStsRsnInf != nil {
+		out.CxlStsRsnInf = make([]CancellationStatusReason2, len(r.CxlStsRsnInf))
+		for i := range r.CxlStsRsnInf {
+			r.CxlStsRsnInf[i].DeepCopyInto(&out.CxlStsRsnInf[i])
+		}
+	}
+	if r.OrgnlInstdAmt != nil {
+		out.OrgnlInstdAmt = new(ActiveOrHistoricCurrencyAndAmount)
+		*out.OrgnlInstdAmt = *r.OrgnlInstdAmt
+	}
+	if r.OrgnlReqdExctnDt != nil {
+		out.OrgnlReqdExctnDt = new(common.ISODate)
+		*out.OrgnlReqdExctnDt = *r.OrgnlReqdExctnDt
+	}
+	if r.OrgnlReqdColltnDt != nil {
+		out.OrgnlReqdColltnDt = new(common.ISODate)
+		*out.OrgnlReqdColltnDt = *r.OrgnlReqdColltnDt
+	}
+	if r.OrgnlTxRef != nil {
+		out.OrgnlTxRef = new(OriginalTransactionReference22)
+		r.OrgnlTxRef.DeepCopyInto(out.OrgnlTxRef)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *PaymentTransaction67) DeepCopy() *PaymentTransaction67 {
+	if r == nil {
+		return nil
+	}
+	out := new(PaymentTransaction67)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *PaymentTransaction67) DeepCopyInto(out *PaymentTransaction67) {
+	*out = *r
+	if r.CxlStsId != nil {
+		out.CxlStsId = new(common.Max35Text)
+		*out.CxlStsId = *r.CxlStsId
+	}
+	if r.RslvdCase != nil {
+		out.RslvdCase = new(Case3)
+		r.RslvdCase.DeepCopyInto(out.RslvdCase)
+	}
+	if r.OrgnlGrpInf != nil {
+		out.OrgnlGrpInf = new(OriginalGroupInformation3)
+		r.OrgnlGrpInf.DeepCopyInto(out.OrgnlGrpInf)
+	}
+	if r.OrgnlInstrId != nil {
+		out.OrgnlInstrId = new(common.Max35Text)
+		*out.OrgnlInstrId = *r.OrgnlInstrId
+	}
+	if r.OrgnlEndToEndId != nil {
+		out.OrgnlEndToEndId = new(common.Max35Text)
+		*out.OrgnlEndToEndId = *r.OrgnlEndToEndId
+	}
+	if r.OrgnlTxId != nil {
+		out.OrgnlTxId = new(common.Max35Text)
+		*out.OrgnlTxId = *r.OrgnlTxId
+	}
+	if r.OrgnlClrSysRef != nil {
+		out.OrgnlClrSysRef = new(common.Max35Text)
+		*out.OrgnlClrSysRef = *r.OrgnlClrSysRef
+	}
+	if r.TxCxlSts != nil {
+		out.TxCxlSts = new(CancellationIndividualStatus1Code)
+		*out.TxCxlSts = *r.TxCxlSts
+	}
+	if r.CxlStsRsnInf != nil {
+		out.CxlStsRsnInf = make([]CancellationStatusReason2, len(r.CxlStsRsnInf))
+		for i := range r.CxlStsRsnInf {
+			r.CxlStsRsnInf[i].DeepCopyInto(&out.CxlStsRsnInf[i])
+		}
+	}
+	if r.RsltnRltdInf != nil {
+		out.RsltnRltdInf = new(ResolutionInformation1)
+		r.RsltnRltdInf.DeepCopyInto(out.RsltnRltdInf)
+	}
+	if r.OrgnlIntrBkSttlmAmt != nil {
+		out.OrgnlIntrBkSttlmAmt = new(ActiveOrHistoricCurrencyAndAmount)
+		*out.OrgnlIntrBkSttlmAmt = *r.OrgnlIntrBkSttlmAmt
+	}
+	if r.OrgnlIntrBkSttlmDt != nil {
+		out.OrgnlIntrBkSttlmDt = new(common.ISODate)
+		*out.OrgnlIntrBkSttlmDt = *r.OrgnlIntrBkSttlmDt
+	}
+	if r.Assgnr != nil {
+		out.Assgnr = new(Party12Choice)
+		r.Assgnr.DeepCopyInto(out.Assgnr)
+	}
+	if r.Assgne != nil {
+		out.Assgne = new(Party12Choice)
+		r.Assgne.DeepCopyInto(out.Assgne)
+	}
+	if r.OrgnlTxRef != nil {
+		out.OrgnlTxRef = new(OriginalTransactionReference22)
+		r.OrgnlTxRef.DeepCopyInto(out.OrgnlTxRef)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *PaymentTypeInformation25) DeepCopy() *PaymentTypeInformation25 {
+	if r == nil {
+		return nil
+	}
+	out := new(PaymentTypeInformation25)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *PaymentTypeInformation25) DeepCopyInto(out *PaymentTypeInformation25) {
+	*out = *r
+	if r.InstrPrty != nil {
+		out.InstrPrty = new(Priority2Code)
+		*out.InstrPrty = *r.InstrPrty
+	}
+	if r.ClrChanl != nil {
+		out.ClrChanl = new(ClearingChannel2Code)
+		*out.ClrChanl = *r.ClrChanl
+	}
+	if r.SvcLvl != nil {
+		out.SvcLvl = new(ServiceLevel8Choice)
+		*out.SvcLvl = *r.SvcLvl
+	}
+	if r.LclInstrm != nil {
+		out.LclInstrm = new(LocalInstrument2Choice)
+		*out.LclInstrm = *r.LclInstrm
+	}
+	if r.SeqTp != nil {
+		out.SeqTp = new(SequenceType3Code)
+		*out.SeqTp = *r.SeqTp
+	}
+	if r.CtgyPurp != nil {
+		out.CtgyPurp = new(CategoryPurpose1Choice)
+		*out.CtgyPurp = *r.CtgyPurp
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *PersonIdentification13) DeepCopy() *PersonIdentification13 {
+	if r == nil {
+		return nil
+	}
+	out := new(PersonIdentification13)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *PersonIdentification13) DeepCopyInto(out *PersonIdentification13) {
+	*out = *r
+	if r.DtAndPlcOfBirth != nil {
+		out.DtAndPlcOfBirth = new(DateAndPlaceOfBirth1)
+		r.DtAndPlcOfBirth.DeepCopyInto(out.DtAndPlcOfBirth)
+	}
+	if r.Othr != nil {
+		out.Othr = make([]GenericPersonIdentification1, len(r.Othr))
+		for i := range r.Othr {
+			r.Othr[i].DeepCopyInto(&out.Othr[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *PersonIdentification5) DeepCopy() *PersonIdentification5 {
+	if r == nil {
+		return nil
+	}
+	out := new(PersonIdentification5)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *PersonIdentification5) DeepCopyInto(out *PersonIdentification5) {
+	*out = *r
+	if r.DtAndPlcOfBirth != nil {
+		out.DtAndPlcOfBirth = new(DateAndPlaceOfBirth)
+		r.DtAndPlcOfBirth.DeepCopyInto(out.DtAndPlcOfBirth)
+	}
+	if r.Othr != nil {
+		out.Othr = make([]GenericPersonIdentification1, len(r.Othr))
+		for i := range r.Othr {
+			r.Othr[i].DeepCopyInto(&out.Othr[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *PersonIdentificationSchemeName1Choice) DeepCopy() *PersonIdentificationSchemeName1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(PersonIdentificationSchemeName1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *PersonIdentificationSchemeName1Choice) DeepCopyInto(out *PersonIdentificationSchemeName1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *PostalAddress24) DeepCopy() *PostalAddress24 {
+	if r == nil {
+		return nil
+	}
+	out := new(PostalAddress24)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *PostalAddress24) DeepCopyInto(out *PostalAddress24) {
+	*out = *r
+	if r.AdrTp != nil {
+		out.AdrTp = new(AddressType3Choice)
+		r.AdrTp.DeepCopyInto(out.AdrTp)
+	}
+	if r.Dept != nil {
+		out.Dept = new(common.Max70Text)
+		*out.Dept = *r.Dept
+	}
+	if r.SubDept != nil {
+		out.SubDept = new(common.Max70Text)
+		*out.SubDept = *r.SubDept
+	}
+	if r.StrtNm != nil {
+		out.StrtNm = new(common.Max70Text)
+		*out.StrtNm = *r.StrtNm
+	}
+	if r.BldgNb != nil {
+		out.BldgNb = new(common.Max16Text)
+		*out.BldgNb = *r.BldgNb
+	}
+	if r.BldgNm != nil {
+		out.BldgNm = new(common.Max35Text)
+		*out.BldgNm = *r.BldgNm
+	}
+	if r.Flr != nil {
+		out.Flr = new(common.Max70Text)
+		*out.Flr = *r.Flr
+	}
+	if r.PstBx != nil {
+		out.PstBx = new(common.Max16Text)
+		*out.PstBx = *r.PstBx
+	}
+	if r.Room != nil {
+		out.Room = new(common.Max70Text)
+		*out.Room = *r.Room
+	}
+	if r.PstCd != nil {
+		out.PstCd = new(common.Max16Text)
+		*out.PstCd = *r.PstCd
+	}
+	if r.TwnNm != nil {
+		out.TwnNm = new(common.Max35Text)
+		*out.TwnNm = *r.TwnNm
+	}
+	if r.TwnLctnNm != nil {
+		out.TwnLctnNm = new(common.Max35Text)
+		*out.TwnLctnNm = *r.TwnLctnNm
+	}
+	if r.DstrctNm != nil {
+		out.DstrctNm = new(common.Max35Text)
+		*out.DstrctNm = *r.DstrctNm
+	}
+	if r.CtrySubDvsn != nil {
+		out.CtrySubDvsn = new(common.Max35Text)
+		*out.CtrySubDvsn = *r.CtrySubDvsn
+	}
+	if r.Ctry != nil {
+		out.Ctry = new(common.CountryCode)
+		*out.Ctry = *r.Ctry
+	}
+	if r.AdrLine != nil {
+		out.AdrLine = make([]common.Max70Text, len(r.AdrLine))
+		copy(out.AdrLine, r.AdrLine)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *PostalAddress6) DeepCopy() *PostalAddress6 {
+	if r == nil {
+		return nil
+	}
+	out := new(PostalAddress6)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *PostalAddress6) DeepCopyInto(out *PostalAddress6) {
+	*out = *r
+	if r.AdrTp != nil {
+		out.AdrTp = new(common.AddressType2Code)
+		*out.AdrTp = *r.AdrTp
+	}
+	if r.Dept != nil {
+		out.Dept = new(common.Max70Text)
+		*out.Dept = *r.Dept
+	}
+	if r.SubDept != nil {
+		out.SubDept = new(common.Max70Text)
+		*out.SubDept = *r.SubDept
+	}
+	if r.StrtNm != nil {
+		out.StrtNm = new(common.Max70Text)
+		*out.StrtNm = *r.StrtNm
+	}
+	if r.BldgNb != nil {
+		out.BldgNb = new(common.Max16Text)
+		*out.BldgNb = *r.BldgNb
+	}
+	if r.PstCd != nil {
+		out.PstCd = new(common.Max16Text)
+		*out.PstCd = *r.PstCd
+	}
+	if r.TwnNm != nil {
+		out.TwnNm = new(common.Max35Text)
+		*out.TwnNm = *r.TwnNm
+	}
+	if r.CtrySubDvsn != nil {
+		out.CtrySubDvsn = new(common.Max35Text)
+		*out.CtrySubDvsn = *r.CtrySubDvsn
+	}
+	if r.Ctry != nil {
+		out.Ctry = new(common.CountryCode)
+		*out.Ctry = *r.Ctry
+	}
+	if r.AdrLine != nil {
+		out.AdrLine = make([]common.Max70Text, len(r.AdrLine))
+		copy(out.AdrLine, r.AdrLine)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ProprietaryData6) DeepCopy() *ProprietaryData6 {
+	if r == nil {
+		return nil
+	}
+	out := new(ProprietaryData6)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ProprietaryData6) DeepCopyInto(out *ProprietaryData6) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ProprietaryData7) DeepCopy() *ProprietaryData7 {
+	if r == nil {
+		return nil
+	}
+	out := new(ProprietaryData7)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ProprietaryData7) DeepCopyInto(out *ProprietaryData7) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ProxyAccountIdentification1) DeepCopy() *ProxyAccountIdentification1 {
+	if r == nil {
+		return nil
+	}
+	out := new(ProxyAccountIdentification1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ProxyAccountIdentification1) DeepCopyInto(out *ProxyAccountIdentification1) {
+	*out = *r
+	if r.Tp != nil {
+		out.Tp = new(ProxyAccountType1Choice)
+		*out.Tp = *r.Tp
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ProxyAccountType1Choice) DeepCopy() *ProxyAccountType1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(ProxyAccountType1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ProxyAccountType1Choice) DeepCopyInto(out *ProxyAccountType1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *Purpose2Choice) DeepCopy() *Purpose2Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(Purpose2Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *Purpose2Choice) DeepCopyInto(out *Purpose2Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ReferredDocumentInformation7) DeepCopy() *ReferredDocumentInformation7 {
+	if r == nil {
+		return nil
+	}
+	out := new(ReferredDocumentInformation7)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ReferredDocumentInformation7) DeepCopyInto(out *ReferredDocumentInformation7) {
+	*out = *r
+	if r.Tp != nil {
+		out.Tp = new(ReferredDocumentType4)
+		r.Tp.DeepCopyInto(out.Tp)
+	}
+	if r.Nb != nil {
+		out.Nb = new(common.Max35Text)
+		*out.Nb = *r.Nb
+	}
+	if r.RltdDt != nil {
+		out.RltdDt = new(common.ISODate)
+		*out.RltdDt = *r.RltdDt
+	}
+	if r.LineDtls != nil {
+		out.LineDtls = make([]DocumentLineInformation1, len(r.LineDtls))
+		for i := range r.LineDtls {
+			r.LineDtls[i].DeepCopyInto(&out.LineDtls[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ReferredDocumentType3Choice) DeepCopy() *ReferredDocumentType3Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(ReferredDocumentType3Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ReferredDocumentType3Choice) DeepCopyInto(out *ReferredDocumentType3Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ReferredDocumentType4) DeepCopy() *ReferredDocumentType4 {
+	if r == nil {
+		return nil
+	}
+	out := new(ReferredDocumentType4)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ReferredDocumentType4) DeepCopyInto(out *ReferredDocumentType4) {
+	*out = *r
+	if r.Issr != nil {
+		out.Issr = new(common.Max35Text)
+		*out.Issr = *r.Issr
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *RejectInvestigationV06) DeepCopy() *RejectInvestigationV06 {
+	if r == nil {
+		return nil
+	}
+	out := new(RejectInvestigationV06)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *RejectInvestigationV06) DeepCopyInto(out *RejectInvestigationV06) {
+	*out = *r
+	r.Assgnmt.DeepCopyInto(&out.Assgnmt)
+	if r.Case != nil {
+		out.Case = new(Case5)
+		r.Case.DeepCopyInto(out.Case)
+	}
+	if r.SplmtryData != nil {
+		out.SplmtryData = make([]SupplementaryData1, len(r.SplmtryData))
+		for i := range r.SplmtryData {
+			r.SplmtryData[i].DeepCopyInto(&out.SplmtryData[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *RemittanceAmount2) DeepCopy() *RemittanceAmount2 {
+	if r == nil {
+		return nil
+	}
+	out := new(RemittanceAmount2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *RemittanceAmount2) DeepCopyInto(out *RemittanceAmount2) {
+	*out = *r
+	if r.DuePyblAmt != nil {
+		out.DuePyblAmt = new(ActiveOrHistoricCurrencyAndAmount)
+		*out.DuePyblAmt = *r.DuePyblAmt
+	}
+	if r.DscntApldAmt != nil {
+		out.DscntApldAmt = make([]DiscountAmountAndType1, len(r.DscntApldAmt))
+		for i := range r.DscntApldAmt {
+			r.DscntApldAmt[i].DeepCopyInto(&out.DscntApldAmt[i])
+		}
+	}
+	if r.CdtNoteAmt != nil {
+		out.CdtNoteAmt = new(ActiveOrHistoricCurrencyAndAmount)
+		*out.CdtNoteAmt = *r.CdtNoteAmt
+	}
+	if r.TaxAmt != nil {
+		out.TaxAmt = make([]TaxAmountAndType1, len(r.TaxAmt))
+		for i := range r.TaxAmt {
+			r.TaxAmt[i].DeepCopyInto(&out.TaxAmt[i])
+		}
+	}
+	if r.AdjstmntAmtAndRsn != nil {
+		out.AdjstmntAmtAndRsn = make([]DocumentAdjustment1, len(r.AdjstmntAmtAndRsn))
+		for i := range r.AdjstmntAmtAndRsn {
+			r.AdjstmntAmtAndRsn[i].DeepCopyInto(&out.AdjstmntAmtAndRsn[i])
+		}
+	}
+	if r.RmtdAmt != nil {
+		out.RmtdAmt = new(ActiveOrHistoricCurrencyAndAmount)
+		*out.RmtdAmt = *r.RmtdAmt
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *RemittanceAmount3) DeepCopy() *RemittanceAmount3 {
+	if r == nil {
+		return nil
+	}
+	out := new(RemittanceAmount3)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *RemittanceAmount3) DeepCopyInto(out *RemittanceAmount3) {
+	*out = *r
+	if r.DuePyblAmt != nil {
+		out.DuePyblAmt = new(ActiveOrHistoricCurrencyAndAmount)
+		*out.DuePyblAmt = *r.DuePyblAmt
+	}
+	if r.DscntApldAmt != nil {
+		out.DscntApldAmt = make([]DiscountAmountAndType1, len(r.DscntApldAmt))
+		for i := range r.DscntApldAmt {
+			r.DscntApldAmt[i].DeepCopyInto(&out.DscntApldAmt[i])
+		}
+	}
+	if r.CdtNoteAmt != nil {
+		out.CdtNoteAmt = new(ActiveOrHistoricCurrencyAndAmount)
+		*out.CdtNoteAmt = *r.CdtNoteAmt
+	}
+	if r.TaxAmt != nil {
+		out.TaxAmt = make([]TaxAmountAndType1, len(r.TaxAmt))
+		for i := range r.TaxAmt {
+			r.TaxAmt[i].DeepCopyInto(&out.TaxAmt[i])
+		}
+	}
+	if r.AdjstmntAmtAndRsn != nil {
+		out.AdjstmntAmtAndRsn = make([]DocumentAdjustment1, len(r.AdjstmntAmtAndRsn))
+		for i := range r.AdjstmntAmtAndRsn {
+			r.AdjstmntAmtAndRsn[i].DeepCopyInto(&out.AdjstmntAmtAndRsn[i])
+		}
+	}
+	if r.RmtdAmt != nil {
+		out.RmtdAmt = new(ActiveOrHistoricCurrencyAndAmount)
+		*out.RmtdAmt = *r.RmtdAmt
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *RemittanceInformation11) DeepCopy() *RemittanceInformation11 {
+	if r == nil {
+		return nil
+	}
+	out := new(RemittanceInformation11)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *RemittanceInformation11) DeepCopyInto(out *RemittanceInformation11) {
+	*out = *r
+	if r.Ustrd != nil {
+		out.Ustrd = make([]common.Max140Text, len(r.Ustrd))
+		copy(out.Ustrd, r.Ustrd)
+	}
+	if r.Strd != nil {
+		out.Strd = make([]StructuredRemittanceInformation13, len(r.Strd))
+		for i := range r.Strd {
+			r.Strd[i].DeepCopyInto(&out.Strd[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *RemittanceInformation16) DeepCopy() *RemittanceInformation16 {
+	if r == nil {
+		return nil
+	}
+	out := new(RemittanceInformation16)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *RemittanceInformation16) DeepCopyInto(out *RemittanceInformation16) {
+	*out = *r
+	if r.Ustrd != nil {
+		out.Ustrd = make([]common.Max140Text, len(r.Ustrd))
+		copy(out.Ustrd, r.Ustrd)
+	}
+	if r.Strd != nil {
+		out.Strd = make([]StructuredRemittanceInformation16, len(r.Strd))
+		for i := range r.Strd {
+			r.Strd[i].DeepCopyInto(&out.Strd[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *RemittanceLocation7) DeepCopy() *RemittanceLocation7 {
+	if r == nil {
+		return nil
+	}
+	out := new(RemittanceLocation7)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *RemittanceLocation7) DeepCopyInto(out *RemittanceLocation7) {
+	*out = *r
+	if r.RmtId != nil {
+		out.RmtId = new(common.Max35Text)
+		*out.RmtId = *r.RmtId
+	}
+	if r.RmtLctnDtls != nil {
+		out.RmtLctnDtls = make([]RemittanceLocationData1, len(r.RmtLctnDtls))
+		for i := range r.RmtLctnDtls {
+			r.RmtLctnDtls[i].DeepCopyInto(&out.RmtLctnDtls[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *RemittanceLocationData1) DeepCopy() *RemittanceLocationData1 {
+	if r == nil {
+		return nil
+	}
+	out := new(RemittanceLocationData1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *RemittanceLocationData1) DeepCopyInto(out *RemittanceLocationData1) {
+	*out = *r
+	if r.ElctrncAdr != nil {
+		out.ElctrncAdr = new(common.Max2048Text)
+		*out.ElctrncAdr = *r.ElctrncAdr
+	}
+	if r.PstlAdr != nil {
+		out.PstlAdr = new(NameAndAddress16)
+		r.PstlAdr.DeepCopyInto(out.PstlAdr)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *RequestForDuplicateV06) DeepCopy() *RequestForDuplicateV06 {
+	if r == nil {
+		return nil
+	}
+	out := new(RequestForDuplicateV06)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *RequestForDuplicateV06) DeepCopyInto(out *RequestForDuplicateV06) {
+	*out = *r
+	r.Assgnmt.DeepCopyInto(&out.Assgnmt)
+	if r.Case != nil {
+		out.Case = new(Case5)
+		r.Case.DeepCopyInto(out.Case)
+	}
+	if r.SplmtryData != nil {
+		out.SplmtryData = make([]SupplementaryData1, len(r.SplmtryData))
+		for i := range r.SplmtryData {
+			r.SplmtryData[i].DeepCopyInto(&out.SplmtryData[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *RequestType4Choice) DeepCopy() *RequestType4Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(RequestType4Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *RequestType4Choice) DeepCopyInto(out *RequestType4Choice) {
+	*out = *r
+	r.Prtry.DeepCopyInto(&out.Prtry)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *Reservation3) DeepCopy() *Reservation3 {
+	if r == nil {
+		return nil
+	}
+	out := new(Reservation3)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *Reservation3) DeepCopyInto(out *Reservation3) {
+	*out = *r
+	if r.Sts != nil {
+		out.Sts = new(ReservationStatus1Choice)
+		*out.Sts = *r.Sts
+	}
+	if r.StartDtTm != nil {
+		out.StartDtTm = new(DateAndDateTime2Choice)
+		*out.StartDtTm = *r.StartDtTm
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ReservationIdentification2) DeepCopy() *ReservationIdentification2 {
+	if r == nil {
+		return nil
+	}
+	out := new(ReservationIdentification2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ReservationIdentification2) DeepCopyInto(out *ReservationIdentification2) {
+	*out = *r
+	if r.RsvatnId != nil {
+		out.RsvatnId = new(common.Max35Text)
+		*out.RsvatnId = *r.RsvatnId
+	}
+	if r.SysId != nil {
+		out.SysId = new(SystemIdentification2Choice)
+		*out.SysId = *r.SysId
+	}
+	if r.AcctOwnr != nil {
+		out.AcctOwnr = new(BranchAndFinancialInstitutionIdentification6)
+		r.AcctOwnr.DeepCopyInto(out.AcctOwnr)
+	}
+	if r.AcctId != nil {
+		out.AcctId = new(AccountIdentification4Choice)
+		r.AcctId.DeepCopyInto(out.AcctId)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ReservationOrError8Choice) DeepCopy() *ReservationOrError8Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(ReservationOrError8Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ReservationOrError8Choice) DeepCopyInto(out *ReservationOrError8Choice) {
+	*out = *r
+	r.BizRpt.DeepCopyInto(&out.BizRpt)
+	if r.OprlErr != nil {
+		out.OprlErr = make([]ErrorHandling5, len(r.OprlErr))
+		for i := range r.OprlErr {
+			r.OprlErr[i].DeepCopyInto(&out.OprlErr[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ReservationOrError9Choice) DeepCopy() *ReservationOrError9Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(ReservationOrError9Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ReservationOrError9Choice) DeepCopyInto(out *ReservationOrError9Choice) {
+	*out = *r
+	r.Rsvatn.DeepCopyInto(&out.Rsvatn)
+	if r.BizErr != nil {
+		out.BizErr = make([]ErrorHandling5, len(r.BizErr))
+		for i := range r.BizErr {
+			r.BizErr[i].DeepCopyInto(&out.BizErr[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ReservationReport6) DeepCopy() *ReservationReport6 {
+	if r == nil {
+		return nil
+	}
+	out := new(ReservationReport6)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ReservationReport6) DeepCopyInto(out *ReservationReport6) {
+	*out = *r
+	r.RsvatnId.DeepCopyInto(&out.RsvatnId)
+	r.RsvatnOrErr.DeepCopyInto(&out.RsvatnOrErr)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ReservationStatus1Choice) DeepCopy() *ReservationStatus1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(ReservationStatus1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ReservationStatus1Choice) DeepCopyInto(out *ReservationStatus1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ReservationType1Choice) DeepCopy() *ReservationType1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(ReservationType1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ReservationType1Choice) DeepCopyInto(out *ReservationType1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ResolutionInformation1) DeepCopy() *ResolutionInformation1 {
+	if r == nil {
+		return nil
+	}
+	out := new(ResolutionInformation1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ResolutionInformation1) DeepCopyInto(out *ResolutionInformation1) {
+	*out = *r
+	if r.IntrBkSttlmAmt != nil {
+		out.IntrBkSttlmAmt = new(ActiveOrHistoricCurrencyAndAmount)
+		*out.IntrBkSttlmAmt = *r.IntrBkSttlmAmt
+	}
+	if r.IntrBkSttlmDt != nil {
+		out.IntrBkSttlmDt = new(common.ISODate)
+		*out.IntrBkSttlmDt = *r.IntrBkSttlmDt
+	}
+	if r.ClrChanl != nil {
+		out.ClrChanl = new(ClearingChannel2Code)
+		*out.ClrChanl = *r.ClrChanl
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ResolutionOfInvestigationV06) DeepCopy() *ResolutionOfInvestigationV06 {
+	if r == nil {
+		return nil
+	}
+	out := new(ResolutionOfInvestigationV06)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ResolutionOfInvestigationV06) DeepCopyInto(out *ResolutionOfInvestigationV06) {
+	*out = *r
+	r.Assgnmt.DeepCopyInto(&out.Assgnmt)
+	if r.RslvdCase != nil {
+		out.RslvdCase = new(Case3)
+		r.RslvdCase.DeepCopyInto(out.RslvdCase)
+	}
+	r.Sts.DeepCopyInto(&out.Sts)
+	if r.CxlDtls != nil {
+		out.CxlDtls = make([]UnderlyingTransaction14, len(r.CxlDtls))
+		for i := range r.CxlDtls {
+			r.CxlDtls[i].DeepCopyInto(&out.CxlDtls[i])
+		}
+	}
+	if r.StmtDtls != nil {
+		out.StmtDtls = new(StatementResolutionEntry2)
+		r.StmtDtls.DeepCopyInto(out.StmtDtls)
+	}
+	if r.CrrctnTx != nil {
+		out.CrrctnTx = new(CorrectiveTransaction1Choice)
+		r.CrrctnTx.DeepCopyInto(out.CrrctnTx)
+	}
+	if r.RsltnRltdInf != nil {
+		out.RsltnRltdInf = new(ResolutionInformation1)
+		r.RsltnRltdInf.DeepCopyInto(out.RsltnRltdInf)
+	}
+	if r.SplmtryData != nil {
+		out.SplmtryData = make([]SupplementaryData1, len(r.SplmtryData))
+		for i := range r.SplmtryData {
+			r.SplmtryData[i].DeepCopyInto(&out.SplmtryData[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ReturnGeneralBusinessInformationV06) DeepCopy() *ReturnGeneralBusinessInformationV06 {
+	if r == nil {
+		return nil
+	}
+	out := new(ReturnGeneralBusinessInformationV06)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ReturnGeneralBusinessInformationV06) DeepCopyInto(out *ReturnGeneralBusinessInformationV06) {
+	*out = *r
+	r.MsgHdr.DeepCopyInto(&out.MsgHdr)
+	r.RptOrErr.DeepCopyInto(&out.RptOrErr)
+	if r.SplmtryData != nil {
+		out.SplmtryData = make([]SupplementaryData1, len(r.SplmtryData))
+		for i := range r.SplmtryData {
+			r.SplmtryData[i].DeepCopyInto(&out.SplmtryData[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ReturnReservationV06) DeepCopy() *ReturnReservationV06 {
+	if r == nil {
+		return nil
+	}
+	out := new(ReturnReservationV06)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ReturnReservationV06) DeepCopyInto(out *ReturnReservationV06) {
+	*out = *r
+	r.MsgHdr.DeepCopyInto(&out.MsgHdr)
+	r.RptOrErr.DeepCopyInto(&out.RptOrErr)
+	if r.SplmtryData != nil {
+		out.SplmtryData = make([]SupplementaryData1, len(r.SplmtryData))
+		for i := range r.SplmtryData {
+			r.SplmtryData[i].DeepCopyInto(&out.SplmtryData[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ServiceLevel8Choice) DeepCopy() *ServiceLevel8Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(ServiceLevel8Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ServiceLevel8Choice) DeepCopyInto(out *ServiceLevel8Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *SettlementInstruction4) DeepCopy() *SettlementInstruction4 {
+	if r == nil {
+		return nil
+	}
+	out := new(SettlementInstruction4)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *SettlementInstruction4) DeepCopyInto(out *SettlementInstruction4) {
+	*out = *r
+	if r.SttlmAcct != nil {
+		out.SttlmAcct = new(CashAccount24)
+		r.SttlmAcct.DeepCopyInto(out.SttlmAcct)
+	}
+	if r.ClrSys != nil {
+		out.ClrSys = new(ClearingSystemIdentification3Choice)
+		*out.ClrSys = *r.ClrSys
+	}
+	if r.InstgRmbrsmntAgt != nil {
+		out.InstgRmbrsmntAgt = new(BranchAndFinancialInstitutionIdentification5)
+		r.InstgRmbrsmntAgt.DeepCopyInto(out.InstgRmbrsmntAgt)
+	}
+	if r.InstgRmbrsmntAgtAcct != nil {
+		out.InstgRmbrsmntAgtAcct = new(CashAccount24)
+		r.InstgRmbrsmntAgtAcct.DeepCopyInto(out.InstgRmbrsmntAgtAcct)
+	}
+	if r.InstdRmbrsmntAgt != nil {
+		out.InstdRmbrsmntAgt = new(BranchAndFinancialInstitutionIdentification5)
+		r.InstdRmbrsmntAgt.DeepCopyInto(out.InstdRmbrsmntAgt)
+	}
+	if r.InstdRmbrsmntAgtAcct != nil {
+		out.InstdRmbrsmntAgtAcct = new(CashAccount24)
+		r.InstdRmbrsmntAgtAcct.DeepCopyInto(out.InstdRmbrsmntAgtAcct)
+	}
+	if r.ThrdRmbrsmntAgt != nil {
+		out.ThrdRmbrsmntAgt = new(BranchAndFinancialInstitutionIdentification5)
+		r.ThrdRmbrsmntAgt.DeepCopyInto(out.ThrdRmbrsmntAgt)
+	}
+	if r.ThrdRmbrsmntAgtAcct != nil {
+		out.ThrdRmbrsmntAgtAcct = new(CashAccount24)
+		r.ThrdRmbrsmntAgtAcct.DeepCopyInto(out.ThrdRmbrsmntAgtAcct)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *SkipPayload) DeepCopy() *SkipPayload {
+	if r == nil {
+		return nil
+	}
+	out := new(SkipPayload)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *SkipPayload) DeepCopyInto(out *SkipPayload) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *StandingOrder7) DeepCopy() *StandingOrder7 {
+	if r == nil {
+		return nil
+	}
+	out := new(StandingOrder7)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *StandingOrder7) DeepCopyInto(out *StandingOrder7) {
+	*out = *r
+	if r.Amt != nil {
+		out.Amt = new(Amount2Choice)
+		*out.Amt = *r.Amt
+	}
+	if r.Cdtr != nil {
+		out.Cdtr = new(BranchAndFinancialInstitutionIdentification6)
+		r.Cdtr.DeepCopyInto(out.Cdtr)
+	}
+	if r.CdtrAcct != nil {
+		out.CdtrAcct = new(CashAccount38)
+		r.CdtrAcct.DeepCopyInto(out.CdtrAcct)
+	}
+	if r.Dbtr != nil {
+		out.Dbtr = new(BranchAndFinancialInstitutionIdentification6)
+		r.Dbtr.DeepCopyInto(out.Dbtr)
+	}
+	if r.DbtrAcct != nil {
+		out.DbtrAcct = new(CashAccount38)
+		r.DbtrAcct.DeepCopyInto(out.DbtrAcct)
+	}
+	if r.ExctnTp != nil {
+		out.ExctnTp = new(ExecutionType1Choice)
+		*out.ExctnTp = *r.ExctnTp
+	}
+	if r.Frqcy != nil {
+		out.Frqcy = new(Frequency2Code)
+		*out.Frqcy = *r.Frqcy
+	}
+	if r.VldtyPrd != nil {
+		out.VldtyPrd = new(DatePeriod2Choice)
+		*out.VldtyPrd = *r.VldtyPrd
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *StandingOrderIdentification4) DeepCopy() *StandingOrderIdentification4 {
+	if r == nil {
+		return nil
+	}
+	out := new(StandingOrderIdentification4)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *StandingOrderIdentification4) DeepCopyInto(out *StandingOrderIdentification4) {
+	*out = *r
+	if r.Id != nil {
+		out.Id = new(common.Max35Text)
+		*out.Id = *r.Id
+	}
+	r.Acct.DeepCopyInto(&out.Acct)
+	if r.AcctOwnr != nil {
+		out.AcctOwnr = new(BranchAndFinancialInstitutionIdentification6)
+		r.AcctOwnr.DeepCopyInto(out.AcctOwnr)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *StatementResolutionEntry2) DeepCopy() *StatementResolutionEntry2 {
+	if r == nil {
+		return nil
+	}
+	out := new(StatementResolutionEntry2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *StatementResolutionEntry2) DeepCopyInto(out *StatementResolutionEntry2) {
+	*out = *r
+	if r.OrgnlGrpInf != nil {
+		out.OrgnlGrpInf = new(OriginalGroupInformation3)
+		r.OrgnlGrpInf.DeepCopyInto(out.OrgnlGrpInf)
+	}
+	if r.OrgnlStmtId != nil {
+		out.OrgnlStmtId = new(common.Max35Text)
+		*out.OrgnlStmtId = *r.OrgnlStmtId
+	}
+	if r.AcctSvcrRef != nil {
+		out.AcctSvcrRef = new(common.Max35Text)
+		*out.AcctSvcrRef = *r.AcctSvcrRef
+	}
+	if r.CrrctdAmt != nil {
+		out.CrrctdAmt = new(ActiveOrHistoricCurrencyAndAmount)
+		*out.CrrctdAmt = *r.CrrctdAmt
+	}
+	if r.Chrgs != nil {
+		out.Chrgs = make([]Charges3, len(r.Chrgs))
+		for i := range r.Chrgs {
+			r.Chrgs[i].DeepCopyInto(&out.Chrgs[i])
+		}
+	}
+	if r.Purp != nil {
+		out.Purp = new(Purpose2Choice)
+		*out.Purp = *r.Purp
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *StructuredRemittanceInformation13) DeepCopy() *StructuredRemittanceInformation13 {
+	if r == nil {
+		return nil
+	}
+	out := new(StructuredRemittanceInformation13)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *StructuredRemittanceInformation13) DeepCopyInto(out *StructuredRemittanceInformation13) {
+	*out = *r
+	if r.RfrdDocInf != nil {
+		out.RfrdDocInf = make([]ReferredDocumentInformation7, len(r.RfrdDocInf))
+		for i := range r.RfrdDocInf {
+			r.RfrdDocInf[i].DeepCopyInto(&out.RfrdDocInf[i])
+		}
+	}
+	if r.RfrdDocAmt != nil {
+		out.RfrdDocAmt = new(RemittanceAmount2)
+		r.RfrdDocAmt.DeepCopyInto(out.RfrdDocAmt)
+	}
+	if r.CdtrRefInf != nil {
+		out.CdtrRefInf = new(CreditorReferenceInformation2)
+		r.CdtrRefInf.DeepCopyInto(out.CdtrRefInf)
+	}
+	if r.Invcr != nil {
+		out.Invcr = new(PartyIdentification43)
+		r.Invcr.DeepCopyInto(out.Invcr)
+	}
+	if r.Invcee != nil {
+		out.Invcee = new(PartyIdentification43)
+		r.Invcee.DeepCopyInto(out.Invcee)
+	}
+	if r.TaxRmt != nil {
+		out.TaxRmt = new(TaxInformation4)
+		r.TaxRmt.DeepCopyInto(out.TaxRmt)
+	}
+	if r.GrnshmtRmt != nil {
+		out.GrnshmtRmt = new(Garnishment1)
+		r.GrnshmtRmt.DeepCopyInto(out.GrnshmtRmt)
+	}
+	if r.AddtlRmtInf != nil {
+		out.AddtlRmtInf = make([]common.Max140Text, len(r.AddtlRmtInf))
+		copy(out.AddtlRmtInf, r.AddtlRmtInf)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *StructuredRemittanceInformation16) DeepCopy() *StructuredRemittanceInformation16 {
+	if r == nil {
+		return nil
+	}
+	out := new(StructuredRemittanceInformation16)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *StructuredRemittanceInformation16) DeepCopyInto(out *StructuredRemittanceInformation16) {
+	*out = *r
+	if r.RfrdDocInf != nil {
+		out.RfrdDocInf = make([]ReferredDocumentInformation7, len(r.RfrdDocInf))
+		for i := range r.RfrdDocInf {
+			r.RfrdDocInf[i].DeepCopyInto(&out.RfrdDocInf[i])
+		}
+	}
+	if r.RfrdDocAmt != nil {
+		out.RfrdDocAmt = new(RemittanceAmount2)
+		r.RfrdDocAmt.DeepCopyInto(out.RfrdDocAmt)
+	}
+	if r.CdtrRefInf != nil {
+		out.CdtrRefInf = new(CreditorReferenceInformation2)
+		r.CdtrRefInf.DeepCopyInto(out.CdtrRefInf)
+	}
+	if r.Invcr != nil {
+		out.Invcr = new(PartyIdentification135)
+		r.Invcr.DeepCopyInto(out.Invcr)
+	}
+	if r.Invcee != nil {
+		out.Invcee = new(PartyIdentification135)
+		r.Invcee.DeepCopyInto(out.Invcee)
+	}
+	if r.TaxRmt != nil {
+		out.TaxRmt = new(TaxInformation7)
+		r.TaxRmt.DeepCopyInto(out.TaxRmt)
+	}
+	if r.GrnshmtRmt != nil {
+		out.GrnshmtRmt = new(Garnishment3)
+		r.GrnshmtRmt.DeepCopyInto(out.GrnshmtRmt)
+	}
+	if r.AddtlRmtInf != nil {
+		out.AddtlRmtInf = make([]common.Max140Text, len(r.AddtlRmtInf))
+		copy(out.AddtlRmtInf, r.AddtlRmtInf)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *SupplementaryData1) DeepCopy() *SupplementaryData1 {
+	if r == nil {
+		return nil
+	}
+	out := new(SupplementaryData1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *SupplementaryData1) DeepCopyInto(out *SupplementaryData1) {
+	*out = *r
+	if r.PlcAndNm != nil {
+		out.PlcAndNm = new(common.Max350Text)
+		*out.PlcAndNm = *r.PlcAndNm
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *SupplementaryDataEnvelope1) DeepCopy() *SupplementaryDataEnvelope1 {
+	if r == nil {
+		return nil
+	}
+	out := new(SupplementaryDataEnvelope1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *SupplementaryDataEnvelope1) DeepCopyInto(out *SupplementaryDataEnvelope1) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *SystemIdentification2Choice) DeepCopy() *SystemIdentification2Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(SystemIdentification2Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *SystemIdentification2Choice) DeepCopyInto(out *SystemIdentification2Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *TaxAmount1) DeepCopy() *TaxAmount1 {
+	if r == nil {
+		return nil
+	}
+	out := new(TaxAmount1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *TaxAmount1) DeepCopyInto(out *TaxAmount1) {
+	*out = *r
+	if r.TaxblBaseAmt != nil {
+		out.TaxblBaseAmt = new(ActiveOrHistoricCurrencyAndAmount)
+		*out.TaxblBaseAmt = *r.TaxblBaseAmt
+	}
+	if r.TtlAmt != nil {
+		out.TtlAmt = new(ActiveOrHistoricCurrencyAndAmount)
+		*out.TtlAmt = *r.TtlAmt
+	}
+	if r.Dtls != nil {
+		out.Dtls = make([]TaxRecordDetails1, len(r.Dtls))
+		for i := range r.Dtls {
+			r.Dtls[i].DeepCopyInto(&out.Dtls[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *TaxAmount2) DeepCopy() *TaxAmount2 {
+	if r == nil {
+		return nil
+	}
+	out := new(TaxAmount2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *TaxAmount2) DeepCopyInto(out *TaxAmount2) {
+	*out = *r
+	if r.TaxblBaseAmt != nil {
+		out.TaxblBaseAmt = new(ActiveOrHistoricCurrencyAndAmount)
+		*out.TaxblBaseAmt = *r.TaxblBaseAmt
+	}
+	if r.TtlAmt != nil {
+		out.TtlAmt = new(ActiveOrHistoricCurrencyAndAmount)
+		*out.TtlAmt = *r.TtlAmt
+	}
+	if r.Dtls != nil {
+		out.Dtls = make([]TaxRecordDetails2, len(r.Dtls))
+		for i := range r.Dtls {
+			r.Dtls[i].DeepCopyInto(&out.Dtls[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *TaxAmountAndType1) DeepCopy() *TaxAmountAndType1 {
+	if r == nil {
+		return nil
+	}
+	out := new(TaxAmountAndType1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *TaxAmountAndType1) DeepCopyInto(out *TaxAmountAndType1) {
+	*out = *r
+	if r.Tp != nil {
+		out.Tp = new(TaxAmountType1Choice)
+		*out.Tp = *r.Tp
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *TaxAmountType1Choice) DeepCopy() *TaxAmountType1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(TaxAmountType1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *TaxAmountType1Choice) DeepCopyInto(out *TaxAmountType1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *TaxAuthorisation1) DeepCopy() *TaxAuthorisation1 {
+	if r == nil {
+		return nil
+	}
+	out := new(TaxAuthorisation1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *TaxAuthorisation1) DeepCopyInto(out *TaxAuthorisation1) {
+	*out = *r
+	if r.Titl != nil {
+		out.Titl = new(common.Max35Text)
+		*out.Titl = *r.Titl
+	}
+	if r.Nm != nil {
+		out.Nm = new(common.Max140Text)
+		*out.Nm = *r.Nm
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *TaxCharges2) DeepCopy() *TaxCharges2 {
+	if r == nil {
+		return nil
+	}
+	out := new(TaxCharges2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *TaxCharges2) DeepCopyInto(out *TaxCharges2) {
+	*out = *r
+	if r.Id != nil {
+		out.Id = new(common.Max35Text)
+		*out.Id = *r.Id
+	}
+	if r.Amt != nil {
+		out.Amt = new(ActiveOrHistoricCurrencyAndAmount)
+		*out.Amt = *r.Amt
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *TaxInformation4) DeepCopy() *TaxInformation4 {
+	if r == nil {
+		return nil
+	}
+	out := new(TaxInformation4)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *TaxInformation4) DeepCopyInto(out *TaxInformation4) {
+	*out = *r
+	if r.Cdtr != nil {
+		out.Cdtr = new(TaxParty1)
+		r.Cdtr.DeepCopyInto(out.Cdtr)
+	}
+	if r.Dbtr != nil {
+		out.Dbtr = new(TaxParty2)
+		r.Dbtr.DeepCopyInto(out.Dbtr)
+	}
+	if r.UltmtDbtr != nil {
+		out.UltmtDbtr = new(TaxParty2)
+		r.UltmtDbtr.DeepCopyInto(out.UltmtDbtr)
+	}
+	if r.AdmstnZone != nil {
+		out.AdmstnZone = new(common.Max35Text)
+		*out.AdmstnZone = *r.AdmstnZone
+	}
+	if r.RefNb != nil {
+		out.RefNb = new(common.Max140Text)
+		*out.RefNb = *r.RefNb
+	}
+	if r.Mtd != nil {
+		out.Mtd = new(common.Max35Text)
+		*out.Mtd = *r.Mtd
+	}
+	if r.TtlTaxblBaseAmt != nil {
+		out.TtlTaxblBaseAmt = new(ActiveOrHistoricCurrencyAndAmount)
+		*out.TtlTaxblBaseAmt = *r.TtlTaxblBaseAmt
+	}
+	if r.TtlTaxAmt != nil {
+		out.TtlTaxAmt = new(ActiveOrHistoricCurrencyAndAmount)
+		*out.TtlTaxAmt = *r.TtlTaxAmt
+	}
+	if r.Dt != nil {
+		out.Dt = new(common.ISODate)
+		*out.Dt = *r.Dt
+	}
+	if r.Rcrd != nil {
+		out.Rcrd = make([]TaxRecord1, len(r.Rcrd))
+		for i := range r.Rcrd {
+			r.Rcrd[i].DeepCopyInto(&out.Rcrd[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *TaxInformation7) DeepCopy() *TaxInformation7 {
+	if r == nil {
+		return nil
+	}
+	out := new(TaxInformation7)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *TaxInformation7) DeepCopyInto(out *TaxInformation7) {
+	*out = *r
+	if r.Cdtr != nil {
+		out.Cdtr = new(TaxParty1)
+		r.Cdtr.DeepCopyInto(out.Cdtr)
+	}
+	if r.Dbtr != nil {
+		out.Dbtr = new(TaxParty2)
+		r.Dbtr.DeepCopyInto(out.Dbtr)
+	}
+	if r.UltmtDbtr != nil {
+		out.UltmtDbtr = new(TaxParty2)
+		r.UltmtDbtr.DeepCopyInto(out.UltmtDbtr)
+	}
+	if r.AdmstnZone != nil {
+		out.AdmstnZone = new(common.Max35Text)
+		*out.AdmstnZone = *r.AdmstnZone
+	}
+	if r.RefNb != nil {
+		out.RefNb = new(common.Max140Text)
+		*out.RefNb = *r.RefNb
+	}
+	if r.Mtd != nil {
+		out.Mtd = new(common.Max35Text)
+		*out.Mtd = *r.Mtd
+	}
+	if r.TtlTaxblBaseAmt != nil {
+		out.TtlTaxblBaseAmt = new(ActiveOrHistoricCurrencyAndAmount)
+		*out.TtlTaxblBaseAmt = *r.TtlTaxblBaseAmt
+	}
+	if r.TtlTaxAmt != nil {
+		out.TtlTaxAmt = new(ActiveOrHistoricCurrencyAndAmount)
+		*out.TtlTaxAmt = *r.TtlTaxAmt
+	}
+	if r.Dt != nil {
+		out.Dt = new(common.ISODate)
+		*out.Dt = *r.Dt
+	}
+	if r.Rcrd != nil {
+		out.Rcrd = make([]TaxRecord2, len(r.Rcrd))
+		for i := range r.Rcrd {
+			r.Rcrd[i].DeepCopyInto(&out.Rcrd[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *TaxParty1) DeepCopy() *TaxParty1 {
+	if r == nil {
+		return nil
+	}
+	out := new(TaxParty1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *TaxParty1) DeepCopyInto(out *TaxParty1) {
+	*out = *r
+	if r.TaxId != nil {
+		out.TaxId = new(common.Max35Text)
+		*out.TaxId = *r.TaxId
+	}
+	if r.RegnId != nil {
+		out.RegnId = new(common.Max35Text)
+		*out.RegnId = *r.RegnId
+	}
+	if r.TaxTp != nil {
+		out.TaxTp = new(common.Max35Text)
+		*out.TaxTp = *r.TaxTp
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *TaxParty2) DeepCopy() *TaxParty2 {
+	if r == nil {
+		return nil
+	}
+	out := new(TaxParty2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *TaxParty2) DeepCopyInto(out *TaxParty2) {
+	*out = *r
+	if r.TaxId != nil {
+		out.TaxId = new(common.Max35Text)
+		*out.TaxId = *r.TaxId
+	}
+	if r.RegnId != nil {
+		out.RegnId = new(common.Max35Text)
+		*out.RegnId = *r.RegnId
+	}
+	if r.TaxTp != nil {
+		out.TaxTp = new(common.Max35Text)
+		*out.TaxTp = *r.TaxTp
+	}
+	if r.Authstn != nil {
+		out.Authstn = new(TaxAuthorisation1)
+		r.Authstn.DeepCopyInto(out.Authstn)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *TaxPeriod1) DeepCopy() *TaxPeriod1 {
+	if r == nil {
+		return nil
+	}
+	out := new(TaxPeriod1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *TaxPeriod1) DeepCopyInto(out *TaxPeriod1) {
+	*out = *r
+	if r.Yr != nil {
+		out.Yr = new(common.ISODate)
+		*out.Yr = *r.Yr
+	}
+	if r.Tp != nil {
+		out.Tp = new(TaxRecordPeriod1Code)
+		*out.Tp = *r.Tp
+	}
+	if r.FrToDt != nil {
+		out.FrToDt = new(DatePeriodDetails)
+		*out.FrToDt = *r.FrToDt
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *TaxPeriod2) DeepCopy() *TaxPeriod2 {
+	if r == nil {
+		return nil
+	}
+	out := new(TaxPeriod2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *TaxPeriod2) DeepCopyInto(out *TaxPeriod2) {
+	*out = *r
+	if r.Yr != nil {
+		out.Yr = new(common.ISODate)
+		*out.Yr = *r.Yr
+	}
+	if r.Tp != nil {
+		out.Tp = new(TaxRecordPeriod1Code)
+		*out.Tp = *r.Tp
+	}
+	if r.FrToDt != nil {
+		out.FrToDt = new(DatePeriod2)
+		*out.FrToDt = *r.FrToDt
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *TaxRecord1) DeepCopy() *TaxRecord1 {
+	if r == nil {
+		return nil
+	}
+	out := new(TaxRecord1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *TaxRecord1) DeepCopyInto(out *TaxRecord1) {
+	*out = *r
+	if r.Tp != nil {
+		out.Tp = new(common.Max35Text)
+		*out.Tp = *r.Tp
+	}
+	if r.Ctgy != nil {
+		out.Ctgy = new(common.Max35Text)
+		*out.Ctgy = *r.Ctgy
+	}
+	if r.CtgyDtls != nil {
+		out.CtgyDtls = new(common.Max35Text)
+		*out.CtgyDtls = *r.CtgyDtls
+	}
+	if r.DbtrSts != nil {
+		out.DbtrSts = new(common.Max35Text)
+		*out.DbtrSts = *r.DbtrSts
+	}
+	if r.CertId != nil {
+		out.CertId = new(common.Max35Text)
+		*out.CertId = *r.CertId
+	}
+	if r.FrmsCd != nil {
+		out.FrmsCd = new(common.Max35Text)
+		*out.FrmsCd = *r.FrmsCd
+	}
+	if r.Prd != nil {
+		out.Prd = new(TaxPeriod1)
+		r.Prd.DeepCopyInto(out.Prd)
+	}
+	if r.TaxAmt != nil {
+		out.TaxAmt = new(TaxAmount1)
+		r.TaxAmt.DeepCopyInto(out.TaxAmt)
+	}
+	if r.AddtlInf != nil {
+		out.AddtlInf = new(common.Max140Text)
+		*out.AddtlInf = *r.AddtlInf
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *TaxRecord2) DeepCopy() *TaxRecord2 {
+	if r == nil {
+		return nil
+	}
+	out := new(TaxRecord2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *TaxRecord2) DeepCopyInto(out *TaxRecord2) {
+	*out = *r
+	if r.Tp != nil {
+		out.Tp = new(common.Max35Text)
+		*out.Tp = *r.Tp
+	}
+	if r.Ctgy != nil {
+		out.Ctgy = new(common.Max35Text)
+		*out.Ctgy = *r.Ctgy
+	}
+	if r.CtgyDtls != nil {
+		out.CtgyDtls = new(common.Max35Text)
+		*out.CtgyDtls = *r.CtgyDtls
+	}
+	if r.DbtrSts != nil {
+		out.DbtrSts = new(common.Max35Text)
+		*out.DbtrSts = *r.DbtrSts
+	}
+	if r.CertId != nil {
+		out.CertId = new(common.Max35Text)
+		*out.CertId = *r.CertId
+	}
+	if r.FrmsCd != nil {
+		out.FrmsCd = new(common.Max35Text)
+		*out.FrmsCd = *r.FrmsCd
+	}
+	if r.Prd != nil {
+		out.Prd = new(TaxPeriod2)
+		r.Prd.DeepCopyInto(out.Prd)
+	}
+	if r.TaxAmt != nil {
+		out.TaxAmt = new(TaxAmount2)
+		r.TaxAmt.DeepCopyInto(out.TaxAmt)
+	}
+	if r.AddtlInf != nil {
+		out.AddtlInf = new(common.Max140Text)
+		*out.AddtlInf = *r.AddtlInf
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *TaxRecordDetails1) DeepCopy() *TaxRecordDetails1 {
+	if r == nil {
+		return nil
+	}
+	out := new(TaxRecordDetails1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *TaxRecordDetails1) DeepCopyInto(out *TaxRecordDetails1) {
+	*out = *r
+	if r.Prd != nil {
+		out.Prd = new(TaxPeriod1)
+		r.Prd.DeepCopyInto(out.Prd)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *TaxRecordDetails2) DeepCopy() *TaxRecordDetails2 {
+	if r == nil {
+		return nil
+	}
+	out := new(TaxRecordDetails2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *TaxRecordDetails2) DeepCopyInto(out *TaxRecordDetails2) {
+	*out = *r
+	if r.Prd != nil {
+		out.Prd = new(TaxPeriod2)
+		r.Prd.DeepCopyInto(out.Prd)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *UnderlyingTransaction14) DeepCopy() *UnderlyingTransaction14 {
+	if r == nil {
+		return nil
+	}
+	out := new(UnderlyingTransaction14)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *UnderlyingTransaction14) DeepCopyInto(out *UnderlyingTransaction14) {
+	*out = *r
+	if r.OrgnlGrpInfAndSts != nil {
+		out.OrgnlGrpInfAndSts = new(OriginalGroupHeader5)
+		r.OrgnlGrpInfAndSts.DeepCopyInto(out.OrgnlGrpInfAndSts)
+	}
+	if r.OrgnlPmtInfAndSts != nil {
+		out.OrgnlPmtInfAndSts = make([]OriginalPaymentInstruction17, len(r.OrgnlPmtInfAndSts))
+		for i := range r.OrgnlPmtInfAndSts {
+			r.OrgnlPmtInfAndSts[i].DeepCopyInto(&out.OrgnlPmtInfAndSts[i])
+		}
+	}
+	if r.TxInfAndSts != nil {
+		out.TxInfAndSts = make([]PaymentTransaction67, len(r.TxInfAndSts))
+		for i := range r.TxInfAndSts {
+			r.TxInfAndSts[i].DeepCopyInto(&out.TxInfAndSts[i])
+		}
+	}
+}
@@ -0,0 +1,4504 @@
+// Code generated by deepcopy-gen. DO NOT EDIT.
+
+package camt_v07
+
+import (
+	"github.com/moov-io/iso20022/pkg/common"
+)
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *AccountCriteria3Choice) DeepCopy() *AccountCriteria3Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(AccountCriteria3Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *AccountCriteria3Choice) DeepCopyInto(out *AccountCriteria3Choice) {
+	*out = *r
+	r.NewCrit.DeepCopyInto(&out.NewCrit)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *AccountCriteria7) DeepCopy() *AccountCriteria7 {
+	if r == nil {
+		return nil
+	}
+	out := new(AccountCriteria7)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *AccountCriteria7) DeepCopyInto(out *AccountCriteria7) {
+	*out = *r
+	if r.NewQryNm != nil {
+		out.NewQryNm = new(common.Max35Text)
+		*out.NewQryNm = *r.NewQryNm
+	}
+	if r.SchCrit != nil {
+		out.SchCrit = make([]CashAccountSearchCriteria7, len(r.SchCrit))
+		for i := range r.SchCrit {
+			r.SchCrit[i].DeepCopyInto(&out.SchCrit[i])
+		}
+	}
+	if r.RtrCrit != nil {
+		out.RtrCrit = new(CashAccountReturnCriteria5)
+		r.RtrCrit.DeepCopyInto(out.RtrCrit)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *AccountIdentification4Choice) DeepCopy() *AccountIdentification4Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(AccountIdentification4Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *AccountIdentification4Choice) DeepCopyInto(out *AccountIdentification4Choice) {
+	*out = *r
+	r.Othr.DeepCopyInto(&out.Othr)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *AccountIdentificationSearchCriteria2Choice) DeepCopy() *AccountIdentificationSearchCriteria2Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(AccountIdentificationSearchCriteria2Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *AccountIdentificationSearchCriteria2Choice) DeepCopyInto(out *AccountIdentificationSearchCriteria2Choice) {
+	*out = *r
+	r.EQ.DeepCopyInto(&out.EQ)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *AccountQuery3) DeepCopy() *AccountQuery3 {
+	if r == nil {
+		return nil
+	}
+	out := new(AccountQuery3)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *AccountQuery3) DeepCopyInto(out *AccountQuery3) {
+	*out = *r
+	if r.QryTp != nil {
+		out.QryTp = new(QueryType2Code)
+		*out.QryTp = *r.QryTp
+	}
+	if r.AcctCrit != nil {
+		out.AcctCrit = new(AccountCriteria3Choice)
+		r.AcctCrit.DeepCopyInto(out.AcctCrit)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *AccountSchemeName1Choice) DeepCopy() *AccountSchemeName1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(AccountSchemeName1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *AccountSchemeName1Choice) DeepCopyInto(out *AccountSchemeName1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ActiveAmountRange3Choice) DeepCopy() *ActiveAmountRange3Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(ActiveAmountRange3Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ActiveAmountRange3Choice) DeepCopyInto(out *ActiveAmountRange3Choice) {
+	*out = *r
+	r.ImpldCcyAndAmtRg.DeepCopyInto(&out.ImpldCcyAndAmtRg)
+	r.CcyAndAmtRg.DeepCopyInto(&out.CcyAndAmtRg)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ActiveCurrencyAndAmount) DeepCopy() *ActiveCurrencyAndAmount {
+	if r == nil {
+		return nil
+	}
+	out := new(ActiveCurrencyAndAmount)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ActiveCurrencyAndAmount) DeepCopyInto(out *ActiveCurrencyAndAmount) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ActiveCurrencyAndAmountRange3) DeepCopy() *ActiveCurrencyAndAmountRange3 {
+	if r == nil {
+		return nil
+	}
+	out := new(ActiveCurrencyAndAmountRange3)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ActiveCurrencyAndAmountRange3) DeepCopyInto(out *ActiveCurrencyAndAmountRange3) {
+	*out = *r
+	if r.CdtDbtInd != nil {
+		out.CdtDbtInd = new(common.CreditDebitCode)
+		*out.CdtDbtInd = *r.CdtDbtInd
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ActiveOrHistoricCurrencyAndAmount) DeepCopy() *ActiveOrHistoricCurrencyAndAmount {
+	if r == nil {
+		return nil
+	}
+	out := new(ActiveOrHistoricCurrencyAndAmount)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ActiveOrHistoricCurrencyAndAmount) DeepCopyInto(out *ActiveOrHistoricCurrencyAndAmount) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *AddressType3Choice) DeepCopy() *AddressType3Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(AddressType3Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *AddressType3Choice) DeepCopyInto(out *AddressType3Choice) {
+	*out = *r
+	r.Prtry.DeepCopyInto(&out.Prtry)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *AmendmentInformationDetails13) DeepCopy() *AmendmentInformationDetails13 {
+	if r == nil {
+		return nil
+	}
+	out := new(AmendmentInformationDetails13)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *AmendmentInformationDetails13) DeepCopyInto(out *AmendmentInformationDetails13) {
+	*out = *r
+	if r.OrgnlMndtId != nil {
+		out.OrgnlMndtId = new(common.Max35Text)
+		*out.OrgnlMndtId = *r.OrgnlMndtId
+	}
+	if r.OrgnlCdtrSchmeId != nil {
+		out.OrgnlCdtrSchmeId = new(PartyIdentification135)
+		r.OrgnlCdtrSchmeId.DeepCopyInto(out.OrgnlCdtrSchmeId)
+	}
+	if r.OrgnlCdtrAgt != nil {
+		out.OrgnlCdtrAgt = new(BranchAndFinancialInstitutionIdentification6)
+		r.OrgnlCdtrAgt.DeepCopyInto(out.OrgnlCdtrAgt)
+	}
+	if r.OrgnlCdtrAgtAcct != nil {
+		out.OrgnlCdtrAgtAcct = new(CashAccount38)
+		r.OrgnlCdtrAgtAcct.DeepCopyInto(out.OrgnlCdtrAgtAcct)
+	}
+	if r.OrgnlDbtr != nil {
+		out.OrgnlDbtr = new(PartyIdentification135)
+		r.OrgnlDbtr.DeepCopyInto(out.OrgnlDbtr)
+	}
+	if r.OrgnlDbtrAcct != nil {
+		out.OrgnlDbtrAcct = new(CashAccount38)
+		r.OrgnlDbtrAcct.DeepCopyInto(out.OrgnlDbtrAcct)
+	}
+	if r.OrgnlDbtrAgt != nil {
+		out.OrgnlDbtrAgt = new(BranchAndFinancialInstitutionIdentification6)
+		r.OrgnlDbtrAgt.DeepCopyInto(out.OrgnlDbtrAgt)
+	}
+	if r.OrgnlDbtrAgtAcct != nil {
+		out.OrgnlDbtrAgtAcct = new(CashAccount38)
+		r.OrgnlDbtrAgtAcct.DeepCopyInto(out.OrgnlDbtrAgtAcct)
+	}
+	if r.OrgnlFnlColltnDt != nil {
+		out.OrgnlFnlColltnDt = new(common.ISODate)
+		*out.OrgnlFnlColltnDt = *r.OrgnlFnlColltnDt
+	}
+	if r.OrgnlFrqcy != nil {
+		out.OrgnlFrqcy = new(Frequency36Choice)
+		*out.OrgnlFrqcy = *r.OrgnlFrqcy
+	}
+	if r.OrgnlRsn != nil {
+		out.OrgnlRsn = new(MandateSetupReason1Choice)
+		*out.OrgnlRsn = *r.OrgnlRsn
+	}
+	if r.OrgnlTrckgDays != nil {
+		out.OrgnlTrckgDays = new(common.Exact2NumericText)
+		*out.OrgnlTrckgDays = *r.OrgnlTrckgDays
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *Amount2Choice) DeepCopy() *Amount2Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(Amount2Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *Amount2Choice) DeepCopyInto(out *Amount2Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *AmountRangeBoundary1) DeepCopy() *AmountRangeBoundary1 {
+	if r == nil {
+		return nil
+	}
+	out := new(AmountRangeBoundary1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *AmountRangeBoundary1) DeepCopyInto(out *AmountRangeBoundary1) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *AmountType4Choice) DeepCopy() *AmountType4Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(AmountType4Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *AmountType4Choice) DeepCopyInto(out *AmountType4Choice) {
+	*out = *r
+	r.EqvtAmt.DeepCopyInto(&out.EqvtAmt)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *BackupPaymentV07) DeepCopy() *BackupPaymentV07 {
+	if r == nil {
+		return nil
+	}
+	out := new(BackupPaymentV07)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *BackupPaymentV07) DeepCopyInto(out *BackupPaymentV07) {
+	*out = *r
+	r.MsgHdr.DeepCopyInto(&out.MsgHdr)
+	if r.OrgnlMsgId != nil {
+		out.OrgnlMsgId = new(MessageHeader1)
+		r.OrgnlMsgId.DeepCopyInto(out.OrgnlMsgId)
+	}
+	if r.InstrInf != nil {
+		out.InstrInf = new(PaymentInstruction13)
+		r.InstrInf.DeepCopyInto(out.InstrInf)
+	}
+	r.Cdtr.DeepCopyInto(&out.Cdtr)
+	if r.CdtrAgt != nil {
+		out.CdtrAgt = new(SystemMember3)
+		r.CdtrAgt.DeepCopyInto(out.CdtrAgt)
+	}
+	if r.DbtrAgt != nil {
+		out.DbtrAgt = new(SystemMember3)
+		r.DbtrAgt.DeepCopyInto(out.DbtrAgt)
+	}
+	if r.SplmtryData != nil {
+		out.SplmtryData = make([]SupplementaryData1, len(r.SplmtryData))
+		for i := range r.SplmtryData {
+			r.SplmtryData[i].DeepCopyInto(&out.SplmtryData[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *BalanceType11Choice) DeepCopy() *BalanceType11Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(BalanceType11Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *BalanceType11Choice) DeepCopyInto(out *BalanceType11Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *BranchAndFinancialInstitutionIdentification6) DeepCopy() *BranchAndFinancialInstitutionIdentification6 {
+	if r == nil {
+		return nil
+	}
+	out := new(BranchAndFinancialInstitutionIdentification6)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *BranchAndFinancialInstitutionIdentification6) DeepCopyInto(out *BranchAndFinancialInstitutionIdentification6) {
+	*out = *r
+	r.FinInstnId.DeepCopyInto(&out.FinInstnId)
+	if r.BrnchId != nil {
+		out.BrnchId = new(BranchData3)
+		r.BrnchId.DeepCopyInto(out.BrnchId)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *BranchData3) DeepCopy() *BranchData3 {
+	if r == nil {
+		return nil
+	}
+	out := new(BranchData3)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *BranchData3) DeepCopyInto(out *BranchData3) {
+	*out = *r
+	if r.Id != nil {
+		out.Id = new(common.Max35Text)
+		*out.Id = *r.Id
+	}
+	if r.LEI != nil {
+		out.LEI = new(common.LEIIdentifier)
+		*out.LEI = *r.LEI
+	}
+	if r.Nm != nil {
+		out.Nm = new(common.Max140Text)
+		*out.Nm = *r.Nm
+	}
+	if r.PstlAdr != nil {
+		out.PstlAdr = new(PostalAddress24)
+		r.PstlAdr.DeepCopyInto(out.PstlAdr)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *BusinessDay8) DeepCopy() *BusinessDay8 {
+	if r == nil {
+		return nil
+	}
+	out := new(BusinessDay8)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *BusinessDay8) DeepCopyInto(out *BusinessDay8) {
+	*out = *r
+	if r.SysId != nil {
+		out.SysId = make([]SystemIdentification2Choice, len(r.SysId))
+		copy(out.SysId, r.SysId)
+	}
+	r.BizDayOrErr.DeepCopyInto(&out.BizDayOrErr)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *BusinessDay9) DeepCopy() *BusinessDay9 {
+	if r == nil {
+		return nil
+	}
+	out := new(BusinessDay9)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *BusinessDay9) DeepCopyInto(out *BusinessDay9) {
+	*out = *r
+	if r.SysDt != nil {
+		out.SysDt = new(DateAndDateTime2Choice)
+		*out.SysDt = *r.SysDt
+	}
+	if r.SysSts != nil {
+		out.SysSts = new(SystemStatus3)
+		r.SysSts.DeepCopyInto(out.SysSts)
+	}
+	if r.SysInfPerCcy != nil {
+		out.SysInfPerCcy = make([]SystemAvailabilityAndEvents3, len(r.SysInfPerCcy))
+		for i := range r.SysInfPerCcy {
+			r.SysInfPerCcy[i].DeepCopyInto(&out.SysInfPerCcy[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *BusinessDayReportOrError10Choice) DeepCopy() *BusinessDayReportOrError10Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(BusinessDayReportOrError10Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *BusinessDayReportOrError10Choice) DeepCopyInto(out *BusinessDayReportOrError10Choice) {
+	*out = *r
+	r.BizDayInf.DeepCopyInto(&out.BizDayInf)
+	if r.BizErr != nil {
+		out.BizErr = make([]ErrorHandling5, len(r.BizErr))
+		for i := range r.BizErr {
+			r.BizErr[i].DeepCopyInto(&out.BizErr[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *BusinessDayReportOrError9Choice) DeepCopy() *BusinessDayReportOrError9Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(BusinessDayReportOrError9Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *BusinessDayReportOrError9Choice) DeepCopyInto(out *BusinessDayReportOrError9Choice) {
+	*out = *r
+	if r.BizRpt != nil {
+		out.BizRpt = make([]BusinessDay8, len(r.BizRpt))
+		for i := range r.BizRpt {
+			r.BizRpt[i].DeepCopyInto(&out.BizRpt[i])
+		}
+	}
+	if r.OprlErr != nil {
+		out.OprlErr = make([]ErrorHandling5, len(r.OprlErr))
+		for i := range r.OprlErr {
+			r.OprlErr[i].DeepCopyInto(&out.OprlErr[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *Case5) DeepCopy() *Case5 {
+	if r == nil {
+		return nil
+	}
+	out := new(Case5)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *Case5) DeepCopyInto(out *Case5) {
+	*out = *r
+	r.Cretr.DeepCopyInto(&out.Cretr)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CaseAssignment5) DeepCopy() *CaseAssignment5 {
+	if r == nil {
+		return nil
+	}
+	out := new(CaseAssignment5)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CaseAssignment5) DeepCopyInto(out *CaseAssignment5) {
+	*out = *r
+	r.Assgnr.DeepCopyInto(&out.Assgnr)
+	r.Assgne.DeepCopyInto(&out.Assgne)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CashAccount38) DeepCopy() *CashAccount38 {
+	if r == nil {
+		return nil
+	}
+	out := new(CashAccount38)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CashAccount38) DeepCopyInto(out *CashAccount38) {
+	*out = *r
+	r.Id.DeepCopyInto(&out.Id)
+	if r.Tp != nil {
+		out.Tp = new(CashAccountType2Choice)
+		*out.Tp = *r.Tp
+	}
+	if r.Ccy != nil {
+		out.Ccy = new(common.ActiveOrHistoricCurrencyCode)
+		*out.Ccy = *r.Ccy
+	}
+	if r.Nm != nil {
+		out.Nm = new(common.Max70Text)
+		*out.Nm = *r.Nm
+	}
+	if r.Prxy != nil {
+		out.Prxy = new(ProxyAccountIdentification1)
+		r.Prxy.DeepCopyInto(out.Prxy)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CashAccountReturnCriteria5) DeepCopy() *CashAccountReturnCriteria5 {
+	if r == nil {
+		return nil
+	}
+	out := new(CashAccountReturnCriteria5)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CashAccountReturnCriteria5) DeepCopyInto(out *CashAccountReturnCriteria5) {
+	*out = *r
+	if r.MulBalRtrCrit != nil {
+		out.MulBalRtrCrit = new(CashBalanceReturnCriteria2)
+		*out.MulBalRtrCrit = *r.MulBalRtrCrit
+	}
+	if r.BilBalRtrCrit != nil {
+		out.BilBalRtrCrit = new(CashBalanceReturnCriteria2)
+		*out.BilBalRtrCrit = *r.BilBalRtrCrit
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CashAccountSearchCriteria7) DeepCopy() *CashAccountSearchCriteria7 {
+	if r == nil {
+		return nil
+	}
+	out := new(CashAccountSearchCriteria7)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CashAccountSearchCriteria7) DeepCopyInto(out *CashAccountSearchCriteria7) {
+	*out = *r
+	if r.AcctId != nil {
+		out.AcctId = make([]AccountIdentificationSearchCriteria2Choice, len(r.AcctId))
+		for i := range r.AcctId {
+			r.AcctId[i].DeepCopyInto(&out.AcctId[i])
+		}
+	}
+	if r.Tp != nil {
+		out.Tp = make([]CashAccountType2Choice, len(r.Tp))
+		copy(out.Tp, r.Tp)
+	}
+	if r.Ccy != nil {
+		out.Ccy = make([]common.ActiveOrHistoricCurrencyCode, len(r.Ccy))
+		copy(out.Ccy, r.Ccy)
+	}
+	if r.Bal != nil {
+		out.Bal = make([]CashBalance12, len(r.Bal))
+		for i := range r.Bal {
+			r.Bal[i].DeepCopyInto(&out.Bal[i])
+		}
+	}
+	if r.AcctOwnr != nil {
+		out.AcctOwnr = new(PartyIdentification135)
+		r.AcctOwnr.DeepCopyInto(out.AcctOwnr)
+	}
+	if r.AcctSvcr != nil {
+		out.AcctSvcr = new(BranchAndFinancialInstitutionIdentification6)
+		r.AcctSvcr.DeepCopyInto(out.AcctSvcr)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CashAccountType2Choice) DeepCopy() *CashAccountType2Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(CashAccountType2Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CashAccountType2Choice) DeepCopyInto(out *CashAccountType2Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CashBalance12) DeepCopy() *CashBalance12 {
+	if r == nil {
+		return nil
+	}
+	out := new(CashBalance12)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CashBalance12) DeepCopyInto(out *CashBalance12) {
+	*out = *r
+	if r.Tp != nil {
+		out.Tp = make([]BalanceType11Choice, len(r.Tp))
+		copy(out.Tp, r.Tp)
+	}
+	if r.CtrPtyTp != nil {
+		out.CtrPtyTp = new(BalanceCounterparty1Code)
+		*out.CtrPtyTp = *r.CtrPtyTp
+	}
+	if r.CtrPtyId != nil {
+		out.CtrPtyId = make([]BranchAndFinancialInstitutionIdentification6, len(r.CtrPtyId))
+		for i := range r.CtrPtyId {
+			r.CtrPtyId[i].DeepCopyInto(&out.CtrPtyId[i])
+		}
+	}
+	if r.ValDt != nil {
+		out.ValDt = make([]DateAndDateTimeSearch4Choice, len(r.ValDt))
+		copy(out.ValDt, r.ValDt)
+	}
+	if r.PrcgDt != nil {
+		out.PrcgDt = new(DateAndDateTimeSearch4Choice)
+		*out.PrcgDt = *r.PrcgDt
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CashBalanceReturnCriteria2) DeepCopy() *CashBalanceReturnCriteria2 {
+	if r == nil {
+		return nil
+	}
+	out := new(CashBalanceReturnCriteria2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CashBalanceReturnCriteria2) DeepCopyInto(out *CashBalanceReturnCriteria2) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CategoryPurpose1Choice) DeepCopy() *CategoryPurpose1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(CategoryPurpose1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CategoryPurpose1Choice) DeepCopyInto(out *CategoryPurpose1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ClearingSystemIdentification2Choice) DeepCopy() *ClearingSystemIdentification2Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(ClearingSystemIdentification2Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ClearingSystemIdentification2Choice) DeepCopyInto(out *ClearingSystemIdentification2Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ClearingSystemIdentification3Choice) DeepCopy() *ClearingSystemIdentification3Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(ClearingSystemIdentification3Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ClearingSystemIdentification3Choice) DeepCopyInto(out *ClearingSystemIdentification3Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ClearingSystemMemberIdentification2) DeepCopy() *ClearingSystemMemberIdentification2 {
+	if r == nil {
+		return nil
+	}
+	out := new(ClearingSystemMemberIdentification2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ClearingSystemMemberIdentification2) DeepCopyInto(out *ClearingSystemMemberIdentification2) {
+	*out = *r
+	if r.ClrSysId != nil {
+		out.ClrSysId = new(ClearingSystemIdentification2Choice)
+		*out.ClrSysId = *r.ClrSysId
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ClosureReason2Choice) DeepCopy() *ClosureReason2Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(ClosureReason2Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ClosureReason2Choice) DeepCopyInto(out *ClosureReason2Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *Contact4) DeepCopy() *Contact4 {
+	if r == nil {
+		return nil
+	}
+	out := new(Contact4)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *Contact4) DeepCopyInto(out *Contact4) {
+	*out = *r
+	if r.NmPrfx != nil {
+		out.NmPrfx = new(common.NamePrefix2Code)
+		*out.NmPrfx = *r.NmPrfx
+	}
+	if r.Nm != nil {
+		out.Nm = new(common.Max140Text)
+		*out.Nm = *r.Nm
+	}
+	if r.PhneNb != nil {
+		out.PhneNb = new(common.PhoneNumber)
+		*out.PhneNb = *r.PhneNb
+	}
+	if r.MobNb != nil {
+		out.MobNb = new(common.PhoneNumber)
+		*out.MobNb = *r.MobNb
+	}
+	if r.FaxNb != nil {
+		out.FaxNb = new(common.PhoneNumber)
+		*out.FaxNb = *r.FaxNb
+	}
+	if r.EmailAdr != nil {
+		out.EmailAdr = new(common.Max2048Text)
+		*out.EmailAdr = *r.EmailAdr
+	}
+	if r.EmailPurp != nil {
+		out.EmailPurp = new(common.Max35Text)
+		*out.EmailPurp = *r.EmailPurp
+	}
+	if r.JobTitl != nil {
+		out.JobTitl = new(common.Max35Text)
+		*out.JobTitl = *r.JobTitl
+	}
+	if r.Rspnsblty != nil {
+		out.Rspnsblty = new(common.Max35Text)
+		*out.Rspnsblty = *r.Rspnsblty
+	}
+	if r.Dept != nil {
+		out.Dept = new(common.Max70Text)
+		*out.Dept = *r.Dept
+	}
+	if r.Othr != nil {
+		out.Othr = make([]OtherContact1, len(r.Othr))
+		copy(out.Othr, r.Othr)
+	}
+	if r.PrefrdMtd != nil {
+		out.PrefrdMtd = new(PreferredContactMethod1Code)
+		*out.PrefrdMtd = *r.PrefrdMtd
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CreditTransferMandateData1) DeepCopy() *CreditTransferMandateData1 {
+	if r == nil {
+		return nil
+	}
+	out := new(CreditTransferMandateData1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CreditTransferMandateData1) DeepCopyInto(out *CreditTransferMandateData1) {
+	*out = *r
+	if r.MndtId != nil {
+		out.MndtId = new(common.Max35Text)
+		*out.MndtId = *r.MndtId
+	}
+	if r.Tp != nil {
+		out.Tp = new(MandateTypeInformation2)
+		r.Tp.DeepCopyInto(out.Tp)
+	}
+	if r.DtOfSgntr != nil {
+		out.DtOfSgntr = new(common.ISODate)
+		*out.DtOfSgntr = *r.DtOfSgntr
+	}
+	if r.DtOfVrfctn != nil {
+		out.DtOfVrfctn = new(common.ISODateTime)
+		*out.DtOfVrfctn = *r.DtOfVrfctn
+	}
+	if r.ElctrncSgntr != nil {
+		out.ElctrncSgntr = new(common.Max10KBinary)
+		*out.ElctrncSgntr = *r.ElctrncSgntr
+		if (*r.ElctrncSgntr) != nil {
+			(*out.ElctrncSgntr) = make(common.Max10KBinary, len((*r.ElctrncSgntr)))
+			copy((*out.ElctrncSgntr), (*r.ElctrncSgntr))
+		}
+	}
+	if r.FrstPmtDt != nil {
+		out.FrstPmtDt = new(common.ISODate)
+		*out.FrstPmtDt = *r.FrstPmtDt
+	}
+	if r.FnlPmtDt != nil {
+		out.FnlPmtDt = new(common.ISODate)
+		*out.FnlPmtDt = *r.FnlPmtDt
+	}
+	if r.Frqcy != nil {
+		out.Frqcy = new(Frequency36Choice)
+		*out.Frqcy = *r.Frqcy
+	}
+	if r.Rsn != nil {
+		out.Rsn = new(MandateSetupReason1Choice)
+		*out.Rsn = *r.Rsn
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CreditorReferenceInformation2) DeepCopy() *CreditorReferenceInformation2 {
+	if r == nil {
+		return nil
+	}
+	out := new(CreditorReferenceInformation2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CreditorReferenceInformation2) DeepCopyInto(out *CreditorReferenceInformation2) {
+	*out = *r
+	if r.Tp != nil {
+		out.Tp = new(CreditorReferenceType2)
+		r.Tp.DeepCopyInto(out.Tp)
+	}
+	if r.Ref != nil {
+		out.Ref = new(common.Max35Text)
+		*out.Ref = *r.Ref
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CreditorReferenceType1Choice) DeepCopy() *CreditorReferenceType1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(CreditorReferenceType1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CreditorReferenceType1Choice) DeepCopyInto(out *CreditorReferenceType1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CreditorReferenceType2) DeepCopy() *CreditorReferenceType2 {
+	if r == nil {
+		return nil
+	}
+	out := new(CreditorReferenceType2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CreditorReferenceType2) DeepCopyInto(out *CreditorReferenceType2) {
+	*out = *r
+	if r.Issr != nil {
+		out.Issr = new(common.Max35Text)
+		*out.Issr = *r.Issr
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *DateAndDateTime2Choice) DeepCopy() *DateAndDateTime2Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(DateAndDateTime2Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *DateAndDateTime2Choice) DeepCopyInto(out *DateAndDateTime2Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *DateAndDateTimeSearch4Choice) DeepCopy() *DateAndDateTimeSearch4Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(DateAndDateTimeSearch4Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *DateAndDateTimeSearch4Choice) DeepCopyInto(out *DateAndDateTimeSearch4Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *DateAndPeriod2Choice) DeepCopy() *DateAndPeriod2Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(DateAndPeriod2Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *DateAndPeriod2Choice) DeepCopyInto(out *DateAndPeriod2Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *DateAndPlaceOfBirth1) DeepCopy() *DateAndPlaceOfBirth1 {
+	if r == nil {
+		return nil
+	}
+	out := new(DateAndPlaceOfBirth1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *DateAndPlaceOfBirth1) DeepCopyInto(out *DateAndPlaceOfBirth1) {
+	*out = *r
+	if r.PrvcOfBirth != nil {
+		out.PrvcOfBirth = new(common.Max35Text)
+		*out.PrvcOfBirth = *r.PrvcOfBirth
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *DatePeriod2) DeepCopy() *DatePeriod2 {
+	if r == nil {
+		return nil
+	}
+	out := new(DatePeriod2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *DatePeriod2) DeepCopyInto(out *DatePeriod2) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *DatePeriodSearch1Choice) DeepCopy() *DatePeriodSearch1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(DatePeriodSearch1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *DatePeriodSearch1Choice) DeepCopyInto(out *DatePeriodSearch1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *DateTimePeriod1) DeepCopy() *DateTimePeriod1 {
+	if r == nil {
+		return nil
+	}
+	out := new(DateTimePeriod1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *DateTimePeriod1) DeepCopyInto(out *DateTimePeriod1) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *DateTimePeriod1Choice) DeepCopy() *DateTimePeriod1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(DateTimePeriod1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *DateTimePeriod1Choice) DeepCopyInto(out *DateTimePeriod1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *DateTimeSearch2Choice) DeepCopy() *DateTimeSearch2Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(DateTimeSearch2Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *DateTimeSearch2Choice) DeepCopyInto(out *DateTimeSearch2Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *DeleteLimitV07) DeepCopy() *DeleteLimitV07 {
+	if r == nil {
+		return nil
+	}
+	out := new(DeleteLimitV07)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *DeleteLimitV07) DeepCopyInto(out *DeleteLimitV07) {
+	*out = *r
+	r.MsgHdr.DeepCopyInto(&out.MsgHdr)
+	r.LmtDtls.DeepCopyInto(&out.LmtDtls)
+	if r.SplmtryData != nil {
+		out.SplmtryData = make([]SupplementaryData1, len(r.SplmtryData))
+		for i := range r.SplmtryData {
+			r.SplmtryData[i].DeepCopyInto(&out.SplmtryData[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *DiscountAmountAndType1) DeepCopy() *DiscountAmountAndType1 {
+	if r == nil {
+		return nil
+	}
+	out := new(DiscountAmountAndType1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *DiscountAmountAndType1) DeepCopyInto(out *DiscountAmountAndType1) {
+	*out = *r
+	if r.Tp != nil {
+		out.Tp = new(DiscountAmountType1Choice)
+		*out.Tp = *r.Tp
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *DiscountAmountType1Choice) DeepCopy() *DiscountAmountType1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(DiscountAmountType1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *DiscountAmountType1Choice) DeepCopyInto(out *DiscountAmountType1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *DocumentAdjustment1) DeepCopy() *DocumentAdjustment1 {
+	if r == nil {
+		return nil
+	}
+	out := new(DocumentAdjustment1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *DocumentAdjustment1) DeepCopyInto(out *DocumentAdjustment1) {
+	*out = *r
+	if r.CdtDbtInd != nil {
+		out.CdtDbtInd = new(common.CreditDebitCode)
+		*out.CdtDbtInd = *r.CdtDbtInd
+	}
+	if r.Rsn != nil {
+		out.Rsn = new(common.Max4Text)
+		*out.Rsn = *r.Rsn
+	}
+	if r.AddtlInf != nil {
+		out.AddtlInf = new(common.Max140Text)
+		*out.AddtlInf = *r.AddtlInf
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *DocumentLineIdentification1) DeepCopy() *DocumentLineIdentification1 {
+	if r == nil {
+		return nil
+	}
+	out := new(DocumentLineIdentification1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *DocumentLineIdentification1) DeepCopyInto(out *DocumentLineIdentification1) {
+	*out = *r
+	if r.Tp != nil {
+		out.Tp = new(DocumentLineType1)
+		r.Tp.DeepCopyInto(out.Tp)
+	}
+	if r.Nb != nil {
+		out.Nb = new(common.Max35Text)
+		*out.Nb = *r.Nb
+	}
+	if r.RltdDt != nil {
+		out.RltdDt = new(common.ISODate)
+		*out.RltdDt = *r.RltdDt
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *DocumentLineInformation1) DeepCopy() *DocumentLineInformation1 {
+	if r == nil {
+		return nil
+	}
+	out := new(DocumentLineInformation1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *DocumentLineInformation1) DeepCopyInto(out *DocumentLineInformation1) {
+	*out = *r
+	if r.Id != nil {
+		out.Id = make([]DocumentLineIdentification1, len(r.Id))
+		for i := range r.Id {
+			r.Id[i].DeepCopyInto(&out.Id[i])
+		}
+	}
+	if r.Desc != nil {
+		out.Desc = new(common.Max2048Text)
+		*out.Desc = *r.Desc
+	}
+	if r.Amt != nil {
+		out.Amt = new(RemittanceAmount3)
+		r.Amt.DeepCopyInto(out.Amt)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *DocumentLineType1) DeepCopy() *DocumentLineType1 {
+	if r == nil {
+		return nil
+	}
+	out := new(DocumentLineType1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *DocumentLineType1) DeepCopyInto(out *DocumentLineType1) {
+	*out = *r
+	if r.Issr != nil {
+		out.Issr = new(common.Max35Text)
+		*out.Issr = *r.Issr
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *DocumentLineType1Choice) DeepCopy() *DocumentLineType1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(DocumentLineType1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *DocumentLineType1Choice) DeepCopyInto(out *DocumentLineType1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *EquivalentAmount2) DeepCopy() *EquivalentAmount2 {
+	if r == nil {
+		return nil
+	}
+	out := new(EquivalentAmount2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *EquivalentAmount2) DeepCopyInto(out *EquivalentAmount2) {
+	*out = *r
+	if r.CcyOfTrf != nil {
+		out.CcyOfTrf = new(common.ActiveOrHistoricCurrencyCode)
+		*out.CcyOfTrf = *r.CcyOfTrf
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ErrorHandling3Choice) DeepCopy() *ErrorHandling3Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(ErrorHandling3Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ErrorHandling3Choice) DeepCopyInto(out *ErrorHandling3Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ErrorHandling5) DeepCopy() *ErrorHandling5 {
+	if r == nil {
+		return nil
+	}
+	out := new(ErrorHandling5)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ErrorHandling5) DeepCopyInto(out *ErrorHandling5) {
+	*out = *r
+	if r.Desc != nil {
+		out.Desc = new(common.Max140Text)
+		*out.Desc = *r.Desc
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *FinancialIdentificationSchemeName1Choice) DeepCopy() *FinancialIdentificationSchemeName1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(FinancialIdentificationSchemeName1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *FinancialIdentificationSchemeName1Choice) DeepCopyInto(out *FinancialIdentificationSchemeName1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *FinancialInstitutionIdentification18) DeepCopy() *FinancialInstitutionIdentification18 {
+	if r == nil {
+		return nil
+	}
+	out := new(FinancialInstitutionIdentification18)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *FinancialInstitutionIdentification18) DeepCopyInto(out *FinancialInstitutionIdentification18) {
+	*out = *r
+	if r.BICFI != nil {
+		out.BICFI = new(common.BICFIDec2014Identifier)
+		*out.BICFI = *r.BICFI
+	}
+	if r.ClrSysMmbId != nil {
+		out.ClrSysMmbId = new(ClearingSystemMemberIdentification2)
+		r.ClrSysMmbId.DeepCopyInto(out.ClrSysMmbId)
+	}
+	if r.LEI != nil {
+		out.LEI = new(common.LEIIdentifier)
+		*out.LEI = *r.LEI
+	}
+	if r.Nm != nil {
+		out.Nm = new(common.Max140Text)
+		*out.Nm = *r.Nm
+	}
+	if r.PstlAdr != nil {
+		out.PstlAdr = new(PostalAddress24)
+		r.PstlAdr.DeepCopyInto(out.PstlAdr)
+	}
+	if r.Othr != nil {
+		out.Othr = new(GenericFinancialIdentification1)
+		r.Othr.DeepCopyInto(out.Othr)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *Frequency36Choice) DeepCopy() *Frequency36Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(Frequency36Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *Frequency36Choice) DeepCopyInto(out *Frequency36Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *FrequencyAndMoment1) DeepCopy() *FrequencyAndMoment1 {
+	if r == nil {
+		return nil
+	}
+	out := new(FrequencyAndMoment1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *FrequencyAndMoment1) DeepCopyInto(out *FrequencyAndMoment1) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *FrequencyPeriod1) DeepCopy() *FrequencyPeriod1 {
+	if r == nil {
+		return nil
+	}
+	out := new(FrequencyPeriod1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *FrequencyPeriod1) DeepCopyInto(out *FrequencyPeriod1) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *FromToAmountRange1) DeepCopy() *FromToAmountRange1 {
+	if r == nil {
+		return nil
+	}
+	out := new(FromToAmountRange1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *FromToAmountRange1) DeepCopyInto(out *FromToAmountRange1) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *FromToPercentageRange1) DeepCopy() *FromToPercentageRange1 {
+	if r == nil {
+		return nil
+	}
+	out := new(FromToPercentageRange1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *FromToPercentageRange1) DeepCopyInto(out *FromToPercentageRange1) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *Garnishment3) DeepCopy() *Garnishment3 {
+	if r == nil {
+		return nil
+	}
+	out := new(Garnishment3)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *Garnishment3) DeepCopyInto(out *Garnishment3) {
+	*out = *r
+	r.Tp.DeepCopyInto(&out.Tp)
+	if r.Grnshee != nil {
+		out.Grnshee = new(PartyIdentification135)
+		r.Grnshee.DeepCopyInto(out.Grnshee)
+	}
+	if r.GrnshmtAdmstr != nil {
+		out.GrnshmtAdmstr = new(PartyIdentification135)
+		r.GrnshmtAdmstr.DeepCopyInto(out.GrnshmtAdmstr)
+	}
+	if r.RefNb != nil {
+		out.RefNb = new(common.Max140Text)
+		*out.RefNb = *r.RefNb
+	}
+	if r.Dt != nil {
+		out.Dt = new(common.ISODate)
+		*out.Dt = *r.Dt
+	}
+	if r.RmtdAmt != nil {
+		out.RmtdAmt = new(ActiveOrHistoricCurrencyAndAmount)
+		*out.RmtdAmt = *r.RmtdAmt
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *GarnishmentType1) DeepCopy() *GarnishmentType1 {
+	if r == nil {
+		return nil
+	}
+	out := new(GarnishmentType1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *GarnishmentType1) DeepCopyInto(out *GarnishmentType1) {
+	*out = *r
+	if r.Issr != nil {
+		out.Issr = new(common.Max35Text)
+		*out.Issr = *r.Issr
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *GarnishmentType1Choice) DeepCopy() *GarnishmentType1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(GarnishmentType1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *GarnishmentType1Choice) DeepCopyInto(out *GarnishmentType1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *GenericAccountIdentification1) DeepCopy() *GenericAccountIdentification1 {
+	if r == nil {
+		return nil
+	}
+	out := new(GenericAccountIdentification1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *GenericAccountIdentification1) DeepCopyInto(out *GenericAccountIdentification1) {
+	*out = *r
+	if r.SchmeNm != nil {
+		out.SchmeNm = new(AccountSchemeName1Choice)
+		*out.SchmeNm = *r.SchmeNm
+	}
+	if r.Issr != nil {
+		out.Issr = new(common.Max35Text)
+		*out.Issr = *r.Issr
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *GenericFinancialIdentification1) DeepCopy() *GenericFinancialIdentification1 {
+	if r == nil {
+		return nil
+	}
+	out := new(GenericFinancialIdentification1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *GenericFinancialIdentification1) DeepCopyInto(out *GenericFinancialIdentification1) {
+	*out = *r
+	if r.SchmeNm != nil {
+		out.SchmeNm = new(FinancialIdentificationSchemeName1Choice)
+		*out.SchmeNm = *r.SchmeNm
+	}
+	if r.Issr != nil {
+		out.Issr = new(common.Max35Text)
+		*out.Issr = *r.Issr
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *GenericIdentification1) DeepCopy() *GenericIdentification1 {
+	if r == nil {
+		return nil
+	}
+	out := new(GenericIdentification1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *GenericIdentification1) DeepCopyInto(out *GenericIdentification1) {
+	*out = *r
+	if r.SchmeNm != nil {
+		out.SchmeNm = new(common.Max35Text)
+		*out.SchmeNm = *r.SchmeNm
+	}
+	if r.Issr != nil {
+		out.Issr = new(common.Max35Text)
+		*out.Issr = *r.Issr
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *GenericIdentification30) DeepCopy() *GenericIdentification30 {
+	if r == nil {
+		return nil
+	}
+	out := new(GenericIdentification30)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *GenericIdentification30) DeepCopyInto(out *GenericIdentification30) {
+	*out = *r
+	if r.SchmeNm != nil {
+		out.SchmeNm = new(common.Max35Text)
+		*out.SchmeNm = *r.SchmeNm
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *GenericOrganisationIdentification1) DeepCopy() *GenericOrganisationIdentification1 {
+	if r == nil {
+		return nil
+	}
+	out := new(GenericOrganisationIdentification1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *GenericOrganisationIdentification1) DeepCopyInto(out *GenericOrganisationIdentification1) {
+	*out = *r
+	if r.SchmeNm != nil {
+		out.SchmeNm = new(OrganisationIdentificationSchemeName1Choice)
+		*out.SchmeNm = *r.SchmeNm
+	}
+	if r.Issr != nil {
+		out.Issr = new(common.Max35Text)
+		*out.Issr = *r.Issr
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *GenericPersonIdentification1) DeepCopy() *GenericPersonIdentification1 {
+	if r == nil {
+		return nil
+	}
+	out := new(GenericPersonIdentification1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *GenericPersonIdentification1) DeepCopyInto(out *GenericPersonIdentification1) {
+	*out = *r
+	if r.SchmeNm != nil {
+		out.SchmeNm = new(PersonIdentificationSchemeName1Choice)
+		*out.SchmeNm = *r.SchmeNm
+	}
+	if r.Issr != nil {
+		out.Issr = new(common.Max35Text)
+		*out.Issr = *r.Issr
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *GetAccountV07) DeepCopy() *GetAccountV07 {
+	if r == nil {
+		return nil
+	}
+	out := new(GetAccountV07)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *GetAccountV07) DeepCopyInto(out *GetAccountV07) {
+	*out = *r
+	r.MsgHdr.DeepCopyInto(&out.MsgHdr)
+	if r.AcctQryDef != nil {
+		out.AcctQryDef = new(AccountQuery3)
+		r.AcctQryDef.DeepCopyInto(out.AcctQryDef)
+	}
+	if r.SplmtryData != nil {
+		out.SplmtryData = make([]SupplementaryData1, len(r.SplmtryData))
+		for i := range r.SplmtryData {
+			r.SplmtryData[i].DeepCopyInto(&out.SplmtryData[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *GetLimitV07) DeepCopy() *GetLimitV07 {
+	if r == nil {
+		return nil
+	}
+	out := new(GetLimitV07)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *GetLimitV07) DeepCopyInto(out *GetLimitV07) {
+	*out = *r
+	r.MsgHdr.DeepCopyInto(&out.MsgHdr)
+	if r.LmtQryDef != nil {
+		out.LmtQryDef = new(LimitQuery4)
+		r.LmtQryDef.DeepCopyInto(out.LmtQryDef)
+	}
+	if r.SplmtryData != nil {
+		out.SplmtryData = make([]SupplementaryData1, len(r.SplmtryData))
+		for i := range r.SplmtryData {
+			r.SplmtryData[i].DeepCopyInto(&out.SplmtryData[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ImpliedCurrencyAmountRange1Choice) DeepCopy() *ImpliedCurrencyAmountRange1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(ImpliedCurrencyAmountRange1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ImpliedCurrencyAmountRange1Choice) DeepCopyInto(out *ImpliedCurrencyAmountRange1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ImpliedCurrencyAndAmountRange1) DeepCopy() *ImpliedCurrencyAndAmountRange1 {
+	if r == nil {
+		return nil
+	}
+	out := new(ImpliedCurrencyAndAmountRange1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ImpliedCurrencyAndAmountRange1) DeepCopyInto(out *ImpliedCurrencyAndAmountRange1) {
+	*out = *r
+	if r.CdtDbtInd != nil {
+		out.CdtDbtInd = new(common.CreditDebitCode)
+		*out.CdtDbtInd = *r.CdtDbtInd
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *InstructionForAssignee1) DeepCopy() *InstructionForAssignee1 {
+	if r == nil {
+		return nil
+	}
+	out := new(InstructionForAssignee1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *InstructionForAssignee1) DeepCopyInto(out *InstructionForAssignee1) {
+	*out = *r
+	if r.Cd != nil {
+		out.Cd = new(ExternalAgentInstruction1Code)
+		*out.Cd = *r.Cd
+	}
+	if r.InstrInf != nil {
+		out.InstrInf = new(common.Max140Text)
+		*out.InstrInf = *r.InstrInf
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *InstructionForCreditorAgent3) DeepCopy() *InstructionForCreditorAgent3 {
+	if r == nil {
+		return nil
+	}
+	out := new(InstructionForCreditorAgent3)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *InstructionForCreditorAgent3) DeepCopyInto(out *InstructionForCreditorAgent3) {
+	*out = *r
+	if r.Cd != nil {
+		out.Cd = new(ExternalCreditorAgentInstruction1Code)
+		*out.Cd = *r.Cd
+	}
+	if r.InstrInf != nil {
+		out.InstrInf = new(common.Max140Text)
+		*out.InstrInf = *r.InstrInf
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *InstructionForNextAgent1) DeepCopy() *InstructionForNextAgent1 {
+	if r == nil {
+		return nil
+	}
+	out := new(InstructionForNextAgent1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *InstructionForNextAgent1) DeepCopyInto(out *InstructionForNextAgent1) {
+	*out = *r
+	if r.Cd != nil {
+		out.Cd = new(Instruction4Code)
+		*out.Cd = *r.Cd
+	}
+	if r.InstrInf != nil {
+		out.InstrInf = new(common.Max140Text)
+		*out.InstrInf = *r.InstrInf
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *Limit8) DeepCopy() *Limit8 {
+	if r == nil {
+		return nil
+	}
+	out := new(Limit8)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *Limit8) DeepCopyInto(out *Limit8) {
+	*out = *r
+	if r.StartDtTm != nil {
+		out.StartDtTm = new(DateAndDateTime2Choice)
+		*out.StartDtTm = *r.StartDtTm
+	}
+	if r.CdtDbtInd != nil {
+		out.CdtDbtInd = new(common.CreditDebitCode)
+		*out.CdtDbtInd = *r.CdtDbtInd
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *LimitCriteria6) DeepCopy() *LimitCriteria6 {
+	if r == nil {
+		return nil
+	}
+	out := new(LimitCriteria6)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *LimitCriteria6) DeepCopyInto(out *LimitCriteria6) {
+	*out = *r
+	if r.NewQryNm != nil {
+		out.NewQryNm = new(common.Max35Text)
+		*out.NewQryNm = *r.NewQryNm
+	}
+	if r.SchCrit != nil {
+		out.SchCrit = make([]LimitSearchCriteria6, len(r.SchCrit))
+		for i := range r.SchCrit {
+			r.SchCrit[i].DeepCopyInto(&out.SchCrit[i])
+		}
+	}
+	if r.RtrCrit != nil {
+		out.RtrCrit = new(LimitReturnCriteria2)
+		*out.RtrCrit = *r.RtrCrit
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *LimitCriteria6Choice) DeepCopy() *LimitCriteria6Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(LimitCriteria6Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *LimitCriteria6Choice) DeepCopyInto(out *LimitCriteria6Choice) {
+	*out = *r
+	r.NewCrit.DeepCopyInto(&out.NewCrit)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *LimitIdentification2Choice) DeepCopy() *LimitIdentification2Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(LimitIdentification2Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *LimitIdentification2Choice) DeepCopyInto(out *LimitIdentification2Choice) {
+	*out = *r
+	r.Cur.DeepCopyInto(&out.Cur)
+	r.Dflt.DeepCopyInto(&out.Dflt)
+	r.AllCur.DeepCopyInto(&out.AllCur)
+	r.AllDflt.DeepCopyInto(&out.AllDflt)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *LimitIdentification5) DeepCopy() *LimitIdentification5 {
+	if r == nil {
+		return nil
+	}
+	out := new(LimitIdentification5)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *LimitIdentification5) DeepCopyInto(out *LimitIdentification5) {
+	*out = *r
+	if r.SysId != nil {
+		out.SysId = new(SystemIdentification2Choice)
+		*out.SysId = *r.SysId
+	}
+	if r.BilLmtCtrPtyId != nil {
+		out.BilLmtCtrPtyId = new(BranchAndFinancialInstitutionIdentification6)
+		r.BilLmtCtrPtyId.DeepCopyInto(out.BilLmtCtrPtyId)
+	}
+	if r.AcctOwnr != nil {
+		out.AcctOwnr = new(BranchAndFinancialInstitutionIdentification6)
+		r.AcctOwnr.DeepCopyInto(out.AcctOwnr)
+	}
+	if r.AcctId != nil {
+		out.AcctId = new(AccountIdentification4Choice)
+		r.AcctId.DeepCopyInto(out.AcctId)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *LimitIdentification6) DeepCopy() *LimitIdentification6 {
+	if r == nil {
+		return nil
+	}
+	out := new(LimitIdentification6)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *LimitIdentification6) DeepCopyInto(out *LimitIdentification6) {
+	*out = *r
+	if r.SysId != nil {
+		out.SysId = new(SystemIdentification2Choice)
+		*out.SysId = *r.SysId
+	}
+	if r.AcctOwnr != nil {
+		out.AcctOwnr = new(BranchAndFinancialInstitutionIdentification6)
+		r.AcctOwnr.DeepCopyInto(out.AcctOwnr)
+	}
+	if r.AcctId != nil {
+		out.AcctId = new(AccountIdentification4Choice)
+		r.AcctId.DeepCopyInto(out.AcctId)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *LimitQuery4) DeepCopy() *LimitQuery4 {
+	if r == nil {
+		return nil
+	}
+	out := new(LimitQuery4)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *LimitQuery4) DeepCopyInto(out *LimitQuery4) {
+	*out = *r
+	if r.QryTp != nil {
+		out.QryTp = new(QueryType2Code)
+		*out.QryTp = *r.QryTp
+	}
+	if r.LmtCrit != nil {
+		out.LmtCrit = new(LimitCriteria6Choice)
+		r.LmtCrit.DeepCopyInto(out.LmtCrit)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *LimitReturnCriteria2) DeepCopy() *LimitReturnCriteria2 {
+	if r == nil {
+		return nil
+	}
+	out := new(LimitReturnCriteria2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *LimitReturnCriteria2) DeepCopyInto(out *LimitReturnCriteria2) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *LimitSearchCriteria6) DeepCopy() *LimitSearchCriteria6 {
+	if r == nil {
+		return nil
+	}
+	out := new(LimitSearchCriteria6)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *LimitSearchCriteria6) DeepCopyInto(out *LimitSearchCriteria6) {
+	*out = *r
+	if r.SysId != nil {
+		out.SysId = new(SystemIdentification2Choice)
+		*out.SysId = *r.SysId
+	}
+	if r.BilLmtCtrPtyId != nil {
+		out.BilLmtCtrPtyId = make([]BranchAndFinancialInstitutionIdentification6, len(r.BilLmtCtrPtyId))
+		for i := range r.BilLmtCtrPtyId {
+			r.BilLmtCtrPtyId[i].DeepCopyInto(&out.BilLmtCtrPtyId[i])
+		}
+	}
+	if r.DfltLmtTp != nil {
+		out.DfltLmtTp = make([]LimitType1Choice, len(r.DfltLmtTp))
+		copy(out.DfltLmtTp, r.DfltLmtTp)
+	}
+	if r.CurLmtTp != nil {
+		out.CurLmtTp = make([]LimitType1Choice, len(r.CurLmtTp))
+		copy(out.CurLmtTp, r.CurLmtTp)
+	}
+	if r.AcctOwnr != nil {
+		out.AcctOwnr = new(BranchAndFinancialInstitutionIdentification6)
+		r.AcctOwnr.DeepCopyInto(out.AcctOwnr)
+	}
+	if r.AcctId != nil {
+		out.AcctId = new(AccountIdentification4Choice)
+		r.AcctId.DeepCopyInto(out.AcctId)
+	}
+	if r.UsdAmt != nil {
+		out.UsdAmt = new(ActiveAmountRange3Choice)
+		r.UsdAmt.DeepCopyInto(out.UsdAmt)
+	}
+	if r.UsdPctg != nil {
+		out.UsdPctg = new(PercentageRange1Choice)
+		*out.UsdPctg = *r.UsdPctg
+	}
+	if r.LmtCcy != nil {
+		out.LmtCcy = new(common.ActiveCurrencyCode)
+		*out.LmtCcy = *r.LmtCcy
+	}
+	if r.LmtAmt != nil {
+		out.LmtAmt = new(ActiveAmountRange3Choice)
+		r.LmtAmt.DeepCopyInto(out.LmtAmt)
+	}
+	if r.LmtVldAsOfDt != nil {
+		out.LmtVldAsOfDt = new(DateAndPeriod2Choice)
+		*out.LmtVldAsOfDt = *r.LmtVldAsOfDt
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *LimitStructure2Choice) DeepCopy() *LimitStructure2Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(LimitStructure2Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *LimitStructure2Choice) DeepCopyInto(out *LimitStructure2Choice) {
+	*out = *r
+	r.CurLmtId.DeepCopyInto(&out.CurLmtId)
+	r.AllCurLmts.DeepCopyInto(&out.AllCurLmts)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *LimitStructure3) DeepCopy() *LimitStructure3 {
+	if r == nil {
+		return nil
+	}
+	out := new(LimitStructure3)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *LimitStructure3) DeepCopyInto(out *LimitStructure3) {
+	*out = *r
+	r.LmtId.DeepCopyInto(&out.LmtId)
+	r.NewLmtValSet.DeepCopyInto(&out.NewLmtValSet)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *LimitType1Choice) DeepCopy() *LimitType1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(LimitType1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *LimitType1Choice) DeepCopyInto(out *LimitType1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *LocalInstrument2Choice) DeepCopy() *LocalInstrument2Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(LocalInstrument2Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *LocalInstrument2Choice) DeepCopyInto(out *LocalInstrument2Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *MandateClassification1Choice) DeepCopy() *MandateClassification1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(MandateClassification1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *MandateClassification1Choice) DeepCopyInto(out *MandateClassification1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *MandateRelatedData1Choice) DeepCopy() *MandateRelatedData1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(MandateRelatedData1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *MandateRelatedData1Choice) DeepCopyInto(out *MandateRelatedData1Choice) {
+	*out = *r
+	if r.DrctDbtMndt != nil {
+		out.DrctDbtMndt = new(MandateRelatedInformation14)
+		r.DrctDbtMndt.DeepCopyInto(out.DrctDbtMndt)
+	}
+	if r.CdtTrfMndt != nil {
+		out.CdtTrfMndt = new(CreditTransferMandateData1)
+		r.CdtTrfMndt.DeepCopyInto(out.CdtTrfMndt)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *MandateRelatedInformation14) DeepCopy() *MandateRelatedInformation14 {
+	if r == nil {
+		return nil
+	}
+	out := new(MandateRelatedInformation14)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *MandateRelatedInformation14) DeepCopyInto(out *MandateRelatedInformation14) {
+	*out = *r
+	if r.MndtId != nil {
+		out.MndtId = new(common.Max35Text)
+		*out.MndtId = *r.MndtId
+	}
+	if r.DtOfSgntr != nil {
+		out.DtOfSgntr = new(common.ISODate)
+		*out.DtOfSgntr = *r.DtOfSgntr
+	}
+	if r.AmdmntInfDtls != nil {
+		out.AmdmntInfDtls = new(AmendmentInformationDetails13)
+		r.AmdmntInfDtls.DeepCopyInto(out.AmdmntInfDtls)
+	}
+	if r.ElctrncSgntr != nil {
+		out.ElctrncSgntr = new(common.Max1025Text)
+		*out.ElctrncSgntr = *r.ElctrncSgntr
+	}
+	if r.FrstColltnDt != nil {
+		out.FrstColltnDt = new(common.ISODate)
+		*out.FrstColltnDt = *r.FrstColltnDt
+	}
+	if r.FnlColltnDt != nil {
+		out.FnlColltnDt = new(common.ISODate)
+		*out.FnlColltnDt = *r.FnlColltnDt
+	}
+	if r.Frqcy != nil {
+		out.Frqcy = new(Frequency36Choice)
+		*out.Frqcy = *r.Frqcy
+	}
+	if r.Rsn != nil {
+		out.Rsn = new(MandateSetupReason1Choice)
+		*out.Rsn = *r.Rsn
+	}
+	if r.TrckgDays != nil {
+		out.TrckgDays = new(common.Exact2NumericText)
+		*out.TrckgDays = *r.TrckgDays
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *MandateSetupReason1Choice) DeepCopy() *MandateSetupReason1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(MandateSetupReason1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *MandateSetupReason1Choice) DeepCopyInto(out *MandateSetupReason1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *MandateTypeInformation2) DeepCopy() *MandateTypeInformation2 {
+	if r == nil {
+		return nil
+	}
+	out := new(MandateTypeInformation2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *MandateTypeInformation2) DeepCopyInto(out *MandateTypeInformation2) {
+	*out = *r
+	if r.SvcLvl != nil {
+		out.SvcLvl = new(ServiceLevel8Choice)
+		*out.SvcLvl = *r.SvcLvl
+	}
+	if r.LclInstrm != nil {
+		out.LclInstrm = new(LocalInstrument2Choice)
+		*out.LclInstrm = *r.LclInstrm
+	}
+	if r.CtgyPurp != nil {
+		out.CtgyPurp = new(CategoryPurpose1Choice)
+		*out.CtgyPurp = *r.CtgyPurp
+	}
+	if r.Clssfctn != nil {
+		out.Clssfctn = new(MandateClassification1Choice)
+		*out.Clssfctn = *r.Clssfctn
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *MarketInfrastructureIdentification1Choice) DeepCopy() *MarketInfrastructureIdentification1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(MarketInfrastructureIdentification1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *MarketInfrastructureIdentification1Choice) DeepCopyInto(out *MarketInfrastructureIdentification1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *MemberIdentification3Choice) DeepCopy() *MemberIdentification3Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(MemberIdentification3Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *MemberIdentification3Choice) DeepCopyInto(out *MemberIdentification3Choice) {
+	*out = *r
+	r.ClrSysMmbId.DeepCopyInto(&out.ClrSysMmbId)
+	r.Othr.DeepCopyInto(&out.Othr)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *MessageHeader1) DeepCopy() *MessageHeader1 {
+	if r == nil {
+		return nil
+	}
+	out := new(MessageHeader1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *MessageHeader1) DeepCopyInto(out *MessageHeader1) {
+	*out = *r
+	if r.CreDtTm != nil {
+		out.CreDtTm = new(common.ISODateTime)
+		*out.CreDtTm = *r.CreDtTm
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *MessageHeader7) DeepCopy() *MessageHeader7 {
+	if r == nil {
+		return nil
+	}
+	out := new(MessageHeader7)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *MessageHeader7) DeepCopyInto(out *MessageHeader7) {
+	*out = *r
+	if r.CreDtTm != nil {
+		out.CreDtTm = new(common.ISODateTime)
+		*out.CreDtTm = *r.CreDtTm
+	}
+	if r.ReqTp != nil {
+		out.ReqTp = new(RequestType4Choice)
+		r.ReqTp.DeepCopyInto(out.ReqTp)
+	}
+	if r.OrgnlBizQry != nil {
+		out.OrgnlBizQry = new(OriginalBusinessQuery1)
+		r.OrgnlBizQry.DeepCopyInto(out.OrgnlBizQry)
+	}
+	if r.QryNm != nil {
+		out.QryNm = new(common.Max35Text)
+		*out.QryNm = *r.QryNm
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *MessageHeader9) DeepCopy() *MessageHeader9 {
+	if r == nil {
+		return nil
+	}
+	out := new(MessageHeader9)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *MessageHeader9) DeepCopyInto(out *MessageHeader9) {
+	*out = *r
+	if r.CreDtTm != nil {
+		out.CreDtTm = new(common.ISODateTime)
+		*out.CreDtTm = *r.CreDtTm
+	}
+	if r.ReqTp != nil {
+		out.ReqTp = new(RequestType4Choice)
+		r.ReqTp.DeepCopyInto(out.ReqTp)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *MissingOrIncorrectInformation3) DeepCopy() *MissingOrIncorrectInformation3 {
+	if r == nil {
+		return nil
+	}
+	out := new(MissingOrIncorrectInformation3)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *MissingOrIncorrectInformation3) DeepCopyInto(out *MissingOrIncorrectInformation3) {
+	*out = *r
+	if r.MssngInf != nil {
+		out.MssngInf = make([]UnableToApplyMissing1, len(r.MssngInf))
+		for i := range r.MssngInf {
+			r.MssngInf[i].DeepCopyInto(&out.MssngInf[i])
+		}
+	}
+	if r.IncrrctInf != nil {
+		out.IncrrctInf = make([]UnableToApplyIncorrect1, len(r.IncrrctInf))
+		for i := range r.IncrrctInf {
+			r.IncrrctInf[i].DeepCopyInto(&out.IncrrctInf[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ModifyLimitV07) DeepCopy() *ModifyLimitV07 {
+	if r == nil {
+		return nil
+	}
+	out := new(ModifyLimitV07)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ModifyLimitV07) DeepCopyInto(out *ModifyLimitV07) {
+	*out = *r
+	r.MsgHdr.DeepCopyInto(&out.MsgHdr)
+	if r.LmtDtls != nil {
+		out.LmtDtls = make([]LimitStructure3, len(r.LmtDtls))
+		for i := range r.LmtDtls {
+			r.LmtDtls[i].DeepCopyInto(&out.LmtDtls[i])
+		}
+	}
+	if r.SplmtryData != nil {
+		out.SplmtryData = make([]SupplementaryData1, len(r.SplmtryData))
+		for i := range r.SplmtryData {
+			r.SplmtryData[i].DeepCopyInto(&out.SplmtryData[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *OrganisationIdentification29) DeepCopy() *OrganisationIdentification29 {
+	if r == nil {
+		return nil
+	}
+	out := new(OrganisationIdentification29)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *OrganisationIdentification29) DeepCopyInto(out *OrganisationIdentification29) {
+	*out = *r
+	if r.AnyBIC != nil {
+		out.AnyBIC = new(common.AnyBICDec2014Identifier)
+		*out.AnyBIC = *r.AnyBIC
+	}
+	if r.LEI != nil {
+		out.LEI = new(common.LEIIdentifier)
+		*out.LEI = *r.LEI
+	}
+	if r.Othr != nil {
+		out.Othr = make([]GenericOrganisationIdentification1, len(r.Othr))
+		for i := range r.Othr {
+			r.Othr[i].DeepCopyInto(&out.Othr[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *OrganisationIdentificationSchemeName1Choice) DeepCopy() *OrganisationIdentificationSchemeName1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(OrganisationIdentificationSchemeName1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *OrganisationIdentificationSchemeName1Choice) DeepCopyInto(out *OrganisationIdentificationSchemeName1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *OriginalBusinessQuery1) DeepCopy() *OriginalBusinessQuery1 {
+	if r == nil {
+		return nil
+	}
+	out := new(OriginalBusinessQuery1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *OriginalBusinessQuery1) DeepCopyInto(out *OriginalBusinessQuery1) {
+	*out = *r
+	if r.MsgNmId != nil {
+		out.MsgNmId = new(common.Max35Text)
+		*out.MsgNmId = *r.MsgNmId
+	}
+	if r.CreDtTm != nil {
+		out.CreDtTm = new(common.ISODateTime)
+		*out.CreDtTm = *r.CreDtTm
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *OriginalGroupInformation29) DeepCopy() *OriginalGroupInformation29 {
+	if r == nil {
+		return nil
+	}
+	out := new(OriginalGroupInformation29)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *OriginalGroupInformation29) DeepCopyInto(out *OriginalGroupInformation29) {
+	*out = *r
+	if r.OrgnlCreDtTm != nil {
+		out.OrgnlCreDtTm = new(common.ISODateTime)
+		*out.OrgnlCreDtTm = *r.OrgnlCreDtTm
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *OriginalTransactionReference28) DeepCopy() *OriginalTransactionReference28 {
+	if r == nil {
+		return nil
+	}
+	out := new(OriginalTransactionReference28)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *OriginalTransactionReference28) DeepCopyInto(out *OriginalTransactionReference28) {
+	*out = *r
+	if r.IntrBkSttlmAmt != nil {
+		out.IntrBkSttlmAmt = new(ActiveOrHistoricCurrencyAndAmount)
+		*out.IntrBkSttlmAmt = *r.IntrBkSttlmAmt
+	}
+	if r.Amt != nil {
+		out.Amt = new(AmountType4Choice)
+		r.Amt.DeepCopyInto(out.Amt)
+	}
+	if r.IntrBkSttlmDt != nil {
+		out.IntrBkSttlmDt = new(common.ISODate)
+		*out.IntrBkSttlmDt = *r.IntrBkSttlmDt
+	}
+	if r.ReqdColltnDt != nil {
+		out.ReqdColltnDt = new(common.ISODate)
+		*out.ReqdColltnDt = *r.ReqdColltnDt
+	}
+	if r.ReqdExctnDt != nil {
+		out.ReqdExctnDt = new(*DateAndDateTime2Choice)
+		*out.ReqdExctnDt = *r.ReqdExctnDt
+		if (*r.ReqdExctnDt) != nil {
+			(*out.ReqdExctnDt) = new(DateAndDateTime2Choice)
+			*(*out.ReqdExctnDt) = *(*r.ReqdExctnDt)
+		}
+	}
+	if r.CdtrSchmeId != nil {
+		out.CdtrSchmeId = new(PartyIdentification135)
+		r.CdtrSchmeId.DeepCopyInto(out.CdtrSchmeId)
+	}
+	if r.SttlmInf != nil {
+		out.SttlmInf = new(SettlementInstruction7)
+		r.SttlmInf.DeepCopyInto(out.SttlmInf)
+	}
+	if r.PmtTpInf != nil {
+		out.PmtTpInf = new(PaymentTypeInformation27)
+		r.PmtTpInf.DeepCopyInto(out.PmtTpInf)
+	}
+	if r.PmtMtd != nil {
+		out.PmtMtd = new(PaymentMethod4Code)
+		*out.PmtMtd = *r.PmtMtd
+	}
+	if r.MndtRltdInf != nil {
+		out.MndtRltdInf = new(MandateRelatedInformation14)
+		r.MndtRltdInf.DeepCopyInto(out.MndtRltdInf)
+	}
+	if r.RmtInf != nil {
+		out.RmtInf = new(RemittanceInformation16)
+		r.RmtInf.DeepCopyInto(out.RmtInf)
+	}
+	if r.UltmtDbtr != nil {
+		out.UltmtDbtr = new(Party40Choice)
+		r.UltmtDbtr.DeepCopyInto(out.UltmtDbtr)
+	}
+	if r.Dbtr != nil {
+		out.Dbtr = new(Party40Choice)
+		r.Dbtr.DeepCopyInto(out.Dbtr)
+	}
+	if r.DbtrAcct != nil {
+		out.DbtrAcct = new(CashAccount38)
+		r.DbtrAcct.DeepCopyInto(out.DbtrAcct)
+	}
+	if r.DbtrAgt != nil {
+		out.DbtrAgt = new(BranchAndFinancialInstitutionIdentification6)
+		r.DbtrAgt.DeepCopyInto(out.DbtrAgt)
+	}
+	if r.DbtrAgtAcct != nil {
+		out.DbtrAgtAcct = new(CashAccount38)
+		r.DbtrAgtAcct.DeepCopyInto(out.DbtrAgtAcct)
+	}
+	if r.CdtrAgt != nil {
+		out.CdtrAgt = new(BranchAndFinancialInstitutionIdentification6)
+		r.CdtrAgt.DeepCopyInto(out.CdtrAgt)
+	}
+	if r.CdtrAgtAcct != nil {
+		out.CdtrAgtAcct = new(CashAccount38)
+		r.CdtrAgtAcct.DeepCopyInto(out.CdtrAgtAcct)
+	}
+	if r.Cdtr != nil {
+		out.Cdtr = new(Party40Choice)
+		r.Cdtr.DeepCopyInto(out.Cdtr)
+	}
+	if r.CdtrAcct != nil {
+		out.CdtrAcct = new(CashAccount38)
+		r.CdtrAcct.DeepCopyInto(out.CdtrAcct)
+	}
+	if r.UltmtCdtr != nil {
+		out.UltmtCdtr = new(Party40Choice)
+		r.UltmtCdtr.DeepCopyInto(out.UltmtCdtr)
+	}
+	if r.Purp != nil {
+		out.Purp = new(Purpose2Choice)
+		*out.Purp = *r.Purp
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *OriginalTransactionReference31) DeepCopy() *OriginalTransactionReference31 {
+	if r == nil {
+		return nil
+	}
+	out := new(OriginalTransactionReference31)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *OriginalTransactionReference31) DeepCopyInto(out *OriginalTransactionReference31) {
+	*out = *r
+	if r.IntrBkSttlmAmt != nil {
+		out.IntrBkSttlmAmt = new(ActiveOrHistoricCurrencyAndAmount)
+		*out.IntrBkSttlmAmt = *r.IntrBkSttlmAmt
+	}
+	if r.Amt != nil {
+		out.Amt = new(AmountType4Choice)
+		r.Amt.DeepCopyInto(out.Amt)
+	}
+	if r.IntrBkSttlmDt != nil {
+		out.IntrBkSttlmDt = new(common.ISODate)
+		*out.IntrBkSttlmDt = *r.IntrBkSttlmDt
+	}
+	if r.ReqdColltnDt != nil {
+		out.ReqdColltnDt = new(common.ISODate)
+		*out.ReqdColltnDt = *r.ReqdColltnDt
+	}
+	if r.ReqdExctnDt != nil {
+		out.ReqdExctnDt = new(DateAndDateTime2Choice)
+		*out.ReqdExctnDt = *r.ReqdExctnDt
+	}
+	if r.CdtrSchmeId != nil {
+		out.CdtrSchmeId = new(PartyIdentification135)
+		r.CdtrSchmeId.DeepCopyInto(out.CdtrSchmeId)
+	}
+	if r.SttlmInf != nil {
+		out.SttlmInf = new(SettlementInstruction7)
+		r.SttlmInf.DeepCopyInto(out.SttlmInf)
+	}
+	if r.PmtTpInf != nil {
+		out.PmtTpInf = new(PaymentTypeInformation27)
+		r.PmtTpInf.DeepCopyInto(out.PmtTpInf)
+	}
+	if r.PmtMtd != nil {
+		out.PmtMtd = new(PaymentMethod4Code)
+		*out.PmtMtd = *r.PmtMtd
+	}
+	if r.MndtRltdInf != nil {
+		out.MndtRltdInf = new(MandateRelatedData1Choice)
+		r.MndtRltdInf.DeepCopyInto(out.MndtRltdInf)
+	}
+	if r.RmtInf != nil {
+		out.RmtInf = new(RemittanceInformation16)
+		r.RmtInf.DeepCopyInto(out.RmtInf)
+	}
+	if r.UltmtDbtr != nil {
+		out.UltmtDbtr = new(Party40Choice)
+		r.UltmtDbtr.DeepCopyInto(out.UltmtDbtr)
+	}
+	if r.Dbtr != nil {
+		out.Dbtr = new(Party40Choice)
+		r.Dbtr.DeepCopyInto(out.Dbtr)
+	}
+	if r.DbtrAcct != nil {
+		out.DbtrAcct = new(CashAccount38)
+		r.DbtrAcct.DeepCopyInto(out.DbtrAcct)
+	}
+	if r.DbtrAgt != nil {
+		out.DbtrAgt = new(BranchAndFinancialInstitutionIdentification6)
+		r.DbtrAgt.DeepCopyInto(out.DbtrAgt)
+	}
+	if r.DbtrAgtAcct != nil {
+		out.DbtrAgtAcct = new(CashAccount38)
+		r.DbtrAgtAcct.DeepCopyInto(out.DbtrAgtAcct)
+	}
+	if r.CdtrAgt != nil {
+		out.CdtrAgt = new(BranchAndFinancialInstitutionIdentification6)
+		r.CdtrAgt.DeepCopyInto(out.CdtrAgt)
+	}
+	if r.CdtrAgtAcct != nil {
+		out.CdtrAgtAcct = new(CashAccount38)
+		r.CdtrAgtAcct.DeepCopyInto(out.CdtrAgtAcct)
+	}
+	if r.Cdtr != nil {
+		out.Cdtr = new(Party40Choice)
+		r.Cdtr.DeepCopyInto(out.Cdtr)
+	}
+	if r.CdtrAcct != nil {
+		out.CdtrAcct = new(CashAccount38)
+		r.CdtrAcct.DeepCopyInto(out.CdtrAcct)
+	}
+	if r.UltmtCdtr != nil {
+		out.UltmtCdtr = new(Party40Choice)
+		r.UltmtCdtr.DeepCopyInto(out.UltmtCdtr)
+	}
+	if r.Purp != nil {
+		out.Purp = new(Purpose2Choice)
+		*out.Purp = *r.Purp
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *OtherContact1) DeepCopy() *OtherContact1 {
+	if r == nil {
+		return nil
+	}
+	out := new(OtherContact1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *OtherContact1) DeepCopyInto(out *OtherContact1) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *Party38Choice) DeepCopy() *Party38Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(Party38Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *Party38Choice) DeepCopyInto(out *Party38Choice) {
+	*out = *r
+	r.OrgId.DeepCopyInto(&out.OrgId)
+	r.PrvtId.DeepCopyInto(&out.PrvtId)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *Party40Choice) DeepCopy() *Party40Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(Party40Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *Party40Choice) DeepCopyInto(out *Party40Choice) {
+	*out = *r
+	r.Pty.DeepCopyInto(&out.Pty)
+	r.Agt.DeepCopyInto(&out.Agt)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *PartyIdentification135) DeepCopy() *PartyIdentification135 {
+	if r == nil {
+		return nil
+	}
+	out := new(PartyIdentification135)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *PartyIdentification135) DeepCopyInto(out *PartyIdentification135) {
+	*out = *r
+	if r.Nm != nil {
+		out.Nm = new(common.Max140Text)
+		*out.Nm = *r.Nm
+	}
+	if r.PstlAdr != nil {
+		out.PstlAdr = new(PostalAddress24)
+		r.PstlAdr.DeepCopyInto(out.PstlAdr)
+	}
+	if r.Id != nil {
+		out.Id = new(Party38Choice)
+		r.Id.DeepCopyInto(out.Id)
+	}
+	if r.CtryOfRes != nil {
+		out.CtryOfRes = new(common.CountryCode)
+		*out.CtryOfRes = *r.CtryOfRes
+	}
+	if r.CtctDtls != nil {
+		out.CtctDtls = new(Contact4)
+		r.CtctDtls.DeepCopyInto(out.CtctDtls)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *PaymentInstruction13) DeepCopy() *PaymentInstruction13 {
+	if r == nil {
+		return nil
+	}
+	out := new(PaymentInstruction13)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *PaymentInstruction13) DeepCopyInto(out *PaymentInstruction13) {
+	*out = *r
+	if r.ReqdExctnDtTm != nil {
+		out.ReqdExctnDtTm = new(common.ISODateTime)
+		*out.ReqdExctnDtTm = *r.ReqdExctnDtTm
+	}
+	if r.PmtTp != nil {
+		out.PmtTp = new(PaymentType4Choice)
+		*out.PmtTp = *r.PmtTp
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *PaymentType4Choice) DeepCopy() *PaymentType4Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(PaymentType4Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *PaymentType4Choice) DeepCopyInto(out *PaymentType4Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *PaymentTypeInformation27) DeepCopy() *PaymentTypeInformation27 {
+	if r == nil {
+		return nil
+	}
+	out := new(PaymentTypeInformation27)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *PaymentTypeInformation27) DeepCopyInto(out *PaymentTypeInformation27) {
+	*out = *r
+	if r.InstrPrty != nil {
+		out.InstrPrty = new(Priority2Code)
+		*out.InstrPrty = *r.InstrPrty
+	}
+	if r.ClrChanl != nil {
+		out.ClrChanl = new(ClearingChannel2Code)
+		*out.ClrChanl = *r.ClrChanl
+	}
+	if r.SvcLvl != nil {
+		out.SvcLvl = make([]ServiceLevel8Choice, len(r.SvcLvl))
+		copy(out.SvcLvl, r.SvcLvl)
+	}
+	if r.LclInstrm != nil {
+		out.LclInstrm = new(LocalInstrument2Choice)
+		*out.LclInstrm = *r.LclInstrm
+	}
+	if r.SeqTp != nil {
+		out.SeqTp = new(SequenceType3Code)
+		*out.SeqTp = *r.SeqTp
+	}
+	if r.CtgyPurp != nil {
+		out.CtgyPurp = new(CategoryPurpose1Choice)
+		*out.CtgyPurp = *r.CtgyPurp
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *PercentageRange1Choice) DeepCopy() *PercentageRange1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(PercentageRange1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *PercentageRange1Choice) DeepCopyInto(out *PercentageRange1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *PercentageRangeBoundary1) DeepCopy() *PercentageRangeBoundary1 {
+	if r == nil {
+		return nil
+	}
+	out := new(PercentageRangeBoundary1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *PercentageRangeBoundary1) DeepCopyInto(out *PercentageRangeBoundary1) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *Period2) DeepCopy() *Period2 {
+	if r == nil {
+		return nil
+	}
+	out := new(Period2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *Period2) DeepCopyInto(out *Period2) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *PersonIdentification13) DeepCopy() *PersonIdentification13 {
+	if r == nil {
+		return nil
+	}
+	out := new(PersonIdentification13)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *PersonIdentification13) DeepCopyInto(out *PersonIdentification13) {
+	*out = *r
+	if r.DtAndPlcOfBirth != nil {
+		out.DtAndPlcOfBirth = new(DateAndPlaceOfBirth1)
+		r.DtAndPlcOfBirth.DeepCopyInto(out.DtAndPlcOfBirth)
+	}
+	if r.Othr != nil {
+		out.Othr = make([]GenericPersonIdentification1, len(r.Othr))
+		for i := range r.Othr {
+			r.Othr[i].DeepCopyInto(&out.Othr[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *PersonIdentificationSchemeName1Choice) DeepCopy() *PersonIdentificationSchemeName1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(PersonIdentificationSchemeName1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *PersonIdentificationSchemeName1Choice) DeepCopyInto(out *PersonIdentificationSchemeName1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *PostalAddress24) DeepCopy() *PostalAddress24 {
+	if r == nil {
+		return nil
+	}
+	out := new(PostalAddress24)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *PostalAddress24) DeepCopyInto(out *PostalAddress24) {
+	*out = *r
+	if r.AdrTp != nil {
+		out.AdrTp = new(AddressType3Choice)
+		r.AdrTp.DeepCopyInto(out.AdrTp)
+	}
+	if r.Dept != nil {
+		out.Dept = new(common.Max70Text)
+		*out.Dept = *r.Dept
+	}
+	if r.SubDept != nil {
+		out.SubDept = new(common.Max70Text)
+		*out.SubDept = *r.SubDept
+	}
+	if r.StrtNm != nil {
+		out.StrtNm = new(common.Max70Text)
+		*out.StrtNm = *r.StrtNm
+	}
+	if r.BldgNb != nil {
+		out.BldgNb = new(common.Max16Text)
+		*out.BldgNb = *r.BldgNb
+	}
+	if r.BldgNm != nil {
+		out.BldgNm = new(common.Max35Text)
+		*out.BldgNm = *r.BldgNm
+	}
+	if r.Flr != nil {
+		out.Flr = new(common.Max70Text)
+		*out.Flr = *r.Flr
+	}
+	if r.PstBx != nil {
+		out.PstBx = new(common.Max16Text)
+		*out.PstBx = *r.PstBx
+	}
+	if r.Room != nil {
+		out.Room = new(common.Max70Text)
+		*out.Room = *r.Room
+	}
+	if r.PstCd != nil {
+		out.PstCd = new(common.Max16Text)
+		*out.PstCd = *r.PstCd
+	}
+	if r.TwnNm != nil {
+		out.TwnNm = new(common.Max35Text)
+		*out.TwnNm = *r.TwnNm
+	}
+	if r.TwnLctnNm != nil {
+		out.TwnLctnNm = new(common.Max35Text)
+		*out.TwnLctnNm = *r.TwnLctnNm
+	}
+	if r.DstrctNm != nil {
+		out.DstrctNm = new(common.Max35Text)
+		*out.DstrctNm = *r.DstrctNm
+	}
+	if r.CtrySubDvsn != nil {
+		out.CtrySubDvsn = new(common.Max35Text)
+		*out.CtrySubDvsn = *r.CtrySubDvsn
+	}
+	if r.Ctry != nil {
+		out.Ctry = new(common.CountryCode)
+		*out.Ctry = *r.Ctry
+	}
+	if r.AdrLine != nil {
+		out.AdrLine = make([]common.Max70Text, len(r.AdrLine))
+		copy(out.AdrLine, r.AdrLine)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ProxyAccountIdentification1) DeepCopy() *ProxyAccountIdentification1 {
+	if r == nil {
+		return nil
+	}
+	out := new(ProxyAccountIdentification1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ProxyAccountIdentification1) DeepCopyInto(out *ProxyAccountIdentification1) {
+	*out = *r
+	if r.Tp != nil {
+		out.Tp = new(ProxyAccountType1Choice)
+		*out.Tp = *r.Tp
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ProxyAccountType1Choice) DeepCopy() *ProxyAccountType1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(ProxyAccountType1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ProxyAccountType1Choice) DeepCopyInto(out *ProxyAccountType1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *Purpose2Choice) DeepCopy() *Purpose2Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(Purpose2Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *Purpose2Choice) DeepCopyInto(out *Purpose2Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ReferredDocumentInformation7) DeepCopy() *ReferredDocumentInformation7 {
+	if r == nil {
+		return nil
+	}
+	out := new(ReferredDocumentInformation7)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ReferredDocumentInformation7) DeepCopyInto(out *ReferredDocumentInformation7) {
+	*out = *r
+	if r.Tp != nil {
+		out.Tp = new(ReferredDocumentType4)
+		r.Tp.DeepCopyInto(out.Tp)
+	}
+	if r.Nb != nil {
+		out.Nb = new(common.Max35Text)
+		*out.Nb = *r.Nb
+	}
+	if r.RltdDt != nil {
+		out.RltdDt = new(common.ISODate)
+		*out.RltdDt = *r.RltdDt
+	}
+	if r.LineDtls != nil {
+		out.LineDtls = make([]DocumentLineInformation1, len(r.LineDtls))
+		for i := range r.LineDtls {
+			r.LineDtls[i].DeepCopyInto(&out.LineDtls[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ReferredDocumentType3Choice) DeepCopy() *ReferredDocumentType3Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(ReferredDocumentType3Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ReferredDocumentType3Choice) DeepCopyInto(out *ReferredDocumentType3Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ReferredDocumentType4) DeepCopy() *ReferredDocumentType4 {
+	if r == nil {
+		return nil
+	}
+	out := new(ReferredDocumentType4)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ReferredDocumentType4) DeepCopyInto(out *ReferredDocumentType4) {
+	*out = *r
+	if r.Issr != nil {
+		out.Issr = new(common.Max35Text)
+		*out.Issr = *r.Issr
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *RemittanceAmount2) DeepCopy() *RemittanceAmount2 {
+	if r == nil {
+		return nil
+	}
+	out := new(RemittanceAmount2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *RemittanceAmount2) DeepCopyInto(out *RemittanceAmount2) {
+	*out = *r
+	if r.DuePyblAmt != nil {
+		out.DuePyblAmt = new(ActiveOrHistoricCurrencyAndAmount)
+		*out.DuePyblAmt = *r.DuePyblAmt
+	}
+	if r.DscntApldAmt != nil {
+		out.DscntApldAmt = make([]DiscountAmountAndType1, len(r.DscntApldAmt))
+		for i := range r.DscntApldAmt {
+			r.DscntApldAmt[i].DeepCopyInto(&out.DscntApldAmt[i])
+		}
+	}
+	if r.CdtNoteAmt != nil {
+		out.CdtNoteAmt = new(ActiveOrHistoricCurrencyAndAmount)
+		*out.CdtNoteAmt = *r.CdtNoteAmt
+	}
+	if r.TaxAmt != nil {
+		out.TaxAmt = make([]TaxAmountAndType1, len(r.TaxAmt))
+		for i := range r.TaxAmt {
+			r.TaxAmt[i].DeepCopyInto(&out.TaxAmt[i])
+		}
+	}
+	if r.AdjstmntAmtAndRsn != nil {
+		out.AdjstmntAmtAndRsn = make([]DocumentAdjustment1, len(r.AdjstmntAmtAndRsn))
+		for i := range r.AdjstmntAmtAndRsn {
+			r.AdjstmntAmtAndRsn[i].DeepCopyInto(&out.AdjstmntAmtAndRsn[i])
+		}
+	}
+	if r.RmtdAmt != nil {
+		out.RmtdAmt = new(ActiveOrHistoricCurrencyAndAmount)
+		*out.RmtdAmt = *r.RmtdAmt
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *RemittanceAmount3) DeepCopy() *RemittanceAmount3 {
+	if r == nil {
+		return nil
+	}
+	out := new(RemittanceAmount3)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *RemittanceAmount3) DeepCopyInto(out *RemittanceAmount3) {
+	*out = *r
+	if r.DuePyblAmt != nil {
+		out.DuePyblAmt = new(ActiveOrHistoricCurrencyAndAmount)
+		*out.DuePyblAmt = *r.DuePyblAmt
+	}
+	if r.DscntApldAmt != nil {
+		out.DscntApldAmt = make([]DiscountAmountAndType1, len(r.DscntApldAmt))
+		for i := range r.DscntApldAmt {
+			r.DscntApldAmt[i].DeepCopyInto(&out.DscntApldAmt[i])
+		}
+	}
+	if r.CdtNoteAmt != nil {
+		out.CdtNoteAmt = new(ActiveOrHistoricCurrencyAndAmount)
+		*out.CdtNoteAmt = *r.CdtNoteAmt
+	}
+	if r.TaxAmt != nil {
+		out.TaxAmt = make([]TaxAmountAndType1, len(r.TaxAmt))
+		for i := range r.TaxAmt {
+			r.TaxAmt[i].DeepCopyInto(&out.TaxAmt[i])
+		}
+	}
+	if r.AdjstmntAmtAndRsn != nil {
+		out.AdjstmntAmtAndRsn = make([]DocumentAdjustment1, len(r.AdjstmntAmtAndRsn))
+		for i := range r.AdjstmntAmtAndRsn {
+			r.AdjstmntAmtAndRsn[i].DeepCopyInto(&out.AdjstmntAmtAndRsn[i])
+		}
+	}
+	if r.RmtdAmt != nil {
+		out.RmtdAmt = new(ActiveOrHistoricCurrencyAndAmount)
+		*out.RmtdAmt = *r.RmtdAmt
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *RemittanceInformation16) DeepCopy() *RemittanceInformation16 {
+	if r == nil {
+		return nil
+	}
+	out := new(RemittanceInformation16)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *RemittanceInformation16) DeepCopyInto(out *RemittanceInformation16) {
+	*out = *r
+	if r.Ustrd != nil {
+		out.Ustrd = make([]common.Max140Text, len(r.Ustrd))
+		copy(out.Ustrd, r.Ustrd)
+	}
+	if r.Strd != nil {
+		out.Strd = make([]StructuredRemittanceInformation16, len(r.Strd))
+		for i := range r.Strd {
+			r.Strd[i].DeepCopyInto(&out.Strd[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *RequestToModifyPaymentV07) DeepCopy() *RequestToModifyPaymentV07 {
+	if r == nil {
+		return nil
+	}
+	out := new(RequestToModifyPaymentV07)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *RequestToModifyPaymentV07) DeepCopyInto(out *RequestToModifyPaymentV07) {
+	*out = *r
+	r.Assgnmt.DeepCopyInto(&out.Assgnmt)
+	if r.Case != nil {
+		out.Case = new(Case5)
+		r.Case.DeepCopyInto(out.Case)
+	}
+	r.Undrlyg.DeepCopyInto(&out.Undrlyg)
+	r.Mod.DeepCopyInto(&out.Mod)
+	if r.InstrForAssgne != nil {
+		out.InstrForAssgne = new(InstructionForAssignee1)
+		r.InstrForAssgne.DeepCopyInto(out.InstrForAssgne)
+	}
+	if r.SplmtryData != nil {
+		out.SplmtryData = make([]SupplementaryData1, len(r.SplmtryData))
+		for i := range r.SplmtryData {
+			r.SplmtryData[i].DeepCopyInto(&out.SplmtryData[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *RequestType4Choice) DeepCopy() *RequestType4Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(RequestType4Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *RequestType4Choice) DeepCopyInto(out *RequestType4Choice) {
+	*out = *r
+	r.Prtry.DeepCopyInto(&out.Prtry)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *RequestedModification9) DeepCopy() *RequestedModification9 {
+	if r == nil {
+		return nil
+	}
+	out := new(RequestedModification9)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *RequestedModification9) DeepCopyInto(out *RequestedModification9) {
+	*out = *r
+	if r.InstrId != nil {
+		out.InstrId = new(common.Max35Text)
+		*out.InstrId = *r.InstrId
+	}
+	if r.EndToEndId != nil {
+		out.EndToEndId = new(common.Max35Text)
+		*out.EndToEndId = *r.EndToEndId
+	}
+	if r.TxId != nil {
+		out.TxId = new(common.Max35Text)
+		*out.TxId = *r.TxId
+	}
+	if r.PmtTpInf != nil {
+		out.PmtTpInf = new(PaymentTypeInformation27)
+		r.PmtTpInf.DeepCopyInto(out.PmtTpInf)
+	}
+	if r.ReqdExctnDt != nil {
+		out.ReqdExctnDt = new(DateAndDateTime2Choice)
+		*out.ReqdExctnDt = *r.ReqdExctnDt
+	}
+	if r.ReqdColltnDt != nil {
+		out.ReqdColltnDt = new(common.ISODate)
+		*out.ReqdColltnDt = *r.ReqdColltnDt
+	}
+	if r.IntrBkSttlmDt != nil {
+		out.IntrBkSttlmDt = new(common.ISODate)
+		*out.IntrBkSttlmDt = *r.IntrBkSttlmDt
+	}
+	if r.SttlmTmIndctn != nil {
+		out.SttlmTmIndctn = new(SettlementDateTimeIndication1)
+		r.SttlmTmIndctn.DeepCopyInto(out.SttlmTmIndctn)
+	}
+	if r.Amt != nil {
+		out.Amt = new(AmountType4Choice)
+		r.Amt.DeepCopyInto(out.Amt)
+	}
+	if r.IntrBkSttlmAmt != nil {
+		out.IntrBkSttlmAmt = new(ActiveOrHistoricCurrencyAndAmount)
+		*out.IntrBkSttlmAmt = *r.IntrBkSttlmAmt
+	}
+	if r.ChrgBr != nil {
+		out.ChrgBr = new(ChargeBearerType1Code)
+		*out.ChrgBr = *r.ChrgBr
+	}
+	if r.UltmtDbtr != nil {
+		out.UltmtDbtr = new(PartyIdentification135)
+		r.UltmtDbtr.DeepCopyInto(out.UltmtDbtr)
+	}
+	if r.Dbtr != nil {
+		out.Dbtr = new(PartyIdentification135)
+		r.Dbtr.DeepCopyInto(out.Dbtr)
+	}
+	if r.DbtrAcct != nil {
+		out.DbtrAcct = new(CashAccount38)
+		r.DbtrAcct.DeepCopyInto(out.DbtrAcct)
+	}
+	if r.DbtrAgtAcct != nil {
+		out.DbtrAgtAcct = new(CashAccount38)
+		r.DbtrAgtAcct.DeepCopyInto(out.DbtrAgtAcct)
+	}
+	if r.SttlmInf != nil {
+		out.SttlmInf = new(SettlementInstruction6)
+		r.SttlmInf.DeepCopyInto(out.SttlmInf)
+	}
+	if r.CdtrAgtAcct != nil {
+		out.CdtrAgtAcct = new(CashAccount38)
+		r.CdtrAgtAcct.DeepCopyInto(out.CdtrAgtAcct)
+	}
+	if r.Cdtr != nil {
+		out.Cdtr = new(PartyIdentification135)
+		r.Cdtr.DeepCopyInto(out.Cdtr)
+	}
+	if r.CdtrAcct != nil {
+		out.CdtrAcct = new(CashAccount38)
+		r.CdtrAcct.DeepCopyInto(out.CdtrAcct)
+	}
+	if r.UltmtCdtr != nil {
+		out.UltmtCdtr = new(PartyIdentification135)
+		r.UltmtCdtr.DeepCopyInto(out.UltmtCdtr)
+	}
+	if r.Purp != nil {
+		out.Purp = new(Purpose2Choice)
+		*out.Purp = *r.Purp
+	}
+	if r.InstrForDbtrAgt != nil {
+		out.InstrForDbtrAgt = new(common.Max140Text)
+		*out.InstrForDbtrAgt = *r.InstrForDbtrAgt
+	}
+	if r.InstrForNxtAgt != nil {
+		out.InstrForNxtAgt = make([]InstructionForNextAgent1, len(r.InstrForNxtAgt))
+		for i := range r.InstrForNxtAgt {
+			r.InstrForNxtAgt[i].DeepCopyInto(&out.InstrForNxtAgt[i])
+		}
+	}
+	if r.InstrForCdtrAgt != nil {
+		out.InstrForCdtrAgt = make([]InstructionForCreditorAgent3, len(r.InstrForCdtrAgt))
+		for i := range r.InstrForCdtrAgt {
+			r.InstrForCdtrAgt[i].DeepCopyInto(&out.InstrForCdtrAgt[i])
+		}
+	}
+	if r.RmtInf != nil {
+		out.RmtInf = new(RemittanceInformation16)
+		r.RmtInf.DeepCopyInto(out.RmtInf)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ReturnBusinessDayInformationV07) DeepCopy() *ReturnBusinessDayInformationV07 {
+	if r == nil {
+		return nil
+	}
+	out := new(ReturnBusinessDayInformationV07)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ReturnBusinessDayInformationV07) DeepCopyInto(out *ReturnBusinessDayInformationV07) {
+	*out = *r
+	r.MsgHdr.DeepCopyInto(&out.MsgHdr)
+	r.RptOrErr.DeepCopyInto(&out.RptOrErr)
+	if r.SplmtryData != nil {
+		out.SplmtryData = make([]SupplementaryData1, len(r.SplmtryData))
+		for i := range r.SplmtryData {
+			r.SplmtryData[i].DeepCopyInto(&out.SplmtryData[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ServiceLevel8Choice) DeepCopy() *ServiceLevel8Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(ServiceLevel8Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ServiceLevel8Choice) DeepCopyInto(out *ServiceLevel8Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *SettlementDateTimeIndication1) DeepCopy() *SettlementDateTimeIndication1 {
+	if r == nil {
+		return nil
+	}
+	out := new(SettlementDateTimeIndication1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *SettlementDateTimeIndication1) DeepCopyInto(out *SettlementDateTimeIndication1) {
+	*out = *r
+	if r.DbtDtTm != nil {
+		out.DbtDtTm = new(common.ISODateTime)
+		*out.DbtDtTm = *r.DbtDtTm
+	}
+	if r.CdtDtTm != nil {
+		out.CdtDtTm = new(common.ISODateTime)
+		*out.CdtDtTm = *r.CdtDtTm
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *SettlementInstruction6) DeepCopy() *SettlementInstruction6 {
+	if r == nil {
+		return nil
+	}
+	out := new(SettlementInstruction6)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *SettlementInstruction6) DeepCopyInto(out *SettlementInstruction6) {
+	*out = *r
+	if r.InstgRmbrsmntAgt != nil {
+		out.InstgRmbrsmntAgt = new(BranchAndFinancialInstitutionIdentification6)
+		r.InstgRmbrsmntAgt.DeepCopyInto(out.InstgRmbrsmntAgt)
+	}
+	if r.InstgRmbrsmntAgtAcct != nil {
+		out.InstgRmbrsmntAgtAcct = new(CashAccount38)
+		r.InstgRmbrsmntAgtAcct.DeepCopyInto(out.InstgRmbrsmntAgtAcct)
+	}
+	if r.InstdRmbrsmntAgt != nil {
+		out.InstdRmbrsmntAgt = new(BranchAndFinancialInstitutionIdentification6)
+		r.InstdRmbrsmntAgt.DeepCopyInto(out.InstdRmbrsmntAgt)
+	}
+	if r.InstdRmbrsmntAgtAcct != nil {
+		out.InstdRmbrsmntAgtAcct = new(CashAccount38)
+		r.InstdRmbrsmntAgtAcct.DeepCopyInto(out.InstdRmbrsmntAgtAcct)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *SettlementInstruction7) DeepCopy() *SettlementInstruction7 {
+	if r == nil {
+		return nil
+	}
+	out := new(SettlementInstruction7)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *SettlementInstruction7) DeepCopyInto(out *SettlementInstruction7) {
+	*out = *r
+	if r.SttlmAcct != nil {
+		out.SttlmAcct = new(CashAccount38)
+		r.SttlmAcct.DeepCopyInto(out.SttlmAcct)
+	}
+	if r.ClrSys != nil {
+		out.ClrSys = new(ClearingSystemIdentification3Choice)
+		*out.ClrSys = *r.ClrSys
+	}
+	if r.InstgRmbrsmntAgt != nil {
+		out.InstgRmbrsmntAgt = new(BranchAndFinancialInstitutionIdentification6)
+		r.InstgRmbrsmntAgt.DeepCopyInto(out.InstgRmbrsmntAgt)
+	}
+	if r.InstgRmbrsmntAgtAcct != nil {
+		out.InstgRmbrsmntAgtAcct = new(CashAccount38)
+		r.InstgRmbrsmntAgtAcct.DeepCopyInto(out.InstgRmbrsmntAgtAcct)
+	}
+	if r.InstdRmbrsmntAgt != nil {
+		out.InstdRmbrsmntAgt = new(BranchAndFinancialInstitutionIdentification6)
+		r.InstdRmbrsmntAgt.DeepCopyInto(out.InstdRmbrsmntAgt)
+	}
+	if r.InstdRmbrsmntAgtAcct != nil {
+		out.InstdRmbrsmntAgtAcct = new(CashAccount38)
+		r.InstdRmbrsmntAgtAcct.DeepCopyInto(out.InstdRmbrsmntAgtAcct)
+	}
+	if r.ThrdRmbrsmntAgt != nil {
+		out.ThrdRmbrsmntAgt = new(BranchAndFinancialInstitutionIdentification6)
+		r.ThrdRmbrsmntAgt.DeepCopyInto(out.ThrdRmbrsmntAgt)
+	}
+	if r.ThrdRmbrsmntAgtAcct != nil {
+		out.ThrdRmbrsmntAgtAcct = new(CashAccount38)
+		r.ThrdRmbrsmntAgtAcct.DeepCopyInto(out.ThrdRmbrsmntAgtAcct)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *StructuredRemittanceInformation16) DeepCopy() *StructuredRemittanceInformation16 {
+	if r == nil {
+		return nil
+	}
+	out := new(StructuredRemittanceInformation16)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *StructuredRemittanceInformation16) DeepCopyInto(out *StructuredRemittanceInformation16) {
+	*out = *r
+	if r.RfrdDocInf != nil {
+		out.RfrdDocInf = make([]ReferredDocumentInformation7, len(r.RfrdDocInf))
+		for i := range r.RfrdDocInf {
+			r.RfrdDocInf[i].DeepCopyInto(&out.RfrdDocInf[i])
+		}
+	}
+	if r.RfrdDocAmt != nil {
+		out.RfrdDocAmt = new(RemittanceAmount2)
+		r.RfrdDocAmt.DeepCopyInto(out.RfrdDocAmt)
+	}
+	if r.CdtrRefInf != nil {
+		out.CdtrRefInf = new(CreditorReferenceInformation2)
+		r.CdtrRefInf.DeepCopyInto(out.CdtrRefInf)
+	}
+	if r.Invcr != nil {
+		out.Invcr = new(PartyIdentification135)
+		r.Invcr.DeepCopyInto(out.Invcr)
+	}
+	if r.Invcee != nil {
+		out.Invcee = new(PartyIdentification135)
+		r.Invcee.DeepCopyInto(out.Invcee)
+	}
+	if r.TaxRmt != nil {
+		out.TaxRmt = new(TaxInformation7)
+		r.TaxRmt.DeepCopyInto(out.TaxRmt)
+	}
+	if r.GrnshmtRmt != nil {
+		out.GrnshmtRmt = new(Garnishment3)
+		r.GrnshmtRmt.DeepCopyInto(out.GrnshmtRmt)
+	}
+	if r.AddtlRmtInf != nil {
+		out.AddtlRmtInf = make([]common.Max140Text, len(r.AddtlRmtInf))
+		copy(out.AddtlRmtInf, r.AddtlRmtInf)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *SupplementaryData1) DeepCopy() *SupplementaryData1 {
+	if r == nil {
+		return nil
+	}
+	out := new(SupplementaryData1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *SupplementaryData1) DeepCopyInto(out *SupplementaryData1) {
+	*out = *r
+	if r.PlcAndNm != nil {
+		out.PlcAndNm = new(common.Max350Text)
+		*out.PlcAndNm = *r.PlcAndNm
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *SupplementaryDataEnvelope1) DeepCopy() *SupplementaryDataEnvelope1 {
+	if r == nil {
+		return nil
+	}
+	out := new(SupplementaryDataEnvelope1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *SupplementaryDataEnvelope1) DeepCopyInto(out *SupplementaryDataEnvelope1) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *SystemAvailabilityAndEvents3) DeepCopy() *SystemAvailabilityAndEvents3 {
+	if r == nil {
+		return nil
+	}
+	out := new(SystemAvailabilityAndEvents3)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *SystemAvailabilityAndEvents3) DeepCopyInto(out *SystemAvailabilityAndEvents3) {
+	*out = *r
+	if r.SysCcy != nil {
+		out.SysCcy = new(common.ActiveCurrencyCode)
+		*out.SysCcy = *r.SysCcy
+	}
+	if r.SsnPrd != nil {
+		out.SsnPrd = new(TimePeriod1)
+		*out.SsnPrd = *r.SsnPrd
+	}
+	if r.Evt != nil {
+		out.Evt = make([]SystemEvent3, len(r.Evt))
+		for i := range r.Evt {
+			r.Evt[i].DeepCopyInto(&out.Evt[i])
+		}
+	}
+	if r.ClsrInf != nil {
+		out.ClsrInf = make([]SystemClosure2, len(r.ClsrInf))
+		for i := range r.ClsrInf {
+			r.ClsrInf[i].DeepCopyInto(&out.ClsrInf[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *SystemClosure2) DeepCopy() *SystemClosure2 {
+	if r == nil {
+		return nil
+	}
+	out := new(SystemClosure2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *SystemClosure2) DeepCopyInto(out *SystemClosure2) {
+	*out = *r
+	if r.Prd != nil {
+		out.Prd = new(DateTimePeriod1Choice)
+		*out.Prd = *r.Prd
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *SystemEvent3) DeepCopy() *SystemEvent3 {
+	if r == nil {
+		return nil
+	}
+	out := new(SystemEvent3)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *SystemEvent3) DeepCopyInto(out *SystemEvent3) {
+	*out = *r
+	r.Tp.DeepCopyInto(&out.Tp)
+	if r.FctvTm != nil {
+		out.FctvTm = new(common.ISODateTime)
+		*out.FctvTm = *r.FctvTm
+	}
+	if r.StartTm != nil {
+		out.StartTm = new(common.ISODateTime)
+		*out.StartTm = *r.StartTm
+	}
+	if r.EndTm != nil {
+		out.EndTm = new(common.ISODateTime)
+		*out.EndTm = *r.EndTm
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *SystemEventType4Choice) DeepCopy() *SystemEventType4Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(SystemEventType4Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *SystemEventType4Choice) DeepCopyInto(out *SystemEventType4Choice) {
+	*out = *r
+	r.Prtry.DeepCopyInto(&out.Prtry)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *SystemIdentification2Choice) DeepCopy() *SystemIdentification2Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(SystemIdentification2Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *SystemIdentification2Choice) DeepCopyInto(out *SystemIdentification2Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *SystemMember3) DeepCopy() *SystemMember3 {
+	if r == nil {
+		return nil
+	}
+	out := new(SystemMember3)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *SystemMember3) DeepCopyInto(out *SystemMember3) {
+	*out = *r
+	if r.SysId != nil {
+		out.SysId = new(SystemIdentification2Choice)
+		*out.SysId = *r.SysId
+	}
+	r.MmbId.DeepCopyInto(&out.MmbId)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *SystemStatus2Choice) DeepCopy() *SystemStatus2Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(SystemStatus2Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *SystemStatus2Choice) DeepCopyInto(out *SystemStatus2Choice) {
+	*out = *r
+	r.Prtry.DeepCopyInto(&out.Prtry)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *SystemStatus3) DeepCopy() *SystemStatus3 {
+	if r == nil {
+		return nil
+	}
+	out := new(SystemStatus3)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *SystemStatus3) DeepCopyInto(out *SystemStatus3) {
+	*out = *r
+	r.Sts.DeepCopyInto(&out.Sts)
+	if r.VldtyTm != nil {
+		out.VldtyTm = new(DateTimePeriod1Choice)
+		*out.VldtyTm = *r.VldtyTm
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *TaxAmount2) DeepCopy() *TaxAmount2 {
+	if r == nil {
+		return nil
+	}
+	out := new(TaxAmount2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *TaxAmount2) DeepCopyInto(out *TaxAmount2) {
+	*out = *r
+	if r.TaxblBaseAmt != nil {
+		out.TaxblBaseAmt = new(ActiveOrHistoricCurrencyAndAmount)
+		*out.TaxblBaseAmt = *r.TaxblBaseAmt
+	}
+	if r.TtlAmt != nil {
+		out.TtlAmt = new(ActiveOrHistoricCurrencyAndAmount)
+		*out.TtlAmt = *r.TtlAmt
+	}
+	if r.Dtls != nil {
+		out.Dtls = make([]TaxRecordDetails2, len(r.Dtls))
+		for i := range r.Dtls {
+			r.Dtls[i].DeepCopyInto(&out.Dtls[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *TaxAmountAndType1) DeepCopy() *TaxAmountAndType1 {
+	if r == nil {
+		return nil
+	}
+	out := new(TaxAmountAndType1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *TaxAmountAndType1) DeepCopyInto(out *TaxAmountAndType1) {
+	*out = *r
+	if r.Tp != nil {
+		out.Tp = new(TaxAmountType1Choice)
+		*out.Tp = *r.Tp
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *TaxAmountType1Choice) DeepCopy() *TaxAmountType1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(TaxAmountType1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *TaxAmountType1Choice) DeepCopyInto(out *TaxAmountType1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *TaxAuthorisation1) DeepCopy() *TaxAuthorisation1 {
+	if r == nil {
+		return nil
+	}
+	out := new(TaxAuthorisation1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *TaxAuthorisation1) DeepCopyInto(out *TaxAuthorisation1) {
+	*out = *r
+	if r.Titl != nil {
+		out.Titl = new(common.Max35Text)
+		*out.Titl = *r.Titl
+	}
+	if r.Nm != nil {
+		out.Nm = new(common.Max140Text)
+		*out.Nm = *r.Nm
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *TaxInformation7) DeepCopy() *TaxInformation7 {
+	if r == nil {
+		return nil
+	}
+	out := new(TaxInformation7)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *TaxInformation7) DeepCopyInto(out *TaxInformation7) {
+	*out = *r
+	if r.Cdtr != nil {
+		out.Cdtr = new(TaxParty1)
+		r.Cdtr.DeepCopyInto(out.Cdtr)
+	}
+	if r.Dbtr != nil {
+		out.Dbtr = new(TaxParty2)
+		r.Dbtr.DeepCopyInto(out.Dbtr)
+	}
+	if r.UltmtDbtr != nil {
+		out.UltmtDbtr = new(TaxParty2)
+		r.UltmtDbtr.DeepCopyInto(out.UltmtDbtr)
+	}
+	if r.AdmstnZone != nil {
+		out.AdmstnZone = new(common.Max35Text)
+		*out.AdmstnZone = *r.AdmstnZone
+	}
+	if r.RefNb != nil {
+		out.RefNb = new(common.Max140Text)
+		*out.RefNb = *r.RefNb
+	}
+	if r.Mtd != nil {
+		out.Mtd = new(common.Max35Text)
+		*out.Mtd = *r.Mtd
+	}
+	if r.TtlTaxblBaseAmt != nil {
+		out.TtlTaxblBaseAmt = new(ActiveOrHistoricCurrencyAndAmount)
+		*out.TtlTaxblBaseAmt = *r.TtlTaxblBaseAmt
+	}
+	if r.TtlTaxAmt != nil {
+		out.TtlTaxAmt = new(ActiveOrHistoricCurrencyAndAmount)
+		*out.TtlTaxAmt = *r.TtlTaxAmt
+	}
+	if r.Dt != nil {
+		out.Dt = new(common.ISODate)
+		*out.Dt = *r.Dt
+	}
+	if r.Rcrd != nil {
+		out.Rcrd = make([]TaxRecord2, len(r.Rcrd))
+		for i := range r.Rcrd {
+			r.Rcrd[i].DeepCopyInto(&out.Rcrd[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *TaxParty1) DeepCopy() *TaxParty1 {
+	if r == nil {
+		return nil
+	}
+	out := new(TaxParty1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *TaxParty1) DeepCopyInto(out *TaxParty1) {
+	*out = *r
+	if r.TaxId != nil {
+		out.TaxId = new(common.Max35Text)
+		*out.TaxId = *r.TaxId
+	}
+	if r.RegnId != nil {
+		out.RegnId = new(common.Max35Text)
+		*out.RegnId = *r.RegnId
+	}
+	if r.TaxTp != nil {
+		out.TaxTp = new(common.Max35Text)
+		*out.TaxTp = *r.TaxTp
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *TaxParty2) DeepCopy() *TaxParty2 {
+	if r == nil {
+		return nil
+	}
+	out := new(TaxParty2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *TaxParty2) DeepCopyInto(out *TaxParty2) {
+	*out = *r
+	if r.TaxId != nil {
+		out.TaxId = new(common.Max35Text)
+		*out.TaxId = *r.TaxId
+	}
+	if r.RegnId != nil {
+		out.RegnId = new(common.Max35Text)
+		*out.RegnId = *r.RegnId
+	}
+	if r.TaxTp != nil {
+		out.TaxTp = new(common.Max35Text)
+		*out.TaxTp = *r.TaxTp
+	}
+	if r.Authstn != nil {
+		out.Authstn = new(TaxAuthorisation1)
+		r.Authstn.DeepCopyInto(out.Authstn)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *TaxPeriod2) DeepCopy() *TaxPeriod2 {
+	if r == nil {
+		return nil
+	}
+	out := new(TaxPeriod2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *TaxPeriod2) DeepCopyInto(out *TaxPeriod2) {
+	*out = *r
+	if r.Yr != nil {
+		out.Yr = new(common.ISODate)
+		*out.Yr = *r.Yr
+	}
+	if r.Tp != nil {
+		out.Tp = new(TaxRecordPeriod1Code)
+		*out.Tp = *r.Tp
+	}
+	if r.FrToDt != nil {
+		out.FrToDt = new(DatePeriod2)
+		*out.FrToDt = *r.FrToDt
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *TaxRecord2) DeepCopy() *TaxRecord2 {
+	if r == nil {
+		return nil
+	}
+	out := new(TaxRecord2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *TaxRecord2) DeepCopyInto(out *TaxRecord2) {
+	*out = *r
+	if r.Tp != nil {
+		out.Tp = new(common.Max35Text)
+		*out.Tp = *r.Tp
+	}
+	if r.Ctgy != nil {
+		out.Ctgy = new(common.Max35Text)
+		*out.Ctgy = *r.Ctgy
+	}
+	if r.CtgyDtls != nil {
+		out.CtgyDtls = new(common.Max35Text)
+		*out.CtgyDtls = *r.CtgyDtls
+	}
+	if r.DbtrSts != nil {
+		out.DbtrSts = new(common.Max35Text)
+		*out.DbtrSts = *r.DbtrSts
+	}
+	if r.CertId != nil {
+		out.CertId = new(common.Max35Text)
+		*out.CertId = *r.CertId
+	}
+	if r.FrmsCd != nil {
+		out.FrmsCd = new(common.Max35Text)
+		*out.FrmsCd = *r.FrmsCd
+	}
+	if r.Prd != nil {
+		out.Prd = new(TaxPeriod2)
+		r.Prd.DeepCopyInto(out.Prd)
+	}
+	if r.TaxAmt != nil {
+		out.TaxAmt = new(TaxAmount2)
+		r.TaxAmt.DeepCopyInto(out.TaxAmt)
+	}
+	if r.AddtlInf != nil {
+		out.AddtlInf = new(common.Max140Text)
+		*out.AddtlInf = *r.AddtlInf
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *TaxRecordDetails2) DeepCopy() *TaxRecordDetails2 {
+	if r == nil {
+		return nil
+	}
+	out := new(TaxRecordDetails2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *TaxRecordDetails2) DeepCopyInto(out *TaxRecordDetails2) {
+	*out = *r
+	if r.Prd != nil {
+		out.Prd = new(TaxPeriod2)
+		r.Prd.DeepCopyInto(out.Prd)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *TimePeriod1) DeepCopy() *TimePeriod1 {
+	if r == nil {
+		return nil
+	}
+	out := new(TimePeriod1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *TimePeriod1) DeepCopyInto(out *TimePeriod1) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *UnableToApplyIncorrect1) DeepCopy() *UnableToApplyIncorrect1 {
+	if r == nil {
+		return nil
+	}
+	out := new(UnableToApplyIncorrect1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *UnableToApplyIncorrect1) DeepCopyInto(out *UnableToApplyIncorrect1) {
+	*out = *r
+	if r.AddtlIncrrctInf != nil {
+		out.AddtlIncrrctInf = new(common.Max140Text)
+		*out.AddtlIncrrctInf = *r.AddtlIncrrctInf
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *UnableToApplyJustification3Choice) DeepCopy() *UnableToApplyJustification3Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(UnableToApplyJustification3Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *UnableToApplyJustification3Choice) DeepCopyInto(out *UnableToApplyJustification3Choice) {
+	*out = *r
+	if r.MssngOrIncrrctInf != nil {
+		out.MssngOrIncrrctInf = new(MissingOrIncorrectInformation3)
+		r.MssngOrIncrrctInf.DeepCopyInto(out.MssngOrIncrrctInf)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *UnableToApplyMissing1) DeepCopy() *UnableToApplyMissing1 {
+	if r == nil {
+		return nil
+	}
+	out := new(UnableToApplyMissing1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *UnableToApplyMissing1) DeepCopyInto(out *UnableToApplyMissing1) {
+	*out = *r
+	if r.AddtlMssngInf != nil {
+		out.AddtlMssngInf = new(common.Max140Text)
+		*out.AddtlMssngInf = *r.AddtlMssngInf
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *UnableToApplyV07) DeepCopy() *UnableToApplyV07 {
+	if r == nil {
+		return nil
+	}
+	out := new(UnableToApplyV07)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *UnableToApplyV07) DeepCopyInto(out *UnableToApplyV07) {
+	*out = *r
+	r.Assgnmt.DeepCopyInto(&out.Assgnmt)
+	if r.Case != nil {
+		out.Case = new(Case5)
+		r.Case.DeepCopyInto(out.Case)
+	}
+	r.Undrlyg.DeepCopyInto(&out.Undrlyg)
+	r.Justfn.DeepCopyInto(&out.Justfn)
+	if r.SplmtryData != nil {
+		out.SplmtryData = make([]SupplementaryData1, len(r.SplmtryData))
+		for i := range r.SplmtryData {
+			r.SplmtryData[i].DeepCopyInto(&out.SplmtryData[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *UnderlyingGroupInformation1) DeepCopy() *UnderlyingGroupInformation1 {
+	if r == nil {
+		return nil
+	}
+	out := new(UnderlyingGroupInformation1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *UnderlyingGroupInformation1) DeepCopyInto(out *UnderlyingGroupInformation1) {
+	*out = *r
+	if r.OrgnlCreDtTm != nil {
+		out.OrgnlCreDtTm = new(common.ISODateTime)
+		*out.OrgnlCreDtTm = *r.OrgnlCreDtTm
+	}
+	if r.OrgnlMsgDlvryChanl != nil {
+		out.OrgnlMsgDlvryChanl = new(common.Max35Text)
+		*out.OrgnlMsgDlvryChanl = *r.OrgnlMsgDlvryChanl
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *UnderlyingPaymentInstruction5) DeepCopy() *UnderlyingPaymentInstruction5 {
+	if r == nil {
+		return nil
+	}
+	out := new(UnderlyingPaymentInstruction5)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *UnderlyingPaymentInstruction5) DeepCopyInto(out *UnderlyingPaymentInstruction5) {
+	*out = *r
+	if r.OrgnlGrpInf != nil {
+		out.OrgnlGrpInf = new(UnderlyingGroupInformation1)
+		r.OrgnlGrpInf.DeepCopyInto(out.OrgnlGrpInf)
+	}
+	if r.OrgnlPmtInfId != nil {
+		out.OrgnlPmtInfId = new(common.Max35Text)
+		*out.OrgnlPmtInfId = *r.OrgnlPmtInfId
+	}
+	if r.OrgnlInstrId != nil {
+		out.OrgnlInstrId = new(common.Max35Text)
+		*out.OrgnlInstrId = *r.OrgnlInstrId
+	}
+	if r.OrgnlEndToEndId != nil {
+		out.OrgnlEndToEndId = new(common.Max35Text)
+		*out.OrgnlEndToEndId = *r.OrgnlEndToEndId
+	}
+	if r.OrgnlUETR != nil {
+		out.OrgnlUETR = new(common.UUIDv4Identifier)
+		*out.OrgnlUETR = *r.OrgnlUETR
+	}
+	if r.ReqdExctnDt != nil {
+		out.ReqdExctnDt = new(DateAndDateTime2Choice)
+		*out.ReqdExctnDt = *r.ReqdExctnDt
+	}
+	if r.ReqdColltnDt != nil {
+		out.ReqdColltnDt = new(common.ISODate)
+		*out.ReqdColltnDt = *r.ReqdColltnDt
+	}
+	if r.OrgnlTxRef != nil {
+		out.OrgnlTxRef = new(OriginalTransactionReference28)
+		r.OrgnlTxRef.DeepCopyInto(out.OrgnlTxRef)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *UnderlyingPaymentInstruction6) DeepCopy() *UnderlyingPaymentInstruction6 {
+	if r == nil {
+		return nil
+	}
+	out := new(UnderlyingPaymentInstruction6)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *UnderlyingPaymentInstruction6) DeepCopyInto(out *UnderlyingPaymentInstruction6) {
+	*out = *r
+	if r.OrgnlGrpInf != nil {
+		out.OrgnlGrpInf = new(UnderlyingGroupInformation1)
+		r.OrgnlGrpInf.DeepCopyInto(out.OrgnlGrpInf)
+	}
+	if r.OrgnlPmtInfId != nil {
+		out.OrgnlPmtInfId = new(common.Max35Text)
+		*out.OrgnlPmtInfId = *r.OrgnlPmtInfId
+	}
+	if r.OrgnlInstrId != nil {
+		out.OrgnlInstrId = new(common.Max35Text)
+		*out.OrgnlInstrId = *r.OrgnlInstrId
+	}
+	if r.OrgnlEndToEndId != nil {
+		out.OrgnlEndToEndId = new(common.Max35Text)
+		*out.OrgnlEndToEndId = *r.OrgnlEndToEndId
+	}
+	if r.OrgnlUETR != nil {
+		out.OrgnlUETR = new(common.UUIDv4Identifier)
+		*out.OrgnlUETR = *r.OrgnlUETR
+	}
+	if r.ReqdExctnDt != nil {
+		out.ReqdExctnDt = new(DateAndDateTime2Choice)
+		*out.ReqdExctnDt = *r.ReqdExctnDt
+	}
+	if r.ReqdColltnDt != nil {
+		out.ReqdColltnDt = new(common.ISODate)
+		*out.ReqdColltnDt = *r.ReqdColltnDt
+	}
+	if r.OrgnlTxRef != nil {
+		out.OrgnlTxRef = new(OriginalTransactionReference31)
+		r.OrgnlTxRef.DeepCopyInto(out.OrgnlTxRef)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *UnderlyingPaymentTransaction4) DeepCopy() *UnderlyingPaymentTransaction4 {
+	if r == nil {
+		return nil
+	}
+	out := new(UnderlyingPaymentTransaction4)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *UnderlyingPaymentTransaction4) DeepCopyInto(out *UnderlyingPaymentTransaction4) {
+	*out = *r
+	if r.OrgnlGrpInf != nil {
+		out.OrgnlGrpInf = new(UnderlyingGroupInformation1)
+		r.OrgnlGrpInf.DeepCopyInto(out.OrgnlGrpInf)
+	}
+	if r.OrgnlInstrId != nil {
+		out.OrgnlInstrId = new(common.Max35Text)
+		*out.OrgnlInstrId = *r.OrgnlInstrId
+	}
+	if r.OrgnlEndToEndId != nil {
+		out.OrgnlEndToEndId = new(common.Max35Text)
+		*out.OrgnlEndToEndId = *r.OrgnlEndToEndId
+	}
+	if r.OrgnlTxId != nil {
+		out.OrgnlTxId = new(common.Max35Text)
+		*out.OrgnlTxId = *r.OrgnlTxId
+	}
+	if r.OrgnlUETR != nil {
+		out.OrgnlUETR = new(common.UUIDv4Identifier)
+		*out.OrgnlUETR = *r.OrgnlUETR
+	}
+	if r.OrgnlTxRef != nil {
+		out.OrgnlTxRef = new(OriginalTransactionReference28)
+		r.OrgnlTxRef.DeepCopyInto(out.OrgnlTxRef)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *UnderlyingPaymentTransaction5) DeepCopy() *UnderlyingPaymentTransaction5 {
+	if r == nil {
+		return nil
+	}
+	out := new(UnderlyingPaymentTransaction5)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *UnderlyingPaymentTransaction5) DeepCopyInto(out *UnderlyingPaymentTransaction5) {
+	*out = *r
+	if r.OrgnlGrpInf != nil {
+		out.OrgnlGrpInf = new(UnderlyingGroupInformation1)
+		r.OrgnlGrpInf.DeepCopyInto(out.OrgnlGrpInf)
+	}
+	if r.OrgnlInstrId != nil {
+		out.OrgnlInstrId = new(common.Max35Text)
+		*out.OrgnlInstrId = *r.OrgnlInstrId
+	}
+	if r.OrgnlEndToEndId != nil {
+		out.OrgnlEndToEndId = new(common.Max35Text)
+		*out.OrgnlEndToEndId = *r.OrgnlEndToEndId
+	}
+	if r.OrgnlTxId != nil {
+		out.OrgnlTxId = new(common.Max35Text)
+		*out.OrgnlTxId = *r.OrgnlTxId
+	}
+	if r.OrgnlUETR != nil {
+		out.OrgnlUETR = new(common.UUIDv4Identifier)
+		*out.OrgnlUETR = *r.OrgnlUETR
+	}
+	if r.OrgnlTxRef != nil {
+		out.OrgnlTxRef = new(OriginalTransactionReference31)
+		r.OrgnlTxRef.DeepCopyInto(out.OrgnlTxRef)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *UnderlyingStatementEntry3) DeepCopy() *UnderlyingStatementEntry3 {
+	if r == nil {
+		return nil
+	}
+	out := new(UnderlyingStatementEntry3)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *UnderlyingStatementEntry3) DeepCopyInto(out *UnderlyingStatementEntry3) {
+	*out = *r
+	if r.OrgnlGrpInf != nil {
+		out.OrgnlGrpInf = new(OriginalGroupInformation29)
+		r.OrgnlGrpInf.DeepCopyInto(out.OrgnlGrpInf)
+	}
+	if r.OrgnlStmtId != nil {
+		out.OrgnlStmtId = new(common.Max35Text)
+		*out.OrgnlStmtId = *r.OrgnlStmtId
+	}
+	if r.OrgnlNtryId != nil {
+		out.OrgnlNtryId = new(common.Max35Text)
+		*out.OrgnlNtryId = *r.OrgnlNtryId
+	}
+	if r.OrgnlUETR != nil {
+		out.OrgnlUETR = new(common.UUIDv4Identifier)
+		*out.OrgnlUETR = *r.OrgnlUETR
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *UnderlyingTransaction5Choice) DeepCopy() *UnderlyingTransaction5Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(UnderlyingTransaction5Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *UnderlyingTransaction5Choice) DeepCopyInto(out *UnderlyingTransaction5Choice) {
+	*out = *r
+	if r.Initn != nil {
+		out.Initn = new(UnderlyingPaymentInstruction5)
+		r.Initn.DeepCopyInto(out.Initn)
+	}
+	if r.IntrBk != nil {
+		out.IntrBk = new(UnderlyingPaymentTransaction4)
+		r.IntrBk.DeepCopyInto(out.IntrBk)
+	}
+	if r.StmtNtry != nil {
+		out.StmtNtry = new(UnderlyingStatementEntry3)
+		r.StmtNtry.DeepCopyInto(out.StmtNtry)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *UnderlyingTransaction6Choice) DeepCopy() *UnderlyingTransaction6Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(UnderlyingTransaction6Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *UnderlyingTransaction6Choice) DeepCopyInto(out *UnderlyingTransaction6Choice) {
+	*out = *r
+	r.Initn.DeepCopyInto(&out.Initn)
+	r.IntrBk.DeepCopyInto(&out.IntrBk)
+	r.StmtNtry.DeepCopyInto(&out.StmtNtry)
+}
@@ -0,0 +1,8242 @@
+// Code generated by deepcopy-gen. DO NOT EDIT.
+
+package camt_v08
+
+import (
+	"github.com/moov-io/iso20022/pkg/common"
+)
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *AccountCashEntryReturnCriteria3) DeepCopy() *AccountCashEntryReturnCriteria3 {
+	if r == nil {
+		return nil
+	}
+	out := new(AccountCashEntryReturnCriteria3)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *AccountCashEntryReturnCriteria3) DeepCopyInto(out *AccountCashEntryReturnCriteria3) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *AccountIdentification4Choice) DeepCopy() *AccountIdentification4Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(AccountIdentification4Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *AccountIdentification4Choice) DeepCopyInto(out *AccountIdentification4Choice) {
+	*out = *r
+	r.Othr.DeepCopyInto(&out.Othr)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *AccountIdentificationSearchCriteria2Choice) DeepCopy() *AccountIdentificationSearchCriteria2Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(AccountIdentificationSearchCriteria2Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *AccountIdentificationSearchCriteria2Choice) DeepCopyInto(out *AccountIdentificationSearchCriteria2Choice) {
+	*out = *r
+	r.EQ.DeepCopyInto(&out.EQ)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *AccountInterest4) DeepCopy() *AccountInterest4 {
+	if r == nil {
+		return nil
+	}
+	out := new(AccountInterest4)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *AccountInterest4) DeepCopyInto(out *AccountInterest4) {
+	*out = *r
+	if r.Tp != nil {
+		out.Tp = new(InterestType1Choice)
+		*out.Tp = *r.Tp
+	}
+	if r.Rate != nil {
+		out.Rate = make([]Rate4, len(r.Rate))
+		for i := range r.Rate {
+			r.Rate[i].DeepCopyInto(&out.Rate[i])
+		}
+	}
+	if r.FrToDt != nil {
+		out.FrToDt = new(DateTimePeriod1)
+		*out.FrToDt = *r.FrToDt
+	}
+	if r.Rsn != nil {
+		out.Rsn = new(common.Max35Text)
+		*out.Rsn = *r.Rsn
+	}
+	if r.Tax != nil {
+		out.Tax = new(TaxCharges2)
+		r.Tax.DeepCopyInto(out.Tax)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *AccountNotification17) DeepCopy() *AccountNotification17 {
+	if r == nil {
+		return nil
+	}
+	out := new(AccountNotification17)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *AccountNotification17) DeepCopyInto(out *AccountNotification17) {
+	*out = *r
+	if r.NtfctnPgntn != nil {
+		out.NtfctnPgntn = new(Pagination1)
+		*out.NtfctnPgntn = *r.NtfctnPgntn
+	}
+	if r.RptgSeq != nil {
+		out.RptgSeq = new(SequenceRange1Choice)
+		r.RptgSeq.DeepCopyInto(out.RptgSeq)
+	}
+	if r.CreDtTm != nil {
+		out.CreDtTm = new(common.ISODateTime)
+		*out.CreDtTm = *r.CreDtTm
+	}
+	if r.FrToDt != nil {
+		out.FrToDt = new(DateTimePeriod1)
+		*out.FrToDt = *r.FrToDt
+	}
+	if r.CpyDplctInd != nil {
+		out.CpyDplctInd = new(common.CopyDuplicate1Code)
+		*out.CpyDplctInd = *r.CpyDplctInd
+	}
+	if r.RptgSrc != nil {
+		out.RptgSrc = new(ReportingSource1Choice)
+		*out.RptgSrc = *r.RptgSrc
+	}
+	r.Acct.DeepCopyInto(&out.Acct)
+	if r.RltdAcct != nil {
+		out.RltdAcct = new(CashAccount38)
+		r.RltdAcct.DeepCopyInto(out.RltdAcct)
+	}
+	if r.Intrst != nil {
+		out.Intrst = make([]AccountInterest4, len(r.Intrst))
+		for i := range r.Intrst {
+			r.Intrst[i].DeepCopyInto(&out.Intrst[i])
+		}
+	}
+	if r.TxsSummry != nil {
+		out.TxsSummry = new(TotalTransactions6)
+		r.TxsSummry.DeepCopyInto(out.TxsSummry)
+	}
+	if r.Ntry != nil {
+		out.Ntry = make([]ReportEntry10, len(r.Ntry))
+		for i := range r.Ntry {
+			r.Ntry[i].DeepCopyInto(&out.Ntry[i])
+		}
+	}
+	if r.AddtlNtfctnInf != nil {
+		out.AddtlNtfctnInf = new(common.Max500Text)
+		*out.AddtlNtfctnInf = *r.AddtlNtfctnInf
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *AccountOrBusinessError4Choice) DeepCopy() *AccountOrBusinessError4Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(AccountOrBusinessError4Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *AccountOrBusinessError4Choice) DeepCopyInto(out *AccountOrBusinessError4Choice) {
+	*out = *r
+	r.Acct.DeepCopyInto(&out.Acct)
+	if r.BizErr != nil {
+		out.BizErr = make([]ErrorHandling5, len(r.BizErr))
+		for i := range r.BizErr {
+			r.BizErr[i].DeepCopyInto(&out.BizErr[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *AccountOrOperationalError4Choice) DeepCopy() *AccountOrOperationalError4Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(AccountOrOperationalError4Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *AccountOrOperationalError4Choice) DeepCopyInto(out *AccountOrOperationalError4Choice) {
+	*out = *r
+	if r.AcctRpt != nil {
+		out.AcctRpt = make([]AccountReport24, len(r.AcctRpt))
+		for i := range r.AcctRpt {
+			r.AcctRpt[i].DeepCopyInto(&out.AcctRpt[i])
+		}
+	}
+	if r.OprlErr != nil {
+		out.OprlErr = make([]ErrorHandling5, len(r.OprlErr))
+		for i := range r.OprlErr {
+			r.OprlErr[i].DeepCopyInto(&out.OprlErr[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *AccountReport24) DeepCopy() *AccountReport24 {
+	if r == nil {
+		return nil
+	}
+	out := new(AccountReport24)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *AccountReport24) DeepCopyInto(out *AccountReport24) {
+	*out = *r
+	r.AcctId.DeepCopyInto(&out.AcctId)
+	r.AcctOrErr.DeepCopyInto(&out.AcctOrErr)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *AccountReport25) DeepCopy() *AccountReport25 {
+	if r == nil {
+		return nil
+	}
+	out := new(AccountReport25)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *AccountReport25) DeepCopyInto(out *AccountReport25) {
+	*out = *r
+	if r.RptPgntn != nil {
+		out.RptPgntn = new(Pagination1)
+		*out.RptPgntn = *r.RptPgntn
+	}
+	if r.RptgSeq != nil {
+		out.RptgSeq = new(SequenceRange1Choice)
+		r.RptgSeq.DeepCopyInto(out.RptgSeq)
+	}
+	if r.CreDtTm != nil {
+		out.CreDtTm = new(common.ISODateTime)
+		*out.CreDtTm = *r.CreDtTm
+	}
+	if r.FrToDt != nil {
+		out.FrToDt = new(DateTimePeriod1)
+		*out.FrToDt = *r.FrToDt
+	}
+	if r.CpyDplctInd != nil {
+		out.CpyDplctInd = new(common.CopyDuplicate1Code)
+		*out.CpyDplctInd = *r.CpyDplctInd
+	}
+	if r.RptgSrc != nil {
+		out.RptgSrc = new(ReportingSource1Choice)
+		*out.RptgSrc = *r.RptgSrc
+	}
+	if r.Acct != nil {
+		out.Acct = new(CashAccount39)
+		r.Acct.DeepCopyInto(out.Acct)
+	}
+	if r.RltdAcct != nil {
+		out.RltdAcct = new(CashAccount38)
+		r.RltdAcct.DeepCopyInto(out.RltdAcct)
+	}
+	if r.Intrst != nil {
+		out.Intrst = make([]AccountInterest4, len(r.Intrst))
+		for i := range r.Intrst {
+			r.Intrst[i].DeepCopyInto(&out.Intrst[i])
+		}
+	}
+	if r.Bal != nil {
+		out.Bal = make([]CashBalance8, len(r.Bal))
+		for i := range r.Bal {
+			r.Bal[i].DeepCopyInto(&out.Bal[i])
+		}
+	}
+	if r.TxsSummry != nil {
+		out.TxsSummry = new(TotalTransactions6)
+		r.TxsSummry.DeepCopyInto(out.TxsSummry)
+	}
+	if r.Ntry != nil {
+		out.Ntry = make([]ReportEntry10, len(r.Ntry))
+		for i := range r.Ntry {
+			r.Ntry[i].DeepCopyInto(&out.Ntry[i])
+		}
+	}
+	if r.AddtlRptInf != nil {
+		out.AddtlRptInf = new(common.Max500Text)
+		*out.AddtlRptInf = *r.AddtlRptInf
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *AccountSchemeName1Choice) DeepCopy() *AccountSchemeName1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(AccountSchemeName1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *AccountSchemeName1Choice) DeepCopyInto(out *AccountSchemeName1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *AccountStatement9) DeepCopy() *AccountStatement9 {
+	if r == nil {
+		return nil
+	}
+	out := new(AccountStatement9)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *AccountStatement9) DeepCopyInto(out *AccountStatement9) {
+	*out = *r
+	if r.StmtPgntn != nil {
+		out.StmtPgntn = new(Pagination1)
+		*out.StmtPgntn = *r.StmtPgntn
+	}
+	if r.RptgSeq != nil {
+		out.RptgSeq = new(SequenceRange1Choice)
+		r.RptgSeq.DeepCopyInto(out.RptgSeq)
+	}
+	if r.CreDtTm != nil {
+		out.CreDtTm = new(common.ISODateTime)
+		*out.CreDtTm = *r.CreDtTm
+	}
+	if r.FrToDt != nil {
+		out.FrToDt = new(DateTimePeriod1)
+		*out.FrToDt = *r.FrToDt
+	}
+	if r.CpyDplctInd != nil {
+		out.CpyDplctInd = new(common.CopyDuplicate1Code)
+		*out.CpyDplctInd = *r.CpyDplctInd
+	}
+	if r.RptgSrc != nil {
+		out.RptgSrc = new(ReportingSource1Choice)
+		*out.RptgSrc = *r.RptgSrc
+	}
+	if r.Acct != nil {
+		out.Acct = new(CashAccount39)
+		r.Acct.DeepCopyInto(out.Acct)
+	}
+	if r.RltdAcct != nil {
+		out.RltdAcct = new(CashAccount38)
+		r.RltdAcct.DeepCopyInto(out.RltdAcct)
+	}
+	if r.Intrst != nil {
+		out.Intrst = make([]AccountInterest4, len(r.Intrst))
+		for i := range r.Intrst {
+			r.Intrst[i].DeepCopyInto(&out.Intrst[i])
+		}
+	}
+	if r.Bal != nil {
+		out.Bal = make([]CashBalance8, len(r.Bal))
+		for i := range r.Bal {
+			r.Bal[i].DeepCopyInto(&out.Bal[i])
+		}
+	}
+	if r.TxsSummry != nil {
+		out.TxsSummry = new(TotalTransactions6)
+		r.TxsSummry.DeepCopyInto(out.TxsSummry)
+	}
+	if r.Ntry != nil {
+		out.Ntry = make([]ReportEntry10, len(r.Ntry))
+		for i := range r.Ntry {
+			r.Ntry[i].DeepCopyInto(&out.Ntry[i])
+		}
+	}
+	if r.AddtlStmtInf != nil {
+		out.AddtlStmtInf = new(common.Max500Text)
+		*out.AddtlStmtInf = *r.AddtlStmtInf
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ActiveAmountRange3Choice) DeepCopy() *ActiveAmountRange3Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(ActiveAmountRange3Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ActiveAmountRange3Choice) DeepCopyInto(out *ActiveAmountRange3Choice) {
+	*out = *r
+	r.ImpldCcyAndAmtRg.DeepCopyInto(&out.ImpldCcyAndAmtRg)
+	r.CcyAndAmtRg.DeepCopyInto(&out.CcyAndAmtRg)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ActiveCurrencyAndAmount) DeepCopy() *ActiveCurrencyAndAmount {
+	if r == nil {
+		return nil
+	}
+	out := new(ActiveCurrencyAndAmount)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ActiveCurrencyAndAmount) DeepCopyInto(out *ActiveCurrencyAndAmount) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ActiveCurrencyAndAmountRange3) DeepCopy() *ActiveCurrencyAndAmountRange3 {
+	if r == nil {
+		return nil
+	}
+	out := new(ActiveCurrencyAndAmountRange3)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ActiveCurrencyAndAmountRange3) DeepCopyInto(out *ActiveCurrencyAndAmountRange3) {
+	*out = *r
+	if r.CdtDbtInd != nil {
+		out.CdtDbtInd = new(common.CreditDebitCode)
+		*out.CdtDbtInd = *r.CdtDbtInd
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ActiveOrHistoricAmountRange2Choice) DeepCopy() *ActiveOrHistoricAmountRange2Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(ActiveOrHistoricAmountRange2Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ActiveOrHistoricAmountRange2Choice) DeepCopyInto(out *ActiveOrHistoricAmountRange2Choice) {
+	*out = *r
+	r.ImpldCcyAndAmtRg.DeepCopyInto(&out.ImpldCcyAndAmtRg)
+	r.CcyAndAmtRg.DeepCopyInto(&out.CcyAndAmtRg)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ActiveOrHistoricCurrencyAnd13DecimalAmount) DeepCopy() *ActiveOrHistoricCurrencyAnd13DecimalAmount {
+	if r == nil {
+		return nil
+	}
+	out := new(ActiveOrHistoricCurrencyAnd13DecimalAmount)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ActiveOrHistoricCurrencyAnd13DecimalAmount) DeepCopyInto(out *ActiveOrHistoricCurrencyAnd13DecimalAmount) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ActiveOrHistoricCurrencyAndAmount) DeepCopy() *ActiveOrHistoricCurrencyAndAmount {
+	if r == nil {
+		return nil
+	}
+	out := new(ActiveOrHistoricCurrencyAndAmount)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ActiveOrHistoricCurrencyAndAmount) DeepCopyInto(out *ActiveOrHistoricCurrencyAndAmount) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ActiveOrHistoricCurrencyAndAmountRange2) DeepCopy() *ActiveOrHistoricCurrencyAndAmountRange2 {
+	if r == nil {
+		return nil
+	}
+	out := new(ActiveOrHistoricCurrencyAndAmountRange2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ActiveOrHistoricCurrencyAndAmountRange2) DeepCopyInto(out *ActiveOrHistoricCurrencyAndAmountRange2) {
+	*out = *r
+	if r.CdtDbtInd != nil {
+		out.CdtDbtInd = new(common.CreditDebitCode)
+		*out.CdtDbtInd = *r.CdtDbtInd
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *AddressType3Choice) DeepCopy() *AddressType3Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(AddressType3Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *AddressType3Choice) DeepCopyInto(out *AddressType3Choice) {
+	*out = *r
+	r.Prtry.DeepCopyInto(&out.Prtry)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *AmendmentInformationDetails13) DeepCopy() *AmendmentInformationDetails13 {
+	if r == nil {
+		return nil
+	}
+	out := new(AmendmentInformationDetails13)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *AmendmentInformationDetails13) DeepCopyInto(out *AmendmentInformationDetails13) {
+	*out = *r
+	if r.OrgnlMndtId != nil {
+		out.OrgnlMndtId = new(common.Max35Text)
+		*out.OrgnlMndtId = *r.OrgnlMndtId
+	}
+	if r.OrgnlCdtrSchmeId != nil {
+		out.OrgnlCdtrSchmeId = new(PartyIdentification135)
+		r.OrgnlCdtrSchmeId.DeepCopyInto(out.OrgnlCdtrSchmeId)
+	}
+	if r.OrgnlCdtrAgt != nil {
+		out.OrgnlCdtrAgt = new(BranchAndFinancialInstitutionIdentification6)
+		r.OrgnlCdtrAgt.DeepCopyInto(out.OrgnlCdtrAgt)
+	}
+	if r.OrgnlCdtrAgtAcct != nil {
+		out.OrgnlCdtrAgtAcct = new(CashAccount38)
+		r.OrgnlCdtrAgtAcct.DeepCopyInto(out.OrgnlCdtrAgtAcct)
+	}
+	if r.OrgnlDbtr != nil {
+		out.OrgnlDbtr = new(PartyIdentification135)
+		r.OrgnlDbtr.DeepCopyInto(out.OrgnlDbtr)
+	}
+	if r.OrgnlDbtrAcct != nil {
+		out.OrgnlDbtrAcct = new(CashAccount38)
+		r.OrgnlDbtrAcct.DeepCopyInto(out.OrgnlDbtrAcct)
+	}
+	if r.OrgnlDbtrAgt != nil {
+		out.OrgnlDbtrAgt = new(BranchAndFinancialInstitutionIdentification6)
+		r.OrgnlDbtrAgt.DeepCopyInto(out.OrgnlDbtrAgt)
+	}
+	if r.OrgnlDbtrAgtAcct != nil {
+		out.OrgnlDbtrAgtAcct = new(CashAccount38)
+		r.OrgnlDbtrAgtAcct.DeepCopyInto(out.OrgnlDbtrAgtAcct)
+	}
+	if r.OrgnlFnlColltnDt != nil {
+		out.OrgnlFnlColltnDt = new(common.ISODate)
+		*out.OrgnlFnlColltnDt = *r.OrgnlFnlColltnDt
+	}
+	if r.OrgnlFrqcy != nil {
+		out.OrgnlFrqcy = new(Frequency36Choice)
+		*out.OrgnlFrqcy = *r.OrgnlFrqcy
+	}
+	if r.OrgnlRsn != nil {
+		out.OrgnlRsn = new(MandateSetupReason1Choice)
+		*out.OrgnlRsn = *r.OrgnlRsn
+	}
+	if r.OrgnlTrckgDays != nil {
+		out.OrgnlTrckgDays = new(common.Exact2NumericText)
+		*out.OrgnlTrckgDays = *r.OrgnlTrckgDays
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *Amount2Choice) DeepCopy() *Amount2Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(Amount2Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *Amount2Choice) DeepCopyInto(out *Amount2Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *Amount3Choice) DeepCopy() *Amount3Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(Amount3Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *Amount3Choice) DeepCopyInto(out *Amount3Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *AmountAndCurrencyExchange3) DeepCopy() *AmountAndCurrencyExchange3 {
+	if r == nil {
+		return nil
+	}
+	out := new(AmountAndCurrencyExchange3)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *AmountAndCurrencyExchange3) DeepCopyInto(out *AmountAndCurrencyExchange3) {
+	*out = *r
+	if r.InstdAmt != nil {
+		out.InstdAmt = new(AmountAndCurrencyExchangeDetails3)
+		r.InstdAmt.DeepCopyInto(out.InstdAmt)
+	}
+	if r.TxAmt != nil {
+		out.TxAmt = new(AmountAndCurrencyExchangeDetails3)
+		r.TxAmt.DeepCopyInto(out.TxAmt)
+	}
+	if r.CntrValAmt != nil {
+		out.CntrValAmt = new(AmountAndCurrencyExchangeDetails3)
+		r.CntrValAmt.DeepCopyInto(out.CntrValAmt)
+	}
+	if r.AnncdPstngAmt != nil {
+		out.AnncdPstngAmt = new(AmountAndCurrencyExchangeDetails3)
+		r.AnncdPstngAmt.DeepCopyInto(out.AnncdPstngAmt)
+	}
+	if r.PrtryAmt != nil {
+		out.PrtryAmt = make([]AmountAndCurrencyExchangeDetails4, len(r.PrtryAmt))
+		for i := range r.PrtryAmt {
+			r.PrtryAmt[i].DeepCopyInto(&out.PrtryAmt[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *AmountAndCurrencyExchangeDetails3) DeepCopy() *AmountAndCurrencyExchangeDetails3 {
+	if r == nil {
+		return nil
+	}
+	out := new(AmountAndCurrencyExchangeDetails3)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *AmountAndCurrencyExchangeDetails3) DeepCopyInto(out *AmountAndCurrencyExchangeDetails3) {
+	*out = *r
+	if r.CcyXchg != nil {
+		out.CcyXchg = new(CurrencyExchange5)
+		r.CcyXchg.DeepCopyInto(out.CcyXchg)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *AmountAndCurrencyExchangeDetails4) DeepCopy() *AmountAndCurrencyExchangeDetails4 {
+	if r == nil {
+		return nil
+	}
+	out := new(AmountAndCurrencyExchangeDetails4)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *AmountAndCurrencyExchangeDetails4) DeepCopyInto(out *AmountAndCurrencyExchangeDetails4) {
+	*out = *r
+	if r.CcyXchg != nil {
+		out.CcyXchg = new(CurrencyExchange5)
+		r.CcyXchg.DeepCopyInto(out.CcyXchg)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *AmountAndDirection35) DeepCopy() *AmountAndDirection35 {
+	if r == nil {
+		return nil
+	}
+	out := new(AmountAndDirection35)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *AmountAndDirection35) DeepCopyInto(out *AmountAndDirection35) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *AmountRangeBoundary1) DeepCopy() *AmountRangeBoundary1 {
+	if r == nil {
+		return nil
+	}
+	out := new(AmountRangeBoundary1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *AmountRangeBoundary1) DeepCopyInto(out *AmountRangeBoundary1) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *AmountType4Choice) DeepCopy() *AmountType4Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(AmountType4Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *AmountType4Choice) DeepCopyInto(out *AmountType4Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *BalanceRestrictionType1) DeepCopy() *BalanceRestrictionType1 {
+	if r == nil {
+		return nil
+	}
+	out := new(BalanceRestrictionType1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *BalanceRestrictionType1) DeepCopyInto(out *BalanceRestrictionType1) {
+	*out = *r
+	r.Tp.DeepCopyInto(&out.Tp)
+	if r.Desc != nil {
+		out.Desc = new(common.Max140Text)
+		*out.Desc = *r.Desc
+	}
+	if r.PrcgTp != nil {
+		out.PrcgTp = new(ProcessingType1Choice)
+		*out.PrcgTp = *r.PrcgTp
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *BalanceSubType1Choice) DeepCopy() *BalanceSubType1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(BalanceSubType1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *BalanceSubType1Choice) DeepCopyInto(out *BalanceSubType1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *BalanceType10Choice) DeepCopy() *BalanceType10Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(BalanceType10Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *BalanceType10Choice) DeepCopyInto(out *BalanceType10Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *BalanceType11Choice) DeepCopy() *BalanceType11Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(BalanceType11Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *BalanceType11Choice) DeepCopyInto(out *BalanceType11Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *BalanceType13) DeepCopy() *BalanceType13 {
+	if r == nil {
+		return nil
+	}
+	out := new(BalanceType13)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *BalanceType13) DeepCopyInto(out *BalanceType13) {
+	*out = *r
+	if r.SubTp != nil {
+		out.SubTp = new(BalanceSubType1Choice)
+		*out.SubTp = *r.SubTp
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *BalanceType9Choice) DeepCopy() *BalanceType9Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(BalanceType9Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *BalanceType9Choice) DeepCopyInto(out *BalanceType9Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *BankToCustomerAccountReportV08) DeepCopy() *BankToCustomerAccountReportV08 {
+	if r == nil {
+		return nil
+	}
+	out := new(BankToCustomerAccountReportV08)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *BankToCustomerAccountReportV08) DeepCopyInto(out *BankToCustomerAccountReportV08) {
+	*out = *r
+	r.GrpHdr.DeepCopyInto(&out.GrpHdr)
+	if r.Rpt != nil {
+		out.Rpt = make([]AccountReport25, len(r.Rpt))
+		for i := range r.Rpt {
+			r.Rpt[i].DeepCopyInto(&out.Rpt[i])
+		}
+	}
+	if r.SplmtryData != nil {
+		out.SplmtryData = make([]SupplementaryData1, len(r.SplmtryData))
+		for i := range r.SplmtryData {
+			r.SplmtryData[i].DeepCopyInto(&out.SplmtryData[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *BankToCustomerDebitCreditNotificationV08) DeepCopy() *BankToCustomerDebitCreditNotificationV08 {
+	if r == nil {
+		return nil
+	}
+	out := new(BankToCustomerDebitCreditNotificationV08)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *BankToCustomerDebitCreditNotificationV08) DeepCopyInto(out *BankToCustomerDebitCreditNotificationV08) {
+	*out = *r
+	r.GrpHdr.DeepCopyInto(&out.GrpHdr)
+	if r.Ntfctn != nil {
+		out.Ntfctn = make([]AccountNotification17, len(r.Ntfctn))
+		for i := range r.Ntfctn {
+			r.Ntfctn[i].DeepCopyInto(&out.Ntfctn[i])
+		}
+	}
+	if r.SplmtryData != nil {
+		out.SplmtryData = make([]SupplementaryData1, len(r.SplmtryData))
+		for i := range r.SplmtryData {
+			r.SplmtryData[i].DeepCopyInto(&out.SplmtryData[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *BankToCustomerStatementV08) DeepCopy() *BankToCustomerStatementV08 {
+	if r == nil {
+		return nil
+	}
+	out := new(BankToCustomerStatementV08)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *BankToCustomerStatementV08) DeepCopyInto(out *BankToCustomerStatementV08) {
+	*out = *r
+	r.GrpHdr.DeepCopyInto(&out.GrpHdr)
+	if r.Stmt != nil {
+		out.Stmt = make([]AccountStatement9, len(r.Stmt))
+		for i := range r.Stmt {
+			r.Stmt[i].DeepCopyInto(&out.Stmt[i])
+		}
+	}
+	if r.SplmtryData != nil {
+		out.SplmtryData = make([]SupplementaryData1, len(r.SplmtryData))
+		for i := range r.SplmtryData {
+			r.SplmtryData[i].DeepCopyInto(&out.SplmtryData[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *BankTransactionCodeStructure4) DeepCopy() *BankTransactionCodeStructure4 {
+	if r == nil {
+		return nil
+	}
+	out := new(BankTransactionCodeStructure4)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *BankTransactionCodeStructure4) DeepCopyInto(out *BankTransactionCodeStructure4) {
+	*out = *r
+	if r.Domn != nil {
+		out.Domn = new(BankTransactionCodeStructure5)
+		*out.Domn = *r.Domn
+	}
+	if r.Prtry != nil {
+		out.Prtry = new(ProprietaryBankTransactionCodeStructure1)
+		r.Prtry.DeepCopyInto(out.Prtry)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *BankTransactionCodeStructure5) DeepCopy() *BankTransactionCodeStructure5 {
+	if r == nil {
+		return nil
+	}
+	out := new(BankTransactionCodeStructure5)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *BankTransactionCodeStructure5) DeepCopyInto(out *BankTransactionCodeStructure5) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *BankTransactionCodeStructure6) DeepCopy() *BankTransactionCodeStructure6 {
+	if r == nil {
+		return nil
+	}
+	out := new(BankTransactionCodeStructure6)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *BankTransactionCodeStructure6) DeepCopyInto(out *BankTransactionCodeStructure6) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *BatchInformation2) DeepCopy() *BatchInformation2 {
+	if r == nil {
+		return nil
+	}
+	out := new(BatchInformation2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *BatchInformation2) DeepCopyInto(out *BatchInformation2) {
+	*out = *r
+	if r.MsgId != nil {
+		out.MsgId = new(common.Max35Text)
+		*out.MsgId = *r.MsgId
+	}
+	if r.PmtInfId != nil {
+		out.PmtInfId = new(common.Max35Text)
+		*out.PmtInfId = *r.PmtInfId
+	}
+	if r.NbOfTxs != nil {
+		out.NbOfTxs = new(common.Max15NumericText)
+		*out.NbOfTxs = *r.NbOfTxs
+	}
+	if r.TtlAmt != nil {
+		out.TtlAmt = new(ActiveOrHistoricCurrencyAndAmount)
+		*out.TtlAmt = *r.TtlAmt
+	}
+	if r.CdtDbtInd != nil {
+		out.CdtDbtInd = new(common.CreditDebitCode)
+		*out.CdtDbtInd = *r.CdtDbtInd
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *BilateralLimit3) DeepCopy() *BilateralLimit3 {
+	if r == nil {
+		return nil
+	}
+	out := new(BilateralLimit3)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *BilateralLimit3) DeepCopyInto(out *BilateralLimit3) {
+	*out = *r
+	r.CtrPtyId.DeepCopyInto(&out.CtrPtyId)
+	if r.BilBal != nil {
+		out.BilBal = make([]CashBalance11, len(r.BilBal))
+		for i := range r.BilBal {
+			r.BilBal[i].DeepCopyInto(&out.BilBal[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *BranchAndFinancialInstitutionIdentification6) DeepCopy() *BranchAndFinancialInstitutionIdentification6 {
+	if r == nil {
+		return nil
+	}
+	out := new(BranchAndFinancialInstitutionIdentification6)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *BranchAndFinancialInstitutionIdentification6) DeepCopyInto(out *BranchAndFinancialInstitutionIdentification6) {
+	*out = *r
+	r.FinInstnId.DeepCopyInto(&out.FinInstnId)
+	if r.BrnchId != nil {
+		out.BrnchId = new(BranchData3)
+		r.BrnchId.DeepCopyInto(out.BrnchId)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *BranchData3) DeepCopy() *BranchData3 {
+	if r == nil {
+		return nil
+	}
+	out := new(BranchData3)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *BranchData3) DeepCopyInto(out *BranchData3) {
+	*out = *r
+	if r.Id != nil {
+		out.Id = new(common.Max35Text)
+		*out.Id = *r.Id
+	}
+	if r.LEI != nil {
+		out.LEI = new(common.LEIIdentifier)
+		*out.LEI = *r.LEI
+	}
+	if r.Nm != nil {
+		out.Nm = new(common.Max140Text)
+		*out.Nm = *r.Nm
+	}
+	if r.PstlAdr != nil {
+		out.PstlAdr = new(PostalAddress24)
+		r.PstlAdr.DeepCopyInto(out.PstlAdr)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CancelTransactionV08) DeepCopy() *CancelTransactionV08 {
+	if r == nil {
+		return nil
+	}
+	out := new(CancelTransactionV08)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CancelTransactionV08) DeepCopyInto(out *CancelTransactionV08) {
+	*out = *r
+	r.MsgHdr.DeepCopyInto(&out.MsgHdr)
+	r.PmtId.DeepCopyInto(&out.PmtId)
+	if r.CshAcct != nil {
+		out.CshAcct = new(CashAccount38)
+		r.CshAcct.DeepCopyInto(out.CshAcct)
+	}
+	if r.CxlRsn != nil {
+		out.CxlRsn = new(PaymentCancellationReason5)
+		r.CxlRsn.DeepCopyInto(out.CxlRsn)
+	}
+	if r.SplmtryData != nil {
+		out.SplmtryData = make([]SupplementaryData1, len(r.SplmtryData))
+		for i := range r.SplmtryData {
+			r.SplmtryData[i].DeepCopyInto(&out.SplmtryData[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CancellationReason33Choice) DeepCopy() *CancellationReason33Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(CancellationReason33Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CancellationReason33Choice) DeepCopyInto(out *CancellationReason33Choice) {
+	*out = *r
+	if r.Cd != nil {
+		out.Cd = new(ExternalCancellationReason1Code)
+		*out.Cd = *r.Cd
+	}
+	if r.Prtry != nil {
+		out.Prtry = new(common.Max35Text)
+		*out.Prtry = *r.Prtry
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CardAggregated2) DeepCopy() *CardAggregated2 {
+	if r == nil {
+		return nil
+	}
+	out := new(CardAggregated2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CardAggregated2) DeepCopyInto(out *CardAggregated2) {
+	*out = *r
+	if r.AddtlSvc != nil {
+		out.AddtlSvc = new(CardPaymentServiceType2Code)
+		*out.AddtlSvc = *r.AddtlSvc
+	}
+	if r.TxCtgy != nil {
+		out.TxCtgy = new(ExternalCardTransactionCategory1Code)
+		*out.TxCtgy = *r.TxCtgy
+	}
+	if r.SaleRcncltnId != nil {
+		out.SaleRcncltnId = new(common.Max35Text)
+		*out.SaleRcncltnId = *r.SaleRcncltnId
+	}
+	if r.SeqNbRg != nil {
+		out.SeqNbRg = new(CardSequenceNumberRange1)
+		r.SeqNbRg.DeepCopyInto(out.SeqNbRg)
+	}
+	if r.TxDtRg != nil {
+		out.TxDtRg = new(DateOrDateTimePeriod1Choice)
+		*out.TxDtRg = *r.TxDtRg
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CardEntry4) DeepCopy() *CardEntry4 {
+	if r == nil {
+		return nil
+	}
+	out := new(CardEntry4)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CardEntry4) DeepCopyInto(out *CardEntry4) {
+	*out = *r
+	if r.Card != nil {
+		out.Card = new(PaymentCard4)
+		r.Card.DeepCopyInto(out.Card)
+	}
+	if r.POI != nil {
+		out.POI = new(PointOfInteraction1)
+		r.POI.DeepCopyInto(out.POI)
+	}
+	if r.AggtdNtry != nil {
+		out.AggtdNtry = new(CardAggregated2)
+		r.AggtdNtry.DeepCopyInto(out.AggtdNtry)
+	}
+	if r.PrePdAcct != nil {
+		out.PrePdAcct = new(CashAccount38)
+		r.PrePdAcct.DeepCopyInto(out.PrePdAcct)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CardIndividualTransaction2) DeepCopy() *CardIndividualTransaction2 {
+	if r == nil {
+		return nil
+	}
+	out := new(CardIndividualTransaction2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CardIndividualTransaction2) DeepCopyInto(out *CardIndividualTransaction2) {
+	*out = *r
+	if r.ICCRltdData != nil {
+		out.ICCRltdData = new(common.Max1025Text)
+		*out.ICCRltdData = *r.ICCRltdData
+	}
+	if r.PmtCntxt != nil {
+		out.PmtCntxt = new(PaymentContext3)
+		r.PmtCntxt.DeepCopyInto(out.PmtCntxt)
+	}
+	if r.AddtlSvc != nil {
+		out.AddtlSvc = new(CardPaymentServiceType2Code)
+		*out.AddtlSvc = *r.AddtlSvc
+	}
+	if r.TxCtgy != nil {
+		out.TxCtgy = new(ExternalCardTransactionCategory1Code)
+		*out.TxCtgy = *r.TxCtgy
+	}
+	if r.SaleRcncltnId != nil {
+		out.SaleRcncltnId = new(common.Max35Text)
+		*out.SaleRcncltnId = *r.SaleRcncltnId
+	}
+	if r.SaleRefNb != nil {
+		out.SaleRefNb = new(common.Max35Text)
+		*out.SaleRefNb = *r.SaleRefNb
+	}
+	if r.RePresntmntRsn != nil {
+		out.RePresntmntRsn = new(ExternalRePresentmentReason1Code)
+		*out.RePresntmntRsn = *r.RePresntmntRsn
+	}
+	if r.SeqNb != nil {
+		out.SeqNb = new(common.Max35Text)
+		*out.SeqNb = *r.SeqNb
+	}
+	if r.TxId != nil {
+		out.TxId = new(TransactionIdentifier1)
+		*out.TxId = *r.TxId
+	}
+	if r.Pdct != nil {
+		out.Pdct = new(Product2)
+		r.Pdct.DeepCopyInto(out.Pdct)
+	}
+	if r.VldtnDt != nil {
+		out.VldtnDt = new(common.ISODate)
+		*out.VldtnDt = *r.VldtnDt
+	}
+	if r.VldtnSeqNb != nil {
+		out.VldtnSeqNb = new(common.Max35Text)
+		*out.VldtnSeqNb = *r.VldtnSeqNb
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CardSecurityInformation1) DeepCopy() *CardSecurityInformation1 {
+	if r == nil {
+		return nil
+	}
+	out := new(CardSecurityInformation1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CardSecurityInformation1) DeepCopyInto(out *CardSecurityInformation1) {
+	*out = *r
+	if r.CSCVal != nil {
+		out.CSCVal = new(common.Min3Max4NumericText)
+		*out.CSCVal = *r.CSCVal
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CardSequenceNumberRange1) DeepCopy() *CardSequenceNumberRange1 {
+	if r == nil {
+		return nil
+	}
+	out := new(CardSequenceNumberRange1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CardSequenceNumberRange1) DeepCopyInto(out *CardSequenceNumberRange1) {
+	*out = *r
+	if r.FrstTx != nil {
+		out.FrstTx = new(common.Max35Text)
+		*out.FrstTx = *r.FrstTx
+	}
+	if r.LastTx != nil {
+		out.LastTx = new(common.Max35Text)
+		*out.LastTx = *r.LastTx
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CardTransaction17) DeepCopy() *CardTransaction17 {
+	if r == nil {
+		return nil
+	}
+	out := new(CardTransaction17)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CardTransaction17) DeepCopyInto(out *CardTransaction17) {
+	*out = *r
+	if r.Card != nil {
+		out.Card = new(PaymentCard4)
+		r.Card.DeepCopyInto(out.Card)
+	}
+	if r.POI != nil {
+		out.POI = new(PointOfInteraction1)
+		r.POI.DeepCopyInto(out.POI)
+	}
+	if r.Tx != nil {
+		out.Tx = new(CardTransaction3Choice)
+		r.Tx.DeepCopyInto(out.Tx)
+	}
+	if r.PrePdAcct != nil {
+		out.PrePdAcct = new(CashAccount38)
+		r.PrePdAcct.DeepCopyInto(out.PrePdAcct)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CardTransaction3Choice) DeepCopy() *CardTransaction3Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(CardTransaction3Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CardTransaction3Choice) DeepCopyInto(out *CardTransaction3Choice) {
+	*out = *r
+	r.Aggtd.DeepCopyInto(&out.Aggtd)
+	r.Indv.DeepCopyInto(&out.Indv)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CardholderAuthentication2) DeepCopy() *CardholderAuthentication2 {
+	if r == nil {
+		return nil
+	}
+	out := new(CardholderAuthentication2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CardholderAuthentication2) DeepCopyInto(out *CardholderAuthentication2) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *Case5) DeepCopy() *Case5 {
+	if r == nil {
+		return nil
+	}
+	out := new(Case5)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *Case5) DeepCopyInto(out *Case5) {
+	*out = *r
+	r.Cretr.DeepCopyInto(&out.Cretr)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CaseAssignment5) DeepCopy() *CaseAssignment5 {
+	if r == nil {
+		return nil
+	}
+	out := new(CaseAssignment5)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CaseAssignment5) DeepCopyInto(out *CaseAssignment5) {
+	*out = *r
+	r.Assgnr.DeepCopyInto(&out.Assgnr)
+	r.Assgne.DeepCopyInto(&out.Assgne)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CashAccount37) DeepCopy() *CashAccount37 {
+	if r == nil {
+		return nil
+	}
+	out := new(CashAccount37)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CashAccount37) DeepCopyInto(out *CashAccount37) {
+	*out = *r
+	if r.Nm != nil {
+		out.Nm = new(common.Max70Text)
+		*out.Nm = *r.Nm
+	}
+	if r.Tp != nil {
+		out.Tp = new(CashAccountType2Choice)
+		*out.Tp = *r.Tp
+	}
+	if r.Ccy != nil {
+		out.Ccy = new(common.ActiveOrHistoricCurrencyCode)
+		*out.Ccy = *r.Ccy
+	}
+	if r.Prxy != nil {
+		out.Prxy = new(ProxyAccountIdentification1)
+		r.Prxy.DeepCopyInto(out.Prxy)
+	}
+	if r.CurMulLmt != nil {
+		out.CurMulLmt = new(Limit5)
+		*out.CurMulLmt = *r.CurMulLmt
+	}
+	if r.Ownr != nil {
+		out.Ownr = new(PartyIdentification135)
+		r.Ownr.DeepCopyInto(out.Ownr)
+	}
+	if r.Svcr != nil {
+		out.Svcr = new(BranchAndFinancialInstitutionIdentification6)
+		r.Svcr.DeepCopyInto(out.Svcr)
+	}
+	if r.MulBal != nil {
+		out.MulBal = make([]CashBalance13, len(r.MulBal))
+		for i := range r.MulBal {
+			r.MulBal[i].DeepCopyInto(&out.MulBal[i])
+		}
+	}
+	if r.CurBilLmt != nil {
+		out.CurBilLmt = make([]BilateralLimit3, len(r.CurBilLmt))
+		for i := range r.CurBilLmt {
+			r.CurBilLmt[i].DeepCopyInto(&out.CurBilLmt[i])
+		}
+	}
+	if r.StgOrdr != nil {
+		out.StgOrdr = make([]StandingOrder6, len(r.StgOrdr))
+		for i := range r.StgOrdr {
+			r.StgOrdr[i].DeepCopyInto(&out.StgOrdr[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CashAccount38) DeepCopy() *CashAccount38 {
+	if r == nil {
+		return nil
+	}
+	out := new(CashAccount38)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CashAccount38) DeepCopyInto(out *CashAccount38) {
+	*out = *r
+	if r.Id != nil {
+		out.Id = new(AccountIdentification4Choice)
+		r.Id.DeepCopyInto(out.Id)
+	}
+	if r.Tp != nil {
+		out.Tp = new(CashAccountType2Choice)
+		*out.Tp = *r.Tp
+	}
+	if r.Ccy != nil {
+		out.Ccy = new(common.ActiveOrHistoricCurrencyCode)
+		*out.Ccy = *r.Ccy
+	}
+	if r.Nm != nil {
+		out.Nm = new(common.Max70Text)
+		*out.Nm = *r.Nm
+	}
+	r.Prxy.DeepCopyInto(&out.Prxy)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CashAccount39) DeepCopy() *CashAccount39 {
+	if r == nil {
+		return nil
+	}
+	out := new(CashAccount39)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CashAccount39) DeepCopyInto(out *CashAccount39) {
+	*out = *r
+	r.Id.DeepCopyInto(&out.Id)
+	if r.Tp != nil {
+		out.Tp = new(CashAccountType2Choice)
+		*out.Tp = *r.Tp
+	}
+	if r.Ccy != nil {
+		out.Ccy = new(common.ActiveOrHistoricCurrencyCode)
+		*out.Ccy = *r.Ccy
+	}
+	if r.Nm != nil {
+		out.Nm = new(common.Max70Text)
+		*out.Nm = *r.Nm
+	}
+	if r.Prxy != nil {
+		out.Prxy = new(ProxyAccountIdentification1)
+		r.Prxy.DeepCopyInto(out.Prxy)
+	}
+	if r.Ownr != nil {
+		out.Ownr = new(PartyIdentification135)
+		r.Ownr.DeepCopyInto(out.Ownr)
+	}
+	if r.Svcr != nil {
+		out.Svcr = new(BranchAndFinancialInstitutionIdentification6)
+		r.Svcr.DeepCopyInto(out.Svcr)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CashAccountAndEntry3) DeepCopy() *CashAccountAndEntry3 {
+	if r == nil {
+		return nil
+	}
+	out := new(CashAccountAndEntry3)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CashAccountAndEntry3) DeepCopyInto(out *CashAccountAndEntry3) {
+	*out = *r
+	r.Acct.DeepCopyInto(&out.Acct)
+	if r.Ntry != nil {
+		out.Ntry = new(CashEntry2)
+		r.Ntry.DeepCopyInto(out.Ntry)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CashAccountEntrySearch6) DeepCopy() *CashAccountEntrySearch6 {
+	if r == nil {
+		return nil
+	}
+	out := new(CashAccountEntrySearch6)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CashAccountEntrySearch6) DeepCopyInto(out *CashAccountEntrySearch6) {
+	*out = *r
+	if r.AcctId != nil {
+		out.AcctId = make([]AccountIdentificationSearchCriteria2Choice, len(r.AcctId))
+		for i := range r.AcctId {
+			r.AcctId[i].DeepCopyInto(&out.AcctId[i])
+		}
+	}
+	if r.NtryAmt != nil {
+		out.NtryAmt = make([]ActiveOrHistoricAmountRange2Choice, len(r.NtryAmt))
+		for i := range r.NtryAmt {
+			r.NtryAmt[i].DeepCopyInto(&out.NtryAmt[i])
+		}
+	}
+	if r.NtryAmtCcy != nil {
+		out.NtryAmtCcy = make([]common.ActiveOrHistoricCurrencyCode, len(r.NtryAmtCcy))
+		copy(out.NtryAmtCcy, r.NtryAmtCcy)
+	}
+	if r.CdtDbtInd != nil {
+		out.CdtDbtInd = new(common.CreditDebitCode)
+		*out.CdtDbtInd = *r.CdtDbtInd
+	}
+	if r.NtrySts != nil {
+		out.NtrySts = make([]EntryStatus1Code, len(r.NtrySts))
+		copy(out.NtrySts, r.NtrySts)
+	}
+	if r.NtryDt != nil {
+		out.NtryDt = make([]DateAndDateTimeSearch3Choice, len(r.NtryDt))
+		copy(out.NtryDt, r.NtryDt)
+	}
+	if r.AcctOwnr != nil {
+		out.AcctOwnr = new(PartyIdentification135)
+		r.AcctOwnr.DeepCopyInto(out.AcctOwnr)
+	}
+	if r.AcctSvcr != nil {
+		out.AcctSvcr = new(BranchAndFinancialInstitutionIdentification6)
+		r.AcctSvcr.DeepCopyInto(out.AcctSvcr)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CashAccountType2Choice) DeepCopy() *CashAccountType2Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(CashAccountType2Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CashAccountType2Choice) DeepCopyInto(out *CashAccountType2Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CashAvailability1) DeepCopy() *CashAvailability1 {
+	if r == nil {
+		return nil
+	}
+	out := new(CashAvailability1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CashAvailability1) DeepCopyInto(out *CashAvailability1) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CashAvailabilityDate1Choice) DeepCopy() *CashAvailabilityDate1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(CashAvailabilityDate1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CashAvailabilityDate1Choice) DeepCopyInto(out *CashAvailabilityDate1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CashBalance11) DeepCopy() *CashBalance11 {
+	if r == nil {
+		return nil
+	}
+	out := new(CashBalance11)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CashBalance11) DeepCopyInto(out *CashBalance11) {
+	*out = *r
+	if r.Tp != nil {
+		out.Tp = new(BalanceType9Choice)
+		*out.Tp = *r.Tp
+	}
+	if r.Sts != nil {
+		out.Sts = new(BalanceStatus1Code)
+		*out.Sts = *r.Sts
+	}
+	if r.ValDt != nil {
+		out.ValDt = new(DateAndDateTime2Choice)
+		*out.ValDt = *r.ValDt
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CashBalance13) DeepCopy() *CashBalance13 {
+	if r == nil {
+		return nil
+	}
+	out := new(CashBalance13)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CashBalance13) DeepCopyInto(out *CashBalance13) {
+	*out = *r
+	if r.Tp != nil {
+		out.Tp = new(BalanceType11Choice)
+		*out.Tp = *r.Tp
+	}
+	if r.Sts != nil {
+		out.Sts = new(BalanceStatus1Code)
+		*out.Sts = *r.Sts
+	}
+	if r.ValDt != nil {
+		out.ValDt = new(DateAndDateTime2Choice)
+		*out.ValDt = *r.ValDt
+	}
+	if r.PrcgDt != nil {
+		out.PrcgDt = new(DateAndDateTime2Choice)
+		*out.PrcgDt = *r.PrcgDt
+	}
+	if r.RstrctnTp != nil {
+		out.RstrctnTp = new(BalanceRestrictionType1)
+		r.RstrctnTp.DeepCopyInto(out.RstrctnTp)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CashBalance8) DeepCopy() *CashBalance8 {
+	if r == nil {
+		return nil
+	}
+	out := new(CashBalance8)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CashBalance8) DeepCopyInto(out *CashBalance8) {
+	*out = *r
+	r.Tp.DeepCopyInto(&out.Tp)
+	if r.CdtLine != nil {
+		out.CdtLine = make([]CreditLine3, len(r.CdtLine))
+		for i := range r.CdtLine {
+			r.CdtLine[i].DeepCopyInto(&out.CdtLine[i])
+		}
+	}
+	if r.Avlbty != nil {
+		out.Avlbty = make([]CashAvailability1, len(r.Avlbty))
+		copy(out.Avlbty, r.Avlbty)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CashDeposit1) DeepCopy() *CashDeposit1 {
+	if r == nil {
+		return nil
+	}
+	out := new(CashDeposit1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CashDeposit1) DeepCopyInto(out *CashDeposit1) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CashEntry2) DeepCopy() *CashEntry2 {
+	if r == nil {
+		return nil
+	}
+	out := new(CashEntry2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CashEntry2) DeepCopyInto(out *CashEntry2) {
+	*out = *r
+	if r.Amt != nil {
+		out.Amt = new(ActiveCurrencyAndAmount)
+		*out.Amt = *r.Amt
+	}
+	if r.Dt != nil {
+		out.Dt = new(DateAndDateTime2Choice)
+		*out.Dt = *r.Dt
+	}
+	if r.Sts != nil {
+		out.Sts = new(EntryStatus1Code)
+		*out.Sts = *r.Sts
+	}
+	if r.Id != nil {
+		out.Id = new(common.Max35Text)
+		*out.Id = *r.Id
+	}
+	if r.StmtId != nil {
+		out.StmtId = new(common.Max35Text)
+		*out.StmtId = *r.StmtId
+	}
+	if r.AddtlNtryInf != nil {
+		out.AddtlNtryInf = make([]common.Max140Text, len(r.AddtlNtryInf))
+		copy(out.AddtlNtryInf, r.AddtlNtryInf)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CategoryPurpose1Choice) DeepCopy() *CategoryPurpose1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(CategoryPurpose1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CategoryPurpose1Choice) DeepCopyInto(out *CategoryPurpose1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ChargeType3Choice) DeepCopy() *ChargeType3Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(ChargeType3Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ChargeType3Choice) DeepCopyInto(out *ChargeType3Choice) {
+	*out = *r
+	r.Prtry.DeepCopyInto(&out.Prtry)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *Charges6) DeepCopy() *Charges6 {
+	if r == nil {
+		return nil
+	}
+	out := new(Charges6)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *Charges6) DeepCopyInto(out *Charges6) {
+	*out = *r
+	if r.TtlChrgsAndTaxAmt != nil {
+		out.TtlChrgsAndTaxAmt = new(ActiveOrHistoricCurrencyAndAmount)
+		*out.TtlChrgsAndTaxAmt = *r.TtlChrgsAndTaxAmt
+	}
+	if r.Rcrd != nil {
+		out.Rcrd = make([]ChargesRecord3, len(r.Rcrd))
+		for i := range r.Rcrd {
+			r.Rcrd[i].DeepCopyInto(&out.Rcrd[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ChargesRecord3) DeepCopy() *ChargesRecord3 {
+	if r == nil {
+		return nil
+	}
+	out := new(ChargesRecord3)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ChargesRecord3) DeepCopyInto(out *ChargesRecord3) {
+	*out = *r
+	if r.CdtDbtInd != nil {
+		out.CdtDbtInd = new(common.CreditDebitCode)
+		*out.CdtDbtInd = *r.CdtDbtInd
+	}
+	if r.Tp != nil {
+		out.Tp = new(ChargeType3Choice)
+		r.Tp.DeepCopyInto(out.Tp)
+	}
+	if r.Br != nil {
+		out.Br = new(ChargeBearerType1Code)
+		*out.Br = *r.Br
+	}
+	if r.Agt != nil {
+		out.Agt = new(BranchAndFinancialInstitutionIdentification6)
+		r.Agt.DeepCopyInto(out.Agt)
+	}
+	if r.Tax != nil {
+		out.Tax = new(TaxCharges2)
+		r.Tax.DeepCopyInto(out.Tax)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ClaimNonReceiptV08) DeepCopy() *ClaimNonReceiptV08 {
+	if r == nil {
+		return nil
+	}
+	out := new(ClaimNonReceiptV08)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ClaimNonReceiptV08) DeepCopyInto(out *ClaimNonReceiptV08) {
+	*out = *r
+	r.Assgnmt.DeepCopyInto(&out.Assgnmt)
+	if r.Case != nil {
+		out.Case = new(Case5)
+		r.Case.DeepCopyInto(out.Case)
+	}
+	r.Undrlyg.DeepCopyInto(&out.Undrlyg)
+	if r.CoverDtls != nil {
+		out.CoverDtls = new(MissingCover4)
+		r.CoverDtls.DeepCopyInto(out.CoverDtls)
+	}
+	if r.InstrForAssgne != nil {
+		out.InstrForAssgne = new(InstructionForAssignee1)
+		r.InstrForAssgne.DeepCopyInto(out.InstrForAssgne)
+	}
+	if r.SplmtryData != nil {
+		out.SplmtryData = make([]SupplementaryData1, len(r.SplmtryData))
+		for i := range r.SplmtryData {
+			r.SplmtryData[i].DeepCopyInto(&out.SplmtryData[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ClearingSystemIdentification2Choice) DeepCopy() *ClearingSystemIdentification2Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(ClearingSystemIdentification2Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ClearingSystemIdentification2Choice) DeepCopyInto(out *ClearingSystemIdentification2Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ClearingSystemIdentification3Choice) DeepCopy() *ClearingSystemIdentification3Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(ClearingSystemIdentification3Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ClearingSystemIdentification3Choice) DeepCopyInto(out *ClearingSystemIdentification3Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ClearingSystemMemberIdentification2) DeepCopy() *ClearingSystemMemberIdentification2 {
+	if r == nil {
+		return nil
+	}
+	out := new(ClearingSystemMemberIdentification2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ClearingSystemMemberIdentification2) DeepCopyInto(out *ClearingSystemMemberIdentification2) {
+	*out = *r
+	if r.ClrSysId != nil {
+		out.ClrSysId = new(ClearingSystemIdentification2Choice)
+		*out.ClrSysId = *r.ClrSysId
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *Contact4) DeepCopy() *Contact4 {
+	if r == nil {
+		return nil
+	}
+	out := new(Contact4)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *Contact4) DeepCopyInto(out *Contact4) {
+	*out = *r
+	if r.NmPrfx != nil {
+		out.NmPrfx = new(common.NamePrefix2Code)
+		*out.NmPrfx = *r.NmPrfx
+	}
+	if r.Nm != nil {
+		out.Nm = new(common.Max140Text)
+		*out.Nm = *r.Nm
+	}
+	if r.PhneNb != nil {
+		out.PhneNb = new(common.PhoneNumber)
+		*out.PhneNb = *r.PhneNb
+	}
+	if r.MobNb != nil {
+		out.MobNb = new(common.PhoneNumber)
+		*out.MobNb = *r.MobNb
+	}
+	if r.FaxNb != nil {
+		out.FaxNb = new(common.PhoneNumber)
+		*out.FaxNb = *r.FaxNb
+	}
+	if r.EmailAdr != nil {
+		out.EmailAdr = new(common.Max2048Text)
+		*out.EmailAdr = *r.EmailAdr
+	}
+	if r.EmailPurp != nil {
+		out.EmailPurp = new(common.Max35Text)
+		*out.EmailPurp = *r.EmailPurp
+	}
+	if r.JobTitl != nil {
+		out.JobTitl = new(common.Max35Text)
+		*out.JobTitl = *r.JobTitl
+	}
+	if r.Rspnsblty != nil {
+		out.Rspnsblty = new(common.Max35Text)
+		*out.Rspnsblty = *r.Rspnsblty
+	}
+	if r.Dept != nil {
+		out.Dept = new(common.Max70Text)
+		*out.Dept = *r.Dept
+	}
+	if r.Othr != nil {
+		out.Othr = make([]OtherContact1, len(r.Othr))
+		for i := range r.Othr {
+			r.Othr[i].DeepCopyInto(&out.Othr[i])
+		}
+	}
+	if r.PrefrdMtd != nil {
+		out.PrefrdMtd = new(PreferredContactMethod1Code)
+		*out.PrefrdMtd = *r.PrefrdMtd
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ControlData1) DeepCopy() *ControlData1 {
+	if r == nil {
+		return nil
+	}
+	out := new(ControlData1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ControlData1) DeepCopyInto(out *ControlData1) {
+	*out = *r
+	if r.NbOfTxs != nil {
+		out.NbOfTxs = new(common.Max15NumericText)
+		*out.NbOfTxs = *r.NbOfTxs
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CorporateAction9) DeepCopy() *CorporateAction9 {
+	if r == nil {
+		return nil
+	}
+	out := new(CorporateAction9)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CorporateAction9) DeepCopyInto(out *CorporateAction9) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CreditLine3) DeepCopy() *CreditLine3 {
+	if r == nil {
+		return nil
+	}
+	out := new(CreditLine3)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CreditLine3) DeepCopyInto(out *CreditLine3) {
+	*out = *r
+	if r.Tp != nil {
+		out.Tp = new(CreditLineType1Choice)
+		*out.Tp = *r.Tp
+	}
+	if r.Amt != nil {
+		out.Amt = new(ActiveOrHistoricCurrencyAndAmount)
+		*out.Amt = *r.Amt
+	}
+	if r.Dt != nil {
+		out.Dt = new(DateAndDateTime2Choice)
+		*out.Dt = *r.Dt
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CreditLineType1Choice) DeepCopy() *CreditLineType1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(CreditLineType1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CreditLineType1Choice) DeepCopyInto(out *CreditLineType1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CreditTransferMandateData1) DeepCopy() *CreditTransferMandateData1 {
+	if r == nil {
+		return nil
+	}
+	out := new(CreditTransferMandateData1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CreditTransferMandateData1) DeepCopyInto(out *CreditTransferMandateData1) {
+	*out = *r
+	if r.MndtId != nil {
+		out.MndtId = new(common.Max35Text)
+		*out.MndtId = *r.MndtId
+	}
+	if r.Tp != nil {
+		out.Tp = new(MandateTypeInformation2)
+		r.Tp.DeepCopyInto(out.Tp)
+	}
+	if r.DtOfSgntr != nil {
+		out.DtOfSgntr = new(common.ISODate)
+		*out.DtOfSgntr = *r.DtOfSgntr
+	}
+	if r.DtOfVrfctn != nil {
+		out.DtOfVrfctn = new(common.ISODateTime)
+		*out.DtOfVrfctn = *r.DtOfVrfctn
+	}
+	if r.ElctrncSgntr != nil {
+		out.ElctrncSgntr = new(common.Max10KBinary)
+		*out.ElctrncSgntr = *r.ElctrncSgntr
+		if (*r.ElctrncSgntr) != nil {
+			(*out.ElctrncSgntr) = make(common.Max10KBinary, len((*r.ElctrncSgntr)))
+			copy((*out.ElctrncSgntr), (*r.ElctrncSgntr))
+		}
+	}
+	if r.FrstPmtDt != nil {
+		out.FrstPmtDt = new(common.ISODate)
+		*out.FrstPmtDt = *r.FrstPmtDt
+	}
+	if r.FnlPmtDt != nil {
+		out.FnlPmtDt = new(common.ISODate)
+		*out.FnlPmtDt = *r.FnlPmtDt
+	}
+	if r.Frqcy != nil {
+		out.Frqcy = new(Frequency36Choice)
+		*out.Frqcy = *r.Frqcy
+	}
+	if r.Rsn != nil {
+		out.Rsn = new(MandateSetupReason1Choice)
+		*out.Rsn = *r.Rsn
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CreditorReferenceInformation2) DeepCopy() *CreditorReferenceInformation2 {
+	if r == nil {
+		return nil
+	}
+	out := new(CreditorReferenceInformation2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CreditorReferenceInformation2) DeepCopyInto(out *CreditorReferenceInformation2) {
+	*out = *r
+	if r.Tp != nil {
+		out.Tp = new(CreditorReferenceType2)
+		r.Tp.DeepCopyInto(out.Tp)
+	}
+	if r.Ref != nil {
+		out.Ref = new(common.Max35Text)
+		*out.Ref = *r.Ref
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CreditorReferenceType1Choice) DeepCopy() *CreditorReferenceType1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(CreditorReferenceType1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CreditorReferenceType1Choice) DeepCopyInto(out *CreditorReferenceType1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CreditorReferenceType2) DeepCopy() *CreditorReferenceType2 {
+	if r == nil {
+		return nil
+	}
+	out := new(CreditorReferenceType2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CreditorReferenceType2) DeepCopyInto(out *CreditorReferenceType2) {
+	*out = *r
+	if r.Issr != nil {
+		out.Issr = new(common.Max35Text)
+		*out.Issr = *r.Issr
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CurrencyExchange5) DeepCopy() *CurrencyExchange5 {
+	if r == nil {
+		return nil
+	}
+	out := new(CurrencyExchange5)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CurrencyExchange5) DeepCopyInto(out *CurrencyExchange5) {
+	*out = *r
+	if r.TrgtCcy != nil {
+		out.TrgtCcy = new(common.ActiveOrHistoricCurrencyCode)
+		*out.TrgtCcy = *r.TrgtCcy
+	}
+	if r.UnitCcy != nil {
+		out.UnitCcy = new(common.ActiveOrHistoricCurrencyCode)
+		*out.UnitCcy = *r.UnitCcy
+	}
+	if r.CtrctId != nil {
+		out.CtrctId = new(common.Max35Text)
+		*out.CtrctId = *r.CtrctId
+	}
+	if r.QtnDt != nil {
+		out.QtnDt = new(common.ISODateTime)
+		*out.QtnDt = *r.QtnDt
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *DateAndDateTime2Choice) DeepCopy() *DateAndDateTime2Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(DateAndDateTime2Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *DateAndDateTime2Choice) DeepCopyInto(out *DateAndDateTime2Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *DateAndDateTimeSearch3Choice) DeepCopy() *DateAndDateTimeSearch3Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(DateAndDateTimeSearch3Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *DateAndDateTimeSearch3Choice) DeepCopyInto(out *DateAndDateTimeSearch3Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *DateAndPlaceOfBirth1) DeepCopy() *DateAndPlaceOfBirth1 {
+	if r == nil {
+		return nil
+	}
+	out := new(DateAndPlaceOfBirth1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *DateAndPlaceOfBirth1) DeepCopyInto(out *DateAndPlaceOfBirth1) {
+	*out = *r
+	if r.PrvcOfBirth != nil {
+		out.PrvcOfBirth = new(common.Max35Text)
+		*out.PrvcOfBirth = *r.PrvcOfBirth
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *DateOrDateTimePeriod1Choice) DeepCopy() *DateOrDateTimePeriod1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(DateOrDateTimePeriod1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *DateOrDateTimePeriod1Choice) DeepCopyInto(out *DateOrDateTimePeriod1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *DatePeriod2) DeepCopy() *DatePeriod2 {
+	if r == nil {
+		return nil
+	}
+	out := new(DatePeriod2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *DatePeriod2) DeepCopyInto(out *DatePeriod2) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *DatePeriodDetails1) DeepCopy() *DatePeriodDetails1 {
+	if r == nil {
+		return nil
+	}
+	out := new(DatePeriodDetails1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *DatePeriodDetails1) DeepCopyInto(out *DatePeriodDetails1) {
+	*out = *r
+	if r.ToDt != nil {
+		out.ToDt = new(common.ISODate)
+		*out.ToDt = *r.ToDt
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *DatePeriodSearch1Choice) DeepCopy() *DatePeriodSearch1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(DatePeriodSearch1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *DatePeriodSearch1Choice) DeepCopyInto(out *DatePeriodSearch1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *DateTimePeriod1) DeepCopy() *DateTimePeriod1 {
+	if r == nil {
+		return nil
+	}
+	out := new(DateTimePeriod1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *DateTimePeriod1) DeepCopyInto(out *DateTimePeriod1) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *DateTimePeriod1Choice) DeepCopy() *DateTimePeriod1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(DateTimePeriod1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *DateTimePeriod1Choice) DeepCopyInto(out *DateTimePeriod1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *DebitAuthorisation2) DeepCopy() *DebitAuthorisation2 {
+	if r == nil {
+		return nil
+	}
+	out := new(DebitAuthorisation2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *DebitAuthorisation2) DeepCopyInto(out *DebitAuthorisation2) {
+	*out = *r
+	r.CxlRsn.DeepCopyInto(&out.CxlRsn)
+	if r.AmtToDbt != nil {
+		out.AmtToDbt = new(ActiveOrHistoricCurrencyAndAmount)
+		*out.AmtToDbt = *r.AmtToDbt
+	}
+	if r.ValDtToDbt != nil {
+		out.ValDtToDbt = new(common.ISODate)
+		*out.ValDtToDbt = *r.ValDtToDbt
+	}
+	if r.AddtlCxlRsnInf != nil {
+		out.AddtlCxlRsnInf = make([]common.Max105Text, len(r.AddtlCxlRsnInf))
+		copy(out.AddtlCxlRsnInf, r.AddtlCxlRsnInf)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *DebitAuthorisationRequestV08) DeepCopy() *DebitAuthorisationRequestV08 {
+	if r == nil {
+		return nil
+	}
+	out := new(DebitAuthorisationRequestV08)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *DebitAuthorisationRequestV08) DeepCopyInto(out *DebitAuthorisationRequestV08) {
+	*out = *r
+	r.Assgnmt.DeepCopyInto(&out.Assgnmt)
+	if r.Case != nil {
+		out.Case = new(Case5)
+		r.Case.DeepCopyInto(out.Case)
+	}
+	r.Undrlyg.DeepCopyInto(&out.Undrlyg)
+	r.Dtl.DeepCopyInto(&out.Dtl)
+	if r.SplmtryData != nil {
+		out.SplmtryData = make([]SupplementaryData1, len(r.SplmtryData))
+		for i := range r.SplmtryData {
+			r.SplmtryData[i].DeepCopyInto(&out.SplmtryData[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *DiscountAmountAndType1) DeepCopy() *DiscountAmountAndType1 {
+	if r == nil {
+		return nil
+	}
+	out := new(DiscountAmountAndType1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *DiscountAmountAndType1) DeepCopyInto(out *DiscountAmountAndType1) {
+	*out = *r
+	if r.Tp != nil {
+		out.Tp = new(DiscountAmountType1Choice)
+		*out.Tp = *r.Tp
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *DiscountAmountType1Choice) DeepCopy() *DiscountAmountType1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(DiscountAmountType1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *DiscountAmountType1Choice) DeepCopyInto(out *DiscountAmountType1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *DisplayCapabilities1) DeepCopy() *DisplayCapabilities1 {
+	if r == nil {
+		return nil
+	}
+	out := new(DisplayCapabilities1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *DisplayCapabilities1) DeepCopyInto(out *DisplayCapabilities1) {
+	*out = *r
+	if r.NbOfLines != nil {
+		out.NbOfLines = new(common.Max3NumericText)
+		*out.NbOfLines = *r.NbOfLines
+	}
+	if r.LineWidth != nil {
+		out.LineWidth = new(common.Max3NumericText)
+		*out.LineWidth = *r.LineWidth
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *DocumentAdjustment1) DeepCopy() *DocumentAdjustment1 {
+	if r == nil {
+		return nil
+	}
+	out := new(DocumentAdjustment1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *DocumentAdjustment1) DeepCopyInto(out *DocumentAdjustment1) {
+	*out = *r
+	if r.CdtDbtInd != nil {
+		out.CdtDbtInd = new(common.CreditDebitCode)
+		*out.CdtDbtInd = *r.CdtDbtInd
+	}
+	if r.Rsn != nil {
+		out.Rsn = new(common.Max4Text)
+		*out.Rsn = *r.Rsn
+	}
+	if r.AddtlInf != nil {
+		out.AddtlInf = new(common.Max140Text)
+		*out.AddtlInf = *r.AddtlInf
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *DocumentLineIdentification1) DeepCopy() *DocumentLineIdentification1 {
+	if r == nil {
+		return nil
+	}
+	out := new(DocumentLineIdentification1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *DocumentLineIdentification1) DeepCopyInto(out *DocumentLineIdentification1) {
+	*out = *r
+	if r.Tp != nil {
+		out.Tp = new(DocumentLineType1)
+		r.Tp.DeepCopyInto(out.Tp)
+	}
+	if r.Nb != nil {
+		out.Nb = new(common.Max35Text)
+		*out.Nb = *r.Nb
+	}
+	if r.RltdDt != nil {
+		out.RltdDt = new(common.ISODate)
+		*out.RltdDt = *r.RltdDt
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *DocumentLineInformation1) DeepCopy() *DocumentLineInformation1 {
+	if r == nil {
+		return nil
+	}
+	out := new(DocumentLineInformation1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *DocumentLineInformation1) DeepCopyInto(out *DocumentLineInformation1) {
+	*out = *r
+	if r.Id != nil {
+		out.Id = make([]DocumentLineIdentification1, len(r.Id))
+		for i := range r.Id {
+			r.Id[i].DeepCopyInto(&out.Id[i])
+		}
+	}
+	if r.Desc != nil {
+		out.Desc = new(common.Max2048Text)
+		*out.Desc = *r.Desc
+	}
+	if r.Amt != nil {
+		out.Amt = new(RemittanceAmount3)
+		r.Amt.DeepCopyInto(out.Amt)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *DocumentLineType1) DeepCopy() *DocumentLineType1 {
+	if r == nil {
+		return nil
+	}
+	out := new(DocumentLineType1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *DocumentLineType1) DeepCopyInto(out *DocumentLineType1) {
+	*out = *r
+	if r.Issr != nil {
+		out.Issr = new(common.Max35Text)
+		*out.Issr = *r.Issr
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *DocumentLineType1Choice) DeepCopy() *DocumentLineType1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(DocumentLineType1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *DocumentLineType1Choice) DeepCopyInto(out *DocumentLineType1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *EntryDetails9) DeepCopy() *EntryDetails9 {
+	if r == nil {
+		return nil
+	}
+	out := new(EntryDetails9)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *EntryDetails9) DeepCopyInto(out *EntryDetails9) {
+	*out = *r
+	if r.Btch != nil {
+		out.Btch = new(BatchInformation2)
+		r.Btch.DeepCopyInto(out.Btch)
+	}
+	if r.TxDtls != nil {
+		out.TxDtls = make([]EntryTransaction10, len(r.TxDtls))
+		for i := range r.TxDtls {
+			r.TxDtls[i].DeepCopyInto(&out.TxDtls[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *EntryStatus1Choice) DeepCopy() *EntryStatus1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(EntryStatus1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *EntryStatus1Choice) DeepCopyInto(out *EntryStatus1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *EntryTransaction10) DeepCopy() *EntryTransaction10 {
+	if r == nil {
+		return nil
+	}
+	out := new(EntryTransaction10)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *EntryTransaction10) DeepCopyInto(out *EntryTransaction10) {
+	*out = *r
+	if r.Refs != nil {
+		out.Refs = new(TransactionReferences6)
+		r.Refs.DeepCopyInto(out.Refs)
+	}
+	if r.Amt != nil {
+		out.Amt = new(ActiveOrHistoricCurrencyAndAmount)
+		*out.Amt = *r.Amt
+	}
+	if r.CdtDbtInd != nil {
+		out.CdtDbtInd = new(common.CreditDebitCode)
+		*out.CdtDbtInd = *r.CdtDbtInd
+	}
+	if r.AmtDtls != nil {
+		out.AmtDtls = new(AmountAndCurrencyExchange3)
+		r.AmtDtls.DeepCopyInto(out.AmtDtls)
+	}
+	if r.Avlbty != nil {
+		out.Avlbty = make([]CashAvailability1, len(r.Avlbty))
+		copy(out.Avlbty, r.Avlbty)
+	}
+	if r.BkTxCd != nil {
+		out.BkTxCd = new(BankTransactionCodeStructure4)
+		r.BkTxCd.DeepCopyInto(out.BkTxCd)
+	}
+	if r.Chrgs != nil {
+		out.Chrgs = new(Charges6)
+		r.Chrgs.DeepCopyInto(out.Chrgs)
+	}
+	if r.Intrst != nil {
+		out.Intrst = new(TransactionInterest4)
+		r.Intrst.DeepCopyInto(out.Intrst)
+	}
+	if r.RltdPties != nil {
+		out.RltdPties = new(TransactionParties6)
+		r.RltdPties.DeepCopyInto(out.RltdPties)
+	}
+	if r.RltdAgts != nil {
+		out.RltdAgts = new(TransactionAgents5)
+		r.RltdAgts.DeepCopyInto(out.RltdAgts)
+	}
+	if r.LclInstrm != nil {
+		out.LclInstrm = new(LocalInstrument2Choice)
+		*out.LclInstrm = *r.LclInstrm
+	}
+	if r.Purp != nil {
+		out.Purp = new(Purpose2Choice)
+		*out.Purp = *r.Purp
+	}
+	if r.RltdRmtInf != nil {
+		out.RltdRmtInf = make([]RemittanceLocation7, len(r.RltdRmtInf))
+		for i := range r.RltdRmtInf {
+			r.RltdRmtInf[i].DeepCopyInto(&out.RltdRmtInf[i])
+		}
+	}
+	if r.RmtInf != nil {
+		out.RmtInf = new(RemittanceInformation16)
+		r.RmtInf.DeepCopyInto(out.RmtInf)
+	}
+	if r.RltdDts != nil {
+		out.RltdDts = new(TransactionDates3)
+		r.RltdDts.DeepCopyInto(out.RltdDts)
+	}
+	if r.RltdPric != nil {
+		out.RltdPric = new(TransactionPrice4Choice)
+		r.RltdPric.DeepCopyInto(out.RltdPric)
+	}
+	if r.RltdQties != nil {
+		out.RltdQties = make([]TransactionQuantities3Choice, len(r.RltdQties))
+		copy(out.RltdQties, r.RltdQties)
+	}
+	if r.FinInstrmId != nil {
+		out.FinInstrmId = new(SecurityIdentification19)
+		r.FinInstrmId.DeepCopyInto(out.FinInstrmId)
+	}
+	if r.Tax != nil {
+		out.Tax = new(TaxInformation8)
+		r.Tax.DeepCopyInto(out.Tax)
+	}
+	if r.RtrInf != nil {
+		out.RtrInf = new(PaymentReturnReason5)
+		r.RtrInf.DeepCopyInto(out.RtrInf)
+	}
+	if r.CorpActn != nil {
+		out.CorpActn = new(CorporateAction9)
+		*out.CorpActn = *r.CorpActn
+	}
+	if r.SfkpgAcct != nil {
+		out.SfkpgAcct = new(SecuritiesAccount19)
+		r.SfkpgAcct.DeepCopyInto(out.SfkpgAcct)
+	}
+	if r.CshDpst != nil {
+		out.CshDpst = make([]CashDeposit1, len(r.CshDpst))
+		copy(out.CshDpst, r.CshDpst)
+	}
+	if r.CardTx != nil {
+		out.CardTx = new(CardTransaction17)
+		r.CardTx.DeepCopyInto(out.CardTx)
+	}
+	if r.AddtlTxInf != nil {
+		out.AddtlTxInf = new(common.Max500Text)
+		*out.AddtlTxInf = *r.AddtlTxInf
+	}
+	if r.SplmtryData != nil {
+		out.SplmtryData = make([]SupplementaryData1, len(r.SplmtryData))
+		for i := range r.SplmtryData {
+			r.SplmtryData[i].DeepCopyInto(&out.SplmtryData[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *EquivalentAmount2) DeepCopy() *EquivalentAmount2 {
+	if r == nil {
+		return nil
+	}
+	out := new(EquivalentAmount2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *EquivalentAmount2) DeepCopyInto(out *EquivalentAmount2) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ErrorHandling3Choice) DeepCopy() *ErrorHandling3Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(ErrorHandling3Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ErrorHandling3Choice) DeepCopyInto(out *ErrorHandling3Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ErrorHandling5) DeepCopy() *ErrorHandling5 {
+	if r == nil {
+		return nil
+	}
+	out := new(ErrorHandling5)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ErrorHandling5) DeepCopyInto(out *ErrorHandling5) {
+	*out = *r
+	if r.Desc != nil {
+		out.Desc = new(common.Max140Text)
+		*out.Desc = *r.Desc
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *EventType1Choice) DeepCopy() *EventType1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(EventType1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *EventType1Choice) DeepCopyInto(out *EventType1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ExecutionType1Choice) DeepCopy() *ExecutionType1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(ExecutionType1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ExecutionType1Choice) DeepCopyInto(out *ExecutionType1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *FIToFIPaymentCancellationRequestV08) DeepCopy() *FIToFIPaymentCancellationRequestV08 {
+	if r == nil {
+		return nil
+	}
+	out := new(FIToFIPaymentCancellationRequestV08)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *FIToFIPaymentCancellationRequestV08) DeepCopyInto(out *FIToFIPaymentCancellationRequestV08) {
+	*out = *r
+	r.Assgnmt.DeepCopyInto(&out.Assgnmt)
+	if r.Case != nil {
+		out.Case = new(Case5)
+		r.Case.DeepCopyInto(out.Case)
+	}
+	if r.CtrlData != nil {
+		out.CtrlData = new(ControlData1)
+		r.CtrlData.DeepCopyInto(out.CtrlData)
+	}
+	if r.Undrlyg != nil {
+		out.Undrlyg = make([]UnderlyingTransaction23, len(r.Undrlyg))
+		for i := range r.Undrlyg {
+			r.Undrlyg[i].DeepCopyInto(&out.Undrlyg[i])
+		}
+	}
+	if r.SplmtryData != nil {
+		out.SplmtryData = make([]SupplementaryData1, len(r.SplmtryData))
+		for i := range r.SplmtryData {
+			r.SplmtryData[i].DeepCopyInto(&out.SplmtryData[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *FinancialIdentificationSchemeName1Choice) DeepCopy() *FinancialIdentificationSchemeName1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(FinancialIdentificationSchemeName1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *FinancialIdentificationSchemeName1Choice) DeepCopyInto(out *FinancialIdentificationSchemeName1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *FinancialInstitutionIdentification18) DeepCopy() *FinancialInstitutionIdentification18 {
+	if r == nil {
+		return nil
+	}
+	out := new(FinancialInstitutionIdentification18)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *FinancialInstitutionIdentification18) DeepCopyInto(out *FinancialInstitutionIdentification18) {
+	*out = *r
+	if r.BICFI != nil {
+		out.BICFI = new(common.BICFIDec2014Identifier)
+		*out.BICFI = *r.BICFI
+	}
+	if r.ClrSysMmbId != nil {
+		out.ClrSysMmbId = new(ClearingSystemMemberIdentification2)
+		r.ClrSysMmbId.DeepCopyInto(out.ClrSysMmbId)
+	}
+	if r.LEI != nil {
+		out.LEI = new(common.LEIIdentifier)
+		*out.LEI = *r.LEI
+	}
+	if r.Nm != nil {
+		out.Nm = new(common.Max140Text)
+		*out.Nm = *r.Nm
+	}
+	if r.PstlAdr != nil {
+		out.PstlAdr = new(PostalAddress24)
+		r.PstlAdr.DeepCopyInto(out.PstlAdr)
+	}
+	if r.Othr != nil {
+		out.Othr = new(GenericFinancialIdentification1)
+		r.Othr.DeepCopyInto(out.Othr)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *FinancialInstrumentQuantity1Choice) DeepCopy() *FinancialInstrumentQuantity1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(FinancialInstrumentQuantity1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *FinancialInstrumentQuantity1Choice) DeepCopyInto(out *FinancialInstrumentQuantity1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *Frequency36Choice) DeepCopy() *Frequency36Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(Frequency36Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *Frequency36Choice) DeepCopyInto(out *Frequency36Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *FrequencyAndMoment1) DeepCopy() *FrequencyAndMoment1 {
+	if r == nil {
+		return nil
+	}
+	out := new(FrequencyAndMoment1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *FrequencyAndMoment1) DeepCopyInto(out *FrequencyAndMoment1) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *FrequencyPeriod1) DeepCopy() *FrequencyPeriod1 {
+	if r == nil {
+		return nil
+	}
+	out := new(FrequencyPeriod1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *FrequencyPeriod1) DeepCopyInto(out *FrequencyPeriod1) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *FromToAmountRange1) DeepCopy() *FromToAmountRange1 {
+	if r == nil {
+		return nil
+	}
+	out := new(FromToAmountRange1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *FromToAmountRange1) DeepCopyInto(out *FromToAmountRange1) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *Garnishment3) DeepCopy() *Garnishment3 {
+	if r == nil {
+		return nil
+	}
+	out := new(Garnishment3)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *Garnishment3) DeepCopyInto(out *Garnishment3) {
+	*out = *r
+	r.Tp.DeepCopyInto(&out.Tp)
+	if r.Grnshee != nil {
+		out.Grnshee = new(PartyIdentification135)
+		r.Grnshee.DeepCopyInto(out.Grnshee)
+	}
+	if r.GrnshmtAdmstr != nil {
+		out.GrnshmtAdmstr = new(PartyIdentification135)
+		r.GrnshmtAdmstr.DeepCopyInto(out.GrnshmtAdmstr)
+	}
+	if r.RefNb != nil {
+		out.RefNb = new(common.Max140Text)
+		*out.RefNb = *r.RefNb
+	}
+	if r.Dt != nil {
+		out.Dt = new(common.ISODate)
+		*out.Dt = *r.Dt
+	}
+	if r.RmtdAmt != nil {
+		out.RmtdAmt = new(ActiveOrHistoricCurrencyAndAmount)
+		*out.RmtdAmt = *r.RmtdAmt
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *GarnishmentType1) DeepCopy() *GarnishmentType1 {
+	if r == nil {
+		return nil
+	}
+	out := new(GarnishmentType1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *GarnishmentType1) DeepCopyInto(out *GarnishmentType1) {
+	*out = *r
+	if r.Issr != nil {
+		out.Issr = new(common.Max35Text)
+		*out.Issr = *r.Issr
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *GarnishmentType1Choice) DeepCopy() *GarnishmentType1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(GarnishmentType1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *GarnishmentType1Choice) DeepCopyInto(out *GarnishmentType1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *GenericAccountIdentification1) DeepCopy() *GenericAccountIdentification1 {
+	if r == nil {
+		return nil
+	}
+	out := new(GenericAccountIdentification1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *GenericAccountIdentification1) DeepCopyInto(out *GenericAccountIdentification1) {
+	*out = *r
+	if r.SchmeNm != nil {
+		out.SchmeNm = new(AccountSchemeName1Choice)
+		*out.SchmeNm = *r.SchmeNm
+	}
+	if r.Issr != nil {
+		out.Issr = new(common.Max35Text)
+		*out.Issr = *r.Issr
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *GenericFinancialIdentification1) DeepCopy() *GenericFinancialIdentification1 {
+	if r == nil {
+		return nil
+	}
+	out := new(GenericFinancialIdentification1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *GenericFinancialIdentification1) DeepCopyInto(out *GenericFinancialIdentification1) {
+	*out = *r
+	if r.SchmeNm != nil {
+		out.SchmeNm = new(FinancialIdentificationSchemeName1Choice)
+		*out.SchmeNm = *r.SchmeNm
+	}
+	if r.Issr != nil {
+		out.Issr = new(common.Max35Text)
+		*out.Issr = *r.Issr
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *GenericIdentification1) DeepCopy() *GenericIdentification1 {
+	if r == nil {
+		return nil
+	}
+	out := new(GenericIdentification1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *GenericIdentification1) DeepCopyInto(out *GenericIdentification1) {
+	*out = *r
+	if r.SchmeNm != nil {
+		out.SchmeNm = new(common.Max35Text)
+		*out.SchmeNm = *r.SchmeNm
+	}
+	if r.Issr != nil {
+		out.Issr = new(common.Max35Text)
+		*out.Issr = *r.Issr
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *GenericIdentification3) DeepCopy() *GenericIdentification3 {
+	if r == nil {
+		return nil
+	}
+	out := new(GenericIdentification3)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *GenericIdentification3) DeepCopyInto(out *GenericIdentification3) {
+	*out = *r
+	if r.Issr != nil {
+		out.Issr = new(common.Max35Text)
+		*out.Issr = *r.Issr
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *GenericIdentification30) DeepCopy() *GenericIdentification30 {
+	if r == nil {
+		return nil
+	}
+	out := new(GenericIdentification30)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *GenericIdentification30) DeepCopyInto(out *GenericIdentification30) {
+	*out = *r
+	if r.SchmeNm != nil {
+		out.SchmeNm = new(common.Max35Text)
+		*out.SchmeNm = *r.SchmeNm
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *GenericIdentification32) DeepCopy() *GenericIdentification32 {
+	if r == nil {
+		return nil
+	}
+	out := new(GenericIdentification32)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *GenericIdentification32) DeepCopyInto(out *GenericIdentification32) {
+	*out = *r
+	if r.Tp != nil {
+		out.Tp = new(PartyType3Code)
+		*out.Tp = *r.Tp
+	}
+	if r.Issr != nil {
+		out.Issr = new(PartyType4Code)
+		*out.Issr = *r.Issr
+	}
+	if r.ShrtNm != nil {
+		out.ShrtNm = new(common.Max35Text)
+		*out.ShrtNm = *r.ShrtNm
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *GenericOrganisationIdentification1) DeepCopy() *GenericOrganisationIdentification1 {
+	if r == nil {
+		return nil
+	}
+	out := new(GenericOrganisationIdentification1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *GenericOrganisationIdentification1) DeepCopyInto(out *GenericOrganisationIdentification1) {
+	*out = *r
+	if r.SchmeNm != nil {
+		out.SchmeNm = new(OrganisationIdentificationSchemeName1Choice)
+		*out.SchmeNm = *r.SchmeNm
+	}
+	if r.Issr != nil {
+		out.Issr = new(common.Max35Text)
+		*out.Issr = *r.Issr
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *GenericPersonIdentification1) DeepCopy() *GenericPersonIdentification1 {
+	if r == nil {
+		return nil
+	}
+	out := new(GenericPersonIdentification1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *GenericPersonIdentification1) DeepCopyInto(out *GenericPersonIdentification1) {
+	*out = *r
+	if r.SchmeNm != nil {
+		out.SchmeNm = new(PersonIdentificationSchemeName1Choice)
+		*out.SchmeNm = *r.SchmeNm
+	}
+	if r.Issr != nil {
+		out.Issr = new(common.Max35Text)
+		*out.Issr = *r.Issr
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *GetTransactionV08) DeepCopy() *GetTransactionV08 {
+	if r == nil {
+		return nil
+	}
+	out := new(GetTransactionV08)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *GetTransactionV08) DeepCopyInto(out *GetTransactionV08) {
+	*out = *r
+	r.MsgHdr.DeepCopyInto(&out.MsgHdr)
+	if r.TxQryDef != nil {
+		out.TxQryDef = new(TransactionQuery5)
+		r.TxQryDef.DeepCopyInto(out.TxQryDef)
+	}
+	if r.SplmtryData != nil {
+		out.SplmtryData = make([]SupplementaryData1, len(r.SplmtryData))
+		for i := range r.SplmtryData {
+			r.SplmtryData[i].DeepCopyInto(&out.SplmtryData[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *GroupHeader81) DeepCopy() *GroupHeader81 {
+	if r == nil {
+		return nil
+	}
+	out := new(GroupHeader81)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *GroupHeader81) DeepCopyInto(out *GroupHeader81) {
+	*out = *r
+	if r.MsgRcpt != nil {
+		out.MsgRcpt = new(PartyIdentification135)
+		r.MsgRcpt.DeepCopyInto(out.MsgRcpt)
+	}
+	if r.MsgPgntn != nil {
+		out.MsgPgntn = new(Pagination1)
+		*out.MsgPgntn = *r.MsgPgntn
+	}
+	if r.OrgnlBizQry != nil {
+		out.OrgnlBizQry = new(OriginalBusinessQuery1)
+		r.OrgnlBizQry.DeepCopyInto(out.OrgnlBizQry)
+	}
+	if r.AddtlInf != nil {
+		out.AddtlInf = new(common.Max500Text)
+		*out.AddtlInf = *r.AddtlInf
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *IdentificationSource3Choice) DeepCopy() *IdentificationSource3Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(IdentificationSource3Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *IdentificationSource3Choice) DeepCopyInto(out *IdentificationSource3Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ImpliedCurrencyAmountRange1Choice) DeepCopy() *ImpliedCurrencyAmountRange1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(ImpliedCurrencyAmountRange1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ImpliedCurrencyAmountRange1Choice) DeepCopyInto(out *ImpliedCurrencyAmountRange1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ImpliedCurrencyAndAmountRange1) DeepCopy() *ImpliedCurrencyAndAmountRange1 {
+	if r == nil {
+		return nil
+	}
+	out := new(ImpliedCurrencyAndAmountRange1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ImpliedCurrencyAndAmountRange1) DeepCopyInto(out *ImpliedCurrencyAndAmountRange1) {
+	*out = *r
+	if r.CdtDbtInd != nil {
+		out.CdtDbtInd = new(common.CreditDebitCode)
+		*out.CdtDbtInd = *r.CdtDbtInd
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *InstructionForAssignee1) DeepCopy() *InstructionForAssignee1 {
+	if r == nil {
+		return nil
+	}
+	out := new(InstructionForAssignee1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *InstructionForAssignee1) DeepCopyInto(out *InstructionForAssignee1) {
+	*out = *r
+	if r.Cd != nil {
+		out.Cd = new(ExternalAgentInstruction1Code)
+		*out.Cd = *r.Cd
+	}
+	if r.InstrInf != nil {
+		out.InstrInf = new(common.Max140Text)
+		*out.InstrInf = *r.InstrInf
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *InstructionStatusReturnCriteria1) DeepCopy() *InstructionStatusReturnCriteria1 {
+	if r == nil {
+		return nil
+	}
+	out := new(InstructionStatusReturnCriteria1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *InstructionStatusReturnCriteria1) DeepCopyInto(out *InstructionStatusReturnCriteria1) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *InstructionStatusSearch5) DeepCopy() *InstructionStatusSearch5 {
+	if r == nil {
+		return nil
+	}
+	out := new(InstructionStatusSearch5)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *InstructionStatusSearch5) DeepCopyInto(out *InstructionStatusSearch5) {
+	*out = *r
+	if r.PmtInstrSts != nil {
+		out.PmtInstrSts = new(PaymentStatusCodeSearch2Choice)
+		*out.PmtInstrSts = *r.PmtInstrSts
+	}
+	if r.PmtInstrStsDtTm != nil {
+		out.PmtInstrStsDtTm = new(DateTimePeriod1Choice)
+		*out.PmtInstrStsDtTm = *r.PmtInstrStsDtTm
+	}
+	if r.PrtryStsRsn != nil {
+		out.PrtryStsRsn = new(common.Max4AlphaNumericText)
+		*out.PrtryStsRsn = *r.PrtryStsRsn
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *InterestRecord2) DeepCopy() *InterestRecord2 {
+	if r == nil {
+		return nil
+	}
+	out := new(InterestRecord2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *InterestRecord2) DeepCopyInto(out *InterestRecord2) {
+	*out = *r
+	if r.Tp != nil {
+		out.Tp = new(InterestType1Choice)
+		*out.Tp = *r.Tp
+	}
+	if r.Rate != nil {
+		out.Rate = new(Rate4)
+		r.Rate.DeepCopyInto(out.Rate)
+	}
+	if r.FrToDt != nil {
+		out.FrToDt = new(DateTimePeriod1)
+		*out.FrToDt = *r.FrToDt
+	}
+	if r.Rsn != nil {
+		out.Rsn = new(common.Max35Text)
+		*out.Rsn = *r.Rsn
+	}
+	if r.Tax != nil {
+		out.Tax = new(TaxCharges2)
+		r.Tax.DeepCopyInto(out.Tax)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *InterestType1Choice) DeepCopy() *InterestType1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(InterestType1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *InterestType1Choice) DeepCopyInto(out *InterestType1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *Limit5) DeepCopy() *Limit5 {
+	if r == nil {
+		return nil
+	}
+	out := new(Limit5)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *Limit5) DeepCopyInto(out *Limit5) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *Limit7) DeepCopy() *Limit7 {
+	if r == nil {
+		return nil
+	}
+	out := new(Limit7)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *Limit7) DeepCopyInto(out *Limit7) {
+	*out = *r
+	if r.CdtDbtInd != nil {
+		out.CdtDbtInd = new(common.CreditDebitCode)
+		*out.CdtDbtInd = *r.CdtDbtInd
+	}
+	if r.Sts != nil {
+		out.Sts = new(LimitStatus1Code)
+		*out.Sts = *r.Sts
+	}
+	if r.StartDtTm != nil {
+		out.StartDtTm = new(DateAndDateTime2Choice)
+		*out.StartDtTm = *r.StartDtTm
+	}
+	if r.UsdAmt != nil {
+		out.UsdAmt = new(Amount2Choice)
+		*out.UsdAmt = *r.UsdAmt
+	}
+	if r.UsdAmtCdtDbtInd != nil {
+		out.UsdAmtCdtDbtInd = new(common.CreditDebitCode)
+		*out.UsdAmtCdtDbtInd = *r.UsdAmtCdtDbtInd
+	}
+	if r.RmngAmt != nil {
+		out.RmngAmt = new(Amount2Choice)
+		*out.RmngAmt = *r.RmngAmt
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *LimitIdentification5) DeepCopy() *LimitIdentification5 {
+	if r == nil {
+		return nil
+	}
+	out := new(LimitIdentification5)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *LimitIdentification5) DeepCopyInto(out *LimitIdentification5) {
+	*out = *r
+	if r.SysId != nil {
+		out.SysId = new(SystemIdentification2Choice)
+		*out.SysId = *r.SysId
+	}
+	if r.BilLmtCtrPtyId != nil {
+		out.BilLmtCtrPtyId = new(BranchAndFinancialInstitutionIdentification6)
+		r.BilLmtCtrPtyId.DeepCopyInto(out.BilLmtCtrPtyId)
+	}
+	if r.AcctOwnr != nil {
+		out.AcctOwnr = new(BranchAndFinancialInstitutionIdentification6)
+		r.AcctOwnr.DeepCopyInto(out.AcctOwnr)
+	}
+	if r.AcctId != nil {
+		out.AcctId = new(AccountIdentification4Choice)
+		r.AcctId.DeepCopyInto(out.AcctId)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *LimitOrError4Choice) DeepCopy() *LimitOrError4Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(LimitOrError4Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *LimitOrError4Choice) DeepCopyInto(out *LimitOrError4Choice) {
+	*out = *r
+	r.Lmt.DeepCopyInto(&out.Lmt)
+	if r.BizErr != nil {
+		out.BizErr = make([]ErrorHandling5, len(r.BizErr))
+		for i := range r.BizErr {
+			r.BizErr[i].DeepCopyInto(&out.BizErr[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *LimitReport7) DeepCopy() *LimitReport7 {
+	if r == nil {
+		return nil
+	}
+	out := new(LimitReport7)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *LimitReport7) DeepCopyInto(out *LimitReport7) {
+	*out = *r
+	r.LmtId.DeepCopyInto(&out.LmtId)
+	r.LmtOrErr.DeepCopyInto(&out.LmtOrErr)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *LimitReportOrError4Choice) DeepCopy() *LimitReportOrError4Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(LimitReportOrError4Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *LimitReportOrError4Choice) DeepCopyInto(out *LimitReportOrError4Choice) {
+	*out = *r
+	r.BizRpt.DeepCopyInto(&out.BizRpt)
+	if r.OprlErr != nil {
+		out.OprlErr = make([]ErrorHandling5, len(r.OprlErr))
+		for i := range r.OprlErr {
+			r.OprlErr[i].DeepCopyInto(&out.OprlErr[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *LimitType1Choice) DeepCopy() *LimitType1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(LimitType1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *LimitType1Choice) DeepCopyInto(out *LimitType1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *Limits7) DeepCopy() *Limits7 {
+	if r == nil {
+		return nil
+	}
+	out := new(Limits7)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *Limits7) DeepCopyInto(out *Limits7) {
+	*out = *r
+	if r.CurLmt != nil {
+		out.CurLmt = make([]LimitReport7, len(r.CurLmt))
+		for i := range r.CurLmt {
+			r.CurLmt[i].DeepCopyInto(&out.CurLmt[i])
+		}
+	}
+	if r.DfltLmt != nil {
+		out.DfltLmt = make([]LimitReport7, len(r.DfltLmt))
+		for i := range r.DfltLmt {
+			r.DfltLmt[i].DeepCopyInto(&out.DfltLmt[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *LocalInstrument2Choice) DeepCopy() *LocalInstrument2Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(LocalInstrument2Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *LocalInstrument2Choice) DeepCopyInto(out *LocalInstrument2Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *LongPaymentIdentification2) DeepCopy() *LongPaymentIdentification2 {
+	if r == nil {
+		return nil
+	}
+	out := new(LongPaymentIdentification2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *LongPaymentIdentification2) DeepCopyInto(out *LongPaymentIdentification2) {
+	*out = *r
+	if r.TxId != nil {
+		out.TxId = new(common.Max35Text)
+		*out.TxId = *r.TxId
+	}
+	if r.UETR != nil {
+		out.UETR = new(common.UUIDv4Identifier)
+		*out.UETR = *r.UETR
+	}
+	if r.PmtMtd != nil {
+		out.PmtMtd = new(PaymentOrigin1Choice)
+		*out.PmtMtd = *r.PmtMtd
+	}
+	r.InstgAgt.DeepCopyInto(&out.InstgAgt)
+	r.InstdAgt.DeepCopyInto(&out.InstdAgt)
+	if r.NtryTp != nil {
+		out.NtryTp = new(EntryTypeIdentifier)
+		*out.NtryTp = *r.NtryTp
+	}
+	if r.EndToEndId != nil {
+		out.EndToEndId = new(common.Max35Text)
+		*out.EndToEndId = *r.EndToEndId
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *MandateClassification1Choice) DeepCopy() *MandateClassification1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(MandateClassification1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *MandateClassification1Choice) DeepCopyInto(out *MandateClassification1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *MandateRelatedData1Choice) DeepCopy() *MandateRelatedData1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(MandateRelatedData1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *MandateRelatedData1Choice) DeepCopyInto(out *MandateRelatedData1Choice) {
+	*out = *r
+	if r.DrctDbtMndt != nil {
+		out.DrctDbtMndt = new(MandateRelatedInformation14)
+		r.DrctDbtMndt.DeepCopyInto(out.DrctDbtMndt)
+	}
+	if r.CdtTrfMndt != nil {
+		out.CdtTrfMndt = new(CreditTransferMandateData1)
+		r.CdtTrfMndt.DeepCopyInto(out.CdtTrfMndt)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *MandateRelatedInformation14) DeepCopy() *MandateRelatedInformation14 {
+	if r == nil {
+		return nil
+	}
+	out := new(MandateRelatedInformation14)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *MandateRelatedInformation14) DeepCopyInto(out *MandateRelatedInformation14) {
+	*out = *r
+	if r.MndtId != nil {
+		out.MndtId = new(common.Max35Text)
+		*out.MndtId = *r.MndtId
+	}
+	if r.DtOfSgntr != nil {
+		out.DtOfSgntr = new(common.ISODate)
+		*out.DtOfSgntr = *r.DtOfSgntr
+	}
+	if r.AmdmntInfDtls != nil {
+		out.AmdmntInfDtls = new(AmendmentInformationDetails13)
+		r.AmdmntInfDtls.DeepCopyInto(out.AmdmntInfDtls)
+	}
+	if r.ElctrncSgntr != nil {
+		out.ElctrncSgntr = new(common.Max1025Text)
+		*out.ElctrncSgntr = *r.ElctrncSgntr
+	}
+	if r.FrstColltnDt != nil {
+		out.FrstColltnDt = new(common.ISODate)
+		*out.FrstColltnDt = *r.FrstColltnDt
+	}
+	if r.FnlColltnDt != nil {
+		out.FnlColltnDt = new(common.ISODate)
+		*out.FnlColltnDt = *r.FnlColltnDt
+	}
+	if r.Frqcy != nil {
+		out.Frqcy = new(Frequency36Choice)
+		*out.Frqcy = *r.Frqcy
+	}
+	if r.Rsn != nil {
+		out.Rsn = new(MandateSetupReason1Choice)
+		*out.Rsn = *r.Rsn
+	}
+	if r.TrckgDays != nil {
+		out.TrckgDays = new(common.Exact2NumericText)
+		*out.TrckgDays = *r.TrckgDays
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *MandateSetupReason1Choice) DeepCopy() *MandateSetupReason1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(MandateSetupReason1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *MandateSetupReason1Choice) DeepCopyInto(out *MandateSetupReason1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *MandateTypeInformation2) DeepCopy() *MandateTypeInformation2 {
+	if r == nil {
+		return nil
+	}
+	out := new(MandateTypeInformation2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *MandateTypeInformation2) DeepCopyInto(out *MandateTypeInformation2) {
+	*out = *r
+	if r.SvcLvl != nil {
+		out.SvcLvl = new(ServiceLevel8Choice)
+		*out.SvcLvl = *r.SvcLvl
+	}
+	if r.LclInstrm != nil {
+		out.LclInstrm = new(LocalInstrument2Choice)
+		*out.LclInstrm = *r.LclInstrm
+	}
+	if r.CtgyPurp != nil {
+		out.CtgyPurp = new(CategoryPurpose1Choice)
+		*out.CtgyPurp = *r.CtgyPurp
+	}
+	if r.Clssfctn != nil {
+		out.Clssfctn = new(MandateClassification1Choice)
+		*out.Clssfctn = *r.Clssfctn
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *MarketInfrastructureIdentification1Choice) DeepCopy() *MarketInfrastructureIdentification1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(MarketInfrastructureIdentification1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *MarketInfrastructureIdentification1Choice) DeepCopyInto(out *MarketInfrastructureIdentification1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *MessageHeader1) DeepCopy() *MessageHeader1 {
+	if r == nil {
+		return nil
+	}
+	out := new(MessageHeader1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *MessageHeader1) DeepCopyInto(out *MessageHeader1) {
+	*out = *r
+	if r.CreDtTm != nil {
+		out.CreDtTm = new(common.ISODateTime)
+		*out.CreDtTm = *r.CreDtTm
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *MessageHeader7) DeepCopy() *MessageHeader7 {
+	if r == nil {
+		return nil
+	}
+	out := new(MessageHeader7)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *MessageHeader7) DeepCopyInto(out *MessageHeader7) {
+	*out = *r
+	if r.CreDtTm != nil {
+		out.CreDtTm = new(common.ISODateTime)
+		*out.CreDtTm = *r.CreDtTm
+	}
+	if r.ReqTp != nil {
+		out.ReqTp = new(RequestType4Choice)
+		r.ReqTp.DeepCopyInto(out.ReqTp)
+	}
+	if r.OrgnlBizQry != nil {
+		out.OrgnlBizQry = new(OriginalBusinessQuery1)
+		r.OrgnlBizQry.DeepCopyInto(out.OrgnlBizQry)
+	}
+	if r.QryNm != nil {
+		out.QryNm = new(common.Max35Text)
+		*out.QryNm = *r.QryNm
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *MessageHeader8) DeepCopy() *MessageHeader8 {
+	if r == nil {
+		return nil
+	}
+	out := new(MessageHeader8)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *MessageHeader8) DeepCopyInto(out *MessageHeader8) {
+	*out = *r
+	if r.CreDtTm != nil {
+		out.CreDtTm = new(common.ISODateTime)
+		*out.CreDtTm = *r.CreDtTm
+	}
+	if r.MsgPgntn != nil {
+		out.MsgPgntn = new(Pagination1)
+		*out.MsgPgntn = *r.MsgPgntn
+	}
+	if r.OrgnlBizQry != nil {
+		out.OrgnlBizQry = new(OriginalBusinessQuery1)
+		r.OrgnlBizQry.DeepCopyInto(out.OrgnlBizQry)
+	}
+	if r.ReqTp != nil {
+		out.ReqTp = new(RequestType4Choice)
+		r.ReqTp.DeepCopyInto(out.ReqTp)
+	}
+	if r.QryNm != nil {
+		out.QryNm = new(common.Max35Text)
+		*out.QryNm = *r.QryNm
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *MessageHeader9) DeepCopy() *MessageHeader9 {
+	if r == nil {
+		return nil
+	}
+	out := new(MessageHeader9)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *MessageHeader9) DeepCopyInto(out *MessageHeader9) {
+	*out = *r
+	if r.CreDtTm != nil {
+		out.CreDtTm = new(common.ISODateTime)
+		*out.CreDtTm = *r.CreDtTm
+	}
+	if r.ReqTp != nil {
+		out.ReqTp = new(RequestType4Choice)
+		r.ReqTp.DeepCopyInto(out.ReqTp)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *MessageIdentification2) DeepCopy() *MessageIdentification2 {
+	if r == nil {
+		return nil
+	}
+	out := new(MessageIdentification2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *MessageIdentification2) DeepCopyInto(out *MessageIdentification2) {
+	*out = *r
+	if r.MsgNmId != nil {
+		out.MsgNmId = new(common.Max35Text)
+		*out.MsgNmId = *r.MsgNmId
+	}
+	if r.MsgId != nil {
+		out.MsgId = new(common.Max35Text)
+		*out.MsgId = *r.MsgId
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *MissingCover4) DeepCopy() *MissingCover4 {
+	if r == nil {
+		return nil
+	}
+	out := new(MissingCover4)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *MissingCover4) DeepCopyInto(out *MissingCover4) {
+	*out = *r
+	if r.CoverCrrctn != nil {
+		out.CoverCrrctn = new(SettlementInstruction6)
+		r.CoverCrrctn.DeepCopyInto(out.CoverCrrctn)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *MissingOrIncorrectInformation3) DeepCopy() *MissingOrIncorrectInformation3 {
+	if r == nil {
+		return nil
+	}
+	out := new(MissingOrIncorrectInformation3)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *MissingOrIncorrectInformation3) DeepCopyInto(out *MissingOrIncorrectInformation3) {
+	*out = *r
+	if r.MssngInf != nil {
+		out.MssngInf = make([]UnableToApplyMissing1, len(r.MssngInf))
+		for i := range r.MssngInf {
+			r.MssngInf[i].DeepCopyInto(&out.MssngInf[i])
+		}
+	}
+	if r.IncrrctInf != nil {
+		out.IncrrctInf = make([]UnableToApplyIncorrect1, len(r.IncrrctInf))
+		for i := range r.IncrrctInf {
+			r.IncrrctInf[i].DeepCopyInto(&out.IncrrctInf[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ModifyTransactionV08) DeepCopy() *ModifyTransactionV08 {
+	if r == nil {
+		return nil
+	}
+	out := new(ModifyTransactionV08)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ModifyTransactionV08) DeepCopyInto(out *ModifyTransactionV08) {
+	*out = *r
+	r.MsgHdr.DeepCopyInto(&out.MsgHdr)
+	if r.Mod != nil {
+		out.Mod = make([]TransactionModification5, len(r.Mod))
+		for i := range r.Mod {
+			r.Mod[i].DeepCopyInto(&out.Mod[i])
+		}
+	}
+	if r.SplmtryData != nil {
+		out.SplmtryData = make([]SupplementaryData1, len(r.SplmtryData))
+		for i := range r.SplmtryData {
+			r.SplmtryData[i].DeepCopyInto(&out.SplmtryData[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *NameAndAddress16) DeepCopy() *NameAndAddress16 {
+	if r == nil {
+		return nil
+	}
+	out := new(NameAndAddress16)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *NameAndAddress16) DeepCopyInto(out *NameAndAddress16) {
+	*out = *r
+	r.Adr.DeepCopyInto(&out.Adr)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *NumberAndSumOfTransactions1) DeepCopy() *NumberAndSumOfTransactions1 {
+	if r == nil {
+		return nil
+	}
+	out := new(NumberAndSumOfTransactions1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *NumberAndSumOfTransactions1) DeepCopyInto(out *NumberAndSumOfTransactions1) {
+	*out = *r
+	if r.NbOfNtries != nil {
+		out.NbOfNtries = new(common.Max15NumericText)
+		*out.NbOfNtries = *r.NbOfNtries
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *NumberAndSumOfTransactions2) DeepCopy() *NumberAndSumOfTransactions2 {
+	if r == nil {
+		return nil
+	}
+	out := new(NumberAndSumOfTransactions2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *NumberAndSumOfTransactions2) DeepCopyInto(out *NumberAndSumOfTransactions2) {
+	*out = *r
+	if r.NbOfNtries != nil {
+		out.NbOfNtries = new(common.Max15NumericText)
+		*out.NbOfNtries = *r.NbOfNtries
+	}
+	if r.CdtDbtInd != nil {
+		out.CdtDbtInd = new(common.CreditDebitCode)
+		*out.CdtDbtInd = *r.CdtDbtInd
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *NumberAndSumOfTransactions4) DeepCopy() *NumberAndSumOfTransactions4 {
+	if r == nil {
+		return nil
+	}
+	out := new(NumberAndSumOfTransactions4)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *NumberAndSumOfTransactions4) DeepCopyInto(out *NumberAndSumOfTransactions4) {
+	*out = *r
+	if r.NbOfNtries != nil {
+		out.NbOfNtries = new(common.Max15NumericText)
+		*out.NbOfNtries = *r.NbOfNtries
+	}
+	if r.TtlNetNtry != nil {
+		out.TtlNetNtry = new(AmountAndDirection35)
+		*out.TtlNetNtry = *r.TtlNetNtry
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *OrganisationIdentification29) DeepCopy() *OrganisationIdentification29 {
+	if r == nil {
+		return nil
+	}
+	out := new(OrganisationIdentification29)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *OrganisationIdentification29) DeepCopyInto(out *OrganisationIdentification29) {
+	*out = *r
+	if r.AnyBIC != nil {
+		out.AnyBIC = new(common.AnyBICDec2014Identifier)
+		*out.AnyBIC = *r.AnyBIC
+	}
+	if r.LEI != nil {
+		out.LEI = new(common.LEIIdentifier)
+		*out.LEI = *r.LEI
+	}
+	if r.Othr != nil {
+		out.Othr = make([]GenericOrganisationIdentification1, len(r.Othr))
+		for i := range r.Othr {
+			r.Othr[i].DeepCopyInto(&out.Othr[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *OrganisationIdentificationSchemeName1Choice) DeepCopy() *OrganisationIdentificationSchemeName1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(OrganisationIdentificationSchemeName1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *OrganisationIdentificationSchemeName1Choice) DeepCopyInto(out *OrganisationIdentificationSchemeName1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *OriginalAndCurrentQuantities1) DeepCopy() *OriginalAndCurrentQuantities1 {
+	if r == nil {
+		return nil
+	}
+	out := new(OriginalAndCurrentQuantities1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *OriginalAndCurrentQuantities1) DeepCopyInto(out *OriginalAndCurrentQuantities1) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *OriginalBusinessQuery1) DeepCopy() *OriginalBusinessQuery1 {
+	if r == nil {
+		return nil
+	}
+	out := new(OriginalBusinessQuery1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *OriginalBusinessQuery1) DeepCopyInto(out *OriginalBusinessQuery1) {
+	*out = *r
+	if r.MsgNmId != nil {
+		out.MsgNmId = new(common.Max35Text)
+		*out.MsgNmId = *r.MsgNmId
+	}
+	if r.CreDtTm != nil {
+		out.CreDtTm = new(common.ISODateTime)
+		*out.CreDtTm = *r.CreDtTm
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *OriginalGroupHeader15) DeepCopy() *OriginalGroupHeader15 {
+	if r == nil {
+		return nil
+	}
+	out := new(OriginalGroupHeader15)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *OriginalGroupHeader15) DeepCopyInto(out *OriginalGroupHeader15) {
+	*out = *r
+	if r.GrpCxlId != nil {
+		out.GrpCxlId = new(common.Max35Text)
+		*out.GrpCxlId = *r.GrpCxlId
+	}
+	if r.Case != nil {
+		out.Case = new(Case5)
+		r.Case.DeepCopyInto(out.Case)
+	}
+	if r.OrgnlCreDtTm != nil {
+		out.OrgnlCreDtTm = new(common.ISODateTime)
+		*out.OrgnlCreDtTm = *r.OrgnlCreDtTm
+	}
+	if r.NbOfTxs != nil {
+		out.NbOfTxs = new(common.Max15NumericText)
+		*out.NbOfTxs = *r.NbOfTxs
+	}
+	if r.CxlRsnInf != nil {
+		out.CxlRsnInf = make([]PaymentCancellationReason5, len(r.CxlRsnInf))
+		for i := range r.CxlRsnInf {
+			r.CxlRsnInf[i].DeepCopyInto(&out.CxlRsnInf[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *OriginalGroupInformation29) DeepCopy() *OriginalGroupInformation29 {
+	if r == nil {
+		return nil
+	}
+	out := new(OriginalGroupInformation29)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *OriginalGroupInformation29) DeepCopyInto(out *OriginalGroupInformation29) {
+	*out = *r
+	if r.OrgnlCreDtTm != nil {
+		out.OrgnlCreDtTm = new(common.ISODateTime)
+		*out.OrgnlCreDtTm = *r.OrgnlCreDtTm
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *OriginalTransactionReference28) DeepCopy() *OriginalTransactionReference28 {
+	if r == nil {
+		return nil
+	}
+	out := new(OriginalTransactionReference28)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *OriginalTransactionReference28) DeepCopyInto(out *OriginalTransactionReference28) {
+	*out = *r
+	if r.IntrBkSttlmAmt != nil {
+		out.IntrBkSttlmAmt = new(ActiveOrHistoricCurrencyAndAmount)
+		*out.IntrBkSttlmAmt = *r.IntrBkSttlmAmt
+	}
+	if r.Amt != nil {
+		out.Amt = new(AmountType4Choice)
+		*out.Amt = *r.Amt
+	}
+	if r.IntrBkSttlmDt != nil {
+		out.IntrBkSttlmDt = new(common.ISODate)
+		*out.IntrBkSttlmDt = *r.IntrBkSttlmDt
+	}
+	if r.ReqdColltnDt != nil {
+		out.ReqdColltnDt = new(common.ISODate)
+		*out.ReqdColltnDt = *r.ReqdColltnDt
+	}
+	if r.ReqdExctnDt != nil {
+		out.ReqdExctnDt = new(DateAndDateTime2Choice)
+		*out.ReqdExctnDt = *r.ReqdExctnDt
+	}
+	if r.CdtrSchmeId != nil {
+		out.CdtrSchmeId = new(PartyIdentification135)
+		r.CdtrSchmeId.DeepCopyInto(out.CdtrSchmeId)
+	}
+	if r.SttlmInf != nil {
+		out.SttlmInf = new(SettlementInstruction7)
+		r.SttlmInf.DeepCopyInto(out.SttlmInf)
+	}
+	if r.PmtTpInf != nil {
+		out.PmtTpInf = new(PaymentTypeInformation27)
+		r.PmtTpInf.DeepCopyInto(out.PmtTpInf)
+	}
+	if r.PmtMtd != nil {
+		out.PmtMtd = new(PaymentMethod4Code)
+		*out.PmtMtd = *r.PmtMtd
+	}
+	if r.MndtRltdInf != nil {
+		out.MndtRltdInf = new(MandateRelatedInformation14)
+		r.MndtRltdInf.DeepCopyInto(out.MndtRltdInf)
+	}
+	if r.RmtInf != nil {
+		out.RmtInf = new(RemittanceInformation16)
+		r.RmtInf.DeepCopyInto(out.RmtInf)
+	}
+	if r.UltmtDbtr != nil {
+		out.UltmtDbtr = new(Party40Choice)
+		r.UltmtDbtr.DeepCopyInto(out.UltmtDbtr)
+	}
+	if r.Dbtr != nil {
+		out.Dbtr = new(Party40Choice)
+		r.Dbtr.DeepCopyInto(out.Dbtr)
+	}
+	if r.DbtrAcct != nil {
+		out.DbtrAcct = new(CashAccount38)
+		r.DbtrAcct.DeepCopyInto(out.DbtrAcct)
+	}
+	if r.DbtrAgt != nil {
+		out.DbtrAgt = new(BranchAndFinancialInstitutionIdentification6)
+		r.DbtrAgt.DeepCopyInto(out.DbtrAgt)
+	}
+	if r.DbtrAgtAcct != nil {
+		out.DbtrAgtAcct = new(CashAccount38)
+		r.DbtrAgtAcct.DeepCopyInto(out.DbtrAgtAcct)
+	}
+	if r.CdtrAgt != nil {
+		out.CdtrAgt = new(BranchAndFinancialInstitutionIdentification6)
+		r.CdtrAgt.DeepCopyInto(out.CdtrAgt)
+	}
+	if r.CdtrAgtAcct != nil {
+		out.CdtrAgtAcct = new(CashAccount38)
+		r.CdtrAgtAcct.DeepCopyInto(out.CdtrAgtAcct)
+	}
+	if r.Cdtr != nil {
+		out.Cdtr = new(Party40Choice)
+		r.Cdtr.DeepCopyInto(out.Cdtr)
+	}
+	if r.CdtrAcct != nil {
+		out.CdtrAcct = new(CashAccount38)
+		r.CdtrAcct.DeepCopyInto(out.CdtrAcct)
+	}
+	if r.UltmtCdtr != nil {
+		out.UltmtCdtr = new(Party40Choice)
+		r.UltmtCdtr.DeepCopyInto(out.UltmtCdtr)
+	}
+	if r.Purp != nil {
+		out.Purp = new(Purpose2Choice)
+		*out.Purp = *r.Purp
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *OriginalTransactionReference31) DeepCopy() *OriginalTransactionReference31 {
+	if r == nil {
+		return nil
+	}
+	out := new(OriginalTransactionReference31)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *OriginalTransactionReference31) DeepCopyInto(out *OriginalTransactionReference31) {
+	*out = *r
+	if r.IntrBkSttlmAmt != nil {
+		out.IntrBkSttlmAmt = new(ActiveOrHistoricCurrencyAndAmount)
+		*out.IntrBkSttlmAmt = *r.IntrBkSttlmAmt
+	}
+	if r.Amt != nil {
+		out.Amt = new(AmountType4Choice)
+		*out.Amt = *r.Amt
+	}
+	if r.IntrBkSttlmDt != nil {
+		out.IntrBkSttlmDt = new(common.ISODate)
+		*out.IntrBkSttlmDt = *r.IntrBkSttlmDt
+	}
+	if r.ReqdColltnDt != nil {
+		out.ReqdColltnDt = new(common.ISODate)
+		*out.ReqdColltnDt = *r.ReqdColltnDt
+	}
+	if r.ReqdExctnDt != nil {
+		out.ReqdExctnDt = new(DateAndDateTime2Choice)
+		*out.ReqdExctnDt = *r.ReqdExctnDt
+	}
+	if r.CdtrSchmeId != nil {
+		out.CdtrSchmeId = new(PartyIdentification135)
+		r.CdtrSchmeId.DeepCopyInto(out.CdtrSchmeId)
+	}
+	if r.SttlmInf != nil {
+		out.SttlmInf = new(SettlementInstruction7)
+		r.SttlmInf.DeepCopyInto(out.SttlmInf)
+	}
+	if r.PmtTpInf != nil {
+		out.PmtTpInf = new(PaymentTypeInformation27)
+		r.PmtTpInf.DeepCopyInto(out.PmtTpInf)
+	}
+	if r.PmtMtd != nil {
+		out.PmtMtd = new(PaymentMethod4Code)
+		*out.PmtMtd = *r.PmtMtd
+	}
+	if r.MndtRltdInf != nil {
+		out.MndtRltdInf = new(MandateRelatedData1Choice)
+		r.MndtRltdInf.DeepCopyInto(out.MndtRltdInf)
+	}
+	if r.RmtInf != nil {
+		out.RmtInf = new(RemittanceInformation16)
+		r.RmtInf.DeepCopyInto(out.RmtInf)
+	}
+	if r.UltmtDbtr != nil {
+		out.UltmtDbtr = new(Party40Choice)
+		r.UltmtDbtr.DeepCopyInto(out.UltmtDbtr)
+	}
+	if r.Dbtr != nil {
+		out.Dbtr = new(Party40Choice)
+		r.Dbtr.DeepCopyInto(out.Dbtr)
+	}
+	if r.DbtrAcct != nil {
+		out.DbtrAcct = new(CashAccount38)
+		r.DbtrAcct.DeepCopyInto(out.DbtrAcct)
+	}
+	if r.DbtrAgt != nil {
+		out.DbtrAgt = new(BranchAndFinancialInstitutionIdentification6)
+		r.DbtrAgt.DeepCopyInto(out.DbtrAgt)
+	}
+	if r.DbtrAgtAcct != nil {
+		out.DbtrAgtAcct = new(CashAccount38)
+		r.DbtrAgtAcct.DeepCopyInto(out.DbtrAgtAcct)
+	}
+	if r.CdtrAgt != nil {
+		out.CdtrAgt = new(BranchAndFinancialInstitutionIdentification6)
+		r.CdtrAgt.DeepCopyInto(out.CdtrAgt)
+	}
+	if r.CdtrAgtAcct != nil {
+		out.CdtrAgtAcct = new(CashAccount38)
+		r.CdtrAgtAcct.DeepCopyInto(out.CdtrAgtAcct)
+	}
+	if r.Cdtr != nil {
+		out.Cdtr = new(Party40Choice)
+		r.Cdtr.DeepCopyInto(out.Cdtr)
+	}
+	if r.CdtrAcct != nil {
+		out.CdtrAcct = new(CashAccount38)
+		r.CdtrAcct.DeepCopyInto(out.CdtrAcct)
+	}
+	if r.UltmtCdtr != nil {
+		out.UltmtCdtr = new(Party40Choice)
+		r.UltmtCdtr.DeepCopyInto(out.UltmtCdtr)
+	}
+	if r.Purp != nil {
+		out.Purp = new(Purpose2Choice)
+		*out.Purp = *r.Purp
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *OtherContact1) DeepCopy() *OtherContact1 {
+	if r == nil {
+		return nil
+	}
+	out := new(OtherContact1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *OtherContact1) DeepCopyInto(out *OtherContact1) {
+	*out = *r
+	if r.Id != nil {
+		out.Id = new(common.Max128Text)
+		*out.Id = *r.Id
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *OtherIdentification1) DeepCopy() *OtherIdentification1 {
+	if r == nil {
+		return nil
+	}
+	out := new(OtherIdentification1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *OtherIdentification1) DeepCopyInto(out *OtherIdentification1) {
+	*out = *r
+	if r.Sfx != nil {
+		out.Sfx = new(common.Max16Text)
+		*out.Sfx = *r.Sfx
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *Pagination1) DeepCopy() *Pagination1 {
+	if r == nil {
+		return nil
+	}
+	out := new(Pagination1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *Pagination1) DeepCopyInto(out *Pagination1) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *Party38Choice) DeepCopy() *Party38Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(Party38Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *Party38Choice) DeepCopyInto(out *Party38Choice) {
+	*out = *r
+	r.OrgId.DeepCopyInto(&out.OrgId)
+	r.PrvtId.DeepCopyInto(&out.PrvtId)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *Party40Choice) DeepCopy() *Party40Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(Party40Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *Party40Choice) DeepCopyInto(out *Party40Choice) {
+	*out = *r
+	if r.Pty != nil {
+		out.Pty = new(PartyIdentification135)
+		r.Pty.DeepCopyInto(out.Pty)
+	}
+	if r.Agt != nil {
+		out.Agt = new(BranchAndFinancialInstitutionIdentification6)
+		r.Agt.DeepCopyInto(out.Agt)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *PartyIdentification135) DeepCopy() *PartyIdentification135 {
+	if r == nil {
+		return nil
+	}
+	out := new(PartyIdentification135)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *PartyIdentification135) DeepCopyInto(out *PartyIdentification135) {
+	*out = *r
+	if r.Nm != nil {
+		out.Nm = new(common.Max140Text)
+		*out.Nm = *r.Nm
+	}
+	if r.PstlAdr != nil {
+		out.PstlAdr = new(PostalAddress24)
+		r.PstlAdr.DeepCopyInto(out.PstlAdr)
+	}
+	if r.Id != nil {
+		out.Id = new(Party38Choice)
+		r.Id.DeepCopyInto(out.Id)
+	}
+	if r.CtryOfRes != nil {
+		out.CtryOfRes = new(common.CountryCode)
+		*out.CtryOfRes = *r.CtryOfRes
+	}
+	if r.CtctDtls != nil {
+		out.CtctDtls = new(Contact4)
+		r.CtctDtls.DeepCopyInto(out.CtctDtls)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *PaymentCancellationReason5) DeepCopy() *PaymentCancellationReason5 {
+	if r == nil {
+		return nil
+	}
+	out := new(PaymentCancellationReason5)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *PaymentCancellationReason5) DeepCopyInto(out *PaymentCancellationReason5) {
+	*out = *r
+	if r.Orgtr != nil {
+		out.Orgtr = new(PartyIdentification135)
+		r.Orgtr.DeepCopyInto(out.Orgtr)
+	}
+	if r.Rsn != nil {
+		out.Rsn = new(CancellationReason33Choice)
+		r.Rsn.DeepCopyInto(out.Rsn)
+	}
+	if r.AddtlInf != nil {
+		out.AddtlInf = make([]common.Max105Text, len(r.AddtlInf))
+		copy(out.AddtlInf, r.AddtlInf)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *PaymentCard4) DeepCopy() *PaymentCard4 {
+	if r == nil {
+		return nil
+	}
+	out := new(PaymentCard4)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *PaymentCard4) DeepCopyInto(out *PaymentCard4) {
+	*out = *r
+	if r.PlainCardData != nil {
+		out.PlainCardData = new(PlainCardData1)
+		r.PlainCardData.DeepCopyInto(out.PlainCardData)
+	}
+	if r.CardCtryCd != nil {
+		out.CardCtryCd = new(Exact3NumericText)
+		*out.CardCtryCd = *r.CardCtryCd
+	}
+	if r.CardBrnd != nil {
+		out.CardBrnd = new(GenericIdentification1)
+		r.CardBrnd.DeepCopyInto(out.CardBrnd)
+	}
+	if r.AddtlCardData != nil {
+		out.AddtlCardData = new(common.Max70Text)
+		*out.AddtlCardData = *r.AddtlCardData
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *PaymentCommon4) DeepCopy() *PaymentCommon4 {
+	if r == nil {
+		return nil
+	}
+	out := new(PaymentCommon4)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *PaymentCommon4) DeepCopyInto(out *PaymentCommon4) {
+	*out = *r
+	if r.PmtFr != nil {
+		out.PmtFr = new(System2)
+		r.PmtFr.DeepCopyInto(out.PmtFr)
+	}
+	if r.PmtTo != nil {
+		out.PmtTo = new(System2)
+		r.PmtTo.DeepCopyInto(out.PmtTo)
+	}
+	if r.CmonSts != nil {
+		out.CmonSts = make([]PaymentStatus6, len(r.CmonSts))
+		for i := range r.CmonSts {
+			r.CmonSts[i].DeepCopyInto(&out.CmonSts[i])
+		}
+	}
+	if r.ReqdExctnDt != nil {
+		out.ReqdExctnDt = new(DateAndDateTime2Choice)
+		*out.ReqdExctnDt = *r.ReqdExctnDt
+	}
+	if r.NtryDt != nil {
+		out.NtryDt = new(DateAndDateTime2Choice)
+		*out.NtryDt = *r.NtryDt
+	}
+	if r.CdtDbtInd != nil {
+		out.CdtDbtInd = new(common.CreditDebitCode)
+		*out.CdtDbtInd = *r.CdtDbtInd
+	}
+	if r.PmtMtd != nil {
+		out.PmtMtd = new(PaymentOrigin1Choice)
+		*out.PmtMtd = *r.PmtMtd
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *PaymentContext3) DeepCopy() *PaymentContext3 {
+	if r == nil {
+		return nil
+	}
+	out := new(PaymentContext3)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *PaymentContext3) DeepCopyInto(out *PaymentContext3) {
+	*out = *r
+	if r.AttndncCntxt != nil {
+		out.AttndncCntxt = new(AttendanceContext1Code)
+		*out.AttndncCntxt = *r.AttndncCntxt
+	}
+	if r.TxEnvt != nil {
+		out.TxEnvt = new(TransactionEnvironment1Code)
+		*out.TxEnvt = *r.TxEnvt
+	}
+	if r.TxChanl != nil {
+		out.TxChanl = new(TransactionChannel1Code)
+		*out.TxChanl = *r.TxChanl
+	}
+	if r.AttndntLang != nil {
+		out.AttndntLang = new(ISO2ALanguageCode)
+		*out.AttndntLang = *r.AttndntLang
+	}
+	if r.CardDataNtryMd != nil {
+		out.CardDataNtryMd = new(CardDataReading1Code)
+		*out.CardDataNtryMd = *r.CardDataNtryMd
+	}
+	if r.AuthntcnMtd != nil {
+		out.AuthntcnMtd = new(CardholderAuthentication2)
+		*out.AuthntcnMtd = *r.AuthntcnMtd
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *PaymentIdentification6Choice) DeepCopy() *PaymentIdentification6Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(PaymentIdentification6Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *PaymentIdentification6Choice) DeepCopyInto(out *PaymentIdentification6Choice) {
+	*out = *r
+	r.LngBizId.DeepCopyInto(&out.LngBizId)
+	r.ShrtBizId.DeepCopyInto(&out.ShrtBizId)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *PaymentInstruction32) DeepCopy() *PaymentInstruction32 {
+	if r == nil {
+		return nil
+	}
+	out := new(PaymentInstruction32)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *PaymentInstruction32) DeepCopyInto(out *PaymentInstruction32) {
+	*out = *r
+	if r.MsgId != nil {
+		out.MsgId = new(common.Max35Text)
+		*out.MsgId = *r.MsgId
+	}
+	if r.ReqdExctnDt != nil {
+		out.ReqdExctnDt = new(DateAndDateTime2Choice)
+		*out.ReqdExctnDt = *r.ReqdExctnDt
+	}
+	if r.Sts != nil {
+		out.Sts = make([]PaymentStatus6, len(r.Sts))
+		for i := range r.Sts {
+			r.Sts[i].DeepCopyInto(&out.Sts[i])
+		}
+	}
+	if r.InstdAmt != nil {
+		out.InstdAmt = new(Amount3Choice)
+		*out.InstdAmt = *r.InstdAmt
+	}
+	if r.IntrBkSttlmAmt != nil {
+		out.IntrBkSttlmAmt = new(Amount2Choice)
+		*out.IntrBkSttlmAmt = *r.IntrBkSttlmAmt
+	}
+	if r.Purp != nil {
+		out.Purp = new(common.Max10Text)
+		*out.Purp = *r.Purp
+	}
+	if r.PmtMtd != nil {
+		out.PmtMtd = new(PaymentOrigin1Choice)
+		*out.PmtMtd = *r.PmtMtd
+	}
+	if r.Prty != nil {
+		out.Prty = new(Priority1Choice)
+		*out.Prty = *r.Prty
+	}
+	if r.PrcgVldtyTm != nil {
+		out.PrcgVldtyTm = new(DateTimePeriod1Choice)
+		*out.PrcgVldtyTm = *r.PrcgVldtyTm
+	}
+	if r.InstrCpy != nil {
+		out.InstrCpy = new(common.Max20000Text)
+		*out.InstrCpy = *r.InstrCpy
+	}
+	if r.Tp != nil {
+		out.Tp = new(PaymentType4Choice)
+		*out.Tp = *r.Tp
+	}
+	if r.TxId != nil {
+		out.TxId = new(common.Max35Text)
+		*out.TxId = *r.TxId
+	}
+	if r.IntrBkSttlmDt != nil {
+		out.IntrBkSttlmDt = new(common.ISODate)
+		*out.IntrBkSttlmDt = *r.IntrBkSttlmDt
+	}
+	if r.EndToEndId != nil {
+		out.EndToEndId = new(common.Max35Text)
+		*out.EndToEndId = *r.EndToEndId
+	}
+	if r.Pties != nil {
+		out.Pties = new(PaymentTransactionParty3)
+		r.Pties.DeepCopyInto(out.Pties)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *PaymentInstruction33) DeepCopy() *PaymentInstruction33 {
+	if r == nil {
+		return nil
+	}
+	out := new(PaymentInstruction33)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *PaymentInstruction33) DeepCopyInto(out *PaymentInstruction33) {
+	*out = *r
+	if r.Instr != nil {
+		out.Instr = new(Instruction1Code)
+		*out.Instr = *r.Instr
+	}
+	if r.Tp != nil {
+		out.Tp = new(PaymentType4Choice)
+		*out.Tp = *r.Tp
+	}
+	if r.Prty != nil {
+		out.Prty = new(Priority1Choice)
+		*out.Prty = *r.Prty
+	}
+	if r.PrcgVldtyTm != nil {
+		out.PrcgVldtyTm = new(DateTimePeriod1Choice)
+		*out.PrcgVldtyTm = *r.PrcgVldtyTm
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *PaymentOrigin1Choice) DeepCopy() *PaymentOrigin1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(PaymentOrigin1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *PaymentOrigin1Choice) DeepCopyInto(out *PaymentOrigin1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *PaymentReturnCriteria4) DeepCopy() *PaymentReturnCriteria4 {
+	if r == nil {
+		return nil
+	}
+	out := new(PaymentReturnCriteria4)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *PaymentReturnCriteria4) DeepCopyInto(out *PaymentReturnCriteria4) {
+	*out = *r
+	if r.InstrStsRtrCrit != nil {
+		out.InstrStsRtrCrit = new(InstructionStatusReturnCriteria1)
+		*out.InstrStsRtrCrit = *r.InstrStsRtrCrit
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *PaymentReturnReason5) DeepCopy() *PaymentReturnReason5 {
+	if r == nil {
+		return nil
+	}
+	out := new(PaymentReturnReason5)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *PaymentReturnReason5) DeepCopyInto(out *PaymentReturnReason5) {
+	*out = *r
+	if r.OrgnlBkTxCd != nil {
+		out.OrgnlBkTxCd = new(BankTransactionCodeStructure4)
+		r.OrgnlBkTxCd.DeepCopyInto(out.OrgnlBkTxCd)
+	}
+	if r.Orgtr != nil {
+		out.Orgtr = new(PartyIdentification135)
+		r.Orgtr.DeepCopyInto(out.Orgtr)
+	}
+	if r.Rsn != nil {
+		out.Rsn = new(ReturnReason5Choice)
+		*out.Rsn = *r.Rsn
+	}
+	if r.AddtlInf != nil {
+		out.AddtlInf = make([]common.Max105Text, len(r.AddtlInf))
+		copy(out.AddtlInf, r.AddtlInf)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *PaymentSearch8) DeepCopy() *PaymentSearch8 {
+	if r == nil {
+		return nil
+	}
+	out := new(PaymentSearch8)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *PaymentSearch8) DeepCopyInto(out *PaymentSearch8) {
+	*out = *r
+	if r.MsgId != nil {
+		out.MsgId = make([]common.Max35Text, len(r.MsgId))
+		copy(out.MsgId, r.MsgId)
+	}
+	if r.ReqdExctnDt != nil {
+		out.ReqdExctnDt = make([]DateAndDateTimeSearch3Choice, len(r.ReqdExctnDt))
+		copy(out.ReqdExctnDt, r.ReqdExctnDt)
+	}
+	if r.PmtId != nil {
+		out.PmtId = make([]PaymentIdentification6Choice, len(r.PmtId))
+		for i := range r.PmtId {
+			r.PmtId[i].DeepCopyInto(&out.PmtId[i])
+		}
+	}
+	if r.Sts != nil {
+		out.Sts = make([]InstructionStatusSearch5, len(r.Sts))
+		for i := range r.Sts {
+			r.Sts[i].DeepCopyInto(&out.Sts[i])
+		}
+	}
+	if r.InstdAmt != nil {
+		out.InstdAmt = make([]ActiveOrHistoricAmountRange2Choice, len(r.InstdAmt))
+		for i := range r.InstdAmt {
+			r.InstdAmt[i].DeepCopyInto(&out.InstdAmt[i])
+		}
+	}
+	if r.InstdAmtCcy != nil {
+		out.InstdAmtCcy = make([]common.ActiveOrHistoricCurrencyCode, len(r.InstdAmtCcy))
+		copy(out.InstdAmtCcy, r.InstdAmtCcy)
+	}
+	if r.CdtDbtInd != nil {
+		out.CdtDbtInd = new(common.CreditDebitCode)
+		*out.CdtDbtInd = *r.CdtDbtInd
+	}
+	if r.IntrBkSttlmAmt != nil {
+		out.IntrBkSttlmAmt = make([]ActiveAmountRange3Choice, len(r.IntrBkSttlmAmt))
+		for i := range r.IntrBkSttlmAmt {
+			r.IntrBkSttlmAmt[i].DeepCopyInto(&out.IntrBkSttlmAmt[i])
+		}
+	}
+	if r.IntrBkSttlmAmtCcy != nil {
+		out.IntrBkSttlmAmtCcy = make([]common.ActiveCurrencyCode, len(r.IntrBkSttlmAmtCcy))
+		copy(out.IntrBkSttlmAmtCcy, r.IntrBkSttlmAmtCcy)
+	}
+	if r.PmtMtd != nil {
+		out.PmtMtd = make([]PaymentOrigin1Choice, len(r.PmtMtd))
+		copy(out.PmtMtd, r.PmtMtd)
+	}
+	if r.PmtTp != nil {
+		out.PmtTp = make([]PaymentType4Choice, len(r.PmtTp))
+		copy(out.PmtTp, r.PmtTp)
+	}
+	if r.Prty != nil {
+		out.Prty = make([]Priority1Choice, len(r.Prty))
+		copy(out.Prty, r.Prty)
+	}
+	if r.PrcgVldtyTm != nil {
+		out.PrcgVldtyTm = make([]DateTimePeriod1Choice, len(r.PrcgVldtyTm))
+		copy(out.PrcgVldtyTm, r.PrcgVldtyTm)
+	}
+	if r.Instr != nil {
+		out.Instr = make([]Instruction1Code, len(r.Instr))
+		copy(out.Instr, r.Instr)
+	}
+	if r.TxId != nil {
+		out.TxId = make([]common.Max35Text, len(r.TxId))
+		copy(out.TxId, r.TxId)
+	}
+	if r.IntrBkSttlmDt != nil {
+		out.IntrBkSttlmDt = make([]common.ISODate, len(r.IntrBkSttlmDt))
+		copy(out.IntrBkSttlmDt, r.IntrBkSttlmDt)
+	}
+	if r.EndToEndId != nil {
+		out.EndToEndId = make([]common.Max35Text, len(r.EndToEndId))
+		copy(out.EndToEndId, r.EndToEndId)
+	}
+	if r.Pties != nil {
+		out.Pties = new(PaymentTransactionParty3)
+		r.Pties.DeepCopyInto(out.Pties)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *PaymentStatus6) DeepCopy() *PaymentStatus6 {
+	if r == nil {
+		return nil
+	}
+	out := new(PaymentStatus6)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *PaymentStatus6) DeepCopyInto(out *PaymentStatus6) {
+	*out = *r
+	if r.Cd != nil {
+		out.Cd = new(PaymentStatusCode6Choice)
+		*out.Cd = *r.Cd
+	}
+	if r.DtTm != nil {
+		out.DtTm = new(DateAndDateTime2Choice)
+		*out.DtTm = *r.DtTm
+	}
+	if r.Rsn != nil {
+		out.Rsn = make([]PaymentStatusReason1Choice, len(r.Rsn))
+		copy(out.Rsn, r.Rsn)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *PaymentStatusCode6Choice) DeepCopy() *PaymentStatusCode6Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(PaymentStatusCode6Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *PaymentStatusCode6Choice) DeepCopyInto(out *PaymentStatusCode6Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *PaymentStatusCodeSearch2Choice) DeepCopy() *PaymentStatusCodeSearch2Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(PaymentStatusCodeSearch2Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *PaymentStatusCodeSearch2Choice) DeepCopyInto(out *PaymentStatusCodeSearch2Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *PaymentStatusReason1Choice) DeepCopy() *PaymentStatusReason1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(PaymentStatusReason1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *PaymentStatusReason1Choice) DeepCopyInto(out *PaymentStatusReason1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *PaymentTransaction106) DeepCopy() *PaymentTransaction106 {
+	if r == nil {
+		return nil
+	}
+	out := new(PaymentTransaction106)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *PaymentTransaction106) DeepCopyInto(out *PaymentTransaction106) {
+	*out = *r
+	if r.CxlId != nil {
+		out.CxlId = new(common.Max35Text)
+		*out.CxlId = *r.CxlId
+	}
+	if r.Case != nil {
+		out.Case = new(Case5)
+		r.Case.DeepCopyInto(out.Case)
+	}
+	if r.OrgnlGrpInf != nil {
+		out.OrgnlGrpInf = new(OriginalGroupInformation29)
+		r.OrgnlGrpInf.DeepCopyInto(out.OrgnlGrpInf)
+	}
+	if r.OrgnlInstrId != nil {
+		out.OrgnlInstrId = new(common.Max35Text)
+		*out.OrgnlInstrId = *r.OrgnlInstrId
+	}
+	if r.OrgnlEndToEndId != nil {
+		out.OrgnlEndToEndId = new(common.Max35Text)
+		*out.OrgnlEndToEndId = *r.OrgnlEndToEndId
+	}
+	if r.OrgnlTxId != nil {
+		out.OrgnlTxId = new(common.Max35Text)
+		*out.OrgnlTxId = *r.OrgnlTxId
+	}
+	if r.OrgnlUETR != nil {
+		out.OrgnlUETR = new(common.UUIDv4Identifier)
+		*out.OrgnlUETR = *r.OrgnlUETR
+	}
+	if r.OrgnlClrSysRef != nil {
+		out.OrgnlClrSysRef = new(common.Max35Text)
+		*out.OrgnlClrSysRef = *r.OrgnlClrSysRef
+	}
+	if r.OrgnlIntrBkSttlmAmt != nil {
+		out.OrgnlIntrBkSttlmAmt = new(ActiveOrHistoricCurrencyAndAmount)
+		*out.OrgnlIntrBkSttlmAmt = *r.OrgnlIntrBkSttlmAmt
+	}
+	if r.OrgnlIntrBkSttlmDt != nil {
+		out.OrgnlIntrBkSttlmDt = new(common.ISODate)
+		*out.OrgnlIntrBkSttlmDt = *r.OrgnlIntrBkSttlmDt
+	}
+	if r.Assgnr != nil {
+		out.Assgnr = new(BranchAndFinancialInstitutionIdentification6)
+		r.Assgnr.DeepCopyInto(out.Assgnr)
+	}
+	if r.Assgne != nil {
+		out.Assgne = new(BranchAndFinancialInstitutionIdentification6)
+		r.Assgne.DeepCopyInto(out.Assgne)
+	}
+	if r.CxlRsnInf != nil {
+		out.CxlRsnInf = make([]PaymentCancellationReason5, len(r.CxlRsnInf))
+		for i := range r.CxlRsnInf {
+			r.CxlRsnInf[i].DeepCopyInto(&out.CxlRsnInf[i])
+		}
+	}
+	if r.OrgnlTxRef != nil {
+		out.OrgnlTxRef = new(OriginalTransactionReference28)
+		r.OrgnlTxRef.DeepCopyInto(out.OrgnlTxRef)
+	}
+	if r.SplmtryData != nil {
+		out.SplmtryData = make([]SupplementaryData1, len(r.SplmtryData))
+		for i := range r.SplmtryData {
+			r.SplmtryData[i].DeepCopyInto(&out.SplmtryData[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *PaymentTransactionParty3) DeepCopy() *PaymentTransactionParty3 {
+	if r == nil {
+		return nil
+	}
+	out := new(PaymentTransactionParty3)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *PaymentTransactionParty3) DeepCopyInto(out *PaymentTransactionParty3) {
+	*out = *r
+	if r.InstgAgt != nil {
+		out.InstgAgt = new(BranchAndFinancialInstitutionIdentification6)
+		r.InstgAgt.DeepCopyInto(out.InstgAgt)
+	}
+	if r.InstdAgt != nil {
+		out.InstdAgt = new(BranchAndFinancialInstitutionIdentification6)
+		r.InstdAgt.DeepCopyInto(out.InstdAgt)
+	}
+	if r.UltmtDbtr != nil {
+		out.UltmtDbtr = new(Party40Choice)
+		r.UltmtDbtr.DeepCopyInto(out.UltmtDbtr)
+	}
+	if r.Dbtr != nil {
+		out.Dbtr = new(Party40Choice)
+		r.Dbtr.DeepCopyInto(out.Dbtr)
+	}
+	if r.DbtrAgt != nil {
+		out.DbtrAgt = new(BranchAndFinancialInstitutionIdentification6)
+		r.DbtrAgt.DeepCopyInto(out.DbtrAgt)
+	}
+	if r.InstgRmbrsmntAgt != nil {
+		out.InstgRmbrsmntAgt = new(BranchAndFinancialInstitutionIdentification6)
+		r.InstgRmbrsmntAgt.DeepCopyInto(out.InstgRmbrsmntAgt)
+	}
+	if r.InstdRmbrsmntAgt != nil {
+		out.InstdRmbrsmntAgt = new(BranchAndFinancialInstitutionIdentification6)
+		r.InstdRmbrsmntAgt.DeepCopyInto(out.InstdRmbrsmntAgt)
+	}
+	if r.IntrmyAgt1 != nil {
+		out.IntrmyAgt1 = new(BranchAndFinancialInstitutionIdentification6)
+		r.IntrmyAgt1.DeepCopyInto(out.IntrmyAgt1)
+	}
+	if r.IntrmyAgt2 != nil {
+		out.IntrmyAgt2 = new(BranchAndFinancialInstitutionIdentification6)
+		r.IntrmyAgt2.DeepCopyInto(out.IntrmyAgt2)
+	}
+	if r.IntrmyAgt3 != nil {
+		out.IntrmyAgt3 = new(BranchAndFinancialInstitutionIdentification6)
+		r.IntrmyAgt3.DeepCopyInto(out.IntrmyAgt3)
+	}
+	if r.CdtrAgt != nil {
+		out.CdtrAgt = new(BranchAndFinancialInstitutionIdentification6)
+		r.CdtrAgt.DeepCopyInto(out.CdtrAgt)
+	}
+	if r.Cdtr != nil {
+		out.Cdtr = new(Party40Choice)
+		r.Cdtr.DeepCopyInto(out.Cdtr)
+	}
+	if r.UltmtCdtr != nil {
+		out.UltmtCdtr = new(Party40Choice)
+		r.UltmtCdtr.DeepCopyInto(out.UltmtCdtr)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *PaymentType4Choice) DeepCopy() *PaymentType4Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(PaymentType4Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *PaymentType4Choice) DeepCopyInto(out *PaymentType4Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *PaymentTypeInformation27) DeepCopy() *PaymentTypeInformation27 {
+	if r == nil {
+		return nil
+	}
+	out := new(PaymentTypeInformation27)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *PaymentTypeInformation27) DeepCopyInto(out *PaymentTypeInformation27) {
+	*out = *r
+	if r.InstrPrty != nil {
+		out.InstrPrty = new(Priority2Code)
+		*out.InstrPrty = *r.InstrPrty
+	}
+	if r.ClrChanl != nil {
+		out.ClrChanl = new(ClearingChannel2Code)
+		*out.ClrChanl = *r.ClrChanl
+	}
+	if r.SvcLvl != nil {
+		out.SvcLvl = make([]ServiceLevel8Choice, len(r.SvcLvl))
+		copy(out.SvcLvl, r.SvcLvl)
+	}
+	if r.LclInstrm != nil {
+		out.LclInstrm = new(LocalInstrument2Choice)
+		*out.LclInstrm = *r.LclInstrm
+	}
+	if r.SeqTp != nil {
+		out.SeqTp = new(SequenceType3Code)
+		*out.SeqTp = *r.SeqTp
+	}
+	if r.CtgyPurp != nil {
+		out.CtgyPurp = new(CategoryPurpose1Choice)
+		*out.CtgyPurp = *r.CtgyPurp
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *PersonIdentification13) DeepCopy() *PersonIdentification13 {
+	if r == nil {
+		return nil
+	}
+	out := new(PersonIdentification13)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *PersonIdentification13) DeepCopyInto(out *PersonIdentification13) {
+	*out = *r
+	if r.DtAndPlcOfBirth != nil {
+		out.DtAndPlcOfBirth = new(DateAndPlaceOfBirth1)
+		r.DtAndPlcOfBirth.DeepCopyInto(out.DtAndPlcOfBirth)
+	}
+	if r.Othr != nil {
+		out.Othr = make([]GenericPersonIdentification1, len(r.Othr))
+		for i := range r.Othr {
+			r.Othr[i].DeepCopyInto(&out.Othr[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *PersonIdentificationSchemeName1Choice) DeepCopy() *PersonIdentificationSchemeName1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(PersonIdentificationSchemeName1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *PersonIdentificationSchemeName1Choice) DeepCopyInto(out *PersonIdentificationSchemeName1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *PlainCardData1) DeepCopy() *PlainCardData1 {
+	if r == nil {
+		return nil
+	}
+	out := new(PlainCardData1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *PlainCardData1) DeepCopyInto(out *PlainCardData1) {
+	*out = *r
+	if r.CardSeqNb != nil {
+		out.CardSeqNb = new(common.Min2Max3NumericText)
+		*out.CardSeqNb = *r.CardSeqNb
+	}
+	if r.FctvDt != nil {
+		out.FctvDt = new(common.ISOYearMonth)
+		*out.FctvDt = *r.FctvDt
+	}
+	if r.SvcCd != nil {
+		out.SvcCd = new(Exact3NumericText)
+		*out.SvcCd = *r.SvcCd
+	}
+	if r.TrckData != nil {
+		out.TrckData = make([]TrackData1, len(r.TrckData))
+		for i := range r.TrckData {
+			r.TrckData[i].DeepCopyInto(&out.TrckData[i])
+		}
+	}
+	if r.CardSctyCd != nil {
+		out.CardSctyCd = new(CardSecurityInformation1)
+		r.CardSctyCd.DeepCopyInto(out.CardSctyCd)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *PointOfInteraction1) DeepCopy() *PointOfInteraction1 {
+	if r == nil {
+		return nil
+	}
+	out := new(PointOfInteraction1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *PointOfInteraction1) DeepCopyInto(out *PointOfInteraction1) {
+	*out = *r
+	r.Id.DeepCopyInto(&out.Id)
+	if r.SysNm != nil {
+		out.SysNm = new(common.Max70Text)
+		*out.SysNm = *r.SysNm
+	}
+	if r.GrpId != nil {
+		out.GrpId = new(common.Max35Text)
+		*out.GrpId = *r.GrpId
+	}
+	if r.Cpblties != nil {
+		out.Cpblties = new(PointOfInteractionCapabilities1)
+		r.Cpblties.DeepCopyInto(out.Cpblties)
+	}
+	if r.Cmpnt != nil {
+		out.Cmpnt = make([]PointOfInteractionComponent1, len(r.Cmpnt))
+		for i := range r.Cmpnt {
+			r.Cmpnt[i].DeepCopyInto(&out.Cmpnt[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *PointOfInteractionCapabilities1) DeepCopy() *PointOfInteractionCapabilities1 {
+	if r == nil {
+		return nil
+	}
+	out := new(PointOfInteractionCapabilities1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *PointOfInteractionCapabilities1) DeepCopyInto(out *PointOfInteractionCapabilities1) {
+	*out = *r
+	if r.CardRdngCpblties != nil {
+		out.CardRdngCpblties = make([]CardDataReading1Code, len(r.CardRdngCpblties))
+		copy(out.CardRdngCpblties, r.CardRdngCpblties)
+	}
+	if r.CrdhldrVrfctnCpblties != nil {
+		out.CrdhldrVrfctnCpblties = make([]CardholderVerificationCapability1Code, len(r.CrdhldrVrfctnCpblties))
+		copy(out.CrdhldrVrfctnCpblties, r.CrdhldrVrfctnCpblties)
+	}
+	if r.OnLineCpblties != nil {
+		out.OnLineCpblties = new(OnLineCapability1Code)
+		*out.OnLineCpblties = *r.OnLineCpblties
+	}
+	if r.DispCpblties != nil {
+		out.DispCpblties = make([]DisplayCapabilities1, len(r.DispCpblties))
+		for i := range r.DispCpblties {
+			r.DispCpblties[i].DeepCopyInto(&out.DispCpblties[i])
+		}
+	}
+	if r.PrtLineWidth != nil {
+		out.PrtLineWidth = new(common.Max3NumericText)
+		*out.PrtLineWidth = *r.PrtLineWidth
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *PointOfInteractionComponent1) DeepCopy() *PointOfInteractionComponent1 {
+	if r == nil {
+		return nil
+	}
+	out := new(PointOfInteractionComponent1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *PointOfInteractionComponent1) DeepCopyInto(out *PointOfInteractionComponent1) {
+	*out = *r
+	if r.ManfctrId != nil {
+		out.ManfctrId = new(common.Max35Text)
+		*out.ManfctrId = *r.ManfctrId
+	}
+	if r.Mdl != nil {
+		out.Mdl = new(common.Max35Text)
+		*out.Mdl = *r.Mdl
+	}
+	if r.VrsnNb != nil {
+		out.VrsnNb = new(common.Max16Text)
+		*out.VrsnNb = *r.VrsnNb
+	}
+	if r.SrlNb != nil {
+		out.SrlNb = new(common.Max35Text)
+		*out.SrlNb = *r.SrlNb
+	}
+	if r.ApprvlNb != nil {
+		out.ApprvlNb = make([]common.Max70Text, len(r.ApprvlNb))
+		copy(out.ApprvlNb, r.ApprvlNb)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *PostalAddress24) DeepCopy() *PostalAddress24 {
+	if r == nil {
+		return nil
+	}
+	out := new(PostalAddress24)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *PostalAddress24) DeepCopyInto(out *PostalAddress24) {
+	*out = *r
+	if r.AdrTp != nil {
+		out.AdrTp = new(AddressType3Choice)
+		r.AdrTp.DeepCopyInto(out.AdrTp)
+	}
+	if r.Dept != nil {
+		out.Dept = new(common.Max70Text)
+		*out.Dept = *r.Dept
+	}
+	if r.SubDept != nil {
+		out.SubDept = new(common.Max70Text)
+		*out.SubDept = *r.SubDept
+	}
+	if r.StrtNm != nil {
+		out.StrtNm = new(common.Max70Text)
+		*out.StrtNm = *r.StrtNm
+	}
+	if r.BldgNb != nil {
+		out.BldgNb = new(common.Max16Text)
+		*out.BldgNb = *r.BldgNb
+	}
+	if r.BldgNm != nil {
+		out.BldgNm = new(common.Max35Text)
+		*out.BldgNm = *r.BldgNm
+	}
+	if r.Flr != nil {
+		out.Flr = new(common.Max70Text)
+		*out.Flr = *r.Flr
+	}
+	if r.PstBx != nil {
+		out.PstBx = new(common.Max16Text)
+		*out.PstBx = *r.PstBx
+	}
+	if r.Room != nil {
+		out.Room = new(common.Max70Text)
+		*out.Room = *r.Room
+	}
+	if r.PstCd != nil {
+		out.PstCd = new(common.Max16Text)
+		*out.PstCd = *r.PstCd
+	}
+	if r.TwnNm != nil {
+		out.TwnNm = new(common.Max35Text)
+		*out.TwnNm = *r.TwnNm
+	}
+	if r.TwnLctnNm != nil {
+		out.TwnLctnNm = new(common.Max35Text)
+		*out.TwnLctnNm = *r.TwnLctnNm
+	}
+	if r.DstrctNm != nil {
+		out.DstrctNm = new(common.Max35Text)
+		*out.DstrctNm = *r.DstrctNm
+	}
+	if r.CtrySubDvsn != nil {
+		out.CtrySubDvsn = new(common.Max35Text)
+		*out.CtrySubDvsn = *r.CtrySubDvsn
+	}
+	if r.Ctry != nil {
+		out.Ctry = new(common.CountryCode)
+		*out.Ctry = *r.Ctry
+	}
+	if r.AdrLine != nil {
+		out.AdrLine = make([]common.Max70Text, len(r.AdrLine))
+		copy(out.AdrLine, r.AdrLine)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *Price7) DeepCopy() *Price7 {
+	if r == nil {
+		return nil
+	}
+	out := new(Price7)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *Price7) DeepCopyInto(out *Price7) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *PriceRateOrAmount3Choice) DeepCopy() *PriceRateOrAmount3Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(PriceRateOrAmount3Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *PriceRateOrAmount3Choice) DeepCopyInto(out *PriceRateOrAmount3Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *Priority1Choice) DeepCopy() *Priority1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(Priority1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *Priority1Choice) DeepCopyInto(out *Priority1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ProcessingType1Choice) DeepCopy() *ProcessingType1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(ProcessingType1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ProcessingType1Choice) DeepCopyInto(out *ProcessingType1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *Product2) DeepCopy() *Product2 {
+	if r == nil {
+		return nil
+	}
+	out := new(Product2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *Product2) DeepCopyInto(out *Product2) {
+	*out = *r
+	if r.UnitOfMeasr != nil {
+		out.UnitOfMeasr = new(UnitOfMeasure1Code)
+		*out.UnitOfMeasr = *r.UnitOfMeasr
+	}
+	if r.TaxTp != nil {
+		out.TaxTp = new(common.Max35Text)
+		*out.TaxTp = *r.TaxTp
+	}
+	if r.AddtlPdctInf != nil {
+		out.AddtlPdctInf = new(common.Max35Text)
+		*out.AddtlPdctInf = *r.AddtlPdctInf
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ProprietaryAgent4) DeepCopy() *ProprietaryAgent4 {
+	if r == nil {
+		return nil
+	}
+	out := new(ProprietaryAgent4)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ProprietaryAgent4) DeepCopyInto(out *ProprietaryAgent4) {
+	*out = *r
+	r.Agt.DeepCopyInto(&out.Agt)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ProprietaryBankTransactionCodeStructure1) DeepCopy() *ProprietaryBankTransactionCodeStructure1 {
+	if r == nil {
+		return nil
+	}
+	out := new(ProprietaryBankTransactionCodeStructure1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ProprietaryBankTransactionCodeStructure1) DeepCopyInto(out *ProprietaryBankTransactionCodeStructure1) {
+	*out = *r
+	if r.Issr != nil {
+		out.Issr = new(common.Max35Text)
+		*out.Issr = *r.Issr
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ProprietaryDate3) DeepCopy() *ProprietaryDate3 {
+	if r == nil {
+		return nil
+	}
+	out := new(ProprietaryDate3)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ProprietaryDate3) DeepCopyInto(out *ProprietaryDate3) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ProprietaryParty5) DeepCopy() *ProprietaryParty5 {
+	if r == nil {
+		return nil
+	}
+	out := new(ProprietaryParty5)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ProprietaryParty5) DeepCopyInto(out *ProprietaryParty5) {
+	*out = *r
+	r.Pty.DeepCopyInto(&out.Pty)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ProprietaryPrice2) DeepCopy() *ProprietaryPrice2 {
+	if r == nil {
+		return nil
+	}
+	out := new(ProprietaryPrice2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ProprietaryPrice2) DeepCopyInto(out *ProprietaryPrice2) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ProprietaryQuantity1) DeepCopy() *ProprietaryQuantity1 {
+	if r == nil {
+		return nil
+	}
+	out := new(ProprietaryQuantity1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ProprietaryQuantity1) DeepCopyInto(out *ProprietaryQuantity1) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ProprietaryReference1) DeepCopy() *ProprietaryReference1 {
+	if r == nil {
+		return nil
+	}
+	out := new(ProprietaryReference1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ProprietaryReference1) DeepCopyInto(out *ProprietaryReference1) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ProprietaryStatusJustification2) DeepCopy() *ProprietaryStatusJustification2 {
+	if r == nil {
+		return nil
+	}
+	out := new(ProprietaryStatusJustification2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ProprietaryStatusJustification2) DeepCopyInto(out *ProprietaryStatusJustification2) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ProxyAccountIdentification1) DeepCopy() *ProxyAccountIdentification1 {
+	if r == nil {
+		return nil
+	}
+	out := new(ProxyAccountIdentification1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ProxyAccountIdentification1) DeepCopyInto(out *ProxyAccountIdentification1) {
+	*out = *r
+	if r.Tp != nil {
+		out.Tp = new(ProxyAccountType1Choice)
+		*out.Tp = *r.Tp
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ProxyAccountType1Choice) DeepCopy() *ProxyAccountType1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(ProxyAccountType1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ProxyAccountType1Choice) DeepCopyInto(out *ProxyAccountType1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *Purpose2Choice) DeepCopy() *Purpose2Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(Purpose2Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *Purpose2Choice) DeepCopyInto(out *Purpose2Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *QueueTransactionIdentification1) DeepCopy() *QueueTransactionIdentification1 {
+	if r == nil {
+		return nil
+	}
+	out := new(QueueTransactionIdentification1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *QueueTransactionIdentification1) DeepCopyInto(out *QueueTransactionIdentification1) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *Rate4) DeepCopy() *Rate4 {
+	if r == nil {
+		return nil
+	}
+	out := new(Rate4)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *Rate4) DeepCopyInto(out *Rate4) {
+	*out = *r
+	if r.VldtyRg != nil {
+		out.VldtyRg = new(ActiveOrHistoricCurrencyAndAmountRange2)
+		r.VldtyRg.DeepCopyInto(out.VldtyRg)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *RateType4Choice) DeepCopy() *RateType4Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(RateType4Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *RateType4Choice) DeepCopyInto(out *RateType4Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ReferredDocumentInformation7) DeepCopy() *ReferredDocumentInformation7 {
+	if r == nil {
+		return nil
+	}
+	out := new(ReferredDocumentInformation7)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ReferredDocumentInformation7) DeepCopyInto(out *ReferredDocumentInformation7) {
+	*out = *r
+	if r.Tp != nil {
+		out.Tp = new(ReferredDocumentType4)
+		r.Tp.DeepCopyInto(out.Tp)
+	}
+	if r.Nb != nil {
+		out.Nb = new(common.Max35Text)
+		*out.Nb = *r.Nb
+	}
+	if r.RltdDt != nil {
+		out.RltdDt = new(common.ISODate)
+		*out.RltdDt = *r.RltdDt
+	}
+	if r.LineDtls != nil {
+		out.LineDtls = make([]DocumentLineInformation1, len(r.LineDtls))
+		for i := range r.LineDtls {
+			r.LineDtls[i].DeepCopyInto(&out.LineDtls[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ReferredDocumentType3Choice) DeepCopy() *ReferredDocumentType3Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(ReferredDocumentType3Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ReferredDocumentType3Choice) DeepCopyInto(out *ReferredDocumentType3Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ReferredDocumentType4) DeepCopy() *ReferredDocumentType4 {
+	if r == nil {
+		return nil
+	}
+	out := new(ReferredDocumentType4)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ReferredDocumentType4) DeepCopyInto(out *ReferredDocumentType4) {
+	*out = *r
+	if r.Issr != nil {
+		out.Issr = new(common.Max35Text)
+		*out.Issr = *r.Issr
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *RemittanceAmount2) DeepCopy() *RemittanceAmount2 {
+	if r == nil {
+		return nil
+	}
+	out := new(RemittanceAmount2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *RemittanceAmount2) DeepCopyInto(out *RemittanceAmount2) {
+	*out = *r
+	if r.DuePyblAmt != nil {
+		out.DuePyblAmt = new(ActiveOrHistoricCurrencyAndAmount)
+		*out.DuePyblAmt = *r.DuePyblAmt
+	}
+	if r.DscntApldAmt != nil {
+		out.DscntApldAmt = make([]DiscountAmountAndType1, len(r.DscntApldAmt))
+		for i := range r.DscntApldAmt {
+			r.DscntApldAmt[i].DeepCopyInto(&out.DscntApldAmt[i])
+		}
+	}
+	if r.CdtNoteAmt != nil {
+		out.CdtNoteAmt = new(ActiveOrHistoricCurrencyAndAmount)
+		*out.CdtNoteAmt = *r.CdtNoteAmt
+	}
+	if r.TaxAmt != nil {
+		out.TaxAmt = make([]TaxAmountAndType1, len(r.TaxAmt))
+		for i := range r.TaxAmt {
+			r.TaxAmt[i].DeepCopyInto(&out.TaxAmt[i])
+		}
+	}
+	if r.AdjstmntAmtAndRsn != nil {
+		out.AdjstmntAmtAndRsn = make([]DocumentAdjustment1, len(r.AdjstmntAmtAndRsn))
+		for i := range r.AdjstmntAmtAndRsn {
+			r.AdjstmntAmtAndRsn[i].DeepCopyInto(&out.AdjstmntAmtAndRsn[i])
+		}
+	}
+	if r.RmtdAmt != nil {
+		out.RmtdAmt = new(ActiveOrHistoricCurrencyAndAmount)
+		*out.RmtdAmt = *r.RmtdAmt
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *RemittanceAmount3) DeepCopy() *RemittanceAmount3 {
+	if r == nil {
+		return nil
+	}
+	out := new(RemittanceAmount3)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *RemittanceAmount3) DeepCopyInto(out *RemittanceAmount3) {
+	*out = *r
+	if r.DuePyblAmt != nil {
+		out.DuePyblAmt = new(ActiveOrHistoricCurrencyAndAmount)
+		*out.DuePyblAmt = *r.DuePyblAmt
+	}
+	if r.DscntApldAmt != nil {
+		out.DscntApldAmt = make([]DiscountAmountAndType1, len(r.DscntApldAmt))
+		for i := range r.DscntApldAmt {
+			r.DscntApldAmt[i].DeepCopyInto(&out.DscntApldAmt[i])
+		}
+	}
+	if r.CdtNoteAmt != nil {
+		out.CdtNoteAmt = new(ActiveOrHistoricCurrencyAndAmount)
+		*out.CdtNoteAmt = *r.CdtNoteAmt
+	}
+	if r.TaxAmt != nil {
+		out.TaxAmt = make([]TaxAmountAndType1, len(r.TaxAmt))
+		for i := range r.TaxAmt {
+			r.TaxAmt[i].DeepCopyInto(&out.TaxAmt[i])
+		}
+	}
+	if r.AdjstmntAmtAndRsn != nil {
+		out.AdjstmntAmtAndRsn = make([]DocumentAdjustment1, len(r.AdjstmntAmtAndRsn))
+		for i := range r.AdjstmntAmtAndRsn {
+			r.AdjstmntAmtAndRsn[i].DeepCopyInto(&out.AdjstmntAmtAndRsn[i])
+		}
+	}
+	if r.RmtdAmt != nil {
+		out.RmtdAmt = new(ActiveOrHistoricCurrencyAndAmount)
+		*out.RmtdAmt = *r.RmtdAmt
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *RemittanceInformation16) DeepCopy() *RemittanceInformation16 {
+	if r == nil {
+		return nil
+	}
+	out := new(RemittanceInformation16)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *RemittanceInformation16) DeepCopyInto(out *RemittanceInformation16) {
+	*out = *r
+	if r.Ustrd != nil {
+		out.Ustrd = make([]common.Max140Text, len(r.Ustrd))
+		copy(out.Ustrd, r.Ustrd)
+	}
+	if r.Strd != nil {
+		out.Strd = make([]StructuredRemittanceInformation16, len(r.Strd))
+		for i := range r.Strd {
+			r.Strd[i].DeepCopyInto(&out.Strd[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *RemittanceLocation7) DeepCopy() *RemittanceLocation7 {
+	if r == nil {
+		return nil
+	}
+	out := new(RemittanceLocation7)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *RemittanceLocation7) DeepCopyInto(out *RemittanceLocation7) {
+	*out = *r
+	if r.RmtId != nil {
+		out.RmtId = new(common.Max35Text)
+		*out.RmtId = *r.RmtId
+	}
+	if r.RmtLctnDtls != nil {
+		out.RmtLctnDtls = make([]RemittanceLocationData1, len(r.RmtLctnDtls))
+		for i := range r.RmtLctnDtls {
+			r.RmtLctnDtls[i].DeepCopyInto(&out.RmtLctnDtls[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *RemittanceLocationData1) DeepCopy() *RemittanceLocationData1 {
+	if r == nil {
+		return nil
+	}
+	out := new(RemittanceLocationData1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *RemittanceLocationData1) DeepCopyInto(out *RemittanceLocationData1) {
+	*out = *r
+	if r.ElctrncAdr != nil {
+		out.ElctrncAdr = new(common.Max2048Text)
+		*out.ElctrncAdr = *r.ElctrncAdr
+	}
+	if r.PstlAdr != nil {
+		out.PstlAdr = new(NameAndAddress16)
+		r.PstlAdr.DeepCopyInto(out.PstlAdr)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ReportEntry10) DeepCopy() *ReportEntry10 {
+	if r == nil {
+		return nil
+	}
+	out := new(ReportEntry10)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ReportEntry10) DeepCopyInto(out *ReportEntry10) {
+	*out = *r
+	if r.NtryRef != nil {
+		out.NtryRef = new(common.Max35Text)
+		*out.NtryRef = *r.NtryRef
+	}
+	if r.BookgDt != nil {
+		out.BookgDt = new(DateAndDateTime2Choice)
+		*out.BookgDt = *r.BookgDt
+	}
+	if r.ValDt != nil {
+		out.ValDt = new(DateAndDateTime2Choice)
+		*out.ValDt = *r.ValDt
+	}
+	if r.AcctSvcrRef != nil {
+		out.AcctSvcrRef = new(common.Max35Text)
+		*out.AcctSvcrRef = *r.AcctSvcrRef
+	}
+	if r.Avlbty != nil {
+		out.Avlbty = make([]CashAvailability1, len(r.Avlbty))
+		copy(out.Avlbty, r.Avlbty)
+	}
+	r.BkTxCd.DeepCopyInto(&out.BkTxCd)
+	if r.AddtlInfInd != nil {
+		out.AddtlInfInd = new(MessageIdentification2)
+		r.AddtlInfInd.DeepCopyInto(out.AddtlInfInd)
+	}
+	if r.AmtDtls != nil {
+		out.AmtDtls = new(AmountAndCurrencyExchange3)
+		r.AmtDtls.DeepCopyInto(out.AmtDtls)
+	}
+	if r.Chrgs != nil {
+		out.Chrgs = new(Charges6)
+		r.Chrgs.DeepCopyInto(out.Chrgs)
+	}
+	if r.TechInptChanl != nil {
+		out.TechInptChanl = new(TechnicalInputChannel1Choice)
+		*out.TechInptChanl = *r.TechInptChanl
+	}
+	if r.Intrst != nil {
+		out.Intrst = new(TransactionInterest4)
+		r.Intrst.DeepCopyInto(out.Intrst)
+	}
+	if r.CardTx != nil {
+		out.CardTx = new(CardEntry4)
+		r.CardTx.DeepCopyInto(out.CardTx)
+	}
+	if r.NtryDtls != nil {
+		out.NtryDtls = make([]EntryDetails9, len(r.NtryDtls))
+		for i := range r.NtryDtls {
+			r.NtryDtls[i].DeepCopyInto(&out.NtryDtls[i])
+		}
+	}
+	if r.AddtlNtryInf != nil {
+		out.AddtlNtryInf = new(common.Max500Text)
+		*out.AddtlNtryInf = *r.AddtlNtryInf
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ReportingSource1Choice) DeepCopy() *ReportingSource1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(ReportingSource1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ReportingSource1Choice) DeepCopyInto(out *ReportingSource1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *RequestType4Choice) DeepCopy() *RequestType4Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(RequestType4Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *RequestType4Choice) DeepCopyInto(out *RequestType4Choice) {
+	*out = *r
+	r.Prtry.DeepCopyInto(&out.Prtry)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ReturnAccountV08) DeepCopy() *ReturnAccountV08 {
+	if r == nil {
+		return nil
+	}
+	out := new(ReturnAccountV08)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ReturnAccountV08) DeepCopyInto(out *ReturnAccountV08) {
+	*out = *r
+	r.MsgHdr.DeepCopyInto(&out.MsgHdr)
+	r.RptOrErr.DeepCopyInto(&out.RptOrErr)
+	if r.SplmtryData != nil {
+		out.SplmtryData = make([]SupplementaryData1, len(r.SplmtryData))
+		for i := range r.SplmtryData {
+			r.SplmtryData[i].DeepCopyInto(&out.SplmtryData[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ReturnLimitV08) DeepCopy() *ReturnLimitV08 {
+	if r == nil {
+		return nil
+	}
+	out := new(ReturnLimitV08)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ReturnLimitV08) DeepCopyInto(out *ReturnLimitV08) {
+	*out = *r
+	r.MsgHdr.DeepCopyInto(&out.MsgHdr)
+	r.RptOrErr.DeepCopyInto(&out.RptOrErr)
+	if r.SplmtryData != nil {
+		out.SplmtryData = make([]SupplementaryData1, len(r.SplmtryData))
+		for i := range r.SplmtryData {
+			r.SplmtryData[i].DeepCopyInto(&out.SplmtryData[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ReturnReason5Choice) DeepCopy() *ReturnReason5Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(ReturnReason5Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ReturnReason5Choice) DeepCopyInto(out *ReturnReason5Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ReturnTransactionV08) DeepCopy() *ReturnTransactionV08 {
+	if r == nil {
+		return nil
+	}
+	out := new(ReturnTransactionV08)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ReturnTransactionV08) DeepCopyInto(out *ReturnTransactionV08) {
+	*out = *r
+	r.MsgHdr.DeepCopyInto(&out.MsgHdr)
+	r.RptOrErr.DeepCopyInto(&out.RptOrErr)
+	if r.SplmtryData != nil {
+		out.SplmtryData = make([]SupplementaryData1, len(r.SplmtryData))
+		for i := range r.SplmtryData {
+			r.SplmtryData[i].DeepCopyInto(&out.SplmtryData[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *SecuritiesAccount19) DeepCopy() *SecuritiesAccount19 {
+	if r == nil {
+		return nil
+	}
+	out := new(SecuritiesAccount19)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *SecuritiesAccount19) DeepCopyInto(out *SecuritiesAccount19) {
+	*out = *r
+	if r.Tp != nil {
+		out.Tp = new(GenericIdentification30)
+		r.Tp.DeepCopyInto(out.Tp)
+	}
+	if r.Nm != nil {
+		out.Nm = new(common.Max70Text)
+		*out.Nm = *r.Nm
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *SecuritiesTransactionReferences1) DeepCopy() *SecuritiesTransactionReferences1 {
+	if r == nil {
+		return nil
+	}
+	out := new(SecuritiesTransactionReferences1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *SecuritiesTransactionReferences1) DeepCopyInto(out *SecuritiesTransactionReferences1) {
+	*out = *r
+	if r.AcctOwnrTxId != nil {
+		out.AcctOwnrTxId = new(common.Max35Text)
+		*out.AcctOwnrTxId = *r.AcctOwnrTxId
+	}
+	if r.AcctSvcrTxId != nil {
+		out.AcctSvcrTxId = new(common.Max35Text)
+		*out.AcctSvcrTxId = *r.AcctSvcrTxId
+	}
+	if r.MktInfrstrctrTxId != nil {
+		out.MktInfrstrctrTxId = new(common.Max35Text)
+		*out.MktInfrstrctrTxId = *r.MktInfrstrctrTxId
+	}
+	if r.PrcgId != nil {
+		out.PrcgId = new(common.Max35Text)
+		*out.PrcgId = *r.PrcgId
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *SecurityIdentification19) DeepCopy() *SecurityIdentification19 {
+	if r == nil {
+		return nil
+	}
+	out := new(SecurityIdentification19)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *SecurityIdentification19) DeepCopyInto(out *SecurityIdentification19) {
+	*out = *r
+	if r.ISIN != nil {
+		out.ISIN = new(ISINOct2015Identifier)
+		*out.ISIN = *r.ISIN
+	}
+	if r.OthrId != nil {
+		out.OthrId = make([]OtherIdentification1, len(r.OthrId))
+		for i := range r.OthrId {
+			r.OthrId[i].DeepCopyInto(&out.OthrId[i])
+		}
+	}
+	if r.Desc != nil {
+		out.Desc = new(common.Max140Text)
+		*out.Desc = *r.Desc
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *SequenceRange1) DeepCopy() *SequenceRange1 {
+	if r == nil {
+		return nil
+	}
+	out := new(SequenceRange1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *SequenceRange1) DeepCopyInto(out *SequenceRange1) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *SequenceRange1Choice) DeepCopy() *SequenceRange1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(SequenceRange1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *SequenceRange1Choice) DeepCopyInto(out *SequenceRange1Choice) {
+	*out = *r
+	if r.FrToSeq != nil {
+		out.FrToSeq = make([]SequenceRange1, len(r.FrToSeq))
+		copy(out.FrToSeq, r.FrToSeq)
+	}
+	if r.EQSeq != nil {
+		out.EQSeq = make([]common.Max35Text, len(r.EQSeq))
+		copy(out.EQSeq, r.EQSeq)
+	}
+	if r.NEQSeq != nil {
+		out.NEQSeq = make([]common.Max35Text, len(r.NEQSeq))
+		copy(out.NEQSeq, r.NEQSeq)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ServiceLevel8Choice) DeepCopy() *ServiceLevel8Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(ServiceLevel8Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ServiceLevel8Choice) DeepCopyInto(out *ServiceLevel8Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *SettlementInstruction6) DeepCopy() *SettlementInstruction6 {
+	if r == nil {
+		return nil
+	}
+	out := new(SettlementInstruction6)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *SettlementInstruction6) DeepCopyInto(out *SettlementInstruction6) {
+	*out = *r
+	if r.InstgRmbrsmntAgt != nil {
+		out.InstgRmbrsmntAgt = new(BranchAndFinancialInstitutionIdentification6)
+		r.InstgRmbrsmntAgt.DeepCopyInto(out.InstgRmbrsmntAgt)
+	}
+	if r.InstgRmbrsmntAgtAcct != nil {
+		out.InstgRmbrsmntAgtAcct = new(CashAccount38)
+		r.InstgRmbrsmntAgtAcct.DeepCopyInto(out.InstgRmbrsmntAgtAcct)
+	}
+	if r.InstdRmbrsmntAgt != nil {
+		out.InstdRmbrsmntAgt = new(BranchAndFinancialInstitutionIdentification6)
+		r.InstdRmbrsmntAgt.DeepCopyInto(out.InstdRmbrsmntAgt)
+	}
+	if r.InstdRmbrsmntAgtAcct != nil {
+		out.InstdRmbrsmntAgtAcct = new(CashAccount38)
+		r.InstdRmbrsmntAgtAcct.DeepCopyInto(out.InstdRmbrsmntAgtAcct)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *SettlementInstruction7) DeepCopy() *SettlementInstruction7 {
+	if r == nil {
+		return nil
+	}
+	out := new(SettlementInstruction7)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *SettlementInstruction7) DeepCopyInto(out *SettlementInstruction7) {
+	*out = *r
+	if r.RfrdDocInf != nil {
+		out.RfrdDocInf = make([]ReferredDocumentInformation7, len(r.RfrdDocInf))
+		for i := range r.RfrdDocInf {
+			r.RfrdDocInf[i].DeepCopyInto(&out.RfrdDocInf[i])
+		}
+	}
+	if r.RfrdDocAmt != nil {
+		out.RfrdDocAmt = new(RemittanceAmount2)
+		r.RfrdDocAmt.DeepCopyInto(out.RfrdDocAmt)
+	}
+	if r.CdtrRefInf != nil {
+		out.CdtrRefInf = new(CreditorReferenceInformation2)
+		r.CdtrRefInf.DeepCopyInto(out.CdtrRefInf)
+	}
+	if r.Invcr != nil {
+		out.Invcr = new(PartyIdentification135)
+		r.Invcr.DeepCopyInto(out.Invcr)
+	}
+	if r.Invcee != nil {
+		out.Invcee = new(PartyIdentification135)
+		r.Invcee.DeepCopyInto(out.Invcee)
+	}
+	if r.TaxRmt != nil {
+		out.TaxRmt = new(TaxInformation7)
+		r.TaxRmt.DeepCopyInto(out.TaxRmt)
+	}
+	if r.GrnshmtRmt != nil {
+		out.GrnshmtRmt = new(Garnishment3)
+		r.GrnshmtRmt.DeepCopyInto(out.GrnshmtRmt)
+	}
+	if r.AddtlRmtInf != nil {
+		out.AddtlRmtInf = make([]common.Max140Text, len(r.AddtlRmtInf))
+		copy(out.AddtlRmtInf, r.AddtlRmtInf)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ShortPaymentIdentification2) DeepCopy() *ShortPaymentIdentification2 {
+	if r == nil {
+		return nil
+	}
+	out := new(ShortPaymentIdentification2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ShortPaymentIdentification2) DeepCopyInto(out *ShortPaymentIdentification2) {
+	*out = *r
+	r.InstgAgt.DeepCopyInto(&out.InstgAgt)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *StandingOrder6) DeepCopy() *StandingOrder6 {
+	if r == nil {
+		return nil
+	}
+	out := new(StandingOrder6)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *StandingOrder6) DeepCopyInto(out *StandingOrder6) {
+	*out = *r
+	if r.Ccy != nil {
+		out.Ccy = new(common.ActiveCurrencyCode)
+		*out.Ccy = *r.Ccy
+	}
+	if r.Tp != nil {
+		out.Tp = new(StandingOrderType1Choice)
+		r.Tp.DeepCopyInto(out.Tp)
+	}
+	if r.AssoctdPoolAcct != nil {
+		out.AssoctdPoolAcct = new(AccountIdentification4Choice)
+		r.AssoctdPoolAcct.DeepCopyInto(out.AssoctdPoolAcct)
+	}
+	if r.Ref != nil {
+		out.Ref = new(common.Max35Text)
+		*out.Ref = *r.Ref
+	}
+	if r.Frqcy != nil {
+		out.Frqcy = new(Frequency2Code)
+		*out.Frqcy = *r.Frqcy
+	}
+	if r.VldtyPrd != nil {
+		out.VldtyPrd = new(DatePeriodDetails1)
+		r.VldtyPrd.DeepCopyInto(out.VldtyPrd)
+	}
+	if r.SysMmb != nil {
+		out.SysMmb = new(BranchAndFinancialInstitutionIdentification6)
+		r.SysMmb.DeepCopyInto(out.SysMmb)
+	}
+	if r.RspnsblPty != nil {
+		out.RspnsblPty = new(BranchAndFinancialInstitutionIdentification6)
+		r.RspnsblPty.DeepCopyInto(out.RspnsblPty)
+	}
+	if r.LkSetId != nil {
+		out.LkSetId = new(common.Max35Text)
+		*out.LkSetId = *r.LkSetId
+	}
+	if r.LkSetOrdrId != nil {
+		out.LkSetOrdrId = new(common.Max35Text)
+		*out.LkSetOrdrId = *r.LkSetOrdrId
+	}
+	if r.ExctnTp != nil {
+		out.ExctnTp = new(ExecutionType1Choice)
+		*out.ExctnTp = *r.ExctnTp
+	}
+	if r.Cdtr != nil {
+		out.Cdtr = new(BranchAndFinancialInstitutionIdentification6)
+		r.Cdtr.DeepCopyInto(out.Cdtr)
+	}
+	if r.CdtrAcct != nil {
+		out.CdtrAcct = new(CashAccount38)
+		r.CdtrAcct.DeepCopyInto(out.CdtrAcct)
+	}
+	if r.Dbtr != nil {
+		out.Dbtr = new(BranchAndFinancialInstitutionIdentification6)
+		r.Dbtr.DeepCopyInto(out.Dbtr)
+	}
+	if r.DbtrAcct != nil {
+		out.DbtrAcct = new(CashAccount38)
+		r.DbtrAcct.DeepCopyInto(out.DbtrAcct)
+	}
+	if r.TtlsPerStgOrdr != nil {
+		out.TtlsPerStgOrdr = new(StandingOrderTotalAmount1)
+		r.TtlsPerStgOrdr.DeepCopyInto(out.TtlsPerStgOrdr)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *StandingOrderTotalAmount1) DeepCopy() *StandingOrderTotalAmount1 {
+	if r == nil {
+		return nil
+	}
+	out := new(StandingOrderTotalAmount1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *StandingOrderTotalAmount1) DeepCopyInto(out *StandingOrderTotalAmount1) {
+	*out = *r
+	r.SetPrdfndOrdr.DeepCopyInto(&out.SetPrdfndOrdr)
+	r.PdgPrdfndOrdr.DeepCopyInto(&out.PdgPrdfndOrdr)
+	r.SetStgOrdr.DeepCopyInto(&out.SetStgOrdr)
+	r.PdgStgOrdr.DeepCopyInto(&out.PdgStgOrdr)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *StandingOrderType1Choice) DeepCopy() *StandingOrderType1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(StandingOrderType1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *StandingOrderType1Choice) DeepCopyInto(out *StandingOrderType1Choice) {
+	*out = *r
+	r.Prtry.DeepCopyInto(&out.Prtry)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *StructuredRemittanceInformation16) DeepCopy() *StructuredRemittanceInformation16 {
+	if r == nil {
+		return nil
+	}
+	out := new(StructuredRemittanceInformation16)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *StructuredRemittanceInformation16) DeepCopyInto(out *StructuredRemittanceInformation16) {
+	*out = *r
+	if r.RfrdDocInf != nil {
+		out.RfrdDocInf = make([]ReferredDocumentInformation7, len(r.RfrdDocInf))
+		for i := range r.RfrdDocInf {
+			r.RfrdDocInf[i].DeepCopyInto(&out.RfrdDocInf[i])
+		}
+	}
+	if r.RfrdDocAmt != nil {
+		out.RfrdDocAmt = new(RemittanceAmount2)
+		r.RfrdDocAmt.DeepCopyInto(out.RfrdDocAmt)
+	}
+	if r.CdtrRefInf != nil {
+		out.CdtrRefInf = new(CreditorReferenceInformation2)
+		r.CdtrRefInf.DeepCopyInto(out.CdtrRefInf)
+	}
+	if r.Invcr != nil {
+		out.Invcr = new(PartyIdentification135)
+		r.Invcr.DeepCopyInto(out.Invcr)
+	}
+	if r.Invcee != nil {
+		out.Invcee = new(PartyIdentification135)
+		r.Invcee.DeepCopyInto(out.Invcee)
+	}
+	if r.TaxRmt != nil {
+		out.TaxRmt = new(TaxInformation7)
+		r.TaxRmt.DeepCopyInto(out.TaxRmt)
+	}
+	if r.GrnshmtRmt != nil {
+		out.GrnshmtRmt = new(Garnishment3)
+		r.GrnshmtRmt.DeepCopyInto(out.GrnshmtRmt)
+	}
+	if r.AddtlRmtInf != nil {
+		out.AddtlRmtInf = make([]common.Max140Text, len(r.AddtlRmtInf))
+		copy(out.AddtlRmtInf, r.AddtlRmtInf)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *SupplementaryData1) DeepCopy() *SupplementaryData1 {
+	if r == nil {
+		return nil
+	}
+	out := new(SupplementaryData1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *SupplementaryData1) DeepCopyInto(out *SupplementaryData1) {
+	*out = *r
+	if r.PlcAndNm != nil {
+		out.PlcAndNm = new(common.Max350Text)
+		*out.PlcAndNm = *r.PlcAndNm
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *SupplementaryDataEnvelope1) DeepCopy() *SupplementaryDataEnvelope1 {
+	if r == nil {
+		return nil
+	}
+	out := new(SupplementaryDataEnvelope1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *SupplementaryDataEnvelope1) DeepCopyInto(out *SupplementaryDataEnvelope1) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *System2) DeepCopy() *System2 {
+	if r == nil {
+		return nil
+	}
+	out := new(System2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *System2) DeepCopyInto(out *System2) {
+	*out = *r
+	if r.SysId != nil {
+		out.SysId = new(MarketInfrastructureIdentification1Choice)
+		*out.SysId = *r.SysId
+	}
+	if r.MmbId != nil {
+		out.MmbId = new(BranchAndFinancialInstitutionIdentification6)
+		r.MmbId.DeepCopyInto(out.MmbId)
+	}
+	if r.Ctry != nil {
+		out.Ctry = new(common.CountryCode)
+		*out.Ctry = *r.Ctry
+	}
+	if r.AcctId != nil {
+		out.AcctId = new(AccountIdentification4Choice)
+		r.AcctId.DeepCopyInto(out.AcctId)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *SystemIdentification2Choice) DeepCopy() *SystemIdentification2Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(SystemIdentification2Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *SystemIdentification2Choice) DeepCopyInto(out *SystemIdentification2Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *SystemReturnCriteria2) DeepCopy() *SystemReturnCriteria2 {
+	if r == nil {
+		return nil
+	}
+	out := new(SystemReturnCriteria2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *SystemReturnCriteria2) DeepCopyInto(out *SystemReturnCriteria2) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *SystemSearch4) DeepCopy() *SystemSearch4 {
+	if r == nil {
+		return nil
+	}
+	out := new(SystemSearch4)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *SystemSearch4) DeepCopyInto(out *SystemSearch4) {
+	*out = *r
+	if r.SysId != nil {
+		out.SysId = make([]ClearingSystemIdentification3Choice, len(r.SysId))
+		copy(out.SysId, r.SysId)
+	}
+	if r.MmbId != nil {
+		out.MmbId = make([]BranchAndFinancialInstitutionIdentification6, len(r.MmbId))
+		for i := range r.MmbId {
+			r.MmbId[i].DeepCopyInto(&out.MmbId[i])
+		}
+	}
+	if r.Ctry != nil {
+		out.Ctry = new(common.CountryCode)
+		*out.Ctry = *r.Ctry
+	}
+	if r.AcctId != nil {
+		out.AcctId = new(AccountIdentification4Choice)
+		r.AcctId.DeepCopyInto(out.AcctId)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *TaxAmount2) DeepCopy() *TaxAmount2 {
+	if r == nil {
+		return nil
+	}
+	out := new(TaxAmount2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *TaxAmount2) DeepCopyInto(out *TaxAmount2) {
+	*out = *r
+	if r.TaxblBaseAmt != nil {
+		out.TaxblBaseAmt = new(ActiveOrHistoricCurrencyAndAmount)
+		*out.TaxblBaseAmt = *r.TaxblBaseAmt
+	}
+	if r.TtlAmt != nil {
+		out.TtlAmt = new(ActiveOrHistoricCurrencyAndAmount)
+		*out.TtlAmt = *r.TtlAmt
+	}
+	if r.Dtls != nil {
+		out.Dtls = make([]TaxRecordDetails2, len(r.Dtls))
+		for i := range r.Dtls {
+			r.Dtls[i].DeepCopyInto(&out.Dtls[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *TaxAmountAndType1) DeepCopy() *TaxAmountAndType1 {
+	if r == nil {
+		return nil
+	}
+	out := new(TaxAmountAndType1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *TaxAmountAndType1) DeepCopyInto(out *TaxAmountAndType1) {
+	*out = *r
+	if r.Tp != nil {
+		out.Tp = new(TaxAmountType1Choice)
+		*out.Tp = *r.Tp
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *TaxAmountType1Choice) DeepCopy() *TaxAmountType1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(TaxAmountType1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *TaxAmountType1Choice) DeepCopyInto(out *TaxAmountType1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *TaxAuthorisation1) DeepCopy() *TaxAuthorisation1 {
+	if r == nil {
+		return nil
+	}
+	out := new(TaxAuthorisation1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *TaxAuthorisation1) DeepCopyInto(out *TaxAuthorisation1) {
+	*out = *r
+	if r.Titl != nil {
+		out.Titl = new(common.Max35Text)
+		*out.Titl = *r.Titl
+	}
+	if r.Nm != nil {
+		out.Nm = new(common.Max140Text)
+		*out.Nm = *r.Nm
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *TaxCharges2) DeepCopy() *TaxCharges2 {
+	if r == nil {
+		return nil
+	}
+	out := new(TaxCharges2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *TaxCharges2) DeepCopyInto(out *TaxCharges2) {
+	*out = *r
+	if r.Id != nil {
+		out.Id = new(common.Max35Text)
+		*out.Id = *r.Id
+	}
+	if r.Amt != nil {
+		out.Amt = new(ActiveOrHistoricCurrencyAndAmount)
+		*out.Amt = *r.Amt
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *TaxInformation7) DeepCopy() *TaxInformation7 {
+	if r == nil {
+		return nil
+	}
+	out := new(TaxInformation7)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *TaxInformation7) DeepCopyInto(out *TaxInformation7) {
+	*out = *r
+	if r.Cdtr != nil {
+		out.Cdtr = new(TaxParty1)
+		r.Cdtr.DeepCopyInto(out.Cdtr)
+	}
+	if r.Dbtr != nil {
+		out.Dbtr = new(TaxParty2)
+		r.Dbtr.DeepCopyInto(out.Dbtr)
+	}
+	if r.UltmtDbtr != nil {
+		out.UltmtDbtr = new(TaxParty2)
+		r.UltmtDbtr.DeepCopyInto(out.UltmtDbtr)
+	}
+	if r.AdmstnZone != nil {
+		out.AdmstnZone = new(common.Max35Text)
+		*out.AdmstnZone = *r.AdmstnZone
+	}
+	if r.RefNb != nil {
+		out.RefNb = new(common.Max140Text)
+		*out.RefNb = *r.RefNb
+	}
+	if r.Mtd != nil {
+		out.Mtd = new(common.Max35Text)
+		*out.Mtd = *r.Mtd
+	}
+	if r.TtlTaxblBaseAmt != nil {
+		out.TtlTaxblBaseAmt = new(ActiveOrHistoricCurrencyAndAmount)
+		*out.TtlTaxblBaseAmt = *r.TtlTaxblBaseAmt
+	}
+	if r.TtlTaxAmt != nil {
+		out.TtlTaxAmt = new(ActiveOrHistoricCurrencyAndAmount)
+		*out.TtlTaxAmt = *r.TtlTaxAmt
+	}
+	if r.Dt != nil {
+		out.Dt = new(common.ISODate)
+		*out.Dt = *r.Dt
+	}
+	if r.Rcrd != nil {
+		out.Rcrd = make([]TaxRecord2, len(r.Rcrd))
+		for i := range r.Rcrd {
+			r.Rcrd[i].DeepCopyInto(&out.Rcrd[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *TaxInformation8) DeepCopy() *TaxInformation8 {
+	if r == nil {
+		return nil
+	}
+	out := new(TaxInformation8)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *TaxInformation8) DeepCopyInto(out *TaxInformation8) {
+	*out = *r
+	if r.Cdtr != nil {
+		out.Cdtr = new(TaxParty1)
+		r.Cdtr.DeepCopyInto(out.Cdtr)
+	}
+	if r.Dbtr != nil {
+		out.Dbtr = new(TaxParty2)
+		r.Dbtr.DeepCopyInto(out.Dbtr)
+	}
+	if r.AdmstnZone != nil {
+		out.AdmstnZone = new(common.Max35Text)
+		*out.AdmstnZone = *r.AdmstnZone
+	}
+	if r.RefNb != nil {
+		out.RefNb = new(common.Max140Text)
+		*out.RefNb = *r.RefNb
+	}
+	if r.Mtd != nil {
+		out.Mtd = new(common.Max35Text)
+		*out.Mtd = *r.Mtd
+	}
+	if r.TtlTaxblBaseAmt != nil {
+		out.TtlTaxblBaseAmt = new(ActiveOrHistoricCurrencyAndAmount)
+		*out.TtlTaxblBaseAmt = *r.TtlTaxblBaseAmt
+	}
+	if r.TtlTaxAmt != nil {
+		out.TtlTaxAmt = new(ActiveOrHistoricCurrencyAndAmount)
+		*out.TtlTaxAmt = *r.TtlTaxAmt
+	}
+	if r.Dt != nil {
+		out.Dt = new(common.ISODate)
+		*out.Dt = *r.Dt
+	}
+	if r.Rcrd != nil {
+		out.Rcrd = make([]TaxRecord2, len(r.Rcrd))
+		for i := range r.Rcrd {
+			r.Rcrd[i].DeepCopyInto(&out.Rcrd[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *TaxParty1) DeepCopy() *TaxParty1 {
+	if r == nil {
+		return nil
+	}
+	out := new(TaxParty1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *TaxParty1) DeepCopyInto(out *TaxParty1) {
+	*out = *r
+	if r.TaxId != nil {
+		out.TaxId = new(common.Max35Text)
+		*out.TaxId = *r.TaxId
+	}
+	if r.RegnId != nil {
+		out.RegnId = new(common.Max35Text)
+		*out.RegnId = *r.RegnId
+	}
+	if r.TaxTp != nil {
+		out.TaxTp = new(common.Max35Text)
+		*out.TaxTp = *r.TaxTp
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *TaxParty2) DeepCopy() *TaxParty2 {
+	if r == nil {
+		return nil
+	}
+	out := new(TaxParty2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *TaxParty2) DeepCopyInto(out *TaxParty2) {
+	*out = *r
+	if r.TaxId != nil {
+		out.TaxId = new(common.Max35Text)
+		*out.TaxId = *r.TaxId
+	}
+	if r.RegnId != nil {
+		out.RegnId = new(common.Max35Text)
+		*out.RegnId = *r.RegnId
+	}
+	if r.TaxTp != nil {
+		out.TaxTp = new(common.Max35Text)
+		*out.TaxTp = *r.TaxTp
+	}
+	if r.Authstn != nil {
+		out.Authstn = new(TaxAuthorisation1)
+		r.Authstn.DeepCopyInto(out.Authstn)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *TaxPeriod2) DeepCopy() *TaxPeriod2 {
+	if r == nil {
+		return nil
+	}
+	out := new(TaxPeriod2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *TaxPeriod2) DeepCopyInto(out *TaxPeriod2) {
+	*out = *r
+	if r.Yr != nil {
+		out.Yr = new(common.ISODate)
+		*out.Yr = *r.Yr
+	}
+	if r.Tp != nil {
+		out.Tp = new(TaxRecordPeriod1Code)
+		*out.Tp = *r.Tp
+	}
+	if r.FrToDt != nil {
+		out.FrToDt = new(DatePeriod2)
+		*out.FrToDt = *r.FrToDt
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *TaxRecord2) DeepCopy() *TaxRecord2 {
+	if r == nil {
+		return nil
+	}
+	out := new(TaxRecord2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *TaxRecord2) DeepCopyInto(out *TaxRecord2) {
+	*out = *r
+	if r.Tp != nil {
+		out.Tp = new(common.Max35Text)
+		*out.Tp = *r.Tp
+	}
+	if r.Ctgy != nil {
+		out.Ctgy = new(common.Max35Text)
+		*out.Ctgy = *r.Ctgy
+	}
+	if r.CtgyDtls != nil {
+		out.CtgyDtls = new(common.Max35Text)
+		*out.CtgyDtls = *r.CtgyDtls
+	}
+	if r.DbtrSts != nil {
+		out.DbtrSts = new(common.Max35Text)
+		*out.DbtrSts = *r.DbtrSts
+	}
+	if r.CertId != nil {
+		out.CertId = new(common.Max35Text)
+		*out.CertId = *r.CertId
+	}
+	if r.FrmsCd != nil {
+		out.FrmsCd = new(common.Max35Text)
+		*out.FrmsCd = *r.FrmsCd
+	}
+	if r.Prd != nil {
+		out.Prd = new(TaxPeriod2)
+		r.Prd.DeepCopyInto(out.Prd)
+	}
+	if r.TaxAmt != nil {
+		out.TaxAmt = new(TaxAmount2)
+		r.TaxAmt.DeepCopyInto(out.TaxAmt)
+	}
+	if r.AddtlInf != nil {
+		out.AddtlInf = new(common.Max140Text)
+		*out.AddtlInf = *r.AddtlInf
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *TaxRecordDetails2) DeepCopy() *TaxRecordDetails2 {
+	if r == nil {
+		return nil
+	}
+	out := new(TaxRecordDetails2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *TaxRecordDetails2) DeepCopyInto(out *TaxRecordDetails2) {
+	*out = *r
+	if r.Prd != nil {
+		out.Prd = new(TaxPeriod2)
+		r.Prd.DeepCopyInto(out.Prd)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *TechnicalInputChannel1Choice) DeepCopy() *TechnicalInputChannel1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(TechnicalInputChannel1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *TechnicalInputChannel1Choice) DeepCopyInto(out *TechnicalInputChannel1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *TotalAmountAndCurrency1) DeepCopy() *TotalAmountAndCurrency1 {
+	if r == nil {
+		return nil
+	}
+	out := new(TotalAmountAndCurrency1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *TotalAmountAndCurrency1) DeepCopyInto(out *TotalAmountAndCurrency1) {
+	*out = *r
+	if r.CdtDbtInd != nil {
+		out.CdtDbtInd = new(common.CreditDebitCode)
+		*out.CdtDbtInd = *r.CdtDbtInd
+	}
+	if r.Ccy != nil {
+		out.Ccy = new(common.ActiveCurrencyCode)
+		*out.Ccy = *r.Ccy
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *TotalTransactions6) DeepCopy() *TotalTransactions6 {
+	if r == nil {
+		return nil
+	}
+	out := new(TotalTransactions6)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *TotalTransactions6) DeepCopyInto(out *TotalTransactions6) {
+	*out = *r
+	if r.TtlNtries != nil {
+		out.TtlNtries = new(NumberAndSumOfTransactions4)
+		r.TtlNtries.DeepCopyInto(out.TtlNtries)
+	}
+	if r.TtlCdtNtries != nil {
+		out.TtlCdtNtries = new(NumberAndSumOfTransactions1)
+		r.TtlCdtNtries.DeepCopyInto(out.TtlCdtNtries)
+	}
+	if r.TtlDbtNtries != nil {
+		out.TtlDbtNtries = new(NumberAndSumOfTransactions1)
+		r.TtlDbtNtries.DeepCopyInto(out.TtlDbtNtries)
+	}
+	if r.TtlNtriesPerBkTxCd != nil {
+		out.TtlNtriesPerBkTxCd = make([]TotalsPerBankTransactionCode5, len(r.TtlNtriesPerBkTxCd))
+		for i := range r.TtlNtriesPerBkTxCd {
+			r.TtlNtriesPerBkTxCd[i].DeepCopyInto(&out.TtlNtriesPerBkTxCd[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *TotalsPerBankTransactionCode5) DeepCopy() *TotalsPerBankTransactionCode5 {
+	if r == nil {
+		return nil
+	}
+	out := new(TotalsPerBankTransactionCode5)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *TotalsPerBankTransactionCode5) DeepCopyInto(out *TotalsPerBankTransactionCode5) {
+	*out = *r
+	if r.NbOfNtries != nil {
+		out.NbOfNtries = new(common.Max15NumericText)
+		*out.NbOfNtries = *r.NbOfNtries
+	}
+	if r.TtlNetNtry != nil {
+		out.TtlNetNtry = new(AmountAndDirection35)
+		*out.TtlNetNtry = *r.TtlNetNtry
+	}
+	if r.CdtNtries != nil {
+		out.CdtNtries = new(NumberAndSumOfTransactions1)
+		r.CdtNtries.DeepCopyInto(out.CdtNtries)
+	}
+	if r.DbtNtries != nil {
+		out.DbtNtries = new(NumberAndSumOfTransactions1)
+		r.DbtNtries.DeepCopyInto(out.DbtNtries)
+	}
+	r.BkTxCd.DeepCopyInto(&out.BkTxCd)
+	if r.Avlbty != nil {
+		out.Avlbty = make([]CashAvailability1, len(r.Avlbty))
+		copy(out.Avlbty, r.Avlbty)
+	}
+	if r.Dt != nil {
+		out.Dt = new(DateAndDateTime2Choice)
+		*out.Dt = *r.Dt
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *TrackData1) DeepCopy() *TrackData1 {
+	if r == nil {
+		return nil
+	}
+	out := new(TrackData1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *TrackData1) DeepCopyInto(out *TrackData1) {
+	*out = *r
+	if r.TrckNb != nil {
+		out.TrckNb = new(Exact1NumericText)
+		*out.TrckNb = *r.TrckNb
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *Transaction66) DeepCopy() *Transaction66 {
+	if r == nil {
+		return nil
+	}
+	out := new(Transaction66)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *Transaction66) DeepCopyInto(out *Transaction66) {
+	*out = *r
+	if r.PmtTo != nil {
+		out.PmtTo = new(System2)
+		r.PmtTo.DeepCopyInto(out.PmtTo)
+	}
+	if r.PmtFr != nil {
+		out.PmtFr = new(System2)
+		r.PmtFr.DeepCopyInto(out.PmtFr)
+	}
+	if r.CdtDbtInd != nil {
+		out.CdtDbtInd = new(common.CreditDebitCode)
+		*out.CdtDbtInd = *r.CdtDbtInd
+	}
+	if r.Pmt != nil {
+		out.Pmt = new(PaymentInstruction32)
+		r.Pmt.DeepCopyInto(out.Pmt)
+	}
+	if r.AcctNtry != nil {
+		out.AcctNtry = new(CashAccountAndEntry3)
+		r.AcctNtry.DeepCopyInto(out.AcctNtry)
+	}
+	if r.SctiesTxRefs != nil {
+		out.SctiesTxRefs = new(SecuritiesTransactionReferences1)
+		r.SctiesTxRefs.DeepCopyInto(out.SctiesTxRefs)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *TransactionAgents5) DeepCopy() *TransactionAgents5 {
+	if r == nil {
+		return nil
+	}
+	out := new(TransactionAgents5)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *TransactionAgents5) DeepCopyInto(out *TransactionAgents5) {
+	*out = *r
+	if r.InstgAgt != nil {
+		out.InstgAgt = new(BranchAndFinancialInstitutionIdentification6)
+		r.InstgAgt.DeepCopyInto(out.InstgAgt)
+	}
+	if r.InstdAgt != nil {
+		out.InstdAgt = new(BranchAndFinancialInstitutionIdentification6)
+		r.InstdAgt.DeepCopyInto(out.InstdAgt)
+	}
+	if r.DbtrAgt != nil {
+		out.DbtrAgt = new(BranchAndFinancialInstitutionIdentification6)
+		r.DbtrAgt.DeepCopyInto(out.DbtrAgt)
+	}
+	if r.CdtrAgt != nil {
+		out.CdtrAgt = new(BranchAndFinancialInstitutionIdentification6)
+		r.CdtrAgt.DeepCopyInto(out.CdtrAgt)
+	}
+	if r.IntrmyAgt1 != nil {
+		out.IntrmyAgt1 = new(BranchAndFinancialInstitutionIdentification6)
+		r.IntrmyAgt1.DeepCopyInto(out.IntrmyAgt1)
+	}
+	if r.IntrmyAgt2 != nil {
+		out.IntrmyAgt2 = new(BranchAndFinancialInstitutionIdentification6)
+		r.IntrmyAgt2.DeepCopyInto(out.IntrmyAgt2)
+	}
+	if r.IntrmyAgt3 != nil {
+		out.IntrmyAgt3 = new(BranchAndFinancialInstitutionIdentification6)
+		r.IntrmyAgt3.DeepCopyInto(out.IntrmyAgt3)
+	}
+	if r.RcvgAgt != nil {
+		out.RcvgAgt = new(BranchAndFinancialInstitutionIdentification6)
+		r.RcvgAgt.DeepCopyInto(out.RcvgAgt)
+	}
+	if r.DlvrgAgt != nil {
+		out.DlvrgAgt = new(BranchAndFinancialInstitutionIdentification6)
+		r.DlvrgAgt.DeepCopyInto(out.DlvrgAgt)
+	}
+	if r.IssgAgt != nil {
+		out.IssgAgt = new(BranchAndFinancialInstitutionIdentification6)
+		r.IssgAgt.DeepCopyInto(out.IssgAgt)
+	}
+	if r.SttlmPlc != nil {
+		out.SttlmPlc = new(BranchAndFinancialInstitutionIdentification6)
+		r.SttlmPlc.DeepCopyInto(out.SttlmPlc)
+	}
+	if r.Prtry != nil {
+		out.Prtry = make([]ProprietaryAgent4, len(r.Prtry))
+		for i := range r.Prtry {
+			r.Prtry[i].DeepCopyInto(&out.Prtry[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *TransactionCriteria5Choice) DeepCopy() *TransactionCriteria5Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(TransactionCriteria5Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *TransactionCriteria5Choice) DeepCopyInto(out *TransactionCriteria5Choice) {
+	*out = *r
+	r.NewCrit.DeepCopyInto(&out.NewCrit)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *TransactionCriteria8) DeepCopy() *TransactionCriteria8 {
+	if r == nil {
+		return nil
+	}
+	out := new(TransactionCriteria8)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *TransactionCriteria8) DeepCopyInto(out *TransactionCriteria8) {
+	*out = *r
+	if r.NewQryNm != nil {
+		out.NewQryNm = new(common.Max35Text)
+		*out.NewQryNm = *r.NewQryNm
+	}
+	if r.SchCrit != nil {
+		out.SchCrit = make([]TransactionSearchCriteria8, len(r.SchCrit))
+		for i := range r.SchCrit {
+			r.SchCrit[i].DeepCopyInto(&out.SchCrit[i])
+		}
+	}
+	if r.StmtRpt != nil {
+		out.StmtRpt = new(ReportIndicator1Code)
+		*out.StmtRpt = *r.StmtRpt
+	}
+	if r.RtrCrit != nil {
+		out.RtrCrit = new(TransactionReturnCriteria5)
+		r.RtrCrit.DeepCopyInto(out.RtrCrit)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *TransactionDates3) DeepCopy() *TransactionDates3 {
+	if r == nil {
+		return nil
+	}
+	out := new(TransactionDates3)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *TransactionDates3) DeepCopyInto(out *TransactionDates3) {
+	*out = *r
+	if r.AccptncDtTm != nil {
+		out.AccptncDtTm = new(common.ISODateTime)
+		*out.AccptncDtTm = *r.AccptncDtTm
+	}
+	if r.TradActvtyCtrctlSttlmDt != nil {
+		out.TradActvtyCtrctlSttlmDt = new(common.ISODate)
+		*out.TradActvtyCtrctlSttlmDt = *r.TradActvtyCtrctlSttlmDt
+	}
+	if r.TradDt != nil {
+		out.TradDt = new(common.ISODate)
+		*out.TradDt = *r.TradDt
+	}
+	if r.IntrBkSttlmDt != nil {
+		out.IntrBkSttlmDt = new(common.ISODate)
+		*out.IntrBkSttlmDt = *r.IntrBkSttlmDt
+	}
+	if r.StartDt != nil {
+		out.StartDt = new(common.ISODate)
+		*out.StartDt = *r.StartDt
+	}
+	if r.EndDt != nil {
+		out.EndDt = new(common.ISODate)
+		*out.EndDt = *r.EndDt
+	}
+	if r.TxDtTm != nil {
+		out.TxDtTm = new(common.ISODateTime)
+		*out.TxDtTm = *r.TxDtTm
+	}
+	if r.Prtry != nil {
+		out.Prtry = make([]ProprietaryDate3, len(r.Prtry))
+		copy(out.Prtry, r.Prtry)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *TransactionIdentifier1) DeepCopy() *TransactionIdentifier1 {
+	if r == nil {
+		return nil
+	}
+	out := new(TransactionIdentifier1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *TransactionIdentifier1) DeepCopyInto(out *TransactionIdentifier1) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *TransactionInterest4) DeepCopy() *TransactionInterest4 {
+	if r == nil {
+		return nil
+	}
+	out := new(TransactionInterest4)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *TransactionInterest4) DeepCopyInto(out *TransactionInterest4) {
+	*out = *r
+	if r.TtlIntrstAndTaxAmt != nil {
+		out.TtlIntrstAndTaxAmt = new(ActiveOrHistoricCurrencyAndAmount)
+		*out.TtlIntrstAndTaxAmt = *r.TtlIntrstAndTaxAmt
+	}
+	if r.Rcrd != nil {
+		out.Rcrd = make([]InterestRecord2, len(r.Rcrd))
+		for i := range r.Rcrd {
+			r.Rcrd[i].DeepCopyInto(&out.Rcrd[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *TransactionModification5) DeepCopy() *TransactionModification5 {
+	if r == nil {
+		return nil
+	}
+	out := new(TransactionModification5)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *TransactionModification5) DeepCopyInto(out *TransactionModification5) {
+	*out = *r
+	r.PmtId.DeepCopyInto(&out.PmtId)
+	r.NewPmtValSet.DeepCopyInto(&out.NewPmtValSet)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *TransactionOrError4Choice) DeepCopy() *TransactionOrError4Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(TransactionOrError4Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *TransactionOrError4Choice) DeepCopyInto(out *TransactionOrError4Choice) {
+	*out = *r
+	r.Tx.DeepCopyInto(&out.Tx)
+	if r.BizErr != nil {
+		out.BizErr = make([]ErrorHandling5, len(r.BizErr))
+		for i := range r.BizErr {
+			r.BizErr[i].DeepCopyInto(&out.BizErr[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *TransactionParties6) DeepCopy() *TransactionParties6 {
+	if r == nil {
+		return nil
+	}
+	out := new(TransactionParties6)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *TransactionParties6) DeepCopyInto(out *TransactionParties6) {
+	*out = *r
+	if r.InitgPty != nil {
+		out.InitgPty = new(Party40Choice)
+		r.InitgPty.DeepCopyInto(out.InitgPty)
+	}
+	if r.Dbtr != nil {
+		out.Dbtr = new(Party40Choice)
+		r.Dbtr.DeepCopyInto(out.Dbtr)
+	}
+	if r.DbtrAcct != nil {
+		out.DbtrAcct = new(CashAccount38)
+		r.DbtrAcct.DeepCopyInto(out.DbtrAcct)
+	}
+	if r.UltmtDbtr != nil {
+		out.UltmtDbtr = new(Party40Choice)
+		r.UltmtDbtr.DeepCopyInto(out.UltmtDbtr)
+	}
+	if r.Cdtr != nil {
+		out.Cdtr = new(Party40Choice)
+		r.Cdtr.DeepCopyInto(out.Cdtr)
+	}
+	if r.CdtrAcct != nil {
+		out.CdtrAcct = new(CashAccount38)
+		r.CdtrAcct.DeepCopyInto(out.CdtrAcct)
+	}
+	if r.UltmtCdtr != nil {
+		out.UltmtCdtr = new(Party40Choice)
+		r.UltmtCdtr.DeepCopyInto(out.UltmtCdtr)
+	}
+	if r.TradgPty != nil {
+		out.TradgPty = new(Party40Choice)
+		r.TradgPty.DeepCopyInto(out.TradgPty)
+	}
+	if r.Prtry != nil {
+		out.Prtry = make([]ProprietaryParty5, len(r.Prtry))
+		for i := range r.Prtry {
+			r.Prtry[i].DeepCopyInto(&out.Prtry[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *TransactionPrice4Choice) DeepCopy() *TransactionPrice4Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(TransactionPrice4Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *TransactionPrice4Choice) DeepCopyInto(out *TransactionPrice4Choice) {
+	*out = *r
+	if r.Prtry != nil {
+		out.Prtry = make([]ProprietaryPrice2, len(r.Prtry))
+		copy(out.Prtry, r.Prtry)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *TransactionQuantities3Choice) DeepCopy() *TransactionQuantities3Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(TransactionQuantities3Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *TransactionQuantities3Choice) DeepCopyInto(out *TransactionQuantities3Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *TransactionQuery5) DeepCopy() *TransactionQuery5 {
+	if r == nil {
+		return nil
+	}
+	out := new(TransactionQuery5)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *TransactionQuery5) DeepCopyInto(out *TransactionQuery5) {
+	*out = *r
+	if r.QryTp != nil {
+		out.QryTp = new(QueryType2Code)
+		*out.QryTp = *r.QryTp
+	}
+	if r.TxCrit != nil {
+		out.TxCrit = new(TransactionCriteria5Choice)
+		r.TxCrit.DeepCopyInto(out.TxCrit)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *TransactionReferences6) DeepCopy() *TransactionReferences6 {
+	if r == nil {
+		return nil
+	}
+	out := new(TransactionReferences6)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *TransactionReferences6) DeepCopyInto(out *TransactionReferences6) {
+	*out = *r
+	if r.MsgId != nil {
+		out.MsgId = new(common.Max35Text)
+		*out.MsgId = *r.MsgId
+	}
+	if r.AcctSvcrRef != nil {
+		out.AcctSvcrRef = new(common.Max35Text)
+		*out.AcctSvcrRef = *r.AcctSvcrRef
+	}
+	if r.PmtInfId != nil {
+		out.PmtInfId = new(common.Max35Text)
+		*out.PmtInfId = *r.PmtInfId
+	}
+	if r.InstrId != nil {
+		out.InstrId = new(common.Max35Text)
+		*out.InstrId = *r.InstrId
+	}
+	if r.EndToEndId != nil {
+		out.EndToEndId = new(common.Max35Text)
+		*out.EndToEndId = *r.EndToEndId
+	}
+	if r.UETR != nil {
+		out.UETR = new(common.UUIDv4Identifier)
+		*out.UETR = *r.UETR
+	}
+	if r.TxId != nil {
+		out.TxId = new(common.Max35Text)
+		*out.TxId = *r.TxId
+	}
+	if r.MndtId != nil {
+		out.MndtId = new(common.Max35Text)
+		*out.MndtId = *r.MndtId
+	}
+	if r.ChqNb != nil {
+		out.ChqNb = new(common.Max35Text)
+		*out.ChqNb = *r.ChqNb
+	}
+	if r.ClrSysRef != nil {
+		out.ClrSysRef = new(common.Max35Text)
+		*out.ClrSysRef = *r.ClrSysRef
+	}
+	if r.AcctOwnrTxId != nil {
+		out.AcctOwnrTxId = new(common.Max35Text)
+		*out.AcctOwnrTxId = *r.AcctOwnrTxId
+	}
+	if r.AcctSvcrTxId != nil {
+		out.AcctSvcrTxId = new(common.Max35Text)
+		*out.AcctSvcrTxId = *r.AcctSvcrTxId
+	}
+	if r.MktInfrstrctrTxId != nil {
+		out.MktInfrstrctrTxId = new(common.Max35Text)
+		*out.MktInfrstrctrTxId = *r.MktInfrstrctrTxId
+	}
+	if r.PrcgId != nil {
+		out.PrcgId = new(common.Max35Text)
+		*out.PrcgId = *r.PrcgId
+	}
+	if r.Prtry != nil {
+		out.Prtry = make([]ProprietaryReference1, len(r.Prtry))
+		copy(out.Prtry, r.Prtry)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *TransactionReport5) DeepCopy() *TransactionReport5 {
+	if r == nil {
+		return nil
+	}
+	out := new(TransactionReport5)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *TransactionReport5) DeepCopyInto(out *TransactionReport5) {
+	*out = *r
+	r.PmtId.DeepCopyInto(&out.PmtId)
+	r.TxOrErr.DeepCopyInto(&out.TxOrErr)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *TransactionReportOrError4Choice) DeepCopy() *TransactionReportOrError4Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(TransactionReportOrError4Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *TransactionReportOrError4Choice) DeepCopyInto(out *TransactionReportOrError4Choice) {
+	*out = *r
+	r.BizRpt.DeepCopyInto(&out.BizRpt)
+	if r.OprlErr != nil {
+		out.OprlErr = make([]ErrorHandling5, len(r.OprlErr))
+		for i := range r.OprlErr {
+			r.OprlErr[i].DeepCopyInto(&out.OprlErr[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *TransactionReturnCriteria5) DeepCopy() *TransactionReturnCriteria5 {
+	if r == nil {
+		return nil
+	}
+	out := new(TransactionReturnCriteria5)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *TransactionReturnCriteria5) DeepCopyInto(out *TransactionReturnCriteria5) {
+	*out = *r
+	if r.PmtToRtrCrit != nil {
+		out.PmtToRtrCrit = new(SystemReturnCriteria2)
+		*out.PmtToRtrCrit = *r.PmtToRtrCrit
+	}
+	if r.PmtFrRtrCrit != nil {
+		out.PmtFrRtrCrit = new(SystemReturnCriteria2)
+		*out.PmtFrRtrCrit = *r.PmtFrRtrCrit
+	}
+	if r.AcctCshNtryRtrCrit != nil {
+		out.AcctCshNtryRtrCrit = new(AccountCashEntryReturnCriteria3)
+		*out.AcctCshNtryRtrCrit = *r.AcctCshNtryRtrCrit
+	}
+	if r.PmtRtrCrit != nil {
+		out.PmtRtrCrit = new(PaymentReturnCriteria4)
+		r.PmtRtrCrit.DeepCopyInto(out.PmtRtrCrit)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *TransactionSearchCriteria8) DeepCopy() *TransactionSearchCriteria8 {
+	if r == nil {
+		return nil
+	}
+	out := new(TransactionSearchCriteria8)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *TransactionSearchCriteria8) DeepCopyInto(out *TransactionSearchCriteria8) {
+	*out = *r
+	if r.PmtTo != nil {
+		out.PmtTo = make([]SystemSearch4, len(r.PmtTo))
+		for i := range r.PmtTo {
+			r.PmtTo[i].DeepCopyInto(&out.PmtTo[i])
+		}
+	}
+	if r.PmtFr != nil {
+		out.PmtFr = make([]SystemSearch4, len(r.PmtFr))
+		for i := range r.PmtFr {
+			r.PmtFr[i].DeepCopyInto(&out.PmtFr[i])
+		}
+	}
+	if r.PmtSch != nil {
+		out.PmtSch = new(PaymentSearch8)
+		r.PmtSch.DeepCopyInto(out.PmtSch)
+	}
+	if r.AcctNtrySch != nil {
+		out.AcctNtrySch = new(CashAccountEntrySearch6)
+		r.AcctNtrySch.DeepCopyInto(out.AcctNtrySch)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *Transactions8) DeepCopy() *Transactions8 {
+	if r == nil {
+		return nil
+	}
+	out := new(Transactions8)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *Transactions8) DeepCopyInto(out *Transactions8) {
+	*out = *r
+	if r.PmtCmonInf != nil {
+		out.PmtCmonInf = new(PaymentCommon4)
+		r.PmtCmonInf.DeepCopyInto(out.PmtCmonInf)
+	}
+	if r.TxsSummry != nil {
+		out.TxsSummry = new(NumberAndSumOfTransactions2)
+		r.TxsSummry.DeepCopyInto(out.TxsSummry)
+	}
+	if r.TxRpt != nil {
+		out.TxRpt = make([]TransactionReport5, len(r.TxRpt))
+		for i := range r.TxRpt {
+			r.TxRpt[i].DeepCopyInto(&out.TxRpt[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *UnableToApplyIncorrect1) DeepCopy() *UnableToApplyIncorrect1 {
+	if r == nil {
+		return nil
+	}
+	out := new(UnableToApplyIncorrect1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *UnableToApplyIncorrect1) DeepCopyInto(out *UnableToApplyIncorrect1) {
+	*out = *r
+	if r.AddtlIncrrctInf != nil {
+		out.AddtlIncrrctInf = new(common.Max140Text)
+		*out.AddtlIncrrctInf = *r.AddtlIncrrctInf
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *UnableToApplyJustification3Choice) DeepCopy() *UnableToApplyJustification3Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(UnableToApplyJustification3Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *UnableToApplyJustification3Choice) DeepCopyInto(out *UnableToApplyJustification3Choice) {
+	*out = *r
+	r.MssngOrIncrrctInf.DeepCopyInto(&out.MssngOrIncrrctInf)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *UnableToApplyMissing1) DeepCopy() *UnableToApplyMissing1 {
+	if r == nil {
+		return nil
+	}
+	out := new(UnableToApplyMissing1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *UnableToApplyMissing1) DeepCopyInto(out *UnableToApplyMissing1) {
+	*out = *r
+	if r.AddtlMssngInf != nil {
+		out.AddtlMssngInf = new(common.Max140Text)
+		*out.AddtlMssngInf = *r.AddtlMssngInf
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *UnableToApplyV08) DeepCopy() *UnableToApplyV08 {
+	if r == nil {
+		return nil
+	}
+	out := new(UnableToApplyV08)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *UnableToApplyV08) DeepCopyInto(out *UnableToApplyV08) {
+	*out = *r
+	r.Assgnmt.DeepCopyInto(&out.Assgnmt)
+	if r.Case != nil {
+		out.Case = new(Case5)
+		r.Case.DeepCopyInto(out.Case)
+	}
+	r.Undrlyg.DeepCopyInto(&out.Undrlyg)
+	r.Justfn.DeepCopyInto(&out.Justfn)
+	if r.SplmtryData != nil {
+		out.SplmtryData = make([]SupplementaryData1, len(r.SplmtryData))
+		for i := range r.SplmtryData {
+			r.SplmtryData[i].DeepCopyInto(&out.SplmtryData[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *UnderlyingGroupInformation1) DeepCopy() *UnderlyingGroupInformation1 {
+	if r == nil {
+		return nil
+	}
+	out := new(UnderlyingGroupInformation1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *UnderlyingGroupInformation1) DeepCopyInto(out *UnderlyingGroupInformation1) {
+	*out = *r
+	if r.OrgnlCreDtTm != nil {
+		out.OrgnlCreDtTm = new(common.ISODateTime)
+		*out.OrgnlCreDtTm = *r.OrgnlCreDtTm
+	}
+	if r.OrgnlMsgDlvryChanl != nil {
+		out.OrgnlMsgDlvryChanl = new(common.Max35Text)
+		*out.OrgnlMsgDlvryChanl = *r.OrgnlMsgDlvryChanl
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *UnderlyingPaymentInstruction6) DeepCopy() *UnderlyingPaymentInstruction6 {
+	if r == nil {
+		return nil
+	}
+	out := new(UnderlyingPaymentInstruction6)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *UnderlyingPaymentInstruction6) DeepCopyInto(out *UnderlyingPaymentInstruction6) {
+	*out = *r
+	if r.OrgnlGrpInf != nil {
+		out.OrgnlGrpInf = new(UnderlyingGroupInformation1)
+		r.OrgnlGrpInf.DeepCopyInto(out.OrgnlGrpInf)
+	}
+	if r.OrgnlPmtInfId != nil {
+		out.OrgnlPmtInfId = new(common.Max35Text)
+		*out.OrgnlPmtInfId = *r.OrgnlPmtInfId
+	}
+	if r.OrgnlInstrId != nil {
+		out.OrgnlInstrId = new(common.Max35Text)
+		*out.OrgnlInstrId = *r.OrgnlInstrId
+	}
+	if r.OrgnlEndToEndId != nil {
+		out.OrgnlEndToEndId = new(common.Max35Text)
+		*out.OrgnlEndToEndId = *r.OrgnlEndToEndId
+	}
+	if r.OrgnlUETR != nil {
+		out.OrgnlUETR = new(common.UUIDv4Identifier)
+		*out.OrgnlUETR = *r.OrgnlUETR
+	}
+	if r.ReqdExctnDt != nil {
+		out.ReqdExctnDt = new(DateAndDateTime2Choice)
+		*out.ReqdExctnDt = *r.ReqdExctnDt
+	}
+	if r.ReqdColltnDt != nil {
+		out.ReqdColltnDt = new(common.ISODate)
+		*out.ReqdColltnDt = *r.ReqdColltnDt
+	}
+	if r.OrgnlTxRef != nil {
+		out.OrgnlTxRef = new(OriginalTransactionReference31)
+		r.OrgnlTxRef.DeepCopyInto(out.OrgnlTxRef)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *UnderlyingPaymentTransaction5) DeepCopy() *UnderlyingPaymentTransaction5 {
+	if r == nil {
+		return nil
+	}
+	out := new(UnderlyingPaymentTransaction5)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *UnderlyingPaymentTransaction5) DeepCopyInto(out *UnderlyingPaymentTransaction5) {
+	*out = *r
+	if r.OrgnlGrpInf != nil {
+		out.OrgnlGrpInf = new(UnderlyingGroupInformation1)
+		r.OrgnlGrpInf.DeepCopyInto(out.OrgnlGrpInf)
+	}
+	if r.OrgnlInstrId != nil {
+		out.OrgnlInstrId = new(common.Max35Text)
+		*out.OrgnlInstrId = *r.OrgnlInstrId
+	}
+	if r.OrgnlEndToEndId != nil {
+		out.OrgnlEndToEndId = new(common.Max35Text)
+		*out.OrgnlEndToEndId = *r.OrgnlEndToEndId
+	}
+	if r.OrgnlTxId != nil {
+		out.OrgnlTxId = new(common.Max35Text)
+		*out.OrgnlTxId = *r.OrgnlTxId
+	}
+	if r.OrgnlUETR != nil {
+		out.OrgnlUETR = new(common.UUIDv4Identifier)
+		*out.OrgnlUETR = *r.OrgnlUETR
+	}
+	if r.OrgnlTxRef != nil {
+		out.OrgnlTxRef = new(OriginalTransactionReference31)
+		r.OrgnlTxRef.DeepCopyInto(out.OrgnlTxRef)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *UnderlyingStatementEntry3) DeepCopy() *UnderlyingStatementEntry3 {
+	if r == nil {
+		return nil
+	}
+	out := new(UnderlyingStatementEntry3)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *UnderlyingStatementEntry3) DeepCopyInto(out *UnderlyingStatementEntry3) {
+	*out = *r
+	if r.OrgnlGrpInf != nil {
+		out.OrgnlGrpInf = new(OriginalGroupInformation29)
+		r.OrgnlGrpInf.DeepCopyInto(out.OrgnlGrpInf)
+	}
+	if r.OrgnlStmtId != nil {
+		out.OrgnlStmtId = new(common.Max35Text)
+		*out.OrgnlStmtId = *r.OrgnlStmtId
+	}
+	if r.OrgnlNtryId != nil {
+		out.OrgnlNtryId = new(common.Max35Text)
+		*out.OrgnlNtryId = *r.OrgnlNtryId
+	}
+	if r.OrgnlUETR != nil {
+		out.OrgnlUETR = new(common.UUIDv4Identifier)
+		*out.OrgnlUETR = *r.OrgnlUETR
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *UnderlyingTransaction23) DeepCopy() *UnderlyingTransaction23 {
+	if r == nil {
+		return nil
+	}
+	out := new(UnderlyingTransaction23)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *UnderlyingTransaction23) DeepCopyInto(out *UnderlyingTransaction23) {
+	*out = *r
+	if r.OrgnlGrpInfAndCxl != nil {
+		out.OrgnlGrpInfAndCxl = new(OriginalGroupHeader15)
+		r.OrgnlGrpInfAndCxl.DeepCopyInto(out.OrgnlGrpInfAndCxl)
+	}
+	if r.TxInf != nil {
+		out.TxInf = make([]PaymentTransaction106, len(r.TxInf))
+		for i := range r.TxInf {
+			r.TxInf[i].DeepCopyInto(&out.TxInf[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *UnderlyingTransaction6Choice) DeepCopy() *UnderlyingTransaction6Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(UnderlyingTransaction6Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *UnderlyingTransaction6Choice) DeepCopyInto(out *UnderlyingTransaction6Choice) {
+	*out = *r
+	r.Initn.DeepCopyInto(&out.Initn)
+	r.IntrBk.DeepCopyInto(&out.IntrBk)
+	r.StmtNtry.DeepCopyInto(&out.StmtNtry)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *YieldedOrValueType1Choice) DeepCopy() *YieldedOrValueType1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(YieldedOrValueType1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *YieldedOrValueType1Choice) DeepCopyInto(out *YieldedOrValueType1Choice) {
+	*out = *r
+}
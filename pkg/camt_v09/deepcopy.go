@@ -0,0 +1,4234 @@
+// Code generated by deepcopy-gen. DO NOT EDIT.
+
+package camt_v09
+
+import (
+	"github.com/moov-io/iso20022/pkg/common"
+)
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *AccountIdentification4Choice) DeepCopy() *AccountIdentification4Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(AccountIdentification4Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *AccountIdentification4Choice) DeepCopyInto(out *AccountIdentification4Choice) {
+	*out = *r
+	r.Othr.DeepCopyInto(&out.Othr)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *AccountSchemeName1Choice) DeepCopy() *AccountSchemeName1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(AccountSchemeName1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *AccountSchemeName1Choice) DeepCopyInto(out *AccountSchemeName1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ActiveCurrencyAndAmount) DeepCopy() *ActiveCurrencyAndAmount {
+	if r == nil {
+		return nil
+	}
+	out := new(ActiveCurrencyAndAmount)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ActiveCurrencyAndAmount) DeepCopyInto(out *ActiveCurrencyAndAmount) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ActiveOrHistoricCurrencyAndAmount) DeepCopy() *ActiveOrHistoricCurrencyAndAmount {
+	if r == nil {
+		return nil
+	}
+	out := new(ActiveOrHistoricCurrencyAndAmount)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ActiveOrHistoricCurrencyAndAmount) DeepCopyInto(out *ActiveOrHistoricCurrencyAndAmount) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *AdditionalPaymentInformationV09) DeepCopy() *AdditionalPaymentInformationV09 {
+	if r == nil {
+		return nil
+	}
+	out := new(AdditionalPaymentInformationV09)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *AdditionalPaymentInformationV09) DeepCopyInto(out *AdditionalPaymentInformationV09) {
+	*out = *r
+	r.Assgnmt.DeepCopyInto(&out.Assgnmt)
+	if r.Case != nil {
+		out.Case = new(Case5)
+		r.Case.DeepCopyInto(out.Case)
+	}
+	r.Undrlyg.DeepCopyInto(&out.Undrlyg)
+	r.Inf.DeepCopyInto(&out.Inf)
+	if r.SplmtryData != nil {
+		out.SplmtryData = make([]SupplementaryData1, len(r.SplmtryData))
+		for i := range r.SplmtryData {
+			r.SplmtryData[i].DeepCopyInto(&out.SplmtryData[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *AddressType3Choice) DeepCopy() *AddressType3Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(AddressType3Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *AddressType3Choice) DeepCopyInto(out *AddressType3Choice) {
+	*out = *r
+	r.Prtry.DeepCopyInto(&out.Prtry)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *AmendmentInformationDetails13) DeepCopy() *AmendmentInformationDetails13 {
+	if r == nil {
+		return nil
+	}
+	out := new(AmendmentInformationDetails13)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *AmendmentInformationDetails13) DeepCopyInto(out *AmendmentInformationDetails13) {
+	*out = *r
+	if r.OrgnlMndtId != nil {
+		out.OrgnlMndtId = new(common.Max35Text)
+		*out.OrgnlMndtId = *r.OrgnlMndtId
+	}
+	if r.OrgnlCdtrSchmeId != nil {
+		out.OrgnlCdtrSchmeId = new(PartyIdentification135)
+		r.OrgnlCdtrSchmeId.DeepCopyInto(out.OrgnlCdtrSchmeId)
+	}
+	if r.OrgnlCdtrAgt != nil {
+		out.OrgnlCdtrAgt = new(BranchAndFinancialInstitutionIdentification6)
+		r.OrgnlCdtrAgt.DeepCopyInto(out.OrgnlCdtrAgt)
+	}
+	if r.OrgnlCdtrAgtAcct != nil {
+		out.OrgnlCdtrAgtAcct = new(CashAccount38)
+		r.OrgnlCdtrAgtAcct.DeepCopyInto(out.OrgnlCdtrAgtAcct)
+	}
+	if r.OrgnlDbtr != nil {
+		out.OrgnlDbtr = new(PartyIdentification135)
+		r.OrgnlDbtr.DeepCopyInto(out.OrgnlDbtr)
+	}
+	if r.OrgnlDbtrAcct != nil {
+		out.OrgnlDbtrAcct = new(CashAccount38)
+		r.OrgnlDbtrAcct.DeepCopyInto(out.OrgnlDbtrAcct)
+	}
+	if r.OrgnlDbtrAgt != nil {
+		out.OrgnlDbtrAgt = new(BranchAndFinancialInstitutionIdentification6)
+		r.OrgnlDbtrAgt.DeepCopyInto(out.OrgnlDbtrAgt)
+	}
+	if r.OrgnlDbtrAgtAcct != nil {
+		out.OrgnlDbtrAgtAcct = new(CashAccount38)
+		r.OrgnlDbtrAgtAcct.DeepCopyInto(out.OrgnlDbtrAgtAcct)
+	}
+	if r.OrgnlFnlColltnDt != nil {
+		out.OrgnlFnlColltnDt = new(common.ISODate)
+		*out.OrgnlFnlColltnDt = *r.OrgnlFnlColltnDt
+	}
+	if r.OrgnlFrqcy != nil {
+		out.OrgnlFrqcy = new(Frequency36Choice)
+		*out.OrgnlFrqcy = *r.OrgnlFrqcy
+	}
+	if r.OrgnlRsn != nil {
+		out.OrgnlRsn = new(MandateSetupReason1Choice)
+		*out.OrgnlRsn = *r.OrgnlRsn
+	}
+	if r.OrgnlTrckgDays != nil {
+		out.OrgnlTrckgDays = new(common.Exact2NumericText)
+		*out.OrgnlTrckgDays = *r.OrgnlTrckgDays
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *AmountType4Choice) DeepCopy() *AmountType4Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(AmountType4Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *AmountType4Choice) DeepCopyInto(out *AmountType4Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *BranchAndFinancialInstitutionIdentification6) DeepCopy() *BranchAndFinancialInstitutionIdentification6 {
+	if r == nil {
+		return nil
+	}
+	out := new(BranchAndFinancialInstitutionIdentification6)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *BranchAndFinancialInstitutionIdentification6) DeepCopyInto(out *BranchAndFinancialInstitutionIdentification6) {
+	*out = *r
+	r.FinInstnId.DeepCopyInto(&out.FinInstnId)
+	if r.BrnchId != nil {
+		out.BrnchId = new(BranchData3)
+		r.BrnchId.DeepCopyInto(out.BrnchId)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *BranchData3) DeepCopy() *BranchData3 {
+	if r == nil {
+		return nil
+	}
+	out := new(BranchData3)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *BranchData3) DeepCopyInto(out *BranchData3) {
+	*out = *r
+	if r.Id != nil {
+		out.Id = new(common.Max35Text)
+		*out.Id = *r.Id
+	}
+	if r.LEI != nil {
+		out.LEI = new(common.LEIIdentifier)
+		*out.LEI = *r.LEI
+	}
+	if r.Nm != nil {
+		out.Nm = new(common.Max140Text)
+		*out.Nm = *r.Nm
+	}
+	if r.PstlAdr != nil {
+		out.PstlAdr = new(PostalAddress24)
+		r.PstlAdr.DeepCopyInto(out.PstlAdr)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CancellationReason33Choice) DeepCopy() *CancellationReason33Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(CancellationReason33Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CancellationReason33Choice) DeepCopyInto(out *CancellationReason33Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CancellationStatusReason3Choice) DeepCopy() *CancellationStatusReason3Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(CancellationStatusReason3Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CancellationStatusReason3Choice) DeepCopyInto(out *CancellationStatusReason3Choice) {
+	*out = *r
+	if r.Cd != nil {
+		out.Cd = new(ExternalPaymentCancellationRejection1Code)
+		*out.Cd = *r.Cd
+	}
+	if r.Prtry != nil {
+		out.Prtry = new(common.Max35Text)
+		*out.Prtry = *r.Prtry
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CancellationStatusReason4) DeepCopy() *CancellationStatusReason4 {
+	if r == nil {
+		return nil
+	}
+	out := new(CancellationStatusReason4)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CancellationStatusReason4) DeepCopyInto(out *CancellationStatusReason4) {
+	*out = *r
+	if r.Orgtr != nil {
+		out.Orgtr = new(PartyIdentification135)
+		r.Orgtr.DeepCopyInto(out.Orgtr)
+	}
+	if r.Rsn != nil {
+		out.Rsn = new(CancellationStatusReason3Choice)
+		r.Rsn.DeepCopyInto(out.Rsn)
+	}
+	if r.AddtlInf != nil {
+		out.AddtlInf = make([]common.Max105Text, len(r.AddtlInf))
+		copy(out.AddtlInf, r.AddtlInf)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *Case5) DeepCopy() *Case5 {
+	if r == nil {
+		return nil
+	}
+	out := new(Case5)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *Case5) DeepCopyInto(out *Case5) {
+	*out = *r
+	r.Cretr.DeepCopyInto(&out.Cretr)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CaseAssignment5) DeepCopy() *CaseAssignment5 {
+	if r == nil {
+		return nil
+	}
+	out := new(CaseAssignment5)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CaseAssignment5) DeepCopyInto(out *CaseAssignment5) {
+	*out = *r
+	r.Assgnr.DeepCopyInto(&out.Assgnr)
+	r.Assgne.DeepCopyInto(&out.Assgne)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CashAccount38) DeepCopy() *CashAccount38 {
+	if r == nil {
+		return nil
+	}
+	out := new(CashAccount38)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CashAccount38) DeepCopyInto(out *CashAccount38) {
+	*out = *r
+	r.Id.DeepCopyInto(&out.Id)
+	if r.Tp != nil {
+		out.Tp = new(CashAccountType2Choice)
+		*out.Tp = *r.Tp
+	}
+	if r.Ccy != nil {
+		out.Ccy = new(common.ActiveOrHistoricCurrencyCode)
+		*out.Ccy = *r.Ccy
+	}
+	if r.Nm != nil {
+		out.Nm = new(common.Max70Text)
+		*out.Nm = *r.Nm
+	}
+	if r.Prxy != nil {
+		out.Prxy = new(ProxyAccountIdentification1)
+		r.Prxy.DeepCopyInto(out.Prxy)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CashAccountType2Choice) DeepCopy() *CashAccountType2Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(CashAccountType2Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CashAccountType2Choice) DeepCopyInto(out *CashAccountType2Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CategoryPurpose1Choice) DeepCopy() *CategoryPurpose1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(CategoryPurpose1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CategoryPurpose1Choice) DeepCopyInto(out *CategoryPurpose1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ChargeType3Choice) DeepCopy() *ChargeType3Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(ChargeType3Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ChargeType3Choice) DeepCopyInto(out *ChargeType3Choice) {
+	*out = *r
+	if r.Cd != nil {
+		out.Cd = new(ExternalChargeType1Code)
+		*out.Cd = *r.Cd
+	}
+	if r.Prtry != nil {
+		out.Prtry = new(GenericIdentification3)
+		r.Prtry.DeepCopyInto(out.Prtry)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *Charges6) DeepCopy() *Charges6 {
+	if r == nil {
+		return nil
+	}
+	out := new(Charges6)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *Charges6) DeepCopyInto(out *Charges6) {
+	*out = *r
+	if r.TtlChrgsAndTaxAmt != nil {
+		out.TtlChrgsAndTaxAmt = new(ActiveOrHistoricCurrencyAndAmount)
+		*out.TtlChrgsAndTaxAmt = *r.TtlChrgsAndTaxAmt
+	}
+	if r.Rcrd != nil {
+		out.Rcrd = make([]ChargesRecord3, len(r.Rcrd))
+		for i := range r.Rcrd {
+			r.Rcrd[i].DeepCopyInto(&out.Rcrd[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *Charges7) DeepCopy() *Charges7 {
+	if r == nil {
+		return nil
+	}
+	out := new(Charges7)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *Charges7) DeepCopyInto(out *Charges7) {
+	*out = *r
+	r.Agt.DeepCopyInto(&out.Agt)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ChargesRecord3) DeepCopy() *ChargesRecord3 {
+	if r == nil {
+		return nil
+	}
+	out := new(ChargesRecord3)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ChargesRecord3) DeepCopyInto(out *ChargesRecord3) {
+	*out = *r
+	if r.Amt != nil {
+		out.Amt = new(ActiveOrHistoricCurrencyAndAmount)
+		*out.Amt = *r.Amt
+	}
+	if r.CdtDbtInd != nil {
+		out.CdtDbtInd = new(common.CreditDebitCode)
+		*out.CdtDbtInd = *r.CdtDbtInd
+	}
+	if r.Tp != nil {
+		out.Tp = new(ChargeType3Choice)
+		r.Tp.DeepCopyInto(out.Tp)
+	}
+	if r.Br != nil {
+		out.Br = new(ChargeBearerType1Code)
+		*out.Br = *r.Br
+	}
+	if r.Agt != nil {
+		out.Agt = new(BranchAndFinancialInstitutionIdentification6)
+		r.Agt.DeepCopyInto(out.Agt)
+	}
+	if r.Tax != nil {
+		out.Tax = new(TaxCharges2)
+		r.Tax.DeepCopyInto(out.Tax)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ClaimNonReceipt2) DeepCopy() *ClaimNonReceipt2 {
+	if r == nil {
+		return nil
+	}
+	out := new(ClaimNonReceipt2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ClaimNonReceipt2) DeepCopyInto(out *ClaimNonReceipt2) {
+	*out = *r
+	if r.OrgnlNxtAgt != nil {
+		out.OrgnlNxtAgt = new(BranchAndFinancialInstitutionIdentification6)
+		r.OrgnlNxtAgt.DeepCopyInto(out.OrgnlNxtAgt)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ClaimNonReceipt2Choice) DeepCopy() *ClaimNonReceipt2Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(ClaimNonReceipt2Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ClaimNonReceipt2Choice) DeepCopyInto(out *ClaimNonReceipt2Choice) {
+	*out = *r
+	if r.Accptd != nil {
+		out.Accptd = new(ClaimNonReceipt2)
+		r.Accptd.DeepCopyInto(out.Accptd)
+	}
+	if r.Rjctd != nil {
+		out.Rjctd = new(ClaimNonReceiptRejectReason1Choice)
+		r.Rjctd.DeepCopyInto(out.Rjctd)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ClaimNonReceiptRejectReason1Choice) DeepCopy() *ClaimNonReceiptRejectReason1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(ClaimNonReceiptRejectReason1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ClaimNonReceiptRejectReason1Choice) DeepCopyInto(out *ClaimNonReceiptRejectReason1Choice) {
+	*out = *r
+	if r.Cd != nil {
+		out.Cd = new(ExternalClaimNonReceiptRejection1Code)
+		*out.Cd = *r.Cd
+	}
+	if r.Prtry != nil {
+		out.Prtry = new(common.Max35Text)
+		*out.Prtry = *r.Prtry
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ClearingSystemIdentification2Choice) DeepCopy() *ClearingSystemIdentification2Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(ClearingSystemIdentification2Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ClearingSystemIdentification2Choice) DeepCopyInto(out *ClearingSystemIdentification2Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ClearingSystemIdentification3Choice) DeepCopy() *ClearingSystemIdentification3Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(ClearingSystemIdentification3Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ClearingSystemIdentification3Choice) DeepCopyInto(out *ClearingSystemIdentification3Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ClearingSystemMemberIdentification2) DeepCopy() *ClearingSystemMemberIdentification2 {
+	if r == nil {
+		return nil
+	}
+	out := new(ClearingSystemMemberIdentification2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ClearingSystemMemberIdentification2) DeepCopyInto(out *ClearingSystemMemberIdentification2) {
+	*out = *r
+	if r.ClrSysId != nil {
+		out.ClrSysId = new(ClearingSystemIdentification2Choice)
+		*out.ClrSysId = *r.ClrSysId
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *Compensation2) DeepCopy() *Compensation2 {
+	if r == nil {
+		return nil
+	}
+	out := new(Compensation2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *Compensation2) DeepCopyInto(out *Compensation2) {
+	*out = *r
+	r.DbtrAgt.DeepCopyInto(&out.DbtrAgt)
+	r.CdtrAgt.DeepCopyInto(&out.CdtrAgt)
+	r.Rsn.DeepCopyInto(&out.Rsn)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CompensationReason1Choice) DeepCopy() *CompensationReason1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(CompensationReason1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CompensationReason1Choice) DeepCopyInto(out *CompensationReason1Choice) {
+	*out = *r
+	if r.Cd != nil {
+		out.Cd = new(ExternalPaymentCompensationReason1Code)
+		*out.Cd = *r.Cd
+	}
+	if r.Prtry != nil {
+		out.Prtry = new(common.Max35Text)
+		*out.Prtry = *r.Prtry
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *Contact4) DeepCopy() *Contact4 {
+	if r == nil {
+		return nil
+	}
+	out := new(Contact4)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *Contact4) DeepCopyInto(out *Contact4) {
+	*out = *r
+	if r.NmPrfx != nil {
+		out.NmPrfx = new(common.NamePrefix2Code)
+		*out.NmPrfx = *r.NmPrfx
+	}
+	if r.Nm != nil {
+		out.Nm = new(common.Max140Text)
+		*out.Nm = *r.Nm
+	}
+	if r.PhneNb != nil {
+		out.PhneNb = new(common.PhoneNumber)
+		*out.PhneNb = *r.PhneNb
+	}
+	if r.MobNb != nil {
+		out.MobNb = new(common.PhoneNumber)
+		*out.MobNb = *r.MobNb
+	}
+	if r.FaxNb != nil {
+		out.FaxNb = new(common.PhoneNumber)
+		*out.FaxNb = *r.FaxNb
+	}
+	if r.EmailAdr != nil {
+		out.EmailAdr = new(common.Max2048Text)
+		*out.EmailAdr = *r.EmailAdr
+	}
+	if r.EmailPurp != nil {
+		out.EmailPurp = new(common.Max35Text)
+		*out.EmailPurp = *r.EmailPurp
+	}
+	if r.JobTitl != nil {
+		out.JobTitl = new(common.Max35Text)
+		*out.JobTitl = *r.JobTitl
+	}
+	if r.Rspnsblty != nil {
+		out.Rspnsblty = new(common.Max35Text)
+		*out.Rspnsblty = *r.Rspnsblty
+	}
+	if r.Dept != nil {
+		out.Dept = new(common.Max70Text)
+		*out.Dept = *r.Dept
+	}
+	if r.Othr != nil {
+		out.Othr = make([]OtherContact1, len(r.Othr))
+		for i := range r.Othr {
+			r.Othr[i].DeepCopyInto(&out.Othr[i])
+		}
+	}
+	if r.PrefrdMtd != nil {
+		out.PrefrdMtd = new(PreferredContactMethod1Code)
+		*out.PrefrdMtd = *r.PrefrdMtd
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ControlData1) DeepCopy() *ControlData1 {
+	if r == nil {
+		return nil
+	}
+	out := new(ControlData1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ControlData1) DeepCopyInto(out *ControlData1) {
+	*out = *r
+	if r.NbOfTxs != nil {
+		out.NbOfTxs = new(common.Max15NumericText)
+		*out.NbOfTxs = *r.NbOfTxs
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CorrectiveGroupInformation1) DeepCopy() *CorrectiveGroupInformation1 {
+	if r == nil {
+		return nil
+	}
+	out := new(CorrectiveGroupInformation1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CorrectiveGroupInformation1) DeepCopyInto(out *CorrectiveGroupInformation1) {
+	*out = *r
+	if r.CreDtTm != nil {
+		out.CreDtTm = new(common.ISODateTime)
+		*out.CreDtTm = *r.CreDtTm
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CorrectiveInterbankTransaction2) DeepCopy() *CorrectiveInterbankTransaction2 {
+	if r == nil {
+		return nil
+	}
+	out := new(CorrectiveInterbankTransaction2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CorrectiveInterbankTransaction2) DeepCopyInto(out *CorrectiveInterbankTransaction2) {
+	*out = *r
+	if r.GrpHdr != nil {
+		out.GrpHdr = new(CorrectiveGroupInformation1)
+		r.GrpHdr.DeepCopyInto(out.GrpHdr)
+	}
+	if r.InstrId != nil {
+		out.InstrId = new(common.Max35Text)
+		*out.InstrId = *r.InstrId
+	}
+	if r.EndToEndId != nil {
+		out.EndToEndId = new(common.Max35Text)
+		*out.EndToEndId = *r.EndToEndId
+	}
+	if r.TxId != nil {
+		out.TxId = new(common.Max35Text)
+		*out.TxId = *r.TxId
+	}
+	if r.UETR != nil {
+		out.UETR = new(common.UUIDv4Identifier)
+		*out.UETR = *r.UETR
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CorrectivePaymentInitiation4) DeepCopy() *CorrectivePaymentInitiation4 {
+	if r == nil {
+		return nil
+	}
+	out := new(CorrectivePaymentInitiation4)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CorrectivePaymentInitiation4) DeepCopyInto(out *CorrectivePaymentInitiation4) {
+	*out = *r
+	if r.GrpHdr != nil {
+		out.GrpHdr = new(CorrectiveGroupInformation1)
+		r.GrpHdr.DeepCopyInto(out.GrpHdr)
+	}
+	if r.PmtInfId != nil {
+		out.PmtInfId = new(common.Max35Text)
+		*out.PmtInfId = *r.PmtInfId
+	}
+	if r.InstrId != nil {
+		out.InstrId = new(common.Max35Text)
+		*out.InstrId = *r.InstrId
+	}
+	if r.EndToEndId != nil {
+		out.EndToEndId = new(common.Max35Text)
+		*out.EndToEndId = *r.EndToEndId
+	}
+	if r.UETR != nil {
+		out.UETR = new(common.UUIDv4Identifier)
+		*out.UETR = *r.UETR
+	}
+	if r.ReqdExctnDt != nil {
+		out.ReqdExctnDt = new(DateAndDateTime2Choice)
+		r.ReqdExctnDt.DeepCopyInto(out.ReqdExctnDt)
+	}
+	if r.ReqdColltnDt != nil {
+		out.ReqdColltnDt = new(common.ISODate)
+		*out.ReqdColltnDt = *r.ReqdColltnDt
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CorrectiveTransaction4Choice) DeepCopy() *CorrectiveTransaction4Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(CorrectiveTransaction4Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CorrectiveTransaction4Choice) DeepCopyInto(out *CorrectiveTransaction4Choice) {
+	*out = *r
+	if r.Initn != nil {
+		out.Initn = new(CorrectivePaymentInitiation4)
+		r.Initn.DeepCopyInto(out.Initn)
+	}
+	if r.IntrBk != nil {
+		out.IntrBk = new(CorrectiveInterbankTransaction2)
+		r.IntrBk.DeepCopyInto(out.IntrBk)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CreditTransferMandateData1) DeepCopy() *CreditTransferMandateData1 {
+	if r == nil {
+		return nil
+	}
+	out := new(CreditTransferMandateData1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CreditTransferMandateData1) DeepCopyInto(out *CreditTransferMandateData1) {
+	*out = *r
+	if r.MndtId != nil {
+		out.MndtId = new(common.Max35Text)
+		*out.MndtId = *r.MndtId
+	}
+	if r.Tp != nil {
+		out.Tp = new(MandateTypeInformation2)
+		r.Tp.DeepCopyInto(out.Tp)
+	}
+	if r.DtOfSgntr != nil {
+		out.DtOfSgntr = new(common.ISODate)
+		*out.DtOfSgntr = *r.DtOfSgntr
+	}
+	if r.DtOfVrfctn != nil {
+		out.DtOfVrfctn = new(common.ISODateTime)
+		*out.DtOfVrfctn = *r.DtOfVrfctn
+	}
+	if r.ElctrncSgntr != nil {
+		out.ElctrncSgntr = new(common.Max10KBinary)
+		*out.ElctrncSgntr = *r.ElctrncSgntr
+		if (*r.ElctrncSgntr) != nil {
+			(*out.ElctrncSgntr) = make(common.Max10KBinary, len((*r.ElctrncSgntr)))
+			copy((*out.ElctrncSgntr), (*r.ElctrncSgntr))
+		}
+	}
+	if r.FrstPmtDt != nil {
+		out.FrstPmtDt = new(common.ISODate)
+		*out.FrstPmtDt = *r.FrstPmtDt
+	}
+	if r.FnlPmtDt != nil {
+		out.FnlPmtDt = new(common.ISODate)
+		*out.FnlPmtDt = *r.FnlPmtDt
+	}
+	if r.Frqcy != nil {
+		out.Frqcy = new(Frequency36Choice)
+		*out.Frqcy = *r.Frqcy
+	}
+	if r.Rsn != nil {
+		out.Rsn = new(MandateSetupReason1Choice)
+		*out.Rsn = *r.Rsn
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CreditorReferenceInformation2) DeepCopy() *CreditorReferenceInformation2 {
+	if r == nil {
+		return nil
+	}
+	out := new(CreditorReferenceInformation2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CreditorReferenceInformation2) DeepCopyInto(out *CreditorReferenceInformation2) {
+	*out = *r
+	if r.Tp != nil {
+		out.Tp = new(CreditorReferenceType2)
+		r.Tp.DeepCopyInto(out.Tp)
+	}
+	if r.Ref != nil {
+		out.Ref = new(common.Max35Text)
+		*out.Ref = *r.Ref
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CreditorReferenceType1Choice) DeepCopy() *CreditorReferenceType1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(CreditorReferenceType1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CreditorReferenceType1Choice) DeepCopyInto(out *CreditorReferenceType1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CreditorReferenceType2) DeepCopy() *CreditorReferenceType2 {
+	if r == nil {
+		return nil
+	}
+	out := new(CreditorReferenceType2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CreditorReferenceType2) DeepCopyInto(out *CreditorReferenceType2) {
+	*out = *r
+	if r.Issr != nil {
+		out.Issr = new(common.Max35Text)
+		*out.Issr = *r.Issr
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CustomerPaymentCancellationRequestV09) DeepCopy() *CustomerPaymentCancellationRequestV09 {
+	if r == nil {
+		return nil
+	}
+	out := new(CustomerPaymentCancellationRequestV09)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CustomerPaymentCancellationRequestV09) DeepCopyInto(out *CustomerPaymentCancellationRequestV09) {
+	*out = *r
+	r.Assgnmt.DeepCopyInto(&out.Assgnmt)
+	if r.Case != nil {
+		out.Case = new(Case5)
+		r.Case.DeepCopyInto(out.Case)
+	}
+	if r.CtrlData != nil {
+		out.CtrlData = new(ControlData1)
+		r.CtrlData.DeepCopyInto(out.CtrlData)
+	}
+	if r.Undrlyg != nil {
+		out.Undrlyg = make([]UnderlyingTransaction27, len(r.Undrlyg))
+		for i := range r.Undrlyg {
+			r.Undrlyg[i].DeepCopyInto(&out.Undrlyg[i])
+		}
+	}
+	if r.SplmtryData != nil {
+		out.SplmtryData = make([]SupplementaryData1, len(r.SplmtryData))
+		for i := range r.SplmtryData {
+			r.SplmtryData[i].DeepCopyInto(&out.SplmtryData[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *DateAndDateTime2Choice) DeepCopy() *DateAndDateTime2Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(DateAndDateTime2Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *DateAndDateTime2Choice) DeepCopyInto(out *DateAndDateTime2Choice) {
+	*out = *r
+	if r.Dt != nil {
+		out.Dt = new(common.ISODate)
+		*out.Dt = *r.Dt
+	}
+	if r.DtTm != nil {
+		out.DtTm = new(common.ISODateTime)
+		*out.DtTm = *r.DtTm
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *DateAndPlaceOfBirth1) DeepCopy() *DateAndPlaceOfBirth1 {
+	if r == nil {
+		return nil
+	}
+	out := new(DateAndPlaceOfBirth1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *DateAndPlaceOfBirth1) DeepCopyInto(out *DateAndPlaceOfBirth1) {
+	*out = *r
+	if r.PrvcOfBirth != nil {
+		out.PrvcOfBirth = new(common.Max35Text)
+		*out.PrvcOfBirth = *r.PrvcOfBirth
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *DatePeriod2) DeepCopy() *DatePeriod2 {
+	if r == nil {
+		return nil
+	}
+	out := new(DatePeriod2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *DatePeriod2) DeepCopyInto(out *DatePeriod2) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *DiscountAmountAndType1) DeepCopy() *DiscountAmountAndType1 {
+	if r == nil {
+		return nil
+	}
+	out := new(DiscountAmountAndType1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *DiscountAmountAndType1) DeepCopyInto(out *DiscountAmountAndType1) {
+	*out = *r
+	if r.Tp != nil {
+		out.Tp = new(DiscountAmountType1Choice)
+		*out.Tp = *r.Tp
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *DiscountAmountType1Choice) DeepCopy() *DiscountAmountType1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(DiscountAmountType1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *DiscountAmountType1Choice) DeepCopyInto(out *DiscountAmountType1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *DocumentAdjustment1) DeepCopy() *DocumentAdjustment1 {
+	if r == nil {
+		return nil
+	}
+	out := new(DocumentAdjustment1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *DocumentAdjustment1) DeepCopyInto(out *DocumentAdjustment1) {
+	*out = *r
+	if r.CdtDbtInd != nil {
+		out.CdtDbtInd = new(common.CreditDebitCode)
+		*out.CdtDbtInd = *r.CdtDbtInd
+	}
+	if r.Rsn != nil {
+		out.Rsn = new(common.Max4Text)
+		*out.Rsn = *r.Rsn
+	}
+	if r.AddtlInf != nil {
+		out.AddtlInf = new(common.Max140Text)
+		*out.AddtlInf = *r.AddtlInf
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *DocumentLineIdentification1) DeepCopy() *DocumentLineIdentification1 {
+	if r == nil {
+		return nil
+	}
+	out := new(DocumentLineIdentification1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *DocumentLineIdentification1) DeepCopyInto(out *DocumentLineIdentification1) {
+	*out = *r
+	if r.Tp != nil {
+		out.Tp = new(DocumentLineType1)
+		r.Tp.DeepCopyInto(out.Tp)
+	}
+	if r.Nb != nil {
+		out.Nb = new(common.Max35Text)
+		*out.Nb = *r.Nb
+	}
+	if r.RltdDt != nil {
+		out.RltdDt = new(common.ISODate)
+		*out.RltdDt = *r.RltdDt
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *DocumentLineInformation1) DeepCopy() *DocumentLineInformation1 {
+	if r == nil {
+		return nil
+	}
+	out := new(DocumentLineInformation1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *DocumentLineInformation1) DeepCopyInto(out *DocumentLineInformation1) {
+	*out = *r
+	if r.Id != nil {
+		out.Id = make([]DocumentLineIdentification1, len(r.Id))
+		for i := range r.Id {
+			r.Id[i].DeepCopyInto(&out.Id[i])
+		}
+	}
+	if r.Desc != nil {
+		out.Desc = new(common.Max2048Text)
+		*out.Desc = *r.Desc
+	}
+	if r.Amt != nil {
+		out.Amt = new(RemittanceAmount3)
+		r.Amt.DeepCopyInto(out.Amt)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *DocumentLineType1) DeepCopy() *DocumentLineType1 {
+	if r == nil {
+		return nil
+	}
+	out := new(DocumentLineType1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *DocumentLineType1) DeepCopyInto(out *DocumentLineType1) {
+	*out = *r
+	if r.Issr != nil {
+		out.Issr = new(common.Max35Text)
+		*out.Issr = *r.Issr
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *DocumentLineType1Choice) DeepCopy() *DocumentLineType1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(DocumentLineType1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *DocumentLineType1Choice) DeepCopyInto(out *DocumentLineType1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *EquivalentAmount2) DeepCopy() *EquivalentAmount2 {
+	if r == nil {
+		return nil
+	}
+	out := new(EquivalentAmount2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *EquivalentAmount2) DeepCopyInto(out *EquivalentAmount2) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *FIToFIPaymentCancellationRequestV09) DeepCopy() *FIToFIPaymentCancellationRequestV09 {
+	if r == nil {
+		return nil
+	}
+	out := new(FIToFIPaymentCancellationRequestV09)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *FIToFIPaymentCancellationRequestV09) DeepCopyInto(out *FIToFIPaymentCancellationRequestV09) {
+	*out = *r
+	r.Assgnmt.DeepCopyInto(&out.Assgnmt)
+	if r.Case != nil {
+		out.Case = new(Case5)
+		r.Case.DeepCopyInto(out.Case)
+	}
+	if r.CtrlData != nil {
+		out.CtrlData = new(ControlData1)
+		r.CtrlData.DeepCopyInto(out.CtrlData)
+	}
+	if r.Undrlyg != nil {
+		out.Undrlyg = make([]UnderlyingTransaction26, len(r.Undrlyg))
+		for i := range r.Undrlyg {
+			r.Undrlyg[i].DeepCopyInto(&out.Undrlyg[i])
+		}
+	}
+	if r.SplmtryData != nil {
+		out.SplmtryData = make([]SupplementaryData1, len(r.SplmtryData))
+		for i := range r.SplmtryData {
+			r.SplmtryData[i].DeepCopyInto(&out.SplmtryData[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *FinancialIdentificationSchemeName1Choice) DeepCopy() *FinancialIdentificationSchemeName1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(FinancialIdentificationSchemeName1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *FinancialIdentificationSchemeName1Choice) DeepCopyInto(out *FinancialIdentificationSchemeName1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *FinancialInstitutionIdentification18) DeepCopy() *FinancialInstitutionIdentification18 {
+	if r == nil {
+		return nil
+	}
+	out := new(FinancialInstitutionIdentification18)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *FinancialInstitutionIdentification18) DeepCopyInto(out *FinancialInstitutionIdentification18) {
+	*out = *r
+	if r.BICFI != nil {
+		out.BICFI = new(common.BICFIDec2014Identifier)
+		*out.BICFI = *r.BICFI
+	}
+	if r.ClrSysMmbId != nil {
+		out.ClrSysMmbId = new(ClearingSystemMemberIdentification2)
+		r.ClrSysMmbId.DeepCopyInto(out.ClrSysMmbId)
+	}
+	if r.LEI != nil {
+		out.LEI = new(common.LEIIdentifier)
+		*out.LEI = *r.LEI
+	}
+	if r.Nm != nil {
+		out.Nm = new(common.Max140Text)
+		*out.Nm = *r.Nm
+	}
+	if r.PstlAdr != nil {
+		out.PstlAdr = new(PostalAddress24)
+		r.PstlAdr.DeepCopyInto(out.PstlAdr)
+	}
+	if r.Othr != nil {
+		out.Othr = new(GenericFinancialIdentification1)
+		r.Othr.DeepCopyInto(out.Othr)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *Frequency36Choice) DeepCopy() *Frequency36Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(Frequency36Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *Frequency36Choice) DeepCopyInto(out *Frequency36Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *FrequencyAndMoment1) DeepCopy() *FrequencyAndMoment1 {
+	if r == nil {
+		return nil
+	}
+	out := new(FrequencyAndMoment1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *FrequencyAndMoment1) DeepCopyInto(out *FrequencyAndMoment1) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *FrequencyPeriod1) DeepCopy() *FrequencyPeriod1 {
+	if r == nil {
+		return nil
+	}
+	out := new(FrequencyPeriod1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *FrequencyPeriod1) DeepCopyInto(out *FrequencyPeriod1) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *Garnishment3) DeepCopy() *Garnishment3 {
+	if r == nil {
+		return nil
+	}
+	out := new(Garnishment3)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *Garnishment3) DeepCopyInto(out *Garnishment3) {
+	*out = *r
+	r.Tp.DeepCopyInto(&out.Tp)
+	if r.Grnshee != nil {
+		out.Grnshee = new(PartyIdentification135)
+		r.Grnshee.DeepCopyInto(out.Grnshee)
+	}
+	if r.GrnshmtAdmstr != nil {
+		out.GrnshmtAdmstr = new(PartyIdentification135)
+		r.GrnshmtAdmstr.DeepCopyInto(out.GrnshmtAdmstr)
+	}
+	if r.RefNb != nil {
+		out.RefNb = new(common.Max140Text)
+		*out.RefNb = *r.RefNb
+	}
+	if r.Dt != nil {
+		out.Dt = new(common.ISODate)
+		*out.Dt = *r.Dt
+	}
+	if r.RmtdAmt != nil {
+		out.RmtdAmt = new(ActiveOrHistoricCurrencyAndAmount)
+		*out.RmtdAmt = *r.RmtdAmt
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *GarnishmentType1) DeepCopy() *GarnishmentType1 {
+	if r == nil {
+		return nil
+	}
+	out := new(GarnishmentType1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *GarnishmentType1) DeepCopyInto(out *GarnishmentType1) {
+	*out = *r
+	if r.Issr != nil {
+		out.Issr = new(common.Max35Text)
+		*out.Issr = *r.Issr
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *GarnishmentType1Choice) DeepCopy() *GarnishmentType1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(GarnishmentType1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *GarnishmentType1Choice) DeepCopyInto(out *GarnishmentType1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *GenericAccountIdentification1) DeepCopy() *GenericAccountIdentification1 {
+	if r == nil {
+		return nil
+	}
+	out := new(GenericAccountIdentification1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *GenericAccountIdentification1) DeepCopyInto(out *GenericAccountIdentification1) {
+	*out = *r
+	if r.SchmeNm != nil {
+		out.SchmeNm = new(AccountSchemeName1Choice)
+		*out.SchmeNm = *r.SchmeNm
+	}
+	if r.Issr != nil {
+		out.Issr = new(common.Max35Text)
+		*out.Issr = *r.Issr
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *GenericFinancialIdentification1) DeepCopy() *GenericFinancialIdentification1 {
+	if r == nil {
+		return nil
+	}
+	out := new(GenericFinancialIdentification1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *GenericFinancialIdentification1) DeepCopyInto(out *GenericFinancialIdentification1) {
+	*out = *r
+	if r.SchmeNm != nil {
+		out.SchmeNm = new(FinancialIdentificationSchemeName1Choice)
+		*out.SchmeNm = *r.SchmeNm
+	}
+	if r.Issr != nil {
+		out.Issr = new(common.Max35Text)
+		*out.Issr = *r.Issr
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *GenericIdentification3) DeepCopy() *GenericIdentification3 {
+	if r == nil {
+		return nil
+	}
+	out := new(GenericIdentification3)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *GenericIdentification3) DeepCopyInto(out *GenericIdentification3) {
+	*out = *r
+	if r.Issr != nil {
+		out.Issr = new(common.Max35Text)
+		*out.Issr = *r.Issr
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *GenericIdentification30) DeepCopy() *GenericIdentification30 {
+	if r == nil {
+		return nil
+	}
+	out := new(GenericIdentification30)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *GenericIdentification30) DeepCopyInto(out *GenericIdentification30) {
+	*out = *r
+	if r.SchmeNm != nil {
+		out.SchmeNm = new(common.Max35Text)
+		*out.SchmeNm = *r.SchmeNm
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *GenericOrganisationIdentification1) DeepCopy() *GenericOrganisationIdentification1 {
+	if r == nil {
+		return nil
+	}
+	out := new(GenericOrganisationIdentification1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *GenericOrganisationIdentification1) DeepCopyInto(out *GenericOrganisationIdentification1) {
+	*out = *r
+	if r.SchmeNm != nil {
+		out.SchmeNm = new(OrganisationIdentificationSchemeName1Choice)
+		*out.SchmeNm = *r.SchmeNm
+	}
+	if r.Issr != nil {
+		out.Issr = new(common.Max35Text)
+		*out.Issr = *r.Issr
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *GenericPersonIdentification1) DeepCopy() *GenericPersonIdentification1 {
+	if r == nil {
+		return nil
+	}
+	out := new(GenericPersonIdentification1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *GenericPersonIdentification1) DeepCopyInto(out *GenericPersonIdentification1) {
+	*out = *r
+	if r.SchmeNm != nil {
+		out.SchmeNm = new(PersonIdentificationSchemeName1Choice)
+		*out.SchmeNm = *r.SchmeNm
+	}
+	if r.Issr != nil {
+		out.Issr = new(common.Max35Text)
+		*out.Issr = *r.Issr
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *InstructionForCreditorAgent1) DeepCopy() *InstructionForCreditorAgent1 {
+	if r == nil {
+		return nil
+	}
+	out := new(InstructionForCreditorAgent1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *InstructionForCreditorAgent1) DeepCopyInto(out *InstructionForCreditorAgent1) {
+	*out = *r
+	if r.Cd != nil {
+		out.Cd = new(Instruction3Code)
+		*out.Cd = *r.Cd
+	}
+	if r.InstrInf != nil {
+		out.InstrInf = new(common.Max140Text)
+		*out.InstrInf = *r.InstrInf
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *InstructionForNextAgent1) DeepCopy() *InstructionForNextAgent1 {
+	if r == nil {
+		return nil
+	}
+	out := new(InstructionForNextAgent1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *InstructionForNextAgent1) DeepCopyInto(out *InstructionForNextAgent1) {
+	*out = *r
+	if r.Cd != nil {
+		out.Cd = new(Instruction4Code)
+		*out.Cd = *r.Cd
+	}
+	if r.InstrInf != nil {
+		out.InstrInf = new(common.Max140Text)
+		*out.InstrInf = *r.InstrInf
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *InvestigationStatus5Choice) DeepCopy() *InvestigationStatus5Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(InvestigationStatus5Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *InvestigationStatus5Choice) DeepCopyInto(out *InvestigationStatus5Choice) {
+	*out = *r
+	if r.Conf != nil {
+		out.Conf = new(ExternalInvestigationExecutionConfirmation1Code)
+		*out.Conf = *r.Conf
+	}
+	if r.RjctdMod != nil {
+		out.RjctdMod = make([]ModificationStatusReason1Choice, len(r.RjctdMod))
+		for i := range r.RjctdMod {
+			r.RjctdMod[i].DeepCopyInto(&out.RjctdMod[i])
+		}
+	}
+	if r.DplctOf != nil {
+		out.DplctOf = new(Case5)
+		r.DplctOf.DeepCopyInto(out.DplctOf)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *LocalInstrument2Choice) DeepCopy() *LocalInstrument2Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(LocalInstrument2Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *LocalInstrument2Choice) DeepCopyInto(out *LocalInstrument2Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *MandateClassification1Choice) DeepCopy() *MandateClassification1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(MandateClassification1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *MandateClassification1Choice) DeepCopyInto(out *MandateClassification1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *MandateRelatedData1Choice) DeepCopy() *MandateRelatedData1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(MandateRelatedData1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *MandateRelatedData1Choice) DeepCopyInto(out *MandateRelatedData1Choice) {
+	*out = *r
+	if r.DrctDbtMndt != nil {
+		out.DrctDbtMndt = new(MandateRelatedInformation14)
+		r.DrctDbtMndt.DeepCopyInto(out.DrctDbtMndt)
+	}
+	if r.CdtTrfMndt != nil {
+		out.CdtTrfMndt = new(CreditTransferMandateData1)
+		r.CdtTrfMndt.DeepCopyInto(out.CdtTrfMndt)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *MandateRelatedInformation14) DeepCopy() *MandateRelatedInformation14 {
+	if r == nil {
+		return nil
+	}
+	out := new(MandateRelatedInformation14)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *MandateRelatedInformation14) DeepCopyInto(out *MandateRelatedInformation14) {
+	*out = *r
+	if r.MndtId != nil {
+		out.MndtId = new(common.Max35Text)
+		*out.MndtId = *r.MndtId
+	}
+	if r.DtOfSgntr != nil {
+		out.DtOfSgntr = new(common.ISODate)
+		*out.DtOfSgntr = *r.DtOfSgntr
+	}
+	if r.AmdmntInfDtls != nil {
+		out.AmdmntInfDtls = new(AmendmentInformationDetails13)
+		r.AmdmntInfDtls.DeepCopyInto(out.AmdmntInfDtls)
+	}
+	if r.ElctrncSgntr != nil {
+		out.ElctrncSgntr = new(common.Max1025Text)
+		*out.ElctrncSgntr = *r.ElctrncSgntr
+	}
+	if r.FrstColltnDt != nil {
+		out.FrstColltnDt = new(common.ISODate)
+		*out.FrstColltnDt = *r.FrstColltnDt
+	}
+	if r.FnlColltnDt != nil {
+		out.FnlColltnDt = new(common.ISODate)
+		*out.FnlColltnDt = *r.FnlColltnDt
+	}
+	if r.Frqcy != nil {
+		out.Frqcy = new(Frequency36Choice)
+		*out.Frqcy = *r.Frqcy
+	}
+	if r.Rsn != nil {
+		out.Rsn = new(MandateSetupReason1Choice)
+		*out.Rsn = *r.Rsn
+	}
+	if r.TrckgDays != nil {
+		out.TrckgDays = new(common.Exact2NumericText)
+		*out.TrckgDays = *r.TrckgDays
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *MandateSetupReason1Choice) DeepCopy() *MandateSetupReason1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(MandateSetupReason1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *MandateSetupReason1Choice) DeepCopyInto(out *MandateSetupReason1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *MandateTypeInformation2) DeepCopy() *MandateTypeInformation2 {
+	if r == nil {
+		return nil
+	}
+	out := new(MandateTypeInformation2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *MandateTypeInformation2) DeepCopyInto(out *MandateTypeInformation2) {
+	*out = *r
+	if r.SvcLvl != nil {
+		out.SvcLvl = new(ServiceLevel8Choice)
+		*out.SvcLvl = *r.SvcLvl
+	}
+	if r.LclInstrm != nil {
+		out.LclInstrm = new(LocalInstrument2Choice)
+		*out.LclInstrm = *r.LclInstrm
+	}
+	if r.CtgyPurp != nil {
+		out.CtgyPurp = new(CategoryPurpose1Choice)
+		*out.CtgyPurp = *r.CtgyPurp
+	}
+	if r.Clssfctn != nil {
+		out.Clssfctn = new(MandateClassification1Choice)
+		*out.Clssfctn = *r.Clssfctn
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ModificationStatusReason1Choice) DeepCopy() *ModificationStatusReason1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(ModificationStatusReason1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ModificationStatusReason1Choice) DeepCopyInto(out *ModificationStatusReason1Choice) {
+	*out = *r
+	if r.Cd != nil {
+		out.Cd = new(ExternalPaymentModificationRejection1Code)
+		*out.Cd = *r.Cd
+	}
+	if r.Prtry != nil {
+		out.Prtry = new(common.Max35Text)
+		*out.Prtry = *r.Prtry
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ModificationStatusReason2) DeepCopy() *ModificationStatusReason2 {
+	if r == nil {
+		return nil
+	}
+	out := new(ModificationStatusReason2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ModificationStatusReason2) DeepCopyInto(out *ModificationStatusReason2) {
+	*out = *r
+	if r.Orgtr != nil {
+		out.Orgtr = new(PartyIdentification135)
+		r.Orgtr.DeepCopyInto(out.Orgtr)
+	}
+	if r.Rsn != nil {
+		out.Rsn = new(ModificationStatusReason1Choice)
+		r.Rsn.DeepCopyInto(out.Rsn)
+	}
+	if r.AddtlInf != nil {
+		out.AddtlInf = make([]common.Max105Text, len(r.AddtlInf))
+		copy(out.AddtlInf, r.AddtlInf)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *NumberOfCancellationsPerStatus1) DeepCopy() *NumberOfCancellationsPerStatus1 {
+	if r == nil {
+		return nil
+	}
+	out := new(NumberOfCancellationsPerStatus1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *NumberOfCancellationsPerStatus1) DeepCopyInto(out *NumberOfCancellationsPerStatus1) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *NumberOfTransactionsPerStatus1) DeepCopy() *NumberOfTransactionsPerStatus1 {
+	if r == nil {
+		return nil
+	}
+	out := new(NumberOfTransactionsPerStatus1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *NumberOfTransactionsPerStatus1) DeepCopyInto(out *NumberOfTransactionsPerStatus1) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *OrganisationIdentification29) DeepCopy() *OrganisationIdentification29 {
+	if r == nil {
+		return nil
+	}
+	out := new(OrganisationIdentification29)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *OrganisationIdentification29) DeepCopyInto(out *OrganisationIdentification29) {
+	*out = *r
+	if r.AnyBIC != nil {
+		out.AnyBIC = new(common.AnyBICDec2014Identifier)
+		*out.AnyBIC = *r.AnyBIC
+	}
+	if r.LEI != nil {
+		out.LEI = new(common.LEIIdentifier)
+		*out.LEI = *r.LEI
+	}
+	if r.Othr != nil {
+		out.Othr = make([]GenericOrganisationIdentification1, len(r.Othr))
+		for i := range r.Othr {
+			r.Othr[i].DeepCopyInto(&out.Othr[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *OrganisationIdentificationSchemeName1Choice) DeepCopy() *OrganisationIdentificationSchemeName1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(OrganisationIdentificationSchemeName1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *OrganisationIdentificationSchemeName1Choice) DeepCopyInto(out *OrganisationIdentificationSchemeName1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *OriginalGroupHeader14) DeepCopy() *OriginalGroupHeader14 {
+	if r == nil {
+		return nil
+	}
+	out := new(OriginalGroupHeader14)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *OriginalGroupHeader14) DeepCopyInto(out *OriginalGroupHeader14) {
+	*out = *r
+	if r.OrgnlGrpCxlId != nil {
+		out.OrgnlGrpCxlId = new(common.Max35Text)
+		*out.OrgnlGrpCxlId = *r.OrgnlGrpCxlId
+	}
+	if r.RslvdCase != nil {
+		out.RslvdCase = new(Case5)
+		r.RslvdCase.DeepCopyInto(out.RslvdCase)
+	}
+	if r.OrgnlCreDtTm != nil {
+		out.OrgnlCreDtTm = new(common.ISODateTime)
+		*out.OrgnlCreDtTm = *r.OrgnlCreDtTm
+	}
+	if r.OrgnlNbOfTxs != nil {
+		out.OrgnlNbOfTxs = new(common.Max15NumericText)
+		*out.OrgnlNbOfTxs = *r.OrgnlNbOfTxs
+	}
+	if r.GrpCxlSts != nil {
+		out.GrpCxlSts = new(GroupCancellationStatus1Code)
+		*out.GrpCxlSts = *r.GrpCxlSts
+	}
+	if r.CxlStsRsnInf != nil {
+		out.CxlStsRsnInf = make([]CancellationStatusReason4, len(r.CxlStsRsnInf))
+		for i := range r.CxlStsRsnInf {
+			r.CxlStsRsnInf[i].DeepCopyInto(&out.CxlStsRsnInf[i])
+		}
+	}
+	if r.NbOfTxsPerCxlSts != nil {
+		out.NbOfTxsPerCxlSts = make([]NumberOfTransactionsPerStatus1, len(r.NbOfTxsPerCxlSts))
+		copy(out.NbOfTxsPerCxlSts, r.NbOfTxsPerCxlSts)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *OriginalGroupHeader15) DeepCopy() *OriginalGroupHeader15 {
+	if r == nil {
+		return nil
+	}
+	out := new(OriginalGroupHeader15)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *OriginalGroupHeader15) DeepCopyInto(out *OriginalGroupHeader15) {
+	*out = *r
+	if r.GrpCxlId != nil {
+		out.GrpCxlId = new(common.Max35Text)
+		*out.GrpCxlId = *r.GrpCxlId
+	}
+	if r.Case != nil {
+		out.Case = new(Case5)
+		r.Case.DeepCopyInto(out.Case)
+	}
+	if r.OrgnlCreDtTm != nil {
+		out.OrgnlCreDtTm = new(common.ISODateTime)
+		*out.OrgnlCreDtTm = *r.OrgnlCreDtTm
+	}
+	if r.NbOfTxs != nil {
+		out.NbOfTxs = new(common.Max15NumericText)
+		*out.NbOfTxs = *r.NbOfTxs
+	}
+	if r.CxlRsnInf != nil {
+		out.CxlRsnInf = make([]PaymentCancellationReason5, len(r.CxlRsnInf))
+		for i := range r.CxlRsnInf {
+			r.CxlRsnInf[i].DeepCopyInto(&out.CxlRsnInf[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *OriginalGroupInformation29) DeepCopy() *OriginalGroupInformation29 {
+	if r == nil {
+		return nil
+	}
+	out := new(OriginalGroupInformation29)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *OriginalGroupInformation29) DeepCopyInto(out *OriginalGroupInformation29) {
+	*out = *r
+	if r.OrgnlCreDtTm != nil {
+		out.OrgnlCreDtTm = new(common.ISODateTime)
+		*out.OrgnlCreDtTm = *r.OrgnlCreDtTm
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *OriginalPaymentInstruction30) DeepCopy() *OriginalPaymentInstruction30 {
+	if r == nil {
+		return nil
+	}
+	out := new(OriginalPaymentInstruction30)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *OriginalPaymentInstruction30) DeepCopyInto(out *OriginalPaymentInstruction30) {
+	*out = *r
+	if r.OrgnlPmtInfCxlId != nil {
+		out.OrgnlPmtInfCxlId = new(common.Max35Text)
+		*out.OrgnlPmtInfCxlId = *r.OrgnlPmtInfCxlId
+	}
+	if r.RslvdCase != nil {
+		out.RslvdCase = new(Case5)
+		r.RslvdCase.DeepCopyInto(out.RslvdCase)
+	}
+	if r.OrgnlGrpInf != nil {
+		out.OrgnlGrpInf = new(OriginalGroupInformation29)
+		r.OrgnlGrpInf.DeepCopyInto(out.OrgnlGrpInf)
+	}
+	if r.OrgnlNbOfTxs != nil {
+		out.OrgnlNbOfTxs = new(common.Max15NumericText)
+		*out.OrgnlNbOfTxs = *r.OrgnlNbOfTxs
+	}
+	if r.PmtInfCxlSts != nil {
+		out.PmtInfCxlSts = new(GroupCancellationStatus1Code)
+		*out.PmtInfCxlSts = *r.PmtInfCxlSts
+	}
+	if r.CxlStsRsnInf != nil {
+		out.CxlStsRsnInf = make([]CancellationStatusReason4, len(r.CxlStsRsnInf))
+		for i := range r.CxlStsRsnInf {
+			r.CxlStsRsnInf[i].DeepCopyInto(&out.CxlStsRsnInf[i])
+		}
+	}
+	if r.NbOfTxsPerCxlSts != nil {
+		out.NbOfTxsPerCxlSts = make([]NumberOfCancellationsPerStatus1, len(r.NbOfTxsPerCxlSts))
+		copy(out.NbOfTxsPerCxlSts, r.NbOfTxsPerCxlSts)
+	}
+	if r.TxInfAndSts != nil {
+		out.TxInfAndSts = make([]PaymentTransaction103, len(r.TxInfAndSts))
+		for i := range r.TxInfAndSts {
+			r.TxInfAndSts[i].DeepCopyInto(&out.TxInfAndSts[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *OriginalPaymentInstruction36) DeepCopy() *OriginalPaymentInstruction36 {
+	if r == nil {
+		return nil
+	}
+	out := new(OriginalPaymentInstruction36)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *OriginalPaymentInstruction36) DeepCopyInto(out *OriginalPaymentInstruction36) {
+	*out = *r
+	if r.PmtCxlId != nil {
+		out.PmtCxlId = new(common.Max35Text)
+		*out.PmtCxlId = *r.PmtCxlId
+	}
+	if r.Case != nil {
+		out.Case = new(Case5)
+		r.Case.DeepCopyInto(out.Case)
+	}
+	if r.OrgnlGrpInf != nil {
+		out.OrgnlGrpInf = new(OriginalGroupInformation29)
+		r.OrgnlGrpInf.DeepCopyInto(out.OrgnlGrpInf)
+	}
+	if r.NbOfTxs != nil {
+		out.NbOfTxs = new(common.Max15NumericText)
+		*out.NbOfTxs = *r.NbOfTxs
+	}
+	if r.CxlRsnInf != nil {
+		out.CxlRsnInf = make([]PaymentCancellationReason5, len(r.CxlRsnInf))
+		for i := range r.CxlRsnInf {
+			r.CxlRsnInf[i].DeepCopyInto(&out.CxlRsnInf[i])
+		}
+	}
+	if r.TxInf != nil {
+		out.TxInf = make([]PaymentTransaction124, len(r.TxInf))
+		for i := range r.TxInf {
+			r.TxInf[i].DeepCopyInto(&out.TxInf[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *OriginalTransactionReference28) DeepCopy() *OriginalTransactionReference28 {
+	if r == nil {
+		return nil
+	}
+	out := new(OriginalTransactionReference28)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *OriginalTransactionReference28) DeepCopyInto(out *OriginalTransactionReference28) {
+	*out = *r
+	if r.IntrBkSttlmAmt != nil {
+		out.IntrBkSttlmAmt = new(ActiveOrHistoricCurrencyAndAmount)
+		*out.IntrBkSttlmAmt = *r.IntrBkSttlmAmt
+	}
+	if r.Amt != nil {
+		out.Amt = new(AmountType4Choice)
+		*out.Amt = *r.Amt
+	}
+	if r.IntrBkSttlmDt != nil {
+		out.IntrBkSttlmDt = new(common.ISODate)
+		*out.IntrBkSttlmDt = *r.IntrBkSttlmDt
+	}
+	if r.ReqdColltnDt != nil {
+		out.ReqdColltnDt = new(common.ISODate)
+		*out.ReqdColltnDt = *r.ReqdColltnDt
+	}
+	if r.ReqdExctnDt != nil {
+		out.ReqdExctnDt = new(DateAndDateTime2Choice)
+		r.ReqdExctnDt.DeepCopyInto(out.ReqdExctnDt)
+	}
+	if r.CdtrSchmeId != nil {
+		out.CdtrSchmeId = new(PartyIdentification135)
+		r.CdtrSchmeId.DeepCopyInto(out.CdtrSchmeId)
+	}
+	if r.SttlmInf != nil {
+		out.SttlmInf = new(SettlementInstruction7)
+		r.SttlmInf.DeepCopyInto(out.SttlmInf)
+	}
+	if r.PmtTpInf != nil {
+		out.PmtTpInf = new(PaymentTypeInformation27)
+		r.PmtTpInf.DeepCopyInto(out.PmtTpInf)
+	}
+	if r.PmtMtd != nil {
+		out.PmtMtd = new(PaymentMethod4Code)
+		*out.PmtMtd = *r.PmtMtd
+	}
+	if r.MndtRltdInf != nil {
+		out.MndtRltdInf = new(MandateRelatedInformation14)
+		r.MndtRltdInf.DeepCopyInto(out.MndtRltdInf)
+	}
+	if r.RmtInf != nil {
+		out.RmtInf = new(RemittanceInformation16)
+		r.RmtInf.DeepCopyInto(out.RmtInf)
+	}
+	if r.UltmtDbtr != nil {
+		out.UltmtDbtr = new(Party40Choice)
+		r.UltmtDbtr.DeepCopyInto(out.UltmtDbtr)
+	}
+	if r.Dbtr != nil {
+		out.Dbtr = new(Party40Choice)
+		r.Dbtr.DeepCopyInto(out.Dbtr)
+	}
+	if r.DbtrAcct != nil {
+		out.DbtrAcct = new(CashAccount38)
+		r.DbtrAcct.DeepCopyInto(out.DbtrAcct)
+	}
+	if r.DbtrAgt != nil {
+		out.DbtrAgt = new(BranchAndFinancialInstitutionIdentification6)
+		r.DbtrAgt.DeepCopyInto(out.DbtrAgt)
+	}
+	if r.DbtrAgtAcct != nil {
+		out.DbtrAgtAcct = new(CashAccount38)
+		r.DbtrAgtAcct.DeepCopyInto(out.DbtrAgtAcct)
+	}
+	if r.CdtrAgt != nil {
+		out.CdtrAgt = new(BranchAndFinancialInstitutionIdentification6)
+		r.CdtrAgt.DeepCopyInto(out.CdtrAgt)
+	}
+	if r.CdtrAgtAcct != nil {
+		out.CdtrAgtAcct = new(CashAccount38)
+		r.CdtrAgtAcct.DeepCopyInto(out.CdtrAgtAcct)
+	}
+	if r.Cdtr != nil {
+		out.Cdtr = new(Party40Choice)
+		r.Cdtr.DeepCopyInto(out.Cdtr)
+	}
+	if r.CdtrAcct != nil {
+		out.CdtrAcct = new(CashAccount38)
+		r.CdtrAcct.DeepCopyInto(out.CdtrAcct)
+	}
+	if r.UltmtCdtr != nil {
+		out.UltmtCdtr = new(Party40Choice)
+		r.UltmtCdtr.DeepCopyInto(out.UltmtCdtr)
+	}
+	if r.Purp != nil {
+		out.Purp = new(Purpose2Choice)
+		*out.Purp = *r.Purp
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *OriginalTransactionReference31) DeepCopy() *OriginalTransactionReference31 {
+	if r == nil {
+		return nil
+	}
+	out := new(OriginalTransactionReference31)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *OriginalTransactionReference31) DeepCopyInto(out *OriginalTransactionReference31) {
+	*out = *r
+	if r.IntrBkSttlmAmt != nil {
+		out.IntrBkSttlmAmt = new(ActiveOrHistoricCurrencyAndAmount)
+		*out.IntrBkSttlmAmt = *r.IntrBkSttlmAmt
+	}
+	if r.Amt != nil {
+		out.Amt = new(AmountType4Choice)
+		*out.Amt = *r.Amt
+	}
+	if r.IntrBkSttlmDt != nil {
+		out.IntrBkSttlmDt = new(common.ISODate)
+		*out.IntrBkSttlmDt = *r.IntrBkSttlmDt
+	}
+	if r.ReqdColltnDt != nil {
+		out.ReqdColltnDt = new(common.ISODate)
+		*out.ReqdColltnDt = *r.ReqdColltnDt
+	}
+	if r.ReqdExctnDt != nil {
+		out.ReqdExctnDt = new(DateAndDateTime2Choice)
+		r.ReqdExctnDt.DeepCopyInto(out.ReqdExctnDt)
+	}
+	if r.CdtrSchmeId != nil {
+		out.CdtrSchmeId = new(PartyIdentification135)
+		r.CdtrSchmeId.DeepCopyInto(out.CdtrSchmeId)
+	}
+	if r.SttlmInf != nil {
+		out.SttlmInf = new(SettlementInstruction7)
+		r.SttlmInf.DeepCopyInto(out.SttlmInf)
+	}
+	if r.PmtTpInf != nil {
+		out.PmtTpInf = new(PaymentTypeInformation27)
+		r.PmtTpInf.DeepCopyInto(out.PmtTpInf)
+	}
+	if r.PmtMtd != nil {
+		out.PmtMtd = new(PaymentMethod4Code)
+		*out.PmtMtd = *r.PmtMtd
+	}
+	if r.MndtRltdInf != nil {
+		out.MndtRltdInf = new(MandateRelatedData1Choice)
+		r.MndtRltdInf.DeepCopyInto(out.MndtRltdInf)
+	}
+	if r.RmtInf != nil {
+		out.RmtInf = new(RemittanceInformation16)
+		r.RmtInf.DeepCopyInto(out.RmtInf)
+	}
+	if r.UltmtDbtr != nil {
+		out.UltmtDbtr = new(Party40Choice)
+		r.UltmtDbtr.DeepCopyInto(out.UltmtDbtr)
+	}
+	if r.Dbtr != nil {
+		out.Dbtr = new(Party40Choice)
+		r.Dbtr.DeepCopyInto(out.Dbtr)
+	}
+	if r.DbtrAcct != nil {
+		out.DbtrAcct = new(CashAccount38)
+		r.DbtrAcct.DeepCopyInto(out.DbtrAcct)
+	}
+	if r.DbtrAgt != nil {
+		out.DbtrAgt = new(BranchAndFinancialInstitutionIdentification6)
+		r.DbtrAgt.DeepCopyInto(out.DbtrAgt)
+	}
+	if r.DbtrAgtAcct != nil {
+		out.DbtrAgtAcct = new(CashAccount38)
+		r.DbtrAgtAcct.DeepCopyInto(out.DbtrAgtAcct)
+	}
+	if r.CdtrAgt != nil {
+		out.CdtrAgt = new(BranchAndFinancialInstitutionIdentification6)
+		r.CdtrAgt.DeepCopyInto(out.CdtrAgt)
+	}
+	if r.CdtrAgtAcct != nil {
+		out.CdtrAgtAcct = new(CashAccount38)
+		r.CdtrAgtAcct.DeepCopyInto(out.CdtrAgtAcct)
+	}
+	if r.Cdtr != nil {
+		out.Cdtr = new(Party40Choice)
+		r.Cdtr.DeepCopyInto(out.Cdtr)
+	}
+	if r.CdtrAcct != nil {
+		out.CdtrAcct = new(CashAccount38)
+		r.CdtrAcct.DeepCopyInto(out.CdtrAcct)
+	}
+	if r.UltmtCdtr != nil {
+		out.UltmtCdtr = new(Party40Choice)
+		r.UltmtCdtr.DeepCopyInto(out.UltmtCdtr)
+	}
+	if r.Purp != nil {
+		out.Purp = new(Purpose2Choice)
+		*out.Purp = *r.Purp
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *OtherContact1) DeepCopy() *OtherContact1 {
+	if r == nil {
+		return nil
+	}
+	out := new(OtherContact1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *OtherContact1) DeepCopyInto(out *OtherContact1) {
+	*out = *r
+	if r.Id != nil {
+		out.Id = new(common.Max128Text)
+		*out.Id = *r.Id
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *Party38Choice) DeepCopy() *Party38Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(Party38Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *Party38Choice) DeepCopyInto(out *Party38Choice) {
+	*out = *r
+	r.OrgId.DeepCopyInto(&out.OrgId)
+	r.PrvtId.DeepCopyInto(&out.PrvtId)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *Party40Choice) DeepCopy() *Party40Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(Party40Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *Party40Choice) DeepCopyInto(out *Party40Choice) {
+	*out = *r
+	if r.Pty != nil {
+		out.Pty = new(PartyIdentification135)
+		r.Pty.DeepCopyInto(out.Pty)
+	}
+	if r.Agt != nil {
+		out.Agt = new(BranchAndFinancialInstitutionIdentification6)
+		r.Agt.DeepCopyInto(out.Agt)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *PartyIdentification135) DeepCopy() *PartyIdentification135 {
+	if r == nil {
+		return nil
+	}
+	out := new(PartyIdentification135)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *PartyIdentification135) DeepCopyInto(out *PartyIdentification135) {
+	*out = *r
+	if r.Nm != nil {
+		out.Nm = new(common.Max140Text)
+		*out.Nm = *r.Nm
+	}
+	if r.PstlAdr != nil {
+		out.PstlAdr = new(PostalAddress24)
+		r.PstlAdr.DeepCopyInto(out.PstlAdr)
+	}
+	if r.Id != nil {
+		out.Id = new(Party38Choice)
+		r.Id.DeepCopyInto(out.Id)
+	}
+	if r.CtryOfRes != nil {
+		out.CtryOfRes = new(common.CountryCode)
+		*out.CtryOfRes = *r.CtryOfRes
+	}
+	if r.CtctDtls != nil {
+		out.CtctDtls = new(Contact4)
+		r.CtctDtls.DeepCopyInto(out.CtctDtls)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *PaymentCancellationReason5) DeepCopy() *PaymentCancellationReason5 {
+	if r == nil {
+		return nil
+	}
+	out := new(PaymentCancellationReason5)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *PaymentCancellationReason5) DeepCopyInto(out *PaymentCancellationReason5) {
+	*out = *r
+	if r.Orgtr != nil {
+		out.Orgtr = new(PartyIdentification135)
+		r.Orgtr.DeepCopyInto(out.Orgtr)
+	}
+	if r.Rsn != nil {
+		out.Rsn = new(CancellationReason33Choice)
+		*out.Rsn = *r.Rsn
+	}
+	if r.AddtlInf != nil {
+		out.AddtlInf = make([]common.Max105Text, len(r.AddtlInf))
+		copy(out.AddtlInf, r.AddtlInf)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *PaymentComplementaryInformation8) DeepCopy() *PaymentComplementaryInformation8 {
+	if r == nil {
+		return nil
+	}
+	out := new(PaymentComplementaryInformation8)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *PaymentComplementaryInformation8) DeepCopyInto(out *PaymentComplementaryInformation8) {
+	*out = *r
+	if r.InstrId != nil {
+		out.InstrId = new(common.Max35Text)
+		*out.InstrId = *r.InstrId
+	}
+	if r.EndToEndId != nil {
+		out.EndToEndId = new(common.Max35Text)
+		*out.EndToEndId = *r.EndToEndId
+	}
+	if r.TxId != nil {
+		out.TxId = new(common.Max35Text)
+		*out.TxId = *r.TxId
+	}
+	if r.PmtTpInf != nil {
+		out.PmtTpInf = new(PaymentTypeInformation27)
+		r.PmtTpInf.DeepCopyInto(out.PmtTpInf)
+	}
+	if r.ReqdExctnDt != nil {
+		out.ReqdExctnDt = new(DateAndDateTime2Choice)
+		r.ReqdExctnDt.DeepCopyInto(out.ReqdExctnDt)
+	}
+	if r.ReqdColltnDt != nil {
+		out.ReqdColltnDt = new(common.ISODate)
+		*out.ReqdColltnDt = *r.ReqdColltnDt
+	}
+	if r.IntrBkSttlmDt != nil {
+		out.IntrBkSttlmDt = new(common.ISODate)
+		*out.IntrBkSttlmDt = *r.IntrBkSttlmDt
+	}
+	if r.Amt != nil {
+		out.Amt = new(AmountType4Choice)
+		*out.Amt = *r.Amt
+	}
+	if r.IntrBkSttlmAmt != nil {
+		out.IntrBkSttlmAmt = new(ActiveOrHistoricCurrencyAndAmount)
+		*out.IntrBkSttlmAmt = *r.IntrBkSttlmAmt
+	}
+	if r.ChrgBr != nil {
+		out.ChrgBr = new(ChargeBearerType1Code)
+		*out.ChrgBr = *r.ChrgBr
+	}
+	if r.UltmtDbtr != nil {
+		out.UltmtDbtr = new(PartyIdentification135)
+		r.UltmtDbtr.DeepCopyInto(out.UltmtDbtr)
+	}
+	if r.Dbtr != nil {
+		out.Dbtr = new(PartyIdentification135)
+		r.Dbtr.DeepCopyInto(out.Dbtr)
+	}
+	if r.DbtrAcct != nil {
+		out.DbtrAcct = new(CashAccount38)
+		r.DbtrAcct.DeepCopyInto(out.DbtrAcct)
+	}
+	if r.DbtrAgt != nil {
+		out.DbtrAgt = new(BranchAndFinancialInstitutionIdentification6)
+		r.DbtrAgt.DeepCopyInto(out.DbtrAgt)
+	}
+	if r.DbtrAgtAcct != nil {
+		out.DbtrAgtAcct = new(CashAccount38)
+		r.DbtrAgtAcct.DeepCopyInto(out.DbtrAgtAcct)
+	}
+	if r.SttlmInf != nil {
+		out.SttlmInf = new(SettlementInstruction7)
+		r.SttlmInf.DeepCopyInto(out.SttlmInf)
+	}
+	if r.IntrmyAgt1 != nil {
+		out.IntrmyAgt1 = new(BranchAndFinancialInstitutionIdentification6)
+		r.IntrmyAgt1.DeepCopyInto(out.IntrmyAgt1)
+	}
+	if r.IntrmyAgt1Acct != nil {
+		out.IntrmyAgt1Acct = new(CashAccount38)
+		r.IntrmyAgt1Acct.DeepCopyInto(out.IntrmyAgt1Acct)
+	}
+	if r.IntrmyAgt2 != nil {
+		out.IntrmyAgt2 = new(BranchAndFinancialInstitutionIdentification6)
+		r.IntrmyAgt2.DeepCopyInto(out.IntrmyAgt2)
+	}
+	if r.IntrmyAgt2Acct != nil {
+		out.IntrmyAgt2Acct = new(CashAccount38)
+		r.IntrmyAgt2Acct.DeepCopyInto(out.IntrmyAgt2Acct)
+	}
+	if r.IntrmyAgt3 != nil {
+		out.IntrmyAgt3 = new(BranchAndFinancialInstitutionIdentification6)
+		r.IntrmyAgt3.DeepCopyInto(out.IntrmyAgt3)
+	}
+	if r.IntrmyAgt3Acct != nil {
+		out.IntrmyAgt3Acct = new(CashAccount38)
+		r.IntrmyAgt3Acct.DeepCopyInto(out.IntrmyAgt3Acct)
+	}
+	if r.CdtrAgt != nil {
+		out.CdtrAgt = new(BranchAndFinancialInstitutionIdentification6)
+		r.CdtrAgt.DeepCopyInto(out.CdtrAgt)
+	}
+	if r.CdtrAgtAcct != nil {
+		out.CdtrAgtAcct = new(CashAccount38)
+		r.CdtrAgtAcct.DeepCopyInto(out.CdtrAgtAcct)
+	}
+	if r.Cdtr != nil {
+		out.Cdtr = new(PartyIdentification135)
+		r.Cdtr.DeepCopyInto(out.Cdtr)
+	}
+	if r.CdtrAcct != nil {
+		out.CdtrAcct = new(CashAccount38)
+		r.CdtrAcct.DeepCopyInto(out.CdtrAcct)
+	}
+	if r.UltmtCdtr != nil {
+		out.UltmtCdtr = new(PartyIdentification135)
+		r.UltmtCdtr.DeepCopyInto(out.UltmtCdtr)
+	}
+	if r.Purp != nil {
+		out.Purp = new(Purpose2Choice)
+		*out.Purp = *r.Purp
+	}
+	if r.InstrForDbtrAgt != nil {
+		out.InstrForDbtrAgt = new(common.Max140Text)
+		*out.InstrForDbtrAgt = *r.InstrForDbtrAgt
+	}
+	if r.PrvsInstgAgt1 != nil {
+		out.PrvsInstgAgt1 = new(BranchAndFinancialInstitutionIdentification6)
+		r.PrvsInstgAgt1.DeepCopyInto(out.PrvsInstgAgt1)
+	}
+	if r.PrvsInstgAgt1Acct != nil {
+		out.PrvsInstgAgt1Acct = new(CashAccount38)
+		r.PrvsInstgAgt1Acct.DeepCopyInto(out.PrvsInstgAgt1Acct)
+	}
+	if r.PrvsInstgAgt2 != nil {
+		out.PrvsInstgAgt2 = new(BranchAndFinancialInstitutionIdentification6)
+		r.PrvsInstgAgt2.DeepCopyInto(out.PrvsInstgAgt2)
+	}
+	if r.PrvsInstgAgt2Acct != nil {
+		out.PrvsInstgAgt2Acct = new(CashAccount38)
+		r.PrvsInstgAgt2Acct.DeepCopyInto(out.PrvsInstgAgt2Acct)
+	}
+	if r.PrvsInstgAgt3 != nil {
+		out.PrvsInstgAgt3 = new(BranchAndFinancialInstitutionIdentification6)
+		r.PrvsInstgAgt3.DeepCopyInto(out.PrvsInstgAgt3)
+	}
+	if r.PrvsInstgAgt3Acct != nil {
+		out.PrvsInstgAgt3Acct = new(CashAccount38)
+		r.PrvsInstgAgt3Acct.DeepCopyInto(out.PrvsInstgAgt3Acct)
+	}
+	if r.InstrForNxtAgt != nil {
+		out.InstrForNxtAgt = make([]InstructionForNextAgent1, len(r.InstrForNxtAgt))
+		for i := range r.InstrForNxtAgt {
+			r.InstrForNxtAgt[i].DeepCopyInto(&out.InstrForNxtAgt[i])
+		}
+	}
+	if r.InstrForCdtrAgt != nil {
+		out.InstrForCdtrAgt = make([]InstructionForCreditorAgent1, len(r.InstrForCdtrAgt))
+		for i := range r.InstrForCdtrAgt {
+			r.InstrForCdtrAgt[i].DeepCopyInto(&out.InstrForCdtrAgt[i])
+		}
+	}
+	if r.RmtInf != nil {
+		out.RmtInf = new(RemittanceInformation16)
+		r.RmtInf.DeepCopyInto(out.RmtInf)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *PaymentTransaction102) DeepCopy() *PaymentTransaction102 {
+	if r == nil {
+		return nil
+	}
+	out := new(PaymentTransaction102)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *PaymentTransaction102) DeepCopyInto(out *PaymentTransaction102) {
+	*out = *r
+	if r.CxlStsId != nil {
+		out.CxlStsId = new(common.Max35Text)
+		*out.CxlStsId = *r.CxlStsId
+	}
+	if r.RslvdCase != nil {
+		out.RslvdCase = new(Case5)
+		r.RslvdCase.DeepCopyInto(out.RslvdCase)
+	}
+	if r.OrgnlGrpInf != nil {
+		out.OrgnlGrpInf = new(OriginalGroupInformation29)
+		r.OrgnlGrpInf.DeepCopyInto(out.OrgnlGrpInf)
+	}
+	if r.OrgnlInstrId != nil {
+		out.OrgnlInstrId = new(common.Max35Text)
+		*out.OrgnlInstrId = *r.OrgnlInstrId
+	}
+	if r.OrgnlEndToEndId != nil {
+		out.OrgnlEndToEndId = new(common.Max35Text)
+		*out.OrgnlEndToEndId = *r.OrgnlEndToEndId
+	}
+	if r.OrgnlTxId != nil {
+		out.OrgnlTxId = new(common.Max35Text)
+		*out.OrgnlTxId = *r.OrgnlTxId
+	}
+	if r.OrgnlClrSysRef != nil {
+		out.OrgnlClrSysRef = new(common.Max35Text)
+		*out.OrgnlClrSysRef = *r.OrgnlClrSysRef
+	}
+	if r.OrgnlUETR != nil {
+		out.OrgnlUETR = new(common.UUIDv4Identifier)
+		*out.OrgnlUETR = *r.OrgnlUETR
+	}
+	if r.TxCxlSts != nil {
+		out.TxCxlSts = new(CancellationIndividualStatus1Code)
+		*out.TxCxlSts = *r.TxCxlSts
+	}
+	if r.CxlStsRsnInf != nil {
+		out.CxlStsRsnInf = make([]CancellationStatusReason4, len(r.CxlStsRsnInf))
+		for i := range r.CxlStsRsnInf {
+			r.CxlStsRsnInf[i].DeepCopyInto(&out.CxlStsRsnInf[i])
+		}
+	}
+	if r.RsltnRltdInf != nil {
+		out.RsltnRltdInf = new(ResolutionData1)
+		r.RsltnRltdInf.DeepCopyInto(out.RsltnRltdInf)
+	}
+	if r.OrgnlIntrBkSttlmAmt != nil {
+		out.OrgnlIntrBkSttlmAmt = new(ActiveOrHistoricCurrencyAndAmount)
+		*out.OrgnlIntrBkSttlmAmt = *r.OrgnlIntrBkSttlmAmt
+	}
+	if r.OrgnlIntrBkSttlmDt != nil {
+		out.OrgnlIntrBkSttlmDt = new(common.ISODate)
+		*out.OrgnlIntrBkSttlmDt = *r.OrgnlIntrBkSttlmDt
+	}
+	if r.Assgnr != nil {
+		out.Assgnr = new(Party40Choice)
+		r.Assgnr.DeepCopyInto(out.Assgnr)
+	}
+	if r.Assgne != nil {
+		out.Assgne = new(Party40Choice)
+		r.Assgne.DeepCopyInto(out.Assgne)
+	}
+	if r.OrgnlTxRef != nil {
+		out.OrgnlTxRef = new(OriginalTransactionReference28)
+		r.OrgnlTxRef.DeepCopyInto(out.OrgnlTxRef)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *PaymentTransaction103) DeepCopy() *PaymentTransaction103 {
+	if r == nil {
+		return nil
+	}
+	out := new(PaymentTransaction103)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *PaymentTransaction103) DeepCopyInto(out *PaymentTransaction103) {
+	*out = *r
+	if r.CxlStsId != nil {
+		out.CxlStsId = new(common.Max35Text)
+		*out.CxlStsId = *r.CxlStsId
+	}
+	if r.RslvdCase != nil {
+		out.RslvdCase = new(Case5)
+		r.RslvdCase.DeepCopyInto(out.RslvdCase)
+	}
+	if r.OrgnlInstrId != nil {
+		out.OrgnlInstrId = new(common.Max35Text)
+		*out.OrgnlInstrId = *r.OrgnlInstrId
+	}
+	if r.OrgnlEndToEndId != nil {
+		out.OrgnlEndToEndId = new(common.Max35Text)
+		*out.OrgnlEndToEndId = *r.OrgnlEndToEndId
+	}
+	if r.UETR != nil {
+		out.UETR = new(common.UUIDv4Identifier)
+		*out.UETR = *r.UETR
+	}
+	if r.TxCxlSts != nil {
+		out.TxCxlSts = new(CancellationIndividualStatus1Code)
+		*out.TxCxlSts = *r.TxCxlSts
+	}
+	if r.CxlStsRsnInf != nil {
+		out.CxlStsRsnInf = make([]CancellationStatusReason4, len(r.CxlStsRsnInf))
+		for i := range r.CxlStsRsnInf {
+			r.CxlStsRsnInf[i].DeepCopyInto(&out.CxlStsRsnInf[i])
+		}
+	}
+	if r.OrgnlInstdAmt != nil {
+		out.OrgnlInstdAmt = new(ActiveOrHistoricCurrencyAndAmount)
+		*out.OrgnlInstdAmt = *r.OrgnlInstdAmt
+	}
+	if r.OrgnlReqdExctnDt != nil {
+		out.OrgnlReqdExctnDt = new(DateAndDateTime2Choice)
+		r.OrgnlReqdExctnDt.DeepCopyInto(out.OrgnlReqdExctnDt)
+	}
+	if r.OrgnlReqdColltnDt != nil {
+		out.OrgnlReqdColltnDt = new(common.ISODate)
+		*out.OrgnlReqdColltnDt = *r.OrgnlReqdColltnDt
+	}
+	if r.OrgnlTxRef != nil {
+		out.OrgnlTxRef = new(OriginalTransactionReference28)
+		r.OrgnlTxRef.DeepCopyInto(out.OrgnlTxRef)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *PaymentTransaction107) DeepCopy() *PaymentTransaction107 {
+	if r == nil {
+		return nil
+	}
+	out := new(PaymentTransaction107)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *PaymentTransaction107) DeepCopyInto(out *PaymentTransaction107) {
+	*out = *r
+	if r.ModStsId != nil {
+		out.ModStsId = new(common.Max35Text)
+		*out.ModStsId = *r.ModStsId
+	}
+	if r.RslvdCase != nil {
+		out.RslvdCase = new(Case5)
+		r.RslvdCase.DeepCopyInto(out.RslvdCase)
+	}
+	if r.OrgnlGrpInf != nil {
+		out.OrgnlGrpInf = new(OriginalGroupInformation29)
+		r.OrgnlGrpInf.DeepCopyInto(out.OrgnlGrpInf)
+	}
+	if r.OrgnlPmtInfId != nil {
+		out.OrgnlPmtInfId = new(common.Max35Text)
+		*out.OrgnlPmtInfId = *r.OrgnlPmtInfId
+	}
+	if r.OrgnlInstrId != nil {
+		out.OrgnlInstrId = new(common.Max35Text)
+		*out.OrgnlInstrId = *r.OrgnlInstrId
+	}
+	if r.OrgnlEndToEndId != nil {
+		out.OrgnlEndToEndId = new(common.Max35Text)
+		*out.OrgnlEndToEndId = *r.OrgnlEndToEndId
+	}
+	if r.OrgnlTxId != nil {
+		out.OrgnlTxId = new(common.Max35Text)
+		*out.OrgnlTxId = *r.OrgnlTxId
+	}
+	if r.OrgnlClrSysRef != nil {
+		out.OrgnlClrSysRef = new(common.Max35Text)
+		*out.OrgnlClrSysRef = *r.OrgnlClrSysRef
+	}
+	if r.OrgnlUETR != nil {
+		out.OrgnlUETR = new(common.UUIDv4Identifier)
+		*out.OrgnlUETR = *r.OrgnlUETR
+	}
+	if r.ModStsRsnInf != nil {
+		out.ModStsRsnInf = make([]ModificationStatusReason2, len(r.ModStsRsnInf))
+		for i := range r.ModStsRsnInf {
+			r.ModStsRsnInf[i].DeepCopyInto(&out.ModStsRsnInf[i])
+		}
+	}
+	if r.RsltnRltdInf != nil {
+		out.RsltnRltdInf = new(ResolutionData1)
+		r.RsltnRltdInf.DeepCopyInto(out.RsltnRltdInf)
+	}
+	if r.OrgnlIntrBkSttlmAmt != nil {
+		out.OrgnlIntrBkSttlmAmt = new(ActiveOrHistoricCurrencyAndAmount)
+		*out.OrgnlIntrBkSttlmAmt = *r.OrgnlIntrBkSttlmAmt
+	}
+	if r.OrgnlIntrBkSttlmDt != nil {
+		out.OrgnlIntrBkSttlmDt = new(common.ISODate)
+		*out.OrgnlIntrBkSttlmDt = *r.OrgnlIntrBkSttlmDt
+	}
+	if r.Assgnr != nil {
+		out.Assgnr = new(Party40Choice)
+		r.Assgnr.DeepCopyInto(out.Assgnr)
+	}
+	if r.Assgne != nil {
+		out.Assgne = new(Party40Choice)
+		r.Assgne.DeepCopyInto(out.Assgne)
+	}
+	if r.OrgnlTxRef != nil {
+		out.OrgnlTxRef = new(OriginalTransactionReference28)
+		r.OrgnlTxRef.DeepCopyInto(out.OrgnlTxRef)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *PaymentTransaction120) DeepCopy() *PaymentTransaction120 {
+	if r == nil {
+		return nil
+	}
+	out := new(PaymentTransaction120)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *PaymentTransaction120) DeepCopyInto(out *PaymentTransaction120) {
+	*out = *r
+	if r.CxlId != nil {
+		out.CxlId = new(common.Max35Text)
+		*out.CxlId = *r.CxlId
+	}
+	if r.Case != nil {
+		out.Case = new(Case5)
+		r.Case.DeepCopyInto(out.Case)
+	}
+	if r.OrgnlGrpInf != nil {
+		out.OrgnlGrpInf = new(OriginalGroupInformation29)
+		r.OrgnlGrpInf.DeepCopyInto(out.OrgnlGrpInf)
+	}
+	if r.OrgnlInstrId != nil {
+		out.OrgnlInstrId = new(common.Max35Text)
+		*out.OrgnlInstrId = *r.OrgnlInstrId
+	}
+	if r.OrgnlEndToEndId != nil {
+		out.OrgnlEndToEndId = new(common.Max35Text)
+		*out.OrgnlEndToEndId = *r.OrgnlEndToEndId
+	}
+	if r.OrgnlTxId != nil {
+		out.OrgnlTxId = new(common.Max35Text)
+		*out.OrgnlTxId = *r.OrgnlTxId
+	}
+	if r.OrgnlUETR != nil {
+		out.OrgnlUETR = new(common.UUIDv4Identifier)
+		*out.OrgnlUETR = *r.OrgnlUETR
+	}
+	if r.OrgnlClrSysRef != nil {
+		out.OrgnlClrSysRef = new(common.Max35Text)
+		*out.OrgnlClrSysRef = *r.OrgnlClrSysRef
+	}
+	if r.OrgnlIntrBkSttlmAmt != nil {
+		out.OrgnlIntrBkSttlmAmt = new(ActiveOrHistoricCurrencyAndAmount)
+		*out.OrgnlIntrBkSttlmAmt = *r.OrgnlIntrBkSttlmAmt
+	}
+	if r.OrgnlIntrBkSttlmDt != nil {
+		out.OrgnlIntrBkSttlmDt = new(common.ISODate)
+		*out.OrgnlIntrBkSttlmDt = *r.OrgnlIntrBkSttlmDt
+	}
+	if r.Assgnr != nil {
+		out.Assgnr = new(BranchAndFinancialInstitutionIdentification6)
+		r.Assgnr.DeepCopyInto(out.Assgnr)
+	}
+	if r.Assgne != nil {
+		out.Assgne = new(BranchAndFinancialInstitutionIdentification6)
+		r.Assgne.DeepCopyInto(out.Assgne)
+	}
+	if r.CxlRsnInf != nil {
+		out.CxlRsnInf = make([]PaymentCancellationReason5, len(r.CxlRsnInf))
+		for i := range r.CxlRsnInf {
+			r.CxlRsnInf[i].DeepCopyInto(&out.CxlRsnInf[i])
+		}
+	}
+	if r.OrgnlTxRef != nil {
+		out.OrgnlTxRef = new(OriginalTransactionReference31)
+		r.OrgnlTxRef.DeepCopyInto(out.OrgnlTxRef)
+	}
+	if r.SplmtryData != nil {
+		out.SplmtryData = make([]SupplementaryData1, len(r.SplmtryData))
+		for i := range r.SplmtryData {
+			r.SplmtryData[i].DeepCopyInto(&out.SplmtryData[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *PaymentTransaction124) DeepCopy() *PaymentTransaction124 {
+	if r == nil {
+		return nil
+	}
+	out := new(PaymentTransaction124)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *PaymentTransaction124) DeepCopyInto(out *PaymentTransaction124) {
+	*out = *r
+	if r.CxlId != nil {
+		out.CxlId = new(common.Max35Text)
+		*out.CxlId = *r.CxlId
+	}
+	if r.Case != nil {
+		out.Case = new(Case5)
+		r.Case.DeepCopyInto(out.Case)
+	}
+	if r.OrgnlInstrId != nil {
+		out.OrgnlInstrId = new(common.Max35Text)
+		*out.OrgnlInstrId = *r.OrgnlInstrId
+	}
+	if r.OrgnlEndToEndId != nil {
+		out.OrgnlEndToEndId = new(common.Max35Text)
+		*out.OrgnlEndToEndId = *r.OrgnlEndToEndId
+	}
+	if r.OrgnlUETR != nil {
+		out.OrgnlUETR = new(common.UUIDv4Identifier)
+		*out.OrgnlUETR = *r.OrgnlUETR
+	}
+	if r.OrgnlInstdAmt != nil {
+		out.OrgnlInstdAmt = new(ActiveOrHistoricCurrencyAndAmount)
+		*out.OrgnlInstdAmt = *r.OrgnlInstdAmt
+	}
+	if r.OrgnlReqdExctnDt != nil {
+		out.OrgnlReqdExctnDt = new(DateAndDateTime2Choice)
+		r.OrgnlReqdExctnDt.DeepCopyInto(out.OrgnlReqdExctnDt)
+	}
+	if r.OrgnlReqdColltnDt != nil {
+		out.OrgnlReqdColltnDt = new(common.ISODate)
+		*out.OrgnlReqdColltnDt = *r.OrgnlReqdColltnDt
+	}
+	if r.CxlRsnInf != nil {
+		out.CxlRsnInf = make([]PaymentCancellationReason5, len(r.CxlRsnInf))
+		for i := range r.CxlRsnInf {
+			r.CxlRsnInf[i].DeepCopyInto(&out.CxlRsnInf[i])
+		}
+	}
+	if r.OrgnlTxRef != nil {
+		out.OrgnlTxRef = new(OriginalTransactionReference31)
+		r.OrgnlTxRef.DeepCopyInto(out.OrgnlTxRef)
+	}
+	if r.SplmtryData != nil {
+		out.SplmtryData = make([]SupplementaryData1, len(r.SplmtryData))
+		for i := range r.SplmtryData {
+			r.SplmtryData[i].DeepCopyInto(&out.SplmtryData[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *PaymentTypeInformation27) DeepCopy() *PaymentTypeInformation27 {
+	if r == nil {
+		return nil
+	}
+	out := new(PaymentTypeInformation27)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *PaymentTypeInformation27) DeepCopyInto(out *PaymentTypeInformation27) {
+	*out = *r
+	if r.InstrPrty != nil {
+		out.InstrPrty = new(Priority2Code)
+		*out.InstrPrty = *r.InstrPrty
+	}
+	if r.ClrChanl != nil {
+		out.ClrChanl = new(ClearingChannel2Code)
+		*out.ClrChanl = *r.ClrChanl
+	}
+	if r.SvcLvl != nil {
+		out.SvcLvl = make([]ServiceLevel8Choice, len(r.SvcLvl))
+		copy(out.SvcLvl, r.SvcLvl)
+	}
+	if r.LclInstrm != nil {
+		out.LclInstrm = new(LocalInstrument2Choice)
+		*out.LclInstrm = *r.LclInstrm
+	}
+	if r.SeqTp != nil {
+		out.SeqTp = new(SequenceType3Code)
+		*out.SeqTp = *r.SeqTp
+	}
+	if r.CtgyPurp != nil {
+		out.CtgyPurp = new(CategoryPurpose1Choice)
+		*out.CtgyPurp = *r.CtgyPurp
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *PersonIdentification13) DeepCopy() *PersonIdentification13 {
+	if r == nil {
+		return nil
+	}
+	out := new(PersonIdentification13)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *PersonIdentification13) DeepCopyInto(out *PersonIdentification13) {
+	*out = *r
+	if r.DtAndPlcOfBirth != nil {
+		out.DtAndPlcOfBirth = new(DateAndPlaceOfBirth1)
+		r.DtAndPlcOfBirth.DeepCopyInto(out.DtAndPlcOfBirth)
+	}
+	if r.Othr != nil {
+		out.Othr = make([]GenericPersonIdentification1, len(r.Othr))
+		for i := range r.Othr {
+			r.Othr[i].DeepCopyInto(&out.Othr[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *PersonIdentificationSchemeName1Choice) DeepCopy() *PersonIdentificationSchemeName1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(PersonIdentificationSchemeName1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *PersonIdentificationSchemeName1Choice) DeepCopyInto(out *PersonIdentificationSchemeName1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *PostalAddress24) DeepCopy() *PostalAddress24 {
+	if r == nil {
+		return nil
+	}
+	out := new(PostalAddress24)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *PostalAddress24) DeepCopyInto(out *PostalAddress24) {
+	*out = *r
+	if r.AdrTp != nil {
+		out.AdrTp = new(AddressType3Choice)
+		r.AdrTp.DeepCopyInto(out.AdrTp)
+	}
+	if r.Dept != nil {
+		out.Dept = new(common.Max70Text)
+		*out.Dept = *r.Dept
+	}
+	if r.SubDept != nil {
+		out.SubDept = new(common.Max70Text)
+		*out.SubDept = *r.SubDept
+	}
+	if r.StrtNm != nil {
+		out.StrtNm = new(common.Max70Text)
+		*out.StrtNm = *r.StrtNm
+	}
+	if r.BldgNb != nil {
+		out.BldgNb = new(common.Max16Text)
+		*out.BldgNb = *r.BldgNb
+	}
+	if r.BldgNm != nil {
+		out.BldgNm = new(common.Max35Text)
+		*out.BldgNm = *r.BldgNm
+	}
+	if r.Flr != nil {
+		out.Flr = new(common.Max70Text)
+		*out.Flr = *r.Flr
+	}
+	if r.PstBx != nil {
+		out.PstBx = new(common.Max16Text)
+		*out.PstBx = *r.PstBx
+	}
+	if r.Room != nil {
+		out.Room = new(common.Max70Text)
+		*out.Room = *r.Room
+	}
+	if r.PstCd != nil {
+		out.PstCd = new(common.Max16Text)
+		*out.PstCd = *r.PstCd
+	}
+	if r.TwnNm != nil {
+		out.TwnNm = new(common.Max35Text)
+		*out.TwnNm = *r.TwnNm
+	}
+	if r.TwnLctnNm != nil {
+		out.TwnLctnNm = new(common.Max35Text)
+		*out.TwnLctnNm = *r.TwnLctnNm
+	}
+	if r.DstrctNm != nil {
+		out.DstrctNm = new(common.Max35Text)
+		*out.DstrctNm = *r.DstrctNm
+	}
+	if r.CtrySubDvsn != nil {
+		out.CtrySubDvsn = new(common.Max35Text)
+		*out.CtrySubDvsn = *r.CtrySubDvsn
+	}
+	if r.Ctry != nil {
+		out.Ctry = new(common.CountryCode)
+		*out.Ctry = *r.Ctry
+	}
+	if r.AdrLine != nil {
+		out.AdrLine = make([]common.Max70Text, len(r.AdrLine))
+		copy(out.AdrLine, r.AdrLine)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ProxyAccountIdentification1) DeepCopy() *ProxyAccountIdentification1 {
+	if r == nil {
+		return nil
+	}
+	out := new(ProxyAccountIdentification1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ProxyAccountIdentification1) DeepCopyInto(out *ProxyAccountIdentification1) {
+	*out = *r
+	if r.Tp != nil {
+		out.Tp = new(ProxyAccountType1Choice)
+		*out.Tp = *r.Tp
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ProxyAccountType1Choice) DeepCopy() *ProxyAccountType1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(ProxyAccountType1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ProxyAccountType1Choice) DeepCopyInto(out *ProxyAccountType1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *Purpose2Choice) DeepCopy() *Purpose2Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(Purpose2Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *Purpose2Choice) DeepCopyInto(out *Purpose2Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ReferredDocumentInformation7) DeepCopy() *ReferredDocumentInformation7 {
+	if r == nil {
+		return nil
+	}
+	out := new(ReferredDocumentInformation7)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ReferredDocumentInformation7) DeepCopyInto(out *ReferredDocumentInformation7) {
+	*out = *r
+	if r.Tp != nil {
+		out.Tp = new(ReferredDocumentType4)
+		r.Tp.DeepCopyInto(out.Tp)
+	}
+	if r.Nb != nil {
+		out.Nb = new(common.Max35Text)
+		*out.Nb = *r.Nb
+	}
+	if r.RltdDt != nil {
+		out.RltdDt = new(common.ISODate)
+		*out.RltdDt = *r.RltdDt
+	}
+	if r.LineDtls != nil {
+		out.LineDtls = make([]DocumentLineInformation1, len(r.LineDtls))
+		for i := range r.LineDtls {
+			r.LineDtls[i].DeepCopyInto(&out.LineDtls[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ReferredDocumentType3Choice) DeepCopy() *ReferredDocumentType3Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(ReferredDocumentType3Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ReferredDocumentType3Choice) DeepCopyInto(out *ReferredDocumentType3Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ReferredDocumentType4) DeepCopy() *ReferredDocumentType4 {
+	if r == nil {
+		return nil
+	}
+	out := new(ReferredDocumentType4)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ReferredDocumentType4) DeepCopyInto(out *ReferredDocumentType4) {
+	*out = *r
+	if r.Issr != nil {
+		out.Issr = new(common.Max35Text)
+		*out.Issr = *r.Issr
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *RemittanceAmount2) DeepCopy() *RemittanceAmount2 {
+	if r == nil {
+		return nil
+	}
+	out := new(RemittanceAmount2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *RemittanceAmount2) DeepCopyInto(out *RemittanceAmount2) {
+	*out = *r
+	if r.DuePyblAmt != nil {
+		out.DuePyblAmt = new(ActiveOrHistoricCurrencyAndAmount)
+		*out.DuePyblAmt = *r.DuePyblAmt
+	}
+	if r.DscntApldAmt != nil {
+		out.DscntApldAmt = make([]DiscountAmountAndType1, len(r.DscntApldAmt))
+		for i := range r.DscntApldAmt {
+			r.DscntApldAmt[i].DeepCopyInto(&out.DscntApldAmt[i])
+		}
+	}
+	if r.CdtNoteAmt != nil {
+		out.CdtNoteAmt = new(ActiveOrHistoricCurrencyAndAmount)
+		*out.CdtNoteAmt = *r.CdtNoteAmt
+	}
+	if r.TaxAmt != nil {
+		out.TaxAmt = make([]TaxAmountAndType1, len(r.TaxAmt))
+		for i := range r.TaxAmt {
+			r.TaxAmt[i].DeepCopyInto(&out.TaxAmt[i])
+		}
+	}
+	if r.AdjstmntAmtAndRsn != nil {
+		out.AdjstmntAmtAndRsn = make([]DocumentAdjustment1, len(r.AdjstmntAmtAndRsn))
+		for i := range r.AdjstmntAmtAndRsn {
+			r.AdjstmntAmtAndRsn[i].DeepCopyInto(&out.AdjstmntAmtAndRsn[i])
+		}
+	}
+	if r.RmtdAmt != nil {
+		out.RmtdAmt = new(ActiveOrHistoricCurrencyAndAmount)
+		*out.RmtdAmt = *r.RmtdAmt
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *RemittanceAmount3) DeepCopy() *RemittanceAmount3 {
+	if r == nil {
+		return nil
+	}
+	out := new(RemittanceAmount3)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *RemittanceAmount3) DeepCopyInto(out *RemittanceAmount3) {
+	*out = *r
+	if r.DuePyblAmt != nil {
+		out.DuePyblAmt = new(ActiveOrHistoricCurrencyAndAmount)
+		*out.DuePyblAmt = *r.DuePyblAmt
+	}
+	if r.DscntApldAmt != nil {
+		out.DscntApldAmt = make([]DiscountAmountAndType1, len(r.DscntApldAmt))
+		for i := range r.DscntApldAmt {
+			r.DscntApldAmt[i].DeepCopyInto(&out.DscntApldAmt[i])
+		}
+	}
+	if r.CdtNoteAmt != nil {
+		out.CdtNoteAmt = new(ActiveOrHistoricCurrencyAndAmount)
+		*out.CdtNoteAmt = *r.CdtNoteAmt
+	}
+	if r.TaxAmt != nil {
+		out.TaxAmt = make([]TaxAmountAndType1, len(r.TaxAmt))
+		for i := range r.TaxAmt {
+			r.TaxAmt[i].DeepCopyInto(&out.TaxAmt[i])
+		}
+	}
+	if r.AdjstmntAmtAndRsn != nil {
+		out.AdjstmntAmtAndRsn = make([]DocumentAdjustment1, len(r.AdjstmntAmtAndRsn))
+		for i := range r.AdjstmntAmtAndRsn {
+			r.AdjstmntAmtAndRsn[i].DeepCopyInto(&out.AdjstmntAmtAndRsn[i])
+		}
+	}
+	if r.RmtdAmt != nil {
+		out.RmtdAmt = new(ActiveOrHistoricCurrencyAndAmount)
+		*out.RmtdAmt = *r.RmtdAmt
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *RemittanceInformation16) DeepCopy() *RemittanceInformation16 {
+	if r == nil {
+		return nil
+	}
+	out := new(RemittanceInformation16)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *RemittanceInformation16) DeepCopyInto(out *RemittanceInformation16) {
+	*out = *r
+	if r.Ustrd != nil {
+		out.Ustrd = make([]common.Max140Text, len(r.Ustrd))
+		copy(out.Ustrd, r.Ustrd)
+	}
+	if r.Strd != nil {
+		out.Strd = make([]StructuredRemittanceInformation16, len(r.Strd))
+		for i := range r.Strd {
+			r.Strd[i].DeepCopyInto(&out.Strd[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ResolutionData1) DeepCopy() *ResolutionData1 {
+	if r == nil {
+		return nil
+	}
+	out := new(ResolutionData1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ResolutionData1) DeepCopyInto(out *ResolutionData1) {
+	*out = *r
+	if r.EndToEndId != nil {
+		out.EndToEndId = new(common.Max35Text)
+		*out.EndToEndId = *r.EndToEndId
+	}
+	if r.TxId != nil {
+		out.TxId = new(common.Max35Text)
+		*out.TxId = *r.TxId
+	}
+	if r.UETR != nil {
+		out.UETR = new(common.UUIDv4Identifier)
+		*out.UETR = *r.UETR
+	}
+	if r.IntrBkSttlmAmt != nil {
+		out.IntrBkSttlmAmt = new(ActiveOrHistoricCurrencyAndAmount)
+		*out.IntrBkSttlmAmt = *r.IntrBkSttlmAmt
+	}
+	if r.IntrBkSttlmDt != nil {
+		out.IntrBkSttlmDt = new(common.ISODate)
+		*out.IntrBkSttlmDt = *r.IntrBkSttlmDt
+	}
+	if r.ClrChanl != nil {
+		out.ClrChanl = new(ClearingChannel2Code)
+		*out.ClrChanl = *r.ClrChanl
+	}
+	if r.Compstn != nil {
+		out.Compstn = new(Compensation2)
+		r.Compstn.DeepCopyInto(out.Compstn)
+	}
+	if r.Chrgs != nil {
+		out.Chrgs = make([]Charges7, len(r.Chrgs))
+		for i := range r.Chrgs {
+			r.Chrgs[i].DeepCopyInto(&out.Chrgs[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ResolutionOfInvestigationV09) DeepCopy() *ResolutionOfInvestigationV09 {
+	if r == nil {
+		return nil
+	}
+	out := new(ResolutionOfInvestigationV09)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ResolutionOfInvestigationV09) DeepCopyInto(out *ResolutionOfInvestigationV09) {
+	*out = *r
+	r.Assgnmt.DeepCopyInto(&out.Assgnmt)
+	if r.RslvdCase != nil {
+		out.RslvdCase = new(Case5)
+		r.RslvdCase.DeepCopyInto(out.RslvdCase)
+	}
+	r.Sts.DeepCopyInto(&out.Sts)
+	if r.CxlDtls != nil {
+		out.CxlDtls = make([]UnderlyingTransaction22, len(r.CxlDtls))
+		for i := range r.CxlDtls {
+			r.CxlDtls[i].DeepCopyInto(&out.CxlDtls[i])
+		}
+	}
+	if r.ModDtls != nil {
+		out.ModDtls = new(PaymentTransaction107)
+		r.ModDtls.DeepCopyInto(out.ModDtls)
+	}
+	if r.ClmNonRctDtls != nil {
+		out.ClmNonRctDtls = new(ClaimNonReceipt2Choice)
+		r.ClmNonRctDtls.DeepCopyInto(out.ClmNonRctDtls)
+	}
+	if r.StmtDtls != nil {
+		out.StmtDtls = new(StatementResolutionEntry4)
+		r.StmtDtls.DeepCopyInto(out.StmtDtls)
+	}
+	if r.CrrctnTx != nil {
+		out.CrrctnTx = new(CorrectiveTransaction4Choice)
+		r.CrrctnTx.DeepCopyInto(out.CrrctnTx)
+	}
+	if r.RsltnRltdInf != nil {
+		out.RsltnRltdInf = new(ResolutionData1)
+		r.RsltnRltdInf.DeepCopyInto(out.RsltnRltdInf)
+	}
+	if r.SplmtryData != nil {
+		out.SplmtryData = make([]SupplementaryData1, len(r.SplmtryData))
+		for i := range r.SplmtryData {
+			r.SplmtryData[i].DeepCopyInto(&out.SplmtryData[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ServiceLevel8Choice) DeepCopy() *ServiceLevel8Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(ServiceLevel8Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ServiceLevel8Choice) DeepCopyInto(out *ServiceLevel8Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *SettlementInstruction7) DeepCopy() *SettlementInstruction7 {
+	if r == nil {
+		return nil
+	}
+	out := new(SettlementInstruction7)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *SettlementInstruction7) DeepCopyInto(out *SettlementInstruction7) {
+	*out = *r
+	if r.SttlmAcct != nil {
+		out.SttlmAcct = new(CashAccount38)
+		r.SttlmAcct.DeepCopyInto(out.SttlmAcct)
+	}
+	if r.ClrSys != nil {
+		out.ClrSys = new(ClearingSystemIdentification3Choice)
+		*out.ClrSys = *r.ClrSys
+	}
+	if r.InstgRmbrsmntAgt != nil {
+		out.InstgRmbrsmntAgt = new(BranchAndFinancialInstitutionIdentification6)
+		r.InstgRmbrsmntAgt.DeepCopyInto(out.InstgRmbrsmntAgt)
+	}
+	if r.InstgRmbrsmntAgtAcct != nil {
+		out.InstgRmbrsmntAgtAcct = new(CashAccount38)
+		r.InstgRmbrsmntAgtAcct.DeepCopyInto(out.InstgRmbrsmntAgtAcct)
+	}
+	if r.InstdRmbrsmntAgt != nil {
+		out.InstdRmbrsmntAgt = new(BranchAndFinancialInstitutionIdentification6)
+		r.InstdRmbrsmntAgt.DeepCopyInto(out.InstdRmbrsmntAgt)
+	}
+	if r.InstdRmbrsmntAgtAcct != nil {
+		out.InstdRmbrsmntAgtAcct = new(CashAccount38)
+		r.InstdRmbrsmntAgtAcct.DeepCopyInto(out.InstdRmbrsmntAgtAcct)
+	}
+	if r.ThrdRmbrsmntAgt != nil {
+		out.ThrdRmbrsmntAgt = new(BranchAndFinancialInstitutionIdentification6)
+		r.ThrdRmbrsmntAgt.DeepCopyInto(out.ThrdRmbrsmntAgt)
+	}
+	if r.ThrdRmbrsmntAgtAcct != nil {
+		out.ThrdRmbrsmntAgtAcct = new(CashAccount38)
+		r.ThrdRmbrsmntAgtAcct.DeepCopyInto(out.ThrdRmbrsmntAgtAcct)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *StatementResolutionEntry4) DeepCopy() *StatementResolutionEntry4 {
+	if r == nil {
+		return nil
+	}
+	out := new(StatementResolutionEntry4)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *StatementResolutionEntry4) DeepCopyInto(out *StatementResolutionEntry4) {
+	*out = *r
+	if r.OrgnlGrpInf != nil {
+		out.OrgnlGrpInf = new(OriginalGroupInformation29)
+		r.OrgnlGrpInf.DeepCopyInto(out.OrgnlGrpInf)
+	}
+	if r.OrgnlStmtId != nil {
+		out.OrgnlStmtId = new(common.Max35Text)
+		*out.OrgnlStmtId = *r.OrgnlStmtId
+	}
+	if r.UETR != nil {
+		out.UETR = new(common.UUIDv4Identifier)
+		*out.UETR = *r.UETR
+	}
+	if r.AcctSvcrRef != nil {
+		out.AcctSvcrRef = new(common.Max35Text)
+		*out.AcctSvcrRef = *r.AcctSvcrRef
+	}
+	if r.CrrctdAmt != nil {
+		out.CrrctdAmt = new(ActiveOrHistoricCurrencyAndAmount)
+		*out.CrrctdAmt = *r.CrrctdAmt
+	}
+	if r.Chrgs != nil {
+		out.Chrgs = make([]Charges6, len(r.Chrgs))
+		for i := range r.Chrgs {
+			r.Chrgs[i].DeepCopyInto(&out.Chrgs[i])
+		}
+	}
+	if r.Purp != nil {
+		out.Purp = new(Purpose2Choice)
+		*out.Purp = *r.Purp
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *StructuredRemittanceInformation16) DeepCopy() *StructuredRemittanceInformation16 {
+	if r == nil {
+		return nil
+	}
+	out := new(StructuredRemittanceInformation16)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *StructuredRemittanceInformation16) DeepCopyInto(out *StructuredRemittanceInformation16) {
+	*out = *r
+	if r.RfrdDocInf != nil {
+		out.RfrdDocInf = make([]ReferredDocumentInformation7, len(r.RfrdDocInf))
+		for i := range r.RfrdDocInf {
+			r.RfrdDocInf[i].DeepCopyInto(&out.RfrdDocInf[i])
+		}
+	}
+	if r.RfrdDocAmt != nil {
+		out.RfrdDocAmt = new(RemittanceAmount2)
+		r.RfrdDocAmt.DeepCopyInto(out.RfrdDocAmt)
+	}
+	if r.CdtrRefInf != nil {
+		out.CdtrRefInf = new(CreditorReferenceInformation2)
+		r.CdtrRefInf.DeepCopyInto(out.CdtrRefInf)
+	}
+	if r.Invcr != nil {
+		out.Invcr = new(PartyIdentification135)
+		r.Invcr.DeepCopyInto(out.Invcr)
+	}
+	if r.Invcee != nil {
+		out.Invcee = new(PartyIdentification135)
+		r.Invcee.DeepCopyInto(out.Invcee)
+	}
+	if r.TaxRmt != nil {
+		out.TaxRmt = new(TaxInformation7)
+		r.TaxRmt.DeepCopyInto(out.TaxRmt)
+	}
+	if r.GrnshmtRmt != nil {
+		out.GrnshmtRmt = new(Garnishment3)
+		r.GrnshmtRmt.DeepCopyInto(out.GrnshmtRmt)
+	}
+	if r.AddtlRmtInf != nil {
+		out.AddtlRmtInf = make([]common.Max140Text, len(r.AddtlRmtInf))
+		copy(out.AddtlRmtInf, r.AddtlRmtInf)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *SupplementaryData1) DeepCopy() *SupplementaryData1 {
+	if r == nil {
+		return nil
+	}
+	out := new(SupplementaryData1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *SupplementaryData1) DeepCopyInto(out *SupplementaryData1) {
+	*out = *r
+	if r.PlcAndNm != nil {
+		out.PlcAndNm = new(common.Max350Text)
+		*out.PlcAndNm = *r.PlcAndNm
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *SupplementaryDataEnvelope1) DeepCopy() *SupplementaryDataEnvelope1 {
+	if r == nil {
+		return nil
+	}
+	out := new(SupplementaryDataEnvelope1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *SupplementaryDataEnvelope1) DeepCopyInto(out *SupplementaryDataEnvelope1) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *TaxAmount2) DeepCopy() *TaxAmount2 {
+	if r == nil {
+		return nil
+	}
+	out := new(TaxAmount2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *TaxAmount2) DeepCopyInto(out *TaxAmount2) {
+	*out = *r
+	if r.TaxblBaseAmt != nil {
+		out.TaxblBaseAmt = new(ActiveOrHistoricCurrencyAndAmount)
+		*out.TaxblBaseAmt = *r.TaxblBaseAmt
+	}
+	if r.TtlAmt != nil {
+		out.TtlAmt = new(ActiveOrHistoricCurrencyAndAmount)
+		*out.TtlAmt = *r.TtlAmt
+	}
+	if r.Dtls != nil {
+		out.Dtls = make([]TaxRecordDetails2, len(r.Dtls))
+		for i := range r.Dtls {
+			r.Dtls[i].DeepCopyInto(&out.Dtls[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *TaxAmountAndType1) DeepCopy() *TaxAmountAndType1 {
+	if r == nil {
+		return nil
+	}
+	out := new(TaxAmountAndType1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *TaxAmountAndType1) DeepCopyInto(out *TaxAmountAndType1) {
+	*out = *r
+	if r.Tp != nil {
+		out.Tp = new(TaxAmountType1Choice)
+		*out.Tp = *r.Tp
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *TaxAmountType1Choice) DeepCopy() *TaxAmountType1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(TaxAmountType1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *TaxAmountType1Choice) DeepCopyInto(out *TaxAmountType1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *TaxAuthorisation1) DeepCopy() *TaxAuthorisation1 {
+	if r == nil {
+		return nil
+	}
+	out := new(TaxAuthorisation1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *TaxAuthorisation1) DeepCopyInto(out *TaxAuthorisation1) {
+	*out = *r
+	if r.Titl != nil {
+		out.Titl = new(common.Max35Text)
+		*out.Titl = *r.Titl
+	}
+	if r.Nm != nil {
+		out.Nm = new(common.Max140Text)
+		*out.Nm = *r.Nm
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *TaxCharges2) DeepCopy() *TaxCharges2 {
+	if r == nil {
+		return nil
+	}
+	out := new(TaxCharges2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *TaxCharges2) DeepCopyInto(out *TaxCharges2) {
+	*out = *r
+	if r.Id != nil {
+		out.Id = new(common.Max35Text)
+		*out.Id = *r.Id
+	}
+	if r.Amt != nil {
+		out.Amt = new(ActiveOrHistoricCurrencyAndAmount)
+		*out.Amt = *r.Amt
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *TaxInformation7) DeepCopy() *TaxInformation7 {
+	if r == nil {
+		return nil
+	}
+	out := new(TaxInformation7)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *TaxInformation7) DeepCopyInto(out *TaxInformation7) {
+	*out = *r
+	if r.Cdtr != nil {
+		out.Cdtr = new(TaxParty1)
+		r.Cdtr.DeepCopyInto(out.Cdtr)
+	}
+	if r.Dbtr != nil {
+		out.Dbtr = new(TaxParty2)
+		r.Dbtr.DeepCopyInto(out.Dbtr)
+	}
+	if r.UltmtDbtr != nil {
+		out.UltmtDbtr = new(TaxParty2)
+		r.UltmtDbtr.DeepCopyInto(out.UltmtDbtr)
+	}
+	if r.AdmstnZone != nil {
+		out.AdmstnZone = new(common.Max35Text)
+		*out.AdmstnZone = *r.AdmstnZone
+	}
+	if r.RefNb != nil {
+		out.RefNb = new(common.Max140Text)
+		*out.RefNb = *r.RefNb
+	}
+	if r.Mtd != nil {
+		out.Mtd = new(common.Max35Text)
+		*out.Mtd = *r.Mtd
+	}
+	if r.TtlTaxblBaseAmt != nil {
+		out.TtlTaxblBaseAmt = new(ActiveOrHistoricCurrencyAndAmount)
+		*out.TtlTaxblBaseAmt = *r.TtlTaxblBaseAmt
+	}
+	if r.TtlTaxAmt != nil {
+		out.TtlTaxAmt = new(ActiveOrHistoricCurrencyAndAmount)
+		*out.TtlTaxAmt = *r.TtlTaxAmt
+	}
+	if r.Dt != nil {
+		out.Dt = new(common.ISODate)
+		*out.Dt = *r.Dt
+	}
+	if r.Rcrd != nil {
+		out.Rcrd = make([]TaxRecord2, len(r.Rcrd))
+		for i := range r.Rcrd {
+			r.Rcrd[i].DeepCopyInto(&out.Rcrd[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *TaxParty1) DeepCopy() *TaxParty1 {
+	if r == nil {
+		return nil
+	}
+	out := new(TaxParty1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *TaxParty1) DeepCopyInto(out *TaxParty1) {
+	*out = *r
+	if r.TaxId != nil {
+		out.TaxId = new(common.Max35Text)
+		*out.TaxId = *r.TaxId
+	}
+	if r.RegnId != nil {
+		out.RegnId = new(common.Max35Text)
+		*out.RegnId = *r.RegnId
+	}
+	if r.TaxTp != nil {
+		out.TaxTp = new(common.Max35Text)
+		*out.TaxTp = *r.TaxTp
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *TaxParty2) DeepCopy() *TaxParty2 {
+	if r == nil {
+		return nil
+	}
+	out := new(TaxParty2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *TaxParty2) DeepCopyInto(out *TaxParty2) {
+	*out = *r
+	if r.TaxId != nil {
+		out.TaxId = new(common.Max35Text)
+		*out.TaxId = *r.TaxId
+	}
+	if r.RegnId != nil {
+		out.RegnId = new(common.Max35Text)
+		*out.RegnId = *r.RegnId
+	}
+	if r.TaxTp != nil {
+		out.TaxTp = new(common.Max35Text)
+		*out.TaxTp = *r.TaxTp
+	}
+	if r.Authstn != nil {
+		out.Authstn = new(TaxAuthorisation1)
+		r.Authstn.DeepCopyInto(out.Authstn)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *TaxPeriod2) DeepCopy() *TaxPeriod2 {
+	if r == nil {
+		return nil
+	}
+	out := new(TaxPeriod2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *TaxPeriod2) DeepCopyInto(out *TaxPeriod2) {
+	*out = *r
+	if r.Yr != nil {
+		out.Yr = new(common.ISODate)
+		*out.Yr = *r.Yr
+	}
+	if r.Tp != nil {
+		out.Tp = new(TaxRecordPeriod1Code)
+		*out.Tp = *r.Tp
+	}
+	if r.FrToDt != nil {
+		out.FrToDt = new(DatePeriod2)
+		*out.FrToDt = *r.FrToDt
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *TaxRecord2) DeepCopy() *TaxRecord2 {
+	if r == nil {
+		return nil
+	}
+	out := new(TaxRecord2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *TaxRecord2) DeepCopyInto(out *TaxRecord2) {
+	*out = *r
+	if r.Tp != nil {
+		out.Tp = new(common.Max35Text)
+		*out.Tp = *r.Tp
+	}
+	if r.Ctgy != nil {
+		out.Ctgy = new(common.Max35Text)
+		*out.Ctgy = *r.Ctgy
+	}
+	if r.CtgyDtls != nil {
+		out.CtgyDtls = new(common.Max35Text)
+		*out.CtgyDtls = *r.CtgyDtls
+	}
+	if r.DbtrSts != nil {
+		out.DbtrSts = new(common.Max35Text)
+		*out.DbtrSts = *r.DbtrSts
+	}
+	if r.CertId != nil {
+		out.CertId = new(common.Max35Text)
+		*out.CertId = *r.CertId
+	}
+	if r.FrmsCd != nil {
+		out.FrmsCd = new(common.Max35Text)
+		*out.FrmsCd = *r.FrmsCd
+	}
+	if r.Prd != nil {
+		out.Prd = new(TaxPeriod2)
+		r.Prd.DeepCopyInto(out.Prd)
+	}
+	if r.TaxAmt != nil {
+		out.TaxAmt = new(TaxAmount2)
+		r.TaxAmt.DeepCopyInto(out.TaxAmt)
+	}
+	if r.AddtlInf != nil {
+		out.AddtlInf = new(common.Max140Text)
+		*out.AddtlInf = *r.AddtlInf
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *TaxRecordDetails2) DeepCopy() *TaxRecordDetails2 {
+	if r == nil {
+		return nil
+	}
+	out := new(TaxRecordDetails2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *TaxRecordDetails2) DeepCopyInto(out *TaxRecordDetails2) {
+	*out = *r
+	if r.Prd != nil {
+		out.Prd = new(TaxPeriod2)
+		r.Prd.DeepCopyInto(out.Prd)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *UnderlyingGroupInformation1) DeepCopy() *UnderlyingGroupInformation1 {
+	if r == nil {
+		return nil
+	}
+	out := new(UnderlyingGroupInformation1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *UnderlyingGroupInformation1) DeepCopyInto(out *UnderlyingGroupInformation1) {
+	*out = *r
+	if r.OrgnlCreDtTm != nil {
+		out.OrgnlCreDtTm = new(common.ISODateTime)
+		*out.OrgnlCreDtTm = *r.OrgnlCreDtTm
+	}
+	if r.OrgnlMsgDlvryChanl != nil {
+		out.OrgnlMsgDlvryChanl = new(common.Max35Text)
+		*out.OrgnlMsgDlvryChanl = *r.OrgnlMsgDlvryChanl
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *UnderlyingPaymentInstruction5) DeepCopy() *UnderlyingPaymentInstruction5 {
+	if r == nil {
+		return nil
+	}
+	out := new(UnderlyingPaymentInstruction5)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *UnderlyingPaymentInstruction5) DeepCopyInto(out *UnderlyingPaymentInstruction5) {
+	*out = *r
+	if r.OrgnlGrpInf != nil {
+		out.OrgnlGrpInf = new(UnderlyingGroupInformation1)
+		r.OrgnlGrpInf.DeepCopyInto(out.OrgnlGrpInf)
+	}
+	if r.OrgnlPmtInfId != nil {
+		out.OrgnlPmtInfId = new(common.Max35Text)
+		*out.OrgnlPmtInfId = *r.OrgnlPmtInfId
+	}
+	if r.OrgnlInstrId != nil {
+		out.OrgnlInstrId = new(common.Max35Text)
+		*out.OrgnlInstrId = *r.OrgnlInstrId
+	}
+	if r.OrgnlEndToEndId != nil {
+		out.OrgnlEndToEndId = new(common.Max35Text)
+		*out.OrgnlEndToEndId = *r.OrgnlEndToEndId
+	}
+	if r.OrgnlUETR != nil {
+		out.OrgnlUETR = new(common.UUIDv4Identifier)
+		*out.OrgnlUETR = *r.OrgnlUETR
+	}
+	if r.ReqdExctnDt != nil {
+		out.ReqdExctnDt = new(DateAndDateTime2Choice)
+		r.ReqdExctnDt.DeepCopyInto(out.ReqdExctnDt)
+	}
+	if r.ReqdColltnDt != nil {
+		out.ReqdColltnDt = new(common.ISODate)
+		*out.ReqdColltnDt = *r.ReqdColltnDt
+	}
+	if r.OrgnlTxRef != nil {
+		out.OrgnlTxRef = new(OriginalTransactionReference28)
+		r.OrgnlTxRef.DeepCopyInto(out.OrgnlTxRef)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *UnderlyingPaymentTransaction4) DeepCopy() *UnderlyingPaymentTransaction4 {
+	if r == nil {
+		return nil
+	}
+	out := new(UnderlyingPaymentTransaction4)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *UnderlyingPaymentTransaction4) DeepCopyInto(out *UnderlyingPaymentTransaction4) {
+	*out = *r
+	if r.OrgnlGrpInf != nil {
+		out.OrgnlGrpInf = new(UnderlyingGroupInformation1)
+		r.OrgnlGrpInf.DeepCopyInto(out.OrgnlGrpInf)
+	}
+	if r.OrgnlInstrId != nil {
+		out.OrgnlInstrId = new(common.Max35Text)
+		*out.OrgnlInstrId = *r.OrgnlInstrId
+	}
+	if r.OrgnlEndToEndId != nil {
+		out.OrgnlEndToEndId = new(common.Max35Text)
+		*out.OrgnlEndToEndId = *r.OrgnlEndToEndId
+	}
+	if r.OrgnlTxId != nil {
+		out.OrgnlTxId = new(common.Max35Text)
+		*out.OrgnlTxId = *r.OrgnlTxId
+	}
+	if r.OrgnlUETR != nil {
+		out.OrgnlUETR = new(common.UUIDv4Identifier)
+		*out.OrgnlUETR = *r.OrgnlUETR
+	}
+	if r.OrgnlIntrBkSttlmAmt != nil {
+		out.OrgnlIntrBkSttlmAmt = new(ActiveOrHistoricCurrencyAndAmount)
+		*out.OrgnlIntrBkSttlmAmt = *r.OrgnlIntrBkSttlmAmt
+	}
+	if r.OrgnlIntrBkSttlmDt != nil {
+		out.OrgnlIntrBkSttlmDt = new(common.ISODate)
+		*out.OrgnlIntrBkSttlmDt = *r.OrgnlIntrBkSttlmDt
+	}
+	if r.OrgnlTxRef != nil {
+		out.OrgnlTxRef = new(OriginalTransactionReference28)
+		r.OrgnlTxRef.DeepCopyInto(out.OrgnlTxRef)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *UnderlyingStatementEntry3) DeepCopy() *UnderlyingStatementEntry3 {
+	if r == nil {
+		return nil
+	}
+	out := new(UnderlyingStatementEntry3)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *UnderlyingStatementEntry3) DeepCopyInto(out *UnderlyingStatementEntry3) {
+	*out = *r
+	if r.OrgnlGrpInf != nil {
+		out.OrgnlGrpInf = new(OriginalGroupInformation29)
+		r.OrgnlGrpInf.DeepCopyInto(out.OrgnlGrpInf)
+	}
+	if r.OrgnlStmtId != nil {
+		out.OrgnlStmtId = new(common.Max35Text)
+		*out.OrgnlStmtId = *r.OrgnlStmtId
+	}
+	if r.OrgnlNtryId != nil {
+		out.OrgnlNtryId = new(common.Max35Text)
+		*out.OrgnlNtryId = *r.OrgnlNtryId
+	}
+	if r.OrgnlUETR != nil {
+		out.OrgnlUETR = new(common.UUIDv4Identifier)
+		*out.OrgnlUETR = *r.OrgnlUETR
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *UnderlyingTransaction22) DeepCopy() *UnderlyingTransaction22 {
+	if r == nil {
+		return nil
+	}
+	out := new(UnderlyingTransaction22)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *UnderlyingTransaction22) DeepCopyInto(out *UnderlyingTransaction22) {
+	*out = *r
+	if r.OrgnlGrpInfAndSts != nil {
+		out.OrgnlGrpInfAndSts = new(OriginalGroupHeader14)
+		r.OrgnlGrpInfAndSts.DeepCopyInto(out.OrgnlGrpInfAndSts)
+	}
+	if r.OrgnlPmtInfAndSts != nil {
+		out.OrgnlPmtInfAndSts = make([]OriginalPaymentInstruction30, len(r.OrgnlPmtInfAndSts))
+		for i := range r.OrgnlPmtInfAndSts {
+			r.OrgnlPmtInfAndSts[i].DeepCopyInto(&out.OrgnlPmtInfAndSts[i])
+		}
+	}
+	if r.TxInfAndSts != nil {
+		out.TxInfAndSts = make([]PaymentTransaction102, len(r.TxInfAndSts))
+		for i := range r.TxInfAndSts {
+			r.TxInfAndSts[i].DeepCopyInto(&out.TxInfAndSts[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *UnderlyingTransaction26) DeepCopy() *UnderlyingTransaction26 {
+	if r == nil {
+		return nil
+	}
+	out := new(UnderlyingTransaction26)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *UnderlyingTransaction26) DeepCopyInto(out *UnderlyingTransaction26) {
+	*out = *r
+	if r.OrgnlGrpInfAndCxl != nil {
+		out.OrgnlGrpInfAndCxl = new(OriginalGroupHeader15)
+		r.OrgnlGrpInfAndCxl.DeepCopyInto(out.OrgnlGrpInfAndCxl)
+	}
+	if r.TxInf != nil {
+		out.TxInf = make([]PaymentTransaction120, len(r.TxInf))
+		for i := range r.TxInf {
+			r.TxInf[i].DeepCopyInto(&out.TxInf[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *UnderlyingTransaction27) DeepCopy() *UnderlyingTransaction27 {
+	if r == nil {
+		return nil
+	}
+	out := new(UnderlyingTransaction27)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *UnderlyingTransaction27) DeepCopyInto(out *UnderlyingTransaction27) {
+	*out = *r
+	if r.OrgnlGrpInfAndCxl != nil {
+		out.OrgnlGrpInfAndCxl = new(OriginalGroupHeader15)
+		r.OrgnlGrpInfAndCxl.DeepCopyInto(out.OrgnlGrpInfAndCxl)
+	}
+	if r.OrgnlPmtInfAndCxl != nil {
+		out.OrgnlPmtInfAndCxl = make([]OriginalPaymentInstruction36, len(r.OrgnlPmtInfAndCxl))
+		for i := range r.OrgnlPmtInfAndCxl {
+			r.OrgnlPmtInfAndCxl[i].DeepCopyInto(&out.OrgnlPmtInfAndCxl[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *UnderlyingTransaction5Choice) DeepCopy() *UnderlyingTransaction5Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(UnderlyingTransaction5Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *UnderlyingTransaction5Choice) DeepCopyInto(out *UnderlyingTransaction5Choice) {
+	*out = *r
+	if r.Initn != nil {
+		out.Initn = new(UnderlyingPaymentInstruction5)
+		r.Initn.DeepCopyInto(out.Initn)
+	}
+	if r.IntrBk != nil {
+		out.IntrBk = new(UnderlyingPaymentTransaction4)
+		r.IntrBk.DeepCopyInto(out.IntrBk)
+	}
+	if r.StmtNtry != nil {
+		out.StmtNtry = new(UnderlyingStatementEntry3)
+		r.StmtNtry.DeepCopyInto(out.StmtNtry)
+	}
+}
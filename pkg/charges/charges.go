@@ -0,0 +1,347 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package charges
+
+/*
+	Package charges recomputes the amounts of a payment along its chain of agents from
+	the charge bearer and the charges of the agents, and checks the amounts received in
+	pacs.008 transfers and camt.054 notifications against them.
+*/
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/moov-io/iso20022/pkg/document"
+	"github.com/moov-io/iso20022/pkg/utils"
+)
+
+// Charge bearers (ChrgBr)
+const (
+	BearerDebtor       = "DEBT"
+	BearerCreditor     = "CRED"
+	BearerShared       = "SHAR"
+	BearerServiceLevel = "SLEV"
+)
+
+var (
+	// ErrUnsupportedMessage is returned when a document isn't a pacs.008 or camt.054
+	ErrUnsupportedMessage = errors.New("message type doesn't have charges to check")
+
+	// Tolerance is the difference between an amount and its expectation ignored as rounding
+	Tolerance = 0.005
+)
+
+// Charge is a charge taken by an agent of the payment chain
+type Charge struct {
+	// Agent is the BIC of the agent taking the charge
+	Agent    string `json:",omitempty"`
+	Amount   float64
+	Currency string `json:",omitempty"`
+
+	// Bearer overrides the bearer of the payment for this charge
+	Bearer string `json:",omitempty"`
+
+	// Included tells whether the charge is deducted from the amount, the bearer decides when nil
+	Included *bool `json:",omitempty"`
+}
+
+// Deducted reports whether charge is deducted from the transferred amount when the payment
+// has bearer and debtorAgent is the BIC of the debtor agent. Charges borne by the creditor
+// are deducted, shared charges unless the debtor agent takes them and charges borne by the
+// debtor never.
+func Deducted(bearer, debtorAgent string, charge Charge) bool {
+	if charge.Included != nil {
+		return *charge.Included
+	}
+	if charge.Bearer != "" {
+		bearer = charge.Bearer
+	}
+	switch bearer {
+	case BearerCreditor:
+		return true
+	case BearerShared, BearerServiceLevel:
+		return debtorAgent == "" || charge.Agent != debtorAgent
+	}
+	return false
+}
+
+// Expectation are the amounts of a payment along its chain of agents
+type Expectation struct {
+	Instructed float64
+
+	// Amounts are the amounts transferred after each charge
+	Amounts []float64 `json:",omitempty"`
+
+	Deducted float64
+	Credited float64
+}
+
+// Expect recomputes the amounts of a payment of instructed after charges are taken in order
+// along the chain, all amounts are in the same currency
+func Expect(instructed float64, bearer, debtorAgent string, charges []Charge) Expectation {
+	exp := Expectation{Instructed: instructed}
+	for _, charge := range charges {
+		if Deducted(bearer, debtorAgent, charge) {
+			exp.Deducted += charge.Amount
+		}
+		exp.Amounts = append(exp.Amounts, round(instructed-exp.Deducted))
+	}
+	exp.Deducted = round(exp.Deducted)
+	exp.Credited = round(instructed - exp.Deducted)
+	return exp
+}
+
+// Result is the check of the amount of a transaction against its expectation
+type Result struct {
+	Path       string
+	EndToEndId string `json:",omitempty"`
+	Currency   string
+	Bearer     string   `json:",omitempty"`
+	Charges    []Charge `json:",omitempty"`
+
+	Instructed float64
+	Expected   float64
+	Actual     float64
+}
+
+// Difference is the actual amount less the expected amount
+func (r Result) Difference() float64 {
+	return round(r.Actual - r.Expected)
+}
+
+// Unexpected reports whether more than the expected charges were deducted from the amount
+func (r Result) Unexpected() bool {
+	return r.Difference() < -Tolerance
+}
+
+// Err describes an unexpected deduction
+func (r Result) Err() error {
+	if !r.Unexpected() {
+		return nil
+	}
+	return fmt.Errorf("unexpected deduction of %.2f %s, expected %.2f and received %.2f", -r.Difference(), r.Currency, r.Expected, r.Actual)
+}
+
+// Check recomputes the amounts of the transactions of doc, a pacs.008 or camt.054, and
+// compares them with the received amounts
+//
+// The interbank settlement amount of a pacs.008 transaction is expected to be the instructed
+// amount, converted with the exchange rate, less the deducted charges. The amount of camt.054
+// entry details is expected to be their instructed amount less the deducted charge records.
+// Transactions without instructed amount or with charges in another currency are skipped.
+func Check(doc document.Iso20022Document) ([]Result, error) {
+	msgType := utils.GetMessageType(doc.NameSpace())
+	switch {
+	case strings.HasPrefix(msgType, "pacs.008"):
+		return checkTransfers(doc), nil
+	case strings.HasPrefix(msgType, "camt.054"):
+		return checkNotifications(doc), nil
+	}
+	return nil, fmt.Errorf("%w: %s", ErrUnsupportedMessage, msgType)
+}
+
+// Unexpected returns the results of Check with unexpected deductions
+func Unexpected(results []Result) []Result {
+	var found []Result
+	for _, result := range results {
+		if result.Unexpected() {
+			found = append(found, result)
+		}
+	}
+	return found
+}
+
+func checkTransfers(doc document.Iso20022Document) []Result {
+	var results []Result
+	for _, tx := range utils.GetTransactions(doc.InspectMessage()) {
+		if !strings.HasPrefix(tx.Path, "CdtTrfTxInf") {
+			continue
+		}
+		instructed, ok := amount(tx.Lookup("CdtTrfTxInf/InstdAmt"))
+		if !ok {
+			continue
+		}
+		settled, _ := amount(tx.Lookup("CdtTrfTxInf/IntrBkSttlmAmt"))
+		currency := tx.Lookup("CdtTrfTxInf/IntrBkSttlmAmt/@Ccy")
+		instructedCurrency := tx.Lookup("CdtTrfTxInf/InstdAmt/@Ccy")
+
+		rate := 1.0
+		if instructedCurrency != currency {
+			if rate, ok = amount(tx.Lookup("CdtTrfTxInf/XchgRate")); !ok {
+				continue
+			}
+		}
+
+		// charges are converted to the settlement currency
+		charges := collect(tx.Elements, tx.Path+"/ChrgsInf[")
+		for i := range charges {
+			switch charges[i].Currency {
+			case "", currency:
+			case instructedCurrency:
+				charges[i].Amount = round(charges[i].Amount * rate)
+			default:
+				ok = false
+			}
+		}
+		if !ok {
+			continue
+		}
+
+		bearer := tx.Lookup("CdtTrfTxInf/ChrgBr", "PmtTpInf/ChrgBr")
+		exp := Expect(round(instructed*rate), bearer, tx.Lookup("CdtTrfTxInf/DbtrAgt/FinInstnId/BICFI"), charges)
+		results = append(results, Result{
+			Path:       tx.Path,
+			EndToEndId: tx.Lookup("CdtTrfTxInf/PmtId/EndToEndId"),
+			Currency:   currency,
+			Bearer:     bearer,
+			Charges:    charges,
+			Instructed: instructed,
+			Expected:   exp.Credited,
+			Actual:     settled,
+		})
+	}
+	return results
+}
+
+func checkNotifications(doc document.Iso20022Document) []Result {
+	var results []Result
+	for _, tx := range utils.GetTransactions(doc.InspectMessage()) {
+		if tx.Path == "" {
+			continue
+		}
+
+		// entry details, the entry itself when it has a single transaction
+		details := detailPaths(tx)
+		if len(details) == 0 {
+			details = []string{tx.Path}
+		}
+		for _, path := range details {
+			elements := tx.Elements
+			if len(details) > 1 {
+				elements = within(tx.Elements, path)
+			}
+			detail := utils.Transaction{Path: path, Elements: elements}
+
+			instructed, ok := amount(detail.Lookup("TxDtls/AmtDtls/InstdAmt/Amt", "Ntry/AmtDtls/InstdAmt/Amt"))
+			if !ok {
+				continue
+			}
+			currency := detail.Lookup("TxDtls/Amt/@Ccy", "Ntry/Amt/@Ccy")
+			if detail.Lookup("TxDtls/AmtDtls/InstdAmt/Amt/@Ccy", "Ntry/AmtDtls/InstdAmt/Amt/@Ccy") != currency {
+				continue
+			}
+			actual, _ := amount(detail.Lookup("TxDtls/Amt", "Ntry/Amt"))
+
+			// the charges of the entry are the charges of its single transaction
+			charges := collect(elements, path+"/Chrgs/Rcrd[")
+			if len(charges) == 0 && len(details) == 1 {
+				charges = collect(elements, tx.Path+"/Chrgs/Rcrd[")
+			}
+			skip := false
+			for _, charge := range charges {
+				skip = skip || (charge.Currency != "" && charge.Currency != currency)
+			}
+			if skip {
+				continue
+			}
+
+			exp := Expect(instructed, "", detail.Lookup("RltdAgts/DbtrAgt/FinInstnId/BICFI"), charges)
+			results = append(results, Result{
+				Path:       path,
+				EndToEndId: detail.Lookup("Refs/EndToEndId"),
+				Currency:   currency,
+				Charges:    charges,
+				Instructed: instructed,
+				Expected:   exp.Credited,
+				Actual:     actual,
+			})
+		}
+	}
+	return results
+}
+
+// detailPaths returns the paths of the entry details (TxDtls) of an entry
+func detailPaths(tx utils.Transaction) []string {
+	var paths []string
+	seen := make(map[string]bool)
+	for _, elm := range tx.Elements {
+		idx := strings.Index(elm.Path, "/TxDtls[")
+		if idx < 0 || !strings.HasPrefix(elm.Path, tx.Path+"/") {
+			continue
+		}
+		end := idx + strings.Index(elm.Path[idx:], "]") + 1
+		if path := elm.Path[:end]; !seen[path] {
+			seen[path] = true
+			paths = append(paths, path)
+		}
+	}
+	return paths
+}
+
+// within returns the elements under path
+func within(elements []utils.Element, path string) []utils.Element {
+	var found []utils.Element
+	for _, elm := range elements {
+		if strings.HasPrefix(elm.Path, path+"/") {
+			found = append(found, elm)
+		}
+	}
+	return found
+}
+
+// collect returns the charges of the repeated elements starting with prefix, a path
+// ending with the opening bracket of the index, e.g. CdtTrfTxInf[0]/ChrgsInf[
+func collect(elements []utils.Element, prefix string) []Charge {
+	var charges []Charge
+	index := make(map[string]int)
+	for _, elm := range elements {
+		if !strings.HasPrefix(elm.Path, prefix) {
+			continue
+		}
+		rest := elm.Path[len(prefix):]
+		end := strings.Index(rest, "]")
+		if end < 0 {
+			continue
+		}
+		key, field := rest[:end], strings.TrimPrefix(rest[end+1:], "/")
+		i, exists := index[key]
+		if !exists {
+			i = len(charges)
+			index[key] = i
+			charges = append(charges, Charge{})
+		}
+
+		switch field {
+		case "Amt":
+			charges[i].Amount, _ = amount(elm.Value)
+		case "Amt/@Ccy":
+			charges[i].Currency = elm.Value
+		case "Agt/FinInstnId/BICFI":
+			charges[i].Agent = elm.Value
+		case "Br":
+			charges[i].Bearer = elm.Value
+		case "ChrgInclInd":
+			included := elm.Value == "true"
+			charges[i].Included = &included
+		}
+	}
+	return charges
+}
+
+func amount(value string) (float64, bool) {
+	if value == "" {
+		return 0, false
+	}
+	f, err := strconv.ParseFloat(value, 64)
+	return f, err == nil
+}
+
+func round(amount float64) float64 {
+	return math.Round(amount*1e5) / 1e5
+}
@@ -0,0 +1,76 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package charges
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/moov-io/iso20022/pkg/iso20022test/fixtures"
+)
+
+func TestExpect(t *testing.T) {
+	charges := []Charge{
+		{Agent: "DEUTDEFF", Amount: 5},
+		{Agent: "COBADEFF", Amount: 15},
+		{Agent: "BNPAFRPP", Amount: 2.5},
+	}
+
+	exp := Expect(1000, BearerShared, "DEUTDEFF", charges)
+	require.Equal(t, Expectation{Instructed: 1000, Amounts: []float64{1000, 985, 982.5}, Deducted: 17.5, Credited: 982.5}, exp)
+
+	require.Equal(t, 977.5, Expect(1000, BearerCreditor, "DEUTDEFF", charges).Credited)
+	require.Equal(t, 1000.0, Expect(1000, BearerDebtor, "DEUTDEFF", charges).Credited)
+
+	// the charge overrides the bearer of the payment
+	included := false
+	charges[1].Included = &included
+	charges[2].Bearer = BearerDebtor
+	require.Equal(t, 995.0, Expect(1000, BearerCreditor, "DEUTDEFF", charges).Credited)
+}
+
+func TestCheckTransfers(t *testing.T) {
+	results, err := Check(fixtures.Document(t, "charges_pacs_v08.xml"))
+	require.Nil(t, err)
+	require.Len(t, results, 2)
+
+	require.Equal(t, "CdtTrfTxInf[0]", results[0].Path)
+	require.Equal(t, BearerShared, results[0].Bearer)
+	require.Equal(t, 985.0, results[0].Expected)
+	require.False(t, results[0].Unexpected())
+	require.Nil(t, results[0].Err())
+
+	// charges borne by the debtor are deducted
+	unexpected := Unexpected(results)
+	require.Len(t, unexpected, 1)
+	require.Equal(t, "E2E-CHRGS-0002", unexpected[0].EndToEndId)
+	require.Equal(t, -10.0, unexpected[0].Difference())
+	require.EqualError(t, unexpected[0].Err(), "unexpected deduction of 10.00 EUR, expected 500.00 and received 490.00")
+
+	// transfers without instructed amount can't be checked
+	results, err = Check(fixtures.Document(t, "fednow_pacs_v08.xml"))
+	require.Nil(t, err)
+	require.Empty(t, results)
+}
+
+func TestCheckNotifications(t *testing.T) {
+	results, err := Check(fixtures.Document(t, "charges_camt054_v08.xml"))
+	require.Nil(t, err)
+	require.Len(t, results, 2)
+
+	require.Equal(t, "Ntfctn[0]/Ntry[0]/NtryDtls[0]/TxDtls[0]", results[0].Path)
+	require.Len(t, results[0].Charges, 2)
+	require.Equal(t, 980.0, results[0].Expected)
+	require.False(t, results[0].Unexpected())
+
+	require.Equal(t, "E2E-CHRGS-0002", results[1].EndToEndId)
+	require.Equal(t, 495.0, results[1].Expected)
+	require.Equal(t, 485.0, results[1].Actual)
+	require.True(t, results[1].Unexpected())
+
+	_, err = Check(fixtures.Document(t, "valid_acmt_v03.xml"))
+	require.ErrorIs(t, err, ErrUnsupportedMessage)
+}
@@ -0,0 +1,311 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package corridor
+
+/*
+	Corridor flags or blocks the payments of corridors, from the country of the debtor to
+	the country of the creditor in a currency, before they're converted or forwarded. Rules
+	are defined in yaml, a rule matches the payments of every listed value of its criteria:
+
+		name: sanctions
+		rules:
+		  - name: embargo
+		    creditorCountries: [IR, KP]
+		    action: block
+		    reason: embargoed country
+		  - name: usd-to-russia
+		    debtorCountries: [US]
+		    creditorCountries: [RU]
+		    currencies: [USD]
+		    action: flag
+
+	The country of a party is the country of its postal address, else of its IBAN, else of
+	the BIC of its agent.
+*/
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/moov-io/iso20022/pkg/document"
+	"github.com/moov-io/iso20022/pkg/enrich"
+	"github.com/moov-io/iso20022/pkg/pipeline"
+	"github.com/moov-io/iso20022/pkg/utils"
+)
+
+// Action is what a matching rule does to a payment
+type Action string
+
+const (
+	// ActionFlag reports the payment for review, it's processed
+	ActionFlag Action = "flag"
+
+	// ActionBlock rejects the payment
+	ActionBlock Action = "block"
+)
+
+const (
+	// StageCorridor is the pipeline stage of the corridor checks
+	StageCorridor = "corridor"
+
+	// KeyFlag is the key of the enrichments of flagged payments, their value is the rule name
+	KeyFlag = "corridorFlag"
+)
+
+// ErrBlocked is returned for documents with a blocked payment
+var ErrBlocked = errors.New("blocked corridor")
+
+// paymentElements are the transaction elements checked as payments
+var paymentElements = []string{"CdtTrfTxInf", "DrctDbtTxInf"}
+
+// Rule flags or blocks the payments matching all of its criteria, an omitted criterion
+// matches every payment
+type Rule struct {
+	Name              string   `yaml:"name"`
+	DebtorCountries   []string `yaml:"debtorCountries"`
+	CreditorCountries []string `yaml:"creditorCountries"`
+	Currencies        []string `yaml:"currencies"`
+	Action            Action   `yaml:"action"`
+	Reason            string   `yaml:"reason"`
+}
+
+// Matches reports whether the rule applies to c
+func (r Rule) Matches(c Corridor) bool {
+	return matches(r.DebtorCountries, c.DebtorCountry) &&
+		matches(r.CreditorCountries, c.CreditorCountry) &&
+		matches(r.Currencies, c.Currency)
+}
+
+func matches(values []string, value string) bool {
+	if len(values) == 0 {
+		return true
+	}
+	for _, v := range values {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+	return false
+}
+
+// Corridor is the corridor of a payment
+type Corridor struct {
+	// Path of the transaction, e.g. CdtTrfTxInf[0]
+	Path            string `json:"path"`
+	DebtorCountry   string `json:"debtorCountry,omitempty"`
+	CreditorCountry string `json:"creditorCountry,omitempty"`
+	Currency        string `json:"currency,omitempty"`
+}
+
+func (c Corridor) String() string {
+	unknown := func(value string) string {
+		if value == "" {
+			return "?"
+		}
+		return value
+	}
+	return fmt.Sprintf("%s (%s to %s in %s)", c.Path, unknown(c.DebtorCountry), unknown(c.CreditorCountry), unknown(c.Currency))
+}
+
+// Hit is a rule matching a payment
+type Hit struct {
+	Rule     string   `json:"rule"`
+	Action   Action   `json:"action"`
+	Reason   string   `json:"reason,omitempty"`
+	Corridor Corridor `json:"corridor"`
+}
+
+func (h Hit) String() string {
+	s := fmt.Sprintf("corridor rule %s %ss %s", h.Rule, h.Action, h.Corridor)
+	if h.Reason != "" {
+		s += ": " + h.Reason
+	}
+	return s
+}
+
+// Result is the outcome of the checks of a document
+type Result struct {
+	Hits []Hit `json:"hits,omitempty"`
+}
+
+// Blocked returns the hits blocking a payment
+func (r Result) Blocked() []Hit {
+	return r.hits(ActionBlock)
+}
+
+// Flagged returns the hits flagging a payment
+func (r Result) Flagged() []Hit {
+	return r.hits(ActionFlag)
+}
+
+func (r Result) hits(action Action) []Hit {
+	var hits []Hit
+	for _, hit := range r.Hits {
+		if hit.Action == action {
+			hits = append(hits, hit)
+		}
+	}
+	return hits
+}
+
+// Err returns ErrBlocked with the blocking hits, nil when no payment is blocked
+func (r Result) Err() error {
+	blocked := r.Blocked()
+	if len(blocked) == 0 {
+		return nil
+	}
+	messages := make([]string, len(blocked))
+	for i, hit := range blocked {
+		messages[i] = hit.String()
+	}
+	return fmt.Errorf("%w: %s", ErrBlocked, strings.Join(messages, "; "))
+}
+
+// Policy is a named set of rules
+type Policy struct {
+	Name    string `yaml:"name"`
+	Version string `yaml:"version"`
+	Rules   []Rule `yaml:"rules"`
+}
+
+// NewPolicy returns the policy of rules
+func NewPolicy(name string, rules []Rule) (*Policy, error) {
+	p := &Policy{Name: name, Rules: rules}
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+func (p *Policy) validate() error {
+	names := make(map[string]bool)
+	for i := range p.Rules {
+		rule := &p.Rules[i]
+		if rule.Name == "" {
+			return fmt.Errorf("corridor rule %d: name is omitted", i)
+		}
+		if names[rule.Name] {
+			return fmt.Errorf("corridor rule %s: name is duplicated", rule.Name)
+		}
+		names[rule.Name] = true
+		rule.Action = Action(strings.ToLower(string(rule.Action)))
+		if rule.Action != ActionFlag && rule.Action != ActionBlock {
+			return fmt.Errorf("corridor rule %s: action %q is not flag or block", rule.Name, rule.Action)
+		}
+		if len(rule.DebtorCountries) == 0 && len(rule.CreditorCountries) == 0 && len(rule.Currencies) == 0 {
+			return fmt.Errorf("corridor rule %s: has no countries nor currencies", rule.Name)
+		}
+	}
+	return nil
+}
+
+// ParsePolicy reads a policy from yaml
+func ParsePolicy(buf []byte) (*Policy, error) {
+	var p Policy
+	if err := yaml.Unmarshal(buf, &p); err != nil {
+		return nil, err
+	}
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
+
+// LoadPolicy reads a policy from a yaml file
+func LoadPolicy(path string) (*Policy, error) {
+	buf, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	p, err := ParsePolicy(buf)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return p, nil
+}
+
+// Corridors returns the corridors of the payments of doc
+func Corridors(doc document.Iso20022Document) []Corridor {
+	var corridors []Corridor
+	for _, tx := range utils.GetTransactions(doc.InspectMessage()) {
+		if !isPayment(tx.Path) {
+			continue
+		}
+		corridors = append(corridors, Corridor{
+			Path:            tx.Path,
+			DebtorCountry:   country(tx, "Dbtr"),
+			CreditorCountry: country(tx, "Cdtr"),
+			Currency:        tx.Lookup("IntrBkSttlmAmt/@Ccy", "InstdAmt/@Ccy", "Amt/InstdAmt/@Ccy"),
+		})
+	}
+	return corridors
+}
+
+func isPayment(path string) bool {
+	name := utils.StripElementIndexes(path)
+	if i := strings.LastIndex(name, "/"); i >= 0 {
+		name = name[i+1:]
+	}
+	for _, element := range paymentElements {
+		if name == element {
+			return true
+		}
+	}
+	return false
+}
+
+// country returns the country of the party of role of tx
+func country(tx utils.Transaction, role string) string {
+	if ctry := tx.Lookup(role + "/PstlAdr/Ctry"); ctry != "" {
+		return strings.ToUpper(ctry)
+	}
+	if iban := tx.Lookup(role + "Acct/Id/IBAN"); len(iban) >= 2 {
+		return strings.ToUpper(iban[:2])
+	}
+	if bic := tx.Lookup(role+"Agt/FinInstnId/BICFI", role+"Agt/FinInstnId/BIC"); len(bic) >= 6 {
+		return strings.ToUpper(bic[4:6])
+	}
+	return ""
+}
+
+// Check returns the hits of the rules of p on the payments of doc
+func (p *Policy) Check(doc document.Iso20022Document) Result {
+	var result Result
+	for _, c := range Corridors(doc) {
+		for _, rule := range p.Rules {
+			if rule.Matches(c) {
+				result.Hits = append(result.Hits, Hit{Rule: rule.Name, Action: rule.Action, Reason: rule.Reason, Corridor: c})
+			}
+		}
+	}
+	return result
+}
+
+// Transform returns a pipeline transform failing the items with a blocked payment and
+// enriching the flagged payments of the others with KeyFlag:
+//
+//	pipeline.New(source, sink, pipeline.WithTransform(corridor.StageCorridor, policy.Transform()))
+func (p *Policy) Transform() pipeline.Transform {
+	return func(_ context.Context, item *pipeline.Item) error {
+		result := p.Check(item.Document)
+		if err := result.Err(); err != nil {
+			return err
+		}
+		for _, hit := range result.Flagged() {
+			item.Enrichments = append(item.Enrichments, enrich.Enrichment{
+				Path:   hit.Corridor.Path,
+				Key:    KeyFlag,
+				Value:  hit.Rule,
+				Source: StageCorridor,
+			})
+		}
+		return nil
+	}
+}
@@ -0,0 +1,135 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package corridor
+
+import (
+	"context"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/moov-io/iso20022/pkg/document"
+	"github.com/moov-io/iso20022/pkg/enrich"
+	"github.com/moov-io/iso20022/pkg/iso20022test/fixtures"
+	"github.com/moov-io/iso20022/pkg/pipeline"
+)
+
+func TestCorridors(t *testing.T) {
+	corridors := Corridors(fixtures.Document(t, "valid_pacs_v08.xml"))
+	require.Equal(t, []Corridor{
+		// countries of postal addresses
+		{Path: "CdtTrfTxInf[0]", DebtorCountry: "US", CreditorCountry: "GB", Currency: "USD"},
+		// countries of the BICs of the agents
+		{Path: "CdtTrfTxInf[1]", DebtorCountry: "US", CreditorCountry: "DE", Currency: "USD"},
+	}, corridors)
+	require.Equal(t, "CdtTrfTxInf[1] (US to DE in USD)", corridors[1].String())
+
+	// IBANs take precedence over BICs
+	buf := strings.Replace(string(fixtures.ReadFile(t, "valid_pacs_v08.xml")), "<Nm>Max Mustermann</Nm>\n\t\t\t</Cdtr>",
+		"<Nm>Max Mustermann</Nm>\n\t\t\t</Cdtr><CdtrAcct><Id><IBAN>AT611904300234573201</IBAN></Id></CdtrAcct>", 1)
+	doc, err := document.ParseIso20022Document([]byte(buf))
+	require.Nil(t, err)
+	require.Equal(t, "AT", Corridors(doc)[1].CreditorCountry)
+}
+
+func TestPolicy(t *testing.T) {
+	policy, err := ParsePolicy([]byte(`
+name: sanctions
+version: "1"
+rules:
+  - name: germany
+    creditorCountries: [de]
+    action: Block
+    reason: embargoed country
+  - name: usd-to-gb
+    debtorCountries: [US]
+    creditorCountries: [GB]
+    currencies: [USD]
+    action: flag
+  - name: euro
+    currencies: [EUR]
+    action: block
+`))
+	require.Nil(t, err)
+	require.Equal(t, "sanctions", policy.Name)
+	require.Equal(t, ActionBlock, policy.Rules[0].Action)
+
+	result := policy.Check(fixtures.Document(t, "valid_pacs_v08.xml"))
+	require.Len(t, result.Hits, 2)
+	require.Equal(t, []Hit{{
+		Rule:     "usd-to-gb",
+		Action:   ActionFlag,
+		Corridor: Corridor{Path: "CdtTrfTxInf[0]", DebtorCountry: "US", CreditorCountry: "GB", Currency: "USD"},
+	}}, result.Flagged())
+	require.Len(t, result.Blocked(), 1)
+
+	err = result.Err()
+	require.ErrorIs(t, err, ErrBlocked)
+	require.EqualError(t, err, "blocked corridor: corridor rule germany blocks CdtTrfTxInf[1] (US to DE in USD): embargoed country")
+
+	require.Nil(t, Result{Hits: result.Flagged()}.Err())
+}
+
+func TestPolicyErrors(t *testing.T) {
+	_, err := NewPolicy("api", []Rule{{Name: "open", Action: ActionBlock}})
+	require.ErrorContains(t, err, "has no countries nor currencies")
+	_, err = NewPolicy("api", []Rule{{Currencies: []string{"USD"}, Action: ActionBlock}})
+	require.ErrorContains(t, err, "name is omitted")
+	_, err = NewPolicy("api", []Rule{{Name: "usd", Currencies: []string{"USD"}, Action: "review"}})
+	require.ErrorContains(t, err, `action "review" is not flag or block`)
+	_, err = NewPolicy("api", []Rule{
+		{Name: "usd", Currencies: []string{"USD"}, Action: ActionFlag},
+		{Name: "usd", Currencies: []string{"USD"}, Action: ActionBlock},
+	})
+	require.ErrorContains(t, err, "name is duplicated")
+
+	_, err = ParsePolicy([]byte("rules: {"))
+	require.Error(t, err)
+	_, err = LoadPolicy(filepath.Join("testdata", "missing.yaml"))
+	require.Error(t, err)
+}
+
+func TestTransform(t *testing.T) {
+	flags, err := NewPolicy("flags", []Rule{{Name: "usd", Currencies: []string{"USD"}, Action: ActionFlag}})
+	require.Nil(t, err)
+	blocks, err := NewPolicy("blocks", []Rule{{Name: "gb", CreditorCountries: []string{"GB"}, Action: ActionBlock}})
+	require.Nil(t, err)
+
+	for _, tc := range []struct {
+		policy  *Policy
+		blocked bool
+	}{{flags, false}, {blocks, true}} {
+		var succeeded, failed []pipeline.Item
+		p := pipeline.New(pipeline.Bytes(fixtures.ReadFile(t, "valid_pacs_v08.xml")),
+			func(_ context.Context, item pipeline.Item) error {
+				succeeded = append(succeeded, item)
+				return nil
+			},
+			pipeline.WithTransform(StageCorridor, tc.policy.Transform()),
+			pipeline.WithErrorSink(func(_ context.Context, item pipeline.Item) error {
+				failed = append(failed, item)
+				return nil
+			}),
+		)
+		_, err := p.Run(context.Background())
+		require.Nil(t, err)
+
+		if tc.blocked {
+			require.Empty(t, succeeded)
+			require.Len(t, failed, 1)
+			require.Equal(t, StageCorridor, failed[0].Stage)
+			require.ErrorIs(t, failed[0].Err, ErrBlocked)
+			continue
+		}
+		require.Empty(t, failed)
+		require.Len(t, succeeded, 1)
+		require.Equal(t, enrich.Set{
+			{Path: "CdtTrfTxInf[0]", Key: KeyFlag, Value: "usd", Source: StageCorridor},
+			{Path: "CdtTrfTxInf[1]", Key: KeyFlag, Value: "usd", Source: StageCorridor},
+		}, succeeded[0].Enrichments)
+	}
+}
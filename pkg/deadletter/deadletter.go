@@ -0,0 +1,265 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package deadletter
+
+/*
+	Deadletter keeps the messages that failed the stages of a pipeline with the metadata of
+	their failure, and redrives them through a pipeline once the cause is fixed:
+
+		queue := deadletter.NewQueue(store)
+		p := pipeline.New(source, sink, pipeline.WithErrorSink(queue.Sink()))
+		...
+		stats, err := queue.Redrive(ctx, sink)
+*/
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/moov-io/iso20022/pkg/document"
+	"github.com/moov-io/iso20022/pkg/pipeline"
+	"github.com/moov-io/iso20022/pkg/priority"
+	"github.com/moov-io/iso20022/pkg/storage"
+	"github.com/moov-io/iso20022/pkg/utils"
+)
+
+const (
+	// CodeMalformed messages can't be parsed
+	CodeMalformed = "malformed"
+
+	// CodeUnsupported messages have an unsupported or omitted namespace
+	CodeUnsupported = "unsupported"
+
+	// CodeInvalid messages fail validation
+	CodeInvalid = "invalid"
+
+	// CodeRejected messages fail a transform of the pipeline
+	CodeRejected = "rejected"
+
+	// HeaderID is the header of redriven messages holding the id of their dead letter
+	HeaderID = "x-dead-letter-id"
+)
+
+// Letter is a message that failed processing
+type Letter struct {
+	ID      string            `json:"id"`
+	Name    string            `json:"name"`
+	Input   []byte            `json:"input"`
+	Headers map[string]string `json:"headers,omitempty"`
+
+	// Stage is the failing stage, Code classifies the failure and Path is the offending element when known
+	Stage string `json:"stage"`
+	Code  string `json:"code"`
+	Path  string `json:"path,omitempty"`
+	Error string `json:"error"`
+
+	// Priority is the processing priority of the letters of parsed documents, redriven first
+	Priority priority.Priority `json:"priority,omitempty"`
+
+	// Attempts counts the failed processings, including redrives
+	Attempts int       `json:"attempts"`
+	Created  time.Time `json:"created"`
+	Updated  time.Time `json:"updated"`
+}
+
+// Queue keeps dead letters in a store, e.g. a storage.FileStore of a directory
+type Queue struct {
+	store storage.Store
+	now   func() time.Time
+}
+
+// NewQueue returns a queue keeping its letters in store
+func NewQueue(store storage.Store) *Queue {
+	return &Queue{store: store, now: time.Now}
+}
+
+// Put dead-letters a failed item, an item redriven from the queue updates its letter
+func (q *Queue) Put(ctx context.Context, item pipeline.Item) (Letter, error) {
+	now := q.now().UTC()
+	letter := Letter{
+		ID:       newID(),
+		Name:     item.Name,
+		Input:    item.Input,
+		Headers:  make(map[string]string),
+		Stage:    item.Stage,
+		Code:     classify(item),
+		Path:     offendingPath(item),
+		Attempts: 1,
+		Created:  now,
+		Updated:  now,
+	}
+	if item.Err != nil {
+		letter.Error = item.Err.Error()
+	}
+	if item.Document != nil {
+		letter.Priority = priority.OfDocument(item.Document).Priority
+	}
+	for key, value := range item.Headers {
+		if key != HeaderID {
+			letter.Headers[key] = value
+		}
+	}
+
+	if id := item.Headers[HeaderID]; id != "" {
+		if previous, err := q.Get(ctx, id); err == nil {
+			letter.ID = previous.ID
+			letter.Attempts = previous.Attempts + 1
+			letter.Created = previous.Created
+		}
+	}
+
+	content, err := json.Marshal(letter)
+	if err != nil {
+		return letter, err
+	}
+	rec := storage.Record{
+		ID:      letter.ID,
+		Kind:    storage.KindDeadLetter,
+		Format:  utils.GetDocumentFormat(item.Input),
+		Content: content,
+		Created: letter.Created,
+		Updated: letter.Updated,
+	}
+	if item.Document != nil {
+		rec.MessageType = utils.GetMessageType(item.Document.NameSpace())
+	}
+	return letter, q.store.Put(ctx, rec)
+}
+
+// Sink returns an error sink of pipelines dead-lettering their failed items
+func (q *Queue) Sink() pipeline.Sink {
+	return func(ctx context.Context, item pipeline.Item) error {
+		_, err := q.Put(ctx, item)
+		return err
+	}
+}
+
+// Get returns the letter with id
+func (q *Queue) Get(ctx context.Context, id string) (Letter, error) {
+	rec, err := q.store.Get(ctx, id)
+	if err != nil {
+		return Letter{}, err
+	}
+	if rec.Kind != storage.KindDeadLetter {
+		return Letter{}, fmt.Errorf("%w: %s", storage.ErrNotFound, id)
+	}
+	return decode(rec)
+}
+
+// List returns every letter in the order of their creation
+func (q *Queue) List(ctx context.Context) ([]Letter, error) {
+	records, err := q.store.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var letters []Letter
+	for _, rec := range records {
+		if rec.Kind != storage.KindDeadLetter {
+			continue
+		}
+		letter, err := decode(rec)
+		if err != nil {
+			return nil, err
+		}
+		letters = append(letters, letter)
+	}
+	return letters, nil
+}
+
+// Delete removes the letter with id
+func (q *Queue) Delete(ctx context.Context, id string) error {
+	if _, err := q.Get(ctx, id); err != nil {
+		return err
+	}
+	return q.store.Delete(ctx, id)
+}
+
+// Redrive reprocesses every letter through a pipeline of sink configured by opts, the letters
+// of the highest priority first. Letters processed into sink are removed, failing letters are
+// kept with their new failure.
+func (q *Queue) Redrive(ctx context.Context, sink pipeline.Sink, opts ...pipeline.Option) (pipeline.Stats, error) {
+	letters, err := q.List(ctx)
+	if err != nil {
+		return pipeline.Stats{}, err
+	}
+	sort.SliceStable(letters, func(i, j int) bool { return letters[i].Priority.Rank() > letters[j].Priority.Rank() })
+
+	source := func(ctx context.Context, emit func(pipeline.Item) error) error {
+		for _, letter := range letters {
+			headers := map[string]string{HeaderID: letter.ID}
+			for key, value := range letter.Headers {
+				headers[key] = value
+			}
+			if err := emit(pipeline.Item{Name: letter.Name, Input: letter.Input, Headers: headers}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	processed := func(ctx context.Context, item pipeline.Item) error {
+		if err := sink(ctx, item); err != nil {
+			return err
+		}
+		err := q.Delete(ctx, item.Headers[HeaderID])
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	opts = append(opts, pipeline.WithErrorSink(q.Sink()))
+	return pipeline.New(source, processed, opts...).Run(ctx)
+}
+
+func decode(rec storage.Record) (Letter, error) {
+	var letter Letter
+	if err := json.Unmarshal(rec.Content, &letter); err != nil {
+		return letter, fmt.Errorf("dead letter %s: %w", rec.ID, err)
+	}
+	return letter, nil
+}
+
+func classify(item pipeline.Item) string {
+	switch item.Stage {
+	case pipeline.StageParse:
+		if item.Err != nil && strings.Contains(item.Err.Error(), "namespace") {
+			return CodeUnsupported
+		}
+		return CodeMalformed
+	case pipeline.StageValidate:
+		return CodeInvalid
+	}
+	return CodeRejected
+}
+
+// offendingPath returns the first element of an invalid document whose value doesn't meet its type
+func offendingPath(item pipeline.Item) string {
+	if item.Stage != pipeline.StageValidate || item.Document == nil {
+		return ""
+	}
+	messageType := utils.GetMessageType(item.Document.NameSpace())
+	for _, elm := range utils.GetElements(item.Document.InspectMessage()) {
+		if err := document.CheckElementValue(messageType, utils.StripElementIndexes(elm.Path), elm.Value); err != nil {
+			return elm.Path
+		}
+	}
+	return ""
+}
+
+// newID returns a random letter id
+func newID() string {
+	buf := make([]byte, 16)
+	if _, err := rand.Read(buf); err != nil {
+		return ""
+	}
+	return hex.EncodeToString(buf)
+}
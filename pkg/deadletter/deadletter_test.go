@@ -0,0 +1,136 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package deadletter
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/moov-io/iso20022/pkg/iso20022test/fixtures"
+	"github.com/moov-io/iso20022/pkg/pipeline"
+	"github.com/moov-io/iso20022/pkg/priority"
+	"github.com/moov-io/iso20022/pkg/storage"
+)
+
+func TestDeadLetters(t *testing.T) {
+	ctx := context.Background()
+	valid := fixtures.ReadFile(t, "valid_pacs_v08.xml")
+	invalid := []byte(strings.Replace(string(valid), "<SttlmMtd>CLRG</SttlmMtd>", "<SttlmMtd>NONE</SttlmMtd>", 1))
+	unsupported := []byte(`<Document xmlns="urn:iso:std:iso:20022:tech:xsd:pacs.999.001.01"></Document>`)
+
+	queue := NewQueue(storage.NewMemoryStore())
+	source := func(ctx context.Context, emit func(pipeline.Item) error) error {
+		for i, input := range [][]byte{valid, invalid, unsupported, []byte("<Document")} {
+			item := pipeline.Item{Name: []string{"valid", "invalid", "unsupported", "malformed"}[i], Input: input, Headers: map[string]string{"offset": string(rune('0' + i))}}
+			if err := emit(item); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	sink := func(ctx context.Context, item pipeline.Item) error { return nil }
+
+	stats, err := pipeline.New(source, sink, pipeline.WithWorkers(1), pipeline.WithErrorSink(queue.Sink())).Run(ctx)
+	require.Nil(t, err)
+	require.Equal(t, int64(3), stats.Failed)
+
+	letters, err := queue.List(ctx)
+	require.Nil(t, err)
+	require.Len(t, letters, 3)
+	byName := make(map[string]Letter)
+	for _, letter := range letters {
+		byName[letter.Name] = letter
+	}
+
+	letter := byName["invalid"]
+	require.Equal(t, pipeline.StageValidate, letter.Stage)
+	require.Equal(t, CodeInvalid, letter.Code)
+	require.Equal(t, "GrpHdr/SttlmInf/SttlmMtd", letter.Path)
+	require.NotEmpty(t, letter.Error)
+	require.Equal(t, map[string]string{"offset": "1"}, letter.Headers)
+	require.Equal(t, invalid, letter.Input)
+	require.Equal(t, 1, letter.Attempts)
+	require.Equal(t, priority.PriorityUrgent, letter.Priority)
+
+	require.Equal(t, CodeUnsupported, byName["unsupported"].Code)
+	require.Equal(t, CodeMalformed, byName["malformed"].Code)
+	require.Empty(t, byName["malformed"].Path)
+	require.Empty(t, byName["malformed"].Priority)
+
+	got, err := queue.Get(ctx, letter.ID)
+	require.Nil(t, err)
+	require.Equal(t, letter, got)
+}
+
+func TestRedrive(t *testing.T) {
+	ctx := context.Background()
+	valid := fixtures.ReadFile(t, "valid_pacs_v08.xml")
+
+	queue := NewQueue(storage.NewMemoryStore())
+	fixed, err := queue.Put(ctx, pipeline.Item{Name: "fixed", Input: valid, Stage: "enrich", Err: errors.New("lookup failed")})
+	require.Nil(t, err)
+	require.Equal(t, CodeRejected, fixed.Code)
+	broken, err := queue.Put(ctx, pipeline.Item{Name: "broken", Input: []byte("<Document"), Stage: pipeline.StageParse, Err: errors.New("EOF"), Headers: map[string]string{"key": "value"}})
+	require.Nil(t, err)
+
+	var redriven []pipeline.Item
+	stats, err := queue.Redrive(ctx, func(ctx context.Context, item pipeline.Item) error {
+		redriven = append(redriven, item)
+		return nil
+	}, pipeline.WithWorkers(1))
+	require.Nil(t, err)
+	require.Equal(t, int64(1), stats.Succeeded)
+	require.Equal(t, int64(1), stats.Failed)
+	require.Len(t, redriven, 1)
+	require.Equal(t, "fixed", redriven[0].Name)
+	require.NotEmpty(t, redriven[0].Headers[HeaderID])
+
+	// the fixed letter is removed, the broken one counts its attempts
+	letters, err := queue.List(ctx)
+	require.Nil(t, err)
+	require.Len(t, letters, 1)
+	require.Equal(t, broken.ID, letters[0].ID)
+	require.Equal(t, 2, letters[0].Attempts)
+	require.Equal(t, broken.Created, letters[0].Created)
+	require.Equal(t, map[string]string{"key": "value"}, letters[0].Headers)
+
+	require.Nil(t, queue.Delete(ctx, broken.ID))
+	require.ErrorIs(t, queue.Delete(ctx, broken.ID), storage.ErrNotFound)
+}
+
+func TestRedrivePriority(t *testing.T) {
+	ctx := context.Background()
+	queue := NewQueue(storage.NewMemoryStore())
+	for _, name := range []string{"valid_camt053_v08.xml", "valid_pacs_v08.xml"} {
+		_, err := queue.Put(ctx, pipeline.Item{Name: name, Input: fixtures.ReadFile(t, name), Document: fixtures.Document(t, name), Stage: "enrich", Err: errors.New("lookup failed")})
+		require.Nil(t, err)
+	}
+
+	// urgent letters are redriven before normal ones
+	var redriven []string
+	_, err := queue.Redrive(ctx, func(ctx context.Context, item pipeline.Item) error {
+		redriven = append(redriven, item.Name)
+		return nil
+	}, pipeline.WithWorkers(1))
+	require.Nil(t, err)
+	require.Equal(t, []string{"valid_pacs_v08.xml", "valid_camt053_v08.xml"}, redriven)
+}
+
+func TestQueueSharesStore(t *testing.T) {
+	ctx := context.Background()
+	store := storage.NewMemoryStore()
+	require.Nil(t, store.Put(ctx, storage.Record{ID: "document", Content: []byte("{}")}))
+
+	queue := NewQueue(store)
+	letters, err := queue.List(ctx)
+	require.Nil(t, err)
+	require.Empty(t, letters)
+	_, err = queue.Get(ctx, "document")
+	require.ErrorIs(t, err, storage.ErrNotFound)
+}
@@ -0,0 +1,52 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package document
+
+import (
+	"encoding/xml"
+	"fmt"
+	"reflect"
+)
+
+// Clone returns a deep copy of doc, modifying the copy (e.g. to build a return) keeps doc intact
+func Clone(doc Iso20022Document) (Iso20022Document, error) {
+	obj, ok := doc.(*Iso20022DocumentObject)
+	if !ok {
+		return nil, fmt.Errorf("unsupported document %T", doc)
+	}
+	message, err := cloneMessage(obj.Message)
+	if err != nil {
+		return nil, err
+	}
+	return &Iso20022DocumentObject{
+		XMLName: obj.XMLName,
+		Attrs:   append([]xml.Attr(nil), obj.Attrs...),
+		Message: message,
+	}, nil
+}
+
+// Clone returns a deep copy of d
+func (d *Document[M]) Clone() *Document[M] {
+	// the message of typed documents is cloneable
+	clone, _ := Clone(d.doc)
+	typed, _ := As[M](clone)
+	return typed
+}
+
+// cloneMessage calls the generated DeepCopy method of message
+func cloneMessage(message Iso20022Message) (Iso20022Message, error) {
+	if message == nil {
+		return nil, nil
+	}
+	method := reflect.ValueOf(message).MethodByName("DeepCopy")
+	if !method.IsValid() {
+		return nil, fmt.Errorf("%T can't be cloned", message)
+	}
+	clone, ok := method.Call(nil)[0].Interface().(Iso20022Message)
+	if !ok {
+		return nil, fmt.Errorf("%T can't be cloned", message)
+	}
+	return clone, nil
+}
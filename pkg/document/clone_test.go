@@ -0,0 +1,62 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package document
+
+import (
+	"bytes"
+	"encoding/xml"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/moov-io/iso20022/pkg/common"
+	"github.com/moov-io/iso20022/pkg/pacs_v08"
+)
+
+func TestClone(t *testing.T) {
+	input := mustReadTestFile(t, "valid_pacs_v08.xml")
+	doc, err := ParseIso20022Document(input)
+	assert.Nil(t, err)
+
+	clone, err := Clone(doc)
+	assert.Nil(t, err)
+	assert.True(t, reflect.DeepEqual(doc, clone))
+
+	message := clone.InspectMessage().(*pacs_v08.FIToFICustomerCreditTransferV08)
+	message.GrpHdr.MsgId = "RETURN-0001"
+	*message.CdtTrfTxInf[0].PmtId.InstrId = "CHANGED"
+	message.CdtTrfTxInf[1].IntrBkSttlmAmt.Value = 1
+
+	original := doc.InspectMessage().(*pacs_v08.FIToFICustomerCreditTransferV08)
+	assert.Equal(t, common.Max35Text("MSG-20210415-0001"), original.GrpHdr.MsgId)
+	assert.NotEqual(t, common.Max35Text("CHANGED"), *original.CdtTrfTxInf[0].PmtId.InstrId)
+	assert.NotEqual(t, 1.0, original.CdtTrfTxInf[1].IntrBkSttlmAmt.Value)
+
+	output, err := xml.Marshal(doc)
+	assert.Nil(t, err)
+	reparsed, err := ParseIso20022Document(output)
+	assert.Nil(t, err)
+	assert.Nil(t, reparsed.Validate())
+	assert.Equal(t, doc.NameSpace(), clone.NameSpace())
+}
+
+func TestCloneTyped(t *testing.T) {
+	doc, err := Parse[pacs_v08.FIToFICustomerCreditTransferV08](bytes.NewReader(mustReadTestFile(t, "valid_pacs_v08.xml")))
+	assert.Nil(t, err)
+
+	clone := doc.Clone()
+	clone.Message.GrpHdr.MsgId = "RETURN-0001"
+	assert.Equal(t, common.Max35Text("MSG-20210415-0001"), doc.Message.GrpHdr.MsgId)
+	assert.Equal(t, common.Max35Text("RETURN-0001"), clone.InspectMessage().(*pacs_v08.FIToFICustomerCreditTransferV08).GrpHdr.MsgId)
+}
+
+func TestCloneEveryMessage(t *testing.T) {
+	for namespace, constructor := range messageConstructor {
+		clone, err := cloneMessage(constructor())
+		assert.Nil(t, err, namespace)
+		assert.IsType(t, constructor(), clone, namespace)
+	}
+}
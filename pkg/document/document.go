@@ -265,11 +265,11 @@ func NewDocument(space string) (doc Iso20022Document, err error) {
 	}, nil
 }
 
-// ParseIso20022Document will return a interface of ISO 20022 document after pass buffer
-func ParseIso20022Document(buf []byte) (Iso20022Document, error) {
+// DetectNameSpace returns the xmlns of the document in buf without parsing its message
+func DetectNameSpace(buf []byte) (string, error) {
 	docformat := utils.GetDocumentFormat(buf)
 	if docformat == utils.DocumentTypeUnknown {
-		return nil, utils.NewErrInvalidFileType()
+		return "", utils.NewErrInvalidFileType()
 	}
 
 	var dummy documentDummy
@@ -281,12 +281,26 @@ func ParseIso20022Document(buf []byte) (Iso20022Document, error) {
 		err = json.Unmarshal(buf, &dummy)
 	}
 	if err != nil {
-		return nil, err
+		return "", err
 	}
 
 	namespace := dummy.NameSpace()
 	if namespace == "" {
-		return nil, utils.NewErrOmittedNameSpace()
+		return "", utils.NewErrOmittedNameSpace()
+	}
+	return namespace, nil
+}
+
+// ParseIso20022Document will return a interface of ISO 20022 document after pass buffer
+func ParseIso20022Document(buf []byte) (Iso20022Document, error) {
+	docformat := utils.GetDocumentFormat(buf)
+	if docformat == utils.DocumentTypeUnknown {
+		return nil, utils.NewErrInvalidFileType()
+	}
+
+	namespace, err := DetectNameSpace(buf)
+	if err != nil {
+		return nil, err
 	}
 
 	constractor := messageConstructor[namespace]
@@ -317,13 +331,17 @@ type Iso20022DocumentObject struct {
 }
 
 func (doc Iso20022DocumentObject) Validate() error {
+	return doc.validate(utils.Validate)
+}
+
+func (doc Iso20022DocumentObject) validate(validate func(interface{}) error) error {
 	if len(doc.NameSpace()) == 0 {
-		return utils.Validate(&doc)
+		return validate(&doc)
 	}
 
 	for _, attr := range doc.Attrs {
 		if attr.Name.Local == utils.XmlDefaultNamespace && doc.NameSpace() == attr.Value {
-			return utils.Validate(&doc)
+			return validate(&doc)
 		}
 	}
 
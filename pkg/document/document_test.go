@@ -296,11 +296,30 @@ func TestJsonXmlWithDummy(t *testing.T) {
 	assert.Equal(t, nil, docInterface.Validate())
 }
 
+func TestDetectNameSpace(t *testing.T) {
+	for _, name := range []string{"valid_remt_v04.xml", "valid_remt_v04.json"} {
+		input, err := os.ReadFile(filepath.Join("..", "..", "test", "testdata", name))
+		assert.Equal(t, nil, err)
+
+		namespace, err := DetectNameSpace(input)
+		assert.Equal(t, nil, err)
+		assert.Equal(t, utils.DocumentRemt00100104NameSpace, namespace)
+	}
+
+	_, err := DetectNameSpace([]byte("invalid"))
+	assert.NotNil(t, err)
+	_, err = DetectNameSpace([]byte("<Document></Document>"))
+	assert.Equal(t, utils.NewErrOmittedNameSpace().Error(), err.Error())
+}
+
 func TestJsonXmlWithFiles(t *testing.T) {
 	validFileList := []string{
 		"valid_acmt_v03.xml",
 		"valid_auth_v02.xml",
 		"valid_camt_v09.xml",
+		"valid_camt052_v08.xml",
+		"valid_camt054_v08_1.xml",
+		"valid_pacs_v08.xml",
 		"valid_pacs_v11.xml",
 		"valid_pain_v11.xml",
 		"valid_reda_v01.xml",
@@ -308,6 +327,7 @@ func TestJsonXmlWithFiles(t *testing.T) {
 		"valid_acmt_v03.json",
 		"valid_auth_v02.json",
 		"valid_camt_v09.json",
+		"valid_pacs_v08.json",
 		"valid_pacs_v11.json",
 		"valid_pain_v11.json",
 		"valid_reda_v01.json",
@@ -392,3 +412,18 @@ func TestJsonXmlWithFiles(t *testing.T) {
 		assert.Equal(t, "The type of file is invalid", err.Error())
 	}
 }
+
+func TestCorrelationKeysWithDocument(t *testing.T) {
+	input, err := os.ReadFile(filepath.Join("..", "..", "test", "testdata", "valid_pacs_v08.xml"))
+	assert.Nil(t, err)
+
+	doc, err := ParseIso20022Document(input)
+	assert.Nil(t, err)
+
+	keys := utils.ExtractCorrelationKeys(doc)
+	assert.Equal(t, []string{"MSG-20210415-0001"}, keys["MsgId"])
+	assert.Equal(t, []string{"INSTR-0001", "INSTR-0002"}, keys["InstrId"])
+	assert.Equal(t, []string{"E2E-0001", "E2E-0002"}, keys["EndToEndId"])
+	assert.Equal(t, []string{"TX-0001", "TX-0002"}, keys["TxId"])
+	assert.Equal(t, []string{"8a562c67-ca16-48ba-b074-65581be6f011"}, keys["UETR"])
+}
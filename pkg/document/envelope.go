@@ -0,0 +1,318 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package document
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+
+	"github.com/moov-io/iso20022/pkg/utils"
+)
+
+const (
+	// EnvelopeElement is the root element of business message envelopes
+	EnvelopeElement = "BizMsgEnvlp"
+
+	headerElement = "AppHdr"
+)
+
+var (
+	// ErrNotEnvelope is returned for inputs which aren't business message envelopes
+	ErrNotEnvelope = errors.New("input isn't a business message envelope")
+
+	// ErrHeaderMismatch is returned when the MsgDefIdr of a business application header isn't
+	// the message of the document it wraps
+	ErrHeaderMismatch = errors.New("business application header doesn't define the message of the document")
+
+	headerNameSpaces = []string{
+		utils.DocumentHead00100101NameSpace,
+		utils.DocumentHead00100102NameSpace,
+	}
+)
+
+// Envelope is a business message, the business application header (head.001 AppHdr) and the
+// document of a message in a BizMsgEnvlp element, as required by CBPR+ and many market
+// infrastructures. Envelopes are documents of the message of their Document: Validate
+// validates the header too and envelopes are encoded with their header.
+type Envelope struct {
+	// Attrs are the attributes of the BizMsgEnvlp element
+	Attrs []xml.Attr
+
+	// Header is a head_v01.BusinessApplicationHeaderV01 or head_v02.BusinessApplicationHeaderV02
+	Header Iso20022Message
+
+	Document Iso20022Document
+}
+
+// NewEnvelope returns the envelope of doc with header, a head_v01.BusinessApplicationHeaderV01
+// or head_v02.BusinessApplicationHeaderV02
+func NewEnvelope(header Iso20022Message, doc Iso20022Document) (*Envelope, error) {
+	if headerNameSpace(header) == "" {
+		return nil, fmt.Errorf("%s: %w", headerElement, utils.NewErrUnsupportedNameSpace())
+	}
+	if doc == nil {
+		return nil, errors.New("envelope has no document")
+	}
+	return &Envelope{Header: header, Document: doc}, nil
+}
+
+// headerNameSpace returns the namespace of the business application header, empty for other messages
+func headerNameSpace(header Iso20022Message) string {
+	value := reflect.ValueOf(header)
+	if !value.IsValid() || (value.Kind() == reflect.Ptr && value.IsNil()) {
+		return ""
+	}
+	for _, space := range headerNameSpaces {
+		if reflect.TypeOf(messageConstructor[space]()).Elem() == reflect.Indirect(value).Type() {
+			return space
+		}
+	}
+	return ""
+}
+
+// messageDefinition returns the MsgDefIdr of the header of e, its namespace must be known
+func (e Envelope) messageDefinition() reflect.Value {
+	return reflect.Indirect(reflect.ValueOf(e.Header)).FieldByName("MsgDefIdr")
+}
+
+// Validate validates the header and document of e, see ValidateHeader
+func (e Envelope) Validate() error {
+	if err := e.ValidateHeader(); err != nil {
+		return err
+	}
+	return e.Document.Validate()
+}
+
+// ValidateHeader validates the header of e, and returns ErrHeaderMismatch unless its MsgDefIdr
+// is the message type of the document, e.g. pacs.008.001.08
+func (e Envelope) ValidateHeader() error {
+	if e.Document == nil {
+		return errors.New("envelope has no document")
+	}
+	if headerNameSpace(e.Header) == "" {
+		return fmt.Errorf("%s: %w", headerElement, utils.NewErrUnsupportedNameSpace())
+	}
+	if err := e.Header.Validate(); err != nil {
+		return fmt.Errorf("%s: %w", headerElement, err)
+	}
+	defined, messageType := e.messageDefinition().String(), utils.GetMessageType(e.Document.NameSpace())
+	if defined != messageType {
+		return fmt.Errorf("%w: %s/MsgDefIdr is %s, the document is a %s", ErrHeaderMismatch, headerElement, defined, messageType)
+	}
+	return nil
+}
+
+// NameSpace returns the xmlns of the document of e
+func (e Envelope) NameSpace() string {
+	return e.Document.NameSpace()
+}
+
+// GetXmlName returns the xml name of the document of e
+func (e *Envelope) GetXmlName() *xml.Name {
+	return e.Document.GetXmlName()
+}
+
+// GetAttrs returns the attributes of the document of e
+func (e *Envelope) GetAttrs() []xml.Attr {
+	return e.Document.GetAttrs()
+}
+
+// InspectMessage returns the message of the document of e
+func (e *Envelope) InspectMessage() Iso20022Message {
+	return e.Document.InspectMessage()
+}
+
+func (e Envelope) MarshalXML(enc *xml.Encoder, start xml.StartElement) error {
+	space := headerNameSpace(e.Header)
+	if space == "" {
+		return fmt.Errorf("%s: %w", headerElement, utils.NewErrUnsupportedNameSpace())
+	}
+
+	start = xml.StartElement{Name: xml.Name{Local: EnvelopeElement}, Attr: e.Attrs}
+	if err := enc.EncodeToken(start); err != nil {
+		return err
+	}
+	header := xml.StartElement{
+		Name: xml.Name{Local: headerElement},
+		Attr: []xml.Attr{{Name: xml.Name{Local: utils.XmlDefaultNamespace}, Value: space}},
+	}
+	if err := enc.EncodeElement(e.Header, header); err != nil {
+		return err
+	}
+	if err := enc.EncodeElement(e.Document, xml.StartElement{Name: xml.Name{Local: "Document"}}); err != nil {
+		return err
+	}
+	return enc.EncodeToken(start.End())
+}
+
+// envelopeObject is the json form of envelopes
+type envelopeObject struct {
+	Attrs    []xml.Attr `json:",omitempty"`
+	AppHdr   json.RawMessage
+	Document json.RawMessage
+}
+
+func (e Envelope) MarshalJSON() ([]byte, error) {
+	space := headerNameSpace(e.Header)
+	if space == "" {
+		return nil, fmt.Errorf("%s: %w", headerElement, utils.NewErrUnsupportedNameSpace())
+	}
+
+	// the namespace of the header is its XMLName, headers built with NewEnvelope may not have it
+	named := reflect.New(reflect.Indirect(reflect.ValueOf(e.Header)).Type())
+	named.Elem().Set(reflect.Indirect(reflect.ValueOf(e.Header)))
+	named.Elem().FieldByName("XMLName").Set(reflect.ValueOf(xml.Name{Space: space, Local: headerElement}))
+
+	header, err := json.Marshal(named.Interface())
+	if err != nil {
+		return nil, err
+	}
+	doc, err := json.Marshal(e.Document)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(envelopeObject{Attrs: e.Attrs, AppHdr: header, Document: doc})
+}
+
+// SplitEnvelope returns the business application header and the document of the envelope in
+// buf, in the format of buf, and ErrNotEnvelope for the inputs that aren't envelopes
+func SplitEnvelope(buf []byte) (header, doc []byte, err error) {
+	_, header, doc, err = splitEnvelope(buf)
+	return header, doc, err
+}
+
+func splitEnvelope(buf []byte) (attrs []xml.Attr, header, doc []byte, err error) {
+	switch utils.GetDocumentFormat(buf) {
+	case utils.DocumentTypeJson:
+		var object envelopeObject
+		if json.Unmarshal(buf, &object) != nil {
+			return nil, nil, nil, ErrNotEnvelope
+		}
+		attrs, header, doc = object.Attrs, object.AppHdr, object.Document
+	case utils.DocumentTypeXml:
+		if attrs, header, doc, err = splitXmlEnvelope(buf); err != nil {
+			return nil, nil, nil, err
+		}
+	default:
+		return nil, nil, nil, ErrNotEnvelope
+	}
+
+	if len(header) == 0 {
+		return nil, nil, nil, ErrNotEnvelope
+	}
+	if len(doc) == 0 {
+		return nil, nil, nil, errors.New("envelope has no document")
+	}
+	return attrs, header, doc, nil
+}
+
+// splitXmlEnvelope returns the attributes of the BizMsgEnvlp of buf and the elements of its
+// header and document
+func splitXmlEnvelope(buf []byte) (attrs []xml.Attr, header, doc []byte, err error) {
+	decoder := xml.NewDecoder(bytes.NewReader(buf))
+	root := false
+	for {
+		offset := decoder.InputOffset()
+		token, err := decoder.Token()
+		if err == io.EOF {
+			return nil, nil, nil, ErrNotEnvelope
+		}
+		if err != nil {
+			return nil, nil, nil, err
+		}
+
+		switch tok := token.(type) {
+		case xml.StartElement:
+			if !root {
+				if tok.Name.Local != EnvelopeElement {
+					return nil, nil, nil, ErrNotEnvelope
+				}
+				root, attrs = true, tok.Attr
+				continue
+			}
+			if err = decoder.Skip(); err != nil {
+				return nil, nil, nil, err
+			}
+			switch tok.Name.Local {
+			case headerElement:
+				header = buf[offset:decoder.InputOffset()]
+			case "Document":
+				doc = buf[offset:decoder.InputOffset()]
+			}
+		case xml.EndElement:
+			return attrs, header, doc, nil
+		}
+	}
+}
+
+// parseHeader returns the business application header of buf in format
+func parseHeader(buf []byte, format utils.DocumentType) (Iso20022Message, error) {
+	var named struct {
+		XMLName xml.Name
+	}
+	var err error
+	if format == utils.DocumentTypeXml {
+		err = xml.Unmarshal(buf, &named)
+	} else {
+		err = json.Unmarshal(buf, &named)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	space := named.XMLName.Space
+	if space == "" {
+		return nil, fmt.Errorf("%s: %w", headerElement, utils.NewErrOmittedNameSpace())
+	}
+	constructor := messageConstructor[space]
+	if constructor == nil || headerNameSpace(constructor()) == "" {
+		return nil, fmt.Errorf("%s: %w", headerElement, utils.NewErrUnsupportedNameSpace())
+	}
+	header := constructor()
+	if format == utils.DocumentTypeXml {
+		err = xml.Unmarshal(buf, header)
+	} else {
+		err = json.Unmarshal(buf, header)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return header, nil
+}
+
+// ParseEnvelope returns the envelope in buf, and ErrNotEnvelope for the inputs that aren't envelopes
+func ParseEnvelope(buf []byte) (*Envelope, error) {
+	return ParseEnvelopeWith(buf, ParseIso20022Document)
+}
+
+// ParseEnvelopeWith returns the envelope in buf whose document is parsed by parse, e.g. to
+// convert it to another version. The MsgDefIdr of the header follows the converted documents.
+func ParseEnvelopeWith(buf []byte, parse func([]byte) (Iso20022Document, error)) (*Envelope, error) {
+	attrs, rawHeader, rawDoc, err := splitEnvelope(buf)
+	if err != nil {
+		return nil, err
+	}
+	header, err := parseHeader(rawHeader, utils.GetDocumentFormat(buf))
+	if err != nil {
+		return nil, err
+	}
+	doc, err := parse(rawDoc)
+	if err != nil {
+		return nil, err
+	}
+
+	e := &Envelope{Attrs: attrs, Header: header, Document: doc}
+	if space, err := DetectNameSpace(rawDoc); err == nil && space != doc.NameSpace() {
+		if definition := e.messageDefinition(); definition.String() == utils.GetMessageType(space) {
+			definition.SetString(utils.GetMessageType(doc.NameSpace()))
+		}
+	}
+	return e, nil
+}
@@ -0,0 +1,133 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package document
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/moov-io/iso20022/pkg/common"
+	"github.com/moov-io/iso20022/pkg/head_v02"
+	"github.com/moov-io/iso20022/pkg/pacs_v08"
+	"github.com/moov-io/iso20022/pkg/utils"
+)
+
+func TestParseEnvelope(t *testing.T) {
+	input := mustReadTestFile(t, "valid_pacs_v08_envelope.xml")
+	envelope, err := ParseEnvelope(input)
+	assert.Nil(t, err)
+	assert.Nil(t, envelope.Validate())
+	assert.Equal(t, utils.DocumentPacs00800108NameSpace, envelope.NameSpace())
+
+	header, ok := envelope.Header.(*head_v02.BusinessApplicationHeaderV02)
+	assert.True(t, ok)
+	assert.Equal(t, common.Max35Text("MSG-20210415-0001"), header.BizMsgIdr)
+	assert.Equal(t, common.Max35Text("pacs.008.001.08"), header.MsgDefIdr)
+	_, ok = envelope.InspectMessage().(*pacs_v08.FIToFICustomerCreditTransferV08)
+	assert.True(t, ok)
+
+	// envelopes round trip through xml and json with their header
+	encoded, err := xml.MarshalIndent(envelope, "", "\t")
+	assert.Nil(t, err)
+	assert.True(t, strings.HasPrefix(string(encoded), "<BizMsgEnvlp>"))
+	assert.Contains(t, string(encoded), `<AppHdr xmlns="urn:iso:std:iso:20022:tech:xsd:head.001.001.02">`)
+	assert.Contains(t, string(encoded), `<Document xmlns="urn:iso:std:iso:20022:tech:xsd:pacs.008.001.08">`)
+	marshaled, err := json.Marshal(envelope)
+	assert.Nil(t, err)
+	for _, buf := range [][]byte{encoded, marshaled} {
+		reparsed, err := ParseEnvelope(buf)
+		assert.Nil(t, err)
+		assert.Equal(t, envelope.Header, reparsed.Header)
+		assert.Nil(t, reparsed.Validate())
+	}
+
+	rawHeader, doc, err := SplitEnvelope(input)
+	assert.Nil(t, err)
+	assert.True(t, strings.HasPrefix(string(rawHeader), "<AppHdr"))
+	space, err := DetectNameSpace(doc)
+	assert.Nil(t, err)
+	assert.Equal(t, utils.DocumentPacs00800108NameSpace, space)
+
+	// documents aren't envelopes
+	_, err = ParseEnvelope(mustReadTestFile(t, "valid_pacs_v08.xml"))
+	assert.True(t, errors.Is(err, ErrNotEnvelope))
+	_, err = ParseEnvelope(mustReadTestFile(t, "valid_pacs_v08.json"))
+	assert.True(t, errors.Is(err, ErrNotEnvelope))
+}
+
+func TestParseEnvelopeErrors(t *testing.T) {
+	input := string(mustReadTestFile(t, "valid_pacs_v08_envelope.xml"))
+
+	start, end := strings.Index(input, "\t<Document"), strings.Index(input, "</BizMsgEnvlp>")
+	_, err := ParseEnvelope([]byte(input[:start] + input[end:]))
+	assert.NotNil(t, err)
+
+	_, err = ParseEnvelope([]byte(strings.Replace(input, "head.001.001.02", "head.001.001.09", 1)))
+	assert.NotNil(t, err)
+
+	// the header defines the message of the document
+	envelope, err := ParseEnvelope([]byte(strings.Replace(input, "<MsgDefIdr>pacs.008.001.08", "<MsgDefIdr>pacs.009.001.08", 1)))
+	assert.Nil(t, err)
+	assert.True(t, errors.Is(envelope.Validate(), ErrHeaderMismatch))
+
+	envelope, err = ParseEnvelope([]byte(strings.Replace(input, "<BizMsgIdr>MSG-20210415-0001</BizMsgIdr>", "", 1)))
+	assert.Nil(t, err)
+	assert.NotNil(t, envelope.Validate())
+}
+
+func TestNewEnvelope(t *testing.T) {
+	doc, err := ParseIso20022Document(mustReadTestFile(t, "valid_pacs_v08.xml"))
+	assert.Nil(t, err)
+
+	bic := common.BICFIDec2014Identifier("BANKUS33XXX")
+	header := &head_v02.BusinessApplicationHeaderV02{
+		BizMsgIdr: "MSG-20210415-0001",
+		MsgDefIdr: "pacs.008.001.08",
+		CreDt:     common.ISODateTime(time.Date(2021, 4, 15, 10, 30, 0, 0, time.UTC)),
+	}
+	header.Fr.FIId.FinInstnId.BICFI = &bic
+	header.To.FIId.FinInstnId.BICFI = &bic
+
+	envelope, err := NewEnvelope(header, doc)
+	assert.Nil(t, err)
+	assert.Nil(t, envelope.Validate())
+
+	// headers without XMLName are named by their namespace
+	marshaled, err := json.Marshal(envelope)
+	assert.Nil(t, err)
+	reparsed, err := ParseEnvelope(marshaled)
+	assert.Nil(t, err)
+	assert.Equal(t, header.BizMsgIdr, reparsed.Header.(*head_v02.BusinessApplicationHeaderV02).BizMsgIdr)
+
+	_, err = NewEnvelope(doc.InspectMessage(), doc)
+	assert.NotNil(t, err)
+	_, err = NewEnvelope(header, nil)
+	assert.NotNil(t, err)
+}
+
+func TestParseEnvelopeWith(t *testing.T) {
+	input := mustReadTestFile(t, "valid_pacs_v08_envelope.xml")
+
+	// the header follows documents converted to another version
+	converted, err := ParseIso20022Document(mustReadTestFile(t, "valid_pacs_v08.xml"))
+	assert.Nil(t, err)
+	converted.GetAttrs()[0].Value = utils.DocumentPacs00800109NameSpace
+	envelope, err := ParseEnvelopeWith(input, func([]byte) (Iso20022Document, error) {
+		return converted, nil
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, common.Max35Text("pacs.008.001.09"), envelope.Header.(*head_v02.BusinessApplicationHeaderV02).MsgDefIdr)
+
+	_, err = ParseEnvelopeWith(input, func([]byte) (Iso20022Document, error) {
+		return nil, errors.New("unparsable")
+	})
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,51 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package document
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"errors"
+	"fmt"
+)
+
+// FingerprintAlgorithm prefixes the fingerprints of documents
+const FingerprintAlgorithm = "sha256"
+
+// ErrFingerprintMismatch is returned when a document doesn't have the expected fingerprint
+var ErrFingerprintMismatch = errors.New("document fingerprint mismatch")
+
+// Fingerprint returns the canonical content hash of doc, sha256:<hex digest> of the compact xml
+// of its namespace and message. The fingerprint doesn't depend on the format, indentation or
+// extra attributes of the parsed input, so a document received as json or re-indented xml has
+// the fingerprint of the document it was encoded from.
+func Fingerprint(doc Iso20022Document) (string, error) {
+	if doc == nil || doc.InspectMessage() == nil {
+		return "", errors.New("document has no message")
+	}
+	canonical := Iso20022DocumentObject{
+		XMLName: xml.Name{Space: doc.NameSpace(), Local: "Document"},
+		Message: doc.InspectMessage(),
+	}
+	buf, err := xml.Marshal(canonical)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(buf)
+	return FingerprintAlgorithm + ":" + hex.EncodeToString(sum[:]), nil
+}
+
+// VerifyFingerprint returns ErrFingerprintMismatch unless doc has the fingerprint expected
+func VerifyFingerprint(doc Iso20022Document, expected string) error {
+	fingerprint, err := Fingerprint(doc)
+	if err != nil {
+		return err
+	}
+	if fingerprint != expected {
+		return fmt.Errorf("%w: expected %s, got %s", ErrFingerprintMismatch, expected, fingerprint)
+	}
+	return nil
+}
@@ -0,0 +1,48 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package document
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/moov-io/iso20022/pkg/pacs_v08"
+)
+
+func TestFingerprint(t *testing.T) {
+	doc, err := ParseIso20022Document(mustReadTestFile(t, "valid_pacs_v08.xml"))
+	assert.Nil(t, err)
+
+	fingerprint, err := Fingerprint(doc)
+	assert.Nil(t, err)
+	assert.True(t, strings.HasPrefix(fingerprint, "sha256:"))
+	assert.Len(t, fingerprint, len("sha256:")+64)
+
+	// the format and indentation of the input don't change the fingerprint
+	indented, err := xml.MarshalIndent(doc, "", "    ")
+	assert.Nil(t, err)
+	encoded, err := json.Marshal(doc)
+	assert.Nil(t, err)
+	for _, input := range [][]byte{indented, encoded} {
+		reparsed, err := ParseIso20022Document(input)
+		assert.Nil(t, err)
+		assert.Nil(t, VerifyFingerprint(reparsed, fingerprint))
+	}
+
+	// changing the content changes the fingerprint
+	clone, err := Clone(doc)
+	assert.Nil(t, err)
+	clone.InspectMessage().(*pacs_v08.FIToFICustomerCreditTransferV08).CdtTrfTxInf[1].IntrBkSttlmAmt.Value = 1
+	err = VerifyFingerprint(clone, fingerprint)
+	assert.True(t, errors.Is(err, ErrFingerprintMismatch))
+
+	_, err = Fingerprint(nil)
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,27 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package document
+
+import (
+	"github.com/moov-io/iso20022/pkg/utils"
+)
+
+// ValidateParallel validates doc like its Validate method with its transactions validated
+// concurrently by workers goroutines, see utils.ValidateParallel, cutting the validation time
+// of documents with thousands of transactions. Its error is the error Validate returns.
+func ValidateParallel(doc Iso20022Document, workers int) error {
+	switch d := doc.(type) {
+	case *Iso20022DocumentObject:
+		return d.validate(func(r interface{}) error {
+			return utils.ValidateParallel(r, workers)
+		})
+	case *Envelope:
+		if err := d.ValidateHeader(); err != nil {
+			return err
+		}
+		return ValidateParallel(d.Document, workers)
+	}
+	return doc.Validate()
+}
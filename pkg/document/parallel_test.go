@@ -0,0 +1,51 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package document
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/moov-io/iso20022/pkg/pacs_v08"
+)
+
+// batchDocument returns the pacs.008 of valid_pacs_v08.xml with its first transaction repeated
+func batchDocument(t *testing.T, transactions int) Iso20022Document {
+	input := string(mustReadTestFile(t, "valid_pacs_v08.xml"))
+	start := strings.Index(input, "<CdtTrfTxInf>")
+	end := strings.Index(input, "</CdtTrfTxInf>") + len("</CdtTrfTxInf>")
+	batch := input[:start] + strings.Repeat(input[start:end], transactions) + input[end:]
+
+	doc, err := ParseIso20022Document([]byte(batch))
+	assert.Nil(t, err)
+	return doc
+}
+
+func TestValidateParallel(t *testing.T) {
+	doc := batchDocument(t, 2000)
+	assert.Nil(t, doc.Validate())
+	assert.Nil(t, ValidateParallel(doc, 8))
+
+	// the error is the one of the first invalid transaction
+	message := doc.InspectMessage().(*pacs_v08.FIToFICustomerCreditTransferV08)
+	message.CdtTrfTxInf[1500].IntrBkSttlmAmt.Ccy = "usd"
+	message.CdtTrfTxInf[700].PmtId.EndToEndId = ""
+	expected := doc.Validate()
+	assert.NotNil(t, expected)
+	for _, workers := range []int{1, 4, 32} {
+		assert.Equal(t, expected, ValidateParallel(doc, workers), fmt.Sprint(workers))
+	}
+
+	// elements before the transactions are validated first
+	message.GrpHdr.MsgId = ""
+	assert.Equal(t, doc.Validate(), ValidateParallel(doc, 4))
+
+	envelope, err := ParseEnvelope(mustReadTestFile(t, "valid_pacs_v08_envelope.xml"))
+	assert.Nil(t, err)
+	assert.Nil(t, ValidateParallel(envelope, 4))
+}
@@ -0,0 +1,114 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package document
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/moov-io/iso20022/pkg/utils"
+)
+
+// Redacted replaces the personal data of redacted parties
+const Redacted = "REDACTED"
+
+// partyElements hold the personal data of a party, a party and its account are redacted together
+var partyElements = map[string]string{
+	"Dbtr":      "DbtrAcct",
+	"DbtrAcct":  "Dbtr",
+	"Cdtr":      "CdtrAcct",
+	"CdtrAcct":  "Cdtr",
+	"UltmtDbtr": "",
+	"UltmtCdtr": "",
+	"InitgPty":  "",
+}
+
+// FindParty returns the paths of the elements of doc identifying party, e.g. its name, BIC or
+// IBAN. Values are compared regardless of case and surrounding whitespace.
+func FindParty(doc Iso20022Document, party string) []string {
+	party = strings.TrimSpace(party)
+	if party == "" {
+		return nil
+	}
+	var paths []string
+	for _, elm := range utils.GetElements(doc.InspectMessage()) {
+		if strings.EqualFold(strings.TrimSpace(elm.Value), party) {
+			paths = append(paths, elm.Path)
+		}
+	}
+	return paths
+}
+
+// RedactParty returns a copy of doc whose elements holding the personal data of party are
+// redacted, with the paths of the redacted elements. Every element of the party blocks (e.g.
+// Dbtr and DbtrAcct) identified by party is replaced by Redacted, or removed when the element
+// can't hold text. The redacted document may not be valid.
+func RedactParty(doc Iso20022Document, party string) (Iso20022Document, []string, error) {
+	matches := FindParty(doc, party)
+	if len(matches) == 0 {
+		return doc, nil, nil
+	}
+
+	var blocks []string
+	for _, path := range matches {
+		blocks = append(blocks, partyBlocks(path)...)
+	}
+
+	var paths []string
+	for _, elm := range utils.GetElements(doc.InspectMessage()) {
+		for _, block := range blocks {
+			if elm.Path == block || strings.HasPrefix(elm.Path, block+"/") {
+				paths = append(paths, elm.Path)
+				break
+			}
+		}
+	}
+
+	redacted, err := ApplyFieldPatches(doc, redactionPatches(paths))
+	if err == nil {
+		return redacted, paths, nil
+	}
+
+	// elements that can't hold text (e.g. dates) are removed
+	redacted = doc
+	for _, patch := range redactionPatches(paths) {
+		next, err := ApplyFieldPatches(redacted, []FieldPatch{patch})
+		if err != nil {
+			if next, err = ApplyFieldPatches(redacted, []FieldPatch{{Path: patch.Path}}); err != nil {
+				return nil, nil, err
+			}
+		}
+		redacted = next
+	}
+	return redacted, paths, nil
+}
+
+// partyBlocks returns the paths of the party blocks enclosing the element at path, the path
+// itself when it isn't enclosed by a party
+func partyBlocks(path string) []string {
+	segments := strings.Split(path, "/")
+	for i := len(segments) - 1; i >= 0; i-- {
+		name := utils.StripElementIndexes(segments[i])
+		sibling, ok := partyElements[name]
+		if !ok {
+			continue
+		}
+		block := strings.Join(segments[:i+1], "/")
+		if sibling == "" {
+			return []string{block}
+		}
+		return []string{block, strings.Join(append(append([]string(nil), segments[:i]...), sibling), "/")}
+	}
+	return []string{path}
+}
+
+func redactionPatches(paths []string) []FieldPatch {
+	value, _ := json.Marshal(Redacted)
+	patches := make([]FieldPatch, len(paths))
+	for i, path := range paths {
+		patches[i] = FieldPatch{Path: path, Value: value}
+	}
+	return patches
+}
@@ -0,0 +1,64 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package document
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/moov-io/iso20022/pkg/common"
+	"github.com/moov-io/iso20022/pkg/utils"
+)
+
+func TestFindParty(t *testing.T) {
+	doc := parsePacs008(t)
+
+	assert.Equal(t, []string{"CdtTrfTxInf[0]/Dbtr/Nm", "CdtTrfTxInf[1]/Dbtr/Nm"}, FindParty(doc, " john smith "))
+	assert.Equal(t, []string{"CdtTrfTxInf[0]/Cdtr/Nm"}, FindParty(doc, "Jane Doe"))
+	assert.Empty(t, FindParty(doc, "Nobody"))
+	assert.Empty(t, FindParty(doc, ""))
+}
+
+func TestRedactParty(t *testing.T) {
+	doc := parsePacs008(t)
+
+	redacted, paths, err := RedactParty(doc, "John Smith")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{
+		"CdtTrfTxInf[0]/Dbtr/Nm",
+		"CdtTrfTxInf[0]/Dbtr/PstlAdr/Ctry",
+		"CdtTrfTxInf[1]/Dbtr/Nm",
+	}, paths)
+
+	message := pacs008Message(redacted)
+	assert.Equal(t, common.Max140Text(Redacted), *message.CdtTrfTxInf[0].Dbtr.Nm)
+	assert.Equal(t, common.Max140Text(Redacted), *message.CdtTrfTxInf[1].Dbtr.Nm)
+	assert.Equal(t, common.Max140Text("Jane Doe"), *message.CdtTrfTxInf[0].Cdtr.Nm)
+	assert.Empty(t, FindParty(redacted, "John Smith"))
+
+	// the original is intact
+	assert.Len(t, FindParty(doc, "John Smith"), 2)
+
+	// the other elements are kept
+	values := make(map[string]string)
+	for _, elm := range utils.GetElements(redacted.InspectMessage()) {
+		values[elm.Path] = elm.Value
+	}
+	assert.Equal(t, "MSG-20210415-0001", values["GrpHdr/MsgId"])
+	assert.Equal(t, "BANKUS33XXX", values["CdtTrfTxInf[1]/DbtrAgt/FinInstnId/BICFI"])
+
+	same, paths, err := RedactParty(doc, "Nobody")
+	assert.Nil(t, err)
+	assert.Empty(t, paths)
+	assert.Equal(t, doc, same)
+}
+
+func TestPartyBlocks(t *testing.T) {
+	assert.Equal(t, []string{"CdtTrfTxInf[0]/Dbtr", "CdtTrfTxInf[0]/DbtrAcct"}, partyBlocks("CdtTrfTxInf[0]/Dbtr/Nm"))
+	assert.Equal(t, []string{"CdtTrfTxInf[0]/CdtrAcct", "CdtTrfTxInf[0]/Cdtr"}, partyBlocks("CdtTrfTxInf[0]/CdtrAcct/Id/IBAN"))
+	assert.Equal(t, []string{"GrpHdr/InitgPty"}, partyBlocks("GrpHdr/InitgPty/Nm"))
+	assert.Equal(t, []string{"GrpHdr/MsgId"}, partyBlocks("GrpHdr/MsgId"))
+}
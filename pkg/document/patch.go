@@ -0,0 +1,292 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package document
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// FieldPatch sets the element of a message at Path (e.g. CdtTrfTxInf[0]/PmtId/InstrId)
+type FieldPatch struct {
+	Path string `json:"path"`
+
+	// Value is the json value of the element, null or omitted removes the element
+	Value json.RawMessage `json:"value,omitempty"`
+}
+
+// ApplyPatch applies patch to the message of doc and returns the patched document, doc is kept
+// intact. patch is either an RFC 7386 json merge patch of the message or an array of field
+// patches. Elements the message doesn't hold are rejected, the patched document isn't validated.
+func ApplyPatch(doc Iso20022Document, patch []byte) (Iso20022Document, error) {
+	trimmed := bytes.TrimSpace(patch)
+	if len(trimmed) > 0 && trimmed[0] == '[' {
+		var patches []FieldPatch
+		if err := json.Unmarshal(trimmed, &patches); err != nil {
+			return nil, err
+		}
+		return ApplyFieldPatches(doc, patches)
+	}
+	return ApplyMergePatch(doc, patch)
+}
+
+// PatchPaths returns the paths of the elements of the message set or removed by patch, a patch
+// of ApplyPatch, e.g. to revalidate the patched document with Revalidate. The empty path is the
+// whole message.
+func PatchPaths(patch []byte) ([]string, error) {
+	trimmed := bytes.TrimSpace(patch)
+	if len(trimmed) > 0 && trimmed[0] == '[' {
+		var patches []FieldPatch
+		if err := json.Unmarshal(trimmed, &patches); err != nil {
+			return nil, err
+		}
+		paths := make([]string, 0, len(patches))
+		for _, patch := range patches {
+			paths = append(paths, strings.Trim(patch.Path, "/"))
+		}
+		return paths, nil
+	}
+
+	p, err := decodeJson(patch)
+	if err != nil {
+		return nil, err
+	}
+	var paths []string
+	mergePatchPaths(p, "", &paths)
+	sort.Strings(paths)
+	return paths, nil
+}
+
+// mergePatchPaths appends the paths of the elements set by the merge patch below path to paths
+func mergePatchPaths(patch interface{}, path string, paths *[]string) {
+	p, ok := patch.(map[string]interface{})
+	if !ok || (len(p) == 0 && path != "") {
+		*paths = append(*paths, path)
+		return
+	}
+	for key, value := range p {
+		if path != "" {
+			key = path + "/" + key
+		}
+		mergePatchPaths(value, key, paths)
+	}
+}
+
+// ApplyMergePatch applies the RFC 7386 json merge patch to the message of doc
+func ApplyMergePatch(doc Iso20022Document, patch []byte) (Iso20022Document, error) {
+	p, err := decodeJson(patch)
+	if err != nil {
+		return nil, err
+	}
+	return patchMessage(doc, func(message interface{}) (interface{}, error) {
+		return mergePatch(message, p), nil
+	})
+}
+
+// ApplyFieldPatches applies patches to the message of doc in order
+func ApplyFieldPatches(doc Iso20022Document, patches []FieldPatch) (Iso20022Document, error) {
+	return patchMessage(doc, func(message interface{}) (interface{}, error) {
+		for _, patch := range patches {
+			var value interface{}
+			if len(patch.Value) > 0 {
+				v, err := decodeJson(patch.Value)
+				if err != nil {
+					return nil, fmt.Errorf("%s: %w", patch.Path, err)
+				}
+				value = v
+			}
+			if patch.Path == "" {
+				return nil, errors.New("field patch path is omitted")
+			}
+			segments := strings.Split(strings.Trim(patch.Path, "/"), "/")
+			patched, err := setField(message, segments, value)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", patch.Path, err)
+			}
+			message = patched
+		}
+		return message, nil
+	})
+}
+
+// patchMessage applies fn to the json tree of the message of doc and decodes the result into a new document
+func patchMessage(doc Iso20022Document, fn func(message interface{}) (interface{}, error)) (Iso20022Document, error) {
+	obj, ok := doc.(*Iso20022DocumentObject)
+	if !ok {
+		return nil, fmt.Errorf("unsupported document %T", doc)
+	}
+	buf, err := json.Marshal(obj.Message)
+	if err != nil {
+		return nil, err
+	}
+	message, err := decodeJson(buf)
+	if err != nil {
+		return nil, err
+	}
+	if message, err = fn(message); err != nil {
+		return nil, err
+	}
+	if buf, err = json.Marshal(message); err != nil {
+		return nil, err
+	}
+
+	patched, err := NewDocument(doc.NameSpace())
+	if err != nil {
+		return nil, err
+	}
+	out := patched.(*Iso20022DocumentObject)
+	out.XMLName = obj.XMLName
+	out.Attrs = append([]xml.Attr(nil), obj.Attrs...)
+
+	dec := json.NewDecoder(bytes.NewReader(buf))
+	dec.DisallowUnknownFields()
+	if err = dec.Decode(out.Message); err != nil {
+		return nil, err
+	}
+	return patched, nil
+}
+
+func decodeJson(buf []byte) (interface{}, error) {
+	var v interface{}
+	dec := json.NewDecoder(bytes.NewReader(buf))
+	dec.UseNumber()
+	if err := dec.Decode(&v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// mergePatch merges patch into target like RFC 7386
+func mergePatch(target, patch interface{}) interface{} {
+	p, ok := patch.(map[string]interface{})
+	if !ok {
+		return patch
+	}
+	t, ok := target.(map[string]interface{})
+	if !ok {
+		t = make(map[string]interface{})
+	}
+	for key, value := range p {
+		if value == nil {
+			delete(t, key)
+			continue
+		}
+		t[key] = mergePatch(t[key], value)
+	}
+	return t
+}
+
+// setField sets the element of node at the path of segments to value, a nil value removes it.
+// Missing elements are created, an index one past the end of a list appends to it.
+func setField(node interface{}, segments []string, value interface{}) (interface{}, error) {
+	name, index, err := parseSegment(segments[0])
+	if err != nil {
+		return nil, err
+	}
+
+	obj, ok := node.(map[string]interface{})
+	if node == nil {
+		obj, ok = make(map[string]interface{}), true
+	}
+	if !ok {
+		return nil, fmt.Errorf("%s isn't an element", name)
+	}
+
+	last := len(segments) == 1
+	if index < 0 {
+		if last {
+			setValue(obj, name, value)
+			return obj, nil
+		}
+		child, err := setField(obj[name], segments[1:], value)
+		if err != nil {
+			return nil, err
+		}
+		obj[name] = child
+		return obj, nil
+	}
+
+	list, isList := obj[name].([]interface{})
+	if obj[name] != nil && !isList {
+		return nil, fmt.Errorf("%s isn't repeated", name)
+	}
+	if index > len(list) {
+		return nil, fmt.Errorf("index %d of %s is out of range", index, name)
+	}
+
+	switch {
+	case last && value == nil:
+		if index < len(list) {
+			list = append(list[:index], list[index+1:]...)
+		}
+	case index == len(list):
+		list = append(list, nil)
+		fallthrough
+	default:
+		if last {
+			list[index] = replaceValue(list[index], value)
+			break
+		}
+		child, err := setField(list[index], segments[1:], value)
+		if err != nil {
+			return nil, err
+		}
+		list[index] = child
+	}
+	obj[name] = list
+	return obj, nil
+}
+
+// setValue sets the element name of obj to value, a nil value removes it
+func setValue(obj map[string]interface{}, name string, value interface{}) {
+	if value == nil {
+		delete(obj, name)
+		return
+	}
+	obj[name] = replaceValue(obj[name], value)
+}
+
+// replaceValue returns value replacing current, the character data of elements with
+// attributes (e.g. amounts) is replaced when value isn't an element
+func replaceValue(current, value interface{}) interface{} {
+	item, ok := current.(map[string]interface{})
+	if !ok {
+		return value
+	}
+	if _, isElement := value.(map[string]interface{}); isElement {
+		return value
+	}
+	if _, hasValue := item["Value"]; hasValue {
+		item["Value"] = value
+		return item
+	}
+	return value
+}
+
+// parseSegment returns the name and index of a path segment (Name, Name[1] or @Name), -1 without index
+func parseSegment(segment string) (string, int, error) {
+	segment = strings.TrimPrefix(segment, "@")
+	open := strings.Index(segment, "[")
+	if open < 0 {
+		if segment == "" {
+			return "", -1, errors.New("empty element name")
+		}
+		return segment, -1, nil
+	}
+	if !strings.HasSuffix(segment, "]") || open == 0 {
+		return "", -1, fmt.Errorf("invalid element %s", segment)
+	}
+	index, err := strconv.Atoi(segment[open+1 : len(segment)-1])
+	if err != nil || index < 0 {
+		return "", -1, fmt.Errorf("invalid index of %s", segment)
+	}
+	return segment[:open], index, nil
+}
@@ -0,0 +1,80 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package document
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/moov-io/iso20022/pkg/common"
+	"github.com/moov-io/iso20022/pkg/pacs_v08"
+)
+
+func parsePacs008(t *testing.T) Iso20022Document {
+	t.Helper()
+	doc, err := ParseIso20022Document(mustReadTestFile(t, "valid_pacs_v08.xml"))
+	assert.Nil(t, err)
+	return doc
+}
+
+func pacs008Message(doc Iso20022Document) *pacs_v08.FIToFICustomerCreditTransferV08 {
+	return doc.InspectMessage().(*pacs_v08.FIToFICustomerCreditTransferV08)
+}
+
+func TestApplyMergePatch(t *testing.T) {
+	doc := parsePacs008(t)
+
+	patched, err := ApplyPatch(doc, []byte(`{"GrpHdr": {"MsgId": "MSG-REPAIRED", "PmtTpInf": null}}`))
+	assert.Nil(t, err)
+	assert.Equal(t, common.Max35Text("MSG-REPAIRED"), pacs008Message(patched).GrpHdr.MsgId)
+	assert.Nil(t, pacs008Message(patched).GrpHdr.PmtTpInf)
+	assert.Equal(t, doc.NameSpace(), patched.NameSpace())
+	assert.Nil(t, patched.Validate())
+
+	// the amounts keep their precision, the original is intact
+	assert.Equal(t, pacs008Message(doc).GrpHdr.TtlIntrBkSttlmAmt, pacs008Message(patched).GrpHdr.TtlIntrBkSttlmAmt)
+	assert.Equal(t, common.Max35Text("MSG-20210415-0001"), pacs008Message(doc).GrpHdr.MsgId)
+	assert.NotNil(t, pacs008Message(doc).GrpHdr.PmtTpInf)
+
+	_, err = ApplyPatch(doc, []byte(`{"GrpHdr": {"Unknown": "value"}}`))
+	assert.NotNil(t, err)
+	_, err = ApplyPatch(doc, []byte(`{"GrpHdr": `))
+	assert.NotNil(t, err)
+}
+
+func TestApplyFieldPatches(t *testing.T) {
+	doc := parsePacs008(t)
+
+	patched, err := ApplyPatch(doc, []byte(`[
+		{"path": "GrpHdr/MsgId", "value": "MSG-REPAIRED"},
+		{"path": "CdtTrfTxInf[1]/IntrBkSttlmAmt", "value": 10.5},
+		{"path": "CdtTrfTxInf[1]/IntrBkSttlmAmt/@Ccy", "value": "EUR"},
+		{"path": "CdtTrfTxInf[0]/PmtId/InstrId"}
+	]`))
+	assert.Nil(t, err)
+	message := pacs008Message(patched)
+	assert.Equal(t, common.Max35Text("MSG-REPAIRED"), message.GrpHdr.MsgId)
+	assert.Equal(t, 10.5, message.CdtTrfTxInf[1].IntrBkSttlmAmt.Value)
+	assert.Equal(t, common.ActiveCurrencyCode("EUR"), message.CdtTrfTxInf[1].IntrBkSttlmAmt.Ccy)
+	assert.Nil(t, message.CdtTrfTxInf[0].PmtId.InstrId)
+	assert.Len(t, message.CdtTrfTxInf, 2)
+
+	patched, err = ApplyFieldPatches(doc, []FieldPatch{{Path: "CdtTrfTxInf[0]"}})
+	assert.Nil(t, err)
+	assert.Len(t, pacs008Message(patched).CdtTrfTxInf, 1)
+
+	for _, patch := range []FieldPatch{
+		{Path: ""},
+		{Path: "CdtTrfTxInf[5]/PmtId/InstrId", Value: []byte(`"X"`)},
+		{Path: "GrpHdr[0]/MsgId", Value: []byte(`"X"`)},
+		{Path: "CdtTrfTxInf[x]", Value: []byte(`"X"`)},
+		{Path: "GrpHdr/MsgId/Sub", Value: []byte(`"X"`)},
+		{Path: "GrpHdr/Unknown", Value: []byte(`"X"`)},
+	} {
+		_, err = ApplyFieldPatches(doc, []FieldPatch{patch})
+		assert.NotNil(t, err, patch.Path)
+	}
+}
@@ -0,0 +1,28 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package document
+
+import (
+	"github.com/moov-io/iso20022/pkg/utils"
+)
+
+// Revalidate validates the elements of the message of doc at paths, for example the elements
+// changed by a patch (see PatchPaths), instead of the whole document. The other elements are
+// assumed valid, as they are in a patched valid document: the error is the error Validate
+// returns then. See utils.ValidateElements.
+func Revalidate(doc Iso20022Document, paths []string) error {
+	switch d := doc.(type) {
+	case *Iso20022DocumentObject:
+		return d.validate(func(r interface{}) error {
+			return utils.ValidateElements(r, paths)
+		})
+	case *Envelope:
+		if err := d.ValidateHeader(); err != nil {
+			return err
+		}
+		return Revalidate(d.Document, paths)
+	}
+	return doc.Validate()
+}
@@ -0,0 +1,61 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package document
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPatchPaths(t *testing.T) {
+	paths, err := PatchPaths([]byte(`[
+		{"path": "GrpHdr/MsgId", "value": "MSG-REPAIRED"},
+		{"path": "/CdtTrfTxInf[1]/IntrBkSttlmAmt/@Ccy", "value": "EUR"}
+	]`))
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"GrpHdr/MsgId", "CdtTrfTxInf[1]/IntrBkSttlmAmt/@Ccy"}, paths)
+
+	paths, err = PatchPaths([]byte(`{"GrpHdr": {"MsgId": "MSG-REPAIRED", "PmtTpInf": null, "SttlmInf": {}}, "CdtTrfTxInf": []}`))
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"CdtTrfTxInf", "GrpHdr/MsgId", "GrpHdr/PmtTpInf", "GrpHdr/SttlmInf"}, paths)
+
+	// patches replacing the message patch every element
+	paths, err = PatchPaths([]byte(`null`))
+	assert.Nil(t, err)
+	assert.Equal(t, []string{""}, paths)
+
+	_, err = PatchPaths([]byte(`{"GrpHdr": `))
+	assert.NotNil(t, err)
+}
+
+func TestRevalidate(t *testing.T) {
+	doc := batchDocument(t, 2000)
+
+	for _, patch := range []string{
+		`[{"path": "CdtTrfTxInf[1500]/IntrBkSttlmAmt/@Ccy", "value": "usd"}]`,
+		`[{"path": "CdtTrfTxInf[700]/PmtId/EndToEndId"}, {"path": "CdtTrfTxInf[1500]/IntrBkSttlmAmt/@Ccy", "value": "usd"}]`,
+		`{"GrpHdr": {"MsgId": null}}`,
+		`{"GrpHdr": {"SttlmInf": {"SttlmMtd": "NONE"}}}`,
+	} {
+		patched, err := ApplyPatch(doc, []byte(patch))
+		assert.Nil(t, err)
+		paths, err := PatchPaths([]byte(patch))
+		assert.Nil(t, err)
+
+		// the error is the one of the first invalid element like with Validate
+		expected := patched.Validate()
+		assert.NotNil(t, expected, patch)
+		assert.Equal(t, expected, Revalidate(patched, paths), patch)
+	}
+
+	patched, err := ApplyPatch(doc, []byte(`[{"path": "CdtTrfTxInf[3]/PmtId/InstrId", "value": "INSTR-REPAIRED"}]`))
+	assert.Nil(t, err)
+	assert.Nil(t, Revalidate(patched, []string{"CdtTrfTxInf[3]/PmtId/InstrId"}))
+
+	envelope, err := ParseEnvelope(mustReadTestFile(t, "valid_pacs_v08_envelope.xml"))
+	assert.Nil(t, err)
+	assert.Nil(t, Revalidate(envelope, []string{"GrpHdr"}))
+}
@@ -0,0 +1,47 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package document
+
+import (
+	"sort"
+
+	"github.com/moov-io/iso20022/pkg/utils"
+)
+
+// MessageTypes returns the supported message types (e.g. pacs.008.001.08) in order
+func MessageTypes() []string {
+	types := make([]string, 0, len(messageConstructor))
+	for space := range messageConstructor {
+		types = append(types, utils.GetMessageType(space))
+	}
+	sort.Strings(types)
+	return types
+}
+
+// Schema returns the elements of messageType
+func Schema(messageType string) ([]utils.SchemaElement, error) {
+	message, err := newMessage(messageType)
+	if err != nil {
+		return nil, err
+	}
+	return utils.DescribeElements(message), nil
+}
+
+// CheckElementValue validates value against the constraints of the element of messageType at path
+func CheckElementValue(messageType, path, value string) error {
+	message, err := newMessage(messageType)
+	if err != nil {
+		return err
+	}
+	return utils.CheckElementValue(message, path, value)
+}
+
+func newMessage(messageType string) (Iso20022Message, error) {
+	constructor := messageConstructor[utils.NameSpacePrefix+utils.GetMessageType(messageType)]
+	if constructor == nil {
+		return nil, utils.NewErrUnsupportedNameSpace()
+	}
+	return constructor(), nil
+}
@@ -0,0 +1,42 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package document
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/moov-io/iso20022/pkg/utils"
+)
+
+func TestMessageTypes(t *testing.T) {
+	types := MessageTypes()
+	assert.Len(t, types, len(messageConstructor))
+	assert.Contains(t, types, "pacs.008.001.08")
+	assert.IsIncreasing(t, types)
+}
+
+func TestSchema(t *testing.T) {
+	elements, err := Schema("pacs.008.001.08")
+	assert.Nil(t, err)
+	assert.Equal(t, utils.SchemaElement{Path: "GrpHdr/MsgId", Type: "Max35Text", Required: true, Value: true}, elements[1])
+	assert.Contains(t, elements, utils.SchemaElement{Path: "CdtTrfTxInf", Type: "CreditTransferTransaction39", Required: false, Repeated: true})
+
+	_, err = Schema(utils.DocumentPacs00800108NameSpace)
+	assert.Nil(t, err)
+
+	_, err = Schema("pacs.999.001.01")
+	assert.NotNil(t, err)
+}
+
+func TestCheckElementValue(t *testing.T) {
+	assert.Nil(t, CheckElementValue("pacs.008.001.08", "GrpHdr/CreDtTm", "2021-04-15T10:29:58"))
+	assert.Nil(t, CheckElementValue("pacs.008.001.08", "CdtTrfTxInf[1]/IntrBkSttlmAmt", "500.75"))
+	assert.NotNil(t, CheckElementValue("pacs.008.001.08", "GrpHdr/CreDtTm", "yesterday"))
+	assert.NotNil(t, CheckElementValue("pacs.008.001.08", "CdtTrfTxInf/IntrBkSttlmAmt/@Ccy", "usd"))
+	assert.NotNil(t, CheckElementValue("pacs.008.001.08", "GrpHdr/Unknown", ""))
+	assert.NotNil(t, CheckElementValue("pacs.999.001.01", "GrpHdr/MsgId", "MSG-1"))
+}
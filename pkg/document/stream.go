@@ -0,0 +1,260 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package document
+
+import (
+	"context"
+	"errors"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/moov-io/iso20022/pkg/utils"
+)
+
+// Types of stream events
+const (
+	StreamEventStart       = "start"
+	StreamEventTransaction = "transaction"
+	StreamEventElement     = "element"
+	StreamEventEnd         = "end"
+)
+
+// StreamEvent is an incremental result of ValidateStream
+type StreamEvent struct {
+	Type string `json:"type"`
+
+	// MessageType is set by the start event
+	MessageType string `json:"messageType,omitempty"`
+
+	// Path and Valid are set by transaction and element events, element events are only emitted for invalid elements
+	Path  string `json:"path,omitempty"`
+	Valid bool   `json:"valid"`
+	Error string `json:"error,omitempty"`
+
+	// Progress of the stream
+	Bytes        int64 `json:"bytes"`
+	Transactions int   `json:"transactions"`
+	Invalid      int   `json:"invalid"`
+}
+
+var (
+	validatorType = reflect.TypeOf((*Iso20022Message)(nil)).Elem()
+)
+
+// ValidateStream validates the xml document read from r incrementally, every transaction
+// (see utils.TransactionElements) is decoded and validated on its own and reported to fn
+// as soon as it was read. Elements outside of transactions are validated by the block
+// enclosing them. The document is valid when the end event has no invalid elements.
+func ValidateStream(r io.Reader, fn func(event StreamEvent)) error {
+	return ValidateStreamContext(context.Background(), r, fn)
+}
+
+// ValidateStreamContext validates like ValidateStream until ctx is done, it returns the error
+// of ctx without end event when the document isn't read by then. The events emitted before
+// are the partial results of the validation. The document is read with a utils.StreamingReader
+// emitting the transactions and the elements outside of them.
+func ValidateStreamContext(ctx context.Context, r io.Reader, fn func(event StreamEvent)) error {
+	reader := utils.NewStreamingReader(r)
+	v := &streamValidator{ctx: ctx, reader: reader, fn: fn}
+	reader.Emit = v.emits
+
+	for {
+		elm, err := reader.Next()
+		if v.err != nil {
+			return v.err
+		}
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if v.field == nil {
+			continue
+		}
+		if err := v.validate(elm); err != nil {
+			return err
+		}
+	}
+
+	if v.frames == nil {
+		if err := v.start(); err != nil {
+			return err
+		}
+	}
+	v.emit(StreamEvent{Type: StreamEventEnd, Valid: v.progress.Invalid == 0})
+	return nil
+}
+
+// streamValidator selects the elements a StreamingReader emits for ValidateStreamContext
+type streamValidator struct {
+	ctx      context.Context
+	reader   *utils.StreamingReader
+	fn       func(event StreamEvent)
+	progress StreamEvent
+
+	// frames are the elements holding transactions by their path without indexes
+	frames map[string]*streamFrame
+
+	// field and path are those of the emitted element, field is nil for skipped elements
+	field reflect.Type
+	path  string
+
+	// err stops the validation, the element emitted with it isn't validated
+	err error
+}
+
+type streamFrame struct {
+	typ    reflect.Type
+	path   string
+	counts map[string]int
+}
+
+func (v *streamValidator) emit(event StreamEvent) {
+	event.Bytes = v.reader.InputOffset()
+	event.Transactions = v.progress.Transactions
+	event.Invalid = v.progress.Invalid
+	v.fn(event)
+}
+
+// start emits the start event once the namespace of the document is read
+func (v *streamValidator) start() error {
+	doc, err := NewDocument(v.reader.Namespace())
+	if err != nil {
+		return err
+	}
+	v.emit(StreamEvent{Type: StreamEventStart, MessageType: v.reader.MessageType(), Valid: true})
+	v.frames = map[string]*streamFrame{"": {typ: reflect.TypeOf(doc.InspectMessage()).Elem(), counts: make(map[string]int)}}
+	return nil
+}
+
+// emits descends into the message and the elements holding transactions, the other elements
+// are emitted to be validated or skipped when they aren't in the message model
+func (v *streamValidator) emits(parents []string, name string) bool {
+	v.field, v.path = nil, ""
+	if v.err = v.ctx.Err(); v.err != nil {
+		return true
+	}
+	if len(parents) == 0 {
+		v.err = v.start()
+		return v.err != nil
+	}
+
+	key := strings.Join(parents[1:], "/")
+	parent := v.frames[key]
+	field, repeated, ok := streamField(parent.typ, name)
+	if !ok {
+		return true
+	}
+
+	path := name
+	if repeated {
+		path += "[" + strconv.Itoa(parent.counts[name]) + "]"
+		parent.counts[name]++
+	}
+	if parent.path != "" {
+		path = parent.path + "/" + path
+	}
+
+	if !isTransactionElement(name) && field.Kind() == reflect.Struct && containsTransactions(field, map[reflect.Type]bool{}) {
+		if key != "" {
+			key += "/"
+		}
+		v.frames[key+name] = &streamFrame{typ: field, path: path, counts: make(map[string]int)}
+		return false
+	}
+	v.field, v.path = field, path
+	return true
+}
+
+// validate decodes and validates an emitted element, reporting the transactions and the
+// invalid elements
+func (v *streamValidator) validate(elm *utils.StreamedElement) error {
+	value := reflect.New(v.field)
+	if err := elm.Decode(value.Interface()); err != nil {
+		return err
+	}
+	var verr error
+	if validator, ok := value.Interface().(Iso20022Message); ok {
+		verr = validator.Validate()
+	}
+
+	isTransaction := isTransactionElement(elm.Name)
+	if verr != nil {
+		v.progress.Invalid++
+	}
+	if isTransaction {
+		v.progress.Transactions++
+	}
+	if isTransaction || verr != nil {
+		event := StreamEvent{Type: StreamEventElement, Path: v.path, Valid: verr == nil}
+		if isTransaction {
+			event.Type = StreamEventTransaction
+		}
+		if verr != nil {
+			event.Error = verr.Error()
+		}
+		v.emit(event)
+	}
+	return nil
+}
+
+// streamField returns the element type of the field of struct typ with xml name
+func streamField(typ reflect.Type, name string) (reflect.Type, bool, bool) {
+	if typ.Kind() != reflect.Struct {
+		return nil, false, false
+	}
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		tag := strings.Split(field.Tag.Get("xml"), ",")[0]
+		if tag != name {
+			continue
+		}
+		ft := field.Type
+		repeated := false
+		if ft.Kind() == reflect.Slice {
+			ft = ft.Elem()
+			repeated = true
+		}
+		for ft.Kind() == reflect.Ptr {
+			ft = ft.Elem()
+		}
+		return ft, repeated, true
+	}
+	return nil, false, false
+}
+
+func isTransactionElement(name string) bool {
+	for _, tx := range utils.TransactionElements {
+		if name == tx {
+			return true
+		}
+	}
+	return false
+}
+
+// containsTransactions reports whether a struct type has transaction elements at any depth
+func containsTransactions(typ reflect.Type, seen map[reflect.Type]bool) bool {
+	if seen[typ] {
+		return false
+	}
+	seen[typ] = true
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		if isTransactionElement(strings.Split(field.Tag.Get("xml"), ",")[0]) {
+			return true
+		}
+		ft := field.Type
+		for ft.Kind() == reflect.Ptr || ft.Kind() == reflect.Slice {
+			ft = ft.Elem()
+		}
+		if ft.Kind() == reflect.Struct && ft.Implements(validatorType) && containsTransactions(ft, seen) {
+			return true
+		}
+	}
+	return false
+}
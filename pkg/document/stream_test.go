@@ -0,0 +1,119 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package document
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func streamEvents(t *testing.T, input string) ([]StreamEvent, error) {
+	var events []StreamEvent
+	err := ValidateStream(strings.NewReader(input), func(event StreamEvent) {
+		events = append(events, event)
+	})
+	return events, err
+}
+
+func TestValidateStream(t *testing.T) {
+	input, err := os.ReadFile(filepath.Join("..", "..", "test", "testdata", "valid_pacs_v08.xml"))
+	assert.Nil(t, err)
+
+	events, err := streamEvents(t, string(input))
+	assert.Nil(t, err)
+	assert.Len(t, events, 4)
+
+	assert.Equal(t, StreamEventStart, events[0].Type)
+	assert.Equal(t, "pacs.008.001.08", events[0].MessageType)
+
+	assert.Equal(t, StreamEventTransaction, events[1].Type)
+	assert.Equal(t, "CdtTrfTxInf[0]", events[1].Path)
+	assert.True(t, events[1].Valid)
+	assert.Equal(t, 1, events[1].Transactions)
+	assert.Equal(t, "CdtTrfTxInf[1]", events[2].Path)
+	assert.Greater(t, events[2].Bytes, events[1].Bytes)
+
+	assert.Equal(t, StreamEvent{
+		Type:         StreamEventEnd,
+		Valid:        true,
+		Bytes:        int64(len(bytes.TrimSpace(input))),
+		Transactions: 2,
+	}, events[3])
+}
+
+func TestValidateStreamContext(t *testing.T) {
+	input, err := os.ReadFile(filepath.Join("..", "..", "test", "testdata", "valid_pacs_v08.xml"))
+	assert.Nil(t, err)
+
+	// the events before ctx is done are the partial results
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	var events []StreamEvent
+	err = ValidateStreamContext(ctx, bytes.NewReader(input), func(event StreamEvent) {
+		events = append(events, event)
+		if event.Type == StreamEventTransaction {
+			cancel()
+		}
+	})
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Len(t, events, 2)
+	assert.Equal(t, "CdtTrfTxInf[0]", events[1].Path)
+	assert.Equal(t, 1, events[1].Transactions)
+
+	err = ValidateStreamContext(ctx, bytes.NewReader(input), func(event StreamEvent) {
+		t.Errorf("unexpected %s event", event.Type)
+	})
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func TestValidateStreamWithInvalidData(t *testing.T) {
+	input, err := os.ReadFile(filepath.Join("..", "..", "test", "testdata", "valid_pacs_v08.xml"))
+	assert.Nil(t, err)
+
+	invalid := strings.Replace(string(input), `<IntrBkSttlmAmt Ccy="USD">500.75`, `<IntrBkSttlmAmt Ccy="usd">500.75`, 1)
+	invalid = strings.Replace(invalid, "<NbOfTxs>2</NbOfTxs>", "<NbOfTxs>two</NbOfTxs>", 1)
+	events, err := streamEvents(t, invalid)
+	assert.Nil(t, err)
+	assert.Len(t, events, 5)
+
+	assert.Equal(t, StreamEventElement, events[1].Type)
+	assert.Equal(t, "GrpHdr", events[1].Path)
+	assert.False(t, events[1].Valid)
+	assert.True(t, events[2].Valid)
+	assert.False(t, events[3].Valid)
+	assert.NotEmpty(t, events[3].Error)
+	assert.Equal(t, 2, events[4].Invalid)
+	assert.False(t, events[4].Valid)
+
+	_, err = streamEvents(t, string(input[:len(input)/2]))
+	assert.NotNil(t, err)
+
+	_, err = streamEvents(t, strings.TrimSuffix(strings.TrimSpace(string(input)), "</Document>"))
+	assert.NotNil(t, err)
+
+	_, err = streamEvents(t, "")
+	assert.Equal(t, io.ErrUnexpectedEOF, err)
+
+	_, err = streamEvents(t, `<Document xmlns="urn:iso:std:iso:20022:tech:xsd:pacs.008.001.01"></Document>`)
+	assert.NotNil(t, err)
+}
+
+func TestValidateStreamWithoutTransactions(t *testing.T) {
+	input, err := os.ReadFile(filepath.Join("..", "..", "test", "testdata", "valid_pacs_v11.xml"))
+	assert.Nil(t, err)
+
+	events, err := streamEvents(t, string(input))
+	assert.Nil(t, err)
+	assert.Len(t, events, 2)
+	assert.Equal(t, StreamEventEnd, events[1].Type)
+	assert.True(t, events[1].Valid)
+}
@@ -0,0 +1,131 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package document
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"io"
+	"strings"
+
+	"github.com/moov-io/iso20022/pkg/utils"
+)
+
+// ValidateStrict validates buf like Validate and rejects the elements the message model
+// doesn't hold. Parsing silently drops such elements (e.g. elements of a newer release of
+// the schema), strict validation detects the drift at the cost of walking the input twice.
+func ValidateStrict(buf []byte) error {
+	doc, err := ParseIso20022Document(buf)
+	if err != nil {
+		return err
+	}
+	if err = doc.Validate(); err != nil {
+		return err
+	}
+	return CheckUnknownElements(doc, buf)
+}
+
+// CheckUnknownElements returns an error for the first element of buf the message of doc,
+// parsed from buf, doesn't hold
+func CheckUnknownElements(doc Iso20022Document, buf []byte) error {
+	if utils.GetDocumentFormat(buf) == utils.DocumentTypeJson {
+		strict, err := NewDocument(doc.NameSpace())
+		if err != nil {
+			return err
+		}
+		dec := json.NewDecoder(bytes.NewReader(buf))
+		dec.DisallowUnknownFields()
+		return dec.Decode(strict)
+	}
+
+	message := doc.InspectMessage()
+	known := make(map[string]bool)
+	for _, elm := range utils.DescribeElements(message) {
+		known[elm.Path] = true
+	}
+	for _, elm := range utils.GetElements(message) {
+		known[utils.StripElementIndexes(elm.Path)] = true
+	}
+
+	var unknown error
+	err := walkXmlLeaves(buf, func(path string) bool {
+		if !known[path] {
+			unknown = utils.NewErrUnknownElement(path)
+			return false
+		}
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	return unknown
+}
+
+// walkXmlLeaves calls fn with the path of every attribute and non-empty leaf element of
+// the message in buf until fn returns false. Paths are relative to the message element.
+func walkXmlLeaves(buf []byte, fn func(path string) bool) error {
+	dec := xml.NewDecoder(bytes.NewReader(buf))
+
+	type frame struct {
+		name     string
+		text     strings.Builder
+		children bool
+	}
+	var stack []*frame
+	path := func(name string) string {
+		names := make([]string, 0, len(stack))
+		for _, f := range stack[2:] {
+			names = append(names, f.name)
+		}
+		return strings.Join(append(names, name), "/")
+	}
+
+	for {
+		token, err := dec.Token()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		switch tok := token.(type) {
+		case xml.StartElement:
+			if len(stack) > 0 {
+				stack[len(stack)-1].children = true
+			}
+			stack = append(stack, &frame{name: tok.Name.Local})
+			if len(stack) < 3 {
+				continue
+			}
+			for _, attr := range tok.Attr {
+				if attr.Name.Space == utils.XmlDefaultNamespace || attr.Name.Local == utils.XmlDefaultNamespace {
+					continue
+				}
+				if !fn(path("@" + attr.Name.Local)) {
+					return nil
+				}
+			}
+		case xml.CharData:
+			if len(stack) > 0 {
+				stack[len(stack)-1].text.Write(tok)
+			}
+		case xml.EndElement:
+			if len(stack) == 0 {
+				continue
+			}
+			current := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			if len(stack) < 2 || current.children || strings.TrimSpace(current.text.String()) == "" {
+				continue
+			}
+			if !fn(path(current.name)) {
+				return nil
+			}
+		}
+	}
+}
@@ -0,0 +1,57 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package document
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateStrict(t *testing.T) {
+	input, err := os.ReadFile(filepath.Join("..", "..", "test", "testdata", "valid_pacs_v08.xml"))
+	assert.Nil(t, err)
+	assert.Nil(t, ValidateStrict(input))
+
+	drifted := strings.Replace(string(input), "</GrpHdr>", "<NewElm>value</NewElm></GrpHdr>", 1)
+	doc, err := ParseIso20022Document([]byte(drifted))
+	assert.Nil(t, err)
+	assert.Nil(t, doc.Validate())
+	err = ValidateStrict([]byte(drifted))
+	assert.NotNil(t, err)
+	assert.Equal(t, "The element GrpHdr/NewElm is unknown", err.Error())
+
+	attributed := strings.Replace(string(input), "<GrpHdr>", `<GrpHdr Ref="1">`, 1)
+	err = ValidateStrict([]byte(attributed))
+	assert.NotNil(t, err)
+	assert.Equal(t, "The element GrpHdr/@Ref is unknown", err.Error())
+
+	invalid, err := os.ReadFile(filepath.Join("..", "..", "test", "testdata", "invalid_pain_v11.xml"))
+	assert.Nil(t, err)
+	assert.NotNil(t, ValidateStrict(invalid))
+}
+
+func TestValidateStrictJson(t *testing.T) {
+	doc, err := ParseIso20022Document(mustReadTestFile(t, "valid_pacs_v08.xml"))
+	assert.Nil(t, err)
+	buf, err := json.Marshal(doc)
+	assert.Nil(t, err)
+	assert.Nil(t, ValidateStrict(buf))
+
+	drifted := strings.Replace(string(buf), `"GrpHdr":{`, `"GrpHdr":{"NewElm":"value",`, 1)
+	assert.NotNil(t, ValidateStrict([]byte(drifted)))
+}
+
+// mustReadTestFile reads the testdata like fixtures.ReadFile, which depends on this package
+func mustReadTestFile(t *testing.T, name string) []byte {
+	t.Helper()
+	buf, err := os.ReadFile(filepath.Join("..", "..", "test", "testdata", name))
+	assert.Nil(t, err)
+	return buf
+}
@@ -0,0 +1,167 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package document
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/moov-io/iso20022/pkg/utils"
+)
+
+// SupportStatus is the support of a message version
+type SupportStatus string
+
+const (
+	// SupportCurrent is the status of the latest supported version of a message
+	SupportCurrent SupportStatus = "current"
+
+	// SupportDeprecated is the status of the versions older than the current one
+	SupportDeprecated SupportStatus = "deprecated"
+
+	// SupportRemovalScheduled is the status of versions whose removal is scheduled by ScheduleRemoval
+	SupportRemovalScheduled SupportStatus = "removal-scheduled"
+
+	// SupportUnsupported is the status of the message versions that can't be read
+	SupportUnsupported SupportStatus = "unsupported"
+
+	// SupportExperimental is the status of versions marked by MarkExperimental, they don't
+	// deprecate the versions before them
+	SupportExperimental SupportStatus = "experimental"
+)
+
+// Support is the support policy of a message version
+type Support struct {
+	MessageType string        `json:"messageType"`
+	Status      SupportStatus `json:"status"`
+
+	// Successor is the current version of the message of older versions
+	Successor string `json:"successor,omitempty"`
+
+	// Removal is the date the version is removed, nil unless it's scheduled
+	Removal *time.Time `json:"removal,omitempty"`
+}
+
+// Warning describes the migration from deprecated versions, empty for current versions
+func (s Support) Warning() string {
+	var warning string
+	switch s.Status {
+	case SupportDeprecated:
+		warning = fmt.Sprintf("%s is deprecated", s.MessageType)
+	case SupportRemovalScheduled:
+		warning = fmt.Sprintf("%s is scheduled for removal on %s", s.MessageType, s.Removal.Format("2006-01-02"))
+	case SupportUnsupported:
+		return fmt.Sprintf("%s isn't supported", s.MessageType)
+	case SupportExperimental:
+		return fmt.Sprintf("%s is experimental", s.MessageType)
+	default:
+		return ""
+	}
+	if s.Successor != "" {
+		warning += ", migrate to " + s.Successor
+	}
+	return warning
+}
+
+var (
+	removalsMu sync.RWMutex
+	removals   = make(map[string]time.Time)
+
+	experimentalMu sync.RWMutex
+	experimental   = make(map[string]bool)
+)
+
+// ScheduleRemoval announces the removal of messageType on date, a zero date cancels it
+func ScheduleRemoval(messageType string, date time.Time) {
+	removalsMu.Lock()
+	defer removalsMu.Unlock()
+	messageType = utils.GetMessageType(messageType)
+	if date.IsZero() {
+		delete(removals, messageType)
+		return
+	}
+	removals[messageType] = date
+}
+
+// MarkExperimental marks messageType as an experimental version, e.g. a version supported
+// before schemes adopt it, false removes the mark
+func MarkExperimental(messageType string, marked bool) {
+	experimentalMu.Lock()
+	defer experimentalMu.Unlock()
+	messageType = utils.GetMessageType(messageType)
+	if !marked {
+		delete(experimental, messageType)
+		return
+	}
+	experimental[messageType] = true
+}
+
+func isExperimental(messageType string) bool {
+	experimentalMu.RLock()
+	defer experimentalMu.RUnlock()
+	return experimental[messageType]
+}
+
+// SupportLevel returns the support of the message version of namespace, a namespace or message type
+func SupportLevel(namespace string) Support {
+	messageType := utils.GetMessageType(namespace)
+	support := Support{MessageType: messageType, Status: SupportUnsupported}
+	if messageConstructor[utils.NameSpacePrefix+messageType] == nil {
+		return support
+	}
+
+	if isExperimental(messageType) {
+		support.Status = SupportExperimental
+		return support
+	}
+	support.Status = SupportCurrent
+	if current := currentVersion(messageType); current != messageType {
+		support.Status = SupportDeprecated
+		support.Successor = current
+	}
+
+	removalsMu.RLock()
+	defer removalsMu.RUnlock()
+	if date, ok := removals[messageType]; ok {
+		support.Status = SupportRemovalScheduled
+		support.Removal = &date
+	}
+	return support
+}
+
+// SupportPolicy returns the support of every supported message version in order
+func SupportPolicy() []Support {
+	types := MessageTypes()
+	policy := make([]Support, 0, len(types))
+	for _, messageType := range types {
+		policy = append(policy, SupportLevel(messageType))
+	}
+	return policy
+}
+
+// currentVersion returns the latest supported version of the message of messageType that
+// isn't experimental
+func currentVersion(messageType string) string {
+	message := messageName(messageType)
+	current := messageType
+	for _, other := range MessageTypes() {
+		// versions share their width, they're ordered as strings
+		if messageName(other) == message && other > current && !isExperimental(other) {
+			current = other
+		}
+	}
+	return current
+}
+
+// messageName returns the message type without its variant and version, e.g. pacs.008
+func messageName(messageType string) string {
+	parts := strings.SplitN(messageType, ".", 3)
+	if len(parts) < 2 {
+		return messageType
+	}
+	return parts[0] + "." + parts[1]
+}
@@ -0,0 +1,80 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package document
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/moov-io/iso20022/pkg/utils"
+)
+
+func TestSupportLevel(t *testing.T) {
+	support := SupportLevel(utils.DocumentPacs00800109NameSpace)
+	assert.Equal(t, SupportCurrent, support.Status)
+	assert.Empty(t, support.Successor)
+	assert.Empty(t, support.Warning())
+
+	support = SupportLevel("pacs.008.001.08")
+	assert.Equal(t, SupportDeprecated, support.Status)
+	assert.Equal(t, "pacs.008.001.09", support.Successor)
+	assert.Equal(t, "pacs.008.001.08 is deprecated, migrate to pacs.008.001.09", support.Warning())
+
+	support = SupportLevel("pacs.008.001.99")
+	assert.Equal(t, SupportUnsupported, support.Status)
+	assert.Equal(t, "pacs.008.001.99 isn't supported", support.Warning())
+}
+
+func TestScheduleRemoval(t *testing.T) {
+	date := time.Date(2027, 1, 31, 0, 0, 0, 0, time.UTC)
+	ScheduleRemoval("pacs.008.001.06", date)
+	t.Cleanup(func() { ScheduleRemoval("pacs.008.001.06", time.Time{}) })
+
+	support := SupportLevel(utils.DocumentPacs00800106NameSpace)
+	assert.Equal(t, SupportRemovalScheduled, support.Status)
+	assert.Equal(t, date, *support.Removal)
+	assert.Equal(t, "pacs.008.001.06 is scheduled for removal on 2027-01-31, migrate to pacs.008.001.09", support.Warning())
+
+	ScheduleRemoval("pacs.008.001.06", time.Time{})
+	assert.Equal(t, SupportDeprecated, SupportLevel("pacs.008.001.06").Status)
+}
+
+func TestMarkExperimental(t *testing.T) {
+	MarkExperimental("pacs.008.001.09", true)
+	t.Cleanup(func() { MarkExperimental("pacs.008.001.09", false) })
+
+	support := SupportLevel(utils.DocumentPacs00800109NameSpace)
+	assert.Equal(t, SupportExperimental, support.Status)
+	assert.Equal(t, "pacs.008.001.09 is experimental", support.Warning())
+
+	// experimental versions don't deprecate the versions before them
+	support = SupportLevel("pacs.008.001.08")
+	assert.Equal(t, SupportCurrent, support.Status)
+	assert.Empty(t, support.Successor)
+	assert.Equal(t, "pacs.008.001.08", SupportLevel("pacs.008.001.06").Successor)
+
+	MarkExperimental("pacs.008.001.09", false)
+	assert.Equal(t, SupportCurrent, SupportLevel("pacs.008.001.09").Status)
+}
+
+func TestSupportPolicy(t *testing.T) {
+	policy := SupportPolicy()
+	assert.Len(t, policy, len(MessageTypes()))
+
+	current := make(map[string]string)
+	for _, support := range policy {
+		assert.NotEqual(t, SupportUnsupported, support.Status)
+		if support.Status == SupportCurrent {
+			current[messageName(support.MessageType)] = support.MessageType
+		}
+	}
+	for _, support := range policy {
+		if support.Status == SupportDeprecated {
+			assert.Equal(t, current[messageName(support.MessageType)], support.Successor)
+		}
+	}
+}
@@ -0,0 +1,107 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package document
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/moov-io/iso20022/pkg/utils"
+)
+
+// Document is a document whose message type is known at compile time, e.g.
+//
+//	doc, err := document.Parse[pacs_v08.FIToFICustomerCreditTransferV08](r)
+//	fmt.Println(doc.Message.GrpHdr.MsgId)
+type Document[M Iso20022Message] struct {
+	// Message is the message of the document, modifications are reflected by every method
+	Message *M
+
+	doc *Iso20022DocumentObject
+}
+
+// Parse reads a document holding a message of type M from r
+func Parse[M Iso20022Message](r io.Reader) (*Document[M], error) {
+	buf, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	doc, err := ParseIso20022Document(buf)
+	if err != nil {
+		return nil, err
+	}
+	return As[M](doc)
+}
+
+// As returns the typed document of doc, failing when doc doesn't hold a message of type M
+func As[M Iso20022Message](doc Iso20022Document) (*Document[M], error) {
+	obj, ok := doc.(*Iso20022DocumentObject)
+	if !ok {
+		return nil, fmt.Errorf("unsupported document %T", doc)
+	}
+	message, ok := any(obj.Message).(*M)
+	if !ok {
+		var m M
+		return nil, fmt.Errorf("the %s document doesn't hold a %T message", utils.GetMessageType(doc.NameSpace()), m)
+	}
+	return &Document[M]{Message: message, doc: obj}, nil
+}
+
+// New returns an empty document holding a message of type M with its namespace
+func New[M Iso20022Message]() (*Document[M], error) {
+	namespaces := make([]string, 0, len(messageConstructor))
+	for namespace := range messageConstructor {
+		namespaces = append(namespaces, namespace)
+	}
+	sort.Strings(namespaces)
+
+	for _, namespace := range namespaces {
+		constructed := messageConstructor[namespace]()
+		message, ok := any(constructed).(*M)
+		if !ok {
+			continue
+		}
+		obj := &Iso20022DocumentObject{
+			XMLName: xml.Name{Local: "Document"},
+			Attrs:   []xml.Attr{{Name: xml.Name{Local: utils.XmlDefaultNamespace}, Value: namespace}},
+			Message: constructed,
+		}
+		return &Document[M]{Message: message, doc: obj}, nil
+	}
+
+	var m M
+	return nil, fmt.Errorf("%T isn't a supported message", m)
+}
+
+func (d *Document[M]) Validate() error {
+	return d.doc.Validate()
+}
+
+func (d *Document[M]) NameSpace() string {
+	return d.doc.NameSpace()
+}
+
+func (d *Document[M]) GetXmlName() *xml.Name {
+	return d.doc.GetXmlName()
+}
+
+func (d *Document[M]) GetAttrs() []xml.Attr {
+	return d.doc.GetAttrs()
+}
+
+func (d *Document[M]) InspectMessage() Iso20022Message {
+	return d.doc.InspectMessage()
+}
+
+func (d *Document[M]) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return d.doc.MarshalXML(e, start)
+}
+
+func (d *Document[M]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.doc)
+}
@@ -0,0 +1,68 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package document
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/moov-io/iso20022/pkg/common"
+	"github.com/moov-io/iso20022/pkg/pacs_v08"
+	"github.com/moov-io/iso20022/pkg/pain_v11"
+	"github.com/moov-io/iso20022/pkg/utils"
+)
+
+func TestParseTyped(t *testing.T) {
+	input := mustReadTestFile(t, "valid_pacs_v08.xml")
+
+	doc, err := Parse[pacs_v08.FIToFICustomerCreditTransferV08](bytes.NewReader(input))
+	assert.Nil(t, err)
+	assert.Equal(t, common.Max35Text("MSG-20210415-0001"), doc.Message.GrpHdr.MsgId)
+	assert.Equal(t, utils.DocumentPacs00800108NameSpace, doc.NameSpace())
+	assert.Nil(t, doc.Validate())
+
+	// the typed message is the message of the document
+	doc.Message.GrpHdr.MsgId = "MSG-CHANGED"
+	output, err := xml.Marshal(doc)
+	assert.Nil(t, err)
+	assert.Contains(t, string(output), "<MsgId>MSG-CHANGED</MsgId>")
+
+	output, err = json.Marshal(doc)
+	assert.Nil(t, err)
+	parsed, err := ParseIso20022Document(output)
+	assert.Nil(t, err)
+	typed, err := As[pacs_v08.FIToFICustomerCreditTransferV08](parsed)
+	assert.Nil(t, err)
+	assert.Equal(t, common.Max35Text("MSG-CHANGED"), typed.Message.GrpHdr.MsgId)
+
+	_, err = Parse[pain_v11.CustomerPaymentStatusReportV11](bytes.NewReader(input))
+	assert.NotNil(t, err)
+	assert.Equal(t, "the pacs.008.001.08 document doesn't hold a pain_v11.CustomerPaymentStatusReportV11 message", err.Error())
+}
+
+func TestNewTyped(t *testing.T) {
+	doc, err := New[pacs_v08.FIToFICustomerCreditTransferV08]()
+	assert.Nil(t, err)
+	assert.Equal(t, utils.DocumentPacs00800108NameSpace, doc.NameSpace())
+
+	doc.Message.GrpHdr.MsgId = "MSG-NEW"
+	output, err := xml.Marshal(doc)
+	assert.Nil(t, err)
+
+	parsed, err := Parse[pacs_v08.FIToFICustomerCreditTransferV08](bytes.NewReader(output))
+	assert.Nil(t, err)
+	assert.Equal(t, common.Max35Text("MSG-NEW"), parsed.Message.GrpHdr.MsgId)
+
+	_, err = New[unsupportedMessage]()
+	assert.NotNil(t, err)
+}
+
+type unsupportedMessage struct{}
+
+func (unsupportedMessage) Validate() error { return nil }
@@ -0,0 +1,177 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package dryrun
+
+/*
+	Dryrun rehearses deliveries: the outbound channels of a dry run do everything but the
+	final send, which is recorded instead, so a cutover can be rehearsed against production
+	configuration without a message leaving the service.
+
+		client := &http.Client{Transport: dryrun.Transport("events", global, nil)}
+		sink := dryrun.Sink("schedules", "outbox", global, pipeline.Output(layout))
+
+		err := publish(dryrun.With(ctx), event)
+		for _, delivery := range dryrun.Deliveries() {
+			...
+		}
+
+	Channels are dry when global is set or when the context of the delivery is from With,
+	e.g. the context of a request with a dry-run flag. Detach keeps the flag for deliveries
+	outliving their request.
+*/
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/moov-io/iso20022/pkg/pipeline"
+)
+
+const (
+	// maxDeliveries is the number of recorded deliveries, older ones are dropped
+	maxDeliveries = 1000
+
+	// maxContent is the size of the recorded content of a delivery
+	maxContent = 64 << 10
+)
+
+// Delivery is a message a dry run didn't send
+type Delivery struct {
+	Time time.Time `json:"time"`
+
+	// Integration is the outbound channel, e.g. events, and Destination where it would have
+	// sent the message, e.g. the url of a webhook
+	Integration string `json:"integration"`
+	Destination string `json:"destination"`
+
+	// Name of the message, e.g. the file name of a scheduled file
+	Name string `json:"name,omitempty"`
+
+	// Size and Digest (sha-256) are of the whole content, Content is truncated to 64KiB
+	Size    int    `json:"size"`
+	Digest  string `json:"digest"`
+	Content string `json:"content"`
+}
+
+var (
+	deliveriesMu sync.Mutex
+	deliveries   []Delivery
+)
+
+type dryRunKey struct{}
+
+// With returns a context whose deliveries are dry
+func With(ctx context.Context) context.Context {
+	return context.WithValue(ctx, dryRunKey{}, true)
+}
+
+// Enabled reports whether the deliveries of ctx are dry
+func Enabled(ctx context.Context) bool {
+	enabled, _ := ctx.Value(dryRunKey{}).(bool)
+	return enabled
+}
+
+// Detach returns a background context with the dry-run flag of ctx, for the deliveries
+// outliving the request of ctx
+func Detach(ctx context.Context) context.Context {
+	if Enabled(ctx) {
+		return With(context.Background())
+	}
+	return context.Background()
+}
+
+// Record adds d to the recorded deliveries, setting its digest and time
+func Record(d Delivery, content []byte) {
+	sum := sha256.Sum256(content)
+	d.Size, d.Digest = len(content), hex.EncodeToString(sum[:])
+	if len(content) > maxContent {
+		content = content[:maxContent]
+	}
+	d.Content = string(content)
+	if d.Time.IsZero() {
+		d.Time = time.Now().UTC()
+	}
+
+	deliveriesMu.Lock()
+	defer deliveriesMu.Unlock()
+	deliveries = append(deliveries, d)
+	if len(deliveries) > maxDeliveries {
+		deliveries = append([]Delivery(nil), deliveries[len(deliveries)-maxDeliveries:]...)
+	}
+}
+
+// Deliveries returns the recorded deliveries, oldest first
+func Deliveries() []Delivery {
+	deliveriesMu.Lock()
+	defer deliveriesMu.Unlock()
+	return append([]Delivery{}, deliveries...)
+}
+
+// Reset discards the recorded deliveries
+func Reset() {
+	deliveriesMu.Lock()
+	defer deliveriesMu.Unlock()
+	deliveries = nil
+}
+
+type transport struct {
+	integration string
+	global      bool
+	next        http.RoundTripper
+}
+
+// Transport returns a round tripper sending the requests of integration with next, the
+// default transport when nil. Requests of dry runs are recorded and answered with 202 Accepted.
+func Transport(integration string, global bool, next http.RoundTripper) http.RoundTripper {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	return &transport{integration: integration, global: global, next: next}
+}
+
+func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if !t.global && !Enabled(req.Context()) {
+		return t.next.RoundTrip(req)
+	}
+	var body []byte
+	if req.Body != nil {
+		var err error
+		body, err = io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+	Record(Delivery{Integration: t.integration, Destination: req.URL.Redacted()}, body)
+	return &http.Response{
+		Status:        "202 Accepted",
+		StatusCode:    http.StatusAccepted,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        http.Header{"X-Dry-Run": []string{"true"}},
+		Body:          io.NopCloser(bytes.NewReader(nil)),
+		ContentLength: 0,
+		Request:       req,
+	}, nil
+}
+
+// Sink returns a sink delivering the items of integration to sink, the items of dry runs
+// are recorded with destination instead
+func Sink(integration, destination string, global bool, sink pipeline.Sink) pipeline.Sink {
+	return func(ctx context.Context, item pipeline.Item) error {
+		if !global && !Enabled(ctx) {
+			return sink(ctx, item)
+		}
+		Record(Delivery{Integration: integration, Destination: destination, Name: item.Name}, item.Input)
+		return nil
+	}
+}
@@ -0,0 +1,113 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package dryrun
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/moov-io/iso20022/pkg/pipeline"
+)
+
+func TestContext(t *testing.T) {
+	ctx := context.Background()
+	require.False(t, Enabled(ctx))
+	require.True(t, Enabled(With(ctx)))
+
+	request, cancel := context.WithCancel(With(ctx))
+	cancel()
+	detached := Detach(request)
+	require.True(t, Enabled(detached))
+	require.Nil(t, detached.Err())
+	require.False(t, Enabled(Detach(ctx)))
+}
+
+func TestTransport(t *testing.T) {
+	Reset()
+	var received int
+	webhook := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received++
+	}))
+	defer webhook.Close()
+
+	post := func(ctx context.Context, client *http.Client) *http.Response {
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.URL+"/hook", strings.NewReader(`{"id": 1}`))
+		require.Nil(t, err)
+		resp, err := client.Do(req)
+		require.Nil(t, err)
+		resp.Body.Close()
+		return resp
+	}
+
+	client := &http.Client{Transport: Transport("events", false, nil)}
+	require.Equal(t, http.StatusOK, post(context.Background(), client).StatusCode)
+	require.Equal(t, 1, received)
+	require.Empty(t, Deliveries())
+
+	resp := post(With(context.Background()), client)
+	require.Equal(t, http.StatusAccepted, resp.StatusCode)
+	require.Equal(t, "true", resp.Header.Get("X-Dry-Run"))
+
+	global := &http.Client{Transport: Transport("slo-alerts", true, nil)}
+	post(context.Background(), global)
+	require.Equal(t, 1, received)
+
+	deliveries := Deliveries()
+	require.Len(t, deliveries, 2)
+	require.Equal(t, "events", deliveries[0].Integration)
+	require.Equal(t, webhook.URL+"/hook", deliveries[0].Destination)
+	require.Equal(t, `{"id": 1}`, deliveries[0].Content)
+	require.Equal(t, 9, deliveries[0].Size)
+	require.Len(t, deliveries[0].Digest, 64)
+	require.Equal(t, "slo-alerts", deliveries[1].Integration)
+}
+
+func TestSink(t *testing.T) {
+	Reset()
+	var delivered []pipeline.Item
+	sink := Sink("schedules", "outbox", false, func(_ context.Context, item pipeline.Item) error {
+		delivered = append(delivered, item)
+		return nil
+	})
+	item := pipeline.Item{Name: "payroll.xml", Input: []byte("<Document/>")}
+
+	require.Nil(t, sink(context.Background(), item))
+	require.Len(t, delivered, 1)
+	require.Nil(t, sink(With(context.Background()), item))
+	require.Len(t, delivered, 1)
+
+	deliveries := Deliveries()
+	require.Len(t, deliveries, 1)
+	require.Equal(t, Delivery{
+		Time:        deliveries[0].Time,
+		Integration: "schedules",
+		Destination: "outbox",
+		Name:        "payroll.xml",
+		Size:        11,
+		Digest:      deliveries[0].Digest,
+		Content:     "<Document/>",
+	}, deliveries[0])
+}
+
+func TestRecordLimits(t *testing.T) {
+	Reset()
+	defer Reset()
+	Record(Delivery{Integration: "events"}, bytes.Repeat([]byte("a"), maxContent+1))
+	require.Len(t, Deliveries()[0].Content, maxContent)
+	require.Equal(t, maxContent+1, Deliveries()[0].Size)
+
+	for i := 0; i < maxDeliveries; i++ {
+		Record(Delivery{Integration: "queue"}, nil)
+	}
+	deliveries := Deliveries()
+	require.Len(t, deliveries, maxDeliveries)
+	require.Equal(t, "queue", deliveries[0].Integration)
+}
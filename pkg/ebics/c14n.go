@@ -0,0 +1,179 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package ebics
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+)
+
+const nsXML = "http://www.w3.org/XML/1998/namespace"
+
+// matcher selects the elements of a document subset by namespace, local name and attributes
+type matcher func(space, local string, attrs []xml.Attr) bool
+
+// authenticated matches the elements of the EBICS reference //*[@authenticate='true']
+func authenticated(_, _ string, attrs []xml.Attr) bool {
+	for _, attr := range attrs {
+		if attr.Name.Space == "" && attr.Name.Local == "authenticate" && attr.Value == "true" {
+			return true
+		}
+	}
+	return false
+}
+
+// element matches the elements space:local
+func element(space, local string) matcher {
+	return func(s, l string, _ []xml.Attr) bool {
+		return s == space && l == local
+	}
+}
+
+// canonicalize returns the canonical XML (C14N 1.0 without comments) of the elements of doc
+// selected by match and their descendants, in document order. Selected elements render every
+// namespace in scope, as inclusive canonicalization of a document subset does.
+func canonicalize(doc []byte, match matcher) ([]byte, error) {
+	d := xml.NewDecoder(bytes.NewReader(doc))
+	var (
+		out      bytes.Buffer
+		scopes   = []map[string]string{{"xml": nsXML}}
+		rendered []map[string]string
+		names    []xml.Name
+	)
+	for {
+		tok, err := d.RawToken()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		switch t := tok.(type) {
+		case xml.StartElement:
+			parent := scopes[len(scopes)-1]
+			ns := make(map[string]string, len(parent)+1)
+			for prefix, uri := range parent {
+				ns[prefix] = uri
+			}
+			var attrs []xml.Attr
+			for _, attr := range t.Attr {
+				switch {
+				case attr.Name.Space == "" && attr.Name.Local == "xmlns":
+					ns[""] = attr.Value
+				case attr.Name.Space == "xmlns":
+					ns[attr.Name.Local] = attr.Value
+				default:
+					attrs = append(attrs, attr)
+				}
+			}
+			scopes, names = append(scopes, ns), append(names, t.Name)
+			if len(rendered) == 0 && !match(ns[t.Name.Space], t.Name.Local, attrs) {
+				continue
+			}
+			previous := map[string]string{}
+			if len(rendered) > 0 {
+				previous = rendered[len(rendered)-1]
+			}
+			writeStart(&out, t.Name, ns, previous, attrs)
+			rendered = append(rendered, ns)
+
+		case xml.EndElement:
+			// raw tokens aren't checked by the decoder
+			if len(names) == 0 || names[len(names)-1] != t.Name {
+				return nil, fmt.Errorf("unexpected end element </%s>", qualified(t.Name))
+			}
+			scopes, names = scopes[:len(scopes)-1], names[:len(names)-1]
+			if len(rendered) > 0 {
+				out.WriteString("</" + qualified(t.Name) + ">")
+				rendered = rendered[:len(rendered)-1]
+			}
+
+		case xml.CharData:
+			if len(rendered) > 0 {
+				escape(&out, string(t), false)
+			}
+
+		case xml.ProcInst:
+			if len(rendered) > 0 {
+				out.WriteString("<?" + t.Target)
+				if len(t.Inst) > 0 {
+					out.WriteString(" " + string(t.Inst))
+				}
+				out.WriteString("?>")
+			}
+		}
+	}
+	return out.Bytes(), nil
+}
+
+// writeStart writes a start tag with the namespaces differing from the ones of its output
+// parent and the attributes in the order of their namespace and local name
+func writeStart(out *bytes.Buffer, name xml.Name, ns, previous map[string]string, attrs []xml.Attr) {
+	out.WriteString("<" + qualified(name))
+
+	prefixes := make([]string, 0, len(ns))
+	for prefix := range ns {
+		if prefix != "xml" && ns[prefix] != previous[prefix] {
+			prefixes = append(prefixes, prefix)
+		}
+	}
+	sort.Strings(prefixes)
+	for _, prefix := range prefixes {
+		if prefix == "" {
+			out.WriteString(` xmlns="`)
+		} else {
+			out.WriteString(" xmlns:" + prefix + `="`)
+		}
+		escape(out, ns[prefix], true)
+		out.WriteString(`"`)
+	}
+
+	sort.SliceStable(attrs, func(i, j int) bool {
+		si, sj := attributeSpace(attrs[i], ns), attributeSpace(attrs[j], ns)
+		if si != sj {
+			return si < sj
+		}
+		return attrs[i].Name.Local < attrs[j].Name.Local
+	})
+	for _, attr := range attrs {
+		out.WriteString(" " + qualified(attr.Name) + `="`)
+		escape(out, attr.Value, true)
+		out.WriteString(`"`)
+	}
+	out.WriteString(">")
+}
+
+// attributeSpace is the namespace of an attribute, unprefixed attributes have none
+func attributeSpace(attr xml.Attr, ns map[string]string) string {
+	if attr.Name.Space == "" {
+		return ""
+	}
+	return ns[attr.Name.Space]
+}
+
+func qualified(name xml.Name) string {
+	if name.Space == "" {
+		return name.Local
+	}
+	return name.Space + ":" + name.Local
+}
+
+var (
+	textEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;", "\r", "&#xD;")
+	attrEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", `"`, "&quot;", "\t", "&#x9;", "\n", "&#xA;", "\r", "&#xD;")
+)
+
+func escape(out *bytes.Buffer, s string, attr bool) {
+	if attr {
+		attrEscaper.WriteString(out, s)
+	} else {
+		textEscaper.WriteString(out, s)
+	}
+}
@@ -0,0 +1,30 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package ebics
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCanonicalize(t *testing.T) {
+	doc := `<?xml version="1.0" encoding="UTF-8"?>
+<r xmlns="urn:a" xmlns:ds="urn:ds"><!-- comment -->
+<h authenticate="true" b="2" ds:z="3" a="1&lt;&#34;"><x>t&amp;&gt;&#13;</x><ds:y/><?pi data?></h>
+<b><e authenticate="true" xmlns:ds="urn:ds"><f xmlns="urn:b" xmlns:ds="urn:ds"><ds:s/></f></e><g authenticate="false"/></b></r>`
+
+	out, err := canonicalize([]byte(doc), authenticated)
+	require.Nil(t, err)
+	require.Equal(t, `<h xmlns="urn:a" xmlns:ds="urn:ds" a="1&lt;&quot;" authenticate="true" b="2" ds:z="3"><x>t&amp;&gt;&#xD;</x><ds:y></ds:y><?pi data?></h>`+
+		`<e xmlns="urn:a" xmlns:ds="urn:ds" authenticate="true"><f xmlns="urn:b"><ds:s></ds:s></f></e>`, string(out))
+
+	out, err = canonicalize([]byte(doc), element("urn:ds", "s"))
+	require.Nil(t, err)
+	require.Equal(t, `<ds:s xmlns="urn:b" xmlns:ds="urn:ds"></ds:s>`, string(out))
+
+	_, err = canonicalize([]byte("<r><h></r>"), authenticated)
+	require.Error(t, err)
+}
@@ -0,0 +1,194 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package ebics
+
+import (
+	"bytes"
+	"compress/zlib"
+	"crypto"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+)
+
+const (
+	// SignatureA005 and SignatureA006 are the versions of order signatures, RSA signatures of
+	// the SHA-256 of order data with PKCS #1 v1.5 and PSS paddings
+	SignatureA005 = "A005"
+	SignatureA006 = "A006"
+
+	algC14N      = "http://www.w3.org/TR/2001/REC-xml-c14n-20010315"
+	algRSASHA256 = "http://www.w3.org/2001/04/xmldsig-more#rsa-sha256"
+	algSHA256    = "http://www.w3.org/2001/04/xmlenc#sha256"
+)
+
+// KeyHash returns the hash of a public key printed on initialisation letters, the SHA-256 of
+// its exponent and modulus in lower case hex separated by a space
+func KeyHash(pub *rsa.PublicKey) []byte {
+	sum := sha256.Sum256([]byte(fmt.Sprintf("%x %x", pub.E, pub.N)))
+	return sum[:]
+}
+
+// deflate and inflate compress order data with zlib
+func deflate(data []byte) []byte {
+	var buf bytes.Buffer
+	w := zlib.NewWriter(&buf)
+	w.Write(data)
+	w.Close()
+	return buf.Bytes()
+}
+
+func inflate(data []byte) ([]byte, error) {
+	r, err := zlib.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("decompressing order data: %w", err)
+	}
+	out, err := io.ReadAll(io.LimitReader(r, maxOrderData+1))
+	if err != nil {
+		return nil, fmt.Errorf("decompressing order data: %w", err)
+	}
+	if len(out) > maxOrderData {
+		return nil, fmt.Errorf("order data is larger than %d bytes", maxOrderData)
+	}
+	return out, nil
+}
+
+// encryptOrderData encrypts data with a transaction key (E002), AES-128-CBC of a zero iv and
+// the padding of ANSI X9.23
+func encryptOrderData(key, data []byte) ([]byte, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	pad := aes.BlockSize - len(data)%aes.BlockSize
+	padded := make([]byte, len(data)+pad)
+	copy(padded, data)
+	padded[len(padded)-1] = byte(pad)
+	cipher.NewCBCEncrypter(block, make([]byte, aes.BlockSize)).CryptBlocks(padded, padded)
+	return padded, nil
+}
+
+func decryptOrderData(key, data []byte) ([]byte, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) == 0 || len(data)%aes.BlockSize != 0 {
+		return nil, errors.New("encrypted order data isn't a sequence of blocks")
+	}
+	plain := make([]byte, len(data))
+	cipher.NewCBCDecrypter(block, make([]byte, aes.BlockSize)).CryptBlocks(plain, data)
+	pad := int(plain[len(plain)-1])
+	if pad == 0 || pad > aes.BlockSize {
+		return nil, errors.New("invalid padding of order data")
+	}
+	return plain[:len(plain)-pad], nil
+}
+
+// signOrder returns the order signature (ES) of data
+func signOrder(version string, key *rsa.PrivateKey, data []byte) ([]byte, error) {
+	digest := sha256.Sum256(data)
+	switch version {
+	case SignatureA005:
+		return rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+	case SignatureA006, "":
+		return rsa.SignPSS(rand.Reader, key, crypto.SHA256, digest[:], &rsa.PSSOptions{SaltLength: sha256.Size})
+	}
+	return nil, fmt.Errorf("unsupported signature version %s", version)
+}
+
+// verifyOrder checks the order signature of data by pub
+func verifyOrder(version string, pub *rsa.PublicKey, data, signature []byte) error {
+	digest := sha256.Sum256(data)
+	switch version {
+	case SignatureA005:
+		return rsa.VerifyPKCS1v15(pub, crypto.SHA256, digest[:], signature)
+	case SignatureA006:
+		return rsa.VerifyPSS(pub, crypto.SHA256, digest[:], signature, &rsa.PSSOptions{SaltLength: sha256.Size})
+	}
+	return fmt.Errorf("unsupported signature version %s", version)
+}
+
+// authSignaturePlaceholder marks the place of the AuthSignature of a request until it's signed
+const authSignaturePlaceholder = "<AuthSignature></AuthSignature>"
+
+// authenticate replaces the placeholder of doc with the authentication signature (X002) of
+// its authenticated elements
+func authenticate(doc []byte, key *rsa.PrivateKey) ([]byte, error) {
+	canonical, err := canonicalize(doc, authenticated)
+	if err != nil {
+		return nil, err
+	}
+	digest := sha256.Sum256(canonical)
+	signedInfo := fmt.Sprintf(`<ds:SignedInfo>`+
+		`<ds:CanonicalizationMethod Algorithm="%s"></ds:CanonicalizationMethod>`+
+		`<ds:SignatureMethod Algorithm="%s"></ds:SignatureMethod>`+
+		`<ds:Reference URI="#xpointer(//*[@authenticate='true'])">`+
+		`<ds:Transforms><ds:Transform Algorithm="%s"></ds:Transform></ds:Transforms>`+
+		`<ds:DigestMethod Algorithm="%s"></ds:DigestMethod>`+
+		`<ds:DigestValue>%s</ds:DigestValue>`+
+		`</ds:Reference></ds:SignedInfo>`,
+		algC14N, algRSASHA256, algC14N, algSHA256, base64.StdEncoding.EncodeToString(digest[:]))
+
+	// the signed info is canonicalized in the document, with the namespaces of its root
+	signed := bytes.Replace(doc, []byte(authSignaturePlaceholder), []byte("<AuthSignature>"+signedInfo+"</AuthSignature>"), 1)
+	canonical, err = canonicalize(signed, element(nsDS, "SignedInfo"))
+	if err != nil {
+		return nil, err
+	}
+	digest = sha256.Sum256(canonical)
+	signature, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+	if err != nil {
+		return nil, fmt.Errorf("signing request: %w", err)
+	}
+	value := "<ds:SignatureValue>" + base64.StdEncoding.EncodeToString(signature) + "</ds:SignatureValue>"
+	return bytes.Replace(doc, []byte(authSignaturePlaceholder), []byte("<AuthSignature>"+signedInfo+value+"</AuthSignature>"), 1), nil
+}
+
+type authSignature struct {
+	AuthSignature struct {
+		DigestValue    string `xml:"SignedInfo>Reference>DigestValue"`
+		SignatureValue string
+	}
+}
+
+// verifyAuthentication checks the authentication signature of doc by pub
+func verifyAuthentication(doc []byte, pub *rsa.PublicKey) error {
+	var sig authSignature
+	if err := xml.Unmarshal(doc, &sig); err != nil {
+		return err
+	}
+	digestValue, err := base64.StdEncoding.DecodeString(sig.AuthSignature.DigestValue)
+	if err != nil {
+		return fmt.Errorf("reading digest: %w", err)
+	}
+	signature, err := base64.StdEncoding.DecodeString(sig.AuthSignature.SignatureValue)
+	if err != nil {
+		return fmt.Errorf("reading signature: %w", err)
+	}
+
+	canonical, err := canonicalize(doc, authenticated)
+	if err != nil {
+		return err
+	}
+	if digest := sha256.Sum256(canonical); !bytes.Equal(digest[:], digestValue) {
+		return ErrAuthentication
+	}
+	if canonical, err = canonicalize(doc, element(nsDS, "SignedInfo")); err != nil {
+		return err
+	}
+	digest := sha256.Sum256(canonical)
+	if err := rsa.VerifyPKCS1v15(pub, crypto.SHA256, digest[:], signature); err != nil {
+		return ErrAuthentication
+	}
+	return nil
+}
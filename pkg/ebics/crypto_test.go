@@ -0,0 +1,75 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package ebics
+
+import (
+	"bytes"
+	"crypto/rsa"
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/moov-io/iso20022/pkg/iso20022test/fixtures"
+)
+
+func TestKeyHash(t *testing.T) {
+	pub := &rsa.PublicKey{N: big.NewInt(0xc0ffee), E: 65537}
+	require.Equal(t, "0c66319b7052705096f8619fccae5db036297964b31a967e27a0f82409423c07", hex.EncodeToString(KeyHash(pub)))
+}
+
+func TestOrderData(t *testing.T) {
+	key := bytes.Repeat([]byte{7}, 16)
+	for _, size := range []int{0, 1, 15, 16, 17} {
+		data := bytes.Repeat([]byte{'a'}, size)
+		encrypted, err := encryptOrderData(key, data)
+		require.Nil(t, err)
+		require.Equal(t, (size/16+1)*16, len(encrypted))
+		plain, err := decryptOrderData(key, encrypted)
+		require.Nil(t, err)
+		require.Equal(t, data, plain)
+	}
+	_, err := decryptOrderData(key, []byte("short"))
+	require.ErrorContains(t, err, "isn't a sequence of blocks")
+
+	data := fixtures.ReadFile(t, "valid_camt053_v08.xml")
+	out, err := inflate(deflate(data))
+	require.Nil(t, err)
+	require.Equal(t, data, out)
+	_, err = inflate(data)
+	require.ErrorContains(t, err, "decompressing order data")
+}
+
+func TestOrderSignature(t *testing.T) {
+	key := newKey(t)
+	data := fixtures.ReadFile(t, "valid_pain_v11.xml")
+	for _, version := range []string{SignatureA005, SignatureA006} {
+		signature, err := signOrder(version, key, data)
+		require.Nil(t, err)
+		require.Nil(t, verifyOrder(version, &key.PublicKey, data, signature))
+		require.Error(t, verifyOrder(version, &key.PublicKey, append(data, ' '), signature))
+	}
+	_, err := signOrder("A004", key, data)
+	require.ErrorContains(t, err, "unsupported signature version A004")
+}
+
+func TestAuthenticate(t *testing.T) {
+	key := newKey(t)
+	doc := request("ebicsRequest", "<HostID>MOOVHOST</HostID>", "<TransactionPhase>Initialisation</TransactionPhase>",
+		`<TransferReceipt authenticate="true"><ReceiptCode>0</ReceiptCode></TransferReceipt>`)
+	signed, err := authenticate(doc, key)
+	require.Nil(t, err)
+	require.Nil(t, verifyAuthentication(signed, &key.PublicKey))
+
+	// changes of authenticated elements
+	tampered := bytes.Replace(signed, []byte("<ReceiptCode>0"), []byte("<ReceiptCode>1"), 1)
+	require.ErrorIs(t, verifyAuthentication(tampered, &key.PublicKey), ErrAuthentication)
+	require.ErrorIs(t, verifyAuthentication(signed, &newKey(t).PublicKey), ErrAuthentication)
+
+	// elements which aren't authenticated may change
+	changed := bytes.Replace(signed, []byte("<body>"), []byte("<body><ReturnCode>000000</ReturnCode>"), 1)
+	require.Nil(t, verifyAuthentication(changed, &key.PublicKey))
+}
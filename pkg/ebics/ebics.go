@@ -0,0 +1,592 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package ebics
+
+/*
+	Ebics downloads the statements and uploads the payments of a subscriber of a bank with the
+	EBICS protocol (version 3.0, H004):
+
+		keys := ebics.Keys{Signature: es, Authentication: x, Encryption: e}
+		client := ebics.New("https://ebics.bank.example/ebics", "BANKHOST", "PARTNER", "USER", keys)
+		client.Bank, err = client.HPB(ctx)
+
+		files, err := client.C53(ctx, &ebics.DateRange{Start: start, End: end})
+		orderID, err := client.CCT(ctx, pain001)
+
+	The subscriber must be initialised with the bank (INI and HIA orders and their letters)
+	before using a client. The keys HPB returns must be checked against the hashes of the
+	initialisation letter of the bank, see KeyHash.
+
+	Clients are sources and sinks of pipelines, Source emits the camt documents of download
+	orders and UploadSink uploads the pain.001 documents of a pipeline:
+
+		p := pipeline.New(client.Source("C53", nil), client.Sink(pipeline.Output(layout)),
+			pipeline.WithErrorSink(client.ErrorSink(deadletter)))
+*/
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/moov-io/iso20022/pkg/resilience"
+)
+
+const (
+	nsH004 = "urn:org:ebics:H004"
+	nsDS   = "http://www.w3.org/2000/09/xmldsig#"
+	nsS001 = "http://www.ebics.org/S001"
+
+	codeOK     = "000000"
+	codeNoData = "090005"
+
+	httpTimeout    = 60 * time.Second
+	maxResponse    = 16 << 20
+	maxOrderData   = 64 << 20
+	maxSegmentData = 786432 // 1 MiB of base64
+	timestamp      = "2006-01-02T15:04:05.000Z"
+)
+
+var (
+	// ErrNoData is returned by downloads when the bank has no data of an order
+	ErrNoData = errors.New("ebics: no download data available")
+
+	// ErrAuthentication is returned when the authentication signature of a response doesn't
+	// verify with the authentication key of the bank
+	ErrAuthentication = errors.New("ebics: invalid authentication signature of response")
+
+	// ErrNoBankKeys is returned by the orders of clients without the keys of their bank
+	ErrNoBankKeys = errors.New("ebics: no keys of the bank")
+)
+
+// Keys are the private keys of a subscriber: its signature (ES), authentication (X002) and
+// encryption (E002) keys
+type Keys struct {
+	Signature *rsa.PrivateKey
+
+	// SignatureVersion is the version of order signatures, SignatureA006 when empty
+	SignatureVersion string
+
+	Authentication *rsa.PrivateKey
+	Encryption     *rsa.PrivateKey
+}
+
+// BankKeys are the public keys of a bank, returned by HPB
+type BankKeys struct {
+	Authentication *rsa.PublicKey
+	Encryption     *rsa.PublicKey
+}
+
+// DateRange restricts the statements of download orders to the days from Start to End
+type DateRange struct {
+	Start time.Time
+	End   time.Time
+}
+
+// Error is an error code of a bank, technical or business
+type Error struct {
+	Code string
+	Text string
+}
+
+func (e *Error) Error() string {
+	if e.Text == "" {
+		return "ebics: return code " + e.Code
+	}
+	return fmt.Sprintf("ebics: %s (return code %s)", e.Text, e.Code)
+}
+
+// Is matches ErrNoData with the return code EBICS_NO_DOWNLOAD_DATA_AVAILABLE
+func (e *Error) Is(target error) bool {
+	return target == ErrNoData && e.Code == codeNoData
+}
+
+// File is a file of the order data of a download, a zip archive of documents is a file per
+// document
+type File struct {
+	Name    string
+	Content []byte
+}
+
+// Client sends the orders of a subscriber, a user of a partner, to the host of a bank
+type Client struct {
+	URL       string
+	HostID    string
+	PartnerID string
+	UserID    string
+
+	Keys Keys
+	Bank BankKeys
+
+	HTTPClient *http.Client
+
+	mu      sync.Mutex
+	pending map[string]*download
+	now     func() time.Time
+}
+
+// New returns the client of the subscriber partnerID and userID of the host hostID at url, calls
+// are retried with resilience.DefaultPolicy as the registered ebics/<hostID> integration
+func New(url, hostID, partnerID, userID string, keys Keys) *Client {
+	guard := resilience.New("ebics/"+hostID, resilience.DefaultPolicy)
+	resilience.Register(guard)
+	return &Client{
+		URL:        url,
+		HostID:     hostID,
+		PartnerID:  partnerID,
+		UserID:     userID,
+		Keys:       keys,
+		HTTPClient: guard.Client(&http.Client{Timeout: httpTimeout}),
+		now:        time.Now,
+	}
+}
+
+// response is an ebicsResponse or ebicsKeyManagementResponse
+type response struct {
+	TransactionID string `xml:"header>static>TransactionID"`
+	NumSegments   int    `xml:"header>static>NumSegments"`
+	Segment       struct {
+		Number int  `xml:",chardata"`
+		Last   bool `xml:"lastSegment,attr"`
+	} `xml:"header>mutable>SegmentNumber"`
+	OrderID        string `xml:"header>mutable>OrderID"`
+	ReturnCode     string `xml:"header>mutable>ReturnCode"`
+	ReportText     string `xml:"header>mutable>ReportText"`
+	TransactionKey string `xml:"body>DataTransfer>DataEncryptionInfo>TransactionKey"`
+	OrderData      string `xml:"body>DataTransfer>OrderData"`
+	BodyReturnCode string `xml:"body>ReturnCode"`
+}
+
+// post signs and sends a request, returning its response when its return codes are ok
+func (c *Client) post(ctx context.Context, doc []byte, ok ...string) (*response, error) {
+	if c.Keys.Authentication == nil {
+		return nil, errors.New("ebics: no authentication key")
+	}
+	doc, err := authenticate(doc, c.Keys.Authentication)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.URL, bytes.NewReader(doc))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "text/xml; charset=UTF-8")
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxResponse))
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("ebics: unexpected http status %s", resp.Status)
+	}
+
+	var r response
+	if err := xml.Unmarshal(data, &r); err != nil {
+		return nil, fmt.Errorf("ebics: reading response: %w", err)
+	}
+	if r.ReturnCode != codeOK && !contains(ok, r.ReturnCode) {
+		return nil, &Error{Code: r.ReturnCode, Text: strings.TrimSpace(r.ReportText)}
+	}
+	if r.BodyReturnCode != "" && r.BodyReturnCode != codeOK {
+		return nil, &Error{Code: r.BodyReturnCode}
+	}
+	if bytes.Contains(data, []byte("<ebicsResponse")) || bytes.Contains(data, []byte(":ebicsResponse")) {
+		// key management responses aren't signed, the keys of the bank are checked by hash
+		if err := verifyAuthentication(data, c.Bank.Authentication); err != nil {
+			return nil, err
+		}
+	}
+	return &r, nil
+}
+
+func contains(codes []string, code string) bool {
+	for _, c := range codes {
+		if c == code {
+			return true
+		}
+	}
+	return false
+}
+
+// text escapes the character data of a request
+func text(s string) string {
+	var buf strings.Builder
+	xml.EscapeText(&buf, []byte(s))
+	return buf.String()
+}
+
+func nonce() string {
+	buf := make([]byte, 16)
+	rand.Read(buf)
+	return strings.ToUpper(hex.EncodeToString(buf))
+}
+
+func keyDigest(pub *rsa.PublicKey) string {
+	return base64.StdEncoding.EncodeToString(KeyHash(pub))
+}
+
+// request returns the document root of the header static, mutable and body with the
+// placeholder of its authentication signature
+func request(root, static, mutable, body string) []byte {
+	return []byte(`<?xml version="1.0" encoding="UTF-8"?>` +
+		`<` + root + ` xmlns="` + nsH004 + `" xmlns:ds="` + nsDS + `" Version="H004" Revision="1">` +
+		`<header authenticate="true"><static>` + static + `</static><mutable>` + mutable + `</mutable></header>` +
+		authSignaturePlaceholder +
+		`<body>` + body + `</body></` + root + `>`)
+}
+
+// initialisation returns the static header of the first request of a transaction
+func (c *Client) initialisation(orderDetails string, segments int, digests bool) string {
+	static := `<HostID>` + text(c.HostID) + `</HostID>` +
+		`<Nonce>` + nonce() + `</Nonce>` +
+		`<Timestamp>` + c.now().UTC().Format(timestamp) + `</Timestamp>` +
+		`<PartnerID>` + text(c.PartnerID) + `</PartnerID>` +
+		`<UserID>` + text(c.UserID) + `</UserID>` +
+		`<Product Language="en">moov-io/iso20022</Product>` +
+		`<OrderDetails>` + orderDetails + `</OrderDetails>`
+	if digests {
+		static += `<BankPubKeyDigests>` +
+			`<Authentication Version="X002" Algorithm="` + algSHA256 + `">` + keyDigest(c.Bank.Authentication) + `</Authentication>` +
+			`<Encryption Version="E002" Algorithm="` + algSHA256 + `">` + keyDigest(c.Bank.Encryption) + `</Encryption>` +
+			`</BankPubKeyDigests>`
+	}
+	static += `<SecurityMedium>0000</SecurityMedium>`
+	if segments > 0 {
+		static += `<NumSegments>` + strconv.Itoa(segments) + `</NumSegments>`
+	}
+	return static
+}
+
+func (c *Client) transfer(transactionID string) string {
+	return `<HostID>` + text(c.HostID) + `</HostID><TransactionID>` + text(transactionID) + `</TransactionID>`
+}
+
+func segment(number int, last bool) string {
+	return `<TransactionPhase>Transfer</TransactionPhase>` +
+		`<SegmentNumber lastSegment="` + strconv.FormatBool(last) + `">` + strconv.Itoa(number) + `</SegmentNumber>`
+}
+
+func (c *Client) checkKeys() error {
+	if c.Bank.Authentication == nil || c.Bank.Encryption == nil {
+		return ErrNoBankKeys
+	}
+	if c.Keys.Encryption == nil {
+		return errors.New("ebics: no encryption key")
+	}
+	return nil
+}
+
+// download returns the transaction and order data of a download order, which must be
+// acknowledged by a receipt
+func (c *Client) download(ctx context.Context, orderType string, dates *DateRange) (string, []byte, error) {
+	if err := c.checkKeys(); err != nil {
+		return "", nil, err
+	}
+	params := `<StandardOrderParams></StandardOrderParams>`
+	if dates != nil {
+		params = `<StandardOrderParams><DateRange>` +
+			`<Start>` + dates.Start.Format("2006-01-02") + `</Start>` +
+			`<End>` + dates.End.Format("2006-01-02") + `</End>` +
+			`</DateRange></StandardOrderParams>`
+	}
+	details := `<OrderType>` + text(orderType) + `</OrderType><OrderAttribute>DZHNN</OrderAttribute>` + params
+	doc := request("ebicsRequest", c.initialisation(details, 0, true), `<TransactionPhase>Initialisation</TransactionPhase>`, "")
+	resp, err := c.post(ctx, doc)
+	if err != nil {
+		return "", nil, fmt.Errorf("ebics %s: %w", orderType, err)
+	}
+
+	transactionID, key := resp.TransactionID, resp.TransactionKey
+	numSegments := resp.NumSegments
+	if numSegments < 1 {
+		numSegments = 1
+	}
+	var encrypted []byte
+	for number := 1; ; number++ {
+		data, err := base64.StdEncoding.DecodeString(strings.TrimSpace(resp.OrderData))
+		if err != nil {
+			return transactionID, nil, fmt.Errorf("ebics %s: reading segment %d: %w", orderType, number, err)
+		}
+		if len(encrypted)+len(data) > maxOrderData {
+			return transactionID, nil, fmt.Errorf("ebics %s: order data is larger than %d bytes", orderType, maxOrderData)
+		}
+		encrypted = append(encrypted, data...)
+		if resp.Segment.Last || number >= numSegments {
+			break
+		}
+		doc := request("ebicsRequest", c.transfer(transactionID), segment(number+1, number+1 == numSegments), "")
+		if resp, err = c.post(ctx, doc); err != nil {
+			return transactionID, nil, fmt.Errorf("ebics %s: segment %d: %w", orderType, number+1, err)
+		}
+	}
+
+	data, err := c.open(key, encrypted)
+	if err != nil {
+		return transactionID, nil, fmt.Errorf("ebics %s: %w", orderType, err)
+	}
+	return transactionID, data, nil
+}
+
+// open decrypts and decompresses the order data of a download
+func (c *Client) open(transactionKey string, encrypted []byte) ([]byte, error) {
+	key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(transactionKey))
+	if err != nil {
+		return nil, fmt.Errorf("reading transaction key: %w", err)
+	}
+	if key, err = rsa.DecryptPKCS1v15(rand.Reader, c.Keys.Encryption, key); err != nil {
+		return nil, fmt.Errorf("decrypting transaction key: %w", err)
+	}
+	plain, err := decryptOrderData(key, encrypted)
+	if err != nil {
+		return nil, err
+	}
+	return inflate(plain)
+}
+
+// receipt acknowledges the order data of a download, a negative receipt lets the bank
+// deliver it again
+func (c *Client) receipt(ctx context.Context, transactionID string, received bool) error {
+	code := "1"
+	if received {
+		code = "0"
+	}
+	doc := request("ebicsRequest", c.transfer(transactionID), `<TransactionPhase>Receipt</TransactionPhase>`,
+		`<TransferReceipt authenticate="true"><ReceiptCode>`+code+`</ReceiptCode></TransferReceipt>`)
+	// EBICS_DOWNLOAD_POSTPROCESS_DONE and EBICS_DOWNLOAD_POSTPROCESS_SKIPPED
+	if _, err := c.post(ctx, doc, "011000", "011001"); err != nil {
+		return fmt.Errorf("ebics receipt: %w", err)
+	}
+	return nil
+}
+
+// Download returns the order data of a download order of orderType, e.g. C53, restricted to
+// dates when not nil, and acknowledges its receipt
+func (c *Client) Download(ctx context.Context, orderType string, dates *DateRange) ([]byte, error) {
+	transactionID, data, err := c.download(ctx, orderType, dates)
+	if err != nil {
+		if transactionID != "" {
+			c.receipt(context.Background(), transactionID, false)
+		}
+		return nil, err
+	}
+	if err := c.receipt(ctx, transactionID, true); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// HTD returns the HTDResponseOrderData of the subscriber, its accounts and permitted orders
+func (c *Client) HTD(ctx context.Context) ([]byte, error) {
+	return c.Download(ctx, "HTD", nil)
+}
+
+// STA returns the MT940 account statements of dates, or of the bank defaults when nil
+func (c *Client) STA(ctx context.Context, dates *DateRange) ([]byte, error) {
+	return c.Download(ctx, "STA", dates)
+}
+
+// C53 returns the camt.053 account statements of dates, or of the bank defaults when nil
+func (c *Client) C53(ctx context.Context, dates *DateRange) ([]File, error) {
+	data, err := c.Download(ctx, "C53", dates)
+	if err != nil {
+		return nil, err
+	}
+	return files("C53", data)
+}
+
+// files returns the documents of the order data of a download, unzipped if it's a zip archive
+func files(orderType string, data []byte) ([]File, error) {
+	if !bytes.HasPrefix(data, []byte("PK\x03\x04")) {
+		return []File{{Name: strings.ToLower(orderType) + ".xml", Content: data}}, nil
+	}
+	archive, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, fmt.Errorf("ebics %s: reading zip archive: %w", orderType, err)
+	}
+	var out []File
+	var total int
+	for _, f := range archive.File {
+		if f.FileInfo().IsDir() {
+			continue
+		}
+		r, err := f.Open()
+		if err != nil {
+			return nil, fmt.Errorf("ebics %s: %s: %w", orderType, f.Name, err)
+		}
+		content, err := io.ReadAll(io.LimitReader(r, int64(maxOrderData-total+1)))
+		r.Close()
+		if err != nil {
+			return nil, fmt.Errorf("ebics %s: %s: %w", orderType, f.Name, err)
+		}
+		if total += len(content); total > maxOrderData {
+			return nil, fmt.Errorf("ebics %s: zip archive is larger than %d bytes", orderType, maxOrderData)
+		}
+		out = append(out, File{Name: path.Base(strings.ReplaceAll(f.Name, `\`, "/")), Content: content})
+	}
+	return out, nil
+}
+
+// Upload uploads the order data of an upload order of orderType, e.g. CCT, signed by the
+// subscriber, and returns its order id
+func (c *Client) Upload(ctx context.Context, orderType string, data []byte) (string, error) {
+	if err := c.checkKeys(); err != nil {
+		return "", err
+	}
+	if c.Keys.Signature == nil {
+		return "", errors.New("ebics: no signature key")
+	}
+	version := c.Keys.SignatureVersion
+	if version == "" {
+		version = SignatureA006
+	}
+	signature, err := signOrder(version, c.Keys.Signature, data)
+	if err != nil {
+		return "", fmt.Errorf("ebics %s: %w", orderType, err)
+	}
+	signatureData := `<?xml version="1.0" encoding="UTF-8"?>` +
+		`<UserSignatureData xmlns="` + nsS001 + `"><OrderSignatureData>` +
+		`<SignatureVersion>` + version + `</SignatureVersion>` +
+		`<SignatureValue>` + base64.StdEncoding.EncodeToString(signature) + `</SignatureValue>` +
+		`<PartnerID>` + text(c.PartnerID) + `</PartnerID>` +
+		`<UserID>` + text(c.UserID) + `</UserID>` +
+		`</OrderSignatureData></UserSignatureData>`
+
+	key := make([]byte, 16)
+	if _, err := rand.Read(key); err != nil {
+		return "", err
+	}
+	transactionKey, err := rsa.EncryptPKCS1v15(rand.Reader, c.Bank.Encryption, key)
+	if err != nil {
+		return "", fmt.Errorf("ebics %s: encrypting transaction key: %w", orderType, err)
+	}
+	encryptedSignature, err := encryptOrderData(key, deflate([]byte(signatureData)))
+	if err != nil {
+		return "", err
+	}
+	encrypted, err := encryptOrderData(key, deflate(data))
+	if err != nil {
+		return "", err
+	}
+	var segments [][]byte
+	for len(encrypted) > maxSegmentData {
+		segments, encrypted = append(segments, encrypted[:maxSegmentData]), encrypted[maxSegmentData:]
+	}
+	segments = append(segments, encrypted)
+
+	details := `<OrderType>` + text(orderType) + `</OrderType><OrderAttribute>OZHNN</OrderAttribute><StandardOrderParams></StandardOrderParams>`
+	body := `<DataTransfer><DataEncryptionInfo authenticate="true">` +
+		`<EncryptionPubKeyDigest Version="E002" Algorithm="` + algSHA256 + `">` + keyDigest(c.Bank.Encryption) + `</EncryptionPubKeyDigest>` +
+		`<TransactionKey>` + base64.StdEncoding.EncodeToString(transactionKey) + `</TransactionKey>` +
+		`</DataEncryptionInfo>` +
+		`<SignatureData authenticate="true">` + base64.StdEncoding.EncodeToString(encryptedSignature) + `</SignatureData>` +
+		`</DataTransfer>`
+	doc := request("ebicsRequest", c.initialisation(details, len(segments), true), `<TransactionPhase>Initialisation</TransactionPhase>`, body)
+	resp, err := c.post(ctx, doc)
+	if err != nil {
+		return "", fmt.Errorf("ebics %s: %w", orderType, err)
+	}
+	transactionID, orderID := resp.TransactionID, resp.OrderID
+
+	for i, data := range segments {
+		body := `<DataTransfer><OrderData>` + base64.StdEncoding.EncodeToString(data) + `</OrderData></DataTransfer>`
+		doc := request("ebicsRequest", c.transfer(transactionID), segment(i+1, i == len(segments)-1), body)
+		if resp, err = c.post(ctx, doc); err != nil {
+			return "", fmt.Errorf("ebics %s: segment %d: %w", orderType, i+1, err)
+		}
+		if orderID == "" {
+			orderID = resp.OrderID
+		}
+	}
+	return orderID, nil
+}
+
+// CCT uploads the pain.001 of SEPA credit transfers and returns its order id
+func (c *Client) CCT(ctx context.Context, pain001 []byte) (string, error) {
+	return c.Upload(ctx, "CCT", pain001)
+}
+
+// XCT uploads the pain.001 of cross-border and foreign currency credit transfers and returns
+// its order id
+func (c *Client) XCT(ctx context.Context, pain001 []byte) (string, error) {
+	return c.Upload(ctx, "XCT", pain001)
+}
+
+// HPB downloads the public keys of the bank, which must be checked against its
+// initialisation letter before they're used by the client
+func (c *Client) HPB(ctx context.Context) (BankKeys, error) {
+	if c.Keys.Encryption == nil {
+		return BankKeys{}, errors.New("ebics: no encryption key")
+	}
+	details := `<OrderType>HPB</OrderType><OrderAttribute>DZHNN</OrderAttribute>`
+	doc := request("ebicsNoPubKeyDigestsRequest", c.initialisation(details, 0, false), "", "")
+	resp, err := c.post(ctx, doc)
+	if err != nil {
+		return BankKeys{}, fmt.Errorf("ebics HPB: %w", err)
+	}
+	encrypted, err := base64.StdEncoding.DecodeString(strings.TrimSpace(resp.OrderData))
+	if err != nil {
+		return BankKeys{}, fmt.Errorf("ebics HPB: reading order data: %w", err)
+	}
+	data, err := c.open(resp.TransactionKey, encrypted)
+	if err != nil {
+		return BankKeys{}, fmt.Errorf("ebics HPB: %w", err)
+	}
+
+	var keys struct {
+		Authentication rsaKeyValue `xml:"AuthenticationPubKeyInfo>PubKeyValue>RSAKeyValue"`
+		Encryption     rsaKeyValue `xml:"EncryptionPubKeyInfo>PubKeyValue>RSAKeyValue"`
+	}
+	if err := xml.Unmarshal(data, &keys); err != nil {
+		return BankKeys{}, fmt.Errorf("ebics HPB: reading keys: %w", err)
+	}
+	var bank BankKeys
+	if bank.Authentication, err = keys.Authentication.publicKey(); err != nil {
+		return BankKeys{}, fmt.Errorf("ebics HPB: authentication key: %w", err)
+	}
+	if bank.Encryption, err = keys.Encryption.publicKey(); err != nil {
+		return BankKeys{}, fmt.Errorf("ebics HPB: encryption key: %w", err)
+	}
+	return bank, nil
+}
+
+// rsaKeyValue is the ds:RSAKeyValue of a public key
+type rsaKeyValue struct {
+	Modulus  string
+	Exponent string
+}
+
+func (v rsaKeyValue) publicKey() (*rsa.PublicKey, error) {
+	modulus, err := base64.StdEncoding.DecodeString(strings.TrimSpace(v.Modulus))
+	if err != nil || len(modulus) == 0 {
+		return nil, fmt.Errorf("invalid modulus: %v", err)
+	}
+	exponent, err := base64.StdEncoding.DecodeString(strings.TrimSpace(v.Exponent))
+	if err != nil || len(exponent) == 0 || len(exponent) > 4 {
+		return nil, fmt.Errorf("invalid exponent: %v", err)
+	}
+	return &rsa.PublicKey{
+		N: new(big.Int).SetBytes(modulus),
+		E: int(new(big.Int).SetBytes(exponent).Int64()),
+	}, nil
+}
@@ -0,0 +1,168 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package ebics
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/xml"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/moov-io/iso20022/pkg/iso20022test/fixtures"
+	"github.com/moov-io/iso20022/pkg/pipeline"
+)
+
+func TestHPB(t *testing.T) {
+	bank, client := newFakeBank(t, newKeys(t))
+	client.Bank = BankKeys{}
+
+	keys, err := client.HPB(context.Background())
+	require.Nil(t, err)
+	require.Equal(t, KeyHash(&bank.auth.PublicKey), KeyHash(keys.Authentication))
+	require.Equal(t, KeyHash(&bank.enc.PublicKey), KeyHash(keys.Encryption))
+
+	_, err = client.C53(context.Background(), nil)
+	require.ErrorIs(t, err, ErrNoBankKeys)
+}
+
+func TestDownload(t *testing.T) {
+	bank, client := newFakeBank(t, newKeys(t))
+	statement := fixtures.ReadFile(t, "valid_camt053_v08.xml")
+	bank.downloads["C53"] = zipFiles(t, File{Name: "2021/statement1.xml", Content: statement}, File{Name: "statement2.xml", Content: statement})
+	bank.downloads["HTD"] = []byte("<HTDResponseOrderData/>")
+
+	start := time.Date(2021, time.March, 1, 0, 0, 0, 0, time.UTC)
+	files, err := client.C53(context.Background(), &DateRange{Start: start, End: start.AddDate(0, 0, 7)})
+	require.Nil(t, err)
+	require.Equal(t, []File{{Name: "statement1.xml", Content: statement}, {Name: "statement2.xml", Content: statement}}, files)
+	require.Equal(t, "2021-03-01", bank.dates["C53"])
+
+	htd, err := client.HTD(context.Background())
+	require.Nil(t, err)
+	require.Equal(t, "<HTDResponseOrderData/>", string(htd))
+	require.Equal(t, []string{"0", "0"}, bank.receipt())
+
+	_, err = client.STA(context.Background(), nil)
+	require.ErrorIs(t, err, ErrNoData)
+	var ebicsErr *Error
+	require.True(t, errors.As(err, &ebicsErr))
+	require.Equal(t, "090005", ebicsErr.Code)
+	require.Len(t, bank.receipt(), 2)
+}
+
+func TestUpload(t *testing.T) {
+	for _, version := range []string{SignatureA005, SignatureA006} {
+		keys := newKeys(t)
+		keys.SignatureVersion = version
+		bank, client := newFakeBank(t, keys)
+		pain := fixtures.ReadFile(t, "musterfile_pain.001_Nov2020.xml")
+
+		orderID, err := client.CCT(context.Background(), pain)
+		require.Nil(t, err)
+		require.Equal(t, "N001", orderID)
+		require.Equal(t, pain, bank.orders["CCT"])
+
+		var signatures struct {
+			XMLName   xml.Name `xml:"http://www.ebics.org/S001 UserSignatureData"`
+			Version   string   `xml:"OrderSignatureData>SignatureVersion"`
+			Signature string   `xml:"OrderSignatureData>SignatureValue"`
+			UserID    string   `xml:"OrderSignatureData>UserID"`
+		}
+		for _, u := range bank.uploads {
+			require.Nil(t, xml.Unmarshal([]byte(u.signature), &signatures))
+		}
+		require.Equal(t, version, signatures.Version)
+		require.Equal(t, "USER", signatures.UserID)
+		signature, err := base64.StdEncoding.DecodeString(signatures.Signature)
+		require.Nil(t, err)
+		require.Nil(t, verifyOrder(version, &keys.Signature.PublicKey, pain, signature))
+	}
+
+	// order data of several segments
+	bank, client := newFakeBank(t, newKeys(t))
+	data := make([]byte, 2*maxSegmentData)
+	_, err := client.XCT(context.Background(), data)
+	require.Nil(t, err)
+	require.Equal(t, data, bank.orders["XCT"])
+}
+
+func TestAuthentication(t *testing.T) {
+	bank, client := newFakeBank(t, newKeys(t))
+	bank.downloads["C53"] = []byte("<Document/>")
+
+	// responses signed by another key
+	client.Bank.Authentication = &newKey(t).PublicKey
+	_, err := client.C53(context.Background(), nil)
+	require.ErrorIs(t, err, ErrAuthentication)
+
+	// requests signed by another key
+	other, client := newFakeBank(t, newKeys(t))
+	other.subscriber.Authentication = newKey(t)
+	_, err = client.C53(context.Background(), nil)
+	require.EqualError(t, err, "ebics C53: ebics: [EBICS_AUTHENTICATION_FAILED] Authentication failed (return code 061001)")
+}
+
+func TestError(t *testing.T) {
+	require.Equal(t, "ebics: return code 091005", (&Error{Code: "091005"}).Error())
+	require.True(t, errors.Is(&Error{Code: "090005"}, ErrNoData))
+	require.False(t, errors.Is(&Error{Code: "091005"}, ErrNoData))
+}
+
+func TestSource(t *testing.T) {
+	bank, client := newFakeBank(t, newKeys(t))
+	statement := fixtures.ReadFile(t, "valid_camt053_v08.xml")
+	bank.downloads["C53"] = zipFiles(t, File{Name: "statement.xml", Content: statement}, File{Name: "invalid.xml", Content: []byte("<Document><Unknown/></Document>")})
+
+	var mu sync.Mutex
+	var names []string
+	collect := func(_ context.Context, item pipeline.Item) error {
+		mu.Lock()
+		defer mu.Unlock()
+		require.Equal(t, "C53", item.Headers[HeaderOrderType])
+		names = append(names, item.Name)
+		return nil
+	}
+	p := pipeline.New(client.Source("C53", nil), client.Sink(collect), pipeline.WithErrorSink(client.ErrorSink(collect)))
+	stats, err := p.Run(context.Background())
+	require.Nil(t, err)
+	require.Equal(t, int64(1), stats.Succeeded)
+	require.Equal(t, int64(1), stats.Failed)
+	require.ElementsMatch(t, []string{"statement.xml", "invalid.xml"}, names)
+	require.Equal(t, []string{"0"}, bank.receipt())
+
+	// failures of the sink are negative receipts
+	failing := func(context.Context, pipeline.Item) error { return errors.New("disk full") }
+	p = pipeline.New(client.Source("C53", nil), client.Sink(failing), pipeline.WithErrorSink(client.ErrorSink(nil)))
+	_, err = p.Run(context.Background())
+	require.ErrorContains(t, err, "disk full")
+	require.Equal(t, []string{"0", "1"}, bank.receipt())
+
+	// no data
+	p = pipeline.New(client.Source("STA", nil), client.Sink(nil))
+	stats, err = p.Run(context.Background())
+	require.Nil(t, err)
+	require.Equal(t, int64(0), stats.Read)
+}
+
+func TestUploadSink(t *testing.T) {
+	bank, client := newFakeBank(t, newKeys(t))
+	pain := fixtures.ReadFile(t, "valid_pain_v11.xml")
+
+	var uploaded pipeline.Item
+	p := pipeline.New(pipeline.Bytes(pain), client.UploadSink("CCT", func(_ context.Context, item pipeline.Item) error {
+		uploaded = item
+		return nil
+	}))
+	_, err := p.Run(context.Background())
+	require.Nil(t, err)
+	require.Equal(t, pain, bank.orders["CCT"])
+	require.Equal(t, "N001", uploaded.Headers[HeaderOrderID])
+	require.Equal(t, "CCT", uploaded.Headers[HeaderOrderType])
+}
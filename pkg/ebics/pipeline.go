@@ -0,0 +1,147 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package ebics
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/moov-io/iso20022/pkg/pipeline"
+)
+
+const (
+	// HeaderOrderType, HeaderTransactionID and HeaderOrderID are the headers of the items of
+	// clients, the order type and transaction id of downloads and the order id of uploads
+	HeaderOrderType     = "x-ebics-order-type"
+	HeaderTransactionID = "x-ebics-transaction-id"
+	HeaderOrderID       = "x-ebics-order-id"
+)
+
+// download tracks the items of a download until every one of them is processed
+type download struct {
+	remaining int
+	err       error
+	done      chan struct{}
+}
+
+// Source downloads the order data of orderType, restricted to dates when not nil, and emits
+// its files, e.g. the camt.053 documents of C53. The receipt of the download is positive once
+// Sink or ErrorSink completed every file and negative when one of them failed or ctx is done,
+// so that the bank delivers the order data again.
+func (c *Client) Source(orderType string, dates *DateRange) pipeline.Source {
+	return func(ctx context.Context, emit func(pipeline.Item) error) error {
+		transactionID, data, err := c.download(ctx, orderType, dates)
+		if errors.Is(err, ErrNoData) {
+			return nil
+		}
+		if err != nil {
+			if transactionID != "" {
+				c.receipt(context.Background(), transactionID, false)
+			}
+			return err
+		}
+		files, err := files(orderType, data)
+		if err != nil {
+			c.receipt(context.Background(), transactionID, false)
+			return err
+		}
+		if len(files) == 0 {
+			return c.receipt(ctx, transactionID, true)
+		}
+
+		d := &download{remaining: len(files), done: make(chan struct{})}
+		c.mu.Lock()
+		if c.pending == nil {
+			c.pending = make(map[string]*download)
+		}
+		c.pending[transactionID] = d
+		c.mu.Unlock()
+		defer func() {
+			c.mu.Lock()
+			delete(c.pending, transactionID)
+			c.mu.Unlock()
+		}()
+
+		for _, f := range files {
+			headers := map[string]string{HeaderOrderType: orderType, HeaderTransactionID: transactionID}
+			if err := emit(pipeline.Item{Name: f.Name, Input: f.Content, Headers: headers}); err != nil {
+				c.receipt(context.Background(), transactionID, false)
+				return err
+			}
+		}
+		select {
+		case <-d.done:
+		case <-ctx.Done():
+			c.receipt(context.Background(), transactionID, false)
+			return ctx.Err()
+		}
+		if d.err != nil {
+			c.receipt(context.Background(), transactionID, false)
+			return fmt.Errorf("ebics %s: %w", orderType, d.err)
+		}
+		return c.receipt(ctx, transactionID, true)
+	}
+}
+
+// Sink completes the downloaded items processed by sink, which may be nil
+func (c *Client) Sink(sink pipeline.Sink) pipeline.Sink {
+	return func(ctx context.Context, item pipeline.Item) error {
+		var err error
+		if sink != nil {
+			err = sink(ctx, item)
+		}
+		c.complete(item, err)
+		return err
+	}
+}
+
+// ErrorSink completes the failed downloaded items handled by sink, which may be nil
+func (c *Client) ErrorSink(sink pipeline.Sink) pipeline.Sink {
+	return func(ctx context.Context, item pipeline.Item) error {
+		var err error
+		if sink != nil {
+			err = sink(ctx, item)
+		}
+		c.complete(item, err)
+		return err
+	}
+}
+
+func (c *Client) complete(item pipeline.Item, err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	d := c.pending[item.Headers[HeaderTransactionID]]
+	if d == nil || d.remaining == 0 {
+		return
+	}
+	if err != nil && d.err == nil {
+		d.err = fmt.Errorf("%s: %w", item.Name, err)
+	}
+	if d.remaining--; d.remaining == 0 {
+		close(d.done)
+	}
+}
+
+// UploadSink uploads the input of the processed items as orders of orderType, e.g. the
+// pain.001 of CCT, and routes them to sink, if not nil, with their order id
+func (c *Client) UploadSink(orderType string, sink pipeline.Sink) pipeline.Sink {
+	return func(ctx context.Context, item pipeline.Item) error {
+		orderID, err := c.Upload(ctx, orderType, item.Input)
+		if err != nil {
+			return fmt.Errorf("%s: %w", item.Name, err)
+		}
+		if sink == nil {
+			return nil
+		}
+		headers := make(map[string]string, len(item.Headers)+2)
+		for key, value := range item.Headers {
+			headers[key] = value
+		}
+		headers[HeaderOrderType], headers[HeaderOrderID] = orderType, orderID
+		item.Headers = headers
+		return sink(ctx, item)
+	}
+}
@@ -0,0 +1,249 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package ebics
+
+import (
+	"archive/zip"
+	"bytes"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newKey(t *testing.T) *rsa.PrivateKey {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.Nil(t, err)
+	return key
+}
+
+func newKeys(t *testing.T) Keys {
+	return Keys{Signature: newKey(t), Authentication: newKey(t), Encryption: newKey(t)}
+}
+
+func zipFiles(t *testing.T, files ...File) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+	for _, f := range files {
+		fw, err := w.Create(f.Name)
+		require.Nil(t, err)
+		fw.Write(f.Content)
+	}
+	require.Nil(t, w.Close())
+	return buf.Bytes()
+}
+
+// fakeBank is an EBICS host of a subscriber, delivering the order data of downloads in two
+// segments and keeping the verified order data of uploads
+type fakeBank struct {
+	t          *testing.T
+	hostID     string
+	auth, enc  *rsa.PrivateKey
+	subscriber Keys
+
+	mu        sync.Mutex
+	downloads map[string][]byte
+	dates     map[string]string
+	segments  map[string][][]byte
+	uploads   map[string]*upload
+	receipts  []string
+	orders    map[string][]byte
+}
+
+type upload struct {
+	orderType string
+	key       []byte
+	numbers   int
+	data      []byte
+	signature string
+}
+
+type fakeRequest struct {
+	XMLName       xml.Name
+	HostID        string `xml:"header>static>HostID"`
+	TransactionID string `xml:"header>static>TransactionID"`
+	PartnerID     string `xml:"header>static>PartnerID"`
+	OrderType     string `xml:"header>static>OrderDetails>OrderType"`
+	Start         string `xml:"header>static>OrderDetails>StandardOrderParams>DateRange>Start"`
+	NumSegments   int    `xml:"header>static>NumSegments"`
+	Phase         string `xml:"header>mutable>TransactionPhase"`
+	Segment       int    `xml:"header>mutable>SegmentNumber"`
+
+	TransactionKey string `xml:"body>DataTransfer>DataEncryptionInfo>TransactionKey"`
+	SignatureData  string `xml:"body>DataTransfer>SignatureData"`
+	OrderData      string `xml:"body>DataTransfer>OrderData"`
+	ReceiptCode    string `xml:"body>TransferReceipt>ReceiptCode"`
+}
+
+func newFakeBank(t *testing.T, subscriber Keys) (*fakeBank, *Client) {
+	t.Helper()
+	bank := &fakeBank{
+		t:          t,
+		hostID:     "MOOVHOST",
+		auth:       newKey(t),
+		enc:        newKey(t),
+		subscriber: subscriber,
+		downloads:  make(map[string][]byte),
+		dates:      make(map[string]string),
+		segments:   make(map[string][][]byte),
+		uploads:    make(map[string]*upload),
+		orders:     make(map[string][]byte),
+	}
+	server := httptest.NewServer(bank)
+	t.Cleanup(server.Close)
+
+	client := New(server.URL, bank.hostID, "PARTNER", "USER", subscriber)
+	client.Bank = BankKeys{Authentication: &bank.auth.PublicKey, Encryption: &bank.enc.PublicKey}
+	return bank, client
+}
+
+func (f *fakeBank) receipt() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]string(nil), f.receipts...)
+}
+
+func (f *fakeBank) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, _ := io.ReadAll(r.Body)
+	var req fakeRequest
+	if err := xml.Unmarshal(body, &req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if err := verifyAuthentication(body, &f.subscriber.Authentication.PublicKey); err != nil {
+		f.respond(w, "", "<ReturnCode>061001</ReturnCode><ReportText>[EBICS_AUTHENTICATION_FAILED] Authentication failed</ReportText>", "")
+		return
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	switch {
+	case req.XMLName.Local == "ebicsNoPubKeyDigestsRequest":
+		keys := fmt.Sprintf(`<HPBResponseOrderData xmlns="%s" xmlns:ds="%s">`+
+			`<AuthenticationPubKeyInfo><PubKeyValue>%s</PubKeyValue><AuthenticationVersion>X002</AuthenticationVersion></AuthenticationPubKeyInfo>`+
+			`<EncryptionPubKeyInfo><PubKeyValue>%s</PubKeyValue><EncryptionVersion>E002</EncryptionVersion></EncryptionPubKeyInfo>`+
+			`<HostID>%s</HostID></HPBResponseOrderData>`, nsH004, nsDS, rsaKey(&f.auth.PublicKey), rsaKey(&f.enc.PublicKey), f.hostID)
+		key, data := f.seal([]byte(keys))
+		doc := request("ebicsKeyManagementResponse", "", "<ReturnCode>000000</ReturnCode><ReportText>[EBICS_OK] OK</ReportText>",
+			"<DataTransfer><DataEncryptionInfo authenticate=\"true\"><TransactionKey>"+key+"</TransactionKey></DataEncryptionInfo>"+
+				"<OrderData>"+base64.StdEncoding.EncodeToString(data)+"</OrderData></DataTransfer><ReturnCode authenticate=\"true\">000000</ReturnCode>")
+		w.Write(bytes.Replace(doc, []byte(authSignaturePlaceholder), nil, 1))
+
+	case req.Phase == "Initialisation" && req.NumSegments == 0:
+		data, exists := f.downloads[req.OrderType]
+		if !exists {
+			f.respond(w, "", ok("Initialisation"), "<ReturnCode authenticate=\"true\">090005</ReturnCode>")
+			return
+		}
+		f.dates[req.OrderType] = req.Start
+		transactionID := fmt.Sprintf("TX%030d", len(f.segments)+1)
+		key, encrypted := f.seal(data)
+		half := len(encrypted) / 2
+		f.segments[transactionID] = [][]byte{encrypted[:half], encrypted[half:]}
+		f.respond(w, "<TransactionID>"+transactionID+"</TransactionID><NumSegments>2</NumSegments>",
+			ok("Initialisation")+`<SegmentNumber lastSegment="false">1</SegmentNumber>`,
+			"<DataTransfer><DataEncryptionInfo authenticate=\"true\"><TransactionKey>"+key+"</TransactionKey></DataEncryptionInfo>"+
+				"<OrderData>"+base64.StdEncoding.EncodeToString(encrypted[:half])+"</OrderData></DataTransfer><ReturnCode authenticate=\"true\">000000</ReturnCode>")
+
+	case req.Phase == "Transfer" && f.segments[req.TransactionID] != nil:
+		segments := f.segments[req.TransactionID]
+		if req.Segment < 1 || req.Segment > len(segments) {
+			f.respond(w, "", "<ReturnCode>091104</ReturnCode><ReportText>[EBICS_TX_SEGMENT_NUMBER_EXCEEDED]</ReportText>", "")
+			return
+		}
+		last := strconv.FormatBool(req.Segment == len(segments))
+		f.respond(w, "<TransactionID>"+req.TransactionID+"</TransactionID>",
+			ok("Transfer")+`<SegmentNumber lastSegment="`+last+`">`+strconv.Itoa(req.Segment)+`</SegmentNumber>`,
+			"<DataTransfer><OrderData>"+base64.StdEncoding.EncodeToString(segments[req.Segment-1])+"</OrderData></DataTransfer><ReturnCode authenticate=\"true\">000000</ReturnCode>")
+
+	case req.Phase == "Receipt":
+		f.receipts = append(f.receipts, req.ReceiptCode)
+		f.respond(w, "<TransactionID>"+req.TransactionID+"</TransactionID>",
+			"<TransactionPhase>Receipt</TransactionPhase><ReturnCode>011000</ReturnCode><ReportText>[EBICS_DOWNLOAD_POSTPROCESS_DONE]</ReportText>",
+			"<ReturnCode authenticate=\"true\">000000</ReturnCode>")
+
+	case req.Phase == "Initialisation":
+		key, err := f.open(req.TransactionKey)
+		require.Nil(f.t, err)
+		signature, err := base64.StdEncoding.DecodeString(req.SignatureData)
+		require.Nil(f.t, err)
+		signature, err = decryptOrderData(key, signature)
+		require.Nil(f.t, err)
+		signature, err = inflate(signature)
+		require.Nil(f.t, err)
+
+		transactionID := fmt.Sprintf("TX%030d", len(f.uploads)+100)
+		f.uploads[transactionID] = &upload{orderType: req.OrderType, key: key, numbers: req.NumSegments, signature: string(signature)}
+		f.respond(w, "<TransactionID>"+transactionID+"</TransactionID>",
+			ok("Initialisation")+"<OrderID>N00"+strconv.Itoa(len(f.uploads))+"</OrderID>",
+			"<ReturnCode authenticate=\"true\">000000</ReturnCode>")
+
+	case req.Phase == "Transfer" && f.uploads[req.TransactionID] != nil:
+		u := f.uploads[req.TransactionID]
+		data, err := base64.StdEncoding.DecodeString(req.OrderData)
+		require.Nil(f.t, err)
+		u.data = append(u.data, data...)
+		if req.Segment == u.numbers {
+			plain, err := decryptOrderData(u.key, u.data)
+			require.Nil(f.t, err)
+			plain, err = inflate(plain)
+			require.Nil(f.t, err)
+			f.orders[u.orderType] = plain
+		}
+		f.respond(w, "<TransactionID>"+req.TransactionID+"</TransactionID>", ok("Transfer"),
+			"<ReturnCode authenticate=\"true\">000000</ReturnCode>")
+
+	default:
+		f.respond(w, "", "<ReturnCode>091101</ReturnCode><ReportText>[EBICS_TX_UNKNOWN_TXID]</ReportText>", "")
+	}
+}
+
+func ok(phase string) string {
+	return "<TransactionPhase>" + phase + "</TransactionPhase><ReturnCode>000000</ReturnCode><ReportText>[EBICS_OK] OK</ReportText>"
+}
+
+// respond writes the signed ebicsResponse of the header static, mutable and body
+func (f *fakeBank) respond(w http.ResponseWriter, static, mutable, body string) {
+	doc, err := authenticate(request("ebicsResponse", static, mutable, body), f.auth)
+	require.Nil(f.t, err)
+	w.Header().Set("Content-Type", "text/xml")
+	w.Write(doc)
+}
+
+// seal compresses and encrypts order data for the encryption key of the subscriber
+func (f *fakeBank) seal(data []byte) (string, []byte) {
+	key := make([]byte, 16)
+	rand.Read(key)
+	encrypted, err := encryptOrderData(key, deflate(data))
+	require.Nil(f.t, err)
+	transactionKey, err := rsa.EncryptPKCS1v15(rand.Reader, &f.subscriber.Encryption.PublicKey, key)
+	require.Nil(f.t, err)
+	return base64.StdEncoding.EncodeToString(transactionKey), encrypted
+}
+
+func (f *fakeBank) open(transactionKey string) ([]byte, error) {
+	key, err := base64.StdEncoding.DecodeString(transactionKey)
+	if err != nil {
+		return nil, err
+	}
+	return rsa.DecryptPKCS1v15(rand.Reader, f.enc, key)
+}
+
+func rsaKey(pub *rsa.PublicKey) string {
+	return "<ds:RSAKeyValue><ds:Modulus>" + base64.StdEncoding.EncodeToString(pub.N.Bytes()) + "</ds:Modulus>" +
+		"<ds:Exponent>" + base64.StdEncoding.EncodeToString(big.NewInt(int64(pub.E)).Bytes()) + "</ds:Exponent></ds:RSAKeyValue>"
+}
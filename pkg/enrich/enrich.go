@@ -0,0 +1,265 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package enrich
+
+/*
+	Enrich adds the reference data of other systems to parsed documents without changing
+	them: enrichers return enrichments, values keyed by name attached to an element of the
+	document, e.g. the name of the bank of a BIC or the customer of an account:
+
+		banks, err := enrich.LoadBankDirectory("banks.csv")
+		set, err := enrich.Enrich(ctx, doc, banks, enrich.IBANCountry(), enrich.Accounts(lookup))
+		name, _ := set.Lookup("CdtTrfTxInf[0]/CdtrAgt/FinInstnId/BICFI", enrich.KeyBankName)
+
+	Pipelines run enrichers after parsing with pipeline.WithEnrichers, summaries hold the
+	enrichments of their transactions with Summary.AddEnrichments.
+*/
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/moov-io/iso20022/pkg/document"
+	"github.com/moov-io/iso20022/pkg/utils"
+)
+
+const (
+	// KeyBankName is the name of the bank of a BIC
+	KeyBankName = "bankName"
+
+	// KeyCountry is the ISO 3166 country code of an IBAN
+	KeyCountry = "country"
+
+	// KeyCustomerID is the internal id of the customer holding an account
+	KeyCustomerID = "customerId"
+)
+
+// Enrichment is a value attached to an element of a document
+type Enrichment struct {
+	// Path of the enriched element, e.g. CdtTrfTxInf[0]/CdtrAgt/FinInstnId/BICFI
+	Path  string `json:"path"`
+	Key   string `json:"key"`
+	Value string `json:"value"`
+
+	// Source is the name of the enricher
+	Source string `json:"source,omitempty"`
+}
+
+// Set is the enrichments of a document in the order of its enrichers
+type Set []Enrichment
+
+// Lookup returns the first value of key attached to the element at path
+func (s Set) Lookup(path, key string) (string, bool) {
+	for _, e := range s {
+		if e.Path == path && e.Key == key {
+			return e.Value, true
+		}
+	}
+	return "", false
+}
+
+// Under returns the enrichments of the elements at or under path, e.g. of a transaction
+func (s Set) Under(path string) Set {
+	var under Set
+	for _, e := range s {
+		if e.Path == path || strings.HasPrefix(e.Path, path+"/") {
+			under = append(under, e)
+		}
+	}
+	return under
+}
+
+// Enricher returns the enrichments of a document
+type Enricher interface {
+	Name() string
+	Enrich(ctx context.Context, doc document.Iso20022Document) ([]Enrichment, error)
+}
+
+type enricherFunc struct {
+	name string
+	fn   func(ctx context.Context, doc document.Iso20022Document) ([]Enrichment, error)
+}
+
+func (e enricherFunc) Name() string { return e.name }
+
+func (e enricherFunc) Enrich(ctx context.Context, doc document.Iso20022Document) ([]Enrichment, error) {
+	return e.fn(ctx, doc)
+}
+
+// New returns the enricher name calling fn
+func New(name string, fn func(ctx context.Context, doc document.Iso20022Document) ([]Enrichment, error)) Enricher {
+	return enricherFunc{name: name, fn: fn}
+}
+
+// Enrich returns the enrichments of doc by enrichers in order, enrichments without source
+// are attributed to their enricher. It stops at the first enricher failing.
+func Enrich(ctx context.Context, doc document.Iso20022Document, enrichers ...Enricher) (Set, error) {
+	var set Set
+	for _, enricher := range enrichers {
+		enrichments, err := enricher.Enrich(ctx, doc)
+		if err != nil {
+			return set, fmt.Errorf("enricher %s: %w", enricher.Name(), err)
+		}
+		for _, e := range enrichments {
+			if e.Source == "" {
+				e.Source = enricher.Name()
+			}
+			set = append(set, e)
+		}
+	}
+	return set, nil
+}
+
+// walkValues calls fn with the path and value of the elements of doc matching one of suffixes
+func walkValues(doc document.Iso20022Document, suffixes []string, fn func(path, value string)) {
+	utils.WalkElements(doc.InspectMessage(), func(path, value string) {
+		for _, suffix := range suffixes {
+			if utils.MatchElementPath(path, suffix) {
+				fn(path, value)
+				return
+			}
+		}
+	})
+}
+
+// bicElements are the elements holding BICs
+var bicElements = []string{"BICFI", "BIC", "AnyBIC", "BICOrBEI"}
+
+// BankDirectory enriches the BICs of a document with the name of their bank in banks, by
+// BIC11 or BIC8. BICs of branches not in banks get the name of their head office.
+type BankDirectory map[string]string
+
+// Name is the name of the enricher
+func (d BankDirectory) Name() string {
+	return "banks"
+}
+
+// Bank returns the name of the bank of bic
+func (d BankDirectory) Bank(bic string) (string, bool) {
+	bic = strings.ToUpper(strings.TrimSpace(bic))
+	if name, exists := d[bic]; exists {
+		return name, true
+	}
+	if len(bic) == 11 || len(bic) == 8 {
+		for _, candidate := range []string{bic[:8], bic[:8] + "XXX"} {
+			if name, exists := d[candidate]; exists {
+				return name, true
+			}
+		}
+	}
+	return "", false
+}
+
+// Enrich returns the bank names of the BICs of doc
+func (d BankDirectory) Enrich(_ context.Context, doc document.Iso20022Document) ([]Enrichment, error) {
+	var enrichments []Enrichment
+	walkValues(doc, bicElements, func(path, value string) {
+		if name, found := d.Bank(value); found {
+			enrichments = append(enrichments, Enrichment{Path: path, Key: KeyBankName, Value: name})
+		}
+	})
+	return enrichments, nil
+}
+
+// ParseBankDirectory reads a directory of banks from csv records of a BIC and a bank name,
+// a first record with a BIC column header is skipped
+func ParseBankDirectory(r io.Reader) (BankDirectory, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	directory := make(BankDirectory)
+	for line := 1; ; line++ {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return directory, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		if len(record) < 2 {
+			return nil, fmt.Errorf("line %d: expected a bic and a bank name", line)
+		}
+		bic := strings.ToUpper(strings.TrimSpace(record[0]))
+		if line == 1 && bic == "BIC" {
+			continue
+		}
+		if len(bic) != 8 && len(bic) != 11 {
+			return nil, fmt.Errorf("line %d: %q is not a BIC", line, record[0])
+		}
+		directory[bic] = strings.TrimSpace(record[1])
+	}
+}
+
+// LoadBankDirectory reads a directory of banks from a csv file
+func LoadBankDirectory(path string) (BankDirectory, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	directory, err := ParseBankDirectory(f)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return directory, nil
+}
+
+// IBANCountry returns an enricher adding the country of the IBANs of a document
+func IBANCountry() Enricher {
+	return New("iban-country", func(_ context.Context, doc document.Iso20022Document) ([]Enrichment, error) {
+		var enrichments []Enrichment
+		walkValues(doc, []string{"IBAN"}, func(path, value string) {
+			value = strings.ToUpper(strings.TrimSpace(value))
+			if len(value) < 2 || value[0] < 'A' || value[0] > 'Z' || value[1] < 'A' || value[1] > 'Z' {
+				return
+			}
+			enrichments = append(enrichments, Enrichment{Path: path, Key: KeyCountry, Value: value[:2]})
+		})
+		return enrichments, nil
+	})
+}
+
+// AccountLookup returns the id of the customer holding account, an empty id when the account
+// isn't a customer's
+type AccountLookup func(ctx context.Context, account string) (string, error)
+
+// accountElements are the identifications of accounts
+var accountElements = []string{"Id/IBAN", "Id/Othr/Id"}
+
+// Accounts returns an enricher adding the customer ids of the accounts of a document given by
+// lookup, which is called once for every account of a document
+func Accounts(lookup AccountLookup) Enricher {
+	return New("accounts", func(ctx context.Context, doc document.Iso20022Document) ([]Enrichment, error) {
+		var (
+			enrichments []Enrichment
+			failure     error
+		)
+		customers := make(map[string]string)
+		walkValues(doc, accountElements, func(path, value string) {
+			if failure != nil || !strings.Contains(utils.StripElementIndexes(path), "Acct/") {
+				return
+			}
+			customer, seen := customers[value]
+			if !seen {
+				var err error
+				if customer, err = lookup(ctx, value); err != nil {
+					failure = fmt.Errorf("account %s: %w", path, err)
+					return
+				}
+				customers[value] = customer
+			}
+			if customer != "" {
+				enrichments = append(enrichments, Enrichment{Path: path, Key: KeyCustomerID, Value: customer})
+			}
+		})
+		return enrichments, failure
+	})
+}
@@ -0,0 +1,112 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package enrich
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/moov-io/iso20022/pkg/document"
+	"github.com/moov-io/iso20022/pkg/iso20022test/fixtures"
+)
+
+func TestBankDirectory(t *testing.T) {
+	banks, err := ParseBankDirectory(strings.NewReader("BIC,Name\nBANKGB2LXXX, Bank of London\nBANKUS33,Bank of New York\n"))
+	require.Nil(t, err)
+	require.Len(t, banks, 2)
+
+	name, found := banks.Bank("bankus33xxx")
+	require.True(t, found)
+	require.Equal(t, "Bank of New York", name)
+	// branches get the name of their head office
+	name, found = banks.Bank("BANKGB2L123")
+	require.True(t, found)
+	require.Equal(t, "Bank of London", name)
+	_, found = banks.Bank("BANKDEFFXXX")
+	require.False(t, found)
+
+	set, err := Enrich(context.Background(), fixtures.Document(t, "valid_pacs_v08.xml"), banks)
+	require.Nil(t, err)
+	value, found := set.Lookup("CdtTrfTxInf[0]/CdtrAgt/FinInstnId/BICFI", KeyBankName)
+	require.True(t, found)
+	require.Equal(t, "Bank of London", value)
+	value, found = set.Lookup("GrpHdr/InstgAgt/FinInstnId/BICFI", KeyBankName)
+	require.True(t, found)
+	require.Equal(t, "Bank of New York", value)
+	_, found = set.Lookup("CdtTrfTxInf[1]/CdtrAgt/FinInstnId/BICFI", KeyBankName)
+	require.False(t, found)
+	for _, e := range set {
+		require.Equal(t, "banks", e.Source)
+	}
+	require.Len(t, set.Under("CdtTrfTxInf[1]"), 1)
+
+	_, err = ParseBankDirectory(strings.NewReader("BANKGB2LXXX\n"))
+	require.Error(t, err)
+	_, err = ParseBankDirectory(strings.NewReader("BANK,Too short\n"))
+	require.Error(t, err)
+	_, err = LoadBankDirectory(filepath.Join("testdata", "missing.csv"))
+	require.Error(t, err)
+}
+
+func TestIBANCountryAndAccounts(t *testing.T) {
+	buf := fixtures.ReadFile(t, "valid_pacs_v08.xml")
+	// both debtors hold the same account, the first creditor another one
+	buf = []byte(strings.ReplaceAll(string(buf), "</Dbtr>", "</Dbtr><DbtrAcct><Id><IBAN>GB29NWBK60161331926819</IBAN></Id></DbtrAcct>"))
+	buf = []byte(strings.Replace(string(buf), "</Cdtr>", "</Cdtr><CdtrAcct><Id><Othr><Id>4711</Id></Othr></Id></CdtrAcct>", 1))
+	doc, err := document.ParseIso20022Document(buf)
+	require.Nil(t, err)
+
+	var lookups []string
+	accounts := Accounts(func(_ context.Context, account string) (string, error) {
+		lookups = append(lookups, account)
+		if account == "GB29NWBK60161331926819" {
+			return "customer-42", nil
+		}
+		return "", nil
+	})
+	set, err := Enrich(context.Background(), doc, IBANCountry(), accounts)
+	require.Nil(t, err)
+	require.ElementsMatch(t, []string{"GB29NWBK60161331926819", "4711"}, lookups)
+
+	var countries, customers int
+	for _, e := range set {
+		switch e.Key {
+		case KeyCountry:
+			countries++
+			require.Equal(t, "GB", e.Value)
+			require.Equal(t, "iban-country", e.Source)
+		case KeyCustomerID:
+			customers++
+			require.Equal(t, "customer-42", e.Value)
+			require.True(t, strings.HasSuffix(e.Path, "DbtrAcct/Id/IBAN"), e.Path)
+		}
+	}
+	require.Equal(t, 2, countries)
+	require.Equal(t, 2, customers)
+
+	failing := Accounts(func(context.Context, string) (string, error) {
+		return "", errors.New("unavailable")
+	})
+	_, err = Enrich(context.Background(), doc, failing)
+	require.ErrorContains(t, err, "enricher accounts")
+}
+
+func TestNew(t *testing.T) {
+	custom := New("static", func(context.Context, document.Iso20022Document) ([]Enrichment, error) {
+		return []Enrichment{{Path: "GrpHdr/MsgId", Key: "channel", Value: "ebics"}, {Path: "GrpHdr", Key: "k", Value: "v", Source: "crm"}}, nil
+	})
+	set, err := Enrich(context.Background(), fixtures.Document(t, "valid_pacs_v08.xml"), custom)
+	require.Nil(t, err)
+	require.Equal(t, Set{
+		{Path: "GrpHdr/MsgId", Key: "channel", Value: "ebics", Source: "static"},
+		{Path: "GrpHdr", Key: "k", Value: "v", Source: "crm"},
+	}, set)
+	require.Equal(t, set, set.Under("GrpHdr"))
+}
@@ -0,0 +1,264 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package events
+
+/*
+	Package events emits fine-grained events of processed documents, one per payment, entry
+	and balance, so downstream services react to transactions instead of parsing documents:
+
+		e := events.New(events.Webhook("https://ledger.internal/events"), "iso20022")
+		pl := pipeline.New(source, e.Sink(sink))
+
+	Events are CloudEvents 1.0 in the structured json mode:
+
+		{
+		  "specversion": "1.0",
+		  "id": "5b1c...",
+		  "source": "iso20022",
+		  "type": "payment.created",
+		  "subject": "CdtTrfTxInf[0]",
+		  "time": "2021-04-15T10:30:00Z",
+		  "datacontenttype": "application/json",
+		  "documentid": "...",
+		  "messagetype": "pacs.008.001.08",
+		  "messageid": "MSG-20210415-0001",
+		  "data": {"path": "CdtTrfTxInf[0]", "endToEndId": "E2E-0001", "amount": "250000", "currency": "USD", ...}
+		}
+
+	The subject is the element path of the transaction or balance in the document. Ids
+	depend on the document, type and subject only, consumers drop the events of a document
+	processed again by their id. Kafka, NATS or any other broker is a Publisher sending the
+	json of the event, e.g. to a topic named by its type.
+*/
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/moov-io/iso20022/pkg/document"
+	"github.com/moov-io/iso20022/pkg/largevalue"
+	"github.com/moov-io/iso20022/pkg/pipeline"
+	"github.com/moov-io/iso20022/pkg/projection"
+)
+
+const (
+	// TypePaymentCreated events are emitted for the transactions of payment messages (pacs.008, pain.001...)
+	TypePaymentCreated = "payment.created"
+
+	// TypeEntryRecorded events are emitted for the entries of statements, reports and notifications
+	TypeEntryRecorded = "statement.entry.recorded"
+
+	// TypeBalanceReported events are emitted for the balances of statements and reports
+	TypeBalanceReported = "statement.balance.reported"
+
+	// TypeLargeValueDetected events are emitted in addition to payment.created for the payments
+	// reaching a large value threshold, see WithLargeValues
+	TypeLargeValueDetected = "payment.largevalue.detected"
+
+	// SpecVersion is the CloudEvents version of events
+	SpecVersion = "1.0"
+
+	// ContentType is the media type of events posted by webhooks
+	ContentType = "application/cloudevents+json"
+
+	// DefaultSource is the source of events unless configured
+	DefaultSource = "iso20022"
+
+	webhookTimeout = 10 * time.Second
+)
+
+// Event is a CloudEvent of a processed document
+type Event struct {
+	SpecVersion     string    `json:"specversion"`
+	ID              string    `json:"id"`
+	Source          string    `json:"source"`
+	Type            string    `json:"type"`
+	Subject         string    `json:"subject"`
+	Time            time.Time `json:"time"`
+	DataContentType string    `json:"datacontenttype"`
+
+	// DocumentID, MessageType and MessageID are extension attributes identifying the document
+	DocumentID  string `json:"documentid"`
+	MessageType string `json:"messagetype"`
+	MessageID   string `json:"messageid,omitempty"`
+
+	// Data is a PaymentData, LargeValueData, EntryData or projection.Balance by Type
+	Data interface{} `json:"data"`
+}
+
+// PaymentData is the data of payment.created events
+type PaymentData struct {
+	projection.Payment
+	Parties []projection.Party `json:"parties,omitempty"`
+}
+
+// LargeValueData is the data of payment.largevalue.detected events
+type LargeValueData struct {
+	PaymentData
+	Threshold float64 `json:"threshold"`
+}
+
+// EntryData is the data of statement.entry.recorded events
+type EntryData struct {
+	projection.Entry
+	Parties []projection.Party `json:"parties,omitempty"`
+}
+
+// Publisher sends an event to a broker or service, returning an error fails the emission
+type Publisher func(ctx context.Context, event Event) error
+
+// Emitter emits the events of documents to a publisher
+type Emitter struct {
+	publish     Publisher
+	source      string
+	now         func() time.Time
+	largeValues largevalue.Thresholds
+}
+
+// Option configures an emitter
+type Option func(*Emitter)
+
+// WithLargeValues emits a payment.largevalue.detected event for the payments reaching the
+// threshold of their currency
+func WithLargeValues(thresholds largevalue.Thresholds) Option {
+	return func(e *Emitter) {
+		e.largeValues = thresholds
+	}
+}
+
+// New returns an emitter publishing the events of source, DefaultSource when empty
+func New(publish Publisher, source string, opts ...Option) *Emitter {
+	if source == "" {
+		source = DefaultSource
+	}
+	e := &Emitter{publish: publish, source: source, now: time.Now}
+	for _, opt := range opts {
+		opt(e)
+	}
+	return e
+}
+
+// Events returns the events of the document id
+func (e *Emitter) Events(id string, doc document.Iso20022Document) []Event {
+	p := projection.Normalize(id, doc)
+	now := e.now().UTC()
+
+	var events []Event
+	add := func(kind, subject string, data interface{}) {
+		events = append(events, Event{
+			SpecVersion:     SpecVersion,
+			ID:              eventID(id, kind, subject),
+			Source:          e.source,
+			Type:            kind,
+			Subject:         subject,
+			Time:            now,
+			DataContentType: "application/json",
+			DocumentID:      id,
+			MessageType:     p.MessageType,
+			MessageID:       p.MessageID,
+			Data:            data,
+		})
+	}
+	for _, payment := range p.Payments {
+		data := PaymentData{Payment: payment, Parties: partiesOf(p, payment.Path)}
+		add(TypePaymentCreated, payment.Path, data)
+		if amount, err := strconv.ParseFloat(payment.Amount, 64); err == nil && e.largeValues.IsLarge(amount, payment.Currency) {
+			threshold, _ := e.largeValues.Threshold(payment.Currency)
+			add(TypeLargeValueDetected, payment.Path, LargeValueData{PaymentData: data, Threshold: threshold})
+		}
+	}
+	for _, entry := range p.Entries {
+		add(TypeEntryRecorded, entry.Path, EntryData{Entry: entry, Parties: partiesOf(p, entry.Path)})
+	}
+	for _, balance := range p.Balances {
+		add(TypeBalanceReported, balance.Path, balance)
+	}
+	return events
+}
+
+// Emit publishes the events of the document id in document order, it stops at the first
+// event failing
+func (e *Emitter) Emit(ctx context.Context, id string, doc document.Iso20022Document) error {
+	for _, event := range e.Events(id, doc) {
+		if err := e.publish(ctx, event); err != nil {
+			return fmt.Errorf("publishing %s event %s of %s: %w", event.Type, event.Subject, id, err)
+		}
+	}
+	return nil
+}
+
+// Sink emits the events of the processed items and routes them to sink, if not nil. Items
+// are identified by their name, or the sha-256 digest of their input when they have none.
+func (e *Emitter) Sink(sink pipeline.Sink) pipeline.Sink {
+	return func(ctx context.Context, item pipeline.Item) error {
+		id := item.Name
+		if id == "" {
+			sum := sha256.Sum256(item.Input)
+			id = hex.EncodeToString(sum[:])
+		}
+		if item.Document != nil {
+			if err := e.Emit(ctx, id, item.Document); err != nil {
+				return err
+			}
+		}
+		if sink != nil {
+			return sink(ctx, item)
+		}
+		return nil
+	}
+}
+
+// Webhook returns a publisher posting every event to url, responses other than 2xx fail the event
+func Webhook(url string) Publisher {
+	return WebhookClient(url, &http.Client{Timeout: webhookTimeout})
+}
+
+// WebhookClient returns a publisher posting every event to url with client, e.g. a client
+// retrying failed posts
+func WebhookClient(url string, client *http.Client) Publisher {
+	return func(ctx context.Context, event Event) error {
+		body, err := json.Marshal(event)
+		if err != nil {
+			return err
+		}
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+		if err != nil {
+			return err
+		}
+		req.Header.Set("Content-Type", ContentType)
+		resp, err := client.Do(req)
+		if err != nil {
+			return err
+		}
+		resp.Body.Close()
+		if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+			return fmt.Errorf("webhook responded %s", resp.Status)
+		}
+		return nil
+	}
+}
+
+// eventID returns the id of the event of kind about subject of the document id
+func eventID(id, kind, subject string) string {
+	sum := sha256.Sum256([]byte(id + "\n" + kind + "\n" + subject))
+	return hex.EncodeToString(sum[:16])
+}
+
+func partiesOf(p *projection.Projection, path string) []projection.Party {
+	var parties []projection.Party
+	for _, party := range p.Parties {
+		if party.Path == path {
+			parties = append(parties, party)
+		}
+	}
+	return parties
+}
@@ -0,0 +1,149 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/moov-io/iso20022/pkg/document"
+	"github.com/moov-io/iso20022/pkg/iso20022test/fixtures"
+	"github.com/moov-io/iso20022/pkg/largevalue"
+	"github.com/moov-io/iso20022/pkg/pipeline"
+	"github.com/moov-io/iso20022/pkg/projection"
+)
+
+func parseTestFile(t *testing.T, name string) document.Iso20022Document {
+	t.Helper()
+	doc, err := document.ParseIso20022Document(fixtures.ReadFile(t, name))
+	require.Nil(t, err)
+	return doc
+}
+
+func TestEvents(t *testing.T) {
+	e := New(nil, "")
+	e.now = func() time.Time { return time.Date(2021, time.April, 15, 10, 30, 0, 0, time.UTC) }
+
+	events := e.Events("doc-1", parseTestFile(t, "valid_pacs_v08.xml"))
+	require.Len(t, events, 2)
+
+	event := events[0]
+	require.Equal(t, SpecVersion, event.SpecVersion)
+	require.Equal(t, DefaultSource, event.Source)
+	require.Equal(t, TypePaymentCreated, event.Type)
+	require.Equal(t, "CdtTrfTxInf[0]", event.Subject)
+	require.Equal(t, "doc-1", event.DocumentID)
+	require.Equal(t, "pacs.008.001.08", event.MessageType)
+	require.Equal(t, "MSG-20210415-0001", event.MessageID)
+
+	data := event.Data.(PaymentData)
+	require.Equal(t, "E2E-0001", data.EndToEndID)
+	require.Contains(t, data.Parties, projection.Party{Path: "CdtTrfTxInf[0]", Role: "Dbtr", Name: "John Smith", Country: "US"})
+
+	// ids identify the transaction of the document
+	again := e.Events("doc-1", parseTestFile(t, "valid_pacs_v08.xml"))
+	require.Equal(t, event.ID, again[0].ID)
+	require.NotEqual(t, event.ID, events[1].ID)
+	require.NotEqual(t, event.ID, e.Events("doc-2", parseTestFile(t, "valid_pacs_v08.xml"))[0].ID)
+
+	buf, err := json.Marshal(event)
+	require.Nil(t, err)
+	require.Contains(t, string(buf), `"specversion":"1.0"`)
+	require.Contains(t, string(buf), `"data":{"path":"CdtTrfTxInf[0]","instructionId":"INSTR-0001","endToEndId":"E2E-0001"`)
+	require.Contains(t, string(buf), `"time":"2021-04-15T10:30:00Z"`)
+}
+
+func TestStatementEvents(t *testing.T) {
+	events := New(nil, "bank").Events("doc-1", parseTestFile(t, "valid_camt053_v08.xml"))
+
+	counts := make(map[string]int)
+	for _, event := range events {
+		require.Equal(t, "bank", event.Source)
+		counts[event.Type]++
+	}
+	require.Zero(t, counts[TypePaymentCreated])
+	require.NotZero(t, counts[TypeEntryRecorded])
+	require.NotZero(t, counts[TypeBalanceReported])
+
+	entry := events[0].Data.(EntryData)
+	require.Equal(t, "A-1", entry.EntryRef)
+	require.Equal(t, "CH2909000000250094239", entry.Account)
+}
+
+func TestLargeValueEvents(t *testing.T) {
+	e := New(nil, "", WithLargeValues(largevalue.Thresholds{"USD": 10000}))
+	events := e.Events("doc-1", parseTestFile(t, "valid_pacs_v08.xml"))
+	require.Len(t, events, 3)
+
+	require.Equal(t, TypePaymentCreated, events[0].Type)
+	event := events[1]
+	require.Equal(t, TypeLargeValueDetected, event.Type)
+	require.Equal(t, "CdtTrfTxInf[0]", event.Subject)
+	require.NotEqual(t, events[0].ID, event.ID)
+
+	data := event.Data.(LargeValueData)
+	require.Equal(t, 10000.0, data.Threshold)
+	require.Equal(t, "250000", data.Amount)
+	require.Equal(t, "USD", data.Currency)
+	require.NotEmpty(t, data.Parties)
+
+	require.Equal(t, TypePaymentCreated, events[2].Type)
+}
+
+func TestWebhook(t *testing.T) {
+	var received []Event
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, ContentType, r.Header.Get("Content-Type"))
+		body, err := io.ReadAll(r.Body)
+		require.Nil(t, err)
+		var event Event
+		require.Nil(t, json.Unmarshal(body, &event))
+		received = append(received, event)
+		if event.Subject == "CdtTrfTxInf[1]" && r.URL.Path == "/failing" {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer ts.Close()
+
+	doc := parseTestFile(t, "valid_pacs_v08.xml")
+	require.Nil(t, New(Webhook(ts.URL), "").Emit(context.Background(), "doc-1", doc))
+	require.Len(t, received, 2)
+	require.Equal(t, TypePaymentCreated, received[1].Type)
+
+	err := New(Webhook(ts.URL+"/failing"), "").Emit(context.Background(), "doc-1", doc)
+	require.ErrorContains(t, err, "publishing payment.created event CdtTrfTxInf[1] of doc-1: webhook responded 503")
+}
+
+func TestSink(t *testing.T) {
+	var published []Event
+	e := New(func(_ context.Context, event Event) error {
+		published = append(published, event)
+		return nil
+	}, "")
+
+	var sunk int
+	sink := e.Sink(func(context.Context, pipeline.Item) error {
+		sunk++
+		return nil
+	})
+	stats, err := pipeline.New(pipeline.Bytes(fixtures.ReadFile(t, "valid_pacs_v08.xml")), sink).Run(context.Background())
+	require.Nil(t, err)
+	require.Equal(t, int64(1), stats.Read)
+	require.Equal(t, 1, sunk)
+	require.Len(t, published, 2)
+
+	// failing events abort the pipeline
+	failing := New(func(context.Context, Event) error { return errors.New("broker unavailable") }, "")
+	_, err = pipeline.New(pipeline.Bytes(fixtures.ReadFile(t, "valid_pacs_v08.xml")), failing.Sink(nil)).Run(context.Background())
+	require.ErrorContains(t, err, "broker unavailable")
+}
@@ -0,0 +1,194 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package exposure
+
+/*
+	Package exposure aggregates batches of payment messages into totals per currency pair
+	and value date, a snapshot of the foreign exchange exposure of the batch for treasury.
+*/
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/moov-io/iso20022/pkg/document"
+	"github.com/moov-io/iso20022/pkg/utils"
+)
+
+var (
+	// PaymentElements are the transaction elements of payments, other transactions (e.g.
+	// status reports of payments) are ignored
+	PaymentElements = []string{"CdtTrfTxInf", "DrctDbtTxInf", "Ntry"}
+
+	// csvHeader is the header row of WriteCSV
+	csvHeader = []string{"pair", "value_date", "transactions", "instructed_currency", "instructed_amount", "settlement_currency", "settlement_amount", "rate"}
+)
+
+// Total is the total of the transactions of a currency pair settled on a value date
+type Total struct {
+	// Pair is the instructed and settlement currency, e.g. USD/EUR
+	Pair      string
+	ValueDate string `json:",omitempty"`
+
+	Transactions int
+
+	InstructedCurrency string
+	InstructedAmount   float64
+	SettlementCurrency string
+	SettlementAmount   float64
+
+	// Rate is the average exchange rate, the settlement amount per unit of instructed amount
+	Rate float64
+}
+
+// Report is the exposure of a batch of messages
+type Report struct {
+	Documents    int
+	Transactions int
+	Totals       []Total
+}
+
+// Aggregate totals the gross amounts of the transactions of docs per currency pair and value date
+//
+// The instructed amount (InstdAmt) of a transaction is settled as its interbank settlement
+// amount (IntrBkSttlmAmt) or the amount of its entry, a transaction with one of them only
+// is settled in the same currency. The value date is the interbank settlement date, the
+// requested execution or collection date, or the value (else booking) date of an entry.
+// Totals are ordered by value date and pair.
+func Aggregate(docs ...document.Iso20022Document) *Report {
+	report := &Report{Documents: len(docs)}
+	totals := make(map[[2]string]*Total)
+
+	for _, doc := range docs {
+		for _, tx := range utils.GetTransactions(doc.InspectMessage()) {
+			if !isPayment(tx.Path) {
+				continue
+			}
+			instructed, instructedCcy := amount(tx, "InstdAmt", "Ntry/AmtDtls/InstdAmt/Amt")
+			settled, settledCcy := amount(tx, "IntrBkSttlmAmt", "Ntry/Amt")
+			switch {
+			case instructedCcy == "" && settledCcy == "":
+				continue
+			case instructedCcy == "":
+				instructed, instructedCcy = settled, settledCcy
+			case settledCcy == "":
+				settled, settledCcy = instructed, instructedCcy
+			}
+
+			pair := instructedCcy + "/" + settledCcy
+			key := [2]string{valueDate(tx), pair}
+			total, exists := totals[key]
+			if !exists {
+				total = &Total{
+					Pair:               pair,
+					ValueDate:          key[0],
+					InstructedCurrency: instructedCcy,
+					SettlementCurrency: settledCcy,
+				}
+				totals[key] = total
+			}
+			total.Transactions++
+			total.InstructedAmount += instructed
+			total.SettlementAmount += settled
+			report.Transactions++
+		}
+	}
+
+	for _, total := range totals {
+		total.InstructedAmount = round(total.InstructedAmount)
+		total.SettlementAmount = round(total.SettlementAmount)
+		if total.InstructedAmount != 0 {
+			total.Rate = round(total.SettlementAmount / total.InstructedAmount)
+		}
+		report.Totals = append(report.Totals, *total)
+	}
+	sort.Slice(report.Totals, func(i, j int) bool {
+		if report.Totals[i].ValueDate != report.Totals[j].ValueDate {
+			return report.Totals[i].ValueDate < report.Totals[j].ValueDate
+		}
+		return report.Totals[i].Pair < report.Totals[j].Pair
+	})
+	return report
+}
+
+// WriteJSON writes the report as indented json
+func (r *Report) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(r)
+}
+
+// WriteCSV writes the totals of the report as csv with a header row
+func (r *Report) WriteCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(csvHeader); err != nil {
+		return err
+	}
+	for _, total := range r.Totals {
+		record := []string{
+			total.Pair,
+			total.ValueDate,
+			strconv.Itoa(total.Transactions),
+			total.InstructedCurrency,
+			strconv.FormatFloat(total.InstructedAmount, 'f', -1, 64),
+			total.SettlementCurrency,
+			strconv.FormatFloat(total.SettlementAmount, 'f', -1, 64),
+			strconv.FormatFloat(total.Rate, 'f', -1, 64),
+		}
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// isPayment reports whether the transaction element at path is a payment
+func isPayment(path string) bool {
+	name := path[strings.LastIndex(path, "/")+1:]
+	if idx := strings.Index(name, "["); idx > 0 {
+		name = name[:idx]
+	}
+	for _, elm := range PaymentElements {
+		if name == elm {
+			return true
+		}
+	}
+	return false
+}
+
+// amount returns the first amount of tx with one of suffixes and its currency
+func amount(tx utils.Transaction, suffixes ...string) (float64, string) {
+	for _, suffix := range suffixes {
+		value := tx.Lookup(suffix)
+		if value == "" {
+			continue
+		}
+		f, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			continue
+		}
+		return f, tx.Lookup(suffix + "/@Ccy")
+	}
+	return 0, ""
+}
+
+// valueDate returns the date the transaction is settled on, yyyy-mm-dd
+func valueDate(tx utils.Transaction) string {
+	date := tx.Lookup("IntrBkSttlmDt", "ReqdExctnDt/Dt", "ReqdExctnDt/DtTm", "ReqdExctnDt", "ReqdColltnDt", "ValDt/Dt", "ValDt/DtTm", "BookgDt/Dt", "BookgDt/DtTm")
+	if idx := strings.Index(date, "T"); idx > 0 {
+		date = date[:idx]
+	}
+	return date
+}
+
+func round(amount float64) float64 {
+	return math.Round(amount*1e5) / 1e5
+}
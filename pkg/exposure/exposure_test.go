@@ -0,0 +1,64 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package exposure
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/moov-io/iso20022/pkg/document"
+	"github.com/moov-io/iso20022/pkg/iso20022test/fixtures"
+)
+
+func loadDocument(t *testing.T, name string, replacements ...string) document.Iso20022Document {
+	t.Helper()
+
+	buf := fixtures.ReadFile(t, name)
+	for i := 0; i+1 < len(replacements); i += 2 {
+		buf = bytes.Replace(buf, []byte(replacements[i]), []byte(replacements[i+1]), 1)
+	}
+	doc, err := document.ParseIso20022Document(buf)
+	require.Nil(t, err)
+	return doc
+}
+
+func TestAggregate(t *testing.T) {
+	fx := loadDocument(t, "charges_pacs_v08.xml",
+		`<InstdAmt Ccy="EUR">1000.00</InstdAmt>`, `<InstdAmt Ccy="USD">1200.00</InstdAmt><XchgRate>0.82083</XchgRate>`)
+
+	report := Aggregate(
+		fx,
+		loadDocument(t, "valid_pacs_v08.xml"),
+		loadDocument(t, "fednow_pacs_v08.xml"),
+		loadDocument(t, "charges_camt054_v08.xml"),
+	)
+	require.Equal(t, 4, report.Documents)
+	require.Equal(t, 6, report.Transactions)
+	require.Equal(t, []Total{
+		{Pair: "EUR/EUR", ValueDate: "2021-04-15", Transactions: 2, InstructedCurrency: "EUR", InstructedAmount: 1965, SettlementCurrency: "EUR", SettlementAmount: 1955, Rate: 0.99491},
+		{Pair: "USD/EUR", ValueDate: "2021-04-15", Transactions: 1, InstructedCurrency: "USD", InstructedAmount: 1200, SettlementCurrency: "EUR", SettlementAmount: 985, Rate: 0.82083},
+		{Pair: "USD/USD", ValueDate: "2021-04-15", Transactions: 3, InstructedCurrency: "USD", InstructedAmount: 253000.75, SettlementCurrency: "USD", SettlementAmount: 253000.75, Rate: 1},
+	}, report.Totals)
+
+	var buf bytes.Buffer
+	require.Nil(t, report.WriteCSV(&buf))
+	require.Equal(t, `pair,value_date,transactions,instructed_currency,instructed_amount,settlement_currency,settlement_amount,rate
+EUR/EUR,2021-04-15,2,EUR,1965,EUR,1955,0.99491
+USD/EUR,2021-04-15,1,USD,1200,EUR,985,0.82083
+USD/USD,2021-04-15,3,USD,253000.75,USD,253000.75,1
+`, buf.String())
+
+	buf.Reset()
+	require.Nil(t, report.WriteJSON(&buf))
+	var decoded Report
+	require.Nil(t, json.Unmarshal(buf.Bytes(), &decoded))
+	require.Equal(t, *report, decoded)
+
+	// status reports aren't payments
+	require.Empty(t, Aggregate(loadDocument(t, "valid_pacs_v11.xml")).Totals)
+}
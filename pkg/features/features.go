@@ -0,0 +1,165 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package features
+
+/*
+	Features are flags enabling experimental capabilities for some requests without affecting
+	the default behavior, e.g. for the requests of a tenant trying a new message version:
+
+		ctx = features.With(ctx, features.LenientNamespaces)
+		if features.Enabled(ctx, features.LenientNamespaces) {
+			opts = append(opts, service.WithLenientNamespaces())
+		}
+
+	Flags are disabled unless the context is from With. Embedders register the flags of their
+	own capabilities with Register, Check and Parse reject the flags that aren't registered.
+*/
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Flags of the experimental capabilities of the library
+const (
+	// LenientNamespaces accepts namespaces differing by case, whitespace or an omitted prefix,
+	// see service.WithLenientNamespaces
+	LenientNamespaces = "lenient-namespaces"
+
+	// StrictValidation rejects the elements the message model doesn't hold, see
+	// document.CheckUnknownElements
+	StrictValidation = "strict-validation"
+
+	// ExperimentalVersions accepts the message versions marked experimental, see
+	// document.MarkExperimental
+	ExperimentalVersions = "experimental-versions"
+
+	// ExperimentalProfiles accepts the profiles defined as experimental
+	ExperimentalProfiles = "experimental-profiles"
+)
+
+var (
+	// ErrUnknownFlag is returned for flags that aren't registered
+	ErrUnknownFlag = errors.New("unknown feature flag")
+
+	// ErrDisabled is returned by the capabilities of disabled flags
+	ErrDisabled = errors.New("feature is disabled")
+)
+
+// Flag is a registered feature flag
+type Flag struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+var (
+	flagsMu sync.RWMutex
+	flags   = map[string]string{
+		LenientNamespaces:    "Accept namespaces differing by case, whitespace or an omitted prefix",
+		StrictValidation:     "Reject the elements the message model doesn't hold",
+		ExperimentalVersions: "Accept the message versions marked experimental",
+		ExperimentalProfiles: "Accept the profiles defined as experimental",
+	}
+)
+
+// Register adds the flag name, replacing the description of a registered flag
+func Register(name, description string) {
+	flagsMu.Lock()
+	defer flagsMu.Unlock()
+	flags[name] = description
+}
+
+// Known reports whether name is a registered flag
+func Known(name string) bool {
+	flagsMu.RLock()
+	defer flagsMu.RUnlock()
+	_, known := flags[name]
+	return known
+}
+
+// Flags returns the registered flags in order of name
+func Flags() []Flag {
+	flagsMu.RLock()
+	defer flagsMu.RUnlock()
+	list := make([]Flag, 0, len(flags))
+	for name, description := range flags {
+		list = append(list, Flag{Name: name, Description: description})
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].Name < list[j].Name })
+	return list
+}
+
+// Check returns ErrUnknownFlag with the first of names that isn't registered
+func Check(names ...string) error {
+	for _, name := range names {
+		if !Known(name) {
+			return fmt.Errorf("%w: %s", ErrUnknownFlag, name)
+		}
+	}
+	return nil
+}
+
+// Parse reads a comma separated list of flags to enable, and to disable when prefixed with
+// a minus, e.g. "lenient-namespaces, -strict-validation"
+func Parse(value string) (enable, disable []string, err error) {
+	for _, item := range strings.Split(value, ",") {
+		name := strings.TrimSpace(item)
+		if name == "" {
+			continue
+		}
+		off := strings.HasPrefix(name, "-")
+		name = strings.TrimSpace(strings.TrimPrefix(name, "-"))
+		if err := Check(name); err != nil {
+			return nil, nil, err
+		}
+		if off {
+			disable = append(disable, name)
+		} else {
+			enable = append(enable, name)
+		}
+	}
+	return enable, disable, nil
+}
+
+type featuresKey struct{}
+
+// With returns a context whose flags are names, the flags of ctx are replaced
+func With(ctx context.Context, names ...string) context.Context {
+	set := make(map[string]bool, len(names))
+	for _, name := range names {
+		set[name] = true
+	}
+	return context.WithValue(ctx, featuresKey{}, set)
+}
+
+// Enabled reports whether the flag name is enabled in ctx
+func Enabled(ctx context.Context, name string) bool {
+	set, _ := ctx.Value(featuresKey{}).(map[string]bool)
+	return set[name]
+}
+
+// List returns the flags enabled in ctx in order
+func List(ctx context.Context) []string {
+	set, _ := ctx.Value(featuresKey{}).(map[string]bool)
+	names := make([]string, 0, len(set))
+	for name := range set {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Require returns ErrDisabled unless the flag name is enabled in ctx, what describes the
+// experimental capability in the error
+func Require(ctx context.Context, name, what string) error {
+	if Enabled(ctx, name) {
+		return nil
+	}
+	return fmt.Errorf("%s: %w: %s", what, ErrDisabled, name)
+}
@@ -0,0 +1,59 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package features
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestContext(t *testing.T) {
+	ctx := context.Background()
+	require.False(t, Enabled(ctx, LenientNamespaces))
+	require.Empty(t, List(ctx))
+	require.ErrorIs(t, Require(ctx, LenientNamespaces, "lenient namespaces"), ErrDisabled)
+
+	ctx = With(ctx, StrictValidation, LenientNamespaces)
+	require.True(t, Enabled(ctx, LenientNamespaces))
+	require.False(t, Enabled(ctx, ExperimentalVersions))
+	require.Equal(t, []string{LenientNamespaces, StrictValidation}, List(ctx))
+	require.Nil(t, Require(ctx, LenientNamespaces, "lenient namespaces"))
+
+	// the flags of a context are replaced
+	require.Equal(t, []string{ExperimentalProfiles}, List(With(ctx, ExperimentalProfiles)))
+}
+
+func TestParse(t *testing.T) {
+	enable, disable, err := Parse(" lenient-namespaces, -strict-validation,,- experimental-versions")
+	require.Nil(t, err)
+	require.Equal(t, []string{LenientNamespaces}, enable)
+	require.Equal(t, []string{StrictValidation, ExperimentalVersions}, disable)
+
+	_, _, err = Parse("lenient-namespaces, instant-replay")
+	require.ErrorIs(t, err, ErrUnknownFlag)
+	require.Contains(t, err.Error(), "instant-replay")
+}
+
+func TestRegister(t *testing.T) {
+	require.False(t, Known("instant-replay"))
+	require.ErrorIs(t, Check(LenientNamespaces, "instant-replay"), ErrUnknownFlag)
+
+	Register("instant-replay", "Replay rejected messages once they're corrected")
+	t.Cleanup(func() {
+		flagsMu.Lock()
+		delete(flags, "instant-replay")
+		flagsMu.Unlock()
+	})
+	require.True(t, Known("instant-replay"))
+	require.Nil(t, Check(LenientNamespaces, "instant-replay"))
+	require.Contains(t, Flags(), Flag{Name: "instant-replay", Description: "Replay rejected messages once they're corrected"})
+
+	names := Flags()
+	for i := 1; i < len(names); i++ {
+		require.Less(t, names[i-1].Name, names[i].Name)
+	}
+}
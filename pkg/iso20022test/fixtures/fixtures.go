@@ -0,0 +1,48 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package fixtures
+
+/*
+	Fixtures loads the documents of test/testdata in the tests of the packages of pkg and cmd,
+	whose directories are two levels below the root of the repository. It only depends on
+	pkg/document, so the tests of the packages iso20022test runs in its server load them too:
+
+		doc := fixtures.Document(t, "valid_pacs_v08.xml")
+		srv := iso20022test.NewServer(t, iso20022test.WithFixtureFiles(fixtures.Path("valid_pacs_v08.xml")))
+*/
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/moov-io/iso20022/pkg/document"
+)
+
+// Path returns the path of the file name of test/testdata, relative to the directory of the
+// package under test like the paths of the tests of pkg/<name> and cmd/<name>
+func Path(name string) string {
+	return filepath.Join("..", "..", "test", "testdata", name)
+}
+
+// ReadFile returns the content of the file name of test/testdata, failing t when it can't be read
+func ReadFile(t testing.TB, name string) []byte {
+	t.Helper()
+	buf, err := os.ReadFile(Path(name))
+	if err != nil {
+		t.Fatalf("reading fixture: %v", err)
+	}
+	return buf
+}
+
+// Document parses the file name of test/testdata, failing t when it isn't a document
+func Document(t testing.TB, name string) document.Iso20022Document {
+	t.Helper()
+	doc, err := document.ParseIso20022Document(ReadFile(t, name))
+	if err != nil {
+		t.Fatalf("parsing fixture %s: %v", name, err)
+	}
+	return doc
+}
@@ -0,0 +1,162 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package iso20022test
+
+/*
+	Iso20022test runs the iso20022 server in-process for integration tests of programs calling
+	its api. Servers store their documents in a temporary directory and are closed with the test:
+
+		srv := iso20022test.NewServer(t, iso20022test.WithFixtureFiles("testdata/pacs008.xml"))
+		resp, err := http.Get(srv.URL + "/documents/" + srv.Fixtures["pacs008.xml"])
+*/
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/moov-io/base/log"
+
+	"github.com/moov-io/iso20022/pkg/client"
+	"github.com/moov-io/iso20022/pkg/server"
+	"github.com/moov-io/iso20022/pkg/service"
+	"github.com/moov-io/iso20022/pkg/storage"
+	"github.com/moov-io/iso20022/pkg/utils"
+)
+
+// Server is an iso20022 server running in-process
+type Server struct {
+	*httptest.Server
+
+	// Documents holds the stored documents of the server
+	Documents storage.Store
+
+	// Fixtures are the ids of the preloaded documents by fixture name
+	Fixtures map[string]string
+
+	// Environment of the server, e.g. to inspect its configuration
+	Environment *server.Environment
+}
+
+type fixture struct {
+	name    string
+	content []byte
+}
+
+type options struct {
+	api      server.APIConfig
+	logger   log.Logger
+	fixtures []fixture
+	files    []string
+}
+
+// Option configures a test server
+type Option func(*options)
+
+// WithAPIConfig configures the api of the server, its storage directory is replaced by a temporary one
+func WithAPIConfig(config server.APIConfig) Option {
+	return func(o *options) {
+		o.api = config
+	}
+}
+
+// WithLogger logs the server to logger, servers don't log by default
+func WithLogger(logger log.Logger) Option {
+	return func(o *options) {
+		o.logger = logger
+	}
+}
+
+// WithFixture preloads the document content as the fixture name
+func WithFixture(name string, content []byte) Option {
+	return func(o *options) {
+		o.fixtures = append(o.fixtures, fixture{name: name, content: content})
+	}
+}
+
+// WithFixtureFiles preloads the documents of files, fixtures are named by the base name of their file
+func WithFixtureFiles(paths ...string) Option {
+	return func(o *options) {
+		o.files = append(o.files, paths...)
+	}
+}
+
+// NewServer starts a server closed by the cleanup of t, failing t when the server or its fixtures can't be set up
+func NewServer(t testing.TB, opts ...Option) *Server {
+	t.Helper()
+
+	o := options{logger: log.NewNopLogger()}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	for _, path := range o.files {
+		content, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatalf("reading fixture: %v", err)
+		}
+		o.fixtures = append(o.fixtures, fixture{name: filepath.Base(path), content: content})
+	}
+
+	api := o.api
+	api.Storage.Directory = t.TempDir()
+	env, err := server.NewEnvironment(&server.Environment{
+		Logger: o.logger,
+		Config: &server.Config{API: api},
+	})
+	if err != nil {
+		t.Fatalf("starting iso20022 server: %v", err)
+	}
+	t.Cleanup(env.Shutdown)
+
+	srv := &Server{
+		Server:      httptest.NewServer(env.PublicRouter),
+		Documents:   env.Documents,
+		Fixtures:    make(map[string]string),
+		Environment: env,
+	}
+	t.Cleanup(srv.Close)
+
+	for i, f := range o.fixtures {
+		id, err := srv.store(f.content, i)
+		if err != nil {
+			t.Fatalf("loading fixture %s: %v", f.name, err)
+		}
+		srv.Fixtures[f.name] = id
+	}
+	return srv
+}
+
+// store keeps content like POST /documents with the fixture id fixture-<index>
+func (s *Server) store(content []byte, index int) (string, error) {
+	doc, err := service.Parse(bytes.NewReader(content))
+	if err != nil {
+		return "", err
+	}
+	now := time.Now().UTC()
+	rec := storage.Record{
+		ID:          fmt.Sprintf("fixture-%d", index),
+		Kind:        storage.KindOriginal,
+		MessageType: utils.GetMessageType(doc.NameSpace()),
+		Format:      utils.GetDocumentFormat(content),
+		Content:     content,
+		Created:     now,
+		Updated:     now,
+	}
+	return rec.ID, s.Documents.Put(context.Background(), rec)
+}
+
+// Client returns an api client of the server
+func (s *Server) Client() *client.APIClient {
+	cfg := client.NewConfiguration()
+	cfg.BasePath = s.URL
+	cfg.Servers = []client.ServerConfiguration{{Url: s.URL, Description: "iso20022test"}}
+	cfg.HTTPClient = s.Server.Client()
+	return client.NewAPIClient(cfg)
+}
@@ -0,0 +1,66 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package iso20022test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/moov-io/iso20022/pkg/iso20022test/fixtures"
+	"github.com/moov-io/iso20022/pkg/server"
+)
+
+func TestNewServer(t *testing.T) {
+	json := fixtures.ReadFile(t, "valid_acmt_v03.json")
+
+	srv := NewServer(t,
+		WithFixtureFiles(fixtures.Path("valid_pacs_v08.xml")),
+		WithFixture("acmt", json),
+		WithAPIConfig(server.APIConfig{Envelope: true}),
+	)
+	require.Len(t, srv.Fixtures, 2)
+
+	resp, err := http.Get(srv.URL + "/v1/documents/" + srv.Fixtures["valid_pacs_v08.xml"])
+	require.Nil(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	body, err := io.ReadAll(resp.Body)
+	require.Nil(t, err)
+	require.Contains(t, string(body), "MSG-20210415-0001")
+
+	rec, err := srv.Documents.Get(context.Background(), srv.Fixtures["acmt"])
+	require.Nil(t, err)
+	require.Equal(t, "acmt.007.001.03", rec.MessageType)
+	require.True(t, srv.Environment.Config.API.Envelope)
+
+	// the generated client calls the server
+	success, _, err := srv.Client().Iso20022MessageApi.Health(context.Background())
+	require.Nil(t, err)
+	require.NotNil(t, success)
+}
+
+func TestNewServerWithInvalidFixture(t *testing.T) {
+	tb := &fatalRecorder{TB: t}
+	func() {
+		defer func() { recover() }()
+		NewServer(tb, WithFixture("broken", []byte("not a document")))
+	}()
+	require.True(t, tb.failed)
+}
+
+// fatalRecorder records Fatalf, which stops NewServer with a panic
+type fatalRecorder struct {
+	testing.TB
+	failed bool
+}
+
+func (r *fatalRecorder) Fatalf(format string, args ...interface{}) {
+	r.failed = true
+	panic("fatal")
+}
@@ -0,0 +1,510 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package jetstream
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/tls"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	defaultPort = "4222"
+	dialTimeout = 10 * time.Second
+
+	// maxControlLine bounds the protocol lines read from the server
+	maxControlLine = 4096
+)
+
+var (
+	// ErrClosed is returned by the operations of a closed connection
+	ErrClosed = errors.New("nats: connection closed")
+
+	// ErrNoResponders is returned by requests of subjects no service listens to, e.g. the
+	// subject of a stream that doesn't exist
+	ErrNoResponders = errors.New("nats: no responders")
+)
+
+// Header are the headers of a message, keys are case sensitive
+type Header map[string][]string
+
+// Get returns the first value of key
+func (h Header) Get(key string) string {
+	if values := h[key]; len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
+
+// Set replaces the values of key by value
+func (h Header) Set(key, value string) {
+	h[key] = []string{value}
+}
+
+// Msg is a message received by a subscription
+type Msg struct {
+	Subject string
+	Reply   string
+	Header  Header
+	Data    []byte
+
+	// Status and Description are the status of control messages, e.g. 404 No Messages
+	Status      string
+	Description string
+
+	conn *Conn
+}
+
+// Conn is a connection to a NATS server speaking the core client protocol
+type Conn struct {
+	conn   net.Conn
+	reader *bufio.Reader
+
+	wmu    sync.Mutex
+	writer *bufio.Writer
+
+	mu      sync.Mutex
+	inbox   string
+	sid     uint64
+	subs    map[uint64]chan *Msg
+	muxSID  uint64
+	replies map[string]chan *Msg
+	pongs   []chan struct{}
+	err     error
+	closed  chan struct{}
+}
+
+// serverInfo is the INFO of the server
+type serverInfo struct {
+	Headers     bool `json:"headers"`
+	AuthMissing bool `json:"auth_required"`
+	TLSRequired bool `json:"tls_required"`
+}
+
+// Dial connects to the NATS server of rawURL, nats://[user:password@|token@]host[:port].
+// tls:// connects with TLS.
+func Dial(ctx context.Context, rawURL string) (*Conn, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, fmt.Errorf("nats: %w", err)
+	}
+	if u.Scheme != "nats" && u.Scheme != "tls" {
+		return nil, fmt.Errorf("nats: unsupported scheme %q", u.Scheme)
+	}
+	address := u.Host
+	if u.Port() == "" {
+		address = net.JoinHostPort(u.Hostname(), defaultPort)
+	}
+
+	dialer := &net.Dialer{Timeout: dialTimeout}
+	raw, err := dialer.DialContext(ctx, "tcp", address)
+	if err != nil {
+		return nil, fmt.Errorf("nats: %w", err)
+	}
+	c := &Conn{
+		conn:    raw,
+		reader:  bufio.NewReader(raw),
+		writer:  bufio.NewWriter(raw),
+		inbox:   "_INBOX." + randomToken(),
+		subs:    make(map[uint64]chan *Msg),
+		replies: make(map[string]chan *Msg),
+		closed:  make(chan struct{}),
+	}
+	if err := c.handshake(ctx, u); err != nil {
+		raw.Close()
+		return nil, err
+	}
+	go c.readLoop()
+
+	if c.muxSID, err = c.subscribe(c.inbox+".*", nil); err != nil {
+		c.Close()
+		return nil, err
+	}
+	if err := c.Flush(ctx); err != nil {
+		c.Close()
+		return nil, err
+	}
+	return c, nil
+}
+
+// handshake reads the INFO of the server and sends the CONNECT of the client
+func (c *Conn) handshake(ctx context.Context, u *url.URL) error {
+	if deadline, ok := ctx.Deadline(); ok {
+		c.conn.SetDeadline(deadline)
+	} else {
+		c.conn.SetDeadline(time.Now().Add(dialTimeout))
+	}
+	defer c.conn.SetDeadline(time.Time{})
+
+	line, err := c.readLine()
+	if err != nil {
+		return fmt.Errorf("nats: reading server info: %w", err)
+	}
+	if !strings.HasPrefix(line, "INFO ") {
+		return fmt.Errorf("nats: unexpected server greeting %q", line)
+	}
+	var info serverInfo
+	if err := json.Unmarshal([]byte(strings.TrimPrefix(line, "INFO ")), &info); err != nil {
+		return fmt.Errorf("nats: reading server info: %w", err)
+	}
+	if !info.Headers {
+		return errors.New("nats: server doesn't support headers")
+	}
+
+	if u.Scheme == "tls" || info.TLSRequired {
+		conn := tls.Client(c.conn, &tls.Config{ServerName: u.Hostname(), MinVersion: tls.VersionTLS12})
+		if err := conn.HandshakeContext(ctx); err != nil {
+			return fmt.Errorf("nats: %w", err)
+		}
+		c.conn, c.reader, c.writer = conn, bufio.NewReader(conn), bufio.NewWriter(conn)
+	}
+
+	options := map[string]interface{}{
+		"verbose":       false,
+		"pedantic":      false,
+		"headers":       true,
+		"no_responders": true,
+		"protocol":      1,
+		"lang":          "go",
+		"name":          "moov-io/iso20022",
+	}
+	if u.User != nil {
+		if password, ok := u.User.Password(); ok {
+			options["user"], options["pass"] = u.User.Username(), password
+		} else {
+			options["auth_token"] = u.User.Username()
+		}
+	}
+	connect, err := json.Marshal(options)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(c.writer, "CONNECT %s\r\nPING\r\n", connect)
+	if err := c.writer.Flush(); err != nil {
+		return fmt.Errorf("nats: %w", err)
+	}
+
+	for {
+		line, err := c.readLine()
+		if err != nil {
+			return fmt.Errorf("nats: connecting: %w", err)
+		}
+		switch {
+		case line == "PONG":
+			return nil
+		case strings.HasPrefix(line, "-ERR"):
+			return protocolError(line)
+		}
+	}
+}
+
+func (c *Conn) readLine() (string, error) {
+	var line []byte
+	for {
+		chunk, isPrefix, err := c.reader.ReadLine()
+		if err != nil {
+			return "", err
+		}
+		line = append(line, chunk...)
+		if len(line) > maxControlLine {
+			return "", errors.New("protocol line is too long")
+		}
+		if !isPrefix {
+			return string(line), nil
+		}
+	}
+}
+
+func protocolError(line string) error {
+	return fmt.Errorf("nats: %s", strings.Trim(strings.TrimSpace(strings.TrimPrefix(line, "-ERR")), "'"))
+}
+
+// readLoop dispatches the messages of the server until the connection fails or is closed
+func (c *Conn) readLoop() {
+	var err error
+	for err == nil {
+		var line string
+		if line, err = c.readLine(); err != nil {
+			break
+		}
+		switch {
+		case strings.HasPrefix(line, "MSG "):
+			err = c.readMsg(strings.Fields(line)[1:], false)
+		case strings.HasPrefix(line, "HMSG "):
+			err = c.readMsg(strings.Fields(line)[1:], true)
+		case line == "PING":
+			err = c.write("PONG\r\n", nil)
+		case line == "PONG":
+			c.mu.Lock()
+			if len(c.pongs) > 0 {
+				close(c.pongs[0])
+				c.pongs = c.pongs[1:]
+			}
+			c.mu.Unlock()
+		case strings.HasPrefix(line, "-ERR"):
+			err = protocolError(line)
+		}
+	}
+	c.fail(err)
+}
+
+// readMsg reads the payload of a MSG (subject sid [reply] size) or HMSG (subject sid [reply]
+// header-size size) and routes it to the channel of its subscription, or of its reply token
+// for the responses of requests
+func (c *Conn) readMsg(args []string, headers bool) error {
+	sizes := 1
+	if headers {
+		sizes = 2
+	}
+	if len(args) != 2+sizes && len(args) != 3+sizes {
+		return fmt.Errorf("nats: malformed message arguments %v", args)
+	}
+	msg := &Msg{Subject: args[0], conn: c}
+	if len(args) == 3+sizes {
+		msg.Reply = args[2]
+	}
+	total, err := strconv.Atoi(args[len(args)-1])
+	if err != nil {
+		return fmt.Errorf("nats: malformed message size: %w", err)
+	}
+	headerSize := 0
+	if headers {
+		if headerSize, err = strconv.Atoi(args[len(args)-2]); err != nil || headerSize > total {
+			return fmt.Errorf("nats: malformed header size %s", args[len(args)-2])
+		}
+	}
+
+	payload := make([]byte, total+2)
+	if _, err := io.ReadFull(c.reader, payload); err != nil {
+		return err
+	}
+	if headers {
+		if err := msg.parseHeader(payload[:headerSize]); err != nil {
+			return err
+		}
+	}
+	msg.Data = payload[headerSize:total]
+
+	sid, err := strconv.ParseUint(args[1], 10, 64)
+	if err != nil {
+		return fmt.Errorf("nats: malformed subscription id %s", args[1])
+	}
+	c.mu.Lock()
+	ch := c.subs[sid]
+	if sid == c.muxSID {
+		ch = c.replies[strings.TrimPrefix(msg.Subject, c.inbox+".")]
+	}
+	c.mu.Unlock()
+	if ch != nil {
+		select {
+		case ch <- msg:
+		default:
+			// replies nobody waits for anymore are dropped
+		}
+	}
+	return nil
+}
+
+// parseHeader parses headers, NATS/1.0[ status[ description]] followed by MIME headers
+func (m *Msg) parseHeader(raw []byte) error {
+	lines := strings.Split(strings.TrimRight(string(raw), "\r\n"), "\r\n")
+	if len(lines) == 0 || !strings.HasPrefix(lines[0], "NATS/1.0") {
+		return errors.New("nats: malformed message headers")
+	}
+	if status := strings.TrimSpace(strings.TrimPrefix(lines[0], "NATS/1.0")); status != "" {
+		m.Status, m.Description, _ = strings.Cut(status, " ")
+	}
+	m.Header = make(Header)
+	for _, line := range lines[1:] {
+		key, value, ok := strings.Cut(line, ":")
+		if !ok {
+			continue
+		}
+		key = strings.TrimSpace(key)
+		m.Header[key] = append(m.Header[key], strings.TrimSpace(value))
+	}
+	return nil
+}
+
+func (c *Conn) fail(err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.err != nil {
+		return
+	}
+	if err == nil || errors.Is(err, net.ErrClosed) || errors.Is(err, io.EOF) {
+		err = ErrClosed
+	}
+	c.err = err
+	close(c.closed)
+	c.conn.Close()
+}
+
+// Close closes the connection
+func (c *Conn) Close() error {
+	c.fail(ErrClosed)
+	return nil
+}
+
+func (c *Conn) write(line string, payload []byte) error {
+	c.wmu.Lock()
+	defer c.wmu.Unlock()
+	c.mu.Lock()
+	err := c.err
+	c.mu.Unlock()
+	if err != nil {
+		return err
+	}
+	c.writer.WriteString(line)
+	if payload != nil {
+		c.writer.Write(payload)
+		c.writer.WriteString("\r\n")
+	}
+	return c.writer.Flush()
+}
+
+// subscribe subscribes to subject, routing its messages to ch when not nil
+func (c *Conn) subscribe(subject string, ch chan *Msg) (uint64, error) {
+	c.mu.Lock()
+	c.sid++
+	sid := c.sid
+	if ch != nil {
+		c.subs[sid] = ch
+	}
+	c.mu.Unlock()
+	return sid, c.write(fmt.Sprintf("SUB %s %d\r\n", subject, sid), nil)
+}
+
+// inboxSubscription subscribes to a new inbox receiving up to size messages on the returned
+// channel until the returned function is called. Unlike the responses of requests, the
+// messages of the inbox keep their subject, e.g. the messages pulled from a consumer.
+func (c *Conn) inboxSubscription(size int) (string, <-chan *Msg, func(), error) {
+	inbox := "_INBOX." + randomToken()
+	ch := make(chan *Msg, size)
+	sid, err := c.subscribe(inbox, ch)
+	done := func() {
+		c.mu.Lock()
+		delete(c.subs, sid)
+		c.mu.Unlock()
+		c.write(fmt.Sprintf("UNSUB %d\r\n", sid), nil)
+	}
+	if err != nil {
+		done()
+		return "", nil, nil, err
+	}
+	return inbox, ch, done, nil
+}
+
+// Publish sends data with header, if not nil, to subject. reply is the subject of the
+// responses, empty when no response is expected.
+func (c *Conn) Publish(subject, reply string, header Header, data []byte) error {
+	if strings.ContainsAny(subject, " \t\r\n") || subject == "" {
+		return fmt.Errorf("nats: invalid subject %q", subject)
+	}
+	if reply != "" {
+		subject += " " + reply
+	}
+	if header == nil {
+		return c.write(fmt.Sprintf("PUB %s %d\r\n", subject, len(data)), data)
+	}
+	raw := encodeHeader(header)
+	return c.write(fmt.Sprintf("HPUB %s %d %d\r\n", subject, len(raw), len(raw)+len(data)), append(raw, data...))
+}
+
+func encodeHeader(header Header) []byte {
+	var buf bytes.Buffer
+	buf.WriteString("NATS/1.0\r\n")
+	for key, values := range header {
+		for _, value := range values {
+			if strings.ContainsAny(key+value, "\r\n") || strings.Contains(key, ":") {
+				continue
+			}
+			fmt.Fprintf(&buf, "%s: %s\r\n", key, value)
+		}
+	}
+	buf.WriteString("\r\n")
+	return buf.Bytes()
+}
+
+// replyInbox returns a subject receiving up to size messages on the returned channel until
+// the returned function is called
+func (c *Conn) replyInbox(size int) (string, <-chan *Msg, func()) {
+	token := randomToken()
+	ch := make(chan *Msg, size)
+	c.mu.Lock()
+	c.replies[token] = ch
+	c.mu.Unlock()
+	return c.inbox + "." + token, ch, func() {
+		c.mu.Lock()
+		delete(c.replies, token)
+		c.mu.Unlock()
+	}
+}
+
+// Request publishes data to subject and returns the first response
+func (c *Conn) Request(ctx context.Context, subject string, header Header, data []byte) (*Msg, error) {
+	reply, ch, done := c.replyInbox(1)
+	defer done()
+	if err := c.Publish(subject, reply, header, data); err != nil {
+		return nil, err
+	}
+	select {
+	case msg := <-ch:
+		if msg.Status == "503" {
+			return nil, fmt.Errorf("%w: %s", ErrNoResponders, subject)
+		}
+		return msg, nil
+	case <-c.closed:
+		return nil, c.closedErr()
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// Flush waits until the server processed the messages published before
+func (c *Conn) Flush(ctx context.Context) error {
+	pong := make(chan struct{})
+	c.mu.Lock()
+	c.pongs = append(c.pongs, pong)
+	c.mu.Unlock()
+	if err := c.write("PING\r\n", nil); err != nil {
+		return err
+	}
+	select {
+	case <-pong:
+		return nil
+	case <-c.closed:
+		return c.closedErr()
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (c *Conn) closedErr() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.err
+}
+
+func randomToken() string {
+	buf := make([]byte, 12)
+	if _, err := rand.Read(buf); err != nil {
+		return strconv.FormatInt(time.Now().UnixNano(), 36)
+	}
+	return hex.EncodeToString(buf)
+}
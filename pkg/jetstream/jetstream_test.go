@@ -0,0 +1,195 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package jetstream
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/moov-io/iso20022/pkg/document"
+	"github.com/moov-io/iso20022/pkg/iso20022test/fixtures"
+	"github.com/moov-io/iso20022/pkg/pipeline"
+)
+
+func newTestJetStream(t *testing.T, s *fakeServer) *JetStream {
+	t.Helper()
+	ctx := context.Background()
+	conn, err := Dial(ctx, s.url())
+	require.Nil(t, err)
+	t.Cleanup(func() { conn.Close() })
+
+	js := New(conn)
+	require.Nil(t, js.EnsureStream(ctx, StreamConfig{Name: "ISO20022", Subjects: []string{"iso20022.>"}}))
+	return js
+}
+
+func TestDial(t *testing.T) {
+	ctx := context.Background()
+	s := newFakeServer(t)
+	s.password = "secret"
+
+	conn, err := Dial(ctx, s.url())
+	require.Nil(t, err)
+	require.Nil(t, conn.Flush(ctx))
+	require.Nil(t, conn.Close())
+	require.ErrorIs(t, conn.Flush(ctx), ErrClosed)
+
+	s.password = "other"
+	_, err = Dial(ctx, "nats://moov:secret@"+s.listener.Addr().String())
+	require.ErrorContains(t, err, "Authorization Violation")
+
+	_, err = Dial(ctx, "http://"+s.listener.Addr().String())
+	require.ErrorContains(t, err, "unsupported scheme")
+}
+
+func TestPublishAndFetch(t *testing.T) {
+	ctx := context.Background()
+	s := newFakeServer(t)
+	js := newTestJetStream(t, s)
+
+	// streams are created once
+	require.Nil(t, js.EnsureStream(ctx, StreamConfig{Name: "ISO20022", Subjects: []string{"iso20022.>"}}))
+
+	header := Header{}
+	header.Set(HeaderMsgID, "msg-1")
+	ack, err := js.Publish(ctx, "iso20022.inbound", header, []byte("<Document/>"))
+	require.Nil(t, err)
+	require.Equal(t, PubAck{Stream: "ISO20022", Sequence: 1}, ack)
+
+	ack, err = js.Publish(ctx, "iso20022.inbound", header, []byte("<Document/>"))
+	require.Nil(t, err)
+	require.True(t, ack.Duplicate)
+
+	_, err = js.Publish(ctx, "payments.inbound", nil, []byte("<Document/>"))
+	require.ErrorIs(t, err, ErrNoResponders)
+
+	consumer, err := js.Consumer(ctx, "ISO20022", ConsumerConfig{Durable: "processor", FilterSubject: "iso20022.inbound"})
+	require.Nil(t, err)
+
+	msgs, err := consumer.Fetch(ctx, 10, time.Second)
+	require.Nil(t, err)
+	require.Len(t, msgs, 1)
+	require.Equal(t, "iso20022.inbound", msgs[0].Subject)
+	require.Equal(t, "msg-1", msgs[0].Header.Get(HeaderMsgID))
+	require.Equal(t, []byte("<Document/>"), msgs[0].Data)
+
+	meta, err := msgs[0].Metadata()
+	require.Nil(t, err)
+	require.Equal(t, Metadata{Stream: "ISO20022", Consumer: "processor", Delivered: 1, Sequence: 1}, meta)
+
+	// delivered messages aren't fetched again before their ack wait passed
+	_, err = consumer.Fetch(ctx, 10, time.Second)
+	require.ErrorIs(t, err, ErrNoMessages)
+
+	require.Nil(t, msgs[0].Nak())
+	require.Nil(t, js.conn.Flush(ctx))
+	msgs, err = consumer.Fetch(ctx, 10, time.Second)
+	require.Nil(t, err)
+	meta, err = msgs[0].Metadata()
+	require.Nil(t, err)
+	require.Equal(t, uint64(2), meta.Delivered)
+
+	require.Nil(t, msgs[0].Ack())
+	require.Nil(t, js.conn.Flush(ctx))
+	require.Equal(t, map[string]string{"iso20022.inbound/1": "acked"}, s.messages("ISO20022", "processor"))
+
+	_, err = js.Consumer(ctx, "PAYMENTS", ConsumerConfig{Durable: "processor"})
+	require.ErrorContains(t, err, "stream not found")
+	_, err = js.Consumer(ctx, "ISO20022", ConsumerConfig{})
+	require.ErrorContains(t, err, "durable name is required")
+}
+
+func TestMetadata(t *testing.T) {
+	meta, err := (&Msg{Reply: "$JS.ACK.ISO20022.processor.3.42.40.1618482600000000000.7"}).Metadata()
+	require.Nil(t, err)
+	require.Equal(t, Metadata{Stream: "ISO20022", Consumer: "processor", Delivered: 3, Sequence: 42}, meta)
+
+	// subjects of servers of domains
+	meta, err = (&Msg{Reply: "$JS.ACK.hub.ACCOUNT.ISO20022.processor.1.43.41.1618482600000000000.0.token"}).Metadata()
+	require.Nil(t, err)
+	require.Equal(t, Metadata{Stream: "ISO20022", Consumer: "processor", Delivered: 1, Sequence: 43}, meta)
+
+	_, err = (&Msg{Reply: "_INBOX.abc"}).Metadata()
+	require.Error(t, err)
+}
+
+func TestSubject(t *testing.T) {
+	doc, err := document.ParseIso20022Document(fixtures.ReadFile(t, "valid_pacs_v08.xml"))
+	require.Nil(t, err)
+	require.Equal(t, "iso20022.processed.pacs.008.001.08", Subject("iso20022.processed", pipeline.Item{Document: doc}))
+	require.Equal(t, "iso20022.processed.unknown", Subject("iso20022.processed", pipeline.Item{}))
+}
+
+func TestPipeline(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	s := newFakeServer(t)
+	js := newTestJetStream(t, s)
+
+	inputs := map[string][]byte{
+		"valid":   fixtures.ReadFile(t, "valid_pacs_v08.xml"),
+		"invalid": []byte("<Document><Unknown/></Document>"),
+		"failing": fixtures.ReadFile(t, "valid_camt053_v08.xml"),
+	}
+	for _, name := range []string{"valid", "invalid", "failing"} {
+		header := Header{}
+		header.Set("x-input", name)
+		_, err := js.Publish(ctx, "iso20022.inbound", header, inputs[name])
+		require.Nil(t, err)
+	}
+
+	consumer, err := js.Consumer(ctx, "ISO20022", ConsumerConfig{Durable: "processor", FilterSubject: "iso20022.inbound"})
+	require.Nil(t, err)
+
+	var done atomic.Int64
+	finish := func() {
+		if done.Add(1) == 3 {
+			js.conn.Flush(ctx)
+			cancel()
+		}
+	}
+	publish := js.Sink("iso20022.processed", nil)
+	sink := consumer.Sink(func(ctx context.Context, item pipeline.Item) error {
+		defer finish()
+		if item.Headers["x-input"] == "failing" {
+			return errors.New("ledger unavailable")
+		}
+		return publish(ctx, item)
+	})
+	var failed []string
+	errorSink := consumer.ErrorSink(func(_ context.Context, item pipeline.Item) error {
+		defer finish()
+		failed = append(failed, item.Name)
+		return nil
+	})
+
+	_, err = pipeline.New(consumer.Source(), sink, pipeline.WithWorkers(1), pipeline.WithErrorSink(errorSink)).Run(ctx)
+	require.ErrorContains(t, err, "ledger unavailable")
+	require.Equal(t, []string{"ISO20022/2"}, failed)
+
+	require.Nil(t, js.conn.Flush(context.Background()))
+	require.Equal(t, map[string]string{
+		"iso20022.inbound/1":                   "acked",
+		"iso20022.inbound/2":                   "terminated",
+		"iso20022.inbound/3":                   "pending",
+		"iso20022.processed.pacs.008.001.08/4": "pending",
+	}, s.messages("ISO20022", "processor"))
+
+	// processed messages keep the headers of their input
+	auditor, err := js.Consumer(context.Background(), "ISO20022", ConsumerConfig{Durable: "auditor", FilterSubject: "iso20022.processed.>"})
+	require.Nil(t, err)
+	msgs, err := auditor.Fetch(context.Background(), 10, time.Second)
+	require.Nil(t, err)
+	require.Len(t, msgs, 1)
+	require.Equal(t, "valid", msgs[0].Header.Get("x-input"))
+	require.Len(t, msgs[0].Header.Get(HeaderMsgID), 64)
+	require.Equal(t, "URGENT", msgs[0].Header.Get(HeaderPriority))
+	require.Equal(t, inputs["valid"], msgs[0].Data)
+}
@@ -0,0 +1,152 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package jetstream
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/moov-io/iso20022/pkg/pipeline"
+	"github.com/moov-io/iso20022/pkg/priority"
+	"github.com/moov-io/iso20022/pkg/utils"
+)
+
+const (
+	// HeaderSubject and HeaderReply are the headers of the items emitted by a consumer source,
+	// the subject of the message and the subject acknowledging it
+	HeaderSubject = "x-nats-subject"
+	HeaderReply   = "x-nats-reply"
+
+	// HeaderPriority is the header of the processing priority of the published messages of
+	// documents, e.g. URGENT
+	HeaderPriority = "Iso20022-Priority"
+
+	// SubjectUnknown is the last token of the subject of items without a document
+	SubjectUnknown = "unknown"
+
+	defaultBatch = 16
+	defaultWait  = 5 * time.Second
+)
+
+// Subject returns the subject of item under prefix, <prefix>.<message type>
+func Subject(prefix string, item pipeline.Item) string {
+	kind := SubjectUnknown
+	if item.Document != nil {
+		if t := utils.GetMessageType(item.Document.NameSpace()); t != "" {
+			kind = t
+		}
+	}
+	return prefix + "." + kind
+}
+
+// Source emits the messages of the consumer as they're delivered until ctx is done, named
+// <stream>/<sequence>. Messages are pulled in batches of 16.
+func (c *Consumer) Source() pipeline.Source {
+	return func(ctx context.Context, emit func(pipeline.Item) error) error {
+		for ctx.Err() == nil {
+			err := c.pull(ctx, c.batch, c.wait, func(msg *Msg) error {
+				if err := emit(c.item(msg)); err != nil {
+					msg.Nak()
+					return err
+				}
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+		}
+		return ctx.Err()
+	}
+}
+
+func (c *Consumer) item(msg *Msg) pipeline.Item {
+	headers := map[string]string{
+		HeaderSubject: msg.Subject,
+		HeaderReply:   msg.Reply,
+	}
+	for key, values := range msg.Header {
+		if len(values) > 0 && headers[key] == "" {
+			headers[key] = values[0]
+		}
+	}
+	name := msg.Subject
+	if meta, err := msg.Metadata(); err == nil {
+		name = fmt.Sprintf("%s/%d", meta.Stream, meta.Sequence)
+	}
+	return pipeline.Item{Name: name, Input: msg.Data, Headers: headers}
+}
+
+// reply returns the message acknowledged by the reply header of item
+func (c *Consumer) reply(item pipeline.Item) (*Msg, error) {
+	reply := item.Headers[HeaderReply]
+	if reply == "" {
+		return nil, fmt.Errorf("jetstream: item %s wasn't read by a consumer", item.Name)
+	}
+	return &Msg{Reply: reply, conn: c.js.conn}, nil
+}
+
+// Sink acknowledges the items processed by sink, items failing sink are redelivered
+func (c *Consumer) Sink(sink pipeline.Sink) pipeline.Sink {
+	return func(ctx context.Context, item pipeline.Item) error {
+		msg, err := c.reply(item)
+		if err != nil {
+			return err
+		}
+		if err := sink(ctx, item); err != nil {
+			msg.Nak()
+			return err
+		}
+		return msg.Ack()
+	}
+}
+
+// ErrorSink routes the failed items to sink, if not nil, and terminates them. Items failing
+// sink are redelivered.
+func (c *Consumer) ErrorSink(sink pipeline.Sink) pipeline.Sink {
+	return func(ctx context.Context, item pipeline.Item) error {
+		msg, err := c.reply(item)
+		if err != nil {
+			return err
+		}
+		if sink != nil {
+			if err := sink(ctx, item); err != nil {
+				msg.Nak()
+				return err
+			}
+		}
+		return msg.Term()
+	}
+}
+
+// Sink publishes the input of the processed items to the subject of their message type under
+// prefix and routes them to sink, if not nil. The headers of items are published with them,
+// but the ones of consumer sources, and the priority of their document with HeaderPriority.
+func (js *JetStream) Sink(prefix string, sink pipeline.Sink) pipeline.Sink {
+	return func(ctx context.Context, item pipeline.Item) error {
+		header := Header{}
+		for key, value := range item.Headers {
+			if !strings.HasPrefix(key, "x-nats-") && key != HeaderMsgID {
+				header.Set(key, value)
+			}
+		}
+		sum := sha256.Sum256(item.Input)
+		header.Set(HeaderMsgID, hex.EncodeToString(sum[:]))
+		if item.Document != nil {
+			header.Set(HeaderPriority, string(priority.OfDocument(item.Document).Priority))
+		}
+
+		if _, err := js.Publish(ctx, Subject(prefix, item), header, item.Input); err != nil {
+			return err
+		}
+		if sink != nil {
+			return sink(ctx, item)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,398 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package jetstream
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// fakeServer is a NATS server speaking the core protocol and the parts of the JetStream api
+// used by the package, streams keep their messages in memory
+type fakeServer struct {
+	listener net.Listener
+	password string
+
+	mu        sync.Mutex
+	clients   []*fakeClient
+	streams   map[string]*fakeStream
+	consumers map[string]*fakeConsumer
+}
+
+type fakeStream struct {
+	config StreamConfig
+	msgs   []*fakeMsg
+	ids    map[string]bool
+}
+
+type fakeMsg struct {
+	subject  string
+	header   Header
+	data     []byte
+	sequence uint64
+
+	// state is pending, delivered, acked or terminated by consumer
+	state     map[string]string
+	delivered map[string]int
+}
+
+type fakeConsumer struct {
+	stream string
+	config ConsumerConfig
+}
+
+type fakeClient struct {
+	conn net.Conn
+	wmu  sync.Mutex
+	subs map[string]string
+}
+
+func newFakeServer(t *testing.T) *fakeServer {
+	t.Helper()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.Nil(t, err)
+	s := &fakeServer{
+		listener:  listener,
+		streams:   make(map[string]*fakeStream),
+		consumers: make(map[string]*fakeConsumer),
+	}
+	t.Cleanup(func() {
+		listener.Close()
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		for _, client := range s.clients {
+			client.conn.Close()
+		}
+	})
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go s.serve(conn)
+		}
+	}()
+	return s
+}
+
+func (s *fakeServer) url() string {
+	if s.password != "" {
+		return "nats://moov:" + s.password + "@" + s.listener.Addr().String()
+	}
+	return "nats://" + s.listener.Addr().String()
+}
+
+// messages returns the state of the messages of stream for consumer
+func (s *fakeServer) messages(stream, consumer string) map[string]string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	states := make(map[string]string)
+	for _, msg := range s.streams[stream].msgs {
+		state := msg.state[consumer]
+		if state == "" {
+			state = "pending"
+		}
+		states[msg.subject+"/"+strconv.FormatUint(msg.sequence, 10)] = state
+	}
+	return states
+}
+
+func (s *fakeServer) serve(conn net.Conn) {
+	client := &fakeClient{conn: conn, subs: make(map[string]string)}
+	s.mu.Lock()
+	s.clients = append(s.clients, client)
+	s.mu.Unlock()
+
+	client.write(`INFO {"server_id":"fake","version":"2.10.0","headers":true,"jetstream":true}` + "\r\n")
+	reader := bufio.NewReader(conn)
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			return
+		}
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+		switch fields[0] {
+		case "CONNECT":
+			var options map[string]interface{}
+			json.Unmarshal([]byte(strings.TrimPrefix(strings.TrimSpace(line), "CONNECT ")), &options)
+			if s.password != "" && options["pass"] != s.password {
+				client.write("-ERR 'Authorization Violation'\r\n")
+				conn.Close()
+				return
+			}
+		case "PING":
+			client.write("PONG\r\n")
+		case "SUB":
+			client.wmu.Lock()
+			client.subs[fields[len(fields)-1]] = fields[1]
+			client.wmu.Unlock()
+		case "PUB", "HPUB":
+			args := fields[1:]
+			total, _ := strconv.Atoi(args[len(args)-1])
+			headerSize := 0
+			if fields[0] == "HPUB" {
+				headerSize, _ = strconv.Atoi(args[len(args)-2])
+				args = args[:len(args)-1]
+			}
+			args = args[:len(args)-1]
+			payload := make([]byte, total+2)
+			if _, err := io.ReadFull(reader, payload); err != nil {
+				return
+			}
+			msg := &Msg{Subject: args[0]}
+			if len(args) == 2 {
+				msg.Reply = args[1]
+			}
+			if headerSize > 0 {
+				msg.parseHeader(payload[:headerSize])
+			}
+			msg.Data = payload[headerSize:total]
+			s.publish(msg)
+		}
+	}
+}
+
+func (c *fakeClient) write(s string) {
+	c.wmu.Lock()
+	defer c.wmu.Unlock()
+	c.conn.Write([]byte(s))
+}
+
+// deliver sends msg to the subscriptions matching its subject, it returns false without any
+func (s *fakeServer) deliver(subject, reply string, header Header, status string, data []byte) bool {
+	return s.deliverTo(subject, subject, reply, header, status, data)
+}
+
+// deliverTo sends msg to the subscriptions matching route, pulled messages are routed to the
+// inbox of their request but keep their subject
+func (s *fakeServer) deliverTo(route, subject, reply string, header Header, status string, data []byte) bool {
+	s.mu.Lock()
+	clients := append([]*fakeClient(nil), s.clients...)
+	s.mu.Unlock()
+
+	delivered := false
+	for _, client := range clients {
+		client.wmu.Lock()
+		var sids []string
+		for sid, pattern := range client.subs {
+			if matchSubject(pattern, route) {
+				sids = append(sids, sid)
+			}
+		}
+		client.wmu.Unlock()
+		for _, sid := range sids {
+			delivered = true
+			args := subject + " " + sid
+			if reply != "" {
+				args += " " + reply
+			}
+			if header == nil && status == "" {
+				client.write(fmt.Sprintf("MSG %s %d\r\n%s\r\n", args, len(data), data))
+				continue
+			}
+			raw := "NATS/1.0"
+			if status != "" {
+				raw += " " + status
+			}
+			raw += "\r\n"
+			for key, values := range header {
+				for _, value := range values {
+					raw += key + ": " + value + "\r\n"
+				}
+			}
+			raw += "\r\n"
+			client.write(fmt.Sprintf("HMSG %s %d %d\r\n%s%s\r\n", args, len(raw), len(raw)+len(data), raw, data))
+		}
+	}
+	return delivered
+}
+
+func matchSubject(pattern, subject string) bool {
+	patterns, tokens := strings.Split(pattern, "."), strings.Split(subject, ".")
+	for i, p := range patterns {
+		if p == ">" {
+			return len(tokens) > i
+		}
+		if i >= len(tokens) || (p != "*" && p != tokens[i]) {
+			return false
+		}
+	}
+	return len(patterns) == len(tokens)
+}
+
+func (s *fakeServer) respond(reply string, body interface{}) {
+	data, _ := json.Marshal(body)
+	s.deliver(reply, "", nil, "", data)
+}
+
+func apiError(code, errCode int, description string) map[string]interface{} {
+	return map[string]interface{}{"error": APIError{Code: code, ErrCode: errCode, Description: description}}
+}
+
+func (s *fakeServer) publish(msg *Msg) {
+	switch {
+	case strings.HasPrefix(msg.Subject, "$JS.API.STREAM.CREATE."):
+		var config StreamConfig
+		json.Unmarshal(msg.Data, &config)
+		s.mu.Lock()
+		_, exists := s.streams[config.Name]
+		if !exists {
+			s.streams[config.Name] = &fakeStream{config: config, ids: make(map[string]bool)}
+		}
+		s.mu.Unlock()
+		if exists {
+			s.respond(msg.Reply, apiError(400, errCodeStreamNameInUse, "stream name already in use with a different configuration"))
+			return
+		}
+		s.respond(msg.Reply, map[string]interface{}{"config": config})
+
+	case strings.HasPrefix(msg.Subject, "$JS.API.CONSUMER.DURABLE.CREATE."):
+		tokens := strings.Split(msg.Subject, ".")
+		var body struct {
+			Config ConsumerConfig `json:"config"`
+		}
+		json.Unmarshal(msg.Data, &body)
+		s.mu.Lock()
+		_, exists := s.streams[tokens[5]]
+		if exists {
+			s.consumers[tokens[6]] = &fakeConsumer{stream: tokens[5], config: body.Config}
+		}
+		s.mu.Unlock()
+		if !exists {
+			s.respond(msg.Reply, apiError(404, 10059, "stream not found"))
+			return
+		}
+		s.respond(msg.Reply, map[string]interface{}{"name": tokens[6], "config": body.Config})
+
+	case strings.HasPrefix(msg.Subject, "$JS.API.CONSUMER.MSG.NEXT."):
+		s.next(strings.Split(msg.Subject, ".")[6], msg)
+
+	case strings.HasPrefix(msg.Subject, "$JS.ACK."):
+		tokens := strings.Split(msg.Subject, ".")
+		sequence, _ := strconv.ParseUint(tokens[5], 10, 64)
+		s.mu.Lock()
+		for _, stored := range s.streams[tokens[2]].msgs {
+			if stored.sequence == sequence {
+				switch string(msg.Data) {
+				case "+ACK":
+					stored.state[tokens[3]] = "acked"
+				case "-NAK":
+					stored.state[tokens[3]] = "pending"
+				case "+TERM":
+					stored.state[tokens[3]] = "terminated"
+				}
+			}
+		}
+		s.mu.Unlock()
+
+	default:
+		if s.store(msg) {
+			return
+		}
+		if !s.deliver(msg.Subject, msg.Reply, msg.Header, "", msg.Data) && msg.Reply != "" {
+			s.deliver(msg.Reply, "", nil, "503", nil)
+		}
+	}
+}
+
+// store appends msg to the stream of its subject and acknowledges it
+func (s *fakeServer) store(msg *Msg) bool {
+	s.mu.Lock()
+	var stream *fakeStream
+	for _, st := range s.streams {
+		for _, pattern := range st.config.Subjects {
+			if matchSubject(pattern, msg.Subject) {
+				stream = st
+			}
+		}
+	}
+	if stream == nil {
+		s.mu.Unlock()
+		return false
+	}
+	id := msg.Header.Get(HeaderMsgID)
+	ack := PubAck{Stream: stream.config.Name, Duplicate: id != "" && stream.ids[id]}
+	if !ack.Duplicate {
+		stream.ids[id] = id != ""
+		ack.Sequence = uint64(len(stream.msgs) + 1)
+		stream.msgs = append(stream.msgs, &fakeMsg{
+			subject:   msg.Subject,
+			header:    msg.Header,
+			data:      msg.Data,
+			sequence:  ack.Sequence,
+			state:     make(map[string]string),
+			delivered: make(map[string]int),
+		})
+	}
+	s.mu.Unlock()
+	if msg.Reply != "" {
+		s.respond(msg.Reply, ack)
+	}
+	return true
+}
+
+// next delivers the pending messages of the consumer durable, the request expires after a
+// short delay when they don't fill its batch
+func (s *fakeServer) next(durable string, msg *Msg) {
+	var request struct {
+		Batch   int           `json:"batch"`
+		Expires time.Duration `json:"expires"`
+	}
+	json.Unmarshal(msg.Data, &request)
+
+	type delivery struct {
+		msg   *fakeMsg
+		reply string
+	}
+	var deliveries []delivery
+	s.mu.Lock()
+	consumer := s.consumers[durable]
+	if consumer != nil {
+		stream := s.streams[consumer.stream]
+		for _, stored := range stream.msgs {
+			if len(deliveries) == request.Batch {
+				break
+			}
+			state := stored.state[durable]
+			if (state != "" && state != "pending") || !matchSubject(consumer.config.FilterSubject, stored.subject) {
+				continue
+			}
+			stored.state[durable] = "delivered"
+			stored.delivered[durable]++
+			reply := fmt.Sprintf("$JS.ACK.%s.%s.%d.%d.%d.%d.0", consumer.stream, durable,
+				stored.delivered[durable], stored.sequence, stored.sequence, time.Now().UnixNano())
+			deliveries = append(deliveries, delivery{stored, reply})
+		}
+	}
+	s.mu.Unlock()
+
+	if consumer == nil {
+		s.deliver(msg.Reply, "", nil, "503", nil)
+		return
+	}
+	for _, d := range deliveries {
+		s.deliverTo(msg.Reply, d.msg.subject, d.reply, d.msg.header, "", d.msg.data)
+	}
+	if len(deliveries) < request.Batch {
+		go func() {
+			time.Sleep(10 * time.Millisecond)
+			s.deliver(msg.Reply, "", nil, "408 Request Timeout", nil)
+		}()
+	}
+}
@@ -0,0 +1,208 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package jws
+
+/*
+	Jws signs payloads with a detached JWS (RFC 7515 appendix F), the encoded protected header
+	and signature separated by two dots, with a P-256 (ES256), RSA (RS256) or Ed25519 (EdDSA) key:
+
+		signer, err := jws.NewSigner(key, "iso20022-1")
+		signature, err := signer.Sign(payload)
+		signed, err := jws.Verify(signature, payload, key.Public())
+
+	The protected header carries the time of the signature (iat), returned by Verify.
+*/
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"strings"
+	"time"
+)
+
+// ErrInvalidSignature is returned when a detached JWS doesn't sign a payload
+var ErrInvalidSignature = errors.New("invalid signature")
+
+// header is the protected header of signatures, Time is when the payload was signed in unix
+// seconds
+type header struct {
+	Algorithm string `json:"alg"`
+	KeyID     string `json:"kid,omitempty"`
+	Time      int64  `json:"iat"`
+}
+
+// Signer signs payloads with a detached JWS
+type Signer struct {
+	algorithm string
+	keyID     string
+	key       crypto.Signer
+	now       func() time.Time
+}
+
+// NewSigner returns the signer of key, keyID is the kid of its signatures and may be empty
+func NewSigner(key crypto.Signer, keyID string) (*Signer, error) {
+	algorithm, err := Algorithm(key.Public())
+	if err != nil {
+		return nil, err
+	}
+	return &Signer{algorithm: algorithm, keyID: keyID, key: key, now: time.Now}, nil
+}
+
+// LoadKey reads the private key of a PEM file, see ParseKey
+func LoadKey(path string) (crypto.Signer, error) {
+	buf, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	key, err := ParseKey(buf)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return key, nil
+}
+
+// ParseKey parses a PEM encoded PKCS #8, EC or PKCS #1 private key
+func ParseKey(buf []byte) (crypto.Signer, error) {
+	block, _ := pem.Decode(buf)
+	if block == nil {
+		return nil, errors.New("no PEM encoded key")
+	}
+	var key interface{}
+	var err error
+	switch block.Type {
+	case "EC PRIVATE KEY":
+		key, err = x509.ParseECPrivateKey(block.Bytes)
+	case "RSA PRIVATE KEY":
+		key, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	default:
+		key, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	}
+	if err != nil {
+		return nil, err
+	}
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return nil, fmt.Errorf("unsupported key %T", key)
+	}
+	return signer, nil
+}
+
+// Algorithm returns the JWS algorithm of key, ES256 for P-256 keys, RS256 for RSA keys and
+// EdDSA for Ed25519 keys
+func Algorithm(key crypto.PublicKey) (string, error) {
+	switch k := key.(type) {
+	case *ecdsa.PublicKey:
+		if k.Curve != elliptic.P256() {
+			return "", fmt.Errorf("unsupported curve %s", k.Curve.Params().Name)
+		}
+		return "ES256", nil
+	case *rsa.PublicKey:
+		return "RS256", nil
+	case ed25519.PublicKey:
+		return "EdDSA", nil
+	}
+	return "", fmt.Errorf("unsupported key %T", key)
+}
+
+// Sign returns the detached JWS of payload
+func (s *Signer) Sign(payload []byte) (string, error) {
+	protectedHeader, err := json.Marshal(header{Algorithm: s.algorithm, KeyID: s.keyID, Time: s.now().Unix()})
+	if err != nil {
+		return "", err
+	}
+	protected := base64.RawURLEncoding.EncodeToString(protectedHeader)
+	input := []byte(protected + "." + base64.RawURLEncoding.EncodeToString(payload))
+
+	// Ed25519 signs the input itself, other algorithms its digest
+	signed, opts := input, crypto.SignerOpts(crypto.Hash(0))
+	if s.algorithm != "EdDSA" {
+		digest := sha256.Sum256(input)
+		signed, opts = digest[:], crypto.SHA256
+	}
+	signature, err := s.key.Sign(rand.Reader, signed, opts)
+	if err != nil {
+		return "", err
+	}
+	if s.algorithm == "ES256" {
+		if signature, err = ecdsaSignature(signature); err != nil {
+			return "", err
+		}
+	}
+	return protected + ".." + base64.RawURLEncoding.EncodeToString(signature), nil
+}
+
+// ecdsaSignature converts an ASN.1 ECDSA signature to the fixed size big-endian r and s of JWS
+func ecdsaSignature(der []byte) ([]byte, error) {
+	var sig struct {
+		R, S *big.Int
+	}
+	if _, err := asn1.Unmarshal(der, &sig); err != nil {
+		return nil, fmt.Errorf("ecdsa signature: %w", err)
+	}
+	signature := make([]byte, 64)
+	sig.R.FillBytes(signature[:32])
+	sig.S.FillBytes(signature[32:])
+	return signature, nil
+}
+
+// Verify verifies that signature, a detached JWS, signs payload with the private key of key
+// and returns when the payload was signed
+func Verify(signature string, payload []byte, key crypto.PublicKey) (time.Time, error) {
+	parts := strings.Split(signature, ".")
+	if len(parts) != 3 || parts[1] != "" {
+		return time.Time{}, fmt.Errorf("%w: not a detached JWS", ErrInvalidSignature)
+	}
+	buf, err := base64.RawURLEncoding.DecodeString(parts[0])
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%w: %v", ErrInvalidSignature, err)
+	}
+	var h header
+	if err := json.Unmarshal(buf, &h); err != nil {
+		return time.Time{}, fmt.Errorf("%w: %v", ErrInvalidSignature, err)
+	}
+	sig, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%w: %v", ErrInvalidSignature, err)
+	}
+	algorithm, err := Algorithm(key)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if h.Algorithm != algorithm {
+		return time.Time{}, fmt.Errorf("%w: algorithm %s isn't the %s of the key", ErrInvalidSignature, h.Algorithm, algorithm)
+	}
+
+	input := []byte(parts[0] + "." + base64.RawURLEncoding.EncodeToString(payload))
+	digest := sha256.Sum256(input)
+	valid := false
+	switch k := key.(type) {
+	case *ecdsa.PublicKey:
+		if len(sig) == 64 {
+			valid = ecdsa.Verify(k, digest[:], new(big.Int).SetBytes(sig[:32]), new(big.Int).SetBytes(sig[32:]))
+		}
+	case *rsa.PublicKey:
+		valid = rsa.VerifyPKCS1v15(k, crypto.SHA256, digest[:], sig) == nil
+	case ed25519.PublicKey:
+		valid = ed25519.Verify(k, input, sig)
+	}
+	if !valid {
+		return time.Time{}, ErrInvalidSignature
+	}
+	return time.Unix(h.Time, 0).UTC(), nil
+}
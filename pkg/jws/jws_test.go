@@ -0,0 +1,95 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package jws
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSigner(t *testing.T) {
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.Nil(t, err)
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.Nil(t, err)
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	require.Nil(t, err)
+
+	signed := time.Date(2021, time.March, 1, 12, 0, 0, 0, time.UTC)
+	payload := []byte(`{"inputs":[]}`)
+	for _, key := range []crypto.Signer{ecKey, rsaKey, edKey} {
+		signer, err := NewSigner(key, "batch-1")
+		require.Nil(t, err)
+		signer.now = func() time.Time { return signed }
+
+		signature, err := signer.Sign(payload)
+		require.Nil(t, err)
+		require.Contains(t, signature, "..")
+
+		at, err := Verify(signature, payload, key.Public())
+		require.Nil(t, err)
+		require.Equal(t, signed, at)
+
+		_, err = Verify(signature, []byte(`{"inputs":[{}]}`), key.Public())
+		require.ErrorIs(t, err, ErrInvalidSignature)
+	}
+
+	_, err = Verify("not.a.jws", payload, ecKey.Public())
+	require.ErrorIs(t, err, ErrInvalidSignature)
+
+	// signatures of another algorithm
+	signer, err := NewSigner(edKey, "")
+	require.Nil(t, err)
+	signature, err := signer.Sign(payload)
+	require.Nil(t, err)
+	_, err = Verify(signature, payload, ecKey.Public())
+	require.ErrorIs(t, err, ErrInvalidSignature)
+
+	p384, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	require.Nil(t, err)
+	_, err = NewSigner(p384, "")
+	require.EqualError(t, err, "unsupported curve P-384")
+}
+
+func TestLoadKey(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.Nil(t, err)
+	der, err := x509.MarshalECPrivateKey(key)
+	require.Nil(t, err)
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, "key.pem")
+	require.Nil(t, os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: der}), 0600))
+	loaded, err := LoadKey(path)
+	require.Nil(t, err)
+	require.True(t, key.Equal(loaded))
+
+	der, err = x509.MarshalPKCS8PrivateKey(key)
+	require.Nil(t, err)
+	loaded, err = ParseKey(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}))
+	require.Nil(t, err)
+	require.True(t, key.Equal(loaded))
+
+	invalid := filepath.Join(dir, "invalid.pem")
+	require.Nil(t, os.WriteFile(invalid, []byte("key"), 0600))
+	_, err = LoadKey(invalid)
+	require.True(t, strings.HasSuffix(err.Error(), "invalid.pem: no PEM encoded key"))
+
+	_, err = LoadKey(filepath.Join(dir, "missing.pem"))
+	require.ErrorIs(t, err, os.ErrNotExist)
+}
@@ -0,0 +1,86 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package largevalue
+
+/*
+	Largevalue flags the transactions whose amount reaches the threshold of their currency,
+	the triggers of currency transaction reports (CTR) and enhanced reviews:
+
+		thresholds, err := largevalue.New(map[string]float64{"USD": 10000, "EUR": 10000})
+		for _, tx := range thresholds.Transactions(doc) {
+			...
+		}
+
+	Summaries mark them with Summary.MarkLargeValues and events.WithLargeValues emits a
+	payment.largevalue.detected event for each of them. Transactions in currencies without
+	threshold are never large value.
+*/
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/moov-io/iso20022/pkg/document"
+	"github.com/moov-io/iso20022/pkg/utils"
+)
+
+// Thresholds are the amounts from which transactions are large value by ISO 4217 currency code
+type Thresholds map[string]float64
+
+// New returns the thresholds of amounts by currency, currencies are case insensitive
+func New(amounts map[string]float64) (Thresholds, error) {
+	thresholds := make(Thresholds, len(amounts))
+	for currency, amount := range amounts {
+		code := strings.ToUpper(strings.TrimSpace(currency))
+		if len(code) != 3 {
+			return nil, fmt.Errorf("large value threshold: %q is not a currency code", currency)
+		}
+		if amount <= 0 {
+			return nil, fmt.Errorf("large value threshold of %s: %v is not positive", code, amount)
+		}
+		thresholds[code] = amount
+	}
+	return thresholds, nil
+}
+
+// Threshold returns the threshold of currency
+func (t Thresholds) Threshold(currency string) (float64, bool) {
+	threshold, exists := t[strings.ToUpper(currency)]
+	return threshold, exists
+}
+
+// IsLarge reports whether amount reaches the threshold of currency
+func (t Thresholds) IsLarge(amount float64, currency string) bool {
+	threshold, exists := t.Threshold(currency)
+	return exists && amount >= threshold
+}
+
+// Transaction is a large value transaction of a document
+type Transaction struct {
+	// Path of the transaction, e.g. CdtTrfTxInf[0]
+	Path      string  `json:"path"`
+	Amount    float64 `json:"amount"`
+	Currency  string  `json:"currency"`
+	Threshold float64 `json:"threshold"`
+}
+
+// Transactions returns the large value transactions of doc in document order
+func (t Thresholds) Transactions(doc document.Iso20022Document) []Transaction {
+	var transactions []Transaction
+	for _, tx := range utils.GetTransactions(doc.InspectMessage()) {
+		if tx.Path == "" {
+			continue
+		}
+		currency := tx.Lookup("IntrBkSttlmAmt/@Ccy", "InstdAmt/@Ccy", "Amt/@Ccy")
+		amount, err := strconv.ParseFloat(tx.Lookup("IntrBkSttlmAmt", "InstdAmt", "Amt"), 64)
+		if err != nil || !t.IsLarge(amount, currency) {
+			continue
+		}
+		threshold, _ := t.Threshold(currency)
+		transactions = append(transactions, Transaction{Path: tx.Path, Amount: amount, Currency: strings.ToUpper(currency), Threshold: threshold})
+	}
+	return transactions
+}
@@ -0,0 +1,41 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package largevalue
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/moov-io/iso20022/pkg/document"
+	"github.com/moov-io/iso20022/pkg/iso20022test/fixtures"
+)
+
+func TestThresholds(t *testing.T) {
+	thresholds, err := New(map[string]float64{" usd": 10000, "EUR": 15000})
+	require.Nil(t, err)
+	require.Equal(t, Thresholds{"USD": 10000, "EUR": 15000}, thresholds)
+
+	require.True(t, thresholds.IsLarge(10000, "usd"))
+	require.False(t, thresholds.IsLarge(9999.99, "USD"))
+	require.False(t, thresholds.IsLarge(1e9, "GBP"))
+
+	_, err = New(map[string]float64{"DOLLAR": 10000})
+	require.ErrorContains(t, err, "is not a currency code")
+	_, err = New(map[string]float64{"USD": 0})
+	require.ErrorContains(t, err, "is not positive")
+}
+
+func TestTransactions(t *testing.T) {
+	buf := fixtures.ReadFile(t, "valid_pacs_v08.xml")
+	doc, err := document.ParseIso20022Document(buf)
+	require.Nil(t, err)
+
+	require.Equal(t, []Transaction{
+		{Path: "CdtTrfTxInf[0]", Amount: 250000, Currency: "USD", Threshold: 10000},
+	}, Thresholds{"USD": 10000}.Transactions(doc))
+	require.Len(t, Thresholds{"USD": 500}.Transactions(doc), 2)
+	require.Empty(t, Thresholds{"EUR": 1}.Transactions(doc))
+}
@@ -0,0 +1,246 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package ledger
+
+/*
+	Ledger records the fingerprints of processed inputs and their processing state in a store,
+	so an input is processed once even when a watcher or stream emits it again after a restart:
+
+		l := ledger.New(store)
+		p := pipeline.New(l.Source(pipeline.Dir("inbox")), l.Sink(sink), pipeline.WithErrorSink(l.ErrorSink(nil)))
+
+	Inputs whose processing was interrupted by a crash stay in the processing state and are
+	processed again when emitted again. An input is completed after its sink returns, sinks
+	should tolerate the repetition of the input interrupted between both.
+
+	Ledgers are replicated to a secondary region, for a restart from the replica after a
+	disaster, by keeping them in a replicated store:
+
+		store := storage.NewReplicatedStore(files, bucket.NewReplica(dr, "ledger"), storage.ReplicationOptions{})
+		defer store.Close()
+		l := ledger.New(store)
+*/
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/moov-io/iso20022/pkg/pipeline"
+	"github.com/moov-io/iso20022/pkg/storage"
+)
+
+const (
+	StateProcessing = "processing"
+	StateDone       = "done"
+	StateFailed     = "failed"
+
+	// HeaderFingerprint is the header of the items emitted by a ledger source
+	HeaderFingerprint = "x-ledger-fingerprint"
+)
+
+// Entry is the processing state of an input
+type Entry struct {
+	Fingerprint string `json:"fingerprint"`
+	Name        string `json:"name"`
+	State       string `json:"state"`
+	Error       string `json:"error,omitempty"`
+
+	// Attempts counts the processings of the input, interrupted and failed ones included
+	Attempts int       `json:"attempts"`
+	Started  time.Time `json:"started"`
+	Finished time.Time `json:"finished,omitempty"`
+}
+
+// Fingerprint identifies content regardless of its name
+func Fingerprint(content []byte) string {
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:])
+}
+
+// Ledger keeps the processing state of inputs in a store
+type Ledger struct {
+	store storage.Store
+	now   func() time.Time
+
+	mu sync.Mutex
+	// claimed are the inputs processed by this ledger, processing entries of another run were interrupted
+	claimed map[string]bool
+}
+
+// New returns a ledger keeping its entries in store
+func New(store storage.Store) *Ledger {
+	return &Ledger{store: store, now: time.Now, claimed: make(map[string]bool)}
+}
+
+// Begin claims the processing of content, it returns false when the content is processed or
+// being processed by the ledger already
+func (l *Ledger) Begin(ctx context.Context, name string, content []byte) (Entry, bool, error) {
+	fingerprint := Fingerprint(content)
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	entry, err := l.get(ctx, fingerprint)
+	switch {
+	case errors.Is(err, storage.ErrNotFound):
+		entry = Entry{Fingerprint: fingerprint}
+	case err != nil:
+		return entry, false, err
+	case entry.State == StateDone, entry.State == StateProcessing && l.claimed[fingerprint]:
+		return entry, false, nil
+	}
+
+	entry.Name = name
+	entry.State = StateProcessing
+	entry.Error = ""
+	entry.Attempts++
+	entry.Started = l.now().UTC()
+	entry.Finished = time.Time{}
+	if err = l.put(ctx, entry); err != nil {
+		return entry, false, err
+	}
+	l.claimed[fingerprint] = true
+	return entry, true, nil
+}
+
+// Complete records the processing of the input with fingerprint
+func (l *Ledger) Complete(ctx context.Context, fingerprint string) error {
+	return l.finish(ctx, fingerprint, StateDone, nil)
+}
+
+// Fail records the failed processing of the input with fingerprint, it's processed again when emitted again
+func (l *Ledger) Fail(ctx context.Context, fingerprint string, cause error) error {
+	return l.finish(ctx, fingerprint, StateFailed, cause)
+}
+
+func (l *Ledger) finish(ctx context.Context, fingerprint, state string, cause error) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	entry, err := l.get(ctx, fingerprint)
+	if err != nil {
+		return err
+	}
+	entry.State = state
+	entry.Finished = l.now().UTC()
+	if cause != nil {
+		entry.Error = cause.Error()
+	}
+	delete(l.claimed, fingerprint)
+	return l.put(ctx, entry)
+}
+
+// Get returns the entry of the input with fingerprint
+func (l *Ledger) Get(ctx context.Context, fingerprint string) (Entry, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.get(ctx, fingerprint)
+}
+
+// Interrupted returns the entries still processing without being claimed by the ledger, the
+// inputs of a run which crashed mid-batch
+func (l *Ledger) Interrupted(ctx context.Context) ([]Entry, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	records, err := l.store.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var entries []Entry
+	for _, rec := range records {
+		if rec.Kind != storage.KindLedger {
+			continue
+		}
+		entry, err := decode(rec)
+		if err != nil {
+			return nil, err
+		}
+		if entry.State == StateProcessing && !l.claimed[entry.Fingerprint] {
+			entries = append(entries, entry)
+		}
+	}
+	return entries, nil
+}
+
+func (l *Ledger) get(ctx context.Context, fingerprint string) (Entry, error) {
+	rec, err := l.store.Get(ctx, fingerprint)
+	if err != nil {
+		return Entry{}, err
+	}
+	if rec.Kind != storage.KindLedger {
+		return Entry{}, fmt.Errorf("%w: %s", storage.ErrNotFound, fingerprint)
+	}
+	return decode(rec)
+}
+
+func (l *Ledger) put(ctx context.Context, entry Entry) error {
+	content, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	return l.store.Put(ctx, storage.Record{
+		ID:      entry.Fingerprint,
+		Kind:    storage.KindLedger,
+		Content: content,
+		Created: entry.Started,
+		Updated: l.now().UTC(),
+	})
+}
+
+func decode(rec storage.Record) (Entry, error) {
+	var entry Entry
+	if err := json.Unmarshal(rec.Content, &entry); err != nil {
+		return entry, fmt.Errorf("ledger entry %s: %w", rec.ID, err)
+	}
+	return entry, nil
+}
+
+// Source emits the items of source the ledger didn't process yet, claiming their processing
+func (l *Ledger) Source(source pipeline.Source) pipeline.Source {
+	return func(ctx context.Context, emit func(pipeline.Item) error) error {
+		return source(ctx, func(item pipeline.Item) error {
+			entry, ok, err := l.Begin(ctx, item.Name, item.Input)
+			if err != nil || !ok {
+				return err
+			}
+			headers := map[string]string{HeaderFingerprint: entry.Fingerprint}
+			for key, value := range item.Headers {
+				headers[key] = value
+			}
+			item.Headers = headers
+			return emit(item)
+		})
+	}
+}
+
+// Sink completes the items processed by sink
+func (l *Ledger) Sink(sink pipeline.Sink) pipeline.Sink {
+	return func(ctx context.Context, item pipeline.Item) error {
+		if err := sink(ctx, item); err != nil {
+			return err
+		}
+		return l.Complete(ctx, item.Headers[HeaderFingerprint])
+	}
+}
+
+// ErrorSink fails the failed items and routes them to sink, if not nil
+func (l *Ledger) ErrorSink(sink pipeline.Sink) pipeline.Sink {
+	return func(ctx context.Context, item pipeline.Item) error {
+		if err := l.Fail(ctx, item.Headers[HeaderFingerprint], item.Err); err != nil {
+			return err
+		}
+		if sink != nil {
+			return sink(ctx, item)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,124 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package ledger
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/moov-io/iso20022/pkg/iso20022test/fixtures"
+	"github.com/moov-io/iso20022/pkg/pipeline"
+	"github.com/moov-io/iso20022/pkg/storage"
+)
+
+func TestLedger(t *testing.T) {
+	ctx := context.Background()
+	content := []byte("<Document/>")
+	l := New(storage.NewMemoryStore())
+
+	entry, ok, err := l.Begin(ctx, "a.xml", content)
+	require.Nil(t, err)
+	require.True(t, ok)
+	require.Equal(t, Fingerprint(content), entry.Fingerprint)
+	require.Equal(t, StateProcessing, entry.State)
+
+	// the same content under another name is claimed already
+	_, ok, err = l.Begin(ctx, "copy-of-a.xml", content)
+	require.Nil(t, err)
+	require.False(t, ok)
+
+	require.Nil(t, l.Fail(ctx, entry.Fingerprint, errors.New("timeout")))
+	entry, err = l.Get(ctx, entry.Fingerprint)
+	require.Nil(t, err)
+	require.Equal(t, StateFailed, entry.State)
+	require.Equal(t, "timeout", entry.Error)
+
+	// failed inputs are processed again
+	entry, ok, err = l.Begin(ctx, "a.xml", content)
+	require.Nil(t, err)
+	require.True(t, ok)
+	require.Equal(t, 2, entry.Attempts)
+	require.Empty(t, entry.Error)
+
+	require.Nil(t, l.Complete(ctx, entry.Fingerprint))
+	_, ok, err = l.Begin(ctx, "a.xml", content)
+	require.Nil(t, err)
+	require.False(t, ok)
+
+	require.ErrorIs(t, l.Complete(ctx, Fingerprint([]byte("unknown"))), storage.ErrNotFound)
+}
+
+func TestLedgerAcrossRestarts(t *testing.T) {
+	ctx := context.Background()
+	dir, inbox := t.TempDir(), t.TempDir()
+	valid := fixtures.ReadFile(t, "valid_pacs_v08.xml")
+	require.Nil(t, os.WriteFile(filepath.Join(inbox, "first.xml"), valid, 0600))
+	require.Nil(t, os.WriteFile(filepath.Join(inbox, "second.xml"), fixtures.ReadFile(t, "valid_pain_v11.xml"), 0600))
+
+	run := func() (*Ledger, []string) {
+		store, err := storage.NewFileStore(dir)
+		require.Nil(t, err)
+		l := New(store)
+
+		var processed []string
+		sink := func(ctx context.Context, item pipeline.Item) error {
+			processed = append(processed, filepath.Base(item.Name))
+			return nil
+		}
+		_, err = pipeline.New(l.Source(pipeline.Dir(inbox)), l.Sink(sink), pipeline.WithWorkers(1), pipeline.WithErrorSink(l.ErrorSink(nil))).Run(ctx)
+		require.Nil(t, err)
+		return l, processed
+	}
+
+	// a run crashing after claiming the first input
+	store, err := storage.NewFileStore(dir)
+	require.Nil(t, err)
+	crashed := New(store)
+	_, ok, err := crashed.Begin(ctx, filepath.Join(inbox, "first.xml"), valid)
+	require.Nil(t, err)
+	require.True(t, ok)
+
+	l, processed := run()
+	require.Equal(t, []string{"first.xml", "second.xml"}, processed)
+	entry, err := l.Get(ctx, Fingerprint(valid))
+	require.Nil(t, err)
+	require.Equal(t, StateDone, entry.State)
+	require.Equal(t, 2, entry.Attempts)
+
+	interrupted, err := l.Interrupted(ctx)
+	require.Nil(t, err)
+	require.Empty(t, interrupted)
+
+	// a restart processes nothing again
+	_, processed = run()
+	require.Empty(t, processed)
+}
+
+func TestLedgerInterrupted(t *testing.T) {
+	ctx := context.Background()
+	store := storage.NewMemoryStore()
+
+	_, _, err := New(store).Begin(ctx, "a.xml", []byte("a"))
+	require.Nil(t, err)
+
+	l := New(store)
+	interrupted, err := l.Interrupted(ctx)
+	require.Nil(t, err)
+	require.Len(t, interrupted, 1)
+	require.Equal(t, "a.xml", interrupted[0].Name)
+
+	// an interrupted input is resumed once
+	_, ok, err := l.Begin(ctx, "a.xml", []byte("a"))
+	require.Nil(t, err)
+	require.True(t, ok)
+	interrupted, err = l.Interrupted(ctx)
+	require.Nil(t, err)
+	require.Empty(t, interrupted)
+}
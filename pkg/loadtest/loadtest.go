@@ -0,0 +1,441 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package loadtest
+
+/*
+	Loadtest sends synthetic traffic to a running server and reports the latency percentiles,
+	throughput and error rate of its responses, for capacity planning without external tools.
+
+		report, err := loadtest.Run(ctx, loadtest.Config{
+			URL:          "http://localhost:8080",
+			Messages:     []loadtest.Message{{Name: "pacs.008", Content: buf, Weight: 3}, ...},
+			Transactions: []int{1, 100},
+			Concurrency:  8,
+			Duration:     time.Minute,
+		})
+
+	Every request posts a message of the mix, picked by weight, as the input of a multipart
+	form. Messages of templates are rendered for every request, so their ${id} and ${now} are
+	fresh. Transactions scale xml messages: their first transaction is repeated until they
+	have one of the sizes.
+*/
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"math/rand"
+	"mime/multipart"
+	"net/http"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/moov-io/iso20022/pkg/document"
+	"github.com/moov-io/iso20022/pkg/templates"
+	"github.com/moov-io/iso20022/pkg/utils"
+)
+
+const (
+	// DefaultEndpoint is the endpoint of the requests when omitted
+	DefaultEndpoint = "/validator"
+
+	// StatusError counts the requests failing without response
+	StatusError = "error"
+
+	defaultTimeout = 30 * time.Second
+)
+
+var (
+	// ErrNoMessages is returned by Run without message to send
+	ErrNoMessages = errors.New("no message to send")
+
+	numberOfTxs = regexp.MustCompile(`<NbOfTxs>\d+</NbOfTxs>`)
+)
+
+// Message is a message of the traffic mix, its Content or the rendering of its Template with
+// Values
+type Message struct {
+	Name     string
+	Content  []byte
+	Template *templates.Template
+	Values   map[string]string
+
+	// Weight is the share of the message in the mix, 1 when omitted
+	Weight int
+}
+
+// Config defines the traffic of a load test
+type Config struct {
+	// URL of the server and Endpoint receiving the messages, DefaultEndpoint when omitted
+	URL      string
+	Endpoint string
+
+	// Fields are the other fields of the forms, e.g. format of /convert
+	Fields map[string]string
+
+	Messages []Message
+
+	// Transactions are the sizes of the xml messages in transactions, picked at random for
+	// every request, messages are sent as they are when empty or when they're larger
+	Transactions []int
+
+	// Concurrency is the number of requests in flight, 1 when omitted
+	Concurrency int
+
+	// Requests is the number of requests sent, the test stops after Duration when zero
+	Requests int
+	Duration time.Duration
+
+	// Timeout of every request, 30 seconds when omitted
+	Timeout time.Duration
+
+	// Client sends the requests, a client with Timeout when nil
+	Client *http.Client
+}
+
+// Latency are the latency percentiles of requests in milliseconds
+type Latency struct {
+	Min  float64 `json:"minMs"`
+	Mean float64 `json:"meanMs"`
+	P50  float64 `json:"p50Ms"`
+	P90  float64 `json:"p90Ms"`
+	P95  float64 `json:"p95Ms"`
+	P99  float64 `json:"p99Ms"`
+	Max  float64 `json:"maxMs"`
+}
+
+// Report is the outcome of a load test, responses with a status of 400 or more are errors and
+// ErrorRate is their percentage
+type Report struct {
+	Requests  int     `json:"requests"`
+	Errors    int     `json:"errors"`
+	ErrorRate float64 `json:"errorRate"`
+
+	// DurationMs is the duration of the test, Throughput the requests per second
+	DurationMs int64   `json:"durationMs"`
+	Throughput float64 `json:"throughput"`
+	Latency    Latency `json:"latency"`
+
+	// Statuses counts the requests by http status code, or StatusError
+	Statuses map[string]int `json:"statuses"`
+
+	Messages []MessageReport `json:"messages"`
+}
+
+// MessageReport is the outcome of the requests of a message by size
+type MessageReport struct {
+	Name         string  `json:"name"`
+	Transactions int     `json:"transactions,omitempty"`
+	Requests     int     `json:"requests"`
+	Errors       int     `json:"errors"`
+	AverageSize  float64 `json:"averageSize"`
+	Latency      Latency `json:"latency"`
+
+	latencies []time.Duration
+	totalSize int
+}
+
+type request struct {
+	message      int
+	transactions int
+}
+
+type result struct {
+	request
+	size    int
+	status  string
+	failed  bool
+	latency time.Duration
+}
+
+// Run sends the traffic of config until its requests are sent, its duration is elapsed or ctx
+// is done, and reports the responses
+func Run(ctx context.Context, config Config) (*Report, error) {
+	if len(config.Messages) == 0 {
+		return nil, ErrNoMessages
+	}
+	if config.Requests <= 0 && config.Duration <= 0 {
+		return nil, errors.New("requests or duration is required")
+	}
+	if config.Endpoint == "" {
+		config.Endpoint = DefaultEndpoint
+	}
+	if config.Concurrency <= 0 {
+		config.Concurrency = 1
+	}
+	if config.Client == nil {
+		timeout := config.Timeout
+		if timeout <= 0 {
+			timeout = defaultTimeout
+		}
+		config.Client = &http.Client{Timeout: timeout}
+	}
+	target := strings.TrimSuffix(config.URL, "/") + "/" + strings.TrimPrefix(config.Endpoint, "/")
+
+	// messages are checked before the traffic starts
+	weights := make([]int, len(config.Messages))
+	for i, msg := range config.Messages {
+		if msg.Weight < 0 {
+			return nil, fmt.Errorf("message %s: weight is negative", msg.Name)
+		}
+		weights[i] = msg.Weight
+		if weights[i] == 0 {
+			weights[i] = 1
+		}
+		for _, n := range append([]int{0}, config.Transactions...) {
+			if _, err := content(msg, n); err != nil {
+				return nil, fmt.Errorf("message %s: %w", msg.Name, err)
+			}
+		}
+	}
+
+	if config.Duration > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, config.Duration)
+		defer cancel()
+	}
+	requests := make(chan request)
+	go func() {
+		defer close(requests)
+		random := rand.New(rand.NewSource(time.Now().UnixNano()))
+		for i := 0; config.Requests <= 0 || i < config.Requests; i++ {
+			req := request{message: pick(random, weights)}
+			if len(config.Transactions) > 0 {
+				req.transactions = config.Transactions[random.Intn(len(config.Transactions))]
+			}
+			select {
+			case requests <- req:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	results := make(chan result)
+	var wg sync.WaitGroup
+	for i := 0; i < config.Concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for req := range requests {
+				results <- send(ctx, config, target, req)
+			}
+		}()
+	}
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+
+	start := time.Now()
+	report := &Report{Statuses: make(map[string]int)}
+	var latencies []time.Duration
+	messages := make(map[request]*MessageReport)
+	for res := range results {
+		// requests interrupted at the end of the test aren't counted
+		if res.status == StatusError && ctx.Err() != nil {
+			continue
+		}
+		report.Requests++
+		report.Statuses[res.status]++
+		latencies = append(latencies, res.latency)
+
+		m, exists := messages[res.request]
+		if !exists {
+			m = &MessageReport{Name: config.Messages[res.message].Name, Transactions: res.transactions}
+			messages[res.request] = m
+		}
+		m.Requests++
+		m.totalSize += res.size
+		m.latencies = append(m.latencies, res.latency)
+		if res.failed {
+			report.Errors++
+			m.Errors++
+		}
+	}
+	elapsed := time.Since(start)
+
+	report.DurationMs = elapsed.Milliseconds()
+	if report.Requests > 0 {
+		report.ErrorRate = 100 * float64(report.Errors) / float64(report.Requests)
+		report.Throughput = float64(report.Requests) / elapsed.Seconds()
+	}
+	report.Latency = newLatency(latencies)
+	for _, m := range messages {
+		m.AverageSize = float64(m.totalSize) / float64(m.Requests)
+		m.Latency = newLatency(m.latencies)
+		report.Messages = append(report.Messages, *m)
+	}
+	sort.Slice(report.Messages, func(i, j int) bool {
+		a, b := report.Messages[i], report.Messages[j]
+		if a.Name != b.Name {
+			return a.Name < b.Name
+		}
+		return a.Transactions < b.Transactions
+	})
+	return report, nil
+}
+
+// send posts the message of req to target
+func send(ctx context.Context, config Config, target string, req request) result {
+	res := result{request: req, status: StatusError, failed: true}
+	msg := config.Messages[req.message]
+	input, err := content(msg, req.transactions)
+	if err != nil {
+		return res
+	}
+	res.size = len(input)
+
+	var body bytes.Buffer
+	form := multipart.NewWriter(&body)
+	for name, value := range config.Fields {
+		form.WriteField(name, value)
+	}
+	part, err := form.CreateFormFile("input", msg.Name)
+	if err != nil {
+		return res
+	}
+	part.Write(input)
+	form.Close()
+
+	start := time.Now()
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, target, &body)
+	if err != nil {
+		return res
+	}
+	httpReq.Header.Set("Content-Type", form.FormDataContentType())
+	resp, err := config.Client.Do(httpReq)
+	if err != nil {
+		res.latency = time.Since(start)
+		return res
+	}
+	io.Copy(io.Discard, resp.Body)
+	resp.Body.Close()
+	res.latency = time.Since(start)
+	res.status = strconv.Itoa(resp.StatusCode)
+	res.failed = resp.StatusCode >= http.StatusBadRequest
+	return res
+}
+
+// content returns the content of msg with n transactions, as it is when n is zero
+func content(msg Message, n int) ([]byte, error) {
+	buf := msg.Content
+	if msg.Template != nil {
+		var err error
+		if buf, err = msg.Template.Render(msg.Values); err != nil {
+			return nil, err
+		}
+	}
+	if len(buf) == 0 {
+		return nil, errors.New("message is empty")
+	}
+	if n <= 0 {
+		return buf, nil
+	}
+	return Scale(buf, n)
+}
+
+// Scale returns the xml message of buf with n transactions, its first transaction is repeated
+// and its numbers of transactions updated, not its totals. Messages with n transactions or
+// more are returned as they are.
+func Scale(buf []byte, n int) ([]byte, error) {
+	if utils.GetDocumentFormat(buf) != utils.DocumentTypeXml {
+		return nil, errors.New("only xml messages are scaled")
+	}
+	doc, err := document.ParseIso20022Document(buf)
+	if err != nil {
+		return nil, err
+	}
+	var name string
+	count := 0
+	for _, tx := range utils.GetTransactions(doc.InspectMessage()) {
+		if tx.Path == "" {
+			continue
+		}
+		if name == "" {
+			segments := strings.Split(utils.StripElementIndexes(tx.Path), "/")
+			name = segments[len(segments)-1]
+		}
+		count++
+	}
+	if name == "" {
+		return nil, errors.New("message has no transactions")
+	}
+	if count >= n {
+		return buf, nil
+	}
+
+	start := bytes.Index(buf, []byte("<"+name+">"))
+	end := bytes.Index(buf, []byte("</"+name+">"))
+	if start < 0 || end < start {
+		return nil, fmt.Errorf("transaction %s isn't found", name)
+	}
+	end += len("</" + name + ">")
+	tx := buf[start:end]
+
+	// the numbers of transactions precede the first transaction
+	var scaled bytes.Buffer
+	scaled.Write(numberOfTxs.ReplaceAll(buf[:end], []byte(fmt.Sprintf("<NbOfTxs>%d</NbOfTxs>", n))))
+	for i := count; i < n; i++ {
+		scaled.Write(tx)
+	}
+	scaled.Write(buf[end:])
+	return scaled.Bytes(), nil
+}
+
+// pick returns the index of a weight picked at random
+func pick(random *rand.Rand, weights []int) int {
+	total := 0
+	for _, weight := range weights {
+		total += weight
+	}
+	n := random.Intn(total)
+	for i, weight := range weights {
+		if n < weight {
+			return i
+		}
+		n -= weight
+	}
+	return len(weights) - 1
+}
+
+// newLatency returns the percentiles of latencies, nearest rank
+func newLatency(latencies []time.Duration) Latency {
+	if len(latencies) == 0 {
+		return Latency{}
+	}
+	sort.Slice(latencies, func(i, j int) bool { return latencies[i] < latencies[j] })
+	var total time.Duration
+	for _, latency := range latencies {
+		total += latency
+	}
+	percentile := func(p float64) float64 {
+		rank := int(math.Ceil(p*float64(len(latencies)))) - 1
+		if rank < 0 {
+			rank = 0
+		}
+		return milliseconds(latencies[rank])
+	}
+	return Latency{
+		Min:  milliseconds(latencies[0]),
+		Mean: milliseconds(total / time.Duration(len(latencies))),
+		P50:  percentile(0.50),
+		P90:  percentile(0.90),
+		P95:  percentile(0.95),
+		P99:  percentile(0.99),
+		Max:  milliseconds(latencies[len(latencies)-1]),
+	}
+}
+
+func milliseconds(d time.Duration) float64 {
+	return float64(d) / float64(time.Millisecond)
+}
@@ -0,0 +1,132 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package loadtest
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/moov-io/iso20022/pkg/document"
+	"github.com/moov-io/iso20022/pkg/iso20022test/fixtures"
+	"github.com/moov-io/iso20022/pkg/templates"
+	"github.com/moov-io/iso20022/pkg/utils"
+)
+
+func TestRun(t *testing.T) {
+	var mu sync.Mutex
+	ids := make(map[string]bool)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/validator", r.URL.Path)
+		file, _, err := r.FormFile("input")
+		require.Nil(t, err)
+		buf, _ := io.ReadAll(file)
+		if strings.Contains(string(buf), "camt.053") {
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			return
+		}
+		mu.Lock()
+		ids[utils.FindElementValues(parse(t, buf).InspectMessage(), "GrpHdr/MsgId")[0]] = true
+		mu.Unlock()
+	}))
+	defer server.Close()
+
+	payment := &templates.Template{
+		Name:    "payment",
+		Content: strings.Replace(string(fixtures.ReadFile(t, "valid_pacs_v08.xml")), "MSG-20210415-0001", "${id}", 1),
+	}
+	report, err := Run(context.Background(), Config{
+		URL: server.URL,
+		Messages: []Message{
+			{Name: "pacs.008", Template: payment, Weight: 3},
+			{Name: "camt.053", Content: fixtures.ReadFile(t, "valid_camt053_v08.xml")},
+		},
+		Concurrency: 4,
+		Requests:    40,
+	})
+	require.Nil(t, err)
+	require.Equal(t, 40, report.Requests)
+	require.Equal(t, report.Statuses["422"], report.Errors)
+	require.Equal(t, 40, report.Statuses["200"]+report.Statuses["422"])
+	require.InDelta(t, 100*float64(report.Errors)/40, report.ErrorRate, 0.001)
+	require.Greater(t, report.Throughput, 0.0)
+	require.LessOrEqual(t, report.Latency.Min, report.Latency.P50)
+	require.LessOrEqual(t, report.Latency.P50, report.Latency.P99)
+	require.LessOrEqual(t, report.Latency.P99, report.Latency.Max)
+
+	// templates are rendered for every request
+	require.Len(t, ids, report.Statuses["200"])
+	require.Len(t, report.Messages, 2)
+	require.Equal(t, "camt.053", report.Messages[0].Name)
+	require.Equal(t, report.Messages[0].Requests, report.Messages[0].Errors)
+	require.Equal(t, "pacs.008", report.Messages[1].Name)
+	require.Zero(t, report.Messages[1].Errors)
+}
+
+func TestRunDuration(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(5 * time.Millisecond)
+	}))
+	defer server.Close()
+
+	start := time.Now()
+	report, err := Run(context.Background(), Config{
+		URL:          server.URL,
+		Endpoint:     "convert",
+		Messages:     []Message{{Name: "pacs.008", Content: fixtures.ReadFile(t, "valid_pacs_v08.xml")}},
+		Transactions: []int{2, 10},
+		Duration:     100 * time.Millisecond,
+	})
+	require.Nil(t, err)
+	require.Less(t, time.Since(start), time.Second)
+	require.Greater(t, report.Requests, 0)
+	require.Zero(t, report.Errors)
+	require.Len(t, report.Messages, 2)
+	require.Equal(t, 2, report.Messages[0].Transactions)
+	require.Equal(t, 10, report.Messages[1].Transactions)
+	require.Greater(t, report.Messages[1].AverageSize, report.Messages[0].AverageSize)
+}
+
+func TestRunErrors(t *testing.T) {
+	_, err := Run(context.Background(), Config{Requests: 1})
+	require.ErrorIs(t, err, ErrNoMessages)
+	_, err = Run(context.Background(), Config{Messages: []Message{{Name: "empty"}}})
+	require.ErrorContains(t, err, "requests or duration is required")
+	_, err = Run(context.Background(), Config{Messages: []Message{{Name: "empty"}}, Requests: 1})
+	require.ErrorContains(t, err, "message empty: message is empty")
+	_, err = Run(context.Background(), Config{
+		Messages:     []Message{{Name: "json", Content: fixtures.ReadFile(t, "valid_pacs_v08.json")}},
+		Transactions: []int{10},
+		Requests:     1,
+	})
+	require.ErrorContains(t, err, "only xml messages are scaled")
+}
+
+func TestScale(t *testing.T) {
+	buf := fixtures.ReadFile(t, "valid_pacs_v08.xml")
+	scaled, err := Scale(buf, 5)
+	require.Nil(t, err)
+	msg := parse(t, scaled).InspectMessage()
+	require.Len(t, utils.FindElementValues(msg, "CdtTrfTxInf/PmtId/EndToEndId"), 5)
+	require.Equal(t, []string{"5"}, utils.FindElementValues(msg, "GrpHdr/NbOfTxs"))
+
+	// messages are never shrunk
+	scaled, err = Scale(buf, 1)
+	require.Nil(t, err)
+	require.Equal(t, buf, scaled)
+}
+
+func parse(t *testing.T, buf []byte) document.Iso20022Document {
+	doc, err := document.ParseIso20022Document(buf)
+	require.Nil(t, err)
+	return doc
+}
@@ -0,0 +1,358 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package manifest
+
+/*
+	Manifest records the provenance of a batch run for auditors of payment file
+	transformations: every input with its SHA-256, the outputs written from it, the versions
+	of the library, profiles and code lists in use and the timing of the run:
+
+		recorder := manifest.NewRecorder("convert")
+		p := pipeline.New(recorder.Source(source), recorder.Sink(recorder.Output(layout)),
+			pipeline.WithErrorSink(recorder.ErrorSink(queue.Sink())))
+		_, err := p.Run(ctx)
+		...
+		err = manifest.Write("run.manifest.json", recorder.Finish(), signer)
+
+	Write signs the manifest with a detached JWS written next to it, see Verify. Runs that
+	don't use a pipeline record their inputs and outputs with RecordInput and RecordOutput.
+*/
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"runtime"
+	"runtime/debug"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/moov-io/iso20022/pkg/jws"
+	"github.com/moov-io/iso20022/pkg/pipeline"
+	"github.com/moov-io/iso20022/pkg/profile"
+)
+
+const (
+	// Statuses of inputs, inputs are pending until their item completes the pipeline
+	StatusPending   = "pending"
+	StatusSucceeded = "succeeded"
+	StatusFailed    = "failed"
+
+	// HeaderInput is the header of items holding the index of their input in the manifest
+	HeaderInput = "x-manifest-input"
+
+	// SignatureExtension is appended to the path of a manifest for the file of its signature
+	SignatureExtension = ".jws"
+
+	// stageSink is the stage of inputs failed by the sink of the pipeline
+	stageSink = "sink"
+
+	modulePath = "github.com/moov-io/iso20022"
+)
+
+// Manifest is the provenance of a batch run
+type Manifest struct {
+	RunID      string    `json:"runId"`
+	Command    string    `json:"command,omitempty"`
+	Started    time.Time `json:"started"`
+	Finished   time.Time `json:"finished"`
+	DurationMs int64     `json:"durationMs"`
+	Versions   Versions  `json:"versions"`
+	Inputs     []Input   `json:"inputs"`
+	Outputs    []Output  `json:"outputs"`
+}
+
+// Versions are the versions of the library, Go and the profiles and code lists of a run
+type Versions struct {
+	Library   string    `json:"library"`
+	Go        string    `json:"go"`
+	Profiles  []Version `json:"profiles,omitempty"`
+	CodeLists []Version `json:"codeLists,omitempty"`
+}
+
+// Version is the version of a profile or code list
+type Version struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
+
+// Input is a message read by a run, Stage and Error are the failing stage and its error
+type Input struct {
+	Name       string    `json:"name"`
+	SHA256     string    `json:"sha256"`
+	Size       int       `json:"size"`
+	Status     string    `json:"status"`
+	Stage      string    `json:"stage,omitempty"`
+	Error      string    `json:"error,omitempty"`
+	Started    time.Time `json:"started"`
+	DurationMs int64     `json:"durationMs"`
+}
+
+// Output is a file written by a run, Inputs are the indexes of the inputs it was produced from
+type Output struct {
+	Path   string `json:"path"`
+	SHA256 string `json:"sha256"`
+	Size   int    `json:"size"`
+	Inputs []int  `json:"inputs"`
+}
+
+// Recorder records the manifest of a run, it's safe for concurrent use
+type Recorder struct {
+	mu       sync.Mutex
+	manifest Manifest
+	now      func() time.Time
+}
+
+// NewRecorder starts the manifest of a run of command
+func NewRecorder(command string) *Recorder {
+	return newRecorder(command, time.Now)
+}
+
+func newRecorder(command string, now func() time.Time) *Recorder {
+	return &Recorder{
+		manifest: Manifest{
+			RunID:    runID(),
+			Command:  command,
+			Started:  now().UTC(),
+			Versions: CurrentVersions(),
+			Inputs:   []Input{},
+			Outputs:  []Output{},
+		},
+		now: now,
+	}
+}
+
+func runID() string {
+	buf := make([]byte, 16)
+	if _, err := rand.Read(buf); err != nil {
+		return strconv.FormatInt(time.Now().UnixNano(), 36)
+	}
+	return hex.EncodeToString(buf)
+}
+
+// CurrentVersions returns the versions of the library, Go and the registered profiles and code lists
+func CurrentVersions() Versions {
+	v := Versions{Library: libraryVersion(), Go: runtime.Version()}
+	for _, name := range profile.Names() {
+		if p, err := profile.Get(name); err == nil {
+			v.Profiles = append(v.Profiles, Version{Name: p.Name, Version: p.Version})
+		}
+	}
+	for _, name := range profile.CodeListNames() {
+		if l, err := profile.GetCodeList(name); err == nil {
+			v.CodeLists = append(v.CodeLists, Version{Name: l.Name, Version: l.Version})
+		}
+	}
+	return v
+}
+
+// libraryVersion returns the module version of the library in the running binary, (devel)
+// when built from its sources
+func libraryVersion() string {
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return "unknown"
+	}
+	version := ""
+	if info.Main.Path == modulePath {
+		version = info.Main.Version
+	}
+	for _, dep := range info.Deps {
+		if dep.Path == modulePath {
+			version = dep.Version
+			if dep.Replace != nil {
+				version = dep.Replace.Version
+			}
+		}
+	}
+	if version == "" {
+		return "(devel)"
+	}
+	return version
+}
+
+func hash(content []byte) string {
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:])
+}
+
+// RecordInput records an input read by the run and returns its index
+func (r *Recorder) RecordInput(name string, content []byte) int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.manifest.Inputs = append(r.manifest.Inputs, Input{
+		Name:    name,
+		SHA256:  hash(content),
+		Size:    len(content),
+		Status:  StatusPending,
+		Started: r.now().UTC(),
+	})
+	return len(r.manifest.Inputs) - 1
+}
+
+// RecordOutput records a file written by the run from the inputs of indexes
+func (r *Recorder) RecordOutput(path string, content []byte, inputs ...int) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if inputs == nil {
+		inputs = []int{}
+	}
+	r.manifest.Outputs = append(r.manifest.Outputs, Output{Path: path, SHA256: hash(content), Size: len(content), Inputs: inputs})
+}
+
+// Succeeded completes the input of index
+func (r *Recorder) Succeeded(index int) {
+	r.complete(index, StatusSucceeded, "", nil)
+}
+
+// Failed completes the input of index which failed stage with err
+func (r *Recorder) Failed(index int, stage string, err error) {
+	r.complete(index, StatusFailed, stage, err)
+}
+
+func (r *Recorder) complete(index int, status, stage string, err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if index < 0 || index >= len(r.manifest.Inputs) {
+		return
+	}
+	in := &r.manifest.Inputs[index]
+	in.Status, in.Stage, in.DurationMs = status, stage, r.now().Sub(in.Started).Milliseconds()
+	if err != nil {
+		in.Error = err.Error()
+	}
+}
+
+// Finish ends the run and returns its manifest
+func (r *Recorder) Finish() *Manifest {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	m := r.manifest
+	m.Finished = r.now().UTC()
+	m.DurationMs = m.Finished.Sub(m.Started).Milliseconds()
+	m.Inputs = append([]Input{}, m.Inputs...)
+	m.Outputs = append([]Output{}, m.Outputs...)
+	return &m
+}
+
+// input returns item with the header of its input, recording the input of items which
+// weren't emitted by Source, e.g. redriven dead letters
+func (r *Recorder) input(item pipeline.Item) (pipeline.Item, int) {
+	if index, err := strconv.Atoi(item.Headers[HeaderInput]); err == nil {
+		return item, index
+	}
+	index := r.RecordInput(item.Name, item.Input)
+	headers := make(map[string]string, len(item.Headers)+1)
+	for key, value := range item.Headers {
+		headers[key] = value
+	}
+	headers[HeaderInput] = strconv.Itoa(index)
+	item.Headers = headers
+	return item, index
+}
+
+// Source records the inputs of the messages of source
+func (r *Recorder) Source(source pipeline.Source) pipeline.Source {
+	return func(ctx context.Context, emit func(pipeline.Item) error) error {
+		return source(ctx, func(item pipeline.Item) error {
+			item, _ = r.input(item)
+			return emit(item)
+		})
+	}
+}
+
+// Sink completes the inputs of the items delivered to sink, which may be nil, the inputs
+// of items failed by sink are failed
+func (r *Recorder) Sink(sink pipeline.Sink) pipeline.Sink {
+	return func(ctx context.Context, item pipeline.Item) error {
+		item, index := r.input(item)
+		if sink != nil {
+			if err := sink(ctx, item); err != nil {
+				r.Failed(index, stageSink, err)
+				return err
+			}
+		}
+		r.Succeeded(index)
+		return nil
+	}
+}
+
+// ErrorSink fails the inputs of the failed items before delivering them to sink, which may
+// be nil
+func (r *Recorder) ErrorSink(sink pipeline.Sink) pipeline.Sink {
+	return func(ctx context.Context, item pipeline.Item) error {
+		item, index := r.input(item)
+		r.Failed(index, item.Stage, item.Err)
+		if sink == nil {
+			return nil
+		}
+		return sink(ctx, item)
+	}
+}
+
+// Output writes every item to the file of layout, see pipeline.Output, and records the file
+func (r *Recorder) Output(layout pipeline.Layout) pipeline.Sink {
+	return func(ctx context.Context, item pipeline.Item) error {
+		item, index := r.input(item)
+		path, content, err := layout.Write(item)
+		if err != nil {
+			return err
+		}
+		r.RecordOutput(path, content, index)
+		return nil
+	}
+}
+
+// Write writes m to path, and its detached JWS to path with SignatureExtension when signer
+// isn't nil
+func Write(path string, m *Manifest, signer *jws.Signer) error {
+	buf, err := json.MarshalIndent(m, "", "\t")
+	if err != nil {
+		return err
+	}
+	if signer != nil {
+		signature, err := signer.Sign(buf)
+		if err != nil {
+			return fmt.Errorf("manifest: signing: %w", err)
+		}
+		if err := pipeline.WriteFile(path+SignatureExtension, []byte(signature+"\n")); err != nil {
+			return err
+		}
+	}
+	return pipeline.WriteFile(path, buf)
+}
+
+// Verify reads the manifest of path and verifies its signature, the file of path with
+// SignatureExtension, with the private key of key, and returns when it was signed
+func Verify(path string, key crypto.PublicKey) (*Manifest, time.Time, error) {
+	buf, err := os.ReadFile(path)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	signature, err := os.ReadFile(path + SignatureExtension)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	signed, err := jws.Verify(strings.TrimSpace(string(signature)), buf, key)
+	if err != nil {
+		return nil, time.Time{}, fmt.Errorf("manifest %s: %w", path, err)
+	}
+	var m Manifest
+	if err := json.Unmarshal(buf, &m); err != nil {
+		return nil, time.Time{}, fmt.Errorf("manifest %s: %w", path, err)
+	}
+	return &m, signed, nil
+}
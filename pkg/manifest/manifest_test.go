@@ -0,0 +1,146 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package manifest
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"errors"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/moov-io/iso20022/pkg/deadletter"
+	"github.com/moov-io/iso20022/pkg/iso20022test/fixtures"
+	"github.com/moov-io/iso20022/pkg/jws"
+	"github.com/moov-io/iso20022/pkg/pipeline"
+	"github.com/moov-io/iso20022/pkg/storage"
+)
+
+// clock advances a second on every reading
+func clock() func() time.Time {
+	now := time.Date(2021, time.March, 1, 12, 0, 0, 0, time.UTC)
+	return func() time.Time {
+		now = now.Add(time.Second)
+		return now
+	}
+}
+
+func TestRecorder(t *testing.T) {
+	dir := t.TempDir()
+	valid := fixtures.ReadFile(t, "valid_pacs_v08.xml")
+	invalid := []byte("<Document><Unknown/></Document>")
+	require.Nil(t, os.WriteFile(filepath.Join(dir, "valid.xml"), valid, 0600))
+	require.Nil(t, os.WriteFile(filepath.Join(dir, "invalid.xml"), invalid, 0600))
+
+	recorder := newRecorder("convert", clock())
+	queue := deadletter.NewQueue(storage.NewMemoryStore())
+	out := filepath.Join(dir, "out")
+	p := pipeline.New(
+		recorder.Source(pipeline.Files(filepath.Join(dir, "valid.xml"), filepath.Join(dir, "invalid.xml"))),
+		recorder.Sink(recorder.Output(pipeline.Layout{Dir: out})),
+		pipeline.WithErrorSink(recorder.ErrorSink(queue.Sink())),
+		pipeline.WithWorkers(1),
+	)
+	_, err := p.Run(context.Background())
+	require.Nil(t, err)
+
+	m := recorder.Finish()
+	require.Len(t, m.RunID, 32)
+	require.Equal(t, "convert", m.Command)
+	require.Equal(t, runtime.Version(), m.Versions.Go)
+	require.NotEmpty(t, m.Versions.Library)
+	require.NotEmpty(t, m.Versions.Profiles)
+	require.True(t, m.Finished.After(m.Started))
+	require.Equal(t, m.Finished.Sub(m.Started).Milliseconds(), m.DurationMs)
+
+	require.Len(t, m.Inputs, 2)
+	inputs := make(map[string]Input)
+	for _, in := range m.Inputs {
+		inputs[filepath.Base(in.Name)] = in
+	}
+	require.Equal(t, StatusSucceeded, inputs["valid.xml"].Status)
+	require.Equal(t, hash(valid), inputs["valid.xml"].SHA256)
+	require.Equal(t, len(valid), inputs["valid.xml"].Size)
+	require.Positive(t, inputs["valid.xml"].DurationMs)
+	require.Equal(t, StatusFailed, inputs["invalid.xml"].Status)
+	require.Equal(t, pipeline.StageParse, inputs["invalid.xml"].Stage)
+	require.NotEmpty(t, inputs["invalid.xml"].Error)
+
+	require.Len(t, m.Outputs, 1)
+	require.Equal(t, filepath.Join(out, "valid.xml"), m.Outputs[0].Path)
+	require.Equal(t, hash(valid), m.Outputs[0].SHA256)
+	require.Equal(t, "valid.xml", filepath.Base(m.Inputs[m.Outputs[0].Inputs[0]].Name))
+
+	// dead letters keep the index of their input
+	letters, err := queue.List(context.Background())
+	require.Nil(t, err)
+	require.Len(t, letters, 1)
+	require.Equal(t, "invalid.xml", filepath.Base(m.Inputs[1].Name))
+	require.Equal(t, "1", letters[0].Headers[HeaderInput])
+}
+
+func TestRecorderSink(t *testing.T) {
+	recorder := newRecorder("redrive", clock())
+	failing := recorder.Sink(func(context.Context, pipeline.Item) error { return errors.New("disk full") })
+
+	err := failing(context.Background(), pipeline.Item{Name: "letter-1", Input: []byte("<Document/>")})
+	require.EqualError(t, err, "disk full")
+
+	index := recorder.RecordInput("statement.xml", []byte("<Document/>"))
+	recorder.RecordOutput("out/a.xml", []byte("a"), index)
+	recorder.RecordOutput("out/b.xml", []byte("b"), index)
+	recorder.Succeeded(index)
+
+	m := recorder.Finish()
+	require.Equal(t, []Input{
+		{Name: "letter-1", SHA256: hash([]byte("<Document/>")), Size: 11, Status: StatusFailed, Stage: "sink", Error: "disk full", Started: m.Started.Add(time.Second), DurationMs: 1000},
+		{Name: "statement.xml", SHA256: hash([]byte("<Document/>")), Size: 11, Status: StatusSucceeded, Started: m.Started.Add(3 * time.Second), DurationMs: 1000},
+	}, m.Inputs)
+	require.Equal(t, []Output{
+		{Path: "out/a.xml", SHA256: hash([]byte("a")), Size: 1, Inputs: []int{1}},
+		{Path: "out/b.xml", SHA256: hash([]byte("b")), Size: 1, Inputs: []int{1}},
+	}, m.Outputs)
+}
+
+func TestWriteAndVerify(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.Nil(t, err)
+	signer, err := jws.NewSigner(key, "batch")
+	require.Nil(t, err)
+
+	recorder := NewRecorder("split")
+	recorder.Succeeded(recorder.RecordInput("statement.xml", []byte("<Document/>")))
+	m := recorder.Finish()
+
+	path := filepath.Join(t.TempDir(), "run.manifest.json")
+	require.Nil(t, Write(path, m, signer))
+
+	verified, _, err := Verify(path, key.Public())
+	require.Nil(t, err)
+	require.Equal(t, m.RunID, verified.RunID)
+	require.Equal(t, m.Inputs, verified.Inputs)
+
+	// tampered manifests
+	buf, err := os.ReadFile(path)
+	require.Nil(t, err)
+	require.Nil(t, os.WriteFile(path, append(buf, ' '), 0600))
+	_, _, err = Verify(path, key.Public())
+	require.ErrorIs(t, err, jws.ErrInvalidSignature)
+
+	// unsigned manifests
+	unsigned := filepath.Join(t.TempDir(), "run.manifest.json")
+	require.Nil(t, Write(unsigned, m, nil))
+	_, err = os.Stat(unsigned + SignatureExtension)
+	require.True(t, os.IsNotExist(err))
+	_, _, err = Verify(unsigned, key.Public())
+	require.ErrorIs(t, err, os.ErrNotExist)
+}
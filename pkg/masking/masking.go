@@ -0,0 +1,203 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package masking
+
+/*
+	Package masking masks the personal data of messages (IBANs, names and remittance text) in
+	log lines and error messages, keeping only their last characters:
+
+		DE89370400440532013000	->	******************3000
+
+	IBANs are recognized anywhere, names and remittance text once the values of the documents
+	holding them are known to the masker.
+*/
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+
+	kitlog "github.com/go-kit/log"
+
+	"github.com/moov-io/iso20022/pkg/document"
+	"github.com/moov-io/iso20022/pkg/utils"
+)
+
+const (
+	// DefaultVisible is the number of trailing characters left visible
+	DefaultVisible = 4
+
+	// minValueLength is the length of the shortest values masked, shorter ones (e.g. country
+	// codes) would mask unrelated text
+	minValueLength = 4
+
+	// maxLearned bounds the values of documents the masker keeps for masking logs
+	maxLearned = 4096
+)
+
+// PersonalElements are the elements holding personal data, masked by default
+var PersonalElements = []string{"IBAN", "Nm", "Ustrd", "AddtlRmtInf"}
+
+var ibanPattern = regexp.MustCompile(`\b[A-Z]{2}[0-9]{2}[A-Z0-9]{11,30}\b`)
+
+// Config - Defines the masking of personal data in logs and errors
+type Config struct {
+	Enabled bool
+
+	// Visible is the number of trailing characters left visible, DefaultVisible when zero
+	Visible int
+
+	// Elements are masked in addition to PersonalElements, e.g. AdrLine or TwnNm
+	Elements []string
+}
+
+// Masker masks personal data in text
+type Masker struct {
+	visible  int
+	elements map[string]bool
+
+	mu      sync.RWMutex
+	learned map[string]bool
+	order   []string
+}
+
+// New returns nil unless config enables masking, a nil Masker masks nothing
+func New(config Config) *Masker {
+	if !config.Enabled {
+		return nil
+	}
+	m := &Masker{
+		visible:  config.Visible,
+		elements: make(map[string]bool),
+		learned:  make(map[string]bool),
+	}
+	if m.visible <= 0 {
+		m.visible = DefaultVisible
+	}
+	for _, name := range append(append([]string{}, PersonalElements...), config.Elements...) {
+		m.elements[name] = true
+	}
+	return m
+}
+
+// Values returns the personal data of doc
+func (m *Masker) Values(doc document.Iso20022Document) []string {
+	if m == nil || doc == nil {
+		return nil
+	}
+	var values []string
+	for _, elm := range utils.GetElements(doc.InspectMessage()) {
+		name := elm.Path[strings.LastIndex(elm.Path, "/")+1:]
+		if i := strings.Index(name, "["); i >= 0 {
+			name = name[:i]
+		}
+		if m.elements[name] && len(strings.TrimSpace(elm.Value)) >= minValueLength {
+			values = append(values, strings.TrimSpace(elm.Value))
+		}
+	}
+	return values
+}
+
+// Learn keeps values to mask them in every text, e.g. the log lines written after an error
+func (m *Masker) Learn(values ...string) {
+	if m == nil {
+		return
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, value := range values {
+		if m.learned[value] {
+			continue
+		}
+		m.learned[value] = true
+		m.order = append(m.order, value)
+		if len(m.order) > maxLearned {
+			delete(m.learned, m.order[0])
+			m.order = m.order[1:]
+		}
+	}
+}
+
+// Mask returns text whose IBANs, learned values and values are masked
+func (m *Masker) Mask(text string, values ...string) string {
+	if m == nil || text == "" {
+		return text
+	}
+
+	m.mu.RLock()
+	for _, value := range m.order {
+		if strings.Contains(text, value) {
+			values = append(values, value)
+		}
+	}
+	m.mu.RUnlock()
+
+	// longer values first, a name may hold a shorter one
+	sort.Slice(values, func(i, j int) bool { return len(values[i]) > len(values[j]) })
+	for _, value := range values {
+		if len(value) >= minValueLength {
+			text = strings.ReplaceAll(text, value, m.mask(value))
+		}
+	}
+	return ibanPattern.ReplaceAllStringFunc(text, m.mask)
+}
+
+func (m *Masker) mask(value string) string {
+	runes := []rune(value)
+	hidden := len(runes) - m.visible
+	if hidden < len(runes)/2 {
+		// short values keep at most half of their characters
+		hidden = len(runes) - len(runes)/2
+	}
+	return strings.Repeat("*", hidden) + string(runes[hidden:])
+}
+
+// Error returns err with a masked message, errors.Is and errors.As still find the errors it wraps
+func (m *Masker) Error(err error, values ...string) error {
+	if m == nil || err == nil {
+		return err
+	}
+	masked := m.Mask(err.Error(), values...)
+	if masked == err.Error() {
+		return err
+	}
+	return &maskedError{message: masked, err: err}
+}
+
+type maskedError struct {
+	message string
+	err     error
+}
+
+func (e *maskedError) Error() string {
+	return e.message
+}
+
+func (e *maskedError) Unwrap() error {
+	return e.err
+}
+
+// Writer returns a logger masking the values of the log lines written to next, e.g. the
+// writer of log.NewLogger
+func (m *Masker) Writer(next kitlog.Logger) kitlog.Logger {
+	if m == nil {
+		return next
+	}
+	return kitlog.LoggerFunc(func(keyvals ...interface{}) error {
+		masked := make([]interface{}, len(keyvals))
+		for i, value := range keyvals {
+			switch v := value.(type) {
+			case string:
+				masked[i] = m.Mask(v)
+			case error:
+				masked[i] = m.Mask(v.Error())
+			default:
+				masked[i] = value
+			}
+		}
+		return next.Log(masked...)
+	})
+}
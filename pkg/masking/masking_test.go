@@ -0,0 +1,87 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package masking
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	kitlog "github.com/go-kit/log"
+	"github.com/moov-io/base/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/moov-io/iso20022/pkg/document"
+	"github.com/moov-io/iso20022/pkg/iso20022test/fixtures"
+)
+
+func TestMask(t *testing.T) {
+	m := New(Config{Enabled: true})
+
+	require.Equal(t, "rejected ******************3000", m.Mask("rejected DE89370400440532013000"))
+	require.Equal(t, "payment of ******mith", m.Mask("payment of John Smith", "John Smith"))
+	require.Equal(t, "code **AD", m.Mask("code SHAD", "SHAD"))
+
+	// short values are left alone
+	require.Equal(t, "country DE", m.Mask("country DE", "DE"))
+
+	m = New(Config{Enabled: true, Visible: 2})
+	require.Equal(t, "********th", m.Mask("John Smith", "John Smith"))
+
+	// a disabled masker masks nothing
+	m = New(Config{})
+	require.Nil(t, m)
+	require.Equal(t, "DE89370400440532013000", m.Mask("DE89370400440532013000"))
+}
+
+func TestValues(t *testing.T) {
+	buf := fixtures.ReadFile(t, "valid_pacs_v08.xml")
+	doc, err := document.ParseIso20022Document(buf)
+	require.Nil(t, err)
+
+	m := New(Config{Enabled: true})
+	values := m.Values(doc)
+	require.Contains(t, values, "John Smith")
+	require.Contains(t, values, "Jane Doe")
+	require.Contains(t, values, "Invoice 2021-0042")
+
+	m = New(Config{Enabled: true, Elements: []string{"Ctry"}})
+	require.NotContains(t, m.Values(doc), "DE", "values shorter than 4 characters aren't masked")
+}
+
+func TestLearn(t *testing.T) {
+	m := New(Config{Enabled: true})
+	m.Learn("John Smith", "Invoice 2021-0042")
+
+	require.Equal(t, "paid ******mith for *************0042", m.Mask("paid John Smith for Invoice 2021-0042"))
+}
+
+var errRejected = errors.New("rejected")
+
+func TestError(t *testing.T) {
+	m := New(Config{Enabled: true})
+
+	err := m.Error(fmt.Errorf("%w: payment of Jane Doe", errRejected), "Jane Doe")
+	require.Equal(t, "rejected: payment of **** Doe", err.Error())
+	require.ErrorIs(t, err, errRejected)
+
+	unmasked := errors.New("invalid document")
+	require.Equal(t, unmasked, m.Error(unmasked))
+	require.Nil(t, m.Error(nil))
+}
+
+func TestWriter(t *testing.T) {
+	m := New(Config{Enabled: true})
+	m.Learn("John Smith")
+
+	var lines strings.Builder
+	logger := log.NewLogger(m.Writer(kitlog.NewLogfmtLogger(&lines)))
+	logger.With(log.Fields{"debtor": log.String("John Smith")}).Log("paid DE89370400440532013000")
+
+	require.Contains(t, lines.String(), "******mith")
+	require.Contains(t, lines.String(), "******************3000")
+	require.NotContains(t, lines.String(), "John Smith")
+}
@@ -0,0 +1,188 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package messagetype
+
+/*
+	Messagetype names the supported iso 20022 messages with typed constants instead of
+	string literals:
+
+		t, err := messagetype.Parse("pacs.008.001.08")
+		if t == messagetype.MsgPacs008V08 && t.Family() == messagetype.FamilyPacs {
+			...
+		}
+*/
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/moov-io/iso20022/pkg/utils"
+)
+
+// Type is a message type with its version, e.g. pacs.008.001.08
+type Type string
+
+// Family is the business area of message types, e.g. pacs
+type Family string
+
+const (
+	FamilyAcmt Family = "acmt"
+	FamilyAdmi Family = "admi"
+	FamilyAuth Family = "auth"
+	FamilyCamt Family = "camt"
+	FamilyHead Family = "head"
+	FamilyPacs Family = "pacs"
+	FamilyPain Family = "pain"
+	FamilyReda Family = "reda"
+	FamilyRemt Family = "remt"
+)
+
+var (
+	// ErrInvalidType is returned when a string isn't formatted like a message type
+	ErrInvalidType = errors.New("invalid message type")
+
+	// ErrUnsupportedType is returned when a message type isn't supported
+	ErrUnsupportedType = errors.New("unsupported message type")
+
+	familyDescriptions = map[Family]string{
+		FamilyAcmt: "Account Management",
+		FamilyAdmi: "Administration",
+		FamilyAuth: "Authorities",
+		FamilyCamt: "Cash Management",
+		FamilyHead: "Business Application Header",
+		FamilyPacs: "Payments Clearing and Settlement",
+		FamilyPain: "Payments Initiation",
+		FamilyReda: "Reference Data",
+		FamilyRemt: "Payments Remittance Advice",
+	}
+
+	supported = make(map[Type]bool, len(all))
+)
+
+func init() {
+	for _, t := range all {
+		supported[t] = true
+	}
+}
+
+// All returns every supported message type
+func All() []Type {
+	return append([]Type(nil), all...)
+}
+
+// Parse returns the supported message type of s, a message type (pacs.008.001.08) or a namespace
+func Parse(s string) (Type, error) {
+	t := Type(strings.TrimPrefix(strings.TrimSpace(s), utils.NameSpacePrefix))
+	if !t.valid() {
+		return "", fmt.Errorf("%w: %s", ErrInvalidType, s)
+	}
+	if !supported[t] {
+		return "", fmt.Errorf("%w: %s", ErrUnsupportedType, s)
+	}
+	return t, nil
+}
+
+// FromNameSpace returns the supported message type of namespace
+func FromNameSpace(namespace string) (Type, error) {
+	if !strings.HasPrefix(namespace, utils.NameSpacePrefix) {
+		return "", fmt.Errorf("%w: %s", ErrInvalidType, namespace)
+	}
+	return Parse(namespace)
+}
+
+// OfFamily returns the supported message types of family
+func OfFamily(family Family) []Type {
+	var types []Type
+	for _, t := range all {
+		if t.Family() == family {
+			types = append(types, t)
+		}
+	}
+	return types
+}
+
+// Versions returns the supported versions of message, e.g. pacs.008
+func Versions(message string) []Type {
+	var types []Type
+	for _, t := range all {
+		if t.Message() == message {
+			types = append(types, t)
+		}
+	}
+	return types
+}
+
+func (t Type) String() string {
+	return string(t)
+}
+
+// NameSpace returns the namespace of documents holding the message type
+func (t Type) NameSpace() string {
+	return utils.NameSpacePrefix + string(t)
+}
+
+// Supported reports whether documents of the message type are read and written
+func (t Type) Supported() bool {
+	return supported[t]
+}
+
+// Family returns the business area of the message type
+func (t Type) Family() Family {
+	return Family(t.part(0))
+}
+
+// Message returns the message type without its variant and version, e.g. pacs.008
+func (t Type) Message() string {
+	return t.part(0) + "." + t.part(1)
+}
+
+// Variant returns the variant of the message type, e.g. 1 of pacs.008.001.08
+func (t Type) Variant() int {
+	n, _ := strconv.Atoi(t.part(2))
+	return n
+}
+
+// Version returns the version of the message type, e.g. 8 of pacs.008.001.08
+func (t Type) Version() int {
+	n, _ := strconv.Atoi(t.part(3))
+	return n
+}
+
+// valid reports whether t is formatted like a message type: four letters and three numbers
+func (t Type) valid() bool {
+	parts := strings.Split(string(t), ".")
+	if len(parts) != 4 || len(parts[0]) != 4 {
+		return false
+	}
+	for _, c := range parts[0] {
+		if c < 'a' || c > 'z' {
+			return false
+		}
+	}
+	for _, part := range parts[1:] {
+		if part == "" {
+			return false
+		}
+		if _, err := strconv.Atoi(part); err != nil {
+			return false
+		}
+	}
+	return true
+}
+
+func (t Type) part(i int) string {
+	parts := strings.Split(string(t), ".")
+	if i >= len(parts) {
+		return ""
+	}
+	return parts[i]
+}
+
+// Description returns the name of the business area, e.g. Payments Initiation
+func (f Family) Description() string {
+	return familyDescriptions[f]
+}
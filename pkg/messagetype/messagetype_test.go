@@ -0,0 +1,70 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package messagetype
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/moov-io/iso20022/pkg/document"
+	"github.com/moov-io/iso20022/pkg/utils"
+)
+
+func TestAllSupported(t *testing.T) {
+	var types []string
+	for _, tp := range All() {
+		types = append(types, tp.String())
+		require.True(t, tp.Supported())
+		require.True(t, tp.valid(), tp)
+		require.NotEmpty(t, tp.Family().Description(), tp)
+	}
+	require.ElementsMatch(t, document.MessageTypes(), types)
+}
+
+func TestParse(t *testing.T) {
+	tp, err := Parse("pacs.008.001.08")
+	require.Nil(t, err)
+	require.Equal(t, MsgPacs008V08, tp)
+
+	tp, err = Parse(utils.DocumentPain00200111NameSpace)
+	require.Nil(t, err)
+	require.Equal(t, MsgPain002V11, tp)
+
+	tp, err = FromNameSpace(utils.DocumentCamt05200108NameSpace)
+	require.Nil(t, err)
+	require.Equal(t, MsgCamt052V08, tp)
+
+	_, err = FromNameSpace("pacs.008.001.08")
+	require.True(t, errors.Is(err, ErrInvalidType))
+
+	for _, s := range []string{"", "pacs.008", "pacs.008.001.x", "PACS.008.001.08", "pac.008.001.08"} {
+		_, err = Parse(s)
+		require.True(t, errors.Is(err, ErrInvalidType), s)
+	}
+	_, err = Parse("pacs.008.001.99")
+	require.True(t, errors.Is(err, ErrUnsupportedType))
+}
+
+func TestTypeParts(t *testing.T) {
+	require.Equal(t, FamilyPacs, MsgPacs008V08.Family())
+	require.Equal(t, "pacs.008", MsgPacs008V08.Message())
+	require.Equal(t, 1, MsgPacs008V08.Variant())
+	require.Equal(t, 8, MsgPacs008V08.Version())
+	require.Equal(t, utils.DocumentPacs00800108NameSpace, MsgPacs008V08.NameSpace())
+	require.Equal(t, "Payments Clearing and Settlement", FamilyPacs.Description())
+	require.False(t, Type("pacs.008.001.99").Supported())
+}
+
+func TestFamilies(t *testing.T) {
+	require.Equal(t, []Type{MsgPacs008V06, MsgPacs008V08, MsgPacs008V09}, Versions("pacs.008"))
+	require.Empty(t, Versions("pacs.999"))
+
+	for _, tp := range OfFamily(FamilyHead) {
+		require.Equal(t, FamilyHead, tp.Family())
+	}
+	require.Len(t, OfFamily(FamilyHead), 2)
+}
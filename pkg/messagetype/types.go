@@ -0,0 +1,338 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package messagetype
+
+// Supported message types, their order follows the namespaces of utils
+const (
+	MsgAcmt036V01 Type = "acmt.036.001.01"
+	MsgAcmt022V02 Type = "acmt.022.001.02"
+	MsgAcmt023V02 Type = "acmt.023.001.02"
+	MsgAcmt024V02 Type = "acmt.024.001.02"
+	MsgAcmt030V02 Type = "acmt.030.001.02"
+	MsgAcmt033V02 Type = "acmt.033.001.02"
+	MsgAcmt035V02 Type = "acmt.035.001.02"
+	MsgAcmt037V02 Type = "acmt.037.001.02"
+	MsgAcmt007V03 Type = "acmt.007.001.03"
+	MsgAcmt008V03 Type = "acmt.008.001.03"
+	MsgAcmt009V03 Type = "acmt.009.001.03"
+	MsgAcmt010V03 Type = "acmt.010.001.03"
+	MsgAcmt011V03 Type = "acmt.011.001.03"
+	MsgAcmt012V03 Type = "acmt.012.001.03"
+	MsgAcmt013V03 Type = "acmt.013.001.03"
+	MsgAcmt014V03 Type = "acmt.014.001.03"
+	MsgAcmt015V03 Type = "acmt.015.001.03"
+	MsgAcmt016V03 Type = "acmt.016.001.03"
+	MsgAcmt017V03 Type = "acmt.017.001.03"
+	MsgAcmt018V03 Type = "acmt.018.001.03"
+	MsgAcmt019V03 Type = "acmt.019.001.03"
+	MsgAcmt020V03 Type = "acmt.020.001.03"
+	MsgAcmt021V03 Type = "acmt.021.001.03"
+	MsgAcmt027V03 Type = "acmt.027.001.03"
+	MsgAcmt028V03 Type = "acmt.028.001.03"
+	MsgAcmt029V03 Type = "acmt.029.001.03"
+	MsgAcmt031V03 Type = "acmt.031.001.03"
+	MsgAcmt032V03 Type = "acmt.032.001.03"
+	MsgAcmt034V03 Type = "acmt.034.001.03"
+	MsgAdmi002V01 Type = "admi.002.001.01"
+	MsgAdmi004V01 Type = "admi.004.001.01"
+	MsgAdmi005V01 Type = "admi.005.001.01"
+	MsgAdmi006V01 Type = "admi.006.001.01"
+	MsgAdmi007V01 Type = "admi.007.001.01"
+	MsgAdmi011V01 Type = "admi.011.001.01"
+	MsgAdmi017V01 Type = "admi.017.001.01"
+	MsgAdmi004V02 Type = "admi.004.001.02"
+	MsgAdmi009V02 Type = "admi.009.001.02"
+	MsgAdmi010V02 Type = "admi.010.001.02"
+	MsgAuth001V01 Type = "auth.001.001.01"
+	MsgAuth002V01 Type = "auth.002.001.01"
+	MsgAuth003V01 Type = "auth.003.001.01"
+	MsgAuth018V02 Type = "auth.018.001.02"
+	MsgAuth019V02 Type = "auth.019.001.02"
+	MsgAuth020V02 Type = "auth.020.001.02"
+	MsgAuth021V02 Type = "auth.021.001.02"
+	MsgAuth022V02 Type = "auth.022.001.02"
+	MsgAuth023V02 Type = "auth.023.001.02"
+	MsgAuth024V02 Type = "auth.024.001.02"
+	MsgAuth025V02 Type = "auth.025.001.02"
+	MsgAuth026V02 Type = "auth.026.001.02"
+	MsgAuth027V02 Type = "auth.027.001.02"
+	MsgCamt101V01 Type = "camt.101.001.01"
+	MsgCamt102V01 Type = "camt.102.001.01"
+	MsgCamt103V01 Type = "camt.103.001.01"
+	MsgCamt104V01 Type = "camt.104.001.01"
+	MsgCamt035V03 Type = "camt.035.001.03"
+	MsgCamt069V03 Type = "camt.069.001.03"
+	MsgCamt071V03 Type = "camt.071.001.03"
+	MsgCamt086V03 Type = "camt.086.001.03"
+	MsgCamt013V04 Type = "camt.013.001.04"
+	MsgCamt014V04 Type = "camt.014.001.04"
+	MsgCamt015V04 Type = "camt.015.001.04"
+	MsgCamt016V04 Type = "camt.016.001.04"
+	MsgCamt017V04 Type = "camt.017.001.04"
+	MsgCamt020V04 Type = "camt.020.001.04"
+	MsgCamt032V04 Type = "camt.032.001.04"
+	MsgCamt038V04 Type = "camt.038.001.04"
+	MsgCamt070V04 Type = "camt.070.001.04"
+	MsgCamt018V05 Type = "camt.018.001.05"
+	MsgCamt025V05 Type = "camt.025.001.05"
+	MsgCamt026V05 Type = "camt.026.001.05"
+	MsgCamt028V05 Type = "camt.028.001.05"
+	MsgCamt030V05 Type = "camt.030.001.05"
+	MsgCamt035V05 Type = "camt.035.001.05"
+	MsgCamt036V05 Type = "camt.036.001.05"
+	MsgCamt039V05 Type = "camt.039.001.05"
+	MsgCamt046V05 Type = "camt.046.001.05"
+	MsgCamt048V05 Type = "camt.048.001.05"
+	MsgCamt049V05 Type = "camt.049.001.05"
+	MsgCamt050V05 Type = "camt.050.001.05"
+	MsgCamt051V05 Type = "camt.051.001.05"
+	MsgCamt056V05 Type = "camt.056.001.05"
+	MsgCamt060V05 Type = "camt.060.001.05"
+	MsgCamt021V06 Type = "camt.021.001.06"
+	MsgCamt024V06 Type = "camt.024.001.06"
+	MsgCamt029V06 Type = "camt.029.001.06"
+	MsgCamt031V06 Type = "camt.031.001.06"
+	MsgCamt033V06 Type = "camt.033.001.06"
+	MsgCamt034V06 Type = "camt.034.001.06"
+	MsgCamt047V06 Type = "camt.047.001.06"
+	MsgCamt057V06 Type = "camt.057.001.06"
+	MsgCamt058V06 Type = "camt.058.001.06"
+	MsgCamt059V06 Type = "camt.059.001.06"
+	MsgCamt003V07 Type = "camt.003.001.07"
+	MsgCamt009V07 Type = "camt.009.001.07"
+	MsgCamt011V07 Type = "camt.011.001.07"
+	MsgCamt012V07 Type = "camt.012.001.07"
+	MsgCamt019V07 Type = "camt.019.001.07"
+	MsgCamt023V07 Type = "camt.023.001.07"
+	MsgCamt026V07 Type = "camt.026.001.07"
+	MsgCamt087V07 Type = "camt.087.001.07"
+	MsgCamt004V08 Type = "camt.004.001.08"
+	MsgCamt005V08 Type = "camt.005.001.08"
+	MsgCamt006V08 Type = "camt.006.001.08"
+	MsgCamt007V08 Type = "camt.007.001.08"
+	MsgCamt008V08 Type = "camt.008.001.08"
+	MsgCamt010V08 Type = "camt.010.001.08"
+	MsgCamt026V08 Type = "camt.026.001.08"
+	MsgCamt027V08 Type = "camt.027.001.08"
+	MsgCamt037V08 Type = "camt.037.001.08"
+	MsgCamt052V08 Type = "camt.052.001.08"
+	MsgCamt053V08 Type = "camt.053.001.08"
+	MsgCamt054V08 Type = "camt.054.001.08"
+	MsgCamt056V08 Type = "camt.056.001.08"
+	MsgCamt028V09 Type = "camt.028.001.09"
+	MsgCamt029V09 Type = "camt.029.001.09"
+	MsgCamt055V09 Type = "camt.055.001.09"
+	MsgCamt056V09 Type = "camt.056.001.09"
+	MsgCamt028V10 Type = "camt.028.001.10"
+	MsgCamt029V10 Type = "camt.029.001.10"
+	MsgHead001V01 Type = "head.001.001.01"
+	MsgHead001V02 Type = "head.001.001.02"
+	MsgPacs010V04 Type = "pacs.010.001.04"
+	MsgPacs028V04 Type = "pacs.028.001.04"
+	MsgPacs008V06 Type = "pacs.008.001.06"
+	MsgPacs002V07 Type = "pacs.002.001.07"
+	MsgPacs002V08 Type = "pacs.002.001.08"
+	MsgPacs003V08 Type = "pacs.003.001.08"
+	MsgPacs008V08 Type = "pacs.008.001.08"
+	MsgPacs008V09 Type = "pacs.008.001.09"
+	MsgPacs009V09 Type = "pacs.009.001.09"
+	MsgPacs002V10 Type = "pacs.002.001.10"
+	MsgPacs004V10 Type = "pacs.004.001.10"
+	MsgPacs007V10 Type = "pacs.007.001.10"
+	MsgPacs002V11 Type = "pacs.002.001.11"
+	MsgPain017V01 Type = "pain.017.001.01"
+	MsgPain018V01 Type = "pain.018.001.01"
+	MsgPain009V05 Type = "pain.009.001.05"
+	MsgPain010V05 Type = "pain.010.001.05"
+	MsgPain011V05 Type = "pain.011.001.05"
+	MsgPain012V05 Type = "pain.012.001.05"
+	MsgPain013V05 Type = "pain.013.001.05"
+	MsgPain014V05 Type = "pain.014.001.05"
+	MsgPain013V07 Type = "pain.013.001.07"
+	MsgPain014V07 Type = "pain.014.001.07"
+	MsgPain013V08 Type = "pain.013.001.08"
+	MsgPain014V08 Type = "pain.014.001.08"
+	MsgPain008V09 Type = "pain.008.001.09"
+	MsgPain001V10 Type = "pain.001.001.10"
+	MsgPain007V10 Type = "pain.007.001.10"
+	MsgPain002V11 Type = "pain.002.001.11"
+	MsgReda066V01 Type = "reda.066.001.01"
+	MsgReda067V01 Type = "reda.067.001.01"
+	MsgReda068V01 Type = "reda.068.001.01"
+	MsgReda069V01 Type = "reda.069.001.01"
+	MsgReda070V01 Type = "reda.070.001.01"
+	MsgReda071V01 Type = "reda.071.001.01"
+	MsgReda072V01 Type = "reda.072.001.01"
+	MsgReda073V01 Type = "reda.073.001.01"
+	MsgRemt001V02 Type = "remt.001.001.02"
+	MsgRemt002V02 Type = "remt.002.001.02"
+	MsgRemt001V04 Type = "remt.001.001.04"
+)
+
+var all = []Type{
+	MsgAcmt036V01,
+	MsgAcmt022V02,
+	MsgAcmt023V02,
+	MsgAcmt024V02,
+	MsgAcmt030V02,
+	MsgAcmt033V02,
+	MsgAcmt035V02,
+	MsgAcmt037V02,
+	MsgAcmt007V03,
+	MsgAcmt008V03,
+	MsgAcmt009V03,
+	MsgAcmt010V03,
+	MsgAcmt011V03,
+	MsgAcmt012V03,
+	MsgAcmt013V03,
+	MsgAcmt014V03,
+	MsgAcmt015V03,
+	MsgAcmt016V03,
+	MsgAcmt017V03,
+	MsgAcmt018V03,
+	MsgAcmt019V03,
+	MsgAcmt020V03,
+	MsgAcmt021V03,
+	MsgAcmt027V03,
+	MsgAcmt028V03,
+	MsgAcmt029V03,
+	MsgAcmt031V03,
+	MsgAcmt032V03,
+	MsgAcmt034V03,
+	MsgAdmi002V01,
+	MsgAdmi004V01,
+	MsgAdmi005V01,
+	MsgAdmi006V01,
+	MsgAdmi007V01,
+	MsgAdmi011V01,
+	MsgAdmi017V01,
+	MsgAdmi004V02,
+	MsgAdmi009V02,
+	MsgAdmi010V02,
+	MsgAuth001V01,
+	MsgAuth002V01,
+	MsgAuth003V01,
+	MsgAuth018V02,
+	MsgAuth019V02,
+	MsgAuth020V02,
+	MsgAuth021V02,
+	MsgAuth022V02,
+	MsgAuth023V02,
+	MsgAuth024V02,
+	MsgAuth025V02,
+	MsgAuth026V02,
+	MsgAuth027V02,
+	MsgCamt101V01,
+	MsgCamt102V01,
+	MsgCamt103V01,
+	MsgCamt104V01,
+	MsgCamt035V03,
+	MsgCamt069V03,
+	MsgCamt071V03,
+	MsgCamt086V03,
+	MsgCamt013V04,
+	MsgCamt014V04,
+	MsgCamt015V04,
+	MsgCamt016V04,
+	MsgCamt017V04,
+	MsgCamt020V04,
+	MsgCamt032V04,
+	MsgCamt038V04,
+	MsgCamt070V04,
+	MsgCamt018V05,
+	MsgCamt025V05,
+	MsgCamt026V05,
+	MsgCamt028V05,
+	MsgCamt030V05,
+	MsgCamt035V05,
+	MsgCamt036V05,
+	MsgCamt039V05,
+	MsgCamt046V05,
+	MsgCamt048V05,
+	MsgCamt049V05,
+	MsgCamt050V05,
+	MsgCamt051V05,
+	MsgCamt056V05,
+	MsgCamt060V05,
+	MsgCamt021V06,
+	MsgCamt024V06,
+	MsgCamt029V06,
+	MsgCamt031V06,
+	MsgCamt033V06,
+	MsgCamt034V06,
+	MsgCamt047V06,
+	MsgCamt057V06,
+	MsgCamt058V06,
+	MsgCamt059V06,
+	MsgCamt003V07,
+	MsgCamt009V07,
+	MsgCamt011V07,
+	MsgCamt012V07,
+	MsgCamt019V07,
+	MsgCamt023V07,
+	MsgCamt026V07,
+	MsgCamt087V07,
+	MsgCamt004V08,
+	MsgCamt005V08,
+	MsgCamt006V08,
+	MsgCamt007V08,
+	MsgCamt008V08,
+	MsgCamt010V08,
+	MsgCamt026V08,
+	MsgCamt027V08,
+	MsgCamt037V08,
+	MsgCamt052V08,
+	MsgCamt053V08,
+	MsgCamt054V08,
+	MsgCamt056V08,
+	MsgCamt028V09,
+	MsgCamt029V09,
+	MsgCamt055V09,
+	MsgCamt056V09,
+	MsgCamt028V10,
+	MsgCamt029V10,
+	MsgHead001V01,
+	MsgHead001V02,
+	MsgPacs010V04,
+	MsgPacs028V04,
+	MsgPacs008V06,
+	MsgPacs002V07,
+	MsgPacs002V08,
+	MsgPacs003V08,
+	MsgPacs008V08,
+	MsgPacs008V09,
+	MsgPacs009V09,
+	MsgPacs002V10,
+	MsgPacs004V10,
+	MsgPacs007V10,
+	MsgPacs002V11,
+	MsgPain017V01,
+	MsgPain018V01,
+	MsgPain009V05,
+	MsgPain010V05,
+	MsgPain011V05,
+	MsgPain012V05,
+	MsgPain013V05,
+	MsgPain014V05,
+	MsgPain013V07,
+	MsgPain014V07,
+	MsgPain013V08,
+	MsgPain014V08,
+	MsgPain008V09,
+	MsgPain001V10,
+	MsgPain007V10,
+	MsgPain002V11,
+	MsgReda066V01,
+	MsgReda067V01,
+	MsgReda068V01,
+	MsgReda069V01,
+	MsgReda070V01,
+	MsgReda071V01,
+	MsgReda072V01,
+	MsgReda073V01,
+	MsgRemt001V02,
+	MsgRemt002V02,
+	MsgRemt001V04,
+}
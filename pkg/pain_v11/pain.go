@@ -887,11 +887,14 @@ func (r SettlementInstruction7) Validate() error {
 }
 
 type StatusReason6Choice struct {
-	Cd    ExternalStatusReason1Code `xml:"Cd"`
-	Prtry common.Max35Text          `xml:"Prtry"`
+	Cd    *ExternalStatusReason1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text          `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r StatusReason6Choice) Validate() error {
+	if r.Cd == nil && r.Prtry == nil {
+		return utils.NewErrValueInvalid("StatusReason6Choice")
+	}
 	return utils.Validate(&r)
 }
 
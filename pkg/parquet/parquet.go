@@ -0,0 +1,320 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package parquet
+
+/*
+	Package parquet writes flat tables as Apache Parquet files, the columnar format read by
+	data warehouses (BigQuery, Snowflake, Spark, DuckDB...). Files hold a single row group of
+	uncompressed, PLAIN encoded data pages, one per column:
+
+		"PAR1" | column chunks | footer (thrift compact FileMetaData) | footer length | "PAR1"
+
+	Columns are required, optional or repeated strings, int64 or millisecond timestamps.
+*/
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+)
+
+const (
+	magic = "PAR1"
+
+	// CreatedBy identifies the writer in the footer of files
+	CreatedBy = "moov-io/iso20022"
+)
+
+// Type is the type of the values of a column
+type Type int
+
+const (
+	// String values are UTF-8 byte arrays
+	String Type = iota
+
+	// Int64 values are signed 64 bits integers
+	Int64
+
+	// Timestamp values are time.Time, stored as milliseconds since the unix epoch
+	Timestamp
+)
+
+// Repetition is the number of values of a column in a row
+type Repetition int
+
+const (
+	// Required columns hold exactly one value in every row
+	Required Repetition = iota
+
+	// Optional columns hold at most one value
+	Optional
+
+	// Repeated columns hold any number of values, read as lists
+	Repeated
+)
+
+// Column is a column of a table
+type Column struct {
+	Name       string
+	Type       Type
+	Repetition Repetition
+}
+
+// Row holds the values of a row by column name, values are strings, int64 or time.Time
+// according to the type of their column
+type Row map[string][]interface{}
+
+// physical types, repetitions, encodings and converted types of parquet.thrift
+const (
+	typeInt64     = 2
+	typeByteArray = 6
+
+	convertedUTF8            = 0
+	convertedTimestampMillis = 9
+
+	encodingPlain = 0
+	encodingRLE   = 3
+
+	pageTypeData = 0
+)
+
+// Write writes the rows of the columns as a Parquet file to w
+func Write(w io.Writer, columns []Column, rows []Row) error {
+	if len(columns) == 0 {
+		return errors.New("parquet: table has no column")
+	}
+	known := make(map[string]bool, len(columns))
+	for _, column := range columns {
+		if column.Name == "" || known[column.Name] {
+			return fmt.Errorf("parquet: column name %q is empty or duplicated", column.Name)
+		}
+		known[column.Name] = true
+	}
+	for i, row := range rows {
+		for name := range row {
+			if !known[name] {
+				return fmt.Errorf("parquet: row %d holds unknown column %s", i, name)
+			}
+		}
+	}
+
+	var file bytes.Buffer
+	file.WriteString(magic)
+
+	chunks := make([]columnChunk, 0, len(columns))
+	for _, column := range columns {
+		offset := int64(file.Len())
+		page, values, err := encodeColumn(column, rows)
+		if err != nil {
+			return err
+		}
+		header := newEncoder()
+		header.field(1, thriftI32).i32(pageTypeData)
+		header.field(2, thriftI32).i32(int32(len(page)))
+		header.field(3, thriftI32).i32(int32(len(page)))
+		header.field(5, thriftStruct).begin()
+		header.field(1, thriftI32).i32(int32(values))
+		header.field(2, thriftI32).i32(encodingPlain)
+		header.field(3, thriftI32).i32(encodingRLE)
+		header.field(4, thriftI32).i32(encodingRLE)
+		header.end()
+		header.end()
+
+		file.Write(header.Bytes())
+		file.Write(page)
+		chunks = append(chunks, columnChunk{
+			column: column,
+			offset: offset,
+			values: int64(values),
+			size:   int64(header.Len() + len(page)),
+		})
+	}
+
+	footer := encodeFooter(columns, chunks, int64(len(rows)))
+	file.Write(footer)
+	binary.Write(&file, binary.LittleEndian, uint32(len(footer)))
+	file.WriteString(magic)
+
+	_, err := w.Write(file.Bytes())
+	return err
+}
+
+type columnChunk struct {
+	column Column
+	offset int64
+	values int64
+	size   int64
+}
+
+// maxLevels returns the maximum repetition and definition levels of column
+func maxLevels(column Column) (int, int) {
+	switch column.Repetition {
+	case Optional:
+		return 0, 1
+	case Repeated:
+		return 1, 1
+	}
+	return 0, 0
+}
+
+// encodeColumn returns the data page of the values of column and the number of its values,
+// absent values included
+func encodeColumn(column Column, rows []Row) ([]byte, int, error) {
+	var (
+		repetitions []int
+		definitions []int
+		values      bytes.Buffer
+	)
+	for i, row := range rows {
+		cells := row[column.Name]
+		switch {
+		case column.Repetition == Required && len(cells) != 1:
+			return nil, 0, fmt.Errorf("parquet: row %d holds %d values of required column %s", i, len(cells), column.Name)
+		case column.Repetition == Optional && len(cells) > 1:
+			return nil, 0, fmt.Errorf("parquet: row %d holds %d values of optional column %s", i, len(cells), column.Name)
+		}
+		if len(cells) == 0 {
+			repetitions, definitions = append(repetitions, 0), append(definitions, 0)
+			continue
+		}
+		for n, cell := range cells {
+			repetition := 0
+			if n > 0 {
+				repetition = 1
+			}
+			repetitions, definitions = append(repetitions, repetition), append(definitions, 1)
+			if err := encodeValue(&values, column, cell); err != nil {
+				return nil, 0, fmt.Errorf("parquet: row %d: %w", i, err)
+			}
+		}
+	}
+
+	var page bytes.Buffer
+	maxRepetition, maxDefinition := maxLevels(column)
+	if maxRepetition > 0 {
+		writeLevels(&page, repetitions)
+	}
+	if maxDefinition > 0 {
+		writeLevels(&page, definitions)
+	}
+	page.Write(values.Bytes())
+	return page.Bytes(), len(definitions), nil
+}
+
+func encodeValue(buf *bytes.Buffer, column Column, value interface{}) error {
+	switch column.Type {
+	case String:
+		s, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("value %v of column %s is not a string", value, column.Name)
+		}
+		binary.Write(buf, binary.LittleEndian, uint32(len(s)))
+		buf.WriteString(s)
+	case Int64:
+		n, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("value %v of column %s is not an int64", value, column.Name)
+		}
+		binary.Write(buf, binary.LittleEndian, n)
+	case Timestamp:
+		t, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("value %v of column %s is not a time", value, column.Name)
+		}
+		binary.Write(buf, binary.LittleEndian, t.UnixNano()/int64(time.Millisecond))
+	default:
+		return fmt.Errorf("column %s has unknown type %d", column.Name, column.Type)
+	}
+	return nil
+}
+
+// writeLevels writes levels of bit width 1 with the RLE hybrid encoding, prefixed by their
+// length, as runs of equal levels
+func writeLevels(buf *bytes.Buffer, levels []int) {
+	var runs bytes.Buffer
+	for start := 0; start < len(levels); {
+		end := start
+		for end < len(levels) && levels[end] == levels[start] {
+			end++
+		}
+		writeUvarint(&runs, uint64(end-start)<<1)
+		runs.WriteByte(byte(levels[start]))
+		start = end
+	}
+	binary.Write(buf, binary.LittleEndian, uint32(runs.Len()))
+	buf.Write(runs.Bytes())
+}
+
+func physicalType(column Column) (int32, int32) {
+	switch column.Type {
+	case Int64:
+		return typeInt64, -1
+	case Timestamp:
+		return typeInt64, convertedTimestampMillis
+	}
+	return typeByteArray, convertedUTF8
+}
+
+// encodeFooter returns the FileMetaData of a file of one row group
+func encodeFooter(columns []Column, chunks []columnChunk, rows int64) []byte {
+	e := newEncoder()
+	e.field(1, thriftI32).i32(1)
+
+	e.field(2, thriftList).list(thriftStruct, len(columns)+1)
+	e.begin()
+	e.field(4, thriftBinary).binary("schema")
+	e.field(5, thriftI32).i32(int32(len(columns)))
+	e.end()
+	for _, column := range columns {
+		physical, converted := physicalType(column)
+		e.begin()
+		e.field(1, thriftI32).i32(physical)
+		e.field(3, thriftI32).i32(int32(column.Repetition))
+		e.field(4, thriftBinary).binary(column.Name)
+		if converted >= 0 {
+			e.field(6, thriftI32).i32(converted)
+		}
+		e.end()
+	}
+
+	e.field(3, thriftI64).i64(rows)
+
+	var total int64
+	for _, chunk := range chunks {
+		total += chunk.size
+	}
+	e.field(4, thriftList).list(thriftStruct, 1)
+	e.begin()
+	e.field(1, thriftList).list(thriftStruct, len(chunks))
+	for _, chunk := range chunks {
+		physical, _ := physicalType(chunk.column)
+		e.begin()
+		e.field(2, thriftI64).i64(chunk.offset)
+		e.field(3, thriftStruct).begin()
+		e.field(1, thriftI32).i32(physical)
+		e.field(2, thriftList).list(thriftI32, 2)
+		e.i32(encodingPlain)
+		e.i32(encodingRLE)
+		e.field(3, thriftList).list(thriftBinary, 1)
+		e.binary(chunk.column.Name)
+		e.field(4, thriftI32).i32(0)
+		e.field(5, thriftI64).i64(chunk.values)
+		e.field(6, thriftI64).i64(chunk.size)
+		e.field(7, thriftI64).i64(chunk.size)
+		e.field(9, thriftI64).i64(chunk.offset)
+		e.end()
+		e.end()
+	}
+	e.field(2, thriftI64).i64(total)
+	e.field(3, thriftI64).i64(rows)
+	e.end()
+
+	e.field(6, thriftBinary).binary(CreatedBy)
+	e.end()
+	return e.Bytes()
+}
@@ -0,0 +1,237 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package parquet
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// thrift structs are decoded as their fields by id
+type thriftFields map[int16]interface{}
+
+type decoder struct {
+	t   *testing.T
+	buf *bytes.Reader
+}
+
+func (d *decoder) uvarint() uint64 {
+	n, err := binary.ReadUvarint(d.buf)
+	require.Nil(d.t, err)
+	return n
+}
+
+func (d *decoder) varint() int64 {
+	n := d.uvarint()
+	return int64(n>>1) ^ -int64(n&1)
+}
+
+func (d *decoder) value(kind byte) interface{} {
+	switch kind {
+	case thriftI32, thriftI64:
+		return d.varint()
+	case thriftBinary:
+		b := make([]byte, d.uvarint())
+		_, err := d.buf.Read(b)
+		require.Nil(d.t, err)
+		return string(b)
+	case thriftList:
+		header, err := d.buf.ReadByte()
+		require.Nil(d.t, err)
+		size := int(header >> 4)
+		if size == 15 {
+			size = int(d.uvarint())
+		}
+		list := make([]interface{}, size)
+		for i := range list {
+			list[i] = d.value(header & 0x0f)
+		}
+		return list
+	case thriftStruct:
+		fields := thriftFields{}
+		var last int16
+		for {
+			header, err := d.buf.ReadByte()
+			require.Nil(d.t, err)
+			if header == 0 {
+				return fields
+			}
+			id := last + int16(header>>4)
+			if header>>4 == 0 {
+				id = int16(d.varint())
+			}
+			fields[id] = d.value(header & 0x0f)
+			last = id
+		}
+	}
+	d.t.Fatalf("unexpected thrift type %d", kind)
+	return nil
+}
+
+func readLevels(t *testing.T, buf *bytes.Reader, count int) []int {
+	var length uint32
+	require.Nil(t, binary.Read(buf, binary.LittleEndian, &length))
+	runs := make([]byte, length)
+	_, err := buf.Read(runs)
+	require.Nil(t, err)
+
+	r := bytes.NewReader(runs)
+	var levels []int
+	for len(levels) < count {
+		header, err := binary.ReadUvarint(r)
+		require.Nil(t, err)
+		require.Zero(t, header&1, "bit-packed runs are not written")
+		level, err := r.ReadByte()
+		require.Nil(t, err)
+		for i := uint64(0); i < header>>1; i++ {
+			levels = append(levels, int(level))
+		}
+	}
+	return levels
+}
+
+// readFile decodes the footer of a file and the values of its columns by row
+func readFile(t *testing.T, data []byte) (thriftFields, []map[string][]interface{}) {
+	require.Equal(t, magic, string(data[:4]))
+	require.Equal(t, magic, string(data[len(data)-4:]))
+	length := int(binary.LittleEndian.Uint32(data[len(data)-8:]))
+	footer := data[len(data)-8-length : len(data)-8]
+	meta := (&decoder{t: t, buf: bytes.NewReader(footer)}).value(thriftStruct).(thriftFields)
+
+	rows := make([]map[string][]interface{}, meta[3].(int64))
+	for i := range rows {
+		rows[i] = map[string][]interface{}{}
+	}
+	schema := meta[2].([]interface{})
+	group := meta[4].([]interface{})[0].(thriftFields)
+	for i, chunk := range group[1].([]interface{}) {
+		element := schema[i+1].(thriftFields)
+		name, repetition := element[4].(string), Repetition(element[3].(int64))
+		column := chunk.(thriftFields)[3].(thriftFields)
+		offset := column[9].(int64)
+
+		buf := bytes.NewReader(data[offset:])
+		header := (&decoder{t: t, buf: buf}).value(thriftStruct).(thriftFields)
+		count := int(header[5].(thriftFields)[1].(int64))
+		require.Equal(t, column[5].(int64), int64(count))
+
+		repetitions, definitions := make([]int, count), make([]int, count)
+		for n := range definitions {
+			definitions[n] = 1
+		}
+		if repetition == Repeated {
+			repetitions = readLevels(t, buf, count)
+		}
+		if repetition != Required {
+			definitions = readLevels(t, buf, count)
+		}
+
+		row := -1
+		for n := 0; n < count; n++ {
+			if repetitions[n] == 0 {
+				row++
+			}
+			if definitions[n] == 0 {
+				continue
+			}
+			var value interface{}
+			switch element[1].(int64) {
+			case typeByteArray:
+				var size uint32
+				require.Nil(t, binary.Read(buf, binary.LittleEndian, &size))
+				b := make([]byte, size)
+				_, err := buf.Read(b)
+				require.Nil(t, err)
+				value = string(b)
+			case typeInt64:
+				var v int64
+				require.Nil(t, binary.Read(buf, binary.LittleEndian, &v))
+				value = v
+			}
+			rows[row][name] = append(rows[row][name], value)
+		}
+		require.Equal(t, len(rows)-1, row, name)
+	}
+	return meta, rows
+}
+
+func TestWrite(t *testing.T) {
+	created := time.Date(2021, time.April, 15, 10, 30, 0, 0, time.UTC)
+	columns := []Column{
+		{Name: "documentId", Type: String},
+		{Name: "created", Type: Timestamp},
+		{Name: "count", Type: Int64, Repetition: Optional},
+		{Name: "RmtInf/Ustrd", Type: String, Repetition: Repeated},
+	}
+	rows := []Row{
+		{"documentId": {"doc-1"}, "created": {created}, "count": {int64(2)}, "RmtInf/Ustrd": {"invoice 1", "invoice 2"}},
+		{"documentId": {"doc-2"}, "created": {created}},
+		{"documentId": {"doc-3"}, "created": {created}, "count": {int64(-1)}, "RmtInf/Ustrd": {"invoice 3"}},
+	}
+
+	var buf bytes.Buffer
+	require.Nil(t, Write(&buf, columns, rows))
+
+	meta, read := readFile(t, buf.Bytes())
+	require.Equal(t, int64(3), meta[3])
+	require.Equal(t, CreatedBy, meta[6])
+
+	schema := meta[2].([]interface{})
+	require.Len(t, schema, 5)
+	require.Equal(t, int64(4), schema[0].(thriftFields)[5])
+	require.Equal(t, int64(convertedTimestampMillis), schema[2].(thriftFields)[6])
+	require.Equal(t, int64(Repeated), schema[4].(thriftFields)[3])
+
+	millis := created.UnixNano() / int64(time.Millisecond)
+	require.Equal(t, []map[string][]interface{}{
+		{"documentId": {"doc-1"}, "created": {millis}, "count": {int64(2)}, "RmtInf/Ustrd": {"invoice 1", "invoice 2"}},
+		{"documentId": {"doc-2"}, "created": {millis}},
+		{"documentId": {"doc-3"}, "created": {millis}, "count": {int64(-1)}, "RmtInf/Ustrd": {"invoice 3"}},
+	}, read)
+}
+
+func TestWriteManyColumns(t *testing.T) {
+	// lists of more than 14 elements encode their size after the header
+	var columns []Column
+	row := Row{}
+	for i := 0; i < 20; i++ {
+		name := string(rune('a' + i))
+		columns = append(columns, Column{Name: name, Type: String, Repetition: Optional})
+		if i%2 == 0 {
+			row[name] = []interface{}{name}
+		}
+	}
+
+	var buf bytes.Buffer
+	require.Nil(t, Write(&buf, columns, []Row{row, {}}))
+
+	_, read := readFile(t, buf.Bytes())
+	require.Len(t, read, 2)
+	require.Len(t, read[0], 10)
+	require.Equal(t, []interface{}{"e"}, read[0]["e"])
+	require.Empty(t, read[1])
+}
+
+func TestWriteErrors(t *testing.T) {
+	columns := []Column{
+		{Name: "id", Type: String},
+		{Name: "amount", Type: Int64, Repetition: Optional},
+	}
+	for name, rows := range map[string][]Row{
+		"missing required": {{"amount": {int64(1)}}},
+		"many optional":    {{"id": {"1"}, "amount": {int64(1), int64(2)}}},
+		"wrong type":       {{"id": {"1"}, "amount": {"1"}}},
+		"unknown column":   {{"id": {"1"}, "other": {"1"}}},
+	} {
+		require.Error(t, Write(&bytes.Buffer{}, columns, rows), name)
+	}
+
+	require.Error(t, Write(&bytes.Buffer{}, nil, nil))
+	require.Error(t, Write(&bytes.Buffer{}, []Column{{Name: "id"}, {Name: "id"}}, nil))
+}
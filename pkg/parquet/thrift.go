@@ -0,0 +1,88 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package parquet
+
+import (
+	"bytes"
+	"encoding/binary"
+)
+
+// types of the thrift compact protocol
+const (
+	thriftI32    = 5
+	thriftI64    = 6
+	thriftBinary = 8
+	thriftList   = 9
+	thriftStruct = 12
+)
+
+// encoder writes thrift structs with the compact protocol, the encoding of the page headers
+// and footer of Parquet files
+type encoder struct {
+	bytes.Buffer
+
+	// fields holds the id of the last field of every enclosing struct
+	fields []int16
+}
+
+func newEncoder() *encoder {
+	return &encoder{fields: []int16{0}}
+}
+
+// field writes the header of the field id of the current struct
+func (e *encoder) field(id int16, kind byte) *encoder {
+	last := &e.fields[len(e.fields)-1]
+	if delta := id - *last; delta > 0 && delta <= 15 {
+		e.WriteByte(byte(delta)<<4 | kind)
+	} else {
+		e.WriteByte(kind)
+		e.varint(int64(id))
+	}
+	*last = id
+	return e
+}
+
+// begin starts a struct, a field value or a list element
+func (e *encoder) begin() {
+	e.fields = append(e.fields, 0)
+}
+
+// end writes the stop field of the current struct
+func (e *encoder) end() {
+	e.WriteByte(0)
+	e.fields = e.fields[:len(e.fields)-1]
+}
+
+func (e *encoder) list(kind byte, size int) {
+	if size < 15 {
+		e.WriteByte(byte(size)<<4 | kind)
+		return
+	}
+	e.WriteByte(0xf0 | kind)
+	writeUvarint(&e.Buffer, uint64(size))
+}
+
+func (e *encoder) i32(n int32) {
+	e.varint(int64(n))
+}
+
+func (e *encoder) i64(n int64) {
+	e.varint(n)
+}
+
+func (e *encoder) binary(s string) {
+	writeUvarint(&e.Buffer, uint64(len(s)))
+	e.WriteString(s)
+}
+
+// varint writes n zigzag encoded
+func (e *encoder) varint(n int64) {
+	writeUvarint(&e.Buffer, uint64(n<<1)^uint64(n>>63))
+}
+
+func writeUvarint(buf *bytes.Buffer, n uint64) {
+	var scratch [binary.MaxVarintLen64]byte
+	buf.Write(scratch[:binary.PutUvarint(scratch[:], n)])
+}
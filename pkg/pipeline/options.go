@@ -0,0 +1,76 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package pipeline
+
+import (
+	"github.com/moov-io/iso20022/pkg/enrich"
+	"github.com/moov-io/iso20022/pkg/service"
+)
+
+// Option configures a pipeline
+type Option func(*Pipeline)
+
+// WithBuffer sets the capacity of the channels between stages, how many items a stage runs
+// ahead of the next one
+func WithBuffer(n int) Option {
+	return func(p *Pipeline) {
+		if n >= 0 {
+			p.buffer = n
+		}
+	}
+}
+
+// WithWorkers sets how many items every stage processes concurrently, the number of cpus by
+// default. Items reach the sink in order with a single worker only.
+func WithWorkers(n int) Option {
+	return func(p *Pipeline) {
+		if n > 0 {
+			p.workers = n
+		}
+	}
+}
+
+// WithTransform adds a stage applying fn to the valid items, failing items are reported with the stage name
+func WithTransform(name string, fn Transform) Option {
+	return func(p *Pipeline) {
+		p.transforms = append(p.transforms, stage{name: name, fn: fn})
+	}
+}
+
+// WithEnrichers adds the enrichments of enrichers to the items after parsing and validation,
+// before the transforms. Items failing an enricher are reported with the enrich stage.
+func WithEnrichers(enrichers ...enrich.Enricher) Option {
+	return func(p *Pipeline) {
+		p.enrichers = append(p.enrichers, enrichers...)
+	}
+}
+
+// WithErrorSink routes the failed items to sink, they're counted and dropped otherwise
+func WithErrorSink(sink Sink) Option {
+	return func(p *Pipeline) {
+		p.errors = sink
+	}
+}
+
+// WithFailFast aborts the pipeline with the error of the first failed item
+func WithFailFast() Option {
+	return func(p *Pipeline) {
+		p.failFast = true
+	}
+}
+
+// WithoutValidation skips the validate stage
+func WithoutValidation() Option {
+	return func(p *Pipeline) {
+		p.validate = false
+	}
+}
+
+// WithParseOptions parses the messages like the service with opts, e.g. service.WithTargetVersion
+func WithParseOptions(opts ...service.Option) Option {
+	return func(p *Pipeline) {
+		p.parseOptions = append(p.parseOptions, opts...)
+	}
+}
@@ -0,0 +1,176 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package pipeline
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/moov-io/iso20022/pkg/service"
+	"github.com/moov-io/iso20022/pkg/utils"
+)
+
+// DefaultNameTemplate keeps the base name of the input
+const DefaultNameTemplate = "{name}{ext}"
+
+var (
+	unsafeNameChars = regexp.MustCompile(`[^A-Za-z0-9._-]+`)
+	headerNames     = regexp.MustCompile(`\{header:([^{}]+)\}`)
+)
+
+// Layout names and places the files written by an Output sink
+type Layout struct {
+	Dir string
+
+	// NameTemplate names files with the placeholders {msgType}, {msgId}, {date} (yyyymmdd
+	// of the write), {name} (base name of the input without extension) and {ext} (.xml or
+	// .json), e.g. "{msgType}_{msgId}_{date}.xml", and {header:<name>} (header name of the
+	// item). DefaultNameTemplate when empty.
+	NameTemplate string
+
+	// ByMessageType places files in a subdirectory per message type
+	ByMessageType bool
+
+	// Format is the format of written documents, the input is written as is when empty
+	Format utils.DocumentType
+
+	// Deterministic writes documents in the deterministic form of service.EncodeDeterministic,
+	// in the format of their input when Format is empty
+	Deterministic bool
+
+	now func() time.Time
+}
+
+// Path returns the path of the file written for item
+func (l Layout) Path(item Item) string {
+	tmpl := l.NameTemplate
+	if tmpl == "" {
+		tmpl = DefaultNameTemplate
+	}
+	now := time.Now
+	if l.now != nil {
+		now = l.now
+	}
+
+	msgType, msgId := "unknown", ""
+	if item.Document != nil {
+		msgType = utils.GetMessageType(item.Document.NameSpace())
+		msg := item.Document.InspectMessage()
+		for _, suffix := range []string{"GrpHdr/MsgId", "MsgHdr/MsgId", "MsgId"} {
+			if values := utils.FindElementValues(msg, suffix); len(values) > 0 {
+				msgId = values[0]
+				break
+			}
+		}
+	}
+	base := filepath.Base(item.Name)
+	ext := strings.ToLower(filepath.Ext(base))
+	switch {
+	case l.Format != "":
+		ext = "." + string(l.Format)
+	case ext != ".xml" && ext != ".json":
+		ext = "." + string(utils.GetDocumentFormat(item.Input))
+	}
+
+	tmpl = headerNames.ReplaceAllStringFunc(tmpl, func(placeholder string) string {
+		return safeName(item.Headers[headerNames.FindStringSubmatch(placeholder)[1]])
+	})
+	name := strings.NewReplacer(
+		"{msgType}", safeName(msgType),
+		"{msgId}", safeName(msgId),
+		"{date}", now().UTC().Format("20060102"),
+		"{name}", safeName(strings.TrimSuffix(base, filepath.Ext(base))),
+		"{ext}", ext,
+	).Replace(tmpl)
+
+	if l.ByMessageType {
+		return filepath.Join(l.Dir, safeName(msgType), name)
+	}
+	return filepath.Join(l.Dir, name)
+}
+
+// safeName replaces the characters of s that aren't safe in file names
+func safeName(s string) string {
+	return unsafeNameChars.ReplaceAllString(s, "_")
+}
+
+// Output writes every item to the file of layout, see Layout.Write
+func Output(layout Layout) Sink {
+	return func(ctx context.Context, item Item) error {
+		_, _, err := layout.Write(item)
+		return err
+	}
+}
+
+// Content returns the content of the file written for item, its input unless the layout has
+// a format or is deterministic
+func (l Layout) Content(item Item) ([]byte, error) {
+	if l.Format == "" && !l.Deterministic {
+		return item.Input, nil
+	}
+	if item.Document == nil {
+		return nil, errors.New("output: item isn't parsed")
+	}
+	var buf bytes.Buffer
+	var err error
+	if l.Deterministic {
+		format := l.Format
+		if format == "" {
+			format = utils.GetDocumentFormat(item.Input)
+		}
+		err = service.EncodeDeterministic(&buf, item.Document, format)
+	} else {
+		err = service.Encode(&buf, item.Document, l.Format)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// Write writes item to the file of its path with the content of the layout, see WriteFile,
+// and returns the path and the written content
+func (l Layout) Write(item Item) (string, []byte, error) {
+	content, err := l.Content(item)
+	if err != nil {
+		return "", nil, err
+	}
+
+	path := l.Path(item)
+	if err := os.MkdirAll(filepath.Dir(path), 0750); err != nil {
+		return "", nil, err
+	}
+	if err := WriteFile(path, content); err != nil {
+		return "", nil, err
+	}
+	return path, content, nil
+}
+
+// WriteFile writes content to a hidden temporary file next to path and renames it to
+// path once complete, so pollers of the directory never pick up a partial file
+func WriteFile(path string, content []byte) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+"-")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err = tmp.Write(content); err == nil {
+		err = tmp.Sync()
+	}
+	if cerr := tmp.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
@@ -0,0 +1,93 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package pipeline
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/moov-io/iso20022/pkg/document"
+	"github.com/moov-io/iso20022/pkg/iso20022test/fixtures"
+	"github.com/moov-io/iso20022/pkg/utils"
+)
+
+func TestLayoutPath(t *testing.T) {
+	valid := fixtures.ReadFile(t, "valid_pacs_v08.xml")
+	doc, err := document.ParseIso20022Document(valid)
+	require.Nil(t, err)
+	item := Item{Name: "inbox/payment.xml", Input: valid, Document: doc}
+	now := func() time.Time { return time.Date(2021, 4, 15, 23, 0, 0, 0, time.UTC) }
+
+	layout := Layout{Dir: "out", now: now}
+	require.Equal(t, filepath.Join("out", "payment.xml"), layout.Path(item))
+
+	layout.NameTemplate = "{msgType}_{msgId}_{date}{ext}"
+	layout.ByMessageType = true
+	layout.Format = utils.DocumentTypeJson
+	require.Equal(t, filepath.Join("out", "pacs.008.001.08", "pacs.008.001.08_MSG-20210415-0001_20210415.json"), layout.Path(item))
+
+	// values can't escape the directory
+	item.Name = "../../etc/passwd"
+	item.Document = nil
+	layout = Layout{Dir: "out", NameTemplate: "{msgType}-{name}{ext}", now: now}
+	require.Equal(t, filepath.Join("out", "unknown-passwd.xml"), layout.Path(item))
+
+	// headers of the item, empty when missing
+	item.Headers = map[string]string{"x-saa-sender-reference": "REF/0001"}
+	layout.NameTemplate = "{header:x-saa-sender-reference}{header:x-missing}{ext}"
+	require.Equal(t, filepath.Join("out", "REF_0001.xml"), layout.Path(item))
+}
+
+func TestOutput(t *testing.T) {
+	dir := t.TempDir()
+	valid := fixtures.ReadFile(t, "valid_pacs_v08.xml")
+
+	stats, err := New(Bytes(valid), Output(Layout{Dir: dir, NameTemplate: "{msgId}{ext}", ByMessageType: true, Format: utils.DocumentTypeJson})).Run(context.Background())
+	require.Nil(t, err)
+	require.Equal(t, int64(1), stats.Succeeded)
+
+	buf, err := os.ReadFile(filepath.Join(dir, "pacs.008.001.08", "MSG-20210415-0001.json"))
+	require.Nil(t, err)
+	require.Equal(t, utils.DocumentTypeJson, utils.GetDocumentFormat(buf))
+
+	// no temporary file is left behind
+	entries, err := os.ReadDir(filepath.Join(dir, "pacs.008.001.08"))
+	require.Nil(t, err)
+	require.Len(t, entries, 1)
+
+	err = Output(Layout{Dir: dir, Format: utils.DocumentTypeXml})(context.Background(), Item{Name: "raw.xml", Input: valid})
+	require.EqualError(t, err, "output: item isn't parsed")
+
+	path, content, err := Layout{Dir: dir}.Write(Item{Name: "inbox/raw.xml", Input: valid})
+	require.Nil(t, err)
+	require.Equal(t, filepath.Join(dir, "raw.xml"), path)
+	require.Equal(t, valid, content)
+
+	doc, err := document.ParseIso20022Document(valid)
+	require.Nil(t, err)
+	content, err = Layout{Deterministic: true}.Content(Item{Input: valid, Document: doc})
+	require.Nil(t, err)
+	require.Equal(t, utils.DocumentTypeXml, utils.GetDocumentFormat(content))
+	require.Equal(t, byte('\n'), content[len(content)-1])
+	_, err = Layout{Deterministic: true}.Content(Item{Input: valid})
+	require.EqualError(t, err, "output: item isn't parsed")
+}
+
+func TestWriteFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "out.xml")
+	require.Nil(t, WriteFile(path, []byte("first")))
+	require.Nil(t, WriteFile(path, []byte("second")))
+
+	buf, err := os.ReadFile(path)
+	require.Nil(t, err)
+	require.Equal(t, "second", string(buf))
+
+	require.Error(t, WriteFile(filepath.Join(path, "missing", "out.xml"), nil))
+}
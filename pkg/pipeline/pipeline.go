@@ -0,0 +1,245 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package pipeline
+
+/*
+	Pipeline processes messages in stages connected by bounded channels, a slow stage blocks
+	the stages before it instead of buffering every message:
+
+		source -> parse -> validate -> enrich -> transforms -> sink
+
+	Messages failing a stage skip the following stages and are routed to the error sink:
+
+		p := pipeline.New(pipeline.Dir("inbox"), store,
+			pipeline.WithTransform("enrich", enrich),
+			pipeline.WithErrorSink(quarantine))
+		stats, err := p.Run(ctx)
+*/
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"runtime"
+	"sync"
+	"sync/atomic"
+
+	"github.com/moov-io/iso20022/pkg/document"
+	"github.com/moov-io/iso20022/pkg/enrich"
+	"github.com/moov-io/iso20022/pkg/service"
+)
+
+const (
+	StageParse     = "parse"
+	StageValidate  = "validate"
+	StageTransform = "transform"
+	StageEnrich    = "enrich"
+
+	// defaultBuffer is the capacity of the channels between stages unless configured
+	defaultBuffer = 16
+)
+
+// Item is a message flowing through a pipeline
+type Item struct {
+	// Name identifies the message, e.g. its file
+	Name  string
+	Input []byte
+
+	// Headers are the metadata of the message given by its source, e.g. the headers of a queue
+	Headers map[string]string
+
+	// Document is the parsed message, nil before parsing
+	Document document.Iso20022Document
+
+	// Enrichments are the reference data added to the document by the enrichers of the pipeline
+	Enrichments enrich.Set
+
+	// Stage and Err are the failing stage and its error, a failed item skips the following stages
+	Stage string
+	Err   error
+}
+
+// Source emits the messages of a pipeline, emit blocks while the pipeline is busy and fails when it's aborted
+type Source func(ctx context.Context, emit func(Item) error) error
+
+// Transform changes an item, e.g. enriching or converting its document, returning an error fails the item
+type Transform func(ctx context.Context, item *Item) error
+
+// Sink receives the processed items one at a time, returning an error aborts the pipeline
+type Sink func(ctx context.Context, item Item) error
+
+// Stats count the items of a pipeline
+type Stats struct {
+	Read      int64
+	Succeeded int64
+	Failed    int64
+
+	// Stages count the failed items by failing stage
+	Stages map[string]int64
+}
+
+type stage struct {
+	name string
+	fn   Transform
+}
+
+// Pipeline is a source of messages processed by stages into a sink
+type Pipeline struct {
+	source Source
+	sink   Sink
+
+	errors       Sink
+	failFast     bool
+	buffer       int
+	workers      int
+	validate     bool
+	parseOptions []service.Option
+	enrichers    []enrich.Enricher
+	transforms   []stage
+}
+
+// New returns a pipeline parsing and validating the messages of source into sink
+func New(source Source, sink Sink, opts ...Option) *Pipeline {
+	p := &Pipeline{
+		source:   source,
+		sink:     sink,
+		buffer:   defaultBuffer,
+		workers:  runtime.NumCPU(),
+		validate: true,
+	}
+	for _, opt := range opts {
+		opt(p)
+	}
+	return p
+}
+
+// Run processes every message of the source until the source is done, ctx is done or the
+// pipeline is aborted by a sink or the source
+func (p *Pipeline) Run(ctx context.Context) (Stats, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		once  sync.Once
+		cause error
+		read  atomic.Int64
+	)
+	abort := func(err error) {
+		once.Do(func() {
+			cause = err
+			cancel()
+		})
+	}
+
+	items := make(chan Item, p.buffer)
+	go func() {
+		defer close(items)
+		err := p.source(ctx, func(item Item) error {
+			select {
+			case items <- item:
+				read.Add(1)
+				return nil
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		})
+		if err != nil && ctx.Err() == nil {
+			abort(fmt.Errorf("source: %w", err))
+		}
+	}()
+
+	out := p.stage(ctx, items, StageParse, p.parse)
+	if p.validate {
+		out = p.stage(ctx, out, StageValidate, validate)
+	}
+	if len(p.enrichers) > 0 {
+		out = p.stage(ctx, out, StageEnrich, p.enrich)
+	}
+	for _, t := range p.transforms {
+		out = p.stage(ctx, out, t.name, t.fn)
+	}
+
+	stats := Stats{Stages: make(map[string]int64)}
+	for item := range out {
+		if ctx.Err() != nil {
+			continue
+		}
+		if item.Err != nil {
+			stats.Failed++
+			stats.Stages[item.Stage]++
+			if p.errors != nil {
+				if err := p.errors(ctx, item); err != nil {
+					abort(fmt.Errorf("error sink: %w", err))
+				}
+			}
+			if p.failFast {
+				abort(fmt.Errorf("%s: %s: %w", item.Name, item.Stage, item.Err))
+			}
+			continue
+		}
+		if err := p.sink(ctx, item); err != nil {
+			abort(fmt.Errorf("sink: %w", err))
+			continue
+		}
+		stats.Succeeded++
+	}
+	stats.Read = read.Load()
+
+	if cause != nil {
+		return stats, cause
+	}
+	return stats, ctx.Err()
+}
+
+// stage applies fn to the items of in with the workers of the pipeline
+func (p *Pipeline) stage(ctx context.Context, in <-chan Item, name string, fn Transform) <-chan Item {
+	out := make(chan Item, p.buffer)
+
+	var wg sync.WaitGroup
+	for i := 0; i < p.workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for item := range in {
+				if item.Err == nil && ctx.Err() == nil {
+					if err := fn(ctx, &item); err != nil {
+						item.Stage, item.Err = name, err
+					}
+				}
+				select {
+				case out <- item:
+				case <-ctx.Done():
+				}
+			}
+		}()
+	}
+	go func() {
+		wg.Wait()
+		close(out)
+	}()
+	return out
+}
+
+func (p *Pipeline) parse(_ context.Context, item *Item) error {
+	doc, err := service.Parse(bytes.NewReader(item.Input), p.parseOptions...)
+	if err != nil {
+		return err
+	}
+	item.Document = doc
+	return nil
+}
+
+func (p *Pipeline) enrich(ctx context.Context, item *Item) error {
+	set, err := enrich.Enrich(ctx, item.Document, p.enrichers...)
+	if err != nil {
+		return err
+	}
+	item.Enrichments = append(item.Enrichments, set...)
+	return nil
+}
+
+func validate(_ context.Context, item *Item) error {
+	return item.Document.Validate()
+}
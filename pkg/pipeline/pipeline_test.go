@@ -0,0 +1,183 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package pipeline
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/moov-io/iso20022/pkg/document"
+	"github.com/moov-io/iso20022/pkg/enrich"
+	"github.com/moov-io/iso20022/pkg/iso20022test/fixtures"
+	"github.com/moov-io/iso20022/pkg/utils"
+)
+
+func TestPipeline(t *testing.T) {
+	valid := fixtures.ReadFile(t, "valid_pacs_v08.xml")
+
+	var processed, failed []Item
+	p := New(Bytes(valid, []byte("not a document"), valid),
+		func(ctx context.Context, item Item) error {
+			processed = append(processed, item)
+			return nil
+		},
+		WithWorkers(1),
+		WithTransform("tag", func(ctx context.Context, item *Item) error {
+			if item.Name == "2" {
+				return errors.New("rejected")
+			}
+			item.Name = "tagged-" + item.Name
+			return nil
+		}),
+		WithErrorSink(func(ctx context.Context, item Item) error {
+			failed = append(failed, item)
+			return nil
+		}),
+	)
+
+	stats, err := p.Run(context.Background())
+	require.Nil(t, err)
+	require.Equal(t, int64(3), stats.Read)
+	require.Equal(t, int64(1), stats.Succeeded)
+	require.Equal(t, int64(2), stats.Failed)
+	require.Equal(t, map[string]int64{StageParse: 1, "tag": 1}, stats.Stages)
+
+	require.Len(t, processed, 1)
+	require.Equal(t, "tagged-0", processed[0].Name)
+	require.Equal(t, utils.DocumentPacs00800108NameSpace, processed[0].Document.NameSpace())
+	require.Len(t, failed, 2)
+	require.Equal(t, StageParse, failed[0].Stage)
+	require.Equal(t, "tag", failed[1].Stage)
+	require.EqualError(t, failed[1].Err, "rejected")
+}
+
+func TestPipelineValidation(t *testing.T) {
+	invalid := []byte(`<Document xmlns="urn:iso:std:iso:20022:tech:xsd:pacs.008.001.08"><FIToFICstmrCdtTrf></FIToFICstmrCdtTrf></Document>`)
+	sink := func(ctx context.Context, item Item) error { return nil }
+
+	stats, err := New(Bytes(invalid), sink).Run(context.Background())
+	require.Nil(t, err)
+	require.Equal(t, int64(1), stats.Stages[StageValidate])
+
+	stats, err = New(Bytes(invalid), sink, WithoutValidation()).Run(context.Background())
+	require.Nil(t, err)
+	require.Equal(t, int64(1), stats.Succeeded)
+
+	_, err = New(Bytes(invalid), sink, WithFailFast()).Run(context.Background())
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "0: validate: ")
+}
+
+func TestPipelineEnrichers(t *testing.T) {
+	valid := fixtures.ReadFile(t, "valid_pacs_v08.xml")
+	banks := enrich.BankDirectory{"BANKGB2LXXX": "Bank of London"}
+	failing := enrich.New("crm", func(context.Context, document.Iso20022Document) ([]enrich.Enrichment, error) {
+		return nil, errors.New("unavailable")
+	})
+
+	var processed []Item
+	stats, err := New(Bytes(valid), func(ctx context.Context, item Item) error {
+		processed = append(processed, item)
+		return nil
+	}, WithEnrichers(banks), WithTransform("route", func(ctx context.Context, item *Item) error {
+		// the enrichments are available to the transforms
+		if _, found := item.Enrichments.Lookup("CdtTrfTxInf[0]/CdtrAgt/FinInstnId/BICFI", enrich.KeyBankName); !found {
+			return errors.New("not enriched")
+		}
+		return nil
+	})).Run(context.Background())
+	require.Nil(t, err)
+	require.Equal(t, int64(1), stats.Succeeded)
+	require.Len(t, processed[0].Enrichments, 2)
+
+	stats, err = New(Bytes(valid), func(ctx context.Context, item Item) error { return nil }, WithEnrichers(banks, failing)).Run(context.Background())
+	require.Nil(t, err)
+	require.Equal(t, int64(1), stats.Stages[StageEnrich])
+}
+
+func TestPipelineBackpressure(t *testing.T) {
+	valid := fixtures.ReadFile(t, "valid_pacs_v08.xml")
+
+	var emitted atomic.Int64
+	source := func(ctx context.Context, emit func(Item) error) error {
+		for i := 0; i < 100; i++ {
+			if err := emit(Item{Input: valid}); err != nil {
+				return err
+			}
+			emitted.Add(1)
+		}
+		return nil
+	}
+	release := make(chan struct{})
+	sink := func(ctx context.Context, item Item) error {
+		<-release
+		return nil
+	}
+
+	done := make(chan Stats)
+	go func() {
+		stats, _ := New(source, sink, WithWorkers(1), WithBuffer(1)).Run(context.Background())
+		done <- stats
+	}()
+
+	// the blocked sink stops the source once the channels between the stages are full
+	time.Sleep(100 * time.Millisecond)
+	require.Less(t, emitted.Load(), int64(10))
+
+	close(release)
+	stats := <-done
+	require.Equal(t, int64(100), stats.Succeeded)
+}
+
+func TestPipelineAborted(t *testing.T) {
+	valid := fixtures.ReadFile(t, "valid_pacs_v08.xml")
+	inputs := make([][]byte, 50)
+	for i := range inputs {
+		inputs[i] = valid
+	}
+
+	stats, err := New(Bytes(inputs...), func(ctx context.Context, item Item) error {
+		return errors.New("disk full")
+	}).Run(context.Background())
+	require.EqualError(t, err, "sink: disk full")
+	require.Equal(t, int64(0), stats.Succeeded)
+
+	source := func(ctx context.Context, emit func(Item) error) error {
+		return errors.New("connection lost")
+	}
+	_, err = New(source, func(ctx context.Context, item Item) error { return nil }).Run(context.Background())
+	require.EqualError(t, err, "source: connection lost")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = New(Bytes(inputs...), func(ctx context.Context, item Item) error { return nil }).Run(ctx)
+	require.ErrorIs(t, err, context.Canceled)
+}
+
+func TestDir(t *testing.T) {
+	dir := t.TempDir()
+	require.Nil(t, os.WriteFile(filepath.Join(dir, "b.xml"), fixtures.ReadFile(t, "valid_pacs_v08.xml"), 0600))
+	require.Nil(t, os.WriteFile(filepath.Join(dir, "a.json"), fixtures.ReadFile(t, "valid_acmt_v03.json"), 0600))
+	require.Nil(t, os.WriteFile(filepath.Join(dir, "notes.txt"), []byte("skipped"), 0600))
+
+	var names []string
+	stats, err := New(Dir(dir), func(ctx context.Context, item Item) error {
+		names = append(names, filepath.Base(item.Name))
+		return nil
+	}, WithWorkers(1)).Run(context.Background())
+	require.Nil(t, err)
+	require.Equal(t, int64(2), stats.Succeeded)
+	require.Equal(t, []string{"a.json", "b.xml"}, names)
+
+	_, err = New(Dir(filepath.Join(dir, "missing")), func(ctx context.Context, item Item) error { return nil }).Run(context.Background())
+	require.NotNil(t, err)
+}
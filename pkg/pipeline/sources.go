@@ -0,0 +1,65 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package pipeline
+
+import (
+	"context"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Bytes emits inputs named by their index
+func Bytes(inputs ...[]byte) Source {
+	return func(ctx context.Context, emit func(Item) error) error {
+		for i, input := range inputs {
+			if err := emit(Item{Name: strconv.Itoa(i), Input: input}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+// Files emits the content of files named by their path, a file is only read once the pipeline accepts it
+func Files(paths ...string) Source {
+	return func(ctx context.Context, emit func(Item) error) error {
+		for _, path := range paths {
+			input, err := os.ReadFile(path)
+			if err != nil {
+				return err
+			}
+			if err = emit(Item{Name: path, Input: input}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+// Dir emits the xml and json files of dir and its subdirectories in alphabetical order
+func Dir(dir string) Source {
+	return func(ctx context.Context, emit func(Item) error) error {
+		var paths []string
+		err := filepath.WalkDir(dir, func(path string, entry fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			ext := strings.ToLower(filepath.Ext(path))
+			if !entry.IsDir() && (ext == ".xml" || ext == ".json") {
+				paths = append(paths, path)
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		sort.Strings(paths)
+		return Files(paths...)(ctx, emit)
+	}
+}
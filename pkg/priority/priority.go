@@ -0,0 +1,161 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package priority
+
+/*
+	Priority derives the processing priority of a transaction from its payment type
+	information (InstrPrty, SttlmPrty, SvcLvl and LclInstrm). The strongest signal wins:
+
+		INSTANT  local instrument of an instant scheme (e.g. INST)
+		URGENT   service level URGP/URNS or settlement priority URGT
+		HIGH     instruction or settlement priority HIGH, service level SDVA
+		NORMAL   everything else
+*/
+
+import (
+	"strings"
+	"time"
+
+	"github.com/moov-io/iso20022/pkg/document"
+	"github.com/moov-io/iso20022/pkg/utils"
+)
+
+// Priority is a processing priority
+type Priority string
+
+const (
+	PriorityNormal  Priority = "NORMAL"
+	PriorityHigh    Priority = "HIGH"
+	PriorityUrgent  Priority = "URGENT"
+	PriorityInstant Priority = "INSTANT"
+)
+
+var (
+	// InstantLocalInstruments are local instrument codes of instant payment schemes
+	InstantLocalInstruments = []string{"INST", "INSTANT", "SCTINST"}
+
+	// DefaultSLAs are the processing time budgets of each priority
+	DefaultSLAs = map[Priority]time.Duration{
+		PriorityInstant: 10 * time.Second,
+		PriorityUrgent:  30 * time.Minute,
+		PriorityHigh:    4 * time.Hour,
+		PriorityNormal:  24 * time.Hour,
+	}
+)
+
+// Rank orders priorities, a higher rank must be processed first
+func (p Priority) Rank() int {
+	switch p {
+	case PriorityInstant:
+		return 3
+	case PriorityUrgent:
+		return 2
+	case PriorityHigh:
+		return 1
+	}
+	return 0
+}
+
+// Tag is the priority derived for a transaction
+type Tag struct {
+	Priority Priority
+	SLA      time.Duration
+
+	// Reason is the element that determined the priority, e.g. "SvcLvl/Cd=URGP"
+	Reason string `json:",omitempty"`
+}
+
+// Tagger derives tags using configurable SLAs
+type Tagger struct {
+	SLAs map[Priority]time.Duration
+}
+
+// NewTagger returns a tagger using DefaultSLAs
+func NewTagger() *Tagger {
+	return &Tagger{SLAs: DefaultSLAs}
+}
+
+// TagTransaction returns the tag of a single transaction
+func (t *Tagger) TagTransaction(tx utils.Transaction) Tag {
+	p, reason := derive(tx)
+	return Tag{Priority: p, SLA: t.SLAs[p], Reason: reason}
+}
+
+// TagMessage returns the tags of every transaction of msg
+func (t *Tagger) TagMessage(msg interface{}) []Tag {
+	var tags []Tag
+	for _, tx := range utils.GetTransactions(msg) {
+		tags = append(tags, t.TagTransaction(tx))
+	}
+	return tags
+}
+
+// Highest returns the tag with the highest priority
+func Highest(tags []Tag) Tag {
+	highest := Tag{Priority: PriorityNormal}
+	for i, tag := range tags {
+		if i == 0 || tag.Priority.Rank() > highest.Priority.Rank() {
+			highest = tag
+		}
+	}
+	return highest
+}
+
+// Of returns the highest priority of msg using DefaultSLAs
+func Of(msg interface{}) Tag {
+	return Highest(NewTagger().TagMessage(msg))
+}
+
+// OfDocument returns the highest priority of the message of doc using DefaultSLAs
+func OfDocument(doc document.Iso20022Document) Tag {
+	return Of(doc.InspectMessage())
+}
+
+func derive(tx utils.Transaction) (Priority, string) {
+	for _, path := range []string{"PmtTpInf/LclInstrm/Cd", "PmtTpInf/LclInstrm/Prtry"} {
+		for _, value := range tx.Values(path) {
+			if contains(InstantLocalInstruments, value) {
+				return PriorityInstant, reason(path, value)
+			}
+		}
+	}
+
+	serviceLevels := tx.Values("PmtTpInf/SvcLvl/Cd")
+	for _, value := range serviceLevels {
+		if contains([]string{"URGP", "URNS"}, value) {
+			return PriorityUrgent, reason("SvcLvl/Cd", value)
+		}
+	}
+	if value := tx.Lookup("SttlmPrty"); value == "URGT" {
+		return PriorityUrgent, reason("SttlmPrty", value)
+	}
+
+	if value := tx.Lookup("PmtTpInf/InstrPrty"); value == "HIGH" {
+		return PriorityHigh, reason("InstrPrty", value)
+	}
+	if value := tx.Lookup("SttlmPrty"); value == "HIGH" {
+		return PriorityHigh, reason("SttlmPrty", value)
+	}
+	for _, value := range serviceLevels {
+		if value == "SDVA" {
+			return PriorityHigh, reason("SvcLvl/Cd", value)
+		}
+	}
+
+	return PriorityNormal, ""
+}
+
+func reason(path, value string) string {
+	return strings.TrimPrefix(path, "PmtTpInf/") + "=" + value
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+	return false
+}
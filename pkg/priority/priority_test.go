@@ -0,0 +1,73 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package priority
+
+import (
+	"testing"
+	"time"
+
+	"github.com/moov-io/iso20022/pkg/iso20022test/fixtures"
+	"github.com/moov-io/iso20022/pkg/pacs_v08"
+	"github.com/stretchr/testify/require"
+)
+
+func message(grpHdr *pacs_v08.PaymentTypeInformation28, txs ...*pacs_v08.PaymentTypeInformation28) pacs_v08.FIToFICustomerCreditTransferV08 {
+	msg := pacs_v08.FIToFICustomerCreditTransferV08{}
+	msg.GrpHdr.PmtTpInf = grpHdr
+	for _, tx := range txs {
+		msg.CdtTrfTxInf = append(msg.CdtTrfTxInf, pacs_v08.CreditTransferTransaction39{
+			PmtId:    pacs_v08.PaymentIdentification7{EndToEndId: "E2E"},
+			PmtTpInf: tx,
+		})
+	}
+	return msg
+}
+
+func TestTagMessage(t *testing.T) {
+	high := pacs_v08.Priority2Code("HIGH")
+	inst := pacs_v08.ExternalLocalInstrument1Code("INST")
+
+	msg := message(
+		&pacs_v08.PaymentTypeInformation28{InstrPrty: &high},
+		nil,
+		&pacs_v08.PaymentTypeInformation28{SvcLvl: []pacs_v08.ServiceLevel8Choice{{Cd: "SEPA"}, {Cd: "URGP"}}},
+		&pacs_v08.PaymentTypeInformation28{LclInstrm: &pacs_v08.LocalInstrument2Choice{Cd: &inst}},
+	)
+
+	tags := NewTagger().TagMessage(&msg)
+	require.Equal(t, []Tag{
+		{Priority: PriorityHigh, SLA: 4 * time.Hour, Reason: "InstrPrty=HIGH"},
+		{Priority: PriorityUrgent, SLA: 30 * time.Minute, Reason: "SvcLvl/Cd=URGP"},
+		{Priority: PriorityInstant, SLA: 10 * time.Second, Reason: "LclInstrm/Cd=INST"},
+	}, tags)
+	require.Equal(t, PriorityInstant, Highest(tags).Priority)
+}
+
+func TestOf(t *testing.T) {
+	sdva := pacs_v08.PaymentTypeInformation28{SvcLvl: []pacs_v08.ServiceLevel8Choice{{Cd: "SDVA"}}}
+	msg := message(nil, &sdva)
+	require.Equal(t, PriorityHigh, Of(&msg).Priority)
+
+	urgent := pacs_v08.Priority3Code("URGT")
+	msg = message(nil, nil)
+	msg.CdtTrfTxInf[0].SttlmPrty = &urgent
+	require.Equal(t, PriorityUrgent, Of(&msg).Priority)
+
+	msg = message(nil)
+	require.Equal(t, Tag{Priority: PriorityNormal, SLA: 24 * time.Hour}, Of(&msg))
+	require.Equal(t, Tag{Priority: PriorityNormal}, Highest(nil))
+
+	tagger := &Tagger{SLAs: map[Priority]time.Duration{PriorityNormal: time.Hour}}
+	require.Equal(t, time.Hour, tagger.TagMessage(&msg)[0].SLA)
+
+	require.Equal(t, PriorityUrgent, OfDocument(fixtures.Document(t, "valid_pacs_v08.xml")).Priority)
+}
+
+func TestRank(t *testing.T) {
+	require.True(t, PriorityInstant.Rank() > PriorityUrgent.Rank())
+	require.True(t, PriorityUrgent.Rank() > PriorityHigh.Rank())
+	require.True(t, PriorityHigh.Rank() > PriorityNormal.Rank())
+	require.Equal(t, 0, Priority("unknown").Rank())
+}
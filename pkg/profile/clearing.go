@@ -0,0 +1,64 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package profile
+
+import (
+	"strings"
+
+	"github.com/moov-io/iso20022/pkg/utils"
+)
+
+// ClearingSystemMemberRuleID identifies the rule of ClearingSystemMemberRule
+const ClearingSystemMemberRuleID = "CLRSYSMMBID"
+
+// ClearingSystemMemberRule checks the member identification of every ClrSysMmbId of a
+// document against the national format of its clearing system, see
+// utils.ValidateClearingSystemMemberId
+func ClearingSystemMemberRule() Rule {
+	return Rule{
+		ID:          ClearingSystemMemberRuleID,
+		Description: "Clearing system member identifications have the format of their clearing system",
+		Severity:    SeverityError,
+		Paths:       []string{"ClrSysMmbId/MmbId"},
+		Check:       checkClearingSystemMembers,
+	}
+}
+
+func checkClearingSystemMembers(in Input) []Finding {
+	var findings []Finding
+	for _, member := range clearingSystemMembers(utils.GetElements(in.Document.InspectMessage()), "ClrSysMmbId") {
+		if err := utils.ValidateClearingSystemMemberId(member.system, member.id); err != nil {
+			findings = append(findings, Finding{Path: member.path, Message: err.Error()})
+		}
+	}
+	return findings
+}
+
+type clearingSystemMember struct {
+	path   string
+	system string
+	id     string
+}
+
+// clearingSystemMembers returns the member identifications of the elements with the path
+// suffix with their clearing system code
+func clearingSystemMembers(elements []utils.Element, suffix string) []clearingSystemMember {
+	codes := make(map[string]string)
+	for _, elm := range elements {
+		if strings.HasSuffix(elm.Path, "/ClrSysId/Cd") {
+			codes[strings.TrimSuffix(elm.Path, "/ClrSysId/Cd")] = elm.Value
+		}
+	}
+
+	var members []clearingSystemMember
+	for _, elm := range elements {
+		if !utils.MatchElementPath(elm.Path, suffix+"/MmbId") {
+			continue
+		}
+		parent := strings.TrimSuffix(elm.Path, "/MmbId")
+		members = append(members, clearingSystemMember{path: elm.Path, system: codes[parent], id: elm.Value})
+	}
+	return members
+}
@@ -0,0 +1,42 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package profile
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/moov-io/iso20022/pkg/document"
+	"github.com/moov-io/iso20022/pkg/iso20022test/fixtures"
+)
+
+func TestClearingSystemMemberRule(t *testing.T) {
+	p := &Profile{Name: "routing", Rules: []Rule{ClearingSystemMemberRule()}}
+	require.True(t, p.Validate(fixtures.Document(t, "valid_pacs_v08.xml")).Valid())
+
+	buf := fixtures.ReadFile(t, "valid_pacs_v08.xml")
+	// two digits of the routing number are swapped
+	doc, err := document.ParseIso20022Document(bytes.Replace(buf, []byte("011000015"), []byte("011000051"), 1))
+	require.Nil(t, err)
+
+	result := p.Evaluate(Input{Document: doc, Now: time.Now()})
+	require.Len(t, result.Findings, 1)
+	require.Equal(t, ClearingSystemMemberRuleID, result.Findings[0].Rule)
+	require.Equal(t, "CdtTrfTxInf[0]/DbtrAgt/FinInstnId/ClrSysMmbId/MmbId", result.Findings[0].Path)
+	require.Equal(t, "The value 011000051 is not a valid USABA clearing system member identification", result.Findings[0].Message)
+
+	expr, err := CompileExpression("clearingmember(DbtrAgt/FinInstnId/ClrSysMmbId)")
+	require.Nil(t, err)
+	require.Len(t, expr.Check(Input{Document: doc}), 1)
+	require.Empty(t, expr.Check(Input{Document: fixtures.Document(t, "valid_pacs_v08.xml")}))
+
+	// other agents aren't checked
+	expr, err = CompileExpression("clearingmember(CdtrAgt/FinInstnId/ClrSysMmbId)")
+	require.Nil(t, err)
+	require.Empty(t, expr.Check(Input{Document: doc}))
+}
@@ -0,0 +1,131 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package profile
+
+/*
+	Code lists are named sets of codes checked by the inlist function of expressions,
+	they're defined in yaml:
+
+		name: ExternalPurpose1Code
+		version: "2021-05"
+		effective: 2021-05-17
+		codes: [CASH, SALA, SUPP, TAXS]
+*/
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// CodeList is a named set of codes
+type CodeList struct {
+	Name    string   `yaml:"name"`
+	Version string   `yaml:"version"`
+	Codes   []string `yaml:"codes"`
+
+	// Effective is the date the version of the list applies from, zero applies to every date
+	Effective time.Time `yaml:"effective"`
+
+	codes map[string]bool
+}
+
+// Contains reports whether code is in the list
+func (l *CodeList) Contains(code string) bool {
+	return l.codes[code]
+}
+
+// NewCodeList returns a code list with name holding codes
+func NewCodeList(name, version string, codes []string) *CodeList {
+	l := &CodeList{Name: name, Version: version, Codes: codes}
+	l.index()
+	return l
+}
+
+func (l *CodeList) index() {
+	l.codes = make(map[string]bool, len(l.Codes))
+	for _, code := range l.Codes {
+		l.codes[code] = true
+	}
+}
+
+// ParseCodeList reads a code list from yaml
+func ParseCodeList(buf []byte) (*CodeList, error) {
+	var l CodeList
+	if err := yaml.Unmarshal(buf, &l); err != nil {
+		return nil, err
+	}
+	if l.Name == "" {
+		return nil, errors.New("code list name is omitted")
+	}
+	l.index()
+	return &l, nil
+}
+
+// LoadCodeList reads and registers the code list defined in a yaml file
+func LoadCodeList(path string) (*CodeList, error) {
+	buf, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	l, err := ParseCodeList(buf)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	RegisterCodeList(l)
+	return l, nil
+}
+
+var (
+	// ErrUnknownCodeList is returned when a code list is not registered
+	ErrUnknownCodeList = errors.New("unknown code list")
+
+	codeListsMu sync.RWMutex
+	codeLists   = make(map[string]*CodeList)
+)
+
+// RegisterCodeList adds l to the registry of code lists, replacing any list with the same name,
+// and records l in the history of the list
+func RegisterCodeList(l *CodeList) {
+	codeListsMu.Lock()
+	codeLists[l.Name] = l
+	codeListsMu.Unlock()
+	recordCodeList(l)
+}
+
+// UnregisterCodeList removes the code list with name from the registry
+func UnregisterCodeList(name string) {
+	codeListsMu.Lock()
+	defer codeListsMu.Unlock()
+	delete(codeLists, name)
+}
+
+// GetCodeList returns the registered code list with name
+func GetCodeList(name string) (*CodeList, error) {
+	codeListsMu.RLock()
+	defer codeListsMu.RUnlock()
+	l, exists := codeLists[name]
+	if !exists {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownCodeList, name)
+	}
+	return l, nil
+}
+
+// CodeListNames returns the names of every registered code list in alphabetical order
+func CodeListNames() []string {
+	codeListsMu.RLock()
+	defer codeListsMu.RUnlock()
+	names := make([]string, 0, len(codeLists))
+	for name := range codeLists {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
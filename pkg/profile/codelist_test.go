@@ -0,0 +1,75 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package profile
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/moov-io/iso20022/pkg/utils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCodeList(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "currencies.yml")
+	require.Nil(t, os.WriteFile(path, []byte("name: TestCurrencies\nversion: \"1\"\ncodes: [EUR, USD]\n"), 0600))
+
+	list, err := LoadCodeList(path)
+	require.Nil(t, err)
+	t.Cleanup(func() { UnregisterCodeList("TestCurrencies") })
+	require.True(t, list.Contains("EUR"))
+	require.False(t, list.Contains("eur"))
+	require.Contains(t, CodeListNames(), "TestCurrencies")
+
+	registered, err := GetCodeList("TestCurrencies")
+	require.Nil(t, err)
+	require.Equal(t, list, registered)
+
+	UnregisterCodeList("TestCurrencies")
+	_, err = GetCodeList("TestCurrencies")
+	require.True(t, errors.Is(err, ErrUnknownCodeList))
+
+	_, err = ParseCodeList([]byte("codes: [EUR]"))
+	require.NotNil(t, err)
+	_, err = ParseCodeList([]byte("codes: {"))
+	require.NotNil(t, err)
+	_, err = LoadCodeList(filepath.Join(t.TempDir(), "missing.yml"))
+	require.NotNil(t, err)
+}
+
+func TestExpressionInList(t *testing.T) {
+	RegisterCodeList(NewCodeList("TestPurposes", "1", []string{"CASH", "SALA"}))
+	t.Cleanup(func() { UnregisterCodeList("TestPurposes") })
+
+	tx := utils.Transaction{
+		Path: "CdtTrfTxInf[0]",
+		Elements: []utils.Element{
+			{Path: "CdtTrfTxInf[0]/Purp/Cd", Value: "SALA"},
+			{Path: "CdtTrfTxInf[0]/RmtInf/Strd[0]/RfrdDocInf/Tp/Cd", Value: "CINV"},
+			{Path: "CdtTrfTxInf[0]/RmtInf/Strd[1]/RfrdDocInf/Tp/Cd", Value: "SALA"},
+		},
+	}
+
+	cases := map[string]bool{
+		"inlist(Purp, 'TestPurposes')":                 true,
+		"inlist(RfrdDocInf/Tp, 'TestPurposes')":        false,
+		"inlist(CtgyPurp, 'TestPurposes')":             true,
+		"inlist(Purp, 'Unknown')":                      false,
+		"!inlist(RfrdDocInf/Tp, 'TestPurposes')":       true,
+		"exists(Purp) && inlist(Purp, 'TestPurposes')": true,
+	}
+	for src, expected := range cases {
+		expr, err := CompileExpression(src)
+		require.Nil(t, err, src)
+		require.Equal(t, expected, expr.Eval(tx), src)
+	}
+
+	for _, src := range []string{"inlist(Purp)", "inlist(Purp, TestPurposes)", "inlist('Purp', 'TestPurposes')"} {
+		_, err := CompileExpression(src)
+		require.NotNil(t, err, src)
+	}
+}
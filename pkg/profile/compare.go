@@ -0,0 +1,132 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package profile
+
+import (
+	"sort"
+)
+
+// Comparison is the outcome of validating a document against a current and a candidate profile
+type Comparison struct {
+	Current   *Result
+	Candidate *Result
+
+	// Divergent reports whether the findings of both profiles differ
+	Divergent bool
+
+	// Added are the findings of the candidate only, Removed the findings of the current profile only
+	Added   []Finding `json:",omitempty"`
+	Removed []Finding `json:",omitempty"`
+}
+
+// NewlyInvalid reports whether the document is valid for the current profile only
+func (c *Comparison) NewlyInvalid() bool {
+	return c.Current.Valid() && !c.Candidate.Valid()
+}
+
+// NewlyValid reports whether the document is valid for the candidate profile only
+func (c *Comparison) NewlyValid() bool {
+	return !c.Current.Valid() && c.Candidate.Valid()
+}
+
+// Compare evaluates in against current and candidate and reports the differences of their findings
+func Compare(current, candidate *Profile, in Input) *Comparison {
+	c := &Comparison{
+		Current:   current.Evaluate(in),
+		Candidate: candidate.Evaluate(in),
+	}
+	c.Added = diffFindings(c.Candidate.Findings, c.Current.Findings)
+	c.Removed = diffFindings(c.Current.Findings, c.Candidate.Findings)
+	c.Divergent = len(c.Added) > 0 || len(c.Removed) > 0
+	return c
+}
+
+// diffFindings returns the findings of a missing from b
+func diffFindings(a, b []Finding) []Finding {
+	remaining := make(map[Finding]int)
+	for _, finding := range b {
+		remaining[finding]++
+	}
+
+	var diff []Finding
+	for _, finding := range a {
+		if remaining[finding] > 0 {
+			remaining[finding]--
+			continue
+		}
+		diff = append(diff, finding)
+	}
+	return diff
+}
+
+// ComparisonSummary aggregates the comparisons of many documents
+type ComparisonSummary struct {
+	Current   string
+	Candidate string
+
+	Documents    int
+	Divergent    int
+	NewlyInvalid int
+	NewlyValid   int
+
+	// Added and Removed count the added and removed findings by rule
+	Added   map[string]int `json:",omitempty"`
+	Removed map[string]int `json:",omitempty"`
+
+	// Failing counts the newly invalid documents by the rules of their added errors
+	Failing map[string]int `json:",omitempty"`
+}
+
+// NewComparisonSummary returns an empty summary of comparing current and candidate
+func NewComparisonSummary(current, candidate *Profile) *ComparisonSummary {
+	return &ComparisonSummary{
+		Current:   current.Name + "@" + current.Version,
+		Candidate: candidate.Name + "@" + candidate.Version,
+		Added:     make(map[string]int),
+		Removed:   make(map[string]int),
+		Failing:   make(map[string]int),
+	}
+}
+
+// Add counts c in the summary
+func (s *ComparisonSummary) Add(c *Comparison) {
+	s.Documents++
+	if c.Divergent {
+		s.Divergent++
+	}
+	if c.NewlyInvalid() {
+		s.NewlyInvalid++
+		var rules []string
+		for _, finding := range c.Added {
+			if finding.Severity == SeverityError && !containsName(rules, finding.Rule) {
+				rules = append(rules, finding.Rule)
+				s.Failing[finding.Rule]++
+			}
+		}
+	}
+	if c.NewlyValid() {
+		s.NewlyValid++
+	}
+	for _, finding := range c.Added {
+		s.Added[finding.Rule]++
+	}
+	for _, finding := range c.Removed {
+		s.Removed[finding.Rule]++
+	}
+}
+
+// Rules returns the rules with added or removed findings in alphabetical order
+func (s *ComparisonSummary) Rules() []string {
+	var rules []string
+	for _, counts := range []map[string]int{s.Added, s.Removed} {
+		for rule := range counts {
+			if !containsName(rules, rule) {
+				rules = append(rules, rule)
+			}
+		}
+	}
+	sort.Strings(rules)
+	return rules
+}
@@ -0,0 +1,69 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package profile
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/moov-io/iso20022/pkg/iso20022test/fixtures"
+)
+
+func TestCompare(t *testing.T) {
+	current := SCTInst()
+	cfg, err := ParseConfig([]byte(`
+name: SCTInst
+version: "2"
+extends: SCTInst
+rules:
+  - id: CAND-001
+    expression: "exists(PmtId/UETR)"
+    message: UETR is required
+  - id: CAND-002
+    severity: warning
+    expression: "IntrBkSttlmAmt <= 0.01"
+`))
+	require.Nil(t, err)
+	candidate, err := NewProfile(cfg)
+	require.Nil(t, err)
+
+	accepted := time.Date(2021, 4, 15, 10, 29, 58, 0, time.UTC)
+	in := Input{Document: fixtures.Document(t, "sct_inst_pacs_v08.xml"), Now: accepted.Add(time.Second)}
+
+	c := Compare(current, candidate, in)
+	require.True(t, c.Divergent)
+	require.True(t, c.Current.Valid())
+	require.False(t, c.Candidate.Valid())
+	require.True(t, c.NewlyInvalid())
+	require.False(t, c.NewlyValid())
+	require.Empty(t, c.Removed)
+	require.Len(t, c.Added, 2)
+	require.Equal(t, Finding{Rule: "CAND-001", Severity: SeverityError, Path: "CdtTrfTxInf[0]", Message: "UETR is required"}, c.Added[0])
+
+	// swapping the profiles swaps the differences
+	swapped := Compare(candidate, current, in)
+	require.True(t, swapped.NewlyValid())
+	require.Equal(t, c.Added, swapped.Removed)
+
+	same := Compare(current, current, in)
+	require.False(t, same.Divergent)
+
+	summary := NewComparisonSummary(current, candidate)
+	summary.Add(c)
+	summary.Add(swapped)
+	summary.Add(same)
+	require.Equal(t, "SCTInst@"+current.Version, summary.Current)
+	require.Equal(t, "SCTInst@2", summary.Candidate)
+	require.Equal(t, 3, summary.Documents)
+	require.Equal(t, 2, summary.Divergent)
+	require.Equal(t, 1, summary.NewlyInvalid)
+	require.Equal(t, 1, summary.NewlyValid)
+	require.Equal(t, map[string]int{"CAND-001": 1, "CAND-002": 1}, summary.Added)
+	// warnings don't make documents fail
+	require.Equal(t, map[string]int{"CAND-001": 1}, summary.Failing)
+	require.Equal(t, []string{"CAND-001", "CAND-002"}, summary.Rules())
+}
@@ -0,0 +1,192 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package profile
+
+/*
+	Profiles can be defined in yaml with expression rules, rules of a registered
+	profile are inherited with "extends":
+
+		name: SCTInst-DE
+		version: "1"
+		effective: 2021-11-21
+		extends: SCTInst
+		rules:
+		  - id: DE-001
+		    description: SEPA transactions are limited to 100000
+		    expression: "IntrBkSttlmAmt <= 100000 when SvcLvl == 'SEPA'"
+		  - id: DE-002
+		    severity: warning
+		    expression: "exists(PmtId/UETR)"
+		    message: UETR is recommended
+
+	The proxy identifications accepted by instant schemes are checked with the formats of
+	a scheme of ProxySchemes, replaced or extended by formats of their own:
+
+		proxies:
+		  scheme: PIX
+		  formats:
+		    - type: TELE
+		      pattern: '^\+55[0-9]{10,11}$'
+*/
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+var (
+	// ErrExpressionOmitted is returned when a configured rule has no expression
+	ErrExpressionOmitted = errors.New("rule expression is omitted")
+)
+
+// Config defines a profile with expression rules
+type Config struct {
+	Name         string       `yaml:"name"`
+	Version      string       `yaml:"version"`
+	Description  string       `yaml:"description"`
+	Extends      string       `yaml:"extends"`
+	Effective    time.Time    `yaml:"effective"`
+	MessageTypes []string     `yaml:"messageTypes"`
+	Rules        []RuleConfig `yaml:"rules"`
+	Proxies      *ProxyConfig `yaml:"proxies"`
+
+	// Experimental profiles are only used by the requests enabling the experimental-profiles
+	// feature, see features.ExperimentalProfiles
+	Experimental bool `yaml:"experimental"`
+}
+
+// RuleConfig defines a rule checked by an expression
+type RuleConfig struct {
+	ID           string   `yaml:"id"`
+	Description  string   `yaml:"description"`
+	Severity     Severity `yaml:"severity"`
+	MessageTypes []string `yaml:"messageTypes"`
+	Expression   string   `yaml:"expression"`
+
+	// Message replaces the default message of findings
+	Message string `yaml:"message"`
+}
+
+// ParseConfig reads a profile definition from yaml
+func ParseConfig(buf []byte) (*Config, error) {
+	var cfg Config
+	if err := yaml.Unmarshal(buf, &cfg); err != nil {
+		return nil, err
+	}
+	return &cfg, nil
+}
+
+// LoadConfig reads a profile definition from a yaml file
+func LoadConfig(path string) (*Config, error) {
+	buf, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return ParseConfig(buf)
+}
+
+// NewProfile compiles the rules of cfg into a profile
+func NewProfile(cfg *Config) (*Profile, error) {
+	return newProfile(cfg, Get)
+}
+
+// newProfile compiles cfg, looking up the extended profile with lookup
+func newProfile(cfg *Config, lookup func(name string) (*Profile, error)) (*Profile, error) {
+	if cfg.Name == "" {
+		return nil, errors.New("profile name is omitted")
+	}
+
+	p := &Profile{
+		Name:         cfg.Name,
+		Version:      cfg.Version,
+		Description:  cfg.Description,
+		MessageTypes: cfg.MessageTypes,
+		Effective:    cfg.Effective,
+		Experimental: cfg.Experimental,
+	}
+	if cfg.Extends != "" {
+		base, err := lookup(cfg.Extends)
+		if err != nil {
+			return nil, err
+		}
+		if len(p.MessageTypes) == 0 {
+			p.MessageTypes = base.MessageTypes
+		}
+		p.Rules = append(p.Rules, base.Rules...)
+	}
+
+	for i, rc := range cfg.Rules {
+		rule, err := rc.compile()
+		if err != nil {
+			return nil, fmt.Errorf("rule %d of profile %s: %w", i+1, cfg.Name, err)
+		}
+		p.Rules = append(p.Rules, rule)
+	}
+	if cfg.Proxies != nil {
+		rule, err := cfg.Proxies.compile()
+		if err != nil {
+			return nil, fmt.Errorf("proxies of profile %s: %w", cfg.Name, err)
+		}
+		p.Rules = append(p.Rules, rule)
+	}
+	return p, nil
+}
+
+// LoadProfile reads, compiles and registers the profile defined in a yaml file
+func LoadProfile(path string) (*Profile, error) {
+	cfg, err := LoadConfig(path)
+	if err != nil {
+		return nil, err
+	}
+	p, err := NewProfile(cfg)
+	if err != nil {
+		return nil, err
+	}
+	Register(p)
+	return p, nil
+}
+
+func (rc RuleConfig) compile() (Rule, error) {
+	if rc.Expression == "" {
+		return Rule{}, ErrExpressionOmitted
+	}
+	switch rc.Severity {
+	case "", SeverityError, SeverityWarning:
+	default:
+		return Rule{}, fmt.Errorf("unknown severity %s", rc.Severity)
+	}
+
+	expr, err := CompileExpression(rc.Expression)
+	if err != nil {
+		return Rule{}, err
+	}
+
+	rule := Rule{
+		ID:           rc.ID,
+		Description:  rc.Description,
+		Severity:     rc.Severity,
+		Paths:        expr.Paths(),
+		MessageTypes: rc.MessageTypes,
+		Expression:   rc.Expression,
+		Check:        expr.Check,
+	}
+	if rule.ID == "" {
+		rule.ID = rc.Expression
+	}
+	if rc.Message != "" {
+		rule.Check = func(in Input) []Finding {
+			findings := expr.Check(in)
+			for i := range findings {
+				findings[i].Message = rc.Message
+			}
+			return findings
+		}
+	}
+	return rule, nil
+}
@@ -0,0 +1,92 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package profile
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/moov-io/iso20022/pkg/iso20022test/fixtures"
+)
+
+const testProfileConfig = `
+name: SCTInst-Test
+version: "1"
+extends: SCTInst
+rules:
+  - id: TEST-001
+    description: Creditor name is latin
+    expression: "matches(Cdtr/Nm, '^[A-Za-z ]+$')"
+  - id: TEST-002
+    severity: warning
+    expression: "exists(PmtId/UETR)"
+    message: UETR is recommended
+`
+
+func TestNewProfile(t *testing.T) {
+	cfg, err := ParseConfig([]byte(testProfileConfig))
+	require.Nil(t, err)
+
+	p, err := NewProfile(cfg)
+	require.Nil(t, err)
+	require.Equal(t, []string{"pacs.008"}, p.MessageTypes)
+	require.Len(t, p.Rules, len(SCTInst().Rules)+2)
+	require.Equal(t, "exists(PmtId/UETR)", p.Rules[len(p.Rules)-1].Expression)
+	require.False(t, p.Experimental)
+
+	cfg, err = ParseConfig([]byte(testProfileConfig + "experimental: true\n"))
+	require.Nil(t, err)
+	experimental, err := NewProfile(cfg)
+	require.Nil(t, err)
+	require.True(t, experimental.Experimental)
+
+	accepted := time.Date(2021, 4, 15, 10, 29, 58, 0, time.UTC)
+	result := p.Evaluate(Input{Document: fixtures.Document(t, "sct_inst_pacs_v08.xml"), Now: accepted.Add(time.Second)})
+	require.True(t, result.Valid())
+	require.Equal(t, []Finding{{
+		Rule:     "TEST-002",
+		Severity: SeverityWarning,
+		Path:     "CdtTrfTxInf[0]",
+		Message:  "UETR is recommended",
+	}}, result.Warnings())
+}
+
+func TestNewProfileErrors(t *testing.T) {
+	_, err := ParseConfig([]byte("name: [invalid"))
+	require.NotNil(t, err)
+
+	for _, cfg := range []*Config{
+		{},
+		{Name: "test", Extends: "unknown"},
+		{Name: "test", Rules: []RuleConfig{{ID: "R1"}}},
+		{Name: "test", Rules: []RuleConfig{{ID: "R1", Expression: "Amt <"}}},
+		{Name: "test", Rules: []RuleConfig{{ID: "R1", Expression: "Amt < 1", Severity: "fatal"}}},
+	} {
+		_, err := NewProfile(cfg)
+		require.NotNil(t, err)
+	}
+
+	_, err = NewProfile(&Config{Name: "test", Rules: []RuleConfig{{}}})
+	require.ErrorIs(t, err, ErrExpressionOmitted)
+}
+
+func TestLoadProfile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "profile.yaml")
+	require.Nil(t, os.WriteFile(path, []byte(testProfileConfig), 0600))
+
+	p, err := LoadProfile(path)
+	require.Nil(t, err)
+
+	registered, err := Get("SCTInst-Test")
+	require.Nil(t, err)
+	require.Equal(t, p, registered)
+
+	_, err = LoadProfile(filepath.Join(t.TempDir(), "missing.yaml"))
+	require.NotNil(t, err)
+}
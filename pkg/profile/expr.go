@@ -0,0 +1,539 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package profile
+
+/*
+	Expressions are small assertions evaluated against every transaction of a document,
+	for example:
+
+		IntrBkSttlmAmt <= 100000 when SvcLvl == 'SEPA'
+		exists(PmtId/UETR) || IntrBkSttlmAmt/@Ccy != 'USD'
+		matches(Cdtr/Nm, '^[A-Za-z ]+$')
+		inlist(Purp, 'ExternalPurpose1Code')
+		clearingmember(InstdAgt/FinInstnId/ClrSysMmbId)
+
+	Identifiers are element path suffixes (see utils.MatchElementPath), an identifier
+	without a value of its own resolves to its code (e.g. SvcLvl to SvcLvl/Cd).
+	Missing elements compare as empty strings. Values are compared as numbers when
+	both sides are numeric, as strings otherwise.
+
+	Supported operators are ==, !=, <, <=, >, >=, && (and), || (or), ! (not) and
+	parentheses, functions are exists(path), count(path), matches(path, regexp),
+	inlist(path, codelist) and clearingmember(path). inlist holds when every value of path
+	is in the registered code list, the list is looked up on evaluation so reloaded lists
+	apply immediately. clearingmember holds when the member identification of every
+	ClrSysMmbId of path has the national format of its clearing system.
+	An optional "when" clause restricts the assertion to the transactions it holds for.
+*/
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/moov-io/iso20022/pkg/utils"
+)
+
+// Expression is a compiled assertion
+type Expression struct {
+	source    string
+	assertion exprNode
+	condition exprNode
+}
+
+// CompileExpression parses source into an expression
+func CompileExpression(source string) (*Expression, error) {
+	tokens, err := lexExpression(source)
+	if err != nil {
+		return nil, fmt.Errorf("expression %q: %w", source, err)
+	}
+	p := &exprParser{tokens: tokens}
+
+	expr := &Expression{source: source}
+	if expr.assertion, err = p.parseOr(); err != nil {
+		return nil, fmt.Errorf("expression %q: %w", source, err)
+	}
+	if p.peek().isKeyword("when") {
+		p.next()
+		if expr.condition, err = p.parseOr(); err != nil {
+			return nil, fmt.Errorf("expression %q: %w", source, err)
+		}
+	}
+	if tok := p.peek(); tok.kind != tokenEOF {
+		return nil, fmt.Errorf("expression %q: unexpected %q at %d", source, tok.text, tok.pos)
+	}
+	return expr, nil
+}
+
+// String returns the source of the expression
+func (e *Expression) String() string {
+	return e.source
+}
+
+// Paths returns the element paths the expression inspects, its assertion and when clause,
+// in order of appearance
+func (e *Expression) Paths() []string {
+	var paths []string
+	seen := make(map[string]bool)
+	var walk func(n exprNode)
+	walk = func(n exprNode) {
+		switch n := n.(type) {
+		case pathNode:
+			if !seen[n.path] {
+				seen[n.path] = true
+				paths = append(paths, n.path)
+			}
+		case callNode:
+			walk(pathNode{path: n.path})
+		case notNode:
+			walk(n.operand)
+		case logicalNode:
+			walk(n.left)
+			walk(n.right)
+		case compareNode:
+			walk(n.left)
+			walk(n.right)
+		}
+	}
+	walk(e.assertion)
+	if e.condition != nil {
+		walk(e.condition)
+	}
+	return paths
+}
+
+// Eval evaluates the expression against a single transaction, a transaction the when clause does not hold for passes
+func (e *Expression) Eval(tx utils.Transaction) bool {
+	return e.eval(evalContext{Transaction: tx, codeList: GetCodeList})
+}
+
+func (e *Expression) eval(ctx evalContext) bool {
+	if e.condition != nil && !truthy(e.condition.eval(ctx)) {
+		return true
+	}
+	return truthy(e.assertion.eval(ctx))
+}
+
+// Check returns a finding for every transaction of the input the expression is false for
+func (e *Expression) Check(in Input) []Finding {
+	codeList := in.CodeList
+	if codeList == nil {
+		codeList = GetCodeList
+	}
+	var findings []Finding
+	for _, tx := range utils.GetTransactions(in.Document.InspectMessage()) {
+		if !e.eval(evalContext{Transaction: tx, codeList: codeList}) {
+			findings = append(findings, Finding{Path: tx.Path, Message: fmt.Sprintf("%s is not satisfied", e.source)})
+		}
+	}
+	return findings
+}
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenIdent
+	tokenNumber
+	tokenString
+	tokenOperator
+)
+
+type exprToken struct {
+	kind tokenKind
+	text string
+	pos  int
+}
+
+func (t exprToken) isKeyword(keyword string) bool {
+	return t.kind == tokenIdent && t.text == keyword
+}
+
+func (t exprToken) isOperator(ops ...string) bool {
+	if t.kind != tokenOperator {
+		return false
+	}
+	for _, op := range ops {
+		if t.text == op {
+			return true
+		}
+	}
+	return false
+}
+
+var exprOperators = []string{"==", "!=", "<=", ">=", "&&", "||", "<", ">", "!", "(", ")", ","}
+
+func lexExpression(source string) ([]exprToken, error) {
+	var tokens []exprToken
+	runes := []rune(source)
+	for i := 0; i < len(runes); {
+		c := runes[i]
+		switch {
+		case unicode.IsSpace(c):
+			i++
+		case c == '\'' || c == '"':
+			end := i + 1
+			for end < len(runes) && runes[end] != c {
+				end++
+			}
+			if end == len(runes) {
+				return nil, fmt.Errorf("unterminated string at %d", i)
+			}
+			tokens = append(tokens, exprToken{kind: tokenString, text: string(runes[i+1 : end]), pos: i})
+			i = end + 1
+		case unicode.IsDigit(c) || (c == '-' && i+1 < len(runes) && unicode.IsDigit(runes[i+1])):
+			end := i + 1
+			for end < len(runes) && (unicode.IsDigit(runes[end]) || runes[end] == '.') {
+				end++
+			}
+			tokens = append(tokens, exprToken{kind: tokenNumber, text: string(runes[i:end]), pos: i})
+			i = end
+		case unicode.IsLetter(c) || c == '_' || c == '@':
+			end := i + 1
+			for end < len(runes) && isIdentRune(runes[end]) {
+				end++
+			}
+			tokens = append(tokens, exprToken{kind: tokenIdent, text: string(runes[i:end]), pos: i})
+			i = end
+		default:
+			matched := false
+			for _, op := range exprOperators {
+				if strings.HasPrefix(string(runes[i:]), op) {
+					tokens = append(tokens, exprToken{kind: tokenOperator, text: op, pos: i})
+					i += len([]rune(op))
+					matched = true
+					break
+				}
+			}
+			if !matched {
+				return nil, fmt.Errorf("unexpected %q at %d", c, i)
+			}
+		}
+	}
+	return append(tokens, exprToken{kind: tokenEOF, pos: len(runes)}), nil
+}
+
+func isIdentRune(c rune) bool {
+	return unicode.IsLetter(c) || unicode.IsDigit(c) || c == '_' || c == '/' || c == '@'
+}
+
+type exprParser struct {
+	tokens []exprToken
+	pos    int
+}
+
+func (p *exprParser) peek() exprToken {
+	return p.tokens[p.pos]
+}
+
+func (p *exprParser) next() exprToken {
+	tok := p.tokens[p.pos]
+	if tok.kind != tokenEOF {
+		p.pos++
+	}
+	return tok
+}
+
+func (p *exprParser) expect(op string) error {
+	if tok := p.next(); !tok.isOperator(op) {
+		return fmt.Errorf("expected %q at %d", op, tok.pos)
+	}
+	return nil
+}
+
+func (p *exprParser) parseOr() (exprNode, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.peek().isOperator("||") || p.peek().isKeyword("or") {
+		p.next()
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = logicalNode{or: true, left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *exprParser) parseAnd() (exprNode, error) {
+	left, err := p.parseNot()
+	if err != nil {
+		return nil, err
+	}
+	for p.peek().isOperator("&&") || p.peek().isKeyword("and") {
+		p.next()
+		right, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		left = logicalNode{left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *exprParser) parseNot() (exprNode, error) {
+	if p.peek().isOperator("!") || p.peek().isKeyword("not") {
+		p.next()
+		operand, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		return notNode{operand: operand}, nil
+	}
+	return p.parseComparison()
+}
+
+func (p *exprParser) parseComparison() (exprNode, error) {
+	left, err := p.parseOperand()
+	if err != nil {
+		return nil, err
+	}
+	if tok := p.peek(); tok.isOperator("==", "!=", "<", "<=", ">", ">=") {
+		p.next()
+		right, err := p.parseOperand()
+		if err != nil {
+			return nil, err
+		}
+		return compareNode{op: tok.text, left: left, right: right}, nil
+	}
+	return left, nil
+}
+
+func (p *exprParser) parseOperand() (exprNode, error) {
+	tok := p.next()
+	switch {
+	case tok.kind == tokenNumber:
+		number, err := strconv.ParseFloat(tok.text, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid number %q at %d", tok.text, tok.pos)
+		}
+		return literalNode{value: number}, nil
+	case tok.kind == tokenString:
+		return literalNode{value: tok.text}, nil
+	case tok.isKeyword("true"), tok.isKeyword("false"):
+		return literalNode{value: tok.text == "true"}, nil
+	case tok.isOperator("("):
+		node, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		return node, p.expect(")")
+	case tok.kind == tokenIdent && p.peek().isOperator("("):
+		return p.parseCall(tok)
+	case tok.kind == tokenIdent:
+		return pathNode{path: tok.text}, nil
+	case tok.kind == tokenEOF:
+		return nil, fmt.Errorf("unexpected end of expression")
+	}
+	return nil, fmt.Errorf("unexpected %q at %d", tok.text, tok.pos)
+}
+
+func (p *exprParser) parseCall(name exprToken) (exprNode, error) {
+	p.next()
+	arg := p.next()
+	if arg.kind != tokenIdent {
+		return nil, fmt.Errorf("%s expects a path at %d", name.text, arg.pos)
+	}
+	call := callNode{name: name.text, path: arg.text}
+
+	switch name.text {
+	case "exists", "count", "clearingmember":
+	case "inlist":
+		if err := p.expect(","); err != nil {
+			return nil, err
+		}
+		list := p.next()
+		if list.kind != tokenString {
+			return nil, fmt.Errorf("inlist expects a code list at %d", list.pos)
+		}
+		call.list = list.text
+	case "matches":
+		if err := p.expect(","); err != nil {
+			return nil, err
+		}
+		pattern := p.next()
+		if pattern.kind != tokenString {
+			return nil, fmt.Errorf("matches expects a pattern at %d", pattern.pos)
+		}
+		re, err := regexp.Compile(pattern.text)
+		if err != nil {
+			return nil, fmt.Errorf("invalid pattern %q: %w", pattern.text, err)
+		}
+		call.pattern = re
+	default:
+		return nil, fmt.Errorf("unknown function %s at %d", name.text, name.pos)
+	}
+	return call, p.expect(")")
+}
+
+type exprNode interface {
+	eval(ctx evalContext) interface{}
+}
+
+// evalContext is the transaction an expression is evaluated against with the code lists of
+// the inlist function
+type evalContext struct {
+	utils.Transaction
+	codeList func(name string) (*CodeList, error)
+}
+
+type literalNode struct {
+	value interface{}
+}
+
+func (n literalNode) eval(ctx evalContext) interface{} {
+	return n.value
+}
+
+type pathNode struct {
+	path string
+}
+
+func (n pathNode) eval(ctx evalContext) interface{} {
+	return ctx.Lookup(n.path, n.path+"/Cd")
+}
+
+type callNode struct {
+	name    string
+	path    string
+	pattern *regexp.Regexp
+	list    string
+}
+
+func (n callNode) eval(ctx evalContext) interface{} {
+	if n.name == "clearingmember" {
+		for _, member := range clearingSystemMembers(ctx.Elements, n.path) {
+			if utils.ValidateClearingSystemMemberId(member.system, member.id) != nil {
+				return false
+			}
+		}
+		return true
+	}
+
+	values := ctx.Values(n.path)
+	if len(values) == 0 {
+		values = ctx.Values(n.path + "/Cd")
+	}
+	switch n.name {
+	case "exists":
+		return len(values) > 0
+	case "count":
+		return float64(len(values))
+	case "inlist":
+		list, err := ctx.codeList(n.list)
+		if err != nil {
+			return false
+		}
+		for _, value := range values {
+			if !list.Contains(value) {
+				return false
+			}
+		}
+		return true
+	}
+	for _, value := range values {
+		if n.pattern.MatchString(value) {
+			return true
+		}
+	}
+	return false
+}
+
+type notNode struct {
+	operand exprNode
+}
+
+func (n notNode) eval(ctx evalContext) interface{} {
+	return !truthy(n.operand.eval(ctx))
+}
+
+type logicalNode struct {
+	or          bool
+	left, right exprNode
+}
+
+func (n logicalNode) eval(ctx evalContext) interface{} {
+	if n.or {
+		return truthy(n.left.eval(ctx)) || truthy(n.right.eval(ctx))
+	}
+	return truthy(n.left.eval(ctx)) && truthy(n.right.eval(ctx))
+}
+
+type compareNode struct {
+	op          string
+	left, right exprNode
+}
+
+func (n compareNode) eval(ctx evalContext) interface{} {
+	left, right := n.left.eval(ctx), n.right.eval(ctx)
+
+	var cmp int
+	leftNum, leftOk := toNumber(left)
+	rightNum, rightOk := toNumber(right)
+	switch {
+	case leftOk && rightOk:
+		switch {
+		case leftNum < rightNum:
+			cmp = -1
+		case leftNum > rightNum:
+			cmp = 1
+		}
+	default:
+		cmp = strings.Compare(toString(left), toString(right))
+	}
+
+	switch n.op {
+	case "==":
+		return cmp == 0
+	case "!=":
+		return cmp != 0
+	case "<":
+		return cmp < 0
+	case "<=":
+		return cmp <= 0
+	case ">":
+		return cmp > 0
+	}
+	return cmp >= 0
+}
+
+func toNumber(value interface{}) (float64, bool) {
+	switch v := value.(type) {
+	case float64:
+		return v, true
+	case string:
+		number, err := strconv.ParseFloat(v, 64)
+		return number, err == nil
+	}
+	return 0, false
+}
+
+func toString(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(v)
+	}
+	return ""
+}
+
+func truthy(value interface{}) bool {
+	switch v := value.(type) {
+	case bool:
+		return v
+	case float64:
+		return v != 0
+	case string:
+		return v != ""
+	}
+	return false
+}
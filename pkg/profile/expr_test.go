@@ -0,0 +1,85 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package profile
+
+import (
+	"testing"
+
+	"github.com/moov-io/iso20022/pkg/iso20022test/fixtures"
+	"github.com/moov-io/iso20022/pkg/utils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExpressionEval(t *testing.T) {
+	tx := utils.Transaction{
+		Path: "CdtTrfTxInf[0]",
+		Elements: []utils.Element{
+			{Path: "CdtTrfTxInf[0]/PmtId/EndToEndId", Value: "E2E-1"},
+			{Path: "CdtTrfTxInf[0]/IntrBkSttlmAmt", Value: "150000"},
+			{Path: "CdtTrfTxInf[0]/IntrBkSttlmAmt/@Ccy", Value: "EUR"},
+			{Path: "CdtTrfTxInf[0]/Cdtr/Nm", Value: "Jane Doe"},
+			{Path: "GrpHdr/PmtTpInf/SvcLvl[0]/Cd", Value: "SEPA"},
+			{Path: "GrpHdr/PmtTpInf/SvcLvl[1]/Prtry", Value: "INST"},
+		},
+	}
+
+	cases := map[string]bool{
+		"IntrBkSttlmAmt <= 100000 when SvcLvl == 'SEPA'":            false,
+		"IntrBkSttlmAmt <= 100000 when SvcLvl == 'URGP'":            true,
+		"IntrBkSttlmAmt > 100000 && IntrBkSttlmAmt/@Ccy == \"EUR\"": true,
+		"IntrBkSttlmAmt/@Ccy == 'USD' or not exists(PmtId/UETR)":    true,
+		"!(IntrBkSttlmAmt >= 150000)":                               false,
+		"count(SvcLvl) == 1 and exists(SvcLvl/Prtry)":               true,
+		"matches(Cdtr/Nm, '^[A-Za-z ]+$')":                          true,
+		"matches(Dbtr/Nm, '.*')":                                    false,
+		"PmtId/UETR == ''":                                          true,
+		"PmtId/EndToEndId != 'E2E-2' && true":                       true,
+		"PmtId/EndToEndId < 'E2E-2'":                                true,
+		"IntrBkSttlmAmt == -1 || false":                             false,
+	}
+	for source, expected := range cases {
+		expr, err := CompileExpression(source)
+		require.Nil(t, err, source)
+		require.Equal(t, expected, expr.Eval(tx), source)
+		require.Equal(t, source, expr.String())
+	}
+}
+
+func TestCompileExpressionErrors(t *testing.T) {
+	for _, source := range []string{
+		"",
+		"IntrBkSttlmAmt <=",
+		"IntrBkSttlmAmt == 'EUR",
+		"(IntrBkSttlmAmt == 1",
+		"IntrBkSttlmAmt == 1 when",
+		"IntrBkSttlmAmt == 1 IntrBkSttlmAmt",
+		"unknown(IntrBkSttlmAmt)",
+		"exists('EUR')",
+		"matches(Cdtr/Nm)",
+		"matches(Cdtr/Nm, '[')",
+		"IntrBkSttlmAmt # 1",
+		"IntrBkSttlmAmt == 1.2.3",
+	} {
+		_, err := CompileExpression(source)
+		require.NotNil(t, err, source)
+	}
+}
+
+func TestExpressionPaths(t *testing.T) {
+	expr, err := CompileExpression("!exists(PmtId/UETR) || IntrBkSttlmAmt/@Ccy != 'USD' && IntrBkSttlmAmt <= 100000 when SvcLvl == 'SEPA' && matches(PmtId/UETR, '^[a-f0-9-]+$')")
+	require.Nil(t, err)
+	require.Equal(t, []string{"PmtId/UETR", "IntrBkSttlmAmt/@Ccy", "IntrBkSttlmAmt", "SvcLvl"}, expr.Paths())
+}
+
+func TestExpressionCheck(t *testing.T) {
+	expr, err := CompileExpression("IntrBkSttlmAmt <= 100000 when IntrBkSttlmAmt/@Ccy == 'USD'")
+	require.Nil(t, err)
+
+	findings := expr.Check(Input{Document: fixtures.Document(t, "valid_pacs_v08.xml")})
+	require.Equal(t, []Finding{{
+		Path:    "CdtTrfTxInf[0]",
+		Message: "IntrBkSttlmAmt <= 100000 when IntrBkSttlmAmt/@Ccy == 'USD' is not satisfied",
+	}}, findings)
+}
@@ -0,0 +1,223 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package profile
+
+/*
+	The history keeps every version of the profiles and code lists registered since the
+	start of the process, and the past versions loaded with LoadHistory, so documents can
+	be validated with the rules that applied when they were sent:
+
+		result, err := profile.ValidateAsOf("SCTInst-DE", doc, sent)
+
+	A version applies from its effective date until the effective date of the next
+	version, a version without effective date applies until the first dated one.
+*/
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/moov-io/iso20022/pkg/document"
+)
+
+var (
+	// ErrNotEffective is returned when no version of a profile or code list applies at a date
+	ErrNotEffective = errors.New("no effective version")
+
+	historyMu       sync.RWMutex
+	profileHistory  = make(map[string][]*Profile)
+	codeListHistory = make(map[string][]*CodeList)
+)
+
+// recordProfile adds p to the versions of its profile, replacing the version with the same
+// version and effective date
+func recordProfile(p *Profile) {
+	historyMu.Lock()
+	defer historyMu.Unlock()
+	versions := profileHistory[p.Name]
+	for i, v := range versions {
+		if v.Version == p.Version && v.Effective.Equal(p.Effective) {
+			versions = append(versions[:i], versions[i+1:]...)
+			break
+		}
+	}
+	versions = append(versions, p)
+	// versions with the same effective date keep the order they were recorded in
+	sort.SliceStable(versions, func(i, j int) bool {
+		return versions[i].Effective.Before(versions[j].Effective)
+	})
+	profileHistory[p.Name] = versions
+}
+
+// recordCodeList adds l to the versions of its code list, replacing the version with the same
+// version and effective date
+func recordCodeList(l *CodeList) {
+	historyMu.Lock()
+	defer historyMu.Unlock()
+	versions := codeListHistory[l.Name]
+	for i, v := range versions {
+		if v.Version == l.Version && v.Effective.Equal(l.Effective) {
+			versions = append(versions[:i], versions[i+1:]...)
+			break
+		}
+	}
+	versions = append(versions, l)
+	sort.SliceStable(versions, func(i, j int) bool {
+		return versions[i].Effective.Before(versions[j].Effective)
+	})
+	codeListHistory[l.Name] = versions
+}
+
+// Versions returns the recorded versions of the profile with name by effective date
+func Versions(name string) []*Profile {
+	historyMu.RLock()
+	defer historyMu.RUnlock()
+	return append([]*Profile{}, profileHistory[name]...)
+}
+
+// CodeListVersions returns the recorded versions of the code list with name by effective date
+func CodeListVersions(name string) []*CodeList {
+	historyMu.RLock()
+	defer historyMu.RUnlock()
+	return append([]*CodeList{}, codeListHistory[name]...)
+}
+
+// GetAsOf returns the version of the profile with name that applied at asOf, the last recorded
+// of the versions effective at asOf
+func GetAsOf(name string, asOf time.Time) (*Profile, error) {
+	historyMu.RLock()
+	defer historyMu.RUnlock()
+	versions, exists := profileHistory[name]
+	if !exists {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownProfile, name)
+	}
+	for i := len(versions) - 1; i >= 0; i-- {
+		if !versions[i].Effective.After(asOf) {
+			return versions[i], nil
+		}
+	}
+	return nil, fmt.Errorf("%w: profile %s at %s", ErrNotEffective, name, asOf.Format(time.RFC3339))
+}
+
+// GetCodeListAsOf returns the version of the code list with name that applied at asOf
+func GetCodeListAsOf(name string, asOf time.Time) (*CodeList, error) {
+	historyMu.RLock()
+	defer historyMu.RUnlock()
+	versions, exists := codeListHistory[name]
+	if !exists {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownCodeList, name)
+	}
+	for i := len(versions) - 1; i >= 0; i-- {
+		if !versions[i].Effective.After(asOf) {
+			return versions[i], nil
+		}
+	}
+	return nil, fmt.Errorf("%w: code list %s at %s", ErrNotEffective, name, asOf.Format(time.RFC3339))
+}
+
+// EvaluateAsOf evaluates the version of the profile with name that applied at in.Now against
+// in, with the code lists that applied then
+func EvaluateAsOf(name string, in Input) (*Result, error) {
+	p, err := GetAsOf(name, in.Now)
+	if err != nil {
+		return nil, err
+	}
+	asOf := in.Now
+	in.CodeList = func(name string) (*CodeList, error) {
+		return GetCodeListAsOf(name, asOf)
+	}
+	return p.Evaluate(in), nil
+}
+
+// ValidateAsOf validates doc with the version of the profile with name that applied at asOf,
+// timing rules are evaluated at asOf
+func ValidateAsOf(name string, doc document.Iso20022Document, asOf time.Time) (*Result, error) {
+	return EvaluateAsOf(name, Input{Document: doc, Now: asOf})
+}
+
+// LoadHistory records the past versions of profiles and code lists defined by the yaml files
+// of dir without registering them, a file holding codes is a code list. Profiles extend the
+// version of the extended profile that applied at their effective date.
+func LoadHistory(dir string) error {
+	return loadHistory(dir, true)
+}
+
+// CheckHistory checks the yaml files of dir like LoadHistory without recording their versions
+func CheckHistory(dir string) error {
+	return loadHistory(dir, false)
+}
+
+// loadHistory compiles the versions of the yaml files of dir, recording them unless record is
+// false: the versions of dir are extended before the recorded ones then
+func loadHistory(dir string, record bool) error {
+	files, err := yamlFiles(dir)
+	if err != nil {
+		return err
+	}
+
+	var configs []*Config
+	paths := make(map[*Config]string)
+	for _, path := range files {
+		buf, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		var kind struct {
+			Codes []string `yaml:"codes"`
+		}
+		if err := yaml.Unmarshal(buf, &kind); err != nil {
+			return fmt.Errorf("%s: %w", path, err)
+		}
+		if kind.Codes != nil {
+			l, err := ParseCodeList(buf)
+			if err != nil {
+				return fmt.Errorf("%s: %w", path, err)
+			}
+			if record {
+				recordCodeList(l)
+			}
+			continue
+		}
+		cfg, err := ParseConfig(buf)
+		if err != nil {
+			return fmt.Errorf("%s: %w", path, err)
+		}
+		configs = append(configs, cfg)
+		paths[cfg] = path
+	}
+
+	// older versions are compiled first, they can be extended by newer ones
+	sort.SliceStable(configs, func(i, j int) bool {
+		return configs[i].Effective.Before(configs[j].Effective)
+	})
+	compiled := make(map[string][]*Profile)
+	for _, cfg := range configs {
+		effective := cfg.Effective
+		p, err := newProfile(cfg, func(name string) (*Profile, error) {
+			versions := compiled[name]
+			for i := len(versions) - 1; i >= 0; i-- {
+				if !versions[i].Effective.After(effective) {
+					return versions[i], nil
+				}
+			}
+			return GetAsOf(name, effective)
+		})
+		if err != nil {
+			return fmt.Errorf("%s: %w", paths[cfg], err)
+		}
+		if record {
+			recordProfile(p)
+		} else {
+			compiled[p.Name] = append(compiled[p.Name], p)
+		}
+	}
+	return nil
+}
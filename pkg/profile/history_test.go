@@ -0,0 +1,145 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package profile
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/moov-io/iso20022/pkg/iso20022test/fixtures"
+)
+
+func unregisterHistory(t *testing.T, profiles []string, lists []string) {
+	t.Helper()
+	t.Cleanup(func() {
+		historyMu.Lock()
+		defer historyMu.Unlock()
+		for _, name := range profiles {
+			delete(profileHistory, name)
+		}
+		for _, name := range lists {
+			delete(codeListHistory, name)
+		}
+	})
+}
+
+func TestHistory(t *testing.T) {
+	unregisterHistory(t, []string{"History-Limits"}, []string{"HistoryCurrencies"})
+	t.Cleanup(func() {
+		Unregister("History-Limits")
+		UnregisterCodeList("HistoryCurrencies")
+	})
+
+	june := time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC)
+	RegisterCodeList(NewCodeList("HistoryCurrencies", "1", []string{"EUR"}))
+	list := NewCodeList("HistoryCurrencies", "2", []string{"EUR", "USD"})
+	list.Effective = june
+	RegisterCodeList(list)
+	require.Len(t, CodeListVersions("HistoryCurrencies"), 2)
+
+	for _, version := range []struct {
+		version, expression string
+		effective           time.Time
+	}{
+		{"1", "IntrBkSttlmAmt <= 1000", time.Time{}},
+		{"2", "IntrBkSttlmAmt <= 1000000 && inlist(IntrBkSttlmAmt/@Ccy, 'HistoryCurrencies')", june},
+	} {
+		cfg := &Config{Name: "History-Limits", Version: version.version, Effective: version.effective, Rules: []RuleConfig{{ID: "LIMIT", Expression: version.expression}}}
+		p, err := NewProfile(cfg)
+		require.Nil(t, err)
+		Register(p)
+	}
+	require.Len(t, Versions("History-Limits"), 2)
+
+	p, err := GetAsOf("History-Limits", june.Add(-time.Second))
+	require.Nil(t, err)
+	require.Equal(t, "1", p.Version)
+	p, err = GetAsOf("History-Limits", june)
+	require.Nil(t, err)
+	require.Equal(t, "2", p.Version)
+
+	doc := fixtures.Document(t, "valid_pacs_v08.xml")
+	result, err := ValidateAsOf("History-Limits", doc, june.AddDate(0, -1, 0))
+	require.Nil(t, err)
+	require.Equal(t, "1", result.Version)
+	require.False(t, result.Valid())
+
+	result, err = ValidateAsOf("History-Limits", doc, june.AddDate(0, 1, 0))
+	require.Nil(t, err)
+	require.Equal(t, "2", result.Version)
+	require.True(t, result.Valid(), result.Err())
+
+	// the code lists that applied then are used
+	list, err = GetCodeListAsOf("HistoryCurrencies", june.Add(-time.Second))
+	require.Nil(t, err)
+	require.Equal(t, "1", list.Version)
+	p, _ = GetAsOf("History-Limits", june)
+	result = p.Evaluate(Input{Document: doc, Now: june, CodeList: func(name string) (*CodeList, error) {
+		return GetCodeListAsOf(name, june.Add(-time.Second))
+	}})
+	require.False(t, result.Valid())
+
+	// re-registering a version replaces it
+	p, err = NewProfile(&Config{Name: "History-Limits", Version: "1"})
+	require.Nil(t, err)
+	Register(p)
+	require.Len(t, Versions("History-Limits"), 2)
+	require.Empty(t, Versions("History-Limits")[0].Rules)
+
+	_, err = GetAsOf("History-Unknown", june)
+	require.True(t, errors.Is(err, ErrUnknownProfile))
+	_, err = GetCodeListAsOf("HistoryUnknown", june)
+	require.True(t, errors.Is(err, ErrUnknownCodeList))
+	_, err = ValidateAsOf("History-Unknown", doc, june)
+	require.NotNil(t, err)
+}
+
+func TestLoadHistory(t *testing.T) {
+	unregisterHistory(t, []string{"History-Base", "History-Scheme"}, []string{"HistoryPurposes"})
+
+	dir := t.TempDir()
+	files := map[string]string{
+		"base-1.yml":   "name: History-Base\nversion: \"1\"\neffective: 2021-01-01\nrules:\n  - id: BASE-1\n    expression: \"exists(PmtId)\"\n",
+		"base-2.yml":   "name: History-Base\nversion: \"2\"\neffective: 2021-06-01\nrules:\n  - id: BASE-2\n    expression: \"exists(PmtId)\"\n",
+		"scheme.yml":   "name: History-Scheme\nversion: \"1\"\neffective: 2021-03-01\nextends: History-Base\n",
+		"purposes.yml": "name: HistoryPurposes\nversion: \"2021-01\"\neffective: 2021-01-01\ncodes: [CASH]\n",
+		"notes.txt":    "not a definition",
+	}
+	for name, content := range files {
+		require.Nil(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0600))
+	}
+	require.Nil(t, CheckHistory(dir))
+	require.Empty(t, Versions("History-Base"))
+	require.Nil(t, LoadHistory(dir))
+
+	// past versions aren't registered
+	_, err := Get("History-Base")
+	require.True(t, errors.Is(err, ErrUnknownProfile))
+
+	require.Len(t, Versions("History-Base"), 2)
+	p, err := GetAsOf("History-Scheme", time.Date(2021, 7, 1, 0, 0, 0, 0, time.UTC))
+	require.Nil(t, err)
+	require.Equal(t, "BASE-1", p.Rules[0].ID)
+	_, err = GetAsOf("History-Scheme", time.Date(2021, 2, 1, 0, 0, 0, 0, time.UTC))
+	require.True(t, errors.Is(err, ErrNotEffective))
+	require.EqualError(t, err, "no effective version: profile History-Scheme at 2021-02-01T00:00:00Z")
+
+	list, err := GetCodeListAsOf("HistoryPurposes", time.Date(2021, 2, 1, 0, 0, 0, 0, time.UTC))
+	require.Nil(t, err)
+	require.True(t, list.Contains("CASH"))
+
+	for _, content := range []string{"name: History-Broken\nextends: History-Unknown\n", "codes: [CASH]\n", "name: {"} {
+		dir := t.TempDir()
+		require.Nil(t, os.WriteFile(filepath.Join(dir, "broken.yml"), []byte(content), 0600))
+		require.NotNil(t, CheckHistory(dir), content)
+		require.NotNil(t, LoadHistory(dir), content)
+	}
+	require.NotNil(t, LoadHistory(filepath.Join(dir, "missing")))
+}
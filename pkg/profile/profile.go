@@ -0,0 +1,312 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package profile
+
+/*
+	Profiles are named sets of rules layered on top of the schema validation of a
+	document (Iso20022Document.Validate). They capture the usage guidelines of market
+	infrastructures and schemes, e.g. the timing constraints of SCT Inst.
+
+	A rule returns findings, findings with error severity make a document invalid for
+	the profile while warnings are informational.
+*/
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/moov-io/iso20022/pkg/document"
+	"github.com/moov-io/iso20022/pkg/utils"
+)
+
+// Severity of a finding
+type Severity string
+
+const (
+	SeverityError   Severity = "error"
+	SeverityWarning Severity = "warning"
+)
+
+// Input is what rules are evaluated against
+type Input struct {
+	Document document.Iso20022Document
+
+	// Now is the reference time for timing rules
+	Now time.Time
+
+	// CodeList returns the code lists of the inlist function of expressions, GetCodeList when nil
+	CodeList func(name string) (*CodeList, error)
+}
+
+// MessageType returns the message type of the document (e.g. pacs.008.001.08)
+func (in Input) MessageType() string {
+	return utils.GetMessageType(in.Document.NameSpace())
+}
+
+// Finding is a single rule violation
+type Finding struct {
+	Rule     string
+	Severity Severity
+	Path     string `json:",omitempty"`
+	Message  string
+}
+
+func (f Finding) Error() string {
+	if f.Path == "" {
+		return fmt.Sprintf("%s: %s", f.Rule, f.Message)
+	}
+	return fmt.Sprintf("%s: %s (%s)", f.Rule, f.Message, f.Path)
+}
+
+// Rule checks one aspect of a document
+type Rule struct {
+	ID          string
+	Description string
+	Severity    Severity
+
+	// Paths are the element paths inspected by the rule
+	Paths []string `json:",omitempty"`
+
+	// MessageTypes are prefixes of the message types the rule applies to, empty applies to all
+	MessageTypes []string `json:",omitempty"`
+
+	// Expression is the source of expression rules (see CompileExpression)
+	Expression string `json:",omitempty"`
+
+	// Check returns the violations of the rule, severity of returned findings defaults to the rule severity
+	Check func(in Input) []Finding `json:"-"`
+}
+
+// Applies reports whether the rule applies to messageType
+func (r Rule) Applies(messageType string) bool {
+	if len(r.MessageTypes) == 0 {
+		return true
+	}
+	for _, tp := range r.MessageTypes {
+		if strings.HasPrefix(messageType, tp) {
+			return true
+		}
+	}
+	return false
+}
+
+// DependsOn reports whether the findings of the rule may change when the elements of message at
+// paths change, e.g. the elements of a patch (see document.PatchPaths). Rules without Paths
+// depend on every element.
+func (r Rule) DependsOn(message interface{}, paths []string) bool {
+	if len(r.Paths) == 0 {
+		return true
+	}
+	for _, path := range paths {
+		for _, inspected := range r.Paths {
+			if utils.AffectsElements(message, path, inspected) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Profile is a named and versioned set of rules
+type Profile struct {
+	Name        string
+	Version     string
+	Description string `json:",omitempty"`
+
+	// MessageTypes are prefixes of the message types the profile supports, empty supports all
+	MessageTypes []string `json:",omitempty"`
+
+	// Effective is the date the version of the profile applies from, zero applies to every date
+	Effective time.Time
+
+	// Experimental profiles are only used when the caller enables them, see Config
+	Experimental bool `json:",omitempty"`
+
+	Rules []Rule
+}
+
+// Supports reports whether the profile covers messageType
+func (p *Profile) Supports(messageType string) bool {
+	return Rule{MessageTypes: p.MessageTypes}.Applies(messageType)
+}
+
+// ActiveRules returns the rules the profile evaluates for messageType, or for every message
+// type when empty, with the error severity when they have none
+func (p *Profile) ActiveRules(messageType string) []Rule {
+	rules := []Rule{}
+	if messageType != "" && !p.Supports(messageType) {
+		return rules
+	}
+	for _, rule := range p.Rules {
+		if rule.Check == nil || (messageType != "" && !rule.Applies(messageType)) {
+			continue
+		}
+		if rule.Severity == "" {
+			rule.Severity = SeverityError
+		}
+		rules = append(rules, rule)
+	}
+	return rules
+}
+
+// Validate evaluates every applicable rule of the profile against doc at the current time
+func (p *Profile) Validate(doc document.Iso20022Document) *Result {
+	return p.Evaluate(Input{Document: doc, Now: time.Now()})
+}
+
+// Evaluate evaluates every applicable rule of the profile against in
+func (p *Profile) Evaluate(in Input) *Result {
+	return p.evaluate(in, func(Rule) bool { return true })
+}
+
+// EvaluatePaths evaluates the applicable rules of the profile depending on the elements of the
+// document of in at paths (see Rule.DependsOn), for example to revalidate a patched document
+// whose other rules are known to pass
+func (p *Profile) EvaluatePaths(in Input, paths []string) *Result {
+	message := in.Document.InspectMessage()
+	return p.evaluate(in, func(rule Rule) bool { return rule.DependsOn(message, paths) })
+}
+
+func (p *Profile) evaluate(in Input, selected func(Rule) bool) *Result {
+	result := &Result{Profile: p.Name, Version: p.Version}
+
+	messageType := in.MessageType()
+	if !p.Supports(messageType) {
+		result.Findings = append(result.Findings, Finding{
+			Rule:     "PROFILE",
+			Severity: SeverityError,
+			Message:  fmt.Sprintf("message type %s is not supported by profile %s", messageType, p.Name),
+		})
+		return result
+	}
+
+	for _, rule := range p.Rules {
+		if rule.Check == nil || !rule.Applies(messageType) || !selected(rule) {
+			continue
+		}
+		for _, finding := range rule.Check(in) {
+			if finding.Rule == "" {
+				finding.Rule = rule.ID
+			}
+			if finding.Severity == "" {
+				finding.Severity = rule.Severity
+			}
+			if finding.Severity == "" {
+				finding.Severity = SeverityError
+			}
+			result.Findings = append(result.Findings, finding)
+		}
+	}
+	return result
+}
+
+// Result is the outcome of validating a document against a profile
+type Result struct {
+	Profile  string
+	Version  string
+	Findings []Finding `json:",omitempty"`
+}
+
+// Valid reports whether the result has no error findings
+func (r *Result) Valid() bool {
+	return len(r.Errors()) == 0
+}
+
+// Errors returns the findings with error severity
+func (r *Result) Errors() []Finding {
+	return r.filter(SeverityError)
+}
+
+// Warnings returns the findings with warning severity
+func (r *Result) Warnings() []Finding {
+	return r.filter(SeverityWarning)
+}
+
+// Err returns an error describing every error finding, nil when the result is valid
+func (r *Result) Err() error {
+	var msgs []string
+	for _, finding := range r.Errors() {
+		msgs = append(msgs, finding.Error())
+	}
+	if len(msgs) == 0 {
+		return nil
+	}
+	return fmt.Errorf("The document is invalid for profile %s (%s)", r.Profile, strings.Join(msgs, "; "))
+}
+
+func (r *Result) filter(severity Severity) []Finding {
+	var findings []Finding
+	for _, finding := range r.Findings {
+		if finding.Severity == severity {
+			findings = append(findings, finding)
+		}
+	}
+	return findings
+}
+
+func sortFindings(findings []Finding) {
+	sort.SliceStable(findings, func(i, j int) bool {
+		return findings[i].Path < findings[j].Path
+	})
+}
+
+var (
+	// ErrUnknownProfile is returned when a profile is not registered
+	ErrUnknownProfile = errors.New("unknown profile")
+
+	registryMu sync.RWMutex
+	registry   = make(map[string]*Profile)
+)
+
+func init() {
+	Register(SCTInst())
+	Register(FedNow())
+	Register(RTP())
+	Register(NPP())
+	Register(RelatedRemittance())
+}
+
+// Register adds p to the registry of profiles, replacing any profile with the same name, and
+// records p in the history of the profile
+func Register(p *Profile) {
+	registryMu.Lock()
+	registry[p.Name] = p
+	registryMu.Unlock()
+	recordProfile(p)
+}
+
+// Unregister removes the profile with name from the registry
+func Unregister(name string) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	delete(registry, name)
+}
+
+// Get returns the registered profile with name
+func Get(name string) (*Profile, error) {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	p, exists := registry[name]
+	if !exists {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownProfile, name)
+	}
+	return p, nil
+}
+
+// Names returns the names of every registered profile in alphabetical order
+func Names() []string {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	names := make([]string, 0, len(registry))
+	for name := range registry {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,171 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package profile
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/moov-io/iso20022/pkg/iso20022test/fixtures"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEvaluate(t *testing.T) {
+	p := &Profile{
+		Name:    "test",
+		Version: "1",
+		Rules: []Rule{
+			{
+				ID:       "TEST-001",
+				Severity: SeverityWarning,
+				Check: func(in Input) []Finding {
+					return []Finding{{Path: "GrpHdr/MsgId", Message: "warning"}}
+				},
+			},
+			{
+				ID:           "TEST-002",
+				MessageTypes: []string{"pacs.008"},
+				Check: func(in Input) []Finding {
+					return []Finding{{Message: "error"}}
+				},
+			},
+			{ID: "TEST-003"},
+		},
+	}
+
+	result := p.Validate(fixtures.Document(t, "valid_pacs_v11.xml"))
+	require.True(t, result.Valid())
+	require.Nil(t, result.Err())
+	require.Equal(t, []Finding{{Rule: "TEST-001", Severity: SeverityWarning, Path: "GrpHdr/MsgId", Message: "warning"}}, result.Warnings())
+
+	result = p.Validate(fixtures.Document(t, "valid_pacs_v08.xml"))
+	require.False(t, result.Valid())
+	require.Equal(t, []Finding{{Rule: "TEST-002", Severity: SeverityError, Message: "error"}}, result.Errors())
+	require.Equal(t, "The document is invalid for profile test (TEST-002: error)", result.Err().Error())
+
+	p.MessageTypes = []string{"pain.001"}
+	result = p.Validate(fixtures.Document(t, "valid_pacs_v08.xml"))
+	require.Len(t, result.Errors(), 1)
+	require.Equal(t, "PROFILE", result.Errors()[0].Rule)
+}
+
+func TestEvaluatePaths(t *testing.T) {
+	doc := fixtures.Document(t, "valid_pacs_v08.xml")
+	in := Input{Document: doc, Now: time.Date(2021, 4, 15, 10, 30, 0, 0, time.UTC)}
+
+	rules := func(result *Result) []string {
+		var rules []string
+		for _, finding := range result.Errors() {
+			rules = append(rules, finding.Rule)
+		}
+		return rules
+	}
+	require.Equal(t, []string{"SCTINST-001"}, rules(SCTInst().EvaluatePaths(in, []string{"GrpHdr/NbOfTxs"})))
+	require.Equal(t, []string{"SCTINST-003", "SCTINST-003"}, rules(SCTInst().EvaluatePaths(in, []string{"CdtTrfTxInf[1]/IntrBkSttlmAmt/@Ccy"})))
+	require.Equal(t, []string{"SCTINST-002", "SCTINST-002"}, rules(SCTInst().EvaluatePaths(in, []string{"GrpHdr/PmtTpInf"})))
+	require.Equal(t, []string{
+		"SCTINST-002", "SCTINST-002",
+		"SCTINST-003", "SCTINST-003",
+		"SCTINST-004",
+	}, rules(SCTInst().EvaluatePaths(in, []string{"CdtTrfTxInf[0]"})))
+	require.Empty(t, rules(SCTInst().EvaluatePaths(in, []string{"GrpHdr/MsgId"})))
+	require.Equal(t, rules(SCTInst().Evaluate(in)), rules(SCTInst().EvaluatePaths(in, []string{""})))
+
+	// rules without paths depend on every element
+	message := doc.InspectMessage()
+	require.True(t, Rule{}.DependsOn(message, []string{"GrpHdr/MsgId"}))
+	require.False(t, Rule{Paths: []string{"CdtTrfTxInf/AccptncDtTm"}}.DependsOn(message, []string{"GrpHdr/MsgId"}))
+}
+
+func TestActiveRules(t *testing.T) {
+	check := func(in Input) []Finding { return nil }
+	p := &Profile{
+		Name:         "test",
+		MessageTypes: []string{"pacs"},
+		Rules: []Rule{
+			{ID: "TEST-001", Severity: SeverityWarning, Check: check},
+			{ID: "TEST-002", MessageTypes: []string{"pacs.008"}, Paths: []string{"GrpHdr/MsgId"}, Check: check},
+			{ID: "TEST-003"},
+		},
+	}
+
+	ids := func(rules []Rule) []string {
+		var ids []string
+		for _, rule := range rules {
+			ids = append(ids, rule.ID)
+		}
+		return ids
+	}
+	rules := p.ActiveRules("")
+	require.Equal(t, []string{"TEST-001", "TEST-002"}, ids(rules))
+	require.Equal(t, SeverityError, rules[1].Severity)
+	require.Empty(t, p.Rules[1].Severity)
+	require.Equal(t, []string{"TEST-001", "TEST-002"}, ids(p.ActiveRules("pacs.008.001.08")))
+	require.Equal(t, []string{"TEST-001"}, ids(p.ActiveRules("pacs.002.001.10")))
+	require.Empty(t, p.ActiveRules("pain.001.001.09"))
+}
+
+func TestRegistry(t *testing.T) {
+	p, err := Get(SCTInstName)
+	require.Nil(t, err)
+	require.Equal(t, SCTInstName, p.Name)
+	require.Contains(t, Names(), SCTInstName)
+
+	_, err = Get("unknown")
+	require.True(t, errors.Is(err, ErrUnknownProfile))
+
+	Register(&Profile{Name: "registered"})
+	_, err = Get("registered")
+	require.Nil(t, err)
+}
+
+func TestSCTInst(t *testing.T) {
+	doc := fixtures.Document(t, "sct_inst_pacs_v08.xml")
+	accepted := time.Date(2021, 4, 15, 10, 29, 58, 0, time.UTC)
+
+	result := SCTInst().Evaluate(Input{Document: doc, Now: accepted.Add(5 * time.Second)})
+	require.Nil(t, result.Err())
+
+	result = SCTInst().Evaluate(Input{Document: doc, Now: accepted.Add(-5 * time.Second)})
+	require.Len(t, result.Errors(), 1)
+	require.Equal(t, "SCTINST-005", result.Errors()[0].Rule)
+	require.Equal(t, "CdtTrfTxInf[0]/AccptncDtTm", result.Errors()[0].Path)
+
+	result = SCTInst().Evaluate(Input{Document: doc, Now: accepted.Add(25 * time.Second)})
+	require.Len(t, result.Errors(), 1)
+	require.Equal(t, "SCTINST-006", result.Errors()[0].Rule)
+	require.Equal(t, "timeout of 20s exceeded by 5s", result.Errors()[0].Message)
+
+	remaining, err := RemainingTime(doc, accepted.Add(5*time.Second))
+	require.Nil(t, err)
+	require.Equal(t, 15*time.Second, remaining)
+
+	remaining, err = RemainingTime(doc, accepted.Add(30*time.Second))
+	require.Nil(t, err)
+	require.Equal(t, -10*time.Second, remaining)
+}
+
+func TestSCTInstViolations(t *testing.T) {
+	doc := fixtures.Document(t, "valid_pacs_v08.xml")
+
+	result := SCTInst().Evaluate(Input{Document: doc, Now: time.Date(2021, 4, 15, 10, 30, 0, 0, time.UTC)})
+	var rules []string
+	for _, finding := range result.Errors() {
+		rules = append(rules, finding.Rule)
+	}
+	require.Equal(t, []string{
+		"SCTINST-001",
+		"SCTINST-002", "SCTINST-002",
+		"SCTINST-003", "SCTINST-003",
+		"SCTINST-004",
+	}, rules)
+
+	_, err := RemainingTime(doc, time.Now())
+	require.Equal(t, ErrAcceptanceDateTimeOmitted, err)
+
+	result = SCTInst().Validate(fixtures.Document(t, "valid_pacs_v11.xml"))
+	require.Equal(t, "PROFILE", result.Errors()[0].Rule)
+}
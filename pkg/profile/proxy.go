@@ -0,0 +1,172 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package profile
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/moov-io/iso20022/pkg/utils"
+)
+
+// ProxyRuleID identifies proxy rules unless configured otherwise
+const ProxyRuleID = "PROXY"
+
+var (
+	// ErrUnknownProxyScheme is returned when a profile refers to a scheme without proxy formats
+	ErrUnknownProxyScheme = errors.New("unknown proxy scheme")
+
+	// ProxySchemes are the formats of the proxy identifications accepted by instant payment schemes
+	ProxySchemes = map[string][]ProxyFormat{
+		"PayNow": {
+			{Type: "TELE", Pattern: `^\+65[3689][0-9]{7}$`, Description: "Singapore mobile number"},
+			{Type: "CINC", Pattern: `^([0-9]{8}[A-Z]|[0-9]{9}[A-Z]|[TSR][0-9]{2}[A-Z]{2}[0-9]{4}[A-Z])$`, Description: "Unique Entity Number"},
+			{Type: "NRIC", Pattern: `^[STFGM][0-9]{7}[A-Z]$`, Description: "national registration identity card number"},
+		},
+		"PIX": {
+			{Type: "TELE", Pattern: `^\+55[1-9]{2}9?[0-9]{8}$`, Description: "Brazilian mobile number"},
+			{Type: "EMAL", Pattern: `^[^@\s]{1,64}@[^@\s]+\.[^@\s]+$`, Description: "email address"},
+			{Type: "CPF", Pattern: `^[0-9]{11}$`, Description: "individual taxpayer number"},
+			{Type: "CNPJ", Pattern: `^[0-9]{14}$`, Description: "company taxpayer number"},
+			{Type: "EVP", Pattern: `^[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}$`, Description: "random key"},
+		},
+		"UPI": {
+			{Type: "VPA", Pattern: `^[A-Za-z0-9._-]{2,256}@[A-Za-z][A-Za-z0-9]{2,64}$`, Description: "virtual payment address"},
+			{Type: "TELE", Pattern: `^\+91[6-9][0-9]{9}$`, Description: "Indian mobile number"},
+		},
+	}
+)
+
+// ProxyFormat is the format of the proxy identifications of a type
+type ProxyFormat struct {
+	// Type is the code or proprietary type of the proxy (Prxy/Tp/Cd or Prxy/Tp/Prtry), e.g. TELE
+	Type        string `yaml:"type"`
+	Pattern     string `yaml:"pattern"`
+	Description string `yaml:"description"`
+}
+
+// ProxyConfig defines the proxy identifications accepted by a profile, the formats of a
+// scheme of ProxySchemes and their replacements or additions
+type ProxyConfig struct {
+	ID       string        `yaml:"id"`
+	Scheme   string        `yaml:"scheme"`
+	Formats  []ProxyFormat `yaml:"formats"`
+	Severity Severity      `yaml:"severity"`
+}
+
+// formats returns the formats of the scheme overridden by the formats of the config
+func (pc ProxyConfig) formats() ([]ProxyFormat, error) {
+	var formats []ProxyFormat
+	if pc.Scheme != "" {
+		scheme, exists := ProxySchemes[pc.Scheme]
+		if !exists {
+			return nil, fmt.Errorf("%w: %s", ErrUnknownProxyScheme, pc.Scheme)
+		}
+		formats = append(formats, scheme...)
+	}
+	for _, format := range pc.Formats {
+		replaced := false
+		for i := range formats {
+			if formats[i].Type == format.Type {
+				formats[i], replaced = format, true
+			}
+		}
+		if !replaced {
+			formats = append(formats, format)
+		}
+	}
+	return formats, nil
+}
+
+// compile returns the proxy rule of the config
+func (pc ProxyConfig) compile() (Rule, error) {
+	formats, err := pc.formats()
+	if err != nil {
+		return Rule{}, err
+	}
+	rule, err := ProxyRule(formats...)
+	if err != nil {
+		return Rule{}, err
+	}
+	if pc.ID != "" {
+		rule.ID = pc.ID
+	}
+	switch pc.Severity {
+	case "":
+	case SeverityError, SeverityWarning:
+		rule.Severity = pc.Severity
+	default:
+		return Rule{}, fmt.Errorf("unknown severity %s", pc.Severity)
+	}
+	return rule, nil
+}
+
+// ProxyRule checks that every proxy identification (Prxy) of a document has one of the types
+// of formats and an identification matching the pattern of its type
+func ProxyRule(formats ...ProxyFormat) (Rule, error) {
+	patterns := make(map[string]*regexp.Regexp, len(formats))
+	var types []string
+	for _, format := range formats {
+		if format.Type == "" {
+			return Rule{}, errors.New("proxy type is omitted")
+		}
+		re, err := regexp.Compile(format.Pattern)
+		if err != nil {
+			return Rule{}, fmt.Errorf("proxy type %s: invalid pattern %q: %w", format.Type, format.Pattern, err)
+		}
+		patterns[format.Type] = re
+		types = append(types, format.Type)
+	}
+
+	return Rule{
+		ID:          ProxyRuleID,
+		Description: fmt.Sprintf("Proxy identifications are of the types %s and have their format", strings.Join(types, ", ")),
+		Severity:    SeverityError,
+		Paths:       []string{"Prxy/Id"},
+		Check: func(in Input) []Finding {
+			var findings []Finding
+			for _, proxy := range proxies(utils.GetElements(in.Document.InspectMessage())) {
+				re, accepted := patterns[proxy.tp]
+				switch {
+				case !accepted:
+					findings = append(findings, Finding{Path: proxy.path, Message: fmt.Sprintf("proxy type %q is not accepted", proxy.tp)})
+				case !re.MatchString(proxy.id):
+					findings = append(findings, Finding{Path: proxy.path, Message: fmt.Sprintf("proxy %s doesn't have the format of type %s", proxy.id, proxy.tp)})
+				}
+			}
+			return findings
+		},
+	}, nil
+}
+
+type proxyIdentification struct {
+	path string
+	tp   string
+	id   string
+}
+
+// proxies returns the proxy identifications of elements with their code or proprietary type
+func proxies(elements []utils.Element) []proxyIdentification {
+	types := make(map[string]string)
+	for _, elm := range elements {
+		if strings.HasSuffix(elm.Path, "/Prxy/Tp/Cd") {
+			types[strings.TrimSuffix(elm.Path, "/Tp/Cd")] = elm.Value
+		}
+		if parent := strings.TrimSuffix(elm.Path, "/Tp/Prtry"); strings.HasSuffix(elm.Path, "/Prxy/Tp/Prtry") && types[parent] == "" {
+			types[parent] = elm.Value
+		}
+	}
+
+	var found []proxyIdentification
+	for _, elm := range elements {
+		if utils.MatchElementPath(elm.Path, "Prxy/Id") {
+			parent := strings.TrimSuffix(elm.Path, "/Id")
+			found = append(found, proxyIdentification{path: elm.Path, tp: types[parent], id: elm.Value})
+		}
+	}
+	return found
+}
@@ -0,0 +1,101 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package profile
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/moov-io/iso20022/pkg/document"
+	"github.com/moov-io/iso20022/pkg/iso20022test/fixtures"
+)
+
+// proxyDocument returns the pacs.008 test document whose first creditor account is the proxy
+func proxyDocument(t *testing.T, tp, id string) document.Iso20022Document {
+	t.Helper()
+	buf := fixtures.ReadFile(t, "valid_pacs_v08.xml")
+	acct := fmt.Sprintf("</Cdtr><CdtrAcct><Prxy><Tp><Prtry>%s</Prtry></Tp><Id>%s</Id></Prxy></CdtrAcct>", tp, id)
+	doc, err := document.ParseIso20022Document(bytes.Replace(buf, []byte("</Cdtr>"), []byte(acct), 1))
+	require.Nil(t, err)
+	return doc
+}
+
+func TestProxyRule(t *testing.T) {
+	rule, err := ProxyRule(ProxySchemes["PIX"]...)
+	require.Nil(t, err)
+	p := &Profile{Name: "pix", Rules: []Rule{rule}}
+
+	require.True(t, p.Validate(fixtures.Document(t, "valid_pacs_v08.xml")).Valid())
+	for tp, id := range map[string]string{
+		"TELE": "+551198765431",
+		"EMAL": "jane.doe@example.com.br",
+		"CPF":  "12345678909",
+		"EVP":  "123e4567-e89b-12d3-a456-426614174000",
+	} {
+		require.True(t, p.Validate(proxyDocument(t, tp, id)).Valid(), tp)
+	}
+
+	result := p.Validate(proxyDocument(t, "TELE", "+55119876543"))
+	require.Len(t, result.Findings, 1)
+	require.Equal(t, Finding{
+		Rule:     ProxyRuleID,
+		Severity: SeverityError,
+		Path:     "CdtTrfTxInf[0]/CdtrAcct/Prxy/Id",
+		Message:  "proxy +55119876543 doesn't have the format of type TELE",
+	}, result.Findings[0])
+
+	result = p.Validate(proxyDocument(t, "VPA", "jane@okbank"))
+	require.Len(t, result.Findings, 1)
+	require.Equal(t, `proxy type "VPA" is not accepted`, result.Findings[0].Message)
+
+	_, err = ProxyRule(ProxyFormat{Type: "TELE", Pattern: "["})
+	require.Error(t, err)
+	_, err = ProxyRule(ProxyFormat{Pattern: ".*"})
+	require.Error(t, err)
+}
+
+func TestProxyConfig(t *testing.T) {
+	cfg, err := ParseConfig([]byte(`
+name: UPI-Corporate
+version: "1"
+proxies:
+  id: UPI-001
+  scheme: UPI
+  severity: warning
+  formats:
+    - type: TELE
+      pattern: '^\+91[0-9]{10}$'
+    - type: EMAL
+      pattern: '@'
+`))
+	require.Nil(t, err)
+	p, err := NewProfile(cfg)
+	require.Nil(t, err)
+	require.Len(t, p.Rules, 1)
+	require.Equal(t, "UPI-001", p.Rules[0].ID)
+
+	require.True(t, p.Validate(proxyDocument(t, "VPA", "jane.doe@okaxis")).Valid())
+	// the configured format replaces the one of the scheme
+	require.Empty(t, p.Validate(proxyDocument(t, "TELE", "+911234567890")).Findings)
+	require.Empty(t, p.Validate(proxyDocument(t, "EMAL", "jane@example.com")).Findings)
+
+	result := p.Validate(proxyDocument(t, "VPA", "jane.doe"))
+	require.True(t, result.Valid())
+	require.Len(t, result.Warnings(), 1)
+
+	for _, bad := range []string{
+		"name: x\nproxies:\n  scheme: Unknown\n",
+		"name: x\nproxies:\n  severity: fatal\n",
+		"name: x\nproxies:\n  formats:\n    - type: TELE\n      pattern: '['\n",
+	} {
+		cfg, err = ParseConfig([]byte(bad))
+		require.Nil(t, err)
+		_, err = NewProfile(cfg)
+		require.Error(t, err, bad)
+	}
+}
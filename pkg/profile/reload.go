@@ -0,0 +1,313 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package profile
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	ReloadTriggerStartup = "startup"
+	ReloadTriggerWatch   = "watch"
+	ReloadTriggerManual  = "manual"
+)
+
+// ReloadStatus is the outcome of the last reload of a loader
+type ReloadStatus struct {
+	Time    time.Time `json:"time"`
+	Trigger string    `json:"trigger"`
+	Error   string    `json:"error,omitempty"`
+
+	// Profiles and CodeLists are the names loaded by the last successful reload
+	Profiles  []string `json:"profiles"`
+	CodeLists []string `json:"codeLists"`
+
+	// Applied is the time of the last successful reload
+	Applied time.Time `json:"applied,omitempty"`
+}
+
+// Loader reloads the profiles and code lists defined by the yaml files of directories
+//
+// A reload applies every file or none, a failing reload keeps the rules of the previous
+// one. Profiles and code lists whose files are removed are unregistered.
+type Loader struct {
+	ProfileDir  string
+	CodeListDir string
+
+	mu          sync.Mutex
+	status      ReloadStatus
+	fingerprint string
+}
+
+// NewLoader returns a loader of the given directories, an empty directory isn't loaded
+func NewLoader(profileDir, codeListDir string) *Loader {
+	return &Loader{ProfileDir: profileDir, CodeListDir: codeListDir}
+}
+
+// Status returns the status of the last reload
+func (l *Loader) Status() ReloadStatus {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.status
+}
+
+// Reload loads and registers every profile and code list of the directories
+func (l *Loader) Reload(trigger string) (ReloadStatus, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	fingerprint, _ := l.fingerprintFiles()
+	err := l.reload()
+
+	l.status.Time = time.Now()
+	l.status.Trigger = trigger
+	l.status.Error = ""
+	if err != nil {
+		l.status.Error = err.Error()
+	} else {
+		l.status.Applied = l.status.Time
+		l.fingerprint = fingerprint
+	}
+	return l.status, err
+}
+
+// Check parses the code lists and compiles the profiles of the directories like Reload
+// without registering them
+func (l *Loader) Check() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if _, err := l.parseCodeLists(); err != nil {
+		return err
+	}
+	_, err := l.compileProfiles()
+	return err
+}
+
+// Watch reloads whenever the files of the directories change, polling them every interval
+// until ctx is done. fn, if not nil, is called with the outcome of every reload.
+func (l *Loader) Watch(ctx context.Context, interval time.Duration, fn func(ReloadStatus, error)) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		l.mu.Lock()
+		fingerprint, _ := l.fingerprintFiles()
+		changed := fingerprint != l.fingerprint
+		l.mu.Unlock()
+		if !changed {
+			continue
+		}
+
+		status, err := l.Reload(ReloadTriggerWatch)
+		if fn != nil {
+			fn(status, err)
+		}
+		if err != nil {
+			// don't retry a broken set of files until it changes again
+			l.mu.Lock()
+			l.fingerprint = fingerprint
+			l.mu.Unlock()
+		}
+	}
+}
+
+func (l *Loader) reload() error {
+	lists, err := l.parseCodeLists()
+	if err != nil {
+		return err
+	}
+	profiles, err := l.compileProfiles()
+	if err != nil {
+		return err
+	}
+
+	var listNames, profileNames []string
+	for _, list := range lists {
+		RegisterCodeList(list)
+		listNames = append(listNames, list.Name)
+	}
+	for _, name := range l.status.CodeLists {
+		if !containsName(listNames, name) {
+			UnregisterCodeList(name)
+		}
+	}
+	for _, p := range profiles {
+		Register(p)
+		profileNames = append(profileNames, p.Name)
+	}
+	for _, name := range l.status.Profiles {
+		if !containsName(profileNames, name) {
+			Unregister(name)
+		}
+	}
+
+	sort.Strings(listNames)
+	sort.Strings(profileNames)
+	l.status.CodeLists = listNames
+	l.status.Profiles = profileNames
+	return nil
+}
+
+func (l *Loader) parseCodeLists() ([]*CodeList, error) {
+	files, err := yamlFiles(l.CodeListDir)
+	if err != nil {
+		return nil, err
+	}
+
+	var lists []*CodeList
+	for _, path := range files {
+		buf, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		list, err := ParseCodeList(buf)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+		lists = append(lists, list)
+	}
+	return lists, nil
+}
+
+// compileProfiles compiles the profiles of the directory, profiles can extend each other
+// regardless of the order of their files
+func (l *Loader) compileProfiles() ([]*Profile, error) {
+	files, err := yamlFiles(l.ProfileDir)
+	if err != nil {
+		return nil, err
+	}
+
+	pending := make(map[string]*Config)
+	paths := make(map[string]string)
+	var names []string
+	for _, path := range files {
+		cfg, err := LoadConfig(path)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+		if other, exists := paths[cfg.Name]; exists {
+			return nil, fmt.Errorf("%s: profile %s is defined by %s too", path, cfg.Name, other)
+		}
+		pending[cfg.Name] = cfg
+		paths[cfg.Name] = path
+		names = append(names, cfg.Name)
+	}
+
+	compiled := make(map[string]*Profile)
+	lookup := func(name string) (*Profile, error) {
+		if p, exists := compiled[name]; exists {
+			return p, nil
+		}
+		if containsName(l.status.Profiles, name) {
+			// a profile of a previous reload whose file was removed
+			return nil, fmt.Errorf("%w: %s", ErrUnknownProfile, name)
+		}
+		return Get(name)
+	}
+
+	for len(pending) > 0 {
+		progress := false
+		for _, name := range names {
+			cfg, ok := pending[name]
+			if !ok {
+				continue
+			}
+			if cfg.Extends == name {
+				return nil, fmt.Errorf("%s: profile %s extends itself", paths[name], name)
+			}
+			if _, waiting := pending[cfg.Extends]; waiting {
+				continue
+			}
+			p, err := newProfile(cfg, lookup)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", paths[name], err)
+			}
+			compiled[name] = p
+			delete(pending, name)
+			progress = true
+		}
+		if !progress {
+			return nil, fmt.Errorf("profiles extend each other: %s", strings.Join(sortedKeys(pending), ", "))
+		}
+	}
+
+	profiles := make([]*Profile, 0, len(names))
+	for _, name := range names {
+		profiles = append(profiles, compiled[name])
+	}
+	return profiles, nil
+}
+
+// fingerprintFiles identifies the names, sizes and modification times of the files
+func (l *Loader) fingerprintFiles() (string, error) {
+	var buf strings.Builder
+	for _, dir := range []string{l.ProfileDir, l.CodeListDir} {
+		files, err := yamlFiles(dir)
+		if err != nil {
+			return "", err
+		}
+		for _, path := range files {
+			info, err := os.Stat(path)
+			if err != nil {
+				return "", err
+			}
+			fmt.Fprintf(&buf, "%s:%d:%d;", path, info.Size(), info.ModTime().UnixNano())
+		}
+	}
+	return buf.String(), nil
+}
+
+// yamlFiles returns the yaml files of dir in alphabetical order, none when dir is empty
+func yamlFiles(dir string) ([]string, error) {
+	if dir == "" {
+		return nil, nil
+	}
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var files []string
+	for _, entry := range entries {
+		ext := strings.ToLower(filepath.Ext(entry.Name()))
+		if entry.IsDir() || (ext != ".yml" && ext != ".yaml") {
+			continue
+		}
+		files = append(files, filepath.Join(dir, entry.Name()))
+	}
+	return files, nil
+}
+
+func containsName(names []string, name string) bool {
+	for _, n := range names {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
+
+func sortedKeys(m map[string]*Config) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,168 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package profile
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func writeTestFile(t *testing.T, dir, name, content string) {
+	require.Nil(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0600))
+}
+
+func newTestLoader(t *testing.T) (*Loader, string, string) {
+	profiles, lists := t.TempDir(), t.TempDir()
+	loader := NewLoader(profiles, lists)
+	t.Cleanup(func() {
+		for _, name := range loader.Status().Profiles {
+			Unregister(name)
+		}
+		for _, name := range loader.Status().CodeLists {
+			UnregisterCodeList(name)
+		}
+	})
+	return loader, profiles, lists
+}
+
+func TestLoaderReload(t *testing.T) {
+	loader, profiles, lists := newTestLoader(t)
+
+	// files are loaded in alphabetical order, extending profiles may come first
+	writeTestFile(t, profiles, "a.yml", "name: Reload-DE\nextends: Reload-Base\nrules:\n  - id: DE-001\n    expression: \"inlist(Purp, 'ReloadPurposes')\"\n")
+	writeTestFile(t, profiles, "b.yaml", "name: Reload-Base\nextends: SCTInst\n")
+	writeTestFile(t, profiles, "README.md", "ignored")
+	writeTestFile(t, lists, "purposes.yml", "name: ReloadPurposes\ncodes: [CASH]\n")
+
+	status, err := loader.Reload(ReloadTriggerStartup)
+	require.Nil(t, err)
+	require.Equal(t, ReloadTriggerStartup, status.Trigger)
+	require.Equal(t, []string{"Reload-Base", "Reload-DE"}, status.Profiles)
+	require.Equal(t, []string{"ReloadPurposes"}, status.CodeLists)
+	require.Equal(t, status.Time, status.Applied)
+
+	p, err := Get("Reload-DE")
+	require.Nil(t, err)
+	require.Len(t, p.Rules, len(SCTInst().Rules)+1)
+
+	// removed files are unregistered
+	require.Nil(t, os.Remove(filepath.Join(profiles, "a.yml")))
+	require.Nil(t, os.Remove(filepath.Join(lists, "purposes.yml")))
+	status, err = loader.Reload(ReloadTriggerManual)
+	require.Nil(t, err)
+	require.Equal(t, []string{"Reload-Base"}, status.Profiles)
+	require.Empty(t, status.CodeLists)
+	_, err = Get("Reload-DE")
+	require.NotNil(t, err)
+	_, err = GetCodeList("ReloadPurposes")
+	require.NotNil(t, err)
+}
+
+func TestLoaderReloadWithInvalidFiles(t *testing.T) {
+	loader, profiles, lists := newTestLoader(t)
+	writeTestFile(t, profiles, "base.yml", "name: Reload-Valid\nrules:\n  - id: V-001\n    expression: \"exists(MsgId)\"\n")
+	_, err := loader.Reload(ReloadTriggerStartup)
+	require.Nil(t, err)
+	applied := loader.Status().Applied
+
+	valid := "name: Reload-Valid\nrules:\n  - id: V-001\n    expression: \"exists(MsgId)\"\n"
+	cases := []struct {
+		name  string
+		files map[string]string
+	}{
+		{"invalid expression", map[string]string{"base.yml": "name: Reload-Valid\nrules:\n  - id: V-001\n    expression: \"exists(\"\n"}},
+		{"unknown base", map[string]string{"base.yml": "name: Reload-Valid\nextends: Unknown\n"}},
+		{"extends itself", map[string]string{"base.yml": "name: Reload-Valid\nextends: Reload-Valid\n"}},
+		{"extend each other", map[string]string{"base.yml": "name: Reload-Valid\nextends: Reload-Cycle\n", "cycle.yml": "name: Reload-Cycle\nextends: Reload-Valid\n"}},
+		{"defined twice", map[string]string{"base.yml": valid, "copy.yml": "name: Reload-Valid\n"}},
+		{"unnamed code list", map[string]string{"base.yml": valid}},
+	}
+	for _, tc := range cases {
+		for name, content := range tc.files {
+			writeTestFile(t, profiles, name, content)
+		}
+		if tc.name == "unnamed code list" {
+			writeTestFile(t, lists, "list.yml", "codes: [CASH]\n")
+		}
+
+		status, err := loader.Reload(ReloadTriggerManual)
+		require.NotNil(t, err, tc.name)
+		require.Equal(t, err.Error(), status.Error)
+		require.Equal(t, applied, status.Applied, tc.name)
+		require.Equal(t, []string{"Reload-Valid"}, status.Profiles, tc.name)
+
+		// the previous rules are kept
+		p, err := Get("Reload-Valid")
+		require.Nil(t, err)
+		require.Len(t, p.Rules, 1, tc.name)
+
+		for name := range tc.files {
+			os.Remove(filepath.Join(profiles, name))
+		}
+	}
+
+	_, err = NewLoader(filepath.Join(profiles, "missing"), "").Reload(ReloadTriggerManual)
+	require.NotNil(t, err)
+}
+
+func TestLoaderCheck(t *testing.T) {
+	loader, profiles, lists := newTestLoader(t)
+	writeTestFile(t, profiles, "a.yml", "name: Check-DE\nextends: Check-Base\n")
+	writeTestFile(t, profiles, "b.yml", "name: Check-Base\nextends: SCTInst\n")
+	writeTestFile(t, lists, "purposes.yml", "name: CheckPurposes\ncodes: [CASH]\n")
+	require.Nil(t, loader.Check())
+
+	// checked profiles and code lists aren't registered
+	_, err := Get("Check-DE")
+	require.NotNil(t, err)
+	_, err = GetCodeList("CheckPurposes")
+	require.NotNil(t, err)
+	require.Empty(t, loader.Status().Profiles)
+
+	writeTestFile(t, profiles, "b.yml", "name: Check-Base\nextends: Unknown\n")
+	require.NotNil(t, loader.Check())
+	writeTestFile(t, profiles, "b.yml", "name: Check-Base\n")
+	writeTestFile(t, lists, "list.yml", "codes: [CASH]\n")
+	require.NotNil(t, loader.Check())
+}
+
+func TestLoaderWatch(t *testing.T) {
+	loader, profiles, _ := newTestLoader(t)
+	writeTestFile(t, profiles, "watch.yml", "name: Reload-Watch\nversion: \"1\"\n")
+	_, err := loader.Reload(ReloadTriggerStartup)
+	require.Nil(t, err)
+
+	reloads := make(chan ReloadStatus, 10)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go loader.Watch(ctx, 10*time.Millisecond, func(status ReloadStatus, err error) {
+		reloads <- status
+	})
+
+	writeTestFile(t, profiles, "watch.yml", "name: Reload-Watch\nversion: \"22\"\n")
+	select {
+	case status := <-reloads:
+		require.Equal(t, ReloadTriggerWatch, status.Trigger)
+		require.Empty(t, status.Error)
+	case <-time.After(5 * time.Second):
+		t.Fatal("profiles were not reloaded")
+	}
+
+	p, err := Get("Reload-Watch")
+	require.Nil(t, err)
+	require.Equal(t, "22", p.Version)
+
+	// unchanged files aren't reloaded
+	select {
+	case <-reloads:
+		t.Fatal("unchanged profiles were reloaded")
+	case <-time.After(50 * time.Millisecond):
+	}
+}
@@ -0,0 +1,85 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package profile
+
+import (
+	"net/url"
+
+	"github.com/moov-io/iso20022/pkg/remittance"
+)
+
+const (
+	RelatedRemittanceName = "RelatedRemittance"
+)
+
+// RelatedRemittance returns the profile checking the references of the remittance documents
+// sent separately from payments (RltdRmtInf)
+func RelatedRemittance() *Profile {
+	return &Profile{
+		Name:        RelatedRemittanceName,
+		Version:     "2021",
+		Description: "Related remittance information identifies remittance documents at valid locations",
+		Rules: []Rule{
+			{
+				ID:          "RLTDRMT-001",
+				Description: "Related remittance information has an identification or a location",
+				Severity:    SeverityError,
+				Paths:       []string{"RltdRmtInf/RmtId", "RltdRmtInf/RmtLctnDtls"},
+				Check:       checkRemittanceReferences,
+			},
+			{
+				ID:          "RLTDRMT-002",
+				Description: "Remittance locations have a valid address for their method",
+				Severity:    SeverityError,
+				Paths:       []string{"RltdRmtInf/RmtLctnDtls/Mtd", "RltdRmtInf/RmtLctnDtls/ElctrncAdr", "RltdRmtInf/RmtLctnDtls/PstlAdr"},
+				Check:       checkRemittanceLocations,
+			},
+			{
+				ID:          "RLTDRMT-003",
+				Description: "Remittance documents are located by https URLs",
+				Severity:    SeverityWarning,
+				Paths:       []string{"RltdRmtInf/RmtLctnDtls/ElctrncAdr"},
+				Check:       checkRemittanceHTTPS,
+			},
+		},
+	}
+}
+
+func checkRemittanceReferences(in Input) []Finding {
+	var findings []Finding
+	for _, ref := range remittance.References(in.Document) {
+		if ref.ID == "" && len(ref.Locations) == 0 {
+			findings = append(findings, Finding{Path: ref.Path, Message: remittance.ErrIncompleteReference.Error()})
+		}
+	}
+	return findings
+}
+
+func checkRemittanceLocations(in Input) []Finding {
+	var findings []Finding
+	for _, ref := range remittance.References(in.Document) {
+		for _, location := range ref.Locations {
+			if err := location.Check(); err != nil {
+				findings = append(findings, Finding{Path: location.Path, Message: err.Error()})
+			}
+		}
+	}
+	return findings
+}
+
+func checkRemittanceHTTPS(in Input) []Finding {
+	var findings []Finding
+	for _, ref := range remittance.References(in.Document) {
+		for _, location := range ref.Locations {
+			if location.Method != remittance.MethodURI {
+				continue
+			}
+			if u, err := url.Parse(location.Address); err == nil && u.Scheme == "http" {
+				findings = append(findings, Finding{Path: location.Path, Message: "remittance document is located by an http URL"})
+			}
+		}
+	}
+	return findings
+}
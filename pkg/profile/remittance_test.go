@@ -0,0 +1,39 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package profile
+
+import (
+	"testing"
+
+	"github.com/moov-io/iso20022/pkg/document"
+	"github.com/moov-io/iso20022/pkg/iso20022test/fixtures"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRelatedRemittance(t *testing.T) {
+	p, err := Get(RelatedRemittanceName)
+	require.Nil(t, err)
+
+	doc := fixtures.Document(t, "related_remittance_pacs_v08.xml")
+	result := p.Validate(doc)
+	require.True(t, result.Valid())
+	require.Empty(t, result.Findings)
+
+	doc, err = document.ApplyFieldPatches(doc, []document.FieldPatch{
+		{Path: "CdtTrfTxInf[0]/RltdRmtInf[0]/RmtLctnDtls[0]/ElctrncAdr", Value: []byte(`"http://remittance.example.com/advices/INV-2021-0042.pdf"`)},
+		{Path: "CdtTrfTxInf[0]/RltdRmtInf[0]/RmtLctnDtls[1]/ElctrncAdr", Value: []byte(`"advices at example.com"`)},
+	})
+	require.Nil(t, err)
+	result = p.Validate(doc)
+	require.False(t, result.Valid())
+	require.Len(t, result.Errors(), 1)
+	require.Equal(t, "RLTDRMT-002", result.Errors()[0].Rule)
+	require.Equal(t, "CdtTrfTxInf[0]/RltdRmtInf[0]/RmtLctnDtls[1]", result.Errors()[0].Path)
+	require.Len(t, result.Warnings(), 1)
+	require.Equal(t, "RLTDRMT-003", result.Warnings()[0].Rule)
+
+	// payments without related remittance information have no findings
+	require.Empty(t, p.Validate(fixtures.Document(t, "valid_pacs_v08.xml")).Findings)
+}
@@ -0,0 +1,343 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package profile
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/moov-io/iso20022/pkg/document"
+	"github.com/moov-io/iso20022/pkg/utils"
+)
+
+const (
+	// FedNowName is the name of the FedNow Service profile
+	FedNowName = "FedNow"
+
+	// RTPName is the name of The Clearing House RTP profile
+	RTPName = "RTP"
+
+	// NPPName is the name of the Australian New Payments Platform profile
+	NPPName = "NPP"
+)
+
+// InstantScheme describes the message subset, required elements and timing of a
+// regional instant payment scheme, its Profile pre-validates messages of participants
+type InstantScheme struct {
+	Name        string
+	Version     string
+	Description string
+
+	// Prefix of the rule identifications, e.g. FEDNOW for FEDNOW-001
+	Prefix string
+
+	// MessageTypes are the message types exchanged in the scheme, e.g. pacs.008.001.08
+	MessageTypes []string
+
+	// Currency of the settlement amounts
+	Currency string
+
+	// MaxAmount is the maximum amount of a single transaction, zero when unlimited
+	MaxAmount float64
+
+	// ClearingSystem is the code of the clearing system identifying the agents, e.g. USABA
+	ClearingSystem string
+
+	// Required are the element paths every transaction holds by message name (e.g. pacs.008)
+	Required map[string][]string
+
+	// Timeout is the time after the creation date time by which a credit transfer must be completed
+	Timeout time.Duration
+
+	// ClockSkew is the tolerated difference between the clocks of the participants
+	ClockSkew time.Duration
+}
+
+var (
+	// FedNowScheme is the FedNow Service of the Federal Reserve Banks
+	FedNowScheme = InstantScheme{
+		Name:           FedNowName,
+		Version:        "2023",
+		Description:    "FedNow Service credit transfers, status reports, returns and cancellation requests",
+		Prefix:         "FEDNOW",
+		MessageTypes:   []string{"pacs.008.001.08", "pacs.002.001.10", "pacs.004.001.10", "camt.056.001.08", "camt.029.001.09"},
+		Currency:       "USD",
+		MaxAmount:      500000,
+		ClearingSystem: utils.ClearingSystemUSABA,
+		Required: map[string][]string{
+			"pacs.008": {
+				"CdtTrfTxInf/PmtId/EndToEndId",
+				"CdtTrfTxInf/PmtId/UETR",
+				"CdtTrfTxInf/Dbtr/Nm",
+				"CdtTrfTxInf/DbtrAcct/Id/Othr/Id",
+				"CdtTrfTxInf/Cdtr/Nm",
+				"CdtTrfTxInf/CdtrAcct/Id/Othr/Id",
+				"CdtTrfTxInf/DbtrAgt/FinInstnId/ClrSysMmbId/MmbId",
+				"CdtTrfTxInf/CdtrAgt/FinInstnId/ClrSysMmbId/MmbId",
+			},
+			"pacs.002": {"TxInfAndSts/OrgnlEndToEndId", "TxInfAndSts/OrgnlUETR", "TxInfAndSts/TxSts"},
+			"pacs.004": {"TxInf/RtrId", "TxInf/OrgnlUETR", "TxInf/RtrdIntrBkSttlmAmt", "TxInf/RtrRsnInf/Rsn/Cd"},
+			"camt.056": {"Assgnmt/Id", "Assgnmt/CreDtTm", "Undrlyg/TxInf/OrgnlUETR", "Undrlyg/TxInf/CxlRsnInf/Rsn/Cd"},
+		},
+		Timeout:   20 * time.Second,
+		ClockSkew: 2 * time.Second,
+	}
+
+	// RTPScheme is the RTP network of The Clearing House
+	RTPScheme = InstantScheme{
+		Name:           RTPName,
+		Version:        "2022",
+		Description:    "The Clearing House RTP credit transfers, status reports and requests for return of funds",
+		Prefix:         "RTP",
+		MessageTypes:   []string{"pacs.008.001.06", "pacs.002.001.07", "camt.056.001.05", "camt.029.001.06"},
+		Currency:       "USD",
+		MaxAmount:      1000000,
+		ClearingSystem: utils.ClearingSystemUSABA,
+		Required: map[string][]string{
+			"pacs.008": {
+				"CdtTrfTxInf/PmtId/EndToEndId",
+				"CdtTrfTxInf/PmtId/TxId",
+				"CdtTrfTxInf/Dbtr/Nm",
+				"CdtTrfTxInf/DbtrAcct/Id/Othr/Id",
+				"CdtTrfTxInf/Cdtr/Nm",
+				"CdtTrfTxInf/CdtrAcct/Id/Othr/Id",
+				"CdtTrfTxInf/DbtrAgt/FinInstnId/ClrSysMmbId/MmbId",
+				"CdtTrfTxInf/CdtrAgt/FinInstnId/ClrSysMmbId/MmbId",
+			},
+			"pacs.002": {"TxInfAndSts/OrgnlEndToEndId", "TxInfAndSts/TxSts"},
+			"camt.056": {"Assgnmt/Id", "Assgnmt/CreDtTm", "Undrlyg/TxInf/OrgnlEndToEndId", "Undrlyg/TxInf/CxlRsnInf/Rsn/Cd"},
+		},
+		Timeout:   20 * time.Second,
+		ClockSkew: 2 * time.Second,
+	}
+
+	// NPPScheme is the New Payments Platform of Australia
+	NPPScheme = InstantScheme{
+		Name:           NPPName,
+		Version:        "2021",
+		Description:    "New Payments Platform credit transfers, status reports, returns and recalls",
+		Prefix:         "NPP",
+		MessageTypes:   []string{"pacs.008.001.08", "pacs.002.001.10", "pacs.004.001.10", "camt.056.001.08", "camt.029.001.09"},
+		Currency:       "AUD",
+		ClearingSystem: utils.ClearingSystemAUBSB,
+		Required: map[string][]string{
+			"pacs.008": {
+				"CdtTrfTxInf/PmtId/EndToEndId",
+				"CdtTrfTxInf/PmtId/TxId",
+				"CdtTrfTxInf/Dbtr/Nm",
+				"CdtTrfTxInf/Cdtr/Nm",
+				"CdtTrfTxInf/DbtrAgt/FinInstnId/BICFI",
+				"CdtTrfTxInf/CdtrAgt/FinInstnId/BICFI",
+			},
+			"pacs.002": {"TxInfAndSts/OrgnlEndToEndId", "TxInfAndSts/TxSts"},
+			"pacs.004": {"TxInf/RtrId", "TxInf/RtrdIntrBkSttlmAmt", "TxInf/RtrRsnInf/Rsn/Cd"},
+			"camt.056": {"Assgnmt/Id", "Assgnmt/CreDtTm", "Undrlyg/TxInf/OrgnlEndToEndId", "Undrlyg/TxInf/CxlRsnInf/Rsn/Cd"},
+		},
+		Timeout:   15 * time.Second,
+		ClockSkew: 2 * time.Second,
+	}
+)
+
+// FedNow returns the profile of FedNowScheme
+func FedNow() *Profile {
+	return FedNowScheme.Profile()
+}
+
+// RTP returns the profile of RTPScheme
+func RTP() *Profile {
+	return RTPScheme.Profile()
+}
+
+// NPP returns the profile of NPPScheme
+func NPP() *Profile {
+	return NPPScheme.Profile()
+}
+
+// Profile returns the rules of the scheme, supporting exactly the message types of the scheme
+func (s InstantScheme) Profile() *Profile {
+	amount := fmt.Sprintf("Settlement amount is in %s", s.Currency)
+	if s.MaxAmount > 0 {
+		amount += fmt.Sprintf(" and at most %.2f", s.MaxAmount)
+	}
+
+	var required []string
+	for _, name := range s.requiredNames() {
+		required = append(required, s.Required[name]...)
+	}
+
+	return &Profile{
+		Name:         s.Name,
+		Version:      s.Version,
+		Description:  s.Description,
+		MessageTypes: s.MessageTypes,
+		Rules: []Rule{
+			{
+				ID:           s.Prefix + "-001",
+				Description:  "A credit transfer carries exactly one transaction",
+				Severity:     SeverityError,
+				Paths:        []string{"GrpHdr/NbOfTxs"},
+				MessageTypes: []string{"pacs.008"},
+				Check:        checkSingleTransaction,
+			},
+			{
+				ID:           s.Prefix + "-002",
+				Description:  amount,
+				Severity:     SeverityError,
+				Paths:        []string{"CdtTrfTxInf/IntrBkSttlmAmt"},
+				MessageTypes: []string{"pacs.008"},
+				Check:        s.checkAmount,
+			},
+			{
+				ID:          s.Prefix + "-003",
+				Description: "Elements required by the scheme are present",
+				Severity:    SeverityError,
+				Paths:       required,
+				Check:       s.checkRequired,
+			},
+			{
+				ID:          s.Prefix + "-004",
+				Description: fmt.Sprintf("Agents are identified by %s clearing system members", s.ClearingSystem),
+				Severity:    SeverityError,
+				Paths:       []string{"FinInstnId/ClrSysMmbId/MmbId"},
+				Check:       s.checkAgents,
+			},
+			{
+				ID:          s.Prefix + "-005",
+				Description: "Creation date time is not in the future",
+				Severity:    SeverityError,
+				Paths:       []string{"GrpHdr/CreDtTm", "Assgnmt/CreDtTm"},
+				Check:       s.checkCreationNotFuture,
+			},
+			{
+				ID:           s.Prefix + "-006",
+				Description:  fmt.Sprintf("Credit transfer is processed within %s of its creation date time", s.Timeout),
+				Severity:     SeverityError,
+				Paths:        []string{"GrpHdr/CreDtTm"},
+				MessageTypes: []string{"pacs.008"},
+				Check:        s.checkTimeout,
+			},
+		},
+	}
+}
+
+// requiredNames returns the message names of Required in order
+func (s InstantScheme) requiredNames() []string {
+	names := make([]string, 0, len(s.Required))
+	for name := range s.Required {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func (s InstantScheme) checkAmount(in Input) []Finding {
+	var findings []Finding
+	for _, tx := range utils.GetTransactions(in.Document.InspectMessage()) {
+		path := tx.Path + "/IntrBkSttlmAmt"
+		if ccy := tx.Lookup("CdtTrfTxInf/IntrBkSttlmAmt/@Ccy"); ccy != s.Currency {
+			findings = append(findings, Finding{Path: path, Message: fmt.Sprintf("currency %s is not %s", ccy, s.Currency)})
+			continue
+		}
+		amount, _ := strconv.ParseFloat(tx.Lookup("CdtTrfTxInf/IntrBkSttlmAmt"), 64)
+		if s.MaxAmount > 0 && amount > s.MaxAmount {
+			findings = append(findings, Finding{Path: path, Message: fmt.Sprintf("amount %.2f exceeds %.2f", amount, s.MaxAmount)})
+		}
+	}
+	return findings
+}
+
+func (s InstantScheme) checkRequired(in Input) []Finding {
+	messageType := in.MessageType()
+	var paths []string
+	for _, name := range s.requiredNames() {
+		if strings.HasPrefix(messageType, name) {
+			paths = append(paths, s.Required[name]...)
+		}
+	}
+
+	var findings []Finding
+	for _, tx := range utils.GetTransactions(in.Document.InspectMessage()) {
+		for _, path := range paths {
+			if tx.Lookup(path) != "" {
+				continue
+			}
+			findings = append(findings, Finding{Path: requiredPath(tx.Path, path), Message: "element required by the scheme is omitted"})
+		}
+	}
+	return findings
+}
+
+func (s InstantScheme) checkAgents(in Input) []Finding {
+	var findings []Finding
+	for _, member := range clearingSystemMembers(utils.GetElements(in.Document.InspectMessage()), "FinInstnId/ClrSysMmbId") {
+		if member.system != s.ClearingSystem {
+			findings = append(findings, Finding{
+				Path:    member.path,
+				Message: fmt.Sprintf("clearing system %q is not %s", member.system, s.ClearingSystem),
+			})
+			continue
+		}
+		if err := utils.ValidateClearingSystemMemberId(member.system, member.id); err != nil {
+			findings = append(findings, Finding{Path: member.path, Message: err.Error()})
+		}
+	}
+	return findings
+}
+
+func (s InstantScheme) checkCreationNotFuture(in Input) []Finding {
+	var findings []Finding
+	for path, created := range creationTimes(in.Document) {
+		if created.After(in.Now.Add(s.ClockSkew)) {
+			findings = append(findings, Finding{Path: path, Message: "creation date time is in the future"})
+		}
+	}
+	sortFindings(findings)
+	return findings
+}
+
+func (s InstantScheme) checkTimeout(in Input) []Finding {
+	var findings []Finding
+	for path, created := range creationTimes(in.Document) {
+		if elapsed := in.Now.Sub(created); elapsed > s.Timeout {
+			findings = append(findings, Finding{
+				Path:    path,
+				Message: fmt.Sprintf("timeout of %s exceeded by %s", s.Timeout, elapsed-s.Timeout),
+			})
+		}
+	}
+	sortFindings(findings)
+	return findings
+}
+
+// requiredPath returns the path of a required element of the transaction at txPath, paths
+// outside of the transaction (e.g. Assgnmt/Id) are returned as is
+func requiredPath(txPath, path string) string {
+	element := utils.StripElementIndexes(txPath[strings.LastIndex(txPath, "/")+1:])
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		if txPath != "" && segment == element {
+			return strings.Join(append([]string{txPath}, segments[i+1:]...), "/")
+		}
+	}
+	return path
+}
+
+// creationTimes returns the creation date times of the group header or assignment of doc by path
+func creationTimes(doc document.Iso20022Document) map[string]time.Time {
+	times := make(map[string]time.Time)
+	utils.WalkElementValues(doc.InspectMessage(), func(path string, value reflect.Value) {
+		if !utils.MatchElementPath(path, "GrpHdr/CreDtTm") && !utils.MatchElementPath(path, "Assgnmt/CreDtTm") {
+			return
+		}
+		if tm, ok := utils.ElementTime(value); ok {
+			times[path] = tm
+		}
+	})
+	return times
+}
@@ -0,0 +1,100 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package profile
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/moov-io/iso20022/pkg/document"
+	"github.com/moov-io/iso20022/pkg/iso20022test/fixtures"
+)
+
+func TestFedNow(t *testing.T) {
+	p, err := Get(FedNowName)
+	require.Nil(t, err)
+
+	created := time.Date(2021, 4, 15, 10, 30, 0, 0, time.UTC)
+	doc := fixtures.Document(t, "fednow_pacs_v08.xml")
+	result := p.Evaluate(Input{Document: doc, Now: created.Add(5 * time.Second)})
+	require.True(t, result.Valid(), "%v", result.Err())
+	require.Equal(t, "2023", result.Version)
+
+	result = p.Evaluate(Input{Document: doc, Now: created.Add(30 * time.Second)})
+	require.Equal(t, []Finding{{
+		Rule:     "FEDNOW-006",
+		Severity: SeverityError,
+		Path:     "GrpHdr/CreDtTm",
+		Message:  "timeout of 20s exceeded by 10s",
+	}}, result.Errors())
+
+	result = p.Evaluate(Input{Document: doc, Now: created.Add(-time.Minute)})
+	require.Len(t, result.Errors(), 1)
+	require.Equal(t, "FEDNOW-005", result.Errors()[0].Rule)
+
+	// multiple transactions without UETR, accounts or routing numbers of the debtor and creditor agents
+	result = p.Evaluate(Input{Document: fixtures.Document(t, "valid_pacs_v08.xml"), Now: created.Add(5 * time.Second)})
+	var rules, paths []string
+	for _, finding := range result.Errors() {
+		rules = append(rules, finding.Rule)
+		paths = append(paths, finding.Path)
+	}
+	require.Equal(t, []string{
+		"FEDNOW-001",
+		"FEDNOW-003", "FEDNOW-003", "FEDNOW-003",
+		"FEDNOW-003", "FEDNOW-003", "FEDNOW-003", "FEDNOW-003", "FEDNOW-003",
+	}, rules)
+	require.Equal(t, []string{
+		"GrpHdr/NbOfTxs",
+		"CdtTrfTxInf[0]/DbtrAcct/Id/Othr/Id",
+		"CdtTrfTxInf[0]/CdtrAcct/Id/Othr/Id",
+		"CdtTrfTxInf[0]/CdtrAgt/FinInstnId/ClrSysMmbId/MmbId",
+		"CdtTrfTxInf[1]/PmtId/UETR",
+		"CdtTrfTxInf[1]/DbtrAcct/Id/Othr/Id",
+		"CdtTrfTxInf[1]/CdtrAcct/Id/Othr/Id",
+		"CdtTrfTxInf[1]/DbtrAgt/FinInstnId/ClrSysMmbId/MmbId",
+		"CdtTrfTxInf[1]/CdtrAgt/FinInstnId/ClrSysMmbId/MmbId",
+	}, paths)
+
+	// message types outside of the scheme aren't supported
+	result = p.Validate(fixtures.Document(t, "valid_pacs_v11.xml"))
+	require.Equal(t, "PROFILE", result.Errors()[0].Rule)
+}
+
+func TestInstantSchemeAmountAndAgents(t *testing.T) {
+	buf := fixtures.ReadFile(t, "fednow_pacs_v08.xml")
+	buf = bytes.Replace(buf, []byte(`Ccy="USD">2500.00`), []byte(`Ccy="USD">750000.00`), 1)
+	buf = bytes.Replace(buf, []byte("<Cd>USABA</Cd>"), []byte("<Cd>GBDSC</Cd>"), 1)
+	buf = bytes.Replace(buf, []byte("021000021"), []byte("021000012"), 1)
+	doc, err := document.ParseIso20022Document(buf)
+	require.Nil(t, err)
+
+	now := time.Date(2021, 4, 15, 10, 30, 5, 0, time.UTC)
+	result := FedNow().Evaluate(Input{Document: doc, Now: now})
+	require.Equal(t, []Finding{
+		{Rule: "FEDNOW-002", Severity: SeverityError, Path: "CdtTrfTxInf[0]/IntrBkSttlmAmt", Message: "amount 750000.00 exceeds 500000.00"},
+		{Rule: "FEDNOW-004", Severity: SeverityError, Path: "CdtTrfTxInf[0]/InstgAgt/FinInstnId/ClrSysMmbId/MmbId", Message: `clearing system "GBDSC" is not USABA`},
+		{Rule: "FEDNOW-004", Severity: SeverityError, Path: "CdtTrfTxInf[0]/InstdAgt/FinInstnId/ClrSysMmbId/MmbId", Message: "The value 021000012 is not a valid USABA clearing system member identification"},
+	}, result.Errors())
+
+	// NPP settles AUD without a scheme limit and identifies agents by BIC
+	result = NPP().Evaluate(Input{Document: doc, Now: now})
+	var rules []string
+	for _, finding := range result.Errors() {
+		rules = append(rules, finding.Rule)
+	}
+	require.Equal(t, []string{"NPP-002", "NPP-003", "NPP-003", "NPP-003", "NPP-004", "NPP-004", "NPP-004", "NPP-004"}, rules)
+	require.Equal(t, "currency USD is not AUD", result.Errors()[0].Message)
+}
+
+func TestRequiredPath(t *testing.T) {
+	require.Equal(t, "CdtTrfTxInf[1]/PmtId/UETR", requiredPath("CdtTrfTxInf[1]", "CdtTrfTxInf/PmtId/UETR"))
+	require.Equal(t, "Undrlyg[0]/TxInf[2]/OrgnlUETR", requiredPath("Undrlyg[0]/TxInf[2]", "Undrlyg/TxInf/OrgnlUETR"))
+	require.Equal(t, "Assgnmt/Id", requiredPath("Undrlyg[0]/TxInf[2]", "Assgnmt/Id"))
+	require.Equal(t, "TxInfAndSts/TxSts", requiredPath("", "TxInfAndSts/TxSts"))
+}
@@ -0,0 +1,202 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package profile
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"time"
+
+	"github.com/moov-io/iso20022/pkg/document"
+	"github.com/moov-io/iso20022/pkg/utils"
+)
+
+const (
+	// SCTInstName is the name of the SEPA Instant Credit Transfer profile
+	SCTInstName = "SCTInst"
+)
+
+var (
+	// SCTInstTimeout is the time after the acceptance time stamp (AT-T056) by which a transaction must be completed
+	SCTInstTimeout = 20 * time.Second
+
+	// SCTInstClockSkew is the tolerated difference between the clocks of the participants
+	SCTInstClockSkew = 2 * time.Second
+
+	// SCTInstMaxAmount is the maximum amount of a single transaction in EUR
+	SCTInstMaxAmount = 100000.0
+
+	// ErrAcceptanceDateTimeOmitted is returned when a transaction has no AccptncDtTm
+	ErrAcceptanceDateTimeOmitted = errors.New("acceptance date time is omitted")
+)
+
+// SCTInst returns the SEPA Instant Credit Transfer profile for pacs.008 interbank messages
+func SCTInst() *Profile {
+	return &Profile{
+		Name:         SCTInstName,
+		Version:      "2019",
+		Description:  "SEPA Instant Credit Transfer interbank pacs.008 with scheme timeout rules",
+		MessageTypes: []string{"pacs.008"},
+		Rules: []Rule{
+			{
+				ID:          "SCTINST-001",
+				Description: "A message carries exactly one transaction",
+				Severity:    SeverityError,
+				Paths:       []string{"GrpHdr/NbOfTxs"},
+				Check:       checkSingleTransaction,
+			},
+			{
+				ID:          "SCTINST-002",
+				Description: "Local instrument is INST",
+				Severity:    SeverityError,
+				Paths:       []string{"PmtTpInf/LclInstrm/Cd"},
+				Check:       checkInstantInstrument,
+			},
+			{
+				ID:          "SCTINST-003",
+				Description: fmt.Sprintf("Settlement amount is in EUR and at most %.2f", SCTInstMaxAmount),
+				Severity:    SeverityError,
+				Paths:       []string{"CdtTrfTxInf/IntrBkSttlmAmt"},
+				Check:       checkInstantAmount,
+			},
+			{
+				ID:          "SCTINST-004",
+				Description: "Acceptance date time (AT-T056) is present",
+				Severity:    SeverityError,
+				Paths:       []string{"CdtTrfTxInf/AccptncDtTm"},
+				Check:       checkAcceptanceDateTime,
+			},
+			{
+				ID:          "SCTINST-005",
+				Description: "Acceptance date time is not in the future",
+				Severity:    SeverityError,
+				Paths:       []string{"CdtTrfTxInf/AccptncDtTm"},
+				Check:       checkAcceptanceNotFuture,
+			},
+			{
+				ID:          "SCTINST-006",
+				Description: fmt.Sprintf("Transaction is processed within %s of its acceptance date time", SCTInstTimeout),
+				Severity:    SeverityError,
+				Paths:       []string{"CdtTrfTxInf/AccptncDtTm"},
+				Check:       checkAcceptanceTimeout,
+			},
+		},
+	}
+}
+
+// RemainingTime returns the time budget left for the transactions of doc at now
+// under the SCT Inst timeout, the smallest budget of all transactions is returned.
+func RemainingTime(doc document.Iso20022Document, now time.Time) (time.Duration, error) {
+	times, missing := acceptanceTimes(doc)
+	if len(missing) > 0 || len(times) == 0 {
+		return 0, ErrAcceptanceDateTimeOmitted
+	}
+
+	var remaining time.Duration
+	first := true
+	for _, accepted := range times {
+		left := accepted.Add(SCTInstTimeout).Sub(now)
+		if first || left < remaining {
+			remaining = left
+			first = false
+		}
+	}
+	return remaining, nil
+}
+
+func checkSingleTransaction(in Input) []Finding {
+	values := utils.FindElementValues(in.Document.InspectMessage(), "GrpHdr/NbOfTxs")
+	if len(values) == 1 && values[0] == "1" {
+		return nil
+	}
+	return []Finding{{Path: "GrpHdr/NbOfTxs", Message: "number of transactions must be 1"}}
+}
+
+func checkInstantInstrument(in Input) []Finding {
+	var findings []Finding
+	for _, tx := range utils.GetTransactions(in.Document.InspectMessage()) {
+		if tx.Lookup("PmtTpInf/LclInstrm/Cd") != "INST" {
+			findings = append(findings, Finding{Path: tx.Path, Message: "local instrument must be INST"})
+		}
+	}
+	return findings
+}
+
+func checkInstantAmount(in Input) []Finding {
+	var findings []Finding
+	for _, tx := range utils.GetTransactions(in.Document.InspectMessage()) {
+		path := tx.Path + "/IntrBkSttlmAmt"
+		if ccy := tx.Lookup("CdtTrfTxInf/IntrBkSttlmAmt/@Ccy"); ccy != "EUR" {
+			findings = append(findings, Finding{Path: path, Message: fmt.Sprintf("currency %s is not EUR", ccy)})
+			continue
+		}
+		amount, _ := strconv.ParseFloat(tx.Lookup("CdtTrfTxInf/IntrBkSttlmAmt"), 64)
+		if amount > SCTInstMaxAmount {
+			findings = append(findings, Finding{Path: path, Message: fmt.Sprintf("amount %.2f exceeds %.2f", amount, SCTInstMaxAmount)})
+		}
+	}
+	return findings
+}
+
+func checkAcceptanceDateTime(in Input) []Finding {
+	var findings []Finding
+	_, missing := acceptanceTimes(in.Document)
+	for _, path := range missing {
+		findings = append(findings, Finding{Path: path + "/AccptncDtTm", Message: ErrAcceptanceDateTimeOmitted.Error()})
+	}
+	return findings
+}
+
+func checkAcceptanceNotFuture(in Input) []Finding {
+	var findings []Finding
+	times, _ := acceptanceTimes(in.Document)
+	for path, accepted := range times {
+		if accepted.After(in.Now.Add(SCTInstClockSkew)) {
+			findings = append(findings, Finding{Path: path + "/AccptncDtTm", Message: "acceptance date time is in the future"})
+		}
+	}
+	sortFindings(findings)
+	return findings
+}
+
+func checkAcceptanceTimeout(in Input) []Finding {
+	var findings []Finding
+	times, _ := acceptanceTimes(in.Document)
+	for path, accepted := range times {
+		if elapsed := in.Now.Sub(accepted); elapsed > SCTInstTimeout {
+			findings = append(findings, Finding{
+				Path:    path + "/AccptncDtTm",
+				Message: fmt.Sprintf("timeout of %s exceeded by %s", SCTInstTimeout, elapsed-SCTInstTimeout),
+			})
+		}
+	}
+	sortFindings(findings)
+	return findings
+}
+
+// acceptanceTimes returns AccptncDtTm by transaction path and the paths of transactions without it
+func acceptanceTimes(doc document.Iso20022Document) (map[string]time.Time, []string) {
+	msg := doc.InspectMessage()
+
+	times := make(map[string]time.Time)
+	utils.WalkElementValues(msg, func(path string, value reflect.Value) {
+		if !utils.MatchElementPath(path, "CdtTrfTxInf/AccptncDtTm") {
+			return
+		}
+		if tm, ok := utils.ElementTime(value); ok {
+			times[path[:len(path)-len("/AccptncDtTm")]] = tm
+		}
+	})
+
+	var missing []string
+	for _, tx := range utils.GetTransactions(msg) {
+		if _, exists := times[tx.Path]; !exists && tx.Path != "" {
+			missing = append(missing, tx.Path)
+		}
+	}
+	return times, missing
+}
@@ -0,0 +1,67 @@
+-- Projected documents, the rows of every other table reference their document_id
+CREATE TABLE IF NOT EXISTS documents (
+    document_id  TEXT PRIMARY KEY,
+    message_type TEXT NOT NULL,
+    message_id   TEXT,
+    projected_at TIMESTAMPTZ NOT NULL
+);
+
+-- Transactions of payment messages (e.g. pacs.008, pacs.003, pain.001)
+CREATE TABLE IF NOT EXISTS payments (
+    document_id     TEXT NOT NULL,
+    path            TEXT NOT NULL,
+    instruction_id  TEXT,
+    end_to_end_id   TEXT,
+    tx_id           TEXT,
+    uetr            TEXT,
+    amount          NUMERIC,
+    currency        TEXT,
+    settlement_date DATE,
+    PRIMARY KEY (document_id, path)
+);
+CREATE INDEX IF NOT EXISTS payments_end_to_end_id ON payments (end_to_end_id);
+CREATE INDEX IF NOT EXISTS payments_uetr ON payments (uetr);
+
+-- Parties of payments and entries by role (Dbtr, Cdtr, UltmtDbtr, UltmtCdtr)
+CREATE TABLE IF NOT EXISTS parties (
+    document_id TEXT NOT NULL,
+    path        TEXT NOT NULL,
+    role        TEXT NOT NULL,
+    name        TEXT,
+    account     TEXT,
+    agent       TEXT,
+    country     TEXT,
+    PRIMARY KEY (document_id, path, role)
+);
+CREATE INDEX IF NOT EXISTS parties_account ON parties (account);
+
+-- Entries of statements, reports and notifications (camt.052, camt.053, camt.054)
+CREATE TABLE IF NOT EXISTS entries (
+    document_id          TEXT NOT NULL,
+    path                 TEXT NOT NULL,
+    account              TEXT,
+    entry_ref            TEXT,
+    amount               NUMERIC,
+    currency             TEXT,
+    credit_debit         TEXT,
+    status               TEXT,
+    booking_date         DATE,
+    value_date           DATE,
+    account_servicer_ref TEXT,
+    PRIMARY KEY (document_id, path)
+);
+CREATE INDEX IF NOT EXISTS entries_account_booking_date ON entries (account, booking_date);
+
+-- Balances of statements and reports
+CREATE TABLE IF NOT EXISTS balances (
+    document_id  TEXT NOT NULL,
+    path         TEXT NOT NULL,
+    account      TEXT,
+    balance_type TEXT,
+    amount       NUMERIC,
+    currency     TEXT,
+    credit_debit TEXT,
+    balance_date DATE,
+    PRIMARY KEY (document_id, path)
+);
+CREATE INDEX IF NOT EXISTS balances_account_date ON balances (account, balance_date);
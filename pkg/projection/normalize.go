@@ -0,0 +1,231 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package projection
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/moov-io/iso20022/pkg/document"
+	"github.com/moov-io/iso20022/pkg/utils"
+)
+
+var (
+	// PaymentElements are the transaction elements projected as payments
+	PaymentElements = []string{"CdtTrfTxInf", "DrctDbtTxInf"}
+
+	// EntryElements are the transaction elements projected as entries
+	EntryElements = []string{"Ntry"}
+
+	// Roles are the roles of the projected parties
+	Roles = []string{"Dbtr", "Cdtr", "UltmtDbtr", "UltmtCdtr"}
+)
+
+// Payment is a row of the payments table
+type Payment struct {
+	Path           string `json:"path"`
+	InstructionID  string `json:"instructionId,omitempty"`
+	EndToEndID     string `json:"endToEndId,omitempty"`
+	TxID           string `json:"txId,omitempty"`
+	UETR           string `json:"uetr,omitempty"`
+	Amount         string `json:"amount,omitempty"`
+	Currency       string `json:"currency,omitempty"`
+	SettlementDate string `json:"settlementDate,omitempty"`
+}
+
+// Party is a row of the parties table, the party of a role of a payment or entry
+type Party struct {
+	Path    string `json:"path"`
+	Role    string `json:"role"`
+	Name    string `json:"name,omitempty"`
+	Account string `json:"account,omitempty"`
+	Agent   string `json:"agent,omitempty"`
+	Country string `json:"country,omitempty"`
+}
+
+// Entry is a row of the entries table
+type Entry struct {
+	Path               string `json:"path"`
+	Account            string `json:"account,omitempty"`
+	EntryRef           string `json:"entryRef,omitempty"`
+	Amount             string `json:"amount,omitempty"`
+	Currency           string `json:"currency,omitempty"`
+	CreditDebit        string `json:"creditDebit,omitempty"`
+	Status             string `json:"status,omitempty"`
+	BookingDate        string `json:"bookingDate,omitempty"`
+	ValueDate          string `json:"valueDate,omitempty"`
+	AccountServicerRef string `json:"accountServicerRef,omitempty"`
+}
+
+// Balance is a row of the balances table
+type Balance struct {
+	Path        string `json:"path"`
+	Account     string `json:"account,omitempty"`
+	Type        string `json:"type,omitempty"`
+	Amount      string `json:"amount,omitempty"`
+	Currency    string `json:"currency,omitempty"`
+	CreditDebit string `json:"creditDebit,omitempty"`
+	Date        string `json:"date,omitempty"`
+}
+
+// Projection holds the rows of a document
+type Projection struct {
+	DocumentID  string `json:"documentId"`
+	MessageType string `json:"messageType"`
+	MessageID   string `json:"messageId,omitempty"`
+
+	Payments []Payment `json:"payments,omitempty"`
+	Parties  []Party   `json:"parties,omitempty"`
+	Entries  []Entry   `json:"entries,omitempty"`
+	Balances []Balance `json:"balances,omitempty"`
+}
+
+// Normalize returns the rows of the document id, elements of enclosing blocks (e.g. the
+// account of a statement) are normalized into the rows of their transactions
+func Normalize(id string, doc document.Iso20022Document) *Projection {
+	msg := doc.InspectMessage()
+	p := &Projection{
+		DocumentID:  id,
+		MessageType: utils.GetMessageType(doc.NameSpace()),
+	}
+	if values := utils.FindElementValues(msg, "GrpHdr/MsgId"); len(values) > 0 {
+		p.MessageID = values[0]
+	}
+
+	for _, tx := range utils.GetTransactions(msg) {
+		switch {
+		case isElement(tx.Path, PaymentElements):
+			p.Payments = append(p.Payments, Payment{
+				Path:           tx.Path,
+				InstructionID:  tx.Lookup("PmtId/InstrId"),
+				EndToEndID:     tx.Lookup("PmtId/EndToEndId"),
+				TxID:           tx.Lookup("PmtId/TxId"),
+				UETR:           strings.ToLower(tx.Lookup("PmtId/UETR")),
+				Amount:         amount(tx.Lookup("IntrBkSttlmAmt", "InstdAmt", "Amt/InstdAmt")),
+				Currency:       tx.Lookup("IntrBkSttlmAmt/@Ccy", "InstdAmt/@Ccy", "Amt/InstdAmt/@Ccy"),
+				SettlementDate: date(tx.Lookup("IntrBkSttlmDt", "ReqdExctnDt/Dt", "ReqdExctnDt/DtTm", "ReqdExctnDt", "ReqdColltnDt")),
+			})
+		case isElement(tx.Path, EntryElements):
+			p.Entries = append(p.Entries, Entry{
+				Path:               tx.Path,
+				Account:            tx.Lookup("Acct/Id/IBAN", "Acct/Id/Othr/Id"),
+				EntryRef:           tx.Lookup("Ntry/NtryRef"),
+				Amount:             amount(tx.Lookup("Ntry/Amt")),
+				Currency:           tx.Lookup("Ntry/Amt/@Ccy"),
+				CreditDebit:        tx.Lookup("Ntry/CdtDbtInd"),
+				Status:             tx.Lookup("Ntry/Sts/Cd", "Ntry/Sts/Prtry", "Ntry/Sts"),
+				BookingDate:        date(tx.Lookup("Ntry/BookgDt/Dt", "Ntry/BookgDt/DtTm")),
+				ValueDate:          date(tx.Lookup("Ntry/ValDt/Dt", "Ntry/ValDt/DtTm")),
+				AccountServicerRef: tx.Lookup("Ntry/AcctSvcrRef"),
+			})
+		default:
+			continue
+		}
+		p.Parties = append(p.Parties, parties(tx)...)
+	}
+	p.Balances = balances(utils.GetElements(msg))
+	return p
+}
+
+// parties returns the parties of the roles of tx, the related parties of entries included
+func parties(tx utils.Transaction) []Party {
+	var list []Party
+	for _, role := range Roles {
+		party := Party{
+			Path:    tx.Path,
+			Role:    role,
+			Name:    tx.Lookup(role+"/Nm", "RltdPties/"+role+"/Pty/Nm", "RltdPties/"+role+"/Nm"),
+			Account: tx.Lookup(role+"Acct/Id/IBAN", role+"Acct/Id/Othr/Id"),
+			Agent:   tx.Lookup(role+"Agt/FinInstnId/BICFI", role+"Agt/FinInstnId/BIC"),
+			Country: tx.Lookup(role+"/PstlAdr/Ctry", "RltdPties/"+role+"/Pty/PstlAdr/Ctry", "RltdPties/"+role+"/PstlAdr/Ctry"),
+		}
+		if party.Name != "" || party.Account != "" || party.Agent != "" {
+			list = append(list, party)
+		}
+	}
+	return list
+}
+
+// balances returns the balances of the statements and reports of elements
+func balances(elements []utils.Element) []Balance {
+	var (
+		list  []Balance
+		index = make(map[string]int)
+	)
+	for _, elm := range elements {
+		i := strings.Index(elm.Path, "/Bal[")
+		if i < 0 {
+			continue
+		}
+		end := strings.Index(elm.Path[i+1:], "]")
+		path := elm.Path[:i+1+end+1]
+		n, exists := index[path]
+		if !exists {
+			n = len(list)
+			index[path] = n
+			list = append(list, Balance{Path: path, Account: accountOf(elements, elm.Path[:i])})
+		}
+
+		b := &list[n]
+		switch strings.TrimPrefix(utils.StripElementIndexes(elm.Path[len(path):]), "/") {
+		case "Tp/CdOrPrtry/Cd":
+			b.Type = elm.Value
+		case "Tp/CdOrPrtry/Prtry":
+			if b.Type == "" {
+				b.Type = elm.Value
+			}
+		case "Amt":
+			b.Amount = amount(elm.Value)
+		case "Amt/@Ccy":
+			b.Currency = elm.Value
+		case "CdtDbtInd":
+			b.CreditDebit = elm.Value
+		case "Dt/Dt", "Dt/DtTm":
+			if b.Date == "" {
+				b.Date = date(elm.Value)
+			}
+		}
+	}
+	return list
+}
+
+// accountOf returns the account of the statement or report at path
+func accountOf(elements []utils.Element, path string) string {
+	for _, suffix := range []string{"/Acct/Id/IBAN", "/Acct/Id/Othr/Id"} {
+		for _, elm := range elements {
+			if elm.Path == path+suffix {
+				return elm.Value
+			}
+		}
+	}
+	return ""
+}
+
+// isElement reports whether the transaction element at path is one of names
+func isElement(path string, names []string) bool {
+	name := utils.StripElementIndexes(path[strings.LastIndex(path, "/")+1:])
+	for _, n := range names {
+		if name == n {
+			return true
+		}
+	}
+	return false
+}
+
+// amount returns value when it's a decimal amount, numeric columns don't accept other values
+func amount(value string) string {
+	if _, err := strconv.ParseFloat(value, 64); err != nil {
+		return ""
+	}
+	return value
+}
+
+// date returns the yyyy-mm-dd date of a date or date time
+func date(value string) string {
+	if i := strings.Index(value, "T"); i > 0 {
+		return value[:i]
+	}
+	return value
+}
@@ -0,0 +1,243 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package projection
+
+/*
+	Projection writes normalized rows of processed messages (payments, parties, entries and
+	balances) into Postgres tables, so reporting queries never need to parse xml:
+
+		db, err := sql.Open("pgx", dsn)
+		p := projection.New(db)
+		err = p.Migrate(ctx)
+		pl := pipeline.New(source, p.Sink(sink))
+
+	The driver is registered by the application, e.g. by importing github.com/jackc/pgx/v5/stdlib
+	or github.com/lib/pq. Migrations creates the tables, Migrate applies the migrations not
+	applied yet, applications using a migration tool apply the files of Migrations instead.
+	Projecting a document again replaces its rows.
+*/
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"embed"
+	"encoding/hex"
+	"fmt"
+	"io/fs"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/moov-io/iso20022/pkg/document"
+	"github.com/moov-io/iso20022/pkg/pipeline"
+)
+
+// Migrations are the sql migrations creating the tables of the projection, in the order of their names
+//
+//go:embed migrations/*.sql
+var Migrations embed.FS
+
+// tables are the tables holding the rows of documents, cleared before a document is projected again
+var tables = []string{"payments", "parties", "entries", "balances", "documents"}
+
+// Projector writes the rows of documents to a database
+type Projector struct {
+	db  *sql.DB
+	now func() time.Time
+}
+
+// New returns a projector writing to db, a Postgres database
+func New(db *sql.DB) *Projector {
+	return &Projector{db: db, now: time.Now}
+}
+
+// Migrate applies the Migrations not applied yet, applied migrations are recorded in the
+// schema_migrations table
+func (p *Projector) Migrate(ctx context.Context) error {
+	if _, err := p.db.ExecContext(ctx, `CREATE TABLE IF NOT EXISTS schema_migrations (
+    version    TEXT PRIMARY KEY,
+    applied_at TIMESTAMPTZ NOT NULL
+)`); err != nil {
+		return fmt.Errorf("creating schema_migrations: %w", err)
+	}
+
+	applied := make(map[string]bool)
+	rows, err := p.db.QueryContext(ctx, "SELECT version FROM schema_migrations")
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var version string
+		if err := rows.Scan(&version); err != nil {
+			return err
+		}
+		applied[version] = true
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	names, err := fs.Glob(Migrations, "migrations/*.sql")
+	if err != nil {
+		return err
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		version := strings.TrimSuffix(name[strings.LastIndex(name, "/")+1:], ".sql")
+		if applied[version] {
+			continue
+		}
+		script, err := Migrations.ReadFile(name)
+		if err != nil {
+			return err
+		}
+		err = p.transaction(ctx, func(tx *sql.Tx) error {
+			for _, statement := range statements(string(script)) {
+				if _, err := tx.ExecContext(ctx, statement); err != nil {
+					return err
+				}
+			}
+			_, err := tx.ExecContext(ctx, "INSERT INTO schema_migrations (version, applied_at) VALUES ($1, $2)", version, p.now())
+			return err
+		})
+		if err != nil {
+			return fmt.Errorf("migration %s: %w", version, err)
+		}
+	}
+	return nil
+}
+
+// statements splits a script into its statements, which end with a semicolon at the end of a line
+func statements(script string) []string {
+	var (
+		list    []string
+		current strings.Builder
+	)
+	for _, line := range strings.Split(script, "\n") {
+		if strings.HasPrefix(strings.TrimSpace(line), "--") {
+			continue
+		}
+		current.WriteString(line)
+		current.WriteString("\n")
+		if strings.HasSuffix(strings.TrimSpace(line), ";") {
+			list = append(list, strings.TrimSuffix(strings.TrimSpace(current.String()), ";"))
+			current.Reset()
+		}
+	}
+	if rest := strings.TrimSpace(current.String()); rest != "" {
+		list = append(list, rest)
+	}
+	return list
+}
+
+func (p *Projector) transaction(ctx context.Context, fn func(tx *sql.Tx) error) error {
+	tx, err := p.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	if err := fn(tx); err != nil {
+		tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+// Project replaces the rows of the document id by the rows of doc
+func (p *Projector) Project(ctx context.Context, id string, doc document.Iso20022Document) error {
+	return p.Write(ctx, Normalize(id, doc))
+}
+
+// Write replaces the rows of the document of projection by its rows in a transaction
+func (p *Projector) Write(ctx context.Context, projection *Projection) error {
+	id := projection.DocumentID
+	err := p.transaction(ctx, func(tx *sql.Tx) error {
+		exec := func(query string, args ...interface{}) error {
+			_, err := tx.ExecContext(ctx, query, args...)
+			return err
+		}
+		for _, table := range tables {
+			if err := exec("DELETE FROM "+table+" WHERE document_id = $1", id); err != nil {
+				return err
+			}
+		}
+
+		err := exec("INSERT INTO documents (document_id, message_type, message_id, projected_at) VALUES ($1, $2, $3, $4)",
+			id, projection.MessageType, null(projection.MessageID), p.now())
+		if err != nil {
+			return err
+		}
+		for _, row := range projection.Payments {
+			err := exec(`INSERT INTO payments (document_id, path, instruction_id, end_to_end_id, tx_id, uetr, amount, currency, settlement_date)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`,
+				id, row.Path, null(row.InstructionID), null(row.EndToEndID), null(row.TxID), null(row.UETR),
+				null(row.Amount), null(row.Currency), null(row.SettlementDate))
+			if err != nil {
+				return err
+			}
+		}
+		for _, row := range projection.Parties {
+			err := exec(`INSERT INTO parties (document_id, path, role, name, account, agent, country)
+VALUES ($1, $2, $3, $4, $5, $6, $7)`,
+				id, row.Path, row.Role, null(row.Name), null(row.Account), null(row.Agent), null(row.Country))
+			if err != nil {
+				return err
+			}
+		}
+		for _, row := range projection.Entries {
+			err := exec(`INSERT INTO entries (document_id, path, account, entry_ref, amount, currency, credit_debit, status, booking_date, value_date, account_servicer_ref)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)`,
+				id, row.Path, null(row.Account), null(row.EntryRef), null(row.Amount), null(row.Currency),
+				null(row.CreditDebit), null(row.Status), null(row.BookingDate), null(row.ValueDate), null(row.AccountServicerRef))
+			if err != nil {
+				return err
+			}
+		}
+		for _, row := range projection.Balances {
+			err := exec(`INSERT INTO balances (document_id, path, account, balance_type, amount, currency, credit_debit, balance_date)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`,
+				id, row.Path, null(row.Account), null(row.Type), null(row.Amount), null(row.Currency),
+				null(row.CreditDebit), null(row.Date))
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("projecting document %s: %w", id, err)
+	}
+	return nil
+}
+
+// null returns nil for empty values, stored as NULL
+func null(value string) interface{} {
+	if value == "" {
+		return nil
+	}
+	return value
+}
+
+// Sink projects the processed items and routes them to sink, if not nil. Items are projected
+// under their name, or the sha-256 digest of their input when they have none.
+func (p *Projector) Sink(sink pipeline.Sink) pipeline.Sink {
+	return func(ctx context.Context, item pipeline.Item) error {
+		id := item.Name
+		if id == "" {
+			sum := sha256.Sum256(item.Input)
+			id = hex.EncodeToString(sum[:])
+		}
+		if item.Document != nil {
+			if err := p.Project(ctx, id, item.Document); err != nil {
+				return err
+			}
+		}
+		if sink != nil {
+			return sink(ctx, item)
+		}
+		return nil
+	}
+}
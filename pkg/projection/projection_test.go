@@ -0,0 +1,232 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package projection
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/moov-io/iso20022/pkg/document"
+	"github.com/moov-io/iso20022/pkg/iso20022test/fixtures"
+	"github.com/moov-io/iso20022/pkg/pipeline"
+)
+
+func parseTestFile(t *testing.T, name string) document.Iso20022Document {
+	t.Helper()
+	doc, err := document.ParseIso20022Document(fixtures.ReadFile(t, name))
+	require.Nil(t, err)
+	return doc
+}
+
+// recorder is a database/sql driver recording the executed statements, it answers queries
+// of schema_migrations with the versions inserted before
+type recorder struct {
+	mu         sync.Mutex
+	statements []statement
+	versions   []string
+	fail       string
+}
+
+type statement struct {
+	query string
+	args  []driver.Value
+}
+
+func (r *recorder) Open(string) (driver.Conn, error) { return &conn{r}, nil }
+
+func (r *recorder) execs(prefix string) []statement {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var list []statement
+	for _, s := range r.statements {
+		if strings.HasPrefix(s.query, prefix) {
+			list = append(list, s)
+		}
+	}
+	return list
+}
+
+type conn struct{ r *recorder }
+
+func (c *conn) Prepare(query string) (driver.Stmt, error) { return &stmt{c.r, query}, nil }
+func (c *conn) Close() error                              { return nil }
+func (c *conn) Begin() (driver.Tx, error)                 { return tx{}, nil }
+
+type tx struct{}
+
+func (tx) Commit() error   { return nil }
+func (tx) Rollback() error { return nil }
+
+type stmt struct {
+	r     *recorder
+	query string
+}
+
+func (s *stmt) Close() error  { return nil }
+func (s *stmt) NumInput() int { return -1 }
+
+func (s *stmt) Exec(args []driver.Value) (driver.Result, error) {
+	s.r.mu.Lock()
+	defer s.r.mu.Unlock()
+	if s.r.fail != "" && strings.HasPrefix(s.query, s.r.fail) {
+		return nil, errors.New("relation does not exist")
+	}
+	s.r.statements = append(s.r.statements, statement{query: s.query, args: args})
+	if strings.HasPrefix(s.query, "INSERT INTO schema_migrations") {
+		s.r.versions = append(s.r.versions, args[0].(string))
+	}
+	return driver.RowsAffected(1), nil
+}
+
+func (s *stmt) Query([]driver.Value) (driver.Rows, error) {
+	s.r.mu.Lock()
+	defer s.r.mu.Unlock()
+	return &rows{versions: append([]string(nil), s.r.versions...)}, nil
+}
+
+type rows struct{ versions []string }
+
+func (r *rows) Columns() []string { return []string{"version"} }
+func (r *rows) Close() error      { return nil }
+
+func (r *rows) Next(dest []driver.Value) error {
+	if len(r.versions) == 0 {
+		return io.EOF
+	}
+	dest[0], r.versions = r.versions[0], r.versions[1:]
+	return nil
+}
+
+func newTestProjector(t *testing.T) (*Projector, *recorder) {
+	r := &recorder{}
+	name := "recorder-" + t.Name()
+	sql.Register(name, r)
+	db, err := sql.Open(name, "")
+	require.Nil(t, err)
+	t.Cleanup(func() { db.Close() })
+	return New(db), r
+}
+
+func TestNormalizePayments(t *testing.T) {
+	p := Normalize("doc-1", parseTestFile(t, "valid_pacs_v08.xml"))
+	require.Equal(t, "pacs.008.001.08", p.MessageType)
+	require.Equal(t, "MSG-20210415-0001", p.MessageID)
+
+	require.Len(t, p.Payments, 2)
+	require.Equal(t, Payment{
+		Path:           "CdtTrfTxInf[0]",
+		InstructionID:  "INSTR-0001",
+		EndToEndID:     "E2E-0001",
+		TxID:           "TX-0001",
+		UETR:           "8a562c67-ca16-48ba-b074-65581be6f011",
+		Amount:         "250000",
+		Currency:       "USD",
+		SettlementDate: "2021-04-15",
+	}, p.Payments[0])
+
+	require.Contains(t, p.Parties, Party{Path: "CdtTrfTxInf[0]", Role: "Dbtr", Name: "John Smith", Country: "US"})
+	require.Contains(t, p.Parties, Party{Path: "CdtTrfTxInf[0]", Role: "Cdtr", Name: "Jane Doe", Agent: "BANKGB2LXXX", Country: "GB"})
+	require.Empty(t, p.Entries)
+	require.Empty(t, p.Balances)
+}
+
+func TestNormalizeStatement(t *testing.T) {
+	p := Normalize("doc-1", parseTestFile(t, "valid_camt053_v08.xml"))
+	require.Empty(t, p.Payments)
+
+	require.NotEmpty(t, p.Entries)
+	require.Equal(t, Entry{
+		Path:        "Stmt[0]/Ntry[1]",
+		Account:     "CH2909000000250094239",
+		EntryRef:    "A-2",
+		Amount:      "100",
+		Currency:    "CHF",
+		CreditDebit: "DBIT",
+		Status:      "BOOK",
+		BookingDate: "2021-04-15",
+	}, p.Entries[1])
+	require.Equal(t, "CH5109000000250092291", p.Entries[2].Account)
+
+	require.NotEmpty(t, p.Balances)
+	require.Equal(t, Balance{
+		Path:        "Stmt[1]/Bal[0]",
+		Account:     "CH5109000000250092291",
+		Type:        "CLBD",
+		Amount:      "1000",
+		Currency:    "CHF",
+		CreditDebit: "CRDT",
+		Date:        "2021-04-15",
+	}, p.Balances[1])
+}
+
+func TestMigrate(t *testing.T) {
+	ctx := context.Background()
+	p, r := newTestProjector(t)
+	require.Nil(t, p.Migrate(ctx))
+
+	require.Len(t, r.execs("INSERT INTO schema_migrations"), 1)
+	require.Len(t, r.execs("CREATE TABLE IF NOT EXISTS payments"), 1)
+	require.Len(t, r.execs("CREATE INDEX IF NOT EXISTS"), 5)
+	for _, s := range r.statements {
+		require.False(t, strings.HasPrefix(s.query, "--"), s.query)
+		require.False(t, strings.HasSuffix(s.query, ";"), s.query)
+	}
+
+	// applied migrations are skipped
+	require.Nil(t, p.Migrate(ctx))
+	require.Len(t, r.execs("INSERT INTO schema_migrations"), 1)
+	require.Len(t, r.execs("CREATE TABLE IF NOT EXISTS payments"), 1)
+}
+
+func TestProject(t *testing.T) {
+	ctx := context.Background()
+	p, r := newTestProjector(t)
+	require.Nil(t, p.Project(ctx, "doc-1", parseTestFile(t, "valid_pacs_v08.xml")))
+
+	require.Len(t, r.execs("DELETE FROM"), len(tables))
+	documents := r.execs("INSERT INTO documents")
+	require.Len(t, documents, 1)
+	require.Equal(t, []driver.Value{"doc-1", "pacs.008.001.08", "MSG-20210415-0001"}, documents[0].args[:3])
+
+	payments := r.execs("INSERT INTO payments")
+	require.Len(t, payments, 2)
+	require.Equal(t, "E2E-0002", payments[1].args[3])
+
+	// empty values are stored as NULL
+	parties := r.execs("INSERT INTO parties")
+	require.NotEmpty(t, parties)
+	require.Nil(t, parties[0].args[4])
+
+	r.fail = "INSERT INTO payments"
+	err := p.Project(ctx, "doc-1", parseTestFile(t, "valid_pacs_v08.xml"))
+	require.ErrorContains(t, err, "projecting document doc-1")
+}
+
+func TestSink(t *testing.T) {
+	ctx := context.Background()
+	p, r := newTestProjector(t)
+
+	var received []string
+	sink := p.Sink(func(_ context.Context, item pipeline.Item) error {
+		received = append(received, item.Name)
+		return nil
+	})
+
+	input := fixtures.ReadFile(t, "valid_camt053_v08.xml")
+	stats, err := pipeline.New(pipeline.Bytes(input), sink).Run(ctx)
+	require.Nil(t, err)
+	require.Equal(t, int64(1), stats.Read)
+	require.Len(t, received, 1)
+	require.NotEmpty(t, r.execs("INSERT INTO entries"))
+	require.NotEmpty(t, r.execs("INSERT INTO balances"))
+}
@@ -0,0 +1,229 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package pseudonym
+
+/*
+	Package pseudonym replaces the personal data of documents (IBANs, names and remittance
+	text) with tokens derived from their values and a secret key, so the same value is
+	replaced by the same token in every document:
+
+		DE89370400440532013000	->	DE11HXZV7Q0PUWBRTK1N9M
+		John Doe		->	PSN-5f0c1a9e2b7d43c8
+
+	IBAN tokens keep the country and length of the IBAN with valid check digits, pseudonymized
+	documents stay valid and their flows referentially consistent. The values of the tokens
+	are kept encrypted in a vault and restored by Reidentify.
+*/
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/moov-io/iso20022/pkg/document"
+	"github.com/moov-io/iso20022/pkg/masking"
+	"github.com/moov-io/iso20022/pkg/utils"
+)
+
+// TokenPrefix starts the tokens of the values of text elements
+const TokenPrefix = "PSN-"
+
+// KeySize is the size of the secret keys of pseudonymizers
+const KeySize = 32
+
+var (
+	// ErrUnknownToken is returned by vaults for tokens they don't hold
+	ErrUnknownToken = errors.New("unknown pseudonymization token")
+
+	// ErrTokenCollision is returned when two values are replaced by the same token
+	ErrTokenCollision = errors.New("pseudonymization token collision")
+)
+
+// Pseudonymizer replaces the values of personal data elements with tokens kept in a vault
+type Pseudonymizer struct {
+	key      []byte
+	vault    Vault
+	elements map[string]bool
+}
+
+// New returns a pseudonymizer of masking.PersonalElements and elements deriving its tokens
+// from key, KeySize bytes
+func New(key []byte, vault Vault, elements ...string) (*Pseudonymizer, error) {
+	if len(key) != KeySize {
+		return nil, fmt.Errorf("pseudonymization key has %d bytes instead of %d", len(key), KeySize)
+	}
+	if vault == nil {
+		return nil, errors.New("pseudonymization vault is missing")
+	}
+	p := &Pseudonymizer{key: derive(key, "token"), vault: vault, elements: make(map[string]bool)}
+	for _, name := range append(append([]string{}, masking.PersonalElements...), elements...) {
+		p.elements[name] = true
+	}
+	return p, nil
+}
+
+// Token returns the token of the value of an element named name, without keeping it
+func (p *Pseudonymizer) Token(name, value string) string {
+	if name == "IBAN" {
+		if token, ok := p.ibanToken(value); ok {
+			return token
+		}
+	}
+	sum := p.sum("text", value)
+	return TokenPrefix + hex.EncodeToString(sum[:8])
+}
+
+// Pseudonymize returns a copy of doc whose personal data elements are replaced by their
+// tokens, with the paths of the replaced elements. Tokens are kept in the vault first.
+func (p *Pseudonymizer) Pseudonymize(ctx context.Context, doc document.Iso20022Document) (document.Iso20022Document, []string, error) {
+	var patches []document.FieldPatch
+	var paths []string
+	for _, elm := range p.personalElements(doc) {
+		value := strings.TrimSpace(elm.Value)
+		if value == "" {
+			continue
+		}
+		if p.isToken(elm.name, value) {
+			// documents are pseudonymized once, tokens are kept
+			_, err := p.vault.Get(ctx, value)
+			if err == nil {
+				continue
+			}
+			if !errors.Is(err, ErrUnknownToken) {
+				return nil, nil, fmt.Errorf("%s: %w", elm.Path, err)
+			}
+		}
+		token := p.Token(elm.name, value)
+		if err := p.vault.Put(ctx, token, value); err != nil {
+			return nil, nil, fmt.Errorf("%s: %w", elm.Path, err)
+		}
+		patches = append(patches, patch(elm.Path, token))
+		paths = append(paths, elm.Path)
+	}
+	if len(patches) == 0 {
+		return doc, nil, nil
+	}
+	pseudonymized, err := document.ApplyFieldPatches(doc, patches)
+	if err != nil {
+		return nil, nil, err
+	}
+	return pseudonymized, paths, nil
+}
+
+// Reidentify returns a copy of doc whose tokens are replaced by the values kept in the vault,
+// with the paths of the restored elements. Tokens the vault doesn't hold are kept.
+func (p *Pseudonymizer) Reidentify(ctx context.Context, doc document.Iso20022Document) (document.Iso20022Document, []string, error) {
+	var patches []document.FieldPatch
+	var paths []string
+	for _, elm := range p.personalElements(doc) {
+		token := strings.TrimSpace(elm.Value)
+		if !p.isToken(elm.name, token) {
+			continue
+		}
+		value, err := p.vault.Get(ctx, token)
+		if errors.Is(err, ErrUnknownToken) {
+			continue
+		}
+		if err != nil {
+			return nil, nil, fmt.Errorf("%s: %w", elm.Path, err)
+		}
+		patches = append(patches, patch(elm.Path, value))
+		paths = append(paths, elm.Path)
+	}
+	if len(patches) == 0 {
+		return doc, nil, nil
+	}
+	reidentified, err := document.ApplyFieldPatches(doc, patches)
+	if err != nil {
+		return nil, nil, err
+	}
+	return reidentified, paths, nil
+}
+
+type element struct {
+	utils.Element
+	name string
+}
+
+func (p *Pseudonymizer) personalElements(doc document.Iso20022Document) []element {
+	var elements []element
+	for _, elm := range utils.GetElements(doc.InspectMessage()) {
+		name := utils.StripElementIndexes(elm.Path[strings.LastIndex(elm.Path, "/")+1:])
+		if p.elements[name] {
+			elements = append(elements, element{Element: elm, name: name})
+		}
+	}
+	return elements
+}
+
+// isToken reports whether value may be a token, IBAN tokens look like any IBAN
+func (p *Pseudonymizer) isToken(name, value string) bool {
+	if name == "IBAN" {
+		_, ok := p.ibanToken(value)
+		return ok
+	}
+	return strings.HasPrefix(value, TokenPrefix)
+}
+
+// ibanToken returns an IBAN of the country and length of iban with valid check digits, its
+// basic bank account number derived from iban
+func (p *Pseudonymizer) ibanToken(iban string) (string, bool) {
+	iban = strings.ToUpper(iban)
+	if len(iban) < 5 || len(iban) > 34 || !isLetter(iban[0]) || !isLetter(iban[1]) {
+		return "", false
+	}
+	sum := p.sum("iban", iban)
+	bban := new(big.Int).SetBytes(sum[:]).Text(36)
+	for len(bban) < len(iban)-4 {
+		bban += bban
+	}
+	bban = strings.ToUpper(bban[:len(iban)-4])
+	country := iban[:2]
+	return fmt.Sprintf("%s%02d%s", country, 98-mod97(bban+country+"00"), bban), true
+}
+
+func (p *Pseudonymizer) sum(kind, value string) [sha256.Size]byte {
+	mac := hmac.New(sha256.New, p.key)
+	mac.Write([]byte(kind + "\x00" + value))
+	var sum [sha256.Size]byte
+	copy(sum[:], mac.Sum(nil))
+	return sum
+}
+
+// mod97 returns the ISO 7064 remainder of an IBAN whose letters are converted to numbers
+func mod97(value string) int {
+	remainder := 0
+	for i := 0; i < len(value); i++ {
+		c := value[i]
+		if isLetter(c) {
+			remainder = (remainder*100 + int(c-'A') + 10) % 97
+		} else {
+			remainder = (remainder*10 + int(c-'0')) % 97
+		}
+	}
+	return remainder
+}
+
+func isLetter(c byte) bool {
+	return c >= 'A' && c <= 'Z'
+}
+
+// derive returns a key of key for a single purpose
+func derive(key []byte, purpose string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte("iso20022-pseudonym-" + purpose))
+	return mac.Sum(nil)
+}
+
+func patch(path, value string) document.FieldPatch {
+	buf, _ := json.Marshal(value)
+	return document.FieldPatch{Path: path, Value: buf}
+}
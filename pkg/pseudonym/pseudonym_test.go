@@ -0,0 +1,143 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package pseudonym
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/moov-io/iso20022/pkg/document"
+	"github.com/moov-io/iso20022/pkg/iso20022test/fixtures"
+	"github.com/moov-io/iso20022/pkg/storage"
+	"github.com/moov-io/iso20022/pkg/utils"
+)
+
+var testKey = bytes.Repeat([]byte{7}, KeySize)
+
+func newPseudonymizer(t *testing.T, store storage.Store, key []byte) *Pseudonymizer {
+	t.Helper()
+	vault, err := NewStoreVault(store, key)
+	require.Nil(t, err)
+	p, err := New(key, vault)
+	require.Nil(t, err)
+	return p
+}
+
+func values(doc document.Iso20022Document, name string) []string {
+	return utils.FindElementValues(doc.InspectMessage(), name)
+}
+
+func TestPseudonymize(t *testing.T) {
+	ctx := context.Background()
+	store := storage.NewMemoryStore()
+	p := newPseudonymizer(t, store, testKey)
+
+	doc := fixtures.Document(t, "valid_camt053_v08.xml")
+	ibans := values(doc, "IBAN")
+	require.Len(t, ibans, 3)
+
+	pseudonymized, paths, err := p.Pseudonymize(ctx, doc)
+	require.Nil(t, err)
+	require.NotEmpty(t, paths)
+	require.Equal(t, ibans, values(doc, "IBAN"), "doc is kept intact")
+
+	// IBAN tokens are valid IBANs of the same country and length
+	tokens := values(pseudonymized, "IBAN")
+	for i, token := range tokens {
+		require.NotEqual(t, ibans[i], token)
+		require.Equal(t, ibans[i][:2], token[:2])
+		require.Len(t, token, len(ibans[i]))
+		require.Equal(t, 1, mod97(token[4:]+token[:4]))
+	}
+	require.Equal(t, tokens[0], tokens[2])
+	require.Nil(t, pseudonymized.Validate())
+
+	// tokens are consistent across documents and elements, and differ by key
+	again, _, err := p.Pseudonymize(ctx, fixtures.Document(t, "valid_camt053_v08.xml"))
+	require.Nil(t, err)
+	require.Equal(t, tokens, values(again, "IBAN"))
+	require.Equal(t, p.Token("Nm", "John Smith"), p.Token("Ustrd", "John Smith"))
+	other := newPseudonymizer(t, storage.NewMemoryStore(), bytes.Repeat([]byte{8}, KeySize))
+	require.NotEqual(t, p.Token("IBAN", ibans[0]), other.Token("IBAN", ibans[0]))
+
+	// pseudonymized documents aren't pseudonymized twice
+	twice, paths, err := p.Pseudonymize(ctx, pseudonymized)
+	require.Nil(t, err)
+	require.Empty(t, paths)
+	require.Equal(t, tokens, values(twice, "IBAN"))
+
+	// the store holds neither values nor tokens
+	records, err := store.List(ctx)
+	require.Nil(t, err)
+	require.NotEmpty(t, records)
+	for _, rec := range records {
+		require.NotContains(t, string(rec.Content), ibans[0])
+		require.NotContains(t, rec.ID, tokens[0])
+	}
+
+	reidentified, paths, err := p.Reidentify(ctx, pseudonymized)
+	require.Nil(t, err)
+	require.Len(t, paths, 3)
+	require.Equal(t, ibans, values(reidentified, "IBAN"))
+
+	// the vault of another key can't restore the values
+	_, _, err = newPseudonymizer(t, store, bytes.Repeat([]byte{8}, KeySize)).Reidentify(ctx, pseudonymized)
+	require.ErrorIs(t, err, storage.ErrDecryption)
+
+	// tokens unknown to the vault are kept
+	kept, paths, err := other.Reidentify(ctx, pseudonymized)
+	require.Nil(t, err)
+	require.Empty(t, paths)
+	require.Equal(t, tokens, values(kept, "IBAN"))
+}
+
+func TestPseudonymizeText(t *testing.T) {
+	ctx := context.Background()
+	p := newPseudonymizer(t, storage.NewMemoryStore(), testKey)
+
+	doc := fixtures.Document(t, "valid_pacs_v08.xml")
+	pseudonymized, paths, err := p.Pseudonymize(ctx, doc)
+	require.Nil(t, err)
+	require.Len(t, paths, 5)
+	names := values(pseudonymized, "Nm")
+	for _, name := range append(names, values(pseudonymized, "Ustrd")...) {
+		require.True(t, strings.HasPrefix(name, TokenPrefix), name)
+	}
+	// the debtor of both transactions keeps a single token
+	require.Equal(t, names[0], names[2])
+	require.NotEqual(t, names[0], names[1])
+	require.Nil(t, pseudonymized.Validate())
+
+	reidentified, _, err := p.Reidentify(ctx, pseudonymized)
+	require.Nil(t, err)
+	require.Equal(t, []string{"John Smith", "Jane Doe", "John Smith", "Max Mustermann"}, values(reidentified, "Nm"))
+	require.Equal(t, []string{"Invoice 2021-0042"}, values(reidentified, "Ustrd"))
+}
+
+func TestVault(t *testing.T) {
+	ctx := context.Background()
+	vault, err := NewStoreVault(storage.NewMemoryStore(), testKey)
+	require.Nil(t, err)
+
+	require.Nil(t, vault.Put(ctx, "PSN-1", "John Smith"))
+	require.Nil(t, vault.Put(ctx, "PSN-1", "John Smith"))
+	require.ErrorIs(t, vault.Put(ctx, "PSN-1", "Jane Doe"), ErrTokenCollision)
+	value, err := vault.Get(ctx, "PSN-1")
+	require.Nil(t, err)
+	require.Equal(t, "John Smith", value)
+	_, err = vault.Get(ctx, "PSN-2")
+	require.ErrorIs(t, err, ErrUnknownToken)
+
+	_, err = NewStoreVault(storage.NewMemoryStore(), testKey[:16])
+	require.ErrorContains(t, err, "vault key has 16 bytes instead of 32")
+	_, err = New(testKey[:16], vault)
+	require.ErrorContains(t, err, "pseudonymization key has 16 bytes instead of 32")
+	_, err = New(testKey, nil)
+	require.ErrorContains(t, err, "vault is missing")
+}
@@ -0,0 +1,107 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package pseudonym
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/moov-io/iso20022/pkg/storage"
+)
+
+// recordKind is the kind of the records of the values of tokens
+const recordKind = "pseudonym"
+
+// Vault keeps the values of tokens
+type Vault interface {
+	// Put keeps value as the value of token, ErrTokenCollision when token has another value
+	Put(ctx context.Context, token, value string) error
+
+	// Get returns the value of token or ErrUnknownToken
+	Get(ctx context.Context, token string) (string, error)
+}
+
+// StoreVault keeps the values of tokens encrypted with AES-256-GCM in a store, records are
+// identified by a digest of their token so the store holds neither values nor tokens
+type StoreVault struct {
+	store storage.Store
+	aead  cipher.AEAD
+	now   func() time.Time
+}
+
+// NewStoreVault returns a vault keeping the values of tokens in store, encrypted with a key
+// derived from key, KeySize bytes
+func NewStoreVault(store storage.Store, key []byte) (*StoreVault, error) {
+	if len(key) != KeySize {
+		return nil, fmt.Errorf("vault key has %d bytes instead of %d", len(key), KeySize)
+	}
+	block, err := aes.NewCipher(derive(key, "vault"))
+	if err != nil {
+		return nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	return &StoreVault{store: store, aead: aead, now: time.Now}, nil
+}
+
+func (v *StoreVault) Put(ctx context.Context, token, value string) error {
+	existing, err := v.Get(ctx, token)
+	if err == nil {
+		if existing != value {
+			return fmt.Errorf("%w: %s", ErrTokenCollision, token)
+		}
+		return nil
+	}
+	if !errors.Is(err, ErrUnknownToken) {
+		return err
+	}
+
+	nonce := make([]byte, v.aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return err
+	}
+	now := v.now().UTC()
+	return v.store.Put(ctx, storage.Record{
+		ID:      recordID(token),
+		Kind:    recordKind,
+		Content: v.aead.Seal(nonce, nonce, []byte(value), []byte(token)),
+		Created: now,
+		Updated: now,
+	})
+}
+
+func (v *StoreVault) Get(ctx context.Context, token string) (string, error) {
+	rec, err := v.store.Get(ctx, recordID(token))
+	if errors.Is(err, storage.ErrNotFound) {
+		return "", fmt.Errorf("%w: %s", ErrUnknownToken, token)
+	}
+	if err != nil {
+		return "", err
+	}
+	if len(rec.Content) < v.aead.NonceSize() {
+		return "", fmt.Errorf("%w: value of %s is too short", storage.ErrDecryption, token)
+	}
+	size := v.aead.NonceSize()
+	value, err := v.aead.Open(nil, rec.Content[:size], rec.Content[size:], []byte(token))
+	if err != nil {
+		return "", fmt.Errorf("%w: value of %s: %v", storage.ErrDecryption, token, err)
+	}
+	return string(value), nil
+}
+
+func recordID(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
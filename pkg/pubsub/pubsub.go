@@ -0,0 +1,394 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package pubsub
+
+/*
+	Pubsub reads the messages of pipelines from Google Cloud Pub/Sub subscriptions and
+	publishes processed messages to topics, with at-least-once semantics:
+
+		client := pubsub.New("my-project", pubsub.MetadataToken())
+		subscription := client.Subscription("inbound-processor", 100)
+		topic := client.Topic("processed")
+		p := pipeline.New(subscription.Source(), subscription.Sink(topic.Sink(nil)),
+			pipeline.WithErrorSink(subscription.ErrorSink(nil)))
+
+	Messages are pulled in batches. A message is acknowledged after the sink processed it,
+	or after the error sink handled it when it failed parsing or validation, and negatively
+	acknowledged for its redelivery when the sink fails. Messages interrupted by a crash are
+	redelivered once their ack deadline passed, sinks should tolerate repetitions.
+
+	Published messages carry the headers of their item as attributes and a messageType
+	attribute, e.g. pacs.008.001.08, for the filters of subscriptions. The client talks to
+	the emulator of PUBSUB_EMULATOR_HOST when it's set.
+*/
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/moov-io/iso20022/pkg/pipeline"
+	"github.com/moov-io/iso20022/pkg/priority"
+	"github.com/moov-io/iso20022/pkg/resilience"
+	"github.com/moov-io/iso20022/pkg/utils"
+)
+
+const (
+	// DefaultEndpoint is the endpoint of the Pub/Sub api
+	DefaultEndpoint = "https://pubsub.googleapis.com"
+
+	// HeaderAckID and HeaderMessageID are the headers of the items emitted by a subscription source
+	HeaderAckID     = "x-pubsub-ack-id"
+	HeaderMessageID = "x-pubsub-message-id"
+
+	// AttributeMessageType and AttributePriority are the attributes of the message type and
+	// processing priority of published messages
+	AttributeMessageType = "messageType"
+	AttributePriority    = "priority"
+
+	// DefaultBatch is the batch of messages pulled at once unless configured
+	DefaultBatch = 100
+
+	metadataTokenURL = "http://metadata.google.internal/computeMetadata/v1/instance/service-accounts/default/token"
+	httpTimeout      = 90 * time.Second
+)
+
+// TokenSource returns the OAuth 2.0 access token authorizing requests
+type TokenSource func(ctx context.Context) (string, error)
+
+// StaticToken returns a source of token, e.g. of `gcloud auth print-access-token`
+func StaticToken(token string) TokenSource {
+	return func(context.Context) (string, error) {
+		return token, nil
+	}
+}
+
+// MetadataToken returns a source of the tokens of the service account of the instance given by
+// the metadata server of Compute Engine, GKE and Cloud Run. Tokens are cached until shortly
+// before they expire. Calls are retried as the gce-metadata integration like in New.
+func MetadataToken() TokenSource {
+	var (
+		mu      sync.Mutex
+		token   string
+		expires time.Time
+	)
+	client := newClient("gce-metadata", 10*time.Second)
+	return func(ctx context.Context) (string, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		if token != "" && time.Now().Before(expires) {
+			return token, nil
+		}
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, metadataTokenURL, nil)
+		if err != nil {
+			return "", err
+		}
+		req.Header.Set("Metadata-Flavor", "Google")
+		resp, err := client.Do(req)
+		if err != nil {
+			return "", fmt.Errorf("metadata token: %w", err)
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			return "", fmt.Errorf("metadata token: %s", resp.Status)
+		}
+		var body struct {
+			AccessToken string `json:"access_token"`
+			ExpiresIn   int    `json:"expires_in"`
+		}
+		if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+			return "", fmt.Errorf("metadata token: %w", err)
+		}
+		token, expires = body.AccessToken, time.Now().Add(time.Duration(body.ExpiresIn)*time.Second-time.Minute)
+		return token, nil
+	}
+}
+
+// Error is an error of the Pub/Sub api
+type Error struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+	Status  string `json:"status"`
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("%s: %s (%d)", e.Status, e.Message, e.Code)
+}
+
+// Client calls the Pub/Sub api of a project
+type Client struct {
+	Project string
+
+	// Endpoint is the endpoint of the api, DefaultEndpoint or the emulator of PUBSUB_EMULATOR_HOST
+	Endpoint string
+
+	Token      TokenSource
+	HTTPClient *http.Client
+}
+
+// New returns a client of the api of project authorized by the tokens of token, nil for the
+// emulator. Calls are guarded as the pubsub/<project> integration.
+func New(project string, token TokenSource) *Client {
+	endpoint := DefaultEndpoint
+	if host := os.Getenv("PUBSUB_EMULATOR_HOST"); host != "" {
+		endpoint = "http://" + host
+	}
+	return &Client{
+		Project:    project,
+		Endpoint:   endpoint,
+		Token:      token,
+		HTTPClient: newClient("pubsub/"+project, httpTimeout),
+	}
+}
+
+// newClient returns a client retrying the calls of the integration name with
+// resilience.DefaultPolicy, its guard is registered so its metrics are listed by resilience.Snapshot
+func newClient(name string, timeout time.Duration) *http.Client {
+	guard := resilience.New(name, resilience.DefaultPolicy)
+	resilience.Register(guard)
+	return guard.Client(&http.Client{Timeout: timeout})
+}
+
+// call posts the json of in to the method of resource, e.g. subscriptions/inbound:pull, and
+// decodes the response into out
+func (c *Client) call(ctx context.Context, resource string, in, out interface{}) error {
+	body, err := json.Marshal(in)
+	if err != nil {
+		return err
+	}
+	url := strings.TrimSuffix(c.Endpoint, "/") + "/v1/projects/" + c.Project + "/" + resource
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if c.Token != nil {
+		token, err := c.Token(ctx)
+		if err != nil {
+			return err
+		}
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("pubsub %s: %w", resource, err)
+	}
+	defer resp.Body.Close()
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		var envelope struct {
+			Error *Error `json:"error"`
+		}
+		if json.Unmarshal(data, &envelope) != nil || envelope.Error == nil {
+			envelope.Error = &Error{Code: resp.StatusCode, Status: http.StatusText(resp.StatusCode), Message: strings.TrimSpace(string(data))}
+		}
+		return fmt.Errorf("pubsub %s: %w", resource, envelope.Error)
+	}
+	if out == nil {
+		return nil
+	}
+	return json.Unmarshal(data, out)
+}
+
+// Message is a message of a topic
+type Message struct {
+	ID string `json:"messageId,omitempty"`
+
+	// Data is encoded in base64 by the api
+	Data       []byte            `json:"data"`
+	Attributes map[string]string `json:"attributes,omitempty"`
+
+	// AckID acknowledges the pulled message, DeliveryAttempt counts its deliveries when the
+	// subscription has a dead letter policy
+	AckID           string `json:"-"`
+	DeliveryAttempt int    `json:"-"`
+}
+
+// Subscription is a subscription of a topic
+type Subscription struct {
+	client *Client
+	name   string
+	batch  int
+}
+
+// Subscription returns the subscription name pulling batch messages at once, DefaultBatch
+// when batch isn't positive
+func (c *Client) Subscription(name string, batch int) *Subscription {
+	if batch <= 0 {
+		batch = DefaultBatch
+	}
+	return &Subscription{client: c, name: name, batch: batch}
+}
+
+// Pull returns the next batch of messages of the subscription, waiting for it. It returns no
+// message when none arrived meanwhile.
+func (s *Subscription) Pull(ctx context.Context) ([]Message, error) {
+	var resp struct {
+		ReceivedMessages []struct {
+			AckID           string  `json:"ackId"`
+			Message         Message `json:"message"`
+			DeliveryAttempt int     `json:"deliveryAttempt"`
+		} `json:"receivedMessages"`
+	}
+	if err := s.client.call(ctx, "subscriptions/"+s.name+":pull", map[string]int{"maxMessages": s.batch}, &resp); err != nil {
+		return nil, err
+	}
+	messages := make([]Message, 0, len(resp.ReceivedMessages))
+	for _, received := range resp.ReceivedMessages {
+		msg := received.Message
+		msg.AckID, msg.DeliveryAttempt = received.AckID, received.DeliveryAttempt
+		messages = append(messages, msg)
+	}
+	return messages, nil
+}
+
+// Acknowledge acknowledges the messages of ackIDs
+func (s *Subscription) Acknowledge(ctx context.Context, ackIDs ...string) error {
+	return s.client.call(ctx, "subscriptions/"+s.name+":acknowledge", map[string][]string{"ackIds": ackIDs}, nil)
+}
+
+// Nack negatively acknowledges the messages of ackIDs, for their immediate redelivery
+func (s *Subscription) Nack(ctx context.Context, ackIDs ...string) error {
+	return s.client.call(ctx, "subscriptions/"+s.name+":modifyAckDeadline", map[string]interface{}{
+		"ackIds":             ackIDs,
+		"ackDeadlineSeconds": 0,
+	}, nil)
+}
+
+// Topic is a topic of the project
+type Topic struct {
+	client *Client
+	name   string
+}
+
+// Topic returns the topic name
+func (c *Client) Topic(name string) *Topic {
+	return &Topic{client: c, name: name}
+}
+
+// Publish publishes messages to the topic and returns their ids
+func (t *Topic) Publish(ctx context.Context, messages ...Message) ([]string, error) {
+	var resp struct {
+		MessageIDs []string `json:"messageIds"`
+	}
+	err := t.client.call(ctx, "topics/"+t.name+":publish", map[string][]Message{"messages": messages}, &resp)
+	return resp.MessageIDs, err
+}
+
+// Source emits the messages of the subscription until ctx is done, named by their message id
+func (s *Subscription) Source() pipeline.Source {
+	return func(ctx context.Context, emit func(pipeline.Item) error) error {
+		for ctx.Err() == nil {
+			messages, err := s.Pull(ctx)
+			if err != nil {
+				if ctx.Err() != nil {
+					break
+				}
+				return err
+			}
+			for i, msg := range messages {
+				headers := map[string]string{HeaderMessageID: msg.ID, HeaderAckID: msg.AckID}
+				for key, value := range msg.Attributes {
+					if headers[key] == "" {
+						headers[key] = value
+					}
+				}
+				if err := emit(pipeline.Item{Name: msg.ID, Input: msg.Data, Headers: headers}); err != nil {
+					ackIDs := make([]string, 0, len(messages)-i)
+					for _, rest := range messages[i:] {
+						ackIDs = append(ackIDs, rest.AckID)
+					}
+					s.Nack(context.Background(), ackIDs...)
+					return err
+				}
+			}
+		}
+		return ctx.Err()
+	}
+}
+
+func ackID(item pipeline.Item) (string, error) {
+	id := item.Headers[HeaderAckID]
+	if id == "" {
+		return "", fmt.Errorf("pubsub: item %s wasn't pulled from a subscription", item.Name)
+	}
+	return id, nil
+}
+
+// Sink acknowledges the items processed by sink, items failing sink are negatively
+// acknowledged for their redelivery, even when ctx is done
+func (s *Subscription) Sink(sink pipeline.Sink) pipeline.Sink {
+	return func(ctx context.Context, item pipeline.Item) error {
+		id, err := ackID(item)
+		if err != nil {
+			return err
+		}
+		if err := sink(ctx, item); err != nil {
+			s.Nack(context.Background(), id)
+			return err
+		}
+		return s.Acknowledge(ctx, id)
+	}
+}
+
+// ErrorSink routes the failed items to sink, if not nil, and acknowledges them. Items failing
+// sink are negatively acknowledged for their redelivery.
+func (s *Subscription) ErrorSink(sink pipeline.Sink) pipeline.Sink {
+	return func(ctx context.Context, item pipeline.Item) error {
+		id, err := ackID(item)
+		if err != nil {
+			return err
+		}
+		if sink != nil {
+			if err := sink(ctx, item); err != nil {
+				s.Nack(context.Background(), id)
+				return err
+			}
+		}
+		return s.Acknowledge(ctx, id)
+	}
+}
+
+// Sink publishes the input of the processed items to the topic and routes them to sink, if not nil
+func (t *Topic) Sink(sink pipeline.Sink) pipeline.Sink {
+	return func(ctx context.Context, item pipeline.Item) error {
+		if _, err := t.Publish(ctx, Message{Data: item.Input, Attributes: Attributes(item)}); err != nil {
+			return err
+		}
+		if sink != nil {
+			return sink(ctx, item)
+		}
+		return nil
+	}
+}
+
+// Attributes returns the attributes of item, its message type, priority and headers, the
+// headers of subscription sources excluded
+func Attributes(item pipeline.Item) map[string]string {
+	attributes := make(map[string]string)
+	for key, value := range item.Headers {
+		if value != "" && !strings.HasPrefix(key, "x-pubsub-") {
+			attributes[key] = value
+		}
+	}
+	if item.Document != nil {
+		if kind := utils.GetMessageType(item.Document.NameSpace()); kind != "" {
+			attributes[AttributeMessageType] = kind
+		}
+		attributes[AttributePriority] = string(priority.OfDocument(item.Document).Priority)
+	}
+	return attributes
+}
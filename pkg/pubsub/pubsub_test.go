@@ -0,0 +1,169 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package pubsub
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/moov-io/iso20022/pkg/iso20022test/fixtures"
+	"github.com/moov-io/iso20022/pkg/pipeline"
+)
+
+// fakePubSub serves the pull, acknowledge, modifyAckDeadline and publish methods of the api
+type fakePubSub struct {
+	mu        sync.Mutex
+	pending   []Message
+	batches   []int
+	acked     []string
+	nacked    []string
+	published map[string][]Message
+}
+
+func (f *fakePubSub) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Header.Get("Authorization") != "Bearer secret" {
+		w.WriteHeader(http.StatusUnauthorized)
+		w.Write([]byte(`{"error":{"code":401,"message":"Request had invalid authentication credentials.","status":"UNAUTHENTICATED"}}`))
+		return
+	}
+	var body struct {
+		MaxMessages int       `json:"maxMessages"`
+		AckIDs      []string  `json:"ackIds"`
+		Messages    []Message `json:"messages"`
+	}
+	json.NewDecoder(r.Body).Decode(&body)
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	resource := strings.TrimPrefix(r.URL.Path, "/v1/projects/moov/")
+	switch {
+	case strings.HasSuffix(resource, ":pull"):
+		f.batches = append(f.batches, body.MaxMessages)
+		n := body.MaxMessages
+		if n > len(f.pending) {
+			n = len(f.pending)
+		}
+		var received []map[string]interface{}
+		for _, msg := range f.pending[:n] {
+			received = append(received, map[string]interface{}{"ackId": "ack-" + msg.ID, "message": msg})
+		}
+		f.pending = f.pending[n:]
+		if n == 0 {
+			time.Sleep(5 * time.Millisecond)
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{"receivedMessages": received})
+	case strings.HasSuffix(resource, ":acknowledge"):
+		f.acked = append(f.acked, body.AckIDs...)
+		w.Write([]byte("{}"))
+	case strings.HasSuffix(resource, ":modifyAckDeadline"):
+		f.nacked = append(f.nacked, body.AckIDs...)
+		w.Write([]byte("{}"))
+	case strings.HasPrefix(resource, "topics/processed:publish"):
+		f.published["processed"] = append(f.published["processed"], body.Messages...)
+		json.NewEncoder(w).Encode(map[string][]string{"messageIds": {"published-1"}})
+	default:
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"error":{"code":404,"message":"Resource not found","status":"NOT_FOUND"}}`))
+	}
+}
+
+func newTestClient(t *testing.T, f *fakePubSub) *Client {
+	t.Helper()
+	server := httptest.NewServer(f)
+	t.Cleanup(server.Close)
+	client := New("moov", StaticToken("secret"))
+	client.Endpoint = server.URL
+	return client
+}
+
+func TestPublishAndPull(t *testing.T) {
+	ctx := context.Background()
+	f := &fakePubSub{published: make(map[string][]Message)}
+	client := newTestClient(t, f)
+
+	ids, err := client.Topic("processed").Publish(ctx, Message{Data: []byte("<Document/>"), Attributes: map[string]string{"source": "test"}})
+	require.Nil(t, err)
+	require.Equal(t, []string{"published-1"}, ids)
+	require.Equal(t, []byte("<Document/>"), f.published["processed"][0].Data)
+
+	_, err = client.Topic("unknown").Publish(ctx, Message{Data: []byte("<Document/>")})
+	var apiErr *Error
+	require.True(t, errors.As(err, &apiErr))
+	require.Equal(t, "NOT_FOUND", apiErr.Status)
+
+	f.pending = []Message{{ID: "1", Data: []byte("a")}, {ID: "2", Data: []byte("b")}, {ID: "3", Data: []byte("c")}}
+	subscription := client.Subscription("inbound", 2)
+	messages, err := subscription.Pull(ctx)
+	require.Nil(t, err)
+	require.Len(t, messages, 2)
+	require.Equal(t, "ack-1", messages[0].AckID)
+	require.Equal(t, []byte("a"), messages[0].Data)
+	require.Equal(t, []int{2}, f.batches)
+
+	require.Nil(t, subscription.Acknowledge(ctx, "ack-1"))
+	require.Nil(t, subscription.Nack(ctx, "ack-2"))
+	require.Equal(t, []string{"ack-1"}, f.acked)
+	require.Equal(t, []string{"ack-2"}, f.nacked)
+
+	client.Token = StaticToken("expired")
+	_, err = subscription.Pull(ctx)
+	require.ErrorContains(t, err, "UNAUTHENTICATED")
+}
+
+func TestPipeline(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	f := &fakePubSub{published: make(map[string][]Message)}
+	f.pending = []Message{
+		{ID: "valid", Data: fixtures.ReadFile(t, "valid_pacs_v08.xml"), Attributes: map[string]string{"source": "bank-a"}},
+		{ID: "invalid", Data: []byte("<Document><Unknown/></Document>")},
+		{ID: "failing", Data: fixtures.ReadFile(t, "valid_camt053_v08.xml")},
+	}
+	client := newTestClient(t, f)
+	subscription := client.Subscription("inbound", 0)
+
+	var done atomic.Int64
+	finish := func() {
+		if done.Add(1) == 3 {
+			cancel()
+		}
+	}
+	publish := client.Topic("processed").Sink(nil)
+	sink := subscription.Sink(func(ctx context.Context, item pipeline.Item) error {
+		defer finish()
+		if item.Name == "failing" {
+			return errors.New("ledger unavailable")
+		}
+		return publish(ctx, item)
+	})
+	errorSink := subscription.ErrorSink(func(context.Context, pipeline.Item) error {
+		finish()
+		return nil
+	})
+
+	_, err := pipeline.New(subscription.Source(), sink, pipeline.WithWorkers(1), pipeline.WithErrorSink(errorSink)).Run(ctx)
+	require.ErrorContains(t, err, "ledger unavailable")
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	require.Equal(t, DefaultBatch, f.batches[0])
+	require.Equal(t, []string{"ack-valid", "ack-invalid"}, f.acked)
+	require.Equal(t, []string{"ack-failing"}, f.nacked)
+
+	published := f.published["processed"]
+	require.Len(t, published, 1)
+	require.Equal(t, map[string]string{"source": "bank-a", AttributeMessageType: "pacs.008.001.08", AttributePriority: "URGENT"}, published[0].Attributes)
+}
@@ -0,0 +1,56 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package reasons
+
+// codes returns the codes of a reason in the ISO, SEPA, MT and FedNow schemes, in this order.
+// Empty codes translate to the fallback of their scheme.
+func codes(iso, sepa, mt, fednow string) map[Scheme]string {
+	codes := make(map[Scheme]string)
+	for scheme, code := range map[Scheme]string{SchemeISO: iso, SchemeSEPA: sepa, SchemeMT: mt, SchemeFedNow: fednow} {
+		if code != "" {
+			codes[scheme] = code
+		}
+	}
+	return codes
+}
+
+// DefaultTable holds the reasons of rejections and returns common to the schemes, reasons of
+// a scheme sharing a code are listed from the most to the least specific
+var DefaultTable = &Table{
+	Name:    DefaultTableName,
+	Version: "2021-06",
+	Fallbacks: map[Scheme]string{
+		SchemeISO:    "NARR",
+		SchemeSEPA:   "MS03",
+		SchemeMT:     "NARR",
+		SchemeFedNow: "NARR",
+	},
+	Reasons: []Reason{
+		{Description: "Incorrect account number", Codes: codes("AC01", "AC01", "AC01", "AC01")},
+		{Description: "Invalid creditor account number", Codes: codes("AC03", "AC01", "AC01", "AC03")},
+		{Description: "Closed account number", Codes: codes("AC04", "AC04", "AC04", "AC04")},
+		{Description: "Blocked account", Codes: codes("AC06", "AC06", "AC06", "AC06")},
+		{Description: "Transaction forbidden", Codes: codes("AG01", "AG01", "AG01", "AG01")},
+		{Description: "Invalid bank operation code", Codes: codes("AG02", "AG02", "AG02", "")},
+		{Description: "Not allowed amount", Codes: codes("AM02", "", "AM02", "AM02")},
+		{Description: "Not allowed currency", Codes: codes("AM03", "", "AM03", "AM03")},
+		{Description: "Insufficient funds", Codes: codes("AM04", "AM04", "AM04", "AM04")},
+		{Description: "Duplication", Codes: codes("AM05", "AM05", "AM05", "AM05")},
+		{Description: "Inconsistent with end customer", Codes: codes("BE01", "", "BE01", "BE01")},
+		{Description: "Invalid date", Codes: codes("DT01", "DT01", "DT01", "DT01")},
+		{Description: "Invalid file format", Codes: codes("FF01", "FF01", "FF01", "FF01")},
+		{Description: "Element content formally incorrect", Codes: codes("CH16", "FF01", "FF01", "CH16")},
+		{Description: "Element not admitted", Codes: codes("CH17", "FF01", "FF01", "CH17")},
+		{Description: "End customer deceased", Codes: codes("MD07", "MD07", "MD07", "")},
+		{Description: "Not specified reason customer generated", Codes: codes("MS02", "MS02", "MS02", "MS02")},
+		{Description: "Not specified reason agent generated", Codes: codes("MS03", "MS03", "MS03", "MS03")},
+		{Description: "Bank identifier incorrect", Codes: codes("RC01", "RC01", "RC01", "RC01")},
+		{Description: "Regulatory reason", Codes: codes("RR04", "RR04", "RR04", "RR04")},
+		{Description: "Timeout at the creditor agent", Codes: codes("AB05", "AB05", "", "AB05")},
+		{Description: "Timeout at the instructed agent", Codes: codes("AB06", "AB06", "", "AB06")},
+		{Description: "Cut off time", Codes: codes("TM01", "TM01", "TM01", "")},
+		{Description: "Narrative", Codes: codes("NARR", "", "NARR", "NARR")},
+	},
+}
@@ -0,0 +1,267 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package reasons
+
+/*
+	Reasons translates the reason and return codes of status reports, returns and cancellations
+	between the conventions of schemes: the ISO 20022 external code sets, the subset of the SEPA
+	rulebooks, the codes of field 72 of MT reject and return messages and the codes of FedNow.
+	Tables hold the codes of a reason in every scheme, they're defined in yaml:
+
+		name: default
+		version: "2021-06"
+		fallbacks:
+		  sepa: MS03
+		reasons:
+		  - description: Closed account number
+		    codes: {iso: AC04, sepa: AC04, mt: AC04, fednow: AC04}
+
+	A reason without a code in a scheme translates to the fallback of the scheme. The first
+	reason of a table holding a code of the source scheme gives its translation.
+*/
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/moov-io/iso20022/pkg/document"
+	"github.com/moov-io/iso20022/pkg/utils"
+)
+
+// Scheme is a convention of reason codes
+type Scheme string
+
+const (
+	SchemeISO    Scheme = "iso"
+	SchemeSEPA   Scheme = "sepa"
+	SchemeMT     Scheme = "mt"
+	SchemeFedNow Scheme = "fednow"
+)
+
+// DefaultTableName is the name of the table translating codes unless another one is named
+const DefaultTableName = "default"
+
+var (
+	// Schemes are the conventions tables translate between
+	Schemes = []Scheme{SchemeISO, SchemeSEPA, SchemeMT, SchemeFedNow}
+
+	// ErrUnknownScheme is returned for schemes that aren't one of Schemes
+	ErrUnknownScheme = errors.New("unknown reason scheme")
+
+	// ErrUnknownCode is returned for codes no reason of a table holds
+	ErrUnknownCode = errors.New("unknown reason code")
+
+	// ErrUnknownTable is returned when a table is not registered
+	ErrUnknownTable = errors.New("unknown reason table")
+)
+
+// ParseScheme returns the scheme named by value, ignoring case
+func ParseScheme(value string) (Scheme, error) {
+	scheme := Scheme(strings.ToLower(strings.TrimSpace(value)))
+	for _, s := range Schemes {
+		if s == scheme {
+			return s, nil
+		}
+	}
+	return "", fmt.Errorf("%w: %s", ErrUnknownScheme, value)
+}
+
+// Reason is a reason with its code in every scheme
+type Reason struct {
+	Description string            `yaml:"description" json:",omitempty"`
+	Codes       map[Scheme]string `yaml:"codes"`
+}
+
+// Table is a named set of reasons
+type Table struct {
+	Name      string            `yaml:"name"`
+	Version   string            `yaml:"version"`
+	Fallbacks map[Scheme]string `yaml:"fallbacks" json:",omitempty"`
+	Reasons   []Reason          `yaml:"reasons"`
+}
+
+// Lookup returns the first reason with code in scheme
+func (t *Table) Lookup(scheme Scheme, code string) (Reason, bool) {
+	if code == "" {
+		return Reason{}, false
+	}
+	for _, reason := range t.Reasons {
+		if reason.Codes[scheme] == code {
+			return reason, true
+		}
+	}
+	return Reason{}, false
+}
+
+// Translate returns the code in scheme to of the reason with code in scheme from
+func (t *Table) Translate(from, to Scheme, code string) (string, error) {
+	for _, scheme := range []Scheme{from, to} {
+		if _, err := ParseScheme(string(scheme)); err != nil {
+			return "", err
+		}
+	}
+	reason, found := t.Lookup(from, code)
+	if !found {
+		return "", fmt.Errorf("%w: %s code %s", ErrUnknownCode, from, code)
+	}
+	if translated := reason.Codes[to]; translated != "" {
+		return translated, nil
+	}
+	if fallback := t.Fallbacks[to]; fallback != "" {
+		return fallback, nil
+	}
+	return "", fmt.Errorf("%w: %s code %s has no %s code", ErrUnknownCode, from, code, to)
+}
+
+// Codes returns the codes in every scheme of the reason with code in scheme, codes the reason
+// hasn't are fallbacks
+func (t *Table) Codes(scheme Scheme, code string) (map[Scheme]string, error) {
+	if _, err := t.Translate(scheme, scheme, code); err != nil {
+		return nil, err
+	}
+	codes := make(map[Scheme]string, len(Schemes))
+	for _, to := range Schemes {
+		if translated, err := t.Translate(scheme, to, code); err == nil {
+			codes[to] = translated
+		}
+	}
+	return codes, nil
+}
+
+// TranslateDocument translates the reason codes of doc from scheme from to scheme to, the code
+// elements of the reasons of status reports (StsRsnInf), returns (RtrRsnInf), cancellations
+// (CxlRsnInf) and other reasons of the message
+func (t *Table) TranslateDocument(doc document.Iso20022Document, from, to Scheme) error {
+	var errs []string
+	utils.WalkElementValues(doc.InspectMessage(), func(path string, value reflect.Value) {
+		if !utils.MatchElementPath(path, "Rsn/Cd") || value.Kind() != reflect.String || !value.CanSet() {
+			return
+		}
+		translated, err := t.Translate(from, to, value.String())
+		if err != nil {
+			errs = append(errs, path+": "+err.Error())
+			return
+		}
+		value.SetString(translated)
+	})
+	if len(errs) > 0 {
+		return errors.New(strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+func (t *Table) validate() error {
+	if t.Name == "" {
+		return errors.New("reason table name is omitted")
+	}
+	for scheme := range t.Fallbacks {
+		if _, err := ParseScheme(string(scheme)); err != nil {
+			return err
+		}
+	}
+	for i, reason := range t.Reasons {
+		if len(reason.Codes) == 0 {
+			return fmt.Errorf("reason %d has no codes", i)
+		}
+		for scheme := range reason.Codes {
+			if _, err := ParseScheme(string(scheme)); err != nil {
+				return fmt.Errorf("reason %d: %w", i, err)
+			}
+		}
+	}
+	return nil
+}
+
+// ParseTable reads a table from yaml
+func ParseTable(buf []byte) (*Table, error) {
+	var t Table
+	if err := yaml.Unmarshal(buf, &t); err != nil {
+		return nil, err
+	}
+	if err := t.validate(); err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+// LoadTable reads and registers the table defined in a yaml file
+func LoadTable(path string) (*Table, error) {
+	buf, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	t, err := ParseTable(buf)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	RegisterTable(t)
+	return t, nil
+}
+
+var (
+	tablesMu sync.RWMutex
+	tables   = map[string]*Table{DefaultTableName: DefaultTable}
+)
+
+// RegisterTable adds t to the registry of tables, replacing any table with the same name
+func RegisterTable(t *Table) {
+	tablesMu.Lock()
+	defer tablesMu.Unlock()
+	tables[t.Name] = t
+}
+
+// UnregisterTable removes the table with name from the registry, the default table is
+// restored to DefaultTable
+func UnregisterTable(name string) {
+	tablesMu.Lock()
+	defer tablesMu.Unlock()
+	delete(tables, name)
+	if name == DefaultTableName {
+		tables[DefaultTableName] = DefaultTable
+	}
+}
+
+// GetTable returns the registered table with name, the default table when name is empty
+func GetTable(name string) (*Table, error) {
+	if name == "" {
+		name = DefaultTableName
+	}
+	tablesMu.RLock()
+	defer tablesMu.RUnlock()
+	t, exists := tables[name]
+	if !exists {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownTable, name)
+	}
+	return t, nil
+}
+
+// TableNames returns the names of every registered table in alphabetical order
+func TableNames() []string {
+	tablesMu.RLock()
+	defer tablesMu.RUnlock()
+	names := make([]string, 0, len(tables))
+	for name := range tables {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Translate returns the code in scheme to of the reason with code in scheme from by the
+// default table
+func Translate(from, to Scheme, code string) (string, error) {
+	t, err := GetTable(DefaultTableName)
+	if err != nil {
+		return "", err
+	}
+	return t.Translate(from, to, code)
+}
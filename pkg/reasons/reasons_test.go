@@ -0,0 +1,143 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package reasons
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/moov-io/iso20022/pkg/document"
+	"github.com/moov-io/iso20022/pkg/utils"
+)
+
+func TestTranslate(t *testing.T) {
+	code, err := Translate(SchemeISO, SchemeSEPA, "AC03")
+	require.Nil(t, err)
+	require.Equal(t, "AC01", code)
+
+	// the first reason holding the code translates it back
+	code, err = Translate(SchemeSEPA, SchemeISO, "AC01")
+	require.Nil(t, err)
+	require.Equal(t, "AC01", code)
+
+	// reasons without a code in the scheme translate to its fallback
+	code, err = Translate(SchemeISO, SchemeSEPA, "AM03")
+	require.Nil(t, err)
+	require.Equal(t, "MS03", code)
+
+	_, err = Translate(SchemeISO, SchemeMT, "ZZ99")
+	require.True(t, errors.Is(err, ErrUnknownCode))
+	require.EqualError(t, err, "unknown reason code: iso code ZZ99")
+	_, err = Translate(SchemeISO, "swift", "AC01")
+	require.True(t, errors.Is(err, ErrUnknownScheme))
+
+	table := &Table{Name: "test", Reasons: []Reason{{Codes: map[Scheme]string{SchemeISO: "AC01"}}}}
+	_, err = table.Translate(SchemeISO, SchemeMT, "AC01")
+	require.EqualError(t, err, "unknown reason code: iso code AC01 has no mt code")
+	_, err = table.Translate(SchemeMT, SchemeISO, "")
+	require.True(t, errors.Is(err, ErrUnknownCode))
+}
+
+func TestCodes(t *testing.T) {
+	codes, err := DefaultTable.Codes(SchemeISO, "CH16")
+	require.Nil(t, err)
+	require.Equal(t, map[Scheme]string{SchemeISO: "CH16", SchemeSEPA: "FF01", SchemeMT: "FF01", SchemeFedNow: "CH16"}, codes)
+
+	_, err = DefaultTable.Codes(SchemeFedNow, "ZZ99")
+	require.True(t, errors.Is(err, ErrUnknownCode))
+}
+
+func TestParseScheme(t *testing.T) {
+	scheme, err := ParseScheme(" FedNow ")
+	require.Nil(t, err)
+	require.Equal(t, SchemeFedNow, scheme)
+	_, err = ParseScheme("swift")
+	require.EqualError(t, err, "unknown reason scheme: swift")
+}
+
+func TestTranslateDocument(t *testing.T) {
+	doc, err := document.ParseIso20022Document([]byte(`<Document xmlns="urn:iso:std:iso:20022:tech:xsd:pacs.002.001.10">
+	<FIToFIPmtStsRpt>
+		<GrpHdr>
+			<MsgId>RJCT-0001</MsgId>
+			<CreDtTm>2021-04-15T10:31:00</CreDtTm>
+		</GrpHdr>
+		<TxInfAndSts>
+			<OrgnlEndToEndId>E2E-0001</OrgnlEndToEndId>
+			<TxSts>RJCT</TxSts>
+			<StsRsnInf>
+				<Rsn>
+					<Cd>AC03</Cd>
+				</Rsn>
+			</StsRsnInf>
+		</TxInfAndSts>
+		<TxInfAndSts>
+			<OrgnlEndToEndId>E2E-0002</OrgnlEndToEndId>
+			<TxSts>RJCT</TxSts>
+			<StsRsnInf>
+				<Rsn>
+					<Cd>CH16</Cd>
+				</Rsn>
+			</StsRsnInf>
+		</TxInfAndSts>
+	</FIToFIPmtStsRpt>
+</Document>`))
+	require.Nil(t, err)
+
+	require.Nil(t, DefaultTable.TranslateDocument(doc, SchemeISO, SchemeSEPA))
+	require.Equal(t, []string{"AC01", "FF01"}, utils.FindElementValues(doc.InspectMessage(), "StsRsnInf/Rsn/Cd"))
+	require.Equal(t, []string{"RJCT", "RJCT"}, utils.FindElementValues(doc.InspectMessage(), "TxSts"))
+
+	err = DefaultTable.TranslateDocument(doc, SchemeMT, SchemeISO)
+	require.Nil(t, err)
+	err = (&Table{Name: "empty"}).TranslateDocument(doc, SchemeISO, SchemeSEPA)
+	require.EqualError(t, err, "TxInfAndSts[0]/StsRsnInf[0]/Rsn/Cd: unknown reason code: iso code AC01; TxInfAndSts[1]/StsRsnInf[0]/Rsn/Cd: unknown reason code: iso code FF01")
+}
+
+func TestLoadTable(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "fednow.yml")
+	require.Nil(t, os.WriteFile(path, []byte(`name: TestFedNow
+version: "1"
+fallbacks:
+  fednow: "9999"
+reasons:
+  - description: Duplication
+    codes: {iso: AM05, fednow: DUPL}
+`), 0600))
+
+	table, err := LoadTable(path)
+	require.Nil(t, err)
+	t.Cleanup(func() { UnregisterTable("TestFedNow") })
+	require.Contains(t, TableNames(), "TestFedNow")
+	registered, err := GetTable("TestFedNow")
+	require.Nil(t, err)
+	require.Equal(t, table, registered)
+
+	code, err := table.Translate(SchemeISO, SchemeFedNow, "AM05")
+	require.Nil(t, err)
+	require.Equal(t, "DUPL", code)
+
+	UnregisterTable("TestFedNow")
+	_, err = GetTable("TestFedNow")
+	require.True(t, errors.Is(err, ErrUnknownTable))
+
+	// the default table is restored
+	RegisterTable(&Table{Name: DefaultTableName})
+	UnregisterTable(DefaultTableName)
+	registered, err = GetTable("")
+	require.Nil(t, err)
+	require.Equal(t, DefaultTable, registered)
+
+	for _, buf := range []string{"reasons: []", "name: x\nfallbacks: {swift: NARR}", "name: x\nreasons: [{codes: {}}]", "name: x\nreasons: [{codes: {swift: AC01}}]", "name: {"} {
+		_, err = ParseTable([]byte(buf))
+		require.NotNil(t, err, buf)
+	}
+	_, err = LoadTable(filepath.Join(t.TempDir(), "missing.yml"))
+	require.NotNil(t, err)
+}
@@ -0,0 +1,1812 @@
+// Code generated by deepcopy-gen. DO NOT EDIT.
+
+package reda_v01
+
+import (
+	"github.com/moov-io/iso20022/pkg/common"
+)
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ActivationHeader2) DeepCopy() *ActivationHeader2 {
+	if r == nil {
+		return nil
+	}
+	out := new(ActivationHeader2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ActivationHeader2) DeepCopyInto(out *ActivationHeader2) {
+	*out = *r
+	if r.MsgOrgtr != nil {
+		out.MsgOrgtr = new(RTPPartyIdentification1)
+		r.MsgOrgtr.DeepCopyInto(out.MsgOrgtr)
+	}
+	if r.MsgRcpt != nil {
+		out.MsgRcpt = new(RTPPartyIdentification1)
+		r.MsgRcpt.DeepCopyInto(out.MsgRcpt)
+	}
+	r.InitgPty.DeepCopyInto(&out.InitgPty)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ActivationStatus2) DeepCopy() *ActivationStatus2 {
+	if r == nil {
+		return nil
+	}
+	out := new(ActivationStatus2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ActivationStatus2) DeepCopyInto(out *ActivationStatus2) {
+	*out = *r
+	if r.OrgnlBizInstr != nil {
+		out.OrgnlBizInstr = new(OriginalBusinessInstruction1)
+		r.OrgnlBizInstr.DeepCopyInto(out.OrgnlBizInstr)
+	}
+	if r.StsRsn != nil {
+		out.StsRsn = new(DebtorActivationStatusReason2)
+		r.StsRsn.DeepCopyInto(out.StsRsn)
+	}
+	if r.OrgnlActvtnRef != nil {
+		out.OrgnlActvtnRef = new(OriginalActivation2Choice)
+		r.OrgnlActvtnRef.DeepCopyInto(out.OrgnlActvtnRef)
+	}
+	if r.FctvActvtnDt != nil {
+		out.FctvActvtnDt = new(DateAndDateTime2Choice)
+		*out.FctvActvtnDt = *r.FctvActvtnDt
+	}
+	if r.SplmtryData != nil {
+		out.SplmtryData = make([]SupplementaryData1, len(r.SplmtryData))
+		for i := range r.SplmtryData {
+			r.SplmtryData[i].DeepCopyInto(&out.SplmtryData[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *AddressType3Choice) DeepCopy() *AddressType3Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(AddressType3Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *AddressType3Choice) DeepCopyInto(out *AddressType3Choice) {
+	*out = *r
+	r.Prtry.DeepCopyInto(&out.Prtry)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *Contact4) DeepCopy() *Contact4 {
+	if r == nil {
+		return nil
+	}
+	out := new(Contact4)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *Contact4) DeepCopyInto(out *Contact4) {
+	*out = *r
+	if r.NmPrfx != nil {
+		out.NmPrfx = new(common.NamePrefix2Code)
+		*out.NmPrfx = *r.NmPrfx
+	}
+	if r.Nm != nil {
+		out.Nm = new(common.Max140Text)
+		*out.Nm = *r.Nm
+	}
+	if r.PhneNb != nil {
+		out.PhneNb = new(common.PhoneNumber)
+		*out.PhneNb = *r.PhneNb
+	}
+	if r.MobNb != nil {
+		out.MobNb = new(common.PhoneNumber)
+		*out.MobNb = *r.MobNb
+	}
+	if r.FaxNb != nil {
+		out.FaxNb = new(common.PhoneNumber)
+		*out.FaxNb = *r.FaxNb
+	}
+	if r.EmailAdr != nil {
+		out.EmailAdr = new(common.Max2048Text)
+		*out.EmailAdr = *r.EmailAdr
+	}
+	if r.EmailPurp != nil {
+		out.EmailPurp = new(common.Max35Text)
+		*out.EmailPurp = *r.EmailPurp
+	}
+	if r.JobTitl != nil {
+		out.JobTitl = new(common.Max35Text)
+		*out.JobTitl = *r.JobTitl
+	}
+	if r.Rspnsblty != nil {
+		out.Rspnsblty = new(common.Max35Text)
+		*out.Rspnsblty = *r.Rspnsblty
+	}
+	if r.Dept != nil {
+		out.Dept = new(common.Max70Text)
+		*out.Dept = *r.Dept
+	}
+	if r.Othr != nil {
+		out.Othr = make([]OtherContact1, len(r.Othr))
+		for i := range r.Othr {
+			r.Othr[i].DeepCopyInto(&out.Othr[i])
+		}
+	}
+	if r.PrefrdMtd != nil {
+		out.PrefrdMtd = new(PreferredContactMethod1Code)
+		*out.PrefrdMtd = *r.PrefrdMtd
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ContractReference1) DeepCopy() *ContractReference1 {
+	if r == nil {
+		return nil
+	}
+	out := new(ContractReference1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ContractReference1) DeepCopyInto(out *ContractReference1) {
+	*out = *r
+	if r.Tp != nil {
+		out.Tp = new(DocumentType1Choice)
+		r.Tp.DeepCopyInto(out.Tp)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CreditorEnrolment3) DeepCopy() *CreditorEnrolment3 {
+	if r == nil {
+		return nil
+	}
+	out := new(CreditorEnrolment3)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CreditorEnrolment3) DeepCopyInto(out *CreditorEnrolment3) {
+	*out = *r
+	r.Enrlmnt.DeepCopyInto(&out.Enrlmnt)
+	if r.CdtrTradgNm != nil {
+		out.CdtrTradgNm = new(common.Max140Text)
+		*out.CdtrTradgNm = *r.CdtrTradgNm
+	}
+	r.Cdtr.DeepCopyInto(&out.Cdtr)
+	if r.UltmtCdtr != nil {
+		out.UltmtCdtr = new(RTPPartyIdentification1)
+		r.UltmtCdtr.DeepCopyInto(out.UltmtCdtr)
+	}
+	if r.CdtrLogo != nil {
+		out.CdtrLogo = new(common.Max10KBinary)
+		*out.CdtrLogo = *r.CdtrLogo
+		if (*r.CdtrLogo) != nil {
+			(*out.CdtrLogo) = make(common.Max10KBinary, len((*r.CdtrLogo)))
+			copy((*out.CdtrLogo), (*r.CdtrLogo))
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CreditorEnrolment4) DeepCopy() *CreditorEnrolment4 {
+	if r == nil {
+		return nil
+	}
+	out := new(CreditorEnrolment4)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CreditorEnrolment4) DeepCopyInto(out *CreditorEnrolment4) {
+	*out = *r
+	if r.Enrlmnt != nil {
+		out.Enrlmnt = new(CreditorServiceEnrolment1)
+		r.Enrlmnt.DeepCopyInto(out.Enrlmnt)
+	}
+	if r.CdtrTradgNm != nil {
+		out.CdtrTradgNm = new(common.Max140Text)
+		*out.CdtrTradgNm = *r.CdtrTradgNm
+	}
+	if r.Cdtr != nil {
+		out.Cdtr = new(RTPPartyIdentification1)
+		r.Cdtr.DeepCopyInto(out.Cdtr)
+	}
+	if r.UltmtCdtr != nil {
+		out.UltmtCdtr = new(RTPPartyIdentification1)
+		r.UltmtCdtr.DeepCopyInto(out.UltmtCdtr)
+	}
+	if r.MrchntCtgyCd != nil {
+		out.MrchntCtgyCd = new(common.MerchantCategoryCodeIdentifier)
+		*out.MrchntCtgyCd = *r.MrchntCtgyCd
+	}
+	if r.CdtrLogo != nil {
+		out.CdtrLogo = new(common.Max10KBinary)
+		*out.CdtrLogo = *r.CdtrLogo
+		if (*r.CdtrLogo) != nil {
+			(*out.CdtrLogo) = make(common.Max10KBinary, len((*r.CdtrLogo)))
+			copy((*out.CdtrLogo), (*r.CdtrLogo))
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CreditorEnrolmentAmendment3) DeepCopy() *CreditorEnrolmentAmendment3 {
+	if r == nil {
+		return nil
+	}
+	out := new(CreditorEnrolmentAmendment3)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CreditorEnrolmentAmendment3) DeepCopyInto(out *CreditorEnrolmentAmendment3) {
+	*out = *r
+	if r.OrgnlBizInstr != nil {
+		out.OrgnlBizInstr = new(OriginalBusinessInstruction1)
+		r.OrgnlBizInstr.DeepCopyInto(out.OrgnlBizInstr)
+	}
+	if r.AmdmntRsn != nil {
+		out.AmdmntRsn = new(CreditorEnrolmentAmendmentReason2)
+		r.AmdmntRsn.DeepCopyInto(out.AmdmntRsn)
+	}
+	r.Amdmnt.DeepCopyInto(&out.Amdmnt)
+	r.OrgnlEnrlmnt.DeepCopyInto(&out.OrgnlEnrlmnt)
+	if r.SplmtryData != nil {
+		out.SplmtryData = make([]SupplementaryData1, len(r.SplmtryData))
+		for i := range r.SplmtryData {
+			r.SplmtryData[i].DeepCopyInto(&out.SplmtryData[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CreditorEnrolmentAmendment4) DeepCopy() *CreditorEnrolmentAmendment4 {
+	if r == nil {
+		return nil
+	}
+	out := new(CreditorEnrolmentAmendment4)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CreditorEnrolmentAmendment4) DeepCopyInto(out *CreditorEnrolmentAmendment4) {
+	*out = *r
+	if r.CdtrEnrlmnt != nil {
+		out.CdtrEnrlmnt = new(CreditorEnrolment4)
+		r.CdtrEnrlmnt.DeepCopyInto(out.CdtrEnrlmnt)
+	}
+	if r.ActvtnData != nil {
+		out.ActvtnData = new(CreditorInvoice4)
+		r.ActvtnData.DeepCopyInto(out.ActvtnData)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CreditorEnrolmentAmendmentReason1Choice) DeepCopy() *CreditorEnrolmentAmendmentReason1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(CreditorEnrolmentAmendmentReason1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CreditorEnrolmentAmendmentReason1Choice) DeepCopyInto(out *CreditorEnrolmentAmendmentReason1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CreditorEnrolmentAmendmentReason2) DeepCopy() *CreditorEnrolmentAmendmentReason2 {
+	if r == nil {
+		return nil
+	}
+	out := new(CreditorEnrolmentAmendmentReason2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CreditorEnrolmentAmendmentReason2) DeepCopyInto(out *CreditorEnrolmentAmendmentReason2) {
+	*out = *r
+	if r.Orgtr != nil {
+		out.Orgtr = new(RTPPartyIdentification1)
+		r.Orgtr.DeepCopyInto(out.Orgtr)
+	}
+	if r.AddtlInf != nil {
+		out.AddtlInf = make([]common.Max105Text, len(r.AddtlInf))
+		copy(out.AddtlInf, r.AddtlInf)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CreditorEnrolmentCancellation2) DeepCopy() *CreditorEnrolmentCancellation2 {
+	if r == nil {
+		return nil
+	}
+	out := new(CreditorEnrolmentCancellation2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CreditorEnrolmentCancellation2) DeepCopyInto(out *CreditorEnrolmentCancellation2) {
+	*out = *r
+	if r.OrgnlBizInstr != nil {
+		out.OrgnlBizInstr = new(OriginalBusinessInstruction1)
+		r.OrgnlBizInstr.DeepCopyInto(out.OrgnlBizInstr)
+	}
+	if r.CxlRsn != nil {
+		out.CxlRsn = new(CreditorEnrolmentCancellationReason2)
+		r.CxlRsn.DeepCopyInto(out.CxlRsn)
+	}
+	r.OrgnlEnrlmnt.DeepCopyInto(&out.OrgnlEnrlmnt)
+	if r.SplmtryData != nil {
+		out.SplmtryData = make([]SupplementaryData1, len(r.SplmtryData))
+		for i := range r.SplmtryData {
+			r.SplmtryData[i].DeepCopyInto(&out.SplmtryData[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CreditorEnrolmentCancellationReason1Choice) DeepCopy() *CreditorEnrolmentCancellationReason1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(CreditorEnrolmentCancellationReason1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CreditorEnrolmentCancellationReason1Choice) DeepCopyInto(out *CreditorEnrolmentCancellationReason1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CreditorEnrolmentCancellationReason2) DeepCopy() *CreditorEnrolmentCancellationReason2 {
+	if r == nil {
+		return nil
+	}
+	out := new(CreditorEnrolmentCancellationReason2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CreditorEnrolmentCancellationReason2) DeepCopyInto(out *CreditorEnrolmentCancellationReason2) {
+	*out = *r
+	if r.Orgtr != nil {
+		out.Orgtr = new(RTPPartyIdentification1)
+		r.Orgtr.DeepCopyInto(out.Orgtr)
+	}
+	if r.AddtlInf != nil {
+		out.AddtlInf = make([]common.Max105Text, len(r.AddtlInf))
+		copy(out.AddtlInf, r.AddtlInf)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CreditorEnrolmentStatusReason2) DeepCopy() *CreditorEnrolmentStatusReason2 {
+	if r == nil {
+		return nil
+	}
+	out := new(CreditorEnrolmentStatusReason2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CreditorEnrolmentStatusReason2) DeepCopyInto(out *CreditorEnrolmentStatusReason2) {
+	*out = *r
+	if r.Orgtr != nil {
+		out.Orgtr = new(RTPPartyIdentification1)
+		r.Orgtr.DeepCopyInto(out.Orgtr)
+	}
+	if r.AddtlInf != nil {
+		out.AddtlInf = make([]common.Max105Text, len(r.AddtlInf))
+		copy(out.AddtlInf, r.AddtlInf)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CreditorEnrolmentStatusReason2Choice) DeepCopy() *CreditorEnrolmentStatusReason2Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(CreditorEnrolmentStatusReason2Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CreditorEnrolmentStatusReason2Choice) DeepCopyInto(out *CreditorEnrolmentStatusReason2Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CreditorInvoice3) DeepCopy() *CreditorInvoice3 {
+	if r == nil {
+		return nil
+	}
+	out := new(CreditorInvoice3)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CreditorInvoice3) DeepCopyInto(out *CreditorInvoice3) {
+	*out = *r
+	if r.CstmrIdTp != nil {
+		out.CstmrIdTp = new(CustomerTypeRequest2)
+		r.CstmrIdTp.DeepCopyInto(out.CstmrIdTp)
+	}
+	if r.CtrctFrmtTp != nil {
+		out.CtrctFrmtTp = make([]DocumentFormat2Choice, len(r.CtrctFrmtTp))
+		for i := range r.CtrctFrmtTp {
+			r.CtrctFrmtTp[i].DeepCopyInto(&out.CtrctFrmtTp[i])
+		}
+	}
+	if r.CtrctRefTp != nil {
+		out.CtrctRefTp = make([]DocumentType1Choice, len(r.CtrctRefTp))
+		for i := range r.CtrctRefTp {
+			r.CtrctRefTp[i].DeepCopyInto(&out.CtrctRefTp[i])
+		}
+	}
+	if r.CdtrInstr != nil {
+		out.CdtrInstr = new(common.Max500Text)
+		*out.CdtrInstr = *r.CdtrInstr
+	}
+	r.ActvtnReqDlvryPty.DeepCopyInto(&out.ActvtnReqDlvryPty)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CreditorInvoice4) DeepCopy() *CreditorInvoice4 {
+	if r == nil {
+		return nil
+	}
+	out := new(CreditorInvoice4)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CreditorInvoice4) DeepCopyInto(out *CreditorInvoice4) {
+	*out = *r
+	if r.CstmrIdTp != nil {
+		out.CstmrIdTp = new(CustomerTypeRequest2)
+		r.CstmrIdTp.DeepCopyInto(out.CstmrIdTp)
+	}
+	if r.CtrctFrmtTp != nil {
+		out.CtrctFrmtTp = make([]DocumentFormat2Choice, len(r.CtrctFrmtTp))
+		for i := range r.CtrctFrmtTp {
+			r.CtrctFrmtTp[i].DeepCopyInto(&out.CtrctFrmtTp[i])
+		}
+	}
+	if r.CtrctRefTp != nil {
+		out.CtrctRefTp = make([]DocumentType1Choice, len(r.CtrctRefTp))
+		for i := range r.CtrctRefTp {
+			r.CtrctRefTp[i].DeepCopyInto(&out.CtrctRefTp[i])
+		}
+	}
+	if r.CdtrInstr != nil {
+		out.CdtrInstr = new(common.Max500Text)
+		*out.CdtrInstr = *r.CdtrInstr
+	}
+	if r.ActvtnReqDlvryPty != nil {
+		out.ActvtnReqDlvryPty = new(RTPPartyIdentification1)
+		r.ActvtnReqDlvryPty.DeepCopyInto(out.ActvtnReqDlvryPty)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CreditorServiceEnrolment1) DeepCopy() *CreditorServiceEnrolment1 {
+	if r == nil {
+		return nil
+	}
+	out := new(CreditorServiceEnrolment1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CreditorServiceEnrolment1) DeepCopyInto(out *CreditorServiceEnrolment1) {
+	*out = *r
+	if r.EnrlmntStartDt != nil {
+		out.EnrlmntStartDt = new(DateAndDateTime2Choice)
+		*out.EnrlmntStartDt = *r.EnrlmntStartDt
+	}
+	if r.EnrlmntEndDt != nil {
+		out.EnrlmntEndDt = new(DateAndDateTime2Choice)
+		*out.EnrlmntEndDt = *r.EnrlmntEndDt
+	}
+	if r.Vsblty != nil {
+		out.Vsblty = new(Visibilty1)
+		r.Vsblty.DeepCopyInto(out.Vsblty)
+	}
+	if r.SvcDescLk != nil {
+		out.SvcDescLk = new(common.Max2048Text)
+		*out.SvcDescLk = *r.SvcDescLk
+	}
+	if r.CdtrSvcActvtnLk != nil {
+		out.CdtrSvcActvtnLk = new(common.Max2048Text)
+		*out.CdtrSvcActvtnLk = *r.CdtrSvcActvtnLk
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *CustomerTypeRequest2) DeepCopy() *CustomerTypeRequest2 {
+	if r == nil {
+		return nil
+	}
+	out := new(CustomerTypeRequest2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *CustomerTypeRequest2) DeepCopyInto(out *CustomerTypeRequest2) {
+	*out = *r
+	if r.OrgTp != nil {
+		out.OrgTp = new(OrganisationType2)
+		r.OrgTp.DeepCopyInto(out.OrgTp)
+	}
+	if r.PrvtTp != nil {
+		out.PrvtTp = new(PersonType2)
+		r.PrvtTp.DeepCopyInto(out.PrvtTp)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *DateAndDateTime2Choice) DeepCopy() *DateAndDateTime2Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(DateAndDateTime2Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *DateAndDateTime2Choice) DeepCopyInto(out *DateAndDateTime2Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *DateAndPlaceOfBirth1) DeepCopy() *DateAndPlaceOfBirth1 {
+	if r == nil {
+		return nil
+	}
+	out := new(DateAndPlaceOfBirth1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *DateAndPlaceOfBirth1) DeepCopyInto(out *DateAndPlaceOfBirth1) {
+	*out = *r
+	if r.PrvcOfBirth != nil {
+		out.PrvcOfBirth = new(common.Max35Text)
+		*out.PrvcOfBirth = *r.PrvcOfBirth
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *DebtorActivation3) DeepCopy() *DebtorActivation3 {
+	if r == nil {
+		return nil
+	}
+	out := new(DebtorActivation3)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *DebtorActivation3) DeepCopyInto(out *DebtorActivation3) {
+	*out = *r
+	if r.DbtrActvtnId != nil {
+		out.DbtrActvtnId = new(common.Max35Text)
+		*out.DbtrActvtnId = *r.DbtrActvtnId
+	}
+	if r.DispNm != nil {
+		out.DispNm = new(common.Max140Text)
+		*out.DispNm = *r.DispNm
+	}
+	if r.UltmtDbtr != nil {
+		out.UltmtDbtr = new(RTPPartyIdentification1)
+		r.UltmtDbtr.DeepCopyInto(out.UltmtDbtr)
+	}
+	r.Dbtr.DeepCopyInto(&out.Dbtr)
+	r.DbtrSolPrvdr.DeepCopyInto(&out.DbtrSolPrvdr)
+	if r.CstmrId != nil {
+		out.CstmrId = make([]Party49Choice, len(r.CstmrId))
+		for i := range r.CstmrId {
+			r.CstmrId[i].DeepCopyInto(&out.CstmrId[i])
+		}
+	}
+	if r.CtrctFrmtTp != nil {
+		out.CtrctFrmtTp = make([]DocumentFormat2Choice, len(r.CtrctFrmtTp))
+		for i := range r.CtrctFrmtTp {
+			r.CtrctFrmtTp[i].DeepCopyInto(&out.CtrctFrmtTp[i])
+		}
+	}
+	if r.CtrctRef != nil {
+		out.CtrctRef = make([]ContractReference1, len(r.CtrctRef))
+		for i := range r.CtrctRef {
+			r.CtrctRef[i].DeepCopyInto(&out.CtrctRef[i])
+		}
+	}
+	r.Cdtr.DeepCopyInto(&out.Cdtr)
+	if r.UltmtCdtr != nil {
+		out.UltmtCdtr = new(RTPPartyIdentification1)
+		r.UltmtCdtr.DeepCopyInto(out.UltmtCdtr)
+	}
+	if r.ActvtnReqDlvryPty != nil {
+		out.ActvtnReqDlvryPty = new(RTPPartyIdentification1)
+		r.ActvtnReqDlvryPty.DeepCopyInto(out.ActvtnReqDlvryPty)
+	}
+	if r.StartDt != nil {
+		out.StartDt = new(DateAndDateTime2Choice)
+		*out.StartDt = *r.StartDt
+	}
+	if r.EndDt != nil {
+		out.EndDt = new(DateAndDateTime2Choice)
+		*out.EndDt = *r.EndDt
+	}
+	if r.DdctdActvtnCd != nil {
+		out.DdctdActvtnCd = new(common.Max35Text)
+		*out.DdctdActvtnCd = *r.DdctdActvtnCd
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *DebtorActivation4) DeepCopy() *DebtorActivation4 {
+	if r == nil {
+		return nil
+	}
+	out := new(DebtorActivation4)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *DebtorActivation4) DeepCopyInto(out *DebtorActivation4) {
+	*out = *r
+	if r.DbtrActvtnId != nil {
+		out.DbtrActvtnId = new(common.Max35Text)
+		*out.DbtrActvtnId = *r.DbtrActvtnId
+	}
+	if r.DispNm != nil {
+		out.DispNm = new(common.Max140Text)
+		*out.DispNm = *r.DispNm
+	}
+	if r.UltmtDbtr != nil {
+		out.UltmtDbtr = new(RTPPartyIdentification1)
+		r.UltmtDbtr.DeepCopyInto(out.UltmtDbtr)
+	}
+	if r.Dbtr != nil {
+		out.Dbtr = new(RTPPartyIdentification1)
+		r.Dbtr.DeepCopyInto(out.Dbtr)
+	}
+	if r.DbtrSolPrvdr != nil {
+		out.DbtrSolPrvdr = new(RTPPartyIdentification1)
+		r.DbtrSolPrvdr.DeepCopyInto(out.DbtrSolPrvdr)
+	}
+	if r.CstmrId != nil {
+		out.CstmrId = make([]Party49Choice, len(r.CstmrId))
+		for i := range r.CstmrId {
+			r.CstmrId[i].DeepCopyInto(&out.CstmrId[i])
+		}
+	}
+	if r.CtrctFrmtTp != nil {
+		out.CtrctFrmtTp = make([]DocumentFormat2Choice, len(r.CtrctFrmtTp))
+		for i := range r.CtrctFrmtTp {
+			r.CtrctFrmtTp[i].DeepCopyInto(&out.CtrctFrmtTp[i])
+		}
+	}
+	if r.CtrctRef != nil {
+		out.CtrctRef = make([]ContractReference1, len(r.CtrctRef))
+		for i := range r.CtrctRef {
+			r.CtrctRef[i].DeepCopyInto(&out.CtrctRef[i])
+		}
+	}
+	if r.Cdtr != nil {
+		out.Cdtr = new(RTPPartyIdentification1)
+		r.Cdtr.DeepCopyInto(out.Cdtr)
+	}
+	if r.UltmtCdtr != nil {
+		out.UltmtCdtr = new(RTPPartyIdentification1)
+		r.UltmtCdtr.DeepCopyInto(out.UltmtCdtr)
+	}
+	if r.ActvtnReqDlvryPty != nil {
+		out.ActvtnReqDlvryPty = new(RTPPartyIdentification1)
+		r.ActvtnReqDlvryPty.DeepCopyInto(out.ActvtnReqDlvryPty)
+	}
+	if r.StartDt != nil {
+		out.StartDt = new(DateAndDateTime2Choice)
+		*out.StartDt = *r.StartDt
+	}
+	if r.EndDt != nil {
+		out.EndDt = new(DateAndDateTime2Choice)
+		*out.EndDt = *r.EndDt
+	}
+	if r.DdctdActvtnCd != nil {
+		out.DdctdActvtnCd = new(common.Max35Text)
+		*out.DdctdActvtnCd = *r.DdctdActvtnCd
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *DebtorActivationAmendment3) DeepCopy() *DebtorActivationAmendment3 {
+	if r == nil {
+		return nil
+	}
+	out := new(DebtorActivationAmendment3)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *DebtorActivationAmendment3) DeepCopyInto(out *DebtorActivationAmendment3) {
+	*out = *r
+	if r.OrgnlBizInstr != nil {
+		out.OrgnlBizInstr = new(OriginalBusinessInstruction1)
+		r.OrgnlBizInstr.DeepCopyInto(out.OrgnlBizInstr)
+	}
+	if r.AmdmntRsn != nil {
+		out.AmdmntRsn = new(DebtorActivationAmendmentReason2)
+		r.AmdmntRsn.DeepCopyInto(out.AmdmntRsn)
+	}
+	r.Amdmnt.DeepCopyInto(&out.Amdmnt)
+	r.OrgnlActvtn.DeepCopyInto(&out.OrgnlActvtn)
+	if r.SplmtryData != nil {
+		out.SplmtryData = make([]SupplementaryData1, len(r.SplmtryData))
+		for i := range r.SplmtryData {
+			r.SplmtryData[i].DeepCopyInto(&out.SplmtryData[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *DebtorActivationAmendment4) DeepCopy() *DebtorActivationAmendment4 {
+	if r == nil {
+		return nil
+	}
+	out := new(DebtorActivationAmendment4)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *DebtorActivationAmendment4) DeepCopyInto(out *DebtorActivationAmendment4) {
+	*out = *r
+	if r.DbtrActvtn != nil {
+		out.DbtrActvtn = new(DebtorActivation4)
+		r.DbtrActvtn.DeepCopyInto(out.DbtrActvtn)
+	}
+	if r.ElctrncInvcData != nil {
+		out.ElctrncInvcData = new(ElectronicInvoice1)
+		*out.ElctrncInvcData = *r.ElctrncInvcData
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *DebtorActivationAmendmentReason1Choice) DeepCopy() *DebtorActivationAmendmentReason1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(DebtorActivationAmendmentReason1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *DebtorActivationAmendmentReason1Choice) DeepCopyInto(out *DebtorActivationAmendmentReason1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *DebtorActivationAmendmentReason2) DeepCopy() *DebtorActivationAmendmentReason2 {
+	if r == nil {
+		return nil
+	}
+	out := new(DebtorActivationAmendmentReason2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *DebtorActivationAmendmentReason2) DeepCopyInto(out *DebtorActivationAmendmentReason2) {
+	*out = *r
+	if r.Orgtr != nil {
+		out.Orgtr = new(RTPPartyIdentification1)
+		r.Orgtr.DeepCopyInto(out.Orgtr)
+	}
+	if r.AddtlInf != nil {
+		out.AddtlInf = make([]common.Max105Text, len(r.AddtlInf))
+		copy(out.AddtlInf, r.AddtlInf)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *DebtorActivationCancellation2) DeepCopy() *DebtorActivationCancellation2 {
+	if r == nil {
+		return nil
+	}
+	out := new(DebtorActivationCancellation2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *DebtorActivationCancellation2) DeepCopyInto(out *DebtorActivationCancellation2) {
+	*out = *r
+	if r.OrgnlBizInstr != nil {
+		out.OrgnlBizInstr = new(OriginalBusinessInstruction1)
+		r.OrgnlBizInstr.DeepCopyInto(out.OrgnlBizInstr)
+	}
+	if r.CxlRsn != nil {
+		out.CxlRsn = new(DebtorActivationCancellationReason2)
+		r.CxlRsn.DeepCopyInto(out.CxlRsn)
+	}
+	r.OrgnlActvtn.DeepCopyInto(&out.OrgnlActvtn)
+	if r.SplmtryData != nil {
+		out.SplmtryData = make([]SupplementaryData1, len(r.SplmtryData))
+		for i := range r.SplmtryData {
+			r.SplmtryData[i].DeepCopyInto(&out.SplmtryData[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *DebtorActivationCancellationReason1Choice) DeepCopy() *DebtorActivationCancellationReason1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(DebtorActivationCancellationReason1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *DebtorActivationCancellationReason1Choice) DeepCopyInto(out *DebtorActivationCancellationReason1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *DebtorActivationCancellationReason2) DeepCopy() *DebtorActivationCancellationReason2 {
+	if r == nil {
+		return nil
+	}
+	out := new(DebtorActivationCancellationReason2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *DebtorActivationCancellationReason2) DeepCopyInto(out *DebtorActivationCancellationReason2) {
+	*out = *r
+	if r.Orgtr != nil {
+		out.Orgtr = new(RTPPartyIdentification1)
+		r.Orgtr.DeepCopyInto(out.Orgtr)
+	}
+	if r.AddtlInf != nil {
+		out.AddtlInf = make([]common.Max105Text, len(r.AddtlInf))
+		copy(out.AddtlInf, r.AddtlInf)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *DebtorActivationStatusReason1Choice) DeepCopy() *DebtorActivationStatusReason1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(DebtorActivationStatusReason1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *DebtorActivationStatusReason1Choice) DeepCopyInto(out *DebtorActivationStatusReason1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *DebtorActivationStatusReason2) DeepCopy() *DebtorActivationStatusReason2 {
+	if r == nil {
+		return nil
+	}
+	out := new(DebtorActivationStatusReason2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *DebtorActivationStatusReason2) DeepCopyInto(out *DebtorActivationStatusReason2) {
+	*out = *r
+	if r.Orgtr != nil {
+		out.Orgtr = new(RTPPartyIdentification1)
+		r.Orgtr.DeepCopyInto(out.Orgtr)
+	}
+	if r.AddtlInf != nil {
+		out.AddtlInf = make([]common.Max105Text, len(r.AddtlInf))
+		copy(out.AddtlInf, r.AddtlInf)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *DocumentFormat2Choice) DeepCopy() *DocumentFormat2Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(DocumentFormat2Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *DocumentFormat2Choice) DeepCopyInto(out *DocumentFormat2Choice) {
+	*out = *r
+	r.Prtry.DeepCopyInto(&out.Prtry)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *DocumentType1Choice) DeepCopy() *DocumentType1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(DocumentType1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *DocumentType1Choice) DeepCopyInto(out *DocumentType1Choice) {
+	*out = *r
+	r.Prtry.DeepCopyInto(&out.Prtry)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ElectronicInvoice1) DeepCopy() *ElectronicInvoice1 {
+	if r == nil {
+		return nil
+	}
+	out := new(ElectronicInvoice1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ElectronicInvoice1) DeepCopyInto(out *ElectronicInvoice1) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *EnrolmentHeader2) DeepCopy() *EnrolmentHeader2 {
+	if r == nil {
+		return nil
+	}
+	out := new(EnrolmentHeader2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *EnrolmentHeader2) DeepCopyInto(out *EnrolmentHeader2) {
+	*out = *r
+	if r.MsgOrgtr != nil {
+		out.MsgOrgtr = new(RTPPartyIdentification1)
+		r.MsgOrgtr.DeepCopyInto(out.MsgOrgtr)
+	}
+	if r.MsgRcpt != nil {
+		out.MsgRcpt = new(RTPPartyIdentification1)
+		r.MsgRcpt.DeepCopyInto(out.MsgRcpt)
+	}
+	r.InitgPty.DeepCopyInto(&out.InitgPty)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *EnrolmentStatus2) DeepCopy() *EnrolmentStatus2 {
+	if r == nil {
+		return nil
+	}
+	out := new(EnrolmentStatus2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *EnrolmentStatus2) DeepCopyInto(out *EnrolmentStatus2) {
+	*out = *r
+	if r.OrgnlBizInstr != nil {
+		out.OrgnlBizInstr = new(OriginalBusinessInstruction1)
+		r.OrgnlBizInstr.DeepCopyInto(out.OrgnlBizInstr)
+	}
+	if r.StsRsn != nil {
+		out.StsRsn = new(CreditorEnrolmentStatusReason2)
+		r.StsRsn.DeepCopyInto(out.StsRsn)
+	}
+	if r.OrgnlEnrlmntRef != nil {
+		out.OrgnlEnrlmntRef = new(OriginalEnrolment2Choice)
+		r.OrgnlEnrlmntRef.DeepCopyInto(out.OrgnlEnrlmntRef)
+	}
+	if r.FctvEnrlmntDt != nil {
+		out.FctvEnrlmntDt = new(DateAndDateTime2Choice)
+		*out.FctvEnrlmntDt = *r.FctvEnrlmntDt
+	}
+	if r.SplmtryData != nil {
+		out.SplmtryData = make([]SupplementaryData1, len(r.SplmtryData))
+		for i := range r.SplmtryData {
+			r.SplmtryData[i].DeepCopyInto(&out.SplmtryData[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *GenericIdentification1) DeepCopy() *GenericIdentification1 {
+	if r == nil {
+		return nil
+	}
+	out := new(GenericIdentification1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *GenericIdentification1) DeepCopyInto(out *GenericIdentification1) {
+	*out = *r
+	if r.SchmeNm != nil {
+		out.SchmeNm = new(common.Max35Text)
+		*out.SchmeNm = *r.SchmeNm
+	}
+	if r.Issr != nil {
+		out.Issr = new(common.Max35Text)
+		*out.Issr = *r.Issr
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *GenericIdentification30) DeepCopy() *GenericIdentification30 {
+	if r == nil {
+		return nil
+	}
+	out := new(GenericIdentification30)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *GenericIdentification30) DeepCopyInto(out *GenericIdentification30) {
+	*out = *r
+	if r.SchmeNm != nil {
+		out.SchmeNm = new(common.Max35Text)
+		*out.SchmeNm = *r.SchmeNm
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *GenericOrganisationIdentification1) DeepCopy() *GenericOrganisationIdentification1 {
+	if r == nil {
+		return nil
+	}
+	out := new(GenericOrganisationIdentification1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *GenericOrganisationIdentification1) DeepCopyInto(out *GenericOrganisationIdentification1) {
+	*out = *r
+	if r.SchmeNm != nil {
+		out.SchmeNm = new(OrganisationIdentificationSchemeName1Choice)
+		*out.SchmeNm = *r.SchmeNm
+	}
+	if r.Issr != nil {
+		out.Issr = new(common.Max35Text)
+		*out.Issr = *r.Issr
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *GenericOrganisationType1) DeepCopy() *GenericOrganisationType1 {
+	if r == nil {
+		return nil
+	}
+	out := new(GenericOrganisationType1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *GenericOrganisationType1) DeepCopyInto(out *GenericOrganisationType1) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *GenericPersonIdentification1) DeepCopy() *GenericPersonIdentification1 {
+	if r == nil {
+		return nil
+	}
+	out := new(GenericPersonIdentification1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *GenericPersonIdentification1) DeepCopyInto(out *GenericPersonIdentification1) {
+	*out = *r
+	if r.SchmeNm != nil {
+		out.SchmeNm = new(PersonIdentificationSchemeName1Choice)
+		*out.SchmeNm = *r.SchmeNm
+	}
+	if r.Issr != nil {
+		out.Issr = new(common.Max35Text)
+		*out.Issr = *r.Issr
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *GenericPersonType1) DeepCopy() *GenericPersonType1 {
+	if r == nil {
+		return nil
+	}
+	out := new(GenericPersonType1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *GenericPersonType1) DeepCopyInto(out *GenericPersonType1) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *OrganisationIdentification37) DeepCopy() *OrganisationIdentification37 {
+	if r == nil {
+		return nil
+	}
+	out := new(OrganisationIdentification37)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *OrganisationIdentification37) DeepCopyInto(out *OrganisationIdentification37) {
+	*out = *r
+	if r.AnyBIC != nil {
+		out.AnyBIC = new(common.AnyBICDec2014Identifier)
+		*out.AnyBIC = *r.AnyBIC
+	}
+	if r.LEI != nil {
+		out.LEI = new(common.LEIIdentifier)
+		*out.LEI = *r.LEI
+	}
+	if r.EmailAdr != nil {
+		out.EmailAdr = new(common.Max256Text)
+		*out.EmailAdr = *r.EmailAdr
+	}
+	if r.Othr != nil {
+		out.Othr = make([]GenericOrganisationIdentification1, len(r.Othr))
+		for i := range r.Othr {
+			r.Othr[i].DeepCopyInto(&out.Othr[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *OrganisationIdentificationSchemeName1Choice) DeepCopy() *OrganisationIdentificationSchemeName1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(OrganisationIdentificationSchemeName1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *OrganisationIdentificationSchemeName1Choice) DeepCopyInto(out *OrganisationIdentificationSchemeName1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *OrganisationType2) DeepCopy() *OrganisationType2 {
+	if r == nil {
+		return nil
+	}
+	out := new(OrganisationType2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *OrganisationType2) DeepCopyInto(out *OrganisationType2) {
+	*out = *r
+	if r.Othr != nil {
+		out.Othr = make([]GenericOrganisationType1, len(r.Othr))
+		copy(out.Othr, r.Othr)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *OriginalActivation2Choice) DeepCopy() *OriginalActivation2Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(OriginalActivation2Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *OriginalActivation2Choice) DeepCopyInto(out *OriginalActivation2Choice) {
+	*out = *r
+	r.OrgnlDbtrId.DeepCopyInto(&out.OrgnlDbtrId)
+	r.OrgnlActvtnData.DeepCopyInto(&out.OrgnlActvtnData)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *OriginalBusinessInstruction1) DeepCopy() *OriginalBusinessInstruction1 {
+	if r == nil {
+		return nil
+	}
+	out := new(OriginalBusinessInstruction1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *OriginalBusinessInstruction1) DeepCopyInto(out *OriginalBusinessInstruction1) {
+	*out = *r
+	if r.MsgNmId != nil {
+		out.MsgNmId = new(common.Max35Text)
+		*out.MsgNmId = *r.MsgNmId
+	}
+	if r.CreDtTm != nil {
+		out.CreDtTm = new(common.ISODateTime)
+		*out.CreDtTm = *r.CreDtTm
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *OriginalEnrolment2Choice) DeepCopy() *OriginalEnrolment2Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(OriginalEnrolment2Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *OriginalEnrolment2Choice) DeepCopyInto(out *OriginalEnrolment2Choice) {
+	*out = *r
+	r.OrgnlCdtrId.DeepCopyInto(&out.OrgnlCdtrId)
+	r.OrgnlEnrlmntData.DeepCopyInto(&out.OrgnlEnrlmntData)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *OtherContact1) DeepCopy() *OtherContact1 {
+	if r == nil {
+		return nil
+	}
+	out := new(OtherContact1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *OtherContact1) DeepCopyInto(out *OtherContact1) {
+	*out = *r
+	if r.Id != nil {
+		out.Id = new(common.Max128Text)
+		*out.Id = *r.Id
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *Party49Choice) DeepCopy() *Party49Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(Party49Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *Party49Choice) DeepCopyInto(out *Party49Choice) {
+	*out = *r
+	r.OrgId.DeepCopyInto(&out.OrgId)
+	r.PrvtId.DeepCopyInto(&out.PrvtId)
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *PersonIdentification17) DeepCopy() *PersonIdentification17 {
+	if r == nil {
+		return nil
+	}
+	out := new(PersonIdentification17)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *PersonIdentification17) DeepCopyInto(out *PersonIdentification17) {
+	*out = *r
+	if r.DtAndPlcOfBirth != nil {
+		out.DtAndPlcOfBirth = new(DateAndPlaceOfBirth1)
+		r.DtAndPlcOfBirth.DeepCopyInto(out.DtAndPlcOfBirth)
+	}
+	if r.EmailAdr != nil {
+		out.EmailAdr = new(common.Max256Text)
+		*out.EmailAdr = *r.EmailAdr
+	}
+	if r.Othr != nil {
+		out.Othr = make([]GenericPersonIdentification1, len(r.Othr))
+		for i := range r.Othr {
+			r.Othr[i].DeepCopyInto(&out.Othr[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *PersonIdentificationSchemeName1Choice) DeepCopy() *PersonIdentificationSchemeName1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(PersonIdentificationSchemeName1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *PersonIdentificationSchemeName1Choice) DeepCopyInto(out *PersonIdentificationSchemeName1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *PersonType2) DeepCopy() *PersonType2 {
+	if r == nil {
+		return nil
+	}
+	out := new(PersonType2)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *PersonType2) DeepCopyInto(out *PersonType2) {
+	*out = *r
+	if r.Othr != nil {
+		out.Othr = make([]GenericPersonType1, len(r.Othr))
+		copy(out.Othr, r.Othr)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *PostalAddress24) DeepCopy() *PostalAddress24 {
+	if r == nil {
+		return nil
+	}
+	out := new(PostalAddress24)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *PostalAddress24) DeepCopyInto(out *PostalAddress24) {
+	*out = *r
+	if r.AdrTp != nil {
+		out.AdrTp = new(AddressType3Choice)
+		r.AdrTp.DeepCopyInto(out.AdrTp)
+	}
+	if r.Dept != nil {
+		out.Dept = new(common.Max70Text)
+		*out.Dept = *r.Dept
+	}
+	if r.SubDept != nil {
+		out.SubDept = new(common.Max70Text)
+		*out.SubDept = *r.SubDept
+	}
+	if r.StrtNm != nil {
+		out.StrtNm = new(common.Max70Text)
+		*out.StrtNm = *r.StrtNm
+	}
+	if r.BldgNb != nil {
+		out.BldgNb = new(common.Max16Text)
+		*out.BldgNb = *r.BldgNb
+	}
+	if r.BldgNm != nil {
+		out.BldgNm = new(common.Max35Text)
+		*out.BldgNm = *r.BldgNm
+	}
+	if r.Flr != nil {
+		out.Flr = new(common.Max70Text)
+		*out.Flr = *r.Flr
+	}
+	if r.PstBx != nil {
+		out.PstBx = new(common.Max16Text)
+		*out.PstBx = *r.PstBx
+	}
+	if r.Room != nil {
+		out.Room = new(common.Max70Text)
+		*out.Room = *r.Room
+	}
+	if r.PstCd != nil {
+		out.PstCd = new(common.Max16Text)
+		*out.PstCd = *r.PstCd
+	}
+	if r.TwnNm != nil {
+		out.TwnNm = new(common.Max35Text)
+		*out.TwnNm = *r.TwnNm
+	}
+	if r.TwnLctnNm != nil {
+		out.TwnLctnNm = new(common.Max35Text)
+		*out.TwnLctnNm = *r.TwnLctnNm
+	}
+	if r.DstrctNm != nil {
+		out.DstrctNm = new(common.Max35Text)
+		*out.DstrctNm = *r.DstrctNm
+	}
+	if r.CtrySubDvsn != nil {
+		out.CtrySubDvsn = new(common.Max35Text)
+		*out.CtrySubDvsn = *r.CtrySubDvsn
+	}
+	if r.Ctry != nil {
+		out.Ctry = new(common.CountryCode)
+		*out.Ctry = *r.Ctry
+	}
+	if r.AdrLine != nil {
+		out.AdrLine = make([]common.Max70Text, len(r.AdrLine))
+		copy(out.AdrLine, r.AdrLine)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *RTPPartyIdentification1) DeepCopy() *RTPPartyIdentification1 {
+	if r == nil {
+		return nil
+	}
+	out := new(RTPPartyIdentification1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *RTPPartyIdentification1) DeepCopyInto(out *RTPPartyIdentification1) {
+	*out = *r
+	if r.Nm != nil {
+		out.Nm = new(common.Max140Text)
+		*out.Nm = *r.Nm
+	}
+	if r.PstlAdr != nil {
+		out.PstlAdr = new(PostalAddress24)
+		r.PstlAdr.DeepCopyInto(out.PstlAdr)
+	}
+	if r.Id != nil {
+		out.Id = new(Party49Choice)
+		r.Id.DeepCopyInto(out.Id)
+	}
+	if r.CtryOfRes != nil {
+		out.CtryOfRes = new(common.CountryCode)
+		*out.CtryOfRes = *r.CtryOfRes
+	}
+	if r.CtctDtls != nil {
+		out.CtctDtls = new(Contact4)
+		r.CtctDtls.DeepCopyInto(out.CtctDtls)
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *RequestToPayCreditorEnrolmentAmendmentRequestV01) DeepCopy() *RequestToPayCreditorEnrolmentAmendmentRequestV01 {
+	if r == nil {
+		return nil
+	}
+	out := new(RequestToPayCreditorEnrolmentAmendmentRequestV01)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *RequestToPayCreditorEnrolmentAmendmentRequestV01) DeepCopyInto(out *RequestToPayCreditorEnrolmentAmendmentRequestV01) {
+	*out = *r
+	r.Hdr.DeepCopyInto(&out.Hdr)
+	if r.AmdmntData != nil {
+		out.AmdmntData = make([]CreditorEnrolmentAmendment3, len(r.AmdmntData))
+		for i := range r.AmdmntData {
+			r.AmdmntData[i].DeepCopyInto(&out.AmdmntData[i])
+		}
+	}
+	if r.SplmtryData != nil {
+		out.SplmtryData = make([]SupplementaryData1, len(r.SplmtryData))
+		for i := range r.SplmtryData {
+			r.SplmtryData[i].DeepCopyInto(&out.SplmtryData[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *RequestToPayCreditorEnrolmentCancellationRequestV01) DeepCopy() *RequestToPayCreditorEnrolmentCancellationRequestV01 {
+	if r == nil {
+		return nil
+	}
+	out := new(RequestToPayCreditorEnrolmentCancellationRequestV01)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *RequestToPayCreditorEnrolmentCancellationRequestV01) DeepCopyInto(out *RequestToPayCreditorEnrolmentCancellationRequestV01) {
+	*out = *r
+	r.Hdr.DeepCopyInto(&out.Hdr)
+	if r.CxlData != nil {
+		out.CxlData = make([]CreditorEnrolmentCancellation2, len(r.CxlData))
+		for i := range r.CxlData {
+			r.CxlData[i].DeepCopyInto(&out.CxlData[i])
+		}
+	}
+	if r.SplmtryData != nil {
+		out.SplmtryData = make([]SupplementaryData1, len(r.SplmtryData))
+		for i := range r.SplmtryData {
+			r.SplmtryData[i].DeepCopyInto(&out.SplmtryData[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *RequestToPayCreditorEnrolmentRequestV01) DeepCopy() *RequestToPayCreditorEnrolmentRequestV01 {
+	if r == nil {
+		return nil
+	}
+	out := new(RequestToPayCreditorEnrolmentRequestV01)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *RequestToPayCreditorEnrolmentRequestV01) DeepCopyInto(out *RequestToPayCreditorEnrolmentRequestV01) {
+	*out = *r
+	r.Hdr.DeepCopyInto(&out.Hdr)
+	if r.CdtrEnrlmnt != nil {
+		out.CdtrEnrlmnt = make([]CreditorEnrolment3, len(r.CdtrEnrlmnt))
+		for i := range r.CdtrEnrlmnt {
+			r.CdtrEnrlmnt[i].DeepCopyInto(&out.CdtrEnrlmnt[i])
+		}
+	}
+	r.ActvtnData.DeepCopyInto(&out.ActvtnData)
+	if r.SplmtryData != nil {
+		out.SplmtryData = make([]SupplementaryData1, len(r.SplmtryData))
+		for i := range r.SplmtryData {
+			r.SplmtryData[i].DeepCopyInto(&out.SplmtryData[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *RequestToPayCreditorEnrolmentStatusReportV01) DeepCopy() *RequestToPayCreditorEnrolmentStatusReportV01 {
+	if r == nil {
+		return nil
+	}
+	out := new(RequestToPayCreditorEnrolmentStatusReportV01)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *RequestToPayCreditorEnrolmentStatusReportV01) DeepCopyInto(out *RequestToPayCreditorEnrolmentStatusReportV01) {
+	*out = *r
+	r.Hdr.DeepCopyInto(&out.Hdr)
+	if r.OrgnlEnrlmntAndSts != nil {
+		out.OrgnlEnrlmntAndSts = make([]EnrolmentStatus2, len(r.OrgnlEnrlmntAndSts))
+		for i := range r.OrgnlEnrlmntAndSts {
+			r.OrgnlEnrlmntAndSts[i].DeepCopyInto(&out.OrgnlEnrlmntAndSts[i])
+		}
+	}
+	if r.SplmtryData != nil {
+		out.SplmtryData = make([]SupplementaryData1, len(r.SplmtryData))
+		for i := range r.SplmtryData {
+			r.SplmtryData[i].DeepCopyInto(&out.SplmtryData[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *RequestToPayDebtorActivationAmendmentRequestV01) DeepCopy() *RequestToPayDebtorActivationAmendmentRequestV01 {
+	if r == nil {
+		return nil
+	}
+	out := new(RequestToPayDebtorActivationAmendmentRequestV01)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *RequestToPayDebtorActivationAmendmentRequestV01) DeepCopyInto(out *RequestToPayDebtorActivationAmendmentRequestV01) {
+	*out = *r
+	r.Hdr.DeepCopyInto(&out.Hdr)
+	if r.AmdmntData != nil {
+		out.AmdmntData = make([]DebtorActivationAmendment3, len(r.AmdmntData))
+		for i := range r.AmdmntData {
+			r.AmdmntData[i].DeepCopyInto(&out.AmdmntData[i])
+		}
+	}
+	if r.SplmtryData != nil {
+		out.SplmtryData = make([]SupplementaryData1, len(r.SplmtryData))
+		for i := range r.SplmtryData {
+			r.SplmtryData[i].DeepCopyInto(&out.SplmtryData[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *RequestToPayDebtorActivationCancellationRequestV01) DeepCopy() *RequestToPayDebtorActivationCancellationRequestV01 {
+	if r == nil {
+		return nil
+	}
+	out := new(RequestToPayDebtorActivationCancellationRequestV01)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *RequestToPayDebtorActivationCancellationRequestV01) DeepCopyInto(out *RequestToPayDebtorActivationCancellationRequestV01) {
+	*out = *r
+	r.Hdr.DeepCopyInto(&out.Hdr)
+	if r.CxlData != nil {
+		out.CxlData = make([]DebtorActivationCancellation2, len(r.CxlData))
+		for i := range r.CxlData {
+			r.CxlData[i].DeepCopyInto(&out.CxlData[i])
+		}
+	}
+	if r.SplmtryData != nil {
+		out.SplmtryData = make([]SupplementaryData1, len(r.SplmtryData))
+		for i := range r.SplmtryData {
+			r.SplmtryData[i].DeepCopyInto(&out.SplmtryData[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *RequestToPayDebtorActivationRequestV01) DeepCopy() *RequestToPayDebtorActivationRequestV01 {
+	if r == nil {
+		return nil
+	}
+	out := new(RequestToPayDebtorActivationRequestV01)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *RequestToPayDebtorActivationRequestV01) DeepCopyInto(out *RequestToPayDebtorActivationRequestV01) {
+	*out = *r
+	r.Hdr.DeepCopyInto(&out.Hdr)
+	if r.DbtrActvtn != nil {
+		out.DbtrActvtn = make([]DebtorActivation3, len(r.DbtrActvtn))
+		for i := range r.DbtrActvtn {
+			r.DbtrActvtn[i].DeepCopyInto(&out.DbtrActvtn[i])
+		}
+	}
+	if r.SplmtryData != nil {
+		out.SplmtryData = make([]SupplementaryData1, len(r.SplmtryData))
+		for i := range r.SplmtryData {
+			r.SplmtryData[i].DeepCopyInto(&out.SplmtryData[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *RequestToPayDebtorActivationStatusReportV01) DeepCopy() *RequestToPayDebtorActivationStatusReportV01 {
+	if r == nil {
+		return nil
+	}
+	out := new(RequestToPayDebtorActivationStatusReportV01)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *RequestToPayDebtorActivationStatusReportV01) DeepCopyInto(out *RequestToPayDebtorActivationStatusReportV01) {
+	*out = *r
+	r.Hdr.DeepCopyInto(&out.Hdr)
+	if r.OrgnlActvtnAndSts != nil {
+		out.OrgnlActvtnAndSts = make([]ActivationStatus2, len(r.OrgnlActvtnAndSts))
+		for i := range r.OrgnlActvtnAndSts {
+			r.OrgnlActvtnAndSts[i].DeepCopyInto(&out.OrgnlActvtnAndSts[i])
+		}
+	}
+	if r.SplmtryData != nil {
+		out.SplmtryData = make([]SupplementaryData1, len(r.SplmtryData))
+		for i := range r.SplmtryData {
+			r.SplmtryData[i].DeepCopyInto(&out.SplmtryData[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *ServiceStatus1Choice) DeepCopy() *ServiceStatus1Choice {
+	if r == nil {
+		return nil
+	}
+	out := new(ServiceStatus1Choice)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *ServiceStatus1Choice) DeepCopyInto(out *ServiceStatus1Choice) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *SupplementaryData1) DeepCopy() *SupplementaryData1 {
+	if r == nil {
+		return nil
+	}
+	out := new(SupplementaryData1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *SupplementaryData1) DeepCopyInto(out *SupplementaryData1) {
+	*out = *r
+	if r.PlcAndNm != nil {
+		out.PlcAndNm = new(common.Max350Text)
+		*out.PlcAndNm = *r.PlcAndNm
+	}
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *SupplementaryDataEnvelope1) DeepCopy() *SupplementaryDataEnvelope1 {
+	if r == nil {
+		return nil
+	}
+	out := new(SupplementaryDataEnvelope1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *SupplementaryDataEnvelope1) DeepCopyInto(out *SupplementaryDataEnvelope1) {
+	*out = *r
+}
+
+// DeepCopy returns a copy of r sharing no memory with r
+func (r *Visibilty1) DeepCopy() *Visibilty1 {
+	if r == nil {
+		return nil
+	}
+	out := new(Visibilty1)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies r into out, out shares no memory with r
+func (r *Visibilty1) DeepCopyInto(out *Visibilty1) {
+	*out = *r
+	if r.StartDt != nil {
+		out.StartDt = new(DateAndDateTime2Choice)
+		*out.StartDt = *r.StartDt
+	}
+	if r.EndDt != nil {
+		out.EndDt = new(DateAndDateTime2Choice)
+		*out.EndDt = *r.EndDt
+	}
+}
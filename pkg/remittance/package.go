@@ -0,0 +1,216 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package remittance
+
+import (
+	"archive/zip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"path"
+	"regexp"
+	"strings"
+)
+
+const (
+	// DefaultMaxSize is the size of the largest remittance document fetched, 10 MiB
+	DefaultMaxSize = 10 << 20
+
+	// ManifestName is the file of the manifest in packages
+	ManifestName = "manifest.json"
+
+	// DocumentsDirectory holds the fetched remittance documents in packages
+	DocumentsDirectory = "remittance"
+)
+
+var (
+	// ErrHostNotAllowed is returned for the URLs of hosts a fetcher doesn't fetch from
+	ErrHostNotAllowed = errors.New("remittance document host is not allowed")
+
+	// ErrTooLarge is returned for remittance documents larger than the maximum size
+	ErrTooLarge = errors.New("remittance document is too large")
+)
+
+var unsafeName = regexp.MustCompile(`[^A-Za-z0-9._-]+`)
+
+// Fetcher fetches the remittance documents of URID locations
+type Fetcher struct {
+	// Client fetches the documents, http.DefaultClient when nil
+	Client *http.Client
+
+	// AllowedHosts are the hosts documents are fetched from, e.g. docs.example.com, or their
+	// domain with a leading dot (.example.com). Nothing is fetched when empty.
+	AllowedHosts []string
+
+	// MaxSize is the size of the largest document, DefaultMaxSize when zero
+	MaxSize int64
+}
+
+// File is a fetched remittance document
+type File struct {
+	Name        string
+	ContentType string
+	Content     []byte
+}
+
+// Fetch returns the remittance document of an URID location
+func (f *Fetcher) Fetch(ctx context.Context, location Location) (File, error) {
+	if location.Method != MethodURI {
+		return File{}, fmt.Errorf("%s locations can't be fetched", location.Method)
+	}
+	if err := location.Check(); err != nil {
+		return File{}, err
+	}
+	u, _ := url.Parse(location.Address)
+	if !f.allowed(u.Hostname()) {
+		return File{}, fmt.Errorf("%w: %s", ErrHostNotAllowed, u.Hostname())
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return File{}, err
+	}
+	client := f.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return File{}, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return File{}, fmt.Errorf("fetching %s: %s", u.Redacted(), resp.Status)
+	}
+
+	max := f.MaxSize
+	if max <= 0 {
+		max = DefaultMaxSize
+	}
+	content, err := io.ReadAll(io.LimitReader(resp.Body, max+1))
+	if err != nil {
+		return File{}, fmt.Errorf("fetching %s: %w", u.Redacted(), err)
+	}
+	if int64(len(content)) > max {
+		return File{}, fmt.Errorf("%w: %s is larger than %d bytes", ErrTooLarge, u.Redacted(), max)
+	}
+	return File{Name: fileName(u, resp.Header), ContentType: resp.Header.Get("Content-Type"), Content: content}, nil
+}
+
+func (f *Fetcher) allowed(host string) bool {
+	host = strings.ToLower(host)
+	for _, allowed := range f.AllowedHosts {
+		allowed = strings.ToLower(allowed)
+		if host == allowed || (strings.HasPrefix(allowed, ".") && strings.HasSuffix(host, allowed)) {
+			return true
+		}
+	}
+	return false
+}
+
+// fileName returns the name of the document of the Content-Disposition header or of the URL
+func fileName(u *url.URL, header http.Header) string {
+	name := path.Base(u.Path)
+	if _, params, err := mime.ParseMediaType(header.Get("Content-Disposition")); err == nil && params["filename"] != "" {
+		name = path.Base(params["filename"])
+	}
+	name = strings.Trim(unsafeName.ReplaceAllString(name, "_"), "._")
+	if name == "" {
+		return "document"
+	}
+	return name
+}
+
+// Manifest lists the related remittance of a packaged payment
+type Manifest struct {
+	// Document is the file of the payment in the package
+	Document    string       `json:"document"`
+	Attachments []Attachment `json:"attachments"`
+}
+
+// Attachment is a remittance location of a packaged payment, with the file of its fetched
+// document or the error fetching it
+type Attachment struct {
+	Reference    string `json:"reference"`
+	RemittanceID string `json:"remittanceId,omitempty"`
+	Location
+
+	File        string `json:"file,omitempty"`
+	ContentType string `json:"contentType,omitempty"`
+	Size        int    `json:"size,omitempty"`
+	SHA256      string `json:"sha256,omitempty"`
+	Error       string `json:"error,omitempty"`
+}
+
+// Package is a payment and its related remittance
+type Package struct {
+	// Name is the file of the payment, Content the payment in its format
+	Name       string
+	Content    []byte
+	References []Reference
+}
+
+// Write writes the package to w as a zip archive of the payment, the manifest of its
+// remittance and the documents of its URID locations fetched with fetcher. References are
+// listed without their documents when fetcher is nil, documents failing to be fetched are
+// listed with their error.
+func (p Package) Write(ctx context.Context, w io.Writer, fetcher *Fetcher) (Manifest, error) {
+	archive := zip.NewWriter(w)
+	manifest := Manifest{Document: p.Name, Attachments: []Attachment{}}
+	if err := writeFile(archive, p.Name, p.Content); err != nil {
+		return manifest, err
+	}
+
+	for _, ref := range p.References {
+		locations := ref.Locations
+		if len(locations) == 0 {
+			locations = []Location{{}}
+		}
+		for _, location := range locations {
+			attachment := Attachment{Reference: ref.Path, RemittanceID: ref.ID, Location: location}
+			if fetcher != nil && location.Method == MethodURI {
+				file, err := fetcher.Fetch(ctx, location)
+				if err != nil {
+					attachment.Error = err.Error()
+				} else {
+					sum := sha256.Sum256(file.Content)
+					attachment.File = fmt.Sprintf("%s/%d-%s", DocumentsDirectory, len(manifest.Attachments)+1, file.Name)
+					attachment.ContentType = file.ContentType
+					attachment.Size = len(file.Content)
+					attachment.SHA256 = hex.EncodeToString(sum[:])
+					if err = writeFile(archive, attachment.File, file.Content); err != nil {
+						return manifest, err
+					}
+				}
+			}
+			manifest.Attachments = append(manifest.Attachments, attachment)
+		}
+	}
+
+	buf, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return manifest, err
+	}
+	if err = writeFile(archive, ManifestName, buf); err != nil {
+		return manifest, err
+	}
+	return manifest, archive.Close()
+}
+
+func writeFile(archive *zip.Writer, name string, content []byte) error {
+	w, err := archive.Create(name)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(content)
+	return err
+}
@@ -0,0 +1,193 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package remittance
+
+/*
+	Package remittance handles the remittance documents sent separately from payments and
+	referenced by their related remittance information (RltdRmtInf): the identification of
+	a remittance advice and where it's delivered, e.g. a URL or an email address.
+
+		for _, ref := range remittance.References(doc) {
+			err := ref.Check()
+			...
+		}
+
+	Package bundles a payment with the remittance documents its URLs reference, fetched from
+	the allowed hosts of a Fetcher, in a zip archive with their manifest.
+*/
+
+import (
+	"errors"
+	"fmt"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/moov-io/iso20022/pkg/document"
+	"github.com/moov-io/iso20022/pkg/utils"
+)
+
+// Remittance location methods (RemittanceLocationMethod2Code)
+const (
+	MethodFax   = "FAXI"
+	MethodEDI   = "EDIC"
+	MethodURI   = "URID"
+	MethodEmail = "EMAL"
+	MethodPost  = "POST"
+	MethodSMS   = "SMSM"
+)
+
+const (
+	// locationDetails are the locations of recent versions, older versions have a single
+	// location with elements prefixed by locationPrefix
+	locationDetails = "RmtLctnDtls"
+	locationPrefix  = "RmtLctn"
+)
+
+var (
+	// ErrIncompleteReference is returned for references with neither identification nor location
+	ErrIncompleteReference = errors.New("related remittance has neither identification nor location")
+
+	// ErrMissingAddress is returned for locations without the address of their method
+	ErrMissingAddress = errors.New("remittance location has no address")
+
+	// ErrInvalidAddress is returned for addresses that aren't valid for their method
+	ErrInvalidAddress = errors.New("invalid remittance location address")
+)
+
+// ISO 20022 PhoneNumber, e.g. +41-447654321
+var phonePattern = regexp.MustCompile(`^\+[0-9]{1,3}-[0-9()+\-]{1,30}$`)
+
+var referencePattern = regexp.MustCompile(`^(.*?RltdRmtInf(?:\[\d+\])?)/(.+)$`)
+
+// Reference is a related remittance information block of a transaction
+type Reference struct {
+	// Path of the block, e.g. CdtTrfTxInf[0]/RltdRmtInf[0]
+	Path string `json:"path"`
+
+	// ID is the identification of the remittance document (RmtId)
+	ID string `json:"id,omitempty"`
+
+	Locations []Location `json:"locations,omitempty"`
+}
+
+// Location is where a remittance document is delivered
+type Location struct {
+	Path string `json:"path"`
+
+	// Method is a RemittanceLocationMethod2Code, e.g. URID
+	Method string `json:"method"`
+
+	// Address is the electronic address of the document, e.g. its URL
+	Address string `json:"address,omitempty"`
+
+	// Postal reports whether the location has a postal address
+	Postal bool `json:"postal,omitempty"`
+}
+
+// References returns the related remittance information of doc in document order
+func References(doc document.Iso20022Document) []Reference {
+	var refs []Reference
+	index := make(map[string]int)
+	for _, elm := range utils.GetElements(doc.InspectMessage()) {
+		match := referencePattern.FindStringSubmatch(elm.Path)
+		if match == nil {
+			continue
+		}
+		i, exists := index[match[1]]
+		if !exists {
+			i = len(refs)
+			index[match[1]] = i
+			refs = append(refs, Reference{Path: match[1]})
+		}
+		refs[i].add(strings.Split(match[2], "/"), strings.TrimSpace(elm.Value))
+	}
+	return refs
+}
+
+// add sets the element at segments of the reference to value, locations are either a list of
+// RmtLctnDtls or the RmtLctnMtd, RmtLctnElctrncAdr and RmtLctnPstlAdr of older versions
+func (r *Reference) add(segments []string, value string) {
+	name := utils.StripElementIndexes(segments[0])
+	if name == "RmtId" {
+		r.ID = value
+		return
+	}
+
+	var path, field string
+	switch {
+	case name == locationDetails && len(segments) > 1:
+		path, field = r.Path+"/"+segments[0], utils.StripElementIndexes(segments[1])
+	case strings.HasPrefix(name, locationPrefix):
+		path, field = r.Path, strings.TrimPrefix(name, locationPrefix)
+	default:
+		return
+	}
+
+	var location *Location
+	for i := range r.Locations {
+		if r.Locations[i].Path == path {
+			location = &r.Locations[i]
+		}
+	}
+	if location == nil {
+		r.Locations = append(r.Locations, Location{Path: path})
+		location = &r.Locations[len(r.Locations)-1]
+	}
+	switch field {
+	case "Mtd":
+		location.Method = value
+	case "ElctrncAdr":
+		location.Address = value
+	case "PstlAdr":
+		location.Postal = true
+	}
+}
+
+// Check returns ErrIncompleteReference, or the error of its first invalid location
+func (r Reference) Check() error {
+	if r.ID == "" && len(r.Locations) == 0 {
+		return fmt.Errorf("%w: %s", ErrIncompleteReference, r.Path)
+	}
+	for _, location := range r.Locations {
+		if err := location.Check(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Check returns an error unless the location has a valid address for its method: an absolute
+// http or https URL, an email address, an ISO 20022 phone number or a postal address
+func (l Location) Check() error {
+	if l.Method == MethodPost {
+		if !l.Postal {
+			return fmt.Errorf("%w: %s has no postal address", ErrMissingAddress, l.Path)
+		}
+		return nil
+	}
+	if l.Address == "" {
+		return fmt.Errorf("%w: %s has no electronic address for %s", ErrMissingAddress, l.Path, l.Method)
+	}
+
+	switch l.Method {
+	case MethodURI:
+		u, err := url.Parse(l.Address)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("%w: %s is not an http or https URL (%s)", ErrInvalidAddress, l.Address, l.Path)
+		}
+	case MethodEmail:
+		addr, err := mail.ParseAddress(l.Address)
+		if err != nil || addr.Address != l.Address {
+			return fmt.Errorf("%w: %s is not an email address (%s)", ErrInvalidAddress, l.Address, l.Path)
+		}
+	case MethodFax, MethodSMS:
+		if !phonePattern.MatchString(l.Address) {
+			return fmt.Errorf("%w: %s is not a phone number like +41-447654321 (%s)", ErrInvalidAddress, l.Address, l.Path)
+		}
+	}
+	return nil
+}
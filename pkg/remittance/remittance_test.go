@@ -0,0 +1,151 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package remittance
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/moov-io/iso20022/pkg/document"
+	"github.com/moov-io/iso20022/pkg/iso20022test/fixtures"
+)
+
+func parse(t *testing.T, buf []byte) document.Iso20022Document {
+	t.Helper()
+	doc, err := document.ParseIso20022Document(buf)
+	require.Nil(t, err)
+	return doc
+}
+
+func TestReferences(t *testing.T) {
+	doc := parse(t, fixtures.ReadFile(t, "related_remittance_pacs_v08.xml"))
+	require.Nil(t, doc.Validate())
+
+	refs := References(doc)
+	require.Equal(t, []Reference{
+		{
+			Path: "CdtTrfTxInf[0]/RltdRmtInf[0]",
+			ID:   "INV-2021-0042",
+			Locations: []Location{
+				{Path: "CdtTrfTxInf[0]/RltdRmtInf[0]/RmtLctnDtls[0]", Method: MethodURI, Address: "https://remittance.example.com/advices/INV-2021-0042.pdf"},
+				{Path: "CdtTrfTxInf[0]/RltdRmtInf[0]/RmtLctnDtls[1]", Method: MethodEmail, Address: "advices@example.com"},
+			},
+		},
+		{Path: "CdtTrfTxInf[1]/RltdRmtInf[0]", ID: "INV-2021-0043"},
+	}, refs)
+	for _, ref := range refs {
+		require.Nil(t, ref.Check())
+	}
+	require.Empty(t, References(parse(t, fixtures.ReadFile(t, "valid_pacs_v08.xml"))))
+}
+
+func TestCheck(t *testing.T) {
+	require.ErrorIs(t, Reference{Path: "RltdRmtInf"}.Check(), ErrIncompleteReference)
+	require.ErrorIs(t, Reference{Locations: []Location{{Method: MethodURI}}}.Check(), ErrMissingAddress)
+
+	cases := []struct {
+		location Location
+		err      error
+	}{
+		{Location{Method: MethodURI, Address: "https://docs.example.com/advice.pdf"}, nil},
+		{Location{Method: MethodURI, Address: "ftp://docs.example.com/advice.pdf"}, ErrInvalidAddress},
+		{Location{Method: MethodURI, Address: "/advice.pdf"}, ErrInvalidAddress},
+		{Location{Method: MethodEmail, Address: "advices@example.com"}, nil},
+		{Location{Method: MethodEmail, Address: "Advices <advices@example.com>"}, ErrInvalidAddress},
+		{Location{Method: MethodFax, Address: "+41-447654321"}, nil},
+		{Location{Method: MethodSMS, Address: "0447654321"}, ErrInvalidAddress},
+		{Location{Method: MethodEDI, Address: "ZZ:SUPPLIER01"}, nil},
+		{Location{Method: MethodPost, Postal: true}, nil},
+		{Location{Method: MethodPost, Address: "advices@example.com"}, ErrMissingAddress},
+	}
+	for _, c := range cases {
+		err := c.location.Check()
+		if c.err == nil {
+			require.Nil(t, err, c.location)
+		} else {
+			require.ErrorIs(t, err, c.err, c.location)
+		}
+	}
+}
+
+func TestPackage(t *testing.T) {
+	advice := []byte("%PDF-1.4 remittance advice")
+	docs := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/advices/INV-2021-0042.pdf":
+			w.Header().Set("Content-Type", "application/pdf")
+			w.Write(advice)
+		case "/large":
+			w.Write(bytes.Repeat([]byte{'x'}, 64))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer docs.Close()
+	host, _ := url.Parse(docs.URL)
+
+	content := bytes.ReplaceAll(fixtures.ReadFile(t, "related_remittance_pacs_v08.xml"), []byte("https://remittance.example.com"), []byte(docs.URL))
+	pkg := Package{Name: "payment.xml", Content: content, References: References(parse(t, content))}
+
+	buf := &bytes.Buffer{}
+	manifest, err := pkg.Write(context.Background(), buf, &Fetcher{AllowedHosts: []string{host.Hostname()}})
+	require.Nil(t, err)
+	require.Len(t, manifest.Attachments, 3)
+	require.Equal(t, "remittance/1-INV-2021-0042.pdf", manifest.Attachments[0].File)
+	require.Equal(t, "application/pdf", manifest.Attachments[0].ContentType)
+	require.Equal(t, len(advice), manifest.Attachments[0].Size)
+	require.Equal(t, MethodEmail, manifest.Attachments[1].Method)
+	require.Empty(t, manifest.Attachments[1].File)
+	require.Equal(t, "INV-2021-0043", manifest.Attachments[2].RemittanceID)
+
+	archive, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	require.Nil(t, err)
+	files := make(map[string][]byte)
+	for _, f := range archive.File {
+		r, err := f.Open()
+		require.Nil(t, err)
+		files[f.Name], _ = io.ReadAll(r)
+		r.Close()
+	}
+	require.Equal(t, content, files["payment.xml"])
+	require.Equal(t, advice, files["remittance/1-INV-2021-0042.pdf"])
+	var written Manifest
+	require.Nil(t, json.Unmarshal(files[ManifestName], &written))
+	require.Equal(t, manifest, written)
+
+	// hosts that aren't allowed, missing and large documents are listed with their error
+	manifest, err = pkg.Write(context.Background(), io.Discard, &Fetcher{})
+	require.Nil(t, err)
+	require.Contains(t, manifest.Attachments[0].Error, ErrHostNotAllowed.Error())
+	fetcher := &Fetcher{AllowedHosts: []string{host.Hostname()}, MaxSize: 32}
+	_, err = fetcher.Fetch(context.Background(), Location{Method: MethodURI, Address: docs.URL + "/large"})
+	require.ErrorIs(t, err, ErrTooLarge)
+	_, err = fetcher.Fetch(context.Background(), Location{Method: MethodURI, Address: docs.URL + "/missing"})
+	require.ErrorContains(t, err, "404 Not Found")
+
+	// without fetcher references are listed only
+	manifest, err = pkg.Write(context.Background(), io.Discard, nil)
+	require.Nil(t, err)
+	require.Len(t, manifest.Attachments, 3)
+	require.Empty(t, manifest.Attachments[0].File+manifest.Attachments[0].Error)
+}
+
+func TestAllowedHosts(t *testing.T) {
+	f := &Fetcher{AllowedHosts: []string{"docs.example.com", ".example.org"}}
+	require.True(t, f.allowed("DOCS.example.com"))
+	require.True(t, f.allowed("advices.example.org"))
+	require.False(t, f.allowed("example.org"))
+	require.False(t, f.allowed("docs.example.com.attacker.net"))
+	require.False(t, f.allowed("example.com"))
+}
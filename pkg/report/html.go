@@ -0,0 +1,70 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package report
+
+import (
+	"html/template"
+	"io"
+)
+
+var htmlTemplate = template.Must(template.New("report").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>Validation report{{if .Name}} of {{.Name}}{{end}}</title>
+<style>
+body { font-family: Helvetica, Arial, sans-serif; margin: 2em; color: #222; }
+table.summary td { padding: 0.2em 1em 0.2em 0; }
+.valid { color: #1a7f37; }
+.invalid { color: #cf222e; }
+.finding { border-top: 1px solid #ddd; margin-top: 1em; }
+pre { background: #f6f8fa; padding: 0.5em; overflow-x: auto; }
+pre .marked { background: #ffebe9; display: block; }
+</style>
+</head>
+<body>
+<h1>Validation report</h1>
+<table class="summary">
+{{- if .Name}}
+<tr><td>File</td><td>{{.Name}}</td></tr>
+{{- end}}
+<tr><td>Message type</td><td>{{if .MessageType}}{{.MessageType}}{{else}}unknown{{end}}</td></tr>
+<tr><td>Generated</td><td>{{.Generated.Format "2006-01-02T15:04:05Z07:00"}}</td></tr>
+<tr><td>Result</td><td>{{if .Valid}}<strong class="valid">valid</strong>{{else}}<strong class="invalid">invalid</strong>{{end}}</td></tr>
+</table>
+{{- if .Error}}
+<h2>Error</h2>
+<p>{{.Error}}</p>
+{{- end}}
+{{- if .Findings}}
+<h2>Findings ({{len .Findings}})</h2>
+{{- range $i, $f := .Findings}}
+<div class="finding">
+<h3>{{$f.Path}}</h3>
+<p>{{$f.Message}}</p>
+<p>Value: <code>{{$f.Value}}</code>{{if $f.Line}}, line {{$f.Line}}{{end}}</p>
+{{- if $f.Context}}
+<pre>{{range $f.Context}}<span{{if .Marked}} class="marked"{{end}}>{{printf "%4d" .Number}} | {{.Text}}</span>
+{{end}}</pre>
+{{- end}}
+</div>
+{{- end}}
+{{- end}}
+{{- if .Warnings}}
+<h2>Warnings</h2>
+<ul>
+{{- range .Warnings}}
+<li>{{.}}</li>
+{{- end}}
+</ul>
+{{- end}}
+</body>
+</html>
+`))
+
+// HTML writes r to w as an html document
+func (r *Report) HTML(w io.Writer) error {
+	return htmlTemplate.Execute(w, r)
+}
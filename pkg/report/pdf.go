@@ -0,0 +1,221 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package report
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// A4 pages in points, the text is kept within the margin
+const (
+	pageWidth  = 595.0
+	pageHeight = 842.0
+	pageMargin = 50.0
+)
+
+// fonts are the standard fonts of the pdf documents, no font file is embedded
+var fonts = []struct {
+	name     string
+	resource string
+	// width of a character relative to the font size, an average for proportional fonts
+	width float64
+}{
+	{name: "Helvetica", resource: "F1", width: 0.55},
+	{name: "Helvetica-Bold", resource: "F2", width: 0.6},
+	{name: "Courier", resource: "F3", width: 0.6},
+}
+
+const (
+	fontRegular = iota
+	fontBold
+	fontMono
+)
+
+type pdfLine struct {
+	font int
+	size float64
+	text string
+	// space before the line
+	gap float64
+}
+
+// PDF writes r to w as a pdf document
+func (r *Report) PDF(w io.Writer) error {
+	pages := paginate(wrap(r.pdfLines()))
+
+	var buf bytes.Buffer
+	var offsets []int
+	object := func(body string) {
+		offsets = append(offsets, buf.Len())
+		fmt.Fprintf(&buf, "%d 0 obj\n%s\nendobj\n", len(offsets), body)
+	}
+
+	// the objects of the pages follow the catalog, page tree and font objects
+	firstPage := 3 + len(fonts)
+	kids := make([]string, len(pages))
+	for i := range pages {
+		kids[i] = strconv.Itoa(firstPage+2*i) + " 0 R"
+	}
+	var resources strings.Builder
+	for i, font := range fonts {
+		fmt.Fprintf(&resources, "/%s %d 0 R ", font.resource, 3+i)
+	}
+
+	buf.WriteString("%PDF-1.4\n")
+	object("<< /Type /Catalog /Pages 2 0 R >>")
+	object(fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(pages)))
+	for _, font := range fonts {
+		object(fmt.Sprintf("<< /Type /Font /Subtype /Type1 /BaseFont /%s /Encoding /WinAnsiEncoding >>", font.name))
+	}
+	for i, page := range pages {
+		object(fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %g %g] /Resources << /Font << %s>> >> /Contents %d 0 R >>",
+			pageWidth, pageHeight, resources.String(), firstPage+2*i+1))
+		object(fmt.Sprintf("<< /Length %d >>\nstream\n%sendstream", len(page), page))
+	}
+
+	xref := buf.Len()
+	fmt.Fprintf(&buf, "xref\n0 %d\n0000000000 65535 f \n", len(offsets)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(&buf, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(&buf, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(offsets)+1, xref)
+
+	_, err := w.Write(buf.Bytes())
+	return err
+}
+
+// pdfLines returns the lines of the pdf document of r
+func (r *Report) pdfLines() []pdfLine {
+	text := func(font int, size, gap float64, format string, args ...interface{}) pdfLine {
+		return pdfLine{font: font, size: size, gap: gap, text: fmt.Sprintf(format, args...)}
+	}
+
+	lines := []pdfLine{text(fontBold, 18, 0, "Validation report")}
+	if r.Name != "" {
+		lines = append(lines, text(fontRegular, 10, 8, "File: %s", r.Name))
+	}
+	messageType := r.MessageType
+	if messageType == "" {
+		messageType = "unknown"
+	}
+	result := "valid"
+	if !r.Valid {
+		result = "invalid"
+	}
+	lines = append(lines,
+		text(fontRegular, 10, 0, "Message type: %s", messageType),
+		text(fontRegular, 10, 0, "Generated: %s", r.Generated.Format("2006-01-02T15:04:05Z07:00")),
+		text(fontBold, 10, 0, "Result: %s", result),
+	)
+
+	if r.Error != "" {
+		lines = append(lines, text(fontBold, 13, 12, "Error"), text(fontRegular, 10, 2, "%s", r.Error))
+	}
+	if len(r.Findings) > 0 {
+		lines = append(lines, text(fontBold, 13, 12, "Findings (%d)", len(r.Findings)))
+		for i, finding := range r.Findings {
+			lines = append(lines,
+				text(fontBold, 10, 8, "%d. %s", i+1, finding.Path),
+				text(fontRegular, 10, 0, "%s", finding.Message),
+			)
+			value := "Value: " + finding.Value
+			if finding.Line > 0 {
+				value += ", line " + strconv.Itoa(finding.Line)
+			}
+			lines = append(lines, text(fontRegular, 10, 0, "%s", value))
+			for j, line := range finding.Context {
+				marker := " "
+				if line.Marked {
+					marker = ">"
+				}
+				gap := 0.0
+				if j == 0 {
+					gap = 4
+				}
+				lines = append(lines, text(fontMono, 8, gap, "%s%4d | %s", marker, line.Number, line.Text))
+			}
+		}
+	}
+	if len(r.Warnings) > 0 {
+		lines = append(lines, text(fontBold, 13, 12, "Warnings"))
+		for _, warning := range r.Warnings {
+			lines = append(lines, text(fontRegular, 10, 2, "- %s", warning))
+		}
+	}
+	return lines
+}
+
+// wrap splits lines too long for the width of a page
+func wrap(lines []pdfLine) []pdfLine {
+	var wrapped []pdfLine
+	for _, line := range lines {
+		text := []rune(strings.ReplaceAll(line.text, "\t", "    "))
+		max := int((pageWidth - 2*pageMargin) / (line.size * fonts[line.font].width))
+		for len(text) > max {
+			cut := max
+			// proportional text is cut after a space when there is one
+			if line.font != fontMono {
+				for i := max - 1; i > 0; i-- {
+					if text[i] == ' ' {
+						cut = i + 1
+						break
+					}
+				}
+			}
+			part := line
+			part.text = string(text[:cut])
+			wrapped = append(wrapped, part)
+			text = text[cut:]
+			line.gap = 0
+		}
+		line.text = string(text)
+		wrapped = append(wrapped, line)
+	}
+	return wrapped
+}
+
+// paginate returns the content streams of the pages holding lines
+func paginate(lines []pdfLine) []string {
+	var pages []string
+	var page strings.Builder
+	y := pageHeight - pageMargin
+	for _, line := range lines {
+		height := line.gap + line.size*1.3
+		if y-height < pageMargin && page.Len() > 0 {
+			pages = append(pages, page.String())
+			page.Reset()
+			y = pageHeight - pageMargin
+		} else {
+			y -= line.gap
+		}
+		y -= line.size * 1.3
+		fmt.Fprintf(&page, "BT /%s %g Tf %g %.2f Td (%s) Tj ET\n", fonts[line.font].resource, line.size, pageMargin, y, escape(line.text))
+	}
+	return append(pages, page.String())
+}
+
+// escape returns text as the content of a pdf string in the WinAnsiEncoding of the fonts,
+// characters the encoding doesn't hold are replaced with a question mark
+func escape(text string) string {
+	var buf strings.Builder
+	for _, c := range text {
+		switch {
+		case c == '\\' || c == '(' || c == ')':
+			buf.WriteByte('\\')
+			buf.WriteRune(c)
+		case c >= 0x20 && c < 0x7f:
+			buf.WriteRune(c)
+		case c >= 0xa0 && c <= 0xff:
+			fmt.Fprintf(&buf, "\\%03o", c)
+		default:
+			buf.WriteByte('?')
+		}
+	}
+	return buf.String()
+}
@@ -0,0 +1,68 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package report
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/moov-io/iso20022/pkg/document"
+)
+
+func TestPDF(t *testing.T) {
+	input := invalidInput(t)
+	doc, err := document.ParseIso20022Document(input)
+	require.Nil(t, err)
+	r := New("payment (1).xml", input, doc, doc.Validate())
+
+	var buf bytes.Buffer
+	require.Nil(t, r.Encode(&buf, FormatPDF))
+	pdf := buf.String()
+	require.True(t, strings.HasPrefix(pdf, "%PDF-1.4\n"))
+	require.True(t, strings.HasSuffix(pdf, "%%EOF\n"))
+	require.Contains(t, pdf, `(File: payment \(1\).xml) Tj`)
+	require.Contains(t, pdf, "(Result: invalid) Tj")
+	require.Contains(t, pdf, "(1. GrpHdr/TtlIntrBkSttlmAmt/@Ccy) Tj")
+
+	// the cross-reference table points at every object
+	xref := regexp.MustCompile(`startxref\n(\d+)\n`).FindStringSubmatch(pdf)
+	require.Len(t, xref, 2)
+	start, err := strconv.Atoi(xref[1])
+	require.Nil(t, err)
+	require.True(t, strings.HasPrefix(pdf[start:], "xref\n"))
+	for i, offset := range regexp.MustCompile(`(\d{10}) 00000 n `).FindAllStringSubmatch(pdf, -1) {
+		n, err := strconv.Atoi(offset[1])
+		require.Nil(t, err)
+		require.True(t, strings.HasPrefix(pdf[n:], fmt.Sprintf("%d 0 obj\n", i+1)))
+	}
+}
+
+func TestPaginate(t *testing.T) {
+	r := &Report{}
+	for i := 0; i < 100; i++ {
+		r.Warnings = append(r.Warnings, strings.Repeat("long warning ", 20))
+	}
+	lines := wrap(r.pdfLines())
+	require.Greater(t, len(lines), 200)
+	for _, line := range lines {
+		require.LessOrEqual(t, len(line.text), 90)
+	}
+
+	pages := paginate(lines)
+	require.Greater(t, len(pages), 1)
+	var buf bytes.Buffer
+	require.Nil(t, r.PDF(&buf))
+	require.Contains(t, buf.String(), fmt.Sprintf("/Count %d", len(pages)))
+}
+
+func TestEscape(t *testing.T) {
+	require.Equal(t, `a\(b\)\\c \351 ?`, escape("a(b)\\c é €"))
+}
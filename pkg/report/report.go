@@ -0,0 +1,272 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package report
+
+/*
+	Report describes the validation of a document in a form that can be attached to incident
+	tickets or sent to counterparties. Every invalid element of the document is a finding with
+	its path, value and the lines of the input around it:
+
+		rep := report.New("payment.xml", input, doc, doc.Validate())
+		err := rep.Encode(w, report.FormatPDF)
+*/
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/moov-io/iso20022/pkg/document"
+	"github.com/moov-io/iso20022/pkg/utils"
+)
+
+// Format is the document format of a report
+type Format string
+
+const (
+	FormatHTML Format = "html"
+	FormatPDF  Format = "pdf"
+)
+
+// contextLines is the number of input lines shown before and after the line of a finding
+const contextLines = 2
+
+// ContentType returns the media type of documents in format f
+func (f Format) ContentType() string {
+	switch f {
+	case FormatHTML:
+		return "text/html; charset=utf-8"
+	case FormatPDF:
+		return "application/pdf"
+	}
+	return "application/octet-stream"
+}
+
+// ParseFormat returns the report format named by value
+func ParseFormat(value string) (Format, error) {
+	switch f := Format(strings.ToLower(value)); f {
+	case FormatHTML, FormatPDF:
+		return f, nil
+	}
+	return "", errors.New(value + " is an invalid report format")
+}
+
+// Report is the validation report of a document
+type Report struct {
+	Name        string `json:",omitempty"`
+	MessageType string
+	Generated   time.Time
+	Valid       bool
+
+	// Error is the validation error of the document
+	Error    string    `json:",omitempty"`
+	Findings []Finding `json:",omitempty"`
+	Warnings []string  `json:",omitempty"`
+}
+
+// Finding is an invalid element of a document
+type Finding struct {
+	Path    string
+	Value   string
+	Message string
+
+	// Line of the element in the input, zero when it isn't found
+	Line    int    `json:",omitempty"`
+	Context []Line `json:",omitempty"`
+}
+
+// Line is a line of the input
+type Line struct {
+	Number int
+	Text   string
+	Marked bool `json:",omitempty"`
+}
+
+type validator interface {
+	Validate() error
+}
+
+// New returns the report of doc parsed from input and named name, err is its validation error
+func New(name string, input []byte, doc document.Iso20022Document, err error) *Report {
+	r := &Report{
+		Name:      name,
+		Generated: time.Now().UTC(),
+		Valid:     err == nil,
+	}
+	if doc != nil {
+		r.MessageType = utils.GetMessageType(doc.NameSpace())
+	}
+	if err == nil {
+		return r
+	}
+	r.Error = err.Error()
+	if doc == nil {
+		return r
+	}
+
+	msg := doc.InspectMessage()
+	texts := make(map[string]string)
+	utils.WalkElements(msg, func(path, value string) {
+		texts[path] = value
+	})
+	utils.WalkElementValues(msg, func(path string, value reflect.Value) {
+		if err := validate(value); err != nil {
+			r.Findings = append(r.Findings, Finding{Path: path, Value: texts[path], Message: err.Error()})
+		}
+	})
+	locate(input, r.Findings)
+	return r
+}
+
+// Mask replaces every text of the input in r with mask(text), e.g. to hide personal data
+func (r *Report) Mask(mask func(text string) string) {
+	r.Error = mask(r.Error)
+	for i := range r.Findings {
+		finding := &r.Findings[i]
+		finding.Value = mask(finding.Value)
+		finding.Message = mask(finding.Message)
+		for j := range finding.Context {
+			finding.Context[j].Text = mask(finding.Context[j].Text)
+		}
+	}
+	for i := range r.Warnings {
+		r.Warnings[i] = mask(r.Warnings[i])
+	}
+}
+
+// Encode writes r to w in format
+func (r *Report) Encode(w io.Writer, format Format) error {
+	switch format {
+	case FormatHTML:
+		return r.HTML(w)
+	case FormatPDF:
+		return r.PDF(w)
+	}
+	return errors.New(string(format) + " is an invalid report format")
+}
+
+// validate returns the validation error of a leaf value of a document
+func validate(value reflect.Value) error {
+	if v, ok := value.Interface().(validator); ok {
+		return v.Validate()
+	}
+	if value.CanAddr() {
+		if v, ok := value.Addr().Interface().(validator); ok {
+			return v.Validate()
+		}
+	}
+	return nil
+}
+
+// locate sets the line and context of findings in input
+func locate(input []byte, findings []Finding) {
+	if len(findings) == 0 {
+		return
+	}
+	lines := strings.Split(strings.ReplaceAll(string(input), "\r\n", "\n"), "\n")
+	var positions map[string]int
+	if utils.GetDocumentFormat(input) == utils.DocumentTypeXml {
+		positions = elementLines(input)
+	}
+
+	for i := range findings {
+		finding := &findings[i]
+		if positions != nil {
+			finding.Line = positions[elementKey(finding.Path)]
+		} else {
+			finding.Line = searchLine(lines, finding.Path, finding.Value)
+		}
+		if finding.Line == 0 {
+			continue
+		}
+		for n := finding.Line - contextLines; n <= finding.Line+contextLines; n++ {
+			if n < 1 || n > len(lines) {
+				continue
+			}
+			finding.Context = append(finding.Context, Line{Number: n, Text: lines[n-1], Marked: n == finding.Line})
+		}
+	}
+}
+
+// elementKey returns the path of the element holding the value of path with the index of
+// every element, e.g. "GrpHdr[0]/TtlIntrBkSttlmAmt[0]" for "GrpHdr/TtlIntrBkSttlmAmt/@Ccy"
+func elementKey(path string) string {
+	segments := strings.Split(path, "/")
+	if last := segments[len(segments)-1]; strings.HasPrefix(last, "@") {
+		segments = segments[:len(segments)-1]
+	}
+	for i, segment := range segments {
+		if !strings.HasSuffix(segment, "]") {
+			segments[i] = segment + "[0]"
+		}
+	}
+	return strings.Join(segments, "/")
+}
+
+// elementLines returns the line of every element of the message of an xml document by its key
+func elementLines(input []byte) map[string]int {
+	type frame struct {
+		key    string
+		counts map[string]int
+	}
+
+	positions := make(map[string]int)
+	stack := []frame{{counts: make(map[string]int)}}
+	decoder := xml.NewDecoder(bytes.NewReader(input))
+	line, offset := 1, 0
+	for {
+		start := int(decoder.InputOffset())
+		token, err := decoder.Token()
+		if err != nil {
+			return positions
+		}
+		line += bytes.Count(input[offset:start], []byte("\n"))
+		offset = start
+
+		switch t := token.(type) {
+		case xml.StartElement:
+			parent := &stack[len(stack)-1]
+			name := t.Name.Local
+			key := name + "[" + strconv.Itoa(parent.counts[name]) + "]"
+			parent.counts[name]++
+			// paths of findings start below the Document and the message elements
+			if len(stack) > 2 {
+				key = strings.TrimPrefix(parent.key+"/"+key, "/")
+				positions[key] = line
+			} else {
+				key = ""
+			}
+			stack = append(stack, frame{key: key, counts: make(map[string]int)})
+		case xml.EndElement:
+			stack = stack[:len(stack)-1]
+		}
+	}
+}
+
+// searchLine returns the first line of lines with the name of the element of path and its
+// value, or with the value only
+func searchLine(lines []string, path, value string) int {
+	name := path[strings.LastIndex(path, "/")+1:]
+	if i := strings.Index(name, "["); i >= 0 {
+		name = name[:i]
+	}
+	name = strings.TrimPrefix(name, "@")
+	for i, line := range lines {
+		if strings.Contains(line, `"`+name+`"`) && strings.Contains(line, value) {
+			return i + 1
+		}
+	}
+	for i, line := range lines {
+		if value != "" && strings.Contains(line, value) {
+			return i + 1
+		}
+	}
+	return 0
+}
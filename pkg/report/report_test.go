@@ -0,0 +1,104 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package report
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/moov-io/iso20022/pkg/document"
+	"github.com/moov-io/iso20022/pkg/iso20022test/fixtures"
+)
+
+func invalidInput(t *testing.T) []byte {
+	return bytes.Replace(fixtures.ReadFile(t, "valid_pacs_v08.xml"), []byte(`Ccy="USD"`), []byte(`Ccy="usd"`), 1)
+}
+
+func TestNew(t *testing.T) {
+	input := invalidInput(t)
+	doc, err := document.ParseIso20022Document(input)
+	require.Nil(t, err)
+
+	r := New("payment.xml", input, doc, doc.Validate())
+	require.False(t, r.Valid)
+	require.Equal(t, "pacs.008.001.08", r.MessageType)
+	require.Contains(t, r.Error, "ActiveCurrencyCode")
+	require.Len(t, r.Findings, 1)
+
+	finding := r.Findings[0]
+	require.Equal(t, "GrpHdr/TtlIntrBkSttlmAmt/@Ccy", finding.Path)
+	require.Equal(t, "usd", finding.Value)
+	require.Equal(t, "The value of ActiveCurrencyCode is invalid", finding.Message)
+	require.Equal(t, 7, finding.Line)
+	require.Len(t, finding.Context, 5)
+	require.Equal(t, Line{Number: 7, Text: "\t\t\t<TtlIntrBkSttlmAmt Ccy=\"usd\">250500.75</TtlIntrBkSttlmAmt>", Marked: true}, finding.Context[2])
+
+	valid := fixtures.ReadFile(t, "valid_pacs_v08.xml")
+	doc, err = document.ParseIso20022Document(valid)
+	require.Nil(t, err)
+	r = New("", valid, doc, nil)
+	require.True(t, r.Valid)
+	require.Empty(t, r.Findings)
+}
+
+func TestLocateJson(t *testing.T) {
+	input := []byte("{\n  \"GrpHdr\": {\n    \"MsgId\": \"MSG-1\",\n    \"TtlIntrBkSttlmAmt\": {\n      \"Ccy\": \"usd\"\n    }\n  }\n}")
+	findings := []Finding{{Path: "GrpHdr/TtlIntrBkSttlmAmt/@Ccy", Value: "usd"}, {Path: "GrpHdr/Missing", Value: "value"}}
+	locate(input, findings)
+	require.Equal(t, 5, findings[0].Line)
+	require.Len(t, findings[0].Context, 5)
+	require.Zero(t, findings[1].Line)
+	require.Empty(t, findings[1].Context)
+}
+
+func TestElementKey(t *testing.T) {
+	require.Equal(t, "GrpHdr[0]/TtlIntrBkSttlmAmt[0]", elementKey("GrpHdr/TtlIntrBkSttlmAmt/@Ccy"))
+	require.Equal(t, "CdtTrfTxInf[1]/PmtId[0]/EndToEndId[0]", elementKey("CdtTrfTxInf[1]/PmtId/EndToEndId"))
+}
+
+func TestMask(t *testing.T) {
+	r := &Report{
+		Error:    "secret",
+		Findings: []Finding{{Value: "secret", Message: "secret", Context: []Line{{Text: "secret"}}}},
+		Warnings: []string{"secret"},
+	}
+	r.Mask(func(text string) string { return strings.ReplaceAll(text, "secret", "***") })
+	require.Equal(t, "***", r.Error)
+	require.Equal(t, Finding{Value: "***", Message: "***", Context: []Line{{Text: "***"}}}, r.Findings[0])
+	require.Equal(t, []string{"***"}, r.Warnings)
+}
+
+func TestHTML(t *testing.T) {
+	input := invalidInput(t)
+	doc, err := document.ParseIso20022Document(input)
+	require.Nil(t, err)
+	r := New("<payment>.xml", input, doc, doc.Validate())
+	r.Generated = time.Date(2021, 4, 15, 10, 30, 0, 0, time.UTC)
+
+	var buf bytes.Buffer
+	require.Nil(t, r.Encode(&buf, FormatHTML))
+	html := buf.String()
+	require.Contains(t, html, "<title>Validation report of &lt;payment&gt;.xml</title>")
+	require.Contains(t, html, "2021-04-15T10:30:00Z")
+	require.Contains(t, html, `<strong class="invalid">invalid</strong>`)
+	require.Contains(t, html, "<h3>GrpHdr/TtlIntrBkSttlmAmt/@Ccy</h3>")
+	require.Contains(t, html, `<span class="marked">   7 | 			&lt;TtlIntrBkSttlmAmt Ccy=&#34;usd&#34;&gt;`)
+}
+
+func TestFormat(t *testing.T) {
+	format, err := ParseFormat("PDF")
+	require.Nil(t, err)
+	require.Equal(t, FormatPDF, format)
+	require.Equal(t, "application/pdf", format.ContentType())
+	require.Equal(t, "text/html; charset=utf-8", FormatHTML.ContentType())
+
+	_, err = ParseFormat("docx")
+	require.EqualError(t, err, "docx is an invalid report format")
+	require.EqualError(t, (&Report{}).Encode(&bytes.Buffer{}, "docx"), "docx is an invalid report format")
+}
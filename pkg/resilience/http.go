@@ -0,0 +1,117 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package resilience
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// statusError is a response with a status code worth retrying
+type statusError struct {
+	resp *http.Response
+}
+
+func (e statusError) Error() string {
+	return fmt.Sprintf("%s %s responded %s", e.resp.Request.Method, e.resp.Request.URL.Redacted(), e.resp.Status)
+}
+
+// retryAfterError asks to wait before retrying, e.g. with the Retry-After header of a response
+type retryAfterError struct {
+	statusError
+	wait time.Duration
+}
+
+// retryable reports whether a response with code is worth retrying: too many requests and
+// the unavailability of the server or its upstream
+func retryable(code int) bool {
+	switch code {
+	case http.StatusTooManyRequests, http.StatusInternalServerError, http.StatusBadGateway,
+		http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// retryAfter returns the wait of the Retry-After header of resp in seconds, zero without one
+func retryAfter(resp *http.Response) time.Duration {
+	seconds, err := strconv.Atoi(resp.Header.Get("Retry-After"))
+	if err != nil || seconds < 0 {
+		return 0
+	}
+	return time.Duration(seconds) * time.Second
+}
+
+type roundTripper struct {
+	guard *Guard
+	next  http.RoundTripper
+}
+
+// RoundTripper returns a transport calling next through g, http.DefaultTransport when nil.
+// Transport errors and responses with 429, 500, 502, 503 or 504 are retried, honoring the
+// Retry-After of the response within the max backoff. Requests with a body are only retried
+// when it can be read again (GetBody), the last response is returned to the caller as is.
+func (g *Guard) RoundTripper(next http.RoundTripper) http.RoundTripper {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	return &roundTripper{guard: g, next: next}
+}
+
+func (t *roundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	retries := t.guard.policy.Retries
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		retries = 0
+	}
+
+	var resp *http.Response
+	attempts := 0
+	err := t.guard.do(req.Context(), retries, func(ctx context.Context) error {
+		// the response of the failed attempt is discarded once it is retried
+		if resp != nil {
+			io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+			resp = nil
+		}
+		attempt := req
+		if attempts > 0 && req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return Permanent(err)
+			}
+			attempt = req.Clone(ctx)
+			attempt.Body = body
+		}
+		attempts++
+
+		var err error
+		if resp, err = t.next.RoundTrip(attempt); err != nil {
+			return err
+		}
+		if retryable(resp.StatusCode) {
+			return retryAfterError{statusError: statusError{resp: resp}, wait: retryAfter(resp)}
+		}
+		return nil
+	})
+	if resp != nil {
+		return resp, nil
+	}
+	return nil, err
+}
+
+// Client returns a copy of client sending its requests through g, a client without timeout
+// when nil. The timeout of client bounds the retries of a request too.
+func (g *Guard) Client(client *http.Client) *http.Client {
+	c := &http.Client{}
+	if client != nil {
+		*c = *client
+	}
+	c.Transport = g.RoundTripper(c.Transport)
+	return c
+}
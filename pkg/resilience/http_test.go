@@ -0,0 +1,107 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package resilience
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestClientRetries(t *testing.T) {
+	var calls int32
+	var bodies []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, string(body))
+		if atomic.AddInt32(&calls, 1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer ts.Close()
+
+	g, _ := testGuard(Policy{Retries: 2, Backoff: time.Millisecond})
+	client := g.Client(&http.Client{Timeout: time.Second})
+
+	resp, err := client.Post(ts.URL, "application/json", bytes.NewReader([]byte(`{"id":1}`)))
+	require.NoError(t, err)
+	resp.Body.Close()
+	require.Equal(t, http.StatusAccepted, resp.StatusCode)
+	require.Equal(t, []string{`{"id":1}`, `{"id":1}`, `{"id":1}`}, bodies)
+	require.Equal(t, time.Second, client.Timeout)
+
+	// the last response is returned once the retries are exhausted
+	atomic.StoreInt32(&calls, -10)
+	resp, err = client.Get(ts.URL)
+	require.NoError(t, err)
+	resp.Body.Close()
+	require.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+	require.Equal(t, 1, g.Stats().Failures)
+}
+
+func TestClientDoesNotRetry(t *testing.T) {
+	var calls int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	defer ts.Close()
+
+	g, _ := testGuard(Policy{Retries: 2, Backoff: time.Millisecond, FailureThreshold: 1})
+	resp, err := g.Client(nil).Get(ts.URL)
+	require.NoError(t, err)
+	resp.Body.Close()
+	require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	require.Equal(t, int32(1), atomic.LoadInt32(&calls))
+	require.Equal(t, StateClosed, g.Stats().State)
+
+	// bodies that can't be read again aren't retried
+	ts.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusBadGateway)
+	})
+	req, err := http.NewRequest(http.MethodPost, ts.URL, io.NopCloser(bytes.NewReader([]byte("body"))))
+	require.NoError(t, err)
+	resp, err = g.Client(nil).Do(req)
+	require.NoError(t, err)
+	resp.Body.Close()
+	require.Equal(t, int32(2), atomic.LoadInt32(&calls))
+}
+
+func TestClientCircuitBreaker(t *testing.T) {
+	var calls int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer ts.Close()
+
+	g, _ := testGuard(Policy{FailureThreshold: 1, OpenTimeout: time.Minute})
+	client := g.Client(nil)
+	resp, err := client.Get(ts.URL)
+	require.NoError(t, err)
+	resp.Body.Close()
+
+	_, err = client.Get(ts.URL)
+	require.ErrorIs(t, err, ErrCircuitOpen)
+	require.Equal(t, int32(1), atomic.LoadInt32(&calls))
+}
+
+func TestRetryAfter(t *testing.T) {
+	resp := &http.Response{Header: http.Header{}}
+	require.Equal(t, time.Duration(0), retryAfter(resp))
+	resp.Header.Set("Retry-After", "3")
+	require.Equal(t, 3*time.Second, retryAfter(resp))
+	resp.Header.Set("Retry-After", "Wed, 21 Oct 2015 07:28:00 GMT")
+	require.Equal(t, time.Duration(0), retryAfter(resp))
+}
@@ -0,0 +1,343 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package resilience
+
+/*
+	Resilience keeps a flaky dependency from cascading into request failures: a guard retries
+	the failing calls of an integration with exponential backoff and opens a circuit breaker
+	after consecutive failures, failing the calls fast until a trial call succeeds again.
+
+		guard := resilience.New("ledger", resilience.DefaultPolicy)
+		client := guard.Client(&http.Client{Timeout: 10 * time.Second})
+
+		err := guard.Do(ctx, func(ctx context.Context) error {
+			return publish(ctx, event)
+		})
+
+	Registered guards report their calls, retries, failures and breaker state with Snapshot.
+*/
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"sort"
+	"sync"
+	"time"
+)
+
+// Policy - Defines the retries and circuit breaker of the calls of an integration, the zero
+// policy is DefaultPolicy
+type Policy struct {
+	// Disabled calls the integration without retries nor breaker
+	Disabled bool
+
+	// Retries of a failing call, none when zero
+	Retries int
+
+	// Backoff before the first retry, doubled for every retry up to MaxBackoff, 100ms and 5s
+	// when zero. Waits are jittered by up to half their duration.
+	Backoff    time.Duration
+	MaxBackoff time.Duration
+
+	// FailureThreshold consecutive failed attempts open the breaker, it is never opened when zero
+	FailureThreshold int
+
+	// OpenTimeout is how long the breaker stays open before letting a trial call through, 30s when zero
+	OpenTimeout time.Duration
+}
+
+const (
+	defaultBackoff     = 100 * time.Millisecond
+	defaultMaxBackoff  = 5 * time.Second
+	defaultOpenTimeout = 30 * time.Second
+)
+
+// DefaultPolicy retries twice and opens the breaker after 5 consecutive failures
+var DefaultPolicy = Policy{
+	Retries:          2,
+	Backoff:          defaultBackoff,
+	MaxBackoff:       defaultMaxBackoff,
+	FailureThreshold: 5,
+	OpenTimeout:      defaultOpenTimeout,
+}
+
+// State is the state of a circuit breaker
+type State string
+
+const (
+	// StateClosed lets every call through
+	StateClosed State = "closed"
+
+	// StateOpen fails every call with ErrCircuitOpen
+	StateOpen State = "open"
+
+	// StateHalfOpen lets a single trial call through, closing the breaker when it succeeds
+	StateHalfOpen State = "half-open"
+)
+
+// ErrCircuitOpen is returned for the calls failed fast by an open breaker
+var ErrCircuitOpen = errors.New("circuit breaker is open")
+
+// permanentError is a failure that isn't retried
+type permanentError struct {
+	err error
+}
+
+func (e permanentError) Error() string { return e.err.Error() }
+func (e permanentError) Unwrap() error { return e.err }
+
+// Permanent marks err as a failure retrying can't fix, like a rejected request. It fails the
+// call without retries and doesn't count towards opening the breaker.
+func Permanent(err error) error {
+	if err == nil {
+		return nil
+	}
+	return permanentError{err: err}
+}
+
+// Stats are the metrics of a guard since its creation
+type Stats struct {
+	Name  string `json:"name"`
+	State State  `json:"state"`
+
+	// Calls counts the calls, Retries their retried attempts and Failures the calls failing
+	// after their retries
+	Calls    int `json:"calls"`
+	Retries  int `json:"retries"`
+	Failures int `json:"failures"`
+
+	// Rejected counts the calls failed fast by the open breaker, Opened how often it opened
+	Rejected int `json:"rejected"`
+	Opened   int `json:"opened"`
+
+	LastError   string    `json:"lastError,omitempty"`
+	LastFailure time.Time `json:"lastFailure,omitempty"`
+}
+
+// Guard applies a policy to the calls of an integration
+type Guard struct {
+	name   string
+	policy Policy
+
+	now    func() time.Time
+	jitter func(time.Duration) time.Duration
+
+	mu       sync.Mutex
+	stats    Stats
+	failures int
+	openedAt time.Time
+	trial    bool
+}
+
+// New returns the guard of the integration name applying policy
+func New(name string, policy Policy) *Guard {
+	if policy == (Policy{}) {
+		policy = DefaultPolicy
+	}
+	if policy.Backoff <= 0 {
+		policy.Backoff = defaultBackoff
+	}
+	if policy.MaxBackoff <= 0 {
+		policy.MaxBackoff = defaultMaxBackoff
+	}
+	if policy.OpenTimeout <= 0 {
+		policy.OpenTimeout = defaultOpenTimeout
+	}
+	return &Guard{
+		name:   name,
+		policy: policy,
+		now:    time.Now,
+		jitter: func(d time.Duration) time.Duration {
+			if d <= 1 {
+				return d
+			}
+			return d/2 + time.Duration(rand.Int63n(int64(d/2)))
+		},
+		stats: Stats{Name: name, State: StateClosed},
+	}
+}
+
+// Name returns the name of the integration of g
+func (g *Guard) Name() string {
+	return g.name
+}
+
+// Do calls fn until it succeeds, fails permanently or exhausts the retries of the policy,
+// waiting for the backoff between attempts. It returns ErrCircuitOpen without calling fn
+// while the breaker is open, and the error of ctx when it is done while waiting.
+func (g *Guard) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	return g.do(ctx, g.policy.Retries, fn)
+}
+
+// do calls fn like Do with at most retries retries
+func (g *Guard) do(ctx context.Context, retries int, fn func(ctx context.Context) error) error {
+	if g.policy.Disabled {
+		return fn(ctx)
+	}
+
+	g.mu.Lock()
+	g.stats.Calls++
+	g.mu.Unlock()
+
+	var err error
+	for attempt := 0; ; attempt++ {
+		if !g.allow() {
+			g.mu.Lock()
+			g.stats.Rejected++
+			g.mu.Unlock()
+			if err == nil {
+				err = fmt.Errorf("%w: %s", ErrCircuitOpen, g.name)
+			}
+			break
+		}
+
+		err = fn(ctx)
+		var permanent permanentError
+		switch {
+		case err == nil:
+			g.succeeded()
+			return nil
+		case errors.As(err, &permanent):
+			g.succeeded()
+			return permanent.err
+		}
+		g.failed()
+
+		if attempt >= retries || ctx.Err() != nil {
+			break
+		}
+		if waitErr := sleep(ctx, g.jitter(g.backoff(attempt, err))); waitErr != nil {
+			break
+		}
+		g.mu.Lock()
+		g.stats.Retries++
+		g.mu.Unlock()
+	}
+
+	g.mu.Lock()
+	g.stats.Failures++
+	g.stats.LastError = err.Error()
+	g.stats.LastFailure = g.now().UTC()
+	g.mu.Unlock()
+	return err
+}
+
+// backoff returns the wait before the retry following attempt, at least the wait asked by err
+func (g *Guard) backoff(attempt int, err error) time.Duration {
+	wait := g.policy.Backoff
+	for i := 0; i < attempt && wait < g.policy.MaxBackoff; i++ {
+		wait *= 2
+	}
+	var after retryAfterError
+	if errors.As(err, &after) && after.wait > wait {
+		wait = after.wait
+	}
+	if wait > g.policy.MaxBackoff {
+		wait = g.policy.MaxBackoff
+	}
+	return wait
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// allow reports whether the breaker lets an attempt through, an open breaker lets a single
+// trial attempt through once its timeout elapsed
+func (g *Guard) allow() bool {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	switch g.stats.State {
+	case StateOpen:
+		if g.now().Sub(g.openedAt) < g.policy.OpenTimeout {
+			return false
+		}
+		g.stats.State, g.trial = StateHalfOpen, true
+		return true
+	case StateHalfOpen:
+		// only the trial attempt is let through until it finishes
+		if g.trial {
+			return false
+		}
+		g.trial = true
+		return true
+	}
+	return true
+}
+
+func (g *Guard) succeeded() {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.failures, g.trial = 0, false
+	g.stats.State = StateClosed
+}
+
+func (g *Guard) failed() {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.failures++
+	g.trial = false
+	threshold := g.policy.FailureThreshold
+	if threshold <= 0 {
+		return
+	}
+	if g.stats.State == StateHalfOpen || (g.stats.State == StateClosed && g.failures >= threshold) {
+		g.stats.State = StateOpen
+		g.stats.Opened++
+		g.openedAt = g.now()
+	}
+}
+
+// Stats returns the metrics of g
+func (g *Guard) Stats() Stats {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	stats := g.stats
+	// an open breaker whose timeout elapsed lets the next call through
+	if stats.State == StateOpen && g.now().Sub(g.openedAt) >= g.policy.OpenTimeout {
+		stats.State = StateHalfOpen
+	}
+	return stats
+}
+
+var (
+	guardsMu sync.RWMutex
+	guards   = make(map[string]*Guard)
+)
+
+// Register adds g to the registry of guards, replacing any guard with the same name
+func Register(g *Guard) {
+	guardsMu.Lock()
+	defer guardsMu.Unlock()
+	guards[g.name] = g
+}
+
+// Unregister removes the guard with name from the registry
+func Unregister(name string) {
+	guardsMu.Lock()
+	defer guardsMu.Unlock()
+	delete(guards, name)
+}
+
+// Snapshot returns the metrics of every registered guard by name
+func Snapshot() []Stats {
+	guardsMu.RLock()
+	defer guardsMu.RUnlock()
+	stats := make([]Stats, 0, len(guards))
+	for _, g := range guards {
+		stats = append(stats, g.Stats())
+	}
+	sort.Slice(stats, func(i, j int) bool { return stats[i].Name < stats[j].Name })
+	return stats
+}
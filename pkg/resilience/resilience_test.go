@@ -0,0 +1,164 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package resilience
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func testGuard(policy Policy) (*Guard, *time.Time) {
+	g := New("test", policy)
+	now := time.Date(2021, 4, 12, 9, 0, 0, 0, time.UTC)
+	g.now = func() time.Time { return now }
+	g.jitter = func(d time.Duration) time.Duration { return d }
+	return g, &now
+}
+
+func TestDoRetries(t *testing.T) {
+	g, _ := testGuard(Policy{Retries: 2, Backoff: time.Millisecond})
+
+	calls := 0
+	err := g.Do(context.Background(), func(context.Context) error {
+		calls++
+		if calls < 3 {
+			return errors.New("unavailable")
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, 3, calls)
+
+	calls = 0
+	err = g.Do(context.Background(), func(context.Context) error {
+		calls++
+		return errors.New("unavailable")
+	})
+	require.EqualError(t, err, "unavailable")
+	require.Equal(t, 3, calls)
+
+	stats := g.Stats()
+	require.Equal(t, 2, stats.Calls)
+	require.Equal(t, 4, stats.Retries)
+	require.Equal(t, 1, stats.Failures)
+	require.Equal(t, "unavailable", stats.LastError)
+	require.Equal(t, StateClosed, stats.State)
+}
+
+func TestDoPermanent(t *testing.T) {
+	g, _ := testGuard(Policy{Retries: 2, Backoff: time.Millisecond, FailureThreshold: 1})
+
+	rejected := errors.New("rejected")
+	calls := 0
+	err := g.Do(context.Background(), func(context.Context) error {
+		calls++
+		return Permanent(rejected)
+	})
+	require.ErrorIs(t, err, rejected)
+	require.Equal(t, 1, calls)
+	// permanent failures don't open the breaker
+	require.Equal(t, StateClosed, g.Stats().State)
+	require.Nil(t, Permanent(nil))
+}
+
+func TestDoCircuitBreaker(t *testing.T) {
+	g, now := testGuard(Policy{FailureThreshold: 2, OpenTimeout: time.Minute})
+	failing := func(context.Context) error { return errors.New("unavailable") }
+
+	require.Error(t, g.Do(context.Background(), failing))
+	require.Equal(t, StateClosed, g.Stats().State)
+	require.Error(t, g.Do(context.Background(), failing))
+	require.Equal(t, StateOpen, g.Stats().State)
+
+	// calls fail fast while the breaker is open
+	called := false
+	err := g.Do(context.Background(), func(context.Context) error {
+		called = true
+		return nil
+	})
+	require.ErrorIs(t, err, ErrCircuitOpen)
+	require.False(t, called)
+
+	// a failing trial opens the breaker again
+	*now = now.Add(time.Minute)
+	require.Equal(t, StateHalfOpen, g.Stats().State)
+	require.Error(t, g.Do(context.Background(), failing))
+	require.Equal(t, StateOpen, g.Stats().State)
+
+	// a succeeding trial closes it
+	*now = now.Add(time.Minute)
+	require.NoError(t, g.Do(context.Background(), func(context.Context) error { return nil }))
+	require.Equal(t, StateClosed, g.Stats().State)
+
+	stats := g.Stats()
+	require.Equal(t, 5, stats.Calls)
+	require.Equal(t, 1, stats.Rejected)
+	require.Equal(t, 2, stats.Opened)
+	require.Equal(t, 4, stats.Failures)
+}
+
+func TestDoContext(t *testing.T) {
+	g, _ := testGuard(Policy{Retries: 5, Backoff: time.Hour, MaxBackoff: time.Hour})
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	calls := 0
+	err := g.Do(ctx, func(context.Context) error {
+		calls++
+		return errors.New("unavailable")
+	})
+	require.EqualError(t, err, "unavailable")
+	require.Equal(t, 1, calls)
+}
+
+func TestDoDisabled(t *testing.T) {
+	g, _ := testGuard(Policy{Disabled: true, Retries: 2, FailureThreshold: 1})
+	calls := 0
+	for i := 0; i < 3; i++ {
+		require.Error(t, g.Do(context.Background(), func(context.Context) error {
+			calls++
+			return errors.New("unavailable")
+		}))
+	}
+	require.Equal(t, 3, calls)
+	require.Equal(t, Stats{Name: "test", State: StateClosed}, g.Stats())
+}
+
+func TestBackoff(t *testing.T) {
+	g, _ := testGuard(Policy{Retries: 5, Backoff: time.Second, MaxBackoff: 5 * time.Second})
+	require.Equal(t, time.Second, g.backoff(0, errors.New("unavailable")))
+	require.Equal(t, 2*time.Second, g.backoff(1, errors.New("unavailable")))
+	require.Equal(t, 4*time.Second, g.backoff(2, errors.New("unavailable")))
+	require.Equal(t, 5*time.Second, g.backoff(3, errors.New("unavailable")))
+	require.Equal(t, 3*time.Second, g.backoff(0, retryAfterError{wait: 3 * time.Second}))
+	require.Equal(t, 5*time.Second, g.backoff(0, retryAfterError{wait: time.Hour}))
+
+	g = New("default", Policy{})
+	require.Equal(t, DefaultPolicy, g.policy)
+}
+
+func TestRegistry(t *testing.T) {
+	g := New("registry-test", DefaultPolicy)
+	Register(g)
+	defer Unregister(g.Name())
+
+	var found bool
+	for _, stats := range Snapshot() {
+		if stats.Name == "registry-test" {
+			found = true
+			require.Equal(t, StateClosed, stats.State)
+		}
+	}
+	require.True(t, found)
+
+	Unregister(g.Name())
+	for _, stats := range Snapshot() {
+		require.NotEqual(t, "registry-test", stats.Name)
+	}
+}
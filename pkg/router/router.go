@@ -0,0 +1,217 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package router
+
+/*
+	Router classifies parsed documents into named routes (channels) by their content.
+	Rules are evaluated in order and the first rule matching every transaction of a
+	document wins. Documents matching no rule take the default route.
+
+	Example configuration:
+
+		default: standard
+		routes:
+		  - route: high-value
+		    messageTypes: [pacs.008]
+		    currencies: [USD]
+		    minAmount: 100000
+		    clearingSystems: [FDW]
+		  - route: sepa
+		    currencies: [EUR]
+		    creditorAgentCountries: [DE, FR, NL]
+*/
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/moov-io/iso20022/pkg/document"
+	"github.com/moov-io/iso20022/pkg/utils"
+)
+
+var (
+	// ErrNoRoute is returned when no rule matches and no default route is configured
+	ErrNoRoute = errors.New("no route matched the document")
+)
+
+// Config defines the routing rules
+type Config struct {
+	Default string `yaml:"default"`
+	Routes  []Rule `yaml:"routes"`
+}
+
+// Rule matches a transaction when every configured condition matches, empty conditions match anything
+type Rule struct {
+	Route string `yaml:"route"`
+
+	// MessageTypes are prefixes of message types, e.g. "pacs.008" or "pacs.008.001.08"
+	MessageTypes []string `yaml:"messageTypes"`
+	Currencies   []string `yaml:"currencies"`
+
+	// MinAmount is inclusive, MaxAmount is exclusive
+	MinAmount *float64 `yaml:"minAmount"`
+	MaxAmount *float64 `yaml:"maxAmount"`
+
+	CreditorAgentCountries []string `yaml:"creditorAgentCountries"`
+	ClearingSystems        []string `yaml:"clearingSystems"`
+}
+
+// Attributes are the routing relevant values of a single transaction
+type Attributes struct {
+	MessageType          string
+	Currency             string
+	Amount               float64
+	CreditorAgentCountry string
+	ClearingSystem       string
+}
+
+// Router chooses routes for documents
+type Router struct {
+	config Config
+}
+
+// ParseConfig reads a routing configuration from yaml
+func ParseConfig(buf []byte) (*Config, error) {
+	config := &Config{}
+	if err := yaml.Unmarshal(buf, config); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+// LoadConfig reads a routing configuration from a yaml file
+func LoadConfig(path string) (*Config, error) {
+	buf, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return ParseConfig(buf)
+}
+
+// NewRouter returns a router after checking the configuration
+func NewRouter(config Config) (*Router, error) {
+	for i, rule := range config.Routes {
+		if rule.Route == "" {
+			return nil, fmt.Errorf("route of rule %d is omitted", i)
+		}
+		if rule.MinAmount != nil && rule.MaxAmount != nil && *rule.MinAmount >= *rule.MaxAmount {
+			return nil, fmt.Errorf("amount band of route %s is invalid", rule.Route)
+		}
+	}
+	return &Router{config: config}, nil
+}
+
+// Route returns the route of the first rule matching every transaction of doc
+func (r *Router) Route(doc document.Iso20022Document) (string, error) {
+	attrs := ExtractAttributes(doc)
+	for _, rule := range r.config.Routes {
+		matched := true
+		for _, attr := range attrs {
+			if !rule.Match(attr) {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			return rule.Route, nil
+		}
+	}
+	if r.config.Default == "" {
+		return "", ErrNoRoute
+	}
+	return r.config.Default, nil
+}
+
+// RouteTransactions returns the route of every transaction of doc
+func (r *Router) RouteTransactions(doc document.Iso20022Document) []string {
+	attrs := ExtractAttributes(doc)
+	routes := make([]string, len(attrs))
+	for i, attr := range attrs {
+		routes[i] = r.config.Default
+		for _, rule := range r.config.Routes {
+			if rule.Match(attr) {
+				routes[i] = rule.Route
+				break
+			}
+		}
+	}
+	return routes
+}
+
+// Match reports whether attr satisfies every condition of the rule
+func (rule Rule) Match(attr Attributes) bool {
+	if len(rule.MessageTypes) > 0 {
+		matched := false
+		for _, tp := range rule.MessageTypes {
+			if strings.HasPrefix(attr.MessageType, tp) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false
+		}
+	}
+	if !matchValue(rule.Currencies, attr.Currency) {
+		return false
+	}
+	if rule.MinAmount != nil && attr.Amount < *rule.MinAmount {
+		return false
+	}
+	if rule.MaxAmount != nil && attr.Amount >= *rule.MaxAmount {
+		return false
+	}
+	if !matchValue(rule.CreditorAgentCountries, attr.CreditorAgentCountry) {
+		return false
+	}
+	return matchValue(rule.ClearingSystems, attr.ClearingSystem)
+}
+
+// ExtractAttributes returns the routing attributes of every transaction of doc
+func ExtractAttributes(doc document.Iso20022Document) []Attributes {
+	messageType := utils.GetMessageType(doc.NameSpace())
+
+	var attrs []Attributes
+	for _, tx := range utils.GetTransactions(doc.InspectMessage()) {
+		attr := Attributes{
+			MessageType:    messageType,
+			Currency:       tx.Lookup("IntrBkSttlmAmt/@Ccy", "InstdAmt/@Ccy", "Amt/@Ccy"),
+			ClearingSystem: tx.Lookup("SttlmInf/ClrSys/Cd", "SttlmInf/ClrSys/Prtry", "CdtrAgt/FinInstnId/ClrSysMmbId/ClrSysId/Cd"),
+		}
+		if amount := tx.Lookup("IntrBkSttlmAmt", "InstdAmt", "Amt"); amount != "" {
+			attr.Amount, _ = strconv.ParseFloat(amount, 64)
+		}
+		attr.CreditorAgentCountry = tx.Lookup("CdtrAgt/FinInstnId/PstlAdr/Ctry")
+		if attr.CreditorAgentCountry == "" {
+			attr.CreditorAgentCountry = countryOfBIC(tx.Lookup("CdtrAgt/FinInstnId/BICFI", "CdtrAgt/FinInstnId/BIC"))
+		}
+		attrs = append(attrs, attr)
+	}
+	return attrs
+}
+
+func countryOfBIC(bic string) string {
+	if len(bic) < 6 {
+		return ""
+	}
+	return bic[4:6]
+}
+
+func matchValue(allowed []string, value string) bool {
+	if len(allowed) == 0 {
+		return true
+	}
+	for _, v := range allowed {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,71 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package router
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/moov-io/iso20022/pkg/iso20022test/fixtures"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExtractAttributes(t *testing.T) {
+	attrs := ExtractAttributes(fixtures.Document(t, "valid_pacs_v08.xml"))
+	require.Equal(t, []Attributes{
+		{MessageType: "pacs.008.001.08", Currency: "USD", Amount: 250000, CreditorAgentCountry: "GB", ClearingSystem: "FDW"},
+		{MessageType: "pacs.008.001.08", Currency: "USD", Amount: 500.75, CreditorAgentCountry: "DE", ClearingSystem: "FDW"},
+	}, attrs)
+}
+
+func TestRoute(t *testing.T) {
+	config, err := ParseConfig([]byte(`
+default: standard
+routes:
+  - route: high-value
+    messageTypes: [pacs.008]
+    minAmount: 100000
+  - route: fedwire
+    currencies: [usd]
+    clearingSystems: [FDW]
+  - route: europe
+    creditorAgentCountries: [DE]
+`))
+	require.Nil(t, err)
+
+	router, err := NewRouter(*config)
+	require.Nil(t, err)
+
+	doc := fixtures.Document(t, "valid_pacs_v08.xml")
+	route, err := router.Route(doc)
+	require.Nil(t, err)
+	require.Equal(t, "fedwire", route)
+	require.Equal(t, []string{"high-value", "fedwire"}, router.RouteTransactions(doc))
+
+	route, err = router.Route(fixtures.Document(t, "valid_pacs_v11.xml"))
+	require.Nil(t, err)
+	require.Equal(t, "standard", route)
+
+	router, err = NewRouter(Config{Routes: config.Routes[2:]})
+	require.Nil(t, err)
+	_, err = router.Route(doc)
+	require.Equal(t, ErrNoRoute, err)
+	require.Equal(t, []string{"", "europe"}, router.RouteTransactions(doc))
+}
+
+func TestNewRouter(t *testing.T) {
+	_, err := NewRouter(Config{Routes: []Rule{{}}})
+	require.NotNil(t, err)
+
+	min, max := 10.0, 5.0
+	_, err = NewRouter(Config{Routes: []Rule{{Route: "band", MinAmount: &min, MaxAmount: &max}}})
+	require.NotNil(t, err)
+
+	_, err = LoadConfig(filepath.Join("..", "..", "test", "testdata", "missing.yml"))
+	require.NotNil(t, err)
+
+	_, err = ParseConfig([]byte("routes: {"))
+	require.NotNil(t, err)
+}
@@ -0,0 +1,61 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package schedule
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCronNext(t *testing.T) {
+	from := time.Date(2026, 10, 14, 10, 17, 30, 0, time.UTC) // a Wednesday
+
+	for _, tc := range []struct {
+		expr string
+		next time.Time
+	}{
+		{"* * * * *", time.Date(2026, 10, 14, 10, 18, 0, 0, time.UTC)},
+		{"*/15 * * * *", time.Date(2026, 10, 14, 10, 30, 0, 0, time.UTC)},
+		{"0 6 * * *", time.Date(2026, 10, 15, 6, 0, 0, 0, time.UTC)},
+		{"0 6 25 * *", time.Date(2026, 10, 25, 6, 0, 0, 0, time.UTC)},
+		{"30 9 * * 1-5", time.Date(2026, 10, 15, 9, 30, 0, 0, time.UTC)},
+		{"0 0 * * 7", time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC)},
+		{"0 0 1 1,7 *", time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"@monthly", time.Date(2026, 11, 1, 0, 0, 0, 0, time.UTC)},
+		{"@hourly", time.Date(2026, 10, 14, 11, 0, 0, 0, time.UTC)},
+		// restricted day of month and day of week match either
+		{"0 0 20 * 5", time.Date(2026, 10, 16, 0, 0, 0, 0, time.UTC)},
+		{"0 0 29 2 *", time.Date(2028, 2, 29, 0, 0, 0, 0, time.UTC)},
+		{"0 0 30 2 *", time.Time{}},
+	} {
+		cron, err := ParseCron(tc.expr)
+		require.Nil(t, err, tc.expr)
+		require.Equal(t, tc.next, cron.Next(from), tc.expr)
+	}
+
+	// times are in the location of from
+	zurich, err := time.LoadLocation("Europe/Zurich")
+	require.Nil(t, err)
+	cron, err := ParseCron("0 6 * * *")
+	require.Nil(t, err)
+	require.Equal(t, time.Date(2026, 10, 15, 6, 0, 0, 0, zurich), cron.Next(from.In(zurich)))
+}
+
+func TestParseCronErrors(t *testing.T) {
+	for expr, msg := range map[string]string{
+		"* * * *":      "has 4 fields instead of 5",
+		"60 * * * *":   `minute "60" isn't within 0-59`,
+		"* * 0 * *":    `day of month "0" isn't within 1-31`,
+		"* 5-1 * * *":  `hour "5-1" isn't within 0-23`,
+		"*/0 * * * *":  `minute step "0" isn't a positive number`,
+		"* * * jan *":  `month "jan" isn't a number`,
+		"@fortnightly": "has 1 fields instead of 5",
+	} {
+		_, err := ParseCron(expr)
+		require.ErrorContains(t, err, msg, expr)
+	}
+}
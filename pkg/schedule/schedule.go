@@ -0,0 +1,372 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package schedule
+
+/*
+	Schedule generates recurring files from message templates on cron schedules, validates
+	them and delivers them to a destination sink, e.g. a directory, a bucket or a queue:
+
+		scheduler, err := schedule.New(library, map[string]pipeline.Sink{
+			"outbox": pipeline.Output(pipeline.Layout{Dir: "/var/spool/iso20022"}),
+		}, []schedule.Job{{
+			Name:        "payroll",
+			Template:    "payroll",
+			Cron:        "0 6 25 * *",
+			Variables:   map[string]string{"amount": "250000"},
+			Destination: "outbox",
+		}}, schedule.WithAlerts(alert))
+		go scheduler.Run(ctx, nil)
+
+	Every run is kept in the history of the scheduler with its outcome, failed runs are
+	reported to the alert functions.
+*/
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/moov-io/iso20022/pkg/dryrun"
+	"github.com/moov-io/iso20022/pkg/pipeline"
+	"github.com/moov-io/iso20022/pkg/templates"
+	"github.com/moov-io/iso20022/pkg/utils"
+)
+
+const (
+	// Stages of failed runs
+	StageGenerate = "generate"
+	StageValidate = "validate"
+	StageDeliver  = "deliver"
+
+	// Triggers of runs
+	TriggerSchedule = "schedule"
+	TriggerManual   = "manual"
+
+	RunStatusSucceeded = "succeeded"
+	RunStatusFailed    = "failed"
+
+	// defaultHistory is the number of runs kept by job unless configured
+	defaultHistory = 100
+)
+
+// ErrUnknownJob is returned for runs of jobs that aren't scheduled
+var ErrUnknownJob = errors.New("unknown job")
+
+// Job generates a file from a template on a schedule
+type Job struct {
+	Name string `yaml:"name" json:"name"`
+
+	// Template is the name of the template of the library
+	Template string `yaml:"template" json:"template"`
+
+	// Variables are the values of the variables of the template, names match case insensitively
+	Variables map[string]string `yaml:"variables,omitempty" json:"variables,omitempty"`
+
+	// Cron is the schedule of the job, see ParseCron, in the TimeZone (UTC when omitted)
+	Cron     string `yaml:"cron" json:"cron"`
+	TimeZone string `yaml:"timeZone,omitempty" json:"timeZone,omitempty"`
+
+	// Destination is the name of the sink the files are delivered to
+	Destination string `yaml:"destination" json:"destination"`
+}
+
+// Run is the outcome of a run of a job
+type Run struct {
+	Job      string    `json:"job"`
+	Trigger  string    `json:"trigger"`
+	Started  time.Time `json:"started"`
+	Finished time.Time `json:"finished"`
+	Status   string    `json:"status"`
+
+	// File is the name of the generated file, e.g. payroll-20261025T060000.xml
+	File string `json:"file,omitempty"`
+
+	// Stage and Error are the failing stage and its error of failed runs
+	Stage string `json:"stage,omitempty"`
+	Error string `json:"error,omitempty"`
+
+	// DryRun runs did everything but the delivery, see dryrun.With
+	DryRun bool `json:"dryRun,omitempty"`
+}
+
+// JobStatus is a job with its next scheduled run and the history of its runs, latest first
+type JobStatus struct {
+	Job
+	Next    time.Time `json:"next"`
+	History []Run     `json:"history"`
+}
+
+// Option configures a scheduler
+type Option func(*Scheduler)
+
+// WithAlerts calls fn with every failed run
+func WithAlerts(fn func(Run)) Option {
+	return func(s *Scheduler) {
+		s.alerts = append(s.alerts, fn)
+	}
+}
+
+// WithDryRun rehearses every run, files are generated and validated but not delivered
+func WithDryRun() Option {
+	return func(s *Scheduler) {
+		s.dryRun = true
+	}
+}
+
+// WithHistory keeps the last n runs of every job, 100 by default
+func WithHistory(n int) Option {
+	return func(s *Scheduler) {
+		if n > 0 {
+			s.history = n
+		}
+	}
+}
+
+type scheduledJob struct {
+	Job
+	cron     *Cron
+	location *time.Location
+	sink     pipeline.Sink
+
+	mu   sync.Mutex
+	next time.Time
+	runs []Run
+}
+
+// Scheduler runs jobs on their schedules
+type Scheduler struct {
+	library templates.Library
+	jobs    []*scheduledJob
+	alerts  []func(Run)
+	history int
+	dryRun  bool
+	now     func() time.Time
+}
+
+// New returns a scheduler of jobs instantiating the templates of library, files are delivered
+// to the destinations of the jobs by name
+func New(library templates.Library, destinations map[string]pipeline.Sink, jobs []Job, opts ...Option) (*Scheduler, error) {
+	s := &Scheduler{library: library, history: defaultHistory, now: time.Now}
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	names := make(map[string]bool)
+	for _, job := range jobs {
+		switch {
+		case job.Name == "":
+			return nil, errors.New("scheduled job: name is omitted")
+		case names[job.Name]:
+			return nil, fmt.Errorf("scheduled job %s: name is duplicated", job.Name)
+		case job.Template == "":
+			return nil, fmt.Errorf("scheduled job %s: template is omitted", job.Name)
+		}
+		names[job.Name] = true
+
+		cron, err := ParseCron(job.Cron)
+		if err != nil {
+			return nil, fmt.Errorf("scheduled job %s: %w", job.Name, err)
+		}
+		location := time.UTC
+		if job.TimeZone != "" {
+			if location, err = time.LoadLocation(job.TimeZone); err != nil {
+				return nil, fmt.Errorf("scheduled job %s: %w", job.Name, err)
+			}
+		}
+		sink, exists := destinations[job.Destination]
+		if !exists {
+			return nil, fmt.Errorf("scheduled job %s: destination %q isn't configured", job.Name, job.Destination)
+		}
+		scheduled := &scheduledJob{Job: job, cron: cron, location: location, sink: sink}
+		scheduled.schedule(s.now())
+		s.jobs = append(s.jobs, scheduled)
+	}
+	return s, nil
+}
+
+// Run runs the jobs on their schedules until ctx is done. fn, if not nil, is called with every run.
+func (s *Scheduler) Run(ctx context.Context, fn func(Run)) {
+	now := s.now()
+	for _, job := range s.jobs {
+		job.schedule(now)
+	}
+
+	for {
+		wait := time.Duration(-1)
+		for _, job := range s.jobs {
+			if next := job.nextRun(); !next.IsZero() && (wait < 0 || next.Sub(now) < wait) {
+				wait = next.Sub(now)
+			}
+		}
+		if wait < 0 {
+			// no job has a next run
+			<-ctx.Done()
+			return
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+
+		now = s.now()
+		for _, job := range s.jobs {
+			if next := job.nextRun(); next.IsZero() || next.After(now) {
+				continue
+			}
+			job.schedule(now)
+			run := s.run(ctx, job, TriggerSchedule)
+			if fn != nil {
+				fn(run)
+			}
+		}
+	}
+}
+
+// RunJob runs the job with name immediately, ErrUnknownJob when it isn't scheduled. The run
+// is rehearsed when ctx is a dry run.
+func (s *Scheduler) RunJob(ctx context.Context, name string) (Run, error) {
+	for _, job := range s.jobs {
+		if job.Name == name {
+			return s.run(ctx, job, TriggerManual), nil
+		}
+	}
+	return Run{}, fmt.Errorf("%w: %s", ErrUnknownJob, name)
+}
+
+// Jobs returns the status of every job in order of configuration
+func (s *Scheduler) Jobs() []JobStatus {
+	statuses := make([]JobStatus, 0, len(s.jobs))
+	for _, job := range s.jobs {
+		job.mu.Lock()
+		status := JobStatus{Job: job.Job, Next: job.next, History: make([]Run, 0, len(job.runs))}
+		for i := len(job.runs) - 1; i >= 0; i-- {
+			status.History = append(status.History, job.runs[i])
+		}
+		job.mu.Unlock()
+		statuses = append(statuses, status)
+	}
+	return statuses
+}
+
+// Restore replaces the history of the jobs of statuses, e.g. exported by the Jobs of another
+// scheduler, and returns the number of jobs restored. Statuses of jobs that aren't scheduled
+// are skipped, next runs follow the schedules of the scheduler.
+func (s *Scheduler) Restore(statuses []JobStatus) int {
+	restored := 0
+	for _, status := range statuses {
+		for _, job := range s.jobs {
+			if job.Name != status.Name {
+				continue
+			}
+			runs := make([]Run, 0, len(status.History))
+			for i := len(status.History) - 1; i >= 0; i-- {
+				runs = append(runs, status.History[i])
+			}
+			if len(runs) > s.history {
+				runs = runs[len(runs)-s.history:]
+			}
+			job.mu.Lock()
+			job.runs = runs
+			job.mu.Unlock()
+			restored++
+		}
+	}
+	return restored
+}
+
+func (j *scheduledJob) schedule(now time.Time) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	j.next = j.cron.Next(now.In(j.location))
+}
+
+func (j *scheduledJob) nextRun() time.Time {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	return j.next
+}
+
+// run generates, validates and delivers the file of job, sinks skip the delivery of dry runs
+func (s *Scheduler) run(ctx context.Context, job *scheduledJob, trigger string) Run {
+	if s.dryRun {
+		ctx = dryrun.With(ctx)
+	}
+	run := Run{Job: job.Name, Trigger: trigger, Started: s.now().UTC(), DryRun: dryrun.Enabled(ctx)}
+	stage, err := s.deliver(ctx, job, &run)
+	run.Finished = s.now().UTC()
+	run.Status = RunStatusSucceeded
+	if err != nil {
+		run.Status, run.Stage, run.Error = RunStatusFailed, stage, err.Error()
+	}
+
+	job.mu.Lock()
+	job.runs = append(job.runs, run)
+	if len(job.runs) > s.history {
+		job.runs = job.runs[len(job.runs)-s.history:]
+	}
+	job.mu.Unlock()
+
+	if err != nil {
+		for _, alert := range s.alerts {
+			alert(run)
+		}
+	}
+	return run
+}
+
+func (s *Scheduler) deliver(ctx context.Context, job *scheduledJob, run *Run) (string, error) {
+	tmpl, err := s.library.Get(ctx, job.Template)
+	if err != nil {
+		return StageGenerate, err
+	}
+	doc, content, err := tmpl.Instantiate(variables(tmpl, job.Variables))
+	if errors.Is(err, templates.ErrVariables) || content == nil {
+		return StageGenerate, err
+	}
+	if err != nil {
+		return StageValidate, err
+	}
+
+	run.File = fmt.Sprintf("%s-%s.%s", job.Name, run.Started.In(job.location).Format("20060102T150405"), utils.GetDocumentFormat(content))
+	item := pipeline.Item{
+		Name:     run.File,
+		Input:    content,
+		Document: doc,
+		Headers:  map[string]string{"job": job.Name, "template": tmpl.Name},
+	}
+	if err = job.sink(ctx, item); err != nil {
+		return StageDeliver, err
+	}
+	return "", nil
+}
+
+// variables returns values by the names of the variables of tmpl, names of configuration
+// files are lowercased
+func variables(tmpl *templates.Template, values map[string]string) map[string]string {
+	resolved := make(map[string]string, len(values))
+	for key, value := range values {
+		resolved[key] = value
+	}
+	for _, v := range tmpl.Variables {
+		if _, exists := resolved[v.Name]; exists {
+			continue
+		}
+		for key, value := range values {
+			if strings.EqualFold(key, v.Name) {
+				delete(resolved, key)
+				resolved[v.Name] = value
+				break
+			}
+		}
+	}
+	return resolved
+}
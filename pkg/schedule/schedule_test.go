@@ -0,0 +1,176 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package schedule
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/moov-io/iso20022/pkg/dryrun"
+	"github.com/moov-io/iso20022/pkg/iso20022test/fixtures"
+	"github.com/moov-io/iso20022/pkg/pipeline"
+	"github.com/moov-io/iso20022/pkg/templates"
+)
+
+func newLibrary(t *testing.T) templates.Library {
+	t.Helper()
+	buf := fixtures.ReadFile(t, "valid_pacs_v08.xml")
+
+	library := templates.NewMemoryLibrary()
+	require.Nil(t, library.Put(context.Background(), templates.Template{
+		Name:      "payroll",
+		Variables: []templates.Variable{{Name: "payMonth", Required: true}},
+		Content:   strings.Replace(string(buf), "MSG-20210415-0001", "PAYROLL-${payMonth}", 1),
+	}))
+	return library
+}
+
+func TestRunJob(t *testing.T) {
+	dir := t.TempDir()
+	var alerts []Run
+	scheduler, err := New(newLibrary(t), map[string]pipeline.Sink{
+		"outbox": pipeline.Output(pipeline.Layout{Dir: dir, NameTemplate: "{name}{ext}"}),
+		"broken": func(context.Context, pipeline.Item) error { return errors.New("connection refused") },
+	}, []Job{
+		// variables of configuration files are lowercased
+		{Name: "payroll", Template: "payroll", Cron: "0 6 25 * *", Variables: map[string]string{"paymonth": "2026-10"}, Destination: "outbox"},
+		{Name: "unset", Template: "payroll", Cron: "@daily", Destination: "outbox"},
+		{Name: "lost", Template: "payroll", Cron: "@daily", Variables: map[string]string{"payMonth": "2026-10"}, Destination: "broken"},
+		{Name: "missing", Template: "bonus", Cron: "@daily", Destination: "outbox"},
+	}, WithAlerts(func(run Run) { alerts = append(alerts, run) }), WithHistory(2))
+	require.Nil(t, err)
+	ctx := context.Background()
+
+	run, err := scheduler.RunJob(ctx, "payroll")
+	require.Nil(t, err)
+	require.Equal(t, RunStatusSucceeded, run.Status)
+	require.Equal(t, TriggerManual, run.Trigger)
+	require.True(t, strings.HasPrefix(run.File, "payroll-") && strings.HasSuffix(run.File, ".xml"), run.File)
+	buf, err := os.ReadFile(filepath.Join(dir, run.File))
+	require.Nil(t, err)
+	require.Contains(t, string(buf), "<MsgId>PAYROLL-2026-10</MsgId>")
+	require.Empty(t, alerts)
+
+	for name, stage := range map[string]string{"unset": StageGenerate, "lost": StageDeliver, "missing": StageGenerate} {
+		run, err = scheduler.RunJob(ctx, name)
+		require.Nil(t, err)
+		require.Equal(t, RunStatusFailed, run.Status, name)
+		require.Equal(t, stage, run.Stage, name)
+	}
+	require.Len(t, alerts, 3)
+	require.Equal(t, "connection refused", scheduler.Jobs()[2].History[0].Error)
+
+	_, err = scheduler.RunJob(ctx, "bonus")
+	require.ErrorIs(t, err, ErrUnknownJob)
+
+	// the latest runs are kept
+	for i := 0; i < 3; i++ {
+		_, err = scheduler.RunJob(ctx, "payroll")
+		require.Nil(t, err)
+	}
+	require.Len(t, scheduler.Jobs()[0].History, 2)
+}
+
+func TestDryRun(t *testing.T) {
+	var delivered int
+	outbox := func(context.Context, pipeline.Item) error { delivered++; return nil }
+	job := Job{Name: "payroll", Template: "payroll", Cron: "@daily", Variables: map[string]string{"payMonth": "2026-10"}, Destination: "outbox"}
+
+	scheduler, err := New(newLibrary(t), map[string]pipeline.Sink{"outbox": outbox}, []Job{job})
+	require.Nil(t, err)
+	run, err := scheduler.RunJob(dryrun.With(context.Background()), "payroll")
+	require.Nil(t, err)
+	require.True(t, run.DryRun)
+	run, err = scheduler.RunJob(context.Background(), "payroll")
+	require.Nil(t, err)
+	require.False(t, run.DryRun)
+
+	// runs of dry schedulers are dry
+	scheduler, err = New(newLibrary(t), map[string]pipeline.Sink{"outbox": outbox}, []Job{job}, WithDryRun())
+	require.Nil(t, err)
+	run, err = scheduler.RunJob(context.Background(), "payroll")
+	require.Nil(t, err)
+	require.True(t, run.DryRun)
+
+	// the sink isn't dry itself, dryrun.Sink wraps the destinations
+	require.Equal(t, 3, delivered)
+}
+
+func TestRun(t *testing.T) {
+	runs := make(chan Run, 1)
+	scheduler, err := New(newLibrary(t), map[string]pipeline.Sink{
+		"outbox": func(context.Context, pipeline.Item) error { return nil },
+	}, []Job{{Name: "payroll", Template: "payroll", Cron: "* * * * *", Variables: map[string]string{"payMonth": "2026-10"}, Destination: "outbox"}})
+	require.Nil(t, err)
+
+	// the clock of the scheduler is 50ms before the next minute
+	start := time.Now()
+	offset := start.Truncate(time.Minute).Add(time.Minute - 50*time.Millisecond).Sub(start)
+	scheduler.now = func() time.Time { return time.Now().Add(offset) }
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go scheduler.Run(ctx, func(run Run) { runs <- run })
+
+	select {
+	case run := <-runs:
+		require.Equal(t, TriggerSchedule, run.Trigger)
+		require.Equal(t, RunStatusSucceeded, run.Status)
+	case <-time.After(5 * time.Second):
+		t.Fatal("job didn't run")
+	}
+	require.True(t, scheduler.Jobs()[0].Next.After(scheduler.now()))
+}
+
+func TestRestore(t *testing.T) {
+	outbox := map[string]pipeline.Sink{"outbox": func(context.Context, pipeline.Item) error { return nil }}
+	job := Job{Name: "payroll", Template: "payroll", Cron: "@daily", Variables: map[string]string{"payMonth": "2026-10"}, Destination: "outbox"}
+	primary, err := New(newLibrary(t), outbox, []Job{job})
+	require.Nil(t, err)
+	for i := 0; i < 3; i++ {
+		_, err = primary.RunJob(context.Background(), "payroll")
+		require.Nil(t, err)
+	}
+
+	// the history of the primary is kept by the standby, within its limit
+	standby, err := New(newLibrary(t), outbox, []Job{job, {Name: "bonus", Template: "payroll", Cron: "@daily", Destination: "outbox"}}, WithHistory(2))
+	require.Nil(t, err)
+	statuses := append(primary.Jobs(), JobStatus{Job: Job{Name: "retired"}})
+	require.Equal(t, 1, standby.Restore(statuses))
+	restored := standby.Jobs()
+	require.Equal(t, statuses[0].History[:2], restored[0].History)
+	require.Empty(t, restored[1].History)
+	require.False(t, restored[0].Next.IsZero())
+}
+
+func TestNewErrors(t *testing.T) {
+	library := newLibrary(t)
+	destinations := map[string]pipeline.Sink{"outbox": func(context.Context, pipeline.Item) error { return nil }}
+
+	for _, tc := range []struct {
+		job Job
+		err string
+	}{
+		{Job{Template: "payroll", Cron: "@daily", Destination: "outbox"}, "name is omitted"},
+		{Job{Name: "payroll", Cron: "@daily", Destination: "outbox"}, "template is omitted"},
+		{Job{Name: "payroll", Template: "payroll", Cron: "daily", Destination: "outbox"}, "has 1 fields"},
+		{Job{Name: "payroll", Template: "payroll", Cron: "@daily", TimeZone: "Mars/Olympus", Destination: "outbox"}, "Mars/Olympus"},
+		{Job{Name: "payroll", Template: "payroll", Cron: "@daily", Destination: "sftp"}, `destination "sftp" isn't configured`},
+	} {
+		_, err := New(library, destinations, []Job{tc.job})
+		require.ErrorContains(t, err, tc.err)
+	}
+
+	job := Job{Name: "payroll", Template: "payroll", Cron: "@daily", Destination: "outbox"}
+	_, err := New(library, destinations, []Job{job, job})
+	require.ErrorContains(t, err, "name is duplicated")
+}
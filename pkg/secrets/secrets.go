@@ -0,0 +1,240 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package secrets
+
+/*
+	Package secrets resolves the secrets of configuration values (api keys, passwords, keys)
+	referenced instead of written in plaintext:
+
+		vault:secret/data/iso20022#sftpPassword	field of a HashiCorp Vault secret
+		file:/run/secrets/sftp-password		content of a file, e.g. injected by an agent
+		env:SFTP_PASSWORD			environment variable
+
+	Other values are literals. Resolved values are cached until they are rotated: Vault
+	secrets are fetched again when their lease or the refresh interval expires, files when
+	they're modified.
+*/
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Schemes of secret references
+const (
+	SchemeVault = "vault"
+	SchemeFile  = "file"
+	SchemeEnv   = "env"
+)
+
+// DefaultRefreshInterval is how often Vault secrets without lease are fetched again
+const DefaultRefreshInterval = 5 * time.Minute
+
+var (
+	// ErrSecretNotFound is returned when a referenced secret doesn't exist
+	ErrSecretNotFound = errors.New("secret not found")
+
+	// ErrVaultNotConfigured is returned when a vault reference is resolved without Vault address
+	ErrVaultNotConfigured = errors.New("vault is not configured")
+)
+
+// Config configures the resolution of secret references
+type Config struct {
+	Vault VaultConfig
+
+	// RefreshInterval is how often Vault secrets without lease are fetched again, DefaultRefreshInterval when zero
+	RefreshInterval time.Duration
+}
+
+// IsReference reports whether value references a secret rather than being a literal, file://
+// urls (e.g. of storage directories) are literals
+func IsReference(value string) bool {
+	scheme, location, found := strings.Cut(value, ":")
+	if !found || location == "" {
+		return false
+	}
+	switch scheme {
+	case SchemeFile:
+		return !strings.HasPrefix(location, "//")
+	case SchemeVault, SchemeEnv:
+		return true
+	}
+	return false
+}
+
+// Resolver resolves secret references, caching their values until they're rotated
+type Resolver struct {
+	vault   *vaultClient
+	refresh time.Duration
+	now     func() time.Time
+
+	mu    sync.Mutex
+	cache map[string]cached
+}
+
+type cached struct {
+	value   string
+	expires time.Time // vault secrets
+	modTime time.Time // file secrets
+}
+
+// NewResolver returns a resolver of the references of config, Vault is configured by the
+// VAULT_ADDR and VAULT_TOKEN environment variables when config omits its address
+func NewResolver(config Config) *Resolver {
+	refresh := config.RefreshInterval
+	if refresh <= 0 {
+		refresh = DefaultRefreshInterval
+	}
+	return &Resolver{
+		vault:   newVaultClient(config.Vault),
+		refresh: refresh,
+		now:     time.Now,
+		cache:   make(map[string]cached),
+	}
+}
+
+// Resolve returns the current value of the secret ref references, or ref itself when it's a literal
+func (r *Resolver) Resolve(ctx context.Context, ref string) (string, error) {
+	if !IsReference(ref) {
+		return ref, nil
+	}
+	scheme, location, _ := strings.Cut(ref, ":")
+
+	switch scheme {
+	case SchemeEnv:
+		value, exists := os.LookupEnv(location)
+		if !exists {
+			return "", fmt.Errorf("%w: environment variable %s", ErrSecretNotFound, location)
+		}
+		return value, nil
+
+	case SchemeFile:
+		info, err := os.Stat(location)
+		if err != nil {
+			return "", fmt.Errorf("%w: %v", ErrSecretNotFound, err)
+		}
+		if c, ok := r.cached(ref); ok && c.modTime.Equal(info.ModTime()) {
+			return c.value, nil
+		}
+		buf, err := os.ReadFile(location)
+		if err != nil {
+			return "", fmt.Errorf("%w: %v", ErrSecretNotFound, err)
+		}
+		value := strings.TrimRight(string(buf), "\r\n")
+		r.store(ref, cached{value: value, modTime: info.ModTime()})
+		return value, nil
+
+	default:
+		if c, ok := r.cached(ref); ok && r.now().Before(c.expires) {
+			return c.value, nil
+		}
+		if r.vault == nil {
+			return "", ErrVaultNotConfigured
+		}
+		path, field, _ := strings.Cut(location, "#")
+		value, lease, err := r.vault.read(ctx, path, field)
+		if err != nil {
+			return "", err
+		}
+		if lease <= 0 || lease > r.refresh {
+			lease = r.refresh
+		}
+		r.store(ref, cached{value: value, expires: r.now().Add(lease)})
+		return value, nil
+	}
+}
+
+// Secret returns the secret referenced by ref, whose value follows its rotations
+func (r *Resolver) Secret(ref string) *Secret {
+	return &Secret{ref: ref, resolver: r}
+}
+
+// ResolveAll replaces the secret references of the string fields of v, a pointer to a struct,
+// by their values. Nested structs, pointers and slices are resolved too.
+func (r *Resolver) ResolveAll(ctx context.Context, v interface{}) error {
+	value := reflect.ValueOf(v)
+	if value.Kind() != reflect.Ptr || value.IsNil() {
+		return fmt.Errorf("%T isn't a pointer", v)
+	}
+	return r.resolveValue(ctx, value.Elem(), "")
+}
+
+func (r *Resolver) resolveValue(ctx context.Context, value reflect.Value, path string) error {
+	switch value.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if value.IsNil() {
+			return nil
+		}
+		return r.resolveValue(ctx, value.Elem(), path)
+	case reflect.Struct:
+		for i := 0; i < value.NumField(); i++ {
+			field := value.Type().Field(i)
+			if field.PkgPath != "" {
+				continue
+			}
+			if err := r.resolveValue(ctx, value.Field(i), joinPath(path, field.Name)); err != nil {
+				return err
+			}
+		}
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < value.Len(); i++ {
+			if err := r.resolveValue(ctx, value.Index(i), fmt.Sprintf("%s[%d]", path, i)); err != nil {
+				return err
+			}
+		}
+	case reflect.String:
+		if !IsReference(value.String()) || !value.CanSet() {
+			return nil
+		}
+		resolved, err := r.Resolve(ctx, value.String())
+		if err != nil {
+			return fmt.Errorf("%s: %w", path, err)
+		}
+		value.SetString(resolved)
+	}
+	return nil
+}
+
+func (r *Resolver) cached(ref string) (cached, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	c, ok := r.cache[ref]
+	return c, ok
+}
+
+func (r *Resolver) store(ref string, c cached) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.cache[ref] = c
+}
+
+// Secret is a secret whose value is resolved again when it's rotated
+type Secret struct {
+	ref      string
+	resolver *Resolver
+}
+
+// Reference is the reference of the secret
+func (s *Secret) Reference() string {
+	return s.ref
+}
+
+// Value returns the current value of the secret
+func (s *Secret) Value(ctx context.Context) (string, error) {
+	return s.resolver.Resolve(ctx, s.ref)
+}
+
+func joinPath(parent, name string) string {
+	if parent == "" {
+		return name
+	}
+	return parent + "." + name
+}
@@ -0,0 +1,95 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package secrets
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolveFileAndEnv(t *testing.T) {
+	ctx := context.Background()
+	r := NewResolver(Config{})
+
+	path := filepath.Join(t.TempDir(), "sftp-password")
+	require.Nil(t, os.WriteFile(path, []byte("s3cret\n"), 0600))
+	secret := r.Secret("file:" + path)
+	value, err := secret.Value(ctx)
+	require.Nil(t, err)
+	require.Equal(t, "s3cret", value)
+
+	// rotated files are read again
+	require.Nil(t, os.WriteFile(path, []byte("rotated"), 0600))
+	later := time.Now().Add(time.Second)
+	require.Nil(t, os.Chtimes(path, later, later))
+	value, err = secret.Value(ctx)
+	require.Nil(t, err)
+	require.Equal(t, "rotated", value)
+
+	t.Setenv("ISO20022_API_KEY", "key-1")
+	value, err = r.Resolve(ctx, "env:ISO20022_API_KEY")
+	require.Nil(t, err)
+	require.Equal(t, "key-1", value)
+
+	value, err = r.Resolve(ctx, "plaintext")
+	require.Nil(t, err)
+	require.Equal(t, "plaintext", value)
+
+	_, err = r.Resolve(ctx, "env:ISO20022_MISSING")
+	require.True(t, errors.Is(err, ErrSecretNotFound))
+	_, err = r.Resolve(ctx, "file:"+filepath.Join(t.TempDir(), "missing"))
+	require.True(t, errors.Is(err, ErrSecretNotFound))
+
+	t.Setenv("VAULT_ADDR", "")
+	_, err = NewResolver(Config{}).Resolve(ctx, "vault:secret/data/iso20022#apiKey")
+	require.True(t, errors.Is(err, ErrVaultNotConfigured))
+}
+
+func TestResolveAll(t *testing.T) {
+	t.Setenv("ISO20022_PASSWORD", "p4ss")
+	t.Setenv("ISO20022_TOKEN", "t0ken")
+
+	type backend struct {
+		Name     string
+		Password string
+	}
+	config := struct {
+		APIKey   string
+		Backends []backend
+		Optional *backend
+		Timeout  time.Duration
+	}{
+		APIKey:   "env:ISO20022_TOKEN",
+		Backends: []backend{{Name: "sftp", Password: "env:ISO20022_PASSWORD"}, {Name: "plain", Password: "literal"}},
+		Timeout:  time.Second,
+	}
+
+	r := NewResolver(Config{})
+	require.Nil(t, r.ResolveAll(context.Background(), &config))
+	require.Equal(t, "t0ken", config.APIKey)
+	require.Equal(t, "p4ss", config.Backends[0].Password)
+	require.Equal(t, "literal", config.Backends[1].Password)
+
+	config.Backends[1].Password = "env:ISO20022_MISSING"
+	err := r.ResolveAll(context.Background(), &config)
+	require.ErrorContains(t, err, "Backends[1].Password")
+
+	require.NotNil(t, r.ResolveAll(context.Background(), config))
+}
+
+func TestIsReference(t *testing.T) {
+	require.True(t, IsReference("vault:secret/data/iso20022#key"))
+	require.True(t, IsReference("file:/run/secrets/key"))
+	require.False(t, IsReference("https://example.com"))
+	require.False(t, IsReference("file:///var/lib/iso20022"))
+	require.False(t, IsReference("env:"))
+	require.False(t, IsReference("password"))
+}
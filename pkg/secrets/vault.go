@@ -0,0 +1,135 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package secrets
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/moov-io/iso20022/pkg/resilience"
+)
+
+// VaultConfig - Defines the HashiCorp Vault server holding secrets
+type VaultConfig struct {
+	// Address of the server, e.g. https://vault.internal:8200, VAULT_ADDR when omitted
+	Address string
+
+	// Token authenticates requests, VAULT_TOKEN when omitted. TokenFile is read for every
+	// request instead, so tokens renewed by an agent are picked up.
+	Token     string
+	TokenFile string
+
+	// Namespace of Vault Enterprise
+	Namespace string
+
+	// Timeout of requests, 10s when zero
+	Timeout time.Duration
+
+	// Policy retries the failed requests and breaks the circuit while Vault is unavailable,
+	// resilience.DefaultPolicy when omitted
+	Policy resilience.Policy
+}
+
+// vaultClient reads secrets with the http api of Vault
+type vaultClient struct {
+	config VaultConfig
+	client *http.Client
+}
+
+// newVaultClient returns nil when Vault has no address
+func newVaultClient(config VaultConfig) *vaultClient {
+	if config.Address == "" {
+		config.Address = os.Getenv("VAULT_ADDR")
+	}
+	if config.Address == "" {
+		return nil
+	}
+	if config.Token == "" && config.TokenFile == "" {
+		config.Token = os.Getenv("VAULT_TOKEN")
+	}
+	timeout := config.Timeout
+	if timeout <= 0 {
+		timeout = 10 * time.Second
+	}
+	guard := resilience.New("vault", config.Policy)
+	resilience.Register(guard)
+	return &vaultClient{config: config, client: guard.Client(&http.Client{Timeout: timeout})}
+}
+
+// token returns the token of requests
+func (c *vaultClient) token() (string, error) {
+	if c.config.TokenFile == "" {
+		return c.config.Token, nil
+	}
+	buf, err := os.ReadFile(c.config.TokenFile)
+	if err != nil {
+		return "", fmt.Errorf("vault token: %w", err)
+	}
+	return strings.TrimSpace(string(buf)), nil
+}
+
+// read returns field of the secret at path with its lease duration, path is the api path of
+// the secret, e.g. secret/data/iso20022 for the KV version 2 secret iso20022 of the secret mount
+func (c *vaultClient) read(ctx context.Context, path, field string) (string, time.Duration, error) {
+	if field == "" {
+		return "", 0, fmt.Errorf("vault secret %s: field is omitted", path)
+	}
+	token, err := c.token()
+	if err != nil {
+		return "", 0, err
+	}
+
+	url := strings.TrimSuffix(c.config.Address, "/") + "/v1/" + strings.TrimPrefix(path, "/")
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return "", 0, err
+	}
+	req.Header.Set("X-Vault-Token", token)
+	if c.config.Namespace != "" {
+		req.Header.Set("X-Vault-Namespace", c.config.Namespace)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return "", 0, fmt.Errorf("vault secret %s: %w", path, err)
+	}
+	defer resp.Body.Close()
+	switch {
+	case resp.StatusCode == http.StatusNotFound:
+		return "", 0, fmt.Errorf("%w: vault secret %s", ErrSecretNotFound, path)
+	case resp.StatusCode != http.StatusOK:
+		return "", 0, fmt.Errorf("vault secret %s: %s", path, resp.Status)
+	}
+
+	var body struct {
+		LeaseDuration int                    `json:"lease_duration"`
+		Data          map[string]interface{} `json:"data"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return "", 0, fmt.Errorf("vault secret %s: %w", path, err)
+	}
+
+	// KV version 2 nests the fields of secrets with their metadata
+	data := body.Data
+	if nested, ok := data["data"].(map[string]interface{}); ok {
+		if _, versioned := data["metadata"]; versioned {
+			data = nested
+		}
+	}
+	value, exists := data[field]
+	if !exists {
+		return "", 0, fmt.Errorf("%w: vault secret %s has no field %s", ErrSecretNotFound, path, field)
+	}
+	lease := time.Duration(body.LeaseDuration) * time.Second
+	if s, ok := value.(string); ok {
+		return s, lease, nil
+	}
+	return fmt.Sprint(value), lease, nil
+}
@@ -0,0 +1,85 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package secrets
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestVaultSecrets(t *testing.T) {
+	var reads int32
+	password := "v1"
+	vault := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Vault-Token") != "agent-token" || r.Header.Get("X-Vault-Namespace") != "payments" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		atomic.AddInt32(&reads, 1)
+		switch r.URL.Path {
+		case "/v1/secret/data/iso20022":
+			fmt.Fprintf(w, `{"data": {"data": {"sftpPassword": %q, "port": 22}, "metadata": {"version": 3}}}`, password)
+		case "/v1/database/creds/iso20022":
+			fmt.Fprint(w, `{"lease_duration": 60, "data": {"username": "iso20022", "password": "dynamic"}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(vault.Close)
+
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	require.Nil(t, os.WriteFile(tokenFile, []byte("agent-token\n"), 0600))
+
+	ctx := context.Background()
+	r := NewResolver(Config{
+		Vault:           VaultConfig{Address: vault.URL, TokenFile: tokenFile, Namespace: "payments"},
+		RefreshInterval: time.Minute,
+	})
+	now := time.Date(2021, 4, 15, 10, 0, 0, 0, time.UTC)
+	r.now = func() time.Time { return now }
+
+	secret := r.Secret("vault:secret/data/iso20022#sftpPassword")
+	value, err := secret.Value(ctx)
+	require.Nil(t, err)
+	require.Equal(t, "v1", value)
+
+	// the value is cached until the refresh interval expires
+	password = "v2"
+	value, _ = secret.Value(ctx)
+	require.Equal(t, "v1", value)
+	now = now.Add(2 * time.Minute)
+	value, _ = secret.Value(ctx)
+	require.Equal(t, "v2", value)
+	require.Equal(t, int32(2), atomic.LoadInt32(&reads))
+
+	value, err = r.Resolve(ctx, "vault:secret/data/iso20022#port")
+	require.Nil(t, err)
+	require.Equal(t, "22", value)
+
+	value, err = r.Resolve(ctx, "vault:database/creds/iso20022#password")
+	require.Nil(t, err)
+	require.Equal(t, "dynamic", value)
+
+	_, err = r.Resolve(ctx, "vault:secret/data/iso20022#missing")
+	require.True(t, errors.Is(err, ErrSecretNotFound))
+	_, err = r.Resolve(ctx, "vault:secret/data/missing#key")
+	require.True(t, errors.Is(err, ErrSecretNotFound))
+	_, err = r.Resolve(ctx, "vault:secret/data/iso20022")
+	require.ErrorContains(t, err, "field is omitted")
+
+	require.Nil(t, os.WriteFile(tokenFile, []byte("revoked"), 0600))
+	_, err = r.Resolve(ctx, "vault:secret/data/other#key")
+	require.ErrorContains(t, err, "403 Forbidden")
+}
@@ -0,0 +1,161 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package server
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/moov-io/iso20022/pkg/storage"
+	"github.com/moov-io/iso20022/pkg/utils"
+)
+
+const (
+	// ReviewStatusPendingApproval is the status of the composed messages waiting for the
+	// approval of a second API key
+	ReviewStatusPendingApproval = "PENDING_APPROVAL"
+
+	// ReviewRuleFourEyes is the review rule holding the composed messages
+	ReviewRuleFourEyes = "four-eyes"
+)
+
+var (
+	// ErrFourEyesNotConfigured is returned for compositions when four-eyes approval isn't configured
+	ErrFourEyesNotConfigured = errors.New("four-eyes approval is not configured")
+
+	// ErrSelfApproval is returned when the API key composing a message releases it
+	ErrSelfApproval = errors.New("the API key composing a message can't approve it")
+
+	// ErrPendingApproval is returned when a composed message is downloaded before its approval
+	ErrPendingApproval = errors.New("document is pending approval")
+
+	// ErrApprovedMessage is returned when an approved message is edited
+	ErrApprovedMessage = errors.New("document was approved as composed")
+)
+
+// approver identifies the API key composing or deciding on a message held for four-eyes approval
+type approver struct {
+	tenant string
+
+	// key is the hex sha-256 digest of the API key, keys aren't stored
+	key string
+}
+
+// approvalKey returns the approver of the API key of r deciding on a composed message,
+// anonymous requests aren't identified
+func (h handlers) approvalKey(r *http.Request) (approver, error) {
+	tenant, err := h.usage.tenant(r)
+	if err != nil {
+		return approver{}, err
+	}
+	if tenant.Name == AnonymousTenant {
+		return approver{}, fmt.Errorf("%w: %s", ErrMissingAPIKey, h.usage.header)
+	}
+	digest := sha256.Sum256([]byte(r.Header.Get(h.usage.header)))
+	return approver{tenant: tenant.Name, key: hex.EncodeToString(digest[:])}, nil
+}
+
+// composer returns the approver composing the document with id, from the audit of its hold
+func (h handlers) composer(ctx context.Context, id string) (approver, error) {
+	records, err := h.documents.List(ctx)
+	if err != nil {
+		return approver{}, err
+	}
+	for _, rec := range records {
+		if rec.Kind != storage.KindAudit {
+			continue
+		}
+		var audit ReviewAudit
+		if json.Unmarshal(rec.Content, &audit) == nil && audit.Document == id && audit.Action == ReviewActionHold {
+			return approver{tenant: audit.Tenant, key: audit.Key}, nil
+		}
+	}
+	return approver{}, fmt.Errorf("the composition of %s isn't audited", id)
+}
+
+// approved responds 409 Conflict and returns false when rec is a composed message pending approval
+func (h handlers) approved(w http.ResponseWriter, r *http.Request, rec storage.Record) bool {
+	if rec.Status == ReviewStatusPendingApproval {
+		h.outputError(w, r, http.StatusConflict, fmt.Errorf("%w: %s", ErrPendingApproval, rec.ID))
+		return false
+	}
+	return true
+}
+
+// editable responds 409 Conflict and returns false when rec is a composed message pending
+// approval or released after its approval, its content is the approved one
+func (h handlers) editable(w http.ResponseWriter, r *http.Request, rec storage.Record) bool {
+	if !h.approved(w, r, rec) {
+		return false
+	}
+	if rec.Status == ReviewStatusReleased {
+		if _, err := h.composer(r.Context(), rec.ID); err == nil {
+			h.outputError(w, r, http.StatusConflict, fmt.Errorf("%w: %s", ErrApprovedMessage, rec.ID))
+			return false
+		}
+	}
+	return true
+}
+
+// composeTemplate - store the message of a template with the variables of the body pending the
+// approval of a second API key, responding 202 Accepted. The message isn't downloadable nor
+// emitted until it's released with POST /reviews/{id}/release with a key other than the one
+// composing it.
+func (h handlers) composeTemplate(w http.ResponseWriter, r *http.Request) {
+	if !h.review.fourEyes {
+		h.outputError(w, r, http.StatusNotImplemented, ErrFourEyesNotConfigured)
+		return
+	}
+	composer, err := h.approvalKey(r)
+	if err != nil {
+		h.outputError(w, r, http.StatusUnauthorized, err)
+		return
+	}
+
+	var body TemplateInstantiation
+	if err := json.NewDecoder(io.LimitReader(r.Body, maxTemplateSize)).Decode(&body); err != nil && !errors.Is(err, io.EOF) {
+		h.outputError(w, r, http.StatusBadRequest, err)
+		return
+	}
+	t, ok := h.getTemplate(w, r)
+	if !ok {
+		return
+	}
+	doc, output, err := t.Instantiate(body.Variables)
+	if err != nil {
+		h.outputError(w, r, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	now := time.Now().UTC()
+	rec := storage.Record{
+		ID:          newId(),
+		Kind:        storage.KindOriginal,
+		MessageType: utils.GetMessageType(doc.NameSpace()),
+		Format:      utils.GetDocumentFormat(output),
+		Content:     output,
+		Created:     now,
+		Updated:     now,
+		Status:      ReviewStatusPendingApproval,
+	}
+	if err := h.documents.Put(r.Context(), rec); err != nil {
+		h.outputError(w, r, http.StatusInternalServerError, err)
+		return
+	}
+	noteRules(r, RuleHit{Kind: RuleKindReview, Rule: ReviewRuleFourEyes, Action: ReviewActionHold})
+	audit := ReviewAudit{Action: ReviewActionHold, Document: rec.ID, Rules: []string{ReviewRuleFourEyes}, Tenant: composer.tenant, Key: composer.key}
+	if _, err := h.reviewAudit(r, audit); err != nil {
+		h.outputError(w, r, http.StatusInternalServerError, err)
+		return
+	}
+	h.outputData(w, r, http.StatusAccepted, newStoredDocument(rec))
+}
@@ -0,0 +1,157 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package server_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/require"
+
+	"github.com/moov-io/iso20022/pkg/events"
+	"github.com/moov-io/iso20022/pkg/iso20022test/fixtures"
+	"github.com/moov-io/iso20022/pkg/server"
+	"github.com/moov-io/iso20022/pkg/templates"
+)
+
+func approvalRequest(t *testing.T, url, key, body string) *http.Response {
+	req, err := http.NewRequest(http.MethodPost, url, strings.NewReader(body))
+	require.Nil(t, err)
+	req.Header.Set("Content-Type", "application/json")
+	if key != "" {
+		req.Header.Set(server.DefaultAPIKeyHeader, key)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	require.Nil(t, err)
+	t.Cleanup(func() { resp.Body.Close() })
+	return resp
+}
+
+func TestFourEyesApproval(t *testing.T) {
+	received := make(chan events.Event, 10)
+	webhook := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var event events.Event
+		require.Nil(t, json.NewDecoder(r.Body).Decode(&event))
+		received <- event
+	}))
+	defer webhook.Close()
+
+	router := mux.NewRouter()
+	require.Nil(t, server.ConfigureHandlersWithOptions(router, server.APIConfig{
+		Events: server.EventsConfig{Webhook: webhook.URL},
+		Review: server.ReviewConfig{FourEyes: true},
+		Usage: server.UsageConfig{Tenants: []server.TenantConfig{
+			{Name: "payroll", Keys: []string{"payroll-key", "payroll-approver-key"}},
+			{Name: "treasury", Keys: []string{"treasury-key"}},
+		}},
+		Subjects: server.SubjectsConfig{Tenants: []string{"treasury"}},
+	}))
+	ts := httptest.NewServer(router)
+	defer ts.Close()
+
+	content := strings.Replace(string(fixtures.ReadFile(t, "valid_pacs_v08.xml")), "MSG-20210415-0001", "PAYROLL-${month}", 1)
+	body, err := json.Marshal(templates.Template{Variables: []templates.Variable{{Name: "month", Required: true}}, Content: content})
+	require.Nil(t, err)
+	resp, buf := templateRequest(t, http.MethodPut, ts.URL+"/templates/payroll", body)
+	require.Equal(t, http.StatusOK, resp.StatusCode, string(buf))
+
+	// compositions are identified by a tenant
+	variables := `{"variables": {"month": "2026-10"}}`
+	require.Equal(t, http.StatusUnauthorized, approvalRequest(t, ts.URL+"/templates/payroll/compose", "", variables).StatusCode)
+	require.Equal(t, http.StatusUnprocessableEntity, approvalRequest(t, ts.URL+"/templates/payroll/compose", "payroll-key", `{}`).StatusCode)
+
+	resp = approvalRequest(t, ts.URL+"/templates/payroll/compose", "payroll-key", variables)
+	require.Equal(t, http.StatusAccepted, resp.StatusCode)
+	var composed server.StoredDocument
+	require.Nil(t, json.NewDecoder(resp.Body).Decode(&composed))
+	require.Equal(t, server.ReviewStatusPendingApproval, composed.Status)
+	require.Equal(t, "pacs.008.001.08", composed.MessageType)
+
+	// the composed message isn't downloadable nor emitted before its approval
+	for _, path := range []string{"/documents/" + composed.ID, "/documents/" + composed.ID + "/package"} {
+		resp, err := http.Get(ts.URL + path)
+		require.Nil(t, err)
+		resp.Body.Close()
+		require.Equal(t, http.StatusConflict, resp.StatusCode, path)
+	}
+	// nor exported for its parties
+	resp = postSubject(t, ts, "export", "treasury-key", "Jane Doe")
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	var export server.SubjectExport
+	require.Nil(t, json.NewDecoder(resp.Body).Decode(&export))
+	require.Empty(t, export.Documents)
+
+	// nor edited before or after its approval
+	patch := `{"GrpHdr": {"MsgId": "EDITED"}}`
+	require.Equal(t, http.StatusConflict, patchDocument(t, ts.URL+"/documents/"+composed.ID, "application/merge-patch+json", patch).StatusCode)
+	select {
+	case event := <-received:
+		t.Fatalf("composed message emitted %s", event.Type)
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	items := getReviews(t, ts.URL+"/reviews?status="+server.ReviewStatusPendingApproval)
+	require.Len(t, items, 1)
+	require.Equal(t, []string{server.ReviewRuleFourEyes}, items[0].Rules)
+	require.Equal(t, "payroll", items[0].History[0].Tenant)
+	require.Len(t, items[0].History[0].Key, 64)
+	require.NotContains(t, items[0].History[0].Key, "payroll-key")
+
+	// the composing key can't approve its message, another key of its tenant can
+	release := ts.URL + "/reviews/" + composed.ID + "/release"
+	require.Equal(t, http.StatusUnauthorized, approvalRequest(t, release, "", `{"operator":"alice"}`).StatusCode)
+	require.Equal(t, http.StatusUnauthorized, approvalRequest(t, release, "unknown-key", `{"operator":"alice"}`).StatusCode)
+	require.Equal(t, http.StatusForbidden, approvalRequest(t, release, "payroll-key", `{"operator":"alice"}`).StatusCode)
+
+	resp = approvalRequest(t, release, "payroll-approver-key", `{"operator":"bob","comment":"checked the totals"}`)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	var audit server.ReviewAudit
+	require.Nil(t, json.NewDecoder(resp.Body).Decode(&audit))
+	require.Equal(t, server.ReviewActionRelease, audit.Action)
+	require.Equal(t, "payroll", audit.Tenant)
+	require.NotEqual(t, items[0].History[0].Key, audit.Key)
+
+	select {
+	case event := <-received:
+		require.Equal(t, composed.ID, event.DocumentID)
+	case <-time.After(5 * time.Second):
+		t.Fatal("no event received")
+	}
+	resp, err = http.Get(ts.URL + "/documents/" + composed.ID)
+	require.Nil(t, err)
+	resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, http.StatusConflict, patchDocument(t, ts.URL+"/documents/"+composed.ID, "application/merge-patch+json", patch).StatusCode)
+
+	// instantiated messages are held too
+	resp = approvalRequest(t, ts.URL+"/templates/payroll/instantiate", "", variables)
+	require.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+	resp = approvalRequest(t, ts.URL+"/templates/payroll/instantiate", "payroll-key", variables)
+	require.Equal(t, http.StatusAccepted, resp.StatusCode)
+	require.Nil(t, json.NewDecoder(resp.Body).Decode(&composed))
+	require.Equal(t, server.ReviewStatusPendingApproval, composed.Status)
+
+	// the composing key can withdraw its message
+	resp = approvalRequest(t, ts.URL+"/templates/payroll/compose", "payroll-key", variables)
+	require.Nil(t, json.NewDecoder(resp.Body).Decode(&composed))
+	require.Equal(t, http.StatusOK, approvalRequest(t, ts.URL+"/reviews/"+composed.ID+"/reject", "payroll-key", `{"operator":"alice"}`).StatusCode)
+	require.Equal(t, http.StatusConflict, approvalRequest(t, ts.URL+"/reviews/"+composed.ID+"/release", "treasury-key", `{"operator":"bob"}`).StatusCode)
+}
+
+func TestFourEyesApprovalWithoutTenants(t *testing.T) {
+	err := server.ConfigureHandlersWithOptions(mux.NewRouter(), server.APIConfig{Review: server.ReviewConfig{FourEyes: true}})
+	require.NotNil(t, err)
+
+	router := mux.NewRouter()
+	require.Nil(t, server.ConfigureHandlers(router))
+	ts := httptest.NewServer(router)
+	defer ts.Close()
+	require.Equal(t, http.StatusNotImplemented, approvalRequest(t, ts.URL+"/templates/payroll/compose", "", `{}`).StatusCode)
+}
@@ -0,0 +1,105 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package server
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"net/http"
+	"time"
+
+	"github.com/moov-io/base/log"
+)
+
+// Stages of the requests faults are injected at
+const (
+	ChaosStageParse    = "parse"
+	ChaosStageValidate = "validate"
+	ChaosStageConvert  = "convert"
+)
+
+// ChaosFaultHeader names the stage of the faults injected in a response, so test clients
+// tell them apart from real failures
+const ChaosFaultHeader = "X-Chaos-Fault"
+
+// ErrInjectedFault is returned by the stages failed by the chaos mode
+var ErrInjectedFault = errors.New("injected fault")
+
+// chaos injects the latencies and failures of the faults configured for every stage
+type chaos struct {
+	faults map[string][]FaultConfig
+	random func() float64
+}
+
+// newChaos returns nil unless config enables the chaos mode
+func newChaos(config ChaosConfig, logger log.Logger) (*chaos, error) {
+	if !config.Enabled {
+		return nil, nil
+	}
+	c := &chaos{faults: make(map[string][]FaultConfig), random: rand.Float64}
+	for _, fault := range config.Faults {
+		switch fault.Stage {
+		case ChaosStageParse, ChaosStageValidate, ChaosStageConvert:
+		default:
+			return nil, fmt.Errorf("chaos fault stage %q is not parse, validate or convert", fault.Stage)
+		}
+		if fault.FailureRate < 0 || fault.FailureRate > 100 {
+			return nil, fmt.Errorf("chaos failure rate %v is not a percentage", fault.FailureRate)
+		}
+		if fault.Latency < 0 {
+			return nil, fmt.Errorf("chaos latency %v is negative", fault.Latency)
+		}
+		if fault.StatusCode == 0 {
+			fault.StatusCode = http.StatusServiceUnavailable
+		}
+		if fault.StatusCode < 400 || fault.StatusCode > 599 {
+			return nil, fmt.Errorf("chaos status code %d is not an error", fault.StatusCode)
+		}
+		c.faults[fault.Stage] = append(c.faults[fault.Stage], fault)
+	}
+	if logger == nil {
+		logger = log.NewDefaultLogger()
+	}
+	logger.Warn().Log("chaos mode is enabled, requests are delayed and failed on purpose")
+	return c, nil
+}
+
+// inject delays the request at stage and returns the status code of the fault failing it
+func (c *chaos) inject(r *http.Request, stage string) (int, error) {
+	if c == nil {
+		return 0, nil
+	}
+	for _, fault := range c.faults[stage] {
+		if fault.Latency > 0 {
+			timer := time.NewTimer(fault.Latency)
+			select {
+			case <-timer.C:
+			case <-r.Context().Done():
+				timer.Stop()
+				return 0, r.Context().Err()
+			}
+		}
+		if c.random()*100 < fault.FailureRate {
+			return fault.StatusCode, fmt.Errorf("%w at %s stage", ErrInjectedFault, stage)
+		}
+	}
+	return 0, nil
+}
+
+// injectFault responds with the fault injected at stage, reporting whether the request continues
+func (h handlers) injectFault(w http.ResponseWriter, r *http.Request, stage string) bool {
+	code, err := h.chaos.inject(r, stage)
+	if err == nil {
+		return true
+	}
+	if code == 0 {
+		// the client went away while the request was delayed
+		return false
+	}
+	w.Header().Set(ChaosFaultHeader, stage)
+	h.outputError(w, r, code, err)
+	return false
+}
@@ -0,0 +1,79 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package server_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/require"
+
+	"github.com/moov-io/iso20022/pkg/iso20022test/fixtures"
+	"github.com/moov-io/iso20022/pkg/server"
+)
+
+func newChaosServer(t *testing.T, faults ...server.FaultConfig) *httptest.Server {
+	router := mux.NewRouter()
+	require.Nil(t, server.ConfigureHandlersWithOptions(router, server.APIConfig{
+		Chaos: server.ChaosConfig{Enabled: true, Faults: faults},
+	}))
+	ts := httptest.NewServer(router)
+	t.Cleanup(ts.Close)
+	return ts
+}
+
+func TestChaosFailures(t *testing.T) {
+	ts := newChaosServer(t,
+		server.FaultConfig{Stage: server.ChaosStageValidate, FailureRate: 100},
+		server.FaultConfig{Stage: server.ChaosStageConvert, FailureRate: 100, StatusCode: http.StatusBadGateway},
+	)
+	input := fixtures.ReadFile(t, "valid_pacs_v08.xml")
+
+	resp := postForm(t, ts.URL+"/validator", input, nil)
+	require.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+	require.Equal(t, server.ChaosStageValidate, resp.Header.Get(server.ChaosFaultHeader))
+
+	resp = postForm(t, ts.URL+"/convert", input, nil)
+	require.Equal(t, http.StatusBadGateway, resp.StatusCode)
+	require.Equal(t, server.ChaosStageConvert, resp.Header.Get(server.ChaosFaultHeader))
+
+	// stages without faults aren't affected
+	resp = postForm(t, ts.URL+"/print", input, nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Empty(t, resp.Header.Get(server.ChaosFaultHeader))
+}
+
+func TestChaosLatency(t *testing.T) {
+	ts := newChaosServer(t, server.FaultConfig{Stage: server.ChaosStageParse, Latency: 100 * time.Millisecond})
+
+	started := time.Now()
+	resp := postForm(t, ts.URL+"/validator", fixtures.ReadFile(t, "valid_pacs_v08.xml"), nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.GreaterOrEqual(t, time.Since(started), 100*time.Millisecond)
+}
+
+func TestChaosConfig(t *testing.T) {
+	for _, fault := range []server.FaultConfig{
+		{Stage: "respond"},
+		{Stage: server.ChaosStageParse, FailureRate: 101},
+		{Stage: server.ChaosStageParse, Latency: -time.Second},
+		{Stage: server.ChaosStageParse, StatusCode: http.StatusOK},
+	} {
+		router := mux.NewRouter()
+		err := server.ConfigureHandlersWithOptions(router, server.APIConfig{
+			Chaos: server.ChaosConfig{Enabled: true, Faults: []server.FaultConfig{fault}},
+		})
+		require.NotNil(t, err, "%+v", fault)
+	}
+
+	// faults are ignored unless the chaos mode is enabled
+	router := mux.NewRouter()
+	require.Nil(t, server.ConfigureHandlersWithOptions(router, server.APIConfig{
+		Chaos: server.ChaosConfig{Faults: []server.FaultConfig{{Stage: "respond"}}},
+	}))
+}
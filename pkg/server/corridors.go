@@ -0,0 +1,38 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package server
+
+import (
+	"net/http"
+
+	"github.com/moov-io/iso20022/pkg/corridor"
+)
+
+// newCorridorPolicy returns nil when rules is empty
+func newCorridorPolicy(rules []corridor.Rule) (*corridor.Policy, error) {
+	if len(rules) == 0 {
+		return nil, nil
+	}
+	return corridor.NewPolicy("api", rules)
+}
+
+// checkCorridors rejects the document of c with 422 Unprocessable Entity when a corridor rule
+// blocks one of its payments and warns of the payments flagged by a rule. It reports whether
+// the request goes on.
+func (h handlers) checkCorridors(w http.ResponseWriter, r *http.Request, c *HookContext) bool {
+	if h.corridors == nil {
+		return true
+	}
+	result := h.corridors.Check(c.Document)
+	noteRules(r, corridorRuleHits(result.Hits)...)
+	if err := result.Err(); err != nil {
+		h.outputError(w, r, http.StatusUnprocessableEntity, err)
+		return false
+	}
+	for _, hit := range result.Flagged() {
+		c.Warnings = append(c.Warnings, hit.String())
+	}
+	return true
+}
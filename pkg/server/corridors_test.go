@@ -0,0 +1,57 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package server_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/require"
+
+	"github.com/moov-io/iso20022/pkg/corridor"
+	"github.com/moov-io/iso20022/pkg/iso20022test/fixtures"
+	"github.com/moov-io/iso20022/pkg/server"
+)
+
+func TestCorridors(t *testing.T) {
+	newServer := func(rules ...corridor.Rule) *httptest.Server {
+		router := mux.NewRouter()
+		require.Nil(t, server.ConfigureHandlersWithOptions(router, server.APIConfig{Corridors: rules}))
+		ts := httptest.NewServer(router)
+		t.Cleanup(ts.Close)
+		return ts
+	}
+	input := fixtures.ReadFile(t, "valid_pacs_v08.xml")
+
+	// flagged payments are processed with a warning
+	ts := newServer(corridor.Rule{Name: "usd-to-gb", DebtorCountries: []string{"US"}, CreditorCountries: []string{"GB"}, Currencies: []string{"USD"}, Action: corridor.ActionFlag})
+	for _, path := range []string{"/convert", "/documents"} {
+		resp := postForm(t, ts.URL+path, input, map[string]string{"format": "json"})
+		require.Less(t, resp.StatusCode, 300, path)
+		require.Contains(t, strings.Join(resp.Header.Values("Warning"), ","), "corridor rule usd-to-gb flags CdtTrfTxInf[0] (US to GB in USD)", path)
+	}
+
+	// blocked payments are rejected
+	ts = newServer(corridor.Rule{Name: "germany", CreditorCountries: []string{"DE"}, Action: corridor.ActionBlock, Reason: "embargoed country"})
+	for _, path := range []string{"/convert", "/documents", "/v2/documents"} {
+		resp := postForm(t, ts.URL+path, input, nil)
+		require.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode, path)
+		body, err := io.ReadAll(resp.Body)
+		require.Nil(t, err)
+		require.Contains(t, string(body), "corridor rule germany blocks CdtTrfTxInf[1] (US to DE in USD): embargoed country", path)
+	}
+
+	// validation isn't a corridor
+	resp := postForm(t, ts.URL+"/validator", input, nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	router := mux.NewRouter()
+	err := server.ConfigureHandlersWithOptions(router, server.APIConfig{Corridors: []corridor.Rule{{Name: "any", Action: corridor.ActionBlock}}})
+	require.ErrorContains(t, err, "has no countries nor currencies")
+}
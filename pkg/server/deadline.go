@@ -0,0 +1,165 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package server
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/moov-io/iso20022/pkg/document"
+	"github.com/moov-io/iso20022/pkg/features"
+	"github.com/moov-io/iso20022/pkg/utils"
+)
+
+var (
+	errDeadlineFormat   = errors.New("documents validated with a timeout are xml")
+	errDeadlineEnvelope = errors.New("business message envelopes are validated without timeout")
+)
+
+// PartialResultHeader is set to true by the responses holding partial results
+const PartialResultHeader = "X-Partial-Result"
+
+// ValidationResult is the response of /validator with a processing deadline
+type ValidationResult struct {
+	MessageType string `json:"messageType"`
+
+	// Partial results are those of the transactions validated before the deadline, the rest
+	// of the document isn't validated
+	Partial bool `json:"partial"`
+
+	// Valid reports that no invalid element was found, the rest of partially validated
+	// documents may still have some
+	Valid bool `json:"valid"`
+
+	Transactions int `json:"transactions"`
+	Invalid      int `json:"invalid"`
+
+	// Bytes of the input were validated, out of its Size
+	Bytes int64 `json:"bytes"`
+	Size  int   `json:"size"`
+
+	Errors []ValidationFinding `json:"errors,omitempty"`
+}
+
+// ValidationFinding is an invalid element of a ValidationResult
+type ValidationFinding struct {
+	Path  string `json:"path"`
+	Error string `json:"error"`
+}
+
+// validateWithDeadline - validate the xml input transaction by transaction until the deadline
+// of the timeout form value, responding with the results computed so far marked as partial
+// when the deadline passes first. Inputs are validated while they're read, the post-validate
+// and pre-respond hooks aren't called without document. Uploads aren't held in memory unless
+// pre-parse hooks are registered or the message is sampled for the shadow validation, strict
+// validation is rejected. Business message envelopes aren't streamed, their header is
+// validated with the whole document.
+func (h handlers) validateWithDeadline(w http.ResponseWriter, r *http.Request, value string) {
+	timeout, err := time.ParseDuration(value)
+	if err != nil || timeout <= 0 {
+		h.outputError(w, r, http.StatusBadRequest, fmt.Errorf("%s is an invalid timeout", value))
+		return
+	}
+	if r.FormValue("report") != "" || r.FormValue("rejection") == "true" {
+		h.outputError(w, r, http.StatusBadRequest, errors.New("validation reports and rejections need the whole document, they don't have a timeout"))
+		return
+	}
+	if features.Enabled(r.Context(), features.StrictValidation) {
+		h.outputError(w, r, http.StatusBadRequest, errors.New("strict validation needs the whole document, it doesn't have a timeout"))
+		return
+	}
+	ctx, cancel := context.WithTimeout(r.Context(), timeout)
+	defer cancel()
+
+	input, size, err := openInputFromRequest(r)
+	if err != nil {
+		h.outputError(w, r, http.StatusBadRequest, err)
+		return
+	}
+	defer input.Close()
+	noteStatsSample(r, "", int(size))
+
+	// uploads are validated while they're read unless pre-parse hooks are called with them or
+	// they're sampled for the shadow validation
+	br := bufio.NewReaderSize(input, sniffSize)
+	c := &HookContext{Request: r, Header: w.Header()}
+	var stream io.Reader = br
+	shadowed := h.shadow.sampled()
+	if hooksRegistered(HookPreParse) || shadowed {
+		buf, err := io.ReadAll(br)
+		if err != nil {
+			h.outputError(w, r, http.StatusBadRequest, err)
+			return
+		}
+		c = newHookContext(w, r, buf)
+		if err = c.run(HookPreParse); err != nil {
+			h.outputError(w, r, http.StatusUnprocessableEntity, err)
+			return
+		}
+		if utils.GetDocumentFormat(c.Input) != utils.DocumentTypeXml {
+			h.outputError(w, r, http.StatusBadRequest, errDeadlineFormat)
+			return
+		}
+		if _, _, err = document.SplitEnvelope(c.Input); err == nil {
+			h.outputError(w, r, http.StatusBadRequest, errDeadlineEnvelope)
+			return
+		}
+		size, stream = int64(len(c.Input)), bytes.NewReader(c.Input)
+	} else {
+		root, ok := sniffXmlRoot(br)
+		if !ok {
+			h.outputError(w, r, http.StatusBadRequest, errDeadlineFormat)
+			return
+		}
+		if root.Name.Local == document.EnvelopeElement {
+			h.outputError(w, r, http.StatusBadRequest, errDeadlineEnvelope)
+			return
+		}
+	}
+	if !h.injectFault(w, r, ChaosStageValidate) {
+		return
+	}
+
+	result := ValidationResult{Size: int(size)}
+	var fast error
+	err = document.ValidateStreamContext(ctx, stream, func(event document.StreamEvent) {
+		if event.Type == document.StreamEventStart {
+			result.MessageType = event.MessageType
+		}
+		if !event.Valid && event.Error != "" {
+			if fast == nil {
+				fast = fmt.Errorf("%s: %s", event.Path, event.Error)
+			}
+			result.Errors = append(result.Errors, ValidationFinding{Path: event.Path, Error: h.masker.Mask(event.Error)})
+		}
+		result.Transactions, result.Invalid, result.Bytes = event.Transactions, event.Invalid, event.Bytes
+	})
+	switch {
+	case errors.Is(err, context.DeadlineExceeded):
+		result.Partial = true
+		w.Header().Set(PartialResultHeader, "true")
+	case err != nil:
+		h.outputError(w, r, http.StatusBadRequest, err)
+		return
+	}
+	noteStatsSample(r, result.MessageType, int(size))
+	result.Valid = result.Invalid == 0
+	if shadowed && !result.Partial {
+		h.shadow.validate(c.Input, result.MessageType, fast)
+	}
+
+	code := http.StatusOK
+	if !result.Valid {
+		code = http.StatusNotImplemented
+	}
+	warningHeaders(w, c.Warnings)
+	h.outputData(w, r, code, result, c.Warnings...)
+}
@@ -0,0 +1,112 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package server_test
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/require"
+
+	"github.com/moov-io/iso20022/pkg/features"
+	"github.com/moov-io/iso20022/pkg/iso20022test/fixtures"
+	"github.com/moov-io/iso20022/pkg/server"
+)
+
+func validateWithTimeout(t *testing.T, ts *httptest.Server, input []byte, timeout string) (*http.Response, server.ValidationResult) {
+	resp := postForm(t, ts.URL+"/validator", input, map[string]string{"timeout": timeout})
+	var result server.ValidationResult
+	if resp.StatusCode == http.StatusOK || resp.StatusCode == http.StatusNotImplemented {
+		require.Nil(t, json.NewDecoder(resp.Body).Decode(&result))
+	}
+	return resp, result
+}
+
+func TestValidatorTimeout(t *testing.T) {
+	router := mux.NewRouter()
+	require.Nil(t, server.ConfigureHandlers(router))
+	ts := httptest.NewServer(router)
+	defer ts.Close()
+
+	input := fixtures.ReadFile(t, "valid_pacs_v08.xml")
+
+	resp, result := validateWithTimeout(t, ts, input, "1m")
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Empty(t, resp.Header.Get(server.PartialResultHeader))
+	require.Equal(t, "pacs.008.001.08", result.MessageType)
+	require.False(t, result.Partial)
+	require.True(t, result.Valid)
+	require.Equal(t, 2, result.Transactions)
+	require.Equal(t, int64(len(input)), result.Bytes)
+	require.Equal(t, len(input), result.Size)
+
+	// the deadline passes before the first transaction
+	resp, result = validateWithTimeout(t, ts, input, "1ns")
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, "true", resp.Header.Get(server.PartialResultHeader))
+	require.True(t, result.Partial)
+	require.Less(t, result.Transactions, 2)
+	require.Less(t, result.Bytes, int64(len(input)))
+
+	invalid := strings.Replace(string(input), `<IntrBkSttlmAmt Ccy="USD">500.75`, `<IntrBkSttlmAmt Ccy="usd">500.75`, 1)
+	resp, result = validateWithTimeout(t, ts, []byte(invalid), "1m")
+	require.Equal(t, http.StatusNotImplemented, resp.StatusCode)
+	require.False(t, result.Valid)
+	require.Equal(t, 1, result.Invalid)
+	require.NotEmpty(t, result.Errors)
+	require.Equal(t, "CdtTrfTxInf[1]", result.Errors[0].Path)
+
+	for _, timeout := range []string{"soon", "-1s", "0s"} {
+		resp, _ = validateWithTimeout(t, ts, input, timeout)
+		require.Equal(t, http.StatusBadRequest, resp.StatusCode, timeout)
+	}
+
+	resp, _ = validateWithTimeout(t, ts, fixtures.ReadFile(t, "valid_pacs_v08.json"), "1m")
+	require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	resp, _ = validateWithTimeout(t, ts, fixtures.ReadFile(t, "valid_pacs_v08_envelope.xml"), "1m")
+	require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+	resp = postForm(t, ts.URL+"/validator", input, map[string]string{"timeout": "1m", "report": "html"})
+	require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
+
+func TestValidatorTimeoutWithHooks(t *testing.T) {
+	t.Cleanup(server.ResetHooks)
+	router := mux.NewRouter()
+	require.Nil(t, server.ConfigureHandlers(router))
+	ts := httptest.NewServer(router)
+	defer ts.Close()
+
+	// uploads are read whole for pre-parse hooks
+	var size int
+	server.RegisterHook(server.HookPreParse, "pacs.008.001.08", func(c *server.HookContext) error {
+		size = len(c.Input)
+		return nil
+	})
+	input := fixtures.ReadFile(t, "valid_pacs_v08.xml")
+	resp, result := validateWithTimeout(t, ts, input, "1m")
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, len(input), size)
+	require.Equal(t, 2, result.Transactions)
+
+	server.RegisterHook(server.HookPreParse, server.AnyMessageType, func(*server.HookContext) error {
+		return errors.New("rejected")
+	})
+	resp, _ = validateWithTimeout(t, ts, input, "1m")
+	require.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
+}
+
+func TestValidatorTimeoutWithStrictValidation(t *testing.T) {
+	ts := featuresServer(t, server.APIConfig{Features: server.FeaturesConfig{Enabled: []string{features.StrictValidation}}})
+
+	// unknown elements aren't checked while streaming
+	resp, _ := validateWithTimeout(t, ts, fixtures.ReadFile(t, "valid_pacs_v08.xml"), "1m")
+	require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
@@ -0,0 +1,199 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package server
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"net"
+	"net/url"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/moov-io/base/log"
+
+	"github.com/moov-io/iso20022/pkg/masking"
+	"github.com/moov-io/iso20022/pkg/profile"
+	"github.com/moov-io/iso20022/pkg/storage"
+	"github.com/moov-io/iso20022/pkg/templates"
+)
+
+const (
+	BackendTypeStorage = "storage"
+	BackendTypeKafka   = "kafka"
+	BackendTypeRedis   = "redis"
+
+	DiagnosticStatusOk    = "ok"
+	DiagnosticStatusError = "error"
+
+	// backendDialTimeout bounds every connectivity check of a backend address
+	backendDialTimeout = 3 * time.Second
+)
+
+// Diagnostic is the outcome of a single configuration check
+type Diagnostic struct {
+	Check   string `json:"check"`
+	Status  string `json:"status"`
+	Message string `json:"message,omitempty"`
+}
+
+// Diagnostics are the outcome of validating a configuration
+type Diagnostics struct {
+	Valid  bool         `json:"valid"`
+	Checks []Diagnostic `json:"checks"`
+}
+
+func (d *Diagnostics) add(check string, err error) {
+	diagnostic := Diagnostic{Check: check, Status: DiagnosticStatusOk}
+	if err != nil {
+		diagnostic.Status = DiagnosticStatusError
+		diagnostic.Message = err.Error()
+		d.Valid = false
+	}
+	d.Checks = append(d.Checks, diagnostic)
+}
+
+// ValidateConfig checks the servers and api of config and the connectivity of its backends
+func ValidateConfig(config *Config) Diagnostics {
+	diagnostics := Diagnostics{Valid: true}
+
+	binds := make(map[string]string)
+	diagnostics.add("servers.admin", checkBindAddress(config.Servers.Admin.Bind.Address, "admin", binds))
+	diagnostics.add("servers.public", checkBindAddress(config.Servers.Public.Bind.Address, "public", binds))
+
+	// the stores are checked without being created, the endpoints are configured in memory
+	diagnostics.add("api.storage", checkStorageConfig(config.API.Storage))
+	if len(config.API.Storage.Retention) > 0 {
+		_, err := storage.NewPurger(storage.NewMemoryStore(), config.API.Storage.Retention)
+		diagnostics.add("api.storage.retention", err)
+	}
+	diagnostics.add("api.templates", checkTemplatesConfig(config.API.Templates))
+	diagnostics.add("api.schedules", checkSchedulesConfig(config.API.Schedules, config.API.Outbound))
+	diagnostics.add("api", checkHandlers(config.API))
+	diagnostics.add("profiles", checkProfiles(config.Profiles))
+
+	for i, backend := range config.Backends {
+		name := backend.Name
+		if name == "" {
+			name = fmt.Sprintf("%d", i)
+		}
+		diagnostics.add("backends."+name, checkBackend(backend))
+	}
+	return diagnostics
+}
+
+// checkHandlers configures the endpoints of options with stores in memory
+func checkHandlers(options APIConfig) error {
+	usage, err := newUsageMeter(options.Usage)
+	if err != nil {
+		return err
+	}
+	return configureHandlers(mux.NewRouter(), options, log.NewNopLogger(), storage.NewMemoryStore(), templates.NewMemoryLibrary(), usage, newJobStore(), masking.New(options.Masking))
+}
+
+// checkProfiles compiles the profiles, code lists and history of config without registering them
+func checkProfiles(config ProfilesConfig) error {
+	if config.History != "" {
+		if err := profile.CheckHistory(config.History); err != nil {
+			return fmt.Errorf("history: %w", err)
+		}
+	}
+	if config.Directory == "" && config.CodeLists == "" {
+		return nil
+	}
+	return profile.NewLoader(config.Directory, config.CodeLists).Check()
+}
+
+// checkDirectory checks that dir is a directory or can be created below its closest existing
+// parent, without creating it
+func checkDirectory(dir string) error {
+	if dir == "" {
+		return nil
+	}
+	for path := filepath.Clean(dir); ; path = filepath.Dir(path) {
+		info, err := os.Stat(path)
+		if err == nil {
+			if !info.IsDir() {
+				return fmt.Errorf("%s isn't a directory", path)
+			}
+			return nil
+		}
+		if !errors.Is(err, fs.ErrNotExist) || filepath.Dir(path) == path {
+			return err
+		}
+	}
+}
+
+func checkBindAddress(address, name string, binds map[string]string) error {
+	if address == "" {
+		return fmt.Errorf("bind address is omitted")
+	}
+	if _, _, err := net.SplitHostPort(address); err != nil {
+		return err
+	}
+	if other, ok := binds[address]; ok {
+		return fmt.Errorf("bind address %s is used by the %s server too", address, other)
+	}
+	binds[address] = name
+	return nil
+}
+
+func checkBackend(backend BackendConfig) error {
+	if len(backend.Addresses) == 0 {
+		return fmt.Errorf("addresses are omitted")
+	}
+
+	for _, address := range backend.Addresses {
+		var err error
+		switch backend.Type {
+		case BackendTypeKafka, BackendTypeRedis:
+			err = dialAddress(address)
+		case BackendTypeStorage:
+			err = checkStorage(address)
+		default:
+			return fmt.Errorf("unknown backend type %q", backend.Type)
+		}
+		if err != nil {
+			return fmt.Errorf("%s: %v", address, err)
+		}
+	}
+	return nil
+}
+
+// checkStorage checks that file:// directories are writable and http(s) endpoints are reachable
+func checkStorage(address string) error {
+	u, err := url.Parse(address)
+	if err != nil {
+		return err
+	}
+
+	switch u.Scheme {
+	case "file", "":
+		file, err := os.CreateTemp(u.Path, ".iso20022-")
+		if err != nil {
+			return err
+		}
+		file.Close()
+		return os.Remove(file.Name())
+	case "http", "https":
+		port := u.Port()
+		if port == "" {
+			port = map[string]string{"http": "80", "https": "443"}[u.Scheme]
+		}
+		return dialAddress(net.JoinHostPort(u.Hostname(), port))
+	}
+	return fmt.Errorf("unsupported scheme %q", u.Scheme)
+}
+
+func dialAddress(address string) error {
+	conn, err := net.DialTimeout("tcp", address, backendDialTimeout)
+	if err != nil {
+		return err
+	}
+	return conn.Close()
+}
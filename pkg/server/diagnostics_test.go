@@ -0,0 +1,181 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package server_test
+
+import (
+	"net"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/moov-io/iso20022/pkg/profile"
+	"github.com/moov-io/iso20022/pkg/schedule"
+	"github.com/moov-io/iso20022/pkg/server"
+	"github.com/moov-io/iso20022/pkg/storage"
+)
+
+func testServersConfig() server.ServerConfig {
+	return server.ServerConfig{
+		Public: server.HTTPConfig{Bind: server.BindAddress{Address: ":8208"}},
+		Admin:  server.HTTPConfig{Bind: server.BindAddress{Address: ":8209"}},
+	}
+}
+
+func TestValidateConfig(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.Nil(t, err)
+	defer listener.Close()
+
+	ts := httptest.NewServer(nil)
+	defer ts.Close()
+
+	diagnostics := server.ValidateConfig(&server.Config{
+		Servers: testServersConfig(),
+		Backends: []server.BackendConfig{
+			{Name: "events", Type: server.BackendTypeKafka, Addresses: []string{listener.Addr().String()}},
+			{Name: "archive", Type: server.BackendTypeStorage, Addresses: []string{"file://" + t.TempDir(), ts.URL}},
+		},
+	})
+	require.True(t, diagnostics.Valid)
+	require.Equal(t, []server.Diagnostic{
+		{Check: "servers.admin", Status: server.DiagnosticStatusOk},
+		{Check: "servers.public", Status: server.DiagnosticStatusOk},
+		{Check: "api.storage", Status: server.DiagnosticStatusOk},
+		{Check: "api.templates", Status: server.DiagnosticStatusOk},
+		{Check: "api.schedules", Status: server.DiagnosticStatusOk},
+		{Check: "api", Status: server.DiagnosticStatusOk},
+		{Check: "profiles", Status: server.DiagnosticStatusOk},
+		{Check: "backends.events", Status: server.DiagnosticStatusOk},
+		{Check: "backends.archive", Status: server.DiagnosticStatusOk},
+	}, diagnostics.Checks)
+}
+
+func TestValidateConfigWithInvalidConfig(t *testing.T) {
+	servers := testServersConfig()
+	servers.Admin.Bind.Address = servers.Public.Bind.Address
+
+	diagnostics := server.ValidateConfig(&server.Config{
+		Servers: servers,
+		API: server.APIConfig{
+			Deprecations: []server.Deprecation{{Version: "v9"}},
+		},
+		Backends: []server.BackendConfig{
+			{Name: "cache", Type: server.BackendTypeRedis, Addresses: []string{"127.0.0.1:1"}},
+			{Type: server.BackendTypeStorage, Addresses: []string{"file://" + filepath.Join(t.TempDir(), "missing")}},
+			{Type: server.BackendTypeStorage, Addresses: []string{"s3://bucket"}},
+			{Type: "queue", Addresses: []string{"127.0.0.1:1"}},
+			{Name: "omitted", Type: server.BackendTypeKafka},
+		},
+	})
+	require.False(t, diagnostics.Valid)
+	require.Len(t, diagnostics.Checks, 12)
+
+	for _, check := range diagnostics.Checks {
+		switch check.Check {
+		case "servers.admin", "api.storage", "api.templates", "api.schedules", "profiles":
+			require.Equal(t, server.DiagnosticStatusOk, check.Status, check.Check)
+			continue
+		}
+		require.Equal(t, server.DiagnosticStatusError, check.Status, check.Check)
+		require.NotEmpty(t, check.Message)
+	}
+	require.Equal(t, "bind address :8208 is used by the admin server too", diagnostics.Checks[1].Message)
+	require.Equal(t, "backends.1", diagnostics.Checks[8].Check)
+	require.Equal(t, `unknown backend type "queue"`, diagnostics.Checks[10].Message)
+	require.Equal(t, "addresses are omitted", diagnostics.Checks[11].Message)
+
+	diagnostics = server.ValidateConfig(&server.Config{})
+	require.False(t, diagnostics.Valid)
+	require.Equal(t, "bind address is omitted", diagnostics.Checks[0].Message)
+}
+
+func TestValidateConfigWithInvalidRetention(t *testing.T) {
+	diagnostics := server.ValidateConfig(&server.Config{
+		Servers: testServersConfig(),
+		API: server.APIConfig{Storage: server.StorageConfig{
+			Retention: []storage.RetentionPolicy{{Kind: storage.KindSummary}},
+		}},
+	})
+	require.False(t, diagnostics.Valid)
+	require.Equal(t, server.Diagnostic{
+		Check:   "api.storage.retention",
+		Status:  server.DiagnosticStatusError,
+		Message: "retention policy 0: max age must be positive",
+	}, diagnostics.Checks[3])
+}
+
+func TestValidateConfigWithoutCreatingStores(t *testing.T) {
+	dir := t.TempDir()
+	config := &server.Config{
+		Servers: testServersConfig(),
+		API: server.APIConfig{
+			Storage: server.StorageConfig{
+				Directory:   filepath.Join(dir, "documents", "primary"),
+				Replication: server.ReplicationConfig{Directory: filepath.Join(dir, "replica")},
+			},
+			Templates: server.TemplatesConfig{Directory: filepath.Join(dir, "templates")},
+		},
+	}
+	diagnostics := server.ValidateConfig(config)
+	require.True(t, diagnostics.Valid, diagnostics.Checks)
+
+	entries, err := os.ReadDir(dir)
+	require.Nil(t, err)
+	require.Empty(t, entries)
+
+	// the directories of the stores can't be created below a file
+	file := filepath.Join(dir, "file")
+	require.Nil(t, os.WriteFile(file, nil, 0600))
+	config.API.Storage.Replication.Directory = filepath.Join(file, "replica")
+	config.API.Templates.Directory = file
+	diagnostics = server.ValidateConfig(config)
+	require.False(t, diagnostics.Valid)
+	require.Contains(t, diagnostics.Checks[2].Message, "replication: stat "+file)
+	require.Equal(t, file+" isn't a directory", diagnostics.Checks[3].Message)
+}
+
+func TestValidateConfigWithInvalidStores(t *testing.T) {
+	profiles := t.TempDir()
+	require.Nil(t, os.WriteFile(filepath.Join(profiles, "broken.yml"), []byte("name: Diagnostics-Broken\nextends: Diagnostics-Unknown\n"), 0600))
+
+	diagnostics := server.ValidateConfig(&server.Config{
+		Servers: testServersConfig(),
+		API: server.APIConfig{
+			Storage: server.StorageConfig{
+				Encryption: server.EncryptionConfig{KeyID: "k1", Keys: []server.EncryptionKey{{ID: "k1", Key: "not base64"}}},
+			},
+			Schedules: server.SchedulesConfig{
+				Jobs: []schedule.Job{{Name: "payroll", Template: "payroll", Cron: "0 6 * * *", Destination: "unknown"}},
+			},
+		},
+		Profiles: server.ProfilesConfig{Directory: profiles, History: filepath.Join(profiles, "missing")},
+	})
+	require.False(t, diagnostics.Valid)
+
+	messages := make(map[string]string)
+	for _, check := range diagnostics.Checks {
+		messages[check.Check] = check.Message
+	}
+	require.Contains(t, messages["api.storage"], "encryption key k1 isn't base64 encoded")
+	require.Equal(t, `scheduled job payroll: destination "unknown" isn't configured`, messages["api.schedules"])
+	require.Contains(t, messages["profiles"], "history: ")
+	require.Empty(t, messages["api"])
+
+	diagnostics = server.ValidateConfig(&server.Config{
+		Servers:  testServersConfig(),
+		API:      server.APIConfig{Storage: server.StorageConfig{Compression: server.CompressionConfig{Level: 20}}},
+		Profiles: server.ProfilesConfig{Directory: profiles},
+	})
+	require.False(t, diagnostics.Valid)
+	require.Equal(t, "compression level 20 isn't between 1 and 9", diagnostics.Checks[2].Message)
+	require.Contains(t, diagnostics.Checks[6].Message, "broken.yml")
+
+	// the checked profiles aren't registered
+	_, err := profile.Get("Diagnostics-Broken")
+	require.NotNil(t, err)
+}
@@ -0,0 +1,434 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package server
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/moov-io/base/log"
+
+	"github.com/moov-io/iso20022/pkg/bucket"
+	"github.com/moov-io/iso20022/pkg/document"
+	"github.com/moov-io/iso20022/pkg/profile"
+	"github.com/moov-io/iso20022/pkg/service"
+	"github.com/moov-io/iso20022/pkg/sigv4"
+	"github.com/moov-io/iso20022/pkg/storage"
+	"github.com/moov-io/iso20022/pkg/utils"
+)
+
+const (
+	contentTypeMergePatch = "application/merge-patch+json"
+	contentTypeJson       = "application/json"
+
+	// maxPatchSize bounds the body of PATCH /documents/{id}
+	maxPatchSize = 1 << 20
+
+	// RevalidatePatched revalidates only the patched elements of documents and the profile
+	// rules depending on them, see document.Revalidate
+	RevalidatePatched = "patched"
+)
+
+// StoredDocument describes a document kept by the /documents endpoints
+type StoredDocument struct {
+	ID          string             `json:"id"`
+	MessageType string             `json:"messageType"`
+	Format      utils.DocumentType `json:"format"`
+	Created     time.Time          `json:"created"`
+	Updated     time.Time          `json:"updated"`
+
+	// Status is the review status of documents held for review, see ReviewConfig
+	Status string `json:"status,omitempty"`
+}
+
+func newStoredDocument(rec storage.Record) StoredDocument {
+	return StoredDocument{
+		ID:          rec.ID,
+		MessageType: rec.MessageType,
+		Format:      rec.Format,
+		Created:     rec.Created,
+		Updated:     rec.Updated,
+		Status:      rec.Status,
+	}
+}
+
+func newDocumentStore(config StorageConfig, logger log.Logger) (storage.Store, *storage.ReplicatedStore, error) {
+	var store storage.Store = storage.NewMemoryStore()
+	if config.Directory != "" {
+		files, err := storage.NewFileStore(config.Directory)
+		if err != nil {
+			return nil, nil, err
+		}
+		store = files
+	}
+
+	// records are replicated as stored, the replica is restored without the layers above
+	replica, err := config.Replication.replica()
+	if err != nil {
+		return nil, nil, err
+	}
+	var replicated *storage.ReplicatedStore
+	if replica != nil {
+		replicated = storage.NewReplicatedStore(store, replica, storage.ReplicationOptions{
+			Kinds:     config.Replication.Kinds,
+			QueueSize: config.Replication.QueueSize,
+			Report: func(change storage.Change, err error) {
+				logger.Error().LogErrorf("problem replicating %s of %s: %w", change.Op, change.ID, err)
+			},
+		})
+		store = replicated
+	}
+
+	minSize := config.Compression.MinSize
+	if minSize <= 0 {
+		minSize = storage.DefaultCompressionMinSize
+	}
+	compressed, err := storage.NewCompressedStore(store, config.Compression.Level, minSize)
+	if err != nil {
+		return nil, nil, err
+	}
+	store = compressed
+
+	wrapper, err := config.Encryption.keys()
+	if err != nil {
+		return nil, nil, err
+	}
+	if wrapper != nil {
+		store = storage.NewEncryptedStore(store, wrapper, config.Encryption.Elements...)
+	}
+
+	// documents are deduplicated before their encryption with a data key of their own
+	if config.Deduplication {
+		store = storage.NewDeduplicatedStore(store)
+	}
+	return store, replicated, nil
+}
+
+// checkStorageConfig checks the options of newDocumentStore without creating its directories
+// nor replicating to its replica
+func checkStorageConfig(config StorageConfig) error {
+	if err := checkDirectory(config.Directory); err != nil {
+		return err
+	}
+	if err := checkDirectory(config.Replication.Directory); err != nil {
+		return fmt.Errorf("replication: %w", err)
+	}
+	if _, err := storage.NewCompressedStore(storage.NewMemoryStore(), config.Compression.Level, config.Compression.MinSize); err != nil {
+		return err
+	}
+	_, err := config.Encryption.keys()
+	return err
+}
+
+// keys returns the key encryption keys of config, nil when documents aren't encrypted
+func (config EncryptionConfig) keys() (*storage.StaticKeys, error) {
+	if config.KeyID == "" {
+		return nil, nil
+	}
+	keys := make(map[string][]byte)
+	for _, key := range config.Keys {
+		buf, err := base64.StdEncoding.DecodeString(key.Key)
+		if err != nil {
+			return nil, fmt.Errorf("encryption key %s isn't base64 encoded: %w", key.ID, err)
+		}
+		keys[key.ID] = buf
+	}
+	return storage.NewStaticKeys(config.KeyID, keys)
+}
+
+// replica returns nil when config has no directory nor bucket
+func (config ReplicationConfig) replica() (storage.Replica, error) {
+	switch {
+	case config.Directory != "":
+		return storage.NewFileStore(config.Directory)
+	case config.Bucket != "":
+		return bucket.NewReplica(bucket.NewS3(config.Bucket, config.Region, sigv4.EnvCredentials()), config.Prefix), nil
+	}
+	return nil, nil
+}
+
+// getDocument returns the stored record with the id of r and its parsed document, it responds
+// with the error and returns false when the document isn't stored
+func (h handlers) getDocument(w http.ResponseWriter, r *http.Request) (storage.Record, document.Iso20022Document, bool) {
+	rec, err := h.documents.Get(r.Context(), mux.Vars(r)["id"])
+	if err == nil && rec.Kind == storage.KindAudit {
+		// audit records aren't documents
+		err = fmt.Errorf("%w: %s", storage.ErrNotFound, rec.ID)
+	}
+	if errors.Is(err, storage.ErrNotFound) || errors.Is(err, storage.ErrInvalidID) {
+		h.outputError(w, r, http.StatusNotFound, err)
+		return rec, nil, false
+	}
+	if err != nil {
+		h.outputError(w, r, http.StatusInternalServerError, err)
+		return rec, nil, false
+	}
+
+	doc, err := service.Parse(bytes.NewReader(rec.Content))
+	if err != nil {
+		h.outputError(w, r, http.StatusInternalServerError, err)
+		return rec, nil, false
+	}
+	noteDetection(r, rec.Content, doc)
+	return rec, doc, true
+}
+
+// createDocument - store the posted document in its format, documents matching a review rule
+// are held for review with 202 Accepted
+func (h handlers) createDocument(w http.ResponseWriter, r *http.Request) {
+	c, ok := h.parseInput(w, r)
+	if !ok || !h.checkCorridors(w, r, c) {
+		return
+	}
+
+	now := time.Now().UTC()
+	rec := storage.Record{
+		ID:          newId(),
+		Kind:        storage.KindOriginal,
+		MessageType: utils.GetMessageType(c.Document.NameSpace()),
+		Format:      utils.GetDocumentFormat(c.Input),
+		Content:     c.Input,
+		Created:     now,
+		Updated:     now,
+	}
+	rules := h.review.hold(c.Document, h.corridors, h.largeValues)
+	if len(rules) > 0 {
+		rec.Status = ReviewStatusPending
+	}
+	for _, rule := range rules {
+		noteRules(r, RuleHit{Kind: RuleKindReview, Rule: rule, Action: ReviewActionHold})
+	}
+	if err := h.documents.Put(r.Context(), rec); err != nil {
+		h.outputError(w, r, http.StatusInternalServerError, err)
+		return
+	}
+
+	// held documents are emitted and answered by the simulator once they're released
+	code := http.StatusCreated
+	if len(rules) > 0 {
+		if _, err := h.reviewAudit(r, ReviewAudit{Action: ReviewActionHold, Document: rec.ID, Rules: rules}); err != nil {
+			h.outputError(w, r, http.StatusInternalServerError, err)
+			return
+		}
+		code = http.StatusAccepted
+	} else {
+		h.events.emit(r.Context(), rec.ID, c.Document)
+		h.counterparty.receive(r.Context(), rec.ID, c.Document)
+	}
+
+	warningHeaders(w, c.Warnings)
+	h.outputData(w, r, code, newStoredDocument(rec), c.Warnings...)
+}
+
+// document - print the stored document in its format or the format parameter
+func (h handlers) document(w http.ResponseWriter, r *http.Request) {
+	rec, doc, ok := h.getDocument(w, r)
+	if !ok || !h.approved(w, r, rec) {
+		return
+	}
+
+	format := rec.Format
+	if r.FormValue("format") != "" {
+		var err error
+		if format, err = getFormat(r); err != nil {
+			h.outputError(w, r, http.StatusNotImplemented, err)
+			return
+		}
+	}
+	output, err := messageToBuf(format, doc)
+	if err != nil {
+		h.outputError(w, r, http.StatusNotImplemented, err)
+		return
+	}
+	if !h.fingerprint(w, r, nil, doc) {
+		return
+	}
+
+	h.outputDocument(w, r, doc, format, output)
+}
+
+// patchDocument - apply a json merge patch (application/merge-patch+json) or an array of field
+// patches (application/json) to the stored document, the document is kept unless the patched
+// document is valid, for the profile parameter too. revalidate=patched revalidates only the
+// patched elements and the profile rules depending on them. Messages held for four-eyes
+// approval or approved aren't patched.
+func (h handlers) patchDocument(w http.ResponseWriter, r *http.Request) {
+	mediaType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil || (mediaType != contentTypeMergePatch && mediaType != contentTypeJson) {
+		h.outputError(w, r, http.StatusUnsupportedMediaType,
+			fmt.Errorf("patches are %s or %s", contentTypeMergePatch, contentTypeJson))
+		return
+	}
+	patch, err := io.ReadAll(io.LimitReader(r.Body, maxPatchSize))
+	if err != nil {
+		h.outputError(w, r, http.StatusBadRequest, err)
+		return
+	}
+
+	revalidate := r.URL.Query().Get("revalidate")
+	if revalidate != "" && revalidate != RevalidatePatched {
+		h.outputError(w, r, http.StatusBadRequest, fmt.Errorf("invalid revalidate %s", revalidate))
+		return
+	}
+	asOf, err := getAsOf(r)
+	if err != nil {
+		h.outputError(w, r, http.StatusBadRequest, err)
+		return
+	}
+	var p *profile.Profile
+	if name := r.URL.Query().Get("profile"); name != "" {
+		if p, err = getProfile(name, asOf); err == nil {
+			err = checkExperimentalProfile(r, p)
+		}
+		if err != nil {
+			h.outputError(w, r, http.StatusBadRequest, err)
+			return
+		}
+	}
+
+	rec, doc, ok := h.getDocument(w, r)
+	if !ok || !h.editable(w, r, rec) {
+		return
+	}
+
+	var patched document.Iso20022Document
+	if mediaType == contentTypeMergePatch {
+		patched, err = document.ApplyMergePatch(doc, patch)
+	} else {
+		patched, err = document.ApplyPatch(doc, patch)
+	}
+	if err != nil {
+		h.outputError(w, r, http.StatusBadRequest, err)
+		return
+	}
+	if err = revalidatePatch(patched, patch, revalidate, p, asOf); err != nil {
+		h.outputError(w, r, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	output, err := messageToBuf(rec.Format, patched)
+	if err != nil {
+		h.outputError(w, r, http.StatusInternalServerError, err)
+		return
+	}
+	rec.Content = output
+	rec.Updated = time.Now().UTC()
+	if err = h.documents.Put(r.Context(), rec); err != nil {
+		h.outputError(w, r, http.StatusInternalServerError, err)
+		return
+	}
+
+	h.outputDocument(w, r, patched, rec.Format, output)
+}
+
+// revalidatePatch validates the document patched by patch and evaluates the rules of p when
+// it isn't nil, at asOf or the current time, only for the patched elements with RevalidatePatched
+func revalidatePatch(patched document.Iso20022Document, patch []byte, revalidate string, p *profile.Profile, asOf time.Time) error {
+	var paths []string
+	if revalidate == RevalidatePatched {
+		var err error
+		if paths, err = document.PatchPaths(patch); err != nil {
+			return err
+		}
+		if err = document.Revalidate(patched, paths); err != nil {
+			return err
+		}
+	} else if err := patched.Validate(); err != nil {
+		return err
+	}
+	if p == nil {
+		return nil
+	}
+
+	in := profile.Input{Document: patched, Now: asOf}
+	if asOf.IsZero() {
+		in.Now = time.Now()
+	}
+	if revalidate == RevalidatePatched {
+		return p.EvaluatePaths(in, paths).Err()
+	}
+	return p.Evaluate(in).Err()
+}
+
+// RetentionHandler - admin endpoint inspecting (GET) the status of the last purge of purger and
+// purging (POST) the expired documents immediately
+func RetentionHandler(purger *storage.Purger) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		code := http.StatusOK
+		var status storage.RetentionStatus
+
+		switch r.Method {
+		case http.MethodGet:
+			status = purger.Status()
+		case http.MethodPost:
+			var err error
+			if status, err = purger.Purge(r.Context(), storage.PurgeTriggerManual); err != nil {
+				code = http.StatusInternalServerError
+			}
+		default:
+			w.Header().Set("Allow", "GET, POST")
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(code)
+		json.NewEncoder(w).Encode(status)
+	}
+}
+
+// ReplicationHandler - admin endpoint inspecting (GET) the progress of the replication of store
+// and replicating (POST) every document again, e.g. after an outage of the replica
+func ReplicationHandler(store *storage.ReplicatedStore) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+		case http.MethodPost:
+			if _, err := store.Sync(r.Context()); err != nil {
+				w.Header().Set("Content-Type", "application/json; charset=utf-8")
+				w.WriteHeader(http.StatusInternalServerError)
+				json.NewEncoder(w).Encode(map[string]interface{}{"error": err.Error()})
+				return
+			}
+		default:
+			w.Header().Set("Allow", "GET, POST")
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(store.Status())
+	}
+}
+
+// DeduplicationHandler - admin endpoint counting (GET) the documents of store sharing their
+// content and the bytes saved
+func DeduplicationHandler(store *storage.DeduplicatedStore) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			w.Header().Set("Allow", "GET")
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		stats, err := store.Stats(r.Context())
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			json.NewEncoder(w).Encode(map[string]interface{}{"error": err.Error()})
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(stats)
+	}
+}
@@ -0,0 +1,356 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package server_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	baseLog "github.com/moov-io/base/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/moov-io/iso20022/pkg/iso20022test/fixtures"
+	"github.com/moov-io/iso20022/pkg/server"
+	"github.com/moov-io/iso20022/pkg/storage"
+	"github.com/moov-io/iso20022/pkg/utils"
+)
+
+func createDocument(t *testing.T, ts *httptest.Server, input []byte) server.StoredDocument {
+	resp := postForm(t, ts.URL+"/documents", input, nil)
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+	var stored server.StoredDocument
+	require.Nil(t, json.NewDecoder(resp.Body).Decode(&stored))
+	require.NotEmpty(t, stored.ID)
+	return stored
+}
+
+func patchDocument(t *testing.T, url, contentType, patch string) *http.Response {
+	req, err := http.NewRequest(http.MethodPatch, url, strings.NewReader(patch))
+	require.Nil(t, err)
+	req.Header.Set("Content-Type", contentType)
+	resp, err := http.DefaultClient.Do(req)
+	require.Nil(t, err)
+	t.Cleanup(func() { resp.Body.Close() })
+	return resp
+}
+
+func getDocument(t *testing.T, url string) []byte {
+	resp, err := http.Get(url)
+	require.Nil(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	body, err := io.ReadAll(resp.Body)
+	require.Nil(t, err)
+	return body
+}
+
+func TestDocumentsMergePatch(t *testing.T) {
+	ts := newJobServer(t)
+
+	stored := createDocument(t, ts, fixtures.ReadFile(t, "valid_pacs_v08.xml"))
+	require.Equal(t, "pacs.008.001.08", stored.MessageType)
+	require.Equal(t, utils.DocumentTypeXml, stored.Format)
+
+	url := ts.URL + "/documents/" + stored.ID
+	resp := patchDocument(t, url, "application/merge-patch+json", `{"GrpHdr": {"MsgId": "MSG-REPAIRED"}}`)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	body, err := io.ReadAll(resp.Body)
+	require.Nil(t, err)
+	require.Contains(t, string(body), "<MsgId>MSG-REPAIRED</MsgId>")
+
+	// the patched document is stored in its format
+	require.Contains(t, string(getDocument(t, url)), "<MsgId>MSG-REPAIRED</MsgId>")
+	require.Contains(t, string(getDocument(t, url+"?format=json")), `"MsgId":"MSG-REPAIRED"`)
+}
+
+func TestDocumentsFieldPatch(t *testing.T) {
+	ts := newJobServer(t)
+
+	stored := createDocument(t, ts, fixtures.ReadFile(t, "valid_pacs_v08.xml"))
+	url := ts.URL + "/documents/" + stored.ID
+	resp := patchDocument(t, url, "application/json", `[{"path": "CdtTrfTxInf[0]/PmtId/InstrId", "value": "INSTR-REPAIRED"}]`)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Contains(t, string(getDocument(t, url)), "<InstrId>INSTR-REPAIRED</InstrId>")
+}
+
+func TestDocumentsPatchRejected(t *testing.T) {
+	ts := newJobServer(t)
+
+	stored := createDocument(t, ts, fixtures.ReadFile(t, "valid_pacs_v08.xml"))
+	url := ts.URL + "/documents/" + stored.ID
+	original := getDocument(t, url)
+
+	// invalid documents aren't stored
+	resp := patchDocument(t, url, "application/merge-patch+json", `{"GrpHdr": {"MsgId": null}}`)
+	require.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
+	require.True(t, bytes.Equal(original, getDocument(t, url)))
+
+	resp = patchDocument(t, url, "application/merge-patch+json", `{"GrpHdr": {"Unknown": "value"}}`)
+	require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+	resp = patchDocument(t, url, "text/plain", `{}`)
+	require.Equal(t, http.StatusUnsupportedMediaType, resp.StatusCode)
+
+	resp = patchDocument(t, ts.URL+"/documents/missing", "application/merge-patch+json", `{}`)
+	require.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
+
+func TestDocumentsPatchRevalidate(t *testing.T) {
+	ts := newJobServer(t)
+
+	// the document is invalid outside of the patched elements
+	input := strings.Replace(string(fixtures.ReadFile(t, "valid_pacs_v08.xml")), "<SttlmMtd>CLRG</SttlmMtd>", "<SttlmMtd>NONE</SttlmMtd>", 1)
+	stored := createDocument(t, ts, []byte(input))
+	url := ts.URL + "/documents/" + stored.ID
+	resp := patchDocument(t, url, "application/merge-patch+json", `{"GrpHdr": {"MsgId": "MSG-REPAIRED"}}`)
+	require.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
+
+	resp = patchDocument(t, url+"?revalidate=patched", "application/merge-patch+json", `{"GrpHdr": {"MsgId": "MSG-REPAIRED"}}`)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Contains(t, string(getDocument(t, url)), "<MsgId>MSG-REPAIRED</MsgId>")
+
+	resp = patchDocument(t, url+"?revalidate=patched", "application/json", `[{"path": "CdtTrfTxInf[1]/IntrBkSttlmAmt/@Ccy", "value": "usd"}]`)
+	require.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
+
+	// the profile rules depending on the patched elements are evaluated
+	resp = patchDocument(t, url+"?revalidate=patched&profile=SCTInst", "application/json", `[{"path": "GrpHdr/MsgId", "value": "MSG-PROFILED"}]`)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	resp = patchDocument(t, url+"?revalidate=patched&profile=SCTInst", "application/json", `[{"path": "GrpHdr/NbOfTxs", "value": "3"}]`)
+	require.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
+	body, err := io.ReadAll(resp.Body)
+	require.Nil(t, err)
+	require.Contains(t, string(body), "SCTINST-001")
+	require.NotContains(t, string(body), "SCTINST-002")
+
+	resp = patchDocument(t, url+"?revalidate=all", "application/merge-patch+json", `{}`)
+	require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	resp = patchDocument(t, url+"?profile=missing", "application/merge-patch+json", `{}`)
+	require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
+
+func TestDocumentsDirectory(t *testing.T) {
+	dir := t.TempDir()
+	router := mux.NewRouter()
+	require.Nil(t, server.ConfigureHandlersWithOptions(router, server.APIConfig{
+		Storage: server.StorageConfig{Directory: dir},
+	}))
+	ts := httptest.NewServer(router)
+	t.Cleanup(ts.Close)
+
+	stored := createDocument(t, ts, fixtures.ReadFile(t, "valid_pacs_v08.xml"))
+
+	// documents are kept by a new server of the directory
+	router = mux.NewRouter()
+	require.Nil(t, server.ConfigureHandlersWithOptions(router, server.APIConfig{
+		Storage: server.StorageConfig{Directory: dir},
+	}))
+	other := httptest.NewServer(router)
+	t.Cleanup(other.Close)
+	require.Contains(t, string(getDocument(t, other.URL+"/documents/"+stored.ID)), "<MsgId>")
+}
+
+func TestDocumentsEncryption(t *testing.T) {
+	dir := t.TempDir()
+	router := mux.NewRouter()
+	require.Nil(t, server.ConfigureHandlersWithOptions(router, server.APIConfig{
+		Storage: server.StorageConfig{
+			Directory: dir,
+			Encryption: server.EncryptionConfig{
+				KeyID: "kek-1",
+				Keys:  []server.EncryptionKey{{ID: "kek-1", Key: base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{1}, 32))}},
+			},
+		},
+	}))
+	ts := httptest.NewServer(router)
+	t.Cleanup(ts.Close)
+
+	stored := createDocument(t, ts, fixtures.ReadFile(t, "valid_pacs_v08.xml"))
+	buf, err := os.ReadFile(filepath.Join(dir, stored.ID+".json"))
+	require.Nil(t, err)
+	require.NotContains(t, string(buf), "John Smith")
+	require.Contains(t, string(getDocument(t, ts.URL+"/documents/"+stored.ID)), "<Nm>John Smith</Nm>")
+
+	// keys are verified at startup
+	require.NotNil(t, server.ConfigureHandlersWithOptions(mux.NewRouter(), server.APIConfig{
+		Storage: server.StorageConfig{Encryption: server.EncryptionConfig{KeyID: "kek-1"}},
+	}))
+}
+
+func TestDocumentsCompression(t *testing.T) {
+	dir := t.TempDir()
+	router := mux.NewRouter()
+	require.Nil(t, server.ConfigureHandlersWithOptions(router, server.APIConfig{
+		Storage: server.StorageConfig{Directory: dir, Compression: server.CompressionConfig{Level: 3}},
+	}))
+	ts := httptest.NewServer(router)
+	t.Cleanup(ts.Close)
+
+	input := fixtures.ReadFile(t, "valid_camt053_v08.xml")
+	stored := createDocument(t, ts, input)
+	buf, err := os.ReadFile(filepath.Join(dir, stored.ID+".json"))
+	require.Nil(t, err)
+	var rec storage.Record
+	require.Nil(t, json.Unmarshal(buf, &rec))
+	require.Equal(t, storage.CompressionZstd, rec.Compression)
+	require.Less(t, len(rec.Content), len(input)/2)
+	output := getDocument(t, ts.URL+"/documents/"+stored.ID)
+	require.Contains(t, string(output), "<IBAN>CH2909000000250094239</IBAN>")
+
+	// compressed documents are read by servers without compression
+	router = mux.NewRouter()
+	require.Nil(t, server.ConfigureHandlersWithOptions(router, server.APIConfig{
+		Storage: server.StorageConfig{Directory: dir},
+	}))
+	other := httptest.NewServer(router)
+	t.Cleanup(other.Close)
+	require.Equal(t, output, getDocument(t, other.URL+"/documents/"+stored.ID))
+
+	require.NotNil(t, server.ConfigureHandlersWithOptions(mux.NewRouter(), server.APIConfig{
+		Storage: server.StorageConfig{Compression: server.CompressionConfig{Level: 10}},
+	}))
+}
+
+func TestDocumentsDeduplication(t *testing.T) {
+	dir := t.TempDir()
+	env, err := server.NewEnvironment(&server.Environment{
+		Logger: baseLog.NewNopLogger(),
+		Config: &server.Config{API: server.APIConfig{Storage: server.StorageConfig{
+			Directory:     dir,
+			Deduplication: true,
+			Compression:   server.CompressionConfig{Level: 3},
+		}}},
+	})
+	require.Nil(t, err)
+	t.Cleanup(env.Shutdown)
+	ts := httptest.NewServer(env.PublicRouter)
+	t.Cleanup(ts.Close)
+
+	// a statement delivered twice is stored once
+	input := fixtures.ReadFile(t, "valid_camt053_v08.xml")
+	first, second := createDocument(t, ts, input), createDocument(t, ts, input)
+	require.NotEqual(t, first.ID, second.ID)
+	files, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	require.Nil(t, err)
+	require.Len(t, files, 3)
+	require.Equal(t, getDocument(t, ts.URL+"/documents/"+first.ID), getDocument(t, ts.URL+"/documents/"+second.ID))
+
+	deduplicated, ok := env.Documents.(*storage.DeduplicatedStore)
+	require.True(t, ok)
+	w := httptest.NewRecorder()
+	server.DeduplicationHandler(deduplicated)(w, httptest.NewRequest(http.MethodGet, "/storage/deduplication", nil))
+	require.Equal(t, http.StatusOK, w.Code)
+	var stats storage.DeduplicationStats
+	require.Nil(t, json.NewDecoder(w.Body).Decode(&stats))
+	require.Equal(t, storage.DeduplicationStats{Records: 2, Blobs: 1, Bytes: int64(len(input)), Saved: int64(len(input))}, stats)
+}
+
+func TestDocumentsReplication(t *testing.T) {
+	replica := t.TempDir()
+	encryption := server.EncryptionConfig{
+		KeyID: "kek-1",
+		Keys:  []server.EncryptionKey{{ID: "kek-1", Key: base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{1}, 32))}},
+	}
+	env, err := server.NewEnvironment(&server.Environment{
+		Logger: baseLog.NewNopLogger(),
+		Config: &server.Config{API: server.APIConfig{Storage: server.StorageConfig{
+			Directory:   t.TempDir(),
+			Encryption:  encryption,
+			Replication: server.ReplicationConfig{Directory: replica},
+		}}},
+	})
+	require.Nil(t, err)
+	require.NotNil(t, env.Replication)
+	ts := httptest.NewServer(env.PublicRouter)
+	t.Cleanup(ts.Close)
+
+	stored := createDocument(t, ts, fixtures.ReadFile(t, "valid_pacs_v08.xml"))
+	handler := server.ReplicationHandler(env.Replication)
+	request := func(method string) (*httptest.ResponseRecorder, storage.ReplicationStatus) {
+		recorder := httptest.NewRecorder()
+		handler(recorder, httptest.NewRequest(method, "/storage/replication", nil))
+		var status storage.ReplicationStatus
+		json.NewDecoder(recorder.Body).Decode(&status)
+		return recorder, status
+	}
+	require.Eventually(t, func() bool {
+		_, status := request(http.MethodGet)
+		return status.Replicated == 1
+	}, 5*time.Second, 10*time.Millisecond)
+
+	recorder, _ := request(http.MethodPost)
+	require.Equal(t, http.StatusOK, recorder.Code)
+	recorder, _ = request(http.MethodDelete)
+	require.Equal(t, http.StatusMethodNotAllowed, recorder.Code)
+	env.Shutdown()
+	require.Equal(t, int64(2), env.Replication.Status().Replicated)
+
+	// the replica is encrypted like the primary and restored by a server of its directory
+	buf, err := os.ReadFile(filepath.Join(replica, stored.ID+".json"))
+	require.Nil(t, err)
+	require.NotContains(t, string(buf), "John Smith")
+	router := mux.NewRouter()
+	require.Nil(t, server.ConfigureHandlersWithOptions(router, server.APIConfig{
+		Storage: server.StorageConfig{Directory: replica, Encryption: encryption},
+	}))
+	restored := httptest.NewServer(router)
+	t.Cleanup(restored.Close)
+	require.Contains(t, string(getDocument(t, restored.URL+"/documents/"+stored.ID)), "<Nm>John Smith</Nm>")
+}
+
+func TestRetentionHandler(t *testing.T) {
+	env, err := server.NewEnvironment(&server.Environment{
+		Logger: baseLog.NewNopLogger(),
+		Config: &server.Config{API: server.APIConfig{Storage: server.StorageConfig{
+			Retention: []storage.RetentionPolicy{{Kind: storage.KindOriginal, MaxAge: 90 * 24 * time.Hour}},
+		}}},
+	})
+	require.Nil(t, err)
+	t.Cleanup(env.Shutdown)
+	require.NotNil(t, env.Purger)
+
+	ts := httptest.NewServer(env.PublicRouter)
+	t.Cleanup(ts.Close)
+	stored := createDocument(t, ts, fixtures.ReadFile(t, "valid_pacs_v08.xml"))
+	rec, err := env.Documents.Get(context.Background(), stored.ID)
+	require.Nil(t, err)
+	require.Equal(t, storage.KindOriginal, rec.Kind)
+	require.Nil(t, env.Documents.Put(context.Background(), storage.Record{ID: "expired", Created: time.Now().AddDate(0, 0, -91)}))
+
+	handler := server.RetentionHandler(env.Purger)
+	request := func(method string) (*httptest.ResponseRecorder, storage.RetentionStatus) {
+		recorder := httptest.NewRecorder()
+		handler(recorder, httptest.NewRequest(method, "/storage/retention", nil))
+		var status storage.RetentionStatus
+		json.NewDecoder(recorder.Body).Decode(&status)
+		return recorder, status
+	}
+
+	recorder, status := request(http.MethodPost)
+	require.Equal(t, http.StatusOK, recorder.Code)
+	require.Equal(t, storage.PurgeTriggerManual, status.Trigger)
+	require.Equal(t, 1, status.Purged)
+	require.Equal(t, 1, status.Policies[0].Records)
+
+	recorder, status = request(http.MethodGet)
+	require.Equal(t, http.StatusOK, recorder.Code)
+	require.Equal(t, 1, status.Purged)
+	_, err = env.Documents.Get(context.Background(), "expired")
+	require.ErrorIs(t, err, storage.ErrNotFound)
+
+	recorder, _ = request(http.MethodDelete)
+	require.Equal(t, http.StatusMethodNotAllowed, recorder.Code)
+}
@@ -0,0 +1,56 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package server
+
+import (
+	"net/http"
+
+	"github.com/moov-io/base/log"
+)
+
+// HandlerOptions are the dependencies of the handlers mounted individually on the routers of embedders
+type HandlerOptions struct {
+	// Envelope responds with the standardized envelope of the /v2 endpoints
+	Envelope bool
+
+	// Fingerprints responds with the X-Document-Fingerprint header and verifies it on uploads
+	Fingerprints bool
+
+	// Receipts responds with the X-Processing-Receipt header and the receipt of envelopes
+	Receipts bool
+
+	// Shadow validates a sample of the validated messages in strict mode too
+	Shadow ShadowConfig
+
+	// Logger logs the discrepancies of shadow validation, the default logger when nil
+	Logger log.Logger
+}
+
+// ValidateHandler returns the handler of POST /validator, it fails when the shadow validation
+// of options is invalid
+func ValidateHandler(options HandlerOptions) (http.Handler, error) {
+	shadow, err := newShadowValidator(options.Shadow, options.Logger)
+	if err != nil {
+		return nil, err
+	}
+	return options.receipts(http.HandlerFunc(handlers{envelope: options.Envelope, fingerprints: options.Fingerprints, shadow: shadow}.validator)), nil
+}
+
+// PrintHandler returns the handler of POST /print
+func PrintHandler(options HandlerOptions) http.Handler {
+	return options.receipts(http.HandlerFunc(handlers{envelope: options.Envelope, fingerprints: options.Fingerprints}.print))
+}
+
+// ConvertHandler returns the handler of POST /convert
+func ConvertHandler(options HandlerOptions) http.Handler {
+	return options.receipts(http.HandlerFunc(handlers{envelope: options.Envelope, fingerprints: options.Fingerprints}.convert))
+}
+
+func (options HandlerOptions) receipts(handler http.Handler) http.Handler {
+	if !options.Receipts {
+		return handler
+	}
+	return receiptMiddleware(handler)
+}
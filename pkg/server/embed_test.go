@@ -0,0 +1,69 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package server_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/moov-io/iso20022/pkg/iso20022test/fixtures"
+	"github.com/moov-io/iso20022/pkg/server"
+)
+
+func TestEmbeddedHandlers(t *testing.T) {
+	validate, err := server.ValidateHandler(server.HandlerOptions{})
+	require.Nil(t, err)
+
+	mux := http.NewServeMux()
+	mux.Handle("/iso/validate", validate)
+	mux.Handle("/iso/convert", server.ConvertHandler(server.HandlerOptions{Envelope: true}))
+
+	calls := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		mux.ServeHTTP(w, r)
+	}))
+	t.Cleanup(ts.Close)
+	input := fixtures.ReadFile(t, "valid_pacs_v08.xml")
+
+	resp := postForm(t, ts.URL+"/iso/validate", input, nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	var status map[string]string
+	require.Nil(t, json.NewDecoder(resp.Body).Decode(&status))
+	require.Equal(t, "valid file", status["status"])
+
+	resp = postForm(t, ts.URL+"/iso/convert", input, map[string]string{"format": "json"})
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	var envelope struct {
+		Status string
+		Data   map[string]string
+	}
+	require.Nil(t, json.NewDecoder(resp.Body).Decode(&envelope))
+	require.Equal(t, "converted_file", envelope.Data["filename"])
+
+	resp = postForm(t, ts.URL+"/print", input, nil)
+	require.Equal(t, http.StatusNotFound, resp.StatusCode)
+	require.Equal(t, 3, calls)
+}
+
+func TestEmbeddedPrintHandler(t *testing.T) {
+	ts := httptest.NewServer(server.PrintHandler(server.HandlerOptions{}))
+	t.Cleanup(ts.Close)
+
+	resp := postForm(t, ts.URL, fixtures.ReadFile(t, "valid_pacs_v08.xml"), map[string]string{"format": "json"})
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	var doc map[string]interface{}
+	require.Nil(t, json.NewDecoder(resp.Body).Decode(&doc))
+	require.NotEmpty(t, doc["Message"])
+}
+
+func TestEmbeddedValidateHandlerShadow(t *testing.T) {
+	_, err := server.ValidateHandler(server.HandlerOptions{Shadow: server.ShadowConfig{SampleRate: 101}})
+	require.NotNil(t, err)
+}
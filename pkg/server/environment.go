@@ -5,12 +5,28 @@
 package server
 
 import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	kitlog "github.com/go-kit/log"
 	"github.com/gorilla/mux"
 	"github.com/moov-io/base/config"
 	"github.com/moov-io/base/log"
 	"github.com/moov-io/base/stime"
+
+	"github.com/moov-io/iso20022/pkg/masking"
+	"github.com/moov-io/iso20022/pkg/profile"
+	"github.com/moov-io/iso20022/pkg/schedule"
+	"github.com/moov-io/iso20022/pkg/secrets"
+	"github.com/moov-io/iso20022/pkg/storage"
+	"github.com/moov-io/iso20022/pkg/templates"
 )
 
+// defaultPurgeInterval is how often expired documents are purged unless configured
+const defaultPurgeInterval = 24 * time.Hour
+
 // Environment - Contains everything thats been instantiated for this service.
 type Environment struct {
 	Logger       log.Logger
@@ -18,23 +34,89 @@ type Environment struct {
 	TimeService  *stime.TimeService
 	PublicRouter *mux.Router
 	Shutdown     func()
+
+	// ProfileLoader reloads the configured profiles and code lists, nil when none are configured
+	ProfileLoader *profile.Loader
+
+	// Documents keeps the documents of the /documents endpoints, Purger purges them by the
+	// configured retention policies and is nil when none are configured
+	Documents storage.Store
+	Purger    *storage.Purger
+
+	// Replication replicates the documents to the configured replica until shutdown, nil when
+	// none is configured or Documents is provided
+	Replication *storage.ReplicatedStore
+
+	// Templates keeps the templates of the /templates endpoints, Scheduler generates files from
+	// them on the configured schedules and is nil when no job is configured
+	Templates templates.Library
+	Scheduler *schedule.Scheduler
+
+	// Revalidator revalidates the stored documents against new versions of profiles
+	Revalidator *Revalidator
+
+	// Secrets resolves the secret references of the configuration, the API keys of the usage
+	// tenants again every RefreshInterval. Secret follows the rotations of a secret used
+	// after startup.
+	Secrets *secrets.Resolver
+
+	// Masker masks the personal data of error responses and, unless Logger is provided, of the
+	// logs when API.Masking enables it
+	Masker *masking.Masker
+
+	// configured is Config before the resolution of its secrets, exported by snapshots, usage
+	// accounts the requests of the tenants of API.Usage and jobs keeps the jobs of /jobs
+	configured Config
+	usage      *usageMeter
+	jobs       *jobStore
+}
+
+// LoadConfig - Loads the default configuration with the overrides of the config file
+func LoadConfig(logger log.Logger) (*Config, error) {
+	ConfigService := config.NewService(logger)
+
+	global := &GlobalConfig{}
+	if err := ConfigService.Load(global); err != nil {
+		return nil, err
+	}
+	return &global.ISO20022, nil
 }
 
 // NewEnvironment - Generates a new default environment. Overrides can be specified via configs.
 func NewEnvironment(env *Environment) (*Environment, error) {
-	if env.Logger == nil {
+	defaultLogger := env.Logger == nil
+	if defaultLogger {
 		env.Logger = log.NewDefaultLogger()
 	}
 
 	if env.Config == nil {
-		ConfigService := config.NewService(env.Logger)
-
-		global := &GlobalConfig{}
-		if err := ConfigService.Load(global); err != nil {
+		cfg, err := LoadConfig(env.Logger)
+		if err != nil {
 			return nil, err
 		}
+		env.Config = cfg
+	}
 
-		env.Config = &global.ISO20022
+	// snapshots export the secret references, never their values
+	configured, err := copyConfig(env.Config)
+	if err != nil {
+		return nil, err
+	}
+	env.configured = configured
+
+	// secret references are replaced by their values
+	if env.Secrets == nil {
+		env.Secrets = secrets.NewResolver(env.Config.Secrets)
+	}
+	if err := env.Secrets.ResolveAll(context.Background(), env.Config); err != nil {
+		return nil, fmt.Errorf("resolving secrets: %w", err)
+	}
+
+	if env.Masker == nil {
+		env.Masker = masking.New(env.Config.API.Masking)
+	}
+	if defaultLogger && env.Masker != nil {
+		env.Logger = log.NewLogger(env.Masker.Writer(kitlog.NewLogfmtLogger(kitlog.NewSyncWriter(os.Stderr))))
 	}
 
 	if env.TimeService == nil {
@@ -47,10 +129,159 @@ func NewEnvironment(env *Environment) (*Environment, error) {
 		env.PublicRouter = mux.NewRouter()
 	}
 
+	if env.Documents == nil {
+		documents, replicated, err := newDocumentStore(env.Config.API.Storage, env.Logger)
+		if err != nil {
+			return nil, err
+		}
+		env.Documents, env.Replication = documents, replicated
+	}
+
+	if env.Templates == nil {
+		library, err := newTemplateLibrary(env.Config.API.Templates)
+		if err != nil {
+			return nil, err
+		}
+		env.Templates = library
+	}
+
+	if env.usage, err = newUsageMeter(env.Config.API.Usage); err != nil {
+		return nil, err
+	}
+	env.jobs = newJobStore()
+
 	// configure custom handlers
-	ConfigureHandlers(env.PublicRouter)
+	if err := configureHandlers(env.PublicRouter, env.Config.API, env.Logger, env.Documents, env.Templates, env.usage, env.jobs, env.Masker, backendDependencies(env.Config.Backends)...); err != nil {
+		return nil, err
+	}
+
+	if env.Scheduler == nil {
+		scheduler, err := newScheduler(env.Config.API.Schedules, env.Templates, env.Config.API.Outbound, env.Logger)
+		if err != nil {
+			return nil, err
+		}
+		env.Scheduler = scheduler
+	}
+
+	if env.Revalidator == nil {
+		env.Revalidator = NewRevalidator(env.Documents)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	env.Shutdown = cancel
+	if replicated := env.Replication; replicated != nil {
+		// the documents queued for the replica are replicated before the shutdown returns
+		env.Shutdown = func() {
+			cancel()
+			replicated.Close()
+		}
+	}
 
-	env.Shutdown = func() {}
+	if env.usage != nil && referencesKeys(env.configured.API.Usage.Tenants) {
+		interval := env.Config.Secrets.RefreshInterval
+		if interval <= 0 {
+			interval = secrets.DefaultRefreshInterval
+		}
+		go env.rotateAPIKeys(ctx, interval)
+	}
+
+	if retention := env.Config.API.Storage; env.Purger == nil && len(retention.Retention) > 0 {
+		purger, err := storage.NewPurger(env.Documents, retention.Retention)
+		if err != nil {
+			cancel()
+			return nil, err
+		}
+		env.Purger = purger
+
+		interval := retention.PurgeInterval
+		if interval <= 0 {
+			interval = defaultPurgeInterval
+		}
+		go env.Purger.Run(ctx, interval, func(status storage.RetentionStatus, err error) {
+			if err != nil {
+				env.Logger.Error().LogErrorf("problem purging documents: %w", err)
+				return
+			}
+			env.Logger.Info().Logf("purged %d expired documents", status.Purged)
+		})
+	}
+
+	if env.Scheduler != nil {
+		go env.Scheduler.Run(ctx, func(run schedule.Run) {
+			if run.Status == schedule.RunStatusSucceeded {
+				env.Logger.Info().Logf("scheduled job %s delivered %s", run.Job, run.File)
+			}
+		})
+	}
+
+	profiles := env.Config.Profiles
+	if profiles.History != "" {
+		if err := profile.LoadHistory(profiles.History); err != nil {
+			cancel()
+			return nil, err
+		}
+	}
+	if env.ProfileLoader == nil && (profiles.Directory != "" || profiles.CodeLists != "") {
+		env.ProfileLoader = profile.NewLoader(profiles.Directory, profiles.CodeLists)
+	}
+	if env.ProfileLoader != nil {
+		status, err := env.ProfileLoader.Reload(profile.ReloadTriggerStartup)
+		if err != nil {
+			cancel()
+			return nil, err
+		}
+		env.Logger.Info().Logf("loaded profiles %v and code lists %v", status.Profiles, status.CodeLists)
+
+		if profiles.ReloadInterval > 0 {
+			go env.ProfileLoader.Watch(ctx, profiles.ReloadInterval, func(status profile.ReloadStatus, err error) {
+				if err != nil {
+					env.Logger.Error().LogErrorf("problem reloading profiles: %w", err)
+					return
+				}
+				env.Logger.Info().Logf("reloaded profiles %v and code lists %v", status.Profiles, status.CodeLists)
+			})
+		}
+	}
 
 	return env, nil
 }
+
+// referencesKeys reports whether an API key of tenants references a secret
+func referencesKeys(tenants []TenantConfig) bool {
+	for _, tenant := range tenants {
+		for _, key := range tenant.Keys {
+			if secrets.IsReference(key) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// rotateAPIKeys resolves the API keys of the usage tenants again every interval until ctx is
+// done, so that the keys rotated in Vault or files identify their tenants without a restart.
+// The previous keys are kept when a key can't be resolved.
+func (env *Environment) rotateAPIKeys(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		// the references of the configuration are kept for the next rotation
+		tenants := make([]TenantConfig, len(env.configured.API.Usage.Tenants))
+		for i, tenant := range env.configured.API.Usage.Tenants {
+			tenant.Keys = append([]string(nil), tenant.Keys...)
+			tenants[i] = tenant
+		}
+		err := env.Secrets.ResolveAll(ctx, &tenants)
+		if err == nil {
+			err = env.usage.rotateKeys(tenants)
+		}
+		if err != nil {
+			env.Logger.Error().LogErrorf("problem rotating API keys: %w", err)
+		}
+	}
+}
@@ -5,14 +5,20 @@
 package server_test
 
 import (
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/go-kit/log"
 	baseLog "github.com/moov-io/base/log"
+	"github.com/moov-io/iso20022/pkg/secrets"
 	"github.com/moov-io/iso20022/pkg/server"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func Test_Environment_Startup(t *testing.T) {
@@ -33,3 +39,56 @@ func Test_Environment_Startup(t *testing.T) {
 
 	t.Cleanup(shutdown)
 }
+
+func Test_Environment_Secrets(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv("ISO20022_DOCUMENTS_DIR", dir)
+
+	config := &server.Config{API: server.APIConfig{Storage: server.StorageConfig{Directory: "env:ISO20022_DOCUMENTS_DIR"}}}
+	env, err := server.NewEnvironment(&server.Environment{Config: config})
+	assert.Nil(t, err)
+	t.Cleanup(env.Shutdown)
+	assert.Equal(t, dir, env.Config.API.Storage.Directory)
+	assert.NotNil(t, env.Secrets)
+
+	config = &server.Config{API: server.APIConfig{Signing: server.SigningConfig{KeyFile: "env:ISO20022_MISSING_KEY"}}}
+	_, err = server.NewEnvironment(&server.Environment{Config: config})
+	assert.ErrorContains(t, err, "API.Signing.KeyFile")
+}
+
+func Test_Environment_APIKeyRotation(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "api-key")
+	require.Nil(t, os.WriteFile(path, []byte("first-key\n"), 0600))
+
+	config := &server.Config{
+		Secrets: secrets.Config{RefreshInterval: 10 * time.Millisecond},
+		API: server.APIConfig{Usage: server.UsageConfig{Tenants: []server.TenantConfig{
+			{Name: "payroll", Keys: []string{"file:" + path}},
+		}}},
+	}
+	env, err := server.NewEnvironment(&server.Environment{Config: config})
+	require.Nil(t, err)
+	t.Cleanup(env.Shutdown)
+	ts := httptest.NewServer(env.PublicRouter)
+	defer ts.Close()
+
+	usage := func(key string) int {
+		req, err := http.NewRequest(http.MethodGet, ts.URL+"/usage", nil)
+		require.Nil(t, err)
+		req.Header.Set(server.DefaultAPIKeyHeader, key)
+		resp, err := http.DefaultClient.Do(req)
+		require.Nil(t, err)
+		resp.Body.Close()
+		return resp.StatusCode
+	}
+	require.Equal(t, http.StatusOK, usage("first-key"))
+
+	// the rotated key identifies the tenant without restart and the previous key doesn't
+	later := time.Now().Add(time.Minute)
+	require.Nil(t, os.WriteFile(path, []byte("second-key\n"), 0600))
+	require.Nil(t, os.Chtimes(path, later, later))
+	require.Eventually(t, func() bool {
+		return usage("second-key") == http.StatusOK
+	}, 5*time.Second, 10*time.Millisecond)
+	require.Equal(t, http.StatusUnauthorized, usage("first-key"))
+}
@@ -0,0 +1,49 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package server
+
+import (
+	"context"
+
+	"github.com/moov-io/base/log"
+
+	"github.com/moov-io/iso20022/pkg/document"
+	"github.com/moov-io/iso20022/pkg/dryrun"
+	"github.com/moov-io/iso20022/pkg/events"
+	"github.com/moov-io/iso20022/pkg/largevalue"
+)
+
+// documentEvents emits the events of the stored documents outside of the requests, failures
+// are logged
+type documentEvents struct {
+	emitter *events.Emitter
+	logger  log.Logger
+}
+
+// newDocumentEvents returns nil unless config has a webhook, events are posted with the
+// policy of outbound and the payments reaching largeValues are emitted as large values too
+func newDocumentEvents(config EventsConfig, outbound OutboundConfig, largeValues largevalue.Thresholds, logger log.Logger) *documentEvents {
+	if config.Webhook == "" {
+		return nil
+	}
+	if logger == nil {
+		logger = log.NewDefaultLogger()
+	}
+	publish := events.WebhookClient(config.Webhook, newOutboundClient(outbound, OutboundEvents, eventsWebhookTimeout))
+	return &documentEvents{emitter: events.New(publish, config.Source, events.WithLargeValues(largeValues)), logger: logger}
+}
+
+// emit emits the events of doc in the background, they're rehearsed when ctx is a dry run
+func (d *documentEvents) emit(ctx context.Context, id string, doc document.Iso20022Document) {
+	if d == nil {
+		return
+	}
+	ctx = dryrun.Detach(ctx)
+	go func() {
+		if err := d.emitter.Emit(ctx, id, doc); err != nil {
+			d.logger.With(log.Fields{"document": log.String(id)}).LogErrorf("emitting events: %v", err)
+		}
+	}()
+}
@@ -0,0 +1,49 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package server_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/require"
+
+	"github.com/moov-io/iso20022/pkg/events"
+	"github.com/moov-io/iso20022/pkg/iso20022test/fixtures"
+	"github.com/moov-io/iso20022/pkg/server"
+)
+
+func TestDocumentEvents(t *testing.T) {
+	received := make(chan events.Event, 10)
+	webhook := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var event events.Event
+		require.Nil(t, json.NewDecoder(r.Body).Decode(&event))
+		received <- event
+	}))
+	defer webhook.Close()
+
+	router := mux.NewRouter()
+	require.Nil(t, server.ConfigureHandlersWithOptions(router, server.APIConfig{
+		Events: server.EventsConfig{Webhook: webhook.URL, Source: "payments-hub"},
+	}))
+	ts := httptest.NewServer(router)
+	defer ts.Close()
+
+	stored := createDocument(t, ts, fixtures.ReadFile(t, "valid_pacs_v08.xml"))
+	for i := 0; i < 2; i++ {
+		select {
+		case event := <-received:
+			require.Equal(t, events.TypePaymentCreated, event.Type)
+			require.Equal(t, "payments-hub", event.Source)
+			require.Equal(t, stored.ID, event.DocumentID)
+		case <-time.After(5 * time.Second):
+			t.Fatal("no event received")
+		}
+	}
+}
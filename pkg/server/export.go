@@ -0,0 +1,233 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package server
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/moov-io/iso20022/pkg/parquet"
+	"github.com/moov-io/iso20022/pkg/service"
+	"github.com/moov-io/iso20022/pkg/storage"
+	"github.com/moov-io/iso20022/pkg/utils"
+)
+
+// ErrExportNotConfigured is returned for export jobs when no export directory is configured
+var ErrExportNotConfigured = errors.New("export directory is not configured")
+
+// exportColumns are the columns of every exported row, followed by the element paths of the
+// message type without indexes (e.g. CdtTrfTxInf/PmtId/EndToEndId)
+var exportColumns = []parquet.Column{
+	{Name: "documentId", Type: parquet.String},
+	{Name: "created", Type: parquet.Timestamp},
+	{Name: "messageType", Type: parquet.String},
+	{Name: "transaction", Type: parquet.String, Repetition: parquet.Optional},
+}
+
+// exportFilter selects the stored documents of an export job
+type exportFilter struct {
+	messageType string
+	since       time.Time
+}
+
+// exportPartition holds the rows of the documents of a message type created on a date
+type exportPartition struct {
+	date        string
+	messageType string
+	rows        []parquet.Row
+}
+
+// path returns the hive style path of the file of the partition written by job
+func (p *exportPartition) path(job string) string {
+	return filepath.Join("date="+p.date, "messageType="+p.messageType, job+".parquet")
+}
+
+// columns returns the columns of the rows of the partition, element columns are repeated
+// when a transaction holds many values of the element
+func (p *exportPartition) columns() []parquet.Column {
+	columns := append([]parquet.Column{}, exportColumns...)
+	index := make(map[string]int)
+	for _, column := range exportColumns {
+		index[column.Name] = -1
+	}
+	for _, row := range p.rows {
+		for _, name := range rowElements(row) {
+			i, exists := index[name]
+			if !exists {
+				index[name] = len(columns)
+				columns = append(columns, parquet.Column{Name: name, Type: parquet.String, Repetition: parquet.Optional})
+				i = len(columns) - 1
+			}
+			if i >= 0 && len(row[name]) > 1 {
+				columns[i].Repetition = parquet.Repeated
+			}
+		}
+	}
+	return columns
+}
+
+// rowElements returns the element columns of row sorted by name
+func rowElements(row parquet.Row) []string {
+	var names []string
+	for name := range row {
+		if name != "documentId" && name != "created" && name != "messageType" && name != "transaction" {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// exportRows flattens the transactions of a stored document into rows, the elements of
+// enclosing blocks (e.g. GrpHdr) are repeated in the row of every transaction
+func exportRows(rec storage.Record, messageType string, transactions []utils.Transaction) []parquet.Row {
+	rows := make([]parquet.Row, 0, len(transactions))
+	for _, tx := range transactions {
+		row := parquet.Row{
+			"documentId":  {rec.ID},
+			"created":     {rec.Created},
+			"messageType": {messageType},
+		}
+		if tx.Path != "" {
+			row["transaction"] = []interface{}{tx.Path}
+		}
+		for _, elm := range tx.Elements {
+			name := utils.StripElementIndexes(elm.Path)
+			row[name] = append(row[name], elm.Value)
+		}
+		rows = append(rows, row)
+	}
+	return rows
+}
+
+// export writes the stored documents selected by filter as Parquet files of directory,
+// partitioned by creation date and message type
+func (s *jobStore) export(id string, documents storage.Store, directory string, filter exportFilter) {
+	s.update(id, func(job *Job) {
+		job.Status = JobStatusRunning
+	})
+	fail := func(err error) {
+		s.update(id, func(job *Job) {
+			job.Status = JobStatusFailed
+			job.Error = err.Error()
+		})
+	}
+
+	records, err := documents.List(context.Background())
+	if err != nil {
+		fail(err)
+		return
+	}
+
+	partitions := make(map[string]*exportPartition)
+	for _, rec := range records {
+		if rec.Kind != "" && rec.Kind != storage.KindOriginal {
+			continue
+		}
+		// documents held for review or approval or rejected aren't exported
+		if rec.Status == ReviewStatusPending || rec.Status == ReviewStatusPendingApproval || rec.Status == ReviewStatusRejected {
+			continue
+		}
+		if (filter.messageType != "" && rec.MessageType != filter.messageType) || rec.Created.Before(filter.since) {
+			continue
+		}
+		s.update(id, func(job *Job) {
+			job.Progress.TotalBytes += int64(len(rec.Content))
+		})
+		doc, err := service.Parse(bytes.NewReader(rec.Content))
+		if err != nil {
+			s.update(id, func(job *Job) {
+				job.Progress.Invalid++
+				job.Progress.Errors = append(job.Progress.Errors, fmt.Sprintf("document %s: %v", rec.ID, err))
+			})
+			continue
+		}
+
+		messageType := utils.GetMessageType(doc.NameSpace())
+		date := rec.Created.UTC().Format("2006-01-02")
+		key := date + "/" + messageType
+		if partitions[key] == nil {
+			partitions[key] = &exportPartition{date: date, messageType: messageType}
+		}
+		rows := exportRows(rec, messageType, utils.GetTransactions(doc.InspectMessage()))
+		partitions[key].rows = append(partitions[key].rows, rows...)
+
+		s.update(id, func(job *Job) {
+			job.Progress.Bytes += int64(len(rec.Content))
+			job.Progress.Transactions += len(rows)
+		})
+	}
+
+	keys := make([]string, 0, len(partitions))
+	for key := range partitions {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var files []string
+	for _, key := range keys {
+		partition := partitions[key]
+		var buf bytes.Buffer
+		if err := parquet.Write(&buf, partition.columns(), partition.rows); err != nil {
+			fail(err)
+			return
+		}
+		path := partition.path(id)
+		if err := writeExportFile(filepath.Join(directory, path), buf.Bytes()); err != nil {
+			fail(err)
+			return
+		}
+		files = append(files, filepath.ToSlash(path))
+	}
+
+	s.update(id, func(job *Job) {
+		valid := job.Progress.Invalid == 0
+		job.Valid = &valid
+		job.Status = JobStatusCompleted
+		job.Files = files
+	})
+}
+
+// writeExportFile writes the file at path through a temporary file, readers of the
+// directory never see partial files
+func writeExportFile(path string, content []byte) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, content, 0o644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+// createExportJob - start exporting the stored documents as Parquet files in the background
+func (h handlers) createExportJob(w http.ResponseWriter, r *http.Request) {
+	if h.export.Directory == "" {
+		h.outputError(w, r, http.StatusNotImplemented, ErrExportNotConfigured)
+		return
+	}
+
+	filter := exportFilter{messageType: r.FormValue("messageType")}
+	if value := r.FormValue("since"); value != "" {
+		var err error
+		if filter.since, err = time.Parse(time.RFC3339, value); err != nil {
+			h.outputError(w, r, http.StatusBadRequest, fmt.Errorf("since %q is not an RFC 3339 time", value))
+			return
+		}
+	}
+
+	job := h.jobs.create(JobOperationExport, utils.DocumentTypeUnknown, "", time.Time{})
+	go h.jobs.export(job.ID, h.documents, h.export.Directory, filter)
+
+	h.outputJob(w, r, http.StatusAccepted, job)
+}
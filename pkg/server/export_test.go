@@ -0,0 +1,94 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package server_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/require"
+
+	"github.com/moov-io/iso20022/pkg/iso20022test/fixtures"
+	"github.com/moov-io/iso20022/pkg/server"
+)
+
+func newExportServer(t *testing.T, directory string) *httptest.Server {
+	router := mux.NewRouter()
+	require.Nil(t, server.ConfigureHandlersWithOptions(router, server.APIConfig{
+		Export: server.ExportConfig{Directory: directory},
+	}))
+	ts := httptest.NewServer(router)
+	t.Cleanup(ts.Close)
+	return ts
+}
+
+func TestExportJob(t *testing.T) {
+	directory := t.TempDir()
+	ts := newExportServer(t, directory)
+	createDocument(t, ts, fixtures.ReadFile(t, "valid_pacs_v08.xml"))
+	createDocument(t, ts, fixtures.ReadFile(t, "charges_pacs_v08.xml"))
+	createDocument(t, ts, fixtures.ReadFile(t, "valid_acmt_v03.xml"))
+
+	job := startJob(t, ts, nil, map[string]string{"operation": server.JobOperationExport})
+	require.Equal(t, server.JobOperationExport, job.Operation)
+
+	events := readJobEvents(t, ts, job.ID)
+	done := events[len(events)-1].job
+	require.Equal(t, server.JobStatusCompleted, done.Status, done.Error)
+	require.True(t, *done.Valid)
+
+	// both pacs.008 documents share a partition, every transaction is a row
+	date := time.Now().UTC().Format("2006-01-02")
+	require.Equal(t, []string{
+		"date=" + date + "/messageType=acmt.007.001.03/" + job.ID + ".parquet",
+		"date=" + date + "/messageType=pacs.008.001.08/" + job.ID + ".parquet",
+	}, done.Files)
+	require.Equal(t, 5, done.Progress.Transactions)
+
+	for _, file := range done.Files {
+		content, err := os.ReadFile(filepath.Join(directory, filepath.FromSlash(file)))
+		require.Nil(t, err)
+		require.True(t, strings.HasPrefix(string(content), "PAR1"))
+		require.True(t, strings.HasSuffix(string(content), "PAR1"))
+	}
+
+	// element paths are the columns, plain encoded values are readable in the file
+	content, err := os.ReadFile(filepath.Join(directory, filepath.FromSlash(done.Files[1])))
+	require.Nil(t, err)
+	require.Contains(t, string(content), "CdtTrfTxInf/PmtId/EndToEndId")
+	require.Contains(t, string(content), "E2E-CHRGS-0002")
+}
+
+func TestExportJobFilter(t *testing.T) {
+	ts := newExportServer(t, t.TempDir())
+	createDocument(t, ts, fixtures.ReadFile(t, "valid_pacs_v08.xml"))
+	createDocument(t, ts, fixtures.ReadFile(t, "valid_acmt_v03.xml"))
+
+	job := startJob(t, ts, nil, map[string]string{"operation": server.JobOperationExport, "messageType": "acmt.007.001.03"})
+	events := readJobEvents(t, ts, job.ID)
+	done := events[len(events)-1].job
+	require.Len(t, done.Files, 1)
+	require.Contains(t, done.Files[0], "messageType=acmt.007.001.03")
+
+	since := time.Now().Add(time.Hour).Format(time.RFC3339)
+	job = startJob(t, ts, nil, map[string]string{"operation": server.JobOperationExport, "since": since})
+	events = readJobEvents(t, ts, job.ID)
+	require.Empty(t, events[len(events)-1].job.Files)
+
+	resp := postJob(t, ts, nil, map[string]string{"operation": server.JobOperationExport, "since": "yesterday"})
+	require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
+
+func TestExportJobNotConfigured(t *testing.T) {
+	ts := newJobServer(t)
+	resp := postJob(t, ts, nil, map[string]string{"operation": server.JobOperationExport})
+	require.Equal(t, http.StatusNotImplemented, resp.StatusCode)
+}
@@ -0,0 +1,145 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package server
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/moov-io/iso20022/pkg/document"
+	"github.com/moov-io/iso20022/pkg/features"
+	"github.com/moov-io/iso20022/pkg/profile"
+)
+
+// FeaturesHeader enables and disables the overridable feature flags of a request, e.g.
+// "lenient-namespaces, -strict-validation", and lists the flags enabled for responses
+const FeaturesHeader = "X-Features"
+
+// ErrFeatureNotOverridable is returned for the flags of the FeaturesHeader that requests
+// can't override
+var ErrFeatureNotOverridable = errors.New("feature flag can't be overridden")
+
+// featureFlags resolves the feature flags of requests from the configuration, the tenant of
+// their API key and their FeaturesHeader
+type featureFlags struct {
+	enabled     []string
+	tenants     map[string][]string
+	overridable map[string]bool
+	usage       *usageMeter
+}
+
+// newFeatureFlags fails for unknown flags and the flags of tenants that usage doesn't have
+func newFeatureFlags(config FeaturesConfig, usage *usageMeter) (*featureFlags, error) {
+	if err := features.Check(config.Enabled...); err != nil {
+		return nil, fmt.Errorf("features: %w", err)
+	}
+	for tenant, names := range config.Tenants {
+		if usage == nil || usage.tenants[tenant] == nil {
+			return nil, fmt.Errorf("features: tenant %s isn't configured", tenant)
+		}
+		if err := features.Check(names...); err != nil {
+			return nil, fmt.Errorf("features: tenant %s: %w", tenant, err)
+		}
+	}
+	overridable := make(map[string]bool)
+	for _, name := range config.Overridable {
+		if name != "*" {
+			if err := features.Check(name); err != nil {
+				return nil, fmt.Errorf("features: %w", err)
+			}
+		}
+		overridable[name] = true
+	}
+	return &featureFlags{enabled: config.Enabled, tenants: config.Tenants, overridable: overridable, usage: usage}, nil
+}
+
+// resolve returns the flags of r, 400 Bad Request for unknown flags of its header and 403
+// Forbidden for flags it can't override
+func (f *featureFlags) resolve(r *http.Request) ([]string, int, error) {
+	set := make(map[string]bool)
+	for _, name := range f.enabled {
+		set[name] = true
+	}
+	if f.usage != nil && len(f.tenants) > 0 {
+		// requests with an unknown API key are rejected by the metered endpoints
+		if tenant, err := f.usage.tenant(r); err == nil {
+			for _, name := range f.tenants[tenant.Name] {
+				set[name] = true
+			}
+		}
+	}
+
+	if header := r.Header.Get(FeaturesHeader); header != "" {
+		enable, disable, err := features.Parse(header)
+		if err != nil {
+			return nil, http.StatusBadRequest, err
+		}
+		for _, name := range append(enable, disable...) {
+			if !f.overridable["*"] && !f.overridable[name] {
+				return nil, http.StatusForbidden, fmt.Errorf("%w: %s", ErrFeatureNotOverridable, name)
+			}
+		}
+		for _, name := range enable {
+			set[name] = true
+		}
+		for _, name := range disable {
+			delete(set, name)
+		}
+	}
+
+	names := make([]string, 0, len(set))
+	for name := range set {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names, 0, nil
+}
+
+// featured enables the feature flags of requests in their context and lists them in the
+// FeaturesHeader of responses
+func (h handlers) featured(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		names, code, err := h.features.resolve(r)
+		if err != nil {
+			h.outputError(w, r, code, err)
+			return
+		}
+		if len(names) > 0 {
+			w.Header().Set(FeaturesHeader, strings.Join(names, ", "))
+		}
+		noteFeatures(r, names)
+		next.ServeHTTP(w, r.WithContext(features.With(r.Context(), names...)))
+	})
+}
+
+// checkExperimentalVersion returns features.ErrDisabled for the message versions marked
+// experimental unless r enables them
+func checkExperimentalVersion(r *http.Request, namespace string) error {
+	support := document.SupportLevel(namespace)
+	if support.Status != document.SupportExperimental {
+		return nil
+	}
+	return features.Require(r.Context(), features.ExperimentalVersions, support.Warning())
+}
+
+// checkExperimentalProfile returns features.ErrDisabled for experimental profiles unless r
+// enables them
+func checkExperimentalProfile(r *http.Request, p *profile.Profile) error {
+	if !p.Experimental {
+		return nil
+	}
+	return features.Require(r.Context(), features.ExperimentalProfiles, fmt.Sprintf("profile %s is experimental", p.Name))
+}
+
+// featureList - the registered feature flags and the flags enabled for the request
+func (h handlers) featureList(w http.ResponseWriter, r *http.Request) {
+	h.outputData(w, r, http.StatusOK, map[string]interface{}{
+		"flags":   features.Flags(),
+		"enabled": features.List(r.Context()),
+	})
+}
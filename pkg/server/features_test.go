@@ -0,0 +1,120 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package server_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/require"
+
+	"github.com/moov-io/iso20022/pkg/document"
+	"github.com/moov-io/iso20022/pkg/features"
+	"github.com/moov-io/iso20022/pkg/iso20022test/fixtures"
+	"github.com/moov-io/iso20022/pkg/server"
+)
+
+func featuresServer(t *testing.T, config server.APIConfig) *httptest.Server {
+	router := mux.NewRouter()
+	require.Nil(t, server.ConfigureHandlersWithOptions(router, config))
+	ts := httptest.NewServer(router)
+	t.Cleanup(ts.Close)
+	return ts
+}
+
+func TestFeaturesOverride(t *testing.T) {
+	ts := featuresServer(t, server.APIConfig{Features: server.FeaturesConfig{Overridable: []string{features.LenientNamespaces}}})
+
+	// flags are disabled by default
+	input := fixtures.ReadFile(t, "valid_pacs_v08.xml")
+	lenient := bytes.Replace(input, []byte("xsd:pacs.008.001.08"), []byte("xsd:PACS.008.001.08"), 1)
+	resp := postWithHeader(t, ts.URL+"/validator", lenient, server.FeaturesHeader, "")
+	require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	require.Empty(t, resp.Header.Get(server.FeaturesHeader))
+
+	resp = postWithHeader(t, ts.URL+"/validator", lenient, server.FeaturesHeader, features.LenientNamespaces)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, features.LenientNamespaces, resp.Header.Get(server.FeaturesHeader))
+
+	// flags that aren't overridable are rejected
+	resp = postWithHeader(t, ts.URL+"/validator", input, server.FeaturesHeader, features.StrictValidation)
+	require.Equal(t, http.StatusForbidden, resp.StatusCode)
+	var body map[string]string
+	require.Nil(t, json.NewDecoder(resp.Body).Decode(&body))
+	require.Contains(t, body["error"], "feature flag can't be overridden: strict-validation")
+
+	resp = postWithHeader(t, ts.URL+"/validator", input, server.FeaturesHeader, "instant-replay")
+	require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
+
+func TestFeaturesEnabled(t *testing.T) {
+	ts := featuresServer(t, server.APIConfig{Features: server.FeaturesConfig{
+		Enabled:     []string{features.StrictValidation},
+		Overridable: []string{"*"},
+	}})
+
+	// strict validation rejects the elements the model doesn't hold
+	input := bytes.Replace(fixtures.ReadFile(t, "valid_pacs_v08.xml"), []byte("<GrpHdr>"), []byte("<GrpHdr><Unknown>1</Unknown>"), 1)
+	resp := postWithHeader(t, ts.URL+"/validator", input, server.FeaturesHeader, "")
+	require.Equal(t, http.StatusNotImplemented, resp.StatusCode)
+	require.Equal(t, features.StrictValidation, resp.Header.Get(server.FeaturesHeader))
+
+	resp = postWithHeader(t, ts.URL+"/validator", input, server.FeaturesHeader, "-"+features.StrictValidation)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	req, err := http.NewRequest(http.MethodGet, ts.URL+"/features", nil)
+	require.Nil(t, err)
+	req.Header.Set(server.FeaturesHeader, features.LenientNamespaces)
+	resp, err = http.DefaultClient.Do(req)
+	require.Nil(t, err)
+	defer resp.Body.Close()
+	var list struct {
+		Flags   []features.Flag
+		Enabled []string
+	}
+	require.Nil(t, json.NewDecoder(resp.Body).Decode(&list))
+	require.Equal(t, features.Flags(), list.Flags)
+	require.Equal(t, []string{features.LenientNamespaces, features.StrictValidation}, list.Enabled)
+}
+
+func TestFeaturesTenants(t *testing.T) {
+	document.MarkExperimental("pacs.008.001.08", true)
+	t.Cleanup(func() { document.MarkExperimental("pacs.008.001.08", false) })
+
+	ts := featuresServer(t, server.APIConfig{
+		Usage:    server.UsageConfig{Tenants: []server.TenantConfig{{Name: "acme", Keys: []string{"acme-key"}}}},
+		Features: server.FeaturesConfig{Tenants: map[string][]string{"acme": {features.ExperimentalVersions}}},
+	})
+
+	// experimental versions are only accepted for the tenants enabling them
+	input := fixtures.ReadFile(t, "valid_pacs_v08.xml")
+	resp := postWithKey(t, ts.URL+"/validator", input, "")
+	require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	var body map[string]string
+	require.Nil(t, json.NewDecoder(resp.Body).Decode(&body))
+	require.Equal(t, "pacs.008.001.08 is experimental: feature is disabled: experimental-versions", body["error"])
+
+	resp = postWithKey(t, ts.URL+"/validator", input, "acme-key")
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, features.ExperimentalVersions, resp.Header.Get(server.FeaturesHeader))
+	require.Contains(t, resp.Header.Get("Warning"), "pacs.008.001.08 is experimental")
+
+	resp = postForm(t, ts.URL+"/jobs", input, nil)
+	require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
+
+func TestFeaturesConfig(t *testing.T) {
+	for _, config := range []server.FeaturesConfig{
+		{Enabled: []string{"instant-replay"}},
+		{Overridable: []string{"instant-replay"}},
+		{Tenants: map[string][]string{"acme": {features.LenientNamespaces}}},
+	} {
+		require.NotNil(t, server.ConfigureHandlersWithOptions(mux.NewRouter(), server.APIConfig{Features: config}))
+	}
+}
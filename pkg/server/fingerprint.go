@@ -0,0 +1,45 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package server
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/moov-io/iso20022/pkg/document"
+)
+
+// FingerprintHeader carries the canonical content hash of a document (see document.Fingerprint)
+// in responses, and the fingerprint an upload is expected to have in requests
+const FingerprintHeader = "X-Document-Fingerprint"
+
+// fingerprint verifies that the uploaded document has the fingerprint of the request header,
+// if any, and sets the fingerprint header of the response to the fingerprint of doc, the
+// processed document. It responds with the error and returns false when they don't match.
+func (h handlers) fingerprint(w http.ResponseWriter, r *http.Request, uploaded, doc document.Iso20022Document) bool {
+	if !h.fingerprints {
+		return true
+	}
+
+	if expected := r.Header.Get(FingerprintHeader); expected != "" && uploaded != nil {
+		actual, err := document.Fingerprint(uploaded)
+		if err != nil {
+			h.outputError(w, r, http.StatusInternalServerError, err)
+			return false
+		}
+		if actual != expected {
+			h.outputError(w, r, http.StatusPreconditionFailed, fmt.Errorf("%w: expected %s, got %s", document.ErrFingerprintMismatch, expected, actual))
+			return false
+		}
+	}
+
+	fingerprint, err := document.Fingerprint(doc)
+	if err != nil {
+		h.outputError(w, r, http.StatusInternalServerError, err)
+		return false
+	}
+	w.Header().Set(FingerprintHeader, fingerprint)
+	return true
+}
@@ -0,0 +1,97 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package server_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/require"
+
+	"github.com/moov-io/iso20022/pkg/iso20022test/fixtures"
+	"github.com/moov-io/iso20022/pkg/server"
+)
+
+func postFingerprinted(t *testing.T, url string, input []byte, fingerprint string, fields map[string]string) *http.Response {
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	part, err := writer.CreateFormFile("input", "input")
+	require.Nil(t, err)
+	_, err = part.Write(input)
+	require.Nil(t, err)
+	for key, value := range fields {
+		require.Nil(t, writer.WriteField(key, value))
+	}
+	require.Nil(t, writer.Close())
+
+	req, err := http.NewRequest(http.MethodPost, url, body)
+	require.Nil(t, err)
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	if fingerprint != "" {
+		req.Header.Set(server.FingerprintHeader, fingerprint)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	require.Nil(t, err)
+	t.Cleanup(func() { resp.Body.Close() })
+	return resp
+}
+
+func TestFingerprints(t *testing.T) {
+	router := mux.NewRouter()
+	require.Nil(t, server.ConfigureHandlersWithOptions(router, server.APIConfig{Fingerprints: true}))
+	ts := httptest.NewServer(router)
+	t.Cleanup(ts.Close)
+
+	input := fixtures.ReadFile(t, "valid_pacs_v08.xml")
+	resp := postFingerprinted(t, ts.URL+"/validator", input, "", nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	fingerprint := resp.Header.Get(server.FingerprintHeader)
+	require.True(t, strings.HasPrefix(fingerprint, "sha256:"))
+
+	// the json document printed from the xml has its fingerprint
+	resp = postFingerprinted(t, ts.URL+"/print", input, fingerprint, map[string]string{"format": "json"})
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, fingerprint, resp.Header.Get(server.FingerprintHeader))
+	var printed bytes.Buffer
+	_, err := printed.ReadFrom(resp.Body)
+	require.Nil(t, err)
+
+	resp = postFingerprinted(t, ts.URL+"/validator", printed.Bytes(), fingerprint, nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	// the fingerprint of a conversion is the fingerprint of the uploaded document
+	resp = postFingerprinted(t, ts.URL+"/convert", input, fingerprint, map[string]string{"targetVersion": "pacs.008.001.09"})
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.NotEqual(t, fingerprint, resp.Header.Get(server.FingerprintHeader))
+
+	// a modified document is rejected
+	modified := bytes.Replace(input, []byte("MSG-20210415-0001"), []byte("MSG-20210415-0002"), 1)
+	resp = postFingerprinted(t, ts.URL+"/validator", modified, fingerprint, nil)
+	require.Equal(t, http.StatusPreconditionFailed, resp.StatusCode)
+	var body map[string]string
+	require.Nil(t, json.NewDecoder(resp.Body).Decode(&body))
+	require.Contains(t, body["error"], "document fingerprint mismatch")
+
+	// stored documents respond with their fingerprint
+	resp = postFingerprinted(t, ts.URL+"/documents", input, fingerprint, nil)
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+	var stored server.StoredDocument
+	require.Nil(t, json.NewDecoder(resp.Body).Decode(&stored))
+	resp, err = http.Get(ts.URL + "/documents/" + stored.ID)
+	require.Nil(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, fingerprint, resp.Header.Get(server.FingerprintHeader))
+
+	// the header is ignored unless fingerprints are enabled
+	resp = postFingerprinted(t, newJobServer(t).URL+"/validator", modified, fingerprint, nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Empty(t, resp.Header.Get(server.FingerprintHeader))
+}
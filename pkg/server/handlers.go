@@ -5,6 +5,7 @@
 package server
 
 import (
+	"bufio"
 	"bytes"
 	"encoding/json"
 	"encoding/xml"
@@ -12,10 +13,25 @@ import (
 	"fmt"
 
 	"io"
+	"mime/multipart"
 	"net/http"
+	"strings"
+	"time"
 
 	"github.com/gorilla/mux"
+	"github.com/moov-io/base/log"
+	"github.com/moov-io/iso20022/pkg/builder"
+	"github.com/moov-io/iso20022/pkg/corridor"
 	"github.com/moov-io/iso20022/pkg/document"
+	"github.com/moov-io/iso20022/pkg/features"
+	"github.com/moov-io/iso20022/pkg/largevalue"
+	"github.com/moov-io/iso20022/pkg/masking"
+	"github.com/moov-io/iso20022/pkg/reasons"
+	"github.com/moov-io/iso20022/pkg/remittance"
+	"github.com/moov-io/iso20022/pkg/report"
+	"github.com/moov-io/iso20022/pkg/service"
+	"github.com/moov-io/iso20022/pkg/storage"
+	"github.com/moov-io/iso20022/pkg/templates"
 	"github.com/moov-io/iso20022/pkg/utils"
 )
 
@@ -35,7 +51,7 @@ func outputSuccess(w http.ResponseWriter, output string) {
 	})
 }
 
-func parseInputFromRequest(r *http.Request) (document.Iso20022Document, error) {
+func readInputFromRequest(r *http.Request) ([]byte, error) {
 	inputFile, _, err := r.FormFile("input")
 	if err != nil {
 		return nil, err
@@ -46,22 +62,70 @@ func parseInputFromRequest(r *http.Request) (document.Iso20022Document, error) {
 	if _, err = io.Copy(&input, inputFile); err != nil {
 		return nil, err
 	}
+	return input.Bytes(), nil
+}
+
+// sniffSize is the size of the beginning of streamed uploads read to detect their document
+const sniffSize = 64 << 10
+
+// openInputFromRequest returns the uploaded input of r and its size without reading it, the
+// multipart form holds large uploads in a temporary file
+func openInputFromRequest(r *http.Request) (multipart.File, int64, error) {
+	inputFile, header, err := r.FormFile("input")
+	if err != nil {
+		return nil, 0, err
+	}
+	return inputFile, header.Size, nil
+}
+
+// sniffXmlRoot returns the root element of the xml document read by br without consuming its
+// input, false when it doesn't begin with one
+func sniffXmlRoot(br *bufio.Reader) (xml.StartElement, bool) {
+	buf, _ := br.Peek(sniffSize)
+	dec := xml.NewDecoder(bytes.NewReader(buf))
+	for {
+		token, err := dec.Token()
+		if err != nil {
+			return xml.StartElement{}, false
+		}
+		switch tok := token.(type) {
+		case xml.StartElement:
+			return tok, true
+		case xml.CharData:
+			if len(bytes.TrimSpace(tok)) > 0 {
+				return xml.StartElement{}, false
+			}
+		}
+	}
+}
+
+func parseInputFromRequest(r *http.Request) (document.Iso20022Document, error) {
+	inputFile, _, err := r.FormFile("input")
+	if err != nil {
+		return nil, err
+	}
+	defer inputFile.Close()
 
-	return document.ParseIso20022Document(input.Bytes())
+	return service.Parse(inputFile)
 }
 
 func messageToBuf(format utils.DocumentType, doc document.Iso20022Document) ([]byte, error) {
-	var output []byte
-	var err error
-	switch format {
-	case utils.DocumentTypeJson:
-		output, err = json.MarshalIndent(doc, "", "\t")
-	case utils.DocumentTypeXml:
-		output, err = xml.MarshalIndent(doc, "", "\t")
-	case utils.DocumentTypeUnknown:
-		err = errors.New("unknown document type")
+	if format == utils.DocumentTypeUnknown {
+		return nil, errors.New("unknown document type")
+	}
+	var output bytes.Buffer
+	err := service.Encode(&output, doc, format)
+	return output.Bytes(), err
+}
+
+// deterministicMessageToBuf encodes doc in the deterministic form of service.EncodeDeterministic
+func deterministicMessageToBuf(format utils.DocumentType, doc document.Iso20022Document) ([]byte, error) {
+	if format == utils.DocumentTypeUnknown {
+		return nil, errors.New("unknown document type")
 	}
-	return output, err
+	var output bytes.Buffer
+	err := service.EncodeDeterministic(&output, doc, format)
+	return output.Bytes(), err
 }
 
 func outputBufferToWriter(w http.ResponseWriter, doc document.Iso20022Document, format utils.DocumentType) {
@@ -93,84 +157,552 @@ func getFormat(r *http.Request) (utils.DocumentType, error) {
 	return format, nil
 }
 
-// validator - validate the file based on publication 1220
-func validator(w http.ResponseWriter, r *http.Request) {
-	doc, err := parseInputFromRequest(r)
-	if err != nil {
-		outputError(w, http.StatusBadRequest, err)
+// warningHeaders adds the Warning headers of warnings to the response
+func warningHeaders(w http.ResponseWriter, warnings []string) {
+	for _, warning := range warnings {
+		w.Header().Add("Warning", fmt.Sprintf("299 - %q", warning))
+	}
+}
+
+// handlers serves the endpoints with either ad-hoc or enveloped responses
+type handlers struct {
+	envelope       bool
+	fingerprints   bool
+	workers        int
+	signer         *responseSigner
+	replay         *replayGuard
+	chaos          *chaos
+	statistics     *statsRecorder
+	usage          *usageMeter
+	corridors      *corridor.Policy
+	largeValues    largevalue.Thresholds
+	review         *reviewQueue
+	templates      templates.Library
+	masker         *masking.Masker
+	jobs           *jobStore
+	export         ExportConfig
+	events         *documentEvents
+	counterparty   *counterparty
+	pseudonymizer  *pseudonymizer
+	remittance     *remittance.Fetcher
+	subjectTenants map[string]bool
+	shadow         *shadowValidator
+	features       *featureFlags
+	documents      storage.Store
+	index          *documentIndex
+	dependencies   []Dependency
+}
+
+func (h handlers) outputError(w http.ResponseWriter, r *http.Request, code int, err error) {
+	err = h.masker.Error(err)
+	if h.envelope {
+		outputEnvelope(w, r, code, nil, err)
 		return
 	}
+	outputError(w, code, err)
+}
 
-	err = doc.Validate()
-	if err != nil {
-		outputError(w, http.StatusNotImplemented, err)
+func (h handlers) outputSuccess(w http.ResponseWriter, r *http.Request, output string, warnings ...string) {
+	warningHeaders(w, warnings)
+	if h.envelope {
+		outputEnvelope(w, r, http.StatusOK, map[string]string{"message": output}, nil, warnings...)
 		return
 	}
+	outputSuccess(w, output)
+}
 
-	outputSuccess(w, "valid file")
+func (h handlers) outputData(w http.ResponseWriter, r *http.Request, code int, data interface{}, warnings ...string) {
+	if h.envelope {
+		outputEnvelope(w, r, code, data, nil, warnings...)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(code)
+	json.NewEncoder(w).Encode(data)
 }
 
-// validator - print file with ascii or json format
-func print(w http.ResponseWriter, r *http.Request) {
-	doc, err := parseInputFromRequest(r)
+func (h handlers) outputDocument(w http.ResponseWriter, r *http.Request, doc document.Iso20022Document, format utils.DocumentType, output []byte, warnings ...string) {
+	warningHeaders(w, warnings)
+	if !h.envelope {
+		outputBufferToWriter(w, doc, format)
+		return
+	}
+	if format == utils.DocumentTypeJson {
+		outputEnvelope(w, r, http.StatusOK, doc, nil, warnings...)
+		return
+	}
+	outputEnvelope(w, r, http.StatusOK, string(output), nil, warnings...)
+}
+
+func (h handlers) outputFile(w http.ResponseWriter, r *http.Request, filename string, format utils.DocumentType, output []byte, warnings ...string) {
+	warningHeaders(w, warnings)
+	if h.envelope {
+		outputEnvelope(w, r, http.StatusOK, map[string]string{
+			"filename": filename,
+			"format":   string(format),
+			"content":  string(output),
+		}, nil, warnings...)
+		return
+	}
+	w.Header().Set("Content-Type", "application/octet-stream")
+	w.Header().Set("Content-Disposition", "attachment; filename="+filename)
+	w.Header().Set("Content-Transfer-Encoding", "binary")
+	w.Header().Set("Expires", "0")
+	w.WriteHeader(http.StatusOK)
+	w.Write(output)
+}
+
+// validator - validate the file based on publication 1220, report=html or report=pdf responds
+// with the validation report of the file and rejection=true with the status report rejecting
+// an invalid pacs.008 or pain.001, with the reason codes of the reasonScheme form value.
+// Business message envelopes are validated with their header. The timeout form value sets a
+// processing deadline, see validateWithDeadline.
+func (h handlers) validator(w http.ResponseWriter, r *http.Request) {
+	if value := r.FormValue("timeout"); value != "" {
+		h.validateWithDeadline(w, r, value)
+		return
+	}
+
+	var reportFormat report.Format
+	if value := r.FormValue("report"); value != "" {
+		var err error
+		if reportFormat, err = report.ParseFormat(value); err != nil {
+			h.outputError(w, r, http.StatusBadRequest, err)
+			return
+		}
+	}
+
+	c, ok := h.parseInput(w, r)
+	if !ok {
+		return
+	}
+
+	if !h.injectFault(w, r, ChaosStageValidate) {
+		return
+	}
+	c.Err = document.ValidateParallel(c.Document, h.workers)
+	// the document of an envelope is checked without its header
+	doc, input := c.Document, c.Input
+	if envelope, ok := c.Document.(*document.Envelope); ok {
+		doc = envelope.Document
+		_, input, _ = document.SplitEnvelope(c.Input)
+	}
+	if c.Err == nil && features.Enabled(r.Context(), features.StrictValidation) {
+		c.Err = document.CheckUnknownElements(doc, input)
+	}
+	if h.shadow.sampled() {
+		h.shadow.validate(input, utils.GetMessageType(doc.NameSpace()), c.Err)
+	}
+	if err := c.run(HookPostValidate); err != nil {
+		h.outputError(w, r, http.StatusUnprocessableEntity, err)
+		return
+	}
+	h.noteValidation(r, c)
+	if err := c.run(HookPreRespond); err != nil {
+		h.outputError(w, r, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	if reportFormat != "" {
+		h.outputReport(w, r, c, reportFormat)
+		return
+	}
+	if c.Err != nil && r.FormValue("rejection") == "true" && h.outputRejection(w, r, c) {
+		return
+	}
+	if c.Err != nil {
+		h.outputError(w, r, http.StatusNotImplemented, c.Err)
+		return
+	}
+
+	h.outputSuccess(w, r, "valid file", c.Warnings...)
+}
+
+// outputRejection responds with the status report rejecting the invalid document of c in the
+// requested format, with the status of the validation error. It reports false without
+// responding when no status report rejects the message of the document.
+func (h handlers) outputRejection(w http.ResponseWriter, r *http.Request, c *HookContext) bool {
+	format, err := getFormat(r)
 	if err != nil {
-		outputError(w, http.StatusBadRequest, err)
+		h.outputError(w, r, http.StatusNotImplemented, err)
+		return true
+	}
+	scheme, err := getReasonScheme(r, "reasonScheme", reasons.SchemeISO)
+	if err != nil {
+		h.outputError(w, r, http.StatusBadRequest, err)
+		return true
+	}
+	rejection, err := builder.NewRejection(builder.Rejection{MessageId: newId(), CreationDateTime: time.Now().UTC(), ReasonScheme: scheme}, c.Document, c.Err)
+	if errors.Is(err, builder.ErrNoRejection) {
+		return false
+	}
+	if err != nil {
+		h.outputError(w, r, http.StatusInternalServerError, err)
+		return true
+	}
+	output, err := messageToBuf(format, rejection)
+	if err != nil {
+		h.outputError(w, r, http.StatusInternalServerError, err)
+		return true
+	}
+
+	warningHeaders(w, c.Warnings)
+	if h.envelope {
+		var data interface{} = string(output)
+		if format == utils.DocumentTypeJson {
+			data = rejection
+		}
+		outputEnvelope(w, r, http.StatusNotImplemented, data, h.masker.Error(c.Err), c.Warnings...)
+		return true
+	}
+	if format == utils.DocumentTypeJson {
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	} else {
+		w.Header().Set("Content-Type", "application/xml; charset=utf-8")
+	}
+	w.WriteHeader(http.StatusNotImplemented)
+	w.Write(output)
+	return true
+}
+
+// outputReport responds with the validation report of c in format, with the status of the
+// response without report
+func (h handlers) outputReport(w http.ResponseWriter, r *http.Request, c *HookContext, format report.Format) {
+	var name string
+	if _, header, err := r.FormFile("input"); err == nil {
+		name = header.Filename
+	}
+	rep := report.New(name, c.Input, c.Document, c.Err)
+	rep.Warnings = append(rep.Warnings, c.Warnings...)
+	values := h.masker.Values(c.Document)
+	rep.Mask(func(text string) string {
+		return h.masker.Mask(text, values...)
+	})
+
+	var output bytes.Buffer
+	if err := rep.Encode(&output, format); err != nil {
+		h.outputError(w, r, http.StatusInternalServerError, err)
+		return
+	}
+	code := http.StatusOK
+	if c.Err != nil {
+		code = http.StatusNotImplemented
+	}
+	warningHeaders(w, c.Warnings)
+	w.Header().Set("Content-Type", format.ContentType())
+	w.Header().Set("Content-Disposition", "attachment; filename=validation-report."+string(format))
+	w.WriteHeader(code)
+	w.Write(output.Bytes())
+}
+
+// validator - print file with ascii or json format
+func (h handlers) print(w http.ResponseWriter, r *http.Request) {
+	c, ok := h.parseInput(w, r)
+	if !ok {
 		return
 	}
 
 	format, err := getFormat(r)
 	if err != nil {
-		outputError(w, http.StatusNotImplemented, err)
+		h.outputError(w, r, http.StatusNotImplemented, err)
 		return
 	}
-	_, err = messageToBuf(format, doc)
+	output, err := messageToBuf(format, c.Document)
 	if err != nil {
-		outputError(w, http.StatusNotImplemented, err)
+		h.outputError(w, r, http.StatusNotImplemented, err)
+		return
+	}
+	if err = c.run(HookPreRespond); err != nil {
+		h.outputError(w, r, http.StatusUnprocessableEntity, err)
 		return
 	}
 
-	outputBufferToWriter(w, doc, format)
+	h.outputDocument(w, r, c.Document, format, output, c.Warnings...)
 }
 
-// convert - convert file with ascii or json format
-func convert(w http.ResponseWriter, r *http.Request) {
-	message, err := parseInputFromRequest(r)
+// convert - convert file with ascii or json format, optionally to another targetVersion of the
+// message or the version negotiated with the comma separated counterpartyVersions. provenance=true
+// responds with the provenance of the converted elements too and deterministic=true encodes in the
+// deterministic form. reasonScheme translates the reason codes of the document from the
+// sourceReasonScheme, the ISO external codes by default. Business message envelopes are
+// converted with their header.
+func (h handlers) convert(w http.ResponseWriter, r *http.Request) {
+	var opts []service.Option
+	from, err := getReasonScheme(r, "sourceReasonScheme", reasons.SchemeISO)
 	if err != nil {
-		outputError(w, http.StatusBadRequest, err)
+		h.outputError(w, r, http.StatusBadRequest, err)
+		return
+	}
+	to, err := getReasonScheme(r, "reasonScheme", "")
+	if err != nil {
+		h.outputError(w, r, http.StatusBadRequest, err)
+		return
+	}
+	if to != "" {
+		opts = append(opts, service.WithReasonCodes(from, to))
+	}
+	if version := r.FormValue("targetVersion"); version != "" {
+		opts = append(opts, service.WithTargetVersion(version))
+	}
+	if versions := r.FormValue("counterpartyVersions"); versions != "" {
+		opts = append(opts, service.WithCounterpartyVersions(strings.Split(versions, ",")...))
+	}
+	var provenance *service.Provenance
+	if r.FormValue("provenance") == "true" {
+		provenance = &service.Provenance{}
+		opts = append(opts, service.WithProvenance(provenance))
+	}
+
+	c, ok := h.parseInput(w, r, opts...)
+	if !ok || !h.checkCorridors(w, r, c) {
 		return
 	}
 
 	format, err := getFormat(r)
 	if err != nil {
-		outputError(w, http.StatusNotImplemented, err)
+		h.outputError(w, r, http.StatusNotImplemented, err)
 		return
 	}
 
-	output, err := messageToBuf(format, message)
+	if !h.injectFault(w, r, ChaosStageConvert) {
+		return
+	}
+	encode := messageToBuf
+	if r.FormValue("deterministic") == "true" {
+		encode = deterministicMessageToBuf
+	}
+	output, err := encode(format, c.Document)
 	if err != nil {
-		outputError(w, http.StatusNotImplemented, err)
+		h.outputError(w, r, http.StatusNotImplemented, err)
+		return
+	}
+	if err = c.run(HookPreRespond); err != nil {
+		h.outputError(w, r, http.StatusUnprocessableEntity, err)
 		return
 	}
 
-	filename := "converted_file"
-	w.Header().Set("Content-Type", "application/octet-stream")
-	w.Header().Set("Content-Disposition", "attachment; filename="+filename)
-	w.Header().Set("Content-Transfer-Encoding", "binary")
-	w.Header().Set("Expires", "0")
-	w.WriteHeader(http.StatusOK)
-	w.Write(output)
+	if provenance != nil {
+		provenance.TargetFormat = format
+		warningHeaders(w, c.Warnings)
+		h.outputData(w, r, http.StatusOK, map[string]interface{}{
+			"filename":   "converted_file",
+			"format":     string(format),
+			"content":    string(output),
+			"provenance": provenance,
+		}, c.Warnings...)
+		return
+	}
+	h.outputFile(w, r, "converted_file", format, output, c.Warnings...)
 }
 
 // health - health check
-func health(w http.ResponseWriter, r *http.Request) {
-	outputSuccess(w, "alive")
+func (h handlers) health(w http.ResponseWriter, r *http.Request) {
+	h.outputSuccess(w, r, "alive")
+}
+
+func (h handlers) configure(r *mux.Router) {
+	r.HandleFunc("/health", h.health).Methods("GET")
+	r.HandleFunc("/ready", h.ready).Methods("GET")
+	r.HandleFunc("/stats", h.stats).Methods("GET")
+	r.HandleFunc("/slos", h.slos).Methods("GET")
+	r.HandleFunc("/usage", h.usageReport).Methods("GET")
+	r.HandleFunc("/features", h.featureList).Methods("GET")
+	r.HandleFunc("/print", h.statistics.recorded(h.metered(h.print))).Methods("POST")
+	r.HandleFunc("/validator", h.statistics.recorded(h.metered(h.signer.signed(h.validator)))).Methods("POST")
+	r.HandleFunc("/convert", h.statistics.recorded(h.metered(h.signer.signed(h.convert)))).Methods("POST")
+	r.Handle("/validator/stream", streamValidator()).Methods("GET")
+	r.HandleFunc("/jobs", h.metered(h.protected(h.createJob))).Methods("POST")
+	r.HandleFunc("/jobs/{id}", h.job).Methods("GET")
+	r.HandleFunc("/jobs/{id}/result", h.jobResult).Methods("GET")
+	r.HandleFunc("/jobs/{id}/events", h.jobEvents).Methods("GET")
+	r.HandleFunc("/schemas", h.schemas).Methods("GET")
+	r.HandleFunc("/schemas/{type}", h.schema).Methods("GET")
+	r.HandleFunc("/schemas/{type}/check", h.checkElements).Methods("POST")
+	r.HandleFunc("/editor", h.editor).Methods("GET")
+	r.HandleFunc("/profiles/compare", h.compareProfiles).Methods("POST")
+	r.HandleFunc("/profiles/{name}/rules", h.profileRules).Methods("GET")
+	r.HandleFunc("/reasons/{scheme}/{code}", h.reasonCode).Methods("GET")
+	r.HandleFunc("/documents", h.statistics.recorded(h.metered(h.protected(h.createDocument)))).Methods("POST")
+	r.HandleFunc("/documents/search", h.searchDocuments).Methods("POST")
+	r.HandleFunc("/documents/{id}", h.document).Methods("GET")
+	r.HandleFunc("/documents/{id}", h.protected(h.patchDocument)).Methods("PATCH")
+	r.HandleFunc("/documents/{id}/package", h.documentPackage).Methods("GET")
+	r.HandleFunc("/reviews", h.reviews).Methods("GET")
+	r.HandleFunc("/reviews/console", h.reviewsConsole).Methods("GET")
+	r.HandleFunc("/reviews/{id}/release", h.protected(h.releaseReview)).Methods("POST")
+	r.HandleFunc("/reviews/{id}/reject", h.protected(h.rejectReview)).Methods("POST")
+	r.HandleFunc("/templates", h.listTemplates).Methods("GET")
+	r.HandleFunc("/templates/{name}", h.template).Methods("GET")
+	r.HandleFunc("/templates/{name}", h.protected(h.putTemplate)).Methods("PUT")
+	r.HandleFunc("/templates/{name}", h.protected(h.deleteTemplate)).Methods("DELETE")
+	r.HandleFunc("/templates/{name}/instantiate", h.metered(h.instantiateTemplate)).Methods("POST")
+	r.HandleFunc("/templates/{name}/compose", h.metered(h.protected(h.composeTemplate))).Methods("POST")
+	r.HandleFunc("/subjects/export", h.metered(h.protected(h.exportSubject))).Methods("POST")
+	r.HandleFunc("/subjects/erase", h.protected(h.eraseSubject)).Methods("POST")
+	r.HandleFunc("/pseudonymize", h.metered(h.pseudonymize)).Methods("POST")
+	r.HandleFunc("/reidentify", h.protected(h.reidentify)).Methods("POST")
 }
 
 // configure handlers
 func ConfigureHandlers(r *mux.Router) error {
-	r.HandleFunc("/health", health).Methods("GET")
-	r.HandleFunc("/print", print).Methods("POST")
-	r.HandleFunc("/validator", validator).Methods("POST")
-	r.HandleFunc("/convert", convert).Methods("POST")
+	return ConfigureHandlersWithOptions(r, APIConfig{})
+}
+
+// ConfigureHandlersWithOptions configures the /v1 and /v2 endpoints and the unversioned
+// endpoints, which respond like /v1 unless options enable envelopes
+func ConfigureHandlersWithOptions(r *mux.Router, options APIConfig) error {
+	return ConfigureHandlersWithLogger(r, options, log.NewDefaultLogger())
+}
+
+// ConfigureHandlersWithLogger configures the endpoints like ConfigureHandlersWithOptions,
+// logging the discrepancies of shadow validation to logger
+func ConfigureHandlersWithLogger(r *mux.Router, options APIConfig, logger log.Logger) error {
+	documents, _, err := newDocumentStore(options.Storage, logger)
+	if err != nil {
+		return err
+	}
+	library, err := newTemplateLibrary(options.Templates)
+	if err != nil {
+		return err
+	}
+	usage, err := newUsageMeter(options.Usage)
+	if err != nil {
+		return err
+	}
+	return configureHandlers(r, options, logger, documents, library, usage, newJobStore(), masking.New(options.Masking))
+}
+
+// configureHandlers configures the endpoints keeping the documents of /documents in documents,
+// the templates of /templates in library, the usage of tenants in usage and the background jobs
+// of /jobs in jobs, /ready checks dependencies with the document store and signing key. masker
+// masks the errors of responses.
+func configureHandlers(r *mux.Router, options APIConfig, logger log.Logger, documents storage.Store, library templates.Library, usage *usageMeter, jobs *jobStore, masker *masking.Masker, dependencies ...Dependency) error {
+	shadow, err := newShadowValidator(options.Shadow, logger)
+	if err != nil {
+		return err
+	}
+	signer, err := newResponseSigner(options.Signing)
+	if err != nil {
+		return err
+	}
+
+	chaos, err := newChaos(options.Chaos, logger)
+	if err != nil {
+		return err
+	}
+
+	// nonces are shared by every version, a request can't be replayed on another one
+	replay, err := newReplayGuard(options.Replay)
+	if err != nil {
+		return err
+	}
+
+	dependencies = append([]Dependency{documentsDependency(documents)}, dependencies...)
+	if signer != nil {
+		dependencies = append(dependencies, signingDependency(signer))
+	}
+
+	unversioned := APIVersion1
+	if options.Envelope {
+		unversioned = APIVersion2
+	}
+
+	// jobs, statistics, usage, templates and the index of documents are shared by every version
+	index := newDocumentIndex()
+	statistics, err := newStatsRecorder(options.Stats)
+	if err != nil {
+		return err
+	}
+	if statistics.slos, err = newSLOTracker(options.SLOs, options.Outbound, logger); err != nil {
+		return err
+	}
+	corridors, err := newCorridorPolicy(options.Corridors)
+	if err != nil {
+		return err
+	}
+	largeValues, err := largevalue.New(options.LargeValues)
+	if err != nil {
+		return err
+	}
+	documentEvents := newDocumentEvents(options.Events, options.Outbound, largeValues, logger)
+	review, err := newReviewQueue(options.Review)
+	if err != nil {
+		return err
+	}
+	if review.fourEyes && usage == nil {
+		return errors.New("four-eyes approval needs the tenants of usage to identify composers and approvers")
+	}
+	counterparty, err := newCounterparty(options.Simulator, documents, documentEvents, options.Outbound, logger)
+	if err != nil {
+		return err
+	}
+	pseudonymizer, err := newPseudonymizer(options.Pseudonymization, options.Usage)
+	if err != nil {
+		return err
+	}
+	subjectTenants, err := newSubjectTenants(options.Subjects, options.Usage)
+	if err != nil {
+		return err
+	}
+	remittanceFetcher := newRemittanceFetcher(options.Remittance, options.Outbound)
+	featureFlags, err := newFeatureFlags(options.Features, usage)
+	if err != nil {
+		return err
+	}
+
+	mounts := []struct {
+		prefix  string
+		version string
+		key     string
+	}{
+		{prefix: "/" + APIVersion1, version: APIVersion1, key: APIVersion1},
+		{prefix: "/" + APIVersion2, version: APIVersion2, key: APIVersion2},
+		{prefix: "/", version: unversioned, key: ""},
+	}
+	for _, mount := range mounts {
+		deprecation, err := findDeprecation(options, mount.key)
+		if err != nil {
+			return err
+		}
+		middleware, err := versionMiddleware(mount.version, deprecation)
+		if err != nil {
+			return err
+		}
+
+		sub := r.PathPrefix(mount.prefix).Subrouter()
+		sub.Use(middleware, dryRunMiddleware)
+		if options.Receipts {
+			sub.Use(receiptMiddleware)
+		}
+		h := handlers{
+			envelope:       mount.version == APIVersion2,
+			fingerprints:   options.Fingerprints,
+			workers:        options.ValidationWorkers,
+			signer:         signer,
+			replay:         replay,
+			chaos:          chaos,
+			statistics:     statistics,
+			usage:          usage,
+			corridors:      corridors,
+			largeValues:    largeValues,
+			review:         review,
+			templates:      library,
+			masker:         masker,
+			jobs:           jobs,
+			export:         options.Export,
+			events:         documentEvents,
+			counterparty:   counterparty,
+			pseudonymizer:  pseudonymizer,
+			remittance:     remittanceFetcher,
+			subjectTenants: subjectTenants,
+			shadow:         shadow,
+			features:       featureFlags,
+			documents:      documents,
+			index:          index,
+			dependencies:   dependencies,
+		}
+		sub.Use(h.featured)
+		h.configure(sub)
+	}
 	return nil
 }
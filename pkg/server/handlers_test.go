@@ -6,6 +6,7 @@ package server_test
 
 import (
 	"bytes"
+	"encoding/json"
 	"io"
 	"mime/multipart"
 	"net/http"
@@ -16,9 +17,14 @@ import (
 	"testing"
 
 	"github.com/gorilla/mux"
+	"github.com/moov-io/iso20022/pkg/document"
+	"github.com/moov-io/iso20022/pkg/features"
+	"github.com/moov-io/iso20022/pkg/iso20022test/fixtures"
 	"github.com/moov-io/iso20022/pkg/server"
+	"github.com/moov-io/iso20022/pkg/service"
 	"github.com/moov-io/iso20022/pkg/utils"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -335,3 +341,360 @@ func (suite *HandlersTest) TestValidatorWithXmlFile() {
 	suite.testServer.ServeHTTP(recorder, request)
 	assert.Equal(suite.T(), http.StatusOK, recorder.Code)
 }
+
+func (suite *HandlersTest) decodeEnvelope(recorder *httptest.ResponseRecorder) server.Envelope {
+	var envelope server.Envelope
+	assert.Nil(suite.T(), json.NewDecoder(recorder.Body).Decode(&envelope))
+	return envelope
+}
+
+func (suite *HandlersTest) TestEnvelopeHealth() {
+	recorder, request := suite.makeRequest(http.MethodGet, "/v2/health", "")
+	request.Header.Set(server.RequestIdHeader, "request-1")
+	suite.testServer.ServeHTTP(recorder, request)
+	assert.Equal(suite.T(), http.StatusOK, recorder.Code)
+	assert.Equal(suite.T(), "request-1", recorder.Header().Get(server.RequestIdHeader))
+
+	envelope := suite.decodeEnvelope(recorder)
+	assert.Equal(suite.T(), server.EnvelopeStatusSuccess, envelope.Status)
+	assert.Equal(suite.T(), "request-1", envelope.RequestId)
+	assert.Equal(suite.T(), map[string]interface{}{"message": "alive"}, envelope.Data)
+}
+
+func (suite *HandlersTest) TestEnvelopeValidatorWithErrorData() {
+	writer, body := suite.getWriter(testInvalidFileName)
+	err := writer.Close()
+	assert.Equal(suite.T(), nil, err)
+	recorder, request := suite.makeRequest(http.MethodPost, "/v2/validator", body.String())
+	request.Header.Set("Content-Type", writer.FormDataContentType())
+	suite.testServer.ServeHTTP(recorder, request)
+	assert.Equal(suite.T(), http.StatusBadRequest, recorder.Code)
+
+	envelope := suite.decodeEnvelope(recorder)
+	assert.Equal(suite.T(), server.EnvelopeStatusError, envelope.Status)
+	assert.Equal(suite.T(), []string{"The type of file is invalid"}, envelope.Errors)
+	assert.Nil(suite.T(), envelope.Data)
+	assert.NotEmpty(suite.T(), envelope.RequestId)
+	assert.Equal(suite.T(), envelope.RequestId, recorder.Header().Get(server.RequestIdHeader))
+}
+
+func (suite *HandlersTest) TestEnvelopePrint() {
+	writer, body := suite.getWriter(testXmlFileName)
+	err := writer.WriteField("format", string(utils.DocumentTypeJson))
+	assert.Equal(suite.T(), nil, err)
+	err = writer.Close()
+	assert.Equal(suite.T(), nil, err)
+	recorder, request := suite.makeRequest(http.MethodPost, "/v2/print", body.String())
+	request.Header.Set("Content-Type", writer.FormDataContentType())
+	suite.testServer.ServeHTTP(recorder, request)
+	assert.Equal(suite.T(), http.StatusOK, recorder.Code)
+
+	envelope := suite.decodeEnvelope(recorder)
+	assert.Equal(suite.T(), server.EnvelopeStatusSuccess, envelope.Status)
+	data, ok := envelope.Data.(map[string]interface{})
+	assert.True(suite.T(), ok)
+	assert.Contains(suite.T(), data, "Message")
+}
+
+func (suite *HandlersTest) TestEnvelopeConvert() {
+	writer, body := suite.getWriter(testJsonFileName)
+	err := writer.WriteField("format", string(utils.DocumentTypeXml))
+	assert.Equal(suite.T(), nil, err)
+	err = writer.Close()
+	assert.Equal(suite.T(), nil, err)
+	recorder, request := suite.makeRequest(http.MethodPost, "/v2/convert", body.String())
+	request.Header.Set("Content-Type", writer.FormDataContentType())
+	suite.testServer.ServeHTTP(recorder, request)
+	assert.Equal(suite.T(), http.StatusOK, recorder.Code)
+
+	envelope := suite.decodeEnvelope(recorder)
+	data, ok := envelope.Data.(map[string]interface{})
+	assert.True(suite.T(), ok)
+	assert.Equal(suite.T(), "xml", data["format"])
+	assert.Contains(suite.T(), data["content"], "<Document")
+}
+
+func (suite *HandlersTest) TestConvertDeterministic() {
+	writer, body := suite.getWriter("valid_pacs_v08.xml")
+	assert.Nil(suite.T(), writer.WriteField("format", string(utils.DocumentTypeJson)))
+	assert.Nil(suite.T(), writer.WriteField("deterministic", "true"))
+	assert.Nil(suite.T(), writer.Close())
+	recorder, request := suite.makeRequest(http.MethodPost, "/convert", body.String())
+	request.Header.Set("Content-Type", writer.FormDataContentType())
+	suite.testServer.ServeHTTP(recorder, request)
+	assert.Equal(suite.T(), http.StatusOK, recorder.Code)
+
+	input, err := os.ReadFile(filepath.Join("..", "..", "test", "testdata", "valid_pacs_v08.xml"))
+	assert.Nil(suite.T(), err)
+	doc, err := document.ParseIso20022Document(input)
+	assert.Nil(suite.T(), err)
+	var expected bytes.Buffer
+	assert.Nil(suite.T(), service.EncodeDeterministic(&expected, doc, utils.DocumentTypeJson))
+	assert.Equal(suite.T(), expected.String(), recorder.Body.String())
+}
+
+func (suite *HandlersTest) TestConvertCounterpartyVersions() {
+	for versions, code := range map[string]int{
+		"pacs.008.001.06, pacs.008.001.09": http.StatusOK,
+		"pacs.008.001.06":                  http.StatusBadRequest,
+	} {
+		writer, body := suite.getWriter("valid_pacs_v08.xml")
+		assert.Nil(suite.T(), writer.WriteField("counterpartyVersions", versions))
+		assert.Nil(suite.T(), writer.Close())
+		recorder, request := suite.makeRequest(http.MethodPost, "/convert", body.String())
+		request.Header.Set("Content-Type", writer.FormDataContentType())
+		suite.testServer.ServeHTTP(recorder, request)
+		assert.Equal(suite.T(), code, recorder.Code)
+		if code == http.StatusOK {
+			assert.Contains(suite.T(), recorder.Body.String(), `xmlns="urn:iso:std:iso:20022:tech:xsd:pacs.008.001.09"`)
+		} else {
+			assert.Contains(suite.T(), recorder.Body.String(), "no common version")
+		}
+	}
+}
+
+func (suite *HandlersTest) TestValidatorReport() {
+	writer, body := suite.getWriter("valid_pacs_v08.xml")
+	assert.Nil(suite.T(), writer.WriteField("report", "pdf"))
+	assert.Nil(suite.T(), writer.Close())
+	recorder, request := suite.makeRequest(http.MethodPost, "/validator", body.String())
+	request.Header.Set("Content-Type", writer.FormDataContentType())
+	suite.testServer.ServeHTTP(recorder, request)
+	assert.Equal(suite.T(), http.StatusOK, recorder.Code)
+	assert.Equal(suite.T(), "application/pdf", recorder.Header().Get("Content-Type"))
+	assert.Equal(suite.T(), "attachment; filename=validation-report.pdf", recorder.Header().Get("Content-Disposition"))
+	assert.True(suite.T(), strings.HasPrefix(recorder.Body.String(), "%PDF-1.4\n"))
+	assert.Contains(suite.T(), recorder.Body.String(), "(File: valid_pacs_v08.xml) Tj")
+
+	// invalid documents are reported with the status of the validation error
+	input, err := os.ReadFile(filepath.Join("..", "..", "test", "testdata", "valid_pacs_v08.xml"))
+	assert.Nil(suite.T(), err)
+	body = &bytes.Buffer{}
+	writer = multipart.NewWriter(body)
+	part, err := writer.CreateFormFile("input", "payment.xml")
+	assert.Nil(suite.T(), err)
+	_, err = part.Write(bytes.Replace(input, []byte(`Ccy="USD"`), []byte(`Ccy="usd"`), 1))
+	assert.Nil(suite.T(), err)
+	assert.Nil(suite.T(), writer.WriteField("report", "html"))
+	assert.Nil(suite.T(), writer.Close())
+	recorder, request = suite.makeRequest(http.MethodPost, "/validator", body.String())
+	request.Header.Set("Content-Type", writer.FormDataContentType())
+	suite.testServer.ServeHTTP(recorder, request)
+	assert.Equal(suite.T(), http.StatusNotImplemented, recorder.Code)
+	assert.Equal(suite.T(), "text/html; charset=utf-8", recorder.Header().Get("Content-Type"))
+	assert.Contains(suite.T(), recorder.Body.String(), "<h3>GrpHdr/TtlIntrBkSttlmAmt/@Ccy</h3>")
+
+	writer, body = suite.getWriter("valid_pacs_v08.xml")
+	assert.Nil(suite.T(), writer.WriteField("report", "docx"))
+	assert.Nil(suite.T(), writer.Close())
+	recorder, request = suite.makeRequest(http.MethodPost, "/validator", body.String())
+	request.Header.Set("Content-Type", writer.FormDataContentType())
+	suite.testServer.ServeHTTP(recorder, request)
+	assert.Equal(suite.T(), http.StatusBadRequest, recorder.Code)
+	assert.Contains(suite.T(), recorder.Body.String(), "docx is an invalid report format")
+}
+
+func (suite *HandlersTest) TestValidatorRejection() {
+	input, err := os.ReadFile(filepath.Join("..", "..", "test", "testdata", "valid_pacs_v08.xml"))
+	assert.Nil(suite.T(), err)
+	for name, content := range map[string][]byte{
+		"valid":   input,
+		"invalid": bytes.Replace(input, []byte(`Ccy="USD"`), []byte(`Ccy="usd"`), 1),
+	} {
+		body := &bytes.Buffer{}
+		writer := multipart.NewWriter(body)
+		part, err := writer.CreateFormFile("input", "payment.xml")
+		assert.Nil(suite.T(), err)
+		_, err = part.Write(content)
+		assert.Nil(suite.T(), err)
+		assert.Nil(suite.T(), writer.WriteField("rejection", "true"))
+		assert.Nil(suite.T(), writer.Close())
+		recorder, request := suite.makeRequest(http.MethodPost, "/validator", body.String())
+		request.Header.Set("Content-Type", writer.FormDataContentType())
+		suite.testServer.ServeHTTP(recorder, request)
+
+		if name == "valid" {
+			assert.Equal(suite.T(), http.StatusOK, recorder.Code)
+			assert.Contains(suite.T(), recorder.Body.String(), "valid file")
+			continue
+		}
+		assert.Equal(suite.T(), http.StatusNotImplemented, recorder.Code)
+		assert.Equal(suite.T(), "application/xml; charset=utf-8", recorder.Header().Get("Content-Type"))
+		rejection, err := document.ParseIso20022Document(recorder.Body.Bytes())
+		assert.Nil(suite.T(), err)
+		assert.Equal(suite.T(), utils.DocumentPacs00200110NameSpace, rejection.NameSpace())
+		assert.Equal(suite.T(), []string{"RJCT"}, utils.FindElementValues(rejection.InspectMessage(), "OrgnlGrpInfAndSts/GrpSts"))
+		assert.Equal(suite.T(), []string{"AM03"}, utils.FindElementValues(rejection.InspectMessage(), "OrgnlGrpInfAndSts/StsRsnInf/Rsn/Cd"))
+	}
+}
+
+func (suite *HandlersTest) TestValidatorRejectionReasonScheme() {
+	input, err := os.ReadFile(filepath.Join("..", "..", "test", "testdata", "valid_pacs_v08.xml"))
+	assert.Nil(suite.T(), err)
+	input = bytes.Replace(input, []byte(`Ccy="USD"`), []byte(`Ccy="usd"`), 1)
+	for scheme, code := range map[string]string{"sepa": "MS03", "mt": "AM03", "swift": ""} {
+		body := &bytes.Buffer{}
+		writer := multipart.NewWriter(body)
+		part, err := writer.CreateFormFile("input", "payment.xml")
+		assert.Nil(suite.T(), err)
+		_, err = part.Write(input)
+		assert.Nil(suite.T(), err)
+		assert.Nil(suite.T(), writer.WriteField("rejection", "true"))
+		assert.Nil(suite.T(), writer.WriteField("reasonScheme", scheme))
+		assert.Nil(suite.T(), writer.Close())
+		recorder, request := suite.makeRequest(http.MethodPost, "/validator", body.String())
+		request.Header.Set("Content-Type", writer.FormDataContentType())
+		suite.testServer.ServeHTTP(recorder, request)
+
+		if code == "" {
+			assert.Equal(suite.T(), http.StatusBadRequest, recorder.Code)
+			continue
+		}
+		assert.Equal(suite.T(), http.StatusNotImplemented, recorder.Code)
+		rejection, err := document.ParseIso20022Document(recorder.Body.Bytes())
+		assert.Nil(suite.T(), err)
+		assert.Equal(suite.T(), []string{code}, utils.FindElementValues(rejection.InspectMessage(), "OrgnlGrpInfAndSts/StsRsnInf/Rsn/Cd"), scheme)
+	}
+}
+
+func (suite *HandlersTest) TestConvertProvenance() {
+	writer, body := suite.getWriter("valid_pacs_v08.xml")
+	assert.Nil(suite.T(), writer.WriteField("format", string(utils.DocumentTypeJson)))
+	assert.Nil(suite.T(), writer.WriteField("targetVersion", "pacs.008.001.09"))
+	assert.Nil(suite.T(), writer.WriteField("provenance", "true"))
+	assert.Nil(suite.T(), writer.Close())
+	recorder, request := suite.makeRequest(http.MethodPost, "/convert", body.String())
+	request.Header.Set("Content-Type", writer.FormDataContentType())
+	suite.testServer.ServeHTTP(recorder, request)
+	assert.Equal(suite.T(), http.StatusOK, recorder.Code)
+
+	var result struct {
+		Format     string
+		Content    string
+		Provenance struct {
+			SourceType string
+			TargetType string
+			Fields     []map[string]string
+		}
+	}
+	assert.Nil(suite.T(), json.NewDecoder(recorder.Body).Decode(&result))
+	assert.Equal(suite.T(), "json", result.Format)
+	assert.Contains(suite.T(), result.Content, "pacs.008.001.09")
+	assert.Equal(suite.T(), "pacs.008.001.08", result.Provenance.SourceType)
+	assert.Equal(suite.T(), "pacs.008.001.09", result.Provenance.TargetType)
+	assert.Contains(suite.T(), result.Provenance.Fields, map[string]string{
+		"target": "GrpHdr/MsgId", "source": "GrpHdr/MsgId", "transformation": "migrate",
+	})
+}
+
+func TestConfigureHandlersWithEnvelope(t *testing.T) {
+	router := mux.NewRouter()
+	assert.Nil(t, server.ConfigureHandlersWithOptions(router, server.APIConfig{Envelope: true}))
+
+	for _, url := range []string{"/health", "/v2/health"} {
+		request, err := http.NewRequest(http.MethodGet, url, nil)
+		assert.Nil(t, err)
+		recorder := httptest.NewRecorder()
+		router.ServeHTTP(recorder, request)
+		assert.Equal(t, http.StatusOK, recorder.Code)
+
+		var envelope server.Envelope
+		assert.Nil(t, json.NewDecoder(recorder.Body).Decode(&envelope))
+		assert.Equal(t, server.EnvelopeStatusSuccess, envelope.Status)
+	}
+}
+
+func TestCashManagementRoundTrip(t *testing.T) {
+	router := mux.NewRouter()
+	require.Nil(t, server.ConfigureHandlers(router))
+	ts := httptest.NewServer(router)
+	t.Cleanup(ts.Close)
+
+	for _, name := range []string{"valid_camt052_v08.xml", "valid_camt054_v08_1.xml"} {
+		t.Run(name, func(t *testing.T) {
+			input := fixtures.ReadFile(t, name)
+			original, err := service.Parse(bytes.NewReader(input))
+			require.Nil(t, err)
+			require.Nil(t, original.Validate())
+
+			// printed as json, then converted back to xml
+			resp := postForm(t, ts.URL+"/print", input, map[string]string{"format": string(utils.DocumentTypeJson)})
+			require.Equal(t, http.StatusOK, resp.StatusCode)
+			printed, err := io.ReadAll(resp.Body)
+			require.Nil(t, err)
+			require.Equal(t, utils.DocumentTypeJson, utils.GetDocumentFormat(printed))
+
+			resp = postForm(t, ts.URL+"/convert", printed, map[string]string{"format": string(utils.DocumentTypeXml)})
+			require.Equal(t, http.StatusOK, resp.StatusCode)
+			converted, err := io.ReadAll(resp.Body)
+			require.Nil(t, err)
+			require.Equal(t, utils.DocumentTypeXml, utils.GetDocumentFormat(converted))
+
+			roundTripped, err := service.Parse(bytes.NewReader(converted))
+			require.Nil(t, err)
+			require.Equal(t, original.NameSpace(), roundTripped.NameSpace())
+			require.Equal(t, original.InspectMessage(), roundTripped.InspectMessage())
+		})
+	}
+}
+
+func TestEnvelopes(t *testing.T) {
+	router := mux.NewRouter()
+	// the document of envelopes is checked for unknown elements without its header
+	require.Nil(t, server.ConfigureHandlersWithOptions(router, server.APIConfig{
+		Features: server.FeaturesConfig{Enabled: []string{features.StrictValidation}},
+	}))
+	ts := httptest.NewServer(router)
+	t.Cleanup(ts.Close)
+
+	input := fixtures.ReadFile(t, "valid_pacs_v08_envelope.xml")
+	resp := postForm(t, ts.URL+"/validator", input, nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	mismatch := strings.Replace(string(input), "<MsgDefIdr>pacs.008.001.08", "<MsgDefIdr>pacs.009.001.08", 1)
+	resp = postForm(t, ts.URL+"/validator", []byte(mismatch), nil)
+	require.Equal(t, http.StatusNotImplemented, resp.StatusCode)
+	body, err := io.ReadAll(resp.Body)
+	require.Nil(t, err)
+	require.Contains(t, string(body), "MsgDefIdr")
+
+	// envelopes are converted with their header
+	resp = postForm(t, ts.URL+"/convert", input, map[string]string{"format": string(utils.DocumentTypeJson)})
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	converted, err := io.ReadAll(resp.Body)
+	require.Nil(t, err)
+	envelope, err := document.ParseEnvelope(converted)
+	require.Nil(t, err)
+	require.Nil(t, envelope.Validate())
+
+	resp = postForm(t, ts.URL+"/convert", converted, map[string]string{"format": string(utils.DocumentTypeXml)})
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	converted, err = io.ReadAll(resp.Body)
+	require.Nil(t, err)
+	require.True(t, strings.HasPrefix(string(converted), "<BizMsgEnvlp>"))
+	roundTripped, err := document.ParseEnvelope(converted)
+	require.Nil(t, err)
+	require.Equal(t, envelope.Header, roundTripped.Header)
+	require.Equal(t, envelope.InspectMessage(), roundTripped.InspectMessage())
+}
+
+func TestValidationWorkers(t *testing.T) {
+	router := mux.NewRouter()
+	require.Nil(t, server.ConfigureHandlersWithOptions(router, server.APIConfig{ValidationWorkers: 4}))
+	ts := httptest.NewServer(router)
+	t.Cleanup(ts.Close)
+
+	input := fixtures.ReadFile(t, "valid_pacs_v08.xml")
+	resp := postForm(t, ts.URL+"/validator", input, nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	invalid := strings.Replace(string(input), `<IntrBkSttlmAmt Ccy="USD">500.75`, `<IntrBkSttlmAmt Ccy="usd">500.75`, 1)
+	resp = postForm(t, ts.URL+"/validator", []byte(invalid), nil)
+	require.Equal(t, http.StatusNotImplemented, resp.StatusCode)
+	body, err := io.ReadAll(resp.Body)
+	require.Nil(t, err)
+	require.Contains(t, string(body), "CreditTransferTransaction39")
+
+	resp = postForm(t, ts.URL+"/validator", fixtures.ReadFile(t, "valid_pacs_v08_envelope.xml"), nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+}
@@ -0,0 +1,161 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package server
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/moov-io/iso20022/pkg/storage"
+)
+
+const (
+	HealthStatusUp       = "up"
+	HealthStatusDegraded = "degraded"
+	HealthStatusDown     = "down"
+
+	// DependencyTypeKeyStore is the type of the key signing responses
+	DependencyTypeKeyStore = "keystore"
+
+	// healthCheckTimeout bounds every check of a dependency
+	healthCheckTimeout = 5 * time.Second
+
+	// healthProbeID is the id of the record read to check the document store
+	healthProbeID = "health-probe"
+)
+
+// Dependency is a service the server depends on, checked by /ready
+type Dependency struct {
+	Name string
+
+	// Type of the dependency, e.g. storage, kafka, redis or keystore
+	Type string
+
+	// Optional dependencies degrade the service when they're down, validation still works
+	Optional bool
+
+	Check func(ctx context.Context) error
+}
+
+// DependencyHealth is the status of a dependency
+type DependencyHealth struct {
+	Name     string `json:"name"`
+	Type     string `json:"type"`
+	Status   string `json:"status"`
+	Optional bool   `json:"optional,omitempty"`
+	Message  string `json:"message,omitempty"`
+	Latency  string `json:"latency"`
+}
+
+// Health is the status of the service and its dependencies, the service is down when a
+// required dependency is down and degraded when an optional one is
+type Health struct {
+	Status       string             `json:"status"`
+	Dependencies []DependencyHealth `json:"dependencies"`
+}
+
+// CheckHealth checks dependencies concurrently
+func CheckHealth(ctx context.Context, dependencies []Dependency) Health {
+	health := Health{Status: HealthStatusUp, Dependencies: make([]DependencyHealth, len(dependencies))}
+
+	var wg sync.WaitGroup
+	for i := range dependencies {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			health.Dependencies[i] = checkDependency(ctx, dependencies[i])
+		}(i)
+	}
+	wg.Wait()
+
+	for _, dep := range health.Dependencies {
+		switch {
+		case dep.Status == HealthStatusUp:
+		case !dep.Optional:
+			health.Status = HealthStatusDown
+		case health.Status == HealthStatusUp:
+			health.Status = HealthStatusDegraded
+		}
+	}
+	return health
+}
+
+func checkDependency(ctx context.Context, dep Dependency) DependencyHealth {
+	ctx, cancel := context.WithTimeout(ctx, healthCheckTimeout)
+	defer cancel()
+
+	started := time.Now()
+	err := dep.Check(ctx)
+	health := DependencyHealth{
+		Name:     dep.Name,
+		Type:     dep.Type,
+		Status:   HealthStatusUp,
+		Optional: dep.Optional,
+		Latency:  time.Since(started).Round(time.Millisecond).String(),
+	}
+	if err != nil {
+		health.Status = HealthStatusDown
+		health.Message = err.Error()
+	}
+	return health
+}
+
+// backendDependencies returns the dependencies of configured backends
+func backendDependencies(backends []BackendConfig) []Dependency {
+	var dependencies []Dependency
+	for _, backend := range backends {
+		backend := backend
+		dependencies = append(dependencies, Dependency{
+			Name:     backend.Name,
+			Type:     backend.Type,
+			Optional: backend.Optional,
+			Check: func(ctx context.Context) error {
+				return checkBackend(backend)
+			},
+		})
+	}
+	return dependencies
+}
+
+// documentsDependency checks that the store of /documents responds, validation doesn't need it
+func documentsDependency(documents storage.Store) Dependency {
+	return Dependency{
+		Name:     "documents",
+		Type:     BackendTypeStorage,
+		Optional: true,
+		Check: func(ctx context.Context) error {
+			_, err := documents.Get(ctx, healthProbeID)
+			if errors.Is(err, storage.ErrNotFound) {
+				return nil
+			}
+			return err
+		},
+	}
+}
+
+// signingDependency checks that the signing key signs, responses of /validator fail without it
+func signingDependency(signer *responseSigner) Dependency {
+	return Dependency{
+		Name: "signing",
+		Type: DependencyTypeKeyStore,
+		Check: func(ctx context.Context) error {
+			_, err := signer.Sign([]byte(healthProbeID))
+			return err
+		},
+	}
+}
+
+// ready - status of the dependencies, 503 Service Unavailable when the service is down
+func (h handlers) ready(w http.ResponseWriter, r *http.Request) {
+	health := CheckHealth(r.Context(), h.dependencies)
+	code := http.StatusOK
+	if health.Status == HealthStatusDown {
+		code = http.StatusServiceUnavailable
+	}
+	h.outputData(w, r, code, health)
+}
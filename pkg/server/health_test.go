@@ -0,0 +1,75 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package server_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/moov-io/iso20022/pkg/server"
+)
+
+func TestCheckHealth(t *testing.T) {
+	up := func(context.Context) error { return nil }
+	down := func(context.Context) error { return errors.New("connection refused") }
+
+	health := server.CheckHealth(context.Background(), []server.Dependency{
+		{Name: "archive", Type: "storage", Check: up},
+		{Name: "cache", Type: "redis", Optional: true, Check: down},
+	})
+	require.Equal(t, server.HealthStatusDegraded, health.Status)
+	require.Equal(t, server.HealthStatusUp, health.Dependencies[0].Status)
+	require.Equal(t, "connection refused", health.Dependencies[1].Message)
+
+	health = server.CheckHealth(context.Background(), []server.Dependency{
+		{Name: "cache", Type: "redis", Optional: true, Check: down},
+		{Name: "queue", Type: "kafka", Check: down},
+	})
+	require.Equal(t, server.HealthStatusDown, health.Status)
+
+	require.Equal(t, server.HealthStatusUp, server.CheckHealth(context.Background(), nil).Status)
+}
+
+func getHealth(t *testing.T, backends []server.BackendConfig) (int, server.Health) {
+	env, err := server.NewEnvironment(&server.Environment{Config: &server.Config{Backends: backends}})
+	require.Nil(t, err)
+	t.Cleanup(env.Shutdown)
+	ts := httptest.NewServer(env.PublicRouter)
+	t.Cleanup(ts.Close)
+
+	resp, err := http.Get(ts.URL + "/ready")
+	require.Nil(t, err)
+	defer resp.Body.Close()
+	var health server.Health
+	require.Nil(t, json.NewDecoder(resp.Body).Decode(&health))
+	return resp.StatusCode, health
+}
+
+func TestReady(t *testing.T) {
+	archive := server.BackendConfig{Name: "archive", Type: server.BackendTypeStorage, Addresses: []string{"file://" + t.TempDir()}}
+	cache := server.BackendConfig{Name: "cache", Type: server.BackendTypeRedis, Addresses: []string{"127.0.0.1:1"}, Optional: true}
+
+	code, health := getHealth(t, []server.BackendConfig{archive})
+	require.Equal(t, http.StatusOK, code)
+	require.Equal(t, server.HealthStatusUp, health.Status)
+	require.Equal(t, []string{"documents", "archive"}, []string{health.Dependencies[0].Name, health.Dependencies[1].Name})
+
+	// validation still works without optional backends
+	code, health = getHealth(t, []server.BackendConfig{archive, cache})
+	require.Equal(t, http.StatusOK, code)
+	require.Equal(t, server.HealthStatusDegraded, health.Status)
+	require.Equal(t, server.HealthStatusDown, health.Dependencies[2].Status)
+
+	cache.Optional = false
+	code, health = getHealth(t, []server.BackendConfig{archive, cache})
+	require.Equal(t, http.StatusServiceUnavailable, code)
+	require.Equal(t, server.HealthStatusDown, health.Status)
+}
@@ -0,0 +1,187 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package server
+
+import (
+	"bytes"
+	"net/http"
+	"sync"
+
+	"github.com/moov-io/iso20022/pkg/document"
+	"github.com/moov-io/iso20022/pkg/features"
+	"github.com/moov-io/iso20022/pkg/service"
+	"github.com/moov-io/iso20022/pkg/utils"
+)
+
+// HookStage is the point of handling a message where hooks are called
+type HookStage string
+
+const (
+	// HookPreParse hooks are called with the raw input of /validator, /print and /convert before it's parsed
+	HookPreParse HookStage = "pre-parse"
+
+	// HookPostValidate hooks are called after /validator validated the document
+	HookPostValidate HookStage = "post-validate"
+
+	// HookPreRespond hooks are called before /validator, /print and /convert respond
+	HookPreRespond HookStage = "pre-respond"
+
+	// AnyMessageType registers a hook called for every message type
+	AnyMessageType = "*"
+)
+
+// HookContext is the message handled by a request, hooks may modify it
+type HookContext struct {
+	Request *http.Request
+
+	// MessageType is the type of the input, e.g. pacs.008.001.08, empty when it's unknown. It's
+	// the type of the document of business message envelopes.
+	MessageType string
+
+	// Input is the raw input, pre-parse hooks may replace it
+	Input []byte
+
+	// Document is the parsed input, nil for pre-parse hooks. Business message envelopes are
+	// a *document.Envelope.
+	Document document.Iso20022Document
+
+	// Err is the validation error of /validator, post-validate hooks may set or clear it
+	Err error
+
+	// Header are the headers of the response
+	Header http.Header
+
+	// Warnings are reported by the response and its Warning headers, e.g. the deprecation of the message version
+	Warnings []string
+}
+
+// HookFunc is called with the message handled by a request, returning an error rejects
+// the request with 422 Unprocessable Entity and the error
+type HookFunc func(*HookContext) error
+
+var (
+	hooksMu sync.RWMutex
+	hooks   = make(map[HookStage]map[string][]HookFunc)
+)
+
+// RegisterHook adds fn to the hooks of stage for messageType, AnyMessageType hooks are
+// called before the hooks of a message type, hooks in the order of their registration
+func RegisterHook(stage HookStage, messageType string, fn HookFunc) {
+	hooksMu.Lock()
+	defer hooksMu.Unlock()
+	if hooks[stage] == nil {
+		hooks[stage] = make(map[string][]HookFunc)
+	}
+	hooks[stage][messageType] = append(hooks[stage][messageType], fn)
+}
+
+// ResetHooks removes every registered hook
+func ResetHooks() {
+	hooksMu.Lock()
+	defer hooksMu.Unlock()
+	hooks = make(map[HookStage]map[string][]HookFunc)
+}
+
+func hooksFor(stage HookStage, messageType string) []HookFunc {
+	hooksMu.RLock()
+	defer hooksMu.RUnlock()
+	fns := append([]HookFunc(nil), hooks[stage][AnyMessageType]...)
+	if messageType != "" && messageType != AnyMessageType {
+		fns = append(fns, hooks[stage][messageType]...)
+	}
+	return fns
+}
+
+// hooksRegistered reports whether hooks of stage are registered for any message type
+func hooksRegistered(stage HookStage) bool {
+	hooksMu.RLock()
+	defer hooksMu.RUnlock()
+	for _, fns := range hooks[stage] {
+		if len(fns) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func newHookContext(w http.ResponseWriter, r *http.Request, input []byte) *HookContext {
+	c := &HookContext{Request: r, Input: input, Header: w.Header()}
+	if _, doc, err := document.SplitEnvelope(input); err == nil {
+		input = doc
+	}
+	if namespace, err := document.DetectNameSpace(input); err == nil {
+		c.MessageType = utils.GetMessageType(namespace)
+	}
+	return c
+}
+
+// run calls the hooks of stage until one of them rejects the message
+func (c *HookContext) run(stage HookStage) error {
+	for _, fn := range hooksFor(stage, c.MessageType) {
+		if err := fn(c); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// parseInput reads and parses the input of r around the pre-parse hooks, it responds
+// with the error and returns false when the input is rejected or invalid. opts configure parsing.
+func (h handlers) parseInput(w http.ResponseWriter, r *http.Request, opts ...service.Option) (*HookContext, bool) {
+	input, err := readInputFromRequest(r)
+	if err != nil {
+		h.outputError(w, r, http.StatusBadRequest, err)
+		return nil, false
+	}
+
+	noteStatsSample(r, "", len(input))
+
+	c := newHookContext(w, r, input)
+	if err = c.run(HookPreParse); err != nil {
+		h.outputError(w, r, http.StatusUnprocessableEntity, err)
+		return nil, false
+	}
+
+	if !h.injectFault(w, r, ChaosStageParse) {
+		return nil, false
+	}
+	var lenient []service.Option
+	if features.Enabled(r.Context(), features.LenientNamespaces) {
+		lenient = append(lenient, service.WithLenientNamespaces())
+	}
+	c.Document, err = service.Parse(bytes.NewReader(c.Input), append(lenient, opts...)...)
+	if err != nil {
+		h.outputError(w, r, http.StatusBadRequest, err)
+		return nil, false
+	}
+	if err = checkExperimentalVersion(r, c.Document.NameSpace()); err != nil {
+		h.outputError(w, r, http.StatusBadRequest, err)
+		return nil, false
+	}
+	noteStatsSample(r, utils.GetMessageType(c.Document.NameSpace()), len(c.Input))
+	noteDetection(r, c.Input, c.Document)
+	if len(h.largeValues) > 0 {
+		transactions := h.largeValues.Transactions(c.Document)
+		noteLargeValues(r, transactions)
+		noteRules(r, largeValueRuleHits(transactions)...)
+	}
+	h.masker.Learn(h.masker.Values(c.Document)...)
+	if warning := document.SupportLevel(c.Document.NameSpace()).Warning(); warning != "" {
+		c.Warnings = append(c.Warnings, warning)
+	}
+
+	// opts may convert the document, the upload is the input as it is
+	uploaded := c.Document
+	if len(opts) > 0 && h.fingerprints && r.Header.Get(FingerprintHeader) != "" {
+		if uploaded, err = service.Parse(bytes.NewReader(c.Input), lenient...); err != nil {
+			h.outputError(w, r, http.StatusBadRequest, err)
+			return nil, false
+		}
+	}
+	if !h.fingerprint(w, r, uploaded, c.Document) {
+		return nil, false
+	}
+	return c, true
+}
@@ -0,0 +1,101 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package server_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/moov-io/iso20022/pkg/iso20022test/fixtures"
+	"github.com/moov-io/iso20022/pkg/server"
+)
+
+func TestHooks(t *testing.T) {
+	t.Cleanup(server.ResetHooks)
+	ts := newJobServer(t)
+	input := fixtures.ReadFile(t, "valid_pacs_v08.xml")
+
+	var stages []string
+	record := func(stage server.HookStage) server.HookFunc {
+		return func(c *server.HookContext) error {
+			stages = append(stages, string(stage)+":"+c.MessageType)
+			return nil
+		}
+	}
+	for _, stage := range []server.HookStage{server.HookPreParse, server.HookPostValidate, server.HookPreRespond} {
+		server.RegisterHook(stage, "pacs.008.001.08", record(stage))
+		server.RegisterHook(stage, "pain.001.001.11", func(*server.HookContext) error {
+			t.Error("hook of another message type called")
+			return nil
+		})
+	}
+	server.RegisterHook(server.HookPreRespond, server.AnyMessageType, func(c *server.HookContext) error {
+		c.Header.Set("X-Enriched", "true")
+		return nil
+	})
+
+	resp := postForm(t, ts.URL+"/validator", input, nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, "true", resp.Header.Get("X-Enriched"))
+	require.Equal(t, []string{
+		"pre-parse:pacs.008.001.08",
+		"post-validate:pacs.008.001.08",
+		"pre-respond:pacs.008.001.08",
+	}, stages)
+
+	stages = nil
+	resp = postForm(t, ts.URL+"/convert", input, map[string]string{"format": "json"})
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, []string{"pre-parse:pacs.008.001.08", "pre-respond:pacs.008.001.08"}, stages)
+}
+
+func TestHooksReject(t *testing.T) {
+	t.Cleanup(server.ResetHooks)
+	ts := newJobServer(t)
+	input := fixtures.ReadFile(t, "valid_pacs_v08.xml")
+
+	server.RegisterHook(server.HookPostValidate, "pacs.008.001.08", func(c *server.HookContext) error {
+		require.Nil(t, c.Err)
+		require.NotNil(t, c.Document)
+		return errors.New("rejected by hook")
+	})
+
+	resp := postForm(t, ts.URL+"/validator", input, nil)
+	require.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
+
+	var body map[string]string
+	require.Nil(t, json.NewDecoder(resp.Body).Decode(&body))
+	require.Equal(t, "rejected by hook", body["error"])
+}
+
+func TestHooksOverride(t *testing.T) {
+	t.Cleanup(server.ResetHooks)
+	ts := newJobServer(t)
+	input := fixtures.ReadFile(t, "valid_pacs_v08.xml")
+
+	server.RegisterHook(server.HookPreParse, server.AnyMessageType, func(c *server.HookContext) error {
+		c.Input = bytes.ReplaceAll(c.Input, []byte("pacs.008.001.08"), []byte("pacs.008.001.99"))
+		return nil
+	})
+	resp := postForm(t, ts.URL+"/print", input, nil)
+	require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+	server.ResetHooks()
+	server.RegisterHook(server.HookPostValidate, server.AnyMessageType, func(c *server.HookContext) error {
+		c.Err = errors.New("invalid by hook")
+		return nil
+	})
+	resp = postForm(t, ts.URL+"/validator", input, nil)
+	require.Equal(t, http.StatusNotImplemented, resp.StatusCode)
+	body, err := io.ReadAll(resp.Body)
+	require.Nil(t, err)
+	require.Contains(t, string(body), "invalid by hook")
+}
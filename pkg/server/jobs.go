@@ -0,0 +1,509 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package server
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"github.com/moov-io/iso20022/pkg/document"
+	"github.com/moov-io/iso20022/pkg/profile"
+	"github.com/moov-io/iso20022/pkg/utils"
+)
+
+const (
+	JobOperationValidate = "validate"
+	JobOperationConvert  = "convert"
+	JobOperationExport   = "export"
+
+	JobStatusPending   = "pending"
+	JobStatusRunning   = "running"
+	JobStatusCompleted = "completed"
+	JobStatusFailed    = "failed"
+
+	// jobRetention is how long finished jobs are kept for polling their results
+	jobRetention = time.Hour
+)
+
+var (
+	ErrJobNotFound    = errors.New("job not found")
+	ErrJobNotFinished = errors.New("job is not finished")
+)
+
+// JobProgress is the progress of a job, it's updated while the input is validated
+type JobProgress struct {
+	Bytes        int64    `json:"bytes"`
+	TotalBytes   int64    `json:"totalBytes"`
+	Transactions int      `json:"transactions"`
+	Invalid      int      `json:"invalid"`
+	Errors       []string `json:"errors,omitempty"`
+	Warnings     []string `json:"warnings,omitempty"`
+}
+
+// Job is a validation or conversion running in the background
+type Job struct {
+	ID        string      `json:"id"`
+	Operation string      `json:"operation"`
+	Profile   string      `json:"profile,omitempty"`
+	AsOf      *time.Time  `json:"asOf,omitempty"`
+	Status    string      `json:"status"`
+	Valid     *bool       `json:"valid,omitempty"`
+	Error     string      `json:"error,omitempty"`
+	Progress  JobProgress `json:"progress"`
+	Created   time.Time   `json:"created"`
+
+	// Files are the paths of the files written by export jobs, relative to the export directory
+	Files []string `json:"files,omitempty"`
+
+	format utils.DocumentType
+	result []byte
+}
+
+// Finished reports whether the job completed or failed
+func (j Job) Finished() bool {
+	return j.Status == JobStatusCompleted || j.Status == JobStatusFailed
+}
+
+func (j Job) snapshot() Job {
+	j.Progress.Errors = append([]string(nil), j.Progress.Errors...)
+	j.Progress.Warnings = append([]string(nil), j.Progress.Warnings...)
+	j.Files = append([]string(nil), j.Files...)
+	return j
+}
+
+// jobStore keeps the jobs of a server, subscribers are notified on every update
+type jobStore struct {
+	mu          sync.Mutex
+	jobs        map[string]*Job
+	subscribers map[string][]chan struct{}
+}
+
+func newJobStore() *jobStore {
+	return &jobStore{
+		jobs:        make(map[string]*Job),
+		subscribers: make(map[string][]chan struct{}),
+	}
+}
+
+func (s *jobStore) create(operation string, format utils.DocumentType, profileName string, asOf time.Time) Job {
+	job := &Job{
+		ID:        newId(),
+		Operation: operation,
+		Profile:   profileName,
+		Status:    JobStatusPending,
+		Created:   time.Now(),
+		format:    format,
+	}
+	if !asOf.IsZero() {
+		job.AsOf = &asOf
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.jobs[job.ID] = job
+	return job.snapshot()
+}
+
+func (s *jobStore) get(id string) (Job, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	job, ok := s.jobs[id]
+	if !ok {
+		return Job{}, ErrJobNotFound
+	}
+	return job.snapshot(), nil
+}
+
+// update applies fn to the job with id and notifies its subscribers
+func (s *jobStore) update(id string, fn func(job *Job)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	job, ok := s.jobs[id]
+	if !ok {
+		return
+	}
+	fn(job)
+	for _, ch := range s.subscribers[id] {
+		select {
+		case ch <- struct{}{}:
+		default:
+		}
+	}
+	if job.Finished() {
+		time.AfterFunc(jobRetention, func() { s.remove(id) })
+	}
+}
+
+// pending returns the jobs that haven't finished, in order of creation
+func (s *jobStore) pending() []Job {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var jobs []Job
+	for _, job := range s.jobs {
+		if !job.Finished() {
+			jobs = append(jobs, job.snapshot())
+		}
+	}
+	sort.Slice(jobs, func(i, j int) bool { return jobs[i].Created.Before(jobs[j].Created) })
+	return jobs
+}
+
+func (s *jobStore) remove(id string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.jobs, id)
+}
+
+// subscribe returns a channel receiving a value whenever the job with id is updated
+func (s *jobStore) subscribe(id string) (<-chan struct{}, func()) {
+	ch := make(chan struct{}, 1)
+
+	s.mu.Lock()
+	s.subscribers[id] = append(s.subscribers[id], ch)
+	s.mu.Unlock()
+
+	return ch, func() {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		subscribers := s.subscribers[id]
+		for i := range subscribers {
+			if subscribers[i] == ch {
+				s.subscribers[id] = append(subscribers[:i], subscribers[i+1:]...)
+				break
+			}
+		}
+		if len(s.subscribers[id]) == 0 {
+			delete(s.subscribers, id)
+		}
+	}
+}
+
+// run validates and converts the input spooled at path for the job with id, the file is removed
+// once the job is finished
+//
+// Xml documents are validated as a stream so the progress reports bytes and transactions
+// while the document is read from the file, json documents only report progress once they're
+// parsed. Both are parsed whole afterwards for the profile and the conversion.
+func (s *jobStore) run(id, path string, isXml bool) {
+	defer os.Remove(path)
+	job, err := s.get(id)
+	if err != nil {
+		return
+	}
+
+	fail := func(err error) {
+		s.update(id, func(job *Job) {
+			job.Status = JobStatusFailed
+			job.Error = err.Error()
+		})
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		fail(err)
+		return
+	}
+	defer file.Close()
+	info, err := file.Stat()
+	if err != nil {
+		fail(err)
+		return
+	}
+	s.update(id, func(job *Job) {
+		job.Status = JobStatusRunning
+		job.Progress.TotalBytes = info.Size()
+	})
+
+	if isXml {
+		err = document.ValidateStream(bufio.NewReader(file), func(event document.StreamEvent) {
+			s.update(id, func(job *Job) {
+				job.Progress.Bytes = event.Bytes
+				if event.Type != document.StreamEventElement {
+					job.Progress.Transactions = event.Transactions
+					job.Progress.Invalid = event.Invalid
+				}
+				if event.Error != "" {
+					job.Progress.Errors = append(job.Progress.Errors, fmt.Sprintf("%s: %s", event.Path, event.Error))
+				}
+			})
+		})
+		if err != nil {
+			fail(err)
+			return
+		}
+	}
+
+	if _, err = file.Seek(0, io.SeekStart); err != nil {
+		fail(err)
+		return
+	}
+	input, err := io.ReadAll(file)
+	if err != nil {
+		fail(err)
+		return
+	}
+	doc, err := document.ParseIso20022Document(input)
+	if err != nil {
+		fail(err)
+		return
+	}
+
+	var (
+		errs     []string
+		warnings []string
+	)
+	if err := doc.Validate(); err != nil {
+		errs = append(errs, err.Error())
+	}
+	if warning := document.SupportLevel(doc.NameSpace()).Warning(); warning != "" {
+		warnings = append(warnings, warning)
+	}
+	if job.Profile != "" {
+		var result *profile.Result
+		if job.AsOf != nil {
+			result, err = profile.ValidateAsOf(job.Profile, doc, *job.AsOf)
+		} else {
+			var p *profile.Profile
+			if p, err = profile.Get(job.Profile); err == nil {
+				result = p.Validate(doc)
+			}
+		}
+		if err != nil {
+			fail(err)
+			return
+		}
+		for _, finding := range result.Errors() {
+			errs = append(errs, finding.Error())
+		}
+		for _, finding := range result.Warnings() {
+			warnings = append(warnings, finding.Error())
+		}
+	}
+
+	var output []byte
+	if job.Operation == JobOperationConvert {
+		if output, err = messageToBuf(job.format, doc); err != nil {
+			fail(err)
+			return
+		}
+	}
+
+	s.update(id, func(job *Job) {
+		valid := job.Progress.Invalid == 0 && len(errs) == 0
+		job.Valid = &valid
+		job.Status = JobStatusCompleted
+		job.Progress.Bytes = job.Progress.TotalBytes
+		for _, msg := range errs {
+			if !containsString(job.Progress.Errors, msg) {
+				job.Progress.Errors = append(job.Progress.Errors, msg)
+			}
+		}
+		job.Progress.Warnings = append(job.Progress.Warnings, warnings...)
+		job.result = output
+	})
+}
+
+// spoolJobInput writes the upload read from r to a temporary file, jobs read it once the request
+// is done and the multipart form removed its own files
+func spoolJobInput(r io.Reader) (string, error) {
+	file, err := os.CreateTemp("", "iso20022-job-*")
+	if err != nil {
+		return "", err
+	}
+	_, err = io.Copy(file, r)
+	if cerr := file.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		os.Remove(file.Name())
+		return "", err
+	}
+	return file.Name(), nil
+}
+
+func containsString(list []string, value string) bool {
+	for _, item := range list {
+		if item == value {
+			return true
+		}
+	}
+	return false
+}
+
+func (h handlers) outputJob(w http.ResponseWriter, r *http.Request, code int, job Job) {
+	h.outputData(w, r, code, job, job.Progress.Warnings...)
+}
+
+// createJob - start validating or converting the file, or exporting the stored documents, in the background
+func (h handlers) createJob(w http.ResponseWriter, r *http.Request) {
+	operation := r.FormValue("operation")
+	if operation == JobOperationExport {
+		h.createExportJob(w, r)
+		return
+	}
+
+	input, size, err := openInputFromRequest(r)
+	if err != nil {
+		h.outputError(w, r, http.StatusBadRequest, err)
+		return
+	}
+	defer input.Close()
+	noteStatsSample(r, "", int(size))
+
+	if operation == "" {
+		operation = JobOperationValidate
+	}
+
+	var format utils.DocumentType
+	switch operation {
+	case JobOperationValidate:
+	case JobOperationConvert:
+		if format, err = getFormat(r); err != nil {
+			h.outputError(w, r, http.StatusNotImplemented, err)
+			return
+		}
+	default:
+		h.outputError(w, r, http.StatusBadRequest, fmt.Errorf("invalid operation %s", operation))
+		return
+	}
+
+	asOf, err := getAsOf(r)
+	if err != nil {
+		h.outputError(w, r, http.StatusBadRequest, err)
+		return
+	}
+	profileName := r.FormValue("profile")
+	if profileName != "" {
+		p, err := getProfile(profileName, asOf)
+		if err == nil {
+			err = checkExperimentalProfile(r, p)
+		}
+		if err != nil {
+			h.outputError(w, r, http.StatusBadRequest, err)
+			return
+		}
+	}
+
+	// xml uploads aren't read into memory, their namespace is the one of the root element
+	br := bufio.NewReaderSize(input, sniffSize)
+	root, isXml := sniffXmlRoot(br)
+	path, err := spoolJobInput(br)
+	if err != nil {
+		h.outputError(w, r, http.StatusInternalServerError, err)
+		return
+	}
+	namespace := root.Name.Space
+	if !isXml {
+		if buf, err := os.ReadFile(path); err == nil {
+			namespace, _ = document.DetectNameSpace(buf)
+		}
+	}
+	if err = checkExperimentalVersion(r, namespace); err != nil {
+		os.Remove(path)
+		h.outputError(w, r, http.StatusBadRequest, err)
+		return
+	}
+
+	job := h.jobs.create(operation, format, profileName, asOf)
+	go h.jobs.run(job.ID, path, isXml)
+
+	h.outputJob(w, r, http.StatusAccepted, job)
+}
+
+// job - status and progress of a job
+func (h handlers) job(w http.ResponseWriter, r *http.Request) {
+	job, err := h.jobs.get(mux.Vars(r)["id"])
+	if err != nil {
+		h.outputError(w, r, http.StatusNotFound, err)
+		return
+	}
+	h.outputJob(w, r, http.StatusOK, job)
+}
+
+// jobResult - converted file of a finished job, the job itself for validations
+func (h handlers) jobResult(w http.ResponseWriter, r *http.Request) {
+	job, err := h.jobs.get(mux.Vars(r)["id"])
+	if err != nil {
+		h.outputError(w, r, http.StatusNotFound, err)
+		return
+	}
+
+	switch {
+	case !job.Finished():
+		h.outputError(w, r, http.StatusConflict, ErrJobNotFinished)
+	case job.Status == JobStatusFailed:
+		h.outputError(w, r, http.StatusUnprocessableEntity, errors.New(job.Error))
+	case job.Operation == JobOperationConvert:
+		h.outputFile(w, r, "converted_file", job.format, job.result)
+	default:
+		h.outputJob(w, r, http.StatusOK, job)
+	}
+}
+
+// jobEvents - stream progress of a job as server-sent events
+//
+// A progress event with the job is sent on connect and on every update, a done event
+// with the finished job is sent last and the stream is closed.
+func (h handlers) jobEvents(w http.ResponseWriter, r *http.Request) {
+	id := mux.Vars(r)["id"]
+	if _, err := h.jobs.get(id); err != nil {
+		h.outputError(w, r, http.StatusNotFound, err)
+		return
+	}
+
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		h.outputError(w, r, http.StatusNotImplemented, errors.New("streaming is not supported"))
+		return
+	}
+
+	updates, unsubscribe := h.jobs.subscribe(id)
+	defer unsubscribe()
+
+	// the stream lasts as long as the job, not the write timeout of the server
+	http.NewResponseController(w).SetWriteDeadline(time.Time{})
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+
+	for {
+		job, err := h.jobs.get(id)
+		if err != nil {
+			return
+		}
+
+		event := "progress"
+		if job.Finished() {
+			event = "done"
+		}
+		data, err := json.Marshal(job)
+		if err != nil {
+			return
+		}
+		fmt.Fprintf(w, "event: %s\ndata: %s\n\n", event, data)
+		flusher.Flush()
+
+		if job.Finished() {
+			return
+		}
+		select {
+		case <-updates:
+		case <-r.Context().Done():
+			return
+		}
+	}
+}
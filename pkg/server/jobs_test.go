@@ -0,0 +1,263 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package server_test
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/require"
+
+	"github.com/moov-io/iso20022/pkg/iso20022test/fixtures"
+	"github.com/moov-io/iso20022/pkg/profile"
+	"github.com/moov-io/iso20022/pkg/server"
+)
+
+type jobEvent struct {
+	name string
+	job  server.Job
+}
+
+func newJobServer(t *testing.T) *httptest.Server {
+	router := mux.NewRouter()
+	require.Nil(t, server.ConfigureHandlers(router))
+	ts := httptest.NewServer(router)
+	t.Cleanup(ts.Close)
+	return ts
+}
+
+func postJob(t *testing.T, ts *httptest.Server, input []byte, fields map[string]string) *http.Response {
+	return postForm(t, ts.URL+"/jobs", input, fields)
+}
+
+func postForm(t *testing.T, url string, input []byte, fields map[string]string) *http.Response {
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	part, err := writer.CreateFormFile("input", "input")
+	require.Nil(t, err)
+	_, err = part.Write(input)
+	require.Nil(t, err)
+	for key, value := range fields {
+		require.Nil(t, writer.WriteField(key, value))
+	}
+	require.Nil(t, writer.Close())
+
+	resp, err := http.Post(url, writer.FormDataContentType(), body)
+	require.Nil(t, err)
+	t.Cleanup(func() { resp.Body.Close() })
+	return resp
+}
+
+func startJob(t *testing.T, ts *httptest.Server, input []byte, fields map[string]string) server.Job {
+	resp := postJob(t, ts, input, fields)
+	require.Equal(t, http.StatusAccepted, resp.StatusCode)
+
+	var job server.Job
+	require.Nil(t, json.NewDecoder(resp.Body).Decode(&job))
+	require.NotEmpty(t, job.ID)
+	return job
+}
+
+func readJobEvents(t *testing.T, ts *httptest.Server, id string) []jobEvent {
+	resp, err := http.Get(ts.URL + "/jobs/" + id + "/events")
+	require.Nil(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+
+	var (
+		events []jobEvent
+		name   string
+	)
+	scanner := bufio.NewScanner(resp.Body)
+	for scanner.Scan() {
+		line := scanner.Text()
+		switch {
+		case strings.HasPrefix(line, "event: "):
+			name = strings.TrimPrefix(line, "event: ")
+		case strings.HasPrefix(line, "data: "):
+			event := jobEvent{name: name}
+			require.Nil(t, json.Unmarshal([]byte(strings.TrimPrefix(line, "data: ")), &event.job))
+			events = append(events, event)
+		}
+	}
+	return events
+}
+
+func TestJobEvents(t *testing.T) {
+	ts := newJobServer(t)
+	input := fixtures.ReadFile(t, "valid_pacs_v08.xml")
+
+	job := startJob(t, ts, input, nil)
+	require.Equal(t, server.JobOperationValidate, job.Operation)
+
+	events := readJobEvents(t, ts, job.ID)
+	require.NotEmpty(t, events)
+	for _, event := range events[:len(events)-1] {
+		require.Equal(t, "progress", event.name)
+	}
+
+	done := events[len(events)-1]
+	require.Equal(t, "done", done.name)
+	require.Equal(t, server.JobStatusCompleted, done.job.Status)
+	require.True(t, *done.job.Valid)
+	require.Equal(t, 2, done.job.Progress.Transactions)
+	require.Equal(t, int64(len(input)), done.job.Progress.Bytes)
+	require.Equal(t, int64(len(input)), done.job.Progress.TotalBytes)
+
+	// finished jobs respond with the done event only
+	events = readJobEvents(t, ts, job.ID)
+	require.Len(t, events, 1)
+	require.Equal(t, "done", events[0].name)
+}
+
+func TestJobEventsPastWriteTimeout(t *testing.T) {
+	router := mux.NewRouter()
+	require.Nil(t, server.ConfigureHandlersWithOptions(router, server.APIConfig{Receipts: true}))
+
+	// the events are written once the write timeout of the server elapsed
+	timeout := 100 * time.Millisecond
+	ts := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "/events") {
+			time.Sleep(2 * timeout)
+		}
+		router.ServeHTTP(w, r)
+	}))
+	ts.Config.WriteTimeout = timeout
+	ts.Start()
+	t.Cleanup(ts.Close)
+
+	job := startJob(t, ts, fixtures.ReadFile(t, "valid_pacs_v08.xml"), nil)
+	events := readJobEvents(t, ts, job.ID)
+	require.NotEmpty(t, events)
+	require.Equal(t, "done", events[len(events)-1].name)
+}
+
+func TestJobEventsWithInvalidData(t *testing.T) {
+	ts := newJobServer(t)
+	input := strings.Replace(string(fixtures.ReadFile(t, "valid_pacs_v08.xml")), `<IntrBkSttlmAmt Ccy="USD">500.75`, `<IntrBkSttlmAmt Ccy="usd">500.75`, 1)
+
+	job := startJob(t, ts, []byte(input), nil)
+	events := readJobEvents(t, ts, job.ID)
+
+	done := events[len(events)-1]
+	require.Equal(t, server.JobStatusCompleted, done.job.Status)
+	require.False(t, *done.job.Valid)
+	require.Equal(t, 1, done.job.Progress.Invalid)
+	require.NotEmpty(t, done.job.Progress.Errors)
+	require.True(t, strings.HasPrefix(done.job.Progress.Errors[0], "CdtTrfTxInf[1]: "))
+
+	job = startJob(t, ts, []byte("<Document><Unknown"), nil)
+	events = readJobEvents(t, ts, job.ID)
+	done = events[len(events)-1]
+	require.Equal(t, server.JobStatusFailed, done.job.Status)
+	require.NotEmpty(t, done.job.Error)
+
+	resp, err := http.Get(ts.URL + "/jobs/" + job.ID + "/result")
+	require.Nil(t, err)
+	resp.Body.Close()
+	require.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
+}
+
+func TestJobConvert(t *testing.T) {
+	ts := newJobServer(t)
+
+	job := startJob(t, ts, fixtures.ReadFile(t, "valid_pacs_v08.xml"), map[string]string{
+		"operation": server.JobOperationConvert,
+		"format":    "json",
+	})
+	events := readJobEvents(t, ts, job.ID)
+	require.Equal(t, server.JobStatusCompleted, events[len(events)-1].job.Status)
+
+	resp, err := http.Get(ts.URL + "/jobs/" + job.ID + "/result")
+	require.Nil(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, "application/octet-stream", resp.Header.Get("Content-Type"))
+
+	var output map[string]interface{}
+	require.Nil(t, json.NewDecoder(resp.Body).Decode(&output))
+	require.NotEmpty(t, output)
+}
+
+func TestJobWithInvalidRequest(t *testing.T) {
+	ts := newJobServer(t)
+	input := fixtures.ReadFile(t, "valid_pacs_v08.xml")
+
+	resp := postJob(t, ts, input, map[string]string{"operation": "unknown"})
+	require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+	resp = postJob(t, ts, input, map[string]string{"profile": "unknown"})
+	require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+	resp = postJob(t, ts, input, map[string]string{"operation": server.JobOperationConvert, "format": "unknown"})
+	require.Equal(t, http.StatusNotImplemented, resp.StatusCode)
+
+	for _, path := range []string{"/jobs/unknown", "/jobs/unknown/result", "/jobs/unknown/events"} {
+		resp, err := http.Get(ts.URL + path)
+		require.Nil(t, err)
+		resp.Body.Close()
+		require.Equal(t, http.StatusNotFound, resp.StatusCode, path)
+	}
+}
+
+func TestJobWithEnvelope(t *testing.T) {
+	ts := newJobServer(t)
+	input := fixtures.ReadFile(t, "valid_pacs_v08.xml")
+
+	resp := postForm(t, ts.URL+"/v2/jobs", input, map[string]string{"profile": profile.SCTInstName})
+	require.Equal(t, http.StatusAccepted, resp.StatusCode)
+
+	var envelope struct {
+		Status string     `json:"status"`
+		Data   server.Job `json:"data"`
+	}
+	require.Nil(t, json.NewDecoder(resp.Body).Decode(&envelope))
+	require.Equal(t, server.EnvelopeStatusSuccess, envelope.Status)
+	require.Equal(t, profile.SCTInstName, envelope.Data.Profile)
+
+	// jobs are shared by every version
+	events := readJobEvents(t, ts, envelope.Data.ID)
+	done := events[len(events)-1]
+	require.Equal(t, server.JobStatusCompleted, done.job.Status)
+	require.False(t, *done.job.Valid)
+}
+
+func TestJobAsOf(t *testing.T) {
+	effective := time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC)
+	for _, p := range []*profile.Profile{
+		{Name: "Server-AsOf", Version: "1", Rules: []profile.Rule{{ID: "OLD-001", Check: func(in profile.Input) []profile.Finding {
+			return []profile.Finding{{Message: "rejected by the old rules"}}
+		}}}},
+		{Name: "Server-AsOf", Version: "2", Effective: effective},
+	} {
+		profile.Register(p)
+	}
+	t.Cleanup(func() { profile.Unregister("Server-AsOf") })
+
+	ts := newJobServer(t)
+	input := fixtures.ReadFile(t, "valid_pacs_v08.xml")
+	for asOf, valid := range map[string]bool{"2021-05-31": false, "2021-06-01T09:30:00Z": true} {
+		job := startJob(t, ts, input, map[string]string{"profile": "Server-AsOf", "asOf": asOf})
+		require.NotNil(t, job.AsOf)
+		events := readJobEvents(t, ts, job.ID)
+		done := events[len(events)-1]
+		require.Equal(t, server.JobStatusCompleted, done.job.Status, asOf)
+		require.Equal(t, valid, *done.job.Valid, asOf)
+	}
+
+	resp := postJob(t, ts, input, map[string]string{"profile": "Server-AsOf", "asOf": "yesterday"})
+	require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	resp = postJob(t, ts, input, map[string]string{"profile": profile.SCTInstName, "asOf": "2021-05-31"})
+	require.Equal(t, http.StatusAccepted, resp.StatusCode)
+}
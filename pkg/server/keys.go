@@ -0,0 +1,60 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package server
+
+import (
+	"crypto"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// ErrUnknownKeyProvider is returned when no provider is registered for the scheme of a key uri
+var ErrUnknownKeyProvider = errors.New("unknown key provider")
+
+// KeyProvider returns the signer of a key held outside of the service, e.g. in AWS KMS, GCP KMS
+// or a PKCS #11 HSM, identified by uri. The private key never leaves the provider, signers call
+// it to sign the digests of signatures.
+type KeyProvider func(uri string) (crypto.Signer, error)
+
+var (
+	keyProvidersMu sync.RWMutex
+	keyProviders   = make(map[string]KeyProvider)
+)
+
+// RegisterKeyProvider makes provider return the signers of the key uris with scheme, the part
+// of the uri before the first colon (e.g. awskms for awskms:///arn:aws:kms:...). Embedders
+// register the client of their KMS or HSM before creating their environment, the service
+// doesn't depend on their SDKs and registers no provider of its own.
+func RegisterKeyProvider(scheme string, provider KeyProvider) {
+	keyProvidersMu.Lock()
+	defer keyProvidersMu.Unlock()
+	keyProviders[scheme] = provider
+}
+
+// providedSigningKey returns the signer of uri from the provider of its scheme
+func providedSigningKey(uri string) (crypto.Signer, error) {
+	scheme, _, found := strings.Cut(uri, ":")
+	if !found || scheme == "" {
+		return nil, fmt.Errorf("signing key %q has no scheme", uri)
+	}
+
+	keyProvidersMu.RLock()
+	provider, exists := keyProviders[scheme]
+	keyProvidersMu.RUnlock()
+	if !exists {
+		return nil, fmt.Errorf("signing key %s: %w: %s", uri, ErrUnknownKeyProvider, scheme)
+	}
+
+	key, err := provider(uri)
+	if err != nil {
+		return nil, fmt.Errorf("signing key %s: %w", uri, err)
+	}
+	if key == nil {
+		return nil, fmt.Errorf("signing key %s: provider returned no key", uri)
+	}
+	return key, nil
+}
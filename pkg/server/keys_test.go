@@ -0,0 +1,88 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package server_test
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/require"
+
+	"github.com/moov-io/iso20022/pkg/iso20022test/fixtures"
+	"github.com/moov-io/iso20022/pkg/server"
+)
+
+// remoteSigner signs like a KMS client, its private key isn't reachable by the service
+type remoteSigner struct {
+	key   crypto.Signer
+	calls *int32
+}
+
+func (s remoteSigner) Public() crypto.PublicKey {
+	return s.key.Public()
+}
+
+func (s remoteSigner) Sign(rand io.Reader, digest []byte, opts crypto.SignerOpts) ([]byte, error) {
+	atomic.AddInt32(s.calls, 1)
+	return s.key.Sign(rand, digest, opts)
+}
+
+func TestKeyProviders(t *testing.T) {
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.Nil(t, err)
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.Nil(t, err)
+
+	var calls int32
+	keys := map[string]crypto.Signer{
+		"testkms://keys/ec":  ecKey,
+		"testkms://keys/rsa": rsaKey,
+	}
+	server.RegisterKeyProvider("testkms", func(uri string) (crypto.Signer, error) {
+		key, exists := keys[uri]
+		if !exists {
+			return nil, errors.New("key not found")
+		}
+		return remoteSigner{key: key, calls: &calls}, nil
+	})
+
+	for uri, key := range keys {
+		router := mux.NewRouter()
+		require.Nil(t, server.ConfigureHandlersWithOptions(router, server.APIConfig{Signing: server.SigningConfig{KeyURI: uri}}))
+		ts := httptest.NewServer(router)
+		t.Cleanup(ts.Close)
+
+		resp := postForm(t, ts.URL+"/validator", fixtures.ReadFile(t, "valid_pacs_v08.xml"), nil)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		body, err := io.ReadAll(resp.Body)
+		require.Nil(t, err)
+		_, err = server.VerifyResponseSignature(resp.Header.Get(server.SignatureHeader), body, key.Public())
+		require.Nil(t, err, uri)
+	}
+	require.Equal(t, int32(2), atomic.LoadInt32(&calls))
+
+	for uri, message := range map[string]string{
+		"testkms://keys/missing": "key not found",
+		"awskms:///arn:aws:kms":  "unknown key provider: awskms",
+		"keys/ec":                "has no scheme",
+	} {
+		err := server.ConfigureHandlersWithOptions(mux.NewRouter(), server.APIConfig{Signing: server.SigningConfig{KeyURI: uri}})
+		require.True(t, err != nil && strings.Contains(err.Error(), message), "%s: %v", uri, err)
+	}
+
+	err = server.ConfigureHandlersWithOptions(mux.NewRouter(), server.APIConfig{Signing: server.SigningConfig{KeyURI: "testkms://keys/ec", KeyFile: "signing.pem"}})
+	require.ErrorContains(t, err, "both a file and an uri")
+}
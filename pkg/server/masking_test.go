@@ -0,0 +1,47 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package server_test
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/require"
+
+	"github.com/moov-io/iso20022/pkg/iso20022test/fixtures"
+	"github.com/moov-io/iso20022/pkg/masking"
+	"github.com/moov-io/iso20022/pkg/server"
+)
+
+func TestMaskedErrors(t *testing.T) {
+	t.Cleanup(server.ResetHooks)
+	server.RegisterHook(server.HookPostValidate, "pacs.008.001.08", func(*server.HookContext) error {
+		return errors.New("sanctioned payment of John Smith from DE89370400440532013000")
+	})
+	input := fixtures.ReadFile(t, "valid_pacs_v08.xml")
+
+	router := mux.NewRouter()
+	require.Nil(t, server.ConfigureHandlersWithOptions(router, server.APIConfig{Masking: masking.Config{Enabled: true}}))
+	ts := httptest.NewServer(router)
+	t.Cleanup(ts.Close)
+
+	for _, endpoint := range []string{"/validator", "/v2/validator"} {
+		resp := postForm(t, ts.URL+endpoint, input, nil)
+		require.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
+		body, err := io.ReadAll(resp.Body)
+		require.Nil(t, err)
+		require.Contains(t, string(body), "sanctioned payment of ******mith from ******************3000")
+	}
+
+	// errors aren't masked unless configured
+	resp := postForm(t, newJobServer(t).URL+"/validator", input, nil)
+	body, err := io.ReadAll(resp.Body)
+	require.Nil(t, err)
+	require.Contains(t, string(body), "John Smith")
+}
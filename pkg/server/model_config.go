@@ -4,13 +4,387 @@
 
 package server
 
+import (
+	"time"
+
+	"github.com/moov-io/iso20022/pkg/corridor"
+	"github.com/moov-io/iso20022/pkg/masking"
+	"github.com/moov-io/iso20022/pkg/resilience"
+	"github.com/moov-io/iso20022/pkg/secrets"
+	"github.com/moov-io/iso20022/pkg/storage"
+)
+
 type GlobalConfig struct {
 	ISO20022 Config
 }
 
 // Config defines all the configuration for the app
 type Config struct {
-	Servers ServerConfig
+	Servers  ServerConfig
+	API      APIConfig
+	Profiles ProfilesConfig
+	Backends []BackendConfig
+
+	// Secrets resolves the vault:, file: and env: references of configuration values
+	Secrets secrets.Config
+}
+
+// ProfilesConfig - Defines the directories of validation profiles and code lists
+type ProfilesConfig struct {
+	// Directory holds yaml profile definitions, CodeLists yaml code lists
+	Directory string
+	CodeLists string
+
+	// ReloadInterval is how often the directories are checked for changes, zero disables watching
+	ReloadInterval time.Duration
+
+	// History holds the yaml definitions of past versions of profiles and code lists, see
+	// profile.LoadHistory
+	History string
+}
+
+// APIConfig - Defines the responses of the http api
+type APIConfig struct {
+	// Envelope responds with the standardized envelope of the /v2 endpoints on the unversioned endpoints too
+	Envelope bool
+
+	// Fingerprints responds with the canonical content hash of processed documents in the
+	// X-Document-Fingerprint header, uploads with the header are rejected unless they match it
+	Fingerprints bool
+
+	// Receipts responds with the processing receipt of every request in the X-Processing-Receipt
+	// header and the receipt of envelopes: the versions of the library and profiles, the
+	// detected message, the timing and the rules hitting the document
+	Receipts bool
+
+	// ValidationWorkers validates the transactions of documents concurrently with as many
+	// goroutines, documents are validated sequentially when it's 0 or 1
+	ValidationWorkers int
+
+	// Features enable experimental capabilities for every request, the requests of tenants or
+	// the requests overriding them
+	Features FeaturesConfig
+
+	// Signing signs the responses of /validator and /convert
+	Signing SigningConfig
+
+	// Replay rejects stale and duplicate requests of the endpoints with downstream effects
+	Replay ReplayConfig
+
+	// Chaos injects latencies and failures in requests to test the retries and alerting of
+	// clients, it must not be enabled in production
+	Chaos ChaosConfig
+
+	// Stats keeps the rollups of processed documents served by GET /stats
+	Stats StatsConfig
+
+	// SLOs are the service level objectives of message types served by GET /slos
+	SLOs []SLOConfig
+
+	// Masking masks the IBANs, names and remittance text of messages in logs and error responses
+	Masking masking.Config
+
+	// Deprecations announce the removal of api versions with Deprecation, Sunset and Link headers
+	Deprecations []Deprecation
+
+	// Shadow validates a sample of the validated messages in strict mode too
+	Shadow ShadowConfig
+
+	// Storage keeps the documents of the /documents endpoints
+	Storage StorageConfig
+
+	// Export writes the stored documents as Parquet files with export jobs
+	Export ExportConfig
+
+	// Events emits the payments, entries and balances of stored documents as events
+	Events EventsConfig
+
+	// Usage accounts the processed messages of tenants by API key and enforces their monthly quotas
+	Usage UsageConfig
+
+	// Outbound retries the calls of the webhooks and breaks their circuit when they keep failing
+	Outbound OutboundConfig
+
+	// Corridors flag or block the payments of /convert and POST /documents from a debtor country
+	// to a creditor country or in a currency, flagged payments are reported in Warning headers
+	Corridors []corridor.Rule
+
+	// LargeValues are the amounts from which transactions are large value by currency, they're
+	// counted in the rollups of GET /stats and emitted as payment.largevalue.detected events
+	LargeValues map[string]float64
+
+	// Review holds the documents of POST /documents matching its rules for a manual review
+	Review ReviewConfig
+
+	// Templates keeps the message templates of the /templates endpoints
+	Templates TemplatesConfig
+
+	// Schedules generate files from the templates on cron schedules and deliver them
+	Schedules SchedulesConfig
+
+	// Simulator answers the pacs.008 of POST /documents like a counterparty in sandboxes
+	Simulator SimulatorConfig
+
+	// Pseudonymization replaces personal data with consistent tokens kept in a vault, authorized
+	// tenants restore it
+	Pseudonymization PseudonymizationConfig
+
+	// Subjects allows tenants to export the documents of data subjects
+	Subjects SubjectsConfig
+
+	// Remittance fetches the remittance documents of the packages of GET /documents/{id}/package
+	Remittance RemittanceConfig
+}
+
+// FeaturesConfig - Defines the feature flags enabling experimental capabilities, see pkg/features
+type FeaturesConfig struct {
+	// Enabled flags are enabled for every request
+	Enabled []string
+
+	// Tenants enable flags for the requests of tenants by name, see UsageConfig
+	Tenants map[string][]string
+
+	// Overridable flags are enabled or disabled by requests with the X-Features header, every
+	// flag with "*". Requests overriding other flags are rejected with 403.
+	Overridable []string
+}
+
+// OutboundConfig - Defines the retry and circuit breaker policies of the outbound integrations
+type OutboundConfig struct {
+	// Default applies to the integrations without policy, resilience.DefaultPolicy when omitted
+	Default resilience.Policy
+
+	// Policies by integration: events, slo-alerts, schedule-alerts, simulator and remittance
+	Policies map[string]resilience.Policy
+
+	// DryRun records the webhook calls and scheduled files of every integration instead of
+	// sending them, requests with the X-Dry-Run header do the same for their deliveries
+	DryRun bool
+}
+
+// UsageConfig - Defines the tenants whose processed messages are accounted and served by GET /usage
+type UsageConfig struct {
+	// Header holds the API key of requests, X-Api-Key when omitted
+	Header string
+
+	// RequireKey rejects the requests without API key with 401, they're accounted to the
+	// anonymous tenant otherwise
+	RequireKey bool
+
+	// Tenants are identified by their API keys, usage isn't accounted when omitted
+	Tenants []TenantConfig
+}
+
+// TenantConfig - Defines the API keys and monthly quotas of a tenant
+type TenantConfig struct {
+	Name string
+
+	// Keys identify the requests of the tenant, they can reference a vault:, file: or env: secret
+	Keys []string
+
+	// MonthlyMessages and MonthlyBytes limit the messages and bytes processed in a calendar
+	// month (UTC), zero is unlimited
+	MonthlyMessages int
+	MonthlyBytes    int64
+
+	// Admin reads the usage of every tenant with GET /usage
+	Admin bool
+}
+
+// ExportConfig - Defines where the export jobs write the Parquet files of stored documents
+type ExportConfig struct {
+	// Directory receives the files partitioned by date and message type, export jobs are
+	// rejected when omitted
+	Directory string
+}
+
+// EventsConfig - Defines where the events of the documents stored with POST /documents are posted
+type EventsConfig struct {
+	// Webhook is posted every event as a CloudEvent, events aren't emitted when omitted
+	Webhook string
+
+	// Source is the source of the events, iso20022 when omitted
+	Source string
+}
+
+// StorageConfig - Defines where the documents of the /documents endpoints are stored
+type StorageConfig struct {
+	// Directory keeps every document as a file, documents are kept in memory when omitted
+	Directory string
+
+	// Retention purges the documents older than the max age of the first policy selecting them,
+	// documents selected by no policy are kept
+	Retention []storage.RetentionPolicy
+
+	// PurgeInterval is how often expired documents are purged, daily when omitted
+	PurgeInterval time.Duration
+
+	// Encryption encrypts the account numbers and names of stored documents
+	Encryption EncryptionConfig
+
+	// Compression compresses stored documents with zstd
+	Compression CompressionConfig
+
+	// Deduplication stores the content of identical documents once, referenced by each of them
+	Deduplication bool
+
+	// Replication copies the stored documents to a secondary region asynchronously
+	Replication ReplicationConfig
+}
+
+// ReplicationConfig - Defines the replica of stored documents, e.g. for disaster recovery.
+// Documents are replicated as stored, encrypted and compressed by the primary.
+type ReplicationConfig struct {
+	// Directory keeps the replicated documents like StorageConfig.Directory, e.g. a volume
+	// mounted from another region, documents aren't replicated when Directory and Bucket are
+	// omitted
+	Directory string
+
+	// Bucket is an S3 bucket receiving the documents under Prefix, calls are signed with the
+	// AWS_* credentials of the environment. Deleted documents are replaced by tombstones.
+	Bucket string
+	Region string
+	Prefix string
+
+	// Kinds are the kinds of records replicated, every kind when omitted
+	Kinds []string
+
+	// QueueSize is the number of documents waiting for the replica, 1000 when zero, writes
+	// wait for room when the replica lags
+	QueueSize int
+}
+
+// CompressionConfig - Defines the compression of stored documents, documents compressed before
+// stay readable once it's disabled
+type CompressionConfig struct {
+	// Level is the zstd level of new documents from 1 (fastest) to 9 (smallest), documents
+	// aren't compressed when omitted
+	Level int
+
+	// MinSize is the size in bytes of the smallest document compressed, 512 when zero
+	MinSize int
+}
+
+// EncryptionConfig - Defines the keys encrypting the sensitive elements of stored documents
+type EncryptionConfig struct {
+	// KeyID is the key wrapping the data keys of new documents, documents aren't encrypted when omitted
+	KeyID string
+
+	// Keys are the key encryption keys, keys replaced by KeyID still decrypt the documents
+	// encrypted before the rotation
+	Keys []EncryptionKey
+
+	// Elements are encrypted in addition to storage.EncryptedElements (IBAN and Nm)
+	Elements []string
+}
+
+// EncryptionKey - Defines a key encryption key
+type EncryptionKey struct {
+	ID string
+
+	// Key is the base64 encoded 256 bits AES key, e.g. a vault: secret reference
+	Key string
+}
+
+// SigningConfig - Defines the key signing responses with a detached JWS in the X-JWS-Signature header
+type SigningConfig struct {
+	// KeyFile is a PEM encoded P-256, RSA or Ed25519 private key, responses aren't signed unless
+	// KeyFile or KeyURI is set
+	KeyFile string
+
+	// KeyURI identifies a key held by the KeyProvider registered for its scheme, e.g. a KMS key
+	// or an HSM object, instead of a key file
+	KeyURI string
+
+	// KeyID is the kid of the signatures, identifying the key to verify them with
+	KeyID string
+}
+
+// ReplayConfig - Defines the replay protection of POST /jobs, POST and PATCH /documents and POST /subjects/erase
+type ReplayConfig struct {
+	// Window is how far the X-Request-Timestamp of requests may be from now, the X-Request-Nonce
+	// of requests is unique within it. Zero disables replay protection.
+	Window time.Duration
+
+	// Secret signs requests with the HMAC-SHA256 of the X-Request-Signature header, requests
+	// aren't signed when omitted. It can reference a vault:, file: or env: secret.
+	Secret string
+}
+
+// StatsConfig - Defines how long the rollups of GET /stats are kept in memory
+type StatsConfig struct {
+	// HourlyRetention keeps the hourly rollups, 48h when zero
+	HourlyRetention time.Duration
+
+	// DailyRetention keeps the daily rollups, 30 days when zero
+	DailyRetention time.Duration
+}
+
+// SLOConfig - Defines a service level objective of the latency and size of the documents of a message type
+type SLOConfig struct {
+	// Name of the objective, the message type when omitted
+	Name string
+
+	// MessageType is a message type (pacs.008.001.08) or its prefix (pacs.008)
+	MessageType string
+
+	// Latency and MaxSize (bytes) are the thresholds requests meet, either can be omitted
+	Latency time.Duration
+	MaxSize int
+
+	// Objective is the percentage (e.g. 99) of requests meeting the thresholds over the Window, 1h when omitted
+	Objective float64
+	Window    time.Duration
+
+	// AlertBurnRate breaches the objective when its error budget burns at least that fast, 1
+	// when omitted. Windows with less than MinRequests requests don't breach it.
+	AlertBurnRate float64
+	MinRequests   int
+
+	// Webhook is posted the alerts of the objective when it's breached and resolved
+	Webhook string
+}
+
+// ChaosConfig - Defines the faults injected in requests by the chaos test mode
+type ChaosConfig struct {
+	Enabled bool
+	Faults  []FaultConfig
+}
+
+// FaultConfig - Defines a fault injected at a stage of the requests
+type FaultConfig struct {
+	// Stage is parse (every endpoint parsing a document), validate (/validator) or convert (/convert)
+	Stage string
+
+	// Latency delays every request reaching the stage
+	Latency time.Duration
+
+	// FailureRate is the percentage (0 to 100) of requests failed at the stage
+	FailureRate float64
+
+	// StatusCode of the failed requests, 503 Service Unavailable when omitted
+	StatusCode int
+}
+
+// ShadowConfig - Defines the shadow validation of the messages posted to /validator
+type ShadowConfig struct {
+	// SampleRate is the percentage (0 to 100) of messages validated in strict mode after the response,
+	// discrepancies with the fast validation are logged. Zero disables shadow validation.
+	SampleRate float64
+}
+
+// BackendConfig - Declares a backend the service depends on, checked by `server --validate-config`
+type BackendConfig struct {
+	Name string
+
+	// Type of the backend: storage, kafka or redis
+	Type string
+
+	// Addresses are host:port of kafka brokers and redis, or urls of storage (file:// directories and http(s) endpoints)
+	Addresses []string
+
+	// Optional backends degrade the service reported by /ready when they're down instead of failing it
+	Optional bool
 }
 
 // ServerConfig - Groups all the http configs for the servers and ports that get opened.
@@ -0,0 +1,102 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/moov-io/iso20022/pkg/dryrun"
+	"github.com/moov-io/iso20022/pkg/resilience"
+)
+
+const (
+	// Outbound integrations of the server, they name the policies of OutboundConfig
+	OutboundEvents    = "events"
+	OutboundSLOAlerts = "slo-alerts"
+
+	OutboundScheduleAlerts = "schedule-alerts"
+
+	// OutboundSchedules is the integration of the destinations of scheduled files
+	OutboundSchedules = "schedules"
+
+	// OutboundSimulator is the webhook of the responses of the simulated counterparty
+	OutboundSimulator = "simulator"
+
+	// OutboundRemittance fetches the remittance documents of payment packages
+	OutboundRemittance = "remittance"
+
+	// DryRunHeader set to true rehearses the deliveries of a request, see OutboundConfig.DryRun.
+	// Responses of dry runs have the header too.
+	DryRunHeader = "X-Dry-Run"
+
+	eventsWebhookTimeout = 10 * time.Second
+)
+
+// policy returns the policy of the integration name
+func (c OutboundConfig) policy(name string) resilience.Policy {
+	if policy, exists := c.Policies[name]; exists {
+		return policy
+	}
+	return c.Default
+}
+
+// newOutboundClient returns a client calling the integration name with its policy, the
+// metrics of its guard are served by OutboundHandler. Calls of dry runs are recorded instead.
+func newOutboundClient(config OutboundConfig, name string, timeout time.Duration) *http.Client {
+	guard := resilience.New(name, config.policy(name))
+	resilience.Register(guard)
+	return guard.Client(&http.Client{Timeout: timeout, Transport: dryrun.Transport(name, config.DryRun, nil)})
+}
+
+// dryRunMiddleware rehearses the deliveries of the requests with a true X-Dry-Run header or
+// dryRun query parameter
+func dryRunMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		header, _ := strconv.ParseBool(r.Header.Get(DryRunHeader))
+		query, _ := strconv.ParseBool(r.URL.Query().Get("dryRun"))
+		if header || query {
+			w.Header().Set(DryRunHeader, "true")
+			r = r.WithContext(dryrun.With(r.Context()))
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// DryRunHandler - admin endpoint listing (GET) and discarding (DELETE) the deliveries
+// recorded by dry runs
+func DryRunHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			w.Header().Set("Content-Type", "application/json; charset=utf-8")
+			w.WriteHeader(http.StatusOK)
+			json.NewEncoder(w).Encode(dryrun.Deliveries())
+		case http.MethodDelete:
+			dryrun.Reset()
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.Header().Set("Allow", "GET, DELETE")
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+	}
+}
+
+// OutboundHandler - admin endpoint inspecting (GET) the calls, retries, failures and circuit
+// breaker state of the outbound integrations
+func OutboundHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			w.Header().Set("Allow", "GET")
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(resilience.Snapshot())
+	}
+}
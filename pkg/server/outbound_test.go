@@ -0,0 +1,154 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package server_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/require"
+
+	"github.com/moov-io/iso20022/pkg/dryrun"
+	"github.com/moov-io/iso20022/pkg/iso20022test/fixtures"
+	"github.com/moov-io/iso20022/pkg/resilience"
+	"github.com/moov-io/iso20022/pkg/server"
+)
+
+func TestOutboundRetries(t *testing.T) {
+	var attempts, received int32
+	webhook := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// every other attempt fails
+		if atomic.AddInt32(&attempts, 1)%2 == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		atomic.AddInt32(&received, 1)
+	}))
+	defer webhook.Close()
+
+	router := mux.NewRouter()
+	require.Nil(t, server.ConfigureHandlersWithOptions(router, server.APIConfig{
+		Events: server.EventsConfig{Webhook: webhook.URL},
+		Outbound: server.OutboundConfig{
+			Policies: map[string]resilience.Policy{
+				server.OutboundEvents: {Retries: 1, Backoff: time.Millisecond},
+			},
+		},
+	}))
+	ts := httptest.NewServer(router)
+	defer ts.Close()
+
+	createDocument(t, ts, fixtures.ReadFile(t, "valid_pacs_v08.xml"))
+	require.Eventually(t, func() bool { return atomic.LoadInt32(&received) == 2 }, 5*time.Second, 10*time.Millisecond)
+
+	rec := httptest.NewRecorder()
+	server.OutboundHandler()(rec, httptest.NewRequest(http.MethodGet, "/outbound", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var stats []resilience.Stats
+	require.Nil(t, json.NewDecoder(rec.Body).Decode(&stats))
+	var found bool
+	for _, s := range stats {
+		if s.Name == server.OutboundEvents {
+			found = true
+			require.Equal(t, 2, s.Calls)
+			require.Equal(t, 2, s.Retries)
+			require.Equal(t, 0, s.Failures)
+			require.Equal(t, resilience.StateClosed, s.State)
+		}
+	}
+	require.True(t, found)
+
+	rec = httptest.NewRecorder()
+	server.OutboundHandler()(rec, httptest.NewRequest(http.MethodPost, "/outbound", nil))
+	require.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+}
+
+// waitDeliveries waits for n deliveries recorded by dry runs
+func waitDeliveries(t *testing.T, n int) []dryrun.Delivery {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) {
+		if deliveries := dryrun.Deliveries(); len(deliveries) >= n {
+			return deliveries
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatalf("%d deliveries recorded instead of %d", len(dryrun.Deliveries()), n)
+	return nil
+}
+
+func TestDryRun(t *testing.T) {
+	dryrun.Reset()
+	t.Cleanup(dryrun.Reset)
+	var received int32
+	webhook := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&received, 1)
+	}))
+	defer webhook.Close()
+
+	router := mux.NewRouter()
+	require.Nil(t, server.ConfigureHandlersWithOptions(router, server.APIConfig{
+		Events: server.EventsConfig{Webhook: webhook.URL},
+	}))
+	ts := httptest.NewServer(router)
+	defer ts.Close()
+
+	// the events of the document are rehearsed, the document is stored
+	resp := postForm(t, ts.URL+"/documents?dryRun=true", fixtures.ReadFile(t, "valid_pacs_v08.xml"), nil)
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+	require.Equal(t, "true", resp.Header.Get(server.DryRunHeader))
+	deliveries := waitDeliveries(t, 2)
+	require.Equal(t, server.OutboundEvents, deliveries[0].Integration)
+	require.Equal(t, webhook.URL, deliveries[0].Destination)
+	require.Contains(t, deliveries[0].Content, "payment.created")
+	require.Equal(t, int32(0), atomic.LoadInt32(&received))
+
+	resp = postForm(t, ts.URL+"/documents", fixtures.ReadFile(t, "valid_pacs_v08.xml"), nil)
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+	require.Empty(t, resp.Header.Get(server.DryRunHeader))
+	require.Eventually(t, func() bool { return atomic.LoadInt32(&received) == 2 }, 5*time.Second, 10*time.Millisecond)
+	require.Len(t, dryrun.Deliveries(), 2)
+
+	w := httptest.NewRecorder()
+	server.DryRunHandler()(w, httptest.NewRequest(http.MethodGet, "/outbound/dry-run", nil))
+	require.Equal(t, http.StatusOK, w.Code)
+	var listed []dryrun.Delivery
+	require.Nil(t, json.NewDecoder(w.Body).Decode(&listed))
+	require.Len(t, listed, 2)
+
+	w = httptest.NewRecorder()
+	server.DryRunHandler()(w, httptest.NewRequest(http.MethodDelete, "/outbound/dry-run", nil))
+	require.Equal(t, http.StatusNoContent, w.Code)
+	require.Empty(t, dryrun.Deliveries())
+}
+
+func TestGlobalDryRun(t *testing.T) {
+	dryrun.Reset()
+	t.Cleanup(dryrun.Reset)
+	var received int32
+	webhook := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&received, 1)
+	}))
+	defer webhook.Close()
+
+	router := mux.NewRouter()
+	require.Nil(t, server.ConfigureHandlersWithOptions(router, server.APIConfig{
+		Events:   server.EventsConfig{Webhook: webhook.URL},
+		Outbound: server.OutboundConfig{DryRun: true},
+	}))
+	ts := httptest.NewServer(router)
+	defer ts.Close()
+
+	resp := postForm(t, ts.URL+"/documents", fixtures.ReadFile(t, "valid_pacs_v08.xml"), nil)
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+	waitDeliveries(t, 2)
+	require.Equal(t, int32(0), atomic.LoadInt32(&received))
+}
@@ -0,0 +1,134 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"github.com/moov-io/iso20022/pkg/profile"
+)
+
+// ProfileReloadHandler - admin endpoint inspecting (GET) and triggering (POST) reloads of loader
+//
+// Both respond with the status of the last reload, a failed reload responds with 422 and
+// keeps the rules of the previous reload.
+func ProfileReloadHandler(loader *profile.Loader) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		code := http.StatusOK
+		var status profile.ReloadStatus
+
+		switch r.Method {
+		case http.MethodGet:
+			status = loader.Status()
+		case http.MethodPost:
+			var err error
+			if status, err = loader.Reload(profile.ReloadTriggerManual); err != nil {
+				code = http.StatusUnprocessableEntity
+			}
+		default:
+			w.Header().Set("Allow", "GET, POST")
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(code)
+		json.NewEncoder(w).Encode(status)
+	}
+}
+
+// compareProfiles - validate the file against a current and a candidate profile and report divergent findings
+func (h handlers) compareProfiles(w http.ResponseWriter, r *http.Request) {
+	doc, err := parseInputFromRequest(r)
+	if err != nil {
+		h.outputError(w, r, http.StatusBadRequest, err)
+		return
+	}
+
+	current, candidate := r.FormValue("current"), r.FormValue("candidate")
+	if current == "" || candidate == "" {
+		h.outputError(w, r, http.StatusBadRequest, errors.New("current and candidate profiles are required"))
+		return
+	}
+	profiles := make([]*profile.Profile, 2)
+	for i, name := range []string{current, candidate} {
+		if profiles[i], err = profile.Get(name); err != nil {
+			h.outputError(w, r, http.StatusBadRequest, err)
+			return
+		}
+	}
+
+	comparison := profile.Compare(profiles[0], profiles[1], profile.Input{Document: doc, Now: time.Now()})
+	h.outputData(w, r, http.StatusOK, comparison)
+}
+
+// ProfileRules are the validation rules a profile enforces, the response of /profiles/{name}/rules
+type ProfileRules struct {
+	Profile      string
+	Version      string
+	Description  string     `json:",omitempty"`
+	MessageTypes []string   `json:",omitempty"`
+	Effective    *time.Time `json:",omitempty"`
+	Rules        []profile.Rule
+}
+
+// profileRules - list the active rules of a profile with their id, description, severity and
+// inspected paths, of the rules applying to the messageType query parameter when given and of
+// the version that applied at the asOf query parameter
+func (h handlers) profileRules(w http.ResponseWriter, r *http.Request) {
+	asOf, err := getAsOf(r)
+	if err != nil {
+		h.outputError(w, r, http.StatusBadRequest, err)
+		return
+	}
+	p, err := getProfile(mux.Vars(r)["name"], asOf)
+	if err != nil {
+		h.outputError(w, r, http.StatusNotFound, err)
+		return
+	}
+	rules := ProfileRules{
+		Profile:      p.Name,
+		Version:      p.Version,
+		Description:  p.Description,
+		MessageTypes: p.MessageTypes,
+		Rules:        p.ActiveRules(r.URL.Query().Get("messageType")),
+	}
+	if !p.Effective.IsZero() {
+		rules.Effective = &p.Effective
+	}
+	h.outputData(w, r, http.StatusOK, rules)
+}
+
+// getAsOf returns the time of the asOf form value, an RFC 3339 time or a date, zero when it's
+// omitted
+func getAsOf(r *http.Request) (time.Time, error) {
+	value := r.FormValue("asOf")
+	if value == "" {
+		return time.Time{}, nil
+	}
+	if asOf, err := time.Parse(time.RFC3339, value); err == nil {
+		return asOf, nil
+	}
+	asOf, err := time.Parse("2006-01-02", value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%s is an invalid asOf time", value)
+	}
+	return asOf, nil
+}
+
+// getProfile returns the registered profile with name, or the version that applied at asOf
+// when it isn't zero
+func getProfile(name string, asOf time.Time) (*profile.Profile, error) {
+	if asOf.IsZero() {
+		return profile.Get(name)
+	}
+	return profile.GetAsOf(name, asOf)
+}
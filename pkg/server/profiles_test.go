@@ -0,0 +1,192 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package server_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	baseLog "github.com/moov-io/base/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/moov-io/iso20022/pkg/iso20022test/fixtures"
+	"github.com/moov-io/iso20022/pkg/profile"
+	"github.com/moov-io/iso20022/pkg/server"
+)
+
+func TestProfileReloadHandler(t *testing.T) {
+	dir := t.TempDir()
+	require.Nil(t, os.WriteFile(filepath.Join(dir, "test.yml"), []byte("name: Server-Reload\nversion: \"1\"\n"), 0600))
+	t.Cleanup(func() { profile.Unregister("Server-Reload") })
+
+	env, err := server.NewEnvironment(&server.Environment{
+		Logger: baseLog.NewNopLogger(),
+		Config: &server.Config{Profiles: server.ProfilesConfig{Directory: dir}},
+	})
+	require.Nil(t, err)
+	t.Cleanup(env.Shutdown)
+	require.NotNil(t, env.ProfileLoader)
+
+	p, err := profile.Get("Server-Reload")
+	require.Nil(t, err)
+	require.Equal(t, "1", p.Version)
+
+	handler := server.ProfileReloadHandler(env.ProfileLoader)
+	request := func(method string) (*httptest.ResponseRecorder, profile.ReloadStatus) {
+		recorder := httptest.NewRecorder()
+		handler(recorder, httptest.NewRequest(method, "/profiles/reload", nil))
+		var status profile.ReloadStatus
+		json.NewDecoder(recorder.Body).Decode(&status)
+		return recorder, status
+	}
+
+	recorder, status := request(http.MethodGet)
+	require.Equal(t, http.StatusOK, recorder.Code)
+	require.Equal(t, profile.ReloadTriggerStartup, status.Trigger)
+	require.Equal(t, []string{"Server-Reload"}, status.Profiles)
+
+	require.Nil(t, os.WriteFile(filepath.Join(dir, "test.yml"), []byte("name: Server-Reload\nversion: \"2\"\n"), 0600))
+	recorder, status = request(http.MethodPost)
+	require.Equal(t, http.StatusOK, recorder.Code)
+	require.Equal(t, profile.ReloadTriggerManual, status.Trigger)
+	p, err = profile.Get("Server-Reload")
+	require.Nil(t, err)
+	require.Equal(t, "2", p.Version)
+
+	require.Nil(t, os.WriteFile(filepath.Join(dir, "test.yml"), []byte("name: Server-Reload\nextends: Unknown\n"), 0600))
+	recorder, status = request(http.MethodPost)
+	require.Equal(t, http.StatusUnprocessableEntity, recorder.Code)
+	require.NotEmpty(t, status.Error)
+	p, err = profile.Get("Server-Reload")
+	require.Nil(t, err)
+	require.Equal(t, "2", p.Version)
+
+	recorder, _ = request(http.MethodDelete)
+	require.Equal(t, http.StatusMethodNotAllowed, recorder.Code)
+}
+
+func TestEnvironmentWithProfileHistory(t *testing.T) {
+	dir := t.TempDir()
+	require.Nil(t, os.WriteFile(filepath.Join(dir, "old.yml"), []byte("name: Server-History\nversion: \"1\"\neffective: 2020-01-01\n"), 0600))
+
+	env, err := server.NewEnvironment(&server.Environment{
+		Logger: baseLog.NewNopLogger(),
+		Config: &server.Config{Profiles: server.ProfilesConfig{History: dir}},
+	})
+	require.Nil(t, err)
+	t.Cleanup(env.Shutdown)
+
+	p, err := profile.GetAsOf("Server-History", time.Date(2020, 6, 1, 0, 0, 0, 0, time.UTC))
+	require.Nil(t, err)
+	require.Equal(t, "1", p.Version)
+	_, err = profile.Get("Server-History")
+	require.NotNil(t, err)
+
+	_, err = server.NewEnvironment(&server.Environment{
+		Logger: baseLog.NewNopLogger(),
+		Config: &server.Config{Profiles: server.ProfilesConfig{History: filepath.Join(dir, "missing")}},
+	})
+	require.NotNil(t, err)
+}
+
+func TestEnvironmentWithInvalidProfiles(t *testing.T) {
+	_, err := server.NewEnvironment(&server.Environment{
+		Logger: baseLog.NewNopLogger(),
+		Config: &server.Config{Profiles: server.ProfilesConfig{Directory: filepath.Join(t.TempDir(), "missing")}},
+	})
+	require.NotNil(t, err)
+}
+
+func TestCompareProfiles(t *testing.T) {
+	cfg, err := profile.ParseConfig([]byte("name: Server-Candidate\nextends: SCTInst\nrules:\n  - id: CAND-001\n    expression: \"exists(PmtId/UETR)\"\n"))
+	require.Nil(t, err)
+	candidate, err := profile.NewProfile(cfg)
+	require.Nil(t, err)
+	profile.Register(candidate)
+	t.Cleanup(func() { profile.Unregister(candidate.Name) })
+
+	ts := newJobServer(t)
+	input := fixtures.ReadFile(t, "sct_inst_pacs_v08.xml")
+
+	resp := postForm(t, ts.URL+"/v2/profiles/compare", input, map[string]string{"current": profile.SCTInstName, "candidate": candidate.Name})
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var envelope struct {
+		Data profile.Comparison `json:"data"`
+	}
+	require.Nil(t, json.NewDecoder(resp.Body).Decode(&envelope))
+	require.True(t, envelope.Data.Divergent)
+	require.Equal(t, "CAND-001", envelope.Data.Added[0].Rule)
+	require.Equal(t, candidate.Name, envelope.Data.Candidate.Profile)
+
+	resp = postForm(t, ts.URL+"/profiles/compare", input, map[string]string{"current": profile.SCTInstName})
+	require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+	resp = postForm(t, ts.URL+"/profiles/compare", input, map[string]string{"current": profile.SCTInstName, "candidate": "Unknown"})
+	require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
+
+func TestProfileRules(t *testing.T) {
+	cfg, err := profile.ParseConfig([]byte("name: Server-Rules\nextends: SCTInst\nrules:\n  - id: RULES-001\n    description: UETR is required\n    severity: warning\n    expression: \"exists(PmtId/UETR)\"\n"))
+	require.Nil(t, err)
+	p, err := profile.NewProfile(cfg)
+	require.Nil(t, err)
+	profile.Register(p)
+	t.Cleanup(func() { profile.Unregister(p.Name) })
+
+	ts := newJobServer(t)
+	get := func(path string) (*http.Response, server.ProfileRules) {
+		resp, err := http.Get(ts.URL + path)
+		require.Nil(t, err)
+		defer resp.Body.Close()
+		var envelope struct {
+			Data server.ProfileRules `json:"data"`
+		}
+		json.NewDecoder(resp.Body).Decode(&envelope)
+		return resp, envelope.Data
+	}
+
+	resp, rules := get("/v2/profiles/Server-Rules/rules")
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, "Server-Rules", rules.Profile)
+	require.Len(t, rules.Rules, len(p.ActiveRules("")))
+	last := rules.Rules[len(rules.Rules)-1]
+	require.Equal(t, "RULES-001", last.ID)
+	require.Equal(t, "UETR is required", last.Description)
+	require.Equal(t, profile.SeverityWarning, last.Severity)
+	require.Equal(t, "exists(PmtId/UETR)", last.Expression)
+	require.Equal(t, []string{"PmtId/UETR"}, last.Paths)
+	for _, rule := range rules.Rules {
+		require.NotEmpty(t, rule.ID)
+		require.NotEmpty(t, rule.Severity)
+	}
+
+	_, rules = get("/v2/profiles/Server-Rules/rules?messageType=pain.001.001.09")
+	require.Empty(t, rules.Rules)
+
+	resp, _ = get("/profiles/Unknown/rules")
+	require.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+	// the rules of the version that applied at asOf
+	cfg, err = profile.ParseConfig([]byte("name: Server-Rules\nversion: \"2\"\neffective: 2021-06-01\n"))
+	require.Nil(t, err)
+	next, err := profile.NewProfile(cfg)
+	require.Nil(t, err)
+	profile.Register(next)
+	_, rules = get("/v2/profiles/Server-Rules/rules?asOf=2021-05-31")
+	require.Len(t, rules.Rules, len(p.ActiveRules("")))
+	require.Nil(t, rules.Effective)
+	_, rules = get("/v2/profiles/Server-Rules/rules?asOf=2021-06-01T10:00:00Z")
+	require.Equal(t, "2", rules.Version)
+	require.Equal(t, "2021-06-01", rules.Effective.Format("2006-01-02"))
+	require.Empty(t, rules.Rules)
+	resp, _ = get("/profiles/Server-Rules/rules?asOf=June")
+	require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
@@ -0,0 +1,203 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package server
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/moov-io/iso20022/pkg/pseudonym"
+	"github.com/moov-io/iso20022/pkg/storage"
+	"github.com/moov-io/iso20022/pkg/utils"
+)
+
+const PseudonymActionReidentify = "reidentify"
+
+var (
+	// ErrPseudonymizationNotConfigured is returned by POST /pseudonymize and /reidentify without
+	// pseudonymization key
+	ErrPseudonymizationNotConfigured = errors.New("pseudonymization is not configured")
+
+	// ErrReidentificationForbidden is returned by POST /reidentify for the tenants that aren't
+	// allowed to re-identify documents
+	ErrReidentificationForbidden = errors.New("re-identification is forbidden")
+)
+
+// PseudonymizationConfig - Defines the tokens replacing the personal data of the documents of
+// POST /pseudonymize and who restores it with POST /reidentify
+type PseudonymizationConfig struct {
+	// Key is the base64 encoded 256 bits key deriving the tokens and encrypting their values in
+	// the vault, e.g. a vault: secret reference. Documents aren't pseudonymized when omitted.
+	Key string
+
+	// Directory keeps the values of the tokens as files, they're kept in memory when omitted
+	Directory string
+
+	// Elements are pseudonymized in addition to masking.PersonalElements
+	Elements []string
+
+	// Tenants are the usage tenants allowed to re-identify documents, identified by their API
+	// keys. Documents can't be re-identified when omitted.
+	Tenants []string
+}
+
+// PseudonymAudit records a re-identification, the restored values aren't kept
+type PseudonymAudit struct {
+	ID     string    `json:"id"`
+	Action string    `json:"action"`
+	Tenant string    `json:"tenant"`
+	Paths  []string  `json:"paths"`
+	Time   time.Time `json:"time"`
+}
+
+// pseudonymizer pseudonymizes documents and re-identifies them for its tenants
+type pseudonymizer struct {
+	*pseudonym.Pseudonymizer
+	tenants map[string]bool
+}
+
+// newPseudonymizer returns nil unless config has a key
+func newPseudonymizer(config PseudonymizationConfig, usage UsageConfig) (*pseudonymizer, error) {
+	if config.Key == "" {
+		return nil, nil
+	}
+	key, err := base64.StdEncoding.DecodeString(config.Key)
+	if err != nil {
+		return nil, fmt.Errorf("pseudonymization key isn't base64 encoded: %w", err)
+	}
+
+	var store storage.Store = storage.NewMemoryStore()
+	if config.Directory != "" {
+		if store, err = storage.NewFileStore(config.Directory); err != nil {
+			return nil, err
+		}
+	}
+	vault, err := pseudonym.NewStoreVault(store, key)
+	if err != nil {
+		return nil, err
+	}
+	p := &pseudonymizer{tenants: make(map[string]bool)}
+	if p.Pseudonymizer, err = pseudonym.New(key, vault, config.Elements...); err != nil {
+		return nil, err
+	}
+
+	known := make(map[string]bool)
+	for _, tenant := range usage.Tenants {
+		known[tenant.Name] = true
+	}
+	for _, tenant := range config.Tenants {
+		if !known[tenant] {
+			return nil, fmt.Errorf("pseudonymization tenant %s isn't a usage tenant", tenant)
+		}
+		p.tenants[tenant] = true
+	}
+	return p, nil
+}
+
+// pseudonymize - replace the personal data of the posted document with tokens
+func (h handlers) pseudonymize(w http.ResponseWriter, r *http.Request) {
+	if h.pseudonymizer == nil {
+		h.outputError(w, r, http.StatusNotImplemented, ErrPseudonymizationNotConfigured)
+		return
+	}
+	c, ok := h.parseInput(w, r)
+	if !ok {
+		return
+	}
+	format, err := getFormat(r)
+	if err != nil {
+		h.outputError(w, r, http.StatusNotImplemented, err)
+		return
+	}
+
+	pseudonymized, _, err := h.pseudonymizer.Pseudonymize(r.Context(), c.Document)
+	if err != nil {
+		h.outputError(w, r, http.StatusInternalServerError, err)
+		return
+	}
+	output, err := messageToBuf(format, pseudonymized)
+	if err != nil {
+		h.outputError(w, r, http.StatusNotImplemented, err)
+		return
+	}
+	h.outputFile(w, r, "pseudonymized_file", format, output, c.Warnings...)
+}
+
+// reidentify - restore the personal data of a pseudonymized document, for the tenants allowed
+// to re-identify documents
+func (h handlers) reidentify(w http.ResponseWriter, r *http.Request) {
+	if h.pseudonymizer == nil {
+		h.outputError(w, r, http.StatusNotImplemented, ErrPseudonymizationNotConfigured)
+		return
+	}
+	if h.usage == nil {
+		h.outputError(w, r, http.StatusForbidden, ErrReidentificationForbidden)
+		return
+	}
+	tenant, err := h.usage.tenant(r)
+	if err != nil {
+		h.outputError(w, r, http.StatusUnauthorized, err)
+		return
+	}
+	if !h.pseudonymizer.tenants[tenant.Name] {
+		h.outputError(w, r, http.StatusForbidden, fmt.Errorf("%w: tenant %s", ErrReidentificationForbidden, tenant.Name))
+		return
+	}
+
+	c, ok := h.parseInput(w, r)
+	if !ok {
+		return
+	}
+	format, err := getFormat(r)
+	if err != nil {
+		h.outputError(w, r, http.StatusNotImplemented, err)
+		return
+	}
+	reidentified, paths, err := h.pseudonymizer.Reidentify(r.Context(), c.Document)
+	if err != nil {
+		h.outputError(w, r, http.StatusInternalServerError, err)
+		return
+	}
+	output, err := messageToBuf(format, reidentified)
+	if err != nil {
+		h.outputError(w, r, http.StatusNotImplemented, err)
+		return
+	}
+	if err = h.pseudonymAudit(r, tenant.Name, paths); err != nil {
+		h.outputError(w, r, http.StatusInternalServerError, err)
+		return
+	}
+	h.outputFile(w, r, "reidentified_file", format, output, c.Warnings...)
+}
+
+// pseudonymAudit stores the audit record of a re-identification
+func (h handlers) pseudonymAudit(r *http.Request, tenant string, paths []string) error {
+	audit := PseudonymAudit{
+		ID:     newId(),
+		Action: PseudonymActionReidentify,
+		Tenant: tenant,
+		Paths:  paths,
+		Time:   time.Now().UTC(),
+	}
+	if audit.Paths == nil {
+		audit.Paths = []string{}
+	}
+	content, err := json.Marshal(audit)
+	if err != nil {
+		return err
+	}
+	return h.documents.Put(r.Context(), storage.Record{
+		ID:      audit.ID,
+		Kind:    storage.KindAudit,
+		Format:  utils.DocumentTypeJson,
+		Content: content,
+		Created: audit.Time,
+		Updated: audit.Time,
+	})
+}
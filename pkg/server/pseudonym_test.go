@@ -0,0 +1,97 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package server_test
+
+import (
+	"bytes"
+	"encoding/base64"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/require"
+
+	"github.com/moov-io/iso20022/pkg/iso20022test/fixtures"
+	"github.com/moov-io/iso20022/pkg/server"
+)
+
+func TestPseudonymization(t *testing.T) {
+	key := base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{1}, 32))
+	router := mux.NewRouter()
+	require.Nil(t, server.ConfigureHandlersWithOptions(router, server.APIConfig{
+		Usage: server.UsageConfig{Tenants: []server.TenantConfig{
+			{Name: "analytics", Keys: []string{"analytics-key"}},
+			{Name: "support", Keys: []string{"support-key"}},
+		}},
+		Pseudonymization: server.PseudonymizationConfig{
+			Key:       key,
+			Directory: t.TempDir(),
+			Tenants:   []string{"support"},
+		},
+	}))
+	ts := httptest.NewServer(router)
+	defer ts.Close()
+
+	post := func(path, apiKey string, input []byte) (int, string) {
+		t.Helper()
+		body := &bytes.Buffer{}
+		writer := multipart.NewWriter(body)
+		part, err := writer.CreateFormFile("input", "input")
+		require.Nil(t, err)
+		part.Write(input)
+		require.Nil(t, writer.Close())
+		req, err := http.NewRequest(http.MethodPost, ts.URL+path, body)
+		require.Nil(t, err)
+		req.Header.Set("Content-Type", writer.FormDataContentType())
+		req.Header.Set("X-Api-Key", apiKey)
+		resp, err := http.DefaultClient.Do(req)
+		require.Nil(t, err)
+		defer resp.Body.Close()
+		buf, _ := io.ReadAll(resp.Body)
+		return resp.StatusCode, string(buf)
+	}
+
+	original := fixtures.ReadFile(t, "valid_pacs_v08.xml")
+	code, pseudonymized := post("/pseudonymize", "analytics-key", original)
+	require.Equal(t, http.StatusOK, code, pseudonymized)
+	require.NotContains(t, pseudonymized, "John Smith")
+	require.NotContains(t, pseudonymized, "Invoice 2021-0042")
+	require.Contains(t, pseudonymized, "<EndToEndId>E2E-0001</EndToEndId>")
+
+	// the same document is replaced by the same tokens
+	code, again := post("/pseudonymize", "support-key", original)
+	require.Equal(t, http.StatusOK, code)
+	require.Equal(t, pseudonymized, again)
+
+	// only the tenants of the configuration re-identify documents
+	code, _ = post("/reidentify", "analytics-key", []byte(pseudonymized))
+	require.Equal(t, http.StatusForbidden, code)
+	code, _ = post("/reidentify", "unknown-key", []byte(pseudonymized))
+	require.Equal(t, http.StatusUnauthorized, code)
+	code, reidentified := post("/reidentify", "support-key", []byte(pseudonymized))
+	require.Equal(t, http.StatusOK, code, reidentified)
+	require.Contains(t, reidentified, "<Nm>John Smith</Nm>")
+	require.Contains(t, reidentified, "<Ustrd>Invoice 2021-0042</Ustrd>")
+
+	// pseudonymization isn't configured without key
+	router = mux.NewRouter()
+	require.Nil(t, server.ConfigureHandlersWithOptions(router, server.APIConfig{}))
+	disabled := httptest.NewServer(router)
+	defer disabled.Close()
+	resp := postForm(t, disabled.URL+"/pseudonymize", original, nil)
+	require.Equal(t, http.StatusNotImplemented, resp.StatusCode)
+
+	err := server.ConfigureHandlersWithOptions(mux.NewRouter(), server.APIConfig{
+		Pseudonymization: server.PseudonymizationConfig{Key: key, Tenants: []string{"support"}},
+	})
+	require.ErrorContains(t, err, "pseudonymization tenant support isn't a usage tenant")
+	err = server.ConfigureHandlersWithOptions(mux.NewRouter(), server.APIConfig{
+		Pseudonymization: server.PseudonymizationConfig{Key: "c2hvcnQ="},
+	})
+	require.ErrorContains(t, err, "key has 5 bytes instead of 32")
+}
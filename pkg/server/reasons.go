@@ -0,0 +1,61 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package server
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"github.com/moov-io/iso20022/pkg/reasons"
+)
+
+// ReasonCode is a reason code with its codes in every scheme, the response of
+// /reasons/{scheme}/{code}
+type ReasonCode struct {
+	Table       string
+	Scheme      reasons.Scheme
+	Code        string
+	Description string `json:",omitempty"`
+	Codes       map[reasons.Scheme]string
+}
+
+// reasonCode - look up a reason code of a scheme in the reason table named by the table query
+// parameter, the default table when omitted
+func (h handlers) reasonCode(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	scheme, err := reasons.ParseScheme(vars["scheme"])
+	if err != nil {
+		h.outputError(w, r, http.StatusBadRequest, err)
+		return
+	}
+	table, err := reasons.GetTable(r.URL.Query().Get("table"))
+	if err != nil {
+		h.outputError(w, r, http.StatusNotFound, err)
+		return
+	}
+	codes, err := table.Codes(scheme, vars["code"])
+	if err != nil {
+		h.outputError(w, r, http.StatusNotFound, err)
+		return
+	}
+	reason, _ := table.Lookup(scheme, vars["code"])
+	h.outputData(w, r, http.StatusOK, ReasonCode{
+		Table:       table.Name,
+		Scheme:      scheme,
+		Code:        vars["code"],
+		Description: reason.Description,
+		Codes:       codes,
+	})
+}
+
+// getReasonScheme returns the reason scheme of the form value key, fallback when it's omitted
+func getReasonScheme(r *http.Request, key string, fallback reasons.Scheme) (reasons.Scheme, error) {
+	value := r.FormValue(key)
+	if value == "" {
+		return fallback, nil
+	}
+	return reasons.ParseScheme(value)
+}
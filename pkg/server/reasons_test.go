@@ -0,0 +1,54 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package server_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/moov-io/iso20022/pkg/reasons"
+	"github.com/moov-io/iso20022/pkg/server"
+)
+
+func TestReasonCode(t *testing.T) {
+	reasons.RegisterTable(&reasons.Table{Name: "Server-Reasons", Reasons: []reasons.Reason{
+		{Description: "Duplication", Codes: map[reasons.Scheme]string{reasons.SchemeISO: "AM05", reasons.SchemeFedNow: "DUPL"}},
+	}})
+	t.Cleanup(func() { reasons.UnregisterTable("Server-Reasons") })
+
+	ts := newJobServer(t)
+	get := func(path string) (*http.Response, server.ReasonCode) {
+		resp, err := http.Get(ts.URL + path)
+		require.Nil(t, err)
+		defer resp.Body.Close()
+		var envelope struct {
+			Data server.ReasonCode `json:"data"`
+		}
+		json.NewDecoder(resp.Body).Decode(&envelope)
+		return resp, envelope.Data
+	}
+
+	resp, reason := get("/v2/reasons/iso/AC03")
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, reasons.DefaultTableName, reason.Table)
+	require.Equal(t, reasons.SchemeISO, reason.Scheme)
+	require.NotEmpty(t, reason.Description)
+	require.Equal(t, "AC01", reason.Codes[reasons.SchemeSEPA])
+	require.Equal(t, "AC03", reason.Codes[reasons.SchemeFedNow])
+
+	_, reason = get("/v2/reasons/FedNow/DUPL?table=Server-Reasons")
+	require.Equal(t, "Server-Reasons", reason.Table)
+	require.Equal(t, map[reasons.Scheme]string{reasons.SchemeISO: "AM05", reasons.SchemeFedNow: "DUPL"}, reason.Codes)
+
+	resp, _ = get("/reasons/swift/AC01")
+	require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	resp, _ = get("/reasons/iso/ZZ99")
+	require.Equal(t, http.StatusNotFound, resp.StatusCode)
+	resp, _ = get("/reasons/iso/AC01?table=Unknown")
+	require.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
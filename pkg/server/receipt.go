@@ -0,0 +1,236 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package server
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/moov-io/iso20022/pkg/corridor"
+	"github.com/moov-io/iso20022/pkg/document"
+	"github.com/moov-io/iso20022/pkg/largevalue"
+	"github.com/moov-io/iso20022/pkg/manifest"
+	"github.com/moov-io/iso20022/pkg/report"
+	"github.com/moov-io/iso20022/pkg/utils"
+)
+
+// ReceiptHeader carries the processing receipt of responses as json, see APIConfig.Receipts
+const ReceiptHeader = "X-Processing-Receipt"
+
+// Kinds of the rules hitting a document
+const (
+	RuleKindValidation = "validation"
+	RuleKindCorridor   = "corridor"
+	RuleKindReview     = "review"
+	RuleKindLargeValue = "large-value"
+)
+
+// Receipt describes how a request was processed, e.g. to explain why a document validated
+// yesterday but not today: the versions of the library, profiles and code lists, the message
+// detected in the input, the timing and the rules hitting the document
+type Receipt struct {
+	Versions manifest.Versions `json:"versions"`
+
+	// Features are the feature flags enabled for the request
+	Features []string `json:"features,omitempty"`
+
+	// Detection is the message of the input, nil when the request has none or it isn't parsed
+	Detection *Detection `json:"detection,omitempty"`
+
+	// Received is the time of the request, DurationMs the fractional milliseconds until the
+	// response
+	Received   time.Time `json:"received"`
+	DurationMs float64   `json:"durationMs"`
+
+	Rules []RuleHit `json:"rules,omitempty"`
+}
+
+// Detection is the message detected in the input of a request
+type Detection struct {
+	MessageType string             `json:"messageType"`
+	Namespace   string             `json:"namespace"`
+	Format      utils.DocumentType `json:"format"`
+
+	// Support is the support status of the message version, see document.SupportLevel
+	Support string `json:"support"`
+}
+
+// RuleHit is a rule hitting the document of a request, e.g. a failed validation of an element
+// or a corridor rule flagging a payment
+type RuleHit struct {
+	Kind string `json:"kind"`
+	Rule string `json:"rule"`
+
+	// Path of the element or transaction hit, empty when the rule hits the document
+	Path   string `json:"path,omitempty"`
+	Action string `json:"action,omitempty"`
+}
+
+type receiptKey struct{}
+
+// processingReceipt collects the receipt of a request while it's handled
+type processingReceipt struct {
+	mu       sync.Mutex
+	receipt  Receipt
+	finished *Receipt
+	now      func() time.Time
+}
+
+// receiptOf returns the receipt of r, nil unless receipts are enabled
+func receiptOf(r *http.Request) *processingReceipt {
+	p, _ := r.Context().Value(receiptKey{}).(*processingReceipt)
+	return p
+}
+
+// noteDetection sets the message of the request r, parsed from input
+func noteDetection(r *http.Request, input []byte, doc document.Iso20022Document) {
+	p := receiptOf(r)
+	if p == nil || doc == nil {
+		return
+	}
+	support := document.SupportLevel(doc.NameSpace())
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.receipt.Detection = &Detection{
+		MessageType: utils.GetMessageType(doc.NameSpace()),
+		Namespace:   doc.NameSpace(),
+		Format:      utils.GetDocumentFormat(input),
+		Support:     string(support.Status),
+	}
+}
+
+// noteFeatures sets the feature flags enabled for the request r
+func noteFeatures(r *http.Request, names []string) {
+	p := receiptOf(r)
+	if p == nil || len(names) == 0 {
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.receipt.Features = names
+}
+
+// noteRules adds rule hits to the receipt of the request r
+func noteRules(r *http.Request, hits ...RuleHit) {
+	p := receiptOf(r)
+	if p == nil || len(hits) == 0 {
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.receipt.Rules = append(p.receipt.Rules, hits...)
+}
+
+// noteValidation adds the elements failing the validation of c to the receipt of the request r,
+// their messages are masked like the errors of responses
+func (h handlers) noteValidation(r *http.Request, c *HookContext) {
+	if receiptOf(r) == nil || c.Err == nil || c.Document == nil {
+		return
+	}
+	values := h.masker.Values(c.Document)
+	var hits []RuleHit
+	for _, finding := range report.New("", nil, c.Document, c.Err).Findings {
+		hits = append(hits, RuleHit{Kind: RuleKindValidation, Rule: h.masker.Mask(finding.Message, values...), Path: finding.Path})
+	}
+	if len(hits) == 0 {
+		// e.g. the error of a post-validate hook
+		hits = append(hits, RuleHit{Kind: RuleKindValidation, Rule: h.masker.Mask(c.Err.Error(), values...)})
+	}
+	noteRules(r, hits...)
+}
+
+func corridorRuleHits(hits []corridor.Hit) []RuleHit {
+	var rules []RuleHit
+	for _, hit := range hits {
+		rules = append(rules, RuleHit{Kind: RuleKindCorridor, Rule: hit.Rule, Action: string(hit.Action)})
+	}
+	return rules
+}
+
+func largeValueRuleHits(transactions []largevalue.Transaction) []RuleHit {
+	var rules []RuleHit
+	for _, tx := range transactions {
+		rules = append(rules, RuleHit{Kind: RuleKindLargeValue, Rule: fmt.Sprintf("%s %v", tx.Currency, tx.Threshold), Path: tx.Path})
+	}
+	return rules
+}
+
+// finish returns the receipt once the response starts, later notes aren't part of it
+func (p *processingReceipt) finish() Receipt {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.finished == nil {
+		receipt := p.receipt
+		receipt.DurationMs = float64(p.now().Sub(receipt.Received).Microseconds()) / 1000
+		receipt.Rules = append([]RuleHit(nil), receipt.Rules...)
+		p.finished = &receipt
+	}
+	return *p.finished
+}
+
+// receiptMiddleware responds with the ReceiptHeader of every request, the receipt is taken
+// when the response starts
+func receiptMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		p := &processingReceipt{now: time.Now}
+		p.receipt = Receipt{Versions: manifest.CurrentVersions(), Received: p.now().UTC()}
+		next.ServeHTTP(&receiptWriter{ResponseWriter: w, receipt: p}, r.WithContext(context.WithValue(r.Context(), receiptKey{}, p)))
+	})
+}
+
+// receiptWriter sets the receipt header before the response starts, it supports the
+// streaming and websocket responses of the handlers
+type receiptWriter struct {
+	http.ResponseWriter
+	receipt     *processingReceipt
+	wroteHeader bool
+}
+
+func (w *receiptWriter) WriteHeader(code int) {
+	if !w.wroteHeader {
+		w.wroteHeader = true
+		if buf, err := json.Marshal(w.receipt.finish()); err == nil {
+			w.Header().Set(ReceiptHeader, string(buf))
+		}
+	}
+	w.ResponseWriter.WriteHeader(code)
+}
+
+func (w *receiptWriter) Write(p []byte) (int, error) {
+	if !w.wroteHeader {
+		w.WriteHeader(http.StatusOK)
+	}
+	return w.ResponseWriter.Write(p)
+}
+
+func (w *receiptWriter) Flush() {
+	if !w.wroteHeader {
+		w.WriteHeader(http.StatusOK)
+	}
+	if flusher, ok := w.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+// Unwrap returns the wrapped writer for http.ResponseController
+func (w *receiptWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+func (w *receiptWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	hijacker, ok := w.ResponseWriter.(http.Hijacker)
+	if !ok {
+		return nil, nil, errors.New("hijacking is not supported")
+	}
+	w.wroteHeader = true
+	return hijacker.Hijack()
+}
@@ -0,0 +1,85 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package server_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/require"
+
+	"github.com/moov-io/iso20022/pkg/iso20022test/fixtures"
+	"github.com/moov-io/iso20022/pkg/server"
+	"github.com/moov-io/iso20022/pkg/utils"
+)
+
+func receiptHeader(t *testing.T, resp *http.Response) server.Receipt {
+	var receipt server.Receipt
+	require.NotEmpty(t, resp.Header.Get(server.ReceiptHeader))
+	require.Nil(t, json.Unmarshal([]byte(resp.Header.Get(server.ReceiptHeader)), &receipt))
+	return receipt
+}
+
+func TestReceipts(t *testing.T) {
+	router := mux.NewRouter()
+	require.Nil(t, server.ConfigureHandlersWithOptions(router, server.APIConfig{Receipts: true}))
+	ts := httptest.NewServer(router)
+	t.Cleanup(ts.Close)
+
+	input := fixtures.ReadFile(t, "valid_pacs_v08.xml")
+	resp := postFingerprinted(t, ts.URL+"/validator", input, "", nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	receipt := receiptHeader(t, resp)
+	require.NotEmpty(t, receipt.Versions.Library)
+	require.NotEmpty(t, receipt.Versions.Profiles)
+	require.False(t, receipt.Received.IsZero())
+	require.GreaterOrEqual(t, receipt.DurationMs, 0.0)
+	require.NotNil(t, receipt.Detection)
+	require.Equal(t, "pacs.008.001.08", receipt.Detection.MessageType)
+	require.Equal(t, utils.DocumentTypeXml, receipt.Detection.Format)
+	require.Empty(t, receipt.Rules)
+
+	// the elements failing validation are the rules hitting the document
+	invalid := bytes.Replace(input, []byte("MSG-20210415-0001"), []byte(strings.Repeat("M", 40)), 1)
+	resp = postFingerprinted(t, ts.URL+"/validator", invalid, "", nil)
+	require.Equal(t, http.StatusNotImplemented, resp.StatusCode)
+	receipt = receiptHeader(t, resp)
+	require.NotEmpty(t, receipt.Rules)
+	require.Equal(t, server.RuleKindValidation, receipt.Rules[0].Kind)
+	require.Contains(t, receipt.Rules[0].Path, "MsgId")
+
+	// envelopes hold the receipt of their header
+	resp = postFingerprinted(t, ts.URL+"/v2/print", input, "", map[string]string{"format": "json"})
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	var envelope server.Envelope
+	require.Nil(t, json.NewDecoder(resp.Body).Decode(&envelope))
+	require.NotNil(t, envelope.Receipt)
+	require.Equal(t, receiptHeader(t, resp), *envelope.Receipt)
+
+	// requests without a document have a receipt without detection
+	resp, err := http.Get(ts.URL + "/health")
+	require.Nil(t, err)
+	t.Cleanup(func() { resp.Body.Close() })
+	require.Nil(t, receiptHeader(t, resp).Detection)
+}
+
+func TestReceiptsDisabled(t *testing.T) {
+	router := mux.NewRouter()
+	require.Nil(t, server.ConfigureHandlers(router))
+	ts := httptest.NewServer(router)
+	t.Cleanup(ts.Close)
+
+	resp := postFingerprinted(t, ts.URL+"/v2/validator", fixtures.ReadFile(t, "valid_pacs_v08.xml"), "", nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Empty(t, resp.Header.Get(server.ReceiptHeader))
+	var envelope server.Envelope
+	require.Nil(t, json.NewDecoder(resp.Body).Decode(&envelope))
+	require.Nil(t, envelope.Receipt)
+}
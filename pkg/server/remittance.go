@@ -0,0 +1,93 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package server
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/moov-io/iso20022/pkg/remittance"
+)
+
+const defaultRemittanceTimeout = 30 * time.Second
+
+// ErrRemittanceFetchNotConfigured is returned by GET /documents/{id}/package?fetch=true when no
+// host is allowed
+var ErrRemittanceFetchNotConfigured = errors.New("fetching remittance documents is not configured")
+
+// RemittanceConfig - Defines where GET /documents/{id}/package fetches the remittance documents
+// referenced by the related remittance information of payments
+type RemittanceConfig struct {
+	// AllowedHosts are the hosts of the remittance documents fetched, e.g. docs.example.com, or
+	// their domain with a leading dot. Documents aren't fetched when omitted.
+	AllowedHosts []string
+
+	// MaxSize is the size of the largest document in bytes, 10 MiB when zero
+	MaxSize int64
+
+	// Timeout of the fetch of a document, 30s when zero
+	Timeout time.Duration
+}
+
+// newRemittanceFetcher returns nil unless config allows hosts, documents are fetched with the
+// policy of outbound
+func newRemittanceFetcher(config RemittanceConfig, outbound OutboundConfig) *remittance.Fetcher {
+	if len(config.AllowedHosts) == 0 {
+		return nil
+	}
+	timeout := config.Timeout
+	if timeout <= 0 {
+		timeout = defaultRemittanceTimeout
+	}
+	return &remittance.Fetcher{
+		Client:       newOutboundClient(outbound, OutboundRemittance, timeout),
+		AllowedHosts: config.AllowedHosts,
+		MaxSize:      config.MaxSize,
+	}
+}
+
+// documentPackage - zip archive of the stored document and the manifest of its related
+// remittance, with the remittance documents of its URLs when fetch is true
+func (h handlers) documentPackage(w http.ResponseWriter, r *http.Request) {
+	var fetch bool
+	if value := r.URL.Query().Get("fetch"); value != "" {
+		var err error
+		if fetch, err = strconv.ParseBool(value); err != nil {
+			h.outputError(w, r, http.StatusBadRequest, fmt.Errorf("fetch %q is not a boolean", value))
+			return
+		}
+	}
+	var fetcher *remittance.Fetcher
+	if fetch {
+		if h.remittance == nil {
+			h.outputError(w, r, http.StatusNotImplemented, ErrRemittanceFetchNotConfigured)
+			return
+		}
+		fetcher = h.remittance
+	}
+
+	rec, doc, ok := h.getDocument(w, r)
+	if !ok || !h.approved(w, r, rec) {
+		return
+	}
+	pkg := remittance.Package{
+		Name:       fmt.Sprintf("%s.%s", rec.ID, rec.Format),
+		Content:    rec.Content,
+		References: remittance.References(doc),
+	}
+	buf := &bytes.Buffer{}
+	if _, err := pkg.Write(r.Context(), buf, fetcher); err != nil {
+		h.outputError(w, r, http.StatusInternalServerError, err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/zip")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", rec.ID+".zip"))
+	w.WriteHeader(http.StatusOK)
+	w.Write(buf.Bytes())
+}
@@ -0,0 +1,87 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package server_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/require"
+
+	"github.com/moov-io/iso20022/pkg/iso20022test/fixtures"
+	"github.com/moov-io/iso20022/pkg/remittance"
+	"github.com/moov-io/iso20022/pkg/server"
+)
+
+func TestDocumentPackage(t *testing.T) {
+	advice := []byte("%PDF-1.4 remittance advice")
+	docs := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/pdf")
+		w.Write(advice)
+	}))
+	defer docs.Close()
+	host, _ := url.Parse(docs.URL)
+
+	router := mux.NewRouter()
+	require.Nil(t, server.ConfigureHandlersWithOptions(router, server.APIConfig{
+		Remittance: server.RemittanceConfig{AllowedHosts: []string{host.Hostname()}},
+	}))
+	ts := httptest.NewServer(router)
+	defer ts.Close()
+
+	input := bytes.ReplaceAll(fixtures.ReadFile(t, "related_remittance_pacs_v08.xml"), []byte("https://remittance.example.com"), []byte(docs.URL))
+	resp := postForm(t, ts.URL+"/documents", input, nil)
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+	var stored server.StoredDocument
+	require.Nil(t, json.NewDecoder(resp.Body).Decode(&stored))
+
+	readPackage := func(query string) (map[string][]byte, remittance.Manifest) {
+		t.Helper()
+		resp, err := http.Get(ts.URL + "/documents/" + stored.ID + "/package" + query)
+		require.Nil(t, err)
+		defer resp.Body.Close()
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		require.Equal(t, "application/zip", resp.Header.Get("Content-Type"))
+		buf, _ := io.ReadAll(resp.Body)
+		archive, err := zip.NewReader(bytes.NewReader(buf), int64(len(buf)))
+		require.Nil(t, err)
+		files := make(map[string][]byte)
+		for _, f := range archive.File {
+			r, err := f.Open()
+			require.Nil(t, err)
+			files[f.Name], _ = io.ReadAll(r)
+			r.Close()
+		}
+		var manifest remittance.Manifest
+		require.Nil(t, json.Unmarshal(files[remittance.ManifestName], &manifest))
+		return files, manifest
+	}
+
+	files, manifest := readPackage("")
+	require.Equal(t, input, files[stored.ID+".xml"])
+	require.Len(t, manifest.Attachments, 3)
+	require.Empty(t, manifest.Attachments[0].File)
+
+	files, manifest = readPackage("?fetch=true")
+	require.Equal(t, "remittance/1-INV-2021-0042.pdf", manifest.Attachments[0].File)
+	require.Equal(t, advice, files[manifest.Attachments[0].File])
+
+	// documents aren't fetched without allowed hosts
+	router = mux.NewRouter()
+	require.Nil(t, server.ConfigureHandlersWithOptions(router, server.APIConfig{}))
+	disabled := httptest.NewServer(router)
+	defer disabled.Close()
+	resp, err := http.Get(disabled.URL + "/documents/" + stored.ID + "/package?fetch=true")
+	require.Nil(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusNotImplemented, resp.StatusCode)
+}
@@ -0,0 +1,140 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package server
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	// Headers of the requests protected from replays
+	RequestTimestampHeader = "X-Request-Timestamp"
+	RequestNonceHeader     = "X-Request-Nonce"
+	RequestSignatureHeader = "X-Request-Signature"
+
+	// maxNonceLength bounds the nonces kept by the replay window
+	maxNonceLength = 128
+)
+
+var (
+	// ErrStaleRequest is returned when a request has no timestamp or a timestamp outside of the replay window
+	ErrStaleRequest = errors.New("stale request")
+
+	// ErrReplayedRequest is returned when the nonce of a request was seen within the replay window
+	ErrReplayedRequest = errors.New("replayed request")
+
+	// ErrInvalidRequestSignature is returned when the signature of a request doesn't match
+	ErrInvalidRequestSignature = errors.New("invalid request signature")
+)
+
+// replayGuard rejects the requests of write-style endpoints (jobs, documents, subject erasure)
+// that are stale or replay the nonce of an earlier request within the window
+type replayGuard struct {
+	window time.Duration
+	secret []byte
+	now    func() time.Time
+
+	mu     sync.Mutex
+	nonces map[string]time.Time
+}
+
+// newReplayGuard returns nil when config has no window
+func newReplayGuard(config ReplayConfig) (*replayGuard, error) {
+	if config.Window < 0 {
+		return nil, fmt.Errorf("replay window %v is negative", config.Window)
+	}
+	if config.Window == 0 {
+		return nil, nil
+	}
+	return &replayGuard{
+		window: config.Window,
+		secret: []byte(config.Secret),
+		now:    time.Now,
+		nonces: make(map[string]time.Time),
+	}, nil
+}
+
+// SignRequest returns the signature of a request for the RequestSignatureHeader, the hex
+// HMAC-SHA256 with secret of the method, path, timestamp, nonce and sha256 of body
+func SignRequest(secret []byte, method, path, timestamp, nonce string, body []byte) string {
+	digest := sha256.Sum256(body)
+	mac := hmac.New(sha256.New, secret)
+	fmt.Fprintf(mac, "%s\n%s\n%s\n%s\n%s", method, path, timestamp, nonce, hex.EncodeToString(digest[:]))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// protected rejects stale and replayed requests before calling next, 401 Unauthorized
+// unless a duplicate nonce is 409 Conflict
+func (h handlers) protected(next http.HandlerFunc) http.HandlerFunc {
+	if h.replay == nil {
+		return next
+	}
+	return func(w http.ResponseWriter, r *http.Request) {
+		if err := h.replay.check(r); err != nil {
+			code := http.StatusUnauthorized
+			if errors.Is(err, ErrReplayedRequest) {
+				code = http.StatusConflict
+			}
+			h.outputError(w, r, code, err)
+			return
+		}
+		next(w, r)
+	}
+}
+
+func (g *replayGuard) check(r *http.Request) error {
+	now := g.now()
+
+	timestamp := r.Header.Get(RequestTimestampHeader)
+	seconds, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return fmt.Errorf("%w: %s is not a unix timestamp", ErrStaleRequest, RequestTimestampHeader)
+	}
+	if sent := time.Unix(seconds, 0); sent.Before(now.Add(-g.window)) || sent.After(now.Add(g.window)) {
+		return fmt.Errorf("%w: sent at %s, outside of the %v window", ErrStaleRequest, sent.UTC().Format(time.RFC3339), g.window)
+	}
+
+	nonce := r.Header.Get(RequestNonceHeader)
+	if nonce == "" || len(nonce) > maxNonceLength {
+		return fmt.Errorf("%w: %s is omitted or longer than %d", ErrStaleRequest, RequestNonceHeader, maxNonceLength)
+	}
+
+	if len(g.secret) > 0 {
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			return err
+		}
+		r.Body = io.NopCloser(bytes.NewReader(body))
+
+		expected := SignRequest(g.secret, r.Method, r.URL.Path, timestamp, nonce, body)
+		if !hmac.Equal([]byte(expected), []byte(r.Header.Get(RequestSignatureHeader))) {
+			return ErrInvalidRequestSignature
+		}
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	for seen, expires := range g.nonces {
+		if now.After(expires) {
+			delete(g.nonces, seen)
+		}
+	}
+	if _, seen := g.nonces[nonce]; seen {
+		return fmt.Errorf("%w: nonce %s", ErrReplayedRequest, nonce)
+	}
+	// a nonce is kept as long as its timestamp is in the window
+	g.nonces[nonce] = time.Unix(seconds, 0).Add(g.window)
+	return nil
+}
@@ -0,0 +1,106 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package server_test
+
+import (
+	"bytes"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/require"
+
+	"github.com/moov-io/iso20022/pkg/iso20022test/fixtures"
+	"github.com/moov-io/iso20022/pkg/server"
+)
+
+func newReplayServer(t *testing.T, config server.ReplayConfig) *httptest.Server {
+	router := mux.NewRouter()
+	require.Nil(t, server.ConfigureHandlersWithOptions(router, server.APIConfig{Replay: config}))
+	ts := httptest.NewServer(router)
+	t.Cleanup(ts.Close)
+	return ts
+}
+
+// postReplayable posts input to /jobs sent at sent with nonce, signed with secret unless it's empty
+func postReplayable(t *testing.T, ts *httptest.Server, input []byte, secret string, sent time.Time, nonce string) *http.Response {
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	part, err := writer.CreateFormFile("input", "input")
+	require.Nil(t, err)
+	_, err = part.Write(input)
+	require.Nil(t, err)
+	require.Nil(t, writer.Close())
+
+	req, err := http.NewRequest(http.MethodPost, ts.URL+"/jobs", bytes.NewReader(body.Bytes()))
+	require.Nil(t, err)
+	timestamp := strconv.FormatInt(sent.Unix(), 10)
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	req.Header.Set(server.RequestTimestampHeader, timestamp)
+	req.Header.Set(server.RequestNonceHeader, nonce)
+	if secret != "" {
+		req.Header.Set(server.RequestSignatureHeader, server.SignRequest([]byte(secret), http.MethodPost, "/jobs", timestamp, nonce, body.Bytes()))
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	require.Nil(t, err)
+	t.Cleanup(func() { resp.Body.Close() })
+	return resp
+}
+
+func TestReplayProtection(t *testing.T) {
+	ts := newReplayServer(t, server.ReplayConfig{Window: time.Minute})
+	input := fixtures.ReadFile(t, "valid_pacs_v08.xml")
+
+	resp := postReplayable(t, ts, input, "", time.Now(), "nonce-1")
+	require.Equal(t, http.StatusAccepted, resp.StatusCode)
+
+	resp = postReplayable(t, ts, input, "", time.Now(), "nonce-1")
+	require.Equal(t, http.StatusConflict, resp.StatusCode)
+
+	resp = postReplayable(t, ts, input, "", time.Now().Add(-2*time.Minute), "nonce-2")
+	require.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+
+	resp = postReplayable(t, ts, input, "", time.Now().Add(2*time.Minute), "nonce-3")
+	require.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+
+	// requests without timestamp and nonce are rejected
+	resp = postJob(t, ts, input, nil)
+	require.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+
+	// read-only endpoints aren't protected
+	resp = postForm(t, ts.URL+"/validator", input, nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+}
+
+func TestReplayProtectionSigned(t *testing.T) {
+	ts := newReplayServer(t, server.ReplayConfig{Window: time.Minute, Secret: "shared-secret"})
+	input := fixtures.ReadFile(t, "valid_pacs_v08.xml")
+
+	resp := postReplayable(t, ts, input, "shared-secret", time.Now(), "nonce-1")
+	require.Equal(t, http.StatusAccepted, resp.StatusCode)
+
+	resp = postReplayable(t, ts, input, "shared-secret", time.Now(), "nonce-1")
+	require.Equal(t, http.StatusConflict, resp.StatusCode)
+
+	resp = postReplayable(t, ts, input, "other-secret", time.Now(), "nonce-2")
+	require.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+
+	resp = postReplayable(t, ts, input, "", time.Now(), "nonce-3")
+	require.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+
+	// a rejected signature doesn't consume the nonce
+	resp = postReplayable(t, ts, input, "shared-secret", time.Now(), "nonce-2")
+	require.Equal(t, http.StatusAccepted, resp.StatusCode)
+}
+
+func TestReplayConfig(t *testing.T) {
+	router := mux.NewRouter()
+	require.NotNil(t, server.ConfigureHandlersWithOptions(router, server.APIConfig{Replay: server.ReplayConfig{Window: -time.Second}}))
+}
@@ -0,0 +1,73 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package server
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+)
+
+const (
+	// RequestIdHeader carries the id of a request, it's generated when a client omits it
+	RequestIdHeader = "X-Request-Id"
+
+	EnvelopeStatusSuccess = "success"
+	EnvelopeStatusError   = "error"
+)
+
+// Envelope is the standardized response of the /v2 endpoints
+type Envelope struct {
+	Status    string      `json:"status"`
+	Data      interface{} `json:"data,omitempty"`
+	Errors    []string    `json:"errors,omitempty"`
+	Warnings  []string    `json:"warnings,omitempty"`
+	RequestId string      `json:"requestId"`
+
+	// Receipt describes the processing of the request when APIConfig.Receipts is set
+	Receipt *Receipt `json:"receipt,omitempty"`
+}
+
+func outputEnvelope(w http.ResponseWriter, r *http.Request, code int, data interface{}, err error, warnings ...string) {
+	envelope := Envelope{
+		Status:    EnvelopeStatusSuccess,
+		Data:      data,
+		Warnings:  warnings,
+		RequestId: requestId(r),
+	}
+	if err != nil {
+		envelope.Status = EnvelopeStatusError
+		envelope.Errors = []string{err.Error()}
+	}
+	if p := receiptOf(r); p != nil {
+		receipt := p.finish()
+		envelope.Receipt = &receipt
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.Header().Set(RequestIdHeader, envelope.RequestId)
+	w.WriteHeader(code)
+	json.NewEncoder(w).Encode(envelope)
+}
+
+// requestId returns the id of r, generating one when the client omitted it
+func requestId(r *http.Request) string {
+	if id := r.Header.Get(RequestIdHeader); id != "" {
+		return id
+	}
+	id := newId()
+	r.Header.Set(RequestIdHeader, id)
+	return id
+}
+
+// newId returns a random identifier
+func newId() string {
+	buf := make([]byte, 16)
+	if _, err := rand.Read(buf); err != nil {
+		return ""
+	}
+	return hex.EncodeToString(buf)
+}
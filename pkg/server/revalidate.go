@@ -0,0 +1,250 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/moov-io/iso20022/pkg/profile"
+	"github.com/moov-io/iso20022/pkg/service"
+	"github.com/moov-io/iso20022/pkg/storage"
+	"github.com/moov-io/iso20022/pkg/utils"
+)
+
+// ErrRevalidationRunning is returned when a revalidation starts before the last one finished
+var ErrRevalidationRunning = errors.New("revalidation is running")
+
+// revalidationSample is the number of newly invalid documents listed by a revalidation
+const revalidationSample = 100
+
+// RevalidationStatus is the progress and impact report of the last revalidation of a revalidator
+type RevalidationStatus struct {
+	ID       string    `json:"id,omitempty"`
+	Status   string    `json:"status,omitempty"`
+	Started  time.Time `json:"started,omitempty"`
+	Finished time.Time `json:"finished,omitempty"`
+	Error    string    `json:"error,omitempty"`
+
+	// MessageType and Since select the revalidated documents
+	MessageType string    `json:"messageType,omitempty"`
+	Since       time.Time `json:"since,omitempty"`
+
+	// Total is the number of selected documents, Unreadable the documents that can't be parsed
+	// and Skipped the documents of message types neither profile supports
+	Total      int `json:"total"`
+	Unreadable int `json:"unreadable"`
+	Skipped    int `json:"skipped"`
+
+	// Report compares the findings of the current and the candidate profile
+	Report *profile.ComparisonSummary `json:"report,omitempty"`
+
+	// NewlyInvalid are the ids of the first documents invalid for the candidate profile only
+	NewlyInvalid []string `json:"newlyInvalid,omitempty"`
+}
+
+// Revalidator revalidates the stored documents against a new version of a profile
+//
+// Every original document is evaluated against the current and the candidate profile at its
+// creation time, the report counts the documents newly failing by rule.
+type Revalidator struct {
+	store storage.Store
+
+	mu      sync.Mutex
+	status  RevalidationStatus
+	running bool
+}
+
+// NewRevalidator returns a revalidator of the documents of store
+func NewRevalidator(store storage.Store) *Revalidator {
+	return &Revalidator{store: store}
+}
+
+// Status returns the status of the last revalidation
+func (v *Revalidator) Status() RevalidationStatus {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	status := v.status
+	if status.Report != nil {
+		report := *status.Report
+		report.Added = copyCounts(report.Added)
+		report.Removed = copyCounts(report.Removed)
+		report.Failing = copyCounts(report.Failing)
+		status.Report = &report
+	}
+	status.NewlyInvalid = append([]string(nil), status.NewlyInvalid...)
+	return status
+}
+
+// Start revalidates the stored documents of messageType, or every type when empty, created
+// since since in the background, comparing current and candidate
+func (v *Revalidator) Start(current, candidate *profile.Profile, messageType string, since time.Time) (RevalidationStatus, error) {
+	v.mu.Lock()
+	if v.running {
+		v.mu.Unlock()
+		return v.Status(), ErrRevalidationRunning
+	}
+	v.running = true
+	v.status = RevalidationStatus{
+		ID:          newId(),
+		Status:      JobStatusRunning,
+		Started:     time.Now().UTC(),
+		MessageType: messageType,
+		Since:       since,
+		Report:      profile.NewComparisonSummary(current, candidate),
+	}
+	v.mu.Unlock()
+
+	go v.run(current, candidate)
+	return v.Status(), nil
+}
+
+func (v *Revalidator) run(current, candidate *profile.Profile) {
+	records, err := v.store.List(context.Background())
+
+	v.mu.Lock()
+	defer func() {
+		v.status.Finished = time.Now().UTC()
+		v.running = false
+		v.mu.Unlock()
+	}()
+	if err != nil {
+		v.status.Status = JobStatusFailed
+		v.status.Error = err.Error()
+		return
+	}
+
+	messageType, since := v.status.MessageType, v.status.Since
+	for _, rec := range records {
+		if rec.Kind != "" && rec.Kind != storage.KindOriginal {
+			continue
+		}
+		if (messageType != "" && rec.MessageType != messageType) || rec.Created.Before(since) {
+			continue
+		}
+		v.status.Total++
+
+		// documents are evaluated without holding the lock, the status is only read meanwhile
+		v.mu.Unlock()
+		comparison, err := revalidate(rec, current, candidate)
+		v.mu.Lock()
+
+		if err != nil {
+			v.status.Unreadable++
+			continue
+		}
+		if comparison == nil {
+			v.status.Skipped++
+			continue
+		}
+		v.status.Report.Add(comparison)
+		if comparison.NewlyInvalid() && len(v.status.NewlyInvalid) < revalidationSample {
+			v.status.NewlyInvalid = append(v.status.NewlyInvalid, rec.ID)
+		}
+	}
+	v.status.Status = JobStatusCompleted
+}
+
+// revalidate compares the findings of current and candidate for the document of rec, nil when
+// neither profile supports its message type
+func revalidate(rec storage.Record, current, candidate *profile.Profile) (*profile.Comparison, error) {
+	doc, err := service.Parse(bytes.NewReader(rec.Content))
+	if err != nil {
+		return nil, err
+	}
+	messageType := utils.GetMessageType(doc.NameSpace())
+	if !current.Supports(messageType) && !candidate.Supports(messageType) {
+		return nil, nil
+	}
+	return profile.Compare(current, candidate, profile.Input{Document: doc, Now: rec.Created}), nil
+}
+
+// RevalidationHandler - admin endpoint inspecting (GET) the status and impact report of the last
+// revalidation of revalidator and starting (POST) a revalidation of the stored documents
+//
+// The candidate profile is the registered profile named by the candidate parameter or the yaml
+// definition of the request body, the current profile is the registered profile named by the
+// current parameter and defaults to the registered profile with the name of the candidate. The
+// messageType and since (RFC 3339) parameters select the documents. A revalidation starting
+// before the last one finished responds with 409.
+func RevalidationHandler(revalidator *Revalidator) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		respond := func(code int, body interface{}) {
+			w.Header().Set("Content-Type", "application/json; charset=utf-8")
+			w.WriteHeader(code)
+			json.NewEncoder(w).Encode(body)
+		}
+		fail := func(code int, err error) {
+			respond(code, map[string]interface{}{"error": err.Error()})
+		}
+
+		switch r.Method {
+		case http.MethodGet:
+			respond(http.StatusOK, revalidator.Status())
+			return
+		case http.MethodPost:
+		default:
+			w.Header().Set("Allow", "GET, POST")
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+
+		query := r.URL.Query()
+		candidate, err := revalidationCandidate(r)
+		if err != nil {
+			fail(http.StatusBadRequest, err)
+			return
+		}
+		currentName := query.Get("current")
+		if currentName == "" {
+			currentName = candidate.Name
+		}
+		current, err := profile.Get(currentName)
+		if err != nil {
+			fail(http.StatusBadRequest, err)
+			return
+		}
+		var since time.Time
+		if value := query.Get("since"); value != "" {
+			if since, err = time.Parse(time.RFC3339, value); err != nil {
+				fail(http.StatusBadRequest, fmt.Errorf("since %q is not an RFC 3339 time", value))
+				return
+			}
+		}
+
+		status, err := revalidator.Start(current, candidate, query.Get("messageType"), since)
+		if err != nil {
+			respond(http.StatusConflict, status)
+			return
+		}
+		respond(http.StatusAccepted, status)
+	}
+}
+
+// revalidationCandidate returns the candidate profile of a revalidation request
+func revalidationCandidate(r *http.Request) (*profile.Profile, error) {
+	if name := r.URL.Query().Get("candidate"); name != "" {
+		return profile.Get(name)
+	}
+	buf, err := io.ReadAll(r.Body)
+	if err != nil {
+		return nil, err
+	}
+	if len(bytes.TrimSpace(buf)) == 0 {
+		return nil, errors.New("candidate profile is omitted")
+	}
+	cfg, err := profile.ParseConfig(buf)
+	if err != nil {
+		return nil, err
+	}
+	return profile.NewProfile(cfg)
+}
@@ -0,0 +1,115 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package server_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/moov-io/iso20022/pkg/iso20022test/fixtures"
+	"github.com/moov-io/iso20022/pkg/profile"
+	"github.com/moov-io/iso20022/pkg/server"
+	"github.com/moov-io/iso20022/pkg/storage"
+)
+
+func TestRevalidationHandler(t *testing.T) {
+	created := time.Date(2021, 4, 15, 10, 29, 59, 0, time.UTC)
+	store := storage.NewMemoryStore()
+	for _, rec := range []storage.Record{
+		{ID: "payment", Kind: storage.KindOriginal, MessageType: "pacs.008.001.08", Content: fixtures.ReadFile(t, "sct_inst_pacs_v08.xml"), Created: created},
+		{ID: "statement", Kind: storage.KindOriginal, MessageType: "camt.053.001.08", Content: fixtures.ReadFile(t, "valid_camt053_v08.xml"), Created: created},
+		{ID: "unreadable", Kind: storage.KindOriginal, MessageType: "pacs.008.001.08", Content: []byte("<Document/>"), Created: created},
+		{ID: "old", Kind: storage.KindOriginal, MessageType: "pacs.008.001.08", Content: fixtures.ReadFile(t, "sct_inst_pacs_v08.xml"), Created: created.AddDate(-1, 0, 0)},
+	} {
+		require.Nil(t, store.Put(context.Background(), rec))
+	}
+
+	handler := server.RevalidationHandler(server.NewRevalidator(store))
+	request := func(method, target, body string) (*httptest.ResponseRecorder, server.RevalidationStatus) {
+		recorder := httptest.NewRecorder()
+		handler(recorder, httptest.NewRequest(method, target, strings.NewReader(body)))
+		var status server.RevalidationStatus
+		json.NewDecoder(recorder.Body).Decode(&status)
+		return recorder, status
+	}
+
+	candidate := `
+name: SCTInst
+version: "2"
+extends: SCTInst
+rules:
+  - id: CAND-001
+    expression: "exists(PmtId/UETR)"
+  - id: CAND-002
+    severity: warning
+    expression: "IntrBkSttlmAmt <= 0.01"
+`
+	recorder, status := request(http.MethodPost, "/storage/revalidate?since=2021-01-01T00:00:00Z", candidate)
+	require.Equal(t, http.StatusAccepted, recorder.Code)
+	require.NotEmpty(t, status.ID)
+	require.Equal(t, "SCTInst@2", status.Report.Candidate)
+
+	require.Eventually(t, func() bool {
+		_, status = request(http.MethodGet, "/storage/revalidate", "")
+		return status.Status == server.JobStatusCompleted
+	}, 5*time.Second, 10*time.Millisecond)
+	require.Equal(t, 3, status.Total)
+	require.Equal(t, 1, status.Unreadable)
+	require.Equal(t, 1, status.Skipped)
+	require.Equal(t, 1, status.Report.Documents)
+	require.Equal(t, 1, status.Report.NewlyInvalid)
+	require.Equal(t, map[string]int{"CAND-001": 1}, status.Report.Failing)
+	require.Equal(t, map[string]int{"CAND-001": 1, "CAND-002": 1}, status.Report.Added)
+	require.Equal(t, []string{"payment"}, status.NewlyInvalid)
+
+	// a registered candidate compared with another current profile
+	recorder, status = request(http.MethodPost, "/storage/revalidate?candidate=SCTInst&current=SCTInst&messageType=camt.053.001.08", "")
+	require.Equal(t, http.StatusAccepted, recorder.Code)
+	require.Eventually(t, func() bool {
+		_, status = request(http.MethodGet, "/storage/revalidate", "")
+		return status.Status == server.JobStatusCompleted
+	}, 5*time.Second, 10*time.Millisecond)
+	require.Equal(t, 1, status.Total)
+	require.Equal(t, 1, status.Skipped)
+
+	for _, target := range []string{"/storage/revalidate", "/storage/revalidate?candidate=Unknown", "/storage/revalidate?candidate=SCTInst&current=Unknown", "/storage/revalidate?candidate=SCTInst&since=yesterday"} {
+		recorder, _ = request(http.MethodPost, target, "")
+		require.Equal(t, http.StatusBadRequest, recorder.Code, target)
+	}
+	recorder, _ = request(http.MethodPost, "/storage/revalidate", "name: {")
+	require.Equal(t, http.StatusBadRequest, recorder.Code)
+	recorder, _ = request(http.MethodDelete, "/storage/revalidate", "")
+	require.Equal(t, http.StatusMethodNotAllowed, recorder.Code)
+}
+
+func TestRevalidatorRunning(t *testing.T) {
+	store := storage.NewMemoryStore()
+	for i := 0; i < 200; i++ {
+		rec := storage.Record{ID: fmt.Sprintf("payment%03d", i), Kind: storage.KindOriginal, Content: fixtures.ReadFile(t, "sct_inst_pacs_v08.xml"), Created: time.Now()}
+		require.Nil(t, store.Put(context.Background(), rec))
+	}
+	revalidator := server.NewRevalidator(store)
+	p, err := profile.Get(profile.SCTInstName)
+	require.Nil(t, err)
+
+	_, err = revalidator.Start(p, p, "", time.Time{})
+	require.Nil(t, err)
+	if _, err = revalidator.Start(p, p, "", time.Time{}); err != nil {
+		require.ErrorIs(t, err, server.ErrRevalidationRunning)
+	}
+	require.Eventually(t, func() bool {
+		return revalidator.Status().Status == server.JobStatusCompleted
+	}, 5*time.Second, 10*time.Millisecond)
+	_, err = revalidator.Start(p, p, "", time.Time{})
+	require.Nil(t, err)
+}
@@ -0,0 +1,346 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package server
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"github.com/moov-io/iso20022/pkg/corridor"
+	"github.com/moov-io/iso20022/pkg/document"
+	"github.com/moov-io/iso20022/pkg/largevalue"
+	"github.com/moov-io/iso20022/pkg/service"
+	"github.com/moov-io/iso20022/pkg/storage"
+	"github.com/moov-io/iso20022/pkg/summary"
+	"github.com/moov-io/iso20022/pkg/utils"
+)
+
+const (
+	// Review statuses of the documents held by a review rule
+	ReviewStatusPending  = "PENDING_REVIEW"
+	ReviewStatusReleased = "RELEASED"
+	ReviewStatusRejected = "REJECTED"
+
+	// Actions of the audit records of reviews
+	ReviewActionHold    = "hold"
+	ReviewActionRelease = "release"
+	ReviewActionReject  = "reject"
+)
+
+// ErrNotPendingReview is returned for decisions on documents that aren't pending review
+var ErrNotPendingReview = errors.New("document isn't pending review")
+
+//go:embed web/reviews.html
+var reviewsPage []byte
+
+// ReviewConfig - Defines the documents of POST /documents held for a manual review
+type ReviewConfig struct {
+	// Rules hold the documents matching one of them, documents aren't held when omitted
+	Rules []ReviewRule
+
+	// FourEyes keeps the messages composed from templates with POST /templates/{name}/compose
+	// pending approval, they're released by a tenant other than the one composing them. Tenants
+	// are identified by the API keys of Usage.Tenants.
+	FourEyes bool
+}
+
+// ReviewRule - Defines the documents held for review, a document matches every criterion of
+// the rule that is set
+type ReviewRule struct {
+	Name string
+
+	// MessageTypes are the held message types, e.g. pacs.009.001.08
+	MessageTypes []string
+
+	// Currencies and MinAmount hold the documents with a transaction in one of the currencies
+	// from the amount
+	Currencies []string
+	MinAmount  float64
+
+	// LargeValue holds the documents with a large value transaction, see APIConfig.LargeValues
+	LargeValue bool
+
+	// Flagged holds the documents with a payment flagged by a corridor rule
+	Flagged bool
+}
+
+func (rule ReviewRule) matches(messageType string, transactions []summary.Transaction, largeValue, flagged bool) bool {
+	if len(rule.MessageTypes) > 0 && !containsFold(rule.MessageTypes, messageType) {
+		return false
+	}
+	if (rule.LargeValue && !largeValue) || (rule.Flagged && !flagged) {
+		return false
+	}
+	if len(rule.Currencies) == 0 && rule.MinAmount == 0 {
+		return true
+	}
+	for _, tx := range transactions {
+		if (len(rule.Currencies) == 0 || containsFold(rule.Currencies, tx.Currency)) && tx.Amount >= rule.MinAmount {
+			return true
+		}
+	}
+	return false
+}
+
+func containsFold(values []string, value string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+	return false
+}
+
+// ReviewDecision is the body of the release and reject requests of a held document
+type ReviewDecision struct {
+	Operator string `json:"operator"`
+	Comment  string `json:"comment,omitempty"`
+}
+
+// ReviewAudit records the hold of a document and the decisions of operators
+type ReviewAudit struct {
+	ID       string `json:"id"`
+	Action   string `json:"action"`
+	Document string `json:"document"`
+
+	// Rules are the review rules holding the document
+	Rules    []string `json:"rules,omitempty"`
+	Operator string   `json:"operator,omitempty"`
+	Comment  string   `json:"comment,omitempty"`
+
+	// Tenant and Key identify the API key composing or deciding on a message held for four-eyes
+	// approval, Key is the hex sha-256 digest of the key
+	Tenant string    `json:"tenant,omitempty"`
+	Key    string    `json:"key,omitempty"`
+	Time   time.Time `json:"time"`
+}
+
+// ReviewItem is a document held for review with its audit records
+type ReviewItem struct {
+	StoredDocument
+	Rules   []string      `json:"rules"`
+	History []ReviewAudit `json:"history"`
+}
+
+// reviewQueue holds the documents matching its rules, decisions are serialized so a document
+// is released or rejected once
+type reviewQueue struct {
+	rules    []ReviewRule
+	fourEyes bool
+
+	mu sync.Mutex
+}
+
+func newReviewQueue(config ReviewConfig) (*reviewQueue, error) {
+	names := make(map[string]bool)
+	for i, rule := range config.Rules {
+		if rule.Name == "" {
+			return nil, fmt.Errorf("review rule %d: name is omitted", i)
+		}
+		if names[rule.Name] {
+			return nil, fmt.Errorf("review rule %s: name is duplicated", rule.Name)
+		}
+		names[rule.Name] = true
+		if rule.MinAmount < 0 {
+			return nil, fmt.Errorf("review rule %s: min amount %v is negative", rule.Name, rule.MinAmount)
+		}
+		if len(rule.MessageTypes) == 0 && len(rule.Currencies) == 0 && rule.MinAmount == 0 && !rule.LargeValue && !rule.Flagged {
+			return nil, fmt.Errorf("review rule %s: has no criteria", rule.Name)
+		}
+	}
+	return &reviewQueue{rules: config.Rules, fourEyes: config.FourEyes}, nil
+}
+
+// hold returns the names of the rules holding doc, given the corridor rules flagging its
+// payments and the large value thresholds of its transactions
+func (q *reviewQueue) hold(doc document.Iso20022Document, corridors *corridor.Policy, largeValues largevalue.Thresholds) []string {
+	if len(q.rules) == 0 {
+		return nil
+	}
+	messageType := utils.GetMessageType(doc.NameSpace())
+	transactions := summary.NewSummary(doc).Transactions
+	largeValue := len(largeValues.Transactions(doc)) > 0
+	flagged := corridors != nil && len(corridors.Check(doc).Flagged()) > 0
+
+	var names []string
+	for _, rule := range q.rules {
+		if rule.matches(messageType, transactions, largeValue, flagged) {
+			names = append(names, rule.Name)
+		}
+	}
+	return names
+}
+
+// reviewAudit stores the audit record of a review
+func (h handlers) reviewAudit(r *http.Request, audit ReviewAudit) (ReviewAudit, error) {
+	audit.ID = newId()
+	audit.Time = time.Now().UTC()
+	content, err := json.Marshal(audit)
+	if err != nil {
+		return audit, err
+	}
+	return audit, h.documents.Put(r.Context(), storage.Record{
+		ID:      audit.ID,
+		Kind:    storage.KindAudit,
+		Format:  utils.DocumentTypeJson,
+		Content: content,
+		Created: audit.Time,
+		Updated: audit.Time,
+	})
+}
+
+// reviews - list the documents held for review with the status parameter, PENDING_REVIEW by default
+func (h handlers) reviews(w http.ResponseWriter, r *http.Request) {
+	status := r.FormValue("status")
+	if status == "" {
+		status = ReviewStatusPending
+	}
+	switch status {
+	case ReviewStatusPending, ReviewStatusPendingApproval, ReviewStatusReleased, ReviewStatusRejected:
+	default:
+		h.outputError(w, r, http.StatusBadRequest, fmt.Errorf("status %q is not %s, %s, %s or %s", status, ReviewStatusPending, ReviewStatusPendingApproval, ReviewStatusReleased, ReviewStatusRejected))
+		return
+	}
+
+	records, err := h.documents.List(r.Context())
+	if err != nil {
+		h.outputError(w, r, http.StatusInternalServerError, err)
+		return
+	}
+	histories := make(map[string][]ReviewAudit)
+	for _, rec := range records {
+		if rec.Kind != storage.KindAudit {
+			continue
+		}
+		// audits of data subject requests have no document
+		var audit ReviewAudit
+		if json.Unmarshal(rec.Content, &audit) == nil && audit.Document != "" {
+			histories[audit.Document] = append(histories[audit.Document], audit)
+		}
+	}
+
+	items := []ReviewItem{}
+	for _, rec := range records {
+		if rec.Kind == storage.KindAudit || rec.Status != status {
+			continue
+		}
+		item := ReviewItem{StoredDocument: newStoredDocument(rec), Rules: []string{}, History: histories[rec.ID]}
+		sort.Slice(item.History, func(i, j int) bool { return item.History[i].Time.Before(item.History[j].Time) })
+		for _, audit := range item.History {
+			if audit.Action == ReviewActionHold {
+				item.Rules = audit.Rules
+			}
+		}
+		items = append(items, item)
+	}
+	h.outputData(w, r, http.StatusOK, items)
+}
+
+// releaseReview - release a document held for review, its events are emitted
+func (h handlers) releaseReview(w http.ResponseWriter, r *http.Request) {
+	h.decideReview(w, r, ReviewActionRelease, ReviewStatusReleased)
+}
+
+// rejectReview - reject a document held for review
+func (h handlers) rejectReview(w http.ResponseWriter, r *http.Request) {
+	h.decideReview(w, r, ReviewActionReject, ReviewStatusRejected)
+}
+
+// decideReview changes the status of the pending document of r to status and audits the
+// decision, 409 Conflict when the document isn't pending review. Decisions on composed messages
+// are taken by an identified tenant, 403 Forbidden when the composing one releases its message.
+func (h handlers) decideReview(w http.ResponseWriter, r *http.Request, action, status string) {
+	var decision ReviewDecision
+	if err := json.NewDecoder(r.Body).Decode(&decision); err != nil {
+		h.outputError(w, r, http.StatusBadRequest, err)
+		return
+	}
+	if strings.TrimSpace(decision.Operator) == "" {
+		h.outputError(w, r, http.StatusBadRequest, errors.New("operator is omitted"))
+		return
+	}
+
+	h.review.mu.Lock()
+	defer h.review.mu.Unlock()
+
+	rec, err := h.documents.Get(r.Context(), mux.Vars(r)["id"])
+	if err == nil && rec.Kind == storage.KindAudit {
+		err = fmt.Errorf("%w: %s", storage.ErrNotFound, rec.ID)
+	}
+	if errors.Is(err, storage.ErrNotFound) || errors.Is(err, storage.ErrInvalidID) {
+		h.outputError(w, r, http.StatusNotFound, err)
+		return
+	}
+	if err != nil {
+		h.outputError(w, r, http.StatusInternalServerError, err)
+		return
+	}
+	if rec.Status != ReviewStatusPending && rec.Status != ReviewStatusPendingApproval {
+		h.outputError(w, r, http.StatusConflict, fmt.Errorf("%w: %s is %s", ErrNotPendingReview, rec.ID, strings.ToLower(rec.Status)))
+		return
+	}
+
+	var decider approver
+	if rec.Status == ReviewStatusPendingApproval {
+		if h.usage == nil {
+			h.outputError(w, r, http.StatusNotImplemented, ErrFourEyesNotConfigured)
+			return
+		}
+		if decider, err = h.approvalKey(r); err != nil {
+			h.outputError(w, r, http.StatusUnauthorized, err)
+			return
+		}
+		composer, err := h.composer(r.Context(), rec.ID)
+		if err != nil {
+			h.outputError(w, r, http.StatusInternalServerError, err)
+			return
+		}
+		if action == ReviewActionRelease && decider.key == composer.key {
+			h.outputError(w, r, http.StatusForbidden, fmt.Errorf("%w: a key of %s composed %s", ErrSelfApproval, decider.tenant, rec.ID))
+			return
+		}
+	}
+
+	var doc document.Iso20022Document
+	if action == ReviewActionRelease {
+		if doc, err = service.Parse(bytes.NewReader(rec.Content)); err != nil {
+			h.outputError(w, r, http.StatusInternalServerError, err)
+			return
+		}
+	}
+	rec.Status = status
+	rec.Updated = time.Now().UTC()
+	if err = h.documents.Put(r.Context(), rec); err != nil {
+		h.outputError(w, r, http.StatusInternalServerError, err)
+		return
+	}
+	audit, err := h.reviewAudit(r, ReviewAudit{Action: action, Document: rec.ID, Operator: decision.Operator, Comment: decision.Comment, Tenant: decider.tenant, Key: decider.key})
+	if err != nil {
+		h.outputError(w, r, http.StatusInternalServerError, err)
+		return
+	}
+	if doc != nil {
+		h.events.emit(r.Context(), rec.ID, doc)
+		h.counterparty.receive(r.Context(), rec.ID, doc)
+	}
+	h.outputData(w, r, http.StatusOK, audit)
+}
+
+// reviewsConsole - page listing the documents pending review for operators to release or reject them
+func (h handlers) reviewsConsole(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	w.Write(reviewsPage)
+}
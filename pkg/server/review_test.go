@@ -0,0 +1,156 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package server_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/require"
+
+	"github.com/moov-io/iso20022/pkg/corridor"
+	"github.com/moov-io/iso20022/pkg/events"
+	"github.com/moov-io/iso20022/pkg/iso20022test/fixtures"
+	"github.com/moov-io/iso20022/pkg/server"
+)
+
+func getReviews(t *testing.T, url string) []server.ReviewItem {
+	resp, err := http.Get(url)
+	require.Nil(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var items []server.ReviewItem
+	require.Nil(t, json.NewDecoder(resp.Body).Decode(&items))
+	return items
+}
+
+func decideReview(t *testing.T, url, body string) *http.Response {
+	resp, err := http.Post(url, "application/json", strings.NewReader(body))
+	require.Nil(t, err)
+	t.Cleanup(func() { resp.Body.Close() })
+	return resp
+}
+
+func TestReview(t *testing.T) {
+	received := make(chan events.Event, 10)
+	webhook := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var event events.Event
+		require.Nil(t, json.NewDecoder(r.Body).Decode(&event))
+		received <- event
+	}))
+	defer webhook.Close()
+
+	router := mux.NewRouter()
+	require.Nil(t, server.ConfigureHandlersWithOptions(router, server.APIConfig{
+		Events: server.EventsConfig{Webhook: webhook.URL},
+		Review: server.ReviewConfig{Rules: []server.ReviewRule{
+			{Name: "large-usd", Currencies: []string{"usd"}, MinAmount: 100000},
+			{Name: "camt", MessageTypes: []string{"camt.053.001.08"}},
+		}},
+	}))
+	ts := httptest.NewServer(router)
+	defer ts.Close()
+
+	// the held document isn't emitted
+	resp := postForm(t, ts.URL+"/documents", fixtures.ReadFile(t, "valid_pacs_v08.xml"), nil)
+	require.Equal(t, http.StatusAccepted, resp.StatusCode)
+	var held server.StoredDocument
+	require.Nil(t, json.NewDecoder(resp.Body).Decode(&held))
+	require.Equal(t, server.ReviewStatusPending, held.Status)
+	select {
+	case event := <-received:
+		t.Fatalf("held document emitted %s", event.Type)
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	items := getReviews(t, ts.URL+"/reviews")
+	require.Len(t, items, 1)
+	require.Equal(t, held.ID, items[0].ID)
+	require.Equal(t, []string{"large-usd"}, items[0].Rules)
+	require.Len(t, items[0].History, 1)
+	require.Equal(t, server.ReviewActionHold, items[0].History[0].Action)
+
+	require.Equal(t, http.StatusBadRequest, decideReview(t, ts.URL+"/reviews/"+held.ID+"/release", `{}`).StatusCode)
+	require.Equal(t, http.StatusNotFound, decideReview(t, ts.URL+"/reviews/missing/release", `{"operator":"alice"}`).StatusCode)
+
+	resp = decideReview(t, ts.URL+"/reviews/"+held.ID+"/release", `{"operator":"alice","comment":"known customer"}`)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	var audit server.ReviewAudit
+	require.Nil(t, json.NewDecoder(resp.Body).Decode(&audit))
+	require.Equal(t, server.ReviewActionRelease, audit.Action)
+	require.Equal(t, "alice", audit.Operator)
+
+	// released documents are emitted
+	for i := 0; i < 2; i++ {
+		select {
+		case event := <-received:
+			require.Equal(t, held.ID, event.DocumentID)
+		case <-time.After(5 * time.Second):
+			t.Fatal("no event received")
+		}
+	}
+
+	// decisions are final
+	resp = decideReview(t, ts.URL+"/reviews/"+held.ID+"/reject", `{"operator":"bob"}`)
+	require.Equal(t, http.StatusConflict, resp.StatusCode)
+
+	require.Empty(t, getReviews(t, ts.URL+"/reviews"))
+	items = getReviews(t, ts.URL+"/reviews?status=RELEASED")
+	require.Len(t, items, 1)
+	require.Equal(t, server.ReviewStatusReleased, items[0].Status)
+	require.Len(t, items[0].History, 2)
+	require.Equal(t, "known customer", items[0].History[1].Comment)
+
+	// rejected documents stay rejected
+	resp = postForm(t, ts.URL+"/documents", fixtures.ReadFile(t, "valid_camt053_v08.xml"), nil)
+	require.Equal(t, http.StatusAccepted, resp.StatusCode)
+	require.Nil(t, json.NewDecoder(resp.Body).Decode(&held))
+	require.Equal(t, http.StatusOK, decideReview(t, ts.URL+"/reviews/"+held.ID+"/reject", `{"operator":"bob"}`).StatusCode)
+	items = getReviews(t, ts.URL+"/reviews?status=REJECTED")
+	require.Len(t, items, 1)
+	require.Equal(t, []string{"camt"}, items[0].Rules)
+
+	resp, err := http.Get(ts.URL + "/reviews?status=LOST")
+	require.Nil(t, err)
+	resp.Body.Close()
+	require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+	resp, err = http.Get(ts.URL + "/v2/reviews/console")
+	require.Nil(t, err)
+	resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Contains(t, resp.Header.Get("Content-Type"), "text/html")
+}
+
+func TestReviewFlagged(t *testing.T) {
+	router := mux.NewRouter()
+	require.Nil(t, server.ConfigureHandlersWithOptions(router, server.APIConfig{
+		Corridors:   []corridor.Rule{{Name: "to-germany", CreditorCountries: []string{"DE"}, Action: corridor.ActionFlag}},
+		LargeValues: map[string]float64{"USD": 10000},
+		Review:      server.ReviewConfig{Rules: []server.ReviewRule{{Name: "flagged-large", Flagged: true, LargeValue: true}}},
+	}))
+	ts := httptest.NewServer(router)
+	defer ts.Close()
+
+	resp := postForm(t, ts.URL+"/documents", fixtures.ReadFile(t, "valid_pacs_v08.xml"), nil)
+	require.Equal(t, http.StatusAccepted, resp.StatusCode)
+	require.Len(t, getReviews(t, ts.URL+"/reviews"), 1)
+
+	for _, rules := range [][]server.ReviewRule{
+		{{Currencies: []string{"USD"}}},
+		{{Name: "any"}},
+		{{Name: "usd", Currencies: []string{"USD"}}, {Name: "usd", MinAmount: 1}},
+		{{Name: "negative", MinAmount: -1}},
+	} {
+		err := server.ConfigureHandlersWithOptions(mux.NewRouter(), server.APIConfig{Review: server.ReviewConfig{Rules: rules}})
+		require.Error(t, err)
+	}
+}
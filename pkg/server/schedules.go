@@ -0,0 +1,198 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/moov-io/base/log"
+
+	"github.com/moov-io/iso20022/pkg/bucket"
+	"github.com/moov-io/iso20022/pkg/dryrun"
+	"github.com/moov-io/iso20022/pkg/pipeline"
+	"github.com/moov-io/iso20022/pkg/schedule"
+	"github.com/moov-io/iso20022/pkg/sigv4"
+	"github.com/moov-io/iso20022/pkg/sqs"
+	"github.com/moov-io/iso20022/pkg/templates"
+)
+
+const scheduleWebhookTimeout = 10 * time.Second
+
+// SchedulesConfig - Defines the files generated from the templates of /templates on schedules
+type SchedulesConfig struct {
+	// Jobs instantiate a template on their cron schedule and deliver the validated file to
+	// one of the Destinations
+	Jobs         []schedule.Job
+	Destinations []DestinationConfig
+
+	// AlertWebhook is posted the failed runs of jobs, they're logged otherwise
+	AlertWebhook string
+
+	// History is the number of runs kept by job, 100 when omitted
+	History int
+}
+
+// DestinationConfig - Defines where the files of scheduled jobs are delivered, one of
+// Directory, Bucket and Queue is set
+type DestinationConfig struct {
+	Name string
+
+	// Directory receives the files
+	Directory string
+
+	// Bucket is an S3 bucket receiving the files, Queue the url of an SQS queue they're sent to,
+	// calls are signed with the AWS_* credentials of the environment
+	Bucket string
+	Queue  string
+	Region string
+
+	// NameTemplate names the files of Directory and Bucket, see pipeline.Layout, the name of the
+	// run (e.g. payroll-20261025T060000.xml) when omitted
+	NameTemplate string
+}
+
+// ScheduleAlert is posted to the alert webhook of the schedules when a run fails
+type ScheduleAlert struct {
+	Time time.Time    `json:"time"`
+	Run  schedule.Run `json:"run"`
+}
+
+func (config DestinationConfig) sink() (pipeline.Sink, error) {
+	layout := pipeline.Layout{Dir: config.Directory, NameTemplate: config.NameTemplate}
+	if layout.NameTemplate == "" {
+		layout.NameTemplate = "{name}{ext}"
+	}
+	switch {
+	case config.Directory != "":
+		return pipeline.Output(layout), nil
+	case config.Bucket != "":
+		layout.Dir = ""
+		return bucket.Output(bucket.NewS3(config.Bucket, config.Region, sigv4.EnvCredentials()), layout), nil
+	case config.Queue != "":
+		return sqs.New(config.Region, sqs.EnvCredentials()).Queue(config.Queue, 1).Forward(nil), nil
+	}
+	return nil, fmt.Errorf("destination %s has no directory, bucket nor queue", config.Name)
+}
+
+// newScheduler returns nil when config has no job, failed runs are posted to the alert
+// webhook with the policy of outbound
+func newScheduler(config SchedulesConfig, library templates.Library, outbound OutboundConfig, logger log.Logger) (*schedule.Scheduler, error) {
+	if len(config.Jobs) == 0 {
+		return nil, nil
+	}
+	destinations := make(map[string]pipeline.Sink)
+	for _, destination := range config.Destinations {
+		if destination.Name == "" {
+			return nil, errors.New("destination name is omitted")
+		}
+		sink, err := destination.sink()
+		if err != nil {
+			return nil, err
+		}
+		destinations[destination.Name] = dryrun.Sink(OutboundSchedules, destination.Name, outbound.DryRun, sink)
+	}
+
+	alert := func(run schedule.Run) {
+		logger.With(log.Fields{
+			"job":   log.String(run.Job),
+			"stage": log.String(run.Stage),
+		}).LogErrorf("scheduled job failed: %s", run.Error)
+	}
+	if config.AlertWebhook != "" {
+		client := newOutboundClient(outbound, OutboundScheduleAlerts, scheduleWebhookTimeout)
+		logAlert := alert
+		alert = func(run schedule.Run) {
+			logAlert(run)
+			postScheduleAlert(client, config.AlertWebhook, run, logger)
+		}
+	}
+	opts := []schedule.Option{schedule.WithAlerts(alert), schedule.WithHistory(config.History)}
+	if outbound.DryRun {
+		opts = append(opts, schedule.WithDryRun())
+	}
+	return schedule.New(library, destinations, config.Jobs, opts...)
+}
+
+// checkSchedulesConfig checks the jobs and destinations of config like newScheduler, the
+// scheduler isn't run and the templates of jobs are checked when they're instantiated
+func checkSchedulesConfig(config SchedulesConfig, outbound OutboundConfig) error {
+	_, err := newScheduler(config, templates.NewMemoryLibrary(), outbound, log.NewNopLogger())
+	return err
+}
+
+// postScheduleAlert posts the alert of run to webhook, failures are logged. Alerts of dry runs
+// are rehearsed too.
+func postScheduleAlert(client *http.Client, webhook string, run schedule.Run, logger log.Logger) {
+	logger = logger.With(log.Fields{"job": log.String(run.Job)})
+	body, err := json.Marshal(ScheduleAlert{Time: time.Now().UTC(), Run: run})
+	if err != nil {
+		logger.LogErrorf("schedule alert: %v", err)
+		return
+	}
+	ctx := context.Background()
+	if run.DryRun {
+		ctx = dryrun.With(ctx)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook, bytes.NewReader(body))
+	if err != nil {
+		logger.LogErrorf("schedule alert: %v", err)
+		return
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := client.Do(req)
+	if err != nil {
+		logger.LogErrorf("schedule alert: %v", err)
+		return
+	}
+	resp.Body.Close()
+	if resp.StatusCode >= http.StatusMultipleChoices {
+		logger.LogErrorf("schedule alert: webhook responded %s", resp.Status)
+		return
+	}
+	logger.Info().Log("schedule alert sent")
+}
+
+// ScheduleHandler - admin endpoint listing (GET) the scheduled jobs with their next run and
+// history, and running the job of the job parameter immediately (POST), a dry run with dryRun=true
+func ScheduleHandler(scheduler *schedule.Scheduler) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var body interface{}
+		code := http.StatusOK
+
+		switch r.Method {
+		case http.MethodGet:
+			body = scheduler.Jobs()
+		case http.MethodPost:
+			ctx := r.Context()
+			if dry, _ := strconv.ParseBool(r.FormValue("dryRun")); dry {
+				ctx = dryrun.With(ctx)
+			}
+			run, err := scheduler.RunJob(ctx, r.FormValue("job"))
+			if errors.Is(err, schedule.ErrUnknownJob) {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			if run.Status == schedule.RunStatusFailed {
+				code = http.StatusInternalServerError
+			}
+			body = run
+		default:
+			w.Header().Set("Allow", "GET, POST")
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(code)
+		json.NewEncoder(w).Encode(body)
+	}
+}
@@ -0,0 +1,112 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package server_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/moov-io/iso20022/pkg/dryrun"
+	"github.com/moov-io/iso20022/pkg/iso20022test/fixtures"
+	"github.com/moov-io/iso20022/pkg/schedule"
+	"github.com/moov-io/iso20022/pkg/server"
+	"github.com/moov-io/iso20022/pkg/templates"
+)
+
+func TestSchedules(t *testing.T) {
+	alerts := make(chan server.ScheduleAlert, 1)
+	webhook := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var alert server.ScheduleAlert
+		require.Nil(t, json.NewDecoder(r.Body).Decode(&alert))
+		alerts <- alert
+	}))
+	defer webhook.Close()
+
+	outbox := t.TempDir()
+	env, err := server.NewEnvironment(&server.Environment{Config: &server.Config{API: server.APIConfig{
+		Templates: server.TemplatesConfig{Directory: t.TempDir()},
+		Schedules: server.SchedulesConfig{
+			Jobs: []schedule.Job{
+				{Name: "payroll", Template: "payroll", Cron: "0 6 25 * *", Variables: map[string]string{"month": "2026-10"}, Destination: "outbox"},
+				{Name: "bonus", Template: "bonus", Cron: "0 6 1 12 *", Destination: "outbox"},
+			},
+			Destinations: []server.DestinationConfig{{Name: "outbox", Directory: outbox}},
+			AlertWebhook: webhook.URL,
+		},
+	}}})
+	require.Nil(t, err)
+	t.Cleanup(env.Shutdown)
+	require.NotNil(t, env.Scheduler)
+
+	// jobs instantiate the templates of the /templates endpoints
+	content := strings.Replace(string(fixtures.ReadFile(t, "valid_pacs_v08.xml")), "MSG-20210415-0001", "PAYROLL-${month}", 1)
+	require.Nil(t, env.Templates.Put(context.Background(), templates.Template{
+		Name:      "payroll",
+		Variables: []templates.Variable{{Name: "month", Required: true}},
+		Content:   content,
+	}))
+
+	handler := server.ScheduleHandler(env.Scheduler)
+	w := httptest.NewRecorder()
+	handler(w, httptest.NewRequest(http.MethodPost, "/schedules?job=payroll", nil))
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	var run schedule.Run
+	require.Nil(t, json.NewDecoder(w.Body).Decode(&run))
+	buf, err := os.ReadFile(filepath.Join(outbox, run.File))
+	require.Nil(t, err)
+	require.Contains(t, string(buf), "<MsgId>PAYROLL-2026-10</MsgId>")
+
+	// dry runs generate and validate the file without delivering it
+	dryrun.Reset()
+	t.Cleanup(dryrun.Reset)
+	w = httptest.NewRecorder()
+	handler(w, httptest.NewRequest(http.MethodPost, "/schedules?job=payroll&dryRun=true", nil))
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	var dry schedule.Run
+	require.Nil(t, json.NewDecoder(w.Body).Decode(&dry))
+	require.True(t, dry.DryRun)
+	entries, err := os.ReadDir(outbox)
+	require.Nil(t, err)
+	require.Len(t, entries, 1)
+	deliveries := dryrun.Deliveries()
+	require.Len(t, deliveries, 1)
+	require.Equal(t, server.OutboundSchedules, deliveries[0].Integration)
+	require.Equal(t, "outbox", deliveries[0].Destination)
+	require.Equal(t, dry.File, deliveries[0].Name)
+
+	// failed runs are alerted
+	w = httptest.NewRecorder()
+	handler(w, httptest.NewRequest(http.MethodPost, "/schedules?job=bonus", nil))
+	require.Equal(t, http.StatusInternalServerError, w.Code)
+	alert := <-alerts
+	require.Equal(t, "bonus", alert.Run.Job)
+	require.Equal(t, schedule.StageGenerate, alert.Run.Stage)
+
+	w = httptest.NewRecorder()
+	handler(w, httptest.NewRequest(http.MethodPost, "/schedules?job=missing", nil))
+	require.Equal(t, http.StatusNotFound, w.Code)
+
+	w = httptest.NewRecorder()
+	handler(w, httptest.NewRequest(http.MethodGet, "/schedules", nil))
+	require.Equal(t, http.StatusOK, w.Code)
+	var jobs []schedule.JobStatus
+	require.Nil(t, json.NewDecoder(w.Body).Decode(&jobs))
+	require.Len(t, jobs, 2)
+	require.Len(t, jobs[0].History, 2)
+	require.Equal(t, 25, jobs[0].Next.Day())
+
+	_, err = server.NewEnvironment(&server.Environment{Config: &server.Config{API: server.APIConfig{
+		Schedules: server.SchedulesConfig{Jobs: []schedule.Job{{Name: "payroll", Template: "payroll", Cron: "@daily", Destination: "sftp"}}},
+	}}})
+	require.ErrorContains(t, err, `destination "sftp" isn't configured`)
+}
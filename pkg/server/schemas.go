@@ -0,0 +1,71 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package server
+
+import (
+	_ "embed"
+	"encoding/json"
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"github.com/moov-io/iso20022/pkg/document"
+)
+
+//go:embed web/editor.html
+var editorPage []byte
+
+// ElementValue is a value of an element to check against its constraints
+type ElementValue struct {
+	Path  string `json:"path"`
+	Value string `json:"value"`
+	Error string `json:"error,omitempty"`
+}
+
+// schemas - message types with schema metadata
+func (h handlers) schemas(w http.ResponseWriter, r *http.Request) {
+	h.outputData(w, r, http.StatusOK, document.MessageTypes())
+}
+
+// schema - elements of a message type with their types and occurrences
+func (h handlers) schema(w http.ResponseWriter, r *http.Request) {
+	elements, err := document.Schema(mux.Vars(r)["type"])
+	if err != nil {
+		h.outputError(w, r, http.StatusNotFound, err)
+		return
+	}
+	h.outputData(w, r, http.StatusOK, elements)
+}
+
+// checkElements - check element values against the constraints of a message type
+//
+// The body is a json list of elements, the response is the same list with the error
+// of every invalid value.
+func (h handlers) checkElements(w http.ResponseWriter, r *http.Request) {
+	messageType := mux.Vars(r)["type"]
+	if _, err := document.Schema(messageType); err != nil {
+		h.outputError(w, r, http.StatusNotFound, err)
+		return
+	}
+
+	var elements []ElementValue
+	if err := json.NewDecoder(r.Body).Decode(&elements); err != nil {
+		h.outputError(w, r, http.StatusBadRequest, err)
+		return
+	}
+	for i := range elements {
+		if err := document.CheckElementValue(messageType, elements[i].Path, elements[i].Value); err != nil {
+			elements[i].Error = err.Error()
+		}
+	}
+	h.outputData(w, r, http.StatusOK, elements)
+}
+
+// editor - web page editing messages with completion and inline checks from the schema endpoints
+func (h handlers) editor(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	w.Write(editorPage)
+}
@@ -0,0 +1,91 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package server_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/moov-io/iso20022/pkg/server"
+	"github.com/moov-io/iso20022/pkg/utils"
+)
+
+func TestSchemas(t *testing.T) {
+	ts := newJobServer(t)
+
+	resp, err := http.Get(ts.URL + "/schemas")
+	require.Nil(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var types []string
+	require.Nil(t, json.NewDecoder(resp.Body).Decode(&types))
+	require.Contains(t, types, "pacs.008.001.08")
+
+	resp, err = http.Get(ts.URL + "/schemas/pacs.008.001.08")
+	require.Nil(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var elements []utils.SchemaElement
+	require.Nil(t, json.NewDecoder(resp.Body).Decode(&elements))
+	require.Equal(t, "GrpHdr", elements[0].Path)
+
+	resp, err = http.Get(ts.URL + "/schemas/pacs.999.001.01")
+	require.Nil(t, err)
+	resp.Body.Close()
+	require.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
+
+func TestCheckElements(t *testing.T) {
+	ts := newJobServer(t)
+
+	body := `[{"path": "GrpHdr/MsgId", "value": "MSG-1"}, {"path": "CdtTrfTxInf[0]/IntrBkSttlmAmt/@Ccy", "value": "usd"}]`
+	resp, err := http.Post(ts.URL+"/v2/schemas/pacs.008.001.08/check", "application/json", strings.NewReader(body))
+	require.Nil(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var envelope struct {
+		Status string                `json:"status"`
+		Data   []server.ElementValue `json:"data"`
+	}
+	require.Nil(t, json.NewDecoder(resp.Body).Decode(&envelope))
+	require.Equal(t, server.EnvelopeStatusSuccess, envelope.Status)
+	require.Len(t, envelope.Data, 2)
+	require.Empty(t, envelope.Data[0].Error)
+	require.Equal(t, "The value of ActiveCurrencyCode is invalid", envelope.Data[1].Error)
+
+	resp, err = http.Post(ts.URL+"/schemas/pacs.008.001.08/check", "application/json", strings.NewReader("{"))
+	require.Nil(t, err)
+	resp.Body.Close()
+	require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+	resp, err = http.Post(ts.URL+"/schemas/pacs.999.001.01/check", "application/json", strings.NewReader(body))
+	require.Nil(t, err)
+	resp.Body.Close()
+	require.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
+
+func TestEditor(t *testing.T) {
+	ts := newJobServer(t)
+
+	for _, path := range []string{"/editor", "/v1/editor", "/v2/editor"} {
+		resp, err := http.Get(ts.URL + path)
+		require.Nil(t, err)
+		page, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		require.Nil(t, err)
+
+		require.Equal(t, http.StatusOK, resp.StatusCode, path)
+		require.Equal(t, "text/html; charset=utf-8", resp.Header.Get("Content-Type"))
+		require.Contains(t, string(page), "/schemas/")
+	}
+}
@@ -0,0 +1,267 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package server
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/moov-io/iso20022/pkg/document"
+	"github.com/moov-io/iso20022/pkg/service"
+	"github.com/moov-io/iso20022/pkg/storage"
+	"github.com/moov-io/iso20022/pkg/utils"
+)
+
+// maxSearchQuerySize bounds the body of POST /documents/search
+const maxSearchQuerySize = 1 << 20
+
+// ErrUnindexedQuery is returned for queries without identifier unless scanning is requested
+var ErrUnindexedQuery = errors.New("query holds no indexed identifier, search with scan=true")
+
+// SearchResult is the response of POST /documents/search
+type SearchResult struct {
+	Documents []StoredDocument `json:"documents"`
+
+	// Indexed reports whether the identifiers of the query selected the compared documents,
+	// Scanned is the number of documents compared with the query
+	Indexed bool `json:"indexed"`
+	Scanned int  `json:"scanned"`
+}
+
+// documentIndex indexes the stored documents by their correlation identifiers, it's
+// refreshed with the records changed since the last search
+type documentIndex struct {
+	mu      sync.Mutex
+	updated map[string]time.Time
+	keys    map[string][]string
+	ids     map[string]map[string]bool
+}
+
+func newDocumentIndex() *documentIndex {
+	return &documentIndex{
+		updated: make(map[string]time.Time),
+		keys:    make(map[string][]string),
+		ids:     make(map[string]map[string]bool),
+	}
+}
+
+func indexKey(name, value string) string {
+	return name + "=" + value
+}
+
+// refresh indexes the new and updated documents of records and drops the removed ones
+func (x *documentIndex) refresh(records []storage.Record) {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+
+	stored := make(map[string]bool, len(records))
+	for _, rec := range records {
+		stored[rec.ID] = true
+		if updated, indexed := x.updated[rec.ID]; indexed && updated.Equal(rec.Updated) {
+			continue
+		}
+		x.remove(rec.ID)
+		x.updated[rec.ID] = rec.Updated
+
+		doc, err := service.Parse(bytes.NewReader(rec.Content))
+		if err != nil {
+			continue
+		}
+		for name, values := range utils.ExtractCorrelationKeys(doc.InspectMessage()) {
+			for _, value := range values {
+				key := indexKey(name, value)
+				if x.ids[key] == nil {
+					x.ids[key] = make(map[string]bool)
+				}
+				x.ids[key][rec.ID] = true
+				x.keys[rec.ID] = append(x.keys[rec.ID], key)
+			}
+		}
+	}
+	for id := range x.updated {
+		if !stored[id] {
+			x.remove(id)
+		}
+	}
+}
+
+func (x *documentIndex) remove(id string) {
+	for _, key := range x.keys[id] {
+		delete(x.ids[key], id)
+		if len(x.ids[key]) == 0 {
+			delete(x.ids, key)
+		}
+	}
+	delete(x.keys, id)
+	delete(x.updated, id)
+}
+
+// lookup returns the ids of the documents holding every key
+func (x *documentIndex) lookup(keys []string) map[string]bool {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+
+	var ids map[string]bool
+	for _, key := range keys {
+		matching := make(map[string]bool)
+		for id := range x.ids[key] {
+			if ids == nil || ids[id] {
+				matching[id] = true
+			}
+		}
+		ids = matching
+	}
+	return ids
+}
+
+// exampleKeys returns the index keys of the identifiers of example
+func exampleKeys(example interface{}, path string) []string {
+	var keys []string
+	switch e := example.(type) {
+	case map[string]interface{}:
+		for name, value := range e {
+			keys = append(keys, exampleKeys(value, path+"/"+name)...)
+		}
+	case []interface{}:
+		for _, value := range e {
+			keys = append(keys, exampleKeys(value, path)...)
+		}
+	case string:
+		if name, value, ok := utils.CorrelationKey(path, e); ok {
+			keys = append(keys, indexKey(name, value))
+		}
+	}
+	return keys
+}
+
+// containsExample reports whether the json value of a document holds every element of
+// example, an example element matches any element of a repeated document element
+func containsExample(value, example interface{}) bool {
+	if examples, ok := example.([]interface{}); ok {
+		for _, e := range examples {
+			if !containsExample(value, e) {
+				return false
+			}
+		}
+		return true
+	}
+	if values, ok := value.([]interface{}); ok {
+		for _, v := range values {
+			if containsExample(v, example) {
+				return true
+			}
+		}
+		return false
+	}
+
+	switch e := example.(type) {
+	case nil:
+		return true
+	case map[string]interface{}:
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			return false
+		}
+		for name, elm := range e {
+			v, exists := object[name]
+			if !exists || !containsExample(v, elm) {
+				return false
+			}
+		}
+		return true
+	case string:
+		s, ok := value.(string)
+		return ok && strings.TrimSpace(s) == strings.TrimSpace(e)
+	default:
+		return fmt.Sprint(value) == fmt.Sprint(e)
+	}
+}
+
+// documentJSON returns the json value of the message of doc
+func documentJSON(doc document.Iso20022Document) (interface{}, error) {
+	buf, err := json.Marshal(doc)
+	if err != nil {
+		return nil, err
+	}
+	var value map[string]interface{}
+	if err := json.Unmarshal(buf, &value); err != nil {
+		return nil, err
+	}
+	return value["Message"], nil
+}
+
+// searchDocuments - stored documents holding the elements of a partial json message, the
+// example. Documents are selected by the identifiers of the example (e.g. EndToEndId) or, with
+// scan=true, every stored document is compared.
+func (h handlers) searchDocuments(w http.ResponseWriter, r *http.Request) {
+	buf, err := io.ReadAll(io.LimitReader(r.Body, maxSearchQuerySize))
+	if err != nil {
+		h.outputError(w, r, http.StatusBadRequest, err)
+		return
+	}
+	var example map[string]interface{}
+	if err := json.Unmarshal(buf, &example); err != nil {
+		h.outputError(w, r, http.StatusBadRequest, fmt.Errorf("query isn't a json object: %w", err))
+		return
+	}
+	// a whole document holds the message
+	if message, ok := example["Message"].(map[string]interface{}); ok {
+		example = message
+	}
+	if len(example) == 0 {
+		h.outputError(w, r, http.StatusBadRequest, errors.New("query is empty"))
+		return
+	}
+
+	keys := exampleKeys(example, "")
+	scan := r.URL.Query().Get("scan") == "true"
+	if len(keys) == 0 && !scan {
+		h.outputError(w, r, http.StatusBadRequest, ErrUnindexedQuery)
+		return
+	}
+
+	records, err := h.documents.List(r.Context())
+	if err != nil {
+		h.outputError(w, r, http.StatusInternalServerError, err)
+		return
+	}
+	var candidates map[string]bool
+	if len(keys) > 0 {
+		h.index.refresh(records)
+		candidates = h.index.lookup(keys)
+	}
+
+	messageType := r.URL.Query().Get("messageType")
+	result := SearchResult{Documents: []StoredDocument{}, Indexed: candidates != nil}
+	for _, rec := range records {
+		if rec.Kind != "" && rec.Kind != storage.KindOriginal {
+			continue
+		}
+		if (candidates != nil && !candidates[rec.ID]) || (messageType != "" && rec.MessageType != messageType) {
+			continue
+		}
+		doc, err := service.Parse(bytes.NewReader(rec.Content))
+		if err != nil {
+			continue
+		}
+		value, err := documentJSON(doc)
+		if err != nil {
+			h.outputError(w, r, http.StatusInternalServerError, err)
+			return
+		}
+		result.Scanned++
+		if containsExample(value, example) {
+			result.Documents = append(result.Documents, newStoredDocument(rec))
+		}
+	}
+	h.outputData(w, r, http.StatusOK, result)
+}
@@ -0,0 +1,89 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package server_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/moov-io/iso20022/pkg/iso20022test/fixtures"
+	"github.com/moov-io/iso20022/pkg/server"
+)
+
+func searchDocuments(t *testing.T, url, query string) (*http.Response, server.SearchResult) {
+	resp, err := http.Post(url, "application/json", strings.NewReader(query))
+	require.Nil(t, err)
+	t.Cleanup(func() { resp.Body.Close() })
+
+	var result server.SearchResult
+	if resp.StatusCode == http.StatusOK {
+		require.Nil(t, json.NewDecoder(resp.Body).Decode(&result))
+	}
+	return resp, result
+}
+
+func newSearchServer(t *testing.T) (*httptest.Server, server.StoredDocument, server.StoredDocument) {
+	ts := newJobServer(t)
+	valid := createDocument(t, ts, fixtures.ReadFile(t, "valid_pacs_v08.xml"))
+	charges := createDocument(t, ts, fixtures.ReadFile(t, "charges_pacs_v08.xml"))
+	return ts, valid, charges
+}
+
+func TestSearchDocumentsByIdentifier(t *testing.T) {
+	ts, valid, _ := newSearchServer(t)
+
+	resp, result := searchDocuments(t, ts.URL+"/documents/search", `{"CdtTrfTxInf": {"PmtId": {"EndToEndId": "E2E-0002"}}}`)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.True(t, result.Indexed)
+	require.Equal(t, 1, result.Scanned)
+	require.Len(t, result.Documents, 1)
+	require.Equal(t, valid.ID, result.Documents[0].ID)
+
+	// every element of the example must match
+	_, result = searchDocuments(t, ts.URL+"/documents/search",
+		`{"GrpHdr": {"MsgId": "MSG-20210415-0001"}, "CdtTrfTxInf": {"PmtId": {"EndToEndId": "E2E-CHRGS-0001"}}}`)
+	require.True(t, result.Indexed)
+	require.Empty(t, result.Documents)
+
+	// a whole document holds the message
+	_, result = searchDocuments(t, ts.URL+"/documents/search", `{"Message": {"GrpHdr": {"MsgId": "CHRGS-20210415-0001"}}}`)
+	require.Len(t, result.Documents, 1)
+}
+
+func TestSearchDocumentsScan(t *testing.T) {
+	ts, _, charges := newSearchServer(t)
+
+	query := `{"CdtTrfTxInf": {"Dbtr": {"Nm": "John Smith"}}}`
+	resp, _ := searchDocuments(t, ts.URL+"/documents/search", query)
+	require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+	resp, result := searchDocuments(t, ts.URL+"/documents/search?scan=true", query)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.False(t, result.Indexed)
+	require.Equal(t, 2, result.Scanned)
+	require.Len(t, result.Documents, 2)
+
+	_, result = searchDocuments(t, ts.URL+"/documents/search?scan=true", `{"CdtTrfTxInf": {"IntrBkSttlmAmt": {"Ccy": "EUR"}}}`)
+	require.Len(t, result.Documents, 1)
+	require.Equal(t, charges.ID, result.Documents[0].ID)
+
+	_, result = searchDocuments(t, ts.URL+"/documents/search?scan=true&messageType=pacs.009.001.08", query)
+	require.Equal(t, 0, result.Scanned)
+	require.Empty(t, result.Documents)
+}
+
+func TestSearchDocumentsInvalidQuery(t *testing.T) {
+	ts, _, _ := newSearchServer(t)
+
+	for _, query := range []string{`not json`, `{}`, `["E2E-0001"]`} {
+		resp, _ := searchDocuments(t, ts.URL+"/documents/search", query)
+		require.Equal(t, http.StatusBadRequest, resp.StatusCode, query)
+	}
+}
@@ -17,6 +17,8 @@ import (
 	"github.com/gorilla/mux"
 	"github.com/moov-io/base/admin"
 	"github.com/moov-io/base/log"
+
+	"github.com/moov-io/iso20022/pkg/storage"
 )
 
 // RunServers - Boots up all the servers and awaits till they are stopped.
@@ -26,6 +28,27 @@ func (env *Environment) RunServers(await bool) func() {
 	terminationListener := newTerminationListener()
 
 	adminServer := bootAdminServer(terminationListener, env.Logger, env.Config.Servers.Admin)
+	if env.ProfileLoader != nil {
+		adminServer.AddHandler("/profiles/reload", ProfileReloadHandler(env.ProfileLoader))
+	}
+	if env.Purger != nil {
+		adminServer.AddHandler("/storage/retention", RetentionHandler(env.Purger))
+	}
+	if deduplicated, ok := env.Documents.(*storage.DeduplicatedStore); ok {
+		adminServer.AddHandler("/storage/deduplication", DeduplicationHandler(deduplicated))
+	}
+	if env.Replication != nil {
+		adminServer.AddHandler("/storage/replication", ReplicationHandler(env.Replication))
+	}
+	if env.Revalidator != nil {
+		adminServer.AddHandler("/storage/revalidate", RevalidationHandler(env.Revalidator))
+	}
+	if env.Scheduler != nil {
+		adminServer.AddHandler("/schedules", ScheduleHandler(env.Scheduler))
+	}
+	adminServer.AddHandler("/snapshot", SnapshotHandler(env))
+	adminServer.AddHandler("/outbound", OutboundHandler())
+	adminServer.AddHandler("/outbound/dry-run", DryRunHandler())
 
 	_, shutdownPublicServer := bootHTTPServer("public", env.PublicRouter, terminationListener, env.Logger, env.Config.Servers.Public)
 
@@ -0,0 +1,76 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package server
+
+import (
+	"fmt"
+	"math/rand"
+
+	"github.com/moov-io/base/log"
+
+	"github.com/moov-io/iso20022/pkg/document"
+)
+
+const (
+	shadowOutcomeValid   = "valid"
+	shadowOutcomeInvalid = "invalid"
+)
+
+// shadowValidator validates a sample of the messages in strict mode after they're fast
+// validated, logging the messages whose outcomes differ. Strict validation runs outside
+// of the request so the latency of the sampled requests isn't affected.
+type shadowValidator struct {
+	rate   float64
+	logger log.Logger
+}
+
+// newShadowValidator returns nil when config disables shadow validation
+func newShadowValidator(config ShadowConfig, logger log.Logger) (*shadowValidator, error) {
+	if config.SampleRate < 0 || config.SampleRate > 100 {
+		return nil, fmt.Errorf("shadow sample rate %v is not a percentage", config.SampleRate)
+	}
+	if config.SampleRate == 0 {
+		return nil, nil
+	}
+	if logger == nil {
+		logger = log.NewDefaultLogger()
+	}
+	return &shadowValidator{rate: config.SampleRate, logger: logger}, nil
+}
+
+// sampled reports whether the current message is validated in strict mode too
+func (s *shadowValidator) sampled() bool {
+	return s != nil && rand.Float64()*100 < s.rate
+}
+
+// validate validates input in strict mode and logs a discrepancy with the outcome of the fast validation
+func (s *shadowValidator) validate(input []byte, messageType string, fast error) {
+	go func() {
+		strict := document.ValidateStrict(input)
+		if (fast == nil) == (strict == nil) {
+			return
+		}
+
+		fields := log.Fields{
+			"messageType": log.String(messageType),
+			"fast":        log.String(shadowOutcome(fast)),
+			"strict":      log.String(shadowOutcome(strict)),
+		}
+		if strict != nil {
+			fields["strictError"] = log.String(strict.Error())
+		}
+		if fast != nil {
+			fields["fastError"] = log.String(fast.Error())
+		}
+		s.logger.Warn().With(fields).Log("shadow validation discrepancy")
+	}()
+}
+
+func shadowOutcome(err error) string {
+	if err != nil {
+		return shadowOutcomeInvalid
+	}
+	return shadowOutcomeValid
+}
@@ -0,0 +1,110 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package server_test
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	kitlog "github.com/go-kit/log"
+	"github.com/gorilla/mux"
+	"github.com/moov-io/base/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/moov-io/iso20022/pkg/iso20022test/fixtures"
+	"github.com/moov-io/iso20022/pkg/server"
+)
+
+// syncBuffer is a buffer written by the shadow validation while tests read it
+type syncBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *syncBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.String()
+}
+
+func newShadowServer(t *testing.T, rate float64) (*httptest.Server, *syncBuffer) {
+	logs := &syncBuffer{}
+	logger := log.NewLogger(kitlog.NewLogfmtLogger(logs))
+
+	router := mux.NewRouter()
+	require.Nil(t, server.ConfigureHandlersWithLogger(router, server.APIConfig{
+		Shadow: server.ShadowConfig{SampleRate: rate},
+	}, logger))
+	ts := httptest.NewServer(router)
+	t.Cleanup(ts.Close)
+	return ts, logs
+}
+
+func TestShadowValidation(t *testing.T) {
+	ts, logs := newShadowServer(t, 100)
+	input := string(fixtures.ReadFile(t, "valid_pacs_v08.xml"))
+
+	resp := postForm(t, ts.URL+"/validator", []byte(input), nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	drifted := strings.Replace(input, "</GrpHdr>", "<NewElm>value</NewElm></GrpHdr>", 1)
+	resp = postForm(t, ts.URL+"/validator", []byte(drifted), nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	require.Eventually(t, func() bool {
+		return strings.Contains(logs.String(), "shadow validation discrepancy")
+	}, time.Second, 10*time.Millisecond)
+
+	output := logs.String()
+	require.Equal(t, 1, strings.Count(output, "shadow validation discrepancy"))
+	require.Contains(t, output, "messageType=pacs.008.001.08")
+	require.Contains(t, output, "fast=valid")
+	require.Contains(t, output, "strict=invalid")
+	require.Contains(t, output, "GrpHdr/NewElm")
+}
+
+func TestShadowValidationWithTimeout(t *testing.T) {
+	ts, logs := newShadowServer(t, 100)
+	input := strings.Replace(string(fixtures.ReadFile(t, "valid_pacs_v08.xml")), "</GrpHdr>", "<NewElm>value</NewElm></GrpHdr>", 1)
+
+	// streamed messages are sampled too
+	resp := postForm(t, ts.URL+"/validator", []byte(input), map[string]string{"timeout": "1m"})
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Eventually(t, func() bool {
+		return strings.Contains(logs.String(), "shadow validation discrepancy")
+	}, time.Second, 10*time.Millisecond)
+	require.Contains(t, logs.String(), "GrpHdr/NewElm")
+}
+
+func TestShadowValidationDisabled(t *testing.T) {
+	ts, logs := newShadowServer(t, 0)
+	input := strings.Replace(string(fixtures.ReadFile(t, "valid_pacs_v08.xml")), "</GrpHdr>", "<NewElm>value</NewElm></GrpHdr>", 1)
+
+	resp := postForm(t, ts.URL+"/validator", []byte(input), nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	time.Sleep(50 * time.Millisecond)
+	require.NotContains(t, logs.String(), "shadow validation discrepancy")
+}
+
+func TestShadowSampleRate(t *testing.T) {
+	for _, rate := range []float64{-1, 100.5} {
+		err := server.ConfigureHandlersWithOptions(mux.NewRouter(), server.APIConfig{
+			Shadow: server.ShadowConfig{SampleRate: rate},
+		})
+		require.NotNil(t, err)
+	}
+}
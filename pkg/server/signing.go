@@ -0,0 +1,114 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package server
+
+import (
+	"bytes"
+	"crypto"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/moov-io/iso20022/pkg/jws"
+)
+
+// SignatureHeader carries the detached JWS (RFC 7515 appendix F) of a signed response body
+const SignatureHeader = "X-JWS-Signature"
+
+// ErrInvalidSignature is returned when a detached JWS doesn't sign a response body
+var ErrInvalidSignature = jws.ErrInvalidSignature
+
+// responseSigner signs the bodies of responses with a detached JWS
+type responseSigner struct {
+	*jws.Signer
+}
+
+// newResponseSigner returns nil when config has no key
+func newResponseSigner(config SigningConfig) (*responseSigner, error) {
+	var key crypto.Signer
+	var err error
+	switch {
+	case config.KeyFile != "" && config.KeyURI != "":
+		return nil, errors.New("signing key is both a file and an uri")
+	case config.KeyFile != "":
+		key, err = loadSigningKey(config.KeyFile)
+	case config.KeyURI != "":
+		key, err = providedSigningKey(config.KeyURI)
+	default:
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	signer, err := jws.NewSigner(key, config.KeyID)
+	if err != nil {
+		return nil, fmt.Errorf("signing key: %w", err)
+	}
+	return &responseSigner{Signer: signer}, nil
+}
+
+// loadSigningKey reads the private key of a PEM file
+func loadSigningKey(path string) (crypto.Signer, error) {
+	key, err := jws.LoadKey(path)
+	if err != nil {
+		return nil, fmt.Errorf("signing key: %w", err)
+	}
+	return key, nil
+}
+
+// signed buffers the responses of next to sign their body, the signature header is
+// added to every response
+func (s *responseSigner) signed(next http.HandlerFunc) http.HandlerFunc {
+	if s == nil {
+		return next
+	}
+	return func(w http.ResponseWriter, r *http.Request) {
+		rec := &bufferedResponse{header: make(http.Header), code: http.StatusOK}
+		next(rec, r)
+
+		for key, values := range rec.header {
+			w.Header()[key] = values
+		}
+		signature, err := s.Sign(rec.body.Bytes())
+		if err != nil {
+			outputError(w, http.StatusInternalServerError, err)
+			return
+		}
+		w.Header().Set(SignatureHeader, signature)
+		w.WriteHeader(rec.code)
+		w.Write(rec.body.Bytes())
+	}
+}
+
+// bufferedResponse keeps a response until it's signed
+type bufferedResponse struct {
+	header      http.Header
+	code        int
+	wroteHeader bool
+	body        bytes.Buffer
+}
+
+func (b *bufferedResponse) Header() http.Header {
+	return b.header
+}
+
+func (b *bufferedResponse) WriteHeader(code int) {
+	if !b.wroteHeader {
+		b.code, b.wroteHeader = code, true
+	}
+}
+
+func (b *bufferedResponse) Write(p []byte) (int, error) {
+	b.wroteHeader = true
+	return b.body.Write(p)
+}
+
+// VerifyResponseSignature verifies that signature, the detached JWS of a SignatureHeader,
+// signs body with the private key of key and returns when the response was signed
+func VerifyResponseSignature(signature string, body []byte, key crypto.PublicKey) (time.Time, error) {
+	return jws.Verify(signature, body, key)
+}
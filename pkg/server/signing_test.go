@@ -0,0 +1,113 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package server_test
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/require"
+
+	"github.com/moov-io/iso20022/pkg/iso20022test/fixtures"
+	"github.com/moov-io/iso20022/pkg/server"
+)
+
+func writeSigningKey(t *testing.T, key crypto.Signer) string {
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	require.Nil(t, err)
+	path := filepath.Join(t.TempDir(), "signing.pem")
+	require.Nil(t, os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), 0600))
+	return path
+}
+
+func newSigningServer(t *testing.T, key crypto.Signer) *httptest.Server {
+	router := mux.NewRouter()
+	signing := server.SigningConfig{KeyFile: writeSigningKey(t, key), KeyID: "iso20022-1"}
+	require.Nil(t, server.ConfigureHandlersWithOptions(router, server.APIConfig{Signing: signing}))
+	ts := httptest.NewServer(router)
+	t.Cleanup(ts.Close)
+	return ts
+}
+
+func TestResponseSigning(t *testing.T) {
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.Nil(t, err)
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	require.Nil(t, err)
+
+	for _, key := range []crypto.Signer{ecKey, edKey} {
+		ts := newSigningServer(t, key)
+		input := fixtures.ReadFile(t, "valid_pacs_v08.xml")
+
+		for _, url := range []string{"/validator", "/v2/validator", "/convert"} {
+			resp := postForm(t, ts.URL+url, input, nil)
+			require.Equal(t, http.StatusOK, resp.StatusCode)
+			body, err := io.ReadAll(resp.Body)
+			require.Nil(t, err)
+
+			signature := resp.Header.Get(server.SignatureHeader)
+			require.Contains(t, signature, "..")
+			signed, err := server.VerifyResponseSignature(signature, body, key.Public())
+			require.Nil(t, err)
+			require.WithinDuration(t, time.Now(), signed, time.Minute)
+
+			header, err := base64.RawURLEncoding.DecodeString(strings.Split(signature, ".")[0])
+			require.Nil(t, err)
+			require.Contains(t, string(header), `"kid":"iso20022-1"`)
+
+			// the signature doesn't sign another body
+			_, err = server.VerifyResponseSignature(signature, append(body, ' '), key.Public())
+			require.True(t, errors.Is(err, server.ErrInvalidSignature))
+		}
+
+		// errors are signed too, other endpoints aren't
+		resp := postForm(t, ts.URL+"/validator", []byte("<Document/>"), nil)
+		require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		require.NotEmpty(t, resp.Header.Get(server.SignatureHeader))
+		resp, err = http.Get(ts.URL + "/health")
+		require.Nil(t, err)
+		defer resp.Body.Close()
+		require.Empty(t, resp.Header.Get(server.SignatureHeader))
+	}
+
+	// signatures are verified with the key of their algorithm
+	resp := postForm(t, newSigningServer(t, ecKey).URL+"/validator", fixtures.ReadFile(t, "valid_pacs_v08.xml"), nil)
+	body, err := io.ReadAll(resp.Body)
+	require.Nil(t, err)
+	_, err = server.VerifyResponseSignature(resp.Header.Get(server.SignatureHeader), body, edKey.Public())
+	require.True(t, errors.Is(err, server.ErrInvalidSignature))
+}
+
+func TestResponseSigningKey(t *testing.T) {
+	router := mux.NewRouter()
+	err := server.ConfigureHandlersWithOptions(router, server.APIConfig{Signing: server.SigningConfig{KeyFile: "missing.pem"}})
+	require.ErrorContains(t, err, "signing key")
+
+	path := filepath.Join(t.TempDir(), "invalid.pem")
+	require.Nil(t, os.WriteFile(path, []byte("not a key"), 0600))
+	err = server.ConfigureHandlersWithOptions(mux.NewRouter(), server.APIConfig{Signing: server.SigningConfig{KeyFile: path}})
+	require.ErrorContains(t, err, "no PEM encoded key")
+
+	p384, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	require.Nil(t, err)
+	err = server.ConfigureHandlersWithOptions(mux.NewRouter(), server.APIConfig{Signing: server.SigningConfig{KeyFile: writeSigningKey(t, p384)}})
+	require.ErrorContains(t, err, "unsupported curve P-384")
+}
@@ -0,0 +1,126 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package server
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/moov-io/base/log"
+
+	"github.com/moov-io/iso20022/pkg/document"
+	"github.com/moov-io/iso20022/pkg/simulator"
+	"github.com/moov-io/iso20022/pkg/storage"
+	"github.com/moov-io/iso20022/pkg/utils"
+)
+
+const (
+	// OriginalDocumentHeader is the id of the stored pacs.008 answered by a simulated response
+	// posted to SimulatorConfig.Webhook, StoredDocumentHeader the id of the stored response
+	OriginalDocumentHeader = "X-Original-Document"
+	StoredDocumentHeader   = "X-Stored-Document"
+
+	simulatorWebhookTimeout = 10 * time.Second
+)
+
+// SimulatorConfig - Defines the counterparty answering the pacs.008 stored by POST /documents in
+// sandboxes, for the end-to-end tests of clients without a scheme
+type SimulatorConfig struct {
+	Enabled bool
+
+	// Counterparty defines the delay, statuses and notifications of the responses
+	Counterparty simulator.Config
+
+	// Webhook is posted every response in xml, responses are stored either way
+	Webhook string
+}
+
+// counterparty stores the responses of the simulator and posts them to its webhook
+type counterparty struct {
+	simulator *simulator.Simulator
+	documents storage.Store
+	events    *documentEvents
+	client    *http.Client
+	webhook   string
+	logger    log.Logger
+}
+
+// newCounterparty returns nil unless config is enabled, responses are posted with the policy
+// of outbound
+func newCounterparty(config SimulatorConfig, documents storage.Store, events *documentEvents, outbound OutboundConfig, logger log.Logger) (*counterparty, error) {
+	if !config.Enabled {
+		return nil, nil
+	}
+	if logger == nil {
+		logger = log.NewDefaultLogger()
+	}
+	c := &counterparty{documents: documents, events: events, webhook: config.Webhook, logger: logger}
+	if config.Webhook != "" {
+		c.client = newOutboundClient(outbound, OutboundSimulator, simulatorWebhookTimeout)
+	}
+	var err error
+	if c.simulator, err = simulator.New(config.Counterparty, c.respond); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// receive answers doc, the stored document with id, when it's a pacs.008
+func (c *counterparty) receive(ctx context.Context, id string, doc document.Iso20022Document) {
+	if c == nil || !simulator.Simulates(doc) {
+		return
+	}
+	if err := c.simulator.Receive(ctx, id, doc); err != nil {
+		c.logger.With(log.Fields{"document": log.String(id)}).LogErrorf("simulating counterparty: %v", err)
+	}
+}
+
+// respond stores response and posts it to the webhook, failures are logged
+func (c *counterparty) respond(ctx context.Context, response simulator.Response) {
+	logger := c.logger.With(log.Fields{"document": log.String(response.Original), "messagetype": log.String(response.MessageType)})
+	content, err := messageToBuf(utils.DocumentTypeXml, response.Document)
+	if err != nil {
+		logger.LogErrorf("simulated response: %v", err)
+		return
+	}
+	now := time.Now().UTC()
+	rec := storage.Record{
+		ID:          newId(),
+		Kind:        storage.KindOriginal,
+		MessageType: response.MessageType,
+		Format:      utils.DocumentTypeXml,
+		Content:     content,
+		Created:     now,
+		Updated:     now,
+	}
+	if err := c.documents.Put(ctx, rec); err != nil {
+		logger.LogErrorf("storing simulated response: %v", err)
+		return
+	}
+	c.events.emit(ctx, rec.ID, response.Document)
+	if c.client == nil {
+		return
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.webhook, bytes.NewReader(content))
+	if err != nil {
+		logger.LogErrorf("simulated response: %v", err)
+		return
+	}
+	req.Header.Set("Content-Type", "application/xml")
+	req.Header.Set(OriginalDocumentHeader, response.Original)
+	req.Header.Set(StoredDocumentHeader, rec.ID)
+	resp, err := c.client.Do(req)
+	if err != nil {
+		logger.LogErrorf("simulated response: %v", err)
+		return
+	}
+	resp.Body.Close()
+	if resp.StatusCode >= http.StatusMultipleChoices {
+		logger.LogErrorf("simulated response: webhook responded %s", resp.Status)
+	}
+}
@@ -0,0 +1,108 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package server_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/require"
+
+	"github.com/moov-io/iso20022/pkg/iso20022test/fixtures"
+	"github.com/moov-io/iso20022/pkg/server"
+	"github.com/moov-io/iso20022/pkg/simulator"
+)
+
+type simulatedResponse struct {
+	original, stored string
+	content          string
+}
+
+func TestSimulator(t *testing.T) {
+	responses := make(chan simulatedResponse, 4)
+	webhook := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		buf, _ := io.ReadAll(r.Body)
+		responses <- simulatedResponse{
+			original: r.Header.Get(server.OriginalDocumentHeader),
+			stored:   r.Header.Get(server.StoredDocumentHeader),
+			content:  string(buf),
+		}
+	}))
+	defer webhook.Close()
+
+	router := mux.NewRouter()
+	require.Nil(t, server.ConfigureHandlersWithOptions(router, server.APIConfig{
+		Simulator: server.SimulatorConfig{
+			Enabled: true,
+			Counterparty: simulator.Config{
+				Delay:        20 * time.Millisecond,
+				Notification: true,
+				Rules:        []simulator.Rule{{EndToEndId: "E2E-0002", Reason: "AC04"}},
+			},
+			Webhook: webhook.URL,
+		},
+	}))
+	ts := httptest.NewServer(router)
+	defer ts.Close()
+
+	resp := postForm(t, ts.URL+"/documents", fixtures.ReadFile(t, "valid_pacs_v08.xml"), nil)
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+	var stored server.StoredDocument
+	require.Nil(t, json.NewDecoder(resp.Body).Decode(&stored))
+
+	// a pacs.002 with the statuses of the transactions, then the camt.054 of the settled one
+	next := func() simulatedResponse {
+		select {
+		case response := <-responses:
+			return response
+		case <-time.After(5 * time.Second):
+			t.Fatal("no simulated response")
+		}
+		return simulatedResponse{}
+	}
+	report := next()
+	require.Equal(t, stored.ID, report.original)
+	require.Contains(t, report.content, "urn:iso:std:iso:20022:tech:xsd:pacs.002.001.10")
+	require.Contains(t, report.content, "<GrpSts>PART</GrpSts>")
+	require.Contains(t, report.content, "<Cd>AC04</Cd>")
+	notification := next()
+	require.Contains(t, notification.content, "urn:iso:std:iso:20022:tech:xsd:camt.054.001.08")
+	require.Contains(t, notification.content, "<EndToEndId>E2E-0001</EndToEndId>")
+	require.NotContains(t, notification.content, "E2E-0002")
+
+	// responses are stored with the documents
+	resp, err := http.Get(ts.URL + "/documents/" + report.stored)
+	require.Nil(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	resp, err = http.Post(ts.URL+"/documents/search", "application/json", strings.NewReader(`{"TxInfAndSts": {"OrgnlEndToEndId": "E2E-0002"}}`))
+	require.Nil(t, err)
+	defer resp.Body.Close()
+	var result server.SearchResult
+	require.Nil(t, json.NewDecoder(resp.Body).Decode(&result))
+	require.Len(t, result.Documents, 1)
+	require.Equal(t, report.stored, result.Documents[0].ID)
+
+	// other messages aren't answered
+	resp = postForm(t, ts.URL+"/documents", fixtures.ReadFile(t, "valid_camt053_v08.xml"), nil)
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+	select {
+	case <-responses:
+		t.Fatal("camt.053 was answered")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	router = mux.NewRouter()
+	err = server.ConfigureHandlersWithOptions(router, server.APIConfig{
+		Simulator: server.SimulatorConfig{Enabled: true, Counterparty: simulator.Config{Delay: -time.Second}},
+	})
+	require.ErrorContains(t, err, "simulator delay is negative")
+}
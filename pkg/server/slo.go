@@ -0,0 +1,245 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package server
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/moov-io/base/log"
+)
+
+const (
+	SLOStatusBreached = "breached"
+	SLOStatusResolved = "resolved"
+
+	defaultSLOWindow = time.Hour
+
+	// sloBuckets is the number of buckets of the sliding window of an objective
+	sloBuckets = 60
+
+	sloWebhookTimeout = 10 * time.Second
+)
+
+// SLOStatus is the compliance of a service level objective over its window
+type SLOStatus struct {
+	Name        string  `json:"name"`
+	MessageType string  `json:"messageType"`
+	Objective   float64 `json:"objective"`
+	Window      string  `json:"window"`
+	Requests    int     `json:"requests"`
+	Breaches    int     `json:"breaches"`
+
+	// BurnRate is the rate the error budget of the objective is consumed, 1 consumes it
+	// exactly over the window
+	BurnRate float64 `json:"burnRate"`
+	Breached bool    `json:"breached"`
+}
+
+// SLOAlert is posted to the webhook of an objective when it's breached and resolved
+type SLOAlert struct {
+	Status string    `json:"status"`
+	Time   time.Time `json:"time"`
+	SLO    SLOStatus `json:"slo"`
+}
+
+type sloBucket struct {
+	start    time.Time
+	requests int
+	breaches int
+}
+
+// sloObjective tracks the requests of an objective in a sliding window of buckets
+type sloObjective struct {
+	config   SLOConfig
+	bucket   time.Duration
+	buckets  []sloBucket
+	breached bool
+}
+
+// sloTracker tracks the service level objectives of message types
+type sloTracker struct {
+	logger log.Logger
+	client *http.Client
+
+	mu         sync.Mutex
+	objectives []*sloObjective
+}
+
+// newSLOTracker returns nil when configs has no objective, alerts are posted with the policy
+// of outbound
+func newSLOTracker(configs []SLOConfig, outbound OutboundConfig, logger log.Logger) (*sloTracker, error) {
+	if len(configs) == 0 {
+		return nil, nil
+	}
+	if logger == nil {
+		logger = log.NewDefaultLogger()
+	}
+	t := &sloTracker{logger: logger, client: newOutboundClient(outbound, OutboundSLOAlerts, sloWebhookTimeout)}
+	for _, config := range configs {
+		if config.MessageType == "" {
+			return nil, fmt.Errorf("slo %s has no message type", config.Name)
+		}
+		if config.Name == "" {
+			config.Name = config.MessageType
+		}
+		if config.Latency <= 0 && config.MaxSize <= 0 {
+			return nil, fmt.Errorf("slo %s has neither latency nor size threshold", config.Name)
+		}
+		if config.Objective <= 0 || config.Objective >= 100 {
+			return nil, fmt.Errorf("slo %s objective %v is not a percentage below 100", config.Name, config.Objective)
+		}
+		if config.Window < 0 || config.AlertBurnRate < 0 || config.MinRequests < 0 {
+			return nil, fmt.Errorf("slo %s has a negative window, burn rate or minimum of requests", config.Name)
+		}
+		if config.Window == 0 {
+			config.Window = defaultSLOWindow
+		}
+		if config.AlertBurnRate == 0 {
+			config.AlertBurnRate = 1
+		}
+		if config.MinRequests == 0 {
+			config.MinRequests = 1
+		}
+		bucket := config.Window / sloBuckets
+		if bucket <= 0 {
+			bucket = config.Window
+		}
+		t.objectives = append(t.objectives, &sloObjective{config: config, bucket: bucket})
+	}
+	return t, nil
+}
+
+// matches reports whether the objective covers messageType, e.g. pacs.008 covers pacs.008.001.08
+func (o *sloObjective) matches(messageType string) bool {
+	return messageType == o.config.MessageType || strings.HasPrefix(messageType, o.config.MessageType+".")
+}
+
+// observe adds a request to the objectives of its message type and alerts the ones whose
+// state changes
+func (t *sloTracker) observe(at time.Time, sample statsSample, latency time.Duration) {
+	if t == nil {
+		return
+	}
+	type pending struct {
+		webhook string
+		alert   SLOAlert
+	}
+	var alerts []pending
+
+	t.mu.Lock()
+	for _, o := range t.objectives {
+		if !o.matches(sample.messageType) {
+			continue
+		}
+		o.add(at, (o.config.Latency > 0 && latency > o.config.Latency) || (o.config.MaxSize > 0 && sample.size > o.config.MaxSize))
+
+		status := o.status(at)
+		if status.Breached == o.breached {
+			continue
+		}
+		o.breached = status.Breached
+		if o.config.Webhook != "" {
+			alert := SLOAlert{Status: SLOStatusResolved, Time: at.UTC(), SLO: status}
+			if status.Breached {
+				alert.Status = SLOStatusBreached
+			}
+			alerts = append(alerts, pending{webhook: o.config.Webhook, alert: alert})
+		}
+	}
+	t.mu.Unlock()
+
+	for _, p := range alerts {
+		go t.alert(p.webhook, p.alert)
+	}
+}
+
+func (o *sloObjective) add(at time.Time, breach bool) {
+	start := at.Truncate(o.bucket)
+	if n := len(o.buckets); n == 0 || o.buckets[n-1].start.Before(start) {
+		o.buckets = append(o.buckets, sloBucket{start: start})
+	}
+	last := &o.buckets[len(o.buckets)-1]
+	last.requests++
+	if breach {
+		last.breaches++
+	}
+}
+
+// status returns the compliance of the objective over the window ending at now
+func (o *sloObjective) status(now time.Time) SLOStatus {
+	// buckets older than the window are dropped
+	expired := 0
+	for expired < len(o.buckets) && !o.buckets[expired].start.After(now.Add(-o.config.Window)) {
+		expired++
+	}
+	o.buckets = o.buckets[expired:]
+
+	status := SLOStatus{
+		Name:        o.config.Name,
+		MessageType: o.config.MessageType,
+		Objective:   o.config.Objective,
+		Window:      o.config.Window.String(),
+	}
+	for _, bucket := range o.buckets {
+		status.Requests += bucket.requests
+		status.Breaches += bucket.breaches
+	}
+	if status.Requests > 0 {
+		budget := 1 - o.config.Objective/100
+		status.BurnRate = float64(status.Breaches) / float64(status.Requests) / budget
+	}
+	status.Breached = status.Requests >= o.config.MinRequests && status.BurnRate >= o.config.AlertBurnRate
+	return status
+}
+
+// statuses returns the status of every objective
+func (t *sloTracker) statuses(now time.Time) []SLOStatus {
+	statuses := []SLOStatus{}
+	if t == nil {
+		return statuses
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for _, o := range t.objectives {
+		statuses = append(statuses, o.status(now))
+	}
+	return statuses
+}
+
+// alert posts alert to webhook, failures are logged
+func (t *sloTracker) alert(webhook string, alert SLOAlert) {
+	logger := t.logger.With(log.Fields{
+		"slo":      log.String(alert.SLO.Name),
+		"status":   log.String(alert.Status),
+		"burnRate": log.Float64(alert.SLO.BurnRate),
+	})
+	body, err := json.Marshal(alert)
+	if err != nil {
+		logger.LogErrorf("slo alert: %v", err)
+		return
+	}
+	resp, err := t.client.Post(webhook, "application/json", bytes.NewReader(body))
+	if err != nil {
+		logger.LogErrorf("slo alert: %v", err)
+		return
+	}
+	resp.Body.Close()
+	if resp.StatusCode >= http.StatusMultipleChoices {
+		logger.LogErrorf("slo alert: webhook responded %s", resp.Status)
+		return
+	}
+	logger.Info().Log("slo alert sent")
+}
+
+// slos - compliance and burn rate of the service level objectives
+func (h handlers) slos(w http.ResponseWriter, r *http.Request) {
+	h.outputData(w, r, http.StatusOK, h.statistics.slos.statuses(time.Now()))
+}
@@ -0,0 +1,125 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package server_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/require"
+
+	"github.com/moov-io/iso20022/pkg/iso20022test/fixtures"
+	"github.com/moov-io/iso20022/pkg/server"
+)
+
+func newSLOServer(t *testing.T, slos ...server.SLOConfig) *httptest.Server {
+	router := mux.NewRouter()
+	require.Nil(t, server.ConfigureHandlersWithOptions(router, server.APIConfig{SLOs: slos}))
+	ts := httptest.NewServer(router)
+	t.Cleanup(ts.Close)
+	return ts
+}
+
+func newAlertWebhook(t *testing.T) (*httptest.Server, chan server.SLOAlert) {
+	alerts := make(chan server.SLOAlert, 10)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var alert server.SLOAlert
+		require.Nil(t, json.NewDecoder(r.Body).Decode(&alert))
+		alerts <- alert
+	}))
+	t.Cleanup(ts.Close)
+	return ts, alerts
+}
+
+func receiveAlert(t *testing.T, alerts chan server.SLOAlert) server.SLOAlert {
+	select {
+	case alert := <-alerts:
+		return alert
+	case <-time.After(5 * time.Second):
+		t.Fatal("no slo alert")
+	}
+	return server.SLOAlert{}
+}
+
+func getSLOs(t *testing.T, ts *httptest.Server) []server.SLOStatus {
+	resp, err := http.Get(ts.URL + "/slos")
+	require.Nil(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var statuses []server.SLOStatus
+	require.Nil(t, json.NewDecoder(resp.Body).Decode(&statuses))
+	return statuses
+}
+
+func TestSLOLatency(t *testing.T) {
+	webhook, alerts := newAlertWebhook(t)
+	ts := newSLOServer(t,
+		server.SLOConfig{Name: "pacs.008 p99", MessageType: "pacs.008", Latency: time.Nanosecond, Objective: 99, Webhook: webhook.URL},
+		server.SLOConfig{MessageType: "pain.001", Latency: time.Nanosecond, Objective: 99},
+	)
+
+	resp := postForm(t, ts.URL+"/validator", fixtures.ReadFile(t, "valid_pacs_v08.xml"), nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	alert := receiveAlert(t, alerts)
+	require.Equal(t, server.SLOStatusBreached, alert.Status)
+	require.Equal(t, "pacs.008 p99", alert.SLO.Name)
+
+	statuses := getSLOs(t, ts)
+	require.Len(t, statuses, 2)
+	require.Equal(t, 1, statuses[0].Requests)
+	require.Equal(t, 1, statuses[0].Breaches)
+	require.InDelta(t, 100, statuses[0].BurnRate, 0.001)
+	require.True(t, statuses[0].Breached)
+
+	// other message types aren't tracked by the objective
+	require.Equal(t, "pain.001", statuses[1].Name)
+	require.Equal(t, 0, statuses[1].Requests)
+	require.False(t, statuses[1].Breached)
+}
+
+func TestSLOSizeResolved(t *testing.T) {
+	input := fixtures.ReadFile(t, "valid_pacs_v08.xml")
+	large := append(append([]byte{}, input...), bytes.Repeat([]byte(" "), 1024)...)
+
+	webhook, alerts := newAlertWebhook(t)
+	ts := newSLOServer(t, server.SLOConfig{
+		MessageType: "pacs.008.001.08",
+		MaxSize:     len(input),
+		Objective:   90,
+		Window:      300 * time.Millisecond,
+		Webhook:     webhook.URL,
+	})
+
+	require.Equal(t, http.StatusOK, postForm(t, ts.URL+"/validator", large, nil).StatusCode)
+	require.Equal(t, server.SLOStatusBreached, receiveAlert(t, alerts).Status)
+
+	// the breach leaves the window
+	time.Sleep(400 * time.Millisecond)
+	require.Equal(t, http.StatusOK, postForm(t, ts.URL+"/validator", input, nil).StatusCode)
+
+	alert := receiveAlert(t, alerts)
+	require.Equal(t, server.SLOStatusResolved, alert.Status)
+	require.Equal(t, 1, alert.SLO.Requests)
+	require.Equal(t, 0.0, alert.SLO.BurnRate)
+}
+
+func TestSLOConfig(t *testing.T) {
+	for _, slo := range []server.SLOConfig{
+		{Latency: time.Second, Objective: 99},
+		{MessageType: "pacs.008", Objective: 99},
+		{MessageType: "pacs.008", Latency: time.Second, Objective: 100},
+		{MessageType: "pacs.008", Latency: time.Second, Objective: 99, Window: -time.Hour},
+	} {
+		router := mux.NewRouter()
+		require.NotNil(t, server.ConfigureHandlersWithOptions(router, server.APIConfig{SLOs: []server.SLOConfig{slo}}), "%+v", slo)
+	}
+}
@@ -0,0 +1,287 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/moov-io/iso20022/pkg/pipeline"
+	"github.com/moov-io/iso20022/pkg/profile"
+	"github.com/moov-io/iso20022/pkg/schedule"
+	"github.com/moov-io/iso20022/pkg/templates"
+)
+
+// SnapshotVersion is the version of the snapshots exported and imported by this release
+const SnapshotVersion = 1
+
+// ErrSnapshotVersion is returned when importing a snapshot of another version
+var ErrSnapshotVersion = errors.New("unsupported snapshot version")
+
+// Snapshot is the portable state of a service, exported by a primary environment and imported
+// by a standby one on failover. Stored documents aren't part of snapshots, they're replicated
+// with StorageConfig.Replication. Background jobs in progress aren't resumed by the standby,
+// snapshots list them so that their inputs are submitted again.
+type Snapshot struct {
+	Version int       `json:"version"`
+	Created time.Time `json:"created"`
+
+	// Config is the configuration of the primary with its secret references, not their values
+	Config Config `json:"config"`
+
+	// Profiles, CodeLists and History are the yaml files of the directories of ProfilesConfig
+	Profiles  []SnapshotFile `json:"profiles,omitempty"`
+	CodeLists []SnapshotFile `json:"codeLists,omitempty"`
+	History   []SnapshotFile `json:"history,omitempty"`
+
+	Templates []templates.Template `json:"templates,omitempty"`
+
+	// Usage are the counters of the tenants by month, Schedules the scheduled jobs with the
+	// history of their runs
+	Usage     []Usage              `json:"usage,omitempty"`
+	Schedules []schedule.JobStatus `json:"schedules,omitempty"`
+
+	// PendingJobs are the background jobs of /jobs the primary hadn't finished, without their
+	// inputs
+	PendingJobs []Job `json:"pendingJobs,omitempty"`
+}
+
+// SnapshotFile is a file of a snapshot, named relative to its directory
+type SnapshotFile struct {
+	Name    string `json:"name"`
+	Content []byte `json:"content"`
+}
+
+// RestoreStatus counts the state imported from a snapshot
+type RestoreStatus struct {
+	Files     int `json:"files"`
+	Templates int `json:"templates"`
+	Usage     int `json:"usage"`
+	Schedules int `json:"schedules"`
+
+	// Skipped are the parts of the snapshot the environment has no place for, e.g. profiles
+	// without a profile directory, the usage of tenants that aren't configured, pending jobs
+	// or a configuration other than its own
+	Skipped []string `json:"skipped,omitempty"`
+}
+
+// copyConfig returns a deep copy of cfg
+func copyConfig(cfg *Config) (Config, error) {
+	var copied Config
+	buf, err := json.Marshal(cfg)
+	if err != nil {
+		return copied, fmt.Errorf("copying config: %w", err)
+	}
+	if err = json.Unmarshal(buf, &copied); err != nil {
+		return copied, fmt.Errorf("copying config: %w", err)
+	}
+	return copied, nil
+}
+
+// sameConfig reports whether a and b are the same configuration
+func sameConfig(a, b Config) bool {
+	x, err := json.Marshal(a)
+	if err != nil {
+		return false
+	}
+	y, err := json.Marshal(b)
+	return err == nil && bytes.Equal(x, y)
+}
+
+// snapshotFiles returns the yaml files of dir in order of name, none when dir is empty
+func snapshotFiles(dir string) ([]SnapshotFile, error) {
+	if dir == "" {
+		return nil, nil
+	}
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	var files []SnapshotFile
+	for _, entry := range entries {
+		if entry.IsDir() || !snapshotFileName(entry.Name()) {
+			continue
+		}
+		content, err := os.ReadFile(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, SnapshotFile{Name: entry.Name(), Content: content})
+	}
+	sort.Slice(files, func(i, j int) bool { return files[i].Name < files[j].Name })
+	return files, nil
+}
+
+// snapshotFileName reports whether name is a visible yaml file of a directory
+func snapshotFileName(name string) bool {
+	ext := strings.ToLower(filepath.Ext(name))
+	return (ext == ".yml" || ext == ".yaml") && !strings.HasPrefix(name, ".") && filepath.Base(name) == name && !strings.ContainsAny(name, `/\`)
+}
+
+// Snapshot exports the state of env
+func (env *Environment) Snapshot(ctx context.Context) (Snapshot, error) {
+	snapshot := Snapshot{Version: SnapshotVersion, Created: time.Now().UTC(), Config: env.configured}
+
+	var err error
+	profiles := env.Config.Profiles
+	if snapshot.Profiles, err = snapshotFiles(profiles.Directory); err != nil {
+		return Snapshot{}, fmt.Errorf("profiles: %w", err)
+	}
+	if snapshot.CodeLists, err = snapshotFiles(profiles.CodeLists); err != nil {
+		return Snapshot{}, fmt.Errorf("code lists: %w", err)
+	}
+	if snapshot.History, err = snapshotFiles(profiles.History); err != nil {
+		return Snapshot{}, fmt.Errorf("profile history: %w", err)
+	}
+	if env.Templates != nil {
+		if snapshot.Templates, err = env.Templates.List(ctx); err != nil {
+			return Snapshot{}, fmt.Errorf("templates: %w", err)
+		}
+	}
+	if env.usage != nil {
+		snapshot.Usage = env.usage.report(env.usage.tenantNames(), "")
+	}
+	if env.Scheduler != nil {
+		snapshot.Schedules = env.Scheduler.Jobs()
+	}
+	if env.jobs != nil {
+		snapshot.PendingJobs = env.jobs.pending()
+	}
+	return snapshot, nil
+}
+
+// Restore imports the state of snapshot into env: files are written to the directories of
+// the profiles of env, which are reloaded, and templates, usage and schedule histories are
+// replaced. The configuration of the snapshot isn't applied, env keeps its own, and pending
+// jobs aren't resumed: both are listed by the skipped parts of the status.
+func (env *Environment) Restore(ctx context.Context, snapshot Snapshot) (RestoreStatus, error) {
+	var status RestoreStatus
+	if snapshot.Version != SnapshotVersion {
+		return status, fmt.Errorf("%w: %d", ErrSnapshotVersion, snapshot.Version)
+	}
+
+	profiles := env.Config.Profiles
+	areas := []struct {
+		name  string
+		dir   string
+		files []SnapshotFile
+	}{
+		{name: "profiles", dir: profiles.Directory, files: snapshot.Profiles},
+		{name: "code lists", dir: profiles.CodeLists, files: snapshot.CodeLists},
+		{name: "profile history", dir: profiles.History, files: snapshot.History},
+	}
+	written := make(map[string]bool)
+	for _, area := range areas {
+		if len(area.files) == 0 {
+			continue
+		}
+		if area.dir == "" {
+			status.Skipped = append(status.Skipped, fmt.Sprintf("%d %s: no directory is configured", len(area.files), area.name))
+			continue
+		}
+		for _, file := range area.files {
+			if !snapshotFileName(file.Name) {
+				return status, fmt.Errorf("%s: %q isn't the name of a yaml file", area.name, file.Name)
+			}
+			if err := pipeline.WriteFile(filepath.Join(area.dir, file.Name), file.Content); err != nil {
+				return status, fmt.Errorf("%s: %w", area.name, err)
+			}
+			status.Files++
+		}
+		written[area.name] = true
+	}
+	if written["profile history"] {
+		if err := profile.LoadHistory(profiles.History); err != nil {
+			return status, fmt.Errorf("profile history: %w", err)
+		}
+	}
+	if env.ProfileLoader != nil && (written["profiles"] || written["code lists"]) {
+		if _, err := env.ProfileLoader.Reload(profile.ReloadTriggerManual); err != nil {
+			return status, fmt.Errorf("reloading profiles: %w", err)
+		}
+	}
+
+	for _, tmpl := range snapshot.Templates {
+		if err := env.Templates.Put(ctx, tmpl); err != nil {
+			return status, fmt.Errorf("template %s: %w", tmpl.Name, err)
+		}
+		status.Templates++
+	}
+
+	if len(snapshot.Usage) > 0 {
+		if env.usage != nil {
+			status.Usage = env.usage.restore(snapshot.Usage)
+		}
+		if skipped := len(snapshot.Usage) - status.Usage; skipped > 0 {
+			status.Skipped = append(status.Skipped, fmt.Sprintf("%d usage months: tenants aren't configured", skipped))
+		}
+	}
+	if len(snapshot.Schedules) > 0 {
+		if env.Scheduler != nil {
+			status.Schedules = env.Scheduler.Restore(snapshot.Schedules)
+		}
+		if skipped := len(snapshot.Schedules) - status.Schedules; skipped > 0 {
+			status.Skipped = append(status.Skipped, fmt.Sprintf("%d scheduled jobs: jobs aren't configured", skipped))
+		}
+	}
+	if len(snapshot.PendingJobs) > 0 {
+		status.Skipped = append(status.Skipped, fmt.Sprintf("%d pending jobs: jobs in progress aren't resumed, their inputs must be submitted again", len(snapshot.PendingJobs)))
+	}
+	if !sameConfig(snapshot.Config, env.configured) {
+		status.Skipped = append(status.Skipped, "config: the configuration of the snapshot isn't applied, the environment keeps its own")
+	}
+	return status, nil
+}
+
+// SnapshotHandler - admin endpoint exporting (GET) the state of env as a snapshot and
+// importing (POST) a snapshot into env, answered with the restore status
+func SnapshotHandler(env *Environment) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		code := http.StatusOK
+		var response interface{}
+
+		switch r.Method {
+		case http.MethodGet:
+			snapshot, err := env.Snapshot(r.Context())
+			if err != nil {
+				code, response = http.StatusInternalServerError, map[string]interface{}{"error": err.Error()}
+			} else {
+				response = snapshot
+			}
+		case http.MethodPost:
+			var snapshot Snapshot
+			if err := json.NewDecoder(r.Body).Decode(&snapshot); err != nil {
+				code, response = http.StatusBadRequest, map[string]interface{}{"error": fmt.Sprintf("reading snapshot: %v", err)}
+				break
+			}
+			status, err := env.Restore(r.Context(), snapshot)
+			switch {
+			case errors.Is(err, ErrSnapshotVersion):
+				code, response = http.StatusBadRequest, map[string]interface{}{"error": err.Error()}
+			case err != nil:
+				code, response = http.StatusInternalServerError, map[string]interface{}{"error": err.Error(), "status": status}
+			default:
+				response = status
+			}
+		default:
+			w.Header().Set("Allow", "GET, POST")
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(code)
+		json.NewEncoder(w).Encode(response)
+	}
+}
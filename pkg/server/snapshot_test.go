@@ -0,0 +1,120 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package server_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	baseLog "github.com/moov-io/base/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/moov-io/iso20022/pkg/iso20022test/fixtures"
+	"github.com/moov-io/iso20022/pkg/profile"
+	"github.com/moov-io/iso20022/pkg/schedule"
+	"github.com/moov-io/iso20022/pkg/server"
+	"github.com/moov-io/iso20022/pkg/templates"
+)
+
+func newSnapshotEnvironment(t *testing.T, profiles string) *server.Environment {
+	env, err := server.NewEnvironment(&server.Environment{
+		Logger: baseLog.NewNopLogger(),
+		Config: &server.Config{
+			Profiles: server.ProfilesConfig{Directory: profiles},
+			API: server.APIConfig{
+				Templates: server.TemplatesConfig{Directory: t.TempDir()},
+				Usage:     server.UsageConfig{Tenants: []server.TenantConfig{{Name: "acme", Keys: []string{"env:ISO20022_SNAPSHOT_KEY"}}}},
+				Schedules: server.SchedulesConfig{
+					Jobs:         []schedule.Job{{Name: "payroll", Template: "payroll", Cron: "0 6 25 * *", Variables: map[string]string{"month": "2026-10"}, Destination: "outbox"}},
+					Destinations: []server.DestinationConfig{{Name: "outbox", Directory: t.TempDir()}},
+				},
+			},
+		},
+	})
+	require.Nil(t, err)
+	t.Cleanup(env.Shutdown)
+	return env
+}
+
+func TestSnapshot(t *testing.T) {
+	t.Setenv("ISO20022_SNAPSHOT_KEY", "acme-key")
+	t.Cleanup(func() { profile.Unregister("Server-Snapshot") })
+	profiles := t.TempDir()
+	require.Nil(t, os.WriteFile(filepath.Join(profiles, "snapshot.yml"), []byte("name: Server-Snapshot\nversion: \"1\"\n"), 0600))
+
+	primary := newSnapshotEnvironment(t, profiles)
+	ctx := context.Background()
+	content := strings.Replace(string(fixtures.ReadFile(t, "valid_pacs_v08.xml")), "MSG-20210415-0001", "PAYROLL-${month}", 1)
+	require.Nil(t, primary.Templates.Put(ctx, templates.Template{
+		Name:      "payroll",
+		Variables: []templates.Variable{{Name: "month", Required: true}},
+		Content:   content,
+	}))
+	run, err := primary.Scheduler.RunJob(ctx, "payroll")
+	require.Nil(t, err)
+	ts := httptest.NewServer(primary.PublicRouter)
+	t.Cleanup(ts.Close)
+	require.Equal(t, http.StatusOK, postWithKey(t, ts.URL+"/validator", fixtures.ReadFile(t, "valid_pacs_v08.xml"), "acme-key").StatusCode)
+
+	w := httptest.NewRecorder()
+	server.SnapshotHandler(primary)(w, httptest.NewRequest(http.MethodGet, "/snapshot", nil))
+	require.Equal(t, http.StatusOK, w.Code)
+	exported := w.Body.Bytes()
+
+	// secrets are exported as references
+	var snapshot server.Snapshot
+	require.Nil(t, json.Unmarshal(exported, &snapshot))
+	require.Equal(t, server.SnapshotVersion, snapshot.Version)
+	require.Equal(t, []string{"env:ISO20022_SNAPSHOT_KEY"}, snapshot.Config.API.Usage.Tenants[0].Keys)
+	require.NotContains(t, string(exported), "acme-key")
+	require.Equal(t, []server.SnapshotFile{{Name: "snapshot.yml", Content: []byte("name: Server-Snapshot\nversion: \"1\"\n")}}, snapshot.Profiles)
+
+	// the standby takes over the state of the primary
+	standbyProfiles := t.TempDir()
+	standby := newSnapshotEnvironment(t, standbyProfiles)
+	w = httptest.NewRecorder()
+	server.SnapshotHandler(standby)(w, httptest.NewRequest(http.MethodPost, "/snapshot", bytes.NewReader(exported)))
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	var status server.RestoreStatus
+	require.Nil(t, json.NewDecoder(w.Body).Decode(&status))
+	require.Equal(t, server.RestoreStatus{Files: 1, Templates: 1, Usage: 1, Schedules: 1, Skipped: []string{
+		"config: the configuration of the snapshot isn't applied, the environment keeps its own",
+	}}, status)
+
+	_, err = os.Stat(filepath.Join(standbyProfiles, "snapshot.yml"))
+	require.Nil(t, err)
+	require.Contains(t, standby.ProfileLoader.Status().Profiles, "Server-Snapshot")
+	tmpl, err := standby.Templates.Get(ctx, "payroll")
+	require.Nil(t, err)
+	require.Equal(t, content, tmpl.Content)
+	require.Equal(t, []schedule.Run{run}, standby.Scheduler.Jobs()[0].History)
+	restored, err := standby.Snapshot(ctx)
+	require.Nil(t, err)
+	require.Equal(t, snapshot.Usage, restored.Usage)
+	require.Equal(t, 1, restored.Usage[0].Messages)
+
+	// pending jobs aren't resumed, the configuration of the standby itself isn't skipped
+	restored.PendingJobs = []server.Job{{ID: "job-1", Operation: "validate", Status: server.JobStatusPending}}
+	status, err = standby.Restore(ctx, restored)
+	require.Nil(t, err)
+	require.Equal(t, []string{"1 pending jobs: jobs in progress aren't resumed, their inputs must be submitted again"}, status.Skipped)
+
+	// snapshots of other versions and other files are refused
+	for _, body := range []string{`{"version": 2}`, `{"version": 1, "profiles": [{"name": "../escape.yml"}]}`, `{`} {
+		w = httptest.NewRecorder()
+		server.SnapshotHandler(standby)(w, httptest.NewRequest(http.MethodPost, "/snapshot", strings.NewReader(body)))
+		require.NotEqual(t, http.StatusOK, w.Code, body)
+	}
+	w = httptest.NewRecorder()
+	server.SnapshotHandler(standby)(w, httptest.NewRequest(http.MethodDelete, "/snapshot", nil))
+	require.Equal(t, http.StatusMethodNotAllowed, w.Code)
+}
@@ -0,0 +1,312 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/moov-io/iso20022/pkg/largevalue"
+)
+
+const (
+	StatsPeriodHour = "hour"
+	StatsPeriodDay  = "day"
+
+	// statsUnknownMessageType counts the requests whose document couldn't be parsed
+	statsUnknownMessageType = "unknown"
+
+	defaultHourlyRetention = 48 * time.Hour
+	defaultDailyRetention  = 30 * 24 * time.Hour
+)
+
+// statsLatencyBounds are the upper bounds of the latency histogram of rollups, percentiles
+// are the bound of the bucket holding them
+var statsLatencyBounds = []time.Duration{
+	time.Millisecond, 2 * time.Millisecond, 5 * time.Millisecond,
+	10 * time.Millisecond, 25 * time.Millisecond, 50 * time.Millisecond,
+	100 * time.Millisecond, 250 * time.Millisecond, 500 * time.Millisecond,
+	time.Second, 2500 * time.Millisecond, 5 * time.Second, 10 * time.Second, time.Minute,
+}
+
+// Rollup aggregates the documents processed during a period
+type Rollup struct {
+	Period string    `json:"period"`
+	Start  time.Time `json:"start"`
+
+	Requests     int            `json:"requests"`
+	Errors       int            `json:"errors"`
+	MessageTypes map[string]int `json:"messageTypes"`
+
+	// ErrorCodes counts failed requests by http status code
+	ErrorCodes map[string]int `json:"errorCodes,omitempty"`
+
+	// LargeValues counts the large value transactions by currency
+	LargeValues map[string]int `json:"largeValues,omitempty"`
+
+	// AverageSize of the documents in bytes
+	AverageSize float64           `json:"averageSize"`
+	Latency     LatencyPercentile `json:"latency"`
+
+	totalSize int
+	histogram []int
+}
+
+// LatencyPercentile are the latency percentiles of a rollup in milliseconds
+type LatencyPercentile struct {
+	P50 float64 `json:"p50Ms"`
+	P90 float64 `json:"p90Ms"`
+	P99 float64 `json:"p99Ms"`
+}
+
+// StatsReport is the response of GET /stats
+type StatsReport struct {
+	Period  string   `json:"period"`
+	Rollups []Rollup `json:"rollups"`
+}
+
+// statsSample is the request being recorded, parseInput sets its message type, size and
+// large value transactions
+type statsSample struct {
+	messageType string
+	size        int
+	largeValues []largevalue.Transaction
+}
+
+type statsSampleKey struct{}
+
+// noteStatsSample sets the message type and size of the document of the request r, for the
+// rollups and the usage of its tenant
+func noteStatsSample(r *http.Request, messageType string, size int) {
+	for _, key := range []interface{}{statsSampleKey{}, usageSampleKey{}} {
+		if sample, ok := r.Context().Value(key).(*statsSample); ok {
+			sample.messageType, sample.size = messageType, size
+		}
+	}
+}
+
+// noteLargeValues sets the large value transactions of the document of the request r
+func noteLargeValues(r *http.Request, transactions []largevalue.Transaction) {
+	if sample, ok := r.Context().Value(statsSampleKey{}).(*statsSample); ok {
+		sample.largeValues = transactions
+	}
+}
+
+// statsRecorder keeps the hourly and daily rollups of processed documents in memory
+type statsRecorder struct {
+	retention map[string]time.Duration
+	now       func() time.Time
+
+	// slos track the objectives of the recorded requests
+	slos *sloTracker
+
+	mu      sync.Mutex
+	rollups map[string]map[time.Time]*Rollup
+}
+
+func newStatsRecorder(config StatsConfig) (*statsRecorder, error) {
+	if config.HourlyRetention < 0 || config.DailyRetention < 0 {
+		return nil, fmt.Errorf("stats retention is negative")
+	}
+	hourly, daily := config.HourlyRetention, config.DailyRetention
+	if hourly == 0 {
+		hourly = defaultHourlyRetention
+	}
+	if daily == 0 {
+		daily = defaultDailyRetention
+	}
+	return &statsRecorder{
+		retention: map[string]time.Duration{StatsPeriodHour: hourly, StatsPeriodDay: daily},
+		now:       time.Now,
+		rollups: map[string]map[time.Time]*Rollup{
+			StatsPeriodHour: make(map[time.Time]*Rollup),
+			StatsPeriodDay:  make(map[time.Time]*Rollup),
+		},
+	}, nil
+}
+
+// periodStart returns the start of the period holding t
+func periodStart(period string, t time.Time) time.Time {
+	t = t.UTC()
+	if period == StatsPeriodDay {
+		return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	}
+	return t.Truncate(time.Hour)
+}
+
+// record adds a processed request to the rollups of its hour and day
+func (s *statsRecorder) record(at time.Time, sample statsSample, code int, latency time.Duration) {
+	messageType := sample.messageType
+	if messageType == "" {
+		messageType = statsUnknownMessageType
+	}
+	bucket := sort.Search(len(statsLatencyBounds), func(i int) bool { return latency <= statsLatencyBounds[i] })
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for period, rollups := range s.rollups {
+		start := periodStart(period, at)
+		rollup, exists := rollups[start]
+		if !exists {
+			rollup = &Rollup{
+				Period:       period,
+				Start:        start,
+				MessageTypes: make(map[string]int),
+				ErrorCodes:   make(map[string]int),
+				LargeValues:  make(map[string]int),
+				histogram:    make([]int, len(statsLatencyBounds)+1),
+			}
+			rollups[start] = rollup
+		}
+		rollup.Requests++
+		rollup.MessageTypes[messageType]++
+		if code >= http.StatusBadRequest {
+			rollup.Errors++
+			rollup.ErrorCodes[strconv.Itoa(code)]++
+		}
+		for _, tx := range sample.largeValues {
+			rollup.LargeValues[tx.Currency]++
+		}
+		rollup.totalSize += sample.size
+		rollup.histogram[bucket]++
+
+		// rollups older than the retention are dropped
+		for started := range rollups {
+			if started.Before(at.Add(-s.retention[period])) {
+				delete(rollups, started)
+			}
+		}
+	}
+}
+
+// report returns the rollups of period starting from since, ordered by start
+func (s *statsRecorder) report(period string, since time.Time) StatsReport {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	report := StatsReport{Period: period, Rollups: []Rollup{}}
+	for start, rollup := range s.rollups[period] {
+		if start.Before(periodStart(period, since)) {
+			continue
+		}
+		r := *rollup
+		r.MessageTypes = copyCounts(rollup.MessageTypes)
+		r.ErrorCodes = copyCounts(rollup.ErrorCodes)
+		r.LargeValues = copyCounts(rollup.LargeValues)
+		if r.Requests > 0 {
+			r.AverageSize = float64(r.totalSize) / float64(r.Requests)
+		}
+		r.Latency = LatencyPercentile{
+			P50: latencyPercentile(rollup.histogram, r.Requests, 0.50),
+			P90: latencyPercentile(rollup.histogram, r.Requests, 0.90),
+			P99: latencyPercentile(rollup.histogram, r.Requests, 0.99),
+		}
+		r.histogram = nil
+		report.Rollups = append(report.Rollups, r)
+	}
+	sort.Slice(report.Rollups, func(i, j int) bool {
+		return report.Rollups[i].Start.Before(report.Rollups[j].Start)
+	})
+	return report
+}
+
+func copyCounts(counts map[string]int) map[string]int {
+	copied := make(map[string]int, len(counts))
+	for key, count := range counts {
+		copied[key] = count
+	}
+	return copied
+}
+
+// latencyPercentile returns the upper bound in milliseconds of the bucket holding the
+// percentile p of the total requests of histogram
+func latencyPercentile(histogram []int, total int, p float64) float64 {
+	if total == 0 {
+		return 0
+	}
+	rank := int(p*float64(total) + 0.5)
+	if rank < 1 {
+		rank = 1
+	}
+	seen := 0
+	for i, count := range histogram {
+		seen += count
+		if seen >= rank {
+			if i == len(statsLatencyBounds) {
+				break
+			}
+			return float64(statsLatencyBounds[i]) / float64(time.Millisecond)
+		}
+	}
+	// the last bucket has no upper bound
+	return float64(statsLatencyBounds[len(statsLatencyBounds)-1]) / float64(time.Millisecond)
+}
+
+// recorded records the requests of next in the rollups
+func (s *statsRecorder) recorded(next http.HandlerFunc) http.HandlerFunc {
+	if s == nil {
+		return next
+	}
+	return func(w http.ResponseWriter, r *http.Request) {
+		started := s.now()
+		sample := &statsSample{}
+		rec := &statusRecorder{ResponseWriter: w, code: http.StatusOK}
+		next(rec, r.WithContext(context.WithValue(r.Context(), statsSampleKey{}, sample)))
+		latency := s.now().Sub(started)
+		s.record(started, *sample, rec.code, latency)
+		s.slos.observe(started, *sample, latency)
+	}
+}
+
+// statusRecorder keeps the status code of a response
+type statusRecorder struct {
+	http.ResponseWriter
+	code        int
+	wroteHeader bool
+}
+
+func (s *statusRecorder) WriteHeader(code int) {
+	if !s.wroteHeader {
+		s.code, s.wroteHeader = code, true
+	}
+	s.ResponseWriter.WriteHeader(code)
+}
+
+func (s *statusRecorder) Write(p []byte) (int, error) {
+	s.wroteHeader = true
+	return s.ResponseWriter.Write(p)
+}
+
+// Unwrap returns the wrapped writer for http.ResponseController
+func (s *statusRecorder) Unwrap() http.ResponseWriter {
+	return s.ResponseWriter
+}
+
+// stats - hourly or daily rollups of the processed documents
+func (h handlers) stats(w http.ResponseWriter, r *http.Request) {
+	period := r.URL.Query().Get("period")
+	if period == "" {
+		period = StatsPeriodHour
+	}
+	if period != StatsPeriodHour && period != StatsPeriodDay {
+		h.outputError(w, r, http.StatusBadRequest, fmt.Errorf("period %q is not hour or day", period))
+		return
+	}
+
+	var since time.Time
+	if value := r.URL.Query().Get("since"); value != "" {
+		var err error
+		if since, err = time.Parse(time.RFC3339, value); err != nil {
+			h.outputError(w, r, http.StatusBadRequest, fmt.Errorf("since %q is not an RFC 3339 time", value))
+			return
+		}
+	}
+	h.outputData(w, r, http.StatusOK, h.statistics.report(period, since))
+}
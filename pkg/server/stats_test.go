@@ -0,0 +1,115 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package server_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/require"
+
+	"github.com/moov-io/iso20022/pkg/events"
+	"github.com/moov-io/iso20022/pkg/iso20022test/fixtures"
+	"github.com/moov-io/iso20022/pkg/server"
+)
+
+func getStats(t *testing.T, url string) (int, server.StatsReport) {
+	resp, err := http.Get(url)
+	require.Nil(t, err)
+	defer resp.Body.Close()
+
+	var report server.StatsReport
+	if resp.StatusCode == http.StatusOK {
+		require.Nil(t, json.NewDecoder(resp.Body).Decode(&report))
+	}
+	return resp.StatusCode, report
+}
+
+func TestStats(t *testing.T) {
+	ts := newJobServer(t)
+	input := fixtures.ReadFile(t, "valid_pacs_v08.xml")
+
+	require.Equal(t, http.StatusOK, postForm(t, ts.URL+"/validator", input, nil).StatusCode)
+	require.Equal(t, http.StatusOK, postForm(t, ts.URL+"/v2/print", input, nil).StatusCode)
+	require.Equal(t, http.StatusBadRequest, postForm(t, ts.URL+"/validator", []byte("<Document>"), nil).StatusCode)
+
+	code, report := getStats(t, ts.URL+"/stats")
+	require.Equal(t, http.StatusOK, code)
+	require.Equal(t, server.StatsPeriodHour, report.Period)
+	require.Len(t, report.Rollups, 1)
+
+	rollup := report.Rollups[0]
+	require.Less(t, time.Since(rollup.Start), time.Hour)
+	require.Equal(t, 3, rollup.Requests)
+	require.Equal(t, 1, rollup.Errors)
+	require.Equal(t, map[string]int{"pacs.008.001.08": 2, "unknown": 1}, rollup.MessageTypes)
+	require.Equal(t, map[string]int{"400": 1}, rollup.ErrorCodes)
+	require.InDelta(t, float64(2*len(input)+len("<Document>"))/3, rollup.AverageSize, 0.01)
+	require.Greater(t, rollup.Latency.P99, 0.0)
+	require.LessOrEqual(t, rollup.Latency.P50, rollup.Latency.P99)
+
+	code, report = getStats(t, ts.URL+"/stats?period=day")
+	require.Equal(t, http.StatusOK, code)
+	require.Len(t, report.Rollups, 1)
+	require.Equal(t, 3, report.Rollups[0].Requests)
+
+	code, report = getStats(t, ts.URL+"/stats?since="+time.Now().Add(2*time.Hour).Format(time.RFC3339))
+	require.Equal(t, http.StatusOK, code)
+	require.Empty(t, report.Rollups)
+
+	code, _ = getStats(t, ts.URL+"/stats?period=week")
+	require.Equal(t, http.StatusBadRequest, code)
+
+	code, _ = getStats(t, ts.URL+"/stats?since=yesterday")
+	require.Equal(t, http.StatusBadRequest, code)
+}
+
+func TestLargeValues(t *testing.T) {
+	received := make(chan events.Event, 10)
+	webhook := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var event events.Event
+		require.Nil(t, json.NewDecoder(r.Body).Decode(&event))
+		received <- event
+	}))
+	defer webhook.Close()
+
+	router := mux.NewRouter()
+	require.Nil(t, server.ConfigureHandlersWithOptions(router, server.APIConfig{
+		Events:      server.EventsConfig{Webhook: webhook.URL},
+		LargeValues: map[string]float64{"usd": 10000},
+	}))
+	ts := httptest.NewServer(router)
+	defer ts.Close()
+
+	input := fixtures.ReadFile(t, "valid_pacs_v08.xml")
+	require.Equal(t, http.StatusOK, postForm(t, ts.URL+"/validator", input, nil).StatusCode)
+	createDocument(t, ts, input)
+
+	code, report := getStats(t, ts.URL+"/stats")
+	require.Equal(t, http.StatusOK, code)
+	require.Len(t, report.Rollups, 1)
+	require.Equal(t, map[string]int{"USD": 2}, report.Rollups[0].LargeValues)
+
+	types := make(map[string]int)
+	for i := 0; i < 3; i++ {
+		select {
+		case event := <-received:
+			types[event.Type]++
+			if event.Type == events.TypeLargeValueDetected {
+				require.Equal(t, "CdtTrfTxInf[0]", event.Subject)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatal("no event received")
+		}
+	}
+	require.Equal(t, map[string]int{events.TypePaymentCreated: 2, events.TypeLargeValueDetected: 1}, types)
+
+	err := server.ConfigureHandlersWithOptions(mux.NewRouter(), server.APIConfig{LargeValues: map[string]float64{"USD": -1}})
+	require.ErrorContains(t, err, "is not positive")
+}
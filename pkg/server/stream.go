@@ -0,0 +1,53 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package server
+
+import (
+	"io"
+
+	"golang.org/x/net/websocket"
+
+	"github.com/moov-io/iso20022/pkg/document"
+)
+
+// streamError is sent when the stream can't be validated any further
+type streamError struct {
+	Type  string `json:"type"`
+	Error string `json:"error"`
+}
+
+// streamValidator - validate xml chunks received over a websocket
+//
+// Every message of the client is a chunk of the xml document, an empty message or
+// closing the connection ends the document. Validation events (document.StreamEvent)
+// are sent as json messages while chunks are received, the connection is closed
+// after the end event.
+func streamValidator() websocket.Server {
+	return websocket.Server{Handler: func(ws *websocket.Conn) {
+		defer ws.Close()
+
+		reader, writer := io.Pipe()
+		go func() {
+			for {
+				var chunk []byte
+				if err := websocket.Message.Receive(ws, &chunk); err != nil || len(chunk) == 0 {
+					writer.Close()
+					return
+				}
+				if _, err := writer.Write(chunk); err != nil {
+					return
+				}
+			}
+		}()
+
+		err := document.ValidateStream(reader, func(event document.StreamEvent) {
+			websocket.JSON.Send(ws, event)
+		})
+		reader.Close()
+		if err != nil {
+			websocket.JSON.Send(ws, streamError{Type: "error", Error: err.Error()})
+		}
+	}}
+}
@@ -0,0 +1,73 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package server_test
+
+import (
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/websocket"
+
+	"github.com/moov-io/iso20022/pkg/document"
+	"github.com/moov-io/iso20022/pkg/iso20022test/fixtures"
+	"github.com/moov-io/iso20022/pkg/server"
+)
+
+func dialStreamValidator(t *testing.T) *websocket.Conn {
+	router := mux.NewRouter()
+	require.Nil(t, server.ConfigureHandlers(router))
+	ts := httptest.NewServer(router)
+	t.Cleanup(ts.Close)
+
+	url := "ws" + strings.TrimPrefix(ts.URL, "http") + "/validator/stream"
+	ws, err := websocket.Dial(url, "", ts.URL)
+	require.Nil(t, err)
+	t.Cleanup(func() { ws.Close() })
+	return ws
+}
+
+func TestStreamValidator(t *testing.T) {
+	input := fixtures.ReadFile(t, "valid_pacs_v08.xml")
+
+	ws := dialStreamValidator(t)
+	for start := 0; start < len(input); start += 512 {
+		end := start + 512
+		if end > len(input) {
+			end = len(input)
+		}
+		require.Nil(t, websocket.Message.Send(ws, input[start:end]))
+	}
+	require.Nil(t, websocket.Message.Send(ws, []byte{}))
+
+	var events []document.StreamEvent
+	for {
+		var event document.StreamEvent
+		if err := websocket.JSON.Receive(ws, &event); err != nil {
+			break
+		}
+		events = append(events, event)
+	}
+
+	require.Len(t, events, 4)
+	require.Equal(t, document.StreamEventStart, events[0].Type)
+	require.Equal(t, "CdtTrfTxInf[1]", events[2].Path)
+	require.Equal(t, document.StreamEventEnd, events[3].Type)
+	require.True(t, events[3].Valid)
+	require.Equal(t, 2, events[3].Transactions)
+}
+
+func TestStreamValidatorWithInvalidData(t *testing.T) {
+	ws := dialStreamValidator(t)
+	require.Nil(t, websocket.Message.Send(ws, "<Document><Unknown"))
+	require.Nil(t, websocket.Message.Send(ws, ""))
+
+	var output map[string]string
+	require.Nil(t, websocket.JSON.Receive(ws, &output))
+	require.Equal(t, "error", output["type"])
+	require.NotEmpty(t, output["error"])
+}
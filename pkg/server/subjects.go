@@ -0,0 +1,234 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package server
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/moov-io/iso20022/pkg/document"
+	"github.com/moov-io/iso20022/pkg/service"
+	"github.com/moov-io/iso20022/pkg/storage"
+	"github.com/moov-io/iso20022/pkg/utils"
+)
+
+const (
+	SubjectActionExport = "export"
+	SubjectActionErase  = "erase"
+)
+
+// ErrSubjectExportForbidden is returned by POST /subjects/export for the tenants that aren't
+// allowed to export the documents of data subjects
+var ErrSubjectExportForbidden = errors.New("subject export is forbidden")
+
+// SubjectsConfig - Defines the tenants of the data subject requests
+type SubjectsConfig struct {
+	// Tenants are the usage tenants allowed to export the documents of data subjects, identified
+	// by their API keys. Documents can't be exported when omitted.
+	Tenants []string
+}
+
+// newSubjectTenants returns the tenants of config allowed to export documents, they are usage tenants
+func newSubjectTenants(config SubjectsConfig, usage UsageConfig) (map[string]bool, error) {
+	known := make(map[string]bool)
+	for _, tenant := range usage.Tenants {
+		known[tenant.Name] = true
+	}
+	tenants := make(map[string]bool)
+	for _, tenant := range config.Tenants {
+		if !known[tenant] {
+			return nil, fmt.Errorf("subjects tenant %s isn't a usage tenant", tenant)
+		}
+		tenants[tenant] = true
+	}
+	return tenants, nil
+}
+
+// SubjectRequest identifies the party of a data subject request by a value of its elements,
+// e.g. a name, BIC or IBAN
+type SubjectRequest struct {
+	Party string `json:"party"`
+}
+
+// SubjectDocument is a stored document holding the data of a party
+type SubjectDocument struct {
+	StoredDocument
+
+	// Paths are the elements identifying the party
+	Paths   []string `json:"paths"`
+	Content string   `json:"content"`
+}
+
+// SubjectExport holds every stored document of a party
+type SubjectExport struct {
+	Documents []SubjectDocument `json:"documents"`
+	Audit     SubjectAudit      `json:"audit"`
+}
+
+// SubjectAudit records a data subject request, the party is only kept as a sha-256 digest
+type SubjectAudit struct {
+	ID          string    `json:"id"`
+	Action      string    `json:"action"`
+	PartyDigest string    `json:"partyDigest"`
+	Documents   []string  `json:"documents"`
+	Time        time.Time `json:"time"`
+}
+
+// readSubjectRequest decodes the party of the request, it responds with the error and
+// returns false when the party is omitted
+func (h handlers) readSubjectRequest(w http.ResponseWriter, r *http.Request) (string, bool) {
+	var req SubjectRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.outputError(w, r, http.StatusBadRequest, err)
+		return "", false
+	}
+	if strings.TrimSpace(req.Party) == "" {
+		h.outputError(w, r, http.StatusBadRequest, errors.New("party is omitted"))
+		return "", false
+	}
+	return req.Party, true
+}
+
+// subjectDocuments calls fn with every stored document holding the data of party
+func (h handlers) subjectDocuments(r *http.Request, party string, fn func(storage.Record, document.Iso20022Document, []string) error) error {
+	records, err := h.documents.List(r.Context())
+	if err != nil {
+		return err
+	}
+	for _, rec := range records {
+		if rec.Kind == storage.KindAudit {
+			continue
+		}
+		doc, err := service.Parse(bytes.NewReader(rec.Content))
+		if err != nil {
+			return err
+		}
+		if paths := document.FindParty(doc, party); len(paths) > 0 {
+			if err = fn(rec, doc, paths); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// audit stores the audit record of a data subject request
+func (h handlers) audit(r *http.Request, action, party string, documents []string) (SubjectAudit, error) {
+	digest := sha256.Sum256([]byte(strings.ToLower(strings.TrimSpace(party))))
+	audit := SubjectAudit{
+		ID:          newId(),
+		Action:      action,
+		PartyDigest: hex.EncodeToString(digest[:]),
+		Documents:   documents,
+		Time:        time.Now().UTC(),
+	}
+	if audit.Documents == nil {
+		audit.Documents = []string{}
+	}
+	content, err := json.Marshal(audit)
+	if err != nil {
+		return audit, err
+	}
+	return audit, h.documents.Put(r.Context(), storage.Record{
+		ID:      audit.ID,
+		Kind:    storage.KindAudit,
+		Format:  utils.DocumentTypeJson,
+		Content: content,
+		Created: audit.Time,
+		Updated: audit.Time,
+	})
+}
+
+// exportSubject - export every stored document holding the data of a party, for the tenants of
+// SubjectsConfig
+func (h handlers) exportSubject(w http.ResponseWriter, r *http.Request) {
+	if h.usage == nil {
+		h.outputError(w, r, http.StatusForbidden, ErrSubjectExportForbidden)
+		return
+	}
+	tenant, err := h.usage.tenant(r)
+	if err != nil {
+		h.outputError(w, r, http.StatusUnauthorized, err)
+		return
+	}
+	if !h.subjectTenants[tenant.Name] {
+		h.outputError(w, r, http.StatusForbidden, fmt.Errorf("%w: tenant %s", ErrSubjectExportForbidden, tenant.Name))
+		return
+	}
+
+	party, ok := h.readSubjectRequest(w, r)
+	if !ok {
+		return
+	}
+
+	export := SubjectExport{Documents: []SubjectDocument{}}
+	var ids []string
+	err = h.subjectDocuments(r, party, func(rec storage.Record, _ document.Iso20022Document, paths []string) error {
+		// composed messages held for approval aren't downloadable
+		if rec.Status == ReviewStatusPendingApproval {
+			return nil
+		}
+		export.Documents = append(export.Documents, SubjectDocument{
+			StoredDocument: newStoredDocument(rec),
+			Paths:          paths,
+			Content:        string(rec.Content),
+		})
+		ids = append(ids, rec.ID)
+		return nil
+	})
+	if err != nil {
+		h.outputError(w, r, http.StatusInternalServerError, err)
+		return
+	}
+
+	if export.Audit, err = h.audit(r, SubjectActionExport, party, ids); err != nil {
+		h.outputError(w, r, http.StatusInternalServerError, err)
+		return
+	}
+	h.outputData(w, r, http.StatusOK, export)
+}
+
+// eraseSubject - redact the personal data of a party from every stored document
+func (h handlers) eraseSubject(w http.ResponseWriter, r *http.Request) {
+	party, ok := h.readSubjectRequest(w, r)
+	if !ok {
+		return
+	}
+
+	var ids []string
+	err := h.subjectDocuments(r, party, func(rec storage.Record, doc document.Iso20022Document, _ []string) error {
+		redacted, _, err := document.RedactParty(doc, party)
+		if err != nil {
+			return err
+		}
+		if rec.Content, err = messageToBuf(rec.Format, redacted); err != nil {
+			return err
+		}
+		rec.Updated = time.Now().UTC()
+		if err = h.documents.Put(r.Context(), rec); err != nil {
+			return err
+		}
+		ids = append(ids, rec.ID)
+		return nil
+	})
+	if err != nil {
+		h.outputError(w, r, http.StatusInternalServerError, err)
+		return
+	}
+
+	audit, err := h.audit(r, SubjectActionErase, party, ids)
+	if err != nil {
+		h.outputError(w, r, http.StatusInternalServerError, err)
+		return
+	}
+	h.outputData(w, r, http.StatusOK, audit)
+}
@@ -0,0 +1,101 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package server_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/require"
+
+	"github.com/moov-io/iso20022/pkg/iso20022test/fixtures"
+	"github.com/moov-io/iso20022/pkg/server"
+)
+
+func postSubject(t *testing.T, ts *httptest.Server, action, key, party string) *http.Response {
+	body, err := json.Marshal(server.SubjectRequest{Party: party})
+	require.Nil(t, err)
+	return approvalRequest(t, ts.URL+"/subjects/"+action, key, string(body))
+}
+
+func newSubjectServer(t *testing.T) *httptest.Server {
+	router := mux.NewRouter()
+	require.Nil(t, server.ConfigureHandlersWithOptions(router, server.APIConfig{
+		Usage: server.UsageConfig{Tenants: []server.TenantConfig{
+			{Name: "privacy", Keys: []string{"privacy-key"}},
+			{Name: "analytics", Keys: []string{"analytics-key"}},
+		}},
+		Subjects: server.SubjectsConfig{Tenants: []string{"privacy"}},
+	}))
+	ts := httptest.NewServer(router)
+	t.Cleanup(ts.Close)
+	return ts
+}
+
+func TestSubjectExport(t *testing.T) {
+	ts := newSubjectServer(t)
+	pacs := createDocument(t, ts, fixtures.ReadFile(t, "valid_pacs_v08.xml"))
+	createDocument(t, ts, fixtures.ReadFile(t, "valid_acmt_v03.json"))
+
+	// exports are restricted to the tenants of SubjectsConfig
+	require.Equal(t, http.StatusForbidden, postSubject(t, ts, "export", "", "Jane Doe").StatusCode)
+	require.Equal(t, http.StatusUnauthorized, postSubject(t, ts, "export", "unknown-key", "Jane Doe").StatusCode)
+	require.Equal(t, http.StatusForbidden, postSubject(t, ts, "export", "analytics-key", "Jane Doe").StatusCode)
+	require.Equal(t, http.StatusForbidden, postSubject(t, newJobServer(t), "export", "", "Jane Doe").StatusCode)
+
+	resp := postSubject(t, ts, "export", "privacy-key", "Jane Doe")
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	var export server.SubjectExport
+	require.Nil(t, json.NewDecoder(resp.Body).Decode(&export))
+	require.Len(t, export.Documents, 1)
+	require.Equal(t, pacs.ID, export.Documents[0].ID)
+	require.Equal(t, []string{"CdtTrfTxInf[0]/Cdtr/Nm"}, export.Documents[0].Paths)
+	require.Contains(t, export.Documents[0].Content, "Jane Doe")
+
+	require.Equal(t, server.SubjectActionExport, export.Audit.Action)
+	require.Equal(t, []string{pacs.ID}, export.Audit.Documents)
+	require.Len(t, export.Audit.PartyDigest, 64)
+	require.NotContains(t, export.Audit.PartyDigest, "Jane")
+
+	resp = postSubject(t, ts, "export", "privacy-key", " ")
+	require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
+
+func TestSubjectErase(t *testing.T) {
+	ts := newSubjectServer(t)
+	pacs := createDocument(t, ts, fixtures.ReadFile(t, "valid_pacs_v08.xml"))
+
+	resp := postSubject(t, ts, "erase", "", "john smith")
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	var audit server.SubjectAudit
+	require.Nil(t, json.NewDecoder(resp.Body).Decode(&audit))
+	require.Equal(t, server.SubjectActionErase, audit.Action)
+	require.Equal(t, []string{pacs.ID}, audit.Documents)
+
+	content := string(getDocument(t, ts.URL+"/documents/"+pacs.ID))
+	require.NotContains(t, content, "John Smith")
+	require.Contains(t, content, "<Nm>REDACTED</Nm>")
+	require.Contains(t, content, "<Nm>Jane Doe</Nm>")
+
+	// the erased party isn't found anymore, audit records aren't documents
+	resp = postSubject(t, ts, "export", "privacy-key", "John Smith")
+	var export server.SubjectExport
+	require.Nil(t, json.NewDecoder(resp.Body).Decode(&export))
+	require.Empty(t, export.Documents)
+
+	resp, err := http.Get(ts.URL + "/documents/" + audit.ID)
+	require.Nil(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+	resp, err = http.Post(ts.URL+"/subjects/erase", "application/json", strings.NewReader("{"))
+	require.Nil(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
@@ -0,0 +1,47 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package server_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/moov-io/iso20022/pkg/iso20022test/fixtures"
+)
+
+func TestDeprecationWarnings(t *testing.T) {
+	ts := newJobServer(t)
+	input := fixtures.ReadFile(t, "valid_pacs_v08.xml")
+	warning := `299 - "pacs.008.001.08 is deprecated, migrate to pacs.008.001.09"`
+
+	resp := postForm(t, ts.URL+"/validator", input, nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, warning, resp.Header.Get("Warning"))
+
+	resp = postForm(t, ts.URL+"/v2/validator", input, nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, warning, resp.Header.Get("Warning"))
+	var envelope struct {
+		Warnings []string
+	}
+	require.Nil(t, json.NewDecoder(resp.Body).Decode(&envelope))
+	require.Equal(t, []string{"pacs.008.001.08 is deprecated, migrate to pacs.008.001.09"}, envelope.Warnings)
+
+	resp = postForm(t, ts.URL+"/convert", input, map[string]string{"format": "json"})
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, warning, resp.Header.Get("Warning"))
+
+	job := startJob(t, ts, input, nil)
+	events := readJobEvents(t, ts, job.ID)
+	done := events[len(events)-1]
+	require.Contains(t, done.job.Progress.Warnings, "pacs.008.001.08 is deprecated, migrate to pacs.008.001.09")
+
+	resp = postForm(t, ts.URL+"/validator", fixtures.ReadFile(t, "valid_remt_v04.xml"), nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Empty(t, resp.Header.Get("Warning"))
+}
@@ -0,0 +1,159 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"github.com/moov-io/iso20022/pkg/templates"
+	"github.com/moov-io/iso20022/pkg/utils"
+)
+
+const maxTemplateSize = 4 << 20
+
+// TemplatesConfig - Defines where the templates of the /templates endpoints are kept
+type TemplatesConfig struct {
+	// Directory keeps every template as a yaml file, templates are kept in memory when omitted
+	Directory string
+}
+
+func newTemplateLibrary(config TemplatesConfig) (templates.Library, error) {
+	if config.Directory == "" {
+		return templates.NewMemoryLibrary(), nil
+	}
+	return templates.NewDirLibrary(config.Directory)
+}
+
+// checkTemplatesConfig checks the options of newTemplateLibrary without creating its directory
+func checkTemplatesConfig(config TemplatesConfig) error {
+	return checkDirectory(config.Directory)
+}
+
+// TemplateInstantiation is the body of the instantiation requests of a template
+type TemplateInstantiation struct {
+	// Variables are the values of the variables of the template by name
+	Variables map[string]string `json:"variables"`
+}
+
+// getTemplate returns the template of the name route variable, responding 404 when it isn't kept
+func (h handlers) getTemplate(w http.ResponseWriter, r *http.Request) (*templates.Template, bool) {
+	t, err := h.templates.Get(r.Context(), mux.Vars(r)["name"])
+	if errors.Is(err, templates.ErrNotFound) {
+		h.outputError(w, r, http.StatusNotFound, err)
+		return nil, false
+	}
+	if err != nil {
+		h.outputError(w, r, http.StatusInternalServerError, err)
+		return nil, false
+	}
+	return t, true
+}
+
+// listTemplates - list the templates ordered by name
+func (h handlers) listTemplates(w http.ResponseWriter, r *http.Request) {
+	list, err := h.templates.List(r.Context())
+	if err != nil {
+		h.outputError(w, r, http.StatusInternalServerError, err)
+		return
+	}
+	h.outputData(w, r, http.StatusOK, list)
+}
+
+// template - template with its variables and content
+func (h handlers) template(w http.ResponseWriter, r *http.Request) {
+	if t, ok := h.getTemplate(w, r); ok {
+		h.outputData(w, r, http.StatusOK, t)
+	}
+}
+
+// putTemplate - create or replace the template of the yaml or json body, named after the path
+// when the body omits its name. 422 Unprocessable Entity when it can't be instantiated.
+func (h handlers) putTemplate(w http.ResponseWriter, r *http.Request) {
+	buf, err := io.ReadAll(io.LimitReader(r.Body, maxTemplateSize))
+	if err != nil {
+		h.outputError(w, r, http.StatusBadRequest, err)
+		return
+	}
+	t, err := templates.Unmarshal(buf)
+	if err != nil {
+		h.outputError(w, r, http.StatusBadRequest, err)
+		return
+	}
+	name := mux.Vars(r)["name"]
+	if t.Name == "" {
+		t.Name = name
+	}
+	if t.Name != name {
+		h.outputError(w, r, http.StatusBadRequest, fmt.Errorf("template name %s isn't %s", t.Name, name))
+		return
+	}
+	if err = t.Validate(); err != nil {
+		h.outputError(w, r, http.StatusUnprocessableEntity, err)
+		return
+	}
+	if err = h.templates.Put(r.Context(), *t); err != nil {
+		h.outputError(w, r, http.StatusInternalServerError, err)
+		return
+	}
+	h.outputData(w, r, http.StatusOK, t)
+}
+
+// deleteTemplate - remove a template
+func (h handlers) deleteTemplate(w http.ResponseWriter, r *http.Request) {
+	err := h.templates.Delete(r.Context(), mux.Vars(r)["name"])
+	if errors.Is(err, templates.ErrNotFound) {
+		h.outputError(w, r, http.StatusNotFound, err)
+		return
+	}
+	if err != nil {
+		h.outputError(w, r, http.StatusInternalServerError, err)
+		return
+	}
+	h.outputSuccess(w, r, "deleted")
+}
+
+// instantiateTemplate - message of a template with the variables of the body, in the format
+// of the template unless the format form value is set. 422 Unprocessable Entity when the
+// variables don't match the template or the message is invalid. With four-eyes approval the
+// message is composed pending approval like by composeTemplate instead.
+func (h handlers) instantiateTemplate(w http.ResponseWriter, r *http.Request) {
+	if h.review.fourEyes {
+		h.protected(h.composeTemplate)(w, r)
+		return
+	}
+	var body TemplateInstantiation
+	if err := json.NewDecoder(io.LimitReader(r.Body, maxTemplateSize)).Decode(&body); err != nil && !errors.Is(err, io.EOF) {
+		h.outputError(w, r, http.StatusBadRequest, err)
+		return
+	}
+	t, ok := h.getTemplate(w, r)
+	if !ok {
+		return
+	}
+
+	doc, output, err := t.Instantiate(body.Variables)
+	if err != nil {
+		h.outputError(w, r, http.StatusUnprocessableEntity, err)
+		return
+	}
+	format := utils.GetDocumentFormat(output)
+	if r.FormValue("format") != "" {
+		if format, err = getFormat(r); err != nil {
+			h.outputError(w, r, http.StatusNotImplemented, err)
+			return
+		}
+		if output, err = messageToBuf(format, doc); err != nil {
+			h.outputError(w, r, http.StatusNotImplemented, err)
+			return
+		}
+	}
+	h.outputFile(w, r, t.Name+"."+string(format), format, output)
+}
@@ -0,0 +1,93 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package server_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/require"
+
+	"github.com/moov-io/iso20022/pkg/iso20022test/fixtures"
+	"github.com/moov-io/iso20022/pkg/server"
+	"github.com/moov-io/iso20022/pkg/templates"
+)
+
+func templateRequest(t *testing.T, method, url string, body []byte) (*http.Response, []byte) {
+	req, err := http.NewRequest(method, url, bytes.NewReader(body))
+	require.Nil(t, err)
+	resp, err := http.DefaultClient.Do(req)
+	require.Nil(t, err)
+	defer resp.Body.Close()
+	buf, err := io.ReadAll(resp.Body)
+	require.Nil(t, err)
+	return resp, buf
+}
+
+func TestTemplates(t *testing.T) {
+	router := mux.NewRouter()
+	require.Nil(t, server.ConfigureHandlersWithOptions(router, server.APIConfig{
+		Templates: server.TemplatesConfig{Directory: t.TempDir()},
+	}))
+	ts := httptest.NewServer(router)
+	defer ts.Close()
+
+	content := strings.Replace(string(fixtures.ReadFile(t, "valid_pacs_v08.xml")), "MSG-20210415-0001", "PAYROLL-${month}", 1)
+	body, err := json.Marshal(templates.Template{
+		Variables: []templates.Variable{{Name: "month", Required: true}},
+		Content:   content,
+	})
+	require.Nil(t, err)
+
+	resp, _ := templateRequest(t, http.MethodGet, ts.URL+"/templates/payroll", nil)
+	require.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+	// templates are named after the path
+	resp, buf := templateRequest(t, http.MethodPut, ts.URL+"/templates/payroll", body)
+	require.Equal(t, http.StatusOK, resp.StatusCode, string(buf))
+	var tmpl templates.Template
+	require.Nil(t, json.Unmarshal(buf, &tmpl))
+	require.Equal(t, "payroll", tmpl.Name)
+	require.Equal(t, "pacs.008.001.08", tmpl.MessageType)
+
+	resp, _ = templateRequest(t, http.MethodPut, ts.URL+"/templates/bonus", buf)
+	require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	resp, buf = templateRequest(t, http.MethodPut, ts.URL+"/templates/bonus", []byte(`{"content": "<Document>${month}</Document>"}`))
+	require.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
+	require.Contains(t, string(buf), "isn't a variable")
+
+	resp, buf = templateRequest(t, http.MethodGet, ts.URL+"/templates", nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	var list []templates.Template
+	require.Nil(t, json.Unmarshal(buf, &list))
+	require.Len(t, list, 1)
+
+	// instances are xml like the template, or converted to the format
+	resp, buf = templateRequest(t, http.MethodPost, ts.URL+"/templates/payroll/instantiate", []byte(`{"variables": {"month": "2026-10"}}`))
+	require.Equal(t, http.StatusOK, resp.StatusCode, string(buf))
+	require.Equal(t, "attachment; filename=payroll.xml", resp.Header.Get("Content-Disposition"))
+	require.Contains(t, string(buf), "<MsgId>PAYROLL-2026-10</MsgId>")
+
+	resp, buf = templateRequest(t, http.MethodPost, ts.URL+"/templates/payroll/instantiate?format=json", []byte(`{"variables": {"month": "2026-10"}}`))
+	require.Equal(t, http.StatusOK, resp.StatusCode, string(buf))
+	require.Contains(t, string(buf), `"PAYROLL-2026-10"`)
+
+	resp, buf = templateRequest(t, http.MethodPost, ts.URL+"/templates/payroll/instantiate", nil)
+	require.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
+	require.Contains(t, string(buf), "month required")
+	resp, _ = templateRequest(t, http.MethodPost, ts.URL+"/templates/bonus/instantiate", nil)
+	require.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+	resp, _ = templateRequest(t, http.MethodDelete, ts.URL+"/templates/payroll", nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	resp, _ = templateRequest(t, http.MethodDelete, ts.URL+"/templates/payroll", nil)
+	require.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
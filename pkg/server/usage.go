@@ -0,0 +1,349 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultAPIKeyHeader holds the API key of requests unless another header is configured
+	DefaultAPIKeyHeader = "X-Api-Key"
+
+	// AnonymousTenant is accounted the requests without API key
+	AnonymousTenant = "anonymous"
+
+	// usageMonthLayout formats the calendar months of usage
+	usageMonthLayout = "2006-01"
+)
+
+var (
+	// ErrUsageNotConfigured is returned for GET /usage when no tenants are configured
+	ErrUsageNotConfigured = errors.New("usage accounting is not configured")
+
+	// ErrMissingAPIKey is returned when a request without API key is rejected
+	ErrMissingAPIKey = errors.New("api key is omitted")
+
+	// ErrUnknownAPIKey is returned when the API key of a request isn't the key of a tenant
+	ErrUnknownAPIKey = errors.New("unknown api key")
+
+	// ErrQuotaExceeded is returned when a tenant processed its monthly quota
+	ErrQuotaExceeded = errors.New("monthly quota exceeded")
+)
+
+// Usage is the usage of a tenant in a calendar month
+type Usage struct {
+	Tenant string `json:"tenant"`
+	Month  string `json:"month"`
+
+	// Requests counts the metered requests, Rejected the requests rejected by a quota
+	Requests int `json:"requests"`
+	Rejected int `json:"rejected"`
+
+	// Messages counts the requests with a message and Bytes their size
+	Messages int   `json:"messages"`
+	Bytes    int64 `json:"bytes"`
+
+	Quota *Quota `json:"quota,omitempty"`
+}
+
+// Quota is the monthly quota of a tenant, zero is unlimited
+type Quota struct {
+	Messages int   `json:"messages,omitempty"`
+	Bytes    int64 `json:"bytes,omitempty"`
+}
+
+func (q Quota) exceeded(u *Usage) bool {
+	return (q.Messages > 0 && u.Messages >= q.Messages) || (q.Bytes > 0 && u.Bytes >= q.Bytes)
+}
+
+type usageSampleKey struct{}
+
+// usageMeter accounts the metered requests of tenants by month in memory
+type usageMeter struct {
+	header     string
+	requireKey bool
+	tenants    map[string]*TenantConfig
+	now        func() time.Time
+
+	// keys are replaced when their secrets are rotated
+	mu    sync.Mutex
+	keys  map[string]*TenantConfig
+	usage map[string]map[string]*Usage
+}
+
+// newUsageMeter returns the meter of the configured tenants, nil when none are configured
+func newUsageMeter(config UsageConfig) (*usageMeter, error) {
+	if len(config.Tenants) == 0 {
+		return nil, nil
+	}
+	header := config.Header
+	if header == "" {
+		header = DefaultAPIKeyHeader
+	}
+	m := &usageMeter{
+		header:     header,
+		requireKey: config.RequireKey,
+		tenants:    map[string]*TenantConfig{AnonymousTenant: {Name: AnonymousTenant}},
+		keys:       make(map[string]*TenantConfig),
+		now:        time.Now,
+		usage:      make(map[string]map[string]*Usage),
+	}
+	for i := range config.Tenants {
+		tenant := config.Tenants[i]
+		if tenant.Name == "" {
+			return nil, fmt.Errorf("tenant %d: name is omitted", i)
+		}
+		if _, exists := m.tenants[tenant.Name]; exists {
+			return nil, fmt.Errorf("tenant %s: name is reserved or duplicated", tenant.Name)
+		}
+		if len(tenant.Keys) == 0 {
+			return nil, fmt.Errorf("tenant %s: keys are omitted", tenant.Name)
+		}
+		if tenant.MonthlyMessages < 0 || tenant.MonthlyBytes < 0 {
+			return nil, fmt.Errorf("tenant %s: quota is negative", tenant.Name)
+		}
+		for _, key := range tenant.Keys {
+			if key == "" {
+				return nil, fmt.Errorf("tenant %s: key is empty", tenant.Name)
+			}
+			if _, exists := m.keys[key]; exists {
+				return nil, fmt.Errorf("tenant %s: key is the key of another tenant", tenant.Name)
+			}
+			m.keys[key] = &tenant
+		}
+		m.tenants[tenant.Name] = &tenant
+	}
+	return m, nil
+}
+
+// tenant returns the tenant of the API key of r
+func (m *usageMeter) tenant(r *http.Request) (*TenantConfig, error) {
+	key := r.Header.Get(m.header)
+	if key == "" {
+		if m.requireKey {
+			return nil, fmt.Errorf("%w: %s", ErrMissingAPIKey, m.header)
+		}
+		return m.tenants[AnonymousTenant], nil
+	}
+	m.mu.Lock()
+	tenant, exists := m.keys[key]
+	m.mu.Unlock()
+	if !exists {
+		return nil, ErrUnknownAPIKey
+	}
+	return tenant, nil
+}
+
+// rotateKeys replaces the API keys of the tenants by the keys of tenants, e.g. once their
+// secrets are rotated. The keys are kept when a tenant is unknown or its keys are invalid.
+func (m *usageMeter) rotateKeys(tenants []TenantConfig) error {
+	keys := make(map[string]*TenantConfig)
+	for _, config := range tenants {
+		tenant, exists := m.tenants[config.Name]
+		if !exists || config.Name == AnonymousTenant {
+			return fmt.Errorf("tenant %s isn't a usage tenant", config.Name)
+		}
+		if len(config.Keys) == 0 {
+			return fmt.Errorf("tenant %s: keys are omitted", config.Name)
+		}
+		for _, key := range config.Keys {
+			if key == "" {
+				return fmt.Errorf("tenant %s: key is empty", config.Name)
+			}
+			if _, exists := keys[key]; exists {
+				return fmt.Errorf("tenant %s: key is the key of another tenant", config.Name)
+			}
+			keys[key] = tenant
+		}
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.keys = keys
+	return nil
+}
+
+// month returns the usage of tenant in the month holding at, the lock must be held
+func (m *usageMeter) month(tenant *TenantConfig, at time.Time) *Usage {
+	month := at.UTC().Format(usageMonthLayout)
+	months, exists := m.usage[tenant.Name]
+	if !exists {
+		months = make(map[string]*Usage)
+		m.usage[tenant.Name] = months
+	}
+	usage, exists := months[month]
+	if !exists {
+		usage = &Usage{Tenant: tenant.Name, Month: month}
+		months[month] = usage
+	}
+	return usage
+}
+
+// admit counts a request of tenant at at, it returns ErrQuotaExceeded and the time until the
+// next month when the tenant processed its quota of the month
+func (m *usageMeter) admit(tenant *TenantConfig, at time.Time) (time.Duration, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	usage := m.month(tenant, at)
+	usage.Requests++
+	if quota := quotaOf(tenant); quota.exceeded(usage) {
+		usage.Rejected++
+		at = at.UTC()
+		next := time.Date(at.Year(), at.Month()+1, 1, 0, 0, 0, 0, time.UTC)
+		return next.Sub(at), fmt.Errorf("%w: tenant %s in %s", ErrQuotaExceeded, tenant.Name, usage.Month)
+	}
+	return 0, nil
+}
+
+// record adds the message of an admitted request of tenant at at
+func (m *usageMeter) record(tenant *TenantConfig, at time.Time, sample statsSample) {
+	if sample.size == 0 {
+		return
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	usage := m.month(tenant, at)
+	usage.Messages++
+	usage.Bytes += int64(sample.size)
+}
+
+// report returns the usage of the tenants by name and month, every month when month is empty
+func (m *usageMeter) report(tenants []string, month string) []Usage {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	usages := []Usage{}
+	for _, name := range tenants {
+		for _, usage := range m.usage[name] {
+			if month != "" && usage.Month != month {
+				continue
+			}
+			u := *usage
+			if quota := quotaOf(m.tenants[name]); quota != (Quota{}) {
+				u.Quota = &quota
+			}
+			usages = append(usages, u)
+		}
+	}
+	sort.Slice(usages, func(i, j int) bool {
+		if usages[i].Tenant != usages[j].Tenant {
+			return usages[i].Tenant < usages[j].Tenant
+		}
+		return usages[i].Month < usages[j].Month
+	})
+	return usages
+}
+
+// tenantNames returns the names of the tenants in order, the anonymous tenant included
+func (m *usageMeter) tenantNames() []string {
+	names := make([]string, 0, len(m.tenants))
+	for name := range m.tenants {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// restore replaces the usage of the tenants of usages by month, e.g. the report of another
+// server, and returns the number of months restored. Usages of unknown tenants are skipped.
+func (m *usageMeter) restore(usages []Usage) int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	restored := 0
+	for _, usage := range usages {
+		tenant, exists := m.tenants[usage.Tenant]
+		if !exists {
+			continue
+		}
+		if _, err := time.Parse(usageMonthLayout, usage.Month); err != nil {
+			continue
+		}
+		months, exists := m.usage[tenant.Name]
+		if !exists {
+			months = make(map[string]*Usage)
+			m.usage[tenant.Name] = months
+		}
+		u := usage
+		u.Quota = nil
+		months[u.Month] = &u
+		restored++
+	}
+	return restored
+}
+
+func quotaOf(tenant *TenantConfig) Quota {
+	return Quota{Messages: tenant.MonthlyMessages, Bytes: tenant.MonthlyBytes}
+}
+
+// metered accounts the requests of next to the tenant of their API key, 401 Unauthorized for
+// unknown keys and 429 Too Many Requests once the tenant processed its monthly quota
+func (h handlers) metered(next http.HandlerFunc) http.HandlerFunc {
+	if h.usage == nil {
+		return next
+	}
+	return func(w http.ResponseWriter, r *http.Request) {
+		tenant, err := h.usage.tenant(r)
+		if err != nil {
+			h.outputError(w, r, http.StatusUnauthorized, err)
+			return
+		}
+		at := h.usage.now()
+		if retry, err := h.usage.admit(tenant, at); err != nil {
+			w.Header().Set("Retry-After", strconv.Itoa(int((retry+time.Second-1)/time.Second)))
+			h.outputError(w, r, http.StatusTooManyRequests, err)
+			return
+		}
+		sample := &statsSample{}
+		next(w, r.WithContext(context.WithValue(r.Context(), usageSampleKey{}, sample)))
+		h.usage.record(tenant, at, *sample)
+	}
+}
+
+// usageReport - usage of the tenant of the API key of the request by month, admins read the
+// usage of every tenant or of the tenant parameter
+func (h handlers) usageReport(w http.ResponseWriter, r *http.Request) {
+	if h.usage == nil {
+		h.outputError(w, r, http.StatusNotImplemented, ErrUsageNotConfigured)
+		return
+	}
+	tenant, err := h.usage.tenant(r)
+	if err != nil {
+		h.outputError(w, r, http.StatusUnauthorized, err)
+		return
+	}
+
+	month := r.URL.Query().Get("month")
+	if month != "" {
+		if _, err := time.Parse(usageMonthLayout, month); err != nil {
+			h.outputError(w, r, http.StatusBadRequest, fmt.Errorf("month %q is not a YYYY-MM month", month))
+			return
+		}
+	}
+
+	tenants := []string{tenant.Name}
+	if name := r.URL.Query().Get("tenant"); name != "" && name != tenant.Name {
+		if !tenant.Admin {
+			h.outputError(w, r, http.StatusForbidden, fmt.Errorf("tenant %s can't read the usage of %s", tenant.Name, name))
+			return
+		}
+		if _, exists := h.usage.tenants[name]; !exists {
+			h.outputError(w, r, http.StatusNotFound, fmt.Errorf("unknown tenant %s", name))
+			return
+		}
+		tenants = []string{name}
+	} else if name == "" && tenant.Admin {
+		tenants = tenants[:0]
+		for name := range h.usage.tenants {
+			tenants = append(tenants, name)
+		}
+	}
+	h.outputData(w, r, http.StatusOK, h.usage.report(tenants, month))
+}
@@ -0,0 +1,171 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package server_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/require"
+
+	"github.com/moov-io/iso20022/pkg/iso20022test/fixtures"
+	"github.com/moov-io/iso20022/pkg/server"
+)
+
+func newUsageServer(t *testing.T, config server.UsageConfig) *httptest.Server {
+	router := mux.NewRouter()
+	require.Nil(t, server.ConfigureHandlersWithOptions(router, server.APIConfig{Usage: config}))
+	ts := httptest.NewServer(router)
+	t.Cleanup(ts.Close)
+	return ts
+}
+
+// postWithKey posts input to url with the API key in the default header, omitted when empty
+func postWithKey(t *testing.T, url string, input []byte, key string) *http.Response {
+	return postWithHeader(t, url, input, server.DefaultAPIKeyHeader, key)
+}
+
+func postWithHeader(t *testing.T, url string, input []byte, header, key string) *http.Response {
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	part, err := writer.CreateFormFile("input", "input")
+	require.Nil(t, err)
+	_, err = part.Write(input)
+	require.Nil(t, err)
+	require.Nil(t, writer.Close())
+
+	req, err := http.NewRequest(http.MethodPost, url, body)
+	require.Nil(t, err)
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	if key != "" {
+		req.Header.Set(header, key)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	require.Nil(t, err)
+	t.Cleanup(func() { resp.Body.Close() })
+	return resp
+}
+
+// getUsage reads the usage visible to the API key with query
+func getUsage(t *testing.T, ts *httptest.Server, key, query string) (int, []server.Usage) {
+	req, err := http.NewRequest(http.MethodGet, ts.URL+"/usage"+query, nil)
+	require.Nil(t, err)
+	if key != "" {
+		req.Header.Set(server.DefaultAPIKeyHeader, key)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	require.Nil(t, err)
+	defer resp.Body.Close()
+
+	var usages []server.Usage
+	if resp.StatusCode == http.StatusOK {
+		require.Nil(t, json.NewDecoder(resp.Body).Decode(&usages))
+	}
+	return resp.StatusCode, usages
+}
+
+func TestUsage(t *testing.T) {
+	ts := newUsageServer(t, server.UsageConfig{
+		Tenants: []server.TenantConfig{
+			{Name: "acme", Keys: []string{"acme-key"}, MonthlyMessages: 2},
+			{Name: "ops", Keys: []string{"ops-key"}, Admin: true},
+		},
+	})
+	input := fixtures.ReadFile(t, "valid_pacs_v08.xml")
+	month := time.Now().UTC().Format("2006-01")
+
+	require.Equal(t, http.StatusOK, postWithKey(t, ts.URL+"/validator", input, "acme-key").StatusCode)
+	require.Equal(t, http.StatusOK, postWithKey(t, ts.URL+"/convert", input, "acme-key").StatusCode)
+
+	// the quota of the month is processed
+	resp := postWithKey(t, ts.URL+"/print", input, "acme-key")
+	require.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+	retry, err := strconv.Atoi(resp.Header.Get("Retry-After"))
+	require.Nil(t, err)
+	require.True(t, retry > 0 && retry <= 31*24*3600)
+
+	require.Equal(t, http.StatusUnauthorized, postWithKey(t, ts.URL+"/validator", input, "wrong-key").StatusCode)
+	require.Equal(t, http.StatusOK, postWithKey(t, ts.URL+"/validator", input, "").StatusCode)
+	require.Equal(t, http.StatusOK, postWithKey(t, ts.URL+"/validator", input, "ops-key").StatusCode)
+
+	code, usages := getUsage(t, ts, "acme-key", "")
+	require.Equal(t, http.StatusOK, code)
+	require.Len(t, usages, 1)
+	require.Equal(t, "acme", usages[0].Tenant)
+	require.Equal(t, month, usages[0].Month)
+	require.Equal(t, 3, usages[0].Requests)
+	require.Equal(t, 1, usages[0].Rejected)
+	require.Equal(t, 2, usages[0].Messages)
+	require.Equal(t, int64(2*len(input)), usages[0].Bytes)
+	require.Equal(t, &server.Quota{Messages: 2}, usages[0].Quota)
+
+	// tenants only read their own usage
+	code, _ = getUsage(t, ts, "acme-key", "?tenant=ops")
+	require.Equal(t, http.StatusForbidden, code)
+	code, _ = getUsage(t, ts, "wrong-key", "")
+	require.Equal(t, http.StatusUnauthorized, code)
+
+	// admins read the usage of every tenant
+	code, usages = getUsage(t, ts, "ops-key", "?month="+month)
+	require.Equal(t, http.StatusOK, code)
+	require.Len(t, usages, 3)
+	require.Equal(t, "acme", usages[0].Tenant)
+	require.Equal(t, server.AnonymousTenant, usages[1].Tenant)
+	require.Equal(t, 1, usages[1].Messages)
+	require.Nil(t, usages[1].Quota)
+	require.Equal(t, "ops", usages[2].Tenant)
+
+	code, usages = getUsage(t, ts, "ops-key", "?tenant=acme&month=2001-01")
+	require.Equal(t, http.StatusOK, code)
+	require.Empty(t, usages)
+	code, _ = getUsage(t, ts, "ops-key", "?tenant=unknown")
+	require.Equal(t, http.StatusNotFound, code)
+	code, _ = getUsage(t, ts, "ops-key", "?month=april")
+	require.Equal(t, http.StatusBadRequest, code)
+}
+
+func TestUsageRequireKey(t *testing.T) {
+	ts := newUsageServer(t, server.UsageConfig{
+		Header:     "X-Tenant-Key",
+		RequireKey: true,
+		Tenants:    []server.TenantConfig{{Name: "acme", Keys: []string{"acme-key"}, MonthlyBytes: 1}},
+	})
+	input := fixtures.ReadFile(t, "valid_pacs_v08.xml")
+
+	require.Equal(t, http.StatusUnauthorized, postWithKey(t, ts.URL+"/validator", input, "").StatusCode)
+
+	// the request reaching the quota is processed, the next one is rejected
+	resp := postWithHeader(t, ts.URL+"/validator", input, "X-Tenant-Key", "acme-key")
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	resp = postWithHeader(t, ts.URL+"/validator", input, "X-Tenant-Key", "acme-key")
+	require.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+}
+
+func TestUsageDisabled(t *testing.T) {
+	ts := newUsageServer(t, server.UsageConfig{})
+	code, _ := getUsage(t, ts, "", "")
+	require.Equal(t, http.StatusNotImplemented, code)
+}
+
+func TestUsageConfig(t *testing.T) {
+	for _, tenants := range [][]server.TenantConfig{
+		{{Keys: []string{"key"}}},
+		{{Name: "acme"}},
+		{{Name: server.AnonymousTenant, Keys: []string{"key"}}},
+		{{Name: "acme", Keys: []string{"key"}}, {Name: "other", Keys: []string{"key"}}},
+		{{Name: "acme", Keys: []string{"key"}, MonthlyMessages: -1}},
+	} {
+		router := mux.NewRouter()
+		err := server.ConfigureHandlersWithOptions(router, server.APIConfig{Usage: server.UsageConfig{Tenants: tenants}})
+		require.NotNil(t, err, "%+v", tenants)
+	}
+}
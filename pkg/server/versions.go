@@ -0,0 +1,106 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package server
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gorilla/mux"
+)
+
+const (
+	// APIVersion1 serves the ad-hoc responses of the unversioned endpoints, its contract doesn't change
+	APIVersion1 = "v1"
+
+	// APIVersion2 serves standardized envelopes
+	APIVersion2 = "v2"
+
+	// APIVersionHeader carries the api version of a response
+	APIVersionHeader = "X-API-Version"
+)
+
+// Deprecation - Marks a version of the api as deprecated
+type Deprecation struct {
+	// Version is the deprecated version, empty for the unversioned endpoints
+	Version string
+
+	// Sunset is the date (2006-01-02) or time (RFC 3339) after which the version may be removed
+	Sunset string
+
+	// Successor is the version replacing the deprecated one
+	Successor string
+}
+
+// headers returns the deprecation headers of the endpoint at path
+func (d Deprecation) headers(path string) (http.Header, error) {
+	header := http.Header{}
+	header.Set("Deprecation", "true")
+
+	if d.Sunset != "" {
+		sunset, err := time.Parse(time.RFC3339, d.Sunset)
+		if err != nil {
+			if sunset, err = time.Parse("2006-01-02", d.Sunset); err != nil {
+				return nil, fmt.Errorf("invalid sunset %s of api version %s", d.Sunset, d.Version)
+			}
+		}
+		header.Set("Sunset", sunset.UTC().Format(http.TimeFormat))
+	}
+
+	if d.Successor != "" {
+		if !isAPIVersion(d.Successor) {
+			return nil, fmt.Errorf("unknown successor %s of api version %s", d.Successor, d.Version)
+		}
+		header.Set("Link", fmt.Sprintf("</%s%s>; rel=\"successor-version\"", d.Successor, path))
+	}
+	return header, nil
+}
+
+func isAPIVersion(version string) bool {
+	return version == APIVersion1 || version == APIVersion2
+}
+
+// versionMiddleware sets the version and deprecation headers of responses
+func versionMiddleware(version string, deprecation *Deprecation) (mux.MiddlewareFunc, error) {
+	if deprecation != nil {
+		// verifying the configuration before serving any request
+		if _, err := deprecation.headers("/"); err != nil {
+			return nil, err
+		}
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set(APIVersionHeader, version)
+			if deprecation != nil {
+				path := r.URL.Path
+				if deprecation.Version != "" {
+					path = strings.TrimPrefix(path, "/"+deprecation.Version)
+				}
+				header, _ := deprecation.headers(path)
+				for key, values := range header {
+					w.Header()[key] = values
+				}
+			}
+			next.ServeHTTP(w, r)
+		})
+	}, nil
+}
+
+// findDeprecation returns the deprecation of version, nil when it isn't deprecated
+func findDeprecation(options APIConfig, version string) (*Deprecation, error) {
+	for i := range options.Deprecations {
+		d := options.Deprecations[i]
+		if d.Version != "" && !isAPIVersion(d.Version) {
+			return nil, fmt.Errorf("unknown deprecated api version %s", d.Version)
+		}
+		if d.Version == version {
+			return &d, nil
+		}
+	}
+	return nil, nil
+}
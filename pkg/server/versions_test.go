@@ -0,0 +1,79 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package server_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/moov-io/iso20022/pkg/server"
+	"github.com/stretchr/testify/require"
+)
+
+func serveHealth(t *testing.T, router *mux.Router, url string) *httptest.ResponseRecorder {
+	request, err := http.NewRequest(http.MethodGet, url, nil)
+	require.Nil(t, err)
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, request)
+	require.Equal(t, http.StatusOK, recorder.Code)
+	return recorder
+}
+
+func TestAPIVersions(t *testing.T) {
+	router := mux.NewRouter()
+	require.Nil(t, server.ConfigureHandlers(router))
+
+	for _, url := range []string{"/health", "/v1/health"} {
+		recorder := serveHealth(t, router, url)
+		require.Equal(t, server.APIVersion1, recorder.Header().Get(server.APIVersionHeader))
+		require.Empty(t, recorder.Header().Get("Deprecation"))
+
+		var output map[string]interface{}
+		require.Nil(t, json.NewDecoder(recorder.Body).Decode(&output))
+		require.Equal(t, map[string]interface{}{"status": "alive"}, output)
+	}
+
+	recorder := serveHealth(t, router, "/v2/health")
+	require.Equal(t, server.APIVersion2, recorder.Header().Get(server.APIVersionHeader))
+}
+
+func TestAPIDeprecations(t *testing.T) {
+	router := mux.NewRouter()
+	require.Nil(t, server.ConfigureHandlersWithOptions(router, server.APIConfig{
+		Deprecations: []server.Deprecation{
+			{Version: "", Successor: server.APIVersion1},
+			{Version: server.APIVersion1, Sunset: "2022-06-30", Successor: server.APIVersion2},
+		},
+	}))
+
+	recorder := serveHealth(t, router, "/health")
+	require.Equal(t, "true", recorder.Header().Get("Deprecation"))
+	require.Empty(t, recorder.Header().Get("Sunset"))
+	require.Equal(t, `</v1/health>; rel="successor-version"`, recorder.Header().Get("Link"))
+
+	recorder = serveHealth(t, router, "/v1/health")
+	require.Equal(t, "true", recorder.Header().Get("Deprecation"))
+	require.Equal(t, "Thu, 30 Jun 2022 00:00:00 GMT", recorder.Header().Get("Sunset"))
+	require.Equal(t, `</v2/health>; rel="successor-version"`, recorder.Header().Get("Link"))
+
+	recorder = serveHealth(t, router, "/v2/health")
+	require.Empty(t, recorder.Header().Get("Deprecation"))
+}
+
+func TestAPIDeprecationErrors(t *testing.T) {
+	for _, deprecation := range []server.Deprecation{
+		{Version: "v9"},
+		{Version: server.APIVersion1, Sunset: "tomorrow"},
+		{Version: server.APIVersion1, Successor: "v9"},
+	} {
+		err := server.ConfigureHandlersWithOptions(mux.NewRouter(), server.APIConfig{
+			Deprecations: []server.Deprecation{deprecation},
+		})
+		require.NotNil(t, err)
+	}
+}
@@ -0,0 +1,168 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package service
+
+import (
+	"bytes"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/moov-io/iso20022/pkg/document"
+	"github.com/moov-io/iso20022/pkg/utils"
+)
+
+const (
+	CorpusStageParse      = "parse"
+	CorpusStageValidate   = "validate"
+	CorpusStageConvert    = "convert"
+	CorpusStageReparse    = "reparse"
+	CorpusStageRevalidate = "revalidate"
+	CorpusStageRoundTrip  = "roundtrip"
+)
+
+// CorpusResult is the outcome of checking a single sample of a corpus
+type CorpusResult struct {
+	File        string             `json:"file"`
+	MessageType string             `json:"messageType,omitempty"`
+	Format      utils.DocumentType `json:"format"`
+	Compatible  bool               `json:"compatible"`
+
+	// Stage is the first failing stage of an incompatible sample
+	Stage string `json:"stage,omitempty"`
+	Error string `json:"error,omitempty"`
+}
+
+// CorpusReport is the outcome of checking every sample of a corpus
+type CorpusReport struct {
+	Directory    string `json:"directory"`
+	Samples      int    `json:"samples"`
+	Compatible   int    `json:"compatible"`
+	Incompatible int    `json:"incompatible"`
+
+	// Stages count the incompatible samples by failing stage, MessageTypes the samples by message type
+	Stages       map[string]int `json:"stages"`
+	MessageTypes map[string]int `json:"messageTypes"`
+
+	Results []CorpusResult `json:"results"`
+}
+
+// CheckCorpus runs every xml and json sample of dir and its subdirectories through parse, validate,
+// convert to the other format, reparse, revalidate and compares the elements of the round trip
+func CheckCorpus(dir string) (*CorpusReport, error) {
+	var files []string
+	err := filepath.WalkDir(dir, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		ext := strings.ToLower(filepath.Ext(path))
+		if !entry.IsDir() && (ext == ".xml" || ext == ".json") {
+			files = append(files, path)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(files)
+
+	report := &CorpusReport{
+		Directory:    dir,
+		Stages:       make(map[string]int),
+		MessageTypes: make(map[string]int),
+		Results:      make([]CorpusResult, 0, len(files)),
+	}
+	for _, path := range files {
+		buf, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		name, err := filepath.Rel(dir, path)
+		if err != nil {
+			name = path
+		}
+
+		result := CheckSample(buf)
+		result.File = filepath.ToSlash(name)
+		report.add(result)
+	}
+	return report, nil
+}
+
+func (r *CorpusReport) add(result CorpusResult) {
+	r.Samples++
+	if result.Compatible {
+		r.Compatible++
+	} else {
+		r.Incompatible++
+		r.Stages[result.Stage]++
+	}
+	if result.MessageType != "" {
+		r.MessageTypes[result.MessageType]++
+	}
+	r.Results = append(r.Results, result)
+}
+
+// CheckSample runs a sample through the stages of CheckCorpus
+func CheckSample(buf []byte) CorpusResult {
+	result := CorpusResult{Format: utils.GetDocumentFormat(buf)}
+	fail := func(stage string, err error) CorpusResult {
+		result.Stage = stage
+		result.Error = err.Error()
+		return result
+	}
+
+	doc, err := document.ParseIso20022Document(buf)
+	if err != nil {
+		return fail(CorpusStageParse, err)
+	}
+	result.MessageType = utils.GetMessageType(doc.NameSpace())
+	if err = doc.Validate(); err != nil {
+		return fail(CorpusStageValidate, err)
+	}
+
+	target := utils.DocumentTypeJson
+	if result.Format == utils.DocumentTypeJson {
+		target = utils.DocumentTypeXml
+	}
+	var converted bytes.Buffer
+	if err = Encode(&converted, doc, target); err != nil {
+		return fail(CorpusStageConvert, err)
+	}
+
+	reparsed, err := document.ParseIso20022Document(converted.Bytes())
+	if err != nil {
+		return fail(CorpusStageReparse, err)
+	}
+	if err = reparsed.Validate(); err != nil {
+		return fail(CorpusStageRevalidate, err)
+	}
+	if err = compareElements(doc, reparsed); err != nil {
+		return fail(CorpusStageRoundTrip, err)
+	}
+
+	result.Compatible = true
+	return result
+}
+
+// compareElements returns an error for the first element whose value differs between a and b
+func compareElements(a, b document.Iso20022Document) error {
+	left := utils.GetElements(a.InspectMessage())
+	right := utils.GetElements(b.InspectMessage())
+	for i := 0; i < len(left) || i < len(right); i++ {
+		switch {
+		case i >= len(right):
+			return fmt.Errorf("the element %s is lost", left[i].Path)
+		case i >= len(left):
+			return fmt.Errorf("the element %s is added", right[i].Path)
+		case left[i] != right[i]:
+			return fmt.Errorf("the element %s changes from %q to %q", left[i].Path, left[i].Value, right[i].Value)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,54 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package service
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/moov-io/iso20022/pkg/iso20022test/fixtures"
+	"github.com/moov-io/iso20022/pkg/utils"
+)
+
+func TestCheckCorpus(t *testing.T) {
+	dir := t.TempDir()
+	require.Nil(t, os.MkdirAll(filepath.Join(dir, "pacs"), 0750))
+	invalid := strings.Replace(string(fixtures.ReadFile(t, "valid_pacs_v08.xml")), "<SttlmMtd>CLRG</SttlmMtd>", "<SttlmMtd>NONE</SttlmMtd>", 1)
+	for name, content := range map[string][]byte{
+		"pacs/valid.xml":   fixtures.ReadFile(t, "valid_pacs_v08.xml"),
+		"pacs/invalid.xml": []byte(invalid),
+		"acmt.json":        fixtures.ReadFile(t, "valid_acmt_v03.json"),
+		"broken.xml":       []byte("<Document>"),
+		"notes.txt":        []byte("skipped"),
+	} {
+		require.Nil(t, os.WriteFile(filepath.Join(dir, name), content, 0600))
+	}
+
+	report, err := CheckCorpus(dir)
+	require.Nil(t, err)
+	require.Equal(t, 4, report.Samples)
+	require.Equal(t, 2, report.Compatible)
+	require.Equal(t, 2, report.Incompatible)
+	require.Equal(t, map[string]int{CorpusStageParse: 1, CorpusStageValidate: 1}, report.Stages)
+	require.Equal(t, 2, report.MessageTypes["pacs.008.001.08"])
+
+	results := make(map[string]CorpusResult)
+	for _, result := range report.Results {
+		results[result.File] = result
+	}
+	require.True(t, results["pacs/valid.xml"].Compatible)
+	require.Equal(t, utils.DocumentTypeXml, results["pacs/valid.xml"].Format)
+	require.True(t, results["acmt.json"].Compatible)
+	require.Equal(t, CorpusStageValidate, results["pacs/invalid.xml"].Stage)
+	require.NotEmpty(t, results["pacs/invalid.xml"].Error)
+	require.Equal(t, CorpusStageParse, results["broken.xml"].Stage)
+
+	_, err = CheckCorpus(filepath.Join(dir, "missing"))
+	require.NotNil(t, err)
+}
@@ -0,0 +1,74 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package service
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"io"
+	"sort"
+
+	"github.com/moov-io/iso20022/pkg/document"
+	"github.com/moov-io/iso20022/pkg/utils"
+)
+
+// EncodeDeterministic writes doc to w in format like Encode, in a form that only depends on
+// the message of doc so identical messages are encoded to identical bytes, e.g. for hash-based
+// change detection. The Document element is named by the namespace of the message whichever
+// way doc was parsed or built, its namespace prefix declarations are dropped and its other
+// attributes sorted by name. Documents are indented with tabs, lines end with a line feed
+// and the document with a single one. Envelopes keep their header without the attributes of
+// their BizMsgEnvlp element.
+func EncodeDeterministic(w io.Writer, doc document.Iso20022Document, format utils.DocumentType) error {
+	var canonical interface{} = canonicalDocument(doc)
+	if envelope, ok := doc.(*document.Envelope); ok {
+		canonical = &document.Envelope{Header: envelope.Header, Document: canonicalDocument(envelope.Document)}
+	}
+
+	var output []byte
+	var err error
+	switch format {
+	case utils.DocumentTypeJson:
+		output, err = json.MarshalIndent(canonical, "", "\t")
+	case utils.DocumentTypeXml, "":
+		output, err = xml.MarshalIndent(canonical, "", "\t")
+	default:
+		err = errors.New("unknown document type")
+	}
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(append(output, '\n'))
+	return err
+}
+
+// canonicalDocument returns the document of the message of doc with the attributes of the
+// deterministic form
+func canonicalDocument(doc document.Iso20022Document) *document.Iso20022DocumentObject {
+	namespace := doc.NameSpace()
+	var attrs []xml.Attr
+	for _, attr := range doc.GetAttrs() {
+		// prefixes are declared by the encoder for the namespaces it writes
+		if attr.Name.Space == "xmlns" || attr.Name.Local == utils.XmlDefaultNamespace {
+			continue
+		}
+		attrs = append(attrs, attr)
+	}
+	sort.SliceStable(attrs, func(i, j int) bool {
+		if attrs[i].Name.Space != attrs[j].Name.Space {
+			return attrs[i].Name.Space < attrs[j].Name.Space
+		}
+		return attrs[i].Name.Local < attrs[j].Name.Local
+	})
+	if namespace != "" {
+		attrs = append([]xml.Attr{{Name: xml.Name{Local: utils.XmlDefaultNamespace}, Value: namespace}}, attrs...)
+	}
+	return &document.Iso20022DocumentObject{
+		XMLName: xml.Name{Space: namespace, Local: "Document"},
+		Attrs:   attrs,
+		Message: doc.InspectMessage(),
+	}
+}
@@ -0,0 +1,75 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package service
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/moov-io/iso20022/pkg/document"
+	"github.com/moov-io/iso20022/pkg/iso20022test/fixtures"
+	"github.com/moov-io/iso20022/pkg/utils"
+)
+
+func TestEncodeDeterministic(t *testing.T) {
+	input := string(fixtures.ReadFile(t, "valid_pacs_v08.xml"))
+	namespace := `xmlns="urn:iso:std:iso:20022:tech:xsd:pacs.008.001.08"`
+
+	// the same message with other declarations, whitespace and line endings
+	declared := strings.Replace(input, namespace, `xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" `+namespace, 1)
+	compact := strings.NewReplacer("\n", "", "\t", "").Replace(input)
+	windows := strings.ReplaceAll(input, "\n", "\r\n")
+
+	for _, format := range []utils.DocumentType{utils.DocumentTypeXml, utils.DocumentTypeJson} {
+		var outputs []string
+		for _, in := range []string{input, declared, compact, windows} {
+			doc, err := document.ParseIso20022Document([]byte(in))
+			require.Nil(t, err)
+			var buf bytes.Buffer
+			require.Nil(t, EncodeDeterministic(&buf, doc, format))
+			outputs = append(outputs, buf.String())
+		}
+		for _, output := range outputs[1:] {
+			require.Equal(t, outputs[0], output)
+		}
+		require.True(t, strings.HasSuffix(outputs[0], "}\n") || strings.HasSuffix(outputs[0], ">\n"))
+		require.False(t, strings.HasSuffix(outputs[0], "\n\n"))
+		require.NotContains(t, outputs[0], "\r")
+
+		// the output is its own deterministic form
+		doc, err := document.ParseIso20022Document([]byte(outputs[0]))
+		require.Nil(t, err)
+		var buf bytes.Buffer
+		require.Nil(t, EncodeDeterministic(&buf, doc, format))
+		require.Equal(t, outputs[0], buf.String())
+	}
+
+	doc, err := document.ParseIso20022Document([]byte(declared))
+	require.Nil(t, err)
+	var buf bytes.Buffer
+	require.Nil(t, EncodeDeterministic(&buf, doc, utils.DocumentTypeXml))
+	require.True(t, strings.HasPrefix(buf.String(), "<Document "+namespace+">\n"))
+
+	require.EqualError(t, EncodeDeterministic(&buf, doc, utils.DocumentTypeUnknown), "unknown document type")
+}
+
+func TestConvertDeterministic(t *testing.T) {
+	input := fixtures.ReadFile(t, "valid_pacs_v08.xml")
+
+	var converted bytes.Buffer
+	require.Nil(t, Convert(bytes.NewReader(input), &converted, WithFormat(utils.DocumentTypeJson), WithDeterministic()))
+	doc, err := document.ParseIso20022Document(input)
+	require.Nil(t, err)
+	var encoded bytes.Buffer
+	require.Nil(t, EncodeDeterministic(&encoded, doc, utils.DocumentTypeJson))
+	require.Equal(t, encoded.String(), converted.String())
+
+	var printed bytes.Buffer
+	require.Nil(t, Print(bytes.NewReader(input), &printed, WithDeterministic()))
+	require.True(t, strings.HasSuffix(printed.String(), "</Document>\n"))
+}
@@ -0,0 +1,75 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package service
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/moov-io/iso20022/pkg/document"
+	"github.com/moov-io/iso20022/pkg/messagetype"
+	"github.com/moov-io/iso20022/pkg/utils"
+)
+
+// ErrNoCommonVersion is returned when no version a counterparty supports holds a document
+var ErrNoCommonVersion = errors.New("no common version")
+
+// Negotiate converts doc to the version of its message that suits a counterparty supporting
+// versions, the message types (pacs.008.001.09) or namespaces of any of its messages. doc is
+// kept when the counterparty supports its version, otherwise it's converted to the closest
+// supported version holding all of its elements, a newer one before an older one at the same
+// distance. Versions the library doesn't support are ignored.
+func Negotiate(doc document.Iso20022Document, versions []string) (document.Iso20022Document, error) {
+	source := messagetype.Type(utils.GetMessageType(doc.NameSpace()))
+
+	var candidates []messagetype.Type
+	seen := make(map[messagetype.Type]bool)
+	for _, version := range versions {
+		t, err := messagetype.Parse(version)
+		if err != nil || t.Message() != source.Message() || seen[t] {
+			continue
+		}
+		if t == source {
+			return doc, nil
+		}
+		seen[t] = true
+		candidates = append(candidates, t)
+	}
+	if len(candidates) == 0 {
+		return nil, fmt.Errorf("%w: the counterparty supports no version of %s", ErrNoCommonVersion, source.Message())
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return closer(source, candidates[i], candidates[j])
+	})
+
+	reasons := make([]string, 0, len(candidates))
+	for _, t := range candidates {
+		converted, err := retarget(doc, string(t))
+		if err == nil {
+			return converted, nil
+		}
+		reasons = append(reasons, err.Error())
+	}
+	return nil, fmt.Errorf("%w: %s", ErrNoCommonVersion, strings.Join(reasons, "; "))
+}
+
+// closer reports whether version a is closer to source than b, newer versions first
+func closer(source, a, b messagetype.Type) bool {
+	da, db := distance(source, a), distance(source, b)
+	if da != db {
+		return da < db
+	}
+	return a.Version() > b.Version()
+}
+
+func distance(source, t messagetype.Type) int {
+	d := t.Version() - source.Version()
+	if d < 0 {
+		return -d
+	}
+	return d
+}
@@ -0,0 +1,64 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package service
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/moov-io/iso20022/pkg/document"
+	"github.com/moov-io/iso20022/pkg/iso20022test/fixtures"
+	"github.com/moov-io/iso20022/pkg/messagetype"
+	"github.com/moov-io/iso20022/pkg/utils"
+)
+
+func TestNegotiate(t *testing.T) {
+	doc, err := document.ParseIso20022Document(fixtures.ReadFile(t, "valid_pacs_v08.xml"))
+	require.Nil(t, err)
+
+	// the version of the document is kept
+	negotiated, err := Negotiate(doc, []string{"pacs.008.001.09", utils.DocumentPacs00800108NameSpace})
+	require.Nil(t, err)
+	require.Equal(t, doc, negotiated)
+
+	// the service level of version 06 isn't repeated
+	negotiated, err = Negotiate(doc, []string{"pain.001.001.09", "pacs.008.001.06", "pacs.008.001.09", "pacs.008.001.99"})
+	require.Nil(t, err)
+	require.Equal(t, utils.DocumentPacs00800109NameSpace, negotiated.NameSpace())
+
+	_, err = Negotiate(doc, []string{"pacs.008.001.06"})
+	require.ErrorIs(t, err, ErrNoCommonVersion)
+	require.Contains(t, err.Error(), "pacs.008.001.08 can't be converted to pacs.008.001.06")
+
+	_, err = Negotiate(doc, []string{"pain.001.001.09"})
+	require.ErrorIs(t, err, ErrNoCommonVersion)
+	require.EqualError(t, err, "no common version: the counterparty supports no version of pacs.008")
+}
+
+func TestCloser(t *testing.T) {
+	source := messagetype.MsgPacs008V08
+	require.True(t, closer(source, messagetype.MsgPacs008V09, messagetype.MsgPacs008V06))
+	require.True(t, closer(source, "pacs.008.001.07", messagetype.MsgPacs008V06))
+	require.True(t, closer(source, "pacs.008.001.10", "pacs.008.001.06"))
+	require.False(t, closer(source, "pacs.008.001.06", "pacs.008.001.10"))
+}
+
+func TestCounterpartyVersions(t *testing.T) {
+	input := fixtures.ReadFile(t, "valid_pacs_v08.xml")
+
+	var output bytes.Buffer
+	require.Nil(t, Convert(bytes.NewReader(input), &output, WithCounterpartyVersions("pacs.008.001.09")))
+	require.Contains(t, output.String(), `xmlns="urn:iso:std:iso:20022:tech:xsd:pacs.008.001.09"`)
+
+	// the target version wins
+	doc, err := Parse(bytes.NewReader(input), WithCounterpartyVersions("pacs.008.001.09"), WithTargetVersion("pacs.008.001.08"))
+	require.Nil(t, err)
+	require.Equal(t, utils.DocumentPacs00800108NameSpace, doc.NameSpace())
+
+	err = Validate(bytes.NewReader(input), WithCounterpartyVersions())
+	require.ErrorIs(t, err, ErrNoCommonVersion)
+}
@@ -0,0 +1,155 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package service
+
+import (
+	"io"
+	"time"
+
+	"github.com/moov-io/iso20022/pkg/document"
+	"github.com/moov-io/iso20022/pkg/reasons"
+	"github.com/moov-io/iso20022/pkg/utils"
+)
+
+// Option configures the parse, validate, print and convert operations
+type Option func(*options)
+
+type options struct {
+	format            utils.DocumentType
+	strict            bool
+	profile           string
+	asOf              time.Time
+	targetVersion     string
+	lenientNamespaces bool
+	provenance        *Provenance
+	deterministic     bool
+	workers           int
+
+	// reason codes are translated from reasonsFrom to reasonsTo when reasonsTo is set
+	reasonsFrom reasons.Scheme
+	reasonsTo   reasons.Scheme
+
+	// counterpartyVersions are negotiated unless targetVersion is set
+	counterpartyVersions []string
+}
+
+func newOptions(opts []Option) options {
+	var o options
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
+
+// WithFormat sets the output format of print and convert, xml by default
+func WithFormat(format utils.DocumentType) Option {
+	return func(o *options) {
+		o.format = format
+	}
+}
+
+// WithStrictValidation rejects the elements the message model doesn't hold, see document.ValidateStrict
+func WithStrictValidation() Option {
+	return func(o *options) {
+		o.strict = true
+	}
+}
+
+// WithProfile validates documents against the registered profile with name too
+func WithProfile(name string) Option {
+	return func(o *options) {
+		o.profile = name
+	}
+}
+
+// WithAsOf validates documents with the version of the profile of WithProfile that applied at
+// asOf, e.g. the time a disputed document was sent, see profile.ValidateAsOf
+func WithAsOf(asOf time.Time) Option {
+	return func(o *options) {
+		o.asOf = asOf
+	}
+}
+
+// WithTargetVersion converts documents to another version of their message, e.g. pacs.008.001.09,
+// failing when an element of the document isn't held by the target version
+func WithTargetVersion(messageType string) Option {
+	return func(o *options) {
+		o.targetVersion = messageType
+	}
+}
+
+// WithCounterpartyVersions converts documents to the version negotiated with a counterparty
+// supporting versions, see Negotiate, unless WithTargetVersion sets the version
+func WithCounterpartyVersions(versions ...string) Option {
+	return func(o *options) {
+		o.counterpartyVersions = append([]string{}, versions...)
+	}
+}
+
+// WithLenientNamespaces accepts namespaces that only differ from the supported ones by case,
+// surrounding whitespace or an omitted urn:iso:std:iso:20022:tech:xsd: prefix
+func WithLenientNamespaces() Option {
+	return func(o *options) {
+		o.lenientNamespaces = true
+	}
+}
+
+// WithProvenance sets p to the provenance of the elements of the parsed or converted document,
+// e.g. to trace the elements of a version migration to the elements of the source version
+func WithProvenance(p *Provenance) Option {
+	return func(o *options) {
+		o.provenance = p
+	}
+}
+
+// WithParallelValidation validates the transactions of documents concurrently with workers
+// goroutines, see document.ValidateParallel
+func WithParallelValidation(workers int) Option {
+	return func(o *options) {
+		o.workers = workers
+	}
+}
+
+// WithDeterministic writes print and convert outputs in the deterministic form of
+// EncodeDeterministic
+func WithDeterministic() Option {
+	return func(o *options) {
+		o.deterministic = true
+	}
+}
+
+// WithReasonCodes translates the reason codes of status reports, returns and cancellations
+// from scheme from to scheme to by the default reason table, see reasons.Table.TranslateDocument
+func WithReasonCodes(from, to reasons.Scheme) Option {
+	return func(o *options) {
+		o.reasonsFrom = from
+		o.reasonsTo = to
+	}
+}
+
+func (o options) encode(w io.Writer, doc document.Iso20022Document) error {
+	if o.deterministic {
+		return EncodeDeterministic(w, doc, o.format)
+	}
+	return Encode(w, doc, o.format)
+}
+
+func (o options) retarget(doc document.Iso20022Document) (document.Iso20022Document, error) {
+	if o.targetVersion == "" && o.counterpartyVersions != nil {
+		return Negotiate(doc, o.counterpartyVersions)
+	}
+	return retarget(doc, o.targetVersion)
+}
+
+func (o options) translateReasons(doc document.Iso20022Document) error {
+	if o.reasonsTo == "" || o.reasonsFrom == o.reasonsTo {
+		return nil
+	}
+	table, err := reasons.GetTable(reasons.DefaultTableName)
+	if err != nil {
+		return err
+	}
+	return table.TranslateDocument(doc, o.reasonsFrom, o.reasonsTo)
+}
@@ -0,0 +1,82 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package service
+
+import (
+	"github.com/moov-io/iso20022/pkg/document"
+	"github.com/moov-io/iso20022/pkg/utils"
+)
+
+const (
+	// TransformationCopy keeps the value of the source element in the same message version
+	TransformationCopy = "copy"
+
+	// TransformationMigrate moves the value of the source element to the element of the target version
+	TransformationMigrate = "migrate"
+
+	// TransformationConvert changes the value of the source element, e.g. normalizing a number
+	TransformationConvert = "convert"
+)
+
+// Provenance traces every element of a converted document to the element of the source it came from
+type Provenance struct {
+	SourceType   string             `json:"sourceType"`
+	TargetType   string             `json:"targetType"`
+	SourceFormat utils.DocumentType `json:"sourceFormat"`
+	TargetFormat utils.DocumentType `json:"targetFormat,omitempty"`
+
+	// Fields are the elements of the target in document order
+	Fields []FieldProvenance `json:"fields"`
+}
+
+// FieldProvenance is the origin of an element of a converted document
+type FieldProvenance struct {
+	Target         string `json:"target"`
+	Source         string `json:"source"`
+	Transformation string `json:"transformation"`
+}
+
+// Origin returns the provenance of the target element at path
+func (p *Provenance) Origin(path string) (FieldProvenance, bool) {
+	for _, field := range p.Fields {
+		if field.Target == path {
+			return field, true
+		}
+	}
+	return FieldProvenance{}, false
+}
+
+// traceProvenance maps the elements of target to the elements of source it was converted from,
+// conversions keep the paths of the elements
+func traceProvenance(source, target document.Iso20022Document, format utils.DocumentType) Provenance {
+	p := Provenance{
+		SourceType:   utils.GetMessageType(source.NameSpace()),
+		TargetType:   utils.GetMessageType(target.NameSpace()),
+		SourceFormat: format,
+	}
+
+	values := make(map[string]string)
+	for _, elm := range utils.GetElements(source.InspectMessage()) {
+		values[elm.Path] = elm.Value
+	}
+	for _, elm := range utils.GetElements(target.InspectMessage()) {
+		value, ok := values[elm.Path]
+		if !ok {
+			continue
+		}
+		transformation := TransformationCopy
+		if value != elm.Value {
+			transformation = TransformationConvert
+		} else if p.SourceType != p.TargetType {
+			transformation = TransformationMigrate
+		}
+		p.Fields = append(p.Fields, FieldProvenance{
+			Target:         elm.Path,
+			Source:         elm.Path,
+			Transformation: transformation,
+		})
+	}
+	return p
+}
@@ -0,0 +1,57 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package service
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/moov-io/iso20022/pkg/iso20022test/fixtures"
+	"github.com/moov-io/iso20022/pkg/utils"
+)
+
+func TestProvenanceMigration(t *testing.T) {
+	input := fixtures.ReadFile(t, "valid_pacs_v08.xml")
+
+	var p Provenance
+	var output bytes.Buffer
+	err := Convert(bytes.NewReader(input), &output, WithTargetVersion("pacs.008.001.09"),
+		WithFormat(utils.DocumentTypeJson), WithProvenance(&p))
+	require.Nil(t, err)
+
+	require.Equal(t, "pacs.008.001.08", p.SourceType)
+	require.Equal(t, "pacs.008.001.09", p.TargetType)
+	require.Equal(t, utils.DocumentTypeXml, p.SourceFormat)
+	require.Equal(t, utils.DocumentTypeJson, p.TargetFormat)
+	require.NotEmpty(t, p.Fields)
+
+	field, ok := p.Origin("GrpHdr/MsgId")
+	require.True(t, ok)
+	require.Equal(t, FieldProvenance{Target: "GrpHdr/MsgId", Source: "GrpHdr/MsgId", Transformation: TransformationMigrate}, field)
+
+	// every element of the result is traced
+	doc, err := Parse(bytes.NewReader(output.Bytes()))
+	require.Nil(t, err)
+	for _, elm := range utils.GetElements(doc.InspectMessage()) {
+		_, ok := p.Origin(elm.Path)
+		require.True(t, ok, elm.Path)
+	}
+
+	_, ok = p.Origin("GrpHdr/Unknown")
+	require.False(t, ok)
+}
+
+func TestProvenanceCopy(t *testing.T) {
+	var p Provenance
+	_, err := Parse(bytes.NewReader(fixtures.ReadFile(t, "valid_pacs_v08.xml")), WithProvenance(&p))
+	require.Nil(t, err)
+	require.Equal(t, p.SourceType, p.TargetType)
+	require.Empty(t, p.TargetFormat)
+	for _, field := range p.Fields {
+		require.Equal(t, TransformationCopy, field.Transformation, field.Target)
+	}
+}
@@ -0,0 +1,287 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package service
+
+/*
+	Service holds the operations of the http api and the command line as functions of
+	readers and writers, so programs embed them without an http round-trip:
+
+		err := service.Convert(input, output, service.WithFormat(utils.DocumentTypeJson))
+*/
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/moov-io/iso20022/pkg/document"
+	"github.com/moov-io/iso20022/pkg/messagetype"
+	"github.com/moov-io/iso20022/pkg/profile"
+	"github.com/moov-io/iso20022/pkg/utils"
+)
+
+// ParseError is returned when the input can't be read or isn't a supported document,
+// other errors of the operations are about a valid document
+type ParseError struct {
+	Err error
+}
+
+func (e *ParseError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+// Parse reads a document from r. Business message envelopes are returned as a document.Envelope
+// whose document is parsed with opts.
+func Parse(r io.Reader, opts ...Option) (document.Iso20022Document, error) {
+	o := newOptions(opts)
+	buf, err := io.ReadAll(r)
+	if err != nil {
+		return nil, &ParseError{Err: err}
+	}
+
+	var parseErr error
+	envelope, err := document.ParseEnvelopeWith(buf, func(buf []byte) (document.Iso20022Document, error) {
+		doc, err := o.parse(buf)
+		parseErr = err
+		return doc, err
+	})
+	switch {
+	case errors.Is(err, document.ErrNotEnvelope):
+		return o.parse(buf)
+	case err != nil && parseErr == nil:
+		return nil, &ParseError{Err: err}
+	case err != nil:
+		return nil, err
+	}
+	return envelope, nil
+}
+
+// parse parses and converts the document of buf
+func (o options) parse(buf []byte) (document.Iso20022Document, error) {
+	buf, doc, err := read(bytes.NewReader(buf), o)
+	if err != nil {
+		return nil, err
+	}
+	converted, err := o.retarget(doc)
+	if err != nil {
+		return nil, err
+	}
+	if err = o.translateReasons(converted); err != nil {
+		return nil, err
+	}
+	if o.provenance != nil {
+		*o.provenance = traceProvenance(doc, converted, utils.GetDocumentFormat(buf))
+	}
+	return converted, nil
+}
+
+// Validate reads and validates a document from r, the header of business message envelopes too
+func Validate(r io.Reader, opts ...Option) error {
+	o := newOptions(opts)
+	buf, err := io.ReadAll(r)
+	if err != nil {
+		return &ParseError{Err: err}
+	}
+	if _, raw, err := document.SplitEnvelope(buf); err == nil {
+		envelope, err := Parse(bytes.NewReader(buf), opts...)
+		if err != nil {
+			return err
+		}
+		if err = envelope.(*document.Envelope).ValidateHeader(); err != nil {
+			return err
+		}
+		buf = raw
+	}
+
+	buf, doc, err := read(bytes.NewReader(buf), o)
+	if err != nil {
+		return err
+	}
+	converted, err := o.retarget(doc)
+	if err != nil {
+		return err
+	}
+
+	if err = document.ValidateParallel(converted, o.workers); err != nil {
+		return err
+	}
+	if o.strict {
+		if err = document.CheckUnknownElements(doc, buf); err != nil {
+			return err
+		}
+	}
+	if o.profile != "" && !o.asOf.IsZero() {
+		result, err := profile.ValidateAsOf(o.profile, converted, o.asOf)
+		if err != nil {
+			return err
+		}
+		return result.Err()
+	}
+	if o.profile != "" {
+		p, err := profile.Get(o.profile)
+		if err != nil {
+			return err
+		}
+		return p.Validate(converted).Err()
+	}
+	return nil
+}
+
+// Convert reads a document from r and writes it to w
+func Convert(r io.Reader, w io.Writer, opts ...Option) error {
+	doc, err := Parse(r, opts...)
+	if err != nil {
+		return err
+	}
+	o := newOptions(opts)
+	if o.provenance != nil {
+		o.provenance.TargetFormat = o.format
+		if o.format == "" {
+			o.provenance.TargetFormat = utils.DocumentTypeXml
+		}
+	}
+	return o.encode(w, doc)
+}
+
+// Print reads a document from r and writes it to w followed by a newline
+func Print(r io.Reader, w io.Writer, opts ...Option) error {
+	doc, err := Parse(r, opts...)
+	if err != nil {
+		return err
+	}
+	o := newOptions(opts)
+	if err = o.encode(w, doc); err != nil || o.deterministic {
+		// the deterministic form ends with its newline
+		return err
+	}
+	_, err = io.WriteString(w, "\n")
+	return err
+}
+
+// Encode writes doc indented with tabs to w in format, xml when format is empty
+func Encode(w io.Writer, doc document.Iso20022Document, format utils.DocumentType) error {
+	var output []byte
+	var err error
+	switch format {
+	case utils.DocumentTypeJson:
+		output, err = json.MarshalIndent(doc, "", "\t")
+	case utils.DocumentTypeXml, "":
+		output, err = xml.MarshalIndent(doc, "", "\t")
+	default:
+		err = errors.New("unknown document type")
+	}
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(output)
+	return err
+}
+
+// read reads and parses a document from r
+func read(r io.Reader, o options) ([]byte, document.Iso20022Document, error) {
+	buf, err := io.ReadAll(r)
+	if err != nil {
+		return nil, nil, &ParseError{Err: err}
+	}
+
+	var doc document.Iso20022Document
+	if o.lenientNamespaces {
+		doc, err = parseLenient(buf)
+	} else {
+		doc, err = document.ParseIso20022Document(buf)
+	}
+	if err != nil {
+		return nil, nil, &ParseError{Err: err}
+	}
+	return buf, doc, nil
+}
+
+// parseLenient parses buf whose namespace may differ from the supported one by case,
+// whitespace or an omitted prefix, the document gets the supported namespace
+func parseLenient(buf []byte) (document.Iso20022Document, error) {
+	namespace, err := document.DetectNameSpace(buf)
+	if err != nil {
+		return nil, err
+	}
+	supported := strings.ToLower(strings.TrimSpace(namespace))
+	if !strings.HasPrefix(supported, utils.NameSpacePrefix) {
+		supported = utils.NameSpacePrefix + supported
+	}
+	if supported == namespace {
+		return document.ParseIso20022Document(buf)
+	}
+
+	doc, err := document.NewDocument(supported)
+	if err != nil {
+		return nil, err
+	}
+	if utils.GetDocumentFormat(buf) == utils.DocumentTypeJson {
+		err = json.Unmarshal(buf, doc)
+	} else {
+		err = xml.Unmarshal(buf, doc)
+	}
+	if err != nil {
+		return nil, err
+	}
+	setNameSpace(doc, supported)
+	return doc, nil
+}
+
+// retarget converts doc to the version of messageType of its message, an empty messageType keeps doc
+func retarget(doc document.Iso20022Document, messageType string) (document.Iso20022Document, error) {
+	source := utils.GetMessageType(doc.NameSpace())
+	if messageType == "" || messageType == source {
+		return doc, nil
+	}
+	if messagetype.Type(source).Message() != messagetype.Type(messageType).Message() {
+		return nil, fmt.Errorf("%s can't be converted to %s", source, messageType)
+	}
+
+	converted, err := document.NewDocument(utils.NameSpacePrefix + messageType)
+	if err != nil {
+		return nil, err
+	}
+	buf, err := json.Marshal(doc.InspectMessage())
+	if err != nil {
+		return nil, err
+	}
+	if err = json.Unmarshal(buf, converted.InspectMessage()); err != nil {
+		return nil, fmt.Errorf("%s can't be converted to %s: %w", source, messageType, err)
+	}
+
+	held := make(map[string]bool)
+	for _, elm := range utils.GetElements(converted.InspectMessage()) {
+		held[elm.Path] = true
+	}
+	for _, elm := range utils.GetElements(doc.InspectMessage()) {
+		if !held[elm.Path] {
+			return nil, fmt.Errorf("The element %s isn't held by %s", elm.Path, messageType)
+		}
+	}
+
+	*converted.GetXmlName() = *doc.GetXmlName()
+	if obj, ok := converted.(*document.Iso20022DocumentObject); ok {
+		obj.Attrs = append([]xml.Attr(nil), doc.GetAttrs()...)
+	}
+	setNameSpace(converted, utils.NameSpacePrefix+messageType)
+	return converted, nil
+}
+
+func setNameSpace(doc document.Iso20022Document, namespace string) {
+	attrs := doc.GetAttrs()
+	for i := range attrs {
+		if attrs[i].Name.Local == utils.XmlDefaultNamespace {
+			attrs[i].Value = namespace
+		}
+	}
+}
@@ -0,0 +1,212 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package service
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/moov-io/iso20022/pkg/document"
+	"github.com/moov-io/iso20022/pkg/iso20022test/fixtures"
+	"github.com/moov-io/iso20022/pkg/profile"
+	"github.com/moov-io/iso20022/pkg/reasons"
+	"github.com/moov-io/iso20022/pkg/utils"
+)
+
+func TestValidate(t *testing.T) {
+	input := fixtures.ReadFile(t, "valid_pacs_v08.xml")
+	require.Nil(t, Validate(bytes.NewReader(input)))
+	require.Nil(t, Validate(bytes.NewReader(input), WithStrictValidation()))
+
+	drifted := strings.Replace(string(input), "</GrpHdr>", "<NewElm>value</NewElm></GrpHdr>", 1)
+	require.Nil(t, Validate(strings.NewReader(drifted)))
+	require.NotNil(t, Validate(strings.NewReader(drifted), WithStrictValidation()))
+
+	invalid := strings.Replace(string(input), "<SttlmMtd>CLRG</SttlmMtd>", "<SttlmMtd>NONE</SttlmMtd>", 1)
+	err := Validate(strings.NewReader(invalid))
+	require.NotNil(t, err)
+	var parseErr *ParseError
+	require.False(t, errors.As(err, &parseErr))
+
+	err = Validate(strings.NewReader("invalid"))
+	require.True(t, errors.As(err, &parseErr))
+	require.Equal(t, utils.NewErrInvalidFileType().Error(), err.Error())
+}
+
+func TestConvert(t *testing.T) {
+	var output bytes.Buffer
+	err := Convert(bytes.NewReader(fixtures.ReadFile(t, "valid_pacs_v08.xml")), &output, WithFormat(utils.DocumentTypeJson))
+	require.Nil(t, err)
+	require.True(t, json.Valid(output.Bytes()))
+
+	doc, err := document.ParseIso20022Document(output.Bytes())
+	require.Nil(t, err)
+	require.Nil(t, doc.Validate())
+
+	var xmlOutput bytes.Buffer
+	require.Nil(t, Convert(&output, &xmlOutput))
+	require.True(t, strings.HasPrefix(xmlOutput.String(), "<Document"))
+
+	err = Convert(bytes.NewReader(fixtures.ReadFile(t, "valid_pacs_v08.xml")), &output, WithFormat("csv"))
+	require.NotNil(t, err)
+}
+
+func TestPrint(t *testing.T) {
+	var output bytes.Buffer
+	require.Nil(t, Print(bytes.NewReader(fixtures.ReadFile(t, "valid_pacs_v08.xml")), &output))
+	require.True(t, strings.HasSuffix(output.String(), "</Document>\n"))
+
+	err := Print(strings.NewReader("invalid"), &output)
+	var parseErr *ParseError
+	require.True(t, errors.As(err, &parseErr))
+}
+
+func TestValidateWithProfile(t *testing.T) {
+	input := fixtures.ReadFile(t, "valid_pacs_v08.xml")
+	err := Validate(bytes.NewReader(input), WithProfile("unknown"))
+	require.True(t, errors.Is(err, profile.ErrUnknownProfile))
+
+	p := &profile.Profile{Name: "usd-only", Rules: []profile.Rule{{
+		ID:       "currency",
+		Severity: profile.SeverityError,
+		Check: func(in profile.Input) []profile.Finding {
+			return []profile.Finding{{Path: "GrpHdr", Message: "always fails"}}
+		},
+	}}}
+	profile.Register(p)
+	t.Cleanup(func() { profile.Unregister(p.Name) })
+	err = Validate(bytes.NewReader(input), WithProfile(p.Name))
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "always fails")
+
+	profile.Register(&profile.Profile{Name: "empty"})
+	t.Cleanup(func() { profile.Unregister("empty") })
+	require.Nil(t, Validate(bytes.NewReader(input), WithProfile("empty")))
+}
+
+func TestValidateAsOf(t *testing.T) {
+	input := fixtures.ReadFile(t, "valid_pacs_v08.xml")
+	effective := time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC)
+	for _, p := range []*profile.Profile{
+		{Name: "as-of", Version: "1", Rules: []profile.Rule{{ID: "old", Check: func(in profile.Input) []profile.Finding {
+			return []profile.Finding{{Message: "rejected by the old rules"}}
+		}}}},
+		{Name: "as-of", Version: "2", Effective: effective},
+	} {
+		profile.Register(p)
+	}
+	t.Cleanup(func() { profile.Unregister("as-of") })
+
+	require.Nil(t, Validate(bytes.NewReader(input), WithProfile("as-of")))
+	require.Nil(t, Validate(bytes.NewReader(input), WithProfile("as-of"), WithAsOf(effective)))
+	err := Validate(bytes.NewReader(input), WithProfile("as-of"), WithAsOf(effective.Add(-time.Hour)))
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "rejected by the old rules")
+}
+
+func TestTargetVersion(t *testing.T) {
+	input := fixtures.ReadFile(t, "valid_pacs_v08.xml")
+
+	var output bytes.Buffer
+	err := Convert(bytes.NewReader(input), &output, WithTargetVersion("pacs.008.001.09"))
+	require.Nil(t, err)
+	require.Contains(t, output.String(), `xmlns="urn:iso:std:iso:20022:tech:xsd:pacs.008.001.09"`)
+
+	doc, err := document.ParseIso20022Document(output.Bytes())
+	require.Nil(t, err)
+	require.Equal(t, utils.DocumentPacs00800109NameSpace, doc.NameSpace())
+	require.Contains(t, output.String(), "<MsgId>MSG-20210415-0001</MsgId>")
+
+	_, err = Parse(bytes.NewReader(input), WithTargetVersion("pain.001.001.09"))
+	require.NotNil(t, err)
+	require.Equal(t, "pacs.008.001.08 can't be converted to pain.001.001.09", err.Error())
+
+	// the service level of version 06 isn't repeated
+	_, err = Parse(bytes.NewReader(input), WithTargetVersion("pacs.008.001.06"))
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "pacs.008.001.08 can't be converted to pacs.008.001.06")
+}
+
+func TestReasonCodes(t *testing.T) {
+	input := []byte(`<Document xmlns="urn:iso:std:iso:20022:tech:xsd:pacs.002.001.10">
+	<FIToFIPmtStsRpt>
+		<GrpHdr>
+			<MsgId>RJCT-0001</MsgId>
+			<CreDtTm>2021-04-15T10:31:00</CreDtTm>
+		</GrpHdr>
+		<TxInfAndSts>
+			<TxSts>RJCT</TxSts>
+			<StsRsnInf>
+				<Rsn>
+					<Cd>AC03</Cd>
+				</Rsn>
+			</StsRsnInf>
+		</TxInfAndSts>
+	</FIToFIPmtStsRpt>
+</Document>`)
+
+	var output bytes.Buffer
+	err := Convert(bytes.NewReader(input), &output, WithReasonCodes(reasons.SchemeISO, reasons.SchemeSEPA))
+	require.Nil(t, err)
+	require.Contains(t, output.String(), "<Cd>AC01</Cd>")
+
+	_, err = Parse(bytes.NewReader(input), WithReasonCodes(reasons.SchemeSEPA, reasons.SchemeISO))
+	require.EqualError(t, err, "TxInfAndSts[0]/StsRsnInf[0]/Rsn/Cd: unknown reason code: sepa code AC03")
+}
+
+func TestLenientNamespaces(t *testing.T) {
+	input := strings.Replace(string(fixtures.ReadFile(t, "valid_pacs_v08.xml")),
+		`xmlns="urn:iso:std:iso:20022:tech:xsd:pacs.008.001.08"`, `xmlns=" PACS.008.001.08 "`, 1)
+
+	_, err := Parse(strings.NewReader(input))
+	require.NotNil(t, err)
+
+	doc, err := Parse(strings.NewReader(input), WithLenientNamespaces())
+	require.Nil(t, err)
+	require.Equal(t, utils.DocumentPacs00800108NameSpace, doc.NameSpace())
+	require.Nil(t, Validate(strings.NewReader(input), WithLenientNamespaces(), WithStrictValidation()))
+}
+
+func TestEnvelopes(t *testing.T) {
+	input := fixtures.ReadFile(t, "valid_pacs_v08_envelope.xml")
+	require.Nil(t, Validate(bytes.NewReader(input), WithStrictValidation()))
+
+	mismatch := strings.Replace(string(input), "<MsgDefIdr>pacs.008.001.08", "<MsgDefIdr>pacs.009.001.08", 1)
+	err := Validate(strings.NewReader(mismatch))
+	require.True(t, errors.Is(err, document.ErrHeaderMismatch))
+
+	// envelopes are converted with their header, which defines the converted message
+	var output bytes.Buffer
+	require.Nil(t, Convert(bytes.NewReader(input), &output, WithFormat(utils.DocumentTypeJson), WithTargetVersion("pacs.008.001.09")))
+	require.Contains(t, output.String(), `"MsgDefIdr": "pacs.008.001.09"`)
+	envelope, err := document.ParseEnvelope(output.Bytes())
+	require.Nil(t, err)
+	require.Nil(t, envelope.ValidateHeader())
+	require.Equal(t, utils.DocumentPacs00800109NameSpace, envelope.NameSpace())
+
+	output.Reset()
+	require.Nil(t, Print(bytes.NewReader(input), &output, WithDeterministic()))
+	require.True(t, strings.HasPrefix(output.String(), "<BizMsgEnvlp>\n\t<AppHdr"))
+
+	_, err = Parse(strings.NewReader(strings.Replace(string(input), "head.001.001.02", "head.001.001.09", 1)))
+	var parseErr *ParseError
+	require.True(t, errors.As(err, &parseErr))
+}
+
+func TestParallelValidation(t *testing.T) {
+	input := fixtures.ReadFile(t, "valid_pacs_v08.xml")
+	require.Nil(t, Validate(bytes.NewReader(input), WithParallelValidation(4)))
+
+	invalid := strings.Replace(string(input), `<IntrBkSttlmAmt Ccy="USD">500.75`, `<IntrBkSttlmAmt Ccy="usd">500.75`, 1)
+	expected := Validate(strings.NewReader(invalid))
+	require.NotNil(t, expected)
+	require.EqualError(t, Validate(strings.NewReader(invalid), WithParallelValidation(4)), expected.Error())
+}
@@ -0,0 +1,39 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package sigv4
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSign(t *testing.T) {
+	// get-vanilla of the Signature Version 4 test suite
+	req, err := http.NewRequest(http.MethodGet, "https://example.amazonaws.com/", nil)
+	require.Nil(t, err)
+	credentials := Credentials{AccessKeyID: "AKIDEXAMPLE", SecretAccessKey: "wJalrXUtnFEMI/K7MDENG+bPxRfiCYEXAMPLEKEY"}
+	Sign(req, nil, "service", "us-east-1", credentials, time.Date(2015, time.August, 30, 12, 36, 0, 0, time.UTC))
+
+	require.Equal(t, "20150830T123600Z", req.Header.Get("X-Amz-Date"))
+	require.Equal(t, "AWS4-HMAC-SHA256 Credential=AKIDEXAMPLE/20150830/us-east-1/service/aws4_request, "+
+		"SignedHeaders=host;x-amz-date, Signature=5fa00fa31553b73ebf1942676e86291e8372ff2a2260956d9b8aae1d763fbf31",
+		req.Header.Get("Authorization"))
+
+	credentials.SessionToken = "token"
+	Sign(req, nil, "service", "us-east-1", credentials, time.Now())
+	require.Equal(t, "token", req.Header.Get("X-Amz-Security-Token"))
+	require.Contains(t, req.Header.Get("Authorization"), "SignedHeaders=host;x-amz-date;x-amz-security-token,")
+}
+
+func TestSignS3(t *testing.T) {
+	req, err := http.NewRequest(http.MethodPut, "https://bucket.s3.us-east-1.amazonaws.com/inbound/a%20b.xml", nil)
+	require.Nil(t, err)
+	Sign(req, []byte("<Document/>"), "s3", "us-east-1", Credentials{AccessKeyID: "AKID", SecretAccessKey: "secret"}, time.Now())
+	require.Equal(t, hexSHA256([]byte("<Document/>")), req.Header.Get("X-Amz-Content-Sha256"))
+	require.Contains(t, req.Header.Get("Authorization"), "SignedHeaders=host;x-amz-content-sha256;x-amz-date,")
+}
@@ -0,0 +1,204 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package simulator
+
+/*
+	Simulator plays the counterparty of credit transfers in sandboxes: a received pacs.008
+	is answered after a delay with a pacs.002 reporting the status of its transactions and,
+	when its transactions are settled, a camt.054 notifying their credit.
+
+		sim, err := simulator.New(simulator.Config{Delay: 2 * time.Second, Notification: true}, respond)
+		err = sim.Receive(ctx, id, pacs008)
+
+	Transactions are settled unless a rule matches them, e.g. the rule {MinAmount: 10000,
+	Reason: AM02} rejects the transactions of 10000 or more, so clients rehearse the
+	rejections of a scheme without connecting to it.
+*/
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/moov-io/iso20022/pkg/builder"
+	"github.com/moov-io/iso20022/pkg/document"
+	"github.com/moov-io/iso20022/pkg/utils"
+)
+
+// Config of the simulated counterparty
+type Config struct {
+	// Delay of the responses after the reception of a pacs.008
+	Delay time.Duration
+
+	// Status of the transactions no rule matches, ACSC when empty
+	Status string
+
+	// Rules give the status of the transactions they match, the first matching rule applies
+	Rules []Rule
+
+	// Notification notifies the credit of the settled (ACSC) transactions with a camt.054
+	Notification bool
+
+	// Agent is the BIC of the counterparty, the instructed agent of the pacs.008 when empty
+	Agent string
+}
+
+// Rule matches transactions on the prefix of their end to end identification, their currency
+// and their minimum amount, omitted criteria match every transaction
+type Rule struct {
+	EndToEndId string
+	Currency   string
+	MinAmount  float64
+
+	// Status of the matched transactions, RJCT when empty, and Reason the status reason code
+	// of rejections
+	Status string
+	Reason string
+}
+
+// Response is a message of the counterparty answering a received document
+type Response struct {
+	// Original is the id of the received document
+	Original    string
+	MessageType string
+	Document    document.Iso20022Document
+}
+
+// Responder delivers the responses of the counterparty
+type Responder func(ctx context.Context, response Response)
+
+// Simulator answers the received pacs.008 like a counterparty
+type Simulator struct {
+	config  Config
+	respond Responder
+	now     func() time.Time
+
+	wg sync.WaitGroup
+}
+
+// New returns a simulator delivering its responses with respond
+func New(config Config, respond Responder) (*Simulator, error) {
+	if config.Delay < 0 {
+		return nil, errors.New("simulator delay is negative")
+	}
+	for i, rule := range config.Rules {
+		if rule.MinAmount < 0 {
+			return nil, fmt.Errorf("simulator rule %d has a negative minimum amount", i+1)
+		}
+		if (rule.Status == "" || rule.Status == builder.RejectedStatus) && rule.Reason == "" {
+			return nil, fmt.Errorf("simulator rule %d rejects without reason", i+1)
+		}
+	}
+	return &Simulator{config: config, respond: respond, now: time.Now}, nil
+}
+
+// Simulates reports whether doc is a message the simulator answers
+func Simulates(doc document.Iso20022Document) bool {
+	return strings.HasPrefix(utils.GetMessageType(doc.NameSpace()), "pacs.008.")
+}
+
+// Respond returns the responses of the counterparty to doc, created at the time of their
+// delivery
+func (s *Simulator) Respond(id string, doc document.Iso20022Document) ([]Response, error) {
+	if !Simulates(doc) {
+		return nil, fmt.Errorf("%w: %s", builder.ErrNotCreditTransfer, utils.GetMessageType(doc.NameSpace()))
+	}
+	created := s.now().UTC().Add(s.config.Delay).Truncate(time.Second)
+
+	sts := builder.StatusReport{MessageId: newID(), CreationDateTime: created, InstructingAgent: s.config.Agent}
+	var settled []int
+	for i, ref := range builder.GetPaymentReferences(doc) {
+		status := s.status(ref)
+		if status.Status == builder.AcceptedSettlementCompletedStatus {
+			settled = append(settled, i)
+		}
+		sts.Transactions = append(sts.Transactions, status)
+	}
+	report, err := builder.NewStatusReport(sts, doc)
+	if err != nil {
+		return nil, err
+	}
+	responses := []Response{{Original: id, MessageType: utils.GetMessageType(report.NameSpace()), Document: report}}
+
+	if !s.config.Notification || len(settled) == 0 {
+		return responses, nil
+	}
+	notification, err := builder.NewCreditNotification(builder.CreditNotification{
+		MessageId:        newID(),
+		CreationDateTime: created,
+		Transactions:     settled,
+	}, doc)
+	if err != nil {
+		return nil, err
+	}
+	return append(responses, Response{Original: id, MessageType: utils.GetMessageType(notification.NameSpace()), Document: notification}), nil
+}
+
+// Receive answers doc, the received document with id, once the delay is elapsed. The
+// responses are delivered in the background with the values of ctx.
+func (s *Simulator) Receive(ctx context.Context, id string, doc document.Iso20022Document) error {
+	responses, err := s.Respond(id, doc)
+	if err != nil {
+		return err
+	}
+	ctx = detached{ctx}
+	s.wg.Add(1)
+	time.AfterFunc(s.config.Delay, func() {
+		defer s.wg.Done()
+		for _, response := range responses {
+			s.respond(ctx, response)
+		}
+	})
+	return nil
+}
+
+// Wait waits for the delivery of the responses of the received documents
+func (s *Simulator) Wait() {
+	s.wg.Wait()
+}
+
+func (s *Simulator) status(ref builder.PaymentReference) builder.TransactionStatus {
+	for _, rule := range s.config.Rules {
+		if !strings.HasPrefix(ref.EndToEndId, rule.EndToEndId) ||
+			(rule.Currency != "" && !strings.EqualFold(rule.Currency, ref.Currency)) ||
+			ref.Amount < rule.MinAmount {
+			continue
+		}
+		status := builder.TransactionStatus{Status: rule.Status, Reason: rule.Reason}
+		if status.Status == "" {
+			status.Status = builder.RejectedStatus
+		}
+		return status
+	}
+	status := s.config.Status
+	if status == "" {
+		status = builder.AcceptedSettlementCompletedStatus
+	}
+	return builder.TransactionStatus{Status: status}
+}
+
+// detached keeps the values of a context without its cancellation, responses outlive the
+// request of the received document
+type detached struct {
+	context.Context
+}
+
+func (detached) Deadline() (time.Time, bool) { return time.Time{}, false }
+func (detached) Done() <-chan struct{}       { return nil }
+func (detached) Err() error                  { return nil }
+
+// newID returns a random message identification
+func newID() string {
+	buf := make([]byte, 16)
+	if _, err := rand.Read(buf); err != nil {
+		return ""
+	}
+	return hex.EncodeToString(buf)
+}
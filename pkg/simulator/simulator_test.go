@@ -0,0 +1,86 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package simulator
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/moov-io/iso20022/pkg/builder"
+	"github.com/moov-io/iso20022/pkg/camt_v08"
+	"github.com/moov-io/iso20022/pkg/iso20022test/fixtures"
+	"github.com/moov-io/iso20022/pkg/pacs_v10"
+)
+
+func TestRespond(t *testing.T) {
+	sim, err := New(Config{
+		Notification: true,
+		Rules:        []Rule{{Currency: "usd", MinAmount: 100000, Reason: "AM02"}},
+	}, nil)
+	require.Nil(t, err)
+
+	// the first transaction (250000 USD) is rejected, the second one settled and notified
+	responses, err := sim.Respond("doc-1", fixtures.Document(t, "valid_pacs_v08.xml"))
+	require.Nil(t, err)
+	require.Len(t, responses, 2)
+	require.Equal(t, "doc-1", responses[0].Original)
+	require.Equal(t, "pacs.002.001.10", responses[0].MessageType)
+	report := responses[0].Document.InspectMessage().(*pacs_v10.FIToFIPaymentStatusReportV10)
+	require.Equal(t, builder.PartiallyAcceptedStatus, string(*report.OrgnlGrpInfAndSts[0].GrpSts))
+	require.Equal(t, builder.RejectedStatus, string(*report.TxInfAndSts[0].TxSts))
+	require.Equal(t, "AM02", string(*report.TxInfAndSts[0].StsRsnInf[0].Rsn.Cd))
+	require.Equal(t, builder.AcceptedSettlementCompletedStatus, string(*report.TxInfAndSts[1].TxSts))
+
+	require.Equal(t, "camt.054.001.08", responses[1].MessageType)
+	notification := responses[1].Document.InspectMessage().(*camt_v08.BankToCustomerDebitCreditNotificationV08)
+	require.Len(t, notification.Ntfctn[0].Ntry, 1)
+	require.Equal(t, 500.75, notification.Ntfctn[0].Ntry[0].Amt.Value)
+
+	// rejected transactions aren't notified
+	sim, err = New(Config{Notification: true, Rules: []Rule{{EndToEndId: "E2E-", Reason: "AC04"}}}, nil)
+	require.Nil(t, err)
+	responses, err = sim.Respond("doc-1", fixtures.Document(t, "valid_pacs_v08.xml"))
+	require.Nil(t, err)
+	require.Len(t, responses, 1)
+
+	_, err = sim.Respond("doc-2", fixtures.Document(t, "valid_camt053_v08.xml"))
+	require.ErrorIs(t, err, builder.ErrNotCreditTransfer)
+}
+
+func TestReceive(t *testing.T) {
+	var mu sync.Mutex
+	var received []Response
+	sim, err := New(Config{Delay: 50 * time.Millisecond, Notification: true}, func(ctx context.Context, response Response) {
+		require.Nil(t, ctx.Err())
+		mu.Lock()
+		defer mu.Unlock()
+		received = append(received, response)
+	})
+	require.Nil(t, err)
+
+	// responses outlive the context of the reception
+	ctx, cancel := context.WithCancel(context.Background())
+	start := time.Now()
+	require.Nil(t, sim.Receive(ctx, "doc-1", fixtures.Document(t, "valid_pacs_v08.xml")))
+	cancel()
+	sim.Wait()
+	require.GreaterOrEqual(t, time.Since(start), 50*time.Millisecond)
+	require.Len(t, received, 2)
+	require.Equal(t, "pacs.002.001.10", received[0].MessageType)
+	require.Equal(t, "camt.054.001.08", received[1].MessageType)
+}
+
+func TestNewErrors(t *testing.T) {
+	_, err := New(Config{Delay: -time.Second}, nil)
+	require.ErrorContains(t, err, "delay is negative")
+	_, err = New(Config{Rules: []Rule{{Currency: "USD"}}}, nil)
+	require.ErrorContains(t, err, "rule 1 rejects without reason")
+	_, err = New(Config{Rules: []Rule{{Status: "ACSP"}, {MinAmount: -1, Reason: "AM02"}}}, nil)
+	require.ErrorContains(t, err, "rule 2 has a negative minimum amount")
+}
@@ -0,0 +1,509 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package sqs
+
+/*
+	Sqs reads the messages of pipelines from AWS SQS queues and writes processed messages to
+	SQS queues or SNS topics, with at-least-once semantics:
+
+		client := sqs.New("us-east-1", sqs.EnvCredentials())
+		queue := client.Queue("https://sqs.us-east-1.amazonaws.com/123456789012/inbound", 10)
+		topic := client.Topic("arn:aws:sns:us-east-1:123456789012:processed")
+		p := pipeline.New(queue.Source(), queue.Sink(topic.Sink(nil)), pipeline.WithErrorSink(queue.ErrorSink(nil)))
+
+	Messages are received in batches of up to 10 with long polling. A message is deleted
+	from its queue after the sink processed it, or after the error sink handled it when it
+	failed parsing or validation, and released for its redelivery when the sink fails.
+	Messages interrupted by a crash are redelivered once their visibility timeout passed,
+	sinks should tolerate repetitions. SNS notifications delivered to queues without raw
+	message delivery are unwrapped.
+
+	Published messages carry the headers of their item as message attributes, up to 10, and
+	a messageType attribute, e.g. pacs.008.001.08, for the filter policies of subscriptions.
+*/
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/moov-io/iso20022/pkg/pipeline"
+	"github.com/moov-io/iso20022/pkg/priority"
+	"github.com/moov-io/iso20022/pkg/resilience"
+	"github.com/moov-io/iso20022/pkg/sigv4"
+	"github.com/moov-io/iso20022/pkg/utils"
+)
+
+const (
+	// HeaderMessageID and HeaderReceiptHandle are the headers of the items emitted by a queue source
+	HeaderMessageID     = "x-sqs-message-id"
+	HeaderReceiptHandle = "x-sqs-receipt-handle"
+
+	// AttributeMessageType and AttributePriority are the message attributes of the message
+	// type and processing priority of published messages
+	AttributeMessageType = "messageType"
+	AttributePriority    = "priority"
+
+	// MaxBatch is the largest batch of messages received at once
+	MaxBatch = 10
+
+	// maxAttributes is the largest number of message attributes of a message
+	maxAttributes = 10
+
+	waitTime    = 20 * time.Second
+	httpTimeout = 30 * time.Second
+	snsVersion  = "2010-03-31"
+)
+
+// Credentials are the AWS credentials signing the requests
+type Credentials = sigv4.Credentials
+
+// EnvCredentials returns the credentials of the AWS_ACCESS_KEY_ID, AWS_SECRET_ACCESS_KEY and
+// AWS_SESSION_TOKEN environment variables
+func EnvCredentials() Credentials {
+	return sigv4.EnvCredentials()
+}
+
+// ErrNoCredentials is returned by the requests of clients without credentials
+var ErrNoCredentials = errors.New("sqs: no credentials")
+
+// Error is an error of the SQS or SNS api
+type Error struct {
+	StatusCode int
+	Code       string
+	Message    string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("%s: %s (%d)", e.Code, e.Message, e.StatusCode)
+}
+
+// Client calls the SQS and SNS apis of a region
+type Client struct {
+	Region      string
+	Credentials Credentials
+
+	// Endpoint replaces the regional endpoints of SQS and SNS, e.g. the endpoint of localstack
+	Endpoint string
+
+	HTTPClient *http.Client
+	now        func() time.Time
+}
+
+// New returns a client of the apis of region signing its requests with credentials, calls are
+// retried with resilience.DefaultPolicy as the registered sqs/<region> integration
+func New(region string, credentials Credentials) *Client {
+	guard := resilience.New("sqs/"+region, resilience.DefaultPolicy)
+	resilience.Register(guard)
+	return &Client{
+		Region:      region,
+		Credentials: credentials,
+		HTTPClient:  guard.Client(&http.Client{Timeout: httpTimeout}),
+		now:         time.Now,
+	}
+}
+
+func (c *Client) endpoint(service string) string {
+	if c.Endpoint != "" {
+		return c.Endpoint
+	}
+	return "https://" + service + "." + c.Region + ".amazonaws.com/"
+}
+
+// do sends the signed request of service and returns the body of its response
+func (c *Client) do(ctx context.Context, service string, header http.Header, body []byte) ([]byte, error) {
+	if c.Credentials.AccessKeyID == "" || c.Credentials.SecretAccessKey == "" {
+		return nil, ErrNoCredentials
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.endpoint(service), bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	for key, values := range header {
+		req.Header[key] = values
+	}
+	sigv4.Sign(req, body, service, c.Region, c.Credentials, c.now())
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode/100 != 2 {
+		return nil, apiError(resp.StatusCode, data)
+	}
+	return data, nil
+}
+
+// apiError returns the error of a response, a json error of SQS or an xml error of SNS
+func apiError(status int, data []byte) error {
+	e := &Error{StatusCode: status, Code: http.StatusText(status)}
+	var jsonErr struct {
+		Type    string `json:"__type"`
+		Message string `json:"message"`
+	}
+	var xmlErr struct {
+		Code    string `xml:"Error>Code"`
+		Message string `xml:"Error>Message"`
+	}
+	switch {
+	case json.Unmarshal(data, &jsonErr) == nil && jsonErr.Type != "":
+		e.Code, e.Message = jsonErr.Type[strings.LastIndex(jsonErr.Type, "#")+1:], jsonErr.Message
+	case xml.Unmarshal(data, &xmlErr) == nil && xmlErr.Code != "":
+		e.Code, e.Message = xmlErr.Code, xmlErr.Message
+	default:
+		e.Message = strings.TrimSpace(string(data))
+	}
+	return e
+}
+
+// call calls the action of the json api of SQS with the json of in and decodes its response into out
+func (c *Client) call(ctx context.Context, action string, in, out interface{}) error {
+	body, err := json.Marshal(in)
+	if err != nil {
+		return err
+	}
+	header := http.Header{}
+	header.Set("Content-Type", "application/x-amz-json-1.0")
+	header.Set("X-Amz-Target", "AmazonSQS."+action)
+	data, err := c.do(ctx, "sqs", header, body)
+	if err != nil {
+		return fmt.Errorf("sqs %s: %w", action, err)
+	}
+	if out == nil {
+		return nil
+	}
+	return json.Unmarshal(data, out)
+}
+
+// Message is a message received from a queue
+type Message struct {
+	ID            string
+	ReceiptHandle string
+	Body          string
+	Attributes    map[string]string
+
+	// ReceiveCount counts the receptions of the message, this one included
+	ReceiveCount int
+}
+
+type messageAttribute struct {
+	DataType    string `json:"DataType"`
+	StringValue string `json:"StringValue,omitempty"`
+}
+
+// Queue is an SQS queue
+type Queue struct {
+	client *Client
+	url    string
+	batch  int
+}
+
+// Queue returns the queue of url receiving batch messages at once, MaxBatch when batch isn't
+// between 1 and MaxBatch
+func (c *Client) Queue(url string, batch int) *Queue {
+	if batch < 1 || batch > MaxBatch {
+		batch = MaxBatch
+	}
+	return &Queue{client: c, url: url, batch: batch}
+}
+
+// Receive returns the next batch of messages of the queue, waiting up to 20s for messages.
+// It returns no message when none arrived meanwhile.
+func (q *Queue) Receive(ctx context.Context) ([]Message, error) {
+	var resp struct {
+		Messages []struct {
+			MessageID         string                      `json:"MessageId"`
+			ReceiptHandle     string                      `json:"ReceiptHandle"`
+			Body              string                      `json:"Body"`
+			Attributes        map[string]string           `json:"Attributes"`
+			MessageAttributes map[string]messageAttribute `json:"MessageAttributes"`
+		} `json:"Messages"`
+	}
+	err := q.client.call(ctx, "ReceiveMessage", map[string]interface{}{
+		"QueueUrl":              q.url,
+		"MaxNumberOfMessages":   q.batch,
+		"WaitTimeSeconds":       int(waitTime.Seconds()),
+		"AttributeNames":        []string{"ApproximateReceiveCount"},
+		"MessageAttributeNames": []string{"All"},
+	}, &resp)
+	if err != nil {
+		return nil, err
+	}
+	messages := make([]Message, 0, len(resp.Messages))
+	for _, m := range resp.Messages {
+		msg := Message{ID: m.MessageID, ReceiptHandle: m.ReceiptHandle, Body: m.Body, Attributes: make(map[string]string)}
+		msg.ReceiveCount, _ = strconv.Atoi(m.Attributes["ApproximateReceiveCount"])
+		for key, attr := range m.MessageAttributes {
+			if attr.StringValue != "" {
+				msg.Attributes[key] = attr.StringValue
+			}
+		}
+		unwrapNotification(&msg)
+		messages = append(messages, msg)
+	}
+	return messages, nil
+}
+
+// unwrapNotification replaces the body and attributes of msg by the ones of the SNS
+// notification it holds, if any
+func unwrapNotification(msg *Message) {
+	if !strings.HasPrefix(strings.TrimSpace(msg.Body), "{") {
+		return
+	}
+	var notification struct {
+		Type              string `json:"Type"`
+		TopicArn          string `json:"TopicArn"`
+		Message           string `json:"Message"`
+		MessageAttributes map[string]struct {
+			Value string `json:"Value"`
+		} `json:"MessageAttributes"`
+	}
+	if json.Unmarshal([]byte(msg.Body), &notification) != nil || notification.Type != "Notification" || notification.TopicArn == "" {
+		return
+	}
+	msg.Body = notification.Message
+	for key, attr := range notification.MessageAttributes {
+		msg.Attributes[key] = attr.Value
+	}
+}
+
+// Delete deletes the messages of the receipt handles from the queue
+func (q *Queue) Delete(ctx context.Context, receiptHandles ...string) error {
+	for len(receiptHandles) > 0 {
+		n := len(receiptHandles)
+		if n > MaxBatch {
+			n = MaxBatch
+		}
+		entries := make([]map[string]string, n)
+		for i, handle := range receiptHandles[:n] {
+			entries[i] = map[string]string{"Id": strconv.Itoa(i), "ReceiptHandle": handle}
+		}
+		var resp struct {
+			Failed []struct {
+				Code    string `json:"Code"`
+				Message string `json:"Message"`
+			} `json:"Failed"`
+		}
+		if err := q.client.call(ctx, "DeleteMessageBatch", map[string]interface{}{"QueueUrl": q.url, "Entries": entries}, &resp); err != nil {
+			return err
+		}
+		if len(resp.Failed) > 0 {
+			return fmt.Errorf("sqs DeleteMessageBatch: %w", &Error{StatusCode: http.StatusOK, Code: resp.Failed[0].Code, Message: resp.Failed[0].Message})
+		}
+		receiptHandles = receiptHandles[n:]
+	}
+	return nil
+}
+
+// Release makes the message of the receipt handle visible again, for its immediate redelivery
+func (q *Queue) Release(ctx context.Context, receiptHandle string) error {
+	return q.client.call(ctx, "ChangeMessageVisibility", map[string]interface{}{
+		"QueueUrl":          q.url,
+		"ReceiptHandle":     receiptHandle,
+		"VisibilityTimeout": 0,
+	}, nil)
+}
+
+// Send sends body with attributes to the queue and returns the id of the message
+func (q *Queue) Send(ctx context.Context, body string, attributes map[string]string) (string, error) {
+	messageAttributes := make(map[string]messageAttribute)
+	for key, value := range attributes {
+		messageAttributes[key] = messageAttribute{DataType: "String", StringValue: value}
+	}
+	var resp struct {
+		MessageID string `json:"MessageId"`
+	}
+	err := q.client.call(ctx, "SendMessage", map[string]interface{}{
+		"QueueUrl":          q.url,
+		"MessageBody":       body,
+		"MessageAttributes": messageAttributes,
+	}, &resp)
+	return resp.MessageID, err
+}
+
+// Topic is an SNS topic
+type Topic struct {
+	client *Client
+	arn    string
+}
+
+// Topic returns the topic of arn
+func (c *Client) Topic(arn string) *Topic {
+	return &Topic{client: c, arn: arn}
+}
+
+// Publish publishes message with attributes to the topic and returns the id of the message
+func (t *Topic) Publish(ctx context.Context, message string, attributes map[string]string) (string, error) {
+	form := url.Values{
+		"Action":   {"Publish"},
+		"Version":  {snsVersion},
+		"TopicArn": {t.arn},
+		"Message":  {message},
+	}
+	keys := make([]string, 0, len(attributes))
+	for key := range attributes {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for i, key := range keys {
+		prefix := fmt.Sprintf("MessageAttributes.entry.%d.", i+1)
+		form.Set(prefix+"Name", key)
+		form.Set(prefix+"Value.DataType", "String")
+		form.Set(prefix+"Value.StringValue", attributes[key])
+	}
+
+	header := http.Header{}
+	header.Set("Content-Type", "application/x-www-form-urlencoded; charset=utf-8")
+	data, err := t.client.do(ctx, "sns", header, []byte(form.Encode()))
+	if err != nil {
+		return "", fmt.Errorf("sns Publish: %w", err)
+	}
+	var resp struct {
+		MessageID string `xml:"PublishResult>MessageId"`
+	}
+	if err := xml.Unmarshal(data, &resp); err != nil {
+		return "", fmt.Errorf("sns Publish: %w", err)
+	}
+	return resp.MessageID, nil
+}
+
+// Source emits the messages of the queue until ctx is done, named by their message id
+func (q *Queue) Source() pipeline.Source {
+	return func(ctx context.Context, emit func(pipeline.Item) error) error {
+		for ctx.Err() == nil {
+			messages, err := q.Receive(ctx)
+			if err != nil {
+				if ctx.Err() != nil {
+					break
+				}
+				return err
+			}
+			for i, msg := range messages {
+				headers := map[string]string{HeaderMessageID: msg.ID, HeaderReceiptHandle: msg.ReceiptHandle}
+				for key, value := range msg.Attributes {
+					if headers[key] == "" {
+						headers[key] = value
+					}
+				}
+				if err := emit(pipeline.Item{Name: msg.ID, Input: []byte(msg.Body), Headers: headers}); err != nil {
+					for _, rest := range messages[i:] {
+						q.Release(context.Background(), rest.ReceiptHandle)
+					}
+					return err
+				}
+			}
+		}
+		return ctx.Err()
+	}
+}
+
+func receiptHandle(item pipeline.Item) (string, error) {
+	handle := item.Headers[HeaderReceiptHandle]
+	if handle == "" {
+		return "", fmt.Errorf("sqs: item %s wasn't received from a queue", item.Name)
+	}
+	return handle, nil
+}
+
+// Sink deletes the items processed by sink from the queue, items failing sink are released
+// for their redelivery, even when ctx is done
+func (q *Queue) Sink(sink pipeline.Sink) pipeline.Sink {
+	return func(ctx context.Context, item pipeline.Item) error {
+		handle, err := receiptHandle(item)
+		if err != nil {
+			return err
+		}
+		if err := sink(ctx, item); err != nil {
+			q.Release(context.Background(), handle)
+			return err
+		}
+		return q.Delete(ctx, handle)
+	}
+}
+
+// ErrorSink routes the failed items to sink, if not nil, and deletes them from the queue.
+// Items failing sink are released for their redelivery.
+func (q *Queue) ErrorSink(sink pipeline.Sink) pipeline.Sink {
+	return func(ctx context.Context, item pipeline.Item) error {
+		handle, err := receiptHandle(item)
+		if err != nil {
+			return err
+		}
+		if sink != nil {
+			if err := sink(ctx, item); err != nil {
+				q.Release(context.Background(), handle)
+				return err
+			}
+		}
+		return q.Delete(ctx, handle)
+	}
+}
+
+// Forward sends the input of the processed items to the queue and routes them to sink, if not nil
+func (q *Queue) Forward(sink pipeline.Sink) pipeline.Sink {
+	return func(ctx context.Context, item pipeline.Item) error {
+		if _, err := q.Send(ctx, string(item.Input), Attributes(item)); err != nil {
+			return err
+		}
+		if sink != nil {
+			return sink(ctx, item)
+		}
+		return nil
+	}
+}
+
+// Sink publishes the input of the processed items to the topic and routes them to sink, if not nil
+func (t *Topic) Sink(sink pipeline.Sink) pipeline.Sink {
+	return func(ctx context.Context, item pipeline.Item) error {
+		if _, err := t.Publish(ctx, string(item.Input), Attributes(item)); err != nil {
+			return err
+		}
+		if sink != nil {
+			return sink(ctx, item)
+		}
+		return nil
+	}
+}
+
+// Attributes returns the message attributes of item, its message type, priority and its
+// headers by name up to 10 attributes, the headers of queue sources excluded
+func Attributes(item pipeline.Item) map[string]string {
+	attributes := make(map[string]string)
+	if item.Document != nil {
+		if kind := utils.GetMessageType(item.Document.NameSpace()); kind != "" {
+			attributes[AttributeMessageType] = kind
+		}
+		attributes[AttributePriority] = string(priority.OfDocument(item.Document).Priority)
+	}
+	keys := make([]string, 0, len(item.Headers))
+	for key, value := range item.Headers {
+		if value != "" && !strings.HasPrefix(key, "x-sqs-") && key != AttributeMessageType && key != AttributePriority {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		if len(attributes) == maxAttributes {
+			break
+		}
+		attributes[key] = item.Headers[key]
+	}
+	return attributes
+}
@@ -0,0 +1,233 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package sqs
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/moov-io/iso20022/pkg/iso20022test/fixtures"
+	"github.com/moov-io/iso20022/pkg/pipeline"
+)
+
+type fakeMessage struct {
+	id, body   string
+	attributes map[string]string
+}
+
+// fakeAWS serves the json api of SQS and the Publish action of SNS
+type fakeAWS struct {
+	mu        sync.Mutex
+	pending   []fakeMessage
+	batches   []int
+	deleted   []string
+	released  []string
+	sent      []map[string]interface{}
+	published []url.Values
+}
+
+func (f *fakeAWS) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential=AKID/") {
+		w.WriteHeader(http.StatusForbidden)
+		w.Write([]byte(`{"__type":"com.amazon.coral.service#UnrecognizedClientException","message":"The security token included in the request is invalid."}`))
+		return
+	}
+	body, _ := io.ReadAll(r.Body)
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if r.Header.Get("X-Amz-Target") == "" {
+		form, _ := url.ParseQuery(string(body))
+		if form.Get("TopicArn") != "arn:aws:sns:us-east-1:123456789012:processed" {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`<ErrorResponse><Error><Type>Sender</Type><Code>NotFound</Code><Message>Topic does not exist</Message></Error></ErrorResponse>`))
+			return
+		}
+		f.published = append(f.published, form)
+		w.Write([]byte(`<PublishResponse><PublishResult><MessageId>published-1</MessageId></PublishResult></PublishResponse>`))
+		return
+	}
+
+	var in map[string]interface{}
+	json.Unmarshal(body, &in)
+	switch r.Header.Get("X-Amz-Target") {
+	case "AmazonSQS.ReceiveMessage":
+		n := int(in["MaxNumberOfMessages"].(float64))
+		f.batches = append(f.batches, n)
+		if n > len(f.pending) {
+			n = len(f.pending)
+		}
+		var messages []map[string]interface{}
+		for _, msg := range f.pending[:n] {
+			attributes := make(map[string]interface{})
+			for key, value := range msg.attributes {
+				attributes[key] = map[string]string{"DataType": "String", "StringValue": value}
+			}
+			messages = append(messages, map[string]interface{}{
+				"MessageId":         msg.id,
+				"ReceiptHandle":     "handle-" + msg.id,
+				"Body":              msg.body,
+				"Attributes":        map[string]string{"ApproximateReceiveCount": "1"},
+				"MessageAttributes": attributes,
+			})
+		}
+		f.pending = f.pending[n:]
+		if n == 0 {
+			time.Sleep(5 * time.Millisecond)
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{"Messages": messages})
+	case "AmazonSQS.DeleteMessageBatch":
+		for _, entry := range in["Entries"].([]interface{}) {
+			f.deleted = append(f.deleted, entry.(map[string]interface{})["ReceiptHandle"].(string))
+		}
+		w.Write([]byte(`{"Successful":[],"Failed":[]}`))
+	case "AmazonSQS.ChangeMessageVisibility":
+		f.released = append(f.released, in["ReceiptHandle"].(string))
+		w.Write([]byte(`{}`))
+	case "AmazonSQS.SendMessage":
+		f.sent = append(f.sent, in)
+		w.Write([]byte(`{"MessageId":"sent-1"}`))
+	default:
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"__type":"com.amazonaws.sqs#InvalidAction","message":"unknown action"}`))
+	}
+}
+
+func newTestClient(t *testing.T, f *fakeAWS) *Client {
+	t.Helper()
+	server := httptest.NewServer(f)
+	t.Cleanup(server.Close)
+	client := New("us-east-1", Credentials{AccessKeyID: "AKID", SecretAccessKey: "secret"})
+	client.Endpoint = server.URL
+	return client
+}
+
+func TestQueue(t *testing.T) {
+	ctx := context.Background()
+	f := &fakeAWS{pending: []fakeMessage{
+		{id: "1", body: "<Document/>", attributes: map[string]string{"source": "bank-a"}},
+		{id: "2", body: `{"Type":"Notification","TopicArn":"arn:aws:sns:us-east-1:123456789012:inbound","Message":"<Document/>","MessageAttributes":{"source":{"Type":"String","Value":"bank-b"}}}`},
+	}}
+	client := newTestClient(t, f)
+	queue := client.Queue("https://sqs.us-east-1.amazonaws.com/123456789012/inbound", 20)
+
+	messages, err := queue.Receive(ctx)
+	require.Nil(t, err)
+	require.Equal(t, []int{MaxBatch}, f.batches)
+	require.Len(t, messages, 2)
+	require.Equal(t, Message{ID: "1", ReceiptHandle: "handle-1", Body: "<Document/>", Attributes: map[string]string{"source": "bank-a"}, ReceiveCount: 1}, messages[0])
+
+	// notifications of topics are unwrapped
+	require.Equal(t, "<Document/>", messages[1].Body)
+	require.Equal(t, "bank-b", messages[1].Attributes["source"])
+
+	require.Nil(t, queue.Delete(ctx, "handle-1"))
+	require.Nil(t, queue.Release(ctx, "handle-2"))
+	require.Equal(t, []string{"handle-1"}, f.deleted)
+	require.Equal(t, []string{"handle-2"}, f.released)
+
+	id, err := queue.Send(ctx, "<Document/>", map[string]string{"source": "bank-a"})
+	require.Nil(t, err)
+	require.Equal(t, "sent-1", id)
+	require.Equal(t, map[string]interface{}{"source": map[string]interface{}{"DataType": "String", "StringValue": "bank-a"}}, f.sent[0]["MessageAttributes"])
+
+	client.Credentials.AccessKeyID = "OTHER"
+	_, err = queue.Receive(ctx)
+	var apiErr *Error
+	require.True(t, errors.As(err, &apiErr))
+	require.Equal(t, "UnrecognizedClientException", apiErr.Code)
+
+	client.Credentials = Credentials{}
+	_, err = queue.Receive(ctx)
+	require.ErrorIs(t, err, ErrNoCredentials)
+}
+
+func TestTopic(t *testing.T) {
+	ctx := context.Background()
+	f := &fakeAWS{}
+	client := newTestClient(t, f)
+
+	id, err := client.Topic("arn:aws:sns:us-east-1:123456789012:processed").Publish(ctx, "<Document/>", map[string]string{"source": "bank-a"})
+	require.Nil(t, err)
+	require.Equal(t, "published-1", id)
+	require.Equal(t, "Publish", f.published[0].Get("Action"))
+	require.Equal(t, "<Document/>", f.published[0].Get("Message"))
+	require.Equal(t, "source", f.published[0].Get("MessageAttributes.entry.1.Name"))
+	require.Equal(t, "bank-a", f.published[0].Get("MessageAttributes.entry.1.Value.StringValue"))
+
+	_, err = client.Topic("arn:aws:sns:us-east-1:123456789012:unknown").Publish(ctx, "<Document/>", nil)
+	require.ErrorContains(t, err, "Topic does not exist")
+}
+
+func TestAttributes(t *testing.T) {
+	headers := map[string]string{HeaderReceiptHandle: "handle"}
+	for _, key := range []string{"a", "b", "c", "d", "e", "f", "g", "h", "i", "j", "k"} {
+		headers[key] = key
+	}
+	attributes := Attributes(pipeline.Item{Headers: headers})
+	require.Len(t, attributes, 10)
+	require.NotContains(t, attributes, HeaderReceiptHandle)
+	require.NotContains(t, attributes, "k")
+}
+
+func TestPipeline(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	f := &fakeAWS{pending: []fakeMessage{
+		{id: "valid", body: string(fixtures.ReadFile(t, "valid_pacs_v08.xml")), attributes: map[string]string{"source": "bank-a"}},
+		{id: "invalid", body: "<Document><Unknown/></Document>"},
+		{id: "failing", body: string(fixtures.ReadFile(t, "valid_camt053_v08.xml"))},
+	}}
+	client := newTestClient(t, f)
+	queue := client.Queue("https://sqs.us-east-1.amazonaws.com/123456789012/inbound", 5)
+
+	var done atomic.Int64
+	finish := func() {
+		if done.Add(1) == 3 {
+			cancel()
+		}
+	}
+	publish := client.Topic("arn:aws:sns:us-east-1:123456789012:processed").Sink(nil)
+	sink := queue.Sink(func(ctx context.Context, item pipeline.Item) error {
+		defer finish()
+		if item.Name == "failing" {
+			return errors.New("ledger unavailable")
+		}
+		return publish(ctx, item)
+	})
+	errorSink := queue.ErrorSink(func(context.Context, pipeline.Item) error {
+		finish()
+		return nil
+	})
+
+	_, err := pipeline.New(queue.Source(), sink, pipeline.WithWorkers(1), pipeline.WithErrorSink(errorSink)).Run(ctx)
+	require.ErrorContains(t, err, "ledger unavailable")
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	require.Equal(t, 5, f.batches[0])
+	require.Equal(t, []string{"handle-valid", "handle-invalid"}, f.deleted)
+	require.Equal(t, []string{"handle-failing"}, f.released)
+
+	require.Len(t, f.published, 1)
+	require.Equal(t, "messageType", f.published[0].Get("MessageAttributes.entry.1.Name"))
+	require.Equal(t, "pacs.008.001.08", f.published[0].Get("MessageAttributes.entry.1.Value.StringValue"))
+	require.Equal(t, "priority", f.published[0].Get("MessageAttributes.entry.2.Name"))
+	require.Equal(t, "URGENT", f.published[0].Get("MessageAttributes.entry.2.Value.StringValue"))
+	require.Equal(t, "bank-a", f.published[0].Get("MessageAttributes.entry.3.Value.StringValue"))
+}
@@ -0,0 +1,77 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package statement
+
+import (
+	"fmt"
+	"time"
+)
+
+// Calendar buckets times into the business days of a time zone. Times from the cutoff of a
+// day on belong to the next business day, e.g. with a cutoff of 18:00 an entry booked at
+// 18:30 is reported with the entries of the next day.
+//
+// The zero calendar buckets times into UTC days.
+type Calendar struct {
+	Location *time.Location
+
+	// Cutoff is the time of the day business days end at, midnight when zero
+	Cutoff time.Duration
+}
+
+// ParseCalendar returns the calendar of the named time zone (e.g. Europe/Zurich) and a
+// cutoff in the 15:04 form, an empty cutoff is midnight
+func ParseCalendar(zone, cutoff string) (Calendar, error) {
+	var c Calendar
+	var err error
+	if c.Location, err = time.LoadLocation(zone); err != nil {
+		return c, err
+	}
+	if cutoff != "" {
+		t, err := time.Parse("15:04", cutoff)
+		if err != nil {
+			return c, fmt.Errorf("invalid cutoff %q, expected hh:mm", cutoff)
+		}
+		c.Cutoff = time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute
+	}
+	return c, nil
+}
+
+func (c Calendar) location() *time.Location {
+	if c.Location == nil {
+		return time.UTC
+	}
+	return c.Location
+}
+
+// Day returns the business day of t, midnight of the day in the time zone of the calendar
+func (c Calendar) Day(t time.Time) time.Time {
+	local := t.In(c.location())
+	day := c.Date(local)
+	if c.Cutoff > 0 && !local.Before(c.cutoffOf(day)) {
+		day = day.AddDate(0, 0, 1)
+	}
+	return day
+}
+
+// Date returns the business day of a date, e.g. the booking date of an entry without time
+func (c Calendar) Date(date time.Time) time.Time {
+	return time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, c.location())
+}
+
+// Period returns the first and last second of the business day day
+func (c Calendar) Period(day time.Time) (time.Time, time.Time) {
+	day = c.Date(day)
+	if c.Cutoff > 0 {
+		return c.cutoffOf(day.AddDate(0, 0, -1)), c.cutoffOf(day).Add(-time.Second)
+	}
+	return day, day.AddDate(0, 0, 1).Add(-time.Second)
+}
+
+// cutoffOf returns the cutoff on the wall clock of day, regardless of daylight saving changes
+func (c Calendar) cutoffOf(day time.Time) time.Time {
+	hours, minutes := int(c.Cutoff/time.Hour), int(c.Cutoff%time.Hour/time.Minute)
+	return time.Date(day.Year(), day.Month(), day.Day(), hours, minutes, 0, 0, c.location())
+}
@@ -0,0 +1,49 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package statement
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCalendarDay(t *testing.T) {
+	booked := time.Date(2021, 4, 15, 22, 30, 0, 0, time.UTC)
+
+	require.Equal(t, time.Date(2021, 4, 15, 0, 0, 0, 0, time.UTC), Calendar{}.Day(booked))
+
+	zurich, err := ParseCalendar("Europe/Zurich", "")
+	require.Nil(t, err)
+	require.Equal(t, time.Date(2021, 4, 16, 0, 0, 0, 0, zurich.Location), zurich.Day(booked))
+
+	newYork, err := ParseCalendar("America/New_York", "17:30")
+	require.Nil(t, err)
+	require.Equal(t, 17*time.Hour+30*time.Minute, newYork.Cutoff)
+	// 18:30 in New York is after the cutoff
+	require.Equal(t, time.Date(2021, 4, 16, 0, 0, 0, 0, newYork.Location), newYork.Day(booked))
+	require.Equal(t, time.Date(2021, 4, 15, 0, 0, 0, 0, newYork.Location), newYork.Day(booked.Add(-2*time.Hour)))
+
+	_, err = ParseCalendar("Europe/Nowhere", "")
+	require.Error(t, err)
+	_, err = ParseCalendar("UTC", "25:00")
+	require.EqualError(t, err, `invalid cutoff "25:00", expected hh:mm`)
+}
+
+func TestCalendarPeriod(t *testing.T) {
+	day := time.Date(2021, 4, 15, 0, 0, 0, 0, time.UTC)
+	start, end := Calendar{}.Period(day)
+	require.Equal(t, day, start)
+	require.Equal(t, time.Date(2021, 4, 15, 23, 59, 59, 0, time.UTC), end)
+
+	// the day daylight saving time starts in Zurich
+	zurich, err := ParseCalendar("Europe/Zurich", "18:00")
+	require.Nil(t, err)
+	start, end = zurich.Period(time.Date(2021, 3, 28, 0, 0, 0, 0, zurich.Location))
+	require.Equal(t, time.Date(2021, 3, 27, 18, 0, 0, 0, zurich.Location), start)
+	require.Equal(t, time.Date(2021, 3, 28, 17, 59, 59, 0, zurich.Location), end)
+	require.Equal(t, 23*time.Hour-time.Second, end.Sub(start))
+}
@@ -0,0 +1,183 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package statement
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/moov-io/iso20022/pkg/camt_v08"
+	"github.com/moov-io/iso20022/pkg/common"
+	"github.com/moov-io/iso20022/pkg/document"
+)
+
+const (
+	dateLayout = "2006-01-02"
+
+	creditCode = common.CreditDebitCode("CRDT")
+	debitCode  = common.CreditDebitCode("DBIT")
+)
+
+// Notification is a camt.054 bank to customer debit credit notification document
+type Notification = document.Document[camt_v08.BankToCustomerDebitCreditNotificationV08]
+
+// Digest merges the entries of many camt.054 notification documents, e.g. intraday files,
+// into one notification document per account and day. Documents are ordered by day and
+// the first notification of their account, entries by their booking time.
+//
+// Every document holds a single notification of the day (from 00:00 to 23:59:59 unless the
+// calendar has a cutoff) whose transaction summary totals its entries, its message
+// identification is DGST-<yyyymmdd>-<n>. Entries are bucketed by their booking date, or
+// value date if they aren't booked, into the business days of the WithCalendar option.
+func Digest(docs []document.Iso20022Document, opts ...Option) ([]*Notification, error) {
+	o := newOptions(opts)
+
+	type bucket struct {
+		day     time.Time
+		key     string
+		acct    camt_v08.CashAccount39
+		entries []camt_v08.ReportEntry10
+	}
+	var buckets []*bucket
+	index := make(map[string]*bucket)
+	accounts := make(map[string]int)
+
+	for i, doc := range docs {
+		ntfctn, err := document.As[camt_v08.BankToCustomerDebitCreditNotificationV08](doc)
+		if err != nil {
+			return nil, fmt.Errorf("document %d: %w", i, err)
+		}
+		for _, n := range ntfctn.Clone().Message.Ntfctn {
+			key := AccountKey(&n.Acct)
+			if _, exists := accounts[key]; !exists {
+				accounts[key] = len(accounts)
+			}
+			for _, ntry := range n.Ntry {
+				day := entryDay(ntry, o.calendar)
+				id := day.Format(dateLayout) + " " + key
+				b, exists := index[id]
+				if !exists {
+					b = &bucket{day: day, key: key, acct: n.Acct}
+					index[id] = b
+					buckets = append(buckets, b)
+				}
+				b.entries = append(b.entries, ntry)
+			}
+		}
+	}
+
+	sort.SliceStable(buckets, func(i, j int) bool {
+		if !buckets[i].day.Equal(buckets[j].day) {
+			return buckets[i].day.Before(buckets[j].day)
+		}
+		return accounts[buckets[i].key] < accounts[buckets[j].key]
+	})
+
+	now := common.ISODateTime(time.Now())
+	digests := make([]*Notification, 0, len(buckets))
+	for i, b := range buckets {
+		sort.SliceStable(b.entries, func(x, y int) bool {
+			return entryTime(b.entries[x]).Before(entryTime(b.entries[y]))
+		})
+
+		digest, err := document.New[camt_v08.BankToCustomerDebitCreditNotificationV08]()
+		if err != nil {
+			return nil, err
+		}
+		start, end := o.calendar.Period(b.day)
+		id := common.Max35Text(fmt.Sprintf("DGST-%s-%d", b.day.Format("20060102"), i+1))
+		digest.Message.GrpHdr = camt_v08.GroupHeader81{MsgId: id, CreDtTm: now}
+		digest.Message.Ntfctn = []camt_v08.AccountNotification17{{
+			Id:        id,
+			CreDtTm:   &now,
+			FrToDt:    &camt_v08.DateTimePeriod1{FrDtTm: common.ISODateTime(start), ToDtTm: common.ISODateTime(end)},
+			Acct:      b.acct,
+			TxsSummry: summarizeEntries(b.entries),
+			Ntry:      b.entries,
+		}}
+		digests = append(digests, digest)
+	}
+	return digests, nil
+}
+
+// entryTime returns the booking time of ntry, or its value time if it isn't booked
+func entryTime(ntry camt_v08.ReportEntry10) time.Time {
+	for _, dt := range []*camt_v08.DateAndDateTime2Choice{ntry.BookgDt, ntry.ValDt} {
+		if dt == nil {
+			continue
+		}
+		if t := time.Time(dt.DtTm); !t.IsZero() {
+			return t
+		}
+		if t := time.Time(dt.Dt); !t.IsZero() {
+			return t
+		}
+	}
+	return time.Time{}
+}
+
+// entryDay returns the business day of the booking or value date of ntry
+func entryDay(ntry camt_v08.ReportEntry10, c Calendar) time.Time {
+	for _, dt := range []*camt_v08.DateAndDateTime2Choice{ntry.BookgDt, ntry.ValDt} {
+		if dt == nil {
+			continue
+		}
+		if t := time.Time(dt.DtTm); !t.IsZero() {
+			return c.Day(t)
+		}
+		if t := time.Time(dt.Dt); !t.IsZero() {
+			// a date without time is a business day already
+			return c.Date(t)
+		}
+	}
+	return c.Date(time.Time{})
+}
+
+// summarizeEntries totals the number and amounts of entries
+func summarizeEntries(entries []camt_v08.ReportEntry10) *camt_v08.TotalTransactions6 {
+	var credits, debits camt_v08.NumberAndSumOfTransactions1
+	var credit, debit float64
+	var nbCredits, nbDebits int
+	for _, ntry := range entries {
+		switch ntry.CdtDbtInd {
+		case creditCode:
+			credit += ntry.Amt.Value
+			nbCredits++
+		case debitCode:
+			debit += ntry.Amt.Value
+			nbDebits++
+		}
+	}
+	credits.NbOfNtries, credits.Sum = numericText(nbCredits), roundAmount(credit)
+	debits.NbOfNtries, debits.Sum = numericText(nbDebits), roundAmount(debit)
+
+	net := camt_v08.AmountAndDirection35{Amt: roundAmount(math.Abs(credit - debit)), CdtDbtInd: creditCode}
+	if debit > credit {
+		net.CdtDbtInd = debitCode
+	}
+	return &camt_v08.TotalTransactions6{
+		TtlNtries: &camt_v08.NumberAndSumOfTransactions4{
+			NbOfNtries: numericText(nbCredits + nbDebits),
+			Sum:        roundAmount(credit + debit),
+			TtlNetNtry: &net,
+		},
+		TtlCdtNtries: &credits,
+		TtlDbtNtries: &debits,
+	}
+}
+
+func numericText(n int) *common.Max15NumericText {
+	text := common.Max15NumericText(strconv.Itoa(n))
+	return &text
+}
+
+// roundAmount rounds the floating point errors of summing amounts to the five fraction
+// digits of iso20022 amounts
+func roundAmount(amount float64) float64 {
+	return math.Round(amount*1e5) / 1e5
+}
@@ -0,0 +1,115 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package statement
+
+import (
+	"bytes"
+	"encoding/xml"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/moov-io/iso20022/pkg/camt_v08"
+	"github.com/moov-io/iso20022/pkg/common"
+	"github.com/moov-io/iso20022/pkg/document"
+	"github.com/moov-io/iso20022/pkg/iso20022test/fixtures"
+)
+
+func entryRefs(entries []camt_v08.ReportEntry10) []string {
+	var refs []string
+	for _, ntry := range entries {
+		refs = append(refs, string(*ntry.NtryRef))
+	}
+	return refs
+}
+
+func TestDigest(t *testing.T) {
+	docs := []document.Iso20022Document{
+		fixtures.Document(t, "valid_camt054_v08_2.xml"),
+		fixtures.Document(t, "valid_camt054_v08_1.xml"),
+	}
+
+	digests, err := Digest(docs)
+	require.Nil(t, err)
+	require.Len(t, digests, 3)
+
+	first := digests[0].Message
+	require.Equal(t, common.Max35Text("DGST-20210415-1"), first.GrpHdr.MsgId)
+	require.Len(t, first.Ntfctn, 1)
+	require.Equal(t, "CH2909000000250094239", AccountKey(&first.Ntfctn[0].Acct))
+	require.Equal(t, []string{"A-1", "A-2", "A-3", "A-4"}, entryRefs(first.Ntfctn[0].Ntry))
+	require.Equal(t, time.Date(2021, 4, 15, 0, 0, 0, 0, time.UTC), time.Time(first.Ntfctn[0].FrToDt.FrDtTm))
+	require.Equal(t, time.Date(2021, 4, 15, 23, 59, 59, 0, time.UTC), time.Time(first.Ntfctn[0].FrToDt.ToDtTm))
+
+	summary := first.Ntfctn[0].TxsSummry
+	require.Equal(t, common.Max15NumericText("4"), *summary.TtlNtries.NbOfNtries)
+	require.Equal(t, 880.0, summary.TtlNtries.Sum)
+	require.Equal(t, camt_v08.AmountAndDirection35{Amt: 620, CdtDbtInd: "CRDT"}, *summary.TtlNtries.TtlNetNtry)
+	require.Equal(t, common.Max15NumericText("2"), *summary.TtlCdtNtries.NbOfNtries)
+	require.Equal(t, 130.0, summary.TtlDbtNtries.Sum)
+
+	second := digests[1].Message
+	require.Equal(t, common.Max35Text("DGST-20210415-2"), second.GrpHdr.MsgId)
+	require.Equal(t, "CH5109000000250092291", AccountKey(&second.Ntfctn[0].Acct))
+	require.Equal(t, []string{"B-1"}, entryRefs(second.Ntfctn[0].Ntry))
+
+	third := digests[2].Message
+	require.Equal(t, common.Max35Text("DGST-20210416-3"), third.GrpHdr.MsgId)
+	require.Equal(t, []string{"A-5"}, entryRefs(third.Ntfctn[0].Ntry))
+
+	for _, digest := range digests {
+		require.Nil(t, digest.Validate())
+
+		// digests are written and read as camt.054 documents
+		buf, err := xml.Marshal(digest)
+		require.Nil(t, err)
+		require.True(t, bytes.Contains(buf, []byte("<TtlNetNtry>")))
+		parsed, err := document.ParseIso20022Document(buf)
+		require.Nil(t, err)
+		require.Nil(t, parsed.Validate())
+	}
+
+	_, err = Digest([]document.Iso20022Document{fixtures.Document(t, "valid_camt053_v08.xml")})
+	require.ErrorContains(t, err, "document 0")
+}
+
+func TestDigestCalendar(t *testing.T) {
+	docs := []document.Iso20022Document{
+		fixtures.Document(t, "valid_camt054_v08_1.xml"),
+		fixtures.Document(t, "valid_camt054_v08_2.xml"),
+	}
+
+	// 22:30 UTC is 00:30 of the next day in Zurich
+	zurich, err := ParseCalendar("Europe/Zurich", "")
+	require.Nil(t, err)
+	digests, err := Digest(docs, WithCalendar(zurich))
+	require.Nil(t, err)
+	require.Len(t, digests, 3)
+	require.Equal(t, []string{"A-1", "A-2", "A-3"}, entryRefs(digests[0].Message.Ntfctn[0].Ntry))
+	require.Equal(t, []string{"A-4", "A-5"}, entryRefs(digests[2].Message.Ntfctn[0].Ntry))
+	require.Equal(t, time.Date(2021, 4, 16, 0, 0, 0, 0, zurich.Location), time.Time(digests[2].Message.Ntfctn[0].FrToDt.FrDtTm))
+
+	// entries from 16:00 on belong to the next business day
+	digests, err = Digest(docs, WithCalendar(Calendar{Location: time.UTC, Cutoff: 16 * time.Hour}))
+	require.Nil(t, err)
+	require.Len(t, digests, 3)
+	require.Equal(t, common.Max35Text("DGST-20210415-1"), digests[0].Message.GrpHdr.MsgId)
+	require.Equal(t, []string{"A-1", "A-2"}, entryRefs(digests[0].Message.Ntfctn[0].Ntry))
+	require.Equal(t, common.Max35Text("DGST-20210416-3"), digests[2].Message.GrpHdr.MsgId)
+	require.Equal(t, []string{"A-3", "A-4", "A-5"}, entryRefs(digests[2].Message.Ntfctn[0].Ntry))
+	require.Equal(t, time.Date(2021, 4, 15, 16, 0, 0, 0, time.UTC), time.Time(digests[2].Message.Ntfctn[0].FrToDt.FrDtTm))
+	require.Equal(t, time.Date(2021, 4, 16, 15, 59, 59, 0, time.UTC), time.Time(digests[2].Message.Ntfctn[0].FrToDt.ToDtTm))
+}
+
+func TestSummarizeEntries(t *testing.T) {
+	summary := summarizeEntries([]camt_v08.ReportEntry10{
+		{Amt: camt_v08.ActiveOrHistoricCurrencyAndAmount{Value: 0.1}, CdtDbtInd: "DBIT"},
+		{Amt: camt_v08.ActiveOrHistoricCurrencyAndAmount{Value: 0.2}, CdtDbtInd: "DBIT"},
+	})
+	require.Equal(t, 0.3, summary.TtlNtries.Sum)
+	require.Equal(t, camt_v08.AmountAndDirection35{Amt: 0.3, CdtDbtInd: "DBIT"}, *summary.TtlNtries.TtlNetNtry)
+	require.Equal(t, common.Max15NumericText("0"), *summary.TtlCdtNtries.NbOfNtries)
+}
@@ -0,0 +1,27 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package statement
+
+// Option configures the transformations of statements
+type Option func(*options)
+
+type options struct {
+	calendar Calendar
+}
+
+func newOptions(opts []Option) options {
+	var o options
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
+
+// WithCalendar buckets entries into the business days of c, UTC days by default
+func WithCalendar(c Calendar) Option {
+	return func(o *options) {
+		o.calendar = c
+	}
+}
@@ -0,0 +1,85 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package statement
+
+/*
+	Package statement transforms camt bank to customer statements and notifications,
+	e.g. splitting a multi-account camt.053 into one document per account.
+*/
+
+import (
+	"fmt"
+
+	"github.com/moov-io/iso20022/pkg/camt_v08"
+	"github.com/moov-io/iso20022/pkg/common"
+	"github.com/moov-io/iso20022/pkg/document"
+)
+
+// maxMessageIdLength is the length of Max35Text message identifications
+const maxMessageIdLength = 35
+
+// Statement is a camt.053 bank to customer statement document
+type Statement = document.Document[camt_v08.BankToCustomerStatementV08]
+
+// AccountKey identifies acct by its IBAN or other identification, followed by its currency if any
+func AccountKey(acct *camt_v08.CashAccount39) string {
+	if acct == nil {
+		return ""
+	}
+	key := string(acct.Id.IBAN)
+	if key == "" {
+		key = string(acct.Id.Othr.Id)
+	}
+	if acct.Ccy != nil {
+		key += "/" + string(*acct.Ccy)
+	}
+	return key
+}
+
+// SplitByAccount splits a camt.053 document into one document per account, in the order of
+// the first statement of each account. Every document holds the statements of its account
+// and a group header of its own: the message identification is suffixed with the index of
+// the account (e.g. STMT-1-2) and the pagination of the original document is dropped.
+func SplitByAccount(doc document.Iso20022Document) ([]*Statement, error) {
+	stmt, err := document.As[camt_v08.BankToCustomerStatementV08](doc)
+	if err != nil {
+		return nil, err
+	}
+
+	var keys []string
+	accounts := make(map[string]bool)
+	for _, s := range stmt.Message.Stmt {
+		if key := AccountKey(s.Acct); !accounts[key] {
+			accounts[key] = true
+			keys = append(keys, key)
+		}
+	}
+
+	split := make([]*Statement, 0, len(keys))
+	for i, key := range keys {
+		// a copy doesn't share elements with doc or the other documents
+		part := stmt.Clone()
+		copied := part.Message.Stmt
+		part.Message.Stmt = nil
+		for _, s := range copied {
+			if AccountKey(s.Acct) == key {
+				part.Message.Stmt = append(part.Message.Stmt, s)
+			}
+		}
+		part.Message.GrpHdr.MsgId = suffixMessageId(stmt.Message.GrpHdr.MsgId, i+1)
+		part.Message.GrpHdr.MsgPgntn = nil
+		split = append(split, part)
+	}
+	return split, nil
+}
+
+// suffixMessageId appends -n to id, truncating id to keep a Max35Text
+func suffixMessageId(id common.Max35Text, n int) common.Max35Text {
+	suffix := fmt.Sprintf("-%d", n)
+	if len(id)+len(suffix) > maxMessageIdLength {
+		id = id[:maxMessageIdLength-len(suffix)]
+	}
+	return id + common.Max35Text(suffix)
+}
@@ -0,0 +1,67 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package statement
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/moov-io/iso20022/pkg/camt_v08"
+	"github.com/moov-io/iso20022/pkg/common"
+	"github.com/moov-io/iso20022/pkg/document"
+	"github.com/moov-io/iso20022/pkg/iso20022test/fixtures"
+)
+
+func TestSplitByAccount(t *testing.T) {
+	doc := fixtures.Document(t, "valid_camt053_v08.xml")
+	require.Nil(t, doc.Validate())
+
+	split, err := SplitByAccount(doc)
+	require.Nil(t, err)
+	require.Len(t, split, 2)
+
+	first, second := split[0].Message, split[1].Message
+	require.Equal(t, common.Max35Text("STMT-20210415-0001-1"), first.GrpHdr.MsgId)
+	require.Equal(t, common.Max35Text("STMT-20210415-0001-2"), second.GrpHdr.MsgId)
+	require.Nil(t, first.GrpHdr.MsgPgntn)
+	require.Equal(t, doc.InspectMessage().(*camt_v08.BankToCustomerStatementV08).GrpHdr.CreDtTm, first.GrpHdr.CreDtTm)
+
+	require.Len(t, first.Stmt, 2)
+	require.Equal(t, common.Max35Text("STMT-A-1"), first.Stmt[0].Id)
+	require.Equal(t, common.Max35Text("STMT-A-2"), first.Stmt[1].Id)
+	require.Len(t, second.Stmt, 1)
+	require.Equal(t, "CH5109000000250092291", AccountKey(second.Stmt[0].Acct))
+
+	for _, stmt := range split {
+		require.Nil(t, stmt.Validate())
+	}
+
+	// the documents don't share elements with the original
+	first.Stmt[0].Acct.Id.IBAN = "CH00"
+	original, err := document.As[camt_v08.BankToCustomerStatementV08](doc)
+	require.Nil(t, err)
+	require.Len(t, original.Message.Stmt, 3)
+	require.Equal(t, "CH2909000000250094239", AccountKey(original.Message.Stmt[0].Acct))
+	require.NotNil(t, original.Message.GrpHdr.MsgPgntn)
+
+	_, err = SplitByAccount(fixtures.Document(t, "valid_pacs_v08.xml"))
+	require.Error(t, err)
+}
+
+func TestAccountKey(t *testing.T) {
+	ccy := common.ActiveOrHistoricCurrencyCode("EUR")
+	require.Equal(t, "", AccountKey(nil))
+	require.Equal(t, "1234/EUR", AccountKey(&camt_v08.CashAccount39{
+		Id:  camt_v08.AccountIdentification4Choice{Othr: camt_v08.GenericAccountIdentification1{Id: "1234"}},
+		Ccy: &ccy,
+	}))
+}
+
+func TestSuffixMessageId(t *testing.T) {
+	require.Equal(t, common.Max35Text("MSG-3"), suffixMessageId("MSG", 3))
+	id := suffixMessageId("12345678901234567890123456789012345", 12)
+	require.Equal(t, common.Max35Text("12345678901234567890123456789012-12"), id)
+}
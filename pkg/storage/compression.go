@@ -0,0 +1,95 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/moov-io/iso20022/pkg/zstd"
+)
+
+// CompressionZstd is the Compression of records whose content is a zstd frame
+const CompressionZstd = "zstd"
+
+// DefaultCompressionMinSize is the size of the smallest content compressed, smaller documents
+// hardly shrink
+const DefaultCompressionMinSize = 512
+
+// ErrDecompression is returned when the content of a record can't be decompressed
+var ErrDecompression = errors.New("decompressing record")
+
+// CompressedStore compresses the content of records with zstd, records are decompressed as
+// they're read so callers never see compressed content. Records stored uncompressed, before
+// compression was enabled or too small to shrink, are returned as they are.
+type CompressedStore struct {
+	store   Store
+	level   int
+	minSize int
+}
+
+// NewCompressedStore returns a store compressing the content of records of at least minSize
+// bytes at level (zstd.MinLevel to zstd.MaxLevel), level 0 stores new records uncompressed and
+// only decompresses the records compressed before
+func NewCompressedStore(store Store, level, minSize int) (*CompressedStore, error) {
+	if level != 0 && (level < zstd.MinLevel || level > zstd.MaxLevel) {
+		return nil, fmt.Errorf("compression level %d isn't between %d and %d", level, zstd.MinLevel, zstd.MaxLevel)
+	}
+	return &CompressedStore{store: store, level: level, minSize: minSize}, nil
+}
+
+func (s *CompressedStore) Put(ctx context.Context, rec Record) error {
+	rec.Compression = ""
+	if s.level > 0 && len(rec.Content) >= s.minSize {
+		if compressed := zstd.Compress(rec.Content, s.level); len(compressed) < len(rec.Content) {
+			rec.Content = compressed
+			rec.Compression = CompressionZstd
+		}
+	}
+	return s.store.Put(ctx, rec)
+}
+
+func (s *CompressedStore) Get(ctx context.Context, id string) (Record, error) {
+	rec, err := s.store.Get(ctx, id)
+	if err != nil {
+		return rec, err
+	}
+	return decompress(rec)
+}
+
+func (s *CompressedStore) Delete(ctx context.Context, id string) error {
+	return s.store.Delete(ctx, id)
+}
+
+func (s *CompressedStore) List(ctx context.Context) ([]Record, error) {
+	records, err := s.store.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for i := range records {
+		if records[i], err = decompress(records[i]); err != nil {
+			return nil, err
+		}
+	}
+	return records, nil
+}
+
+func decompress(rec Record) (Record, error) {
+	switch rec.Compression {
+	case "":
+		return rec, nil
+	case CompressionZstd:
+		content, err := zstd.Decompress(rec.Content)
+		if err != nil {
+			return rec, fmt.Errorf("%w %s: %v", ErrDecompression, rec.ID, err)
+		}
+		rec.Content = content
+		rec.Compression = ""
+		return rec, nil
+	default:
+		return rec, fmt.Errorf("%w %s: unknown compression %q", ErrDecompression, rec.ID, rec.Compression)
+	}
+}
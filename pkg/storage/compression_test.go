@@ -0,0 +1,91 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package storage
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/moov-io/iso20022/pkg/iso20022test/fixtures"
+	"github.com/moov-io/iso20022/pkg/utils"
+	"github.com/moov-io/iso20022/pkg/zstd"
+)
+
+func TestCompressedStore(t *testing.T) {
+	store, err := NewCompressedStore(NewMemoryStore(), zstd.DefaultLevel, 0)
+	require.Nil(t, err)
+	testStore(t, store)
+}
+
+func TestCompressedStoreContent(t *testing.T) {
+	ctx := context.Background()
+	content := fixtures.ReadFile(t, "valid_camt053_v08.xml")
+
+	memory := NewMemoryStore()
+	store, err := NewCompressedStore(memory, zstd.DefaultLevel, DefaultCompressionMinSize)
+	require.Nil(t, err)
+	require.Nil(t, store.Put(ctx, Record{ID: "statement", Format: utils.DocumentTypeXml, Content: content}))
+	require.Nil(t, store.Put(ctx, Record{ID: "small", Content: []byte("<Document/>")}))
+
+	stored, err := memory.Get(ctx, "statement")
+	require.Nil(t, err)
+	assert.Equal(t, CompressionZstd, stored.Compression)
+	assert.True(t, zstd.IsFrame(stored.Content))
+	assert.Less(t, len(stored.Content), len(content)/2)
+
+	// contents smaller than the min size are stored as they are
+	stored, err = memory.Get(ctx, "small")
+	require.Nil(t, err)
+	assert.Empty(t, stored.Compression)
+
+	rec, err := store.Get(ctx, "statement")
+	require.Nil(t, err)
+	assert.Equal(t, content, rec.Content)
+	assert.Empty(t, rec.Compression)
+
+	records, err := store.List(ctx)
+	require.Nil(t, err)
+	require.Len(t, records, 2)
+	for _, rec := range records {
+		if rec.ID == "statement" {
+			assert.Equal(t, content, rec.Content)
+		}
+	}
+
+	// compressed records stay readable once compression is disabled
+	disabled, err := NewCompressedStore(memory, 0, 0)
+	require.Nil(t, err)
+	require.Nil(t, disabled.Put(ctx, Record{ID: "plain", Content: content}))
+	stored, err = memory.Get(ctx, "plain")
+	require.Nil(t, err)
+	assert.Equal(t, content, stored.Content)
+	rec, err = disabled.Get(ctx, "statement")
+	require.Nil(t, err)
+	assert.Equal(t, content, rec.Content)
+
+	// compression under encryption
+	files, err := NewFileStore(t.TempDir())
+	require.Nil(t, err)
+	compressed, err := NewCompressedStore(files, zstd.MaxLevel, 0)
+	require.Nil(t, err)
+	encrypted := NewEncryptedStore(compressed, testKeys(t, "kek-1"))
+	require.Nil(t, encrypted.Put(ctx, Record{ID: "statement", Content: content}))
+	rec, err = encrypted.Get(ctx, "statement")
+	require.Nil(t, err)
+	assert.Equal(t, content, rec.Content)
+
+	require.Nil(t, memory.Put(ctx, Record{ID: "corrupted", Content: []byte("not zstd"), Compression: CompressionZstd}))
+	_, err = store.Get(ctx, "corrupted")
+	require.True(t, errors.Is(err, ErrDecompression))
+	_, err = store.List(ctx)
+	require.True(t, errors.Is(err, ErrDecompression))
+
+	_, err = NewCompressedStore(memory, zstd.MaxLevel+1, 0)
+	require.Error(t, err)
+}
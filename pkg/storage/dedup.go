@@ -0,0 +1,230 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package storage
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// KindBlob records hold the content shared by the records of a DeduplicatedStore, they're
+// hidden from its callers
+const KindBlob = "blob"
+
+const blobPrefix = "sha256-"
+
+// DeduplicationStats counts the records of a DeduplicatedStore sharing their content
+type DeduplicationStats struct {
+	// Records reference the Blobs holding their content
+	Records int `json:"records"`
+	Blobs   int `json:"blobs"`
+
+	// Bytes is the size of the content of the blobs, Saved the size of the copies they replaced
+	Bytes int64 `json:"bytes"`
+	Saved int64 `json:"saved"`
+}
+
+// DeduplicatedStore keeps the content of records once by its sha256 digest, identical
+// documents, e.g. statements delivered again by a channel, are stored as records referencing
+// a single blob. Blobs count their references and are deleted with their last record, records
+// are read with their content.
+type DeduplicatedStore struct {
+	store Store
+	kinds map[string]bool
+
+	// mu serializes the updates of references
+	mu sync.Mutex
+}
+
+// NewDeduplicatedStore returns a store deduplicating the content of the original records and
+// records of kinds kept in store
+func NewDeduplicatedStore(store Store, kinds ...string) *DeduplicatedStore {
+	s := &DeduplicatedStore{store: store, kinds: map[string]bool{KindOriginal: true}}
+	for _, kind := range kinds {
+		s.kinds[kind] = true
+	}
+	return s
+}
+
+// Digest returns the hex encoded sha256 digest of content
+func Digest(content []byte) string {
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:])
+}
+
+func (s *DeduplicatedStore) deduplicated(rec Record) bool {
+	kind := rec.Kind
+	if kind == "" {
+		kind = KindOriginal
+	}
+	return s.kinds[kind]
+}
+
+func (s *DeduplicatedStore) Put(ctx context.Context, rec Record) error {
+	if err := CheckID(rec.ID); err != nil {
+		return err
+	}
+	if rec.Kind == KindBlob {
+		return fmt.Errorf("%s: blob records are kept by the store", rec.ID)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	previous := ""
+	old, err := s.store.Get(ctx, rec.ID)
+	switch {
+	case err == nil && old.Kind == KindBlob:
+		return fmt.Errorf("%s: blob records are kept by the store", rec.ID)
+	case err == nil:
+		previous = old.Digest
+	case !errors.Is(err, ErrNotFound):
+		return err
+	}
+
+	rec.Digest, rec.References = "", 0
+	if s.deduplicated(rec) {
+		// blobs are referenced before their records, a failure leaks a reference but never
+		// leaves a record without its content
+		digest := Digest(rec.Content)
+		if digest != previous {
+			if err := s.reference(ctx, rec, digest); err != nil {
+				return err
+			}
+		}
+		rec.Digest, rec.Content = digest, nil
+	}
+	if err := s.store.Put(ctx, rec); err != nil {
+		return err
+	}
+	if previous != "" && previous != rec.Digest {
+		return s.release(ctx, previous)
+	}
+	return nil
+}
+
+// reference adds a reference of rec to the blob of digest, storing the blob when it's new
+func (s *DeduplicatedStore) reference(ctx context.Context, rec Record, digest string) error {
+	blob, err := s.store.Get(ctx, blobPrefix+digest)
+	if errors.Is(err, ErrNotFound) {
+		blob = Record{
+			ID:          blobPrefix + digest,
+			Kind:        KindBlob,
+			MessageType: rec.MessageType,
+			Format:      rec.Format,
+			Content:     rec.Content,
+			Created:     rec.Created,
+		}
+	} else if err != nil {
+		return err
+	}
+	blob.References++
+	blob.Updated = rec.Updated
+	return s.store.Put(ctx, blob)
+}
+
+// release removes a reference to the blob of digest, deleting the blob without references
+func (s *DeduplicatedStore) release(ctx context.Context, digest string) error {
+	blob, err := s.store.Get(ctx, blobPrefix+digest)
+	if errors.Is(err, ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if blob.References--; blob.References > 0 {
+		return s.store.Put(ctx, blob)
+	}
+	return s.store.Delete(ctx, blob.ID)
+}
+
+func (s *DeduplicatedStore) Get(ctx context.Context, id string) (Record, error) {
+	rec, err := s.store.Get(ctx, id)
+	if err != nil {
+		return rec, err
+	}
+	if rec.Kind == KindBlob {
+		return Record{}, fmt.Errorf("%w: %s", ErrNotFound, id)
+	}
+	if rec.Digest == "" {
+		return rec, nil
+	}
+	blob, err := s.store.Get(ctx, blobPrefix+rec.Digest)
+	if err != nil {
+		return rec, fmt.Errorf("content of %s: %w", id, err)
+	}
+	rec.Content, rec.Digest = blob.Content, ""
+	return rec, nil
+}
+
+func (s *DeduplicatedStore) Delete(ctx context.Context, id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	rec, err := s.store.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+	if rec.Kind == KindBlob {
+		return fmt.Errorf("%w: %s", ErrNotFound, id)
+	}
+	if err := s.store.Delete(ctx, id); err != nil {
+		return err
+	}
+	if rec.Digest != "" {
+		return s.release(ctx, rec.Digest)
+	}
+	return nil
+}
+
+func (s *DeduplicatedStore) List(ctx context.Context) ([]Record, error) {
+	all, err := s.store.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	blobs := make(map[string][]byte)
+	for _, rec := range all {
+		if rec.Kind == KindBlob {
+			blobs[rec.ID] = rec.Content
+		}
+	}
+	records := make([]Record, 0, len(all)-len(blobs))
+	for _, rec := range all {
+		if rec.Kind == KindBlob {
+			continue
+		}
+		if rec.Digest != "" {
+			content, exists := blobs[blobPrefix+rec.Digest]
+			if !exists {
+				return nil, fmt.Errorf("content of %s: %w: %s", rec.ID, ErrNotFound, blobPrefix+rec.Digest)
+			}
+			rec.Content, rec.Digest = append([]byte(nil), content...), ""
+		}
+		records = append(records, rec)
+	}
+	return records, nil
+}
+
+// Stats returns the number of records sharing blobs and the bytes saved
+func (s *DeduplicatedStore) Stats(ctx context.Context) (DeduplicationStats, error) {
+	all, err := s.store.List(ctx)
+	if err != nil {
+		return DeduplicationStats{}, err
+	}
+	var stats DeduplicationStats
+	for _, rec := range all {
+		switch {
+		case rec.Kind == KindBlob:
+			stats.Blobs++
+			stats.Bytes += int64(len(rec.Content))
+			stats.Saved += int64(len(rec.Content)) * int64(rec.References-1)
+		case rec.Digest != "":
+			stats.Records++
+		}
+	}
+	return stats, nil
+}
@@ -0,0 +1,87 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package storage
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeduplicatedStore(t *testing.T) {
+	testStore(t, NewDeduplicatedStore(NewMemoryStore()))
+}
+
+func TestDeduplicatedStoreReferences(t *testing.T) {
+	ctx := context.Background()
+	memory := NewMemoryStore()
+	store := NewDeduplicatedStore(memory)
+	statement := []byte(`<Document><BkToCstmrStmt><GrpHdr><MsgId>STMT-1</MsgId></GrpHdr></BkToCstmrStmt></Document>`)
+	blob := blobPrefix + Digest(statement)
+
+	// statements delivered twice share their blob
+	require.Nil(t, store.Put(ctx, Record{ID: "first", Content: statement}))
+	require.Nil(t, store.Put(ctx, Record{ID: "second", Kind: KindOriginal, Content: statement}))
+	require.Nil(t, store.Put(ctx, Record{ID: "audit", Kind: KindAudit, Content: statement}))
+
+	stored, err := memory.Get(ctx, "second")
+	require.Nil(t, err)
+	assert.Empty(t, stored.Content)
+	assert.Equal(t, Digest(statement), stored.Digest)
+	stored, err = memory.Get(ctx, blob)
+	require.Nil(t, err)
+	assert.Equal(t, 2, stored.References)
+	assert.Equal(t, statement, stored.Content)
+
+	// records of other kinds are stored as they are
+	stored, err = memory.Get(ctx, "audit")
+	require.Nil(t, err)
+	assert.Equal(t, statement, stored.Content)
+	assert.Empty(t, stored.Digest)
+
+	stats, err := store.Stats(ctx)
+	require.Nil(t, err)
+	assert.Equal(t, DeduplicationStats{Records: 2, Blobs: 1, Bytes: int64(len(statement)), Saved: int64(len(statement))}, stats)
+
+	rec, err := store.Get(ctx, "first")
+	require.Nil(t, err)
+	assert.Equal(t, statement, rec.Content)
+	records, err := store.List(ctx)
+	require.Nil(t, err)
+	require.Len(t, records, 3)
+	for _, rec := range records {
+		assert.Equal(t, statement, rec.Content)
+	}
+
+	// blobs are hidden from callers
+	_, err = store.Get(ctx, blob)
+	assert.True(t, errors.Is(err, ErrNotFound))
+	assert.True(t, errors.Is(store.Delete(ctx, blob), ErrNotFound))
+	assert.Error(t, store.Put(ctx, Record{ID: "blob", Kind: KindBlob}))
+
+	// replaced contents release their blob
+	repaired := []byte(`<Document><BkToCstmrStmt><GrpHdr><MsgId>STMT-2</MsgId></GrpHdr></BkToCstmrStmt></Document>`)
+	require.Nil(t, store.Put(ctx, Record{ID: "second", Content: repaired}))
+	stored, err = memory.Get(ctx, blob)
+	require.Nil(t, err)
+	assert.Equal(t, 1, stored.References)
+	require.Nil(t, store.Put(ctx, Record{ID: "second", Content: repaired, Status: "released"}))
+	stored, err = memory.Get(ctx, blobPrefix+Digest(repaired))
+	require.Nil(t, err)
+	assert.Equal(t, 1, stored.References)
+
+	// blobs are deleted with their last record
+	require.Nil(t, store.Delete(ctx, "first"))
+	_, err = memory.Get(ctx, blob)
+	assert.True(t, errors.Is(err, ErrNotFound))
+	require.Nil(t, store.Delete(ctx, "second"))
+	records, err = memory.List(ctx)
+	require.Nil(t, err)
+	require.Len(t, records, 1)
+	assert.Equal(t, "audit", records[0].ID)
+}
@@ -0,0 +1,245 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package storage
+
+import (
+	"bytes"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+)
+
+// EncryptedElements are the elements encrypted by default, account numbers and names
+var EncryptedElements = []string{"IBAN", "Nm"}
+
+var (
+	// ErrUnknownKey is returned when a record is encrypted with a key that isn't configured
+	ErrUnknownKey = errors.New("unknown encryption key")
+
+	// ErrDecryption is returned when the elements of a record can't be decrypted
+	ErrDecryption = errors.New("decrypting record")
+)
+
+// Envelope identifies the data key encrypting the elements of a record, wrapped by the key
+// encryption key KeyID
+type Envelope struct {
+	KeyID      string `json:"keyId"`
+	WrappedKey []byte `json:"wrappedKey"`
+}
+
+// KeyWrapper wraps the data keys of records with key encryption keys, e.g. the keys of a KMS
+type KeyWrapper interface {
+	// WrapKey returns key wrapped by the current key encryption key and its id
+	WrapKey(ctx context.Context, key []byte) (keyID string, wrapped []byte, err error)
+
+	// UnwrapKey returns the key wrapped by the key encryption key keyID
+	UnwrapKey(ctx context.Context, keyID string, wrapped []byte) ([]byte, error)
+}
+
+// StaticKeys wraps data keys with AES-256 key encryption keys by id
+type StaticKeys struct {
+	current string
+	keys    map[string]cipher.AEAD
+}
+
+// NewStaticKeys returns the key wrapper of keys, 32 bytes by id, wrapping new data keys with current
+func NewStaticKeys(current string, keys map[string][]byte) (*StaticKeys, error) {
+	s := &StaticKeys{current: current, keys: make(map[string]cipher.AEAD)}
+	for id, key := range keys {
+		if len(key) != 32 {
+			return nil, fmt.Errorf("encryption key %s has %d bytes instead of 32", id, len(key))
+		}
+		aead, err := newAEAD(key)
+		if err != nil {
+			return nil, err
+		}
+		s.keys[id] = aead
+	}
+	if _, exists := s.keys[current]; !exists {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownKey, current)
+	}
+	return s, nil
+}
+
+func (s *StaticKeys) WrapKey(_ context.Context, key []byte) (string, []byte, error) {
+	wrapped, err := seal(s.keys[s.current], key, []byte(s.current))
+	return s.current, wrapped, err
+}
+
+func (s *StaticKeys) UnwrapKey(_ context.Context, keyID string, wrapped []byte) ([]byte, error) {
+	aead, exists := s.keys[keyID]
+	if !exists {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownKey, keyID)
+	}
+	return open(aead, wrapped, []byte(keyID))
+}
+
+// EncryptedStore encrypts the values of sensitive elements of records with a data key per
+// record, the data key is wrapped and kept in the Encryption envelope of the record. Other
+// elements and the metadata of records are stored as they are.
+type EncryptedStore struct {
+	store Store
+	keys  KeyWrapper
+
+	xmlElements  *regexp.Regexp
+	jsonElements *regexp.Regexp
+}
+
+// NewEncryptedStore returns a store encrypting the EncryptedElements and elements of the
+// records kept in store
+func NewEncryptedStore(store Store, keys KeyWrapper, elements ...string) *EncryptedStore {
+	var names []string
+	for _, name := range append(append([]string{}, EncryptedElements...), elements...) {
+		names = append(names, regexp.QuoteMeta(name))
+	}
+	alternatives := strings.Join(names, "|")
+	return &EncryptedStore{
+		store: store,
+		keys:  keys,
+
+		// values of simple elements hold no markup, they end with the closing tag
+		xmlElements:  regexp.MustCompile(`<(?:[\w.-]+:)?(?:` + alternatives + `)>([^<]+)<`),
+		jsonElements: regexp.MustCompile(`"(?:` + alternatives + `)"\s*:\s*"((?:[^"\\]|\\.)+)"`),
+	}
+}
+
+func (s *EncryptedStore) Put(ctx context.Context, rec Record) error {
+	key := make([]byte, 32)
+	if _, err := io.ReadFull(rand.Reader, key); err != nil {
+		return err
+	}
+	aead, err := newAEAD(key)
+	if err != nil {
+		return err
+	}
+	keyID, wrapped, err := s.keys.WrapKey(ctx, key)
+	if err != nil {
+		return fmt.Errorf("wrapping data key of %s: %w", rec.ID, err)
+	}
+
+	rec.Content, err = s.transform(rec.Content, func(value []byte) ([]byte, error) {
+		sealed, err := seal(aead, value, []byte(rec.ID))
+		if err != nil {
+			return nil, err
+		}
+		return []byte(base64.StdEncoding.EncodeToString(sealed)), nil
+	})
+	if err != nil {
+		return err
+	}
+	rec.Encryption = &Envelope{KeyID: keyID, WrappedKey: wrapped}
+	return s.store.Put(ctx, rec)
+}
+
+func (s *EncryptedStore) Get(ctx context.Context, id string) (Record, error) {
+	rec, err := s.store.Get(ctx, id)
+	if err != nil {
+		return rec, err
+	}
+	return s.decrypt(ctx, rec)
+}
+
+func (s *EncryptedStore) Delete(ctx context.Context, id string) error {
+	return s.store.Delete(ctx, id)
+}
+
+func (s *EncryptedStore) List(ctx context.Context) ([]Record, error) {
+	records, err := s.store.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for i := range records {
+		if records[i], err = s.decrypt(ctx, records[i]); err != nil {
+			return nil, err
+		}
+	}
+	return records, nil
+}
+
+// decrypt returns rec with the decrypted values of its elements, records stored before
+// encryption was enabled are returned as they are
+func (s *EncryptedStore) decrypt(ctx context.Context, rec Record) (Record, error) {
+	if rec.Encryption == nil {
+		return rec, nil
+	}
+	key, err := s.keys.UnwrapKey(ctx, rec.Encryption.KeyID, rec.Encryption.WrappedKey)
+	if err != nil {
+		return rec, fmt.Errorf("%w %s: %v", ErrDecryption, rec.ID, err)
+	}
+	aead, err := newAEAD(key)
+	if err != nil {
+		return rec, err
+	}
+
+	rec.Content, err = s.transform(rec.Content, func(value []byte) ([]byte, error) {
+		sealed, err := base64.StdEncoding.DecodeString(string(value))
+		if err != nil {
+			return nil, err
+		}
+		return open(aead, sealed, []byte(rec.ID))
+	})
+	if err != nil {
+		return rec, fmt.Errorf("%w %s: %v", ErrDecryption, rec.ID, err)
+	}
+	rec.Encryption = nil
+	return rec, nil
+}
+
+// transform replaces the values of the encrypted elements of the xml or json content by fn of
+// their raw, still escaped, bytes
+func (s *EncryptedStore) transform(content []byte, fn func([]byte) ([]byte, error)) ([]byte, error) {
+	for _, pattern := range []*regexp.Regexp{s.xmlElements, s.jsonElements} {
+		matches := pattern.FindAllSubmatchIndex(content, -1)
+		if len(matches) == 0 {
+			continue
+		}
+		var buf bytes.Buffer
+		last := 0
+		for _, match := range matches {
+			start, end := match[2], match[3]
+			value, err := fn(content[start:end])
+			if err != nil {
+				return nil, err
+			}
+			buf.Write(content[last:start])
+			buf.Write(value)
+			last = end
+		}
+		buf.Write(content[last:])
+		content = buf.Bytes()
+	}
+	return content, nil
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// seal returns the nonce and ciphertext of plaintext
+func seal(aead cipher.AEAD, plaintext, data []byte) ([]byte, error) {
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, err
+	}
+	return aead.Seal(nonce, nonce, plaintext, data), nil
+}
+
+func open(aead cipher.AEAD, sealed, data []byte) ([]byte, error) {
+	if len(sealed) < aead.NonceSize() {
+		return nil, errors.New("ciphertext is too short")
+	}
+	return aead.Open(nil, sealed[:aead.NonceSize()], sealed[aead.NonceSize():], data)
+}
@@ -0,0 +1,116 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package storage
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/moov-io/iso20022/pkg/utils"
+)
+
+func testKeys(t *testing.T, current string) *StaticKeys {
+	keys, err := NewStaticKeys(current, map[string][]byte{
+		"kek-1": bytes.Repeat([]byte{1}, 32),
+		"kek-2": bytes.Repeat([]byte{2}, 32),
+	})
+	require.Nil(t, err)
+	return keys
+}
+
+func TestEncryptedStore(t *testing.T) {
+	testStore(t, NewEncryptedStore(NewMemoryStore(), testKeys(t, "kek-1")))
+}
+
+func TestEncryptedStoreElements(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	files, err := NewFileStore(dir)
+	require.Nil(t, err)
+	store := NewEncryptedStore(files, testKeys(t, "kek-1"), "Ustrd")
+
+	xml := []byte(`<Document><Dbtr><Nm>John &amp; Smith</Nm></Dbtr><DbtrAcct><Id><IBAN>DE89370400440532013000</IBAN></Id></DbtrAcct><Ctry>DE</Ctry><Ustrd>Invoice 42</Ustrd></Document>`)
+	json := []byte(`{"Dbtr": {"Nm": "Jane \"JD\" Doe"}, "DbtrAcct": {"Id": {"IBAN": "DE89370400440532013000"}}, "Ctry": "DE"}`)
+	require.Nil(t, store.Put(ctx, Record{ID: "xml", Format: utils.DocumentTypeXml, Content: xml}))
+	require.Nil(t, store.Put(ctx, Record{ID: "json", Format: utils.DocumentTypeJson, Content: json}))
+
+	// files hold no sensitive values
+	for _, id := range []string{"xml", "json"} {
+		buf, err := os.ReadFile(filepath.Join(dir, id+".json"))
+		require.Nil(t, err)
+		for _, value := range []string{"Smith", "Doe", "DE89370400440532013000", "Invoice"} {
+			assert.NotContains(t, string(buf), value)
+		}
+		assert.Contains(t, string(buf), `"keyId":"kek-1"`)
+
+		stored, err := files.Get(ctx, id)
+		require.Nil(t, err)
+		assert.Contains(t, string(stored.Content), "DE")
+	}
+
+	rec, err := store.Get(ctx, "xml")
+	require.Nil(t, err)
+	assert.Equal(t, xml, rec.Content)
+	assert.Nil(t, rec.Encryption)
+
+	records, err := store.List(ctx)
+	require.Nil(t, err)
+	require.Len(t, records, 2)
+	for _, rec := range records {
+		if rec.ID == "json" {
+			assert.Equal(t, json, rec.Content)
+		}
+	}
+}
+
+func TestEncryptedStoreRotation(t *testing.T) {
+	ctx := context.Background()
+	memory := NewMemoryStore()
+	content := []byte(`<Document><Nm>John Smith</Nm></Document>`)
+
+	// records stored before encryption are read as they are
+	require.Nil(t, memory.Put(ctx, Record{ID: "plain", Content: content}))
+	require.Nil(t, NewEncryptedStore(memory, testKeys(t, "kek-1")).Put(ctx, Record{ID: "old", Content: content}))
+
+	store := NewEncryptedStore(memory, testKeys(t, "kek-2"))
+	require.Nil(t, store.Put(ctx, Record{ID: "new", Content: content}))
+	for _, id := range []string{"plain", "old", "new"} {
+		rec, err := store.Get(ctx, id)
+		require.Nil(t, err, id)
+		assert.Equal(t, content, rec.Content, id)
+	}
+
+	stored, err := memory.Get(ctx, "new")
+	require.Nil(t, err)
+	assert.Equal(t, "kek-2", stored.Encryption.KeyID)
+
+	// a removed key can't decrypt its records
+	keys, err := NewStaticKeys("kek-2", map[string][]byte{"kek-2": bytes.Repeat([]byte{2}, 32)})
+	require.Nil(t, err)
+	_, err = NewEncryptedStore(memory, keys).Get(ctx, "old")
+	assert.ErrorIs(t, err, ErrDecryption)
+
+	// the ciphertext of a record is bound to its id
+	stored, err = memory.Get(ctx, "new")
+	require.Nil(t, err)
+	stored.ID = "copy"
+	require.Nil(t, memory.Put(ctx, stored))
+	_, err = store.Get(ctx, "copy")
+	assert.ErrorIs(t, err, ErrDecryption)
+}
+
+func TestNewStaticKeys(t *testing.T) {
+	_, err := NewStaticKeys("kek-1", map[string][]byte{"kek-1": []byte("short")})
+	assert.NotNil(t, err)
+
+	_, err = NewStaticKeys("kek-3", map[string][]byte{"kek-1": bytes.Repeat([]byte{1}, 32)})
+	assert.ErrorIs(t, err, ErrUnknownKey)
+}
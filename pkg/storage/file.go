@@ -0,0 +1,120 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package storage
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+const recordExt = ".json"
+
+// FileStore keeps every record as a json file of a directory
+type FileStore struct {
+	dir string
+}
+
+// NewFileStore returns a store of dir, creating it when missing
+func NewFileStore(dir string) (*FileStore, error) {
+	if err := os.MkdirAll(dir, 0750); err != nil {
+		return nil, err
+	}
+	return &FileStore{dir: dir}, nil
+}
+
+func (s *FileStore) path(id string) string {
+	return filepath.Join(s.dir, id+recordExt)
+}
+
+func (s *FileStore) Put(_ context.Context, rec Record) error {
+	if err := CheckID(rec.ID); err != nil {
+		return err
+	}
+	buf, err := json.Marshal(rec)
+	if err != nil {
+		return err
+	}
+
+	// records are replaced atomically
+	tmp, err := os.CreateTemp(s.dir, ".record-")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err = tmp.Write(buf); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err = tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), s.path(rec.ID))
+}
+
+func (s *FileStore) Get(_ context.Context, id string) (Record, error) {
+	if err := CheckID(id); err != nil {
+		return Record{}, err
+	}
+	return s.read(s.path(id), id)
+}
+
+func (s *FileStore) read(path, id string) (Record, error) {
+	buf, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return Record{}, fmt.Errorf("%w: %s", ErrNotFound, id)
+	}
+	if err != nil {
+		return Record{}, err
+	}
+	var rec Record
+	if err = json.Unmarshal(buf, &rec); err != nil {
+		return Record{}, fmt.Errorf("%s: %w", path, err)
+	}
+	return rec, nil
+}
+
+func (s *FileStore) Delete(_ context.Context, id string) error {
+	if err := CheckID(id); err != nil {
+		return err
+	}
+	err := os.Remove(s.path(id))
+	if errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("%w: %s", ErrNotFound, id)
+	}
+	return err
+}
+
+func (s *FileStore) List(_ context.Context) ([]Record, error) {
+	entries, err := os.ReadDir(s.dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var records []Record
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || strings.HasPrefix(name, ".") || filepath.Ext(name) != recordExt {
+			continue
+		}
+		id := strings.TrimSuffix(name, recordExt)
+		rec, err := s.read(filepath.Join(s.dir, name), id)
+		if errors.Is(err, ErrNotFound) {
+			// removed while listing
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, rec)
+	}
+	sortRecords(records)
+	return records, nil
+}
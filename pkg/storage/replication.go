@@ -0,0 +1,268 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+const (
+	ChangePut    = "put"
+	ChangeDelete = "delete"
+
+	defaultReplicationQueueSize     = 1000
+	defaultReplicationRetries       = 3
+	defaultReplicationRetryInterval = time.Second
+)
+
+// ErrReplicationClosed is returned by the writes of a closed ReplicatedStore
+var ErrReplicationClosed = errors.New("replication is closed")
+
+// Replica receives the changes of the records of a ReplicatedStore, e.g. a store or a bucket
+// of a secondary region. Stores are replicas.
+type Replica interface {
+	// Put stores rec, replacing the record with the same id
+	Put(ctx context.Context, rec Record) error
+
+	// Delete removes the record with id, records missing from the replica are ignored
+	Delete(ctx context.Context, id string) error
+}
+
+// Change is a write of a ReplicatedStore waiting for or applied to its replica
+type Change struct {
+	Op   string    `json:"op"`
+	ID   string    `json:"id"`
+	Kind string    `json:"kind,omitempty"`
+	Time time.Time `json:"time"`
+
+	record Record
+}
+
+// ReplicationOptions selects the records replicated by a ReplicatedStore and how failures are
+// retried
+type ReplicationOptions struct {
+	// Kinds are replicated, every kind when empty. Deletes are replicated whatever the kind.
+	Kinds []string
+
+	// QueueSize is the number of changes waiting for the replica, 1000 when zero. Writes wait
+	// for room in the queue when the replica lags.
+	QueueSize int
+
+	// Retries of the changes failing, 3 when zero, and the delay before the first retry, 1s
+	// when zero, doubled by retry
+	Retries       int
+	RetryInterval time.Duration
+
+	// Report is called with the changes failing after their retries, Sync replicates them
+	// again
+	Report func(Change, error)
+}
+
+// ReplicationStatus is the progress of the replication of a ReplicatedStore
+type ReplicationStatus struct {
+	// Pending changes wait for the replica, Replicated and Failed are counted since the start
+	Pending    int   `json:"pending"`
+	Replicated int64 `json:"replicated"`
+	Failed     int64 `json:"failed"`
+
+	// LastReplicated is the time of the last replicated change, Lag the delay after its write
+	LastReplicated time.Time     `json:"lastReplicated,omitempty"`
+	Lag            time.Duration `json:"lag"`
+
+	LastError string `json:"lastError,omitempty"`
+}
+
+// ReplicatedStore replicates the writes of a store to a replica asynchronously, reads are
+// served by the store. Changes are applied to the replica in the order of the writes, failed
+// changes are retried then reported, Sync replicates every record again, e.g. after an outage
+// of the replica.
+type ReplicatedStore struct {
+	store   Store
+	replica Replica
+	options ReplicationOptions
+	kinds   map[string]bool
+	now     func() time.Time
+
+	changes chan Change
+	done    chan struct{}
+
+	// closing guards closed, writes hold it for reading while they queue their change
+	closing sync.RWMutex
+	closed  bool
+
+	mu     sync.Mutex
+	status ReplicationStatus
+}
+
+// NewReplicatedStore returns a store replicating the writes of store to replica until it's closed
+func NewReplicatedStore(store Store, replica Replica, options ReplicationOptions) *ReplicatedStore {
+	if options.QueueSize <= 0 {
+		options.QueueSize = defaultReplicationQueueSize
+	}
+	if options.Retries <= 0 {
+		options.Retries = defaultReplicationRetries
+	}
+	if options.RetryInterval <= 0 {
+		options.RetryInterval = defaultReplicationRetryInterval
+	}
+	s := &ReplicatedStore{
+		store:   store,
+		replica: replica,
+		options: options,
+		now:     time.Now,
+		changes: make(chan Change, options.QueueSize),
+		done:    make(chan struct{}),
+	}
+	if len(options.Kinds) > 0 {
+		s.kinds = make(map[string]bool)
+		for _, kind := range options.Kinds {
+			s.kinds[kind] = true
+		}
+	}
+	go s.replicate()
+	return s
+}
+
+func (s *ReplicatedStore) replicated(rec Record) bool {
+	kind := rec.Kind
+	if kind == "" {
+		kind = KindOriginal
+	}
+	return s.kinds == nil || s.kinds[kind]
+}
+
+func (s *ReplicatedStore) Put(ctx context.Context, rec Record) error {
+	if err := s.store.Put(ctx, rec); err != nil {
+		return err
+	}
+	if !s.replicated(rec) {
+		return nil
+	}
+	rec.Content = append([]byte(nil), rec.Content...)
+	return s.queue(ctx, Change{Op: ChangePut, ID: rec.ID, Kind: rec.Kind, Time: s.now().UTC(), record: rec})
+}
+
+func (s *ReplicatedStore) Get(ctx context.Context, id string) (Record, error) {
+	return s.store.Get(ctx, id)
+}
+
+func (s *ReplicatedStore) Delete(ctx context.Context, id string) error {
+	if err := s.store.Delete(ctx, id); err != nil {
+		return err
+	}
+	return s.queue(ctx, Change{Op: ChangeDelete, ID: id, Time: s.now().UTC()})
+}
+
+func (s *ReplicatedStore) List(ctx context.Context) ([]Record, error) {
+	return s.store.List(ctx)
+}
+
+// queue adds change to the changes waiting for the replica, the change is reported as failed
+// when ctx is done before there's room for it
+func (s *ReplicatedStore) queue(ctx context.Context, change Change) error {
+	s.closing.RLock()
+	if s.closed {
+		s.closing.RUnlock()
+		return fmt.Errorf("%w: %s of %s", ErrReplicationClosed, change.Op, change.ID)
+	}
+	select {
+	case s.changes <- change:
+		s.closing.RUnlock()
+	case <-ctx.Done():
+		s.closing.RUnlock()
+		s.fail(change, ctx.Err())
+	}
+	return nil
+}
+
+// Sync queues every replicated record of the store for the replica and returns their number
+func (s *ReplicatedStore) Sync(ctx context.Context) (int, error) {
+	records, err := s.store.List(ctx)
+	if err != nil {
+		return 0, err
+	}
+	n := 0
+	for _, rec := range records {
+		if !s.replicated(rec) {
+			continue
+		}
+		if err := s.queue(ctx, Change{Op: ChangePut, ID: rec.ID, Kind: rec.Kind, Time: s.now().UTC(), record: rec}); err != nil {
+			return n, err
+		}
+		if err := ctx.Err(); err != nil {
+			return n, err
+		}
+		n++
+	}
+	return n, nil
+}
+
+// Status returns the progress of the replication
+func (s *ReplicatedStore) Status() ReplicationStatus {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	status := s.status
+	status.Pending = len(s.changes)
+	return status
+}
+
+// Close stops the replication once the queued changes are applied
+func (s *ReplicatedStore) Close() {
+	s.closing.Lock()
+	if !s.closed {
+		s.closed = true
+		close(s.changes)
+	}
+	s.closing.Unlock()
+	<-s.done
+}
+
+func (s *ReplicatedStore) replicate() {
+	defer close(s.done)
+	for change := range s.changes {
+		err := s.apply(change)
+		for retry, delay := 0, s.options.RetryInterval; err != nil && retry < s.options.Retries; retry++ {
+			time.Sleep(delay)
+			delay *= 2
+			err = s.apply(change)
+		}
+		if err != nil {
+			s.fail(change, err)
+			continue
+		}
+		now := s.now().UTC()
+		s.mu.Lock()
+		s.status.Replicated++
+		s.status.LastReplicated = now
+		s.status.Lag = now.Sub(change.Time)
+		s.mu.Unlock()
+	}
+}
+
+func (s *ReplicatedStore) apply(change Change) error {
+	ctx := context.Background()
+	if change.Op == ChangePut {
+		return s.replica.Put(ctx, change.record)
+	}
+	if err := s.replica.Delete(ctx, change.ID); err != nil && !errors.Is(err, ErrNotFound) {
+		return err
+	}
+	return nil
+}
+
+// fail counts the failure of change and reports it
+func (s *ReplicatedStore) fail(change Change, err error) {
+	s.mu.Lock()
+	s.status.Failed++
+	s.status.LastError = fmt.Sprintf("%s of %s: %v", change.Op, change.ID, err)
+	s.mu.Unlock()
+	if s.options.Report != nil {
+		s.options.Report(change, err)
+	}
+}
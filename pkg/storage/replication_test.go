@@ -0,0 +1,106 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package storage
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// failingReplica fails the first failures changes
+type failingReplica struct {
+	Store
+
+	mu       sync.Mutex
+	failures int
+}
+
+func (r *failingReplica) Put(ctx context.Context, rec Record) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.failures > 0 {
+		r.failures--
+		return errors.New("secondary region unavailable")
+	}
+	return r.Store.Put(ctx, rec)
+}
+
+func TestReplicatedStore(t *testing.T) {
+	store := NewReplicatedStore(NewMemoryStore(), NewMemoryStore(), ReplicationOptions{})
+	testStore(t, store)
+	store.Close()
+}
+
+func TestReplicatedStoreReplica(t *testing.T) {
+	ctx := context.Background()
+	replica := NewMemoryStore()
+	store := NewReplicatedStore(NewMemoryStore(), replica, ReplicationOptions{Kinds: []string{KindOriginal, KindLedger}})
+
+	require.Nil(t, store.Put(ctx, Record{ID: "statement", Content: []byte("<Document/>")}))
+	require.Nil(t, store.Put(ctx, Record{ID: "entry", Kind: KindLedger, Content: []byte("{}")}))
+	require.Nil(t, store.Put(ctx, Record{ID: "summary", Kind: KindSummary, Content: []byte("{}")}))
+	require.Nil(t, store.Put(ctx, Record{ID: "erased", Content: []byte("<Document/>")}))
+	require.Nil(t, store.Delete(ctx, "erased"))
+	require.Nil(t, store.Delete(ctx, "summary"))
+	store.Close()
+
+	records, err := replica.List(ctx)
+	require.Nil(t, err)
+	require.Len(t, records, 2)
+	assert.Equal(t, "entry", records[0].ID)
+	assert.Equal(t, "statement", records[1].ID)
+	assert.Equal(t, "<Document/>", string(records[1].Content))
+
+	status := store.Status()
+	assert.Equal(t, int64(5), status.Replicated)
+	assert.Zero(t, status.Failed)
+	assert.Zero(t, status.Pending)
+	assert.False(t, status.LastReplicated.IsZero())
+
+	// closed stores keep their records but refuse writes
+	assert.ErrorIs(t, store.Put(ctx, Record{ID: "late"}), ErrReplicationClosed)
+	_, err = store.Get(ctx, "statement")
+	assert.Nil(t, err)
+}
+
+func TestReplicatedStoreFailures(t *testing.T) {
+	ctx := context.Background()
+	replica := &failingReplica{Store: NewMemoryStore(), failures: 3}
+	var reported []Change
+	store := NewReplicatedStore(NewMemoryStore(), replica, ReplicationOptions{
+		Retries:       1,
+		RetryInterval: time.Millisecond,
+		Report:        func(change Change, err error) { reported = append(reported, change) },
+	})
+
+	// failures are retried then reported
+	require.Nil(t, store.Put(ctx, Record{ID: "lost", Content: []byte("<Document/>")}))
+	require.Nil(t, store.Put(ctx, Record{ID: "retried", Content: []byte("<Document/>")}))
+	require.Eventually(t, func() bool { return store.Status().Replicated == 1 }, time.Second, time.Millisecond)
+	status := store.Status()
+	assert.Equal(t, int64(1), status.Failed)
+	assert.Equal(t, "put of lost: secondary region unavailable", status.LastError)
+	require.Len(t, reported, 1)
+	assert.Equal(t, ChangePut, reported[0].Op)
+	assert.Equal(t, "lost", reported[0].ID)
+	_, err := replica.Get(ctx, "lost")
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	// sync replicates the records again
+	n, err := store.Sync(ctx)
+	require.Nil(t, err)
+	assert.Equal(t, 2, n)
+	store.Close()
+	records, err := replica.List(ctx)
+	require.Nil(t, err)
+	assert.Len(t, records, 2)
+	assert.Equal(t, int64(3), store.Status().Replicated)
+}
@@ -0,0 +1,171 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+const (
+	PurgeTriggerSchedule = "schedule"
+	PurgeTriggerManual   = "manual"
+)
+
+// RetentionPolicy purges the records of a kind and message type older than MaxAge
+type RetentionPolicy struct {
+	// Kind and MessageType select the records of the policy, empty selects every kind or type
+	Kind        string
+	MessageType string
+
+	// MaxAge is how long records are kept after their creation
+	MaxAge time.Duration
+}
+
+func (p RetentionPolicy) matches(rec Record) bool {
+	kind := rec.Kind
+	if kind == "" {
+		kind = KindOriginal
+	}
+	return (p.Kind == "" || p.Kind == kind) && (p.MessageType == "" || p.MessageType == rec.MessageType)
+}
+
+// PolicyStatus is the outcome of the last purge of a retention policy
+type PolicyStatus struct {
+	RetentionPolicy
+
+	// Records are kept by the policy, Purged were purged by the last purge
+	Records int `json:"records"`
+	Purged  int `json:"purged"`
+
+	// Oldest is the creation of the oldest kept record
+	Oldest time.Time `json:"oldest,omitempty"`
+}
+
+// RetentionStatus is the outcome of the last purge of a purger
+type RetentionStatus struct {
+	Time    time.Time `json:"time"`
+	Trigger string    `json:"trigger"`
+	Error   string    `json:"error,omitempty"`
+
+	Policies []PolicyStatus `json:"policies"`
+
+	// Unmanaged records aren't selected by any policy and kept indefinitely
+	Unmanaged int `json:"unmanaged"`
+
+	// Purged counts the records purged since the purger started
+	Purged int `json:"purged"`
+}
+
+// Purger deletes the records of a store older than the max age of their retention policy
+//
+// A record is managed by the first policy selecting it, records selected by no policy are kept.
+type Purger struct {
+	store    Store
+	policies []RetentionPolicy
+
+	mu     sync.Mutex
+	status RetentionStatus
+	now    func() time.Time
+}
+
+// NewPurger returns a purger of store applying policies
+func NewPurger(store Store, policies []RetentionPolicy) (*Purger, error) {
+	for i, policy := range policies {
+		if policy.MaxAge <= 0 {
+			return nil, fmt.Errorf("retention policy %d: max age must be positive", i)
+		}
+	}
+	return &Purger{store: store, policies: policies, now: time.Now}, nil
+}
+
+// Status returns the status of the last purge
+func (p *Purger) Status() RetentionStatus {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	status := p.status
+	status.Policies = append([]PolicyStatus(nil), status.Policies...)
+	return status
+}
+
+// Purge deletes the expired records of the store
+func (p *Purger) Purge(ctx context.Context, trigger string) (RetentionStatus, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	now := p.now()
+	policies := make([]PolicyStatus, len(p.policies))
+	for i, policy := range p.policies {
+		policies[i].RetentionPolicy = policy
+	}
+	unmanaged := 0
+
+	records, err := p.store.List(ctx)
+	for _, rec := range records {
+		i := p.policyOf(rec)
+		if i < 0 {
+			unmanaged++
+			continue
+		}
+
+		status := &policies[i]
+		if now.Sub(rec.Created) > status.MaxAge {
+			if err = p.store.Delete(ctx, rec.ID); err != nil && !errors.Is(err, ErrNotFound) {
+				break
+			}
+			err = nil
+			status.Purged++
+			p.status.Purged++
+			continue
+		}
+		status.Records++
+		if status.Oldest.IsZero() || rec.Created.Before(status.Oldest) {
+			status.Oldest = rec.Created
+		}
+	}
+
+	p.status.Time = now
+	p.status.Trigger = trigger
+	p.status.Policies = policies
+	p.status.Unmanaged = unmanaged
+	p.status.Error = ""
+	if err != nil {
+		p.status.Error = err.Error()
+	}
+	status := p.status
+	status.Policies = append([]PolicyStatus(nil), policies...)
+	return status, err
+}
+
+func (p *Purger) policyOf(rec Record) int {
+	for i, policy := range p.policies {
+		if policy.matches(rec) {
+			return i
+		}
+	}
+	return -1
+}
+
+// Run purges every interval until ctx is done. fn, if not nil, is called with the outcome of every purge.
+func (p *Purger) Run(ctx context.Context, interval time.Duration, fn func(RetentionStatus, error)) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		status, err := p.Purge(ctx, PurgeTriggerSchedule)
+		if fn != nil {
+			fn(status, err)
+		}
+	}
+}
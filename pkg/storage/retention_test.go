@@ -0,0 +1,92 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package storage
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPurger(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2021, 9, 1, 0, 0, 0, 0, time.UTC)
+	day := 24 * time.Hour
+
+	store := NewMemoryStore()
+	for _, rec := range []Record{
+		{ID: "old-original", MessageType: "pacs.008.001.08", Created: now.Add(-100 * day)},
+		{ID: "new-original", Kind: KindOriginal, MessageType: "pacs.008.001.08", Created: now.Add(-10 * day)},
+		{ID: "old-summary", Kind: KindSummary, MessageType: "pacs.008.001.08", Created: now.Add(-100 * day)},
+		{ID: "other-kind", Kind: "report", Created: now.Add(-1000 * day)},
+	} {
+		require.Nil(t, store.Put(ctx, rec))
+	}
+
+	purger, err := NewPurger(store, []RetentionPolicy{
+		{Kind: KindOriginal, MaxAge: 90 * day},
+		{Kind: KindSummary, MaxAge: 7 * 365 * day},
+	})
+	require.Nil(t, err)
+	purger.now = func() time.Time { return now }
+
+	status, err := purger.Purge(ctx, PurgeTriggerManual)
+	require.Nil(t, err)
+	assert.Equal(t, now, status.Time)
+	assert.Equal(t, PurgeTriggerManual, status.Trigger)
+	assert.Equal(t, 1, status.Purged)
+	assert.Equal(t, 1, status.Unmanaged)
+	require.Len(t, status.Policies, 2)
+	assert.Equal(t, 1, status.Policies[0].Records)
+	assert.Equal(t, 1, status.Policies[0].Purged)
+	assert.Equal(t, now.Add(-10*day), status.Policies[0].Oldest)
+	assert.Equal(t, 1, status.Policies[1].Records)
+	assert.Equal(t, status, purger.Status())
+
+	_, err = store.Get(ctx, "old-original")
+	assert.ErrorIs(t, err, ErrNotFound)
+	records, err := store.List(ctx)
+	require.Nil(t, err)
+	assert.Len(t, records, 3)
+
+	// summaries expire later
+	purger.now = func() time.Time { return now.Add(7 * 365 * day) }
+	status, err = purger.Purge(ctx, PurgeTriggerSchedule)
+	require.Nil(t, err)
+	assert.Equal(t, 3, status.Purged)
+	assert.Equal(t, 2, status.Policies[0].Purged+status.Policies[1].Purged)
+
+	_, err = NewPurger(store, []RetentionPolicy{{Kind: KindSummary}})
+	assert.NotNil(t, err)
+}
+
+func TestPurgerRun(t *testing.T) {
+	store := NewMemoryStore()
+	require.Nil(t, store.Put(context.Background(), Record{ID: "expired", Created: time.Now().Add(-time.Hour)}))
+	purger, err := NewPurger(store, []RetentionPolicy{{MaxAge: time.Minute}})
+	require.Nil(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	purged := make(chan RetentionStatus, 1)
+	go purger.Run(ctx, 10*time.Millisecond, func(status RetentionStatus, err error) {
+		if err == nil && status.Purged > 0 {
+			select {
+			case purged <- status:
+			default:
+			}
+		}
+	})
+
+	select {
+	case status := <-purged:
+		assert.Equal(t, PurgeTriggerSchedule, status.Trigger)
+	case <-time.After(5 * time.Second):
+		t.Fatal("records weren't purged")
+	}
+}
@@ -0,0 +1,174 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package storage
+
+/*
+	Storage keeps documents by id for the endpoints working on stored documents. Documents
+	are kept in memory or as files of a directory:
+
+		store, err := storage.NewFileStore("/var/lib/iso20022/documents")
+		err = store.Put(ctx, storage.Record{ID: id, Content: buf})
+
+	NewEncryptedStore encrypts the account numbers and names of the documents of a store, so
+	dumps of the store don't expose customer data. NewCompressedStore compresses documents
+	with zstd, decompressing them as they're read. NewDeduplicatedStore keeps identical
+	documents once.
+*/
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/moov-io/iso20022/pkg/utils"
+)
+
+var (
+	// ErrNotFound is returned when no document is stored with an id
+	ErrNotFound = errors.New("document not found")
+
+	// ErrInvalidID is returned for ids other than letters, digits, - and _
+	ErrInvalidID = errors.New("invalid document id")
+
+	validID = regexp.MustCompile(`^[A-Za-z0-9_-]{1,128}$`)
+)
+
+const (
+	// KindOriginal records are received documents, records of an empty kind are originals
+	KindOriginal = "original"
+
+	// KindSummary records summarize documents, e.g. the outcome of their validation
+	KindSummary = "summary"
+
+	// KindAudit records log the access to and changes of other records
+	KindAudit = "audit"
+
+	// KindDeadLetter records hold messages that failed processing
+	KindDeadLetter = "dead-letter"
+
+	// KindLedger records hold the processing state of inputs
+	KindLedger = "ledger"
+)
+
+// Record is a stored document
+type Record struct {
+	ID          string             `json:"id"`
+	Kind        string             `json:"kind,omitempty"`
+	MessageType string             `json:"messageType"`
+	Format      utils.DocumentType `json:"format"`
+	Content     []byte             `json:"content"`
+	Created     time.Time          `json:"created"`
+	Updated     time.Time          `json:"updated"`
+
+	// Status is the processing status of the record, e.g. of a document held for review,
+	// empty for records processed as they're stored
+	Status string `json:"status,omitempty"`
+
+	// Encryption is the envelope of the data key of the encrypted elements of Content
+	Encryption *Envelope `json:"encryption,omitempty"`
+
+	// Compression is the algorithm compressing Content, e.g. CompressionZstd, empty for
+	// uncompressed content
+	Compression string `json:"compression,omitempty"`
+
+	// Digest is the sha256 digest of the content of records deduplicated by a
+	// DeduplicatedStore, their content is kept by the blob of the digest
+	Digest string `json:"digest,omitempty"`
+
+	// References is the number of records sharing the content of a blob
+	References int `json:"references,omitempty"`
+}
+
+// Store keeps records by id
+type Store interface {
+	// Put stores rec, replacing the record with the same id
+	Put(ctx context.Context, rec Record) error
+
+	// Get returns the record with id or ErrNotFound
+	Get(ctx context.Context, id string) (Record, error)
+
+	// Delete removes the record with id, ErrNotFound when it isn't stored
+	Delete(ctx context.Context, id string) error
+
+	// List returns every record ordered by creation
+	List(ctx context.Context) ([]Record, error)
+}
+
+// CheckID returns ErrInvalidID unless id can be stored
+func CheckID(id string) error {
+	if !validID.MatchString(id) {
+		return fmt.Errorf("%w: %q", ErrInvalidID, id)
+	}
+	return nil
+}
+
+// MemoryStore keeps records in memory
+type MemoryStore struct {
+	mu      sync.RWMutex
+	records map[string]Record
+}
+
+// NewMemoryStore returns an empty memory store
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{records: make(map[string]Record)}
+}
+
+func (s *MemoryStore) Put(_ context.Context, rec Record) error {
+	if err := CheckID(rec.ID); err != nil {
+		return err
+	}
+	rec.Content = append([]byte(nil), rec.Content...)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.records[rec.ID] = rec
+	return nil
+}
+
+func (s *MemoryStore) Get(_ context.Context, id string) (Record, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	rec, ok := s.records[id]
+	if !ok {
+		return Record{}, fmt.Errorf("%w: %s", ErrNotFound, id)
+	}
+	rec.Content = append([]byte(nil), rec.Content...)
+	return rec, nil
+}
+
+func (s *MemoryStore) Delete(_ context.Context, id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.records[id]; !ok {
+		return fmt.Errorf("%w: %s", ErrNotFound, id)
+	}
+	delete(s.records, id)
+	return nil
+}
+
+func (s *MemoryStore) List(_ context.Context) ([]Record, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	records := make([]Record, 0, len(s.records))
+	for _, rec := range s.records {
+		rec.Content = append([]byte(nil), rec.Content...)
+		records = append(records, rec)
+	}
+	sortRecords(records)
+	return records, nil
+}
+
+func sortRecords(records []Record) {
+	sort.Slice(records, func(i, j int) bool {
+		if !records[i].Created.Equal(records[j].Created) {
+			return records[i].Created.Before(records[j].Created)
+		}
+		return records[i].ID < records[j].ID
+	})
+}
@@ -0,0 +1,85 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package storage
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/moov-io/iso20022/pkg/utils"
+)
+
+func testStore(t *testing.T, store Store) {
+	ctx := context.Background()
+	created := time.Date(2021, 4, 15, 10, 0, 0, 0, time.UTC)
+
+	first := Record{ID: "doc-1", MessageType: "pacs.008.001.08", Format: utils.DocumentTypeXml, Content: []byte("<Document/>"), Created: created, Updated: created}
+	second := Record{ID: "doc_0", MessageType: "pain.001.001.09", Format: utils.DocumentTypeJson, Content: []byte("{}"), Created: created.Add(time.Minute), Updated: created.Add(time.Minute)}
+	require.Nil(t, store.Put(ctx, second))
+	require.Nil(t, store.Put(ctx, first))
+
+	rec, err := store.Get(ctx, "doc-1")
+	require.Nil(t, err)
+	assert.Equal(t, first, rec)
+
+	records, err := store.List(ctx)
+	require.Nil(t, err)
+	require.Len(t, records, 2)
+	assert.Equal(t, "doc-1", records[0].ID)
+	assert.Equal(t, "doc_0", records[1].ID)
+
+	// records are replaced
+	first.Content = []byte("<Document></Document>")
+	require.Nil(t, store.Put(ctx, first))
+	rec, err = store.Get(ctx, "doc-1")
+	require.Nil(t, err)
+	assert.Equal(t, first.Content, rec.Content)
+
+	require.Nil(t, store.Delete(ctx, "doc-1"))
+	_, err = store.Get(ctx, "doc-1")
+	assert.ErrorIs(t, err, ErrNotFound)
+	assert.ErrorIs(t, store.Delete(ctx, "doc-1"), ErrNotFound)
+
+	assert.ErrorIs(t, store.Put(ctx, Record{ID: "../escape"}), ErrInvalidID)
+	assert.ErrorIs(t, store.Put(ctx, Record{}), ErrInvalidID)
+}
+
+func TestMemoryStore(t *testing.T) {
+	testStore(t, NewMemoryStore())
+
+	// stored content isn't shared with callers
+	store := NewMemoryStore()
+	content := []byte("<Document/>")
+	require.Nil(t, store.Put(context.Background(), Record{ID: "doc", Content: content}))
+	content[1] = 'X'
+	rec, err := store.Get(context.Background(), "doc")
+	require.Nil(t, err)
+	assert.Equal(t, "<Document/>", string(rec.Content))
+}
+
+func TestFileStore(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "documents")
+	store, err := NewFileStore(dir)
+	require.Nil(t, err)
+	testStore(t, store)
+
+	// records survive the store
+	require.Nil(t, store.Put(context.Background(), Record{ID: "kept", Content: []byte("{}")}))
+	require.Nil(t, os.WriteFile(filepath.Join(dir, "notes.txt"), []byte("ignored"), 0600))
+	reopened, err := NewFileStore(dir)
+	require.Nil(t, err)
+	records, err := reopened.List(context.Background())
+	require.Nil(t, err)
+	require.Len(t, records, 2)
+
+	_, err = reopened.Get(context.Background(), "../kept")
+	assert.ErrorIs(t, err, ErrInvalidID)
+}
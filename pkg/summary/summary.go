@@ -0,0 +1,120 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package summary
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/moov-io/iso20022/pkg/document"
+	"github.com/moov-io/iso20022/pkg/enrich"
+	"github.com/moov-io/iso20022/pkg/largevalue"
+	"github.com/moov-io/iso20022/pkg/priority"
+	"github.com/moov-io/iso20022/pkg/utils"
+)
+
+// Summary is a compact, message type independent description of a document
+type Summary struct {
+	MessageType          string
+	MessageId            string `json:",omitempty"`
+	CreationDateTime     string `json:",omitempty"`
+	NumberOfTransactions int
+	Priority             priority.Tag
+	Transactions         []Transaction `json:",omitempty"`
+
+	// LargeValueTransactions is the number of transactions marked by MarkLargeValues
+	LargeValueTransactions int `json:",omitempty"`
+
+	// Enrichments of the elements outside of the transactions
+	Enrichments []enrich.Enrichment `json:",omitempty"`
+}
+
+// Transaction is the summary of a single transaction
+type Transaction struct {
+	Path          string
+	EndToEndId    string  `json:",omitempty"`
+	TransactionId string  `json:",omitempty"`
+	Amount        float64 `json:",omitempty"`
+	Currency      string  `json:",omitempty"`
+	Priority      priority.Tag
+	LargeValue    bool                `json:",omitempty"`
+	Enrichments   []enrich.Enrichment `json:",omitempty"`
+}
+
+// NewSummary returns the summary of doc
+func NewSummary(doc document.Iso20022Document) *Summary {
+	msg := doc.InspectMessage()
+	tagger := priority.NewTagger()
+
+	s := &Summary{
+		MessageType:      utils.GetMessageType(doc.NameSpace()),
+		MessageId:        firstValue(msg, "GrpHdr/MsgId", "MsgHdr/MsgId", "MsgId"),
+		CreationDateTime: firstValue(msg, "GrpHdr/CreDtTm", "MsgHdr/CreDtTm", "CreDtTm"),
+	}
+
+	var tags []priority.Tag
+	for _, tx := range utils.GetTransactions(msg) {
+		tag := tagger.TagTransaction(tx)
+		tags = append(tags, tag)
+		if tx.Path == "" {
+			continue
+		}
+
+		item := Transaction{
+			Path:          tx.Path,
+			EndToEndId:    tx.Lookup("PmtId/EndToEndId", "OrgnlEndToEndId"),
+			TransactionId: tx.Lookup("PmtId/TxId", "OrgnlTxId"),
+			Currency:      tx.Lookup("IntrBkSttlmAmt/@Ccy", "InstdAmt/@Ccy", "Amt/@Ccy"),
+			Priority:      tag,
+		}
+		if amount := tx.Lookup("IntrBkSttlmAmt", "InstdAmt", "Amt"); amount != "" {
+			item.Amount, _ = strconv.ParseFloat(amount, 64)
+		}
+		s.Transactions = append(s.Transactions, item)
+	}
+	s.NumberOfTransactions = len(s.Transactions)
+	s.Priority = priority.Highest(tags)
+
+	return s
+}
+
+// AddEnrichments attaches enrichments to the transactions holding their elements and to s
+func (s *Summary) AddEnrichments(enrichments enrich.Set) {
+	attached := make([]bool, len(enrichments))
+	for i := range s.Transactions {
+		for j, e := range enrichments {
+			if !attached[j] && strings.HasPrefix(e.Path, s.Transactions[i].Path+"/") {
+				s.Transactions[i].Enrichments = append(s.Transactions[i].Enrichments, e)
+				attached[j] = true
+			}
+		}
+	}
+	for j, e := range enrichments {
+		if !attached[j] {
+			s.Enrichments = append(s.Enrichments, e)
+		}
+	}
+}
+
+// MarkLargeValues marks the transactions whose amount reaches the threshold of their currency
+func (s *Summary) MarkLargeValues(thresholds largevalue.Thresholds) {
+	s.LargeValueTransactions = 0
+	for i := range s.Transactions {
+		tx := &s.Transactions[i]
+		tx.LargeValue = thresholds.IsLarge(tx.Amount, tx.Currency)
+		if tx.LargeValue {
+			s.LargeValueTransactions++
+		}
+	}
+}
+
+func firstValue(msg interface{}, suffixes ...string) string {
+	for _, suffix := range suffixes {
+		if values := utils.FindElementValues(msg, suffix); len(values) > 0 {
+			return values[0]
+		}
+	}
+	return ""
+}
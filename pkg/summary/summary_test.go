@@ -0,0 +1,74 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package summary
+
+import (
+	"context"
+	"testing"
+
+	"github.com/moov-io/iso20022/pkg/enrich"
+	"github.com/moov-io/iso20022/pkg/iso20022test/fixtures"
+	"github.com/moov-io/iso20022/pkg/largevalue"
+	"github.com/moov-io/iso20022/pkg/priority"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewSummary(t *testing.T) {
+	s := NewSummary(fixtures.Document(t, "valid_pacs_v08.xml"))
+	require.Equal(t, "pacs.008.001.08", s.MessageType)
+	require.Equal(t, "MSG-20210415-0001", s.MessageId)
+	require.Equal(t, "2021-04-15T10:30:00", s.CreationDateTime)
+	require.Equal(t, 2, s.NumberOfTransactions)
+	require.Equal(t, priority.PriorityUrgent, s.Priority.Priority)
+
+	require.Equal(t, Transaction{
+		Path:          "CdtTrfTxInf[1]",
+		EndToEndId:    "E2E-0002",
+		TransactionId: "TX-0002",
+		Amount:        500.75,
+		Currency:      "USD",
+		Priority:      s.Priority,
+	}, s.Transactions[1])
+
+	s = NewSummary(fixtures.Document(t, "valid_pacs_v11.xml"))
+	require.Equal(t, "pacs.002.001.11", s.MessageType)
+	require.Equal(t, "MsgId", s.MessageId)
+	require.Equal(t, 0, s.NumberOfTransactions)
+	require.Equal(t, priority.PriorityNormal, s.Priority.Priority)
+}
+
+func TestAddEnrichments(t *testing.T) {
+	doc := fixtures.Document(t, "valid_pacs_v08.xml")
+	set, err := enrich.Enrich(context.Background(), doc, enrich.BankDirectory{"BANKGB2LXXX": "Bank of London", "BANKDEFF": "Bank of Frankfurt"})
+	require.Nil(t, err)
+
+	s := NewSummary(doc)
+	s.AddEnrichments(set)
+	require.Equal(t, []enrich.Enrichment{
+		{Path: "GrpHdr/InstdAgt/FinInstnId/BICFI", Key: enrich.KeyBankName, Value: "Bank of London", Source: "banks"},
+	}, s.Enrichments)
+	require.Equal(t, []enrich.Enrichment{
+		{Path: "CdtTrfTxInf[0]/CdtrAgt/FinInstnId/BICFI", Key: enrich.KeyBankName, Value: "Bank of London", Source: "banks"},
+	}, s.Transactions[0].Enrichments)
+	require.Equal(t, []enrich.Enrichment{
+		{Path: "CdtTrfTxInf[1]/CdtrAgt/FinInstnId/BICFI", Key: enrich.KeyBankName, Value: "Bank of Frankfurt", Source: "banks"},
+	}, s.Transactions[1].Enrichments)
+}
+
+func TestMarkLargeValues(t *testing.T) {
+	thresholds, err := largevalue.New(map[string]float64{"usd": 10000})
+	require.Nil(t, err)
+
+	s := NewSummary(fixtures.Document(t, "valid_pacs_v08.xml"))
+	s.MarkLargeValues(thresholds)
+	require.Equal(t, 1, s.LargeValueTransactions)
+	require.True(t, s.Transactions[0].LargeValue)
+	require.False(t, s.Transactions[1].LargeValue)
+
+	// marks are replaced
+	s.MarkLargeValues(largevalue.Thresholds{"EUR": 1})
+	require.Equal(t, 0, s.LargeValueTransactions)
+	require.False(t, s.Transactions[0].LargeValue)
+}
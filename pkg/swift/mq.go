@@ -0,0 +1,161 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package swift
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	mqmdV1Length = 324
+	mqmdV2Length = 364
+	rfh2Length   = 36
+
+	// integer encodings of MQ, the low bits of Encoding
+	encodingReversed = 0x2
+	encodingMask     = 0xF
+)
+
+var (
+	mqmdStrucID = []byte("MD  ")
+	rfh2StrucID = []byte("RFH ")
+)
+
+// mqText returns the MQCHAR field of buf without its padding
+func mqText(buf []byte) string {
+	return strings.TrimRight(string(bytes.TrimRight(buf, "\x00")), " ")
+}
+
+func mqID(buf []byte) string {
+	if len(bytes.Trim(buf, "\x00")) == 0 {
+		return ""
+	}
+	return hex.EncodeToString(buf)
+}
+
+// byteOrder detects the integer encoding of a structure by its version, which is small
+func byteOrder(buf []byte) binary.ByteOrder {
+	if binary.BigEndian.Uint32(buf) > 0xFFFF {
+		return binary.LittleEndian
+	}
+	return binary.BigEndian
+}
+
+func encodingOrder(encoding uint32) binary.ByteOrder {
+	if encoding&encodingMask == encodingReversed {
+		return binary.LittleEndian
+	}
+	return binary.BigEndian
+}
+
+// parseMQMD reads the MQ message descriptor (MQMD version 1 or 2) prefixing data into
+// headers and returns the rest of data and its integer encoding
+func parseMQMD(data []byte, headers map[string]string) ([]byte, binary.ByteOrder, error) {
+	if len(data) < mqmdV1Length {
+		return nil, nil, errors.New("mq: message descriptor is truncated")
+	}
+	order := byteOrder(data[4:8])
+	length := mqmdV1Length
+	switch version := order.Uint32(data[4:8]); version {
+	case 1:
+	case 2:
+		if len(data) < mqmdV2Length {
+			return nil, nil, errors.New("mq: message descriptor is truncated")
+		}
+		length = mqmdV2Length
+	default:
+		return nil, nil, fmt.Errorf("mq: unsupported message descriptor version %d", version)
+	}
+	md := data[:length]
+	long := func(offset int) string {
+		return strconv.FormatInt(int64(int32(order.Uint32(md[offset:]))), 10)
+	}
+
+	set(headers, HeaderMQMsgType, long(12))
+	set(headers, HeaderMQFormat, mqText(md[32:40]))
+	set(headers, HeaderMQCodedCharSetID, long(28))
+	set(headers, HeaderMQPriority, long(40))
+	set(headers, HeaderMQPersistence, long(44))
+	set(headers, HeaderMQMsgID, mqID(md[48:72]))
+	set(headers, HeaderMQCorrelID, mqID(md[72:96]))
+	set(headers, HeaderMQBackoutCount, long(96))
+	set(headers, HeaderMQReplyToQ, mqText(md[100:148]))
+	set(headers, HeaderMQReplyToQMgr, mqText(md[148:196]))
+	set(headers, HeaderMQUserIdentifier, mqText(md[196:208]))
+	set(headers, HeaderMQApplIdentityData, mqText(md[240:272]))
+	set(headers, HeaderMQPutApplName, mqText(md[276:304]))
+	set(headers, HeaderMQPutDate, mqText(md[304:312]))
+	set(headers, HeaderMQPutTime, mqText(md[312:320]))
+	if length == mqmdV2Length {
+		set(headers, HeaderMQGroupID, mqID(md[324:348]))
+		set(headers, HeaderMQMsgSeqNumber, long(348))
+	}
+	return data[length:], encodingOrder(order.Uint32(md[24:28])), nil
+}
+
+// parseRFH2 reads the MQRFH2 header prefixing data, its folders are the headers
+// x-mq-<folder>-<element>, and returns the rest of data
+func parseRFH2(data []byte, order binary.ByteOrder, headers map[string]string) ([]byte, error) {
+	if len(data) < rfh2Length || !bytes.HasPrefix(data, rfh2StrucID) {
+		return nil, errors.New("mq: message has no rules and formatting header")
+	}
+	length := int(order.Uint32(data[8:12]))
+	if length < rfh2Length || length > len(data) {
+		return nil, fmt.Errorf("mq: invalid length %d of rules and formatting header", length)
+	}
+	for folders := data[rfh2Length:length]; len(folders) > 0; {
+		if len(folders) < 4 {
+			return nil, errors.New("mq: rules and formatting header is truncated")
+		}
+		size := int(order.Uint32(folders))
+		if size < 0 || size > len(folders)-4 {
+			return nil, fmt.Errorf("mq: invalid length %d of folder", size)
+		}
+		if err := parseFolder(bytes.TrimRight(folders[4:4+size], " \x00"), headers); err != nil {
+			return nil, err
+		}
+		folders = folders[4+size:]
+	}
+	return data[length:], nil
+}
+
+// parseFolder reads the elements of a folder, e.g. <usr><Channel>FIN</Channel></usr>
+func parseFolder(folder []byte, headers map[string]string) error {
+	d := xml.NewDecoder(bytes.NewReader(folder))
+	var path []string
+	var value strings.Builder
+	for {
+		tok, err := d.Token()
+		if err != nil {
+			if len(path) == 0 && tok == nil {
+				return nil
+			}
+			return fmt.Errorf("mq: reading folder: %w", err)
+		}
+		switch t := tok.(type) {
+		case xml.StartElement:
+			path = append(path, strings.ToLower(t.Name.Local))
+			value.Reset()
+		case xml.CharData:
+			value.Write(t)
+		case xml.EndElement:
+			if len(path) > 1 {
+				set(headers, "x-mq-"+strings.Join(path, "-"), strings.TrimSpace(value.String()))
+			}
+			value.Reset()
+			path = path[:len(path)-1]
+			if len(path) == 0 {
+				return nil
+			}
+		}
+	}
+}
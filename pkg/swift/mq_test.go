@@ -0,0 +1,125 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package swift
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func field(s string, size int) []byte {
+	return append([]byte(s), bytes.Repeat([]byte(" "), size-len(s))...)
+}
+
+// mqmd returns a message descriptor of version with the fields of a message put by an
+// Alliance Access adapter
+func mqmd(order binary.ByteOrder, version int, format string) []byte {
+	length := mqmdV1Length
+	if version == 2 {
+		length = mqmdV2Length
+	}
+	md := make([]byte, length)
+	copy(md, mqmdStrucID)
+	order.PutUint32(md[4:], uint32(version))
+	order.PutUint32(md[12:], 8) // datagram
+	encoding := uint32(0x111)
+	if order == binary.LittleEndian {
+		encoding = 0x222
+	}
+	order.PutUint32(md[24:], encoding)
+	order.PutUint32(md[28:], 1208)
+	copy(md[32:], field(format, 8))
+	order.PutUint32(md[40:], 5)
+	order.PutUint32(md[44:], 1)
+	copy(md[48:], "AMQ MOOVQM      \x6a\x1b\x2c\x3d\x00\x00\x00\x01")
+	order.PutUint32(md[96:], 0)
+	copy(md[100:], field("SAA.REPLY", 48))
+	copy(md[148:], field("MOOVQM", 48))
+	copy(md[196:], field("saaadm", 12))
+	copy(md[276:], field("SWIFT Alliance Access", 28))
+	copy(md[304:], "20210415")
+	copy(md[312:], "10300012")
+	if version == 2 {
+		order.PutUint32(md[348:], 1)
+	}
+	return md
+}
+
+// rfh2 returns an MQRFH2 header of folders
+func rfh2(order binary.ByteOrder, folders ...string) []byte {
+	var data []byte
+	for _, folder := range folders {
+		for len(folder)%4 != 0 {
+			folder += " "
+		}
+		size := make([]byte, 4)
+		order.PutUint32(size, uint32(len(folder)))
+		data = append(append(data, size...), folder...)
+	}
+	header := make([]byte, rfh2Length)
+	copy(header, rfh2StrucID)
+	order.PutUint32(header[4:], 2)
+	order.PutUint32(header[8:], uint32(rfh2Length+len(data)))
+	copy(header[20:], field("MQSTR", 8))
+	return append(header, data...)
+}
+
+func TestParseMQMD(t *testing.T) {
+	for _, order := range []binary.ByteOrder{binary.BigEndian, binary.LittleEndian} {
+		headers := make(map[string]string)
+		rest, encoding, err := parseMQMD(append(mqmd(order, 2, "MQSTR"), "payload"...), headers)
+		require.Nil(t, err)
+		require.Equal(t, "payload", string(rest))
+		require.Equal(t, order, encoding)
+		require.Equal(t, map[string]string{
+			HeaderMQMsgType:        "8",
+			HeaderMQFormat:         "MQSTR",
+			HeaderMQCodedCharSetID: "1208",
+			HeaderMQPriority:       "5",
+			HeaderMQPersistence:    "1",
+			HeaderMQMsgID:          "414d51204d4f4f56514d2020202020206a1b2c3d00000001",
+			HeaderMQBackoutCount:   "0",
+			HeaderMQReplyToQ:       "SAA.REPLY",
+			HeaderMQReplyToQMgr:    "MOOVQM",
+			HeaderMQUserIdentifier: "saaadm",
+			HeaderMQPutApplName:    "SWIFT Alliance Access",
+			HeaderMQPutDate:        "20210415",
+			HeaderMQPutTime:        "10300012",
+			HeaderMQMsgSeqNumber:   "1",
+		}, headers)
+	}
+
+	headers := make(map[string]string)
+	rest, _, err := parseMQMD(append(mqmd(binary.BigEndian, 1, "MQSTR"), "payload"...), headers)
+	require.Nil(t, err)
+	require.Equal(t, "payload", string(rest))
+	require.Empty(t, headers[HeaderMQMsgSeqNumber])
+
+	_, _, err = parseMQMD(mqmd(binary.BigEndian, 2, "MQSTR")[:mqmdV2Length-1], headers)
+	require.ErrorContains(t, err, "truncated")
+	_, _, err = parseMQMD(mqmd(binary.BigEndian, 3, "MQSTR"), headers)
+	require.ErrorContains(t, err, "unsupported message descriptor version 3")
+}
+
+func TestParseRFH2(t *testing.T) {
+	headers := make(map[string]string)
+	header := rfh2(binary.BigEndian, "<mcd><Msd>jms_text</Msd></mcd>", "<usr><Channel>FIN</Channel><Route><Queue>IN</Queue></Route></usr>")
+	rest, err := parseRFH2(append(header, "payload"...), binary.BigEndian, headers)
+	require.Nil(t, err)
+	require.Equal(t, "payload", string(rest))
+	require.Equal(t, map[string]string{
+		"x-mq-mcd-msd":         "jms_text",
+		"x-mq-usr-channel":     "FIN",
+		"x-mq-usr-route-queue": "IN",
+	}, headers)
+
+	_, err = parseRFH2(header[:len(header)-4], binary.BigEndian, headers)
+	require.ErrorContains(t, err, "invalid length")
+	_, err = parseRFH2(rfh2(binary.BigEndian, "<usr><Channel>"), binary.BigEndian, headers)
+	require.ErrorContains(t, err, "reading folder")
+}
@@ -0,0 +1,138 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package swift
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// dataPDUHeader is the header of an Alliance Access XML v2 DataPDU, its namespace differs by
+// revision and is ignored
+type dataPDUHeader struct {
+	Revision string `xml:"Revision"`
+	Message  struct {
+		SenderReference   string  `xml:"SenderReference"`
+		MessageIdentifier string  `xml:"MessageIdentifier"`
+		Format            string  `xml:"Format"`
+		SubFormat         string  `xml:"SubFormat"`
+		Sender            address `xml:"Sender"`
+		Receiver          address `xml:"Receiver"`
+		InterfaceInfo     struct {
+			UserReference  string `xml:"UserReference"`
+			MessageCreator string `xml:"MessageCreator"`
+			MessageContext string `xml:"MessageContext"`
+			MessageNature  string `xml:"MessageNature"`
+		} `xml:"InterfaceInfo"`
+		NetworkInfo struct {
+			Priority            string `xml:"Priority"`
+			IsPossibleDuplicate string `xml:"IsPossibleDuplicate"`
+			Service             string `xml:"Service"`
+			SWIFTNet            struct {
+				RequestType     string `xml:"RequestType"`
+				Reference       string `xml:"Reference"`
+				SnFQueueName    string `xml:"SnFQueueName"`
+				SnFInputTime    string `xml:"SnFInputTime"`
+				SnFDeliveryTime string `xml:"SnFDeliveryTime"`
+			} `xml:"SWIFTNetNetworkInfo"`
+		} `xml:"NetworkInfo"`
+	} `xml:"Header>Message"`
+}
+
+type address struct {
+	DN  string `xml:"DN"`
+	BIC string `xml:"FullName>X1"`
+}
+
+// isDataPDU reports whether the root element of data is a DataPDU
+func isDataPDU(data []byte) bool {
+	d := xml.NewDecoder(bytes.NewReader(data))
+	for {
+		tok, err := d.Token()
+		if err != nil {
+			return false
+		}
+		if start, ok := tok.(xml.StartElement); ok {
+			return start.Name.Local == "DataPDU"
+		}
+	}
+}
+
+// unwrapDataPDU returns the ISO 20022 document of the body of a DataPDU, as is, and adds the
+// routing metadata of its header to headers
+func unwrapDataPDU(data []byte, headers map[string]string) ([]byte, error) {
+	var pdu dataPDUHeader
+	if err := xml.Unmarshal(data, &pdu); err != nil {
+		return nil, fmt.Errorf("saa: reading DataPDU: %w", err)
+	}
+	m := pdu.Message
+	set(headers, HeaderSAARevision, pdu.Revision)
+	set(headers, HeaderSAASenderReference, m.SenderReference)
+	set(headers, HeaderSAAMessageIdentifier, m.MessageIdentifier)
+	set(headers, HeaderSAAFormat, m.Format)
+	set(headers, HeaderSAASubFormat, m.SubFormat)
+	set(headers, HeaderSAASender, m.Sender.BIC)
+	set(headers, HeaderSAASenderDN, m.Sender.DN)
+	set(headers, HeaderSAAReceiver, m.Receiver.BIC)
+	set(headers, HeaderSAAReceiverDN, m.Receiver.DN)
+	set(headers, HeaderSAAUserReference, m.InterfaceInfo.UserReference)
+	set(headers, HeaderSAAMessageCreator, m.InterfaceInfo.MessageCreator)
+	set(headers, HeaderSAAMessageContext, m.InterfaceInfo.MessageContext)
+	set(headers, HeaderSAAMessageNature, m.InterfaceInfo.MessageNature)
+	set(headers, HeaderSAAPriority, m.NetworkInfo.Priority)
+	set(headers, HeaderSAAPossibleDuplicate, m.NetworkInfo.IsPossibleDuplicate)
+	set(headers, HeaderSAAService, m.NetworkInfo.Service)
+	set(headers, HeaderSAARequestType, m.NetworkInfo.SWIFTNet.RequestType)
+	set(headers, HeaderSAANetworkReference, m.NetworkInfo.SWIFTNet.Reference)
+	set(headers, HeaderSAASnFQueueName, m.NetworkInfo.SWIFTNet.SnFQueueName)
+	set(headers, HeaderSAASnFInputTime, m.NetworkInfo.SWIFTNet.SnFInputTime)
+	set(headers, HeaderSAASnFDeliveryTime, m.NetworkInfo.SWIFTNet.SnFDeliveryTime)
+
+	return bodyDocument(data)
+}
+
+// bodyDocument returns the bytes of the Document element of the Body of a DataPDU, the
+// business application header preceding it is skipped
+func bodyDocument(data []byte) ([]byte, error) {
+	d := xml.NewDecoder(bytes.NewReader(data))
+	depth := 0
+	inBody := false
+	for {
+		offset := d.InputOffset()
+		tok, err := d.Token()
+		if errors.Is(err, io.EOF) {
+			return nil, errors.New("saa: DataPDU has no document")
+		}
+		if err != nil {
+			return nil, fmt.Errorf("saa: reading DataPDU: %w", err)
+		}
+		switch t := tok.(type) {
+		case xml.StartElement:
+			depth++
+			switch {
+			case depth == 2 && t.Name.Local == "Body":
+				inBody = true
+			case inBody && depth == 3 && t.Name.Local == "Document":
+				if err := d.Skip(); err != nil {
+					return nil, fmt.Errorf("saa: reading document: %w", err)
+				}
+				document := data[offset:d.InputOffset()]
+				if !strings.HasPrefix(t.Name.Space, "urn:iso:std:iso:20022:") {
+					return nil, fmt.Errorf("saa: document of namespace %q isn't an ISO 20022 message", t.Name.Space)
+				}
+				return bytes.TrimSpace(document), nil
+			}
+		case xml.EndElement:
+			if depth == 2 {
+				inBody = false
+			}
+			depth--
+		}
+	}
+}
@@ -0,0 +1,149 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package swift
+
+/*
+	Swift keeps the transport headers of the messages consumed from SWIFT interfaces, the
+	routing metadata of Alliance Access (SAA) DataPDU envelopes and the fields of the
+	message descriptors (MQMD) and rules and formatting headers (MQRFH2) of IBM MQ:
+
+		p := pipeline.New(swift.Source(pipeline.Dir("inbox")), pipeline.Output(pipeline.Layout{
+			Dir:          "out",
+			NameTemplate: "{header:x-saa-sender-reference}{ext}",
+		}))
+
+	Source unwraps the messages of a source into their ISO 20022 document and adds the
+	transport headers to the headers of their item, which flow through the pipeline into its
+	sinks, dead letters included. Messages without envelopes are emitted as is.
+
+	The text fields of MQ headers are read as ASCII, messages of EBCDIC queue managers must be
+	converted by the channel or the consumer.
+*/
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"strings"
+
+	"github.com/moov-io/iso20022/pkg/pipeline"
+)
+
+const (
+	// Headers of the routing metadata of DataPDU envelopes
+	HeaderSAARevision          = "x-saa-revision"
+	HeaderSAASenderReference   = "x-saa-sender-reference"
+	HeaderSAAMessageIdentifier = "x-saa-message-identifier"
+	HeaderSAAFormat            = "x-saa-format"
+	HeaderSAASubFormat         = "x-saa-sub-format"
+	HeaderSAASender            = "x-saa-sender"
+	HeaderSAASenderDN          = "x-saa-sender-dn"
+	HeaderSAAReceiver          = "x-saa-receiver"
+	HeaderSAAReceiverDN        = "x-saa-receiver-dn"
+	HeaderSAAUserReference     = "x-saa-user-reference"
+	HeaderSAAMessageCreator    = "x-saa-message-creator"
+	HeaderSAAMessageContext    = "x-saa-message-context"
+	HeaderSAAMessageNature     = "x-saa-message-nature"
+	HeaderSAAPriority          = "x-saa-priority"
+	HeaderSAAPossibleDuplicate = "x-saa-possible-duplicate"
+	HeaderSAAService           = "x-saa-service"
+	HeaderSAARequestType       = "x-saa-request-type"
+	HeaderSAANetworkReference  = "x-saa-network-reference"
+	HeaderSAASnFQueueName      = "x-saa-snf-queue-name"
+	HeaderSAASnFInputTime      = "x-saa-snf-input-time"
+	HeaderSAASnFDeliveryTime   = "x-saa-snf-delivery-time"
+
+	// Headers of the fields of MQ message descriptors, ids are in hex. The elements of the
+	// folders of MQRFH2 headers are the headers x-mq-<folder>-<element>, e.g. x-mq-usr-channel.
+	HeaderMQMsgType          = "x-mq-msg-type"
+	HeaderMQFormat           = "x-mq-format"
+	HeaderMQCodedCharSetID   = "x-mq-coded-char-set-id"
+	HeaderMQPriority         = "x-mq-priority"
+	HeaderMQPersistence      = "x-mq-persistence"
+	HeaderMQMsgID            = "x-mq-msg-id"
+	HeaderMQCorrelID         = "x-mq-correl-id"
+	HeaderMQBackoutCount     = "x-mq-backout-count"
+	HeaderMQReplyToQ         = "x-mq-reply-to-q"
+	HeaderMQReplyToQMgr      = "x-mq-reply-to-q-mgr"
+	HeaderMQUserIdentifier   = "x-mq-user-identifier"
+	HeaderMQApplIdentityData = "x-mq-appl-identity-data"
+	HeaderMQPutApplName      = "x-mq-put-appl-name"
+	HeaderMQPutDate          = "x-mq-put-date"
+	HeaderMQPutTime          = "x-mq-put-time"
+	HeaderMQGroupID          = "x-mq-group-id"
+	HeaderMQMsgSeqNumber     = "x-mq-msg-seq-number"
+
+	maxNesting = 4
+)
+
+func set(headers map[string]string, key, value string) {
+	if value = strings.TrimSpace(value); value != "" {
+		headers[key] = value
+	}
+}
+
+// Unwrap returns the ISO 20022 document of a message without its MQ headers and DataPDU
+// envelope and their transport headers. Messages without them are returned as is.
+func Unwrap(message []byte) ([]byte, map[string]string, error) {
+	headers := make(map[string]string)
+	data := message
+	order := binary.ByteOrder(binary.BigEndian)
+	for depth := 0; depth < maxNesting; depth++ {
+		var err error
+		switch {
+		case bytes.HasPrefix(data, mqmdStrucID):
+			data, order, err = parseMQMD(data, headers)
+		case bytes.HasPrefix(data, rfh2StrucID):
+			data, err = parseRFH2(data, order, headers)
+		case isDataPDU(data):
+			data, err = unwrapDataPDU(data, headers)
+			if err != nil {
+				return nil, nil, err
+			}
+			return data, headers, nil
+		default:
+			return data, headers, nil
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	return nil, nil, fmt.Errorf("swift: message has more than %d headers", maxNesting)
+}
+
+// Headers returns the transport headers of item, the x-saa- and x-mq- headers
+func Headers(item pipeline.Item) map[string]string {
+	headers := make(map[string]string)
+	for key, value := range item.Headers {
+		if strings.HasPrefix(key, "x-saa-") || strings.HasPrefix(key, "x-mq-") {
+			headers[key] = value
+		}
+	}
+	return headers
+}
+
+// Source unwraps the messages of source, see Unwrap, adding their transport headers to the
+// headers of their items. Headers given by source are kept and messages which fail to unwrap
+// are emitted as is, failing the parse stage of the pipeline.
+func Source(source pipeline.Source) pipeline.Source {
+	return func(ctx context.Context, emit func(pipeline.Item) error) error {
+		return source(ctx, func(item pipeline.Item) error {
+			input, transport, err := Unwrap(item.Input)
+			if err != nil || len(transport) == 0 {
+				return emit(item)
+			}
+			headers := make(map[string]string, len(item.Headers)+len(transport))
+			for key, value := range transport {
+				headers[key] = value
+			}
+			for key, value := range item.Headers {
+				headers[key] = value
+			}
+			item.Input, item.Headers = input, headers
+			return emit(item)
+		})
+	}
+}
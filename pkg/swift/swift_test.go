@@ -0,0 +1,150 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package swift
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/moov-io/iso20022/pkg/deadletter"
+	"github.com/moov-io/iso20022/pkg/iso20022test/fixtures"
+	"github.com/moov-io/iso20022/pkg/pipeline"
+	"github.com/moov-io/iso20022/pkg/storage"
+)
+
+// dataPDU wraps document in the DataPDU of Alliance Access, after a business application header
+func dataPDU(document []byte) []byte {
+	return []byte(`<?xml version="1.0" encoding="UTF-8"?>
+<Saa:DataPDU xmlns:Saa="urn:swift:saa:xsd:saa.2.0" xmlns:Sw="urn:swift:snl:ns.Sw">
+	<Saa:Revision>2.0.13</Saa:Revision>
+	<Saa:Header>
+		<Saa:Message>
+			<Saa:SenderReference>SAA-REF-0001</Saa:SenderReference>
+			<Saa:MessageIdentifier>pacs.008.001.08</Saa:MessageIdentifier>
+			<Saa:Format>MX</Saa:Format>
+			<Saa:SubFormat>Output</Saa:SubFormat>
+			<Saa:Sender>
+				<Saa:DN>ou=xxx,o=bankus33,o=swift</Saa:DN>
+				<Saa:FullName><Saa:X1>BANKUS33XXX</Saa:X1></Saa:FullName>
+			</Saa:Sender>
+			<Saa:Receiver>
+				<Saa:DN>ou=xxx,o=moovus33,o=swift</Saa:DN>
+				<Saa:FullName><Saa:X1>MOOVUS33XXX</Saa:X1></Saa:FullName>
+			</Saa:Receiver>
+			<Saa:InterfaceInfo>
+				<Saa:UserReference>USER-REF-1</Saa:UserReference>
+				<Saa:MessageContext>Original</Saa:MessageContext>
+				<Saa:MessageNature>Financial</Saa:MessageNature>
+			</Saa:InterfaceInfo>
+			<Saa:NetworkInfo>
+				<Saa:Priority>Normal</Saa:Priority>
+				<Saa:IsPossibleDuplicate>false</Saa:IsPossibleDuplicate>
+				<Saa:Service>swift.finplus</Saa:Service>
+				<Saa:SWIFTNetNetworkInfo>
+					<Saa:RequestType>pacs.008.001.08</Saa:RequestType>
+					<Saa:Reference>b6f7a3c2-0d6e-4a34-9d2e-5a53b2c0a001</Saa:Reference>
+					<Saa:SnFQueueName>moovus33_generic!x</Saa:SnFQueueName>
+				</Saa:SWIFTNetNetworkInfo>
+			</Saa:NetworkInfo>
+		</Saa:Message>
+	</Saa:Header>
+	<Saa:Body>
+		<AppHdr xmlns="urn:iso:std:iso:20022:tech:xsd:head.001.001.02"><BizMsgIdr>MSG-20210415-0001</BizMsgIdr></AppHdr>
+		` + string(document) + `
+	</Saa:Body>
+</Saa:DataPDU>`)
+}
+
+func TestUnwrap(t *testing.T) {
+	document := fixtures.ReadFile(t, "valid_pacs_v08.xml")
+	out, headers, err := Unwrap(dataPDU(document))
+	require.Nil(t, err)
+	require.Equal(t, string(bytes.TrimSpace(document)), string(out))
+	require.Equal(t, map[string]string{
+		HeaderSAARevision:          "2.0.13",
+		HeaderSAASenderReference:   "SAA-REF-0001",
+		HeaderSAAMessageIdentifier: "pacs.008.001.08",
+		HeaderSAAFormat:            "MX",
+		HeaderSAASubFormat:         "Output",
+		HeaderSAASender:            "BANKUS33XXX",
+		HeaderSAASenderDN:          "ou=xxx,o=bankus33,o=swift",
+		HeaderSAAReceiver:          "MOOVUS33XXX",
+		HeaderSAAReceiverDN:        "ou=xxx,o=moovus33,o=swift",
+		HeaderSAAUserReference:     "USER-REF-1",
+		HeaderSAAMessageContext:    "Original",
+		HeaderSAAMessageNature:     "Financial",
+		HeaderSAAPriority:          "Normal",
+		HeaderSAAPossibleDuplicate: "false",
+		HeaderSAAService:           "swift.finplus",
+		HeaderSAARequestType:       "pacs.008.001.08",
+		HeaderSAANetworkReference:  "b6f7a3c2-0d6e-4a34-9d2e-5a53b2c0a001",
+		HeaderSAASnFQueueName:      "moovus33_generic!x",
+	}, headers)
+
+	// an MQ message of a DataPDU
+	message := append(mqmd(binary.LittleEndian, 2, "MQHRF2"), rfh2(binary.LittleEndian, "<usr><Channel>FIN</Channel></usr>")...)
+	out, headers, err = Unwrap(append(message, dataPDU(document)...))
+	require.Nil(t, err)
+	require.Equal(t, string(bytes.TrimSpace(document)), string(out))
+	require.Equal(t, "SAA-REF-0001", headers[HeaderSAASenderReference])
+	require.Equal(t, "414d51204d4f4f56514d2020202020206a1b2c3d00000001", headers[HeaderMQMsgID])
+	require.Equal(t, "FIN", headers["x-mq-usr-channel"])
+
+	// messages without envelopes
+	out, headers, err = Unwrap(document)
+	require.Nil(t, err)
+	require.Equal(t, document, out)
+	require.Empty(t, headers)
+
+	_, _, err = Unwrap(dataPDU([]byte(`<Document xmlns="urn:other"/>`)))
+	require.ErrorContains(t, err, "isn't an ISO 20022 message")
+	_, _, err = Unwrap(dataPDU(nil))
+	require.ErrorContains(t, err, "DataPDU has no document")
+}
+
+func TestSource(t *testing.T) {
+	document := fixtures.ReadFile(t, "valid_pacs_v08.xml")
+	source := func(ctx context.Context, emit func(pipeline.Item) error) error {
+		if err := emit(pipeline.Item{Name: "wrapped.xml", Input: dataPDU(document), Headers: map[string]string{HeaderSAAService: "source", "x-queue": "saa"}}); err != nil {
+			return err
+		}
+		if err := emit(pipeline.Item{Name: "plain.xml", Input: document}); err != nil {
+			return err
+		}
+		return emit(pipeline.Item{Name: "broken.xml", Input: dataPDU(nil)})
+	}
+
+	var mu sync.Mutex
+	items := make(map[string]pipeline.Item)
+	collect := func(_ context.Context, item pipeline.Item) error {
+		mu.Lock()
+		defer mu.Unlock()
+		items[item.Name] = item
+		return nil
+	}
+	queue := deadletter.NewQueue(storage.NewMemoryStore())
+	stats, err := pipeline.New(Source(source), collect, pipeline.WithErrorSink(queue.Sink())).Run(context.Background())
+	require.Nil(t, err)
+	require.Equal(t, int64(2), stats.Succeeded)
+
+	wrapped := items["wrapped.xml"]
+	require.NotNil(t, wrapped.Document)
+	require.Equal(t, "SAA-REF-0001", wrapped.Headers[HeaderSAASenderReference])
+	require.Equal(t, "source", wrapped.Headers[HeaderSAAService])
+	require.Equal(t, "saa", wrapped.Headers["x-queue"])
+	require.Len(t, Headers(wrapped), 18)
+	require.Empty(t, Headers(items["plain.xml"]))
+
+	// messages failing to unwrap reach the dead letters as is
+	letters, err := queue.List(context.Background())
+	require.Nil(t, err)
+	require.Len(t, letters, 1)
+	require.Equal(t, dataPDU(nil), letters[0].Input)
+}
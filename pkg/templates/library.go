@@ -0,0 +1,207 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package templates
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"gopkg.in/yaml.v3"
+)
+
+const templateExt = ".yaml"
+
+// ErrNotFound is returned when no template is kept with a name
+var ErrNotFound = errors.New("template not found")
+
+// Library keeps templates by name
+type Library interface {
+	// Put validates and keeps t, replacing the template with the same name
+	Put(ctx context.Context, t Template) error
+
+	// Get returns the template with name or ErrNotFound
+	Get(ctx context.Context, name string) (*Template, error)
+
+	// Delete removes the template with name, ErrNotFound when it isn't kept
+	Delete(ctx context.Context, name string) error
+
+	// List returns every template ordered by name
+	List(ctx context.Context) ([]Template, error)
+}
+
+func checkName(name string) error {
+	if !validName.MatchString(name) {
+		return fmt.Errorf("%w: %q", ErrNotFound, name)
+	}
+	return nil
+}
+
+// MemoryLibrary keeps templates in memory
+type MemoryLibrary struct {
+	mu        sync.RWMutex
+	templates map[string]Template
+}
+
+// NewMemoryLibrary returns an empty memory library
+func NewMemoryLibrary() *MemoryLibrary {
+	return &MemoryLibrary{templates: make(map[string]Template)}
+}
+
+func (l *MemoryLibrary) Put(_ context.Context, t Template) error {
+	if err := t.Validate(); err != nil {
+		return err
+	}
+	t.Variables = append([]Variable(nil), t.Variables...)
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.templates[t.Name] = t
+	return nil
+}
+
+func (l *MemoryLibrary) Get(_ context.Context, name string) (*Template, error) {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	t, ok := l.templates[name]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrNotFound, name)
+	}
+	t.Variables = append([]Variable(nil), t.Variables...)
+	return &t, nil
+}
+
+func (l *MemoryLibrary) Delete(_ context.Context, name string) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if _, ok := l.templates[name]; !ok {
+		return fmt.Errorf("%w: %s", ErrNotFound, name)
+	}
+	delete(l.templates, name)
+	return nil
+}
+
+func (l *MemoryLibrary) List(_ context.Context) ([]Template, error) {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	templates := make([]Template, 0, len(l.templates))
+	for _, t := range l.templates {
+		t.Variables = append([]Variable(nil), t.Variables...)
+		templates = append(templates, t)
+	}
+	sortTemplates(templates)
+	return templates, nil
+}
+
+// DirLibrary keeps every template as a yaml file of a directory, so templates can be
+// versioned and reviewed with the other configuration files
+type DirLibrary struct {
+	dir string
+}
+
+// NewDirLibrary returns a library of dir, creating it when missing
+func NewDirLibrary(dir string) (*DirLibrary, error) {
+	if err := os.MkdirAll(dir, 0750); err != nil {
+		return nil, err
+	}
+	return &DirLibrary{dir: dir}, nil
+}
+
+func (l *DirLibrary) path(name string) string {
+	return filepath.Join(l.dir, name+templateExt)
+}
+
+func (l *DirLibrary) Put(_ context.Context, t Template) error {
+	if err := t.Validate(); err != nil {
+		return err
+	}
+	buf, err := yaml.Marshal(t)
+	if err != nil {
+		return err
+	}
+
+	// templates are replaced atomically
+	tmp, err := os.CreateTemp(l.dir, ".template-")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err = tmp.Write(buf); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err = tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), l.path(t.Name))
+}
+
+func (l *DirLibrary) Get(_ context.Context, name string) (*Template, error) {
+	if err := checkName(name); err != nil {
+		return nil, err
+	}
+	return l.read(l.path(name), name)
+}
+
+func (l *DirLibrary) read(path, name string) (*Template, error) {
+	t, err := Load(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("%w: %s", ErrNotFound, name)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if t.Name != name {
+		return nil, fmt.Errorf("%s: %w: name %s isn't the file name", path, ErrInvalidTemplate, t.Name)
+	}
+	return t, nil
+}
+
+func (l *DirLibrary) Delete(_ context.Context, name string) error {
+	if err := checkName(name); err != nil {
+		return err
+	}
+	err := os.Remove(l.path(name))
+	if errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("%w: %s", ErrNotFound, name)
+	}
+	return err
+}
+
+func (l *DirLibrary) List(_ context.Context) ([]Template, error) {
+	entries, err := os.ReadDir(l.dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var templates []Template
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || strings.HasPrefix(name, ".") || filepath.Ext(name) != templateExt {
+			continue
+		}
+		t, err := l.read(filepath.Join(l.dir, name), strings.TrimSuffix(name, templateExt))
+		if errors.Is(err, ErrNotFound) {
+			// removed while listing
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		templates = append(templates, *t)
+	}
+	sortTemplates(templates)
+	return templates, nil
+}
+
+func sortTemplates(templates []Template) {
+	sort.Slice(templates, func(i, j int) bool { return templates[i].Name < templates[j].Name })
+}
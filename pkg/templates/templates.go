@@ -0,0 +1,286 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package templates
+
+/*
+	Templates are named skeletons of recurring messages, e.g. the monthly payroll, whose
+	variable values are ${name} placeholders of the xml or json content:
+
+		name: payroll
+		description: monthly payroll of the head office
+		variables:
+		  - name: month
+		    required: true
+		  - name: amount
+		    default: "250000"
+		content: |
+		  <Document xmlns="urn:iso:std:iso:20022:tech:xsd:pacs.008.001.08">
+		    ... <MsgId>PAYROLL-${month}</MsgId><CreDtTm>${now}</CreDtTm> ...
+		  </Document>
+
+	Instantiating a template replaces its placeholders by the given values, escaped for the
+	format of the content, then parses and validates the message. The built-in variables
+	now (ISO date time), today (ISO date) and id (a unique identifier) are given a fresh
+	value by every instantiation.
+*/
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/moov-io/iso20022/pkg/document"
+	"github.com/moov-io/iso20022/pkg/utils"
+)
+
+const (
+	// Built-in variables
+	VariableNow   = "now"
+	VariableToday = "today"
+	VariableID    = "id"
+)
+
+var (
+	// ErrInvalidTemplate is returned for templates that can't be instantiated
+	ErrInvalidTemplate = errors.New("invalid template")
+
+	// ErrVariables is returned when the values of an instantiation don't match the variables of the template
+	ErrVariables = errors.New("invalid template variables")
+
+	validName   = regexp.MustCompile(`^[A-Za-z0-9_-]{1,128}$`)
+	placeholder = regexp.MustCompile(`\$\{([A-Za-z_][A-Za-z0-9_.-]*)\}`)
+
+	builtins = []string{VariableNow, VariableToday, VariableID}
+)
+
+// Variable is a value of the instantiations of a template
+type Variable struct {
+	Name        string `yaml:"name" json:"name"`
+	Description string `yaml:"description,omitempty" json:"description,omitempty"`
+
+	// Required variables must be given a value, the Default is used otherwise
+	Required bool   `yaml:"required,omitempty" json:"required,omitempty"`
+	Default  string `yaml:"default,omitempty" json:"default,omitempty"`
+}
+
+// Template is a named message skeleton
+type Template struct {
+	Name        string     `yaml:"name" json:"name"`
+	Description string     `yaml:"description,omitempty" json:"description,omitempty"`
+	Variables   []Variable `yaml:"variables,omitempty" json:"variables,omitempty"`
+
+	// Content is the xml or json message with ${name} placeholders
+	Content string `yaml:"content" json:"content"`
+
+	// MessageType of the content, set by Validate
+	MessageType string `yaml:"messageType,omitempty" json:"messageType,omitempty"`
+}
+
+// Parse reads and validates a template from yaml or json
+func Parse(buf []byte) (*Template, error) {
+	t, err := Unmarshal(buf)
+	if err != nil {
+		return nil, err
+	}
+	if err = t.Validate(); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// Unmarshal reads a template from yaml or json without validating it
+func Unmarshal(buf []byte) (*Template, error) {
+	var t Template
+	if err := yaml.Unmarshal(buf, &t); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidTemplate, err)
+	}
+	return &t, nil
+}
+
+// Load reads a template from a yaml or json file
+func Load(path string) (*Template, error) {
+	buf, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	t, err := Parse(buf)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return t, nil
+}
+
+// Validate checks the name of t, that its placeholders are declared variables or built-in
+// ones and that its content is an iso20022 message once instantiated with the defaults of
+// its variables, setting the message type of t
+func (t *Template) Validate() error {
+	if !validName.MatchString(t.Name) {
+		return fmt.Errorf("%w: name %q isn't letters, digits, - and _", ErrInvalidTemplate, t.Name)
+	}
+	declared := make(map[string]bool)
+	for _, v := range t.Variables {
+		switch {
+		case v.Name == "":
+			return fmt.Errorf("%w: variable name is omitted", ErrInvalidTemplate)
+		case declared[v.Name]:
+			return fmt.Errorf("%w: variable %s is duplicated", ErrInvalidTemplate, v.Name)
+		case isBuiltin(v.Name):
+			return fmt.Errorf("%w: variable %s is built-in", ErrInvalidTemplate, v.Name)
+		}
+		declared[v.Name] = true
+	}
+	for _, name := range t.Placeholders() {
+		if !declared[name] && !isBuiltin(name) {
+			return fmt.Errorf("%w: placeholder ${%s} isn't a variable", ErrInvalidTemplate, name)
+		}
+	}
+
+	format := utils.GetDocumentFormat([]byte(t.Content))
+	if format != utils.DocumentTypeXml && format != utils.DocumentTypeJson {
+		return fmt.Errorf("%w: content isn't an xml or json message", ErrInvalidTemplate)
+	}
+	// required variables get a value of their placeholder to check the structure
+	values := make(map[string]string)
+	for _, v := range t.Variables {
+		if v.Required && v.Default == "" {
+			values[v.Name] = v.Name
+		}
+	}
+	content, err := t.render(values)
+	if err != nil {
+		return err
+	}
+	doc, err := document.ParseIso20022Document(content)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidTemplate, err)
+	}
+	t.MessageType = utils.GetMessageType(doc.NameSpace())
+	return nil
+}
+
+// Placeholders returns the names of the placeholders of the content of t in order of appearance
+func (t *Template) Placeholders() []string {
+	var names []string
+	seen := make(map[string]bool)
+	for _, match := range placeholder.FindAllStringSubmatch(t.Content, -1) {
+		if !seen[match[1]] {
+			seen[match[1]] = true
+			names = append(names, match[1])
+		}
+	}
+	return names
+}
+
+// Render returns the content of t with the values of its variables, the defaults of variables
+// without value and fresh values of the built-in variables. Values of undeclared variables
+// and required variables without value fail.
+func (t *Template) Render(values map[string]string) ([]byte, error) {
+	declared := make(map[string]bool)
+	var missing []string
+	for _, v := range t.Variables {
+		declared[v.Name] = true
+		if _, exists := values[v.Name]; !exists && v.Required && v.Default == "" {
+			missing = append(missing, v.Name)
+		}
+	}
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("%w: %s required", ErrVariables, strings.Join(missing, ", "))
+	}
+	var unknown []string
+	for name := range values {
+		if !declared[name] {
+			unknown = append(unknown, name)
+		}
+	}
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+		return nil, fmt.Errorf("%w: %s undeclared", ErrVariables, strings.Join(unknown, ", "))
+	}
+	return t.render(values)
+}
+
+func (t *Template) render(values map[string]string) ([]byte, error) {
+	now := time.Now().UTC()
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		return nil, err
+	}
+	all := map[string]string{
+		VariableNow:   now.Format("2006-01-02T15:04:05"),
+		VariableToday: now.Format("2006-01-02"),
+		VariableID:    hex.EncodeToString(id),
+	}
+	for _, v := range t.Variables {
+		all[v.Name] = v.Default
+	}
+	for name, value := range values {
+		all[name] = value
+	}
+
+	escape := escapeXML
+	if utils.GetDocumentFormat([]byte(t.Content)) == utils.DocumentTypeJson {
+		escape = escapeJSON
+	}
+	var failure error
+	content := placeholder.ReplaceAllStringFunc(t.Content, func(match string) string {
+		value, err := escape(all[match[2:len(match)-1]])
+		if err != nil && failure == nil {
+			failure = err
+		}
+		return value
+	})
+	return []byte(content), failure
+}
+
+// Instantiate returns the message of t with values, see Render, failing unless it's valid
+func (t *Template) Instantiate(values map[string]string) (document.Iso20022Document, []byte, error) {
+	content, err := t.Render(values)
+	if err != nil {
+		return nil, nil, err
+	}
+	doc, err := document.ParseIso20022Document(content)
+	if err != nil {
+		return nil, content, err
+	}
+	if err = doc.Validate(); err != nil {
+		return doc, content, err
+	}
+	return doc, content, nil
+}
+
+func isBuiltin(name string) bool {
+	for _, builtin := range builtins {
+		if name == builtin {
+			return true
+		}
+	}
+	return false
+}
+
+func escapeXML(value string) (string, error) {
+	var buf bytes.Buffer
+	err := xml.EscapeText(&buf, []byte(value))
+	return buf.String(), err
+}
+
+// escapeJSON escapes value for a json string, placeholders are within quotes
+func escapeJSON(value string) (string, error) {
+	buf, err := json.Marshal(value)
+	if err != nil {
+		return "", err
+	}
+	return string(buf[1 : len(buf)-1]), nil
+}
@@ -0,0 +1,155 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package templates
+
+import (
+	"context"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/moov-io/iso20022/pkg/iso20022test/fixtures"
+	"github.com/moov-io/iso20022/pkg/utils"
+)
+
+// payroll returns a template of valid_pacs_v08.xml with the message id, creation time and
+// amount of its first transaction as placeholders
+func payroll(t *testing.T) Template {
+	t.Helper()
+	buf := fixtures.ReadFile(t, "valid_pacs_v08.xml")
+	content := strings.NewReplacer(
+		"MSG-20210415-0001", "PAYROLL-${month}",
+		"2021-04-15T10:30:00", "${now}",
+		`<IntrBkSttlmAmt Ccy="USD">250000</IntrBkSttlmAmt>`, `<IntrBkSttlmAmt Ccy="USD">${amount}</IntrBkSttlmAmt>`,
+	).Replace(string(buf))
+	return Template{
+		Name:        "payroll",
+		Description: "monthly payroll",
+		Variables: []Variable{
+			{Name: "month", Required: true},
+			{Name: "amount", Default: "250000"},
+		},
+		Content: content,
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tmpl := payroll(t)
+	require.Nil(t, tmpl.Validate())
+	require.Equal(t, "pacs.008.001.08", tmpl.MessageType)
+	require.Equal(t, []string{"month", "now", "amount"}, tmpl.Placeholders())
+
+	for _, tc := range []struct {
+		change func(*Template)
+		err    string
+	}{
+		{func(t *Template) { t.Name = "pay roll" }, `name "pay roll" isn't letters`},
+		{func(t *Template) { t.Variables = append(t.Variables, Variable{}) }, "variable name is omitted"},
+		{func(t *Template) { t.Variables = append(t.Variables, Variable{Name: "month"}) }, "variable month is duplicated"},
+		{func(t *Template) { t.Variables = append(t.Variables, Variable{Name: "today"}) }, "variable today is built-in"},
+		{func(t *Template) { t.Variables = t.Variables[:1] }, "placeholder ${amount} isn't a variable"},
+		{func(t *Template) { t.Content = "payroll of ${month}" }, "content isn't an xml or json message"},
+		{func(t *Template) { t.Content = "<Document>${month}</Document>" }, "invalid template"},
+	} {
+		tmpl := payroll(t)
+		tc.change(&tmpl)
+		err := tmpl.Validate()
+		require.ErrorIs(t, err, ErrInvalidTemplate)
+		require.ErrorContains(t, err, tc.err)
+	}
+}
+
+func TestInstantiate(t *testing.T) {
+	tmpl := payroll(t)
+
+	doc, content, err := tmpl.Instantiate(map[string]string{"month": "2026-10"})
+	require.Nil(t, err)
+	require.NotNil(t, doc)
+	require.Contains(t, string(content), "<MsgId>PAYROLL-2026-10</MsgId>")
+	require.Contains(t, string(content), `<IntrBkSttlmAmt Ccy="USD">250000</IntrBkSttlmAmt>`)
+	require.NotContains(t, string(content), "${")
+
+	// values are escaped
+	_, content, err = tmpl.Instantiate(map[string]string{"month": "10&11", "amount": "1200.50"})
+	require.Nil(t, err)
+	require.Contains(t, string(content), "<MsgId>PAYROLL-10&amp;11</MsgId>")
+	require.Contains(t, string(content), `<IntrBkSttlmAmt Ccy="USD">1200.50</IntrBkSttlmAmt>`)
+
+	// instances are validated
+	_, content, err = tmpl.Instantiate(map[string]string{"month": "2026-10", "amount": "abc"})
+	require.Error(t, err)
+	require.Contains(t, string(content), `<IntrBkSttlmAmt Ccy="USD">abc</IntrBkSttlmAmt>`)
+
+	_, _, err = tmpl.Instantiate(nil)
+	require.ErrorIs(t, err, ErrVariables)
+	require.EqualError(t, err, "invalid template variables: month required")
+	_, _, err = tmpl.Instantiate(map[string]string{"month": "2026-10", "year": "2026", "day": "1"})
+	require.EqualError(t, err, "invalid template variables: day, year undeclared")
+}
+
+func TestRenderJSON(t *testing.T) {
+	tmpl := Template{
+		Name:      "note",
+		Variables: []Variable{{Name: "note"}},
+		Content:   `{"note": "${note}", "id": "${id}", "date": "${today}"}`,
+	}
+	content, err := tmpl.Render(map[string]string{"note": `say "hi"`})
+	require.Nil(t, err)
+	require.Equal(t, utils.DocumentTypeJson, utils.GetDocumentFormat(content))
+	require.Contains(t, string(content), `"note": "say \"hi\""`)
+	require.NotContains(t, string(content), "${")
+}
+
+func TestParse(t *testing.T) {
+	_, err := Parse([]byte("name: ["))
+	require.ErrorIs(t, err, ErrInvalidTemplate)
+	_, err = Parse([]byte("name: empty\ncontent: x"))
+	require.ErrorContains(t, err, "content isn't an xml or json message")
+
+	_, err = Load(filepath.Join("testdata", "missing.yaml"))
+	require.Error(t, err)
+}
+
+func TestLibraries(t *testing.T) {
+	ctx := context.Background()
+	dir, err := NewDirLibrary(filepath.Join(t.TempDir(), "templates"))
+	require.Nil(t, err)
+
+	for _, library := range []Library{NewMemoryLibrary(), dir} {
+		_, err := library.Get(ctx, "payroll")
+		require.ErrorIs(t, err, ErrNotFound)
+		require.ErrorIs(t, library.Delete(ctx, "payroll"), ErrNotFound)
+		_, err = library.Get(ctx, "../payroll")
+		require.ErrorIs(t, err, ErrNotFound)
+
+		invalid := payroll(t)
+		invalid.Variables = nil
+		require.ErrorIs(t, library.Put(ctx, invalid), ErrInvalidTemplate)
+
+		require.Nil(t, library.Put(ctx, payroll(t)))
+		other := payroll(t)
+		other.Name = "bonus"
+		require.Nil(t, library.Put(ctx, other))
+
+		tmpl, err := library.Get(ctx, "payroll")
+		require.Nil(t, err)
+		require.Equal(t, "pacs.008.001.08", tmpl.MessageType)
+		require.Equal(t, payroll(t).Content, tmpl.Content)
+		_, _, err = tmpl.Instantiate(map[string]string{"month": "2026-10"})
+		require.Nil(t, err)
+
+		templates, err := library.List(ctx)
+		require.Nil(t, err)
+		require.Len(t, templates, 2)
+		require.Equal(t, "bonus", templates[0].Name)
+
+		require.Nil(t, library.Delete(ctx, "bonus"))
+		templates, err = library.List(ctx)
+		require.Nil(t, err)
+		require.Len(t, templates, 1)
+	}
+}
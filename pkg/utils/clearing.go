@@ -0,0 +1,57 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package utils
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// Codes of the clearing systems (ExternalClearingSystemIdentification1Code) whose member
+// identifications have a national format
+const (
+	ClearingSystemUSABA = "USABA"
+	ClearingSystemGBDSC = "GBDSC"
+	ClearingSystemDEBLZ = "DEBLZ"
+	ClearingSystemAUBSB = "AUBSB"
+	ClearingSystemINFSC = "INFSC"
+)
+
+var (
+	// ClearingSystemMemberFormats validate the member identifications of clearing systems by code
+	ClearingSystemMemberFormats = map[string]func(memberId string) bool{
+		ClearingSystemUSABA: validABARoutingNumber,
+		ClearingSystemGBDSC: regexp.MustCompile(`^[0-9]{6}$`).MatchString,
+		ClearingSystemDEBLZ: regexp.MustCompile(`^[1-8][0-9]{7}$`).MatchString,
+		ClearingSystemAUBSB: regexp.MustCompile(`^[0-9]{6}$`).MatchString,
+		ClearingSystemINFSC: regexp.MustCompile(`^[A-Z]{4}0[A-Z0-9]{6}$`).MatchString,
+	}
+
+	abaRoutingNumber = regexp.MustCompile(`^(0[0-9]|1[0-2]|2[1-9]|3[0-2]|6[1-9]|7[0-2]|80)[0-9]{7}$`)
+)
+
+// ValidateClearingSystemMemberId checks memberId against the national format of the clearing
+// system, e.g. the checksum of US ABA routing numbers. Member identifications of clearing
+// systems without a known format are valid.
+func ValidateClearingSystemMemberId(system, memberId string) error {
+	valid, known := ClearingSystemMemberFormats[system]
+	if !known || valid(memberId) {
+		return nil
+	}
+	return fmt.Errorf("The value %s is not a valid %s clearing system member identification", memberId, system)
+}
+
+// validABARoutingNumber checks the prefix and the 3-7-1 weighted checksum of a routing number
+func validABARoutingNumber(number string) bool {
+	if !abaRoutingNumber.MatchString(number) {
+		return false
+	}
+	weights := []int{3, 7, 1}
+	sum := 0
+	for i, c := range number {
+		sum += int(c-'0') * weights[i%3]
+	}
+	return sum%10 == 0
+}
@@ -0,0 +1,43 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateClearingSystemMemberId(t *testing.T) {
+	valid := map[string][]string{
+		ClearingSystemUSABA: {"021000021", "011000015", "322271627"},
+		ClearingSystemGBDSC: {"200000"},
+		ClearingSystemDEBLZ: {"37040044", "10010010"},
+		ClearingSystemAUBSB: {"062000"},
+		ClearingSystemINFSC: {"SBIN0001234", "HDFC0ABC123"},
+		"CHBCC":             {"anything"},
+	}
+	for system, ids := range valid {
+		for _, id := range ids {
+			require.Nil(t, ValidateClearingSystemMemberId(system, id), "%s %s", system, id)
+		}
+	}
+
+	invalid := map[string][]string{
+		// a typo breaks the checksum, 13 isn't a federal reserve prefix
+		ClearingSystemUSABA: {"021000012", "02100002", "131000010"},
+		ClearingSystemGBDSC: {"20-00-00", "2000000"},
+		ClearingSystemDEBLZ: {"07040044", "3704004"},
+		ClearingSystemAUBSB: {"06200"},
+		ClearingSystemINFSC: {"SBIN1001234", "sbin0001234"},
+	}
+	for system, ids := range invalid {
+		for _, id := range ids {
+			require.Error(t, ValidateClearingSystemMemberId(system, id), "%s %s", system, id)
+		}
+	}
+	require.EqualError(t, ValidateClearingSystemMemberId(ClearingSystemUSABA, "021000012"),
+		"The value 021000012 is not a valid USABA clearing system member identification")
+}
@@ -0,0 +1,82 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package utils
+
+import (
+	"strings"
+)
+
+var (
+	// CorrelationElements are the xml names of identifiers usable for correlating messages
+	CorrelationElements = []string{"MsgId", "InstrId", "EndToEndId", "TxId", "UETR", "ClrSysRef", "AcctSvcrRef"}
+
+	// correlationPlaceholders are values used when an identifier is not available
+	correlationPlaceholders = []string{"NOTPROVIDED", "NONREF"}
+)
+
+// CorrelationKeys holds the identifiers of a message by element name
+type CorrelationKeys map[string][]string
+
+// Get returns the first identifier of name
+func (k CorrelationKeys) Get(name string) string {
+	if values := k[name]; len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
+
+// ExtractCorrelationKeys returns every identifier of r usable for correlation, by
+// the names of CorrelationElements. References to original messages (e.g.
+// OrgnlEndToEndId) are reported under the name of the original identifier so a
+// status report shares the keys of the payment it reports on. Values are trimmed,
+// deduplicated and placeholders such as NOTPROVIDED are skipped, UETRs are lower-cased.
+func ExtractCorrelationKeys(r interface{}) CorrelationKeys {
+	keys := make(CorrelationKeys)
+	WalkElements(r, func(path, value string) {
+		name, value, ok := CorrelationKey(path, value)
+		if !ok || containsString(keys[name], value) {
+			return
+		}
+		keys[name] = append(keys[name], value)
+	})
+	return keys
+}
+
+// CorrelationKey returns the correlation element name of the element at path with its
+// normalized value like ExtractCorrelationKeys, false when the element isn't an identifier
+// or value is a placeholder
+func CorrelationKey(path, value string) (string, string, bool) {
+	name := correlationName(path)
+	if name == "" {
+		return "", "", false
+	}
+	value = strings.TrimSpace(value)
+	if name == "UETR" {
+		value = strings.ToLower(value)
+	}
+	if value == "" || containsString(correlationPlaceholders, value) {
+		return "", "", false
+	}
+	return name, value, true
+}
+
+// correlationName returns the correlation element name of path, empty when path is not an identifier
+func correlationName(path string) string {
+	segments := strings.Split(StripElementIndexes(path), "/")
+	name := strings.TrimPrefix(segments[len(segments)-1], "Orgnl")
+	if !containsString(CorrelationElements, name) {
+		return ""
+	}
+	return name
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,74 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type testPaymentId struct {
+	InstrId    string `xml:"InstrId"`
+	EndToEndId string `xml:"EndToEndId"`
+	TxId       string `xml:"TxId"`
+	UETR       string `xml:"UETR"`
+}
+
+type testStatus struct {
+	OrgnlMsgId      string `xml:"OrgnlMsgId"`
+	OrgnlEndToEndId string `xml:"OrgnlEndToEndId"`
+	AcctSvcrRef     string `xml:"AcctSvcrRef"`
+}
+
+type testCorrelated struct {
+	MsgId  string          `xml:"MsgId"`
+	PmtId  []testPaymentId `xml:"PmtId"`
+	Sts    testStatus      `xml:"Sts"`
+	Ustrd  string          `xml:"Ustrd"`
+	MsgRef string          `xml:"MsgRef"`
+}
+
+func TestExtractCorrelationKeys(t *testing.T) {
+	keys := ExtractCorrelationKeys(&testCorrelated{
+		MsgId: "MSG-1",
+		PmtId: []testPaymentId{
+			{InstrId: "INSTR-1", EndToEndId: " E2E-1 ", TxId: "TX-1", UETR: "8A562C67-CA16-48BA-B074-65581BE6F011"},
+			{EndToEndId: "NOTPROVIDED", TxId: "TX-1"},
+		},
+		Sts:    testStatus{OrgnlMsgId: "MSG-0", OrgnlEndToEndId: "E2E-1", AcctSvcrRef: "REF-1"},
+		Ustrd:  "unstructured",
+		MsgRef: "ignored",
+	})
+
+	require.Equal(t, CorrelationKeys{
+		"MsgId":       {"MSG-1", "MSG-0"},
+		"InstrId":     {"INSTR-1"},
+		"EndToEndId":  {"E2E-1"},
+		"TxId":        {"TX-1"},
+		"UETR":        {"8a562c67-ca16-48ba-b074-65581be6f011"},
+		"AcctSvcrRef": {"REF-1"},
+	}, keys)
+	require.Equal(t, "MSG-1", keys.Get("MsgId"))
+	require.Equal(t, "", keys.Get("ClrSysRef"))
+	require.Empty(t, ExtractCorrelationKeys(nil))
+}
+
+func TestCorrelationKey(t *testing.T) {
+	name, value, ok := CorrelationKey("/CdtTrfTxInf/PmtId/UETR", " 8A562C67-CA16-48BA-B074-65581BE6F011")
+	require.True(t, ok)
+	require.Equal(t, "UETR", name)
+	require.Equal(t, "8a562c67-ca16-48ba-b074-65581be6f011", value)
+
+	name, value, ok = CorrelationKey("/OrgnlGrpInfAndSts/OrgnlMsgId", "MSG-1")
+	require.True(t, ok)
+	require.Equal(t, "MsgId", name)
+	require.Equal(t, "MSG-1", value)
+
+	_, _, ok = CorrelationKey("/CdtTrfTxInf/PmtId/EndToEndId", "NOTPROVIDED")
+	require.False(t, ok)
+	_, _, ok = CorrelationKey("/CdtTrfTxInf/Dbtr/Nm", "John Smith")
+	require.False(t, ok)
+}
@@ -0,0 +1,334 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package utils
+
+/*
+	Element helpers give generic, read-only access to the leaf values of a message
+	without depending on the concrete message types. Every element is addressed by
+	the path of xml names leading to it, for example:
+
+		GrpHdr/MsgId
+		CdtTrfTxInf[0]/PmtId/EndToEndId
+		CdtTrfTxInf[0]/IntrBkSttlmAmt/@Ccy
+
+	Repeated elements carry their index, attributes are prefixed with "@" and
+	character data is addressed by the path of the enclosing element.
+*/
+
+import (
+	"encoding"
+	"encoding/xml"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	// TransactionElements are the xml names of repeated elements holding a single transaction
+	TransactionElements = []string{"CdtTrfTxInf", "CdtTrfTx", "DrctDbtTxInf", "TxInf", "OrgnlPmtInfAndSts", "Ntry"}
+
+	textMarshalerType = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+	xmlNameType       = reflect.TypeOf(xml.Name{})
+	xmlAttrsType      = reflect.TypeOf([]xml.Attr{})
+	timeType          = reflect.TypeOf(time.Time{})
+)
+
+// Element is a leaf value of a message
+type Element struct {
+	Path  string
+	Value string
+}
+
+// Transaction is a group of elements describing a single transaction of a message
+type Transaction struct {
+	// Path of the transaction element, for example "CdtTrfTxInf[1]"
+	Path string
+
+	// Elements of the transaction, followed by the elements of enclosing blocks (e.g. GrpHdr)
+	Elements []Element
+}
+
+// Lookup returns the first value with one of the given path suffixes
+func (t Transaction) Lookup(suffixes ...string) string {
+	for _, suffix := range suffixes {
+		for _, elm := range t.Elements {
+			if MatchElementPath(elm.Path, suffix) {
+				return elm.Value
+			}
+		}
+	}
+	return ""
+}
+
+// Values returns every value with the given path suffix
+func (t Transaction) Values(suffix string) []string {
+	var values []string
+	for _, elm := range t.Elements {
+		if MatchElementPath(elm.Path, suffix) {
+			values = append(values, elm.Value)
+		}
+	}
+	return values
+}
+
+// WalkElements calls fn for every non-empty leaf value of r
+func WalkElements(r interface{}, fn func(path, value string)) {
+	WalkElementValues(r, func(path string, value reflect.Value) {
+		if text, _ := elementText(value); text != "" {
+			fn(path, text)
+		}
+	})
+}
+
+// WalkElementValues calls fn for every non-empty leaf of r with its typed value
+func WalkElementValues(r interface{}, fn func(path string, value reflect.Value)) {
+	walkElements(reflect.ValueOf(r), "", fn)
+}
+
+// ElementTime returns the time of a date or time leaf value
+func ElementTime(value reflect.Value) (time.Time, bool) {
+	if !value.IsValid() || value.Kind() != reflect.Struct || !value.Type().ConvertibleTo(timeType) {
+		return time.Time{}, false
+	}
+	return value.Convert(timeType).Interface().(time.Time), true
+}
+
+// GetElements returns every non-empty leaf value of r in document order
+func GetElements(r interface{}) []Element {
+	var elements []Element
+	WalkElements(r, func(path, value string) {
+		elements = append(elements, Element{Path: path, Value: value})
+	})
+	return elements
+}
+
+// FindElementValues returns the values of all elements whose path ends with suffix
+func FindElementValues(r interface{}, suffix string) []string {
+	var values []string
+	WalkElements(r, func(path, value string) {
+		if MatchElementPath(path, suffix) {
+			values = append(values, value)
+		}
+	})
+	return values
+}
+
+// GetTransactions splits the elements of r into transactions. Elements outside of
+// any transaction are shared with every transaction they enclose. When r has no
+// transactions, a single transaction with all elements is returned.
+func GetTransactions(r interface{}) []Transaction {
+	elements := GetElements(r)
+
+	var keys []string
+	seen := make(map[string]bool)
+	for _, elm := range elements {
+		if key := transactionKey(elm.Path); key != "" && !seen[key] {
+			seen[key] = true
+			keys = append(keys, key)
+		}
+	}
+	if len(keys) == 0 {
+		return []Transaction{{Elements: elements}}
+	}
+
+	transactions := make([]Transaction, 0, len(keys))
+	for _, key := range keys {
+		tx := Transaction{Path: key}
+		for _, elm := range elements {
+			if strings.HasPrefix(elm.Path, key+"/") {
+				tx.Elements = append(tx.Elements, elm)
+			}
+		}
+		for _, elm := range elements {
+			if transactionKey(elm.Path) != "" {
+				continue
+			}
+			if scope := elementScope(elm.Path, keys); scope == "" || strings.HasPrefix(key, scope+"/") {
+				tx.Elements = append(tx.Elements, elm)
+			}
+		}
+		transactions = append(transactions, tx)
+	}
+	return transactions
+}
+
+// MatchElementPath reports whether path ends with suffix, ignoring indexes of repeated elements
+func MatchElementPath(path, suffix string) bool {
+	path = StripElementIndexes(path)
+	suffix = StripElementIndexes(suffix)
+	return path == suffix || strings.HasSuffix(path, "/"+suffix)
+}
+
+// StripElementIndexes removes the indexes of repeated elements from path
+func StripElementIndexes(path string) string {
+	if !strings.Contains(path, "[") {
+		return path
+	}
+	var buf strings.Builder
+	skip := false
+	for _, c := range path {
+		switch {
+		case c == '[':
+			skip = true
+		case c == ']':
+			skip = false
+		case !skip:
+			buf.WriteRune(c)
+		}
+	}
+	return buf.String()
+}
+
+// transactionKey returns the path of the innermost transaction element enclosing path
+func transactionKey(path string) string {
+	segments := strings.Split(path, "/")
+	for i := len(segments) - 1; i >= 0; i-- {
+		name := segments[i]
+		if idx := strings.Index(name, "["); idx > 0 {
+			name = name[:idx]
+		} else {
+			continue
+		}
+		for _, tx := range TransactionElements {
+			if name == tx {
+				return strings.Join(segments[:i+1], "/")
+			}
+		}
+	}
+	return ""
+}
+
+// elementScope returns the path of the innermost repeated element enclosing path and any transaction
+func elementScope(path string, keys []string) string {
+	segments := strings.Split(path, "/")
+	for i := len(segments) - 2; i >= 0; i-- {
+		if !strings.HasSuffix(segments[i], "]") {
+			continue
+		}
+		scope := strings.Join(segments[:i+1], "/")
+		for _, key := range keys {
+			if strings.HasPrefix(key, scope+"/") {
+				return scope
+			}
+		}
+	}
+	return ""
+}
+
+func joinElementPath(parent, name string) string {
+	if parent == "" {
+		return name
+	}
+	if name == "" {
+		return parent
+	}
+	return parent + "/" + name
+}
+
+func walkElements(value reflect.Value, path string, fn func(path string, value reflect.Value)) {
+	for value.Kind() == reflect.Ptr || value.Kind() == reflect.Interface {
+		if value.IsNil() {
+			return
+		}
+		value = value.Elem()
+	}
+	if !value.IsValid() {
+		return
+	}
+
+	if text, ok := elementText(value); ok {
+		if text != "" {
+			fn(path, value)
+		}
+		return
+	}
+
+	switch value.Kind() {
+	case reflect.Struct:
+		for i := 0; i < value.NumField(); i++ {
+			field := value.Type().Field(i)
+			if field.PkgPath != "" || field.Type == xmlNameType || field.Type == xmlAttrsType {
+				continue
+			}
+			name, isAttr, ok := elementName(field)
+			if !ok {
+				continue
+			}
+			if isAttr {
+				name = "@" + name
+			}
+			walkElements(value.Field(i), joinElementPath(path, name), fn)
+		}
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < value.Len(); i++ {
+			walkElements(value.Index(i), path+"["+strconv.Itoa(i)+"]", fn)
+		}
+	}
+}
+
+// elementName returns the xml name of a struct field
+func elementName(field reflect.StructField) (name string, isAttr bool, ok bool) {
+	tag := field.Tag.Get("xml")
+	if tag == "-" {
+		return "", false, false
+	}
+	parts := strings.Split(tag, ",")
+	name = parts[0]
+	for _, opt := range parts[1:] {
+		switch opt {
+		case "attr":
+			isAttr = true
+		case "chardata", "any":
+			return "", false, true
+		case "innerxml", "comment":
+			return "", false, false
+		}
+	}
+	if name == "" {
+		name = field.Name
+	}
+	return name, isAttr, true
+}
+
+// elementText returns the text of a leaf value
+func elementText(value reflect.Value) (string, bool) {
+	if value.Type().Implements(textMarshalerType) {
+		if value.IsZero() {
+			return "", true
+		}
+		text, err := value.Interface().(encoding.TextMarshaler).MarshalText()
+		if err != nil {
+			return "", true
+		}
+		return string(text), true
+	}
+
+	switch value.Kind() {
+	case reflect.String:
+		return value.String(), true
+	case reflect.Bool:
+		if !value.Bool() {
+			return "", true
+		}
+		return strconv.FormatBool(value.Bool()), true
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if value.Int() == 0 {
+			return "", true
+		}
+		return strconv.FormatInt(value.Int(), 10), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if value.Uint() == 0 {
+			return "", true
+		}
+		return strconv.FormatUint(value.Uint(), 10), true
+	case reflect.Float32, reflect.Float64:
+		if value.Float() == 0 {
+			return "", true
+		}
+		return strconv.FormatFloat(value.Float(), 'f', -1, 64), true
+	}
+	return "", false
+}
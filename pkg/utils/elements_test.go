@@ -0,0 +1,144 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package utils
+
+import (
+	"encoding/xml"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type testAmount struct {
+	Value float64 `xml:",chardata"`
+	Ccy   string  `xml:"Ccy,attr"`
+}
+
+type testTransaction struct {
+	EndToEndId string      `xml:"EndToEndId"`
+	Amt        testAmount  `xml:"IntrBkSttlmAmt"`
+	Nm         *string     `xml:"Nm,omitempty"`
+	Purp       interface{} `xml:"Purp,omitempty"`
+}
+
+type testMessage struct {
+	XMLName     xml.Name          `xml:"FIToFICstmrCdtTrf"`
+	MsgId       string            `xml:"MsgId"`
+	CdtTrfTxInf []testTransaction `xml:"CdtTrfTxInf"`
+	ignored     string
+}
+
+func TestGetElements(t *testing.T) {
+	name := "Jane Doe"
+	msg := &testMessage{
+		MsgId: "MSG-1",
+		CdtTrfTxInf: []testTransaction{
+			{EndToEndId: "E2E-1", Amt: testAmount{Value: 10.5, Ccy: "EUR"}, Nm: &name},
+			{EndToEndId: "E2E-2", Amt: testAmount{Value: 0, Ccy: "USD"}},
+		},
+		ignored: "ignored",
+	}
+
+	require.Equal(t, []Element{
+		{Path: "MsgId", Value: "MSG-1"},
+		{Path: "CdtTrfTxInf[0]/EndToEndId", Value: "E2E-1"},
+		{Path: "CdtTrfTxInf[0]/IntrBkSttlmAmt", Value: "10.5"},
+		{Path: "CdtTrfTxInf[0]/IntrBkSttlmAmt/@Ccy", Value: "EUR"},
+		{Path: "CdtTrfTxInf[0]/Nm", Value: "Jane Doe"},
+		{Path: "CdtTrfTxInf[1]/EndToEndId", Value: "E2E-2"},
+		{Path: "CdtTrfTxInf[1]/IntrBkSttlmAmt/@Ccy", Value: "USD"},
+	}, GetElements(msg))
+
+	require.Equal(t, []string{"EUR", "USD"}, FindElementValues(msg, "IntrBkSttlmAmt/@Ccy"))
+	require.Empty(t, FindElementValues(msg, "Amt/@Ccy"))
+	require.Empty(t, GetElements(nil))
+}
+
+func TestGetTransactions(t *testing.T) {
+	msg := &testMessage{
+		MsgId: "MSG-1",
+		CdtTrfTxInf: []testTransaction{
+			{EndToEndId: "E2E-1", Amt: testAmount{Value: 10.5, Ccy: "EUR"}},
+			{EndToEndId: "E2E-2", Amt: testAmount{Value: 7, Ccy: "USD"}},
+		},
+	}
+
+	transactions := GetTransactions(msg)
+	require.Len(t, transactions, 2)
+	require.Equal(t, "CdtTrfTxInf[1]", transactions[1].Path)
+	require.Equal(t, "USD", transactions[1].Lookup("IntrBkSttlmAmt/@Ccy"))
+	require.Equal(t, "7", transactions[1].Lookup("InstdAmt", "IntrBkSttlmAmt"))
+	require.Equal(t, "MSG-1", transactions[1].Lookup("MsgId"))
+	require.Equal(t, "", transactions[1].Lookup("UETR"))
+	require.Equal(t, []string{"E2E-2"}, transactions[1].Values("EndToEndId"))
+	require.Empty(t, transactions[1].Values("UETR"))
+
+	transactions = GetTransactions(&testMessage{MsgId: "MSG-2"})
+	require.Len(t, transactions, 1)
+	require.Equal(t, "MSG-2", transactions[0].Lookup("MsgId"))
+}
+
+func TestMatchElementPath(t *testing.T) {
+	require.True(t, MatchElementPath("CdtTrfTxInf[3]/PmtId/UETR", "PmtId/UETR"))
+	require.True(t, MatchElementPath("CdtTrfTxInf[3]/PmtId/UETR", "CdtTrfTxInf/PmtId/UETR"))
+	require.True(t, MatchElementPath("GrpHdr/MsgId", "GrpHdr/MsgId"))
+	require.False(t, MatchElementPath("GrpHdr/OrgnlMsgId", "MsgId"))
+	require.Equal(t, "PmtInf/CdtTrfTxInf/Amt", StripElementIndexes("PmtInf[0]/CdtTrfTxInf[12]/Amt"))
+}
+
+type testPaymentInformation struct {
+	DbtrNm      string            `xml:"DbtrNm"`
+	SvcLvl      []string          `xml:"SvcLvl"`
+	CdtTrfTxInf []testTransaction `xml:"CdtTrfTxInf"`
+}
+
+type testInitiation struct {
+	MsgId  string                   `xml:"MsgId"`
+	PmtInf []testPaymentInformation `xml:"PmtInf"`
+}
+
+func TestGetNestedTransactions(t *testing.T) {
+	msg := &testInitiation{
+		MsgId: "MSG-1",
+		PmtInf: []testPaymentInformation{
+			{DbtrNm: "First", SvcLvl: []string{"URGP"}, CdtTrfTxInf: []testTransaction{{EndToEndId: "E2E-1"}}},
+			{DbtrNm: "Second", CdtTrfTxInf: []testTransaction{{EndToEndId: "E2E-2"}, {EndToEndId: "E2E-3"}}},
+		},
+	}
+
+	transactions := GetTransactions(msg)
+	require.Len(t, transactions, 3)
+	require.Equal(t, "PmtInf[1]/CdtTrfTxInf[0]", transactions[1].Path)
+	require.Equal(t, "First", transactions[0].Lookup("DbtrNm"))
+	require.Equal(t, []string{"URGP"}, transactions[0].Values("SvcLvl"))
+	require.Equal(t, "Second", transactions[2].Lookup("DbtrNm"))
+	require.Empty(t, transactions[2].Values("SvcLvl"))
+	require.Equal(t, "MSG-1", transactions[2].Lookup("MsgId"))
+}
+
+type testTimes struct {
+	Dt  time.Time `xml:"Dt"`
+	Nm  string    `xml:"Nm"`
+	Nbr int       `xml:"Nbr"`
+}
+
+func TestWalkElementValues(t *testing.T) {
+	dt := time.Date(2021, 4, 15, 10, 30, 0, 0, time.FixedZone("CEST", 7200))
+	var times []time.Time
+	WalkElementValues(&testTimes{Dt: dt, Nm: "name", Nbr: 2}, func(path string, value reflect.Value) {
+		if tm, ok := ElementTime(value); ok {
+			require.Equal(t, "Dt", path)
+			times = append(times, tm)
+		}
+	})
+	require.Equal(t, []time.Time{dt}, times)
+
+	_, ok := ElementTime(reflect.ValueOf("2021-04-15"))
+	require.False(t, ok)
+	_, ok = ElementTime(reflect.Value{})
+	require.False(t, ok)
+}
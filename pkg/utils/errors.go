@@ -47,3 +47,9 @@ func NewErrInvalidFileType() error {
 	errStr := fmt.Sprintf("The type of %s is invalid", "file")
 	return fmt.Errorf(errStr)
 }
+
+// NewErrUnknownElement returns a error that the element of path is unknown
+func NewErrUnknownElement(path string) error {
+	errStr := fmt.Sprintf("The element %s is unknown", path)
+	return fmt.Errorf(errStr)
+}
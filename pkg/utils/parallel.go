@@ -0,0 +1,220 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package utils
+
+import (
+	"reflect"
+	"sync"
+)
+
+// addressKey identifies a transaction by its address in the slice holding it
+type addressKey struct {
+	addr uintptr
+	typ  reflect.Type
+}
+
+func keyOf(item reflect.Value) (addressKey, bool) {
+	if item.Kind() == reflect.Ptr {
+		if item.IsNil() {
+			return addressKey{}, false
+		}
+		return addressKey{addr: item.Pointer(), typ: item.Type()}, true
+	}
+	if !item.CanAddr() {
+		return addressKey{}, false
+	}
+	return addressKey{addr: item.Addr().Pointer(), typ: item.Type()}, true
+}
+
+// ValidateParallel validates r like Validate with the transactions of r (see TransactionElements)
+// validated concurrently by workers goroutines, for large documents. Its error is the error
+// Validate returns, the first in the order of the elements. The Validate methods of the structs
+// holding transactions must be the one calling Validate, like those of the message models.
+// Documents are validated sequentially when workers is less than 2.
+func ValidateParallel(r interface{}, workers int) error {
+	if workers < 2 {
+		return Validate(r)
+	}
+	root := reflect.ValueOf(r).Elem()
+
+	var transactions []reflect.Value
+	collectTransactions(root, &transactions)
+	if len(transactions) < 2 {
+		return Validate(r)
+	}
+	if workers > len(transactions) {
+		workers = len(transactions)
+	}
+
+	errs := make([]error, len(transactions))
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for idx := range indexes {
+				errs[idx] = validateCallbackByValue(transactions[idx])
+			}
+		}()
+	}
+	for idx := range transactions {
+		indexes <- idx
+	}
+	close(indexes)
+	wg.Wait()
+
+	results := make(map[addressKey]error, len(transactions))
+	for idx, tx := range transactions {
+		key, _ := keyOf(tx)
+		results[key] = errs[idx]
+	}
+	return validateFields(root, results)
+}
+
+// collectTransactions appends the transactions of value to transactions in the order of the elements
+func collectTransactions(value reflect.Value, transactions *[]reflect.Value) {
+	switch value.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if !value.IsNil() {
+			collectTransactions(value.Elem(), transactions)
+		}
+	case reflect.Struct:
+		if !holdsTransactions(value.Type()) {
+			return
+		}
+		for i := 0; i < value.NumField(); i++ {
+			field := value.Field(i)
+			if !isTransactionSlice(value.Type().Field(i)) {
+				collectTransactions(field, transactions)
+				continue
+			}
+			for j := 0; j < field.Len(); j++ {
+				if _, ok := keyOf(field.Index(j)); ok {
+					*transactions = append(*transactions, field.Index(j))
+				}
+			}
+		}
+	case reflect.Slice, reflect.Array:
+		if !holdsTransactions(value.Type()) {
+			return
+		}
+		for i := 0; i < value.Len(); i++ {
+			collectTransactions(value.Index(i), transactions)
+		}
+	}
+}
+
+// validateFields validates the fields of the struct fields like Validate, the transactions
+// are the results of ValidateParallel
+func validateFields(fields reflect.Value, results map[addressKey]error) error {
+	for i := 0; i < fields.NumField(); i++ {
+		fieldData := fields.Field(i)
+		var err error
+		switch fieldData.Kind() {
+		case reflect.Slice:
+			for j := 0; j < fieldData.Len(); j++ {
+				item := fieldData.Index(j)
+				if key, ok := keyOf(item); ok {
+					if result, done := results[key]; done {
+						if result != nil {
+							return result
+						}
+						continue
+					}
+				}
+				if err = validateHolding(item, results); err != nil {
+					return err
+				}
+			}
+		case reflect.Map:
+			for _, key := range fieldData.MapKeys() {
+				if err = validateCallbackByValue(fieldData.MapIndex(key)); err != nil {
+					return err
+				}
+			}
+		case reflect.Ptr:
+			if fieldData.Pointer() != 0 {
+				err = validateHolding(fieldData, results)
+			}
+		default:
+			err = validateHolding(fieldData, results)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateHolding validates data like validateCallbackByValue, the structs holding transactions
+// are validated with the results of ValidateParallel instead of their Validate method
+func validateHolding(data reflect.Value, results map[addressKey]error) error {
+	value := data
+	for value.Kind() == reflect.Ptr || value.Kind() == reflect.Interface {
+		if value.IsNil() {
+			return validateCallbackByValue(data)
+		}
+		value = value.Elem()
+	}
+	if value.Kind() != reflect.Struct || !holdsTransactions(value.Type()) || !data.MethodByName(DefaultValidateFunction).IsValid() {
+		return validateCallbackByValue(data)
+	}
+	if err := validateFields(value, results); err != nil {
+		return wrapValidateError(data, err)
+	}
+	return nil
+}
+
+// isTransactionSlice reports whether field is a repeated transaction element
+func isTransactionSlice(field reflect.StructField) bool {
+	if field.Type.Kind() != reflect.Slice {
+		return false
+	}
+	name, _, ok := elementName(field)
+	if !ok {
+		return false
+	}
+	for _, tx := range TransactionElements {
+		if name == tx {
+			return true
+		}
+	}
+	return false
+}
+
+var transactionHolders sync.Map
+
+// holdsTransactions reports whether values of typ may hold transactions, those of interfaces may
+func holdsTransactions(typ reflect.Type) bool {
+	return holds(typ, make(map[reflect.Type]bool))
+}
+
+func holds(typ reflect.Type, visiting map[reflect.Type]bool) bool {
+	for typ.Kind() == reflect.Ptr || typ.Kind() == reflect.Slice || typ.Kind() == reflect.Array {
+		typ = typ.Elem()
+	}
+	switch {
+	case typ.Kind() == reflect.Interface:
+		return true
+	case typ.Kind() != reflect.Struct:
+		return false
+	}
+	if held, ok := transactionHolders.Load(typ); ok {
+		return held.(bool)
+	}
+	// recursive types are validated sequentially below their first occurrence
+	if visiting[typ] {
+		return false
+	}
+	visiting[typ] = true
+
+	held := false
+	for i := 0; i < typ.NumField() && !held; i++ {
+		held = isTransactionSlice(typ.Field(i)) || holds(typ.Field(i).Type, visiting)
+	}
+	transactionHolders.Store(typ, held)
+	return held
+}
@@ -0,0 +1,130 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type testText string
+
+func (r testText) Validate() error {
+	if r == "" {
+		return errors.New("The value of testText is empty")
+	}
+	return nil
+}
+
+type testParallelTransaction struct {
+	Id  testText  `xml:"Id"`
+	Ref *testText `xml:"Ref,omitempty"`
+}
+
+func (r testParallelTransaction) Validate() error {
+	return Validate(&r)
+}
+
+type testParallelPayment struct {
+	PmtInfId    testText                  `xml:"PmtInfId"`
+	CdtTrfTxInf []testParallelTransaction `xml:"CdtTrfTxInf"`
+	CtrlSum     testText                  `xml:"CtrlSum"`
+}
+
+func (r testParallelPayment) Validate() error {
+	return Validate(&r)
+}
+
+type testParallelGroupHeader struct {
+	MsgId testText `xml:"MsgId"`
+}
+
+func (r testParallelGroupHeader) Validate() error {
+	return Validate(&r)
+}
+
+type testParallelInitiation struct {
+	GrpHdr testParallelGroupHeader `xml:"GrpHdr"`
+	PmtInf []testParallelPayment   `xml:"PmtInf"`
+}
+
+func (r testParallelInitiation) Validate() error {
+	return Validate(&r)
+}
+
+type testValidator interface {
+	Validate() error
+}
+
+type testParallelDocument struct {
+	Message testValidator `xml:",any"`
+}
+
+func newTestParallelInitiation(payments, transactions int) *testParallelDocument {
+	message := &testParallelInitiation{GrpHdr: testParallelGroupHeader{MsgId: "MSG-1"}}
+	for i := 0; i < payments; i++ {
+		payment := testParallelPayment{PmtInfId: testText(fmt.Sprintf("PMT-%d", i)), CtrlSum: "100"}
+		for j := 0; j < transactions; j++ {
+			payment.CdtTrfTxInf = append(payment.CdtTrfTxInf, testParallelTransaction{Id: testText(fmt.Sprintf("TX-%d-%d", i, j))})
+		}
+		message.PmtInf = append(message.PmtInf, payment)
+	}
+	return &testParallelDocument{Message: message}
+}
+
+func TestValidateParallel(t *testing.T) {
+	for name, invalidate := range map[string]func(*testParallelInitiation){
+		"valid": func(*testParallelInitiation) {},
+		"transactions": func(m *testParallelInitiation) {
+			m.PmtInf[2].CdtTrfTxInf[7].Id = ""
+			m.PmtInf[1].CdtTrfTxInf[40].Id = ""
+			m.PmtInf[1].CdtTrfTxInf[3].Ref = new(testText)
+		},
+		"header before transactions": func(m *testParallelInitiation) {
+			m.GrpHdr.MsgId = ""
+			m.PmtInf[0].CdtTrfTxInf[0].Id = ""
+		},
+		"after transactions": func(m *testParallelInitiation) {
+			m.PmtInf[1].CtrlSum = ""
+			m.PmtInf[2].CdtTrfTxInf[0].Id = ""
+		},
+		"before transactions": func(m *testParallelInitiation) {
+			m.PmtInf[1].PmtInfId = ""
+			m.PmtInf[1].CdtTrfTxInf[0].Id = ""
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			doc := newTestParallelInitiation(3, 50)
+			invalidate(doc.Message.(*testParallelInitiation))
+
+			expected := Validate(doc)
+			for _, workers := range []int{0, 1, 2, 8, 500} {
+				err := ValidateParallel(doc, workers)
+				if expected == nil {
+					require.Nil(t, err, workers)
+					continue
+				}
+				require.NotNil(t, err, workers)
+				require.Equal(t, expected.Error(), err.Error(), workers)
+			}
+		})
+	}
+}
+
+func TestCollectTransactions(t *testing.T) {
+	doc := newTestParallelInitiation(3, 50)
+	var transactions []reflect.Value
+	collectTransactions(reflect.ValueOf(doc).Elem(), &transactions)
+	require.Len(t, transactions, 150)
+	require.Equal(t, testText("TX-0-0"), transactions[0].Interface().(testParallelTransaction).Id)
+	require.Equal(t, testText("TX-2-49"), transactions[149].Interface().(testParallelTransaction).Id)
+
+	require.True(t, holdsTransactions(reflect.TypeOf(testParallelInitiation{})))
+	require.False(t, holdsTransactions(reflect.TypeOf(testParallelGroupHeader{})))
+}
@@ -0,0 +1,257 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package utils
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// pathTree holds the element paths to validate below an element
+type pathTree struct {
+	// all validates the whole element
+	all bool
+
+	// children are the paths below the child elements by name, they apply to every item of
+	// repeated elements
+	children map[string]*pathTree
+
+	// items are the paths below the items of repeated elements by index
+	items map[int]*pathTree
+}
+
+func newPathTree(paths []string) *pathTree {
+	root := &pathTree{}
+	for _, path := range paths {
+		path = strings.Trim(path, "/")
+		if path == "" {
+			root.all = true
+			continue
+		}
+		node := root
+		for _, segment := range strings.Split(path, "/") {
+			name, index := splitSegment(segment)
+			node = node.child(name)
+			if index >= 0 {
+				if node.items == nil {
+					node.items = make(map[int]*pathTree)
+				}
+				if node.items[index] == nil {
+					node.items[index] = &pathTree{}
+				}
+				node = node.items[index]
+			}
+		}
+		node.all = true
+	}
+	return root
+}
+
+func (t *pathTree) child(name string) *pathTree {
+	if t.children == nil {
+		t.children = make(map[string]*pathTree)
+	}
+	if t.children[name] == nil {
+		t.children[name] = &pathTree{}
+	}
+	return t.children[name]
+}
+
+// splitSegment returns the name and index of a path segment (Name, Name[1] or @Name), -1 without index
+func splitSegment(segment string) (string, int) {
+	segment = strings.TrimPrefix(segment, "@")
+	open := strings.Index(segment, "[")
+	if open < 0 || !strings.HasSuffix(segment, "]") {
+		return segment, -1
+	}
+	index, err := strconv.Atoi(segment[open+1 : len(segment)-1])
+	if err != nil || index < 0 {
+		return segment, -1
+	}
+	return segment[:open], index
+}
+
+// mergePathTrees returns the paths of a and b, either may be nil
+func mergePathTrees(a, b *pathTree) *pathTree {
+	switch {
+	case a == nil:
+		return b
+	case b == nil:
+		return a
+	case a.all || b.all:
+		return &pathTree{all: true}
+	}
+	merged := &pathTree{}
+	for _, t := range []*pathTree{a, b} {
+		for name, child := range t.children {
+			if merged.children == nil {
+				merged.children = make(map[string]*pathTree)
+			}
+			merged.children[name] = mergePathTrees(merged.children[name], child)
+		}
+		for index, item := range t.items {
+			if merged.items == nil {
+				merged.items = make(map[int]*pathTree)
+			}
+			merged.items[index] = mergePathTrees(merged.items[index], item)
+		}
+	}
+	return merged
+}
+
+// item returns the paths below the item index of a repeated element, nil when there are none
+func (t *pathTree) item(index int) *pathTree {
+	if t.all {
+		return t
+	}
+	var every *pathTree
+	if len(t.children) > 0 {
+		every = &pathTree{children: t.children}
+	}
+	return mergePathTrees(every, t.items[index])
+}
+
+// ValidateElements validates the elements of r at paths like Validate, for example the elements
+// changed by a patch ("CdtTrfTxInf[1]/PmtId", see Element). The other elements of r aren't
+// validated: the error is the one Validate returns when they're valid. Paths without index
+// select every item of repeated elements and the elements at paths of omitted elements are
+// skipped like by Validate. The Validate methods of the structs holding the elements must be
+// the one calling Validate, like those of the message models.
+func ValidateElements(r interface{}, paths []string) error {
+	tree := newPathTree(paths)
+	if tree.all {
+		return Validate(r)
+	}
+	return validatePaths(reflect.ValueOf(r).Elem(), tree)
+}
+
+// validatePaths validates the elements of the struct fields at the paths of tree like Validate
+func validatePaths(fields reflect.Value, tree *pathTree) error {
+	for i := 0; i < fields.NumField(); i++ {
+		fieldData := fields.Field(i)
+		child := fieldPaths(fields.Type().Field(i), fieldData, tree)
+		if child == nil {
+			continue
+		}
+
+		var err error
+		switch fieldData.Kind() {
+		case reflect.Slice:
+			for j := 0; j < fieldData.Len(); j++ {
+				if item := child.item(j); item != nil {
+					if err = validatePath(fieldData.Index(j), item); err != nil {
+						return err
+					}
+				}
+			}
+		case reflect.Map:
+			for _, key := range fieldData.MapKeys() {
+				if err = validateCallbackByValue(fieldData.MapIndex(key)); err != nil {
+					return err
+				}
+			}
+		case reflect.Ptr:
+			if fieldData.Pointer() != 0 {
+				err = validatePath(fieldData, child)
+			}
+		default:
+			err = validatePath(fieldData, child)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// fieldPaths returns the paths of tree below field, by the name of its element or json name,
+// nil when there are none. The message of documents is held by the document element.
+func fieldPaths(field reflect.StructField, value reflect.Value, tree *pathTree) *pathTree {
+	if field.PkgPath != "" || field.Type == xmlNameType || field.Type == xmlAttrsType {
+		return nil
+	}
+	name, _, ok := elementName(field)
+	switch {
+	case !ok:
+		return nil
+	case name == "" && value.Kind() == reflect.Interface:
+		return tree
+	case name == "":
+		return tree.children[field.Name]
+	case name == field.Name:
+		return tree.children[name]
+	}
+	return mergePathTrees(tree.children[name], tree.children[field.Name])
+}
+
+// validatePath validates data like validateCallbackByValue when the whole element is selected,
+// otherwise the elements of the struct at the paths of tree
+func validatePath(data reflect.Value, tree *pathTree) error {
+	if tree.all {
+		return validateCallbackByValue(data)
+	}
+	value := data
+	for value.Kind() == reflect.Ptr || value.Kind() == reflect.Interface {
+		if value.IsNil() {
+			return nil
+		}
+		value = value.Elem()
+	}
+	if value.Kind() != reflect.Struct || !data.MethodByName(DefaultValidateFunction).IsValid() {
+		return validateCallbackByValue(data)
+	}
+	if err := validatePaths(value, tree); err != nil {
+		return wrapValidateError(data, err)
+	}
+	return nil
+}
+
+// AffectsElements reports whether changing the element at path of the message r, e.g.
+// "CdtTrfTxInf[0]/PmtId", may change the elements with the path suffix (see MatchElementPath):
+// the element is one of them, is held by one or holds one in the message model of r. The element
+// may be omitted, the empty path is the whole message.
+func AffectsElements(r interface{}, path, suffix string) bool {
+	path = normalizeElementPath(path)
+	suffix = normalizeElementPath(suffix)
+	if path == "" {
+		return true
+	}
+
+	segments := strings.Split(path, "/")
+	for i := range segments {
+		if MatchElementPath(strings.Join(segments[:i+1], "/"), suffix) {
+			return true
+		}
+	}
+	for _, held := range schemaPaths(r) {
+		if strings.HasPrefix(held, path+"/") && MatchElementPath(held, suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+// normalizeElementPath removes the indexes and attribute markers of path
+func normalizeElementPath(path string) string {
+	return strings.ReplaceAll(StripElementIndexes(strings.Trim(path, "/")), "@", "")
+}
+
+var messageSchemaPaths sync.Map
+
+// schemaPaths returns the normalized paths of the elements r can hold, see DescribeElements
+func schemaPaths(r interface{}) []string {
+	typ := reflect.TypeOf(r)
+	if paths, ok := messageSchemaPaths.Load(typ); ok {
+		return paths.([]string)
+	}
+	var paths []string
+	for _, elm := range DescribeElements(r) {
+		paths = append(paths, normalizeElementPath(elm.Path))
+	}
+	messageSchemaPaths.Store(typ, paths)
+	return paths
+}
@@ -0,0 +1,62 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateElements(t *testing.T) {
+	doc := newTestParallelInitiation(3, 50)
+	require.Nil(t, ValidateElements(doc, []string{"GrpHdr", "PmtInf[1]/CdtTrfTxInf[3]/Id"}))
+
+	message := doc.Message.(*testParallelInitiation)
+	message.PmtInf[2].CdtTrfTxInf[7].Id = ""
+	expected := Validate(doc)
+	require.NotNil(t, expected)
+
+	for _, paths := range [][]string{
+		{"PmtInf[2]/CdtTrfTxInf[7]/Id"},
+		{"PmtInf[2]/CdtTrfTxInf[7]"},
+		{"PmtInf/CdtTrfTxInf/Id"},
+		{"GrpHdr/MsgId", "/PmtInf[2]/"},
+		{"PmtInf"},
+		{""},
+	} {
+		err := ValidateElements(doc, paths)
+		require.NotNil(t, err, paths)
+		require.Equal(t, expected.Error(), err.Error(), paths)
+	}
+
+	// the other elements are assumed valid
+	for _, paths := range [][]string{
+		{"GrpHdr"},
+		{"PmtInf[2]/CdtTrfTxInf[6]", "PmtInf[1]/CdtTrfTxInf[7]"},
+		{"PmtInf[2]/CdtTrfTxInf[7]/Ref"},
+		{"PmtInf[5]"},
+		{},
+	} {
+		require.Nil(t, ValidateElements(doc, paths), paths)
+	}
+
+	// the first error of the paths in document order is returned
+	message.GrpHdr.MsgId = ""
+	require.Equal(t, Validate(doc).Error(), ValidateElements(doc, []string{"PmtInf", "GrpHdr"}).Error())
+}
+
+func TestAffectsElements(t *testing.T) {
+	message := &testParallelInitiation{}
+
+	require.True(t, AffectsElements(message, "PmtInf[0]/CdtTrfTxInf[1]/Id", "CdtTrfTxInf/Id"))
+	require.True(t, AffectsElements(message, "PmtInf[0]/CdtTrfTxInf[1]/Id", "CdtTrfTxInf"))
+	require.True(t, AffectsElements(message, "PmtInf[1]", "CdtTrfTxInf/Ref"))
+	require.True(t, AffectsElements(message, "PmtInf", "Ref"))
+	require.True(t, AffectsElements(message, "", "CdtTrfTxInf"))
+	require.False(t, AffectsElements(message, "GrpHdr/MsgId", "CdtTrfTxInf"))
+	require.False(t, AffectsElements(message, "PmtInf[0]/CtrlSum", "CdtTrfTxInf/Id"))
+	require.False(t, AffectsElements(message, "PmtInf[0]/CdtTrfTxInf[1]/Ref", "CdtTrfTxInf/Id"))
+}
@@ -0,0 +1,235 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package utils
+
+/*
+	Schema helpers describe the elements a message type can hold, using the same
+	paths as the element helpers without indexes, for example:
+
+		CdtTrfTxInf/IntrBkSttlmAmt
+		CdtTrfTxInf/IntrBkSttlmAmt/@Ccy
+
+	Recursive types are described once, their nested occurrences are not expanded.
+*/
+
+import (
+	"encoding"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+var textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+
+// SchemaElement describes an element of a message type
+type SchemaElement struct {
+	Path string `json:"path"`
+
+	// Type is the name of the go type of the element (e.g. Max35Text)
+	Type string `json:"type"`
+
+	// Required elements are not optional in their enclosing element
+	Required  bool `json:"required"`
+	Repeated  bool `json:"repeated,omitempty"`
+	Attribute bool `json:"attribute,omitempty"`
+
+	// Value reports whether the element holds a value, other elements only enclose elements
+	Value bool `json:"value"`
+}
+
+// DescribeElements returns the elements r can hold in document order
+func DescribeElements(r interface{}) []SchemaElement {
+	var elements []SchemaElement
+	describeElements(reflect.TypeOf(r), "", SchemaElement{}, make(map[reflect.Type]bool), &elements)
+	return elements
+}
+
+// CheckElementValue parses value into the type of the element of r at path and validates it
+func CheckElementValue(r interface{}, path, value string) error {
+	tp, ok := elementType(reflect.TypeOf(r), StripElementIndexes(path))
+	if !ok {
+		return NewErrUnknownElement(path)
+	}
+
+	data := reflect.New(tp)
+	switch {
+	case data.Type().Implements(textUnmarshalerType):
+		if err := data.Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte(value)); err != nil {
+			return NewErrValueInvalid(tp.Name())
+		}
+	default:
+		if err := setElementText(data.Elem(), value); err != nil {
+			return NewErrValueInvalid(tp.Name())
+		}
+	}
+	if validator, ok := data.Elem().Interface().(interface{ Validate() error }); ok {
+		return validator.Validate()
+	}
+	return nil
+}
+
+func describeElements(tp reflect.Type, path string, elm SchemaElement, seen map[reflect.Type]bool, elements *[]SchemaElement) {
+	for tp.Kind() == reflect.Ptr {
+		tp = tp.Elem()
+	}
+	if tp.Kind() == reflect.Slice && tp.Elem().Kind() != reflect.Uint8 {
+		elm.Repeated = true
+		describeElements(tp.Elem(), path, elm, seen, elements)
+		return
+	}
+
+	elm.Path = path
+	elm.Type = tp.Name()
+	if isElementValueType(tp) {
+		elm.Value = true
+		*elements = append(*elements, elm)
+		return
+	}
+	if tp.Kind() != reflect.Struct {
+		return
+	}
+
+	if _, ok := charDataField(tp); ok {
+		elm.Value = true
+	}
+	if path != "" {
+		*elements = append(*elements, elm)
+	}
+	if seen[tp] {
+		return
+	}
+	seen[tp] = true
+	defer delete(seen, tp)
+
+	for i := 0; i < tp.NumField(); i++ {
+		field := tp.Field(i)
+		if field.PkgPath != "" || field.Type == xmlNameType || field.Type == xmlAttrsType {
+			continue
+		}
+		name, isAttr, ok := elementName(field)
+		if !ok || name == "" {
+			continue
+		}
+		if isAttr {
+			name = "@" + name
+		}
+		child := SchemaElement{
+			Required:  !strings.Contains(field.Tag.Get("xml"), "omitempty") && field.Type.Kind() != reflect.Ptr && field.Type.Kind() != reflect.Slice,
+			Attribute: isAttr,
+		}
+		describeElements(field.Type, joinElementPath(path, name), child, seen, elements)
+	}
+}
+
+// elementType returns the value type of the element of tp at path
+func elementType(tp reflect.Type, path string) (reflect.Type, bool) {
+	var segments []string
+	if path != "" {
+		segments = strings.Split(path, "/")
+	}
+
+	for {
+		for tp.Kind() == reflect.Ptr || (tp.Kind() == reflect.Slice && tp.Elem().Kind() != reflect.Uint8) {
+			tp = tp.Elem()
+		}
+		if len(segments) == 0 {
+			break
+		}
+		if tp.Kind() != reflect.Struct || isElementValueType(tp) {
+			return nil, false
+		}
+
+		found := false
+		for i := 0; i < tp.NumField(); i++ {
+			field := tp.Field(i)
+			name, isAttr, ok := elementName(field)
+			if !ok || name == "" || field.PkgPath != "" {
+				continue
+			}
+			if isAttr {
+				name = "@" + name
+			}
+			if name == segments[0] {
+				tp = field.Type
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, false
+		}
+		segments = segments[1:]
+	}
+
+	if isElementValueType(tp) {
+		return tp, true
+	}
+	if field, ok := charDataField(tp); ok {
+		return field.Type, true
+	}
+	return nil, false
+}
+
+func isElementValueType(tp reflect.Type) bool {
+	if tp.Implements(textMarshalerType) || reflect.PtrTo(tp).Implements(textUnmarshalerType) {
+		return true
+	}
+	switch tp.Kind() {
+	case reflect.String, reflect.Bool, reflect.Float32, reflect.Float64,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return true
+	}
+	return false
+}
+
+// charDataField returns the field with the character data of a struct
+func charDataField(tp reflect.Type) (reflect.StructField, bool) {
+	for i := 0; i < tp.NumField(); i++ {
+		field := tp.Field(i)
+		for _, opt := range strings.Split(field.Tag.Get("xml"), ",")[1:] {
+			if opt == "chardata" {
+				return field, true
+			}
+		}
+	}
+	return reflect.StructField{}, false
+}
+
+// setElementText parses text into a value of basic kind
+func setElementText(value reflect.Value, text string) error {
+	switch value.Kind() {
+	case reflect.String:
+		value.SetString(text)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(text)
+		if err != nil {
+			return err
+		}
+		value.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i, err := strconv.ParseInt(text, 10, 64)
+		if err != nil {
+			return err
+		}
+		value.SetInt(i)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		u, err := strconv.ParseUint(text, 10, 64)
+		if err != nil {
+			return err
+		}
+		value.SetUint(u)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(text, 64)
+		if err != nil {
+			return err
+		}
+		value.SetFloat(f)
+	default:
+		return fmt.Errorf("unsupported kind %s", value.Kind())
+	}
+	return nil
+}
@@ -0,0 +1,66 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type testCode string
+
+func (r testCode) Validate() error {
+	if r != "INST" {
+		return NewErrValueInvalid("testCode")
+	}
+	return nil
+}
+
+type testParty struct {
+	Nm   string     `xml:"Nm,omitempty"`
+	Lcl  testCode   `xml:"Lcl"`
+	Prty *testParty `xml:"Prty,omitempty"`
+}
+
+type testSchemaMessage struct {
+	Msg   testMessage `xml:"Msg"`
+	Pty   []testParty `xml:"Pty,omitempty"`
+	Chrgs *testAmount `xml:"Chrgs,omitempty"`
+}
+
+func TestDescribeElements(t *testing.T) {
+	require.Equal(t, []SchemaElement{
+		{Path: "Msg", Type: "testMessage", Required: true},
+		{Path: "Msg/MsgId", Type: "string", Required: true, Value: true},
+		{Path: "Msg/CdtTrfTxInf", Type: "testTransaction", Repeated: true},
+		{Path: "Msg/CdtTrfTxInf/EndToEndId", Type: "string", Required: true, Value: true},
+		{Path: "Msg/CdtTrfTxInf/IntrBkSttlmAmt", Type: "testAmount", Required: true, Value: true},
+		{Path: "Msg/CdtTrfTxInf/IntrBkSttlmAmt/@Ccy", Type: "string", Required: true, Attribute: true, Value: true},
+		{Path: "Msg/CdtTrfTxInf/Nm", Type: "string", Value: true},
+		{Path: "Pty", Type: "testParty", Repeated: true},
+		{Path: "Pty/Nm", Type: "string", Value: true},
+		{Path: "Pty/Lcl", Type: "testCode", Required: true, Value: true},
+		{Path: "Pty/Prty", Type: "testParty"},
+		{Path: "Chrgs", Type: "testAmount", Value: true},
+		{Path: "Chrgs/@Ccy", Type: "string", Required: true, Attribute: true, Value: true},
+	}, DescribeElements(&testSchemaMessage{}))
+}
+
+func TestCheckElementValue(t *testing.T) {
+	msg := &testSchemaMessage{}
+
+	require.Nil(t, CheckElementValue(msg, "Pty/Lcl", "INST"))
+	require.Nil(t, CheckElementValue(msg, "Pty[1]/Prty/Lcl", "INST"))
+	require.Equal(t, "The value of testCode is invalid", CheckElementValue(msg, "Pty/Lcl", "NURG").Error())
+
+	require.Nil(t, CheckElementValue(msg, "Msg/CdtTrfTxInf/IntrBkSttlmAmt", "10.5"))
+	require.Equal(t, "The value of float64 is invalid", CheckElementValue(msg, "Msg/CdtTrfTxInf/IntrBkSttlmAmt", "ten").Error())
+	require.Nil(t, CheckElementValue(msg, "Chrgs/@Ccy", "EUR"))
+
+	require.Equal(t, "The element Pty/Unknown is unknown", CheckElementValue(msg, "Pty/Unknown", "").Error())
+	require.NotNil(t, CheckElementValue(msg, "Pty", "INST"))
+	require.NotNil(t, CheckElementValue(msg, "Msg/MsgId/Unknown", "INST"))
+}
@@ -0,0 +1,176 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package utils
+
+import (
+	"encoding/xml"
+	"errors"
+	"io"
+	"strings"
+)
+
+// ErrElementPassed is returned when decoding an element the reader has moved past
+var ErrElementPassed = errors.New("streamed element was decoded or passed")
+
+// StreamingReader reads the elements of an xml document one at a time, e.g. the transactions
+// of pacs.008 and pacs.003 batch files, so documents of any size are processed without being
+// held in memory:
+//
+//	reader := utils.NewStreamingReader(file)
+//	for {
+//		elm, err := reader.Next()
+//		if errors.Is(err, io.EOF) {
+//			break
+//		}
+//		if err != nil {
+//			return err
+//		}
+//		var tx pacs_v08.CreditTransferTransaction39
+//		if err := elm.Decode(&tx); err != nil {
+//			return err
+//		}
+//	}
+//
+// Only the emitted element being decoded is in memory, the elements around them are skipped.
+type StreamingReader struct {
+	// Elements are the local names of the emitted elements, TransactionElements unless set
+	// before the first call of Next. Emitted elements aren't searched for other elements.
+	Elements []string
+
+	// Emit, when set, selects the emitted elements instead of Elements by their local name and
+	// the local names of the elements holding them below the document, outermost first. It's
+	// called for every element the reader meets, those not selected are searched.
+	Emit func(parents []string, name string) bool
+
+	dec       *xml.Decoder
+	names     map[string]bool
+	namespace string
+	started   bool
+	stack     []string
+	current   *StreamedElement
+	count     int
+}
+
+// StreamedElement is an element emitted by a StreamingReader, it's decoded before the next
+// call of Next or skipped
+type StreamedElement struct {
+	// Name is the local name of the element and Path its slash-separated path below the
+	// document, e.g. FIToFICstmrCdtTrf/CdtTrfTxInf
+	Name string
+	Path string
+
+	// Index counts the elements emitted before it and Offset is the input offset of its start
+	Index  int
+	Offset int64
+
+	reader  *StreamingReader
+	start   xml.StartElement
+	decoded bool
+}
+
+// NewStreamingReader returns a reader of the transactions of the xml document read from r
+func NewStreamingReader(r io.Reader) *StreamingReader {
+	return &StreamingReader{Elements: TransactionElements, dec: xml.NewDecoder(r)}
+}
+
+// Namespace returns the namespace of the document, empty before the first call of Next
+func (s *StreamingReader) Namespace() string {
+	return s.namespace
+}
+
+// MessageType returns the message type of the document, e.g. pacs.008.001.08, empty before
+// the first call of Next
+func (s *StreamingReader) MessageType() string {
+	if s.namespace == "" {
+		return ""
+	}
+	return GetMessageType(s.namespace)
+}
+
+// InputOffset returns the number of bytes read, e.g. to report the progress of large files
+func (s *StreamingReader) InputOffset() int64 {
+	return s.dec.InputOffset()
+}
+
+// Next returns the next emitted element, io.EOF at the end of the document and
+// io.ErrUnexpectedEOF when the input has no document. The previous element is skipped unless
+// it was decoded.
+func (s *StreamingReader) Next() (*StreamedElement, error) {
+	if s.names == nil {
+		s.names = make(map[string]bool, len(s.Elements))
+		for _, name := range s.Elements {
+			s.names[name] = true
+		}
+	}
+	if current := s.current; current != nil {
+		s.current = nil
+		if !current.decoded {
+			current.decoded = true
+			if err := s.dec.Skip(); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	for {
+		offset := s.dec.InputOffset()
+		token, err := s.dec.Token()
+		if errors.Is(err, io.EOF) {
+			if !s.started {
+				return nil, io.ErrUnexpectedEOF
+			}
+			return nil, io.EOF
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		switch tok := token.(type) {
+		case xml.StartElement:
+			if !s.started {
+				s.started, s.namespace = true, tok.Name.Space
+				continue
+			}
+			if s.emits(tok.Name.Local) {
+				path := tok.Name.Local
+				if len(s.stack) > 0 {
+					path = strings.Join(s.stack, "/") + "/" + path
+				}
+				s.current = &StreamedElement{
+					Name:   tok.Name.Local,
+					Path:   path,
+					Index:  s.count,
+					Offset: offset,
+					reader: s,
+					start:  tok.Copy(),
+				}
+				s.count++
+				return s.current, nil
+			}
+			s.stack = append(s.stack, tok.Name.Local)
+		case xml.EndElement:
+			if len(s.stack) > 0 {
+				s.stack = s.stack[:len(s.stack)-1]
+			}
+		}
+	}
+}
+
+func (s *StreamingReader) emits(name string) bool {
+	if s.Emit != nil {
+		return s.Emit(s.stack, name)
+	}
+	return s.names[name]
+}
+
+// Decode decodes the element into v, e.g. a *pacs_v08.CreditTransferTransaction39, like
+// xml.Unmarshal. Elements are decoded once, before the next call of Next of their reader.
+func (e *StreamedElement) Decode(v interface{}) error {
+	if e.decoded || e.reader.current != e {
+		return ErrElementPassed
+	}
+	e.decoded = true
+	return e.reader.dec.DecodeElement(v, &e.start)
+}
@@ -0,0 +1,133 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package utils_test
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/moov-io/iso20022/pkg/pacs_v08"
+	"github.com/moov-io/iso20022/pkg/utils"
+)
+
+// countingReader counts the bytes read from r
+type countingReader struct {
+	r io.Reader
+	n int64
+}
+
+func (c *countingReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	c.n += int64(n)
+	return n, err
+}
+
+// batchFile returns the pacs.008 of the testdata with n copies of its first transaction
+func batchFile(t *testing.T, n int) []byte {
+	buf, err := os.ReadFile(filepath.Join("..", "..", "test", "testdata", "valid_pacs_v08.xml"))
+	require.Nil(t, err)
+	doc := string(buf)
+	start, end := strings.Index(doc, "<CdtTrfTxInf>"), strings.LastIndex(doc, "</CdtTrfTxInf>")+len("</CdtTrfTxInf>")
+	first := doc[start : strings.Index(doc, "</CdtTrfTxInf>")+len("</CdtTrfTxInf>")]
+	return []byte(doc[:start] + strings.Repeat(first, n) + doc[end:])
+}
+
+func TestStreamingReader(t *testing.T) {
+	input := batchFile(t, 5000)
+	counter := &countingReader{r: bytes.NewReader(input)}
+	reader := utils.NewStreamingReader(counter)
+	require.Empty(t, reader.MessageType())
+
+	count := 0
+	for {
+		elm, err := reader.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		require.Nil(t, err)
+		require.Equal(t, "CdtTrfTxInf", elm.Name)
+		require.Equal(t, "FIToFICstmrCdtTrf/CdtTrfTxInf", elm.Path)
+		require.Equal(t, count, elm.Index)
+
+		// transactions are decoded as they're read
+		var tx pacs_v08.CreditTransferTransaction39
+		require.Nil(t, elm.Decode(&tx))
+		require.Nil(t, tx.Validate())
+		require.Equal(t, "pacs.008.001.08", reader.MessageType())
+		if count == 0 {
+			require.Less(t, counter.n, int64(len(input))/10)
+		}
+		require.ErrorIs(t, elm.Decode(&tx), utils.ErrElementPassed)
+		count++
+	}
+	require.Equal(t, 5000, count)
+	require.Equal(t, int64(len(input)), reader.InputOffset())
+}
+
+func TestStreamingReaderElements(t *testing.T) {
+	reader := utils.NewStreamingReader(bytes.NewReader(batchFile(t, 3)))
+	reader.Elements = []string{"GrpHdr", "CdtTrfTxInf"}
+
+	// elements that aren't decoded are skipped
+	var names []string
+	var header pacs_v08.GroupHeader93
+	for {
+		elm, err := reader.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		require.Nil(t, err)
+		names = append(names, elm.Name)
+		if elm.Name == "GrpHdr" {
+			require.Nil(t, elm.Decode(&header))
+		}
+	}
+	require.Equal(t, []string{"GrpHdr", "CdtTrfTxInf", "CdtTrfTxInf", "CdtTrfTxInf"}, names)
+	require.NotEmpty(t, header.MsgId)
+
+	_, err := utils.NewStreamingReader(strings.NewReader("")).Next()
+	require.ErrorIs(t, err, io.ErrUnexpectedEOF)
+	reader = utils.NewStreamingReader(strings.NewReader(`<Document xmlns="urn:iso:std:iso:20022:tech:xsd:pacs.008.001.08"><FIToFICstmrCdtTrf><CdtTrfTxInf>`))
+	elm, err := reader.Next()
+	require.Nil(t, err)
+	_, err = reader.Next()
+	require.NotNil(t, err)
+	require.ErrorIs(t, elm.Decode(&pacs_v08.CreditTransferTransaction39{}), utils.ErrElementPassed)
+}
+
+func TestStreamingReaderEmit(t *testing.T) {
+	reader := utils.NewStreamingReader(bytes.NewReader(batchFile(t, 2)))
+	var parents []string
+	reader.Emit = func(holders []string, name string) bool {
+		if name == "MsgId" {
+			parents = append([]string(nil), holders...)
+		}
+		return len(holders) == 2 && holders[1] == "GrpHdr"
+	}
+
+	// the children of the selected parents are emitted, the other elements are searched
+	var paths []string
+	for {
+		elm, err := reader.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		require.Nil(t, err)
+		paths = append(paths, elm.Path)
+	}
+	require.Equal(t, []string{"FIToFICstmrCdtTrf", "GrpHdr"}, parents)
+	require.Contains(t, paths, "FIToFICstmrCdtTrf/GrpHdr/MsgId")
+	require.NotContains(t, paths, "FIToFICstmrCdtTrf/CdtTrfTxInf")
+	for _, path := range paths {
+		require.True(t, strings.HasPrefix(path, "FIToFICstmrCdtTrf/GrpHdr/"), path)
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"encoding/xml"
 	"io"
+	"strings"
 )
 
 type DocumentType string
@@ -15,8 +16,16 @@ const (
 	DocumentTypeUnknown DocumentType = "unknown"
 
 	XmlDefaultNamespace = "xmlns"
+
+	// NameSpacePrefix is the common prefix of iso 20022 document namespaces
+	NameSpacePrefix = "urn:iso:std:iso:20022:tech:xsd:"
 )
 
+// GetMessageType returns message type of namespace (e.g. pacs.008.001.08)
+func GetMessageType(namespace string) string {
+	return strings.TrimPrefix(namespace, NameSpacePrefix)
+}
+
 func GetDocumentFormat(buf []byte) DocumentType {
 	if json.Valid(buf) {
 		return DocumentTypeJson
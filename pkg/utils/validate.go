@@ -46,23 +46,28 @@ func validateCallbackByValue(data reflect.Value) error {
 		if len(response) > 0 {
 			err := response[0]
 			if !err.IsNil() {
-				typeName := getTypeName(data.String())
-				if len(typeName) > 0 {
-					errStr := err.Interface().(error).Error()
-					if !strings.Contains(errStr, ")") {
-						errStr = errStr + " (" + typeName + ")"
-					} else {
-						errStr = errStr[:len(errStr)-1] + ", " + typeName + ")"
-					}
-					return errors.New(errStr)
-				}
-				return err.Interface().(error)
+				return wrapValidateError(data, err.Interface().(error))
 			}
 		}
 	}
 	return nil
 }
 
+// wrapValidateError appends the type name of data to the types of err
+func wrapValidateError(data reflect.Value, err error) error {
+	typeName := getTypeName(data.String())
+	if len(typeName) > 0 {
+		errStr := err.Error()
+		if !strings.Contains(errStr, ")") {
+			errStr = errStr + " (" + typeName + ")"
+		} else {
+			errStr = errStr[:len(errStr)-1] + ", " + typeName + ")"
+		}
+		return errors.New(errStr)
+	}
+	return err
+}
+
 // to validate interface
 func Validate(r interface{}) error {
 	var err error
@@ -0,0 +1,121 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package zstd
+
+import (
+	"encoding/binary"
+	"math/bits"
+)
+
+// bitWriter writes bit streams from their least significant bit, the streams of FSE and
+// Huffman are read backward from their last bit
+type bitWriter struct {
+	out   []byte
+	value uint64
+	n     uint
+}
+
+// add writes the n (at most 32) low bits of value
+func (w *bitWriter) add(value uint64, n uint) {
+	w.value |= (value & (1<<n - 1)) << w.n
+	w.n += n
+	for w.n >= 8 {
+		w.out = append(w.out, byte(w.value))
+		w.value >>= 8
+		w.n -= 8
+	}
+}
+
+// close writes the end mark of the stream and returns it
+func (w *bitWriter) close() []byte {
+	w.add(1, 1)
+	if w.n > 0 {
+		w.out = append(w.out, byte(w.value))
+		w.value, w.n = 0, 0
+	}
+	return w.out
+}
+
+// reverseReader reads a bit stream backward from the bit preceding its end mark
+type reverseReader struct {
+	in  []byte
+	pos int
+}
+
+func newReverseReader(in []byte) (*reverseReader, error) {
+	if len(in) == 0 || in[len(in)-1] == 0 {
+		return nil, errorf("bit stream has no end mark")
+	}
+	last := in[len(in)-1]
+	return &reverseReader{in: in, pos: (len(in)-1)*8 + bits.Len8(last) - 1}, nil
+}
+
+// peek returns the next n (at most 56) bits, missing bits past the start of the stream are zeros
+func (r *reverseReader) peek(n uint) uint64 {
+	if n == 0 {
+		return 0
+	}
+	start := r.pos - int(n)
+	if start < 0 {
+		if r.pos <= 0 {
+			return 0
+		}
+		return r.load(0) & (1<<uint(r.pos) - 1) << uint(-start)
+	}
+	return r.load(start) & (1<<n - 1)
+}
+
+// load returns the bits of the stream from the bit start
+func (r *reverseReader) load(start int) uint64 {
+	i := start >> 3
+	var buf [8]byte
+	copy(buf[:], r.in[i:])
+	return binary.LittleEndian.Uint64(buf[:]) >> uint(start&7)
+}
+
+func (r *reverseReader) read(n uint) uint64 {
+	value := r.peek(n)
+	r.pos -= int(n)
+	return value
+}
+
+// overflowed reports whether more bits were read than the stream holds
+func (r *reverseReader) overflowed() bool {
+	return r.pos < 0
+}
+
+// finished reports whether every bit of the stream was read
+func (r *reverseReader) finished() bool {
+	return r.pos == 0
+}
+
+// forwardReader reads the bits of the table descriptions from their least significant bit
+type forwardReader struct {
+	in  []byte
+	pos int
+}
+
+func (r *forwardReader) peek(n uint) uint64 {
+	i := r.pos >> 3
+	if i >= len(r.in) {
+		return 0
+	}
+	var buf [8]byte
+	copy(buf[:], r.in[i:])
+	return binary.LittleEndian.Uint64(buf[:]) >> uint(r.pos&7) & (1<<n - 1)
+}
+
+func (r *forwardReader) skip(n uint) {
+	r.pos += int(n)
+}
+
+// bytes returns the number of bytes holding the bits read
+func (r *forwardReader) bytes() int {
+	return (r.pos + 7) / 8
+}
+
+func highBit(v uint32) uint {
+	return uint(bits.Len32(v)) - 1
+}
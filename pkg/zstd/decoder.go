@@ -0,0 +1,443 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package zstd
+
+import (
+	"encoding/binary"
+)
+
+// Block types
+const (
+	blockRaw = iota
+	blockRLE
+	blockCompressed
+)
+
+// Literals block types
+const (
+	literalsRaw = iota
+	literalsRLE
+	literalsCompressed
+	literalsTreeless
+)
+
+// Symbol compression modes of the sequences tables
+const (
+	modePredefined = iota
+	modeRLE
+	modeFSE
+	modeRepeat
+)
+
+// decoder appends the content of frames to out
+type decoder struct {
+	out []byte
+
+	// start of the content of the current frame in out
+	start int
+
+	// tables and repeat offsets of the previous blocks of the frame
+	huffman     *huffmanTable
+	literals    *fseTable
+	offsets     *fseTable
+	matches     *fseTable
+	repeats     [3]uint32
+	literalsBuf []byte
+}
+
+// frame decodes the frame at the start of in and returns its size
+func (d *decoder) frame(in []byte) (int, error) {
+	if len(in) < 4 {
+		return 0, errorf("frame is truncated")
+	}
+	magic := binary.LittleEndian.Uint32(in)
+	if magic&skippableMagicMask == skippableMagic {
+		if len(in) < 8 {
+			return 0, errorf("skippable frame is truncated")
+		}
+		size := int(binary.LittleEndian.Uint32(in[4:]))
+		if size > len(in)-8 {
+			return 0, errorf("skippable frame is truncated")
+		}
+		return 8 + size, nil
+	}
+	if magic != frameMagic {
+		return 0, errorf("unknown magic number %08x", magic)
+	}
+
+	pos := 4
+	if len(in) < pos+1 {
+		return 0, errorf("frame header is truncated")
+	}
+	descriptor := in[pos]
+	pos++
+	if descriptor&0x08 != 0 {
+		return 0, errorf("frame header has its reserved bit set")
+	}
+	singleSegment := descriptor&0x20 != 0
+	checksum := descriptor&0x04 != 0
+	dictionarySize := [4]int{0, 1, 2, 4}[descriptor&3]
+	contentSize := [4]int{0, 2, 4, 8}[descriptor>>6]
+	if contentSize == 0 && singleSegment {
+		contentSize = 1
+	}
+	headerSize := dictionarySize + contentSize
+	if !singleSegment {
+		headerSize++
+	}
+	if len(in) < pos+headerSize {
+		return 0, errorf("frame header is truncated")
+	}
+	if !singleSegment {
+		// the window descriptor, the whole content is kept in memory
+		pos++
+	}
+	var dictionary uint64
+	for i := 0; i < dictionarySize; i++ {
+		dictionary |= uint64(in[pos+i]) << (8 * i)
+	}
+	pos += dictionarySize
+	if dictionary != 0 {
+		return 0, ErrDictionary
+	}
+	expected := int64(-1)
+	if contentSize > 0 {
+		var size uint64
+		for i := 0; i < contentSize; i++ {
+			size |= uint64(in[pos+i]) << (8 * i)
+		}
+		if contentSize == 2 {
+			size += 256
+		}
+		expected = int64(size)
+		pos += contentSize
+	}
+
+	d.start = len(d.out)
+	d.huffman, d.literals, d.offsets, d.matches = nil, nil, nil, nil
+	d.repeats = [3]uint32{1, 4, 8}
+	for last := false; !last; {
+		if len(in) < pos+3 {
+			return 0, errorf("block header is truncated")
+		}
+		header := uint32(in[pos]) | uint32(in[pos+1])<<8 | uint32(in[pos+2])<<16
+		pos += 3
+		last = header&1 != 0
+		size := int(header >> 3)
+		switch (header >> 1) & 3 {
+		case blockRaw:
+			if len(in) < pos+size {
+				return 0, errorf("raw block is truncated")
+			}
+			d.out = append(d.out, in[pos:pos+size]...)
+			pos += size
+		case blockRLE:
+			if len(in) < pos+1 {
+				return 0, errorf("rle block is truncated")
+			}
+			if size > maxBlockSize {
+				return 0, errorf("rle block of %d bytes is too large", size)
+			}
+			for i := 0; i < size; i++ {
+				d.out = append(d.out, in[pos])
+			}
+			pos++
+		case blockCompressed:
+			if len(in) < pos+size {
+				return 0, errorf("compressed block is truncated")
+			}
+			if err := d.block(in[pos : pos+size]); err != nil {
+				return 0, err
+			}
+			pos += size
+		default:
+			return 0, errorf("block type is reserved")
+		}
+		if expected >= 0 && int64(len(d.out)-d.start) > expected {
+			return 0, errorf("frame is larger than its content size %d", expected)
+		}
+	}
+	if expected >= 0 && int64(len(d.out)-d.start) != expected {
+		return 0, errorf("frame has %d bytes instead of %d", len(d.out)-d.start, expected)
+	}
+	if checksum {
+		if len(in) < pos+4 {
+			return 0, errorf("content checksum is truncated")
+		}
+		if uint32(xxhash64(d.out[d.start:])) != binary.LittleEndian.Uint32(in[pos:]) {
+			return 0, errorf("content checksum doesn't match")
+		}
+		pos += 4
+	}
+	return pos, nil
+}
+
+// block decodes a compressed block
+func (d *decoder) block(in []byte) error {
+	literals, n, err := d.readLiterals(in)
+	if err != nil {
+		return err
+	}
+	in = in[n:]
+
+	if len(in) == 0 {
+		return errorf("sequences section is missing")
+	}
+	count := int(in[0])
+	switch {
+	case count == 0:
+		in = in[1:]
+	case count < 128:
+		in = in[1:]
+	case count < 255:
+		if len(in) < 2 {
+			return errorf("sequences section is truncated")
+		}
+		count = (count-128)<<8 + int(in[1])
+		in = in[2:]
+	default:
+		if len(in) < 3 {
+			return errorf("sequences section is truncated")
+		}
+		count = int(binary.LittleEndian.Uint16(in[1:])) + 0x7F00
+		in = in[3:]
+	}
+	start := len(d.out)
+	if count == 0 {
+		if len(in) != 0 {
+			return errorf("block has %d extra bytes", len(in))
+		}
+		d.out = append(d.out, literals...)
+		return nil
+	}
+
+	if len(in) == 0 {
+		return errorf("sequences section is truncated")
+	}
+	modes := in[0]
+	if modes&3 != 0 {
+		return errorf("symbol compression modes have reserved bits set")
+	}
+	in = in[1:]
+	if d.literals, n, err = readTable(in, modes>>6, d.literals, predefinedLiteralLengthsTable, maxLiteralLengthCode, maxLiteralLengthLog); err != nil {
+		return err
+	}
+	in = in[n:]
+	if d.offsets, n, err = readTable(in, (modes>>4)&3, d.offsets, predefinedOffsetsTable, maxOffsetCode, maxOffsetLog); err != nil {
+		return err
+	}
+	in = in[n:]
+	if d.matches, n, err = readTable(in, (modes>>2)&3, d.matches, predefinedMatchLengthsTable, maxMatchLengthCode, maxMatchLengthLog); err != nil {
+		return err
+	}
+	in = in[n:]
+
+	r, err := newReverseReader(in)
+	if err != nil {
+		return err
+	}
+	ll, of, ml := &fseDecoder{table: d.literals}, &fseDecoder{table: d.offsets}, &fseDecoder{table: d.matches}
+	ll.init(r)
+	of.init(r)
+	ml.init(r)
+	for i := 0; i < count; i++ {
+		ofCode, mlCode, llCode := of.symbol(), ml.symbol(), ll.symbol()
+		if int(ofCode) > maxOffsetCode || int(mlCode) > maxMatchLengthCode || int(llCode) > maxLiteralLengthCode {
+			return errorf("sequence code is invalid")
+		}
+		seq := sequence{offset: 1<<ofCode + uint32(r.read(uint(ofCode)))}
+		code := matchLengthCodes[mlCode]
+		seq.match = code.baseline + uint32(r.read(uint(code.bits)))
+		code = literalLengthCodes[llCode]
+		seq.literals = code.baseline + uint32(r.read(uint(code.bits)))
+		if i < count-1 {
+			ll.update(r)
+			ml.update(r)
+			of.update(r)
+		}
+		if r.overflowed() {
+			return errorf("sequences bit stream is truncated")
+		}
+		if literals, err = d.execute(seq, literals); err != nil {
+			return err
+		}
+	}
+	if !r.finished() {
+		return errorf("sequences bit stream has %d extra bits", r.pos)
+	}
+	d.out = append(d.out, literals...)
+	if len(d.out)-start > maxBlockSize {
+		return errorf("block of %d bytes is too large", len(d.out)-start)
+	}
+	return nil
+}
+
+// execute copies the literals and the match of seq, it returns the remaining literals
+func (d *decoder) execute(seq sequence, literals []byte) ([]byte, error) {
+	if int(seq.literals) > len(literals) {
+		return nil, errorf("sequence has %d literals but %d remain", seq.literals, len(literals))
+	}
+	d.out = append(d.out, literals[:seq.literals]...)
+	literals = literals[seq.literals:]
+
+	var offset uint32
+	if seq.offset > 3 {
+		offset = seq.offset - 3
+		d.repeats = [3]uint32{offset, d.repeats[0], d.repeats[1]}
+	} else {
+		index := seq.offset
+		if seq.literals == 0 {
+			index++
+		}
+		switch index {
+		case 1:
+			offset = d.repeats[0]
+		case 2:
+			offset = d.repeats[1]
+			d.repeats = [3]uint32{offset, d.repeats[0], d.repeats[2]}
+		case 3:
+			offset = d.repeats[2]
+			d.repeats = [3]uint32{offset, d.repeats[0], d.repeats[1]}
+		default:
+			offset = d.repeats[0] - 1
+			d.repeats = [3]uint32{offset, d.repeats[0], d.repeats[1]}
+		}
+	}
+	if offset == 0 || int(offset) > len(d.out)-d.start {
+		return nil, errorf("match offset %d is out of the frame", offset)
+	}
+	if seq.match > maxBlockSize {
+		return nil, errorf("match length %d is too large", seq.match)
+	}
+	from := len(d.out) - int(offset)
+	for i := 0; i < int(seq.match); i++ {
+		d.out = append(d.out, d.out[from+i])
+	}
+	return literals, nil
+}
+
+// readLiterals reads the literals section of a block, it returns the literals and the size of
+// the section
+func (d *decoder) readLiterals(in []byte) ([]byte, int, error) {
+	if len(in) == 0 {
+		return nil, 0, errorf("literals section is missing")
+	}
+	kind := in[0] & 3
+	format := (in[0] >> 2) & 3
+	if kind == literalsRaw || kind == literalsRLE {
+		var size, n int
+		switch format {
+		case 0, 2:
+			size, n = int(in[0]>>3), 1
+		case 1:
+			if len(in) < 2 {
+				return nil, 0, errorf("literals header is truncated")
+			}
+			size, n = int(in[0]>>4)+int(in[1])<<4, 2
+		default:
+			if len(in) < 3 {
+				return nil, 0, errorf("literals header is truncated")
+			}
+			size, n = int(in[0]>>4)+int(in[1])<<4+int(in[2])<<12, 3
+		}
+		if size > maxBlockSize {
+			return nil, 0, errorf("%d literals are too many", size)
+		}
+		if kind == literalsRaw {
+			if len(in) < n+size {
+				return nil, 0, errorf("raw literals are truncated")
+			}
+			return in[n : n+size], n + size, nil
+		}
+		if len(in) < n+1 {
+			return nil, 0, errorf("rle literals are truncated")
+		}
+		literals := d.literalsBuf[:0]
+		for i := 0; i < size; i++ {
+			literals = append(literals, in[n])
+		}
+		d.literalsBuf = literals
+		return literals, n + 1, nil
+	}
+
+	var regenerated, compressed, n int
+	streams := 4
+	switch format {
+	case 0, 1:
+		if len(in) < 3 {
+			return nil, 0, errorf("literals header is truncated")
+		}
+		v := int(in[0]) | int(in[1])<<8 | int(in[2])<<16
+		regenerated, compressed, n = v>>4&0x3FF, v>>14&0x3FF, 3
+		if format == 0 {
+			streams = 1
+		}
+	case 2:
+		if len(in) < 4 {
+			return nil, 0, errorf("literals header is truncated")
+		}
+		v := int(binary.LittleEndian.Uint32(in))
+		regenerated, compressed, n = v>>4&0x3FFF, v>>18&0x3FFF, 4
+	default:
+		if len(in) < 5 {
+			return nil, 0, errorf("literals header is truncated")
+		}
+		v := int(binary.LittleEndian.Uint32(in)) | int(in[4])<<32
+		regenerated, compressed, n = v>>4&0x3FFFF, v>>22&0x3FFFF, 5
+	}
+	if regenerated > maxBlockSize {
+		return nil, 0, errorf("%d literals are too many", regenerated)
+	}
+	if len(in) < n+compressed {
+		return nil, 0, errorf("compressed literals are truncated")
+	}
+	data := in[n : n+compressed]
+	if kind == literalsCompressed {
+		table, size, err := readHuffmanTable(data)
+		if err != nil {
+			return nil, 0, err
+		}
+		d.huffman = table
+		data = data[size:]
+	} else if d.huffman == nil {
+		return nil, 0, errorf("treeless literals without previous huffman tree")
+	}
+
+	var literals []byte
+	var err error
+	if streams == 1 {
+		literals, err = d.huffman.decode(d.literalsBuf[:0], data, regenerated)
+	} else {
+		literals, err = d.huffman.decode4(d.literalsBuf[:0], data, regenerated)
+	}
+	if err != nil {
+		return nil, 0, err
+	}
+	d.literalsBuf = literals
+	return literals, n + compressed, nil
+}
+
+// readTable returns the table of a symbol compression mode and the size of its description
+func readTable(in []byte, mode uint8, previous, predefined *fseTable, maxSymbol int, maxLog uint) (*fseTable, int, error) {
+	switch mode {
+	case modePredefined:
+		return predefined, 0, nil
+	case modeRLE:
+		if len(in) == 0 {
+			return nil, 0, errorf("rle table is truncated")
+		}
+		if int(in[0]) > maxSymbol {
+			return nil, 0, errorf("rle symbol %d is invalid", in[0])
+		}
+		return newRLETable(in[0]), 1, nil
+	case modeFSE:
+		return readFSETable(in, maxSymbol, maxLog)
+	default:
+		if previous == nil {
+			return nil, 0, errorf("repeat table without previous table")
+		}
+		return previous, 0, nil
+	}
+}
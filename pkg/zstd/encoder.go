@@ -0,0 +1,343 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package zstd
+
+import (
+	"encoding/binary"
+	"math/bits"
+)
+
+const (
+	minMatch = 4
+
+	// maxDistance is the largest offset of the matches searched
+	maxDistance = 1 << 20
+
+	// minHuffmanLiterals is the number of literals worth their Huffman tree
+	minHuffmanLiterals = 64
+)
+
+// levels are the number of candidates compared for each match and the number of following
+// positions checked for a longer match
+var levels = [MaxLevel + 1]struct {
+	depth int
+	lazy  int
+}{
+	1: {depth: 1},
+	2: {depth: 2},
+	3: {depth: 4, lazy: 1},
+	4: {depth: 8, lazy: 1},
+	5: {depth: 16, lazy: 1},
+	6: {depth: 32, lazy: 1},
+	7: {depth: 64, lazy: 2},
+	8: {depth: 128, lazy: 2},
+	9: {depth: 256, lazy: 2},
+}
+
+// encoder finds the matches of a frame with hash chains
+type encoder struct {
+	depth int
+	lazy  int
+
+	src      []byte
+	hashLog  uint
+	head     []int32
+	chain    []int32
+	inserted int
+}
+
+func newEncoder(level int) *encoder {
+	return &encoder{depth: levels[level].depth, lazy: levels[level].lazy}
+}
+
+func (e *encoder) frame(src []byte) []byte {
+	e.src = src
+	e.hashLog = uint(bits.Len(uint(len(src))))
+	if e.hashLog < 10 {
+		e.hashLog = 10
+	}
+	if e.hashLog > 17 {
+		e.hashLog = 17
+	}
+	e.head = make([]int32, 1<<e.hashLog)
+	for i := range e.head {
+		e.head[i] = -1
+	}
+	window := len(src)
+	if window > maxDistance {
+		window = maxDistance
+	}
+	e.chain = make([]int32, window)
+
+	out := make([]byte, 0, len(src)/3+32)
+	out = binary.LittleEndian.AppendUint32(out, frameMagic)
+	switch size := uint64(len(src)); {
+	case size < 256:
+		out = append(out, 0x24, byte(size))
+	case size < 65536+256:
+		out = append(out, 0x64)
+		out = binary.LittleEndian.AppendUint16(out, uint16(size-256))
+	case size < 1<<32:
+		out = append(out, 0xA4)
+		out = binary.LittleEndian.AppendUint32(out, uint32(size))
+	default:
+		out = append(out, 0xE4)
+		out = binary.LittleEndian.AppendUint64(out, size)
+	}
+
+	if len(src) == 0 {
+		out = appendBlockHeader(out, true, blockRaw, 0)
+	}
+	for start := 0; start < len(src); start += maxBlockSize {
+		end := start + maxBlockSize
+		if end > len(src) {
+			end = len(src)
+		}
+		out = e.block(out, start, end, end == len(src))
+	}
+	return binary.LittleEndian.AppendUint32(out, uint32(xxhash64(src)))
+}
+
+func appendBlockHeader(out []byte, last bool, kind, size int) []byte {
+	header := uint32(size)<<3 | uint32(kind)<<1
+	if last {
+		header |= 1
+	}
+	return append(out, byte(header), byte(header>>8), byte(header>>16))
+}
+
+// block appends the smallest block of src[start:end]
+func (e *encoder) block(out []byte, start, end int, last bool) []byte {
+	data := e.src[start:end]
+	rle := true
+	for _, b := range data[1:] {
+		if b != data[0] {
+			rle = false
+			break
+		}
+	}
+	if rle && len(data) > 1 {
+		e.insertUntil(end)
+		out = appendBlockHeader(out, last, blockRLE, len(data))
+		return append(out, data[0])
+	}
+
+	sequences, literals := e.parse(start, end)
+	compressed := appendLiterals(nil, literals)
+	compressed = appendSequences(compressed, sequences)
+	if len(compressed) >= len(data) {
+		out = appendBlockHeader(out, last, blockRaw, len(data))
+		return append(out, data...)
+	}
+	out = appendBlockHeader(out, last, blockCompressed, len(compressed))
+	return append(out, compressed...)
+}
+
+func (e *encoder) hash(i int) uint32 {
+	return binary.LittleEndian.Uint32(e.src[i:]) * 2654435761 >> (32 - e.hashLog)
+}
+
+// insertUntil adds the positions before end to the hash chains
+func (e *encoder) insertUntil(end int) {
+	if end > len(e.src)-minMatch+1 {
+		end = len(e.src) - minMatch + 1
+	}
+	for ; e.inserted < end; e.inserted++ {
+		h := e.hash(e.inserted)
+		e.chain[e.inserted%len(e.chain)] = e.head[h]
+		e.head[h] = int32(e.inserted)
+	}
+}
+
+// match returns the longest match at i ending before end among the candidates inserted
+func (e *encoder) match(i, end int) (length, offset int) {
+	src := e.src
+	candidate := int(e.head[e.hash(i)])
+	for depth := 0; depth < e.depth && candidate >= 0 && i-candidate < len(e.chain); depth++ {
+		if src[candidate+length] == src[i+length] {
+			n := 0
+			for i+n < end && src[candidate+n] == src[i+n] {
+				n++
+			}
+			if n > length {
+				length, offset = n, i-candidate
+				if i+n == end {
+					break
+				}
+			}
+		}
+		candidate = int(e.chain[candidate%len(e.chain)])
+	}
+	if length < minMatch {
+		return 0, 0
+	}
+	return length, offset
+}
+
+// parse returns the sequences and literals of src[start:end], matching greedily then checking
+// the following positions for longer matches
+func (e *encoder) parse(start, end int) ([]sequence, []byte) {
+	var sequences []sequence
+	var literals []byte
+	anchor := start
+	for i := start; i+minMatch <= end; {
+		e.insertUntil(i)
+		length, offset := e.match(i, end)
+		if length == 0 {
+			i++
+			continue
+		}
+		for step := 0; step < e.lazy && i+1+minMatch <= end; step++ {
+			e.insertUntil(i + 1)
+			next, nextOffset := e.match(i+1, end)
+			if next <= length {
+				break
+			}
+			i, length, offset = i+1, next, nextOffset
+		}
+		literals = append(literals, e.src[anchor:i]...)
+		sequences = append(sequences, sequence{
+			literals: uint32(i - anchor),
+			match:    uint32(length),
+			offset:   uint32(offset + 3),
+		})
+		i += length
+		anchor = i
+	}
+	e.insertUntil(end)
+	return sequences, append(literals, e.src[anchor:end]...)
+}
+
+// appendLiterals appends the literals section, Huffman coded when it's smaller
+func appendLiterals(out, literals []byte) []byte {
+	size := len(literals)
+	if size >= minHuffmanLiterals {
+		if description, codes, ok := huffmanEncoding(literals); ok {
+			if section := compressedLiterals(description, codes, literals); section != nil && len(section) < size {
+				return append(out, section...)
+			}
+		}
+	}
+	switch {
+	case size < 32:
+		out = append(out, byte(size<<3|literalsRaw))
+	case size < 4096:
+		out = append(out, byte(size<<4|1<<2|literalsRaw), byte(size>>4))
+	default:
+		out = append(out, byte(size<<4|3<<2|literalsRaw), byte(size>>4), byte(size>>12))
+	}
+	return append(out, literals...)
+}
+
+// compressedLiterals returns the literals section of a tree and a single stream for short
+// literals, or 4 streams, nil when the sizes don't fit its header
+func compressedLiterals(description []byte, codes []huffmanCode, literals []byte) []byte {
+	size := len(literals)
+	data := append([]byte(nil), description...)
+	streams := 1
+	if size < 256 {
+		data = append(data, huffmanStream(literals, codes)...)
+	} else {
+		streams = 4
+		segment := (size + 3) / 4
+		var parts [4][]byte
+		for i := range parts {
+			from, to := i*segment, (i+1)*segment
+			if to > size {
+				to = size
+			}
+			parts[i] = huffmanStream(literals[from:to], codes)
+		}
+		for _, part := range parts[:3] {
+			if len(part) > 0xFFFF {
+				return nil
+			}
+			data = binary.LittleEndian.AppendUint16(data, uint16(len(part)))
+		}
+		for _, part := range parts {
+			data = append(data, part...)
+		}
+	}
+
+	var header []byte
+	compressed := len(data)
+	largest := size
+	if compressed > largest {
+		largest = compressed
+	}
+	switch {
+	case streams == 1 && largest < 1<<10:
+		v := literalsCompressed | size<<4 | compressed<<14
+		header = []byte{byte(v), byte(v >> 8), byte(v >> 16)}
+	case largest < 1<<10:
+		v := literalsCompressed | 1<<2 | size<<4 | compressed<<14
+		header = []byte{byte(v), byte(v >> 8), byte(v >> 16)}
+	case largest < 1<<14:
+		v := literalsCompressed | 2<<2 | size<<4 | compressed<<18
+		header = []byte{byte(v), byte(v >> 8), byte(v >> 16), byte(v >> 24)}
+	case largest < 1<<18:
+		v := uint64(literalsCompressed | 3<<2 | size<<4 | compressed<<22)
+		header = []byte{byte(v), byte(v >> 8), byte(v >> 16), byte(v >> 24), byte(v >> 32)}
+	default:
+		return nil
+	}
+	return append(header, data...)
+}
+
+// appendSequences appends the sequences section, coded with the predefined distributions
+func appendSequences(out []byte, sequences []sequence) []byte {
+	switch count := len(sequences); {
+	case count < 128:
+		out = append(out, byte(count))
+	case count < 0x7F00:
+		out = append(out, byte(count>>8+128), byte(count))
+	default:
+		out = append(out, 255)
+		out = binary.LittleEndian.AppendUint16(out, uint16(count-0x7F00))
+	}
+	if len(sequences) == 0 {
+		return out
+	}
+	out = append(out, modePredefined<<6|modePredefined<<4|modePredefined<<2)
+
+	type codes struct {
+		literals, match, offset uint8
+	}
+	symbols := make([]codes, len(sequences))
+	for i, seq := range sequences {
+		symbols[i] = codes{
+			literals: literalLengthCode(seq.literals),
+			match:    matchLengthCode(seq.match),
+			offset:   uint8(highBit(seq.offset)),
+		}
+	}
+
+	w := &bitWriter{out: out}
+	ll := &fseEncoder{table: predefinedLiteralLengthsEncoding}
+	ml := &fseEncoder{table: predefinedMatchLengthsEncoding}
+	of := &fseEncoder{table: predefinedOffsetsEncoding}
+	extra := func(seq sequence, c codes) {
+		code := literalLengthCodes[c.literals]
+		w.add(uint64(seq.literals-code.baseline), uint(code.bits))
+		code = matchLengthCodes[c.match]
+		w.add(uint64(seq.match-code.baseline), uint(code.bits))
+		w.add(uint64(seq.offset), uint(c.offset))
+	}
+	last := len(sequences) - 1
+	ml.init(symbols[last].match)
+	of.init(symbols[last].offset)
+	ll.init(symbols[last].literals)
+	extra(sequences[last], symbols[last])
+	for i := last - 1; i >= 0; i-- {
+		of.encode(w, symbols[i].offset)
+		ml.encode(w, symbols[i].match)
+		ll.encode(w, symbols[i].literals)
+		extra(sequences[i], symbols[i])
+	}
+	ml.flush(w)
+	of.flush(w)
+	ll.flush(w)
+	return w.close()
+}
@@ -0,0 +1,307 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package zstd
+
+// Finite State Entropy tables of the sequences (RFC 8878 section 4.1)
+
+// Predefined distributions of the literal lengths, match lengths and offsets codes
+var (
+	predefinedLiteralLengths = []int16{
+		4, 3, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 1, 1, 1,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 3, 2, 1, 1, 1, 1, 1,
+		-1, -1, -1, -1,
+	}
+	predefinedMatchLengths = []int16{
+		1, 4, 3, 2, 2, 2, 2, 2, 2, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, -1, -1,
+		-1, -1, -1, -1, -1,
+	}
+	predefinedOffsets = []int16{
+		1, 1, 1, 1, 1, 1, 2, 2, 2, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, -1, -1, -1, -1, -1,
+	}
+)
+
+const (
+	predefinedLiteralLengthsLog = 6
+	predefinedMatchLengthsLog   = 6
+	predefinedOffsetsLog        = 5
+)
+
+// Tables of the predefined distributions
+var (
+	predefinedLiteralLengthsTable = mustFSETable(predefinedLiteralLengths, predefinedLiteralLengthsLog)
+	predefinedOffsetsTable        = mustFSETable(predefinedOffsets, predefinedOffsetsLog)
+	predefinedMatchLengthsTable   = mustFSETable(predefinedMatchLengths, predefinedMatchLengthsLog)
+
+	predefinedLiteralLengthsEncoding = newFSEEncoding(predefinedLiteralLengths, predefinedLiteralLengthsLog)
+	predefinedOffsetsEncoding        = newFSEEncoding(predefinedOffsets, predefinedOffsetsLog)
+	predefinedMatchLengthsEncoding   = newFSEEncoding(predefinedMatchLengths, predefinedMatchLengthsLog)
+)
+
+type fseEntry struct {
+	symbol   uint8
+	nbBits   uint8
+	baseline uint16
+}
+
+// fseTable is a decoding table
+type fseTable struct {
+	log     uint
+	entries []fseEntry
+}
+
+// spread returns the symbol of every state of the table of norm
+func spread(norm []int16, log uint) ([]uint8, int, error) {
+	size := 1 << log
+	symbols := make([]uint8, size)
+	high := size - 1
+	for s, count := range norm {
+		if count == -1 {
+			if high < 0 {
+				return nil, 0, errorf("fse distribution exceeds its table")
+			}
+			symbols[high] = uint8(s)
+			high--
+		}
+	}
+	step := size>>1 + size>>3 + 3
+	mask := size - 1
+	position := 0
+	for s, count := range norm {
+		for i := 0; i < int(count); i++ {
+			symbols[position] = uint8(s)
+			position = (position + step) & mask
+			for position > high {
+				position = (position + step) & mask
+			}
+		}
+	}
+	if position != 0 {
+		return nil, 0, errorf("fse distribution doesn't fill its table")
+	}
+	return symbols, high, nil
+}
+
+// newFSETable returns the decoding table of the normalized distribution norm
+func newFSETable(norm []int16, log uint) (*fseTable, error) {
+	symbols, _, err := spread(norm, log)
+	if err != nil {
+		return nil, err
+	}
+	size := 1 << log
+	next := make([]uint32, len(norm))
+	for s, count := range norm {
+		if count == -1 {
+			next[s] = 1
+		} else {
+			next[s] = uint32(count)
+		}
+	}
+	t := &fseTable{log: log, entries: make([]fseEntry, size)}
+	for u, s := range symbols {
+		state := next[s]
+		next[s]++
+		nbBits := log - highBit(state)
+		t.entries[u] = fseEntry{symbol: s, nbBits: uint8(nbBits), baseline: uint16(state<<nbBits) - uint16(size)}
+	}
+	return t, nil
+}
+
+func mustFSETable(norm []int16, log uint) *fseTable {
+	t, err := newFSETable(norm, log)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+// newRLETable returns the table of a single symbol
+func newRLETable(symbol uint8) *fseTable {
+	return &fseTable{entries: []fseEntry{{symbol: symbol}}}
+}
+
+// readFSETable reads a table description of symbols up to maxSymbol and accuracy up to
+// maxLog, it returns the table and the number of bytes read
+func readFSETable(in []byte, maxSymbol int, maxLog uint) (*fseTable, int, error) {
+	norm, log, n, err := readDistribution(in, maxSymbol, maxLog)
+	if err != nil {
+		return nil, 0, err
+	}
+	t, err := newFSETable(norm, log)
+	return t, n, err
+}
+
+func readDistribution(in []byte, maxSymbol int, maxLog uint) ([]int16, uint, int, error) {
+	if len(in) == 0 {
+		return nil, 0, 0, errorf("fse table description is empty")
+	}
+	r := &forwardReader{in: in}
+	log := uint(r.peek(4)) + 5
+	r.skip(4)
+	if log > maxLog {
+		return nil, 0, 0, errorf("fse accuracy %d is larger than %d", log, maxLog)
+	}
+
+	remaining := 1<<log + 1
+	threshold := 1 << log
+	nbBits := log + 1
+	var norm []int16
+	previousZero := false
+	for remaining > 1 && len(norm) <= maxSymbol {
+		if previousZero {
+			// 2-bit repeat flags of the symbols without probability, 3 continues
+			for {
+				repeat := int(r.peek(2))
+				r.skip(2)
+				for i := 0; i < repeat; i++ {
+					norm = append(norm, 0)
+				}
+				if repeat != 3 {
+					break
+				}
+				if r.bytes() > len(in) {
+					return nil, 0, 0, errorf("fse table description is truncated")
+				}
+			}
+			if len(norm) > maxSymbol {
+				break
+			}
+		}
+
+		max := 2*threshold - 1 - remaining
+		var count int
+		if low := int(r.peek(nbBits - 1)); low < max {
+			count = low
+			r.skip(nbBits - 1)
+		} else {
+			count = int(r.peek(nbBits))
+			if count >= threshold {
+				count -= max
+			}
+			r.skip(nbBits)
+		}
+		count--
+		if count < 0 {
+			remaining += count
+		} else {
+			remaining -= count
+		}
+		norm = append(norm, int16(count))
+		previousZero = count == 0
+		for remaining < threshold {
+			nbBits--
+			threshold >>= 1
+		}
+		if r.bytes() > len(in) {
+			return nil, 0, 0, errorf("fse table description is truncated")
+		}
+	}
+	if remaining != 1 || len(norm) > maxSymbol+1 {
+		return nil, 0, 0, errorf("fse table description is invalid")
+	}
+	return norm, log, r.bytes(), nil
+}
+
+// fseDecoder decodes the symbols of a table from a bit stream
+type fseDecoder struct {
+	table *fseTable
+	state uint16
+}
+
+func (d *fseDecoder) init(r *reverseReader) {
+	d.state = uint16(r.read(d.table.log))
+}
+
+func (d *fseDecoder) symbol() uint8 {
+	return d.table.entries[d.state].symbol
+}
+
+func (d *fseDecoder) update(r *reverseReader) {
+	e := d.table.entries[d.state]
+	d.state = e.baseline + uint16(r.read(uint(e.nbBits)))
+}
+
+// fseEncoding is the encoding table of a distribution
+type fseEncoding struct {
+	log    uint
+	states []uint16
+
+	// deltaBits and deltaState of symbols give the bits of a transition and its next state
+	deltaBits  []uint32
+	deltaState []int32
+}
+
+func newFSEEncoding(norm []int16, log uint) *fseEncoding {
+	symbols, _, err := spread(norm, log)
+	if err != nil {
+		// predefined distributions are valid
+		panic(err)
+	}
+	size := 1 << log
+	e := &fseEncoding{
+		log:        log,
+		states:     make([]uint16, size),
+		deltaBits:  make([]uint32, len(norm)),
+		deltaState: make([]int32, len(norm)),
+	}
+
+	cumul := make([]int, len(norm)+1)
+	for s, count := range norm {
+		if count == -1 {
+			count = 1
+		}
+		cumul[s+1] = cumul[s] + int(count)
+	}
+	next := append([]int(nil), cumul[:len(norm)]...)
+	for u, s := range symbols {
+		e.states[next[s]] = uint16(size + u)
+		next[s]++
+	}
+
+	total := 0
+	for s, count := range norm {
+		switch {
+		case count == 0:
+		case count == -1 || count == 1:
+			e.deltaBits[s] = uint32(log<<16) - uint32(size)
+			e.deltaState[s] = int32(total - 1)
+			total++
+		default:
+			maxBitsOut := log - highBit(uint32(count-1))
+			minStatePlus := uint32(count) << maxBitsOut
+			e.deltaBits[s] = uint32(maxBitsOut<<16) - minStatePlus
+			e.deltaState[s] = int32(total - int(count))
+			total += int(count)
+		}
+	}
+	return e
+}
+
+// fseEncoder encodes symbols with a table, writing the bits of the transitions
+type fseEncoder struct {
+	table *fseEncoding
+	state uint32
+}
+
+// init sets the state of the first encoded symbol, the last one to be decoded
+func (e *fseEncoder) init(symbol uint8) {
+	t := e.table
+	nbBitsOut := (t.deltaBits[symbol] + 1<<15) >> 16
+	value := nbBitsOut<<16 - t.deltaBits[symbol]
+	e.state = uint32(t.states[int32(value>>nbBitsOut)+t.deltaState[symbol]])
+}
+
+func (e *fseEncoder) encode(w *bitWriter, symbol uint8) {
+	t := e.table
+	nbBitsOut := (e.state + t.deltaBits[symbol]) >> 16
+	w.add(uint64(e.state), uint(nbBitsOut))
+	e.state = uint32(t.states[int32(e.state>>nbBitsOut)+t.deltaState[symbol]])
+}
+
+func (e *fseEncoder) flush(w *bitWriter) {
+	w.add(uint64(e.state), e.table.log)
+}
@@ -0,0 +1,340 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package zstd
+
+import (
+	"container/heap"
+	"encoding/binary"
+)
+
+// Huffman coding of the literals (RFC 8878 section 4.2)
+
+const (
+	maxHuffmanBits = 11
+
+	// maxDirectWeights is the number of weights described with 4 bits each, the weight of the
+	// last symbol is implied
+	maxDirectWeights = 128
+)
+
+type huffmanEntry struct {
+	symbol uint8
+	nbBits uint8
+}
+
+// huffmanTable is a decoding table indexed by the next maxBits bits of streams
+type huffmanTable struct {
+	maxBits uint
+	entries []huffmanEntry
+}
+
+// readHuffmanTable reads a tree description, it returns the table and the number of bytes read
+func readHuffmanTable(in []byte) (*huffmanTable, int, error) {
+	if len(in) == 0 {
+		return nil, 0, errorf("huffman tree description is empty")
+	}
+	var weights []uint8
+	header := int(in[0])
+	n := 1
+	if header < 128 {
+		// weights are FSE compressed with two interleaved states
+		if len(in) < 1+header {
+			return nil, 0, errorf("huffman weights are truncated")
+		}
+		data := in[1 : 1+header]
+		table, size, err := readFSETable(data, 255, 6)
+		if err != nil {
+			return nil, 0, err
+		}
+		r, err := newReverseReader(data[size:])
+		if err != nil {
+			return nil, 0, err
+		}
+		s1, s2 := &fseDecoder{table: table}, &fseDecoder{table: table}
+		s1.init(r)
+		s2.init(r)
+		for {
+			if len(weights) > 254 {
+				return nil, 0, errorf("huffman tree has too many weights")
+			}
+			weights = append(weights, s1.symbol())
+			s1.update(r)
+			if r.overflowed() {
+				weights = append(weights, s2.symbol())
+				break
+			}
+			weights = append(weights, s2.symbol())
+			s2.update(r)
+			if r.overflowed() {
+				weights = append(weights, s1.symbol())
+				break
+			}
+		}
+		n += header
+	} else {
+		count := header - 127
+		size := (count + 1) / 2
+		if len(in) < 1+size {
+			return nil, 0, errorf("huffman weights are truncated")
+		}
+		for i := 0; i < count; i++ {
+			b := in[1+i/2]
+			if i%2 == 0 {
+				weights = append(weights, b>>4)
+			} else {
+				weights = append(weights, b&15)
+			}
+		}
+		n += size
+	}
+	t, err := newHuffmanTable(weights)
+	return t, n, err
+}
+
+// newHuffmanTable returns the table of weights, completed with the implied weight of the last symbol
+func newHuffmanTable(weights []uint8) (*huffmanTable, error) {
+	if len(weights) > 255 {
+		return nil, errorf("huffman tree has too many weights")
+	}
+	total := uint32(0)
+	for _, w := range weights {
+		if w > maxHuffmanBits {
+			return nil, errorf("huffman weight %d is too large", w)
+		}
+		if w > 0 {
+			total += 1 << (w - 1)
+		}
+	}
+	if total == 0 {
+		return nil, errorf("huffman tree has no weight")
+	}
+	maxBits := highBit(total) + 1
+	rest := uint32(1)<<maxBits - total
+	if maxBits > maxHuffmanBits || rest&(rest-1) != 0 {
+		return nil, errorf("huffman weights are invalid")
+	}
+	weights = append(weights, uint8(highBit(rest)+1))
+
+	// codes are assigned by increasing weight then symbol
+	t := &huffmanTable{maxBits: maxBits, entries: make([]huffmanEntry, 1<<maxBits)}
+	position := 0
+	for w := uint8(1); w <= uint8(maxBits); w++ {
+		for s, weight := range weights {
+			if weight != w {
+				continue
+			}
+			entry := huffmanEntry{symbol: uint8(s), nbBits: uint8(maxBits) + 1 - w}
+			for i := 0; i < 1<<(w-1); i++ {
+				t.entries[position] = entry
+				position++
+			}
+		}
+	}
+	return t, nil
+}
+
+// decode appends the size symbols of a stream to out
+func (t *huffmanTable) decode(out, stream []byte, size int) ([]byte, error) {
+	r, err := newReverseReader(stream)
+	if err != nil {
+		return nil, err
+	}
+	for i := 0; i < size; i++ {
+		e := t.entries[r.peek(t.maxBits)]
+		r.pos -= int(e.nbBits)
+		out = append(out, e.symbol)
+	}
+	if !r.finished() {
+		return nil, errorf("huffman stream has %d extra bits", r.pos)
+	}
+	return out, nil
+}
+
+// decode4 appends the size symbols of 4 streams preceded by their jump table
+func (t *huffmanTable) decode4(out, in []byte, size int) ([]byte, error) {
+	if len(in) < 10 {
+		return nil, errorf("huffman streams are truncated")
+	}
+	sizes := [3]int{
+		int(binary.LittleEndian.Uint16(in)),
+		int(binary.LittleEndian.Uint16(in[2:])),
+		int(binary.LittleEndian.Uint16(in[4:])),
+	}
+	in = in[6:]
+	segment := (size + 3) / 4
+	var err error
+	for i := 0; i < 4; i++ {
+		stream, n := in, size-3*segment
+		if i < 3 {
+			if sizes[i] > len(in) {
+				return nil, errorf("huffman streams are truncated")
+			}
+			stream, n = in[:sizes[i]], segment
+			in = in[sizes[i]:]
+		}
+		if n < 0 {
+			return nil, errorf("huffman streams are too large")
+		}
+		if out, err = t.decode(out, stream, n); err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
+// huffmanCode is the code of a symbol, written from its most significant bit
+type huffmanCode struct {
+	code   uint16
+	nbBits uint8
+}
+
+// huffmanEncoding returns the description of a tree with direct weights and the codes of the
+// literals, it returns false when the literals use a single symbol or have bytes past the
+// direct weights
+func huffmanEncoding(literals []byte) ([]byte, []huffmanCode, bool) {
+	var counts [256]int
+	maxSymbol := 0
+	for _, b := range literals {
+		counts[b]++
+		if int(b) > maxSymbol {
+			maxSymbol = int(b)
+		}
+	}
+	if maxSymbol > maxDirectWeights {
+		return nil, nil, false
+	}
+	lengths := huffmanLengths(counts[:maxSymbol+1])
+	if lengths == nil {
+		return nil, nil, false
+	}
+
+	maxBits := uint8(0)
+	for _, l := range lengths {
+		if l > maxBits {
+			maxBits = l
+		}
+	}
+	weights := make([]uint8, len(lengths))
+	for s, l := range lengths {
+		if l > 0 {
+			weights[s] = maxBits + 1 - l
+		}
+	}
+
+	codes := make([]huffmanCode, len(lengths))
+	position := 0
+	for w := uint8(1); w <= maxBits; w++ {
+		for s, weight := range weights {
+			if weight == w {
+				codes[s] = huffmanCode{code: uint16(position >> (w - 1)), nbBits: lengths[s]}
+				position += 1 << (w - 1)
+			}
+		}
+	}
+
+	count := len(weights) - 1
+	description := make([]byte, 1+(count+1)/2)
+	description[0] = byte(127 + count)
+	for i := 0; i < count; i++ {
+		if i%2 == 0 {
+			description[1+i/2] |= weights[i] << 4
+		} else {
+			description[1+i/2] |= weights[i]
+		}
+	}
+	return description, codes, true
+}
+
+// huffmanLengths returns the code lengths of counts up to maxHuffmanBits, halving the counts
+// until the tree is short enough, or nil without two symbols
+func huffmanLengths(counts []int) []uint8 {
+	counts = append([]int(nil), counts...)
+	for {
+		lengths, depth := huffmanTree(counts)
+		if lengths == nil || depth <= maxHuffmanBits {
+			return lengths
+		}
+		for s, c := range counts {
+			if c > 0 {
+				counts[s] = (c + 1) / 2
+			}
+		}
+	}
+}
+
+type huffmanNode struct {
+	count       int
+	symbol      int
+	left, right *huffmanNode
+}
+
+type huffmanHeap []*huffmanNode
+
+func (h huffmanHeap) Len() int { return len(h) }
+func (h huffmanHeap) Less(i, j int) bool {
+	if h[i].count != h[j].count {
+		return h[i].count < h[j].count
+	}
+	return h[i].symbol < h[j].symbol
+}
+func (h huffmanHeap) Swap(i, j int)       { h[i], h[j] = h[j], h[i] }
+func (h *huffmanHeap) Push(x interface{}) { *h = append(*h, x.(*huffmanNode)) }
+func (h *huffmanHeap) Pop() interface{} {
+	old := *h
+	n := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return n
+}
+
+func huffmanTree(counts []int) ([]uint8, uint8) {
+	h := &huffmanHeap{}
+	for s, c := range counts {
+		if c > 0 {
+			*h = append(*h, &huffmanNode{count: c, symbol: s})
+		}
+	}
+	if h.Len() < 2 {
+		return nil, 0
+	}
+	heap.Init(h)
+	next := len(counts)
+	for h.Len() > 1 {
+		a := heap.Pop(h).(*huffmanNode)
+		b := heap.Pop(h).(*huffmanNode)
+		heap.Push(h, &huffmanNode{count: a.count + b.count, symbol: next, left: a, right: b})
+		next++
+	}
+
+	lengths := make([]uint8, len(counts))
+	depth := uint8(0)
+	type item struct {
+		node  *huffmanNode
+		depth uint8
+	}
+	stack := []item{{node: (*h)[0]}}
+	for len(stack) > 0 {
+		it := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if it.node.left == nil {
+			lengths[it.node.symbol] = it.depth
+			if it.depth > depth {
+				depth = it.depth
+			}
+			continue
+		}
+		stack = append(stack, item{it.node.left, it.depth + 1}, item{it.node.right, it.depth + 1})
+	}
+	return lengths, depth
+}
+
+// huffmanStream returns the codes of literals as a bit stream, the first literal is read first
+func huffmanStream(literals []byte, codes []huffmanCode) []byte {
+	w := &bitWriter{out: make([]byte, 0, len(literals)/2)}
+	for i := len(literals) - 1; i >= 0; i-- {
+		c := codes[literals[i]]
+		w.add(uint64(c.code), uint(c.nbBits))
+	}
+	return w.close()
+}
@@ -0,0 +1,73 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package zstd
+
+// Codes of the literal lengths and match lengths (RFC 8878 section 3.1.1.3.2.1.1)
+
+type lengthCode struct {
+	baseline uint32
+	bits     uint8
+}
+
+var literalLengthCodes = [36]lengthCode{
+	{0, 0}, {1, 0}, {2, 0}, {3, 0}, {4, 0}, {5, 0}, {6, 0}, {7, 0},
+	{8, 0}, {9, 0}, {10, 0}, {11, 0}, {12, 0}, {13, 0}, {14, 0}, {15, 0},
+	{16, 1}, {18, 1}, {20, 1}, {22, 1}, {24, 2}, {28, 2}, {32, 3}, {40, 3},
+	{48, 4}, {64, 6}, {128, 7}, {256, 8}, {512, 9}, {1024, 10}, {2048, 11}, {4096, 12},
+	{8192, 13}, {16384, 14}, {32768, 15}, {65536, 16},
+}
+
+var matchLengthCodes = [53]lengthCode{
+	{3, 0}, {4, 0}, {5, 0}, {6, 0}, {7, 0}, {8, 0}, {9, 0}, {10, 0},
+	{11, 0}, {12, 0}, {13, 0}, {14, 0}, {15, 0}, {16, 0}, {17, 0}, {18, 0},
+	{19, 0}, {20, 0}, {21, 0}, {22, 0}, {23, 0}, {24, 0}, {25, 0}, {26, 0},
+	{27, 0}, {28, 0}, {29, 0}, {30, 0}, {31, 0}, {32, 0}, {33, 0}, {34, 0},
+	{35, 1}, {37, 1}, {39, 1}, {41, 1}, {43, 2}, {47, 2}, {51, 3}, {59, 3},
+	{67, 4}, {83, 4}, {99, 5}, {131, 7}, {259, 8}, {515, 9}, {1027, 10}, {2051, 11},
+	{4099, 12}, {8195, 13}, {16387, 14}, {32771, 15}, {65539, 16},
+}
+
+const (
+	maxLiteralLengthCode = len(literalLengthCodes) - 1
+	maxMatchLengthCode   = len(matchLengthCodes) - 1
+	maxOffsetCode        = 31
+
+	maxLiteralLengthLog = 9
+	maxMatchLengthLog   = 9
+	maxOffsetLog        = 8
+)
+
+// sequence copies literals then a match
+type sequence struct {
+	literals uint32
+	match    uint32
+
+	// offset is the offset value, repeat offsets are 1 to 3 and new offsets are shifted by 3
+	offset uint32
+}
+
+// literalLengthCode and matchLengthCode return the code of a length, the largest code whose
+// baseline isn't after it
+func literalLengthCode(length uint32) uint8 {
+	if length >= 64 {
+		return uint8(highBit(length) + 19)
+	}
+	return lengthCodeOf(literalLengthCodes[:25], length)
+}
+
+func matchLengthCode(length uint32) uint8 {
+	if length >= 131 {
+		return uint8(highBit(length-3) + 36)
+	}
+	return lengthCodeOf(matchLengthCodes[:43], length)
+}
+
+func lengthCodeOf(codes []lengthCode, length uint32) uint8 {
+	code := len(codes) - 1
+	for codes[code].baseline > length {
+		code--
+	}
+	return uint8(code)
+}
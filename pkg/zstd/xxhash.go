@@ -0,0 +1,79 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package zstd
+
+import (
+	"encoding/binary"
+	"math/bits"
+)
+
+// XXH64 with seed 0, the low 32 bits are the content checksum of the frames
+
+const (
+	prime64a uint64 = 11400714785074694791
+	prime64b uint64 = 14029467366897019727
+	prime64c uint64 = 1609587929392839161
+	prime64d uint64 = 9650029242287828579
+	prime64e uint64 = 2870177450012600261
+)
+
+func xxhash64(in []byte) uint64 {
+	n := len(in)
+	var h uint64
+	if n >= 32 {
+		a, b := prime64a, prime64b
+		v1 := a + b
+		v2 := b
+		v3 := uint64(0)
+		v4 := -a
+		for len(in) >= 32 {
+			v1 = xxhashRound(v1, binary.LittleEndian.Uint64(in[0:]))
+			v2 = xxhashRound(v2, binary.LittleEndian.Uint64(in[8:]))
+			v3 = xxhashRound(v3, binary.LittleEndian.Uint64(in[16:]))
+			v4 = xxhashRound(v4, binary.LittleEndian.Uint64(in[24:]))
+			in = in[32:]
+		}
+		h = bits.RotateLeft64(v1, 1) + bits.RotateLeft64(v2, 7) + bits.RotateLeft64(v3, 12) + bits.RotateLeft64(v4, 18)
+		h = xxhashMerge(h, v1)
+		h = xxhashMerge(h, v2)
+		h = xxhashMerge(h, v3)
+		h = xxhashMerge(h, v4)
+	} else {
+		h = prime64e
+	}
+	h += uint64(n)
+
+	for ; len(in) >= 8; in = in[8:] {
+		h ^= xxhashRound(0, binary.LittleEndian.Uint64(in))
+		h = bits.RotateLeft64(h, 27)*prime64a + prime64d
+	}
+	if len(in) >= 4 {
+		h ^= uint64(binary.LittleEndian.Uint32(in)) * prime64a
+		h = bits.RotateLeft64(h, 23)*prime64b + prime64c
+		in = in[4:]
+	}
+	for _, b := range in {
+		h ^= uint64(b) * prime64e
+		h = bits.RotateLeft64(h, 11) * prime64a
+	}
+
+	h ^= h >> 33
+	h *= prime64b
+	h ^= h >> 29
+	h *= prime64c
+	h ^= h >> 32
+	return h
+}
+
+func xxhashRound(acc, input uint64) uint64 {
+	acc += input * prime64b
+	acc = bits.RotateLeft64(acc, 31)
+	return acc * prime64a
+}
+
+func xxhashMerge(acc, v uint64) uint64 {
+	acc ^= xxhashRound(0, v)
+	return acc*prime64a + prime64d
+}
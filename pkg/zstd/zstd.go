@@ -0,0 +1,82 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package zstd
+
+/*
+	Package zstd compresses and decompresses Zstandard frames (RFC 8878), the format of the
+	zstd command line tool and libraries:
+
+		magic (28 b5 2f fd) | frame header | blocks | content checksum
+
+	Decompress reads frames of any compressor without dictionary, including skippable frames
+	and concatenated frames. Compress writes single segment frames of raw, RLE or compressed
+	blocks: literals are Huffman coded with weights described directly and sequences use the
+	predefined FSE distributions, levels trade the time searching matches for their length.
+*/
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+const (
+	// MinLevel is the fastest level of compression
+	MinLevel = 1
+
+	// MaxLevel is the level of the smallest frames
+	MaxLevel = 9
+
+	// DefaultLevel balances speed and size
+	DefaultLevel = 3
+
+	frameMagic         = 0xFD2FB528
+	skippableMagicMask = 0xFFFFFFF0
+	skippableMagic     = 0x184D2A50
+
+	maxBlockSize = 128 << 10
+)
+
+// ErrCorrupted is returned when data isn't a valid zstd frame
+var ErrCorrupted = errors.New("zstd: corrupted data")
+
+// ErrDictionary is returned for frames compressed with a dictionary
+var ErrDictionary = errors.New("zstd: dictionaries aren't supported")
+
+func errorf(format string, args ...interface{}) error {
+	return fmt.Errorf("%w: %s", ErrCorrupted, fmt.Sprintf(format, args...))
+}
+
+// IsFrame reports whether data starts with the magic number of a zstd frame
+func IsFrame(data []byte) bool {
+	return len(data) >= 4 && binary.LittleEndian.Uint32(data) == frameMagic
+}
+
+// Compress returns src as a zstd frame, level is clamped between MinLevel and MaxLevel
+func Compress(src []byte, level int) []byte {
+	if level < MinLevel {
+		level = MinLevel
+	}
+	if level > MaxLevel {
+		level = MaxLevel
+	}
+	return newEncoder(level).frame(src)
+}
+
+// Decompress returns the content of the frames of src
+func Decompress(src []byte) ([]byte, error) {
+	d := &decoder{}
+	if len(src) == 0 {
+		return nil, errorf("no frame")
+	}
+	for len(src) > 0 {
+		n, err := d.frame(src)
+		if err != nil {
+			return nil, err
+		}
+		src = src[n:]
+	}
+	return d.out, nil
+}
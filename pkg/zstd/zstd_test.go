@@ -0,0 +1,101 @@
+// Copyright 2021 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package zstd
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/moov-io/iso20022/pkg/iso20022test/fixtures"
+)
+
+func TestCompress(t *testing.T) {
+	statement := fixtures.ReadFile(t, "valid_camt053_v08.xml")
+	payment := fixtures.ReadFile(t, "valid_pacs_v08.xml")
+	random := make([]byte, 200<<10)
+	rand.New(rand.NewSource(1)).Read(random)
+
+	inputs := map[string][]byte{
+		"empty":      {},
+		"byte":       {'<'},
+		"statement":  statement,
+		"payment":    payment,
+		"statements": bytes.Repeat(statement, 200),
+		"utf-8":      bytes.Repeat([]byte("<Nm>Jürgen Müller</Nm><Ctry>CH</Ctry>"), 500),
+		"random":     random,
+		"rle":        bytes.Repeat([]byte{' '}, 300<<10),
+	}
+	for name, input := range inputs {
+		for level := MinLevel; level <= MaxLevel; level++ {
+			frame := Compress(input, level)
+			require.True(t, IsFrame(frame), name)
+			output, err := Decompress(frame)
+			require.Nil(t, err, "%s at level %d", name, level)
+			require.True(t, bytes.Equal(input, output), "%s at level %d", name, level)
+		}
+	}
+
+	// levels trade speed for size
+	fast, small := Compress(inputs["statements"], MinLevel), Compress(inputs["statements"], MaxLevel)
+	require.Less(t, len(small), len(fast))
+	require.Less(t, len(Compress(statement, DefaultLevel)), len(statement)/2)
+
+	// incompressible data is stored in raw blocks
+	require.Less(t, len(Compress(random, DefaultLevel)), len(random)+64)
+
+	// levels out of range are clamped
+	require.Equal(t, Compress(payment, MaxLevel), Compress(payment, 20))
+	require.Equal(t, Compress(payment, MinLevel), Compress(payment, -1))
+}
+
+func TestDecompress(t *testing.T) {
+	// frame of the reference implementation, with FSE compressed tables and Huffman weights
+	frame := fixtures.ReadFile(t, "valid_camt053_v08.xml.zst")
+	output, err := Decompress(frame)
+	require.Nil(t, err)
+	require.Equal(t, fixtures.ReadFile(t, "valid_camt053_v08.xml"), output)
+
+	// concatenated and skippable frames
+	skippable := make([]byte, 12)
+	binary.LittleEndian.PutUint32(skippable, skippableMagic+3)
+	binary.LittleEndian.PutUint32(skippable[4:], 4)
+	input := append(append(append([]byte(nil), frame...), skippable...), Compress([]byte("<Document/>"), DefaultLevel)...)
+	output, err = Decompress(input)
+	require.Nil(t, err)
+	require.Equal(t, append(fixtures.ReadFile(t, "valid_camt053_v08.xml"), "<Document/>"...), output)
+
+	corrupted := append([]byte(nil), frame...)
+	corrupted[len(corrupted)-1] ^= 0xFF
+	for name, input := range map[string][]byte{
+		"empty":     nil,
+		"magic":     []byte("<Document/>"),
+		"truncated": frame[:len(frame)/2],
+		"checksum":  corrupted,
+	} {
+		_, err := Decompress(input)
+		require.True(t, errors.Is(err, ErrCorrupted), name)
+	}
+
+	// corrupted frames fail without panicking
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 2000; i++ {
+		input := append([]byte(nil), frame...)
+		for j := 0; j < 1+rng.Intn(4); j++ {
+			input[4+rng.Intn(len(input)-4)] = byte(rng.Intn(256))
+		}
+		Decompress(input)
+	}
+}
+
+func TestXXHash64(t *testing.T) {
+	require.Equal(t, uint64(0xEF46DB3751D8E999), xxhash64(nil))
+	require.Equal(t, uint64(0x44BC2CF5AD770999), xxhash64([]byte("abc")))
+	require.Equal(t, uint64(0xD6FB1032A94E83A2), xxhash64(bytes.Repeat([]byte("iso20022"), 10)))
+}